@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -154,6 +156,17 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		panic(err)
 	}
 
+	// the span of the previous block is ended by Commit, unless it was not
+	// committed, e.g. in tests
+	app.endBlockSpan()
+	app.startBlockSpan(req.Header.Height)
+	traceCtx, span := app.startSpan("BeginBlock", req.Header.Height)
+	defer span.End()
+
+	if app.gasUsage != nil {
+		app.gasUsage.beginBlock()
+	}
+
 	// Initialize the DeliverTx state. If this is the first block, it should
 	// already be initialized in InitChain. Otherwise app.deliverState will be
 	// nil, since it is reset on Commit.
@@ -190,11 +203,20 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	}
 
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		res = app.beginBlocker(app.deliverState.ctx.WithContext(traceCtx), req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	if app.replayVerifier != nil {
+		app.replayVerifier.beginBlock(req)
+	}
+
+	app.listen("BeginBlock", func(listener ABCIListener) error {
+		return listener.ListenBeginBlock(app.deliverState.ctx, req, res)
+	})
+
 	return res
 }
 
@@ -206,8 +228,11 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
+	traceCtx, span := app.startSpan("EndBlock", req.Height)
+	defer span.End()
+
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		res = app.endBlocker(app.deliverState.ctx.WithContext(traceCtx), req)
 		res.Events = sdk.MarkEventsToIndex(res.Events, app.indexEvents)
 	}
 
@@ -215,6 +240,14 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res.ConsensusParamUpdates = cp
 	}
 
+	if app.replayVerifier != nil {
+		app.replayVerifier.endBlock(req)
+	}
+
+	app.listen("EndBlock", func(listener ABCIListener) error {
+		return listener.ListenEndBlock(app.deliverState.ctx, req, res)
+	})
+
 	return res
 }
 
@@ -225,7 +258,8 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 // will contain releveant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
 func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
-	defer telemetry.MeasureSince(time.Now(), "abci", "check_tx")
+	start := time.Now()
+	defer telemetry.MeasureSince(start, "abci", "check_tx")
 
 	var mode runTxMode
 
@@ -240,7 +274,8 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, err := app.runTx(mode, req.Tx)
+	gInfo, result, _, err := app.runTx(mode, req.Tx)
+	recordCheckTx(mode, start, err)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
 	}
@@ -259,7 +294,7 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "deliver_tx")
 
 	gInfo := sdk.GasInfo{}
@@ -272,10 +307,25 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx)
+	defer func() {
+		app.listen("DeliverTx", func(listener ABCIListener) error {
+			return listener.ListenDeliverTx(app.deliverState.ctx, req, res)
+		})
+	}()
+
+	if app.replayVerifier != nil {
+		app.replayVerifier.deliverTx(req.Tx)
+	}
+
+	traceCtx, span := app.startDeliverTxSpan(req.Tx, app.deliverState.ctx.BlockHeight())
+	app.txTraceCtx = traceCtx
+	defer func() { app.txTraceCtx = nil }()
+
+	gInfo, result, anteEvents, err := app.runTx(runTxModeDeliver, req.Tx)
+	endSpan(span, err)
 	if err != nil {
 		resultStr = "failed"
-		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed, app.trace)
+		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
 	}
 
 	return abci.ResponseDeliverTx{
@@ -297,9 +347,16 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	defer telemetry.MeasureSince(time.Now(), "abci", "commit")
 
+	app.commitMtx.Lock()
+	defer app.commitMtx.Unlock()
+
 	header := app.deliverState.ctx.BlockHeader()
 	retainHeight := app.GetBlockRetentionHeight(header.Height)
 
+	_, span := app.startSpan("Commit", header.Height)
+	defer app.endBlockSpan()
+	defer span.End()
+
 	// Write the DeliverTx state into branched storage and commit the MultiStore.
 	// The write to the DeliverTx state writes all state transitions to the root
 	// MultiStore (app.cms) so when Commit() is called is persists those values.
@@ -307,6 +364,36 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Info("commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	if app.replayVerifier != nil {
+		app.replayVerifier.commit(commitID.Hash)
+	}
+
+	if app.gasUsage != nil {
+		var gasUsed uint64
+		if gm := app.deliverState.ctx.BlockGasMeter(); gm != nil {
+			gasUsed = gm.GasConsumedToLimit()
+		}
+		app.gasUsage.commit(header.Height, gasUsed)
+	}
+
+	if app.stateSize != nil {
+		app.commitStateSize(header.Height)
+	}
+
+	// the cached query responses are mostly of the previous height
+	if app.queryCache != nil {
+		app.queryCache.clear()
+	}
+
+	res = abci.ResponseCommit{
+		Data:         commitID.Hash,
+		RetainHeight: retainHeight,
+	}
+
+	app.listen("Commit", func(listener ABCIListener) error {
+		return listener.ListenCommit(app.deliverState.ctx, res)
+	})
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -335,13 +422,14 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	}
 
 	if app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
-		go app.snapshot(header.Height)
+		app.snapshotWG.Add(1)
+		go func() {
+			defer app.snapshotWG.Done()
+			app.snapshot(header.Height)
+		}()
 	}
 
-	return abci.ResponseCommit{
-		Data:         commitID.Hash,
-		RetainHeight: retainHeight,
-	}
+	return res
 }
 
 // halt attempts to gracefully shutdown the node via SIGINT and SIGTERM falling
@@ -568,7 +656,16 @@ func (app *BaseApp) ApplySnapshotChunk(req abci.RequestApplySnapshotChunk) abci.
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	// the proofs are not cached
+	cached := app.queryCache.Cached(req.Path) && !req.Prove
+	cacheKey := queryCacheKey{method: req.Path, height: req.Height, request: string(req.Data)}
+	if cached {
+		if res, ok := app.queryCache.get(cacheKey); ok {
+			return res.(abci.ResponseQuery)
+		}
+	}
+
+	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
@@ -580,6 +677,10 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 		return res
 	}
 
+	if cached {
+		app.queryCache.set(cacheKey, res)
+	}
+
 	return res
 }
 
@@ -614,9 +715,11 @@ func checkNegativeHeight(height int64) error {
 	return nil
 }
 
-// createQueryContext creates a new sdk.Context for a query, taking as args
-// the block height and whether the query needs a proof or not.
-func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
+// CreateQueryContext creates a new sdk.Context for a query, taking as args
+// the block height and whether the query needs a proof or not. The context is
+// on a branch of the committed state of the height, which can be read while
+// the blocks are executed, e.g. to check the state off the consensus path.
+func (app *BaseApp) CreateQueryContext(height int64, prove bool) (sdk.Context, error) {
 	if err := checkNegativeHeight(height); err != nil {
 		return sdk.Context{}, err
 	}
@@ -646,7 +749,7 @@ func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, e
 	// branch the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.getMinGasPrices())
 
 	return ctx, nil
 }
@@ -772,6 +875,15 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.version),
 			}
 
+		case "commit_info":
+			return handleQueryCommitInfo(app, req)
+
+		case "gas_usage":
+			return handleQueryGasUsage(app, req)
+
+		case "state_size":
+			return handleQueryStateSize(app, req)
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -780,11 +892,86 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be one of 'simulate', 'version', 'commit_info', 'gas_usage' or 'state_size', none was present",
 		),
 	)
 }
 
+// commitInfoGetter is implemented by multistores able to return the per-store
+// commit IDs composing the app hash at a given version.
+type commitInfoGetter interface {
+	GetCommitInfo(ver int64) (*storetypes.CommitInfo, error)
+}
+
+// handleQueryCommitInfo returns the protobuf encoded CommitInfo at the request
+// height, which allows app hash mismatches to be narrowed down to a store.
+func handleQueryCommitInfo(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	getter, ok := app.cms.(commitInfoGetter)
+	if !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "multistore doesn't expose commit info"))
+	}
+
+	commitInfo, err := getter.GetCommitInfo(req.Height)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to load commit info at height %d: %s", req.Height, err))
+	}
+
+	bz, err := commitInfo.Marshal()
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to encode commit info"))
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    req.Height,
+		Value:     bz,
+	}
+}
+
+// handleQueryGasUsage returns the JSON encoded GasUsageResponse with the
+// breakdown per message type of the gas consumed by the last committed blocks,
+// which is local to the node and kept since it started.
+func handleQueryGasUsage(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	if app.gasUsage == nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "gas usage is not tracked, the gas usage window is 0"))
+	}
+
+	bz, err := json.Marshal(app.gasUsage.usage())
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode gas usage"))
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    app.LastBlockHeight(),
+		Value:     bz,
+	}
+}
+
+// handleQueryStateSize returns the JSON encoded StateSizeReport of the last
+// measurement of the size of the stores of the state.
+func handleQueryStateSize(app *BaseApp, req abci.RequestQuery) abci.ResponseQuery {
+	if app.stateSize == nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "state size is not measured, the state size interval is 0"))
+	}
+
+	report, ok := app.stateSize.lastReport()
+	if !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrNotFound, "state size was not measured yet"))
+	}
+
+	bz, err := json.Marshal(report)
+	if err != nil {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode state size"))
+	}
+
+	return abci.ResponseQuery{
+		Codespace: sdkerrors.RootCodespace,
+		Height:    report.Height,
+		Value:     bz,
+	}
+}
+
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) abci.ResponseQuery {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(sdk.Queryable)
@@ -854,7 +1041,7 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	ctx, err := app.createQueryContext(req.Height, req.Prove)
+	ctx, err := app.CreateQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
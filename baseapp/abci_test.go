@@ -133,7 +133,7 @@ func TestBaseAppCreateQueryContextRejectsNegativeHeights(t *testing.T) {
 	}
 	for _, prove := range proves {
 		t.Run(fmt.Sprintf("prove=%t", prove), func(t *testing.T) {
-			sctx, err := app.createQueryContext(-10, true)
+			sctx, err := app.CreateQueryContext(-10, true)
 			require.Error(t, err)
 			require.Equal(t, sctx, sdk.Context{})
 		})
@@ -1,10 +1,14 @@
 package baseapp
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +16,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -69,8 +75,12 @@ type BaseApp struct { // nolint: maligned
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager    *snapshots.Manager
-	snapshotInterval   uint64 // block interval between state sync snapshots
-	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotInterval   uint64         // block interval between state sync snapshots
+	snapshotKeepRecent uint32         // recent state sync snapshots to keep
+	snapshotWG         sync.WaitGroup // snapshots being taken, waited for on Close
+
+	// commitMtx is held while a block is committed, so that Close waits for it.
+	commitMtx sync.Mutex
 
 	// volatile states:
 	//
@@ -90,8 +100,10 @@ type BaseApp struct { // nolint: maligned
 	paramStore ParamStore
 
 	// The minimum gas prices a validator is willing to accept for processing a
-	// transaction. This is mainly used for DoS and spam prevention.
-	minGasPrices sdk.DecCoins
+	// transaction. This is mainly used for DoS and spam prevention. They can be
+	// updated at runtime, hence minGasPricesMtx.
+	minGasPrices    sdk.DecCoins
+	minGasPricesMtx sync.RWMutex
 
 	// initialHeight is the initial height at which we start the baseapp
 	initialHeight int64
@@ -133,6 +145,37 @@ type BaseApp struct { // nolint: maligned
 	// indexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	indexEvents map[string]struct{}
+
+	// queryCache caches the responses of the configured gRPC queries, if set.
+	queryCache *QueryCache
+
+	// replayVerifier re-executes the committed blocks in the background to
+	// verify their app hash, if replay verification is enabled.
+	replayVerifier *replayVerifier
+
+	// gasUsage keeps the breakdown per message type of the gas consumed by the
+	// last committed blocks, if enabled.
+	gasUsage *gasUsageTracker
+
+	// kvStoreKeys are the keys of the persisted KV stores mounted, and
+	// stateSize keeps the last measurement of their size, if enabled.
+	kvStoreKeys []sdk.StoreKey
+	stateSize   *stateSizeTracker
+
+	// abciListeners are hooked into the ABCI methods.
+	abciListeners []ABCIListener
+
+	// blockTraceCtx carries the span of the block being executed, set on
+	// BeginBlock and ended on Commit, and txTraceCtx the span of the tx being
+	// delivered. Both are no-op unless tracing is started.
+	blockTraceCtx context.Context
+	blockSpan     trace.Span
+	txTraceCtx    context.Context
+
+	// grpcUnaryInterceptors and grpcStreamInterceptors are the interceptors of
+	// the gRPC server added by the operators embedding the app.
+	grpcUnaryInterceptors  []grpc.UnaryServerInterceptor
+	grpcStreamInterceptors []grpc.StreamServerInterceptor
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -255,6 +298,10 @@ func (app *BaseApp) MountMemoryStores(keys map[string]*sdk.MemoryStoreKey) {
 // using the default DB.
 func (app *BaseApp) MountStore(key sdk.StoreKey, typ sdk.StoreType) {
 	app.cms.MountStoreWithDB(key, typ, nil)
+
+	if typ == sdk.StoreTypeIAVL || typ == sdk.StoreTypeDB {
+		app.kvStoreKeys = append(app.kvStoreKeys, key)
+	}
 }
 
 // LoadLatestVersion loads the latest application version. It will panic if
@@ -294,6 +341,30 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// Close waits for the block being committed and for the state sync snapshot
+// being taken, if any, then closes the ABCI listeners implementing io.Closer,
+// e.g. the streaming services, which flush the events of the committed blocks.
+// It is called on shutdown, once no more blocks are executed.
+func (app *BaseApp) Close() error {
+	app.commitMtx.Lock()
+	defer app.commitMtx.Unlock()
+
+	app.snapshotWG.Wait()
+
+	var err error
+	for _, listener := range app.abciListeners {
+		closer, ok := listener.(io.Closer)
+		if !ok {
+			continue
+		}
+		if cerr := closer.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+
+	return err
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -317,13 +388,47 @@ func (app *BaseApp) init() error {
 		}
 	}
 
+	// make sure the state the replayed blocks were executed on is not pruned
+	// before they are verified
+	if app.replayVerifier != nil {
+		rms, ok := app.cms.(*rootmulti.Store)
+		if !ok {
+			return errors.New("replay verification requires a rootmulti store")
+		}
+		pruningOpts := rms.GetPruning()
+		if pruningOpts.Interval > 0 && app.replayVerifier.window > pruningOpts.KeepRecent {
+			return fmt.Errorf(
+				"replay verification window %v must not exceed the pruning keep recent %v",
+				app.replayVerifier.window, pruningOpts.KeepRecent)
+		}
+
+		go app.replayVerifier.run()
+	}
+
 	return nil
 }
 
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
+	app.minGasPricesMtx.Lock()
+	defer app.minGasPricesMtx.Unlock()
+
 	app.minGasPrices = gasPrices
 }
 
+func (app *BaseApp) getMinGasPrices() sdk.DecCoins {
+	app.minGasPricesMtx.RLock()
+	defer app.minGasPricesMtx.RUnlock()
+
+	return app.minGasPrices
+}
+
+// UpdateMinGasPrices replaces the minimum gas prices of the node at runtime,
+// e.g. when the configuration is reloaded. They apply to the transactions
+// checked once the next block is committed.
+func (app *BaseApp) UpdateMinGasPrices(gasPrices sdk.DecCoins) {
+	app.setMinGasPrices(gasPrices)
+}
+
 func (app *BaseApp) setHaltHeight(haltHeight uint64) {
 	app.haltHeight = haltHeight
 }
@@ -340,6 +445,45 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+func (app *BaseApp) setQueryCache(cache *QueryCache) {
+	app.queryCache = cache
+}
+
+func (app *BaseApp) addGRPCUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) {
+	app.grpcUnaryInterceptors = append(app.grpcUnaryInterceptors, interceptors...)
+}
+
+func (app *BaseApp) addGRPCStreamInterceptors(interceptors []grpc.StreamServerInterceptor) {
+	app.grpcStreamInterceptors = append(app.grpcStreamInterceptors, interceptors...)
+}
+
+func (app *BaseApp) setReplayVerificationWindow(window uint64) {
+	if window == 0 {
+		app.replayVerifier = nil
+		return
+	}
+
+	app.replayVerifier = newReplayVerifier(app, window)
+}
+
+func (app *BaseApp) setGasUsageWindow(window uint64) {
+	if window == 0 {
+		app.gasUsage = nil
+		return
+	}
+
+	app.gasUsage = newGasUsageTracker(window)
+}
+
+func (app *BaseApp) setStateSizeInterval(interval uint64) {
+	if interval == 0 {
+		app.stateSize = nil
+		return
+	}
+
+	app.stateSize = newStateSizeTracker(interval)
+}
+
 func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
@@ -380,7 +524,7 @@ func (app *BaseApp) setCheckState(header tmproto.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.getMinGasPrices()),
 	}
 }
 
@@ -539,6 +683,11 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) sdk.Context
 		ctx = ctx.WithIsReCheckTx(true)
 	}
 
+	// the spans of the messages of the tx are children of its span
+	if mode == runTxModeDeliver && app.txTraceCtx != nil {
+		ctx = ctx.WithContext(app.txTraceCtx)
+	}
+
 	if mode == runTxModeSimulate {
 		ctx, _ = ctx.CacheContext()
 	}
@@ -571,8 +720,10 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+// and execute successfully. An error is returned otherwise. The events of the
+// AnteHandler are returned if it succeeded, even if the messages failed, as its
+// state changes, e.g. the deduction of the fees, are persisted.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
@@ -584,7 +735,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -620,15 +771,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	tx, err := app.txDecoder(txBytes)
 	if err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, nil, err
 	}
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, nil, err
 	}
 
-	var events sdk.Events
 	if app.anteHandler != nil {
 		var (
 			anteCtx sdk.Context
@@ -644,7 +794,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
 		anteCtx = anteCtx.WithEventManager(sdk.NewEventManager())
+		anteStart := time.Now()
 		newCtx, err := app.anteHandler(anteCtx, tx, mode == runTxModeSimulate)
+		recordAnteHandler(mode, anteStart)
 
 		if !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is a store branch, or something else
@@ -656,16 +808,17 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 			ctx = newCtx.WithMultiStore(ms)
 		}
 
-		events = ctx.EventManager().Events()
+		events := ctx.EventManager().Events()
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
-			return gInfo, nil, err
+			return gInfo, nil, nil, err
 		}
 
 		msCache.Write()
+		anteEvents = events.ToABCIEvents()
 	}
 
 	// Create a new Context based off of the existing Context with a MultiStore branch
@@ -680,13 +833,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 	if err == nil && mode == runTxModeDeliver {
 		msCache.Write()
 
-		if len(events) > 0 {
+		if len(anteEvents) > 0 {
 			// append the events in the order of occurrence
-			result.Events = append(events.ToABCIEvents(), result.Events...)
+			result.Events = append(anteEvents, result.Events...)
 		}
 	}
 
-	return gInfo, result, err
+	return gInfo, result, anteEvents, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
@@ -714,9 +867,11 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			err          error
 		)
 
+		msgCtx, msgSpan := startMsgSpan(ctx, msg, mode)
+		gasBefore := ctx.GasMeter().GasConsumed()
 		if handler := app.msgServiceRouter.Handler(msg); handler != nil {
 			// ADR 031 request type routing
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 			eventMsgName = sdk.MsgTypeURL(msg)
 		} else if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
 			// legacy sdk.Msg routing
@@ -728,12 +883,21 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 			eventMsgName = legacyMsg.Type()
 			handler := app.router.Route(ctx, msgRoute)
 			if handler == nil {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+				err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+				endSpan(msgSpan, err)
+				return nil, err
 			}
 
-			msgResult, err = handler(ctx, msg)
+			msgResult, err = handler(msgCtx, msg)
 		} else {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %+v", msg)
+			endSpan(msgSpan, err)
+			return nil, err
+		}
+		endSpan(msgSpan, err)
+
+		if mode == runTxModeDeliver {
+			app.recordMsgGas(sdk.MsgTypeURL(msg), ctx.GasMeter().GasConsumed()-gasBefore)
 		}
 
 		if err != nil {
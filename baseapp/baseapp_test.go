@@ -550,6 +550,22 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestUpdateMinGasPrices(t *testing.T) {
+	app := setupBaseApp(t, SetMinGasPrices("5000stake"))
+	app.InitChain(abci.RequestInitChain{})
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}, app.checkState.ctx.MinGasPrices())
+
+	// the updated prices apply once the next block is committed
+	minGasPrices := sdk.DecCoins{sdk.NewInt64DecCoin("stake", 10)}
+	app.UpdateMinGasPrices(minGasPrices)
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin("stake", 5000)}, app.checkState.ctx.MinGasPrices())
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+	require.Equal(t, minGasPrices, app.checkState.ctx.MinGasPrices())
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -1543,9 +1559,12 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	require.NoError(t, err)
 
 	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
-	require.Empty(t, res.Events)
 	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
 
+	// the events of the ante handler are kept, as its state changes are
+	require.Len(t, res.Events, 1)
+	require.Equal(t, "ante_handler", res.Events[0].Type)
+
 	ctx = app.getState(runTxModeDeliver).ctx
 	store = ctx.KVStore(capKey1)
 	require.Equal(t, int64(1), getIntFromStore(store, anteKey))
@@ -1703,6 +1722,33 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestQueryCommitInfo(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	var appHashes [][]byte
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		appHashes = append(appHashes, app.Commit().Data)
+	}
+
+	for i, appHash := range appHashes {
+		height := int64(i + 1)
+		res := app.Query(abci.RequestQuery{Path: "/app/commit_info", Height: height})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, height, res.Height)
+
+		var ci store.CommitInfo
+		require.NoError(t, ci.Unmarshal(res.Value))
+		require.Equal(t, height, ci.Version)
+		require.Equal(t, appHash, ci.Hash())
+		require.NotEmpty(t, ci.StoreInfos)
+	}
+
+	res := app.Query(abci.RequestQuery{Path: "/app/commit_info", Height: 10})
+	require.False(t, res.IsOK())
+}
+
 func TestGRPCQuery(t *testing.T) {
 	grpcQueryOpt := func(bapp *BaseApp) {
 		testdata.RegisterQueryServer(
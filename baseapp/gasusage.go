@@ -0,0 +1,147 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// MsgGasUsage is the gas consumed by the handlers of the messages of a type.
+type MsgGasUsage struct {
+	MsgTypeURL string `json:"msg_type_url"`
+	Count      uint64 `json:"count"`
+	GasUsed    uint64 `json:"gas_used"`
+}
+
+// BlockGasUsage is the breakdown per message type of the gas consumed by a
+// block. GasUsed is the gas consumed by all the txs of the block, including
+// their ante handlers, which is not attributed to any message type.
+type BlockGasUsage struct {
+	Height  int64         `json:"height"`
+	GasUsed uint64        `json:"gas_used"`
+	Msgs    []MsgGasUsage `json:"msgs"`
+}
+
+// GasUsageResponse is the response of the app/gas_usage query, with the gas
+// usage of the last committed blocks, from the oldest, and its total per
+// message type over these blocks.
+type GasUsageResponse struct {
+	Blocks []BlockGasUsage `json:"blocks"`
+	Msgs   []MsgGasUsage   `json:"msgs"`
+}
+
+// gasUsageTracker keeps the breakdown per message type of the gas consumed by
+// the last window committed blocks. The messages are added by DeliverTx, and the
+// blocks are read by the queries concurrently.
+type gasUsageTracker struct {
+	window uint64
+
+	// current is the gas usage of the block being delivered, only accessed by
+	// the ABCI methods.
+	current map[string]*MsgGasUsage
+
+	mtx    sync.RWMutex
+	blocks []BlockGasUsage
+}
+
+func newGasUsageTracker(window uint64) *gasUsageTracker {
+	return &gasUsageTracker{
+		window:  window,
+		current: make(map[string]*MsgGasUsage),
+	}
+}
+
+// beginBlock discards the gas usage of any block which was not committed.
+func (t *gasUsageTracker) beginBlock() {
+	t.current = make(map[string]*MsgGasUsage)
+}
+
+// addMsg adds gas to the gas usage of msgTypeURL in the current block.
+func (t *gasUsageTracker) addMsg(msgTypeURL string, gas uint64) {
+	usage, ok := t.current[msgTypeURL]
+	if !ok {
+		usage = &MsgGasUsage{MsgTypeURL: msgTypeURL}
+		t.current[msgTypeURL] = usage
+	}
+
+	usage.Count++
+	usage.GasUsed += gas
+}
+
+// commit adds the gas usage of the current block, committed at height,
+// evicting the oldest block if the window is full.
+func (t *gasUsageTracker) commit(height int64, gasUsed uint64) {
+	msgs := make([]MsgGasUsage, 0, len(t.current))
+	for _, usage := range t.current {
+		msgs = append(msgs, *usage)
+	}
+	sortMsgGasUsages(msgs)
+	t.current = make(map[string]*MsgGasUsage)
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.blocks = append(t.blocks, BlockGasUsage{Height: height, GasUsed: gasUsed, Msgs: msgs})
+	if uint64(len(t.blocks)) > t.window {
+		t.blocks = append([]BlockGasUsage(nil), t.blocks[uint64(len(t.blocks))-t.window:]...)
+	}
+}
+
+// usage returns the gas usage of the blocks of the window.
+func (t *gasUsageTracker) usage() GasUsageResponse {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	totals := make(map[string]*MsgGasUsage)
+	for _, block := range t.blocks {
+		for _, msg := range block.Msgs {
+			total, ok := totals[msg.MsgTypeURL]
+			if !ok {
+				total = &MsgGasUsage{MsgTypeURL: msg.MsgTypeURL}
+				totals[msg.MsgTypeURL] = total
+			}
+
+			total.Count += msg.Count
+			total.GasUsed += msg.GasUsed
+		}
+	}
+
+	msgs := make([]MsgGasUsage, 0, len(totals))
+	for _, total := range totals {
+		msgs = append(msgs, *total)
+	}
+	sortMsgGasUsages(msgs)
+
+	return GasUsageResponse{
+		Blocks: append([]BlockGasUsage{}, t.blocks...),
+		Msgs:   msgs,
+	}
+}
+
+// sortMsgGasUsages sorts msgs by decreasing gas used, then by type URL.
+func sortMsgGasUsages(msgs []MsgGasUsage) {
+	sort.Slice(msgs, func(i, j int) bool {
+		if msgs[i].GasUsed != msgs[j].GasUsed {
+			return msgs[i].GasUsed > msgs[j].GasUsed
+		}
+		return msgs[i].MsgTypeURL < msgs[j].MsgTypeURL
+	})
+}
+
+// recordMsgGas records the gas consumed by the handler of a delivered message
+// of type msgTypeURL, in the tx_msg_gas_used summary labeled by message type
+// and, if enabled, in the gas usage of the current block.
+func (app *BaseApp) recordMsgGas(msgTypeURL string, gas uint64) {
+	telemetry.AddSampleWithLabels(
+		[]string{"tx", "msg", "gas_used"},
+		float32(gas),
+		[]metrics.Label{telemetry.NewLabel("msg_type", msgTypeURL)},
+	)
+
+	if app.gasUsage != nil {
+		app.gasUsage.addMsg(msgTypeURL, gas)
+	}
+}
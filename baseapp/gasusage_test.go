@@ -0,0 +1,117 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasUsageTracker(t *testing.T) {
+	tracker := newGasUsageTracker(2)
+
+	tracker.beginBlock()
+	tracker.addMsg("/cosmos.bank.v1beta1.MsgSend", 100)
+	tracker.addMsg("/cosmos.gov.v1beta1.MsgVote", 50)
+	tracker.addMsg("/cosmos.bank.v1beta1.MsgSend", 100)
+	tracker.commit(1, 400)
+
+	// the messages of a block which is not committed are discarded
+	tracker.beginBlock()
+	tracker.addMsg("/cosmos.gov.v1beta1.MsgVote", 1000)
+	tracker.beginBlock()
+	tracker.addMsg("/cosmos.gov.v1beta1.MsgVote", 60)
+	tracker.commit(2, 100)
+
+	require.Equal(t, GasUsageResponse{
+		Blocks: []BlockGasUsage{
+			{Height: 1, GasUsed: 400, Msgs: []MsgGasUsage{
+				{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Count: 2, GasUsed: 200},
+				{MsgTypeURL: "/cosmos.gov.v1beta1.MsgVote", Count: 1, GasUsed: 50},
+			}},
+			{Height: 2, GasUsed: 100, Msgs: []MsgGasUsage{
+				{MsgTypeURL: "/cosmos.gov.v1beta1.MsgVote", Count: 1, GasUsed: 60},
+			}},
+		},
+		Msgs: []MsgGasUsage{
+			{MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend", Count: 2, GasUsed: 200},
+			{MsgTypeURL: "/cosmos.gov.v1beta1.MsgVote", Count: 2, GasUsed: 110},
+		},
+	}, tracker.usage())
+
+	// the oldest block is evicted once the window is full
+	tracker.beginBlock()
+	tracker.commit(3, 0)
+
+	usage := tracker.usage()
+	require.Len(t, usage.Blocks, 2)
+	require.Equal(t, int64(2), usage.Blocks[0].Height)
+	require.Equal(t, int64(3), usage.Blocks[1].Height)
+	require.Empty(t, usage.Blocks[1].Msgs)
+	require.Equal(t, []MsgGasUsage{{MsgTypeURL: "/cosmos.gov.v1beta1.MsgVote", Count: 1, GasUsed: 60}}, usage.Msgs)
+}
+
+func TestQueryGasUsage(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+
+	app := setupBaseApp(t, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// the query fails if the gas usage is not tracked
+	res := app.Query(abci.RequestQuery{Path: "/app/gas_usage"})
+	require.False(t, res.IsOK())
+
+	app.setGasUsageWindow(2)
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	var counter int64
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+		// the block at height h has h messages
+		msgCounters := make([]int64, height)
+		for i := range msgCounters {
+			msgCounters[i] = counter
+			counter++
+		}
+
+		txBytes, err := codec.Marshal(newTxCounter(height, msgCounters...))
+		require.NoError(t, err)
+		deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, deliverRes.IsOK(), deliverRes.Log)
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	res = app.Query(abci.RequestQuery{Path: "/app/gas_usage"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(3), res.Height)
+
+	var usage GasUsageResponse
+	require.NoError(t, json.Unmarshal(res.Value, &usage))
+	require.Len(t, usage.Blocks, 2)
+
+	msgType := sdk.MsgTypeURL(msgCounter{})
+	for i, block := range usage.Blocks {
+		require.Equal(t, int64(i+2), block.Height)
+		require.Len(t, block.Msgs, 1)
+		require.Equal(t, msgType, block.Msgs[0].MsgTypeURL)
+		require.Equal(t, uint64(block.Height), block.Msgs[0].Count)
+		require.NotZero(t, block.Msgs[0].GasUsed)
+		require.GreaterOrEqual(t, block.GasUsed, block.Msgs[0].GasUsed)
+	}
+
+	require.Len(t, usage.Msgs, 1)
+	require.Equal(t, uint64(5), usage.Msgs[0].Count)
+	require.Equal(t, usage.Blocks[0].Msgs[0].GasUsed+usage.Blocks[1].Msgs[0].GasUsed, usage.Msgs[0].GasUsed)
+}
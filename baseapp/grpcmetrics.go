@@ -0,0 +1,44 @@
+package baseapp
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// measureQuery starts measuring a query of the gRPC method, e.g.
+// "/cosmos.bank.v1beta1.Query/Balance", and returns the function recording its
+// outcome, err being its error if it failed. The latency of the queries, their
+// errors by gRPC code and the queries in flight are recorded per method.
+func (qrt *GRPCQueryRouter) measureQuery(method string) func(err error) {
+	start := time.Now()
+	labels := []metrics.Label{telemetry.NewLabel("method", method)}
+
+	// only the registered methods are counted in flight, their counters being
+	// created on registration
+	inFlight := qrt.inFlight[method]
+	if inFlight != nil {
+		telemetry.SetGaugeWithLabels([]string{"query", "in_flight"}, float32(atomic.AddInt64(inFlight, 1)), labels)
+	}
+
+	return func(err error) {
+		if inFlight != nil {
+			telemetry.SetGaugeWithLabels([]string{"query", "in_flight"}, float32(atomic.AddInt64(inFlight, -1)), labels)
+		}
+
+		telemetry.MeasureSinceWithLabels([]string{"query", "latency"}, start, labels)
+
+		if err != nil {
+			// the errors which are not gRPC statuses have the Unknown code
+			telemetry.IncrCounterWithLabels(
+				[]string{"query", "errors"},
+				1,
+				append(labels, telemetry.NewLabel("code", status.Code(err).String())),
+			)
+		}
+	}
+}
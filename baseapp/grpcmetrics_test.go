@@ -0,0 +1,60 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestGRPCQueryMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	grpcQueryOpt := func(bapp *BaseApp) {
+		testdata.RegisterQueryServer(bapp.GRPCQueryRouter(), testdata.QueryImpl{})
+	}
+
+	app := setupBaseApp(t, grpcQueryOpt)
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.Commit()
+
+	reqBz, err := (&testdata.SayHelloRequest{Name: "foo"}).Marshal()
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		res := app.Query(abci.RequestQuery{Path: "/testdata.Query/SayHello", Data: reqBz})
+		require.True(t, res.IsOK(), res.Log)
+	}
+
+	// the request can't be decoded
+	res := app.Query(abci.RequestQuery{Path: "/testdata.Query/SayHello", Data: []byte{0xff}})
+	require.False(t, res.IsOK())
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	interval := data[len(data)-1]
+
+	latency, ok := interval.Samples["test.query.latency;method=/testdata.Query/SayHello"]
+	require.True(t, ok)
+	require.Equal(t, 3, latency.Count)
+
+	errors, ok := interval.Counters["test.query.errors;method=/testdata.Query/SayHello;code=Unknown"]
+	require.True(t, ok)
+	require.Equal(t, 1, errors.Count)
+
+	inFlight, ok := interval.Gauges["test.query.in_flight;method=/testdata.Query/SayHello"]
+	require.True(t, ok)
+	require.Equal(t, float32(0), inFlight.Value)
+}
@@ -22,6 +22,9 @@ type GRPCQueryRouter struct {
 	routes            map[string]GRPCQueryHandler
 	interfaceRegistry codectypes.InterfaceRegistry
 	serviceData       []serviceData
+
+	// inFlight counts the queries in flight per method.
+	inFlight map[string]*int64
 }
 
 // serviceData represents a gRPC service, along with its handler.
@@ -35,7 +38,8 @@ var _ gogogrpc.Server = &GRPCQueryRouter{}
 // NewGRPCQueryRouter creates a new GRPCQueryRouter
 func NewGRPCQueryRouter() *GRPCQueryRouter {
 	return &GRPCQueryRouter{
-		routes: map[string]GRPCQueryHandler{},
+		routes:   map[string]GRPCQueryHandler{},
+		inFlight: map[string]*int64{},
 	}
 }
 
@@ -79,7 +83,11 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 			)
 		}
 
-		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+		qrt.inFlight[fqName] = new(int64)
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (resp abci.ResponseQuery, err error) {
+			done := qrt.measureQuery(fqName)
+			defer func() { done(err) }()
+
 			// call the method handler from the service description with the handler object,
 			// a wrapped sdk.Context with proto-unmarshaled data from the ABCI request data
 			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
@@ -116,6 +124,17 @@ func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interf
 	})
 }
 
+// ServiceDescs returns the descriptions of the registered gRPC services, in
+// the order they were registered.
+func (qrt *GRPCQueryRouter) ServiceDescs() []*grpc.ServiceDesc {
+	descs := make([]*grpc.ServiceDesc, len(qrt.serviceData))
+	for i, data := range qrt.serviceData {
+		descs[i] = data.serviceDesc
+	}
+
+	return descs
+}
+
 // SetInterfaceRegistry sets the interface registry for the router. This will
 // also register the interface reflection gRPC service.
 func (qrt *GRPCQueryRouter) SetInterfaceRegistry(interfaceRegistry codectypes.InterfaceRegistry) {
@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
@@ -20,11 +21,26 @@ import (
 // GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
 func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
 
+// GRPCServerOptions returns the options of the gRPC server chaining the
+// interceptors added with SetGRPCUnaryInterceptors and
+// SetGRPCStreamInterceptors.
+func (app *BaseApp) GRPCServerOptions() []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if len(app.grpcUnaryInterceptors) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(app.grpcUnaryInterceptors...))
+	}
+	if len(app.grpcStreamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(app.grpcStreamInterceptors...))
+	}
+
+	return opts
+}
+
 // RegisterGRPCServer registers gRPC services directly with the gRPC server.
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 	// Define an interceptor for all gRPC queries: this interceptor will create
 	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	interceptor := func(grpcCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// If there's some metadata in the context, retrieve it.
 		md, ok := metadata.FromIncomingContext(grpcCtx)
 		if !ok {
@@ -45,9 +61,36 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		// Serve the response from the query cache if any, keyed by the height
+		// the query is resolved at.
+		var cacheKey queryCacheKey
+		cached := false
+		if msg, ok := req.(codec.ProtoMarshaler); ok && app.queryCache.Cached(info.FullMethod) {
+			reqBz, err := msg.Marshal()
+			if err != nil {
+				return nil, err
+			}
+
+			cacheHeight := height
+			if cacheHeight == 0 {
+				cacheHeight = app.LastBlockHeight()
+			}
+
+			cached = true
+			cacheKey = queryCacheKey{method: info.FullMethod, height: cacheHeight, request: string(reqBz), grpc: true}
+			if resp, ok := app.queryCache.get(cacheKey); ok {
+				md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(cacheHeight, 10))
+				grpc.SetHeader(grpcCtx, md)
+
+				return resp, nil
+			}
+
+			height = cacheHeight
+		}
+
 		// Create the sdk.Context. Passing false as 2nd arg, as we can't
 		// actually support proofs with gRPC right now.
-		sdkCtx, err := app.createQueryContext(height, false)
+		sdkCtx, err := app.CreateQueryContext(height, false)
 		if err != nil {
 			return nil, err
 		}
@@ -63,7 +106,15 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 		md = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
 		grpc.SetHeader(grpcCtx, md)
 
-		return handler(grpcCtx, req)
+		done := app.grpcQueryRouter.measureQuery(info.FullMethod)
+		defer func() { done(err) }()
+
+		resp, err = handler(grpcCtx, req)
+		if err == nil && cached {
+			app.queryCache.set(cacheKey, resp)
+		}
+
+		return resp, err
 	}
 
 	// Loop through all services and methods, add the interceptor, and register
@@ -76,11 +127,16 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			methodHandler := method.Handler
 			newMethods[i] = grpc.MethodDesc{
 				MethodName: method.MethodName,
-				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
-					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(
-						grpcrecovery.UnaryServerInterceptor(),
-						interceptor,
-					))
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, serverInterceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+					// the interceptors of the server, e.g. the rate limiting
+					// and the ones of the operators, run before the query
+					// context is created
+					interceptors := []grpc.UnaryServerInterceptor{grpcrecovery.UnaryServerInterceptor()}
+					if serverInterceptor != nil {
+						interceptors = append(interceptors, serverInterceptor)
+					}
+
+					return methodHandler(srv, ctx, dec, grpcmiddleware.ChainUnaryServer(append(interceptors, interceptor)...))
 				},
 			}
 		}
@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestGRPCServerInterceptors(t *testing.T) {
+	var calls []string
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+	auth := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if tokens := md.Get("authorization"); len(tokens) != 1 || tokens[0] != "token" {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+		return handler(ctx, req)
+	}
+
+	app := setupBaseApp(t, SetGRPCUnaryInterceptors(record("app"), auth), SetGRPCUnaryInterceptors(record("tag")))
+	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.Commit()
+
+	// the app interceptors run after the ones of the server
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(append([]grpc.ServerOption{grpc.ChainUnaryInterceptor(record("server"))}, app.GRPCServerOptions()...)...)
+	app.RegisterGRPCServer(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := testdata.NewQueryClient(conn)
+
+	_, err = client.SayHello(context.Background(), &testdata.SayHelloRequest{Name: "Foo"})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, []string{"server", "app"}, calls)
+
+	calls = nil
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "token")
+	res, err := client.SayHello(ctx, &testdata.SayHelloRequest{Name: "Foo"})
+	require.NoError(t, err)
+	require.Equal(t, "Hello Foo!", res.Greeting)
+	require.Equal(t, []string{"server", "app", "tag"}, calls)
+
+	// no options are returned without interceptors
+	require.Empty(t, setupBaseApp(t).GRPCServerOptions())
+}
@@ -17,6 +17,14 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	aliases           map[string]string // alias type URL -> canonical type URL
+	circuitBreaker    CircuitBreaker
+}
+
+// CircuitBreaker tells whether the Msgs of a type URL may be executed, e.g.
+// the keeper of x/circuit.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) (bool, error)
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -24,7 +32,8 @@ var _ gogogrpc.Server = &MsgServiceRouter{}
 // NewMsgServiceRouter creates a new MsgServiceRouter.
 func NewMsgServiceRouter() *MsgServiceRouter {
 	return &MsgServiceRouter{
-		routes: map[string]MsgServiceHandler{},
+		routes:  map[string]MsgServiceHandler{},
+		aliases: map[string]string{},
 	}
 }
 
@@ -37,9 +46,62 @@ func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 }
 
 // HandlerByTypeURL returns the MsgServiceHandler for a given query route path or nil
-// if not found.
+// if not found. Aliases registered with RegisterAlias resolve to the handler of
+// their canonical type URL.
 func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler {
-	return msr.routes[typeURL]
+	return msr.routes[msr.CanonicalTypeURL(typeURL)]
+}
+
+// CanonicalTypeURL returns the canonical type URL of an alias registered with
+// RegisterAlias, or typeURL itself if it is not an alias.
+func (msr *MsgServiceRouter) CanonicalTypeURL(typeURL string) string {
+	if canonical, ok := msr.aliases[typeURL]; ok {
+		return canonical
+	}
+
+	return typeURL
+}
+
+// customTypeURLRegistry is implemented by interface registries which can
+// register implementations under arbitrary type URLs.
+type customTypeURLRegistry interface {
+	RegisterCustomTypeURL(iface interface{}, typeURL string, impl proto.Message)
+}
+
+// RegisterAlias registers aliasTypeURL as an alternate type URL of the Msg
+// registered under canonicalTypeURL, typically its type URL before a proto
+// package rename. Msgs packed under the alias are decoded to the canonical
+// Msg type and routed to its handler, so the events and the tx msg data of
+// their execution use the canonical type URL.
+//
+// This function PANICs:
+// - if the Msg service of canonicalTypeURL has not been registered yet,
+// - or if aliasTypeURL is already registered as a Msg type URL or alias.
+func (msr *MsgServiceRouter) RegisterAlias(aliasTypeURL, canonicalTypeURL string) {
+	if _, found := msr.routes[canonicalTypeURL]; !found {
+		panic(fmt.Errorf("cannot register alias %s: no msg service is registered for %s", aliasTypeURL, canonicalTypeURL))
+	}
+
+	_, isRoute := msr.routes[aliasTypeURL]
+	_, isAlias := msr.aliases[aliasTypeURL]
+	if isRoute || isAlias {
+		panic(fmt.Errorf("cannot register alias %s: type_url %s has already been registered", aliasTypeURL, aliasTypeURL))
+	}
+
+	registry, ok := msr.interfaceRegistry.(customTypeURLRegistry)
+	if !ok {
+		panic(fmt.Errorf("cannot register alias %s: interface registry %T does not support custom type URLs", aliasTypeURL, msr.interfaceRegistry))
+	}
+
+	msg, err := msr.interfaceRegistry.Resolve(canonicalTypeURL)
+	if err != nil {
+		panic(err)
+	}
+
+	// register the alias so that Anys packed under it are decoded to the
+	// canonical Msg type
+	registry.RegisterCustomTypeURL((*sdk.Msg)(nil), aliasTypeURL, msg)
+	msr.aliases[aliasTypeURL] = canonicalTypeURL
 }
 
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
@@ -107,6 +169,16 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			if msr.circuitBreaker != nil {
+				allowed, err := msr.circuitBreaker.IsAllowed(ctx, requestTypeName)
+				if err != nil {
+					return nil, err
+				}
+				if !allowed {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disallows execution of message %s", requestTypeName)
+				}
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -134,6 +206,13 @@ func (msr *MsgServiceRouter) SetInterfaceRegistry(interfaceRegistry codectypes.I
 	msr.interfaceRegistry = interfaceRegistry
 }
 
+// SetCircuitBreaker sets the circuit breaker checked before the execution of
+// every Msg, including the Msgs executed by other Msgs, e.g. by the authz
+// MsgExec.
+func (msr *MsgServiceRouter) SetCircuitBreaker(cb CircuitBreaker) {
+	msr.circuitBreaker = cb
+}
+
 func noopDecoder(_ interface{}) error { return nil }
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
 	return nil, nil
@@ -14,6 +14,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -120,3 +123,120 @@ func TestMsgService(t *testing.T) {
 	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
 	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
 }
+
+func TestRegisterMsgServiceAlias(t *testing.T) {
+	db := dbm.NewMemDB()
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	app := baseapp.NewBaseApp("test", log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+
+	// The canonical Msg service must be registered first.
+	require.Panics(t, func() {
+		app.MsgServiceRouter().RegisterAlias("/testdata.legacy.MsgCreateDog", "/testdata.MsgCreateDog")
+	})
+
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+
+	require.NotPanics(t, func() {
+		app.MsgServiceRouter().RegisterAlias("/testdata.legacy.MsgCreateDog", "/testdata.MsgCreateDog")
+	})
+	require.NotNil(t, app.MsgServiceRouter().HandlerByTypeURL("/testdata.legacy.MsgCreateDog"))
+	require.Equal(t, "/testdata.MsgCreateDog", app.MsgServiceRouter().CanonicalTypeURL("/testdata.legacy.MsgCreateDog"))
+	require.Equal(t, "/testdata.MsgCreateDog", app.MsgServiceRouter().CanonicalTypeURL("/testdata.MsgCreateDog"))
+
+	// Aliases can't be registered twice, nor shadow a Msg type URL.
+	require.Panics(t, func() {
+		app.MsgServiceRouter().RegisterAlias("/testdata.legacy.MsgCreateDog", "/testdata.MsgCreateDog")
+	})
+	require.Panics(t, func() {
+		app.MsgServiceRouter().RegisterAlias("/testdata.MsgCreateDog", "/testdata.MsgCreateDog")
+	})
+}
+
+func TestMsgServiceAlias(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	db := dbm.NewMemDB()
+	app := baseapp.NewBaseApp("test", log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, encCfg.TxConfig.TxDecoder())
+	app.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	_ = app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+	// Build a tx whose Msg is packed under its pre-rename type URL.
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	require.NoError(t, txBuilder.SetMsgs(&testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}))
+	txBytes, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	var raw txtypes.TxRaw
+	require.NoError(t, encCfg.Marshaler.Unmarshal(txBytes, &raw))
+	var body txtypes.TxBody
+	require.NoError(t, encCfg.Marshaler.Unmarshal(raw.BodyBytes, &body))
+	body.Messages[0].TypeUrl = "/testdata.legacy.MsgCreateDog"
+	raw.BodyBytes, err = encCfg.Marshaler.Marshal(&body)
+	require.NoError(t, err)
+	txBytes, err = encCfg.Marshaler.Marshal(&raw)
+	require.NoError(t, err)
+
+	// The alias type URL is unknown until it is registered.
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.NotEqual(t, abci.CodeTypeOK, res.Code)
+
+	app.MsgServiceRouter().RegisterAlias("/testdata.legacy.MsgCreateDog", "/testdata.MsgCreateDog")
+
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.Equal(t, abci.CodeTypeOK, res.Code, "res=%+v", res)
+
+	// Events are emitted under the canonical type URL.
+	var actions []string
+	for _, event := range res.Events {
+		if event.Type != sdk.EventTypeMessage {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == sdk.AttributeKeyAction {
+				actions = append(actions, string(attr.Value))
+			}
+		}
+	}
+	require.Equal(t, []string{"/testdata.MsgCreateDog"}, actions)
+}
+
+type disabledMsgs map[string]bool
+
+func (d disabledMsgs) IsAllowed(_ sdk.Context, typeURL string) (bool, error) {
+	return !d[typeURL], nil
+}
+
+func TestMsgServiceCircuitBreaker(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	testdata.RegisterInterfaces(encCfg.InterfaceRegistry)
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(encCfg.InterfaceRegistry)
+	testdata.RegisterMsgServer(router, testdata.MsgServerImpl{})
+
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}}
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+
+	disabled := disabledMsgs{}
+	router.SetCircuitBreaker(disabled)
+	_, err := router.Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+
+	disabled[sdk.MsgTypeURL(msg)] = true
+	_, err = router.Handler(msg)(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	router.SetCircuitBreaker(nil)
+	_, err = router.Handler(msg)(ctx, msg)
+	require.NoError(t, err)
+}
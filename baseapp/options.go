@@ -5,6 +5,7 @@ import (
 	"io"
 
 	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
@@ -47,6 +48,49 @@ func SetMinRetainBlocks(minRetainBlocks uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setMinRetainBlocks(minRetainBlocks) }
 }
 
+// SetQueryCache returns a BaseApp option function that sets the cache of the
+// gRPC query responses. A nil cache disables caching.
+func SetQueryCache(cache *QueryCache) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setQueryCache(cache) }
+}
+
+// SetGRPCUnaryInterceptors returns a BaseApp option function that adds unary
+// interceptors to the gRPC server of the app, e.g. to authenticate, audit or
+// tag the requests. They are chained in order, after the interceptors of the
+// server such as the rate limiting, and intercept the calls to all the unary
+// services, including the queries of the modules.
+func SetGRPCUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.addGRPCUnaryInterceptors(interceptors) }
+}
+
+// SetGRPCStreamInterceptors returns a BaseApp option function that adds stream
+// interceptors to the gRPC server of the app, chained in order after the
+// interceptors of the server.
+func SetGRPCStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.addGRPCStreamInterceptors(interceptors) }
+}
+
+// SetReplayVerificationWindow returns a BaseApp option function that enables
+// the background replay verification of the last window committed blocks. A
+// value of 0 disables it.
+func SetReplayVerificationWindow(window uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setReplayVerificationWindow(window) }
+}
+
+// SetGasUsageWindow returns a BaseApp option function that enables the
+// breakdown per message type of the gas consumed by the last window committed
+// blocks, returned by the app/gas_usage query. A value of 0 disables it.
+func SetGasUsageWindow(window uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setGasUsageWindow(window) }
+}
+
+// SetStateSizeInterval returns a BaseApp option function that enables the
+// measurement of the size of the stores of the state every interval committed
+// blocks, returned by the app/state_size query. A value of 0 disables it.
+func SetStateSizeInterval(interval uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.setStateSizeInterval(interval) }
+}
+
 // SetTrace will turn on or off trace flag
 func SetTrace(trace bool) func(*BaseApp) {
 	return func(app *BaseApp) { app.setTrace(trace) }
@@ -231,6 +275,14 @@ func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	app.snapshotKeepRecent = snapshotKeepRecent
 }
 
+// SetABCIListeners sets the listeners hooked into the ABCI methods.
+func (app *BaseApp) SetABCIListeners(listeners ...ABCIListener) {
+	if app.sealed {
+		panic("SetABCIListeners() on sealed BaseApp")
+	}
+	app.abciListeners = listeners
+}
+
 // SetInterfaceRegistry sets the InterfaceRegistry.
 func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 	app.interfaceRegistry = registry
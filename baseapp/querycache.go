@@ -0,0 +1,109 @@
+package baseapp
+
+import (
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// QueryCache caches the responses of gRPC queries, served both by the gRPC
+// server and through ABCI Query, by method, request and height. The cached
+// responses expire after the TTL, and are all removed on Commit.
+type QueryCache struct {
+	ttl        time.Duration
+	maxEntries int
+	methods    map[string]bool
+
+	mtx     sync.Mutex
+	entries map[queryCacheKey]queryCacheEntry
+	now     func() time.Time
+}
+
+type queryCacheKey struct {
+	method  string
+	height  int64
+	request string
+	// grpc is true for the responses of the gRPC server, false for the
+	// responses of ABCI Query.
+	grpc bool
+}
+
+type queryCacheEntry struct {
+	response interface{}
+	expires  time.Time
+}
+
+// NewQueryCache returns a QueryCache of the responses of methods, each one
+// cached for at most ttl. At most maxEntries responses are cached, the others
+// being served uncached until the cache is cleared on Commit.
+func NewQueryCache(ttl time.Duration, maxEntries int, methods []string) *QueryCache {
+	c := &QueryCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		methods:    make(map[string]bool, len(methods)),
+		entries:    make(map[queryCacheKey]queryCacheEntry),
+		now:        time.Now,
+	}
+	for _, method := range methods {
+		c.methods[method] = true
+	}
+
+	return c
+}
+
+// Cached returns true if the responses of method are cached.
+func (c *QueryCache) Cached(method string) bool {
+	return c != nil && c.methods[method]
+}
+
+func (c *QueryCache) get(key queryCacheKey) (interface{}, bool) {
+	c.mtx.Lock()
+	entry, ok := c.entries[key]
+	if ok && !c.now().Before(entry.expires) {
+		delete(c.entries, key)
+		ok = false
+	}
+	c.mtx.Unlock()
+
+	result := "miss"
+	if ok {
+		result = "hit"
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{"query_cache", result},
+		1,
+		[]metrics.Label{telemetry.NewLabel("method", key.method)},
+	)
+
+	return entry.response, ok
+}
+
+func (c *QueryCache) set(key queryCacheKey, response interface{}) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= c.maxEntries {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expires) {
+				delete(c.entries, k)
+			}
+		}
+
+		if len(c.entries) >= c.maxEntries {
+			return
+		}
+	}
+
+	c.entries[key] = queryCacheEntry{response: response, expires: now.Add(c.ttl)}
+}
+
+// clear removes all the cached responses.
+func (c *QueryCache) clear() {
+	c.mtx.Lock()
+	c.entries = make(map[queryCacheKey]queryCacheEntry)
+	c.mtx.Unlock()
+}
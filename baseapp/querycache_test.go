@@ -0,0 +1,124 @@
+package baseapp
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+const echoMethod = "/testdata.Query/Echo"
+
+// countingQueryServer counts the Echo queries it serves.
+type countingQueryServer struct {
+	testdata.QueryImpl
+	echoes *int
+}
+
+func (s countingQueryServer) Echo(ctx context.Context, req *testdata.EchoRequest) (*testdata.EchoResponse, error) {
+	*s.echoes++
+	return s.QueryImpl.Echo(ctx, req)
+}
+
+func setupQueryCacheApp(t *testing.T, cache *QueryCache) (*BaseApp, *int) {
+	app := setupBaseApp(t, SetQueryCache(cache))
+	app.SetInterfaceRegistry(testdata.NewTestInterfaceRegistry())
+
+	var echoes int
+	testdata.RegisterQueryServer(app.GRPCQueryRouter(), countingQueryServer{echoes: &echoes})
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	return app, &echoes
+}
+
+func TestQueryCacheABCIQuery(t *testing.T) {
+	cache := NewQueryCache(time.Minute, 2, []string{echoMethod})
+	now := time.Unix(1000, 0)
+	cache.now = func() time.Time { return now }
+	app, echoes := setupQueryCacheApp(t, cache)
+
+	query := func(message string) {
+		t.Helper()
+		data, err := (&testdata.EchoRequest{Message: message}).Marshal()
+		require.NoError(t, err)
+
+		res := app.Query(abci.RequestQuery{Path: echoMethod, Data: data})
+		require.True(t, res.IsOK(), res.Log)
+
+		var echo testdata.EchoResponse
+		require.NoError(t, echo.Unmarshal(res.Value))
+		require.Equal(t, message, echo.Message)
+	}
+
+	query("hello")
+	query("hello")
+	require.Equal(t, 1, *echoes)
+
+	// the cache is keyed by request
+	query("world")
+	require.Equal(t, 2, *echoes)
+
+	// the cache is full
+	query("!")
+	query("!")
+	require.Equal(t, 4, *echoes)
+
+	// the responses expire
+	now = now.Add(time.Minute)
+	query("hello")
+	require.Equal(t, 5, *echoes)
+
+	// the responses are removed on commit
+	query("hello")
+	require.Equal(t, 5, *echoes)
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+	query("hello")
+	require.Equal(t, 6, *echoes)
+}
+
+func TestQueryCacheGRPCServer(t *testing.T) {
+	app, echoes := setupQueryCacheApp(t, NewQueryCache(time.Minute, 10, []string{echoMethod}))
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	app.RegisterGRPCServer(server)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := testdata.NewQueryClient(conn)
+
+	for i := 0; i < 2; i++ {
+		var header metadata.MD
+		res, err := client.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"}, grpc.Header(&header))
+		require.NoError(t, err)
+		require.Equal(t, "hello", res.Message)
+		require.Equal(t, []string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+	}
+	require.Equal(t, 1, *echoes)
+
+	// the uncached methods are served as usual
+	res, err := client.SayHello(context.Background(), &testdata.SayHelloRequest{Name: "Foo"})
+	require.NoError(t, err)
+	require.Equal(t, "Hello Foo!", res.Greeting)
+}
@@ -0,0 +1,227 @@
+package baseapp
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// replayBlock records the inputs of a committed block, and the app hash its
+// execution resulted in.
+type replayBlock struct {
+	beginBlock abci.RequestBeginBlock
+	txs        [][]byte
+	endBlock   abci.RequestEndBlock
+	appHash    []byte
+}
+
+func (b *replayBlock) height() int64 {
+	return b.beginBlock.Header.Height
+}
+
+// replayVerifier re-executes the committed blocks in the background, on a
+// branch of the state they were executed on, and compares the app hashes they
+// result in with the committed ones. A mismatch reveals a nondeterministic
+// execution, e.g. caused by faulty hardware or a bad dependency, which the
+// node would otherwise only notice once the network rejects its votes.
+//
+// The blocks are verified in order, as long as they are among the last window
+// committed blocks. The blocks falling out of the window before being verified
+// are skipped.
+type replayVerifier struct {
+	app    *BaseApp
+	window uint64
+
+	// block is the block being executed, only accessed by the ABCI methods.
+	block *replayBlock
+
+	mtx    sync.Mutex
+	queue  []*replayBlock
+	notify chan struct{}
+}
+
+func newReplayVerifier(app *BaseApp, window uint64) *replayVerifier {
+	return &replayVerifier{
+		app:    app,
+		window: window,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// beginBlock starts recording the block of req, unless it is executed on the
+// state set by InitChain, which is not committed.
+func (v *replayVerifier) beginBlock(req abci.RequestBeginBlock) {
+	v.block = nil
+	if v.app.LastBlockHeight() > 0 {
+		v.block = &replayBlock{beginBlock: req}
+	}
+}
+
+func (v *replayVerifier) deliverTx(tx []byte) {
+	if v.block != nil {
+		v.block.txs = append(v.block.txs, tx)
+	}
+}
+
+func (v *replayVerifier) endBlock(req abci.RequestEndBlock) {
+	if v.block != nil {
+		v.block.endBlock = req
+	}
+}
+
+// commit queues the recorded block for verification.
+func (v *replayVerifier) commit(appHash []byte) {
+	block := v.block
+	v.block = nil
+	if block == nil {
+		return
+	}
+	block.appHash = appHash
+
+	v.mtx.Lock()
+	v.queue = append(v.queue, block)
+	for uint64(len(v.queue)) > v.window {
+		v.app.logger.Debug("replay verification skipped", "height", v.queue[0].height())
+		telemetry.IncrCounter(1, "replay_verification", "skipped")
+		v.queue = v.queue[1:]
+	}
+	v.mtx.Unlock()
+
+	select {
+	case v.notify <- struct{}{}:
+	default:
+	}
+}
+
+// next pops the next block to verify, or returns nil if there is none.
+func (v *replayVerifier) next() *replayBlock {
+	v.mtx.Lock()
+	defer v.mtx.Unlock()
+
+	if len(v.queue) == 0 {
+		return nil
+	}
+
+	block := v.queue[0]
+	v.queue = v.queue[1:]
+	return block
+}
+
+// run verifies the queued blocks as they are committed.
+func (v *replayVerifier) run() {
+	for range v.notify {
+		for block := v.next(); block != nil; block = v.next() {
+			v.verify(block)
+		}
+	}
+}
+
+// verify replays block, and reports whether it resulted in the committed app
+// hash.
+func (v *replayVerifier) verify(block *replayBlock) bool {
+	logger := v.app.logger.With("height", block.height())
+
+	appHash, err := v.app.replayBlock(block)
+	if err != nil {
+		logger.Error("replay verification failed", "err", err)
+		telemetry.IncrCounter(1, "replay_verification", "failed")
+		return false
+	}
+
+	if !bytes.Equal(appHash, block.appHash) {
+		logger.Error(
+			"replay verification app hash mismatch, the block execution may be nondeterministic",
+			"committed", fmt.Sprintf("%X", block.appHash), "replayed", fmt.Sprintf("%X", appHash),
+		)
+		telemetry.IncrCounter(1, "replay_verification", "mismatch")
+		return false
+	}
+
+	logger.Debug("replay verification succeeded")
+	telemetry.IncrCounter(1, "replay_verification", "verified")
+	return true
+}
+
+// replayBlock re-executes block on a branch of the committed state it was
+// executed on, and returns the app hash it results in. The state of the app
+// is unaffected.
+//
+// The block is executed by a replica of the app sharing its handlers, which
+// must therefore not keep state outside of the stores.
+func (app *BaseApp) replayBlock(block *replayBlock) (appHash []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while replaying the block: %v", r)
+		}
+	}()
+
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("replay verification requires a rootmulti store")
+	}
+
+	branch, err := rms.BranchVersion(block.height() - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	// the replica only copies the fields set on creation, the others being
+	// modified by the ABCI methods concurrently
+	replica := &BaseApp{
+		logger:                  log.NewNopLogger(),
+		name:                    app.name,
+		router:                  app.router,
+		msgServiceRouter:        app.msgServiceRouter,
+		interfaceRegistry:       app.interfaceRegistry,
+		txDecoder:               app.txDecoder,
+		anteHandler:             app.anteHandler,
+		beginBlocker:            app.beginBlocker,
+		endBlocker:              app.endBlocker,
+		paramStore:              app.paramStore,
+		runTxRecoveryMiddleware: app.runTxRecoveryMiddleware,
+		indexEvents:             app.indexEvents,
+	}
+
+	req := block.beginBlock
+	ms := branch.CacheMultiStore()
+	replica.deliverState = &state{
+		ms:  ms,
+		ctx: sdk.NewContext(ms, req.Header, false, replica.logger),
+	}
+
+	var gasMeter sdk.GasMeter
+	if maxGas := replica.getMaximumBlockGas(replica.deliverState.ctx); maxGas > 0 {
+		gasMeter = sdk.NewGasMeter(maxGas)
+	} else {
+		gasMeter = sdk.NewInfiniteGasMeter()
+	}
+
+	replica.deliverState.ctx = replica.deliverState.ctx.
+		WithBlockGasMeter(gasMeter).
+		WithHeaderHash(req.Hash)
+
+	if replica.beginBlocker != nil {
+		replica.beginBlocker(replica.deliverState.ctx, req)
+	}
+	replica.voteInfos = req.LastCommitInfo.GetVotes()
+
+	for _, tx := range block.txs {
+		// the failed txs are part of the execution as well
+		_, _, _, _ = replica.runTx(runTxModeDeliver, tx)
+	}
+
+	if replica.endBlocker != nil {
+		replica.endBlocker(replica.deliverState.ctx, block.endBlock)
+	}
+
+	ms.Write()
+
+	return branch.WorkingHash(), nil
+}
@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestReplayVerification(t *testing.T) {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	// the execution becomes nondeterministic once a suffix is set
+	var suffix []byte
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey2).Set(kv.Key, append(kv.Value, suffix...))
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// the verifier is driven synchronously instead of running in background
+	v := newReplayVerifier(app, 2)
+	app.replayVerifier = v
+
+	for height := int64(1); height <= 4; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		for i := 0; i < 3; i++ {
+			key := []byte(fmt.Sprintf("%d-%d", height, i))
+			txBytes, err := codec.Marshal(txTest{Msgs: []sdk.Msg{msgKeyValue{Key: key, Value: key}}})
+			require.NoError(t, err)
+			require.True(t, app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes}).IsOK())
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// the first block, executed on the state of InitChain, is not queued, and
+	// only the last 2 blocks are kept
+	block3 := v.next()
+	require.NotNil(t, block3)
+	require.Equal(t, int64(3), block3.height())
+	require.Len(t, block3.txs, 3)
+	require.True(t, v.verify(block3))
+
+	block4 := v.next()
+	require.NotNil(t, block4)
+	require.Nil(t, v.next())
+
+	suffix = []byte("nondeterministic")
+	require.False(t, v.verify(block4))
+
+	// the committed state is unaffected by the replays
+	require.Equal(t, []byte("4-0"), app.cms.GetKVStore(capKey2).Get([]byte("4-0")))
+}
+
+func TestReplayVerificationPruning(t *testing.T) {
+	app := newBaseApp(t.Name(), SetPruning(sdk.PruningOptions{KeepRecent: 2, Interval: 10}), SetReplayVerificationWindow(3))
+	app.MountStores(capKey1)
+	require.EqualError(t, app.LoadLatestVersion(), "replay verification window 3 must not exceed the pruning keep recent 2")
+
+	app = newBaseApp(t.Name(), SetPruning(sdk.PruningOptions{KeepRecent: 2, Interval: 10}), SetReplayVerificationWindow(2))
+	app.MountStores(capKey1)
+	require.NoError(t, app.LoadLatestVersion())
+}
@@ -0,0 +1,143 @@
+package baseapp
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StoreSize is the size of a store of the state at a height, and its growth
+// since the previous measurement.
+type StoreSize struct {
+	Name       string `json:"name"`
+	Keys       int64  `json:"keys"`
+	Bytes      int64  `json:"bytes"`
+	KeysDelta  int64  `json:"keys_delta"`
+	BytesDelta int64  `json:"bytes_delta"`
+}
+
+// StateSizeReport is the response of the app/state_size query, with the size
+// of the stores of the state at Height, sorted by name, and their growth since
+// PreviousHeight, the height of the previous measurement, which is 0 for the
+// first measurement. The bytes of a store are the sum of the lengths of its
+// keys and values.
+type StateSizeReport struct {
+	Height         int64       `json:"height"`
+	PreviousHeight int64       `json:"previous_height"`
+	Stores         []StoreSize `json:"stores"`
+}
+
+// stateSizeTracker keeps the last measurement of the size of the stores of the
+// state, measured every interval committed blocks in the background.
+type stateSizeTracker struct {
+	interval uint64
+
+	// measuring is 1 while a measurement is running, the heights committed
+	// meanwhile being skipped.
+	measuring int32
+
+	mtx  sync.RWMutex
+	last *StateSizeReport
+}
+
+func newStateSizeTracker(interval uint64) *stateSizeTracker {
+	return &stateSizeTracker{interval: interval}
+}
+
+// lastReport returns the last measurement, and false if none completed yet.
+func (t *stateSizeTracker) lastReport() (StateSizeReport, bool) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+
+	if t.last == nil {
+		return StateSizeReport{}, false
+	}
+	return *t.last, true
+}
+
+// commitStateSize starts measuring the size of the state committed at height in
+// a new goroutine if height is a multiple of the interval, unless the previous
+// measurement is still running.
+func (app *BaseApp) commitStateSize(height int64) {
+	if uint64(height)%app.stateSize.interval != 0 || !atomic.CompareAndSwapInt32(&app.stateSize.measuring, 0, 1) {
+		return
+	}
+
+	go func() {
+		defer atomic.StoreInt32(&app.stateSize.measuring, 0)
+
+		if _, err := app.measureStateSize(height); err != nil {
+			app.logger.Error("failed to measure the state size", "height", height, "err", err)
+		}
+	}()
+}
+
+// measureStateSize measures the size of the KV stores of the state committed
+// at height by iterating them, records it as the last measurement and publishes
+// it through telemetry.
+func (app *BaseApp) measureStateSize(height int64) (StateSizeReport, error) {
+	start := time.Now()
+
+	cms, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return StateSizeReport{}, err
+	}
+
+	previous, _ := app.stateSize.lastReport()
+	previousSizes := make(map[string]StoreSize, len(previous.Stores))
+	for _, store := range previous.Stores {
+		previousSizes[store.Name] = store
+	}
+
+	report := StateSizeReport{
+		Height:         height,
+		PreviousHeight: previous.Height,
+		Stores:         make([]StoreSize, 0, len(app.kvStoreKeys)),
+	}
+	for _, key := range app.kvStoreKeys {
+		size := measureStoreSize(key.Name(), cms.GetKVStore(key))
+		if prev, ok := previousSizes[size.Name]; ok {
+			size.KeysDelta = size.Keys - prev.Keys
+			size.BytesDelta = size.Bytes - prev.Bytes
+		}
+		report.Stores = append(report.Stores, size)
+
+		labels := []metrics.Label{telemetry.NewLabel("store", size.Name)}
+		telemetry.SetGaugeWithLabels([]string{"state_size", "keys"}, float32(size.Keys), labels)
+		telemetry.SetGaugeWithLabels([]string{"state_size", "bytes"}, float32(size.Bytes), labels)
+		telemetry.SetGaugeWithLabels([]string{"state_size", "keys_delta"}, float32(size.KeysDelta), labels)
+		telemetry.SetGaugeWithLabels([]string{"state_size", "bytes_delta"}, float32(size.BytesDelta), labels)
+	}
+	sort.Slice(report.Stores, func(i, j int) bool { return report.Stores[i].Name < report.Stores[j].Name })
+
+	app.stateSize.mtx.Lock()
+	app.stateSize.last = &report
+	app.stateSize.mtx.Unlock()
+
+	telemetry.MeasureSince(start, "state_size", "measure")
+	app.logger.Info("measured the state size", "height", height, "duration", time.Since(start))
+
+	return report, nil
+}
+
+// measureStoreSize returns the number of keys of store and the sum of the
+// lengths of its keys and values.
+func measureStoreSize(name string, store sdk.KVStore) StoreSize {
+	size := StoreSize{Name: name}
+
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		size.Keys++
+		size.Bytes += int64(len(it.Key()) + len(it.Value()))
+	}
+
+	return size
+}
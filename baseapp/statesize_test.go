@@ -0,0 +1,77 @@
+package baseapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStateSize(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgKeyValue, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			kv := msg.(*msgKeyValue)
+			ctx.KVStore(capKey2).Set(kv.Key, kv.Value)
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, routerOpt, SetStateSizeInterval(2))
+	app.InitChain(abci.RequestInitChain{})
+
+	// the query fails until the state is measured
+	res := app.Query(abci.RequestQuery{Path: "/app/state_size"})
+	require.False(t, res.IsOK())
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	lastReport := func() StateSizeReport {
+		report, _ := app.stateSize.lastReport()
+		return report
+	}
+
+	// each block writes 2 keys of 3 bytes with values of 5 bytes
+	for height := int64(1); height <= 4; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+
+		tx := newTxCounter(height)
+		for i := 0; i < 2; i++ {
+			tx.Msgs = append(tx.Msgs, msgKeyValue{Key: []byte(fmt.Sprintf("k%d%d", height, i)), Value: []byte("value")})
+		}
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		deliverRes := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, deliverRes.IsOK(), deliverRes.Log)
+
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+
+		// the state is measured every 2 blocks in the background
+		if height%2 == 0 {
+			require.Eventually(t, func() bool { return lastReport().Height == height }, time.Second, 10*time.Millisecond)
+		}
+	}
+
+	res = app.Query(abci.RequestQuery{Path: "/app/state_size"})
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, int64(4), res.Height)
+
+	var report StateSizeReport
+	require.NoError(t, json.Unmarshal(res.Value, &report))
+	require.Equal(t, StateSizeReport{
+		Height:         4,
+		PreviousHeight: 2,
+		Stores: []StoreSize{
+			{Name: "key1"},
+			{Name: "key2", Keys: 8, Bytes: 64, KeysDelta: 4, BytesDelta: 32},
+		},
+	}, report)
+}
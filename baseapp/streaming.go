@@ -0,0 +1,31 @@
+package baseapp
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ABCIListener is hooked into the ABCI methods of the BaseApp, which call it
+// with their requests and responses once they are executed. An error returned
+// by a listener is logged, without affecting the execution of the block.
+type ABCIListener interface {
+	// ListenBeginBlock is called with the BeginBlock messages.
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	// ListenDeliverTx is called with the DeliverTx messages.
+	ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenEndBlock is called with the EndBlock messages.
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	// ListenCommit is called with the Commit response, once the block is
+	// committed.
+	ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error
+}
+
+// listen calls the ABCI listeners with fn, logging their errors.
+func (app *BaseApp) listen(method string, fn func(ABCIListener) error) {
+	for _, listener := range app.abciListeners {
+		if err := fn(listener); err != nil {
+			app.logger.Error("ABCI listener failed", "method", method, "err", err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package baseapp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordingListener records the ABCI methods it is called with.
+type recordingListener struct {
+	calls     []string
+	txCodes   []uint32
+	commitRes abci.ResponseCommit
+	err       error
+}
+
+// closingListener is a recordingListener implementing io.Closer.
+type closingListener struct {
+	recordingListener
+	closed bool
+}
+
+func (l *closingListener) Close() error {
+	l.closed = true
+	return l.err
+}
+
+func (l *recordingListener) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.calls = append(l.calls, "BeginBlock")
+	return l.err
+}
+
+func (l *recordingListener) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	l.calls = append(l.calls, "DeliverTx")
+	l.txCodes = append(l.txCodes, res.Code)
+	return l.err
+}
+
+func (l *recordingListener) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	l.calls = append(l.calls, "EndBlock")
+	return l.err
+}
+
+func (l *recordingListener) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	l.calls = append(l.calls, "Commit")
+	l.commitRes = res
+	return l.err
+}
+
+func TestABCIListeners(t *testing.T) {
+	listener := &recordingListener{}
+	failing := &recordingListener{err: errors.New("failed")}
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.SetABCIListeners(listener, failing)
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	// the listeners are called with the failed txs as well
+	txRes := app.DeliverTx(abci.RequestDeliverTx{Tx: []byte("invalid")})
+	require.False(t, txRes.IsOK())
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	commitRes := app.Commit()
+
+	// the failing listener doesn't affect the execution
+	expected := []string{"BeginBlock", "DeliverTx", "EndBlock", "Commit"}
+	require.Equal(t, expected, listener.calls)
+	require.Equal(t, expected, failing.calls)
+	require.Equal(t, []uint32{txRes.Code}, listener.txCodes)
+	require.Equal(t, commitRes, listener.commitRes)
+	require.Equal(t, int64(1), app.LastBlockHeight())
+}
+
+func TestCloseABCIListeners(t *testing.T) {
+	listener := &recordingListener{}
+	closing := &closingListener{}
+	failing := &closingListener{recordingListener: recordingListener{err: errors.New("failed")}}
+	app := setupBaseApp(t, func(bapp *BaseApp) {
+		bapp.SetABCIListeners(listener, closing, failing)
+	})
+
+	// the listeners implementing io.Closer are closed, even if one fails
+	require.EqualError(t, app.Close(), "failed")
+	require.True(t, closing.closed)
+	require.True(t, failing.closed)
+}
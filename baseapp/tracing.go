@@ -0,0 +1,80 @@
+package baseapp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// startBlockSpan starts the span of the block of the given height, parent of
+// the spans of the ABCI methods executing it, which is ended on Commit.
+func (app *BaseApp) startBlockSpan(height int64) {
+	app.blockTraceCtx, app.blockSpan = telemetry.Tracer().Start(
+		context.Background(), "Block",
+		trace.WithAttributes(telemetry.SpanAttributeKeyHeight.Int64(height)),
+	)
+}
+
+// endBlockSpan ends the span of the block being executed, if any.
+func (app *BaseApp) endBlockSpan() {
+	if app.blockSpan != nil {
+		app.blockSpan.End()
+	}
+	app.blockTraceCtx, app.blockSpan = nil, nil
+}
+
+// startSpan starts a span of the execution of the current block, child of its
+// span, or a root span if the block has none, e.g. when the ABCI methods are
+// called without BeginBlock in tests.
+func (app *BaseApp) startSpan(name string, height int64) (context.Context, trace.Span) {
+	parent := app.blockTraceCtx
+	if parent == nil {
+		parent = context.Background()
+	}
+
+	return telemetry.Tracer().Start(parent, name, trace.WithAttributes(telemetry.SpanAttributeKeyHeight.Int64(height)))
+}
+
+// startDeliverTxSpan starts the span of the delivery of tx, parent of the spans
+// of its messages. The hash of the tx is only computed if the span is recorded.
+func (app *BaseApp) startDeliverTxSpan(tx []byte, height int64) (context.Context, trace.Span) {
+	ctx, span := app.startSpan("DeliverTx", height)
+	if span.IsRecording() {
+		span.SetAttributes(telemetry.SpanAttributeKeyTxHash.String(fmt.Sprintf("%X", tmhash.Sum(tx))))
+	}
+
+	return ctx, span
+}
+
+// startMsgSpan starts the span of the execution of msg, child of the span of
+// its tx, and returns ctx carrying it so that the handler can start child spans.
+// The messages are only traced when delivered within a recorded span, and not
+// e.g. when simulated or replayed.
+func startMsgSpan(ctx sdk.Context, msg sdk.Msg, mode runTxMode) (sdk.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx.Context())
+	if mode != runTxModeDeliver || !parent.IsRecording() {
+		return ctx, trace.SpanFromContext(context.Background())
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	spanCtx, span := telemetry.Tracer().Start(
+		ctx.Context(), msgType,
+		trace.WithAttributes(telemetry.SpanAttributeKeyMsgType.String(msgType)),
+	)
+
+	return ctx.WithContext(spanCtx), span
+}
+
+// endSpan ends span, with an error status if err is not nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
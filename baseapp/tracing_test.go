@@ -0,0 +1,75 @@
+package baseapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTracingSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key")))
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	// the tx is not traced when simulated
+	_, _, err = app.Simulate(txBytes)
+	require.NoError(t, err)
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	msgType := sdk.MsgTypeURL(msgCounter{})
+	require.Len(t, spans, 6)
+	for _, name := range []string{"Block", "BeginBlock", "DeliverTx", msgType, "EndBlock", "Commit"} {
+		require.Contains(t, spans, name)
+	}
+
+	block := spans["Block"]
+	require.Contains(t, block.Attributes(), telemetry.SpanAttributeKeyHeight.Int64(1))
+	for _, name := range []string{"BeginBlock", "DeliverTx", "EndBlock", "Commit"} {
+		require.Equal(t, block.SpanContext().SpanID(), spans[name].Parent().SpanID(), name)
+		require.Equal(t, block.SpanContext().TraceID(), spans[name].SpanContext().TraceID(), name)
+	}
+
+	deliverTx := spans["DeliverTx"]
+	require.Contains(t, deliverTx.Attributes(), telemetry.SpanAttributeKeyHeight.Int64(1))
+	require.Len(t, deliverTx.Attributes(), 2)
+
+	msg := spans[msgType]
+	require.Equal(t, deliverTx.SpanContext().SpanID(), msg.Parent().SpanID())
+	require.Contains(t, msg.Attributes(), telemetry.SpanAttributeKeyMsgType.String(msgType))
+}
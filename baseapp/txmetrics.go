@@ -0,0 +1,69 @@
+package baseapp
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// String returns the name of the mode, as the mode label of the metrics.
+func (m runTxMode) String() string {
+	switch m {
+	case runTxModeCheck:
+		return "check"
+	case runTxModeReCheck:
+		return "recheck"
+	case runTxModeSimulate:
+		return "simulate"
+	case runTxModeDeliver:
+		return "deliver"
+	default:
+		return "unknown"
+	}
+}
+
+// recordCheckTx records the outcome of a CheckTx of the given mode started at
+// start, err being its error if the tx was rejected. The rejections are labeled
+// by the codespace and code of their error, attributing them to the application
+// level causes which Tendermint's mempool metrics don't tell apart.
+func recordCheckTx(mode runTxMode, start time.Time, err error) {
+	modeLabel := telemetry.NewLabel("mode", mode.String())
+
+	if mode == runTxModeReCheck {
+		telemetry.MeasureSince(start, "check_tx", "recheck")
+	}
+
+	if err == nil {
+		telemetry.IncrCounterWithLabels([]string{"check_tx", "accepted"}, 1, []metrics.Label{modeLabel})
+		return
+	}
+
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	telemetry.IncrCounterWithLabels(
+		[]string{"check_tx", "rejected"},
+		1,
+		[]metrics.Label{
+			modeLabel,
+			telemetry.NewLabel("codespace", codespace),
+			telemetry.NewLabel("code", strconv.FormatUint(uint64(code), 10)),
+		},
+	)
+
+	if sdkerrors.ErrWrongSequence.Is(err) {
+		telemetry.IncrCounterWithLabels([]string{"check_tx", "sequence_mismatch"}, 1, []metrics.Label{modeLabel})
+	}
+}
+
+// recordAnteHandler records the duration of an ante handler run in mode started
+// at start.
+func recordAnteHandler(mode runTxMode, start time.Time) {
+	telemetry.MeasureSinceWithLabels(
+		[]string{"tx", "ante_handler"},
+		start,
+		[]metrics.Label{telemetry.NewLabel("mode", mode.String())},
+	)
+}
@@ -0,0 +1,90 @@
+package baseapp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestCheckTxMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Minute, time.Minute)
+	cfg := metrics.DefaultConfig("test")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer metrics.NewGlobal(cfg, &metrics.BlackholeSink{}) //nolint:errcheck
+
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			txTest := tx.(txTest)
+			switch {
+			case txTest.FailOnAnte:
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			case txTest.Counter < 0:
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrWrongSequence, "account sequence mismatch")
+			default:
+				return ctx, nil
+			}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	checkTx := func(tx txTest, txType abci.CheckTxType) {
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		app.CheckTx(abci.RequestCheckTx{Tx: txBytes, Type: txType})
+	}
+
+	failOnAnte := newTxCounter(2, 0)
+	failOnAnte.setFailOnAnte(true)
+
+	checkTx(*newTxCounter(0, 0), abci.CheckTxType_New)
+	checkTx(*newTxCounter(1, 0), abci.CheckTxType_New)
+	checkTx(*newTxCounter(-1, 0), abci.CheckTxType_New)
+	checkTx(*failOnAnte, abci.CheckTxType_New)
+	checkTx(*newTxCounter(0, 0), abci.CheckTxType_Recheck)
+	checkTx(*newTxCounter(-1, 0), abci.CheckTxType_Recheck)
+
+	data := sink.Data()
+	require.NotEmpty(t, data)
+	interval := data[len(data)-1]
+
+	counters := map[string]int{
+		"test.check_tx.accepted;mode=check":                         2,
+		"test.check_tx.accepted;mode=recheck":                       1,
+		"test.check_tx.rejected;mode=check;codespace=sdk;code=32":   1,
+		"test.check_tx.rejected;mode=check;codespace=sdk;code=4":    1,
+		"test.check_tx.rejected;mode=recheck;codespace=sdk;code=32": 1,
+		"test.check_tx.sequence_mismatch;mode=check":                1,
+		"test.check_tx.sequence_mismatch;mode=recheck":              1,
+	}
+	for key, count := range counters {
+		counter, ok := interval.Counters[key]
+		require.True(t, ok, key)
+		require.Equal(t, count, counter.Count, key)
+	}
+
+	samples := map[string]int{
+		"test.check_tx.recheck":             2,
+		"test.tx.ante_handler;mode=check":   4,
+		"test.tx.ante_handler;mode=recheck": 2,
+	}
+	for key, count := range samples {
+		sample, ok := interval.Samples[key]
+		require.True(t, ok, key)
+		require.Equal(t, count, sample.Count, key)
+	}
+}
@@ -120,7 +120,12 @@ func ReadPersistentCommandFlags(clientCtx Context, flagSet *pflag.FlagSet) (Cont
 		clientCtx = clientCtx.WithChainID(chainID)
 	}
 
-	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) {
+	if flagSet.Changed(flags.FlagKeyringAuditLog) {
+		auditLog, _ := flagSet.GetString(flags.FlagKeyringAuditLog)
+		clientCtx = clientCtx.WithKeyringOptions(append(clientCtx.KeyringOptions, keyring.WithAuditLog(auditLog))...)
+	}
+
+	if clientCtx.Keyring == nil || flagSet.Changed(flags.FlagKeyringBackend) || flagSet.Changed(flags.FlagKeyringAuditLog) {
 		keyringBackend, _ := flagSet.GetString(flags.FlagKeyringBackend)
 
 		if keyringBackend != "" {
@@ -0,0 +1,191 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// StoreCommitHash is the commit hash of a single store of the multistore.
+type StoreCommitHash struct {
+	Name string           `json:"name"`
+	Hash tmbytes.HexBytes `json:"hash"`
+}
+
+// CommitInfoOutput is the per-store breakdown of the app hash at a height.
+type CommitInfoOutput struct {
+	Height  int64             `json:"height"`
+	AppHash tmbytes.HexBytes  `json:"app_hash"`
+	Stores  []StoreCommitHash `json:"stores"`
+}
+
+// NewCommitInfoOutput converts a CommitInfo into its printable form, with stores
+// sorted by name.
+func NewCommitInfoOutput(ci storetypes.CommitInfo) CommitInfoOutput {
+	stores := make([]StoreCommitHash, 0, len(ci.StoreInfos))
+	for _, si := range ci.StoreInfos {
+		stores = append(stores, StoreCommitHash{Name: si.Name, Hash: si.GetHash()})
+	}
+
+	sort.Slice(stores, func(i, j int) bool { return stores[i].Name < stores[j].Name })
+
+	return CommitInfoOutput{
+		Height:  ci.Version,
+		AppHash: ci.Hash(),
+		Stores:  stores,
+	}
+}
+
+// StoreHashDiff describes a store whose commit hash differs between two
+// CommitInfoOutputs. A nil hash means the store is missing on that side.
+type StoreHashDiff struct {
+	Name  string           `json:"name"`
+	HashA tmbytes.HexBytes `json:"hash_a"`
+	HashB tmbytes.HexBytes `json:"hash_b"`
+}
+
+// DiffCommitInfo returns the stores whose commit hashes differ between a and b,
+// sorted by store name.
+func DiffCommitInfo(a, b CommitInfoOutput) []StoreHashDiff {
+	hashesA := make(map[string]tmbytes.HexBytes, len(a.Stores))
+	for _, s := range a.Stores {
+		hashesA[s.Name] = s.Hash
+	}
+
+	hashesB := make(map[string]tmbytes.HexBytes, len(b.Stores))
+	for _, s := range b.Stores {
+		hashesB[s.Name] = s.Hash
+	}
+
+	var diffs []StoreHashDiff
+
+	for name, hashA := range hashesA {
+		hashB, ok := hashesB[name]
+		if !ok || !bytes.Equal(hashA, hashB) {
+			diffs = append(diffs, StoreHashDiff{Name: name, HashA: hashA, HashB: hashB})
+		}
+	}
+
+	for name, hashB := range hashesB {
+		if _, ok := hashesA[name]; !ok {
+			diffs = append(diffs, StoreHashDiff{Name: name, HashB: hashB})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Name < diffs[j].Name })
+
+	return diffs
+}
+
+// CommitInfoCmd returns a command querying the per-store commit hashes
+// composing the app hash at a height.
+func CommitInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-info",
+		Short: "Query the per-store commit hashes composing the app hash at a height",
+		Long: fmt.Sprintf(`Query the per-store commit hashes composing the app hash at a height.
+The JSON output of two nodes can be compared with the commit-info-diff command
+to find which module store caused an app hash mismatch.
+
+Example:
+$ %s debug commit-info --height 1234 --node tcp://localhost:26657 > node-a.json
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/commit_info"})
+			if err != nil {
+				return err
+			}
+
+			var ci storetypes.CommitInfo
+			if err := ci.Unmarshal(res.Value); err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, NewCommitInfoOutput(ci))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CommitInfoDiffCmd returns a command comparing the outputs of commit-info
+// obtained from two nodes.
+func CommitInfoDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-info-diff [file-a] [file-b]",
+		Short: "Compare two commit-info outputs and list the stores whose hashes differ",
+		Long: fmt.Sprintf(`Compare two commit-info outputs and list the stores whose hashes differ.
+
+Example:
+$ %s debug commit-info-diff node-a.json node-b.json
+			`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			a, err := readCommitInfoOutput(args[0])
+			if err != nil {
+				return err
+			}
+
+			b, err := readCommitInfoOutput(args[1])
+			if err != nil {
+				return err
+			}
+
+			if a.Height != b.Height {
+				return fmt.Errorf("cannot compare commit info of different heights: %d and %d", a.Height, b.Height)
+			}
+
+			diffs := DiffCommitInfo(a, b)
+			if diffs == nil {
+				diffs = []StoreHashDiff{}
+			}
+
+			return printJSON(clientCtx, diffs)
+		},
+	}
+}
+
+func readCommitInfoOutput(path string) (CommitInfoOutput, error) {
+	var out CommitInfoOutput
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return out, err
+	}
+
+	if err := json.Unmarshal(bz, &out); err != nil {
+		return out, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	return out, nil
+}
+
+func printJSON(clientCtx client.Context, v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintBytes(append(bz, '\n'))
+}
@@ -0,0 +1,33 @@
+package debug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestDiffCommitInfo(t *testing.T) {
+	newInfo := func(hashes map[string]string) CommitInfoOutput {
+		ci := storetypes.CommitInfo{Version: 10}
+		for name, hash := range hashes {
+			ci.StoreInfos = append(ci.StoreInfos, storetypes.StoreInfo{
+				Name:     name,
+				CommitId: storetypes.CommitID{Version: 10, Hash: []byte(hash)},
+			})
+		}
+		return NewCommitInfoOutput(ci)
+	}
+
+	a := newInfo(map[string]string{"acc": "a", "bank": "b", "gov": "g", "mint": "m"})
+	require.Equal(t, []string{"acc", "bank", "gov", "mint"}, []string{a.Stores[0].Name, a.Stores[1].Name, a.Stores[2].Name, a.Stores[3].Name})
+	require.Empty(t, DiffCommitInfo(a, a))
+
+	b := newInfo(map[string]string{"acc": "a", "bank": "x", "gov": "g", "staking": "s"})
+	require.Equal(t, []StoreHashDiff{
+		{Name: "bank", HashA: []byte("b"), HashB: []byte("x")},
+		{Name: "mint", HashA: []byte("m")},
+		{Name: "staking", HashB: []byte("s")},
+	}, DiffCommitInfo(a, b))
+}
@@ -0,0 +1,55 @@
+package debug
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// DecodeKeyCmd returns a command rendering a raw store key, and optionally its
+// value, using the key formats of registry.
+func DecodeKeyCmd(registry *keyformat.Registry) *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-key [store] [key-hex] [value-hex]",
+		Short: "Decode a raw store key and value into a human-readable form",
+		Long: fmt.Sprintf(`Decode a raw store key, and optionally its value, of the store with the given
+key name, using the key layouts declared by the modules. The key and the value
+are given in hex.
+
+Stores with registered key formats: %s
+
+Example:
+$ %s debug decode-key bank 0214e4ce7ec6ed2c1e2c0b0b30dbc3cc8e4048e9a110757374616b65
+			`, strings.Join(registry.Stores(), ", "), version.AppName),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			key, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid key: %w", err)
+			}
+
+			var value []byte
+			if len(args) > 2 {
+				value, err = hex.DecodeString(args[2])
+				if err != nil {
+					return fmt.Errorf("invalid value: %w", err)
+				}
+			}
+
+			decoded, err := registry.Decode(clientCtx.Codec, args[0], key, value)
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, decoded)
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// GasUsageCmd returns a command querying the breakdown per message type of the
+// gas consumed by the last blocks committed by a node.
+func GasUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-usage",
+		Short: "Query the breakdown per message type of the gas consumed by the last committed blocks",
+		Long: fmt.Sprintf(`Query the breakdown per message type of the gas consumed by the last blocks
+committed by a node, and its total per message type over these blocks, sorted by
+decreasing gas used. The number of blocks is set by the gas-usage-window of the
+node, which must not be 0.
+
+Example:
+$ %s debug gas-usage --node tcp://localhost:26657
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/gas_usage"})
+			if err != nil {
+				return err
+			}
+
+			var usage baseapp.GasUsageResponse
+			if err := json.Unmarshal(res.Value, &usage); err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, usage)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
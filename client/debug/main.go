@@ -36,6 +36,11 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(PubkeyRawCmd())
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(CommitInfoCmd())
+	cmd.AddCommand(CommitInfoDiffCmd())
+	cmd.AddCommand(GasUsageCmd())
+	cmd.AddCommand(StateSizeCmd())
+	cmd.AddCommand(ValidateSignersCmd())
 
 	return cmd
 }
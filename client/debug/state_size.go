@@ -0,0 +1,54 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// StateSizeCmd returns a command querying the last measurement of the size of
+// the stores of the state of a node.
+func StateSizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-size",
+		Short: "Query the last measurement of the number of keys and bytes of each store of the state",
+		Long: fmt.Sprintf(`Query the last measurement of the number of keys and bytes of each store of the
+state of a node, and their growth since the previous measurement. The state is
+measured every state-size-interval committed blocks of the node, which must not
+be 0.
+
+Example:
+$ %s debug state-size --node tcp://localhost:26657
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{Path: "/app/state_size"})
+			if err != nil {
+				return err
+			}
+
+			var report baseapp.StateSizeReport
+			if err := json.Unmarshal(res.Value, &report); err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, report)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package debug
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// ValidateSignersCmd returns a command reporting the registered Msgs whose
+// GetSigners implementation disagrees with their cosmos.msg.v1.signer
+// annotation.
+func ValidateSignersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate-signers",
+		Short: "Report the Msgs whose GetSigners implementation disagrees with their signer annotation",
+		Long: fmt.Sprintf(`Report the Msgs registered by the application whose GetSigners implementation
+disagrees with the signers declared by their cosmos.msg.v1.signer proto option,
+or which have no such option. The command fails if any Msg is reported.
+
+Example:
+$ %s debug validate-signers
+			`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			mismatches := msgservice.ValidateMsgSigners(clientCtx.InterfaceRegistry)
+			if mismatches == nil {
+				mismatches = []msgservice.SignerMismatch{}
+			}
+
+			if err := printJSON(clientCtx, mismatches); err != nil {
+				return err
+			}
+
+			if len(mismatches) > 0 {
+				return fmt.Errorf("%d Msgs have inconsistent signers", len(mismatches))
+			}
+
+			return nil
+		},
+	}
+}
@@ -62,6 +62,7 @@ const (
 	FlagSkipConfirmation = "yes"
 	FlagProve            = "prove"
 	FlagKeyringBackend   = "keyring-backend"
+	FlagKeyringAuditLog  = "keyring-audit-log"
 	FlagPage             = "page"
 	FlagLimit            = "limit"
 	FlagSignMode         = "sign-mode"
@@ -110,6 +111,7 @@ func AddTxFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
 	cmd.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 	cmd.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	cmd.Flags().String(FlagKeyringAuditLog, "", "Append a record of every signing operation to this file")
 	cmd.Flags().String(FlagSignMode, "", "Choose sign mode (direct|amino-json), this is an advanced feature")
 	cmd.Flags().Uint64(FlagTimeoutHeight, 0, "Set a block timeout height to prevent the tx from being committed past a certain height")
 	cmd.Flags().String(FlagFeeAccount, "", "Fee account pays fees for the transaction instead of deducting from the signer")
@@ -0,0 +1,150 @@
+package node
+
+import (
+	"context"
+	"sort"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Config is the information about the application reported by the node
+// service.
+type Config struct {
+	// Modules are the consensus versions of the modules of the application.
+	Modules module.VersionMap
+	// SignModes are the sign modes the application accepts.
+	SignModes []signing.SignMode
+	// Features tell whether the optional features of the application, such as
+	// the ones set in app.toml, are enabled.
+	Features map[string]bool
+	// StakingDenom returns the denom staked on the chain from the state of ctx,
+	// e.g. the bond denom of the staking module.
+	StakingDenom func(ctx sdk.Context) string
+	// DenomMetadata returns the metadata of a denom from the state of ctx, or
+	// false if it has none, e.g. the denom metadata of the bank module.
+	DenomMetadata func(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+type queryServer struct {
+	response       NodeInfoResponse
+	bech32Prefixes Bech32Prefixes
+	slip44         uint32
+	stakingDenom   func(ctx sdk.Context) string
+	denomMetadata  func(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+var _ ServiceServer = queryServer{}
+
+// NewQueryServer creates a new node query server reporting the information of
+// cfg. The node information is computed once, cfg and the global SDK config
+// being fixed for the lifetime of the application.
+func NewQueryServer(cfg Config) ServiceServer {
+	resp := NodeInfoResponse{
+		ApplicationVersion: tmservice.NewVersionInfo(version.NewInfo()),
+		Modules:            make([]*ModuleInfo, 0, len(cfg.Modules)),
+		SignModes:          make([]string, len(cfg.SignModes)),
+		Features:           make([]*Feature, 0, len(cfg.Features)),
+	}
+
+	for name, consensusVersion := range cfg.Modules {
+		resp.Modules = append(resp.Modules, &ModuleInfo{Name: name, ConsensusVersion: consensusVersion})
+	}
+	sort.Slice(resp.Modules, func(i, j int) bool {
+		return resp.Modules[i].Name < resp.Modules[j].Name
+	})
+
+	for i, mode := range cfg.SignModes {
+		resp.SignModes[i] = mode.String()
+	}
+
+	for name, enabled := range cfg.Features {
+		resp.Features = append(resp.Features, &Feature{Name: name, Enabled: enabled})
+	}
+	sort.Slice(resp.Features, func(i, j int) bool {
+		return resp.Features[i].Name < resp.Features[j].Name
+	})
+
+	sdkConfig := sdk.GetConfig()
+
+	return queryServer{
+		response: resp,
+		bech32Prefixes: Bech32Prefixes{
+			AccountAddress:   sdkConfig.GetBech32AccountAddrPrefix(),
+			AccountPubkey:    sdkConfig.GetBech32AccountPubPrefix(),
+			ValidatorAddress: sdkConfig.GetBech32ValidatorAddrPrefix(),
+			ValidatorPubkey:  sdkConfig.GetBech32ValidatorPubPrefix(),
+			ConsensusAddress: sdkConfig.GetBech32ConsensusAddrPrefix(),
+			ConsensusPubkey:  sdkConfig.GetBech32ConsensusPubPrefix(),
+		},
+		slip44:        sdkConfig.GetCoinType(),
+		stakingDenom:  cfg.StakingDenom,
+		denomMetadata: cfg.DenomMetadata,
+	}
+}
+
+// NodeInfo implements ServiceServer.NodeInfo
+func (s queryServer) NodeInfo(_ context.Context, _ *NodeInfoRequest) (*NodeInfoResponse, error) {
+	resp := s.response
+	return &resp, nil
+}
+
+// ChainInfo implements ServiceServer.ChainInfo. The fee tokens are the minimum
+// gas prices of the node, and the denoms are read from the queried state.
+func (s queryServer) ChainInfo(goCtx context.Context, _ *ChainInfoRequest) (*ChainInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := &ChainInfoResponse{
+		ChainId:        ctx.ChainID(),
+		Bech32Prefixes: s.bech32Prefixes,
+		Slip44:         s.slip44,
+		FeeTokens:      ctx.MinGasPrices(),
+	}
+
+	if s.stakingDenom != nil {
+		resp.StakingDenom = s.stakingDenom(ctx)
+	}
+
+	if len(resp.FeeTokens) == 0 && resp.StakingDenom != "" {
+		resp.FeeTokens = sdk.DecCoins{sdk.NewDecCoin(resp.StakingDenom, sdk.ZeroInt())}
+	}
+
+	if s.denomMetadata != nil {
+		denoms := map[string]bool{}
+		if resp.StakingDenom != "" {
+			denoms[resp.StakingDenom] = true
+		}
+		for _, token := range resp.FeeTokens {
+			denoms[token.Denom] = true
+		}
+
+		for denom := range denoms {
+			if metadata, ok := s.denomMetadata(ctx, denom); ok {
+				resp.DenomsMetadata = append(resp.DenomsMetadata, metadata)
+			}
+		}
+		sort.Slice(resp.DenomsMetadata, func(i, j int) bool {
+			return resp.DenomsMetadata[i].Base < resp.DenomsMetadata[j].Base
+		})
+	}
+
+	return resp, nil
+}
+
+// RegisterNodeService registers the node queries on the gRPC router.
+func RegisterNodeService(qrt gogogrpc.Server, cfg Config) {
+	RegisterServiceServer(qrt, NewQueryServer(cfg))
+}
+
+// RegisterGRPCGatewayRoutes mounts the node service's GRPC-gateway routes on
+// the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	RegisterServiceHandlerClient(context.Background(), mux, NewServiceClient(clientConn))
+}
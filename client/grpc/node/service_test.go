@@ -0,0 +1,107 @@
+package node_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNodeInfo(t *testing.T) {
+	srv := node.NewQueryServer(node.Config{
+		Modules:   module.VersionMap{"staking": 2, "bank": 2, "authz": 1},
+		SignModes: []signing.SignMode{signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		Features:  map[string]bool{"replay_verification": false, "event_streaming": true},
+	})
+
+	res, err := srv.NodeInfo(context.Background(), &node.NodeInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, version.NewInfo().CosmosSdkVersion, res.ApplicationVersion.CosmosSdkVersion)
+	require.Equal(t, []*node.ModuleInfo{
+		{Name: "authz", ConsensusVersion: 1},
+		{Name: "bank", ConsensusVersion: 2},
+		{Name: "staking", ConsensusVersion: 2},
+	}, res.Modules)
+	require.Equal(t, []string{"SIGN_MODE_DIRECT", "SIGN_MODE_LEGACY_AMINO_JSON"}, res.SignModes)
+	require.Equal(t, []*node.Feature{
+		{Name: "event_streaming", Enabled: true},
+		{Name: "replay_verification", Enabled: false},
+	}, res.Features)
+}
+
+func TestSimAppNodeInfo(t *testing.T) {
+	app := simapp.Setup(false)
+
+	abciRes := app.Query(abci.RequestQuery{Path: "/cosmos.base.node.v1beta1.Service/NodeInfo"})
+	require.True(t, abciRes.IsOK(), abciRes.Log)
+
+	var res node.NodeInfoResponse
+	require.NoError(t, res.Unmarshal(abciRes.Value))
+	require.Contains(t, res.Modules, &node.ModuleInfo{Name: "bank", ConsensusVersion: 2})
+	require.Contains(t, res.SignModes, "SIGN_MODE_DIRECT")
+	require.Contains(t, res.Features, &node.Feature{Name: "query_cache", Enabled: false})
+}
+
+func TestChainInfo(t *testing.T) {
+	atomMetadata := banktypes.Metadata{Base: "uatom", Display: "atom", DenomUnits: []*banktypes.DenomUnit{
+		{Denom: "uatom"}, {Denom: "atom", Exponent: 6},
+	}}
+	stakeMetadata := banktypes.Metadata{Base: "stake", Display: "stake", DenomUnits: []*banktypes.DenomUnit{{Denom: "stake"}}}
+	metadata := map[string]banktypes.Metadata{"uatom": atomMetadata, "stake": stakeMetadata}
+
+	srv := node.NewQueryServer(node.Config{
+		StakingDenom: func(sdk.Context) string { return "uatom" },
+		DenomMetadata: func(_ sdk.Context, denom string) (banktypes.Metadata, bool) {
+			md, ok := metadata[denom]
+			return md, ok
+		},
+	})
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(25, 4)), sdk.NewDecCoin("stake", sdk.OneInt()), sdk.NewDecCoin("uosmo", sdk.OneInt()))
+	ctx := sdk.Context{}.WithContext(context.Background()).WithChainID("test-chain").WithMinGasPrices(minGasPrices)
+
+	res, err := srv.ChainInfo(sdk.WrapSDKContext(ctx), &node.ChainInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "test-chain", res.ChainId)
+	require.Equal(t, node.Bech32Prefixes{
+		AccountAddress:   "cosmos",
+		AccountPubkey:    "cosmospub",
+		ValidatorAddress: "cosmosvaloper",
+		ValidatorPubkey:  "cosmosvaloperpub",
+		ConsensusAddress: "cosmosvalcons",
+		ConsensusPubkey:  "cosmosvalconspub",
+	}, res.Bech32Prefixes)
+	require.Equal(t, uint32(sdk.CoinType), res.Slip44)
+	require.Equal(t, "uatom", res.StakingDenom)
+	require.Equal(t, minGasPrices, res.FeeTokens)
+	require.Equal(t, []banktypes.Metadata{stakeMetadata, atomMetadata}, res.DenomsMetadata)
+
+	// the staking denom is returned as fee token without min gas prices
+	res, err = srv.ChainInfo(sdk.WrapSDKContext(ctx.WithMinGasPrices(nil)), &node.ChainInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin("uatom", sdk.ZeroInt())}, res.FeeTokens)
+	require.Equal(t, []banktypes.Metadata{atomMetadata}, res.DenomsMetadata)
+}
+
+func TestSimAppChainInfo(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+
+	abciRes := app.Query(abci.RequestQuery{Path: "/cosmos.base.node.v1beta1.Service/ChainInfo"})
+	require.True(t, abciRes.IsOK(), abciRes.Log)
+
+	var res node.ChainInfoResponse
+	require.NoError(t, res.Unmarshal(abciRes.Value))
+	require.Equal(t, sdk.DefaultBondDenom, res.StakingDenom)
+	require.Equal(t, sdk.Bech32MainPrefix, res.Bech32Prefixes.AccountAddress)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt())}, res.FeeTokens)
+}
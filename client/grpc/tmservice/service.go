@@ -168,12 +168,18 @@ func (s queryServer) GetNodeInfo(ctx context.Context, req *GetNodeInfoRequest) (
 		return nil, err
 	}
 
-	protoNodeInfo := status.NodeInfo.ToProto()
-	nodeInfo := version.NewInfo()
-
-	deps := make([]*Module, len(nodeInfo.BuildDeps))
+	resp := GetNodeInfoResponse{
+		DefaultNodeInfo:    status.NodeInfo.ToProto(),
+		ApplicationVersion: NewVersionInfo(version.NewInfo()),
+	}
+	return &resp, nil
+}
 
-	for i, dep := range nodeInfo.BuildDeps {
+// NewVersionInfo returns the VersionInfo of the application binary described
+// by info.
+func NewVersionInfo(info version.Info) *VersionInfo {
+	deps := make([]*Module, len(info.BuildDeps))
+	for i, dep := range info.BuildDeps {
 		deps[i] = &Module{
 			Path:    dep.Path,
 			Sum:     dep.Sum,
@@ -181,20 +187,16 @@ func (s queryServer) GetNodeInfo(ctx context.Context, req *GetNodeInfoRequest) (
 		}
 	}
 
-	resp := GetNodeInfoResponse{
-		DefaultNodeInfo: protoNodeInfo,
-		ApplicationVersion: &VersionInfo{
-			AppName:          nodeInfo.AppName,
-			Name:             nodeInfo.Name,
-			GitCommit:        nodeInfo.GitCommit,
-			GoVersion:        nodeInfo.GoVersion,
-			Version:          nodeInfo.Version,
-			BuildTags:        nodeInfo.BuildTags,
-			BuildDeps:        deps,
-			CosmosSdkVersion: nodeInfo.CosmosSdkVersion,
-		},
+	return &VersionInfo{
+		AppName:          info.AppName,
+		Name:             info.Name,
+		GitCommit:        info.GitCommit,
+		GoVersion:        info.GoVersion,
+		Version:          info.Version,
+		BuildTags:        info.BuildTags,
+		BuildDeps:        deps,
+		CosmosSdkVersion: info.CosmosSdkVersion,
 	}
-	return &resp, nil
 }
 
 // RegisterTendermintService registers the tendermint queries on the gRPC router.
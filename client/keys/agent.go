@@ -0,0 +1,170 @@
+package keys
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+const (
+	flagTTL = "ttl"
+
+	// agentReady is printed by the agent once it serves the passphrase.
+	agentReady = "ready"
+)
+
+// UnlockKeyringCommand starts an agent caching the passphrase of the file keyring.
+func UnlockKeyringCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock",
+		Short: "Cache the passphrase of the file keyring in a background agent",
+		Long: `Prompt the passphrase of the file keyring once, and cache it in a background agent
+process until the --ttl duration elapses or the keyring is locked with the 'keys lock'
+command. The commands using the keyring are then not prompting the passphrase, e.g. in
+scripts signing many transactions.
+
+The agent keeps the passphrase in memory locked from being swapped to disk, and serves it
+on a unix socket of the keyring directory, which is only accessible by the current user.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			ttl, _ := cmd.Flags().GetDuration(flagTTL)
+
+			passphrase, err := keyring.UnlockFile(clientCtx.Keyring)
+			if err != nil {
+				return err
+			}
+
+			return startAgent(cmd, clientCtx.KeyringDir, passphrase, ttl)
+		},
+	}
+
+	cmd.Flags().Duration(flagTTL, time.Hour, "Duration for which the passphrase is cached")
+
+	return cmd
+}
+
+// LockKeyringCommand stops the agent caching the passphrase of the file keyring.
+func LockKeyringCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lock",
+		Short: "Discard the passphrase of the file keyring cached by 'keys unlock'",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if err := keyring.LockAgent(clientCtx.KeyringDir); err != nil {
+				return err
+			}
+			cmd.PrintErrln("Keyring locked")
+
+			return nil
+		},
+	}
+}
+
+// agentCommand runs the agent started by the unlock command, reading the
+// passphrase from its standard input.
+func agentCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:    "agent",
+		Short:  "Run the agent caching the passphrase of the file keyring",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keyringDir, _ := cmd.Flags().GetString(flags.FlagKeyringDir)
+			ttl, _ := cmd.Flags().GetDuration(flagTTL)
+
+			passphrase, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+			if err != nil {
+				return err
+			}
+
+			agent, err := keyring.NewAgent(keyringDir, strings.TrimSuffix(passphrase, "\n"), ttl)
+			if err != nil {
+				return err
+			}
+
+			// the agent outlives the terminal of the unlock command
+			signal.Ignore(syscall.SIGHUP)
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				agent.Close()
+			}()
+
+			fmt.Fprintln(cmd.OutOrStdout(), agentReady)
+
+			return agent.Serve()
+		},
+	}
+
+	cmd.Flags().Duration(flagTTL, time.Hour, "Duration for which the passphrase is cached")
+
+	return cmd
+}
+
+// startAgent runs the agent command in a background process, and waits for it
+// to serve the passphrase.
+func startAgent(cmd *cobra.Command, keyringDir, passphrase string, ttl time.Duration) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	// the agent command is a sibling of the unlock command
+	args := strings.Fields(cmd.Parent().CommandPath())[1:]
+	args = append(args, "agent", "--"+flags.FlagKeyringDir, keyringDir, "--"+flagTTL, ttl.String())
+
+	agent := exec.Command(exe, args...)
+	agent.Stderr = os.Stderr
+	stdin, err := agent.StdinPipe()
+	if err != nil {
+		return err
+	}
+	stdout, err := agent.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := agent.Start(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(stdin, passphrase); err != nil {
+		return err
+	}
+	stdin.Close()
+
+	line, err := bufio.NewReader(stdout).ReadString('\n')
+	if err != nil || strings.TrimSpace(line) != agentReady {
+		// the agent exited, e.g. as another agent is running
+		if err := agent.Wait(); err != nil {
+			return fmt.Errorf("failed to start the keyring agent: %w", err)
+		}
+		return fmt.Errorf("failed to start the keyring agent")
+	}
+	stdout.Close()
+
+	cmd.PrintErrf("Keyring unlocked for %s\n", ttl)
+
+	return agent.Process.Release()
+}
@@ -0,0 +1,74 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runUnlockCmdBackend(t *testing.T) {
+	kbHome := t.TempDir()
+	cmd := UnlockKeyringCommand()
+	cmd.Flags().AddFlagSet(Commands(kbHome).PersistentFlags())
+	mockIn := testutil.ApplyMockIODiscardOutErr(cmd)
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendTest, kbHome, mockIn)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.WithKeyringDir(kbHome).WithKeyring(kb)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, kbHome)})
+	require.EqualError(t, cmd.ExecuteContext(ctx), "the keyring agent only supports the file backend")
+}
+
+func Test_runAgentCmd(t *testing.T) {
+	kbHome := t.TempDir()
+
+	kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, strings.NewReader("password\npassword\n"))
+	require.NoError(t, err)
+	_, _, err = kb.NewMnemonic("foo", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	cmd := agentCommand()
+	cmd.Flags().AddFlagSet(Commands(kbHome).PersistentFlags())
+	mockIn, mockOut := testutil.ApplyMockIO(cmd)
+	mockIn.Reset("password\n")
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagKeyringDir, kbHome)})
+
+	served := make(chan error)
+	go func() { served <- cmd.Execute() }()
+
+	// the keyring is unlocked by the agent once it's ready
+	require.Eventually(t, func() bool {
+		kb, err := keyring.New(sdk.KeyringServiceName(), keyring.BackendFile, kbHome, strings.NewReader(""))
+		if err != nil {
+			return false
+		}
+		_, err = kb.Key("foo")
+		return err == nil
+	}, time.Second*5, 10*time.Millisecond)
+
+	lockCmd := LockKeyringCommand()
+	lockCmd.Flags().AddFlagSet(Commands(kbHome).PersistentFlags())
+	testutil.ApplyMockIODiscardOutErr(lockCmd)
+	clientCtx := client.Context{}.WithKeyringDir(kbHome)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	lockCmd.SetArgs([]string{})
+	require.NoError(t, lockCmd.ExecuteContext(ctx))
+	require.NoError(t, <-served)
+	require.Equal(t, agentReady+"\n", mockOut.String())
+
+	// without agent, there's nothing to lock
+	require.Error(t, lockCmd.ExecuteContext(ctx))
+}
@@ -21,8 +21,9 @@ The keyring supports the following backends:
 
     os          Uses the operating system's default credentials store.
     file        Uses encrypted file-based keystore within the app's configuration directory.
-                This keyring will request a password each time it is accessed, which may occur
-                multiple times in a single command resulting in repeated password prompts.
+                This keyring will request a password once per command, when it is accessed,
+                and derives the encryption key from the password with argon2id. The password
+                can be cached for several commands with the unlock command.
     kwallet     Uses KDE Wallet Manager as a credentials management application.
     pass        Uses the pass command line utility to store and retrieve keys.
     test        Stores keys insecurely to disk. It does not prompt for a password to be unlocked
@@ -47,11 +48,15 @@ The pass backend requires GnuPG: https://gnupg.org/
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
 		MigrateCommand(),
+		UnlockKeyringCommand(),
+		LockKeyringCommand(),
+		agentCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.PersistentFlags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
 	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.PersistentFlags().String(flags.FlagKeyringAuditLog, "", "Append a record of every signing operation to this file")
 	cmd.PersistentFlags().String(cli.OutputFlag, "text", "Output format (text|json)")
 
 	return cmd
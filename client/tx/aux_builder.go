@@ -0,0 +1,300 @@
+package tx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// AuxTxBuilder is a client-side builder for creating an AuxSignerData. An
+// auxiliary signer only signs over the transaction body with
+// SIGN_MODE_DIRECT_AUX and hands the resulting AuxSignerData to a fee payer,
+// who completes the transaction with BuildTxFromAuxSignerData.
+type AuxTxBuilder struct {
+	// body is used for constructing the DIRECT_AUX sign bytes.
+	body *tx.TxBody
+	// auxSignerData represents the output of the AuxTxBuilder.
+	auxSignerData *tx.AuxSignerData
+}
+
+// NewAuxTxBuilder creates a new client-side builder for constructing an
+// AuxSignerData.
+func NewAuxTxBuilder() AuxTxBuilder {
+	return AuxTxBuilder{}
+}
+
+// SetAddress sets the aux signer's bech32 address.
+func (b *AuxTxBuilder) SetAddress(addr string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Address = addr
+}
+
+// SetMemo sets a memo in the tx.
+func (b *AuxTxBuilder) SetMemo(memo string) {
+	b.checkEmptyFields()
+
+	b.body.Memo = memo
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetTimeoutHeight sets a timeout height in the tx.
+func (b *AuxTxBuilder) SetTimeoutHeight(height uint64) {
+	b.checkEmptyFields()
+
+	b.body.TimeoutHeight = height
+	b.auxSignerData.SignDoc.BodyBytes = nil
+}
+
+// SetMsgs sets an array of Msgs in the tx.
+func (b *AuxTxBuilder) SetMsgs(msgs ...sdk.Msg) error {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		var err error
+		anys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.checkEmptyFields()
+
+	b.body.Messages = anys
+	b.auxSignerData.SignDoc.BodyBytes = nil
+
+	return nil
+}
+
+// SetAccountNumber sets the aux signer's account number in the AuxSignerData.
+func (b *AuxTxBuilder) SetAccountNumber(accNum uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.AccountNumber = accNum
+}
+
+// SetChainID sets the chain id in the AuxSignerData.
+func (b *AuxTxBuilder) SetChainID(chainID string) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.ChainId = chainID
+}
+
+// SetSequence sets the aux signer's sequence in the AuxSignerData.
+func (b *AuxTxBuilder) SetSequence(accSeq uint64) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.Sequence = accSeq
+}
+
+// SetPubKey sets the aux signer's pubkey in the AuxSignerData.
+func (b *AuxTxBuilder) SetPubKey(pk cryptotypes.PubKey) error {
+	any, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return err
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.SignDoc.PublicKey = any
+
+	return nil
+}
+
+// SetSignMode sets the aux signer's sign mode. Only SIGN_MODE_DIRECT_AUX is
+// currently supported.
+func (b *AuxTxBuilder) SetSignMode(mode signing.SignMode) error {
+	if mode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "AuxTxBuilder can only sign with %s, got %s", signing.SignMode_SIGN_MODE_DIRECT_AUX, mode)
+	}
+
+	b.checkEmptyFields()
+
+	b.auxSignerData.Mode = mode
+
+	return nil
+}
+
+// SetSignature sets the aux signer's signature in the AuxSignerData.
+func (b *AuxTxBuilder) SetSignature(sig []byte) {
+	b.checkEmptyFields()
+
+	b.auxSignerData.Sig = sig
+}
+
+// GetSignBytes returns the builder's sign bytes.
+func (b *AuxTxBuilder) GetSignBytes() ([]byte, error) {
+	auxTx := b.auxSignerData
+	if auxTx == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "aux tx is nil, call setters on AuxTxBuilder first")
+	}
+
+	body := b.body
+	if body == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "tx body is nil, call setters on AuxTxBuilder first")
+	}
+
+	sd := auxTx.SignDoc
+	if sd == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign doc is nil, call setters on AuxTxBuilder first")
+	}
+
+	bodyBz, err := proto.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	sd.BodyBytes = bodyBz
+
+	if err := sd.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return sd.Marshal()
+}
+
+// GetAuxSignerData returns the builder's AuxSignerData.
+func (b *AuxTxBuilder) GetAuxSignerData() (tx.AuxSignerData, error) {
+	if b.auxSignerData == nil {
+		return tx.AuxSignerData{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "aux tx is nil, call setters on AuxTxBuilder first")
+	}
+
+	if err := b.auxSignerData.ValidateBasic(); err != nil {
+		return tx.AuxSignerData{}, err
+	}
+
+	return *b.auxSignerData, nil
+}
+
+func (b *AuxTxBuilder) checkEmptyFields() {
+	if b.body == nil {
+		b.body = &tx.TxBody{}
+	}
+
+	if b.auxSignerData == nil {
+		b.auxSignerData = &tx.AuxSignerData{SignDoc: &tx.SignDocDirectAux{}}
+	}
+}
+
+// feePayerSetter is implemented by TxBuilders which support setting an explicit
+// fee payer.
+type feePayerSetter interface {
+	SetFeePayer(feePayer sdk.AccAddress)
+}
+
+// BuildTxFromAuxSignerData builds an unsigned transaction out of the data
+// signed by its auxiliary signers. All auxiliary signers must have signed over
+// the same body and chain ID, and every required signer of the transaction
+// other than feePayer must be an auxiliary signer. The fee and gas limit are
+// taken from the factory, and feePayer is set as the fee payer of the
+// transaction, so that it can then be signed by feePayer with Sign.
+func BuildTxFromAuxSignerData(txf Factory, feePayer sdk.AccAddress, auxSignerData ...tx.AuxSignerData) (client.TxBuilder, error) {
+	if len(auxSignerData) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no aux signer data provided")
+	}
+
+	fees, err := txf.feeAmount()
+	if err != nil {
+		return nil, err
+	}
+
+	auxByAddr := make(map[string]tx.AuxSignerData, len(auxSignerData))
+	bodyBz := auxSignerData[0].SignDoc.GetBodyBytes()
+
+	for _, data := range auxSignerData {
+		if err := data.ValidateBasic(); err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(data.SignDoc.BodyBytes, bodyBz) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "aux signer %s signed over a different tx body", data.Address)
+		}
+
+		if data.SignDoc.ChainId != txf.chainID {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidChainID, "aux signer %s signed for chain %s, expected %s", data.Address, data.SignDoc.ChainId, txf.chainID)
+		}
+
+		if _, ok := auxByAddr[data.Address]; ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate aux signer data for %s", data.Address)
+		}
+
+		auxByAddr[data.Address] = data
+	}
+
+	// Decoding the signed body from a TxRaw preserves its exact bytes, which
+	// the aux signers' signatures commit to.
+	authInfoBz, err := proto.Marshal(&tx.AuthInfo{Fee: &tx.Fee{}})
+	if err != nil {
+		return nil, err
+	}
+
+	txBz, err := proto.Marshal(&tx.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz})
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := txf.txConfig.TxDecoder()(txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	txBuilder, err := txf.txConfig.WrapTxBuilder(decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	feePayerTxBuilder, ok := txBuilder.(feePayerSetter)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "tx builder %T cannot set a fee payer", txBuilder)
+	}
+
+	for _, signer := range txBuilder.GetTx().GetSigners() {
+		if signer.Equals(feePayer) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "fee payer %s cannot be a message signer of an aux signed tx", feePayer)
+		}
+	}
+
+	feePayerTxBuilder.SetFeePayer(feePayer)
+	txBuilder.SetFeeAmount(fees)
+	txBuilder.SetGasLimit(txf.gas)
+
+	// the fee payer is the last required signer, and signs after all the aux
+	// signers with Sign
+	signers := txBuilder.GetTx().GetSigners()
+	sigs := make([]signing.SignatureV2, 0, len(signers)-1)
+
+	for _, signer := range signers[:len(signers)-1] {
+		data, ok := auxByAddr[signer.String()]
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNoSignatures, "missing aux signer data for required signer %s", signer)
+		}
+
+		sig, err := data.GetSignatureV2()
+		if err != nil {
+			return nil, err
+		}
+
+		sigs = append(sigs, sig)
+		delete(auxByAddr, signer.String())
+	}
+
+	for _, data := range auxSignerData {
+		if _, ok := auxByAddr[data.Address]; ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "aux signer %s is not a required signer of the tx", data.Address)
+		}
+	}
+
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, fmt.Errorf("failed to set aux signatures: %w", err)
+	}
+
+	return txBuilder, nil
+}
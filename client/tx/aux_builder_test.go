@@ -0,0 +1,133 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestAuxTxBuilder(t *testing.T) {
+	bldr := tx.NewAuxTxBuilder()
+
+	_, err := bldr.GetSignBytes()
+	require.Error(t, err)
+	_, err = bldr.GetAuxSignerData()
+	require.Error(t, err)
+
+	_, _, addr := newKey(t)
+	require.NoError(t, bldr.SetMsgs(banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil)))
+
+	// chain id and pubkey are required
+	_, err = bldr.GetSignBytes()
+	require.Error(t, err)
+
+	require.Error(t, bldr.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT))
+	require.NoError(t, bldr.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX))
+}
+
+func TestBuildTxFromAuxSignerData(t *testing.T) {
+	kr, auxInfo, auxAddr := newKey(t)
+	feePayerInfo, err := kr.NewAccount("fee_payer", testMnemonic2, "", hd.CreateHDPath(118, 0, 0).String(), hd.Secp256k1)
+	require.NoError(t, err)
+	feePayerAddr := feePayerInfo.GetAddress()
+
+	txCfg := NewTestTxConfig()
+	msg := banktypes.NewMsgSend(auxAddr, sdk.AccAddress("to"), nil)
+
+	// the aux signer signs over the body only
+	auxBldr := tx.NewAuxTxBuilder()
+	auxBldr.SetAddress(auxAddr.String())
+	auxBldr.SetChainID("test-chain")
+	auxBldr.SetAccountNumber(1)
+	auxBldr.SetSequence(2)
+	auxBldr.SetMemo("memo")
+	require.NoError(t, auxBldr.SetMsgs(msg))
+	require.NoError(t, auxBldr.SetPubKey(auxInfo.GetPubKey()))
+	require.NoError(t, auxBldr.SetSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX))
+	signBz, err := auxBldr.GetSignBytes()
+	require.NoError(t, err)
+	sig, _, err := kr.Sign(auxInfo.GetName(), signBz)
+	require.NoError(t, err)
+	auxBldr.SetSignature(sig)
+	auxSignerData, err := auxBldr.GetAuxSignerData()
+	require.NoError(t, err)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txCfg).
+		WithKeybase(kr).
+		WithChainID("test-chain").
+		WithAccountNumber(3).
+		WithSequence(4).
+		WithFees("50stake").
+		WithGas(200000)
+
+	testCases := []struct {
+		name     string
+		txf      tx.Factory
+		feePayer sdk.AccAddress
+		data     []txtypes.AuxSignerData
+		expErr   bool
+	}{
+		{"no aux signer data", txf, feePayerAddr, nil, true},
+		{"wrong chain id", txf.WithChainID("other-chain"), feePayerAddr, []txtypes.AuxSignerData{auxSignerData}, true},
+		{"duplicate aux signer data", txf, feePayerAddr, []txtypes.AuxSignerData{auxSignerData, auxSignerData}, true},
+		{"fee payer is a message signer", txf, auxAddr, []txtypes.AuxSignerData{auxSignerData}, true},
+		{"valid", txf, feePayerAddr, []txtypes.AuxSignerData{auxSignerData}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tx.BuildTxFromAuxSignerData(tc.txf, tc.feePayer, tc.data...)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	txBuilder, err := tx.BuildTxFromAuxSignerData(txf, feePayerAddr, auxSignerData)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{auxAddr, feePayerAddr}, txBuilder.GetTx().GetSigners())
+	require.Equal(t, "memo", txBuilder.GetTx().GetMemo())
+
+	// the fee payer signs last, in DIRECT mode
+	require.NoError(t, tx.Sign(txf.WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT), feePayerInfo.GetName(), txBuilder, false))
+
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+
+	handler := txCfg.SignModeHandler()
+	auxSignerInfo := signing.SignerData{
+		Address: auxAddr.String(), ChainID: "test-chain", AccountNumber: 1, Sequence: 2, PubKey: auxInfo.GetPubKey(),
+	}
+	require.NoError(t, signing.VerifySignature(auxInfo.GetPubKey(), auxSignerInfo, sigs[0].Data, handler, txBuilder.GetTx()))
+
+	feePayerSignerInfo := signing.SignerData{
+		Address: feePayerAddr.String(), ChainID: "test-chain", AccountNumber: 3, Sequence: 4, PubKey: feePayerInfo.GetPubKey(),
+	}
+	require.NoError(t, signing.VerifySignature(feePayerInfo.GetPubKey(), feePayerSignerInfo, sigs[1].Data, handler, txBuilder.GetTx()))
+}
+
+const testMnemonic2 = "equip will roof matter pink blind book anxiety banner elbow sun young"
+
+func newKey(t *testing.T) (keyring.Keyring, keyring.Info, sdk.AccAddress) {
+	kr, err := keyring.New(t.Name(), "test", t.TempDir(), nil)
+	require.NoError(t, err)
+
+	info, _, err := kr.NewMnemonic("aux_signer", keyring.English, hd.CreateHDPath(118, 0, 0).String(), keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	return kr, info, info.GetAddress()
+}
@@ -0,0 +1,63 @@
+// Package remotesigner implements the client and the server of the remote
+// signer daemons, which sign the transactions of the services embedding the
+// SDK, such as faucets and bots, with keys held out of their process.
+package remotesigner
+
+import (
+	"context"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ tx.TxSigner = &RemoteSigner{}
+
+// RemoteSigner is a tx.TxSigner signing with a key of a remote signer daemon.
+type RemoteSigner struct {
+	client  SignerClient
+	keyName string
+	pubKey  cryptotypes.PubKey
+}
+
+// NewRemoteSigner returns a tx.TxSigner signing with the named key of the
+// remote signer daemon served on conn. The public key of the key is queried
+// once, and unpacked with registry.
+func NewRemoteSigner(ctx context.Context, conn gogogrpc.ClientConn, registry codectypes.InterfaceRegistry, keyName string) (*RemoteSigner, error) {
+	client := NewSignerClient(conn)
+
+	res, err := client.PubKey(ctx, &PubKeyRequest{KeyName: keyName})
+	if err != nil {
+		return nil, err
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := registry.UnpackAny(res.PublicKey, &pubKey); err != nil {
+		return nil, err
+	}
+
+	return &RemoteSigner{client: client, keyName: keyName, pubKey: pubKey}, nil
+}
+
+// PubKey implements tx.TxSigner.
+func (s *RemoteSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+// Sign implements tx.TxSigner. The signature is verified, for a misconfigured
+// daemon not to make the transactions fail once broadcast.
+func (s *RemoteSigner) Sign(ctx context.Context, signBytes []byte) ([]byte, error) {
+	res, err := s.client.Sign(ctx, &SignRequest{KeyName: s.keyName, SignBytes: signBytes})
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.pubKey.VerifySignature(signBytes, res.Signature) {
+		return nil, fmt.Errorf("invalid signature of the remote key %s", s.keyName)
+	}
+
+	return res.Signature, nil
+}
@@ -0,0 +1,78 @@
+package remotesigner
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+)
+
+var _ SignerServer = server{}
+
+type server struct {
+	kr keyring.Keyring
+	// keyNames are the names of the keys served, or nil to serve all the keys
+	// of the keyring.
+	keyNames map[string]bool
+}
+
+// NewServer returns the server of a remote signer daemon signing with the
+// keys of kr, restricted to keyNames if given. It signs any sign bytes, so it
+// must only be served to authenticated clients, e.g. over mutual TLS or a
+// local socket, and registered with RegisterSignerServer.
+func NewServer(kr keyring.Keyring, keyNames ...string) SignerServer {
+	s := server{kr: kr}
+	if len(keyNames) > 0 {
+		s.keyNames = make(map[string]bool, len(keyNames))
+		for _, name := range keyNames {
+			s.keyNames[name] = true
+		}
+	}
+
+	return s
+}
+
+// PubKey implements SignerServer.PubKey.
+func (s server) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	key, err := s.key(req.KeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := codectypes.NewAnyWithValue(key.GetPubKey())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &PubKeyResponse{PublicKey: pubKey}, nil
+}
+
+// Sign implements SignerServer.Sign.
+func (s server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if _, err := s.key(req.KeyName); err != nil {
+		return nil, err
+	}
+
+	sig, _, err := s.kr.Sign(req.KeyName, req.SignBytes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &SignResponse{Signature: sig}, nil
+}
+
+func (s server) key(name string) (keyring.Info, error) {
+	if s.keyNames != nil && !s.keyNames[name] {
+		return nil, status.Errorf(codes.NotFound, "key %s not served", name)
+	}
+
+	key, err := s.kr.Key(name)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return key, nil
+}
@@ -0,0 +1,102 @@
+package remotesigner_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/client/tx/remotesigner"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// tamperingServer returns signatures of other sign bytes.
+type tamperingServer struct {
+	remotesigner.SignerServer
+}
+
+func (s tamperingServer) Sign(ctx context.Context, req *remotesigner.SignRequest) (*remotesigner.SignResponse, error) {
+	req.SignBytes = append(req.SignBytes, 0)
+	return s.SignerServer.Sign(ctx, req)
+}
+
+func dial(t *testing.T, srv remotesigner.SignerServer) *grpc.ClientConn {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer()
+	remotesigner.RegisterSignerServer(grpcSrv, srv)
+	go grpcSrv.Serve(listener)
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+func TestRemoteSigner(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	kr := keyring.NewInMemory()
+	path := hd.CreateHDPath(118, 0, 0).String()
+
+	faucet, _, err := kr.NewMnemonic("faucet", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	_, _, err = kr.NewMnemonic("other", keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	srv := remotesigner.NewServer(kr, "faucet")
+	conn := dial(t, srv)
+
+	signer, err := remotesigner.NewRemoteSigner(ctx, conn, encCfg.InterfaceRegistry, "faucet")
+	require.NoError(t, err)
+	require.True(t, faucet.GetPubKey().Equals(signer.PubKey()))
+
+	// the keys not served are not found
+	_, err = remotesigner.NewRemoteSigner(ctx, conn, encCfg.InterfaceRegistry, "other")
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// the transactions are signed without a keyring in the process
+	txf := tx.Factory{}.
+		WithTxConfig(encCfg.TxConfig).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_DIRECT)
+	txb, err := tx.BuildUnsignedTx(txf, banktypes.NewMsgSend(faucet.GetAddress(), sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+	require.NoError(t, tx.SignWithTxSigner(ctx, txf, signer, txb, true))
+
+	sigs, err := txb.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	signerData := signing.SignerData{
+		Address:       faucet.GetAddress().String(),
+		ChainID:       "test-chain",
+		AccountNumber: 50,
+		Sequence:      23,
+		PubKey:        faucet.GetPubKey(),
+	}
+	require.NoError(t, signing.VerifySignature(faucet.GetPubKey(), signerData, sigs[0].Data, encCfg.TxConfig.SignModeHandler(), txb.GetTx()))
+
+	// the signatures of other sign bytes are rejected
+	signer, err = remotesigner.NewRemoteSigner(ctx, dial(t, tamperingServer{srv}), encCfg.InterfaceRegistry, "faucet")
+	require.NoError(t, err)
+	require.Error(t, tx.SignWithTxSigner(ctx, txf, signer, txb, true))
+}
@@ -0,0 +1,46 @@
+package tx
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// TxSigner signs the sign bytes of transactions with a key, e.g. a key of a
+// keyring, or a key of a remote signer daemon keeping it out of the process of
+// the services embedding the SDK, such as faucets and bots.
+type TxSigner interface {
+	// PubKey returns the public key of the signing key.
+	PubKey() cryptotypes.PubKey
+	// Sign signs the sign bytes of a transaction, returning the signature.
+	Sign(ctx context.Context, signBytes []byte) ([]byte, error)
+}
+
+var _ TxSigner = keyringSigner{}
+
+// keyringSigner is a TxSigner signing with a key of a keyring.
+type keyringSigner struct {
+	kr     keyring.Keyring
+	name   string
+	pubKey cryptotypes.PubKey
+}
+
+// NewKeyringSigner returns a TxSigner signing with the named key of kr.
+func NewKeyringSigner(kr keyring.Keyring, name string) (TxSigner, error) {
+	key, err := kr.Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyringSigner{kr: kr, name: name, pubKey: key.GetPubKey()}, nil
+}
+
+func (s keyringSigner) PubKey() cryptotypes.PubKey {
+	return s.pubKey
+}
+
+func (s keyringSigner) Sign(_ context.Context, signBytes []byte) ([]byte, error) {
+	sig, _, err := s.kr.Sign(s.name, signBytes)
+	return sig, err
+}
@@ -225,23 +225,9 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 		return nil, fmt.Errorf("chain ID required but not specified")
 	}
 
-	fees := txf.fees
-
-	if !txf.gasPrices.IsZero() {
-		if !fees.IsZero() {
-			return nil, errors.New("cannot provide both fees and gas prices")
-		}
-
-		glDec := sdk.NewDec(int64(txf.gas))
-
-		// Derive the fees based on the provided gas prices, where
-		// fee = ceil(gasPrice * gasLimit).
-		fees = make(sdk.Coins, len(txf.gasPrices))
-
-		for i, gp := range txf.gasPrices {
-			fee := gp.Amount.Mul(glDec)
-			fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-		}
+	fees, err := txf.feeAmount()
+	if err != nil {
+		return nil, err
 	}
 
 	tx := txf.txConfig.NewTxBuilder()
@@ -258,6 +244,29 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	return tx, nil
 }
 
+// feeAmount returns the fees set on the factory, or derives them from its gas
+// prices and gas limit, where fee = ceil(gasPrice * gasLimit).
+func (f Factory) feeAmount() (sdk.Coins, error) {
+	fees := f.fees
+
+	if !f.gasPrices.IsZero() {
+		if !fees.IsZero() {
+			return nil, errors.New("cannot provide both fees and gas prices")
+		}
+
+		glDec := sdk.NewDec(int64(f.gas))
+
+		fees = make(sdk.Coins, len(f.gasPrices))
+
+		for i, gp := range f.gasPrices {
+			fee := gp.Amount.Mul(glDec)
+			fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+		}
+	}
+
+	return fees, nil
+}
+
 // BuildSimTx creates an unsigned tx with an empty single signature and returns
 // the encoded transaction or an error if the unsigned transaction cannot be
 // built.
@@ -372,12 +381,31 @@ func SignWithPrivKey(
 
 func checkMultipleSigners(mode signing.SignMode, tx authsigning.Tx) error {
 	if mode == signing.SignMode_SIGN_MODE_DIRECT &&
-		len(tx.GetSigners()) > 1 {
+		len(tx.GetSigners()) > 1 && !isSignedByAuxSigners(tx) {
 		return sdkerrors.Wrap(sdkerrors.ErrNotSupported, "Signing in DIRECT mode is only supported for transactions with one signer only")
 	}
 	return nil
 }
 
+// isSignedByAuxSigners returns true if all the required signers of the tx but
+// the last one have already signed with SIGN_MODE_DIRECT_AUX. As those don't
+// sign over the signer infos, the last signer can sign in DIRECT mode.
+func isSignedByAuxSigners(tx authsigning.Tx) bool {
+	sigs, err := tx.GetSignaturesV2()
+	if err != nil || len(sigs) != len(tx.GetSigners())-1 {
+		return false
+	}
+
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Sign signs a given tx with a named key. The bytes signed over are canconical.
 // The resulting signature will be added to the transaction builder overwriting the previous
 // ones if overwrite=true (otherwise, the signature will be appended).
@@ -389,6 +417,17 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		return errors.New("keybase must be set prior to signing a transaction")
 	}
 
+	signer, err := NewKeyringSigner(txf.keybase, name)
+	if err != nil {
+		return err
+	}
+
+	return SignWithTxSigner(context.Background(), txf, signer, txBuilder, overwriteSig)
+}
+
+// SignWithTxSigner signs a given tx with signer, like Sign signs it with a
+// named key of the keyring of the factory, which is not required.
+func SignWithTxSigner(ctx context.Context, txf Factory, signer TxSigner, txBuilder client.TxBuilder, overwriteSig bool) error {
 	signMode := txf.signMode
 	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
 		// use the SignModeHandler's default mode if unspecified
@@ -398,15 +437,13 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		return err
 	}
 
-	key, err := txf.keybase.Key(name)
-	if err != nil {
-		return err
-	}
-	pubKey := key.GetPubKey()
+	pubKey := signer.PubKey()
 	signerData := authsigning.SignerData{
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
 		ChainID:       txf.chainID,
 		AccountNumber: txf.accountNumber,
 		Sequence:      txf.sequence,
+		PubKey:        pubKey,
 	}
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
@@ -426,14 +463,20 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		Data:     &sigData,
 		Sequence: txf.Sequence(),
 	}
-	var prevSignatures []signing.SignatureV2
+	var (
+		prevSignatures []signing.SignatureV2
+		err            error
+	)
 	if !overwriteSig {
 		prevSignatures, err = txBuilder.GetTx().GetSignaturesV2()
 		if err != nil {
 			return err
 		}
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+	// Previous signer infos are kept while generating the sign bytes, so that
+	// a SIGN_MODE_DIRECT signature also covers the signer infos of the aux
+	// signers which signed before.
+	if err := txBuilder.SetSignatures(append(prevSignatures, sig)...); err != nil {
 		return err
 	}
 
@@ -444,7 +487,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 	}
 
 	// Sign those bytes
-	sigBytes, _, err := txf.keybase.Sign(name, bytesToSign)
+	sigBytes, err := signer.Sign(ctx, bytesToSign)
 	if err != nil {
 		return err
 	}
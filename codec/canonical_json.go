@@ -0,0 +1,255 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ProtoMarshalCanonicalJSON returns the canonical proto3 JSON encoding of msg.
+// Fields are named after their proto names and omitted when they hold their
+// default value, Anys are rendered with their @type, and the resulting
+// document is canonicalized with CanonicalizeJSON. The encoding of a message is
+// therefore byte for byte identical across platforms and implementations,
+// which makes it suitable for sign bytes.
+func ProtoMarshalCanonicalJSON(msg proto.Message, resolver jsonpb.AnyResolver) ([]byte, error) {
+	jm := &jsonpb.Marshaler{OrigName: true, EmitDefaults: false, AnyResolver: resolver}
+	err := types.UnpackInterfaces(msg, types.ProtoJSONPacker{JSONPBMarshaler: jm})
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	if err := jm.Marshal(buf, msg); err != nil {
+		return nil, err
+	}
+
+	return CanonicalizeJSON(buf.Bytes())
+}
+
+// CanonicalizeJSON returns the canonical form of a JSON document, which is
+// defined as follows:
+//
+// - there is no insignificant whitespace
+// - object members are sorted by the bytes of their UTF-8 encoded names, and
+// duplicate names are rejected
+// - strings are written as UTF-8, only escaping quotation marks, reverse
+// solidi and control characters, using the two-character escapes \b, \f, \n,
+// \r and \t where they exist and lowercase \u00xx escapes otherwise
+// - integers are written in decimal without exponent, and negative zero is
+// written as 0
+// - other numbers are written in the shortest form that round-trips through
+// an IEEE 754 double, as in ECMAScript's Number.prototype.toString
+func CanonicalizeJSON(bz []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	buf := new(bytes.Buffer)
+	if err := canonicalizeValue(dec, buf); err != nil {
+		return nil, err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("invalid JSON: unexpected data after top-level value")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func canonicalizeValue(dec *json.Decoder, buf *bytes.Buffer) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	switch v := tok.(type) {
+	case json.Delim:
+		switch v {
+		case '{':
+			return canonicalizeObject(dec, buf)
+		case '[':
+			return canonicalizeArray(dec, buf)
+		default:
+			return fmt.Errorf("invalid JSON: unexpected %s", v)
+		}
+
+	case string:
+		writeCanonicalString(buf, v)
+
+	case json.Number:
+		s, err := canonicalNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+
+	case nil:
+		buf.WriteString("null")
+
+	default:
+		return fmt.Errorf("invalid JSON: unexpected token %v", tok)
+	}
+
+	return nil
+}
+
+type canonicalMember struct {
+	name  string
+	value []byte
+}
+
+func canonicalizeObject(dec *json.Decoder, buf *bytes.Buffer) error {
+	var members []canonicalMember
+	names := make(map[string]bool)
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+
+		name, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid JSON: expected an object member name, got %v", tok)
+		}
+
+		if names[name] {
+			return fmt.Errorf("invalid JSON: duplicate object member %q", name)
+		}
+		names[name] = true
+
+		value := new(bytes.Buffer)
+		if err := canonicalizeValue(dec, value); err != nil {
+			return err
+		}
+
+		members = append(members, canonicalMember{name: name, value: value.Bytes()})
+	}
+
+	// consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].name < members[j].name })
+
+	buf.WriteByte('{')
+	for i, m := range members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		writeCanonicalString(buf, m.name)
+		buf.WriteByte(':')
+		buf.Write(m.value)
+	}
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func canonicalizeArray(dec *json.Decoder, buf *bytes.Buffer) error {
+	buf.WriteByte('[')
+	for i := 0; dec.More(); i++ {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := canonicalizeValue(dec, buf); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	// consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return nil
+}
+
+const hexDigits = "0123456789abcdef"
+
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[r>>4])
+				buf.WriteByte(hexDigits[r&0xf])
+				continue
+			}
+
+			var b [utf8.UTFMax]byte
+			n := utf8.EncodeRune(b[:], r)
+			buf.Write(b[:n])
+		}
+	}
+	buf.WriteByte('"')
+}
+
+func canonicalNumber(n json.Number) (string, error) {
+	s := n.String()
+
+	if !strings.ContainsAny(s, ".eE") {
+		i, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return "", fmt.Errorf("invalid JSON number %s", s)
+		}
+		return i.String(), nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid JSON number %s: %w", s, err)
+	}
+
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return "", fmt.Errorf("invalid JSON number %s", s)
+	}
+
+	if f == 0 {
+		return "0", nil
+	}
+
+	if abs := math.Abs(f); abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	}
+
+	// strconv writes at least two exponent digits, e.g. 1e-07, whereas
+	// ECMAScript writes 1e-7
+	s = strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp := s[:strings.IndexByte(s, 'e')+2], strings.TrimLeft(s[strings.IndexByte(s, 'e')+2:], "0")
+
+	return mantissa + exp, nil
+}
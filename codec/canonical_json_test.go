@@ -0,0 +1,193 @@
+package codec_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const canonicalJSONVectorsFile = "testdata/canonical_json_vectors.json"
+
+var updateCanonicalJSONVectors = flag.Bool("update-canonical-json-vectors", false, "regenerate "+canonicalJSONVectorsFile)
+
+// canonicalJSONVectors are test vectors for implementations of the canonical
+// proto JSON encoding in other languages.
+type canonicalJSONVectors struct {
+	// Canonicalize holds JSON documents and their canonical form.
+	Canonicalize []canonicalizeVector `json:"canonicalize"`
+	// Proto holds binary encoded proto messages and their canonical JSON
+	// encoding.
+	Proto []protoCanonicalJSONVector `json:"proto"`
+}
+
+type canonicalizeVector struct {
+	Name   string `json:"name"`
+	Input  string `json:"input"`
+	Output string `json:"output"`
+}
+
+type protoCanonicalJSONVector struct {
+	Name          string `json:"name"`
+	TypeURL       string `json:"type_url"`
+	ProtoHex      string `json:"proto_hex"`
+	CanonicalJSON string `json:"canonical_json"`
+}
+
+func TestCanonicalizeJSON(t *testing.T) {
+	testCases := []struct {
+		name   string
+		input  string
+		output string
+		expErr bool
+	}{
+		{"sorted object members", `{"b":1,"a":{"d":[3,{"z":null,"y":true}],"c":"x"}}`, `{"a":{"c":"x","d":[3,{"y":true,"z":null}]},"b":1}`, false},
+		{"members sorted by UTF-8 bytes", `{"é":1,"z":2,"A":3,"_":4}`, `{"A":3,"_":4,"z":2,"é":1}`, false},
+		{"insignificant whitespace", " { \"a\" :\n[ 1 ,\t2 ] , \"b\" : { } , \"c\" : [ ] } ", `{"a":[1,2],"b":{},"c":[]}`, false},
+		{"string escapes", `"Aé\u2028\n\t\b\f\r\u0001\u001f\"\\\/<>&"`, "\"Aé\u2028\\n\\t\\b\\f\\r\\u0001\\u001f\\\"\\\\/<>&\"", false},
+		{"integers", `[0,-0,1,-1,123456789012345678901234567890]`, `[0,0,1,-1,123456789012345678901234567890]`, false},
+		{"non integers", `[1.0,1e2,-1.5e-7,1e21,1E-7,0.000001,2.50,0.1,-0.0,1.7976931348623157e308]`, `[1,100,-1.5e-7,1e+21,1e-7,0.000001,2.5,0.1,0,1.7976931348623157e+308]`, false},
+		{"literals", `[true,false,null]`, `[true,false,null]`, false},
+		{"duplicate object members", `{"a":1,"a":2}`, "", true},
+		{"trailing data", `{"a":1} {}`, "", true},
+		{"invalid JSON", `{"a":}`, "", true},
+		{"number overflow", `1e400`, "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := codec.CanonicalizeJSON([]byte(tc.input))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.output, string(bz))
+
+			// canonicalization is idempotent
+			bz2, err := codec.CanonicalizeJSON(bz)
+			require.NoError(t, err)
+			require.Equal(t, bz, bz2)
+		})
+	}
+}
+
+func TestProtoMarshalCanonicalJSON(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	any, err := types.NewAnyWithValue(&testdata.Dog{Size_: "big", Name: "Spot"})
+	require.NoError(t, err)
+
+	bz, err := cdc.MarshalCanonicalJSON(&testdata.HasAnimal{X: 10, Animal: any})
+	require.NoError(t, err)
+	require.Equal(t, `{"animal":{"@type":"/testdata.Dog","name":"Spot","size":"big"},"x":"10"}`, string(bz))
+
+	// default values are omitted
+	bz, err = cdc.MarshalCanonicalJSON(&testdata.Cat{})
+	require.NoError(t, err)
+	require.Equal(t, `{}`, string(bz))
+
+	// Anys of unregistered types can't be rendered
+	_, err = codec.ProtoMarshalCanonicalJSON(&testdata.HasAnimal{Animal: any}, types.NewInterfaceRegistry())
+	require.Error(t, err)
+}
+
+func TestCanonicalJSONVectors(t *testing.T) {
+	vectors := canonicalJSONVectors{}
+
+	for _, tc := range []struct{ name, input string }{
+		{"sorted object members", `{"b":1,"a":{"d":[3,{"z":null,"y":true}],"c":"x"}}`},
+		{"members sorted by UTF-8 bytes", `{"é":1,"z":2,"A":3,"_":4}`},
+		{"insignificant whitespace", " { \"a\" :\n[ 1 ,\t2 ] , \"b\" : { } } "},
+		{"string escapes", `"Aé\u2028\n\t\b\f\r\u0001\u001f\"\\\/<>&"`},
+		{"numbers", `[0,-0,1.0,1e2,-1.5e-7,1e21,1E-7,0.000001,2.50,123456789012345678901234567890]`},
+	} {
+		output, err := codec.CanonicalizeJSON([]byte(tc.input))
+		require.NoError(t, err)
+		vectors.Canonicalize = append(vectors.Canonicalize, canonicalizeVector{Name: tc.name, Input: tc.input, Output: string(output)})
+	}
+
+	registry := types.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	testdata.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+
+	dog, err := types.NewAnyWithValue(&testdata.Dog{Size_: "big", Name: "Spot"})
+	require.NoError(t, err)
+	hasAnimal, err := types.NewAnyWithValue(&testdata.HasAnimal{Animal: dog, X: -3})
+	require.NoError(t, err)
+	msgSend, err := types.NewAnyWithValue(banktypes.NewMsgSend(
+		sdk.AccAddress("from________________"), sdk.AccAddress("to__________________"),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 1)),
+	))
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name    string
+		typeURL string
+		msg     proto.Message
+	}{
+		{"empty message", "/testdata.Cat", &testdata.Cat{}},
+		{"32-bit integers are numbers", "/testdata.Cat", &testdata.Cat{Moniker: "Garfield", Lives: 9}},
+		{"64-bit integers are strings", "/testdata.HasAnimal", &testdata.HasAnimal{X: 1 << 62}},
+		{"nested Anys", "/testdata.HasHasAnimal", &testdata.HasHasAnimal{HasAnimal: hasAnimal}},
+		{"tx body", "/cosmos.tx.v1beta1.TxBody", &tx.TxBody{
+			Messages:      []*types.Any{msgSend},
+			Memo:          "\"<memo>\" é\n",
+			TimeoutHeight: 100,
+		}},
+	} {
+		protoBz, err := proto.Marshal(tc.msg)
+		require.NoError(t, err)
+
+		canonicalJSON, err := codec.ProtoMarshalCanonicalJSON(tc.msg, registry)
+		require.NoError(t, err)
+
+		vectors.Proto = append(vectors.Proto, protoCanonicalJSONVector{
+			Name:          tc.name,
+			TypeURL:       tc.typeURL,
+			ProtoHex:      hex.EncodeToString(protoBz),
+			CanonicalJSON: string(canonicalJSON),
+		})
+
+		// the binary encoding decodes to a message with the same encoding
+		decoded := reflect.New(reflect.TypeOf(tc.msg).Elem()).Interface().(proto.Message)
+		require.NoError(t, proto.Unmarshal(protoBz, decoded))
+		require.NoError(t, types.UnpackInterfaces(decoded, registry))
+		decodedJSON, err := codec.ProtoMarshalCanonicalJSON(decoded, registry)
+		require.NoError(t, err)
+		require.Equal(t, canonicalJSON, decodedJSON)
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	require.NoError(t, enc.Encode(vectors))
+	bz := buf.Bytes()
+
+	if *updateCanonicalJSONVectors {
+		require.NoError(t, ioutil.WriteFile(canonicalJSONVectorsFile, bz, 0644))
+	}
+
+	expected, err := ioutil.ReadFile(canonicalJSONVectorsFile)
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(bz), "run go test ./codec -run TestCanonicalJSONVectors -update-canonical-json-vectors to regenerate the vectors")
+}
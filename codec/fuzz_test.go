@@ -0,0 +1,184 @@
+//go:build go1.18
+// +build go1.18
+
+package codec_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	proposaltypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// The fuzz targets below exercise the decoding of untrusted inputs, and fail
+// if any of them panics. Their inputs are seeded with valid encodings, and the
+// inputs which once made them fail are kept in testdata/fuzz. Run them all with
+// make test-fuzz, or a single one with e.g.:
+//
+//   go test ./codec -run '^$' -fuzz '^FuzzTxDecode$' -fuzztime 1m
+
+var fuzzAddr = sdk.AccAddress("fuzz________________")
+
+func FuzzTxDecode(f *testing.F) {
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	txBuilder := encCfg.TxConfig.NewTxBuilder()
+	err := txBuilder.SetMsgs(
+		banktypes.NewMsgSend(fuzzAddr, fuzzAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		&authz.MsgRevoke{Granter: fuzzAddr.String(), Grantee: fuzzAddr.String(), MsgTypeUrl: sdk.MsgTypeURL(&banktypes.MsgSend{})},
+	)
+	if err != nil {
+		f.Fatal(err)
+	}
+	txBuilder.SetMemo("memo")
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetTimeoutHeight(100)
+
+	txBz, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	f.Add(txBz)
+	f.Add([]byte{})
+
+	decoder := encCfg.TxConfig.TxDecoder()
+	jsonEncoder := encCfg.TxConfig.TxJSONEncoder()
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		tx, err := decoder(bz)
+		if err != nil {
+			return
+		}
+
+		_ = tx.ValidateBasic()
+		_, _ = jsonEncoder(tx)
+	})
+}
+
+func FuzzUnpackAuthorization(f *testing.F) {
+	fuzzUnpackAny(f, func(unpacker types.AnyUnpacker, any *types.Any) {
+		var authorization authz.Authorization
+		if err := unpacker.UnpackAny(any, &authorization); err != nil || authorization == nil {
+			return
+		}
+
+		_ = authorization.ValidateBasic()
+	},
+		authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})),
+		banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		&stakingtypes.StakeAuthorization{
+			MaxTokens:         &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(10)},
+			Validators:        &stakingtypes.StakeAuthorization_AllowList{AllowList: &stakingtypes.StakeAuthorization_Validators{Address: []string{sdk.ValAddress(fuzzAddr).String()}}},
+			AuthorizationType: stakingtypes.AuthorizationType_AUTHORIZATION_TYPE_DELEGATE,
+		},
+	)
+}
+
+func FuzzUnpackAccount(f *testing.F) {
+	baseAcc := authtypes.NewBaseAccountWithAddress(fuzzAddr)
+
+	fuzzUnpackAny(f, func(unpacker types.AnyUnpacker, any *types.Any) {
+		var acc authtypes.AccountI
+		_ = unpacker.UnpackAny(any, &acc)
+	},
+		baseAcc,
+		authtypes.NewEmptyModuleAccount("fuzz", authtypes.Minter),
+		vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 1, 2),
+		vestingtypes.NewPeriodicVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), 1, vestingtypes.Periods{
+			{Length: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		}),
+	)
+}
+
+func FuzzUnpackProposalContent(f *testing.F) {
+	fuzzUnpackAny(f, func(unpacker types.AnyUnpacker, any *types.Any) {
+		var content govtypes.Content
+		if err := unpacker.UnpackAny(any, &content); err != nil || content == nil {
+			return
+		}
+
+		_ = content.ValidateBasic()
+		_ = content.String()
+	},
+		&govtypes.TextProposal{Title: "title", Description: "description"},
+		distrtypes.NewCommunityPoolSpendProposal("title", "description", fuzzAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		proposaltypes.NewParameterChangeProposal("title", "description", []proposaltypes.ParamChange{
+			{Subspace: "staking", Key: "MaxValidators", Value: "1"},
+		}),
+		&upgradetypes.SoftwareUpgradeProposal{Title: "title", Description: "description", Plan: upgradetypes.Plan{Name: "upgrade", Height: 100}},
+	)
+}
+
+// fuzzUnpackAny fuzzes unpack with Anys seeded with the given implementations.
+// The Anys which were successfully unpacked are then encoded to JSON.
+func fuzzUnpackAny(f *testing.F, unpack func(unpacker types.AnyUnpacker, any *types.Any), seeds ...proto.Message) {
+	encCfg := simapp.MakeTestEncodingConfig()
+
+	for _, seed := range seeds {
+		any, err := types.NewAnyWithValue(seed)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(any.TypeUrl, any.Value)
+	}
+
+	f.Fuzz(func(t *testing.T, typeURL string, value []byte) {
+		any := &types.Any{TypeUrl: typeURL, Value: value}
+		unpack(encCfg.InterfaceRegistry, any)
+
+		if any.GetCachedValue() != nil {
+			_, _ = encCfg.Marshaler.MarshalJSON(any)
+		}
+	})
+}
+
+func FuzzBech32(f *testing.F) {
+	f.Add(fuzzAddr.String())
+	f.Add(sdk.ValAddress(fuzzAddr).String())
+	f.Add(sdk.ConsAddress(fuzzAddr).String())
+	f.Add("cosmos1")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = sdk.AccAddressFromBech32(s)
+		_, _ = sdk.ValAddressFromBech32(s)
+		_, _ = sdk.ConsAddressFromBech32(s)
+
+		hrp, bz, err := bech32.DecodeAndConvert(s)
+		if err != nil {
+			return
+		}
+
+		// decoding is the inverse of encoding
+		encoded, err := bech32.ConvertAndEncode(hrp, bz)
+		if err != nil {
+			return
+		}
+
+		_, decoded, err := bech32.DecodeAndConvert(encoded)
+		if err != nil {
+			t.Fatalf("cannot decode %s, the encoding of %s: %v", encoded, s, err)
+		}
+
+		if !bytes.Equal(bz, decoded) {
+			t.Fatalf("%s decodes to %X, its re-encoding %s to %X", s, bz, encoded, decoded)
+		}
+	})
+}
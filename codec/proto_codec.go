@@ -135,6 +135,12 @@ func (pc *ProtoCodec) MarshalJSON(o proto.Message) ([]byte, error) {
 	return ProtoMarshalJSON(m, pc.interfaceRegistry)
 }
 
+// MarshalCanonicalJSON returns the canonical proto3 JSON encoding of a
+// message, as defined by ProtoMarshalCanonicalJSON.
+func (pc *ProtoCodec) MarshalCanonicalJSON(o proto.Message) ([]byte, error) {
+	return ProtoMarshalCanonicalJSON(o, pc.interfaceRegistry)
+}
+
 // MustMarshalJSON implements JSONCodec.MustMarshalJSON method,
 // it executes MarshalJSON except it panics upon failure.
 // NOTE: this function must be used with a concrete type which
@@ -184,10 +190,11 @@ func (pc *ProtoCodec) MarshalInterface(i proto.Message) ([]byte, error) {
 	if err := assertNotNil(i); err != nil {
 		return nil, err
 	}
-	any, err := types.NewAnyWithValue(i)
+	any, err := types.AcquireAnyWithValue(i)
 	if err != nil {
 		return nil, err
 	}
+	defer types.ReleaseAny(any)
 
 	return pc.Marshal(any)
 }
@@ -214,10 +221,12 @@ func (pc *ProtoCodec) UnmarshalInterface(bz []byte, ptr interface{}) error {
 // packs the provided value in an Any and then marshals it to bytes.
 // NOTE: to marshal a concrete type, you should use MarshalJSON instead
 func (pc *ProtoCodec) MarshalInterfaceJSON(x proto.Message) ([]byte, error) {
-	any, err := types.NewAnyWithValue(x)
+	any, err := types.AcquireAnyWithValue(x)
 	if err != nil {
 		return nil, err
 	}
+	defer types.ReleaseAny(any)
+
 	return pc.MarshalJSON(any)
 }
 
@@ -131,3 +131,22 @@ func BenchmarkProtoCodecMarshalLengthPrefixed(b *testing.B) {
 		b.SetBytes(int64(len(blob)))
 	}
 }
+
+func BenchmarkProtoCodecMarshalInterface(b *testing.B) {
+	var pCdc = codec.NewProtoCodec(testdata.NewTestInterfaceRegistry())
+	var animal testdata.Animal = &testdata.Cat{
+		Moniker: "Garfield",
+		Lives:   6,
+	}
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		blob, err := pCdc.MarshalInterface(animal)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.SetBytes(int64(len(blob)))
+	}
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -77,6 +78,38 @@ func NewAnyWithValue(v proto.Message) (*Any, error) {
 	}, nil
 }
 
+var anyPool = sync.Pool{
+	New: func() interface{} { return new(Any) },
+}
+
+// AcquireAnyWithValue works like NewAnyWithValue, except that the returned Any is
+// taken from a pool. It is meant for short-lived Any values, which must be handed
+// back to the pool with ReleaseAny once they are no longer referenced.
+func AcquireAnyWithValue(v proto.Message) (*Any, error) {
+	if v == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrPackAny, "Expecting non nil value to create a new Any")
+	}
+
+	bz, err := proto.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	any := anyPool.Get().(*Any)
+	any.TypeUrl = "/" + proto.MessageName(v)
+	any.Value = bz
+	any.cachedValue = v
+
+	return any, nil
+}
+
+// ReleaseAny resets an Any acquired with AcquireAnyWithValue and returns it to
+// the pool. The Any must not be used after it is released.
+func ReleaseAny(any *Any) {
+	*any = Any{}
+	anyPool.Put(any)
+}
+
 // UnsafePackAny packs the value x in the Any and instead of returning the error
 // in the case of a packing failure, keeps the cached value. This should only
 // be used in situations where compatibility is needed with amino. Amino-only
@@ -64,3 +64,62 @@ func BenchmarkNewAnyWithCustomTypeURLWithErrorReturned(b *testing.B) {
 	}
 	sink = (interface{})(nil)
 }
+
+func TestAcquireAnyWithValue(t *testing.T) {
+	spot := &testdata.Dog{Name: "Spot"}
+
+	any, err := types.AcquireAnyWithValue(spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := types.NewAnyWithValue(spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if any.TypeUrl != expected.TypeUrl || string(any.Value) != string(expected.Value) || any.GetCachedValue() != spot {
+		t.Fatalf("Unexpected Any value: %v, expected: %v", any, expected)
+	}
+
+	types.ReleaseAny(any)
+	if any.TypeUrl != "" || any.Value != nil || any.GetCachedValue() != nil {
+		t.Fatalf("Any was not reset on release: %v", any)
+	}
+
+	if _, err := types.AcquireAnyWithValue(eom); err == nil {
+		t.Fatal("err wasn't returned")
+	}
+}
+
+func BenchmarkNewAnyWithValue(b *testing.B) {
+	spot := &testdata.Dog{Name: "Spot"}
+
+	b.Run("new", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			any, err := types.NewAnyWithValue(spot)
+			if err != nil {
+				b.Fatal(err)
+			}
+			sink = any
+		}
+	})
+
+	b.Run("pooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			any, err := types.AcquireAnyWithValue(spot)
+			if err != nil {
+				b.Fatal(err)
+			}
+			sink = any.Value
+			types.ReleaseAny(any)
+		}
+	})
+
+	if sink == nil {
+		b.Fatal("benchmark didn't run")
+	}
+	sink = (interface{})(nil)
+}
@@ -82,6 +82,7 @@ type interfaceRegistry struct {
 	interfaceNames map[string]reflect.Type
 	interfaceImpls map[reflect.Type]interfaceMap
 	typeURLMap     map[string]reflect.Type
+	unpackCache    *unpackCache
 }
 
 type interfaceMap = map[string]reflect.Type
@@ -95,6 +96,25 @@ func NewInterfaceRegistry() InterfaceRegistry {
 	}
 }
 
+// NewInterfaceRegistryWithUnpackCache returns a new InterfaceRegistry which keeps
+// up to size of the messages it unpacks in an LRU cache, keyed by the type URL
+// and the hash of the value of their Any. Unpacking an Any which was already
+// unpacked then doesn't unmarshal its value again.
+//
+// NOTE: cached messages are shared between all the Any values with the same
+// type URL and value, so they must not be modified after being unpacked.
+func NewInterfaceRegistryWithUnpackCache(size int) InterfaceRegistry {
+	cache, err := newUnpackCache(size)
+	if err != nil {
+		panic(err)
+	}
+
+	registry := NewInterfaceRegistry().(*interfaceRegistry)
+	registry.unpackCache = cache
+
+	return registry
+}
+
 func (registry *interfaceRegistry) RegisterInterface(protoName string, iface interface{}, impls ...proto.Message) {
 	typ := reflect.TypeOf(iface)
 	if typ.Elem().Kind() != reflect.Interface {
@@ -229,6 +249,16 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 		return fmt.Errorf("no concrete type registered for type URL %s against interface %T", any.TypeUrl, iface)
 	}
 
+	var cacheKey unpackCacheKey
+	if registry.unpackCache != nil {
+		cacheKey = newUnpackCacheKey(any)
+		if msg, ok := registry.unpackCache.get(cacheKey); ok {
+			rv.Elem().Set(reflect.ValueOf(msg))
+			any.cachedValue = msg
+			return nil
+		}
+	}
+
 	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
 	if !ok {
 		return fmt.Errorf("can't proto unmarshal %T", msg)
@@ -248,6 +278,10 @@ func (registry *interfaceRegistry) UnpackAny(any *Any, iface interface{}) error
 
 	any.cachedValue = msg
 
+	if registry.unpackCache != nil {
+		registry.unpackCache.add(cacheKey, msg)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+// DefaultUnpackCacheSize is the default number of unpacked messages kept by an
+// InterfaceRegistry created with NewInterfaceRegistryWithUnpackCache.
+const DefaultUnpackCacheSize = 10000
+
+// unpackCacheKey identifies an Any by its type URL and the hash of its value.
+type unpackCacheKey struct {
+	typeURL string
+	hash    [sha256.Size]byte
+}
+
+func newUnpackCacheKey(any *Any) unpackCacheKey {
+	return unpackCacheKey{
+		typeURL: any.TypeUrl,
+		hash:    sha256.Sum256(any.Value),
+	}
+}
+
+// unpackCache is a bounded LRU cache of the messages unpacked from Any values.
+// It is safe for concurrent use.
+type unpackCache struct {
+	mtx sync.Mutex
+	lru *simplelru.LRU
+}
+
+func newUnpackCache(size int) (*unpackCache, error) {
+	lru, err := simplelru.NewLRU(size, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unpackCache{lru: lru}, nil
+}
+
+func (c *unpackCache) get(key unpackCacheKey) (proto.Message, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	msg, ok := c.lru.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	return msg.(proto.Message), true
+}
+
+func (c *unpackCache) add(key unpackCacheKey, msg proto.Message) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.lru.Add(key, msg)
+}
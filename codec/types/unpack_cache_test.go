@@ -0,0 +1,100 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func TestInterfaceRegistryWithUnpackCache(t *testing.T) {
+	registry := types.NewInterfaceRegistryWithUnpackCache(1)
+	testdata.RegisterInterfaces(registry)
+
+	spotAny, err := types.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	require.NoError(t, err)
+	garfieldAny, err := types.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield"})
+	require.NoError(t, err)
+
+	var animal testdata.Animal
+	require.NoError(t, registry.UnpackAny(&types.Any{TypeUrl: spotAny.TypeUrl, Value: spotAny.Value}, &animal))
+	spot := animal
+
+	// an Any with the same type URL and value is unpacked to the cached message
+	var animal2 testdata.Animal
+	require.NoError(t, registry.UnpackAny(&types.Any{TypeUrl: spotAny.TypeUrl, Value: spotAny.Value}, &animal2))
+	require.Equal(t, &testdata.Dog{Name: "Spot"}, animal2)
+	require.Same(t, spot, animal2)
+
+	// the cached message is evicted by a new one
+	var animal3 testdata.Animal
+	require.NoError(t, registry.UnpackAny(&types.Any{TypeUrl: garfieldAny.TypeUrl, Value: garfieldAny.Value}, &animal3))
+	require.Equal(t, &testdata.Cat{Moniker: "Garfield"}, animal3)
+
+	var animal4 testdata.Animal
+	require.NoError(t, registry.UnpackAny(&types.Any{TypeUrl: spotAny.TypeUrl, Value: spotAny.Value}, &animal4))
+	require.Equal(t, spot, animal4)
+	require.NotSame(t, spot, animal4)
+
+	// the cache doesn't bypass the registered implementations
+	var hasAnimal testdata.HasAnimalI
+	require.Error(t, registry.UnpackAny(&types.Any{TypeUrl: spotAny.TypeUrl, Value: spotAny.Value}, &hasAnimal))
+
+	require.Panics(t, func() { types.NewInterfaceRegistryWithUnpackCache(0) })
+}
+
+func BenchmarkUnpackAny(b *testing.B) {
+	any := mustNestedAny(b)
+
+	benchmarks := []struct {
+		name     string
+		registry types.InterfaceRegistry
+	}{
+		{"no cache", types.NewInterfaceRegistry()},
+		{"with cache", types.NewInterfaceRegistryWithUnpackCache(types.DefaultUnpackCacheSize)},
+	}
+
+	for _, bm := range benchmarks {
+		testdata.RegisterInterfaces(bm.registry)
+
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				// a freshly decoded Any doesn't have a cached value yet
+				var hasHasAnimal testdata.HasHasAnimalI
+				if err := bm.registry.UnpackAny(&types.Any{TypeUrl: any.TypeUrl, Value: any.Value}, &hasHasAnimal); err != nil {
+					b.Fatal(err)
+				}
+				sink = hasHasAnimal
+			}
+		})
+	}
+
+	if sink == nil {
+		b.Fatal("benchmark didn't run")
+	}
+	sink = (interface{})(nil)
+}
+
+func mustNestedAny(b *testing.B) *types.Any {
+	any, err := types.NewAnyWithValue(&testdata.Cat{Moniker: "Garfield", Lives: 6})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	any, err = types.NewAnyWithValue(&testdata.HasAnimal{X: 1000, Animal: any})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	any, err = types.NewAnyWithValue(&testdata.HasHasAnimal{HasAnimal: any})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return any
+}
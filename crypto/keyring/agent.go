@@ -0,0 +1,240 @@
+package keyring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// agentSocketName is the name of the unix socket of the agent caching the
+	// passphrase of a file keyring, in the directory of the keyring.
+	agentSocketName = "agent.sock"
+
+	// agentTimeout bounds the exchanges with the agent, which are local.
+	agentTimeout = time.Second
+
+	agentCommandPassphrase = "passphrase"
+	agentCommandLock       = "lock"
+)
+
+// agentRequest is a request to the agent, sent as JSON on its socket.
+type agentRequest struct {
+	Command string `json:"command"`
+}
+
+// agentResponse is the response of the agent to an agentRequest.
+type agentResponse struct {
+	Passphrase string `json:"passphrase,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Agent caches the passphrase of a file keyring in locked memory, which is not
+// swapped to disk, and serves it on a unix socket in the directory of the
+// keyring until it expires or the agent is locked. The file keyring is then
+// unlocked with the passphrase of the agent instead of prompting it.
+type Agent struct {
+	listener net.Listener
+	timer    *time.Timer
+
+	mtx        sync.Mutex
+	passphrase []byte
+	closed     bool
+}
+
+// UnlockFile prompts the passphrase of the file keyring kr, checks it and
+// returns it, e.g. to start an Agent.
+func UnlockFile(kr Keyring) (string, error) {
+	ks, ok := kr.(keystore)
+	if !ok {
+		return "", errors.New("the keyring agent only supports the file backend")
+	}
+	fk, ok := ks.db.(*fileKeyring)
+	if !ok {
+		return "", errors.New("the keyring agent only supports the file backend")
+	}
+
+	if err := fk.unlock(); err != nil {
+		return "", err
+	}
+
+	return fk.password, nil
+}
+
+// NewAgent returns an Agent caching the passphrase of the file keyring of
+// rootDir for ttl. It listens on the socket of the keyring, and fails if
+// another agent already does.
+func NewAgent(rootDir, passphrase string, ttl time.Duration) (*Agent, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("invalid keyring agent ttl %s", ttl)
+	}
+
+	buf := make([]byte, len(passphrase))
+	if err := lockMemory(buf); err != nil {
+		return nil, fmt.Errorf("failed to lock the memory of the passphrase: %w", err)
+	}
+	copy(buf, passphrase)
+
+	listener, err := listenAgent(filepath.Join(rootDir, keyringFileDirName))
+	if err != nil {
+		zero(buf)
+		return nil, err
+	}
+
+	a := &Agent{listener: listener, passphrase: buf}
+	a.timer = time.AfterFunc(ttl, func() { a.Close() })
+
+	return a, nil
+}
+
+// Serve serves the passphrase until the agent expires or is closed.
+func (a *Agent) Serve() error {
+	for {
+		conn, err := a.listener.Accept()
+		if err != nil {
+			a.mtx.Lock()
+			closed := a.closed
+			a.mtx.Unlock()
+			if closed {
+				return nil
+			}
+			return err
+		}
+
+		go a.handle(conn)
+	}
+}
+
+// Close discards the passphrase and stops the agent, removing its socket.
+func (a *Agent) Close() error {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	if a.closed {
+		return nil
+	}
+	a.closed = true
+	a.timer.Stop()
+	zero(a.passphrase)
+
+	return a.listener.Close()
+}
+
+func (a *Agent) handle(conn net.Conn) {
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(agentTimeout)); err != nil {
+		return
+	}
+
+	var (
+		req  agentRequest
+		resp agentResponse
+	)
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		return
+	}
+
+	switch req.Command {
+	case agentCommandPassphrase:
+		a.mtx.Lock()
+		if a.closed {
+			resp.Error = "keyring agent locked"
+		} else {
+			resp.Passphrase = string(a.passphrase)
+		}
+		a.mtx.Unlock()
+
+	case agentCommandLock:
+		if err := a.Close(); err != nil {
+			resp.Error = err.Error()
+		}
+
+	default:
+		resp.Error = fmt.Sprintf("unknown keyring agent command %q", req.Command)
+	}
+
+	_ = json.NewEncoder(conn).Encode(resp)
+}
+
+// LockAgent stops the agent of the file keyring of rootDir, discarding the
+// passphrase it caches.
+func LockAgent(rootDir string) error {
+	_, err := callAgent(filepath.Join(rootDir, keyringFileDirName), agentCommandLock)
+	return err
+}
+
+// agentPassphrase returns the passphrase cached by the agent of the file
+// keyring of dir, if it runs.
+func agentPassphrase(dir string) (string, bool) {
+	resp, err := callAgent(dir, agentCommandPassphrase)
+	if err != nil {
+		return "", false
+	}
+
+	return resp.Passphrase, true
+}
+
+func callAgent(dir, command string) (agentResponse, error) {
+	conn, err := net.DialTimeout("unix", filepath.Join(dir, agentSocketName), agentTimeout)
+	if err != nil {
+		return agentResponse{}, fmt.Errorf("no keyring agent running: %w", err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(agentTimeout)); err != nil {
+		return agentResponse{}, err
+	}
+	if err := json.NewEncoder(conn).Encode(agentRequest{Command: command}); err != nil {
+		return agentResponse{}, err
+	}
+
+	var resp agentResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return agentResponse{}, err
+	}
+	if resp.Error != "" {
+		return agentResponse{}, errors.New(resp.Error)
+	}
+
+	return resp, nil
+}
+
+// listenAgent listens on the socket of the agent of the file keyring of dir,
+// replacing the socket of an agent which did not exit cleanly.
+func listenAgent(dir string) (net.Listener, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, agentSocketName)
+	if conn, err := net.DialTimeout("unix", path, agentTimeout); err == nil {
+		conn.Close()
+		return nil, errors.New("a keyring agent is already running")
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+func zero(bz []byte) {
+	for i := range bz {
+		bz[i] = 0
+	}
+}
@@ -0,0 +1,78 @@
+package keyring
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAgent(t *testing.T) {
+	dir := t.TempDir()
+
+	kr, err := New("cosmos", BackendFile, dir, strings.NewReader("password\npassword\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	_, err = UnlockFile(NewInMemory())
+	require.EqualError(t, err, "the keyring agent only supports the file backend")
+
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	passphrase, err := UnlockFile(kr)
+	require.NoError(t, err)
+	require.Equal(t, "password", passphrase)
+
+	agent, err := NewAgent(dir, passphrase, time.Minute)
+	require.NoError(t, err)
+	served := make(chan error)
+	go func() { served <- agent.Serve() }()
+
+	_, err = NewAgent(dir, passphrase, time.Minute)
+	require.EqualError(t, err, "a keyring agent is already running")
+
+	// the keyring is unlocked by the agent, without prompting the passphrase
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader(""), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, info.GetPubKey(), infos[0].GetPubKey())
+
+	require.NoError(t, LockAgent(dir))
+	require.NoError(t, <-served)
+	require.NoFileExists(t, filepath.Join(dir, keyringFileDirName, agentSocketName))
+	require.Error(t, LockAgent(dir))
+
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader(""), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.EqualError(t, err, "too many failed passphrase attempts")
+}
+
+func TestAgentTTL(t *testing.T) {
+	dir := t.TempDir()
+
+	agent, err := NewAgent(dir, "password", 100*time.Millisecond)
+	require.NoError(t, err)
+	served := make(chan error)
+	go func() { served <- agent.Serve() }()
+	pass, ok := agentPassphrase(filepath.Join(dir, keyringFileDirName))
+	require.True(t, ok)
+	require.Equal(t, "password", pass)
+
+	// the passphrase is discarded once the agent expires
+	require.NoError(t, <-served)
+	_, ok = agentPassphrase(filepath.Join(dir, keyringFileDirName))
+	require.False(t, ok)
+	require.Equal(t, make([]byte, len("password")), agent.passphrase)
+
+	_, err = NewAgent(dir, "password", 0)
+	require.EqualError(t, err, "invalid keyring agent ttl 0s")
+}
@@ -0,0 +1,11 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package keyring
+
+import "golang.org/x/sys/unix"
+
+// lockMemory prevents the memory of bz from being swapped to disk.
+func lockMemory(bz []byte) error {
+	return unix.Mlock(bz)
+}
@@ -0,0 +1,108 @@
+package keyring
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuditEntry is a single record of the keyring audit log. One entry is written
+// for every signing operation performed by the keyring.
+type AuditEntry struct {
+	// Timestamp is the UTC time at which the signature was produced.
+	Timestamp time.Time `json:"timestamp"`
+	// KeyName is the name under which the signing key is stored.
+	KeyName string `json:"key_name"`
+	// Address is the bech32 encoded account address of the signing key.
+	Address string `json:"address"`
+	// SignBytesDigest is the hex encoded SHA-256 digest of the signed bytes.
+	SignBytesDigest string `json:"sign_bytes_digest"`
+	// Command is the command line of the process that requested the signature.
+	Command string `json:"command"`
+}
+
+// WithAuditLog enables the append-only audit log at the given path. When set,
+// every successful signing operation is recorded as a JSON line. An empty path
+// disables the audit log.
+func WithAuditLog(path string) Option {
+	return func(options *Options) {
+		options.AuditLogPath = path
+	}
+}
+
+// ReadAuditLog parses the audit log at the given path and returns its entries
+// in the order they were written.
+func ReadAuditLog(path string) ([]AuditEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []AuditEntry
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var entry AuditEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("failed to parse audit log entry %d: %w", len(entries)+1, err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+// newAuditEntry builds the audit record for a signature over msg produced by
+// the key described by info.
+func newAuditEntry(info Info, msg []byte) AuditEntry {
+	digest := sha256.Sum256(msg)
+
+	return AuditEntry{
+		Timestamp:       time.Now().UTC(),
+		KeyName:         info.GetName(),
+		Address:         sdk.AccAddress(info.GetAddress()).String(),
+		SignBytesDigest: hex.EncodeToString(digest[:]),
+		Command:         strings.Join(os.Args, " "),
+	}
+}
+
+// appendAuditEntry appends entry to the audit log at path. The file is opened
+// in append-only mode for every write so that concurrent processes sharing the
+// same log never overwrite each other's records.
+func appendAuditEntry(path string, entry AuditEntry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return f.Close()
+}
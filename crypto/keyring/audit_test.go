@@ -0,0 +1,57 @@
+package keyring
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAuditLog(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "audit", "signing.log")
+	kr, err := New("keybasename", BackendTest, t.TempDir(), nil, WithAuditLog(logPath))
+	require.NoError(t, err)
+
+	info, _, err := kr.NewMnemonic("alice", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// no file is created before anything is signed
+	_, err = os.Stat(logPath)
+	require.True(t, os.IsNotExist(err))
+
+	msgs := [][]byte{[]byte("first"), []byte("second")}
+	_, _, err = kr.Sign("alice", msgs[0])
+	require.NoError(t, err)
+	_, _, err = kr.SignByAddress(info.GetAddress(), msgs[1])
+	require.NoError(t, err)
+
+	// failed signatures are not recorded
+	_, err = kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("offline", msgs[0])
+	require.Error(t, err)
+
+	entries, err := ReadAuditLog(logPath)
+	require.NoError(t, err)
+	require.Len(t, entries, len(msgs))
+
+	for i, entry := range entries {
+		digest := sha256.Sum256(msgs[i])
+		require.Equal(t, "alice", entry.KeyName)
+		require.Equal(t, info.GetAddress().String(), entry.Address)
+		require.Equal(t, hex.EncodeToString(digest[:]), entry.SignBytesDigest)
+		require.NotEmpty(t, entry.Command)
+		require.False(t, entry.Timestamp.IsZero())
+	}
+
+	fi, err := os.Stat(logPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+}
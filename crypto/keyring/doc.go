@@ -32,9 +32,11 @@
 // 			that the keyring keyring may be kept unlocked for the whole duration of the user
 // 			session.
 // 	file	This backend more closely resembles the previous keyring storage used prior to
-// 			v0.38.1. It stores the keyring encrypted within the app's configuration directory.
-// 			This keyring will request a password each time it is accessed, which may occur
-// 			multiple times in a single command resulting in repeated password prompts.
+// 			v0.38.1. It stores the keyring encrypted within the app's configuration directory,
+// 			with a key derived from the password with argon2id, whose parameters are set with
+// 			WithArgon2Params. This keyring will request the password once, when it is first
+// 			accessed, and re-encrypt then the entries encrypted with other parameters, e.g.
+// 			the ones of previous versions.
 // 	kwallet	This backend uses KDE Wallet Manager as a credentials management application:
 // 			https://github.com/KDE/kwallet
 // 	pass	This backend uses the pass command line utility to store and retrieve keys:
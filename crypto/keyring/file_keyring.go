@@ -0,0 +1,537 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/99designs/keyring"
+	"github.com/mtibben/percent"
+	"github.com/tendermint/crypto/bcrypt"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const (
+	// fileKDFName is the name of the file storing the argon2id parameters and
+	// salt of the key encrypting the entries of the file backend.
+	fileKDFName = "keyring-kdf.json"
+	// keyhashFileName is the name of the file storing the bcrypt hash of the
+	// passphrase of the legacy file backend.
+	keyhashFileName = "keyhash"
+
+	argon2KeyLen  = chacha20poly1305.KeySize
+	argon2SaltLen = 16
+)
+
+// Argon2Params are the argon2id parameters deriving the key encrypting the
+// entries of the file backend from the keyring passphrase, see RFC 9106.
+type Argon2Params struct {
+	// Time is the number of passes over the memory.
+	Time uint32 `json:"time"`
+	// Memory is the size of the memory, in KiB.
+	Memory uint32 `json:"memory"`
+	// Threads is the number of lanes of the memory, processed in parallel.
+	Threads uint8 `json:"threads"`
+}
+
+// DefaultArgon2Params returns the argon2id parameters recommended by RFC 9106
+// for the memory constrained environments: 3 passes over 64 MiB with 4 lanes.
+func DefaultArgon2Params() Argon2Params {
+	return Argon2Params{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+}
+
+// Validate checks that the argon2id parameters are valid.
+func (p Argon2Params) Validate() error {
+	if p.Time == 0 {
+		return errors.New("argon2id time must be positive")
+	}
+	if p.Threads == 0 {
+		return errors.New("argon2id threads must be positive")
+	}
+	if p.Memory < 8*uint32(p.Threads) {
+		return fmt.Errorf("argon2id memory must be at least 8 KiB per thread, got %d KiB for %d threads", p.Memory, p.Threads)
+	}
+
+	return nil
+}
+
+// WithArgon2Params sets the argon2id parameters deriving the key encrypting the
+// entries of the file backend. The entries encrypted with other parameters are
+// re-encrypted when the keyring is unlocked.
+func WithArgon2Params(params Argon2Params) Option {
+	return func(options *Options) {
+		options.Argon2Params = params
+	}
+}
+
+// fileKDF is the content of the fileKDFName file, with the parameters and salt
+// of the key encrypting the entries of the file backend.
+type fileKDF struct {
+	Params Argon2Params `json:"params"`
+	Salt   []byte       `json:"salt"`
+	// Check is the empty plaintext sealed with the key, which checks the
+	// passphrase of the keyring.
+	Check []byte `json:"check"`
+}
+
+// fileEntry is the content of the file of an entry of the file backend, the
+// item sealed with the key derived with the parameters and salt of the entry,
+// and the key of the item as additional data.
+type fileEntry struct {
+	Params     Argon2Params `json:"params"`
+	Salt       []byte       `json:"salt"`
+	Ciphertext []byte       `json:"ciphertext"`
+}
+
+var _ keyring.Keyring = &fileKeyring{}
+
+// fileKeyring is the keyring.Keyring of the file backend. It stores each item
+// in a file of dir, like the file backend of github.com/99designs/keyring, but
+// encrypted with XChaCha20-Poly1305 by a key derived from the passphrase with
+// argon2id, instead of PBKDF2. The entries of the legacy file backend, encoded
+// as JWE, are re-encrypted when the keyring is unlocked.
+type fileKeyring struct {
+	dir    string
+	params Argon2Params
+	buf    io.Reader
+
+	// password, kdf and legacy are set once the keyring is unlocked, legacy
+	// being the file backend of github.com/99designs/keyring reading the
+	// legacy entries
+	password string
+	kdf      *fileKDF
+	legacy   keyring.Keyring
+	// keys caches the derived keys, by parameters and salt
+	keys map[string][]byte
+}
+
+func newFileKeyring(dir string, params Argon2Params, buf io.Reader) (*fileKeyring, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &fileKeyring{
+		dir:    dir,
+		params: params,
+		buf:    buf,
+		keys:   map[string][]byte{},
+	}, nil
+}
+
+func (k *fileKeyring) Get(key string) (keyring.Item, error) {
+	if _, err := k.GetMetadata(key); err != nil {
+		return keyring.Item{}, err
+	}
+	// the entries are re-encrypted when the keyring is unlocked, so the entry
+	// is read afterwards
+	if err := k.unlock(); err != nil {
+		return keyring.Item{}, err
+	}
+
+	bz, err := ioutil.ReadFile(k.filename(key))
+	if os.IsNotExist(err) {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	} else if err != nil {
+		return keyring.Item{}, err
+	}
+
+	item, stale, err := k.decrypt(key, bz)
+	if err != nil {
+		return keyring.Item{}, err
+	}
+	if stale {
+		if err := k.Set(item); err != nil {
+			return keyring.Item{}, err
+		}
+	}
+
+	return item, nil
+}
+
+func (k *fileKeyring) GetMetadata(key string) (keyring.Metadata, error) {
+	stat, err := os.Stat(k.filename(key))
+	if os.IsNotExist(err) {
+		return keyring.Metadata{}, keyring.ErrKeyNotFound
+	} else if err != nil {
+		return keyring.Metadata{}, err
+	}
+
+	// as all the data of the entries is encrypted, only the timestamps are known
+	return keyring.Metadata{
+		ModificationTime: stat.ModTime(),
+	}, nil
+}
+
+func (k *fileKeyring) Set(item keyring.Item) error {
+	if err := k.unlock(); err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	key, err := k.key(k.kdf.Params, k.kdf.Salt)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := seal(key, plaintext, []byte(item.Key))
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(fileEntry{
+		Params:     k.kdf.Params,
+		Salt:       k.kdf.Salt,
+		Ciphertext: ciphertext,
+	})
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(k.filename(item.Key), bz)
+}
+
+func (k *fileKeyring) Remove(key string) error {
+	return os.Remove(k.filename(key))
+}
+
+func (k *fileKeyring) Keys() ([]string, error) {
+	if err := k.mkdir(); err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(k.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for _, f := range files {
+		switch {
+		case !f.Mode().IsRegular(), f.Name() == fileKDFName, f.Name() == keyhashFileName, filepath.Ext(f.Name()) == ".tmp":
+			continue
+		}
+		keys = append(keys, percent.Decode(f.Name()))
+	}
+
+	return keys, nil
+}
+
+// unlock prompts the passphrase of the keyring, once, and checks it. If the
+// entries of the keyring were not encrypted with the argon2id parameters of the
+// keyring, e.g. the ones of the legacy file backend, they are re-encrypted.
+func (k *fileKeyring) unlock() error {
+	if k.kdf != nil {
+		return nil
+	}
+	if err := k.mkdir(); err != nil {
+		return err
+	}
+
+	kdf, err := k.readKDF()
+	if err != nil {
+		return err
+	}
+	legacyKeys, err := k.legacyKeys()
+	if err != nil {
+		return err
+	}
+
+	var check func(pass string) error
+	switch {
+	case kdf != nil:
+		check = func(pass string) error {
+			key := deriveKey(pass, kdf.Params, kdf.Salt)
+			if _, err := open(key, kdf.Check, []byte(fileKDFName)); err != nil {
+				return err
+			}
+			k.keys[keyID(kdf.Params, kdf.Salt)] = key
+			return nil
+		}
+
+	case len(legacyKeys) > 0:
+		keyhash, err := ioutil.ReadFile(filepath.Join(k.dir, keyhashFileName))
+		switch {
+		case err == nil:
+			check = func(pass string) error {
+				return bcrypt.CompareHashAndPassword(keyhash, []byte(pass))
+			}
+		case os.IsNotExist(err):
+			// the passphrase decrypts the legacy entries
+			check = func(pass string) error {
+				_, err := k.newLegacyKeyring(pass).Get(legacyKeys[0])
+				return err
+			}
+		default:
+			return err
+		}
+	}
+
+	// the passphrase cached by an agent is used if it is the one of the keyring,
+	// otherwise it is prompted, and confirmed if the keyring has no entries yet
+	pass, ok := agentPassphrase(k.dir)
+	if !ok || check == nil || check(pass) != nil {
+		if pass, err = promptPassphrase(k.buf, check == nil, check); err != nil {
+			return err
+		}
+	}
+	k.password = pass
+	k.legacy = k.newLegacyKeyring(pass)
+
+	if kdf == nil || kdf.Params != k.params {
+		if kdf, err = k.writeKDF(); err != nil {
+			return err
+		}
+	}
+	k.kdf = kdf
+
+	if err := k.reencrypt(); err != nil {
+		return err
+	}
+
+	// the bcrypt hash of the passphrase is removed with the legacy entries, as
+	// it would be checked faster than the argon2id key
+	err = os.Remove(filepath.Join(k.dir, keyhashFileName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// reencrypt re-encrypts the entries not encrypted with the key of the keyring.
+func (k *fileKeyring) reencrypt() error {
+	keys, err := k.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		bz, err := ioutil.ReadFile(k.filename(key))
+		if err != nil {
+			return err
+		}
+		item, stale, err := k.decrypt(key, bz)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt the keyring entry %s: %w", key, err)
+		}
+		if !stale {
+			continue
+		}
+		if err := k.Set(item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decrypt decrypts the content of the file of the entry of key, which is
+// stale if it was not encrypted with the key of the keyring.
+func (k *fileKeyring) decrypt(key string, bz []byte) (item keyring.Item, stale bool, err error) {
+	if !isFileEntry(bz) {
+		item, err = k.legacy.Get(key)
+		return item, true, err
+	}
+
+	var entry fileEntry
+	if err := json.Unmarshal(bz, &entry); err != nil {
+		return keyring.Item{}, false, err
+	}
+	if err := entry.Params.Validate(); err != nil {
+		return keyring.Item{}, false, err
+	}
+
+	derivedKey, err := k.key(entry.Params, entry.Salt)
+	if err != nil {
+		return keyring.Item{}, false, err
+	}
+	plaintext, err := open(derivedKey, entry.Ciphertext, []byte(key))
+	if err != nil {
+		return keyring.Item{}, false, err
+	}
+	if err := json.Unmarshal(plaintext, &item); err != nil {
+		return keyring.Item{}, false, err
+	}
+
+	stale = entry.Params != k.kdf.Params || !bytes.Equal(entry.Salt, k.kdf.Salt)
+	return item, stale, nil
+}
+
+// key returns the key derived from the passphrase of the unlocked keyring with
+// the argon2id params and salt.
+func (k *fileKeyring) key(params Argon2Params, salt []byte) ([]byte, error) {
+	if len(salt) != argon2SaltLen {
+		return nil, fmt.Errorf("invalid argon2id salt length %d", len(salt))
+	}
+
+	id := keyID(params, salt)
+	if key, ok := k.keys[id]; ok {
+		return key, nil
+	}
+
+	key := deriveKey(k.password, params, salt)
+	k.keys[id] = key
+
+	return key, nil
+}
+
+// readKDF returns the content of the fileKDFName file, or nil if it does not
+// exist.
+func (k *fileKeyring) readKDF() (*fileKDF, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(k.dir, fileKDFName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var kdf fileKDF
+	if err := json.Unmarshal(bz, &kdf); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", fileKDFName, err)
+	}
+	if err := kdf.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &kdf, nil
+}
+
+// writeKDF writes the fileKDFName file with the parameters of the keyring and a
+// new salt.
+func (k *fileKeyring) writeKDF() (*fileKDF, error) {
+	kdf := &fileKDF{
+		Params: k.params,
+		Salt:   tmcrypto.CRandBytes(argon2SaltLen),
+	}
+	key, err := k.key(kdf.Params, kdf.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if kdf.Check, err = seal(key, nil, []byte(fileKDFName)); err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	return kdf, writeFileAtomic(filepath.Join(k.dir, fileKDFName), bz)
+}
+
+// legacyKeys returns the keys of the entries of the legacy file backend.
+func (k *fileKeyring) legacyKeys() ([]string, error) {
+	keys, err := k.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	var legacyKeys []string
+	for _, key := range keys {
+		bz, err := ioutil.ReadFile(k.filename(key))
+		if err != nil {
+			return nil, err
+		}
+		if !isFileEntry(bz) {
+			legacyKeys = append(legacyKeys, key)
+		}
+	}
+
+	return legacyKeys, nil
+}
+
+// newLegacyKeyring returns the file backend of github.com/99designs/keyring
+// of the directory of the keyring, with the passphrase pass.
+func (k *fileKeyring) newLegacyKeyring(pass string) keyring.Keyring {
+	kr, err := keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		FileDir:         k.dir,
+		FilePasswordFunc: func(string) (string, error) {
+			return pass, nil
+		},
+	})
+	if err != nil {
+		// the file backend is always available
+		panic(err)
+	}
+
+	return kr
+}
+
+func (k *fileKeyring) mkdir() error {
+	return os.MkdirAll(k.dir, 0700)
+}
+
+// filename returns the path of the file of the entry of key, escaped like by
+// the file backend of github.com/99designs/keyring.
+func (k *fileKeyring) filename(key string) string {
+	return filepath.Join(k.dir, percent.Encode(key, "/"))
+}
+
+// deriveKey derives the key encrypting the entries from the passphrase with
+// argon2id.
+func deriveKey(pass string, params Argon2Params, salt []byte) []byte {
+	return argon2.IDKey([]byte(pass), salt, params.Time, params.Memory, params.Threads, argon2KeyLen)
+}
+
+// keyID identifies the keys derived with the argon2id params and salt.
+func keyID(params Argon2Params, salt []byte) string {
+	return fmt.Sprintf("%d/%d/%d/%X", params.Time, params.Memory, params.Threads, salt)
+}
+
+// isFileEntry returns whether the content of the file of an entry is a
+// fileEntry, rather than a JWE of the legacy file backend.
+func isFileEntry(bz []byte) bool {
+	return len(bz) > 0 && bz[0] == '{'
+}
+
+// seal encrypts and authenticates the plaintext and additional data with
+// XChaCha20-Poly1305, returning the random nonce followed by the ciphertext.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := tmcrypto.CRandBytes(aead.NonceSize())
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts and authenticates the ciphertext sealed with seal.
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+// writeFileAtomic writes the file through a temporary file renamed to it, so
+// that the file is never partially written.
+func writeFileAtomic(filename string, bz []byte) error {
+	tmp := filename + ".tmp"
+	if err := ioutil.WriteFile(tmp, bz, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, filename)
+}
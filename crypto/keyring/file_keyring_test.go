@@ -0,0 +1,159 @@
+package keyring
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/99designs/keyring"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/crypto/bcrypt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// testArgon2Params are weak argon2id parameters speeding up the tests.
+var testArgon2Params = Argon2Params{Time: 1, Memory: 64, Threads: 1}
+
+func TestFileKeyringArgon2(t *testing.T) {
+	dir := t.TempDir()
+	fileDir := filepath.Join(dir, keyringFileDirName)
+	mockIn := strings.NewReader("password\npassword\n")
+
+	kr, err := New("cosmos", BackendFile, dir, mockIn, WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+
+	// the entries are encrypted with the argon2id key
+	kdf := readTestKDF(t, fileDir)
+	require.Equal(t, testArgon2Params, kdf.Params)
+	entry := readTestFileEntry(t, fileDir, "foo.info")
+	require.Equal(t, kdf.Params, entry.Params)
+	require.Equal(t, kdf.Salt, entry.Salt)
+	require.NoFileExists(t, filepath.Join(fileDir, keyhashFileName))
+
+	// the passphrase is checked when the keyring is unlocked
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("wrongpassword\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	_, err = kr.Key("foo")
+	require.EqualError(t, err, "too many failed passphrase attempts")
+
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	key, err := kr.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), key.GetPubKey())
+
+	// the entries are bound to their keys
+	bz, err := ioutil.ReadFile(filepath.Join(fileDir, "foo.info"))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(fileDir, "bar.info"), bz, 0600))
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	_, err = kr.Key("bar")
+	require.Error(t, err)
+}
+
+func TestFileKeyringArgon2ParamsChange(t *testing.T) {
+	dir := t.TempDir()
+	fileDir := filepath.Join(dir, keyringFileDirName)
+
+	kr, err := New("cosmos", BackendFile, dir, strings.NewReader("password\npassword\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	info, _, err := kr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	oldKDF := readTestKDF(t, fileDir)
+
+	// the entries are re-encrypted with the new parameters once unlocked
+	newParams := Argon2Params{Time: 2, Memory: 128, Threads: 2}
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\n"), WithArgon2Params(newParams))
+	require.NoError(t, err)
+	key, err := kr.Key("foo")
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), key.GetPubKey())
+
+	kdf := readTestKDF(t, fileDir)
+	require.Equal(t, newParams, kdf.Params)
+	require.NotEqual(t, oldKDF.Salt, kdf.Salt)
+	for _, name := range []string{"foo.info", addrHexKeyAsString(info.GetAddress())} {
+		entry := readTestFileEntry(t, fileDir, name)
+		require.Equal(t, newParams, entry.Params)
+		require.Equal(t, kdf.Salt, entry.Salt)
+	}
+
+	_, err = New("cosmos", BackendFile, dir, nil, WithArgon2Params(Argon2Params{Time: 1, Memory: 8, Threads: 2}))
+	require.EqualError(t, err, "argon2id memory must be at least 8 KiB per thread, got 8 KiB for 2 threads")
+}
+
+func TestFileKeyringLegacyMigration(t *testing.T) {
+	dir := t.TempDir()
+	fileDir := filepath.Join(dir, keyringFileDirName)
+
+	// keyring of the legacy file backend, with its bcrypt hash of the passphrase
+	db, err := keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.FileBackend},
+		FileDir:         fileDir,
+		FilePasswordFunc: func(_ string) (string, error) {
+			return "password", nil
+		},
+	})
+	require.NoError(t, err)
+	legacyKr := newKeystore(db)
+	info, _, err := legacyKr.NewMnemonic("foo", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	keyhash, err := bcrypt.GenerateFromPassword([]byte("0123456789abcdef"), []byte("password"), 2)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(fileDir, keyhashFileName), keyhash, 0600))
+
+	// the legacy passphrase is checked with its bcrypt hash
+	kr, err := New("cosmos", BackendFile, dir, strings.NewReader("wrongpassword\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	_, err = kr.List()
+	require.EqualError(t, err, "too many failed passphrase attempts")
+	require.NoFileExists(t, filepath.Join(fileDir, fileKDFName))
+
+	// the legacy entries are re-encrypted once unlocked
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	infos, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, info.GetPubKey(), infos[0].GetPubKey())
+
+	kdf := readTestKDF(t, fileDir)
+	for _, name := range []string{"foo.info", addrHexKeyAsString(info.GetAddress())} {
+		entry := readTestFileEntry(t, fileDir, name)
+		require.Equal(t, kdf.Salt, entry.Salt)
+	}
+	require.NoFileExists(t, filepath.Join(fileDir, keyhashFileName))
+
+	// the migrated keyring is unlocked with the same passphrase
+	kr, err = New("cosmos", BackendFile, dir, strings.NewReader("password\n"), WithArgon2Params(testArgon2Params))
+	require.NoError(t, err)
+	key, err := kr.KeyByAddress(info.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, "foo", key.GetName())
+}
+
+func readTestKDF(t *testing.T, dir string) fileKDF {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, fileKDFName))
+	require.NoError(t, err)
+	var kdf fileKDF
+	require.NoError(t, json.Unmarshal(bz, &kdf))
+
+	return kdf
+}
+
+func readTestFileEntry(t *testing.T, dir, key string) fileEntry {
+	bz, err := ioutil.ReadFile(filepath.Join(dir, key))
+	require.NoError(t, err)
+	require.True(t, isFileEntry(bz), "%s is not a file entry", key)
+	var entry fileEntry
+	require.NoError(t, json.Unmarshal(bz, &entry))
+
+	return entry
+}
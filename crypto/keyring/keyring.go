@@ -151,6 +151,10 @@ type Options struct {
 	SupportedAlgos SigningAlgoList
 	// supported signing algorithms for Ledger
 	SupportedAlgosLedger SigningAlgoList
+	// path of the append-only signing audit log, disabled if empty
+	AuditLogPath string
+	// argon2id parameters of the key encrypting the entries of the file backend
+	Argon2Params Argon2Params
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -177,7 +181,7 @@ func New(
 	case BackendTest:
 		db, err = keyring.Open(newTestBackendKeyringConfig(appName, rootDir))
 	case BackendFile:
-		db, err = keyring.Open(newFileBackendKeyringConfig(appName, rootDir, userInput))
+		db, err = newFileKeyring(filepath.Join(rootDir, keyringFileDirName), newOptions(opts...).Argon2Params, userInput)
 	case BackendOS:
 		db, err = keyring.Open(newOSBackendKeyringConfig(appName, rootDir, userInput))
 	case BackendKWallet:
@@ -201,17 +205,23 @@ type keystore struct {
 }
 
 func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
+	return keystore{kr, newOptions(opts...)}
+}
+
+// newOptions returns the default options overridden by opts.
+func newOptions(opts ...Option) Options {
 	// Default options for keybase
 	options := Options{
 		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
+		Argon2Params:         DefaultArgon2Params(),
 	}
 
 	for _, optionFn := range opts {
 		optionFn(&options)
 	}
 
-	return keystore{kr, options}
+	return options
 }
 
 func (ks keystore) ExportPubKeyArmor(uid string) (string, error) {
@@ -343,7 +353,27 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, types.PubKey, error) {
 		return nil, nil, err
 	}
 
-	var priv types.PrivKey
+	sig, pub, err := ks.sign(info, msg)
+	if err != nil {
+		return nil, pub, err
+	}
+
+	// Signatures that could not be recorded are never handed out, so that the
+	// audit log is a complete account of what this keyring has signed.
+	if ks.options.AuditLogPath != "" {
+		if err := appendAuditEntry(ks.options.AuditLogPath, newAuditEntry(info, msg)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return sig, pub, nil
+}
+
+func (ks keystore) sign(info Info, msg []byte) ([]byte, types.PubKey, error) {
+	var (
+		priv types.PrivKey
+		err  error
+	)
 
 	switch i := info.(type) {
 	case localInfo:
@@ -648,17 +678,6 @@ func newPassBackendKeyringConfig(appName, _ string, _ io.Reader) keyring.Config
 	}
 }
 
-func newFileBackendKeyringConfig(name, dir string, buf io.Reader) keyring.Config {
-	fileDir := filepath.Join(dir, keyringFileDirName)
-
-	return keyring.Config{
-		AllowedBackends:  []keyring.BackendType{keyring.FileBackend},
-		ServiceName:      name,
-		FileDir:          fileDir,
-		FilePasswordFunc: newRealPrompt(fileDir, buf),
-	}
-}
-
 func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 	return func(prompt string) (string, error) {
 		keyhashStored := false
@@ -684,62 +703,81 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 			return "", fmt.Errorf("failed to open %s: %v", keyhashFilePath, err)
 		}
 
-		failureCounter := 0
+		if keyhashStored {
+			return promptPassphrase(buf, false, func(pass string) error {
+				return bcrypt.CompareHashAndPassword(keyhash, []byte(pass))
+			})
+		}
 
-		for {
-			failureCounter++
-			if failureCounter > maxPassphraseEntryAttempts {
-				return "", fmt.Errorf("too many failed passphrase attempts")
-			}
+		pass, err := promptPassphrase(buf, true, nil)
+		if err != nil {
+			return "", err
+		}
 
-			buf := bufio.NewReader(buf)
-			pass, err := input.GetPassword("Enter keyring passphrase:", buf)
-			if err != nil {
-				// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
-				// but we only log the error.
-				//
-				// lgtm [go/clear-text-logging]
-				fmt.Fprintln(os.Stderr, err)
-				continue
-			}
+		saltBytes := tmcrypto.CRandBytes(16)
+		passwordHash, err := bcrypt.GenerateFromPassword(saltBytes, []byte(pass), 2)
+		if err != nil {
+			return "", err
+		}
 
-			if keyhashStored {
-				if err := bcrypt.CompareHashAndPassword(keyhash, []byte(pass)); err != nil {
-					fmt.Fprintln(os.Stderr, "incorrect passphrase")
-					continue
-				}
+		if err := ioutil.WriteFile(dir+"/keyhash", passwordHash, 0555); err != nil {
+			return "", err
+		}
 
-				return pass, nil
-			}
+		return pass, nil
+	}
+}
 
-			reEnteredPass, err := input.GetPassword("Re-enter keyring passphrase:", buf)
-			if err != nil {
-				// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
-				// but we only log the error.
-				//
-				// lgtm [go/clear-text-logging]
-				fmt.Fprintln(os.Stderr, err)
-				continue
-			}
+// promptPassphrase prompts the keyring passphrase until check accepts it, or
+// until it is re-entered identically when confirm is set, for the passphrases
+// of new keyrings.
+func promptPassphrase(buf io.Reader, confirm bool, check func(pass string) error) (string, error) {
+	failureCounter := 0
 
-			if pass != reEnteredPass {
-				fmt.Fprintln(os.Stderr, "passphrase do not match")
-				continue
-			}
+	for {
+		failureCounter++
+		if failureCounter > maxPassphraseEntryAttempts {
+			return "", fmt.Errorf("too many failed passphrase attempts")
+		}
 
-			saltBytes := tmcrypto.CRandBytes(16)
-			passwordHash, err := bcrypt.GenerateFromPassword(saltBytes, []byte(pass), 2)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err)
-				continue
-			}
+		buf := bufio.NewReader(buf)
+		pass, err := input.GetPassword("Enter keyring passphrase:", buf)
+		if err != nil {
+			// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
+			// but we only log the error.
+			//
+			// lgtm [go/clear-text-logging]
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
 
-			if err := ioutil.WriteFile(dir+"/keyhash", passwordHash, 0555); err != nil {
-				return "", err
+		if check != nil {
+			if err := check(pass); err != nil {
+				fmt.Fprintln(os.Stderr, "incorrect passphrase")
+				continue
 			}
+		}
 
+		if !confirm {
 			return pass, nil
 		}
+
+		reEnteredPass, err := input.GetPassword("Re-enter keyring passphrase:", buf)
+		if err != nil {
+			// NOTE: LGTM.io reports a false positive alert that states we are printing the password,
+			// but we only log the error.
+			//
+			// lgtm [go/clear-text-logging]
+			fmt.Fprintln(os.Stderr, err)
+			continue
+		}
+
+		if pass != reEnteredPass {
+			fmt.Fprintln(os.Stderr, "passphrase do not match")
+			continue
+		}
+
+		return pass, nil
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,29 +14,102 @@ import (
 // KeyOutput defines a structure wrapping around an Info object used for output
 // functionality.
 type KeyOutput struct {
-	Name     string `json:"name" yaml:"name"`
-	Type     string `json:"type" yaml:"type"`
-	Address  string `json:"address" yaml:"address"`
-	PubKey   string `json:"pubkey" yaml:"pubkey"`
-	Mnemonic string `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	Name      string         `json:"name" yaml:"name"`
+	Type      string         `json:"type" yaml:"type"`
+	Address   string         `json:"address" yaml:"address"`
+	PubKey    string         `json:"pubkey" yaml:"pubkey"`
+	Mnemonic  string         `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	Threshold uint32         `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+	Members   []MemberOutput `json:"members,omitempty" yaml:"members,omitempty"`
 }
 
-// NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
+// MemberOutput defines a structure wrapping around a member public key of a
+// multisig public key used for output functionality. If the member is itself a
+// multisig public key, then its threshold and members are set, down to the
+// single public keys of the tree.
+type MemberOutput struct {
+	Address   string         `json:"address" yaml:"address"`
+	PubKey    string         `json:"pubkey" yaml:"pubkey"`
+	Threshold uint32         `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+	Members   []MemberOutput `json:"members,omitempty" yaml:"members,omitempty"`
+}
+
+// NewKeyOutput creates a default KeyOutput instance without Mnemonic. If the
+// public key is a multisig public key, then the threshold and the tree of its
+// members will be added, the members addresses having the Bech32 prefixes of a.
 func NewKeyOutput(name string, keyType KeyType, a sdk.Address, pk cryptotypes.PubKey) (KeyOutput, error) { // nolint:interfacer
-	apk, err := codectypes.NewAnyWithValue(pk)
+	bz, err := pubKeyJSON(pk)
 	if err != nil {
 		return KeyOutput{}, err
 	}
-	bz, err := codec.ProtoMarshalJSON(apk, nil)
-	if err != nil {
-		return KeyOutput{}, err
-	}
-	return KeyOutput{
+	ko := KeyOutput{
 		Name:    name,
 		Type:    keyType.String(),
 		Address: a.String(),
-		PubKey:  string(bz),
-	}, nil
+		PubKey:  bz,
+	}
+	if multiPK, ok := pk.(multisig.PubKey); ok {
+		ko.Threshold = uint32(multiPK.GetThreshold())
+		ko.Members, err = newMemberOutputs(a, multiPK)
+		if err != nil {
+			return KeyOutput{}, err
+		}
+	}
+
+	return ko, nil
+}
+
+// newMemberOutputs returns the MemberOutputs of the members of multiPK, and
+// recursively of the members of its nested multisig public keys.
+func newMemberOutputs(a sdk.Address, multiPK multisig.PubKey) ([]MemberOutput, error) {
+	pks := multiPK.GetPubKeys()
+	members := make([]MemberOutput, len(pks))
+	for i, pk := range pks {
+		bz, err := pubKeyJSON(pk)
+		if err != nil {
+			return nil, err
+		}
+		members[i] = MemberOutput{
+			Address: memberAddress(a, pk).String(),
+			PubKey:  bz,
+		}
+		if nested, ok := pk.(multisig.PubKey); ok {
+			members[i].Threshold = uint32(nested.GetThreshold())
+			members[i].Members, err = newMemberOutputs(a, nested)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return members, nil
+}
+
+// memberAddress returns the address of pk, of the same type as a so that it
+// has the same Bech32 prefixes.
+func memberAddress(a sdk.Address, pk cryptotypes.PubKey) sdk.Address {
+	switch a.(type) {
+	case sdk.ValAddress:
+		return sdk.ValAddress(pk.Address())
+	case sdk.ConsAddress:
+		return sdk.ConsAddress(pk.Address())
+	default:
+		return sdk.AccAddress(pk.Address())
+	}
+}
+
+// pubKeyJSON returns the JSON of pk packed in an Any.
+func pubKeyJSON(pk cryptotypes.PubKey) (string, error) {
+	apk, err := codectypes.NewAnyWithValue(pk)
+	if err != nil {
+		return "", err
+	}
+	bz, err := codec.ProtoMarshalJSON(apk, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
 }
 
 // MkConsKeyOutput create a KeyOutput in with "cons" Bech32 prefixes.
@@ -54,7 +128,7 @@ func MkValKeyOutput(keyInfo Info) (KeyOutput, error) {
 
 // MkAccKeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
 // public key is a multisig public key, then the threshold and constituent
-// public keys will be added.
+// public keys will be added, see NewKeyOutput.
 func MkAccKeyOutput(keyInfo Info) (KeyOutput, error) {
 	pk := keyInfo.GetPubKey()
 	addr := sdk.AccAddress(pk.Address())
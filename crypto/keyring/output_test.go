@@ -26,5 +26,39 @@ func TestBech32KeysOutput(t *testing.T) {
 	out, err := MkAccKeyOutput(info)
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, out)
-	require.Equal(t, `{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{"@type":"/cosmos.crypto.multisig.LegacyAminoPubKey","threshold":1,"public_keys":[{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ"}]} Mnemonic:}`, fmt.Sprintf("%+v", out))
+	require.Equal(t, `{Name:multisig Type:multi Address:cosmos1nf8lf6n4wa43rzmdzwe6hkrnw5guekhqt595cw PubKey:{"@type":"/cosmos.crypto.multisig.LegacyAminoPubKey","threshold":1,"public_keys":[{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ"}]} Mnemonic: Threshold:1 Members:[{Address:cosmos1vg9h6gsc379ahgn8mtuf4ptssyyj56nvr6awpt PubKey:{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"AurroA7jvfPd1AadmmOvWM2rJSwipXfRf8yD6pLbA2DJ"} Threshold:0 Members:[]}]}`, fmt.Sprintf("%+v", out))
+}
+
+func TestNestedMultisigKeysOutput(t *testing.T) {
+	pks := make([]types.PubKey, 3)
+	for i := range pks {
+		pks[i] = secp256k1.GenPrivKey().PubKey()
+	}
+	nestedPk := kmultisig.NewLegacyAminoPubKey(2, pks[:2])
+	multisigPk := kmultisig.NewLegacyAminoPubKey(1, []types.PubKey{nestedPk, pks[2]})
+
+	info, err := NewMultiInfo("multisig", multisigPk)
+	require.NoError(t, err)
+
+	out, err := MkAccKeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), out.Threshold)
+	require.Len(t, out.Members, 2)
+
+	nested := out.Members[0]
+	require.Equal(t, sdk.AccAddress(nestedPk.Address()).String(), nested.Address)
+	require.Equal(t, uint32(2), nested.Threshold)
+	require.Len(t, nested.Members, 2)
+	for i, member := range nested.Members {
+		require.Equal(t, sdk.AccAddress(pks[i].Address()).String(), member.Address)
+		require.Zero(t, member.Threshold)
+		require.Empty(t, member.Members)
+	}
+	require.Equal(t, sdk.AccAddress(pks[2].Address()).String(), out.Members[1].Address)
+	require.Empty(t, out.Members[1].Members)
+
+	// the members have the Bech32 prefixes of the key
+	out, err = MkValKeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ValAddress(pks[0].Address()).String(), out.Members[0].Members[0].Address)
 }
@@ -0,0 +1,102 @@
+package bls12381
+
+import (
+	"errors"
+	"fmt"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// AggregateSignatures aggregates the signatures in a single signature, the sum
+// of their points of G2, which is verified with AggregateVerify, or with
+// FastAggregateVerify when all the signatures are of the same message.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for i, sig := range sigs {
+		if len(sig) != SignatureSize {
+			return nil, fmt.Errorf("invalid size of signature %d", i)
+		}
+		p, err := g2.FromCompressed(sig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature %d: %w", i, err)
+		}
+		g2.Add(agg, agg, p)
+	}
+
+	return g2.ToCompressed(agg), nil
+}
+
+// AggregateVerify checks that sig is the aggregated signature of the i-th
+// message of msgs by the i-th public key of pubKeys. The public keys must
+// have proven the possession of their private keys, see
+// PubKey.VerifyPossession.
+func AggregateVerify(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	points := make([]*bls12381.PointG1, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		p, err := pubKey.point()
+		if err != nil {
+			return false
+		}
+		points[i] = p
+	}
+
+	return verifyPairs(points, msgs, sig, dst)
+}
+
+// FastAggregateVerify checks that sig is the aggregated signature of msg by
+// all the public keys of pubKeys, verifying a single pairing equation with the
+// sum of the public keys. The public keys must have proven the possession of
+// their private keys, see PubKey.VerifyPossession, as a rogue public key could
+// otherwise cancel out the other ones of the sum.
+func FastAggregateVerify(pubKeys []*PubKey, msg []byte, sig []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+
+	g1 := bls12381.NewG1()
+	agg := g1.Zero()
+	for _, pubKey := range pubKeys {
+		p, err := pubKey.point()
+		if err != nil {
+			return false
+		}
+		g1.Add(agg, agg, p)
+	}
+
+	return verifyPairs([]*bls12381.PointG1{agg}, [][]byte{msg}, sig, dst)
+}
+
+// verifyPairs checks that e(G1, sig) == e(pks[0], H(msgs[0])) * ... *
+// e(pks[n], H(msgs[n])), with the messages hashed to G2 with the domain
+// separation tag domain.
+func verifyPairs(pks []*bls12381.PointG1, msgs [][]byte, sig []byte, domain []byte) bool {
+	if len(pks) == 0 || len(sig) != SignatureSize {
+		return false
+	}
+
+	engine := bls12381.NewEngine()
+	s, err := engine.G2.FromCompressed(sig)
+	if err != nil || engine.G2.IsZero(s) {
+		return false
+	}
+
+	engine.AddPairInv(engine.G1.One(), s)
+	for i, pk := range pks {
+		h, err := engine.G2.HashToCurve(msgs[i], domain)
+		if err != nil {
+			return false
+		}
+		engine.AddPair(pk, h)
+	}
+
+	return engine.Check()
+}
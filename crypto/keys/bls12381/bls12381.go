@@ -0,0 +1,253 @@
+package bls12381
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/gogo/protobuf/proto"
+	bls12381 "github.com/kilic/bls12-381"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// PrivKeySize is the size, in bytes, of the secret scalar of private keys.
+	PrivKeySize = 32
+	// PubKeySize is the size, in bytes, of the compressed point of the G1
+	// group of public keys.
+	PubKeySize = 48
+	// SignatureSize is the size, in bytes, of the compressed point of the G2
+	// group of signatures.
+	SignatureSize = 96
+
+	keyType     = "bls12381"
+	PrivKeyName = "cosmos/PrivKeyBls12381"
+	PubKeyName  = "cosmos/PubKeyBls12381"
+)
+
+var (
+	// dst is the domain separation tag of the messages hashed to G2, the one
+	// of the proof of possession scheme of the IETF BLS signature draft with
+	// the public keys in G1 and the signatures in G2.
+	dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	// popDST is the domain separation tag of the proofs of possession.
+	popDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	// curveOrder is the order r of the G1 and G2 groups.
+	curveOrder = bls12381.NewG1().Q()
+)
+
+var _ cryptotypes.PrivKey = &PrivKey{}
+var _ codec.AminoMarshaler = &PrivKey{}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point of G1 to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	g1 := bls12381.NewG1()
+	p := g1.MulScalarBig(g1.New(), g1.One(), new(big.Int).SetBytes(privKey.Key))
+	return &PubKey{Key: g1.ToCompressed(p)}
+}
+
+// Sign hashes the message to G2 and multiplies the resulting point by the
+// secret scalar of the private key.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	return privKey.sign(msg, dst)
+}
+
+// ProvePossession returns the proof of possession of the private key, the
+// signature of its public key. Verifying the proofs of possession of public
+// keys prevents rogue key attacks on the signatures aggregated over a
+// message, see FastAggregateVerify.
+func (privKey *PrivKey) ProvePossession() ([]byte, error) {
+	return privKey.sign(privKey.PubKey().Bytes(), popDST)
+}
+
+func (privKey *PrivKey) sign(msg, domain []byte) ([]byte, error) {
+	secret := new(big.Int).SetBytes(privKey.Key)
+	if len(privKey.Key) != PrivKeySize || secret.Sign() == 0 || secret.Cmp(curveOrder) >= 0 {
+		return nil, errors.New("invalid bls12381 private key")
+	}
+
+	g2 := bls12381.NewG2()
+	h, err := g2.HashToCurve(msg, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return g2.ToCompressed(g2.MulScalarBig(g2.New(), h, secret)), nil
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+// GenPrivKey generates a new BLS12-381 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	return &PrivKey{Key: genPrivKey(crypto.CReader())}
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) []byte {
+	var privKeyBytes [PrivKeySize]byte
+	d := new(big.Int)
+	for {
+		privKeyBytes = [PrivKeySize]byte{}
+		_, err := io.ReadFull(rand, privKeyBytes[:])
+		if err != nil {
+			panic(err)
+		}
+
+		d.SetBytes(privKeyBytes[:])
+		// break if we found a valid scalar (i.e. > 0 and < r == curveOrder)
+		if 0 < d.Sign() && d.Cmp(curveOrder) < 0 {
+			break
+		}
+	}
+
+	return privKeyBytes[:]
+}
+
+//-------------------------------------
+
+var _ cryptotypes.PubKey = &PubKey{}
+var _ codec.AminoMarshaler = &PubKey{}
+
+// Address returns the ADR-28 address of the public key.
+func (pubKey *PubKey) Address() crypto.Address {
+	if len(pubKey.Key) != PubKeySize {
+		panic("length of pubkey is incorrect")
+	}
+
+	return address.Hash(proto.MessageName(pubKey), pubKey.Key)
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+// VerifySignature checks that sig is the signature of msg by the public key,
+// i.e. e(pubKey, H(msg)) == e(G1, sig). Both the public key and the signature
+// must be valid compressed points of their subgroup, other than the identity.
+func (pubKey *PubKey) VerifySignature(msg []byte, sig []byte) bool {
+	return pubKey.verify(msg, sig, dst)
+}
+
+// VerifyPossession checks that proof is the proof of possession of the private
+// key of the public key, see PrivKey.ProvePossession.
+func (pubKey *PubKey) VerifyPossession(proof []byte) bool {
+	return pubKey.verify(pubKey.Key, proof, popDST)
+}
+
+func (pubKey *PubKey) verify(msg, sig, domain []byte) bool {
+	pk, err := pubKey.point()
+	if err != nil {
+		return false
+	}
+
+	return verifyPairs([]*bls12381.PointG1{pk}, [][]byte{msg}, sig, domain)
+}
+
+// point returns the point of G1 of the public key.
+func (pubKey *PubKey) point() (*bls12381.PointG1, error) {
+	if len(pubKey.Key) != PubKeySize {
+		return nil, errors.New("invalid bls12381 public key size")
+	}
+
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(pubKey.Key)
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) {
+		return nil, errors.New("bls12381 public key is the identity")
+	}
+
+	return p, nil
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyBls12381{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// MarshalAmino overrides Amino binary marshalling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshalling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	// When we marshal to Amino JSON, we don't marshal the "key" field itself,
+	// just its contents (i.e. the key bytes).
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshalling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
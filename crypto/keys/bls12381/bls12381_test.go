@@ -0,0 +1,146 @@
+package bls12381_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func mustDecode(t *testing.T, s string) []byte {
+	bz, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestSignatureVector(t *testing.T) {
+	// test vector of the Ethereum consensus specs, which use the same scheme
+	privKey := &bls12381.PrivKey{Key: mustDecode(t, "263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3")}
+	msg := make([]byte, 32)
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Equal(t, "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55", hex.EncodeToString(sig))
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+}
+
+func TestSignAndValidate(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12381.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls12381.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the signature of another message or by another key is invalid
+	require.False(t, pubKey.VerifySignature(crypto.CRandBytes(128), sig))
+	require.False(t, bls12381.GenPrivKey().PubKey().VerifySignature(msg, sig))
+
+	sig[7] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, sig))
+	require.False(t, pubKey.VerifySignature(msg, sig[:bls12381.SignatureSize-1]))
+	require.False(t, (&bls12381.PubKey{Key: pubKey.Bytes()[1:]}).VerifySignature(msg, sig))
+
+	// zero and out of range scalars are not private keys
+	_, err = (&bls12381.PrivKey{Key: make([]byte, bls12381.PrivKeySize)}).Sign(msg)
+	require.Error(t, err)
+	_, err = (&bls12381.PrivKey{Key: mustDecode(t, "73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001")}).Sign(msg)
+	require.Error(t, err)
+}
+
+func TestAddress(t *testing.T) {
+	pubKey := bls12381.GenPrivKey().PubKey()
+	require.Equal(t, crypto.Address(address.Hash("cosmos.crypto.bls12381.PubKey", pubKey.Bytes())), pubKey.Address())
+}
+
+func TestPossession(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey().(*bls12381.PubKey)
+
+	proof, err := privKey.ProvePossession()
+	require.NoError(t, err)
+	require.True(t, pubKey.VerifyPossession(proof))
+	require.False(t, bls12381.GenPrivKey().PubKey().(*bls12381.PubKey).VerifyPossession(proof))
+
+	// a proof of possession is not the signature of the public key
+	sig, err := privKey.Sign(pubKey.Bytes())
+	require.NoError(t, err)
+	require.False(t, pubKey.VerifyPossession(sig))
+}
+
+func TestAggregate(t *testing.T) {
+	msg := []byte("message")
+	pubKeys := make([]*bls12381.PubKey, 3)
+	msgs := make([][]byte, 3)
+	sameMsgSigs := make([][]byte, 3)
+	sigs := make([][]byte, 3)
+	for i := range pubKeys {
+		privKey := bls12381.GenPrivKey()
+		pubKeys[i] = privKey.PubKey().(*bls12381.PubKey)
+		msgs[i] = crypto.CRandBytes(32)
+
+		var err error
+		sameMsgSigs[i], err = privKey.Sign(msg)
+		require.NoError(t, err)
+		sigs[i], err = privKey.Sign(msgs[i])
+		require.NoError(t, err)
+	}
+
+	sig, err := bls12381.AggregateSignatures(sameMsgSigs)
+	require.NoError(t, err)
+	require.True(t, bls12381.FastAggregateVerify(pubKeys, msg, sig))
+	require.False(t, bls12381.FastAggregateVerify(pubKeys[1:], msg, sig))
+	require.False(t, bls12381.FastAggregateVerify(nil, msg, sig))
+
+	sig, err = bls12381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12381.AggregateVerify(pubKeys, msgs, sig))
+	require.False(t, bls12381.AggregateVerify(pubKeys, [][]byte{msgs[1], msgs[0], msgs[2]}, sig))
+	require.False(t, bls12381.AggregateVerify(pubKeys, msgs[1:], sig))
+
+	_, err = bls12381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12381.AggregateSignatures([][]byte{sigs[0], sigs[1][1:]})
+	require.Error(t, err)
+}
+
+func TestMarshal(t *testing.T) {
+	privKey := bls12381.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterface(pubKey)
+	require.NoError(t, err)
+	var pk cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &pk))
+	require.True(t, pubKey.Equals(pk))
+
+	amino := codec.NewLegacyAmino()
+	cryptocodec.RegisterCrypto(amino)
+
+	bz, err = amino.Marshal(pubKey)
+	require.NoError(t, err)
+	var aminoPK cryptotypes.PubKey
+	require.NoError(t, amino.Unmarshal(bz, &aminoPK))
+	require.True(t, pubKey.Equals(aminoPK))
+
+	bz, err = amino.Marshal(privKey)
+	require.NoError(t, err)
+	var aminoSK cryptotypes.PrivKey
+	require.NoError(t, amino.Unmarshal(bz, &aminoSK))
+	require.True(t, privKey.Equals(aminoSK))
+}
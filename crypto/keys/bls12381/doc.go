@@ -0,0 +1,8 @@
+// Package bls12381 implements Cosmos-SDK compatible BLS signatures over the
+// BLS12-381 curve, with the public keys in G1 and the signatures in G2, as in
+// the proof of possession scheme of the IETF BLS signature draft:
+// https://datatracker.ietf.org/doc/draft-irtf-cfrg-bls-signature/
+//
+// The signatures can be aggregated, see AggregateSignatures. The addresses of
+// the public keys are ADR-28 addresses.
+package bls12381
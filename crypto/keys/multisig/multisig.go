@@ -6,6 +6,7 @@ import (
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -64,6 +65,10 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 	if bitarray.NumTrueBitsBefore(size) < int(m.Threshold) {
 		return fmt.Errorf("not enough signatures set, have %d, expected %d", bitarray.NumTrueBitsBefore(size), int(m.Threshold))
 	}
+	// the secp256k1 signatures are verified as a batch once the others are
+	// verified, batchIndexes being the indexes of their keys
+	batch := secp256k1.NewBatchVerifier()
+	var batchIndexes []int
 	// index in the list of signatures which we are concerned with.
 	sigIndex := 0
 	for i := 0; i < size; i++ {
@@ -75,7 +80,9 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 				if err != nil {
 					return err
 				}
-				if !pubKeys[i].VerifySignature(msg, si.Signature) {
+				if batch.Add(pubKeys[i], msg, si.Signature) == nil {
+					batchIndexes = append(batchIndexes, i)
+				} else if !pubKeys[i].VerifySignature(msg, si.Signature) {
 					return fmt.Errorf("unable to verify signature at index %d", i)
 				}
 			case *signing.MultiSignatureData:
@@ -92,6 +99,15 @@ func (m *LegacyAminoPubKey) VerifyMultisignature(getSignBytes multisigtypes.GetS
 			sigIndex++
 		}
 	}
+
+	if ok, valid := batch.Verify(); !ok {
+		for j, i := range batchIndexes {
+			if !valid[j] {
+				return fmt.Errorf("unable to verify signature at index %d", i)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,112 @@
+package secp256k1
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ types.BatchVerifier = &BatchVerifier{}
+
+// verificationSlots bounds the number of secp256k1 signatures verified at the
+// same time by all the concurrent batch verifiers to the number of CPUs.
+var verificationSlots = make(chan struct{}, runtime.GOMAXPROCS(0))
+
+// BatchVerifier verifies batches of secp256k1 signatures. It is not batch
+// verification: ECDSA signatures cannot be verified algebraically as a batch,
+// so every signature is verified individually, and a batch costs as much CPU
+// as its signatures. A concurrent batch verifier only reduces the latency of
+// the verification of the transactions with many signatures, spreading their
+// verification over the available CPUs.
+type BatchVerifier struct {
+	keys []*PubKey
+	msgs [][]byte
+	sigs [][]byte
+
+	concurrent bool
+}
+
+// NewBatchVerifier returns a new empty secp256k1 batch verifier, verifying the
+// signatures one after the other.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// NewConcurrentBatchVerifier returns a new empty secp256k1 batch verifier,
+// verifying the signatures concurrently on at most as many goroutines as CPUs,
+// the signatures verified at the same time by all the concurrent batch
+// verifiers being bounded by the number of CPUs too. It is meant for CheckTx:
+// the block execution verifies the signatures one after the other, without
+// starting goroutines on the consensus path.
+func NewConcurrentBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{concurrent: true}
+}
+
+// Add implements types.BatchVerifier. It fails if key is not a secp256k1 key.
+func (b *BatchVerifier) Add(key types.PubKey, msg, sig []byte) error {
+	pk, ok := key.(*PubKey)
+	if !ok {
+		return fmt.Errorf("secp256k1 batch verifier: invalid key type %T", key)
+	}
+
+	b.keys = append(b.keys, pk)
+	b.msgs = append(b.msgs, msg)
+	b.sigs = append(b.sigs, sig)
+
+	return nil
+}
+
+// Len returns the number of signatures of the batch.
+func (b *BatchVerifier) Len() int {
+	return len(b.keys)
+}
+
+// Verify implements types.BatchVerifier. An empty batch is valid.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.keys))
+
+	workers := cap(verificationSlots)
+	if workers > len(b.keys) {
+		workers = len(b.keys)
+	}
+
+	if !b.concurrent || workers <= 1 {
+		for i, key := range b.keys {
+			valid[i] = key.VerifySignature(b.msgs[i], b.sigs[i])
+		}
+	} else {
+		var (
+			next int64 = -1
+			wg   sync.WaitGroup
+		)
+
+		wg.Add(workers)
+		for w := 0; w < workers; w++ {
+			go func() {
+				defer wg.Done()
+				for {
+					i := int(atomic.AddInt64(&next, 1))
+					if i >= len(b.keys) {
+						return
+					}
+
+					verificationSlots <- struct{}{}
+					valid[i] = b.keys[i].VerifySignature(b.msgs[i], b.sigs[i])
+					<-verificationSlots
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	for _, ok := range valid {
+		if !ok {
+			return false, valid
+		}
+	}
+
+	return true, valid
+}
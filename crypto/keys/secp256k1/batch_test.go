@@ -0,0 +1,70 @@
+package secp256k1_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	batch := secp256k1.NewBatchVerifier()
+
+	// an empty batch is valid
+	ok, valid := batch.Verify()
+	require.True(t, ok)
+	require.Empty(t, valid)
+
+	for i := 0; i < 10; i++ {
+		priv := secp256k1.GenPrivKey()
+		msg := []byte(fmt.Sprintf("msg %d", i))
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, batch.Add(priv.PubKey(), msg, sig))
+	}
+	require.Equal(t, 10, batch.Len())
+
+	ok, valid = batch.Verify()
+	require.True(t, ok)
+	require.Len(t, valid, 10)
+
+	// the invalid signatures are reported
+	priv := secp256k1.GenPrivKey()
+	sig, err := priv.Sign([]byte("msg"))
+	require.NoError(t, err)
+	require.NoError(t, batch.Add(priv.PubKey(), []byte("other msg"), sig))
+	require.NoError(t, batch.Add(priv.PubKey(), []byte("msg"), sig))
+
+	ok, valid = batch.Verify()
+	require.False(t, ok)
+	require.False(t, valid[10])
+	require.True(t, valid[11])
+
+	// other keys are rejected
+	require.Error(t, batch.Add(ed25519.GenPrivKey().PubKey(), []byte("msg"), sig))
+	require.Equal(t, 12, batch.Len())
+}
+
+func TestConcurrentBatchVerifier(t *testing.T) {
+	batch := secp256k1.NewConcurrentBatchVerifier()
+
+	for i := 0; i < 100; i++ {
+		priv := secp256k1.GenPrivKey()
+		msg := []byte(fmt.Sprintf("msg %d", i))
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+		if i == 42 {
+			msg = []byte("other msg")
+		}
+		require.NoError(t, batch.Add(priv.PubKey(), msg, sig))
+	}
+
+	ok, valid := batch.Verify()
+	require.False(t, ok)
+	for i, v := range valid {
+		require.Equal(t, i != 42, v, "signature %d", i)
+	}
+}
@@ -28,3 +28,32 @@ func BenchmarkVerification(b *testing.B) {
 	priv := GenPrivKey()
 	benchmarking.BenchmarkVerification(b, priv)
 }
+
+func BenchmarkBatchVerification(b *testing.B) {
+	b.Run("sequential", func(b *testing.B) { benchmarkBatchVerification(b, NewBatchVerifier()) })
+	b.Run("concurrent", func(b *testing.B) { benchmarkBatchVerification(b, NewConcurrentBatchVerifier()) })
+}
+
+func benchmarkBatchVerification(b *testing.B, batch *BatchVerifier) {
+	const batchSize = 16
+
+	b.ReportAllocs()
+	for i := 0; i < batchSize; i++ {
+		priv := GenPrivKey()
+		msg := []byte("msg")
+		sig, err := priv.Sign(msg)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := batch.Add(priv.PubKey(), msg, sig); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if ok, _ := batch.Verify(); !ok {
+			b.Fatal("invalid batch")
+		}
+	}
+}
@@ -25,3 +25,15 @@ func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
 func (pubKey *PrivKey) VerifySignature(msg []byte, sig []byte) bool {
 	return secp256k1.VerifySignature(pubKey.Key, crypto.Sha256(msg), sig)
 }
+
+// VerifyDigestSignature validates a R || S signature, optionally followed by an
+// Ethereum style recovery byte V, over a precomputed 32 bytes digest.
+func (pubKey *PubKey) VerifyDigestSignature(digest []byte, sig []byte) bool {
+	if len(sig) == 65 {
+		sig = sig[:64]
+	}
+	if len(digest) != 32 || len(sig) != 64 {
+		return false
+	}
+	return secp256k1.VerifySignature(pubKey.Key, digest, sig)
+}
@@ -49,6 +49,31 @@ func (pubKey *PubKey) VerifySignature(msg []byte, sigStr []byte) bool {
 	return signature.Verify(crypto.Sha256(msg), pub)
 }
 
+// VerifyDigestSignature verifies a signature of the form R || S, optionally
+// followed by an Ethereum style recovery byte V, over a precomputed 32 bytes
+// digest instead of the SHA-256 hash of a message.
+// It rejects signatures which are not in lower-S form.
+func (pubKey *PubKey) VerifyDigestSignature(digest []byte, sigStr []byte) bool {
+	if len(digest) != 32 {
+		return false
+	}
+	if len(sigStr) == 65 {
+		sigStr = sigStr[:64]
+	}
+	if len(sigStr) != 64 {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey.Key, secp256k1.S256())
+	if err != nil {
+		return false
+	}
+	signature := signatureFromBytes(sigStr)
+	if signature.S.Cmp(secp256k1halfN) > 0 {
+		return false
+	}
+	return signature.Verify(digest, pub)
+}
+
 // Read Signature struct from R || S. Caller needs to ensure
 // that len(sigStr) == 64.
 func signatureFromBytes(sigStr []byte) *secp256k1.Signature {
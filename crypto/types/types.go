@@ -38,6 +38,17 @@ type PrivKey interface {
 	LedgerPrivKey
 }
 
+// BatchVerifier verifies a batch of signatures, e.g. the signatures of the
+// signers of a transaction.
+type BatchVerifier interface {
+	// Add adds the signature sig of msg by key to the batch.
+	Add(key PubKey, msg, sig []byte) error
+	// Verify verifies the signatures of the batch. It returns true if they are
+	// all valid, and the validity of each signature, in the order they were
+	// added.
+	Verify() (bool, []bool)
+}
+
 type (
 	Address = tmcrypto.Address
 )
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// HealthStatus is the response of the health endpoints.
+type HealthStatus struct {
+	// Status is "ok" if the node passes the check, "unavailable" otherwise.
+	Status string `json:"status"`
+	// Error is the reason the node fails the check.
+	Error string `json:"error,omitempty"`
+
+	CatchingUp        bool      `json:"catching_up"`
+	LatestBlockHeight int64     `json:"latest_block_height"`
+	LatestBlockTime   time.Time `json:"latest_block_time"`
+	// AppHeight is the last height committed by the application, which is
+	// only reported by the readiness check.
+	AppHeight int64 `json:"app_height,omitempty"`
+}
+
+const (
+	healthStatusOK          = "ok"
+	healthStatusUnavailable = "unavailable"
+)
+
+// LivenessHandler returns the handler of the liveness check, which passes as
+// long as the node answers its status, whether it is in sync or not.
+func LivenessHandler(node rpcclient.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, err := nodeHealth(r, node)
+		writeHealth(w, status, err)
+	})
+}
+
+// ReadinessHandler returns the handler of the readiness check, which passes if
+// the node is live, is not catching up, its application answers with its last
+// committed height, and its latest block is at most maxBlockAge old. The age of
+// the latest block is not checked if maxBlockAge is 0.
+func ReadinessHandler(node rpcclient.Client, maxBlockAge time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, err := nodeHealth(r, node)
+		if err != nil {
+			writeHealth(w, status, err)
+			return
+		}
+
+		info, err := node.ABCIInfo(r.Context())
+		if err != nil {
+			writeHealth(w, status, fmt.Errorf("failed to query the application: %w", err))
+			return
+		}
+		status.AppHeight = info.Response.LastBlockHeight
+
+		switch {
+		case status.CatchingUp:
+			err = fmt.Errorf("node is catching up")
+		case maxBlockAge > 0 && time.Since(status.LatestBlockTime) > maxBlockAge:
+			err = fmt.Errorf("latest block is older than %s", maxBlockAge)
+		}
+		writeHealth(w, status, err)
+	})
+}
+
+func nodeHealth(r *http.Request, node rpcclient.Client) (HealthStatus, error) {
+	if node == nil {
+		return HealthStatus{}, fmt.Errorf("no node to query")
+	}
+
+	res, err := node.Status(r.Context())
+	if err != nil {
+		return HealthStatus{}, fmt.Errorf("failed to query the node status: %w", err)
+	}
+
+	return HealthStatus{
+		CatchingUp:        res.SyncInfo.CatchingUp,
+		LatestBlockHeight: res.SyncInfo.LatestBlockHeight,
+		LatestBlockTime:   res.SyncInfo.LatestBlockTime,
+	}, nil
+}
+
+// writeHealth writes status, with the 503 code if err is not nil.
+func writeHealth(w http.ResponseWriter, status HealthStatus, err error) {
+	code := http.StatusOK
+	status.Status = healthStatusOK
+	if err != nil {
+		code = http.StatusServiceUnavailable
+		status.Status = healthStatusUnavailable
+		status.Error = err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+func (s *Server) registerHealthRoutes(maxBlockAge time.Duration) {
+	s.Router.Handle("/health/live", LivenessHandler(s.ClientCtx.Client)).Methods("GET")
+	s.Router.Handle("/health/ready", ReadinessHandler(s.ClientCtx.Client, maxBlockAge)).Methods("GET")
+}
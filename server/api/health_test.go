@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/server/api"
+)
+
+// healthNode is a node answering its status and ABCI info as configured.
+type healthNode struct {
+	rpcclient.Client
+
+	syncInfo  coretypes.SyncInfo
+	statusErr error
+	appErr    error
+}
+
+func (n healthNode) Status(context.Context) (*coretypes.ResultStatus, error) {
+	if n.statusErr != nil {
+		return nil, n.statusErr
+	}
+	return &coretypes.ResultStatus{SyncInfo: n.syncInfo}, nil
+}
+
+func (n healthNode) ABCIInfo(context.Context) (*coretypes.ResultABCIInfo, error) {
+	if n.appErr != nil {
+		return nil, n.appErr
+	}
+	return &coretypes.ResultABCIInfo{Response: abci.ResponseInfo{LastBlockHeight: n.syncInfo.LatestBlockHeight}}, nil
+}
+
+func TestHealthHandlers(t *testing.T) {
+	now := time.Now().UTC()
+	synced := coretypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now}
+
+	testCases := map[string]struct {
+		node     healthNode
+		expLive  string
+		expReady string
+	}{
+		"synced":         {healthNode{syncInfo: synced}, "", ""},
+		"catching up":    {healthNode{syncInfo: coretypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now, CatchingUp: true}}, "", "node is catching up"},
+		"stale":          {healthNode{syncInfo: coretypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now.Add(-time.Hour)}}, "", "latest block is older than 1m0s"},
+		"app error":      {healthNode{syncInfo: synced, appErr: errors.New("closed")}, "", "failed to query the application: closed"},
+		"node unreached": {healthNode{statusErr: errors.New("refused")}, "failed to query the node status: refused", "failed to query the node status: refused"},
+	}
+
+	check := func(t *testing.T, handler http.Handler, expErr string) api.HealthStatus {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", "/health", nil))
+
+		var status api.HealthStatus
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &status))
+		require.Equal(t, expErr, status.Error)
+		if expErr == "" {
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, "ok", status.Status)
+		} else {
+			require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+			require.Equal(t, "unavailable", status.Status)
+		}
+		return status
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			live := check(t, api.LivenessHandler(tc.node), tc.expLive)
+			require.Equal(t, tc.node.syncInfo.LatestBlockHeight, live.LatestBlockHeight)
+			require.Zero(t, live.AppHeight)
+
+			ready := check(t, api.ReadinessHandler(tc.node, time.Minute), tc.expReady)
+			require.Equal(t, tc.node.syncInfo.CatchingUp, ready.CatchingUp)
+		})
+	}
+
+	// the age of the latest block is not checked without a maximum
+	stale := healthNode{syncInfo: coretypes.SyncInfo{LatestBlockHeight: 10, LatestBlockTime: now.Add(-time.Hour)}}
+	ready := check(t, api.ReadinessHandler(stale, 0), "")
+	require.Equal(t, int64(10), ready.AppHeight)
+	require.True(t, ready.LatestBlockTime.Equal(stale.syncInfo.LatestBlockTime))
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// LogLevels defines the log levels of the modules of the node, which can be
+// changed at runtime, e.g. server.LogLevels.
+type LogLevels interface {
+	fmt.Stringer
+
+	// Set replaces the log levels with the ones parsed from levels, e.g.
+	// "x/gov:debug,*:info".
+	Set(levels string) error
+}
+
+// LogLevelResponse is the request and response of the log level endpoint.
+type LogLevelResponse struct {
+	LogLevel string `json:"log_level"`
+}
+
+// LogLevelHandler returns the handler of the log level endpoint, which returns
+// the log levels of the node on GET, and replaces them with the ones of the
+// body of the request on PUT, so that the logs of a module can be debugged
+// without restarting the node.
+func LogLevelHandler(levels LogLevels, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			var req LogLevelResponse
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to decode the request: %s", err))
+				return
+			}
+
+			if err := levels.Set(req.LogLevel); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			logger.Info("log levels changed", "log_level", levels.String())
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(LogLevelResponse{LogLevel: levels.String()})
+	})
+}
+
+// RegisterLogLevelRoute registers the log level endpoint, changing levels. It
+// must be called before the server is started.
+func (s *Server) RegisterLogLevelRoute(levels LogLevels) {
+	s.Router.Handle("/log_level", LogLevelHandler(levels, s.logger)).Methods("GET", "PUT")
+}
@@ -0,0 +1,50 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/api"
+)
+
+func TestLogLevelHandler(t *testing.T) {
+	levels, err := server.NewLogLevels("info")
+	require.NoError(t, err)
+	handler := api.LogLevelHandler(levels, log.NewNopLogger())
+
+	serve := func(method, body string) (int, string) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, "/log_level", strings.NewReader(body)))
+
+		var res api.LogLevelResponse
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		}
+		return rec.Code, res.LogLevel
+	}
+
+	code, res := serve("GET", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "*:info", res)
+
+	code, res = serve("PUT", `{"log_level":"x/gov:debug,*:info"}`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "x/gov:debug,*:info", res)
+
+	code, _ = serve("PUT", `{"log_level":"x/gov:verbose"}`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = serve("PUT", `not json`)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	code, res = serve("GET", "")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "x/gov:debug,*:info", res)
+}
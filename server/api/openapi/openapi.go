@@ -0,0 +1,504 @@
+// Package openapi generates the OpenAPI 2.0 (Swagger) document of the REST
+// routes of the gRPC query services registered with an app, from their
+// protobuf descriptors and google.api.http annotations.
+//
+// Unlike a vendored document, the generated one always matches the services
+// the app actually serves, including the ones of its own modules.
+package openapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// errorDefinition is the definition of the error responses of the
+// grpc-gateway.
+const errorDefinition = "grpc.gateway.runtime.Error"
+
+// Document is an OpenAPI 2.0 document.
+type Document struct {
+	Swagger     string                          `json:"swagger"`
+	Info        Info                            `json:"info"`
+	Consumes    []string                        `json:"consumes"`
+	Produces    []string                        `json:"produces"`
+	Paths       map[string]map[string]Operation `json:"paths"`
+	Definitions map[string]*Schema              `json:"definitions"`
+}
+
+// Info is the metadata of a Document.
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Operation is an operation on a path of a Document, i.e. a gRPC method.
+type Operation struct {
+	OperationID string              `json:"operationId"`
+	Tags        []string            `json:"tags"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is a parameter of an Operation.
+type Parameter struct {
+	Name     string   `json:"name"`
+	In       string   `json:"in"`
+	Required bool     `json:"required,omitempty"`
+	Type     string   `json:"type,omitempty"`
+	Format   string   `json:"format,omitempty"`
+	Items    *Schema  `json:"items,omitempty"`
+	Enum     []string `json:"enum,omitempty"`
+	Schema   *Schema  `json:"schema,omitempty"`
+}
+
+// Response is a response of an Operation.
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Schema is the schema of a message or field.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// Generate returns the Document of the REST routes of services, the gRPC
+// services registered with an app, e.g. by its GRPCQueryRouter. The methods
+// without google.api.http annotation are left out.
+func Generate(info Info, services []*grpc.ServiceDesc) (*Document, error) {
+	g := &generator{
+		files:    make(map[string]*descriptorpb.FileDescriptorProto),
+		messages: make(map[string]*descriptorpb.DescriptorProto),
+		enums:    make(map[string]*descriptorpb.EnumDescriptorProto),
+		doc: &Document{
+			Swagger:     "2.0",
+			Info:        info,
+			Consumes:    []string{"application/json"},
+			Produces:    []string{"application/json"},
+			Paths:       make(map[string]map[string]Operation),
+			Definitions: make(map[string]*Schema),
+		},
+	}
+
+	for _, sd := range services {
+		if err := g.addService(sd); err != nil {
+			return nil, fmt.Errorf("failed to generate the OpenAPI document of %s: %w", sd.ServiceName, err)
+		}
+	}
+
+	g.doc.Definitions[errorDefinition] = &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"error":   {Type: "string"},
+			"code":    {Type: "integer", Format: "int32"},
+			"message": {Type: "string"},
+			"details": {Type: "array", Items: g.ref(".google.protobuf.Any")},
+		},
+	}
+
+	// the definitions are added as they are referenced, including by the
+	// definitions added meanwhile
+	for len(g.pending) > 0 {
+		name := g.pending[0]
+		g.pending = g.pending[1:]
+		g.doc.Definitions[strings.TrimPrefix(name, ".")] = g.messageSchema(name)
+	}
+
+	return g.doc, nil
+}
+
+type generator struct {
+	doc *Document
+
+	// files are the loaded file descriptors, by file name
+	files map[string]*descriptorpb.FileDescriptorProto
+	// messages and enums are the types of the loaded files, by fully
+	// qualified name, e.g. .cosmos.base.v1beta1.Coin
+	messages map[string]*descriptorpb.DescriptorProto
+	enums    map[string]*descriptorpb.EnumDescriptorProto
+
+	// pending are the referenced messages whose definition is not added yet
+	pending    []string
+	referenced map[string]bool
+}
+
+func (g *generator) addService(sd *grpc.ServiceDesc) error {
+	fileName, ok := sd.Metadata.(string)
+	if !ok {
+		return fmt.Errorf("no proto file name in the service metadata")
+	}
+
+	fd, err := g.loadFile(fileName)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range fd.Service {
+		if fd.GetPackage()+"."+service.GetName() != sd.ServiceName {
+			continue
+		}
+
+		for _, method := range service.Method {
+			rule, ok := proto.GetExtension(method.GetOptions(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+
+			for _, binding := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+				if err := g.addMethod(fd.GetPackage(), sd.ServiceName, method, binding); err != nil {
+					return fmt.Errorf("%s: %w", method.GetName(), err)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("service not found in %s", fileName)
+}
+
+// pathParamRegexp matches the path parameters of an HTTP rule, e.g. {address}
+// or {denom=**}.
+var pathParamRegexp = regexp.MustCompile(`{([^}=]+)(=[^}]*)?}`)
+
+func (g *generator) addMethod(pkg, serviceName string, method *descriptorpb.MethodDescriptorProto, rule *annotations.HttpRule) error {
+	var httpMethod, path string
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		httpMethod, path = "get", pattern.Get
+	case *annotations.HttpRule_Post:
+		httpMethod, path = "post", pattern.Post
+	case *annotations.HttpRule_Put:
+		httpMethod, path = "put", pattern.Put
+	case *annotations.HttpRule_Delete:
+		httpMethod, path = "delete", pattern.Delete
+	case *annotations.HttpRule_Patch:
+		httpMethod, path = "patch", pattern.Patch
+	default:
+		return nil
+	}
+
+	input, ok := g.messages[method.GetInputType()]
+	if !ok {
+		return fmt.Errorf("unknown request type %s", method.GetInputType())
+	}
+
+	var params []Parameter
+	inPath := make(map[string]bool)
+	for _, match := range pathParamRegexp.FindAllStringSubmatch(path, -1) {
+		name := match[1]
+		inPath[name] = true
+
+		schema := g.fieldPathSchema(input, name)
+		params = append(params, Parameter{
+			Name: name, In: "path", Required: true,
+			Type: schema.Type, Format: schema.Format, Enum: schema.Enum,
+		})
+	}
+
+	switch rule.Body {
+	case "":
+		params = append(params, g.queryParams(input, "", inPath, map[string]bool{method.GetInputType(): true})...)
+	case "*":
+		params = append(params, Parameter{Name: "body", In: "body", Required: true, Schema: g.ref(method.GetInputType())})
+	default:
+		params = append(params, Parameter{Name: "body", In: "body", Required: true, Schema: g.fieldPathSchema(input, rule.Body)})
+		inPath[rule.Body] = true
+		params = append(params, g.queryParams(input, "", inPath, map[string]bool{method.GetInputType(): true})...)
+	}
+
+	path = pathParamRegexp.ReplaceAllString(path, "{$1}")
+	if g.doc.Paths[path] == nil {
+		g.doc.Paths[path] = make(map[string]Operation)
+	}
+
+	g.doc.Paths[path][httpMethod] = Operation{
+		OperationID: strings.ReplaceAll(serviceName, ".", "_") + "_" + method.GetName(),
+		Tags:        []string{pkg},
+		Parameters:  params,
+		Responses: map[string]Response{
+			"200":     {Description: "A successful response.", Schema: g.ref(method.GetOutputType())},
+			"default": {Description: "An unexpected error response.", Schema: &Schema{Ref: "#/definitions/" + errorDefinition}},
+		},
+	}
+
+	return nil
+}
+
+// queryParams returns the query parameters of the fields of msg not in the
+// path, the fields of the nested messages being flattened, e.g. as
+// pagination.limit. The repeated messages and the maps are left out, as they
+// can't be passed as query parameters.
+func (g *generator) queryParams(msg *descriptorpb.DescriptorProto, prefix string, inPath, visited map[string]bool) []Parameter {
+	var params []Parameter
+	for _, field := range msg.Field {
+		name := prefix + field.GetName()
+		if inPath[name] {
+			continue
+		}
+
+		repeated := field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		if field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+			nested, ok := g.messages[field.GetTypeName()]
+			if repeated || !ok || visited[field.GetTypeName()] || isWellKnown(field.GetTypeName()) {
+				continue
+			}
+
+			visited[field.GetTypeName()] = true
+			params = append(params, g.queryParams(nested, name+".", inPath, visited)...)
+			delete(visited, field.GetTypeName())
+			continue
+		}
+
+		schema := g.scalarSchema(field)
+		param := Parameter{Name: name, In: "query", Type: schema.Type, Format: schema.Format, Enum: schema.Enum}
+		if repeated {
+			param = Parameter{Name: name, In: "query", Type: "array", Items: schema}
+		}
+		params = append(params, param)
+	}
+
+	return params
+}
+
+// fieldPathSchema returns the schema of the field of msg at path, e.g.
+// pagination.key.
+func (g *generator) fieldPathSchema(msg *descriptorpb.DescriptorProto, path string) *Schema {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		for _, field := range msg.Field {
+			if field.GetName() != name {
+				continue
+			}
+
+			if i == len(names)-1 {
+				return g.fieldSchema(field)
+			}
+			msg = g.messages[field.GetTypeName()]
+			break
+		}
+
+		if msg == nil {
+			break
+		}
+	}
+
+	return &Schema{Type: "string"}
+}
+
+// ref returns a reference to the definition of the message typeName, adding
+// the definition if it is not already.
+func (g *generator) ref(typeName string) *Schema {
+	if schema, ok := wellKnownSchema(typeName); ok {
+		return schema
+	}
+
+	if g.referenced == nil {
+		g.referenced = make(map[string]bool)
+	}
+	if !g.referenced[typeName] {
+		g.referenced[typeName] = true
+		g.pending = append(g.pending, typeName)
+	}
+
+	return &Schema{Ref: "#/definitions/" + strings.TrimPrefix(typeName, ".")}
+}
+
+func (g *generator) messageSchema(typeName string) *Schema {
+	msg, ok := g.messages[typeName]
+	if !ok {
+		return &Schema{Type: "object"}
+	}
+
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema, len(msg.Field))}
+	for _, field := range msg.Field {
+		schema.Properties[field.GetName()] = g.fieldSchema(field)
+	}
+
+	return schema
+}
+
+func (g *generator) fieldSchema(field *descriptorpb.FieldDescriptorProto) *Schema {
+	var schema *Schema
+	if field.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		if entry, ok := g.messages[field.GetTypeName()]; ok && entry.GetOptions().GetMapEntry() {
+			// the maps are JSON objects of their values, the map entries
+			// being key and value fields
+			return &Schema{Type: "object", AdditionalProperties: g.fieldSchema(entry.Field[1])}
+		}
+		schema = g.ref(field.GetTypeName())
+	} else {
+		schema = g.scalarSchema(field)
+	}
+
+	if field.GetLabel() == descriptorpb.FieldDescriptorProto_LABEL_REPEATED {
+		return &Schema{Type: "array", Items: schema}
+	}
+
+	return schema
+}
+
+// scalarSchema returns the schema of the JSON encoding of a non-message field,
+// the 64 bits integers being encoded as strings.
+func (g *generator) scalarSchema(field *descriptorpb.FieldDescriptorProto) *Schema {
+	switch field.GetType() {
+	case descriptorpb.FieldDescriptorProto_TYPE_BOOL:
+		return &Schema{Type: "boolean"}
+	case descriptorpb.FieldDescriptorProto_TYPE_INT32, descriptorpb.FieldDescriptorProto_TYPE_SINT32, descriptorpb.FieldDescriptorProto_TYPE_SFIXED32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT32, descriptorpb.FieldDescriptorProto_TYPE_FIXED32:
+		return &Schema{Type: "integer", Format: "int64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_SINT64, descriptorpb.FieldDescriptorProto_TYPE_SFIXED64:
+		return &Schema{Type: "string", Format: "int64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_UINT64, descriptorpb.FieldDescriptorProto_TYPE_FIXED64:
+		return &Schema{Type: "string", Format: "uint64"}
+	case descriptorpb.FieldDescriptorProto_TYPE_FLOAT:
+		return &Schema{Type: "number", Format: "float"}
+	case descriptorpb.FieldDescriptorProto_TYPE_DOUBLE:
+		return &Schema{Type: "number", Format: "double"}
+	case descriptorpb.FieldDescriptorProto_TYPE_BYTES:
+		return &Schema{Type: "string", Format: "byte"}
+	case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+		schema := &Schema{Type: "string"}
+		if enum, ok := g.enums[field.GetTypeName()]; ok {
+			for _, value := range enum.Value {
+				schema.Enum = append(schema.Enum, value.GetName())
+			}
+		}
+		return schema
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+func isWellKnown(typeName string) bool {
+	_, ok := wellKnownSchema(typeName)
+	return ok
+}
+
+// wellKnownSchema returns the schema of the JSON encoding of the well-known
+// type typeName, if it is one.
+func wellKnownSchema(typeName string) (*Schema, bool) {
+	switch typeName {
+	case ".google.protobuf.Any":
+		return &Schema{
+			Type:                 "object",
+			Properties:           map[string]*Schema{"@type": {Type: "string"}},
+			AdditionalProperties: &Schema{},
+		}, true
+	case ".google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}, true
+	case ".google.protobuf.Duration":
+		return &Schema{Type: "string"}, true
+	case ".google.protobuf.Struct":
+		return &Schema{Type: "object"}, true
+	default:
+		return nil, false
+	}
+}
+
+// loadFile loads the descriptor of the file fileName and of its dependencies,
+// indexing their types.
+func (g *generator) loadFile(fileName string) (*descriptorpb.FileDescriptorProto, error) {
+	if fd, ok := g.files[fileName]; ok {
+		return fd, nil
+	}
+
+	fd, err := fileDescriptor(fileName)
+	if err != nil {
+		return nil, err
+	}
+	g.files[fileName] = fd
+
+	prefix := "." + fd.GetPackage()
+	for _, msg := range fd.MessageType {
+		g.indexMessage(prefix, msg)
+	}
+	for _, enum := range fd.EnumType {
+		g.enums[prefix+"."+enum.GetName()] = enum
+	}
+
+	// some dependencies defining options are not registered under their import
+	// path, e.g. gogoproto/gogo.proto, and are skipped: the messages of the
+	// dependencies missing would be defined as any object
+	for _, dep := range fd.Dependency {
+		_, _ = g.loadFile(dep)
+	}
+
+	return fd, nil
+}
+
+func (g *generator) indexMessage(prefix string, msg *descriptorpb.DescriptorProto) {
+	name := prefix + "." + msg.GetName()
+	g.messages[name] = msg
+
+	for _, nested := range msg.NestedType {
+		g.indexMessage(name, nested)
+	}
+	for _, enum := range msg.EnumType {
+		g.enums[name+"."+enum.GetName()] = enum
+	}
+}
+
+// fileDescriptor returns the descriptor of the file fileName, registered
+// either with gogoproto or, as the well-known types, with the golang protobuf
+// registry.
+func fileDescriptor(fileName string) (*descriptorpb.FileDescriptorProto, error) {
+	gzipped := gogoproto.FileDescriptor(fileName)
+	if len(gzipped) == 0 {
+		desc, err := protoregistry.GlobalFiles.FindFileByPath(fileName)
+		if err != nil {
+			return nil, fmt.Errorf("file %s is not registered", fileName)
+		}
+		return protodesc.ToFileDescriptorProto(desc), nil
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(gzipped))
+	if err != nil {
+		return nil, err
+	}
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(bz, fd); err != nil {
+		return nil, fmt.Errorf("failed to decode the descriptor of %s: %w", fileName, err)
+	}
+
+	return fd, nil
+}
+
+// Handler returns an http.Handler serving doc as JSON.
+func Handler(doc *Document) (http.Handler, error) {
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}), nil
+}
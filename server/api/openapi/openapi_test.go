@@ -0,0 +1,109 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
+	"github.com/cosmos/cosmos-sdk/simapp"
+)
+
+func generate(t *testing.T) *openapi.Document {
+	app := simapp.Setup(false)
+	app.RegisterTxService(client.Context{})
+	app.RegisterTendermintService(client.Context{})
+
+	doc, err := openapi.Generate(openapi.Info{Title: "SimApp", Version: "test"}, app.GRPCQueryRouter().ServiceDescs())
+	require.NoError(t, err)
+
+	return doc
+}
+
+func TestGenerate(t *testing.T) {
+	doc := generate(t)
+
+	op, ok := doc.Paths["/cosmos/bank/v1beta1/balances/{address}"]["get"]
+	require.True(t, ok)
+	require.Equal(t, "cosmos_bank_v1beta1_Query_AllBalances", op.OperationID)
+	require.Equal(t, []string{"cosmos.bank.v1beta1"}, op.Tags)
+	require.Contains(t, op.Parameters, openapi.Parameter{Name: "address", In: "path", Required: true, Type: "string"})
+	require.Contains(t, op.Parameters, openapi.Parameter{Name: "pagination.limit", In: "query", Type: "string", Format: "uint64"})
+	require.Equal(t, "#/definitions/cosmos.bank.v1beta1.QueryAllBalancesResponse", op.Responses["200"].Schema.Ref)
+
+	// the referenced messages are defined
+	coin := doc.Definitions["cosmos.base.v1beta1.Coin"]
+	require.NotNil(t, coin)
+	require.Equal(t, &openapi.Schema{Type: "string"}, coin.Properties["denom"])
+	require.Equal(t, &openapi.Schema{Type: "array", Items: &openapi.Schema{Ref: "#/definitions/cosmos.base.v1beta1.Coin"}},
+		doc.Definitions["cosmos.bank.v1beta1.QueryAllBalancesResponse"].Properties["balances"])
+
+	// the request of the methods with a body are passed as the body
+	op, ok = doc.Paths["/cosmos/tx/v1beta1/txs"]["post"]
+	require.True(t, ok)
+	require.Equal(t, []openapi.Parameter{{
+		Name: "body", In: "body", Required: true,
+		Schema: &openapi.Schema{Ref: "#/definitions/cosmos.tx.v1beta1.BroadcastTxRequest"},
+	}}, op.Parameters)
+
+	// the enums are listed
+	mode := doc.Definitions["cosmos.tx.v1beta1.BroadcastTxRequest"].Properties["mode"]
+	require.Equal(t, "string", mode.Type)
+	require.Contains(t, mode.Enum, "BROADCAST_MODE_SYNC")
+
+	// the services of the fork are included
+	_, ok = doc.Paths["/cosmos/distribution/v1beta1/validators/{validator_address}/commission_split"]["get"]
+	require.True(t, ok)
+}
+
+func TestGenerateReferences(t *testing.T) {
+	doc := generate(t)
+
+	var check func(schema *openapi.Schema)
+	check = func(schema *openapi.Schema) {
+		if schema == nil {
+			return
+		}
+		if schema.Ref != "" {
+			_, ok := doc.Definitions[strings.TrimPrefix(schema.Ref, "#/definitions/")]
+			require.True(t, ok, "undefined %s", schema.Ref)
+		}
+
+		check(schema.Items)
+		check(schema.AdditionalProperties)
+		for _, property := range schema.Properties {
+			check(property)
+		}
+	}
+
+	for _, definition := range doc.Definitions {
+		check(definition)
+	}
+	for _, operations := range doc.Paths {
+		for _, op := range operations {
+			for _, param := range op.Parameters {
+				check(param.Schema)
+			}
+			for _, res := range op.Responses {
+				check(res.Schema)
+			}
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler, err := openapi.Handler(generate(t))
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/swagger/openapi.json", nil))
+	require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	require.Equal(t, "2.0", doc["swagger"])
+}
@@ -1,10 +1,14 @@
 package api
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/gateway"
@@ -16,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -33,6 +38,10 @@ type Server struct {
 	logger   log.Logger
 	metrics  *telemetry.Metrics
 	listener net.Listener
+
+	mtx     sync.Mutex
+	server  *http.Server
+	limiter *ratelimit.Limiter
 }
 
 // CustomGRPCHeaderMatcher for mapping request headers to
@@ -49,6 +58,35 @@ func CustomGRPCHeaderMatcher(key string) (string, bool) {
 	}
 }
 
+// heightQueryParam is the query parameter of the height to query the state at,
+// equivalent to the grpctypes.GRPCBlockHeightHeader header.
+const heightQueryParam = "height"
+
+// HeightHandler returns a handler passing the height of the requests to the
+// gRPC-gateway next through the grpctypes.GRPCBlockHeightHeader header, which
+// is set from the height query parameter if missing. It rejects the requests
+// whose height is not a non-negative integer.
+func HeightHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		height := r.Header.Get(grpctypes.GRPCBlockHeightHeader)
+		if height == "" {
+			height = r.URL.Query().Get(heightQueryParam)
+		}
+
+		if height != "" {
+			h, err := strconv.ParseInt(height, 10, 64)
+			if err != nil || h < 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid height %q, must be a non-negative integer", height))
+				return
+			}
+
+			r.Header.Set(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(h, 10))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func New(clientCtx client.Context, logger log.Logger) *Server {
 	// The default JSON marshaller used by the gRPC-Gateway is unable to marshal non-nullable non-scalar fields.
 	// Using the gogo/gateway package with the gRPC-Gateway WithMarshaler option fixes the scalar field marshalling issue.
@@ -84,12 +122,15 @@ func New(clientCtx client.Context, logger log.Logger) *Server {
 // non-blocking, so an external signal handler must be used.
 func (s *Server) Start(cfg config.Config) error {
 	if cfg.Telemetry.Enabled {
-		m, err := telemetry.New(cfg.Telemetry)
-		if err != nil {
-			return err
+		if s.metrics == nil {
+			m, err := telemetry.New(cfg.Telemetry)
+			if err != nil {
+				return err
+			}
+
+			s.metrics = m
 		}
 
-		s.metrics = m
 		s.registerMetrics()
 	}
 
@@ -99,32 +140,117 @@ func (s *Server) Start(cfg config.Config) error {
 	tmCfg.WriteTimeout = time.Duration(cfg.API.RPCWriteTimeout) * time.Second
 	tmCfg.MaxBodyBytes = int64(cfg.API.RPCMaxBodyBytes)
 
+	limiter, err := ratelimit.NewLimiter("api", cfg.RateLimit)
+	if err != nil {
+		return err
+	}
+	s.SetRateLimiter(limiter)
+
+	tlsCfg, err := cfg.API.TLS.Load()
+	if err != nil {
+		return err
+	}
+
 	listener, err := tmrpcserver.Listen(cfg.API.Address, tmCfg)
 	if err != nil {
 		return err
 	}
+	if tlsCfg != nil {
+		listener = tls.NewListener(listener, tlsCfg)
+	}
 
+	s.registerHealthRoutes(time.Duration(cfg.API.HealthMaxBlockAge) * time.Second)
 	s.registerGRPCGatewayRoutes()
 
 	s.listener = listener
-	var h http.Handler = s.Router
+	// the limiter is looked up per request, as it can be replaced at runtime
+	var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.rateLimiter().Middleware(s.Router).ServeHTTP(w, r)
+	})
 
 	if cfg.API.EnableUnsafeCORS {
 		allowAllCORS := handlers.CORS(handlers.AllowedHeaders([]string{"Content-Type"}))
-		return tmrpcserver.Serve(s.listener, allowAllCORS(h), s.logger, tmCfg)
+		h = allowAllCORS(h)
 	}
 
 	s.logger.Info("starting API server...")
-	return tmrpcserver.Serve(s.listener, s.Router, s.logger, tmCfg)
+
+	// the server is the one of tmrpcserver.Serve, kept to be shut down
+	maxBodyBytes := tmCfg.MaxBodyBytes
+	server := &http.Server{
+		Handler: tmrpcserver.RecoverAndLogHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+			h.ServeHTTP(w, r)
+		}), s.logger),
+		ReadTimeout:    tmCfg.ReadTimeout,
+		WriteTimeout:   tmCfg.WriteTimeout,
+		MaxHeaderBytes: tmCfg.MaxHeaderBytes,
+	}
+	s.mtx.Lock()
+	s.server = server
+	s.mtx.Unlock()
+
+	s.logger.Info(fmt.Sprintf("Starting RPC HTTP server on %s", s.listener.Addr()))
+	err = server.Serve(s.listener)
+	s.logger.Info("RPC HTTP server stopped", "err", err)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
+}
+
+// SetTelemetry sets the metrics served by the server when the telemetry is
+// enabled, shared with the other servers of the node. The server creates its
+// own metrics on Start otherwise.
+func (s *Server) SetTelemetry(m *telemetry.Metrics) {
+	s.metrics = m
+}
+
+// SetRateLimiter replaces the limiter of the requests to the server, e.g. when
+// the rate limits are reloaded. A nil limiter disables rate limiting.
+func (s *Server) SetRateLimiter(l *ratelimit.Limiter) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.limiter = l
+}
+
+func (s *Server) rateLimiter() *ratelimit.Limiter {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return s.limiter
 }
 
 // Close closes the API server.
 func (s *Server) Close() error {
+	// the listener is not set if the server failed to start
+	if s.listener == nil {
+		return nil
+	}
+
 	return s.listener.Close()
 }
 
+// Shutdown stops the API server from accepting new requests, and waits for the
+// outstanding ones to be served until ctx is done, interrupting them then.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mtx.Lock()
+	server := s.server
+	s.mtx.Unlock()
+	if server == nil {
+		return s.Close()
+	}
+
+	if err := server.Shutdown(ctx); err != nil {
+		server.Close()
+		return err
+	}
+
+	return nil
+}
+
 func (s *Server) registerGRPCGatewayRoutes() {
-	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
+	s.Router.PathPrefix("/").Handler(HeightHandler(s.GRPCGatewayRouter))
 }
 
 func (s *Server) registerMetrics() {
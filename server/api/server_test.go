@@ -0,0 +1,142 @@
+package api_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+func TestHeightHandler(t *testing.T) {
+	testCases := map[string]struct {
+		url       string
+		header    string
+		expCode   int
+		expHeight string
+	}{
+		"no height":                 {"/cosmos/bank/v1beta1/supply", "", http.StatusOK, ""},
+		"header":                    {"/cosmos/bank/v1beta1/supply", "5", http.StatusOK, "5"},
+		"query param":               {"/cosmos/bank/v1beta1/supply?height=5", "", http.StatusOK, "5"},
+		"header takes precedence":   {"/cosmos/bank/v1beta1/supply?height=5", "3", http.StatusOK, "3"},
+		"negative header":           {"/cosmos/bank/v1beta1/supply", "-1", http.StatusBadRequest, ""},
+		"invalid query param":       {"/cosmos/bank/v1beta1/supply?height=latest", "", http.StatusBadRequest, ""},
+		"empty query param ignored": {"/cosmos/bank/v1beta1/supply?height=", "", http.StatusOK, ""},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var height string
+			handler := api.HeightHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				height = r.Header.Get(grpctypes.GRPCBlockHeightHeader)
+			}))
+
+			req := httptest.NewRequest("GET", tc.url, nil)
+			if tc.header != "" {
+				req.Header.Set(grpctypes.GRPCBlockHeightHeader, tc.header)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+			require.Equal(t, tc.expHeight, height)
+		})
+	}
+}
+
+func TestServerShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	srv := api.New(client.Context{}, log.NewNopLogger())
+	served, release := make(chan struct{}), make(chan struct{})
+	srv.Router.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(served)
+		<-release
+		_, _ = w.Write([]byte("done"))
+	})
+
+	cfg := config.DefaultConfig()
+	cfg.API.Address = "tcp://" + addr
+	go srv.Start(*cfg)
+
+	res := make(chan string)
+	go func() {
+		for {
+			resp, err := http.Get("http://" + addr + "/slow")
+			if err != nil {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			bz, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			res <- string(bz)
+			return
+		}
+	}()
+	<-served
+
+	// the new requests are rejected, while the outstanding one is served
+	shutdown := make(chan error)
+	go func() { shutdown <- srv.Shutdown(context.Background()) }()
+	require.Eventually(t, func() bool {
+		_, err := net.Dial("tcp", addr)
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	close(release)
+	require.Equal(t, "done", <-res)
+	require.NoError(t, <-shutdown)
+}
+
+func TestServerSetRateLimiter(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	srv := api.New(client.Context{}, log.NewNopLogger())
+	srv.Router.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("pong"))
+	})
+
+	cfg := config.DefaultConfig()
+	cfg.API.Address = "tcp://" + addr
+	go srv.Start(*cfg)
+	defer srv.Shutdown(context.Background())
+
+	get := func() int {
+		resp, err := http.Get("http://" + addr + "/ping")
+		if err != nil {
+			return 0
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	require.Eventually(t, func() bool { return get() == http.StatusOK }, time.Second, 10*time.Millisecond)
+	require.Equal(t, http.StatusOK, get())
+
+	// the limits apply to the next requests
+	limiter, err := ratelimit.NewLimiter("api", config.RateLimitConfig{Enable: true, GlobalRPS: 1})
+	require.NoError(t, err)
+	srv.SetRateLimiter(limiter)
+	require.Equal(t, http.StatusOK, get())
+	require.Equal(t, http.StatusTooManyRequests, get())
+
+	srv.SetRateLimiter(nil)
+	require.Equal(t, http.StatusOK, get())
+}
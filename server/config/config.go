@@ -1,7 +1,10 @@
 package config
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -10,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 const (
@@ -18,7 +22,8 @@ const (
 	// DefaultGRPCAddress defines the default address to bind the gRPC server to.
 	DefaultGRPCAddress = "0.0.0.0:9090"
 
-	// DefaultGRPCWebAddress defines the default address to bind the gRPC-web server to.
+	// DefaultGRPCWebAddress defines the conventional address to bind a dedicated
+	// gRPC-web server to. By default, gRPC-web is served on the gRPC server address.
 	DefaultGRPCWebAddress = "0.0.0.0:9091"
 )
 
@@ -69,6 +74,42 @@ type BaseConfig struct {
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
+
+	// TxDecodeMode defines how unknown protobuf fields in the transactions
+	// decoded by the tx service queries are handled, either "strict" or
+	// "tolerant". In tolerant mode, unknown non-critical fields are accepted in
+	// both the tx body and auth info, so that transactions built against newer
+	// proto revisions can be queried. It does not apply to the transactions
+	// executed in CheckTx and DeliverTx, which are always decoded strictly.
+	TxDecodeMode string `mapstructure:"tx-decode-mode"`
+
+	// ReplayVerificationWindow defines the number of trailing committed blocks
+	// which are re-executed in the background, on a branch of the state they
+	// were executed on, to verify that they result in the same app hash. A
+	// mismatch is logged and reported by the replay_verification_mismatch
+	// telemetry counter. A value of 0 disables replay verification.
+	//
+	// Note: The window must not exceed the pruning-keep-recent heights, unless
+	// the state is not pruned.
+	ReplayVerificationWindow uint64 `mapstructure:"replay-verification-window"`
+
+	// GasUsageWindow defines the number of trailing committed blocks whose gas
+	// consumption is broken down per message type, and returned by the
+	// app/gas_usage query. A value of 0 disables the breakdown.
+	GasUsageWindow uint64 `mapstructure:"gas-usage-window"`
+
+	// StateSizeInterval defines the interval of committed blocks at which the
+	// number of keys and bytes of each store of the state are measured in the
+	// background, reported through telemetry and returned by the app/state_size
+	// query with their growth since the previous measurement. A value of 0
+	// disables the measurement.
+	StateSizeInterval uint64 `mapstructure:"state-size-interval"`
+
+	// ShutdownTimeout defines the maximum duration, in seconds, for which the
+	// outstanding gRPC and API requests are served on shutdown, once the servers
+	// stopped accepting new requests. The requests still served are then
+	// interrupted.
+	ShutdownTimeout uint64 `mapstructure:"shutdown-timeout"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -97,6 +138,19 @@ type APIConfig struct {
 	// RPCMaxBodyBytes defines the Tendermint maximum response body (in bytes)
 	RPCMaxBodyBytes uint `mapstructure:"rpc-max-body-bytes"`
 
+	// HealthMaxBlockAge defines the maximum age of the latest block (in
+	// seconds) for the node to be reported ready by /health/ready, 0 disabling
+	// the check.
+	HealthMaxBlockAge uint `mapstructure:"health-max-block-age"`
+
+	// EnableLogLevelEndpoint defines if the /log_level endpoint, changing the
+	// log levels of the node at runtime, should be registered (unsafe - the
+	// endpoint is not authenticated).
+	EnableLogLevelEndpoint bool `mapstructure:"enable-log-level-endpoint"`
+
+	// TLS defines the TLS configuration of the API server.
+	TLS TLSConfig `mapstructure:",squash"`
+
 	// TODO: TLS/Proxy configuration.
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/6420
@@ -132,6 +186,78 @@ type GRPCConfig struct {
 
 	// Address defines the API server to listen on
 	Address string `mapstructure:"address"`
+
+	// EnableReflection defines if the gRPC server reflection service should be
+	// registered, letting clients discover the services of the node.
+	EnableReflection bool `mapstructure:"enable-reflection"`
+
+	// EnableHealthCheck defines if the standard gRPC health checking service
+	// should be registered, e.g. for load balancers.
+	EnableHealthCheck bool `mapstructure:"enable-health-check"`
+
+	// TLS defines the TLS configuration of the gRPC server, which also applies
+	// to the gRPC-web server.
+	TLS TLSConfig `mapstructure:",squash"`
+}
+
+// TLSConfig defines the TLS configuration of a server, which serves TLS if a
+// certificate is set.
+type TLSConfig struct {
+	// CertFile defines the PEM encoded certificate chain of the server.
+	CertFile string `mapstructure:"tls-cert-file"`
+
+	// KeyFile defines the PEM encoded private key of the certificate.
+	KeyFile string `mapstructure:"tls-key-file"`
+
+	// ClientCAFile defines the PEM encoded certificates of the authorities the
+	// client certificates must be signed by. If set, the server only accepts
+	// the clients authenticating with such a certificate.
+	ClientCAFile string `mapstructure:"tls-client-ca-file"`
+}
+
+// Enabled returns true if the server should serve TLS.
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != ""
+}
+
+// Load returns the tls.Config of the server, or nil if TLS is disabled.
+func (c TLSConfig) Load() (*tls.Config, error) {
+	if !c.Enabled() {
+		if c.KeyFile != "" || c.ClientCAFile != "" {
+			return nil, fmt.Errorf("tls-cert-file must be set along with tls-key-file and tls-client-ca-file")
+		}
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the TLS certificate: %w", err)
+	}
+
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// gRPC clients negotiate HTTP/2, while the REST and gRPC-web clients
+		// may use HTTP/1
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+
+	if c.ClientCAFile != "" {
+		bz, err := ioutil.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the TLS client CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bz) {
+			return nil, fmt.Errorf("no PEM encoded certificate in the TLS client CA file %s", c.ClientCAFile)
+		}
+
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
 }
 
 // GRPCWebConfig defines configuration for the gRPC-web server.
@@ -139,11 +265,119 @@ type GRPCWebConfig struct {
 	// Enable defines if the gRPC-web should be enabled.
 	Enable bool `mapstructure:"enable"`
 
-	// Address defines the gRPC-web server to listen on
+	// Address defines the gRPC-web server to listen on. If empty or equal to the
+	// gRPC server address, gRPC-web is served on the gRPC server address.
 	Address string `mapstructure:"address"`
 
-	// EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk)
+	// EnableUnsafeCORS defines if cross-origin requests should be allowed from any
+	// origin (unsafe - use it at your own risk)
 	EnableUnsafeCORS bool `mapstructure:"enable-unsafe-cors"`
+
+	// CORSAllowedOrigins defines the origins allowed to make cross-origin
+	// requests, e.g. "https://app.example.com". "*" allows any origin.
+	CORSAllowedOrigins []string `mapstructure:"cors-allowed-origins"`
+
+	// CORSAllowedHeaders defines the headers allowed in cross-origin requests,
+	// in addition to the ones required by gRPC-web. "*" allows any header.
+	CORSAllowedHeaders []string `mapstructure:"cors-allowed-headers"`
+}
+
+// SharesGRPCAddress returns true if gRPC-web is served on the gRPC server
+// address grpcAddress.
+func (c GRPCWebConfig) SharesGRPCAddress(grpcAddress string) bool {
+	return c.Address == "" || c.Address == grpcAddress
+}
+
+// RateLimitConfig defines the rate limits of the requests to the API and gRPC
+// servers. Each server enforces the limits separately.
+type RateLimitConfig struct {
+	// Enable defines if the requests should be rate limited.
+	Enable bool `mapstructure:"enable"`
+
+	// GlobalRPS defines the requests per second served to all the clients.
+	// 0 disables the limit.
+	GlobalRPS float64 `mapstructure:"global-rps"`
+
+	// PerClientRPS defines the requests per second served to each client IP.
+	// 0 disables the limit.
+	PerClientRPS float64 `mapstructure:"per-client-rps"`
+
+	// MethodLimits override PerClientRPS for some methods, formatted as
+	// "<method>=<rps>". The method is a prefix of the gRPC full method names
+	// or of the REST paths, e.g. "/cosmos.bank.v1beta1.Query/DenomsMetadata=1"
+	// or "/cosmos/bank/v1beta1/denoms_metadata=1".
+	MethodLimits []string `mapstructure:"method-limits"`
+
+	// TrustForwardedFor defines if the client IP is read from the
+	// X-Forwarded-For header, which should only be trusted behind a proxy.
+	TrustForwardedFor bool `mapstructure:"trust-forwarded-for"`
+}
+
+// QueryCacheConfig defines the cache of the responses of gRPC queries, served
+// both by the gRPC server and the API server.
+type QueryCacheConfig struct {
+	// Enable defines if the query responses should be cached.
+	Enable bool `mapstructure:"enable"`
+
+	// TTL defines the maximum lifetime of the cached responses, in seconds. All
+	// the responses are removed on commit as well.
+	TTL uint `mapstructure:"ttl"`
+
+	// MaxEntries defines the maximum number of cached responses.
+	MaxEntries uint `mapstructure:"max-entries"`
+
+	// Methods defines the gRPC full method names of the cached queries, which
+	// must be idempotent.
+	Methods []string `mapstructure:"methods"`
+}
+
+// PaginationConfig defines the pagination limits of the queries served by the
+// node.
+type PaginationConfig struct {
+	// DefaultLimit defines the number of results of the page requests not
+	// supplying a limit.
+	DefaultLimit uint64 `mapstructure:"default-limit"`
+
+	// MaxLimit defines the maximum limit of the page requests, the requests over
+	// the limit being rejected. 0 disables the limit.
+	MaxLimit uint64 `mapstructure:"max-limit"`
+}
+
+// DiagnosticsConfig defines the local diagnostics server, which toggles the
+// pprof profiles and dumps the profiles and the telemetry to files without
+// restarting the node.
+type DiagnosticsConfig struct {
+	// Enable defines if the diagnostics server should be started.
+	Enable bool `mapstructure:"enable"`
+
+	// Address defines the address the diagnostics server listens on, which
+	// should only be reachable from the host.
+	Address string `mapstructure:"address"`
+
+	// Token defines the bearer token authenticating the requests.
+	Token string `mapstructure:"token"`
+
+	// Dir defines the directory the dumps are written to, relative to the home
+	// directory of the node if not absolute.
+	Dir string `mapstructure:"dir"`
+}
+
+// ValidateBasic returns an error if the diagnostics server is enabled without
+// an address or a token.
+func (c DiagnosticsConfig) ValidateBasic() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.Address == "" {
+		return fmt.Errorf("diagnostics address cannot be empty")
+	}
+
+	if c.Token == "" {
+		return fmt.Errorf("diagnostics token cannot be empty")
+	}
+
+	return nil
 }
 
 // StateSyncConfig defines the state sync snapshot configuration.
@@ -162,12 +396,17 @@ type Config struct {
 	BaseConfig `mapstructure:",squash"`
 
 	// Telemetry defines the application telemetry configuration
-	Telemetry telemetry.Config `mapstructure:"telemetry"`
-	API       APIConfig        `mapstructure:"api"`
-	GRPC      GRPCConfig       `mapstructure:"grpc"`
-	Rosetta   RosettaConfig    `mapstructure:"rosetta"`
-	GRPCWeb   GRPCWebConfig    `mapstructure:"grpc-web"`
-	StateSync StateSyncConfig  `mapstructure:"state-sync"`
+	Telemetry   telemetry.Config        `mapstructure:"telemetry"`
+	Tracing     telemetry.TracingConfig `mapstructure:"tracing"`
+	API         APIConfig               `mapstructure:"api"`
+	GRPC        GRPCConfig              `mapstructure:"grpc"`
+	Rosetta     RosettaConfig           `mapstructure:"rosetta"`
+	GRPCWeb     GRPCWebConfig           `mapstructure:"grpc-web"`
+	RateLimit   RateLimitConfig         `mapstructure:"rate-limit"`
+	QueryCache  QueryCacheConfig        `mapstructure:"query-cache"`
+	Pagination  PaginationConfig        `mapstructure:"pagination"`
+	StateSync   StateSyncConfig         `mapstructure:"state-sync"`
+	Diagnostics DiagnosticsConfig       `mapstructure:"diagnostics"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -197,6 +436,22 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 	return gasPrices
 }
 
+// DefaultQueryCacheMethods are the gRPC queries cached by default: idempotent
+// queries which are both expensive and frequently requested by explorers and
+// wallets.
+var DefaultQueryCacheMethods = []string{
+	"/cosmos.staking.v1beta1.Query/Validators",
+	"/cosmos.bank.v1beta1.Query/TotalSupply",
+	"/cosmos.distribution.v1beta1.Query/CommunityPool",
+	"/cosmos.auth.v1beta1.Query/Params",
+	"/cosmos.bank.v1beta1.Query/Params",
+	"/cosmos.distribution.v1beta1.Query/Params",
+	"/cosmos.gov.v1beta1.Query/Params",
+	"/cosmos.mint.v1beta1.Query/Params",
+	"/cosmos.slashing.v1beta1.Query/Params",
+	"/cosmos.staking.v1beta1.Query/Params",
+}
+
 // DefaultConfig returns server's default configuration.
 func DefaultConfig() *Config {
 	return &Config{
@@ -209,11 +464,19 @@ func DefaultConfig() *Config {
 			PruningInterval:   "0",
 			MinRetainBlocks:   0,
 			IndexEvents:       make([]string, 0),
+			TxDecodeMode:      "strict",
+			ShutdownTimeout:   30,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
 			GlobalLabels: [][]string{},
 		},
+		Tracing: telemetry.TracingConfig{
+			Enabled:    false,
+			Endpoint:   "localhost:4318",
+			Insecure:   true,
+			SampleRate: 1,
+		},
 		API: APIConfig{
 			Enable:             false,
 			Swagger:            false,
@@ -223,8 +486,10 @@ func DefaultConfig() *Config {
 			RPCMaxBodyBytes:    1000000,
 		},
 		GRPC: GRPCConfig{
-			Enable:  true,
-			Address: DefaultGRPCAddress,
+			Enable:            true,
+			Address:           DefaultGRPCAddress,
+			EnableReflection:  true,
+			EnableHealthCheck: true,
 		},
 		Rosetta: RosettaConfig{
 			Enable:     false,
@@ -235,13 +500,34 @@ func DefaultConfig() *Config {
 			Offline:    false,
 		},
 		GRPCWeb: GRPCWebConfig{
-			Enable:  true,
-			Address: DefaultGRPCWebAddress,
+			Enable:             true,
+			Address:            "",
+			CORSAllowedOrigins: []string{},
+			CORSAllowedHeaders: []string{"*"},
+		},
+		RateLimit: RateLimitConfig{
+			Enable:       false,
+			MethodLimits: []string{},
+		},
+		QueryCache: QueryCacheConfig{
+			Enable:     false,
+			TTL:        5,
+			MaxEntries: 1000,
+			Methods:    DefaultQueryCacheMethods,
+		},
+		Pagination: PaginationConfig{
+			DefaultLimit: query.DefaultLimit,
+			MaxLimit:     0,
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   0,
 			SnapshotKeepRecent: 2,
 		},
+		Diagnostics: DiagnosticsConfig{
+			Enable:  false,
+			Address: "localhost:6061",
+			Dir:     "diagnostics",
+		},
 	}
 }
 
@@ -258,16 +544,21 @@ func GetConfig(v *viper.Viper) Config {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:      v.GetString("minimum-gas-prices"),
-			InterBlockCache:   v.GetBool("inter-block-cache"),
-			Pruning:           v.GetString("pruning"),
-			PruningKeepRecent: v.GetString("pruning-keep-recent"),
-			PruningKeepEvery:  v.GetString("pruning-keep-every"),
-			PruningInterval:   v.GetString("pruning-interval"),
-			HaltHeight:        v.GetUint64("halt-height"),
-			HaltTime:          v.GetUint64("halt-time"),
-			IndexEvents:       v.GetStringSlice("index-events"),
-			MinRetainBlocks:   v.GetUint64("min-retain-blocks"),
+			MinGasPrices:             v.GetString("minimum-gas-prices"),
+			InterBlockCache:          v.GetBool("inter-block-cache"),
+			Pruning:                  v.GetString("pruning"),
+			PruningKeepRecent:        v.GetString("pruning-keep-recent"),
+			PruningKeepEvery:         v.GetString("pruning-keep-every"),
+			PruningInterval:          v.GetString("pruning-interval"),
+			HaltHeight:               v.GetUint64("halt-height"),
+			HaltTime:                 v.GetUint64("halt-time"),
+			IndexEvents:              v.GetStringSlice("index-events"),
+			MinRetainBlocks:          v.GetUint64("min-retain-blocks"),
+			TxDecodeMode:             v.GetString("tx-decode-mode"),
+			ReplayVerificationWindow: v.GetUint64("replay-verification-window"),
+			GasUsageWindow:           v.GetUint64("gas-usage-window"),
+			StateSizeInterval:        v.GetUint64("state-size-interval"),
+			ShutdownTimeout:          v.GetUint64("shutdown-timeout"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
@@ -278,15 +569,29 @@ func GetConfig(v *viper.Viper) Config {
 			PrometheusRetentionTime: v.GetInt64("telemetry.prometheus-retention-time"),
 			GlobalLabels:            globalLabels,
 		},
+		Tracing: telemetry.TracingConfig{
+			Enabled:     v.GetBool("tracing.enabled"),
+			Endpoint:    v.GetString("tracing.endpoint"),
+			Insecure:    v.GetBool("tracing.insecure"),
+			ServiceName: v.GetString("tracing.service-name"),
+			SampleRate:  v.GetFloat64("tracing.sample-rate"),
+		},
 		API: APIConfig{
-			Enable:             v.GetBool("api.enable"),
-			Swagger:            v.GetBool("api.swagger"),
-			Address:            v.GetString("api.address"),
-			MaxOpenConnections: v.GetUint("api.max-open-connections"),
-			RPCReadTimeout:     v.GetUint("api.rpc-read-timeout"),
-			RPCWriteTimeout:    v.GetUint("api.rpc-write-timeout"),
-			RPCMaxBodyBytes:    v.GetUint("api.rpc-max-body-bytes"),
-			EnableUnsafeCORS:   v.GetBool("api.enabled-unsafe-cors"),
+			Enable:                 v.GetBool("api.enable"),
+			Swagger:                v.GetBool("api.swagger"),
+			Address:                v.GetString("api.address"),
+			MaxOpenConnections:     v.GetUint("api.max-open-connections"),
+			RPCReadTimeout:         v.GetUint("api.rpc-read-timeout"),
+			RPCWriteTimeout:        v.GetUint("api.rpc-write-timeout"),
+			RPCMaxBodyBytes:        v.GetUint("api.rpc-max-body-bytes"),
+			EnableUnsafeCORS:       v.GetBool("api.enabled-unsafe-cors"),
+			HealthMaxBlockAge:      v.GetUint("api.health-max-block-age"),
+			EnableLogLevelEndpoint: v.GetBool("api.enable-log-level-endpoint"),
+			TLS: TLSConfig{
+				CertFile:     v.GetString("api.tls-cert-file"),
+				KeyFile:      v.GetString("api.tls-key-file"),
+				ClientCAFile: v.GetString("api.tls-client-ca-file"),
+			},
 		},
 		Rosetta: RosettaConfig{
 			Enable:     v.GetBool("rosetta.enable"),
@@ -297,18 +602,50 @@ func GetConfig(v *viper.Viper) Config {
 			Offline:    v.GetBool("rosetta.offline"),
 		},
 		GRPC: GRPCConfig{
-			Enable:  v.GetBool("grpc.enable"),
-			Address: v.GetString("grpc.address"),
+			Enable:            v.GetBool("grpc.enable"),
+			Address:           v.GetString("grpc.address"),
+			EnableReflection:  v.GetBool("grpc.enable-reflection"),
+			EnableHealthCheck: v.GetBool("grpc.enable-health-check"),
+			TLS: TLSConfig{
+				CertFile:     v.GetString("grpc.tls-cert-file"),
+				KeyFile:      v.GetString("grpc.tls-key-file"),
+				ClientCAFile: v.GetString("grpc.tls-client-ca-file"),
+			},
 		},
 		GRPCWeb: GRPCWebConfig{
-			Enable:           v.GetBool("grpc-web.enable"),
-			Address:          v.GetString("grpc-web.address"),
-			EnableUnsafeCORS: v.GetBool("grpc-web.enable-unsafe-cors"),
+			Enable:             v.GetBool("grpc-web.enable"),
+			Address:            v.GetString("grpc-web.address"),
+			EnableUnsafeCORS:   v.GetBool("grpc-web.enable-unsafe-cors"),
+			CORSAllowedOrigins: v.GetStringSlice("grpc-web.cors-allowed-origins"),
+			CORSAllowedHeaders: v.GetStringSlice("grpc-web.cors-allowed-headers"),
+		},
+		RateLimit: RateLimitConfig{
+			Enable:            v.GetBool("rate-limit.enable"),
+			GlobalRPS:         v.GetFloat64("rate-limit.global-rps"),
+			PerClientRPS:      v.GetFloat64("rate-limit.per-client-rps"),
+			MethodLimits:      v.GetStringSlice("rate-limit.method-limits"),
+			TrustForwardedFor: v.GetBool("rate-limit.trust-forwarded-for"),
+		},
+		QueryCache: QueryCacheConfig{
+			Enable:     v.GetBool("query-cache.enable"),
+			TTL:        v.GetUint("query-cache.ttl"),
+			MaxEntries: v.GetUint("query-cache.max-entries"),
+			Methods:    v.GetStringSlice("query-cache.methods"),
+		},
+		Pagination: PaginationConfig{
+			DefaultLimit: v.GetUint64("pagination.default-limit"),
+			MaxLimit:     v.GetUint64("pagination.max-limit"),
 		},
 		StateSync: StateSyncConfig{
 			SnapshotInterval:   v.GetUint64("state-sync.snapshot-interval"),
 			SnapshotKeepRecent: v.GetUint32("state-sync.snapshot-keep-recent"),
 		},
+		Diagnostics: DiagnosticsConfig{
+			Enable:  v.GetBool("diagnostics.enable"),
+			Address: v.GetString("diagnostics.address"),
+			Token:   v.GetString("diagnostics.token"),
+			Dir:     v.GetString("diagnostics.dir"),
+		},
 	}
 }
 
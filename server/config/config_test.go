@@ -1,8 +1,10 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +13,54 @@ import (
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.GetMinGasPrices().IsZero())
+	require.True(t, cfg.GRPC.EnableReflection)
+	require.True(t, cfg.GRPC.EnableHealthCheck)
+	require.False(t, cfg.RateLimit.Enable)
+}
+
+func TestRateLimitConfigTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.RateLimit = RateLimitConfig{
+		Enable:            true,
+		GlobalRPS:         100,
+		PerClientRPS:      2.5,
+		MethodLimits:      []string{"/cosmos.bank.v1beta1.Query/DenomsMetadata=1", "/cosmos/bank/v1beta1/denoms_metadata=0.5"},
+		TrustForwardedFor: true,
+	}
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.RateLimit, GetConfig(v).RateLimit)
+}
+
+func TestQueryCacheConfigTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.QueryCache, GetConfig(v).QueryCache)
+	require.Equal(t, DefaultQueryCacheMethods, GetConfig(v).QueryCache.Methods)
+}
+
+func TestPaginationConfigTemplate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Pagination = PaginationConfig{DefaultLimit: 50, MaxLimit: 500}
+
+	path := filepath.Join(t.TempDir(), "app.toml")
+	WriteConfigFile(path, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	require.NoError(t, v.ReadInConfig())
+	require.Equal(t, cfg.Pagination, GetConfig(v).Pagination)
 }
 
 func TestSetMinimumFees(t *testing.T) {
@@ -70,6 +70,45 @@ inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 # ["message.sender", "message.recipient"]
 index-events = {{ .BaseConfig.IndexEvents }}
 
+# TxDecodeMode defines how unknown protobuf fields in the transactions decoded
+# by the tx service queries are handled. "strict" rejects them everywhere except
+# for non-critical fields in the tx body, while "tolerant" also accepts unknown
+# non-critical fields in the auth info. Unknown critical fields are always
+# rejected, and the transactions executed by the node are always decoded
+# strictly, for all the validators to agree on them.
+tx-decode-mode = "{{ .BaseConfig.TxDecodeMode }}"
+
+# ReplayVerificationWindow defines the number of trailing committed blocks
+# which are re-executed in the background, on a branch of the state they were
+# executed on, to verify that they result in the same app hash. A mismatch is
+# logged and reported by the replay_verification_mismatch telemetry counter.
+# A value of 0 disables replay verification.
+#
+# Note: The window must not exceed the pruning-keep-recent heights, unless the
+# state is not pruned.
+replay-verification-window = {{ .BaseConfig.ReplayVerificationWindow }}
+
+# GasUsageWindow defines the number of trailing committed blocks whose gas
+# consumption is broken down per message type, and returned by the
+# app/gas_usage query. A value of 0 disables the breakdown.
+gas-usage-window = {{ .BaseConfig.GasUsageWindow }}
+
+# StateSizeInterval defines the interval of committed blocks at which the number
+# of keys and bytes of each store of the state are measured in the background,
+# reported through telemetry and returned by the app/state_size query with their
+# growth since the previous measurement. A value of 0 disables the measurement.
+#
+# Note: The measurement iterates the whole state, so the interval should leave
+# it the time to complete on large states.
+state-size-interval = {{ .BaseConfig.StateSizeInterval }}
+
+# ShutdownTimeout defines the maximum duration, in seconds, for which the
+# outstanding gRPC and API requests are served on shutdown (SIGINT or SIGTERM),
+# once the servers stopped accepting new requests. Meanwhile, the node is
+# stopped, the block being committed and the state sync snapshot being taken are
+# completed, and the event streams are closed.
+shutdown-timeout = {{ .BaseConfig.ShutdownTimeout }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -105,6 +144,30 @@ global-labels = [{{ range $k, $v := .Telemetry.GlobalLabels }}
   ["{{index $v 0 }}", "{{ index $v 1}}"],{{ end }}
 ]
 
+###############################################################################
+###                          Tracing Configuration                          ###
+###############################################################################
+
+[tracing]
+
+# Enabled enables the export of OpenTelemetry spans covering the execution of
+# the blocks: BeginBlock, each DeliverTx and its messages, EndBlock and Commit.
+enabled = {{ .Tracing.Enabled }}
+
+# Endpoint defines the host and port of the OTLP/HTTP collector the spans are
+# exported to.
+endpoint = "{{ .Tracing.Endpoint }}"
+
+# Insecure exports the spans over HTTP instead of HTTPS.
+insecure = {{ .Tracing.Insecure }}
+
+# ServiceName defines the name of the service the spans are attributed to.
+service-name = "{{ .Tracing.ServiceName }}"
+
+# SampleRate defines the fraction of the blocks whose execution is traced,
+# between 0 and 1.
+sample-rate = {{ .Tracing.SampleRate }}
+
 ###############################################################################
 ###                           API Configuration                             ###
 ###############################################################################
@@ -135,6 +198,27 @@ rpc-max-body-bytes = {{ .API.RPCMaxBodyBytes }}
 # EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
 enabled-unsafe-cors = {{ .API.EnableUnsafeCORS }}
 
+# HealthMaxBlockAge defines the maximum age of the latest block (in seconds) for
+# the node to be reported ready by /health/ready, 0 disabling the check.
+health-max-block-age = {{ .API.HealthMaxBlockAge }}
+
+# EnableLogLevelEndpoint defines if the /log_level endpoint, changing the log
+# levels of the node at runtime, should be registered (unsafe - the endpoint is
+# not authenticated, only enable it if the API server is not exposed publicly).
+enable-log-level-endpoint = {{ .API.EnableLogLevelEndpoint }}
+
+# TLSCertFile defines the PEM encoded certificate chain the API server is served
+# over TLS with. The API server is served in plaintext if empty.
+tls-cert-file = "{{ .API.TLS.CertFile }}"
+
+# TLSKeyFile defines the PEM encoded private key of tls-cert-file.
+tls-key-file = "{{ .API.TLS.KeyFile }}"
+
+# TLSClientCAFile defines the PEM encoded certificates of the authorities the
+# client certificates must be signed by. If set, only the clients
+# authenticating with such a certificate are served (mTLS).
+tls-client-ca-file = "{{ .API.TLS.ClientCAFile }}"
+
 ###############################################################################
 ###                           Rosetta Configuration                         ###
 ###############################################################################
@@ -171,6 +255,26 @@ enable = {{ .GRPC.Enable }}
 # Address defines the gRPC server address to bind to.
 address = "{{ .GRPC.Address }}"
 
+# EnableReflection defines if the gRPC server reflection service should be
+# registered, which tools like grpcurl use to discover the node's services.
+enable-reflection = {{ .GRPC.EnableReflection }}
+
+# EnableHealthCheck defines if the standard gRPC health checking service
+# (grpc.health.v1.Health) should be registered.
+enable-health-check = {{ .GRPC.EnableHealthCheck }}
+
+# TLSCertFile defines the PEM encoded certificate chain the gRPC and gRPC-web
+# servers are served over TLS with. They are served in plaintext if empty.
+tls-cert-file = "{{ .GRPC.TLS.CertFile }}"
+
+# TLSKeyFile defines the PEM encoded private key of tls-cert-file.
+tls-key-file = "{{ .GRPC.TLS.KeyFile }}"
+
+# TLSClientCAFile defines the PEM encoded certificates of the authorities the
+# client certificates must be signed by. If set, only the clients
+# authenticating with such a certificate are served (mTLS).
+tls-client-ca-file = "{{ .GRPC.TLS.ClientCAFile }}"
+
 ###############################################################################
 ###                        gRPC Web Configuration                           ###
 ###############################################################################
@@ -181,12 +285,91 @@ address = "{{ .GRPC.Address }}"
 # NOTE: gRPC must also be enabled, otherwise, this configuration is a no-op.
 enable = {{ .GRPCWeb.Enable }}
 
-# Address defines the gRPC-web server address to bind to.
+# Address defines the gRPC-web server address to bind to. If empty or equal to
+# the gRPC server address, gRPC-web is served on the gRPC server address.
 address = "{{ .GRPCWeb.Address }}"
 
-# EnableUnsafeCORS defines if CORS should be enabled (unsafe - use it at your own risk).
+# EnableUnsafeCORS defines if cross-origin requests should be allowed from any
+# origin (unsafe - use it at your own risk).
 enable-unsafe-cors = {{ .GRPCWeb.EnableUnsafeCORS }}
 
+# CORSAllowedOrigins defines the origins allowed to make cross-origin requests,
+# e.g. the origins of browser dapps talking to the node. "*" allows any origin.
+#
+# Example:
+# ["https://app.example.com"]
+cors-allowed-origins = [{{ range .GRPCWeb.CORSAllowedOrigins }}{{ printf "%q, " . }}{{ end }}]
+
+# CORSAllowedHeaders defines the headers allowed in cross-origin requests, in
+# addition to the ones required by gRPC-web. "*" allows any header.
+cors-allowed-headers = [{{ range .GRPCWeb.CORSAllowedHeaders }}{{ printf "%q, " . }}{{ end }}]
+
+###############################################################################
+###                        Rate Limit Configuration                         ###
+###############################################################################
+
+# Rate limits of the requests to the API and gRPC (including gRPC-web) servers,
+# each enforcing them separately. The requests over the limits are rejected
+# with the HTTP 429 status or the gRPC RESOURCE_EXHAUSTED code. Each limit
+# allows bursts of up to one second of requests.
+[rate-limit]
+
+# Enable defines if the requests should be rate limited.
+enable = {{ .RateLimit.Enable }}
+
+# GlobalRPS defines the requests per second served to all the clients (0 for no limit).
+global-rps = {{ .RateLimit.GlobalRPS }}
+
+# PerClientRPS defines the requests per second served to each client IP (0 for no limit).
+per-client-rps = {{ .RateLimit.PerClientRPS }}
+
+# MethodLimits override per-client-rps for the methods starting with a prefix of
+# the gRPC full method names or REST paths, e.g.
+# ["/cosmos.bank.v1beta1.Query/DenomsMetadata=1", "/cosmos/bank/v1beta1/denoms_metadata=1"]
+method-limits = [{{ range .RateLimit.MethodLimits }}{{ printf "%q, " . }}{{ end }}]
+
+# TrustForwardedFor defines if the client IP is read from the X-Forwarded-For header,
+# which should only be enabled behind a trusted proxy.
+trust-forwarded-for = {{ .RateLimit.TrustForwardedFor }}
+
+###############################################################################
+###                       Query Cache Configuration                         ###
+###############################################################################
+
+# Cache of the responses of gRPC queries, served both by the gRPC server and
+# the API server, by request and height. The hit rate is reported by the
+# query_cache_hit and query_cache_miss telemetry counters.
+[query-cache]
+
+# Enable defines if the query responses should be cached.
+enable = {{ .QueryCache.Enable }}
+
+# TTL defines the maximum lifetime of the cached responses, in seconds. All the
+# responses are removed on commit as well.
+ttl = {{ .QueryCache.TTL }}
+
+# MaxEntries defines the maximum number of cached responses.
+max-entries = {{ .QueryCache.MaxEntries }}
+
+# Methods defines the gRPC full method names of the cached queries, which must
+# be idempotent.
+methods = [{{ range .QueryCache.Methods }}{{ printf "%q, " . }}{{ end }}]
+
+###############################################################################
+###                        Pagination Configuration                         ###
+###############################################################################
+
+# Pagination limits of the queries served by the node, whichever the server.
+[pagination]
+
+# DefaultLimit defines the number of results of the page requests not
+# supplying a limit.
+default-limit = {{ .Pagination.DefaultLimit }}
+
+# MaxLimit defines the maximum limit of the page requests, the requests over
+# the limit being rejected. 0 disables the limit.
+max-limit = {{ .Pagination.MaxLimit }}
+
 ###############################################################################
 ###                        State Sync Configuration                         ###
 ###############################################################################
@@ -201,6 +384,31 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
+
+###############################################################################
+###                       Diagnostics Configuration                         ###
+###############################################################################
+
+# The diagnostics server enables and disables the pprof profiles, and writes
+# goroutine and heap dumps and snapshots of the telemetry to files, and reloads
+# the configuration, without restarting the node. The requests are
+# authenticated by the token, sent in the "Authorization: Bearer <token>" header.
+[diagnostics]
+
+# Enable defines if the diagnostics server should be started.
+enable = {{ .Diagnostics.Enable }}
+
+# Address defines the address the diagnostics server listens on, which should
+# only be reachable from the host.
+address = "{{ .Diagnostics.Address }}"
+
+# Token defines the bearer token authenticating the requests, required when
+# the server is enabled.
+token = "{{ .Diagnostics.Token }}"
+
+# Dir defines the directory the dumps are written to, relative to the home
+# directory of the node if not absolute.
+dir = "{{ .Diagnostics.Dir }}"
 `
 
 var configTemplate *template.Template
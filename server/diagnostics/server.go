@@ -0,0 +1,332 @@
+// Package diagnostics implements the local diagnostics server of the node,
+// which enables and disables the pprof profiles, writes profile dumps and
+// snapshots of the telemetry to files, and reloads the configuration, without
+// restarting the node.
+package diagnostics
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	rpprof "runtime/pprof"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	// blockProfileRate is the rate of the block profile while the profiles are
+	// enabled, sampling one blocking event per microsecond spent blocked.
+	blockProfileRate = 1000
+
+	// mutexProfileFraction is the fraction of the mutex contention events
+	// sampled while the profiles are enabled.
+	mutexProfileFraction = 5
+)
+
+// Profiles are the names of the profiles which can be dumped to files.
+var Profiles = []string{"goroutine", "heap", "allocs", "block", "mutex", "threadcreate"}
+
+// StatusResponse is the response of the status endpoint.
+type StatusResponse struct {
+	PprofEnabled bool `json:"pprof_enabled"`
+}
+
+// DumpResponse is the response of the dump endpoints, with the path of the
+// file written.
+type DumpResponse struct {
+	File string `json:"file"`
+}
+
+// Server defines the diagnostics server. Its requests are authenticated by a
+// bearer token, the server being meant to listen on a local address.
+type Server struct {
+	router   *mux.Router
+	logger   log.Logger
+	metrics  *telemetry.Metrics
+	token    []byte
+	dir      string
+	listener net.Listener
+	reload   func() error
+
+	// pprofEnabled is 1 while the pprof profiles are enabled.
+	pprofEnabled int32
+}
+
+// New returns the diagnostics server of cfg, writing the dumps to the
+// directory of cfg, relative to home if not absolute. The telemetry snapshots
+// are unavailable if metrics is nil, i.e. if the telemetry is disabled.
+func New(cfg config.DiagnosticsConfig, home string, metrics *telemetry.Metrics, logger log.Logger) (*Server, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	dir := cfg.Dir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(home, dir)
+	}
+
+	s := &Server{
+		router:  mux.NewRouter(),
+		logger:  logger,
+		metrics: metrics,
+		token:   []byte(cfg.Token),
+		dir:     dir,
+	}
+	s.registerRoutes()
+
+	return s, nil
+}
+
+// Handler returns the handler of the requests to the server, authenticating
+// them.
+func (s *Server) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), s.token) != 1 {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "invalid diagnostics token")
+			return
+		}
+
+		s.router.ServeHTTP(w, r)
+	})
+}
+
+// Start starts the diagnostics server on addr. It blocks until the server is
+// closed.
+func (s *Server) Start(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+
+	s.logger.Info("starting diagnostics server...", "address", addr)
+	if err := http.Serve(listener, s.Handler()); err != nil && !isClosedErr(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the diagnostics server, disabling the profiles.
+func (s *Server) Close() error {
+	s.setPprof(false)
+
+	if s.listener == nil {
+		return nil
+	}
+
+	return s.listener.Close()
+}
+
+// SetReload sets the function reloading the configuration of the node on the
+// requests to the reload endpoint. It must be called before Start.
+func (s *Server) SetReload(reload func() error) {
+	s.reload = reload
+}
+
+// PprofEnabled returns true if the pprof profiles are enabled.
+func (s *Server) PprofEnabled() bool {
+	return atomic.LoadInt32(&s.pprofEnabled) == 1
+}
+
+func (s *Server) registerRoutes() {
+	s.router.HandleFunc("/status", s.handleStatus).Methods("GET")
+	s.router.HandleFunc("/pprof/enable", s.handleSetPprof(true)).Methods("POST")
+	s.router.HandleFunc("/pprof/disable", s.handleSetPprof(false)).Methods("POST")
+	s.router.HandleFunc("/dump/{profile}", s.handleDump).Methods("POST")
+	s.router.HandleFunc("/telemetry/snapshot", s.handleTelemetrySnapshot).Methods("POST")
+	s.router.HandleFunc("/config/reload", s.handleReload).Methods("POST")
+
+	pprofRouter := s.router.PathPrefix("/debug/pprof").Subrouter()
+	pprofRouter.Use(s.requirePprof)
+	pprofRouter.HandleFunc("/cmdline", pprof.Cmdline)
+	pprofRouter.HandleFunc("/profile", pprof.Profile)
+	pprofRouter.HandleFunc("/symbol", pprof.Symbol)
+	pprofRouter.HandleFunc("/trace", pprof.Trace)
+	pprofRouter.PathPrefix("/").HandlerFunc(pprof.Index)
+}
+
+// setPprof enables or disables the pprof profiles, starting or stopping the
+// sampling of the block and mutex profiles, which are disabled by default as
+// they slow the node down.
+func (s *Server) setPprof(enable bool) {
+	if enable {
+		atomic.StoreInt32(&s.pprofEnabled, 1)
+		runtime.SetBlockProfileRate(blockProfileRate)
+		runtime.SetMutexProfileFraction(mutexProfileFraction)
+		return
+	}
+
+	if atomic.CompareAndSwapInt32(&s.pprofEnabled, 1, 0) {
+		runtime.SetBlockProfileRate(0)
+		runtime.SetMutexProfileFraction(0)
+	}
+}
+
+func (s *Server) requirePprof(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !s.PprofEnabled() {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "pprof profiles are disabled")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, StatusResponse{PprofEnabled: s.PprofEnabled()})
+}
+
+func (s *Server) handleSetPprof(enable bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		s.setPprof(enable)
+		s.logger.Info("pprof profiles toggled", "enabled", enable)
+
+		writeJSON(w, StatusResponse{PprofEnabled: s.PprofEnabled()})
+	}
+}
+
+// handleDump writes the profile of the request to a file, in the binary format
+// unless a debug level is given, e.g. debug=2 for the stacks of the goroutines
+// in the format of a panic.
+func (s *Server) handleDump(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["profile"]
+	if !isProfile(name) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown profile %s, expected one of %s", name, strings.Join(Profiles, ", ")))
+		return
+	}
+
+	debug := 0
+	if v := r.FormValue("debug"); v != "" {
+		var err error
+		if debug, err = strconv.Atoi(v); err != nil || debug < 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid debug level: %s", v))
+			return
+		}
+	}
+
+	ext := "pb.gz"
+	if debug > 0 {
+		ext = "txt"
+	}
+
+	if name == "heap" {
+		// report the up-to-date statistics of the garbage collected objects
+		runtime.GC()
+	}
+
+	path, err := s.writeFile(name, ext, func(f *os.File) error {
+		return rpprof.Lookup(name).WriteTo(f, debug)
+	})
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to dump the %s profile: %s", name, err))
+		return
+	}
+
+	s.logger.Info("profile dumped", "profile", name, "file", path)
+	writeJSON(w, DumpResponse{File: path})
+}
+
+// handleTelemetrySnapshot writes the current telemetry to a file, in the format
+// of the request, as returned by the metrics endpoint of the API server.
+func (s *Server) handleTelemetrySnapshot(w http.ResponseWriter, r *http.Request) {
+	if s.metrics == nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "telemetry is disabled")
+		return
+	}
+
+	format := strings.TrimSpace(r.FormValue("format"))
+	gr, err := s.metrics.Gather(format)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to gather metrics: %s", err))
+		return
+	}
+
+	ext := "json"
+	if format == telemetry.FormatPrometheus {
+		ext = "txt"
+	}
+
+	path, err := s.writeFile("telemetry", ext, func(f *os.File) error {
+		_, err := f.Write(gr.Metrics)
+		return err
+	})
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("failed to write the telemetry: %s", err))
+		return
+	}
+
+	s.logger.Info("telemetry snapshot written", "file", path)
+	writeJSON(w, DumpResponse{File: path})
+}
+
+func (s *Server) handleReload(w http.ResponseWriter, _ *http.Request) {
+	if s.reload == nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "configuration reloading is unavailable")
+		return
+	}
+
+	if err := s.reload(); err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to reload the configuration: %s", err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeFile creates the file of the dump of name in the directory of the
+// server, named after the current time, and writes it with write.
+func (s *Server) writeFile(name, ext string, write func(f *os.File) error) (string, error) {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(s.dir, fmt.Sprintf("%s-%d.%s", name, time.Now().UnixNano(), ext))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+
+	return path, f.Close()
+}
+
+func isProfile(name string) bool {
+	for _, p := range Profiles {
+		if p == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isClosedErr(err error) bool {
+	return strings.Contains(err.Error(), "use of closed network connection")
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
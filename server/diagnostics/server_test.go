@@ -0,0 +1,152 @@
+package diagnostics_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/diagnostics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const token = "secret"
+
+func newServer(t *testing.T, metrics *telemetry.Metrics) (*diagnostics.Server, http.Handler, string) {
+	home := t.TempDir()
+	cfg := config.DefaultConfig().Diagnostics
+	cfg.Enable = true
+	cfg.Token = token
+
+	s, err := diagnostics.New(cfg, home, metrics, log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+
+	return s, s.Handler(), filepath.Join(home, cfg.Dir)
+}
+
+func serve(handler http.Handler, method, target, auth string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	if auth != "" {
+		req.Header.Set("Authorization", "Bearer "+auth)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestNewRequiresToken(t *testing.T) {
+	cfg := config.DefaultConfig().Diagnostics
+	cfg.Enable = true
+
+	_, err := diagnostics.New(cfg, t.TempDir(), nil, log.NewNopLogger())
+	require.Error(t, err)
+}
+
+func TestAuthentication(t *testing.T) {
+	_, handler, _ := newServer(t, nil)
+
+	require.Equal(t, http.StatusUnauthorized, serve(handler, "GET", "/status", "").Code)
+	require.Equal(t, http.StatusUnauthorized, serve(handler, "GET", "/status", "wrong").Code)
+	require.Equal(t, http.StatusOK, serve(handler, "GET", "/status", token).Code)
+}
+
+func TestTogglePprof(t *testing.T) {
+	s, handler, _ := newServer(t, nil)
+
+	require.False(t, s.PprofEnabled())
+	require.Equal(t, http.StatusNotFound, serve(handler, "GET", "/debug/pprof/", token).Code)
+
+	rec := serve(handler, "POST", "/pprof/enable", token)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res diagnostics.StatusResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.True(t, res.PprofEnabled)
+	require.True(t, s.PprofEnabled())
+
+	rec = serve(handler, "GET", "/debug/pprof/", token)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), "goroutine")
+	require.Equal(t, http.StatusOK, serve(handler, "GET", "/debug/pprof/goroutine?debug=1", token).Code)
+
+	require.Equal(t, http.StatusOK, serve(handler, "POST", "/pprof/disable", token).Code)
+	require.False(t, s.PprofEnabled())
+	require.Equal(t, http.StatusNotFound, serve(handler, "GET", "/debug/pprof/", token).Code)
+}
+
+func TestDump(t *testing.T) {
+	_, handler, dir := newServer(t, nil)
+
+	rec := serve(handler, "POST", "/dump/goroutine?debug=2", token)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res diagnostics.DumpResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, dir, filepath.Dir(res.File))
+
+	bz, err := ioutil.ReadFile(res.File)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "goroutine")
+
+	rec = serve(handler, "POST", "/dump/heap", token)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.True(t, strings.HasSuffix(res.File, ".pb.gz"))
+	require.FileExists(t, res.File)
+
+	require.Equal(t, http.StatusBadRequest, serve(handler, "POST", "/dump/cpu", token).Code)
+	require.Equal(t, http.StatusBadRequest, serve(handler, "POST", "/dump/heap?debug=x", token).Code)
+}
+
+func TestTelemetrySnapshot(t *testing.T) {
+	_, handler, _ := newServer(t, nil)
+	require.Equal(t, http.StatusBadRequest, serve(handler, "POST", "/telemetry/snapshot", token).Code)
+
+	metrics, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+	telemetry.IncrCounter(1, "diagnostics_test")
+
+	_, handler, _ = newServer(t, metrics)
+	rec := serve(handler, "POST", "/telemetry/snapshot", token)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res diagnostics.DumpResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+
+	bz, err := ioutil.ReadFile(res.File)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), "diagnostics_test")
+
+	require.Equal(t, http.StatusBadRequest, serve(handler, "POST", "/telemetry/snapshot?format=prometheus", token).Code)
+}
+
+func TestReload(t *testing.T) {
+	s, handler, _ := newServer(t, nil)
+	require.Equal(t, http.StatusBadRequest, serve(handler, "POST", "/config/reload", token).Code)
+
+	var reloads int
+	s.SetReload(func() error {
+		reloads++
+		if reloads > 1 {
+			return errors.New("invalid config")
+		}
+		return nil
+	})
+	require.Equal(t, http.StatusNoContent, serve(handler, "POST", "/config/reload", token).Code)
+
+	rec := serve(handler, "POST", "/config/reload", token)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "invalid config")
+	require.Equal(t, 2, reloads)
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -12,34 +13,66 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 )
 
-// StartGRPCWeb starts a gRPC-Web server on the given address.
+// StartGRPCWeb starts a gRPC-Web server on the given address, serving TLS as
+// the gRPC server does.
 func StartGRPCWeb(grpcSrv *grpc.Server, config config.Config) (*http.Server, error) {
-	var options []grpcweb.Option
-	if config.GRPCWeb.EnableUnsafeCORS {
-		options = append(options,
-			grpcweb.WithOriginFunc(func(origin string) bool {
-				return true
-			}),
-		)
-	}
-
-	wrappedServer := grpcweb.WrapServer(grpcSrv, options...)
 	grpcWebSrv := &http.Server{
 		Addr:    config.GRPCWeb.Address,
-		Handler: wrappedServer,
+		Handler: wrapGRPCWeb(grpcSrv, config.GRPCWeb),
+	}
+
+	tlsCfg, err := config.GRPC.TLS.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := listen(config.GRPCWeb.Address, tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := serveGRPCWeb(grpcWebSrv, listener); err != nil {
+		return nil, err
 	}
 
+	return grpcWebSrv, nil
+}
+
+// wrapGRPCWeb returns a gRPC-web handler of the requests to grpcSrv, allowing
+// the cross-origin requests configured by cfg.
+func wrapGRPCWeb(grpcSrv *grpc.Server, cfg config.GRPCWebConfig) *grpcweb.WrappedGrpcServer {
+	allowedOrigins := make(map[string]bool, len(cfg.CORSAllowedOrigins))
+	for _, origin := range cfg.CORSAllowedOrigins {
+		allowedOrigins[origin] = true
+	}
+	allowAllOrigins := cfg.EnableUnsafeCORS || allowedOrigins["*"]
+
+	options := []grpcweb.Option{
+		grpcweb.WithOriginFunc(func(origin string) bool {
+			return allowAllOrigins || allowedOrigins[origin]
+		}),
+	}
+	// keep allowing any header for the configurations missing the setting
+	if len(cfg.CORSAllowedHeaders) > 0 {
+		options = append(options, grpcweb.WithAllowedRequestHeaders(cfg.CORSAllowedHeaders))
+	}
+
+	return grpcweb.WrapServer(grpcSrv, options...)
+}
+
+// serveGRPCWeb serves grpcWebSrv on listener.
+func serveGRPCWeb(grpcWebSrv *http.Server, listener net.Listener) error {
 	errCh := make(chan error)
 	go func() {
-		if err := grpcWebSrv.ListenAndServe(); err != nil {
+		if err := grpcWebSrv.Serve(listener); err != nil && err != http.ErrServerClosed {
 			errCh <- fmt.Errorf("[grpc] failed to serve: %w", err)
 		}
 	}()
 
 	select {
 	case err := <-errCh:
-		return nil, err
+		return err
 	case <-time.After(types.ServerStartTime): // assume server started successfully
-		return grpcWebSrv, nil
+		return nil
 	}
 }
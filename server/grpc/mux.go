@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// protocolDetectionTimeout is how long a connection has to send the first bytes
+// identifying its protocol.
+const protocolDetectionTimeout = 10 * time.Second
+
+var errListenerClosed = errors.New("listener closed")
+
+// protocolMux splits the connections accepted by a listener between the native
+// gRPC connections, which start with the HTTP/2 client preface, and the HTTP/1
+// connections, e.g. of gRPC-web clients.
+type protocolMux struct {
+	root net.Listener
+
+	grpc  *muxListener
+	http1 *muxListener
+
+	mtx    sync.Mutex
+	closed int
+}
+
+func newProtocolMux(root net.Listener) *protocolMux {
+	m := &protocolMux{root: root}
+	m.grpc = newMuxListener(m)
+	m.http1 = newMuxListener(m)
+
+	go m.serve()
+
+	return m
+}
+
+func (m *protocolMux) serve() {
+	for {
+		conn, err := m.root.Accept()
+		if ne, ok := err.(net.Error); ok && ne.Temporary() {
+			time.Sleep(5 * time.Millisecond)
+			continue
+		}
+		if err != nil {
+			m.grpc.closeWithErr(err)
+			m.http1.closeWithErr(err)
+			return
+		}
+
+		go m.dispatch(conn)
+	}
+}
+
+// dispatch reads the first bytes of conn, as long as they match the HTTP/2
+// client preface, and hands the connection over to the matching listener.
+func (m *protocolMux) dispatch(conn net.Conn) {
+	preface := []byte(http2.ClientPreface)
+	buf := make([]byte, 0, len(preface))
+
+	_ = conn.SetReadDeadline(time.Now().Add(protocolDetectionTimeout))
+	for len(buf) < len(preface) && bytes.HasPrefix(preface, buf) {
+		n, err := conn.Read(buf[len(buf):cap(buf)])
+		if err != nil {
+			_ = conn.Close()
+			return
+		}
+		buf = buf[:len(buf)+n]
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	peeked := &peekedConn{Conn: conn, peeked: buf}
+	if bytes.Equal(buf, preface) {
+		m.grpc.push(peeked)
+	} else {
+		m.http1.push(peeked)
+	}
+}
+
+// listenerClosed closes the root listener once both child listeners are closed.
+func (m *protocolMux) listenerClosed() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.closed++
+	if m.closed == 2 {
+		_ = m.root.Close()
+	}
+}
+
+// muxListener is a net.Listener accepting the connections dispatched to it by
+// a protocolMux.
+type muxListener struct {
+	mux   *protocolMux
+	conns chan net.Conn
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+func newMuxListener(mux *protocolMux) *muxListener {
+	return &muxListener{
+		mux:   mux,
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+func (l *muxListener) push(conn net.Conn) {
+	select {
+	case l.conns <- conn:
+	case <-l.done:
+		_ = conn.Close()
+	}
+}
+
+// Accept implements net.Listener.
+func (l *muxListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, l.err
+	}
+}
+
+// Close implements net.Listener.
+func (l *muxListener) Close() error {
+	if l.closeWithErr(errListenerClosed) {
+		l.mux.listenerClosed()
+	}
+	return nil
+}
+
+// closeWithErr closes the listener, whose calls to Accept then return err. It
+// returns false if the listener was already closed.
+func (l *muxListener) closeWithErr(err error) bool {
+	closed := false
+	l.once.Do(func() {
+		l.err = err
+		close(l.done)
+		closed = true
+	})
+	return closed
+}
+
+// Addr implements net.Listener.
+func (l *muxListener) Addr() net.Addr {
+	return l.mux.root.Addr()
+}
+
+// peekedConn is a net.Conn whose first bytes were already read.
+type peekedConn struct {
+	net.Conn
+	peeked []byte
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	if len(c.peeked) > 0 {
+		n := copy(b, c.peeked)
+		c.peeked = c.peeked[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
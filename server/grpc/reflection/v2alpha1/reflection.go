@@ -3,9 +3,15 @@ package v2alpha1
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,7 +37,9 @@ func Register(srv *grpc.Server, conf Config) error {
 }
 
 type reflectionServiceServer struct {
-	desc *AppDescriptor
+	desc  *AppDescriptor
+	ir    codectypes.InterfaceRegistry
+	types []*TypeDescriptor
 }
 
 func (r reflectionServiceServer) GetAuthnDescriptor(_ context.Context, _ *GetAuthnDescriptorRequest) (*GetAuthnDescriptorResponse, error) {
@@ -58,6 +66,23 @@ func (r reflectionServiceServer) GetTxDescriptor(_ context.Context, _ *GetTxDesc
 	return &GetTxDescriptorResponse{Tx: r.desc.Tx}, nil
 }
 
+func (r reflectionServiceServer) GetTypeDescriptors(_ context.Context, req *GetTypeDescriptorsRequest) (*GetTypeDescriptorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.TypeUrls) == 0 {
+		return &GetTypeDescriptorsResponse{Types: r.types}, nil
+	}
+
+	types, err := newTypeDescriptors(r.ir, req.TypeUrls)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &GetTypeDescriptorsResponse{Types: types}, nil
+}
+
 func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionServiceServer, error) {
 	// set chain descriptor
 	chainDescriptor := &ChainDescriptor{Id: conf.ChainID}
@@ -97,8 +122,19 @@ func newReflectionServiceServer(grpcSrv *grpc.Server, conf Config) (reflectionSe
 		}
 		ifaceImplementers[iface.Fullname] = impls
 	}
+	// describe all the registered implementations
+	var implementations []string
+	for _, impls := range ifaceImplementers {
+		implementations = append(implementations, impls...)
+	}
+	types, err := newTypeDescriptors(conf.InterfaceRegistry, implementations)
+	if err != nil {
+		return reflectionServiceServer{}, fmt.Errorf("unable to create type descriptors: %w", err)
+	}
 	return reflectionServiceServer{
-		desc: desc,
+		desc:  desc,
+		ir:    conf.InterfaceRegistry,
+		types: types,
 	}, nil
 }
 
@@ -199,3 +235,105 @@ func newAuthnDescriptor(signingModes map[string]int32) *AuthnDescriptor {
 	}
 	return &AuthnDescriptor{SignModes: signModesDesc}
 }
+
+// newTypeDescriptors describes the fields of the types with the given type URLs,
+// and of all the message types they transitively reference. Type URLs are resolved
+// with the codectypes.InterfaceRegistry first, and fall back to the protobuf registry
+// so that non-interface types, such as query requests, can be described as well.
+func newTypeDescriptors(ir codectypes.InterfaceRegistry, typeURLs []string) ([]*TypeDescriptor, error) {
+	// map implementations to the interfaces they implement
+	ifaces := make(map[string][]string)
+	for _, iface := range ir.ListAllInterfaces() {
+		for _, impl := range ir.ListImplementations(iface) {
+			ifaces[impl] = append(ifaces[impl], iface)
+		}
+	}
+
+	described := make(map[string]*TypeDescriptor)
+	for _, typeURL := range typeURLs {
+		msg, err := ir.Resolve(typeURL)
+		if err != nil {
+			msg = newProtoMessage(strings.TrimPrefix(typeURL, "/"))
+		}
+		if msg == nil {
+			return nil, fmt.Errorf("unable to resolve type URL %s", typeURL)
+		}
+		if err := describeType(msg, typeURL, ifaces, described); err != nil {
+			return nil, err
+		}
+	}
+
+	types := make([]*TypeDescriptor, 0, len(described))
+	for _, typ := range described {
+		types = append(types, typ)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Fullname < types[j].Fullname })
+	return types, nil
+}
+
+// describeType adds the descriptor of msg and of the message types it references to described.
+func describeType(msg proto.Message, typeURL string, ifaces map[string][]string, described map[string]*TypeDescriptor) error {
+	pbName := proto.MessageName(msg)
+	if pbName == "" {
+		return fmt.Errorf("unable to get proto name for type %s", typeURL)
+	}
+	if _, ok := described[pbName]; ok {
+		return nil
+	}
+
+	descMsg, ok := msg.(descriptor.Message)
+	if !ok {
+		return fmt.Errorf("unable to get the descriptor of type %s", pbName)
+	}
+	_, msgDesc := descriptor.ForMessage(descMsg)
+
+	typ := &TypeDescriptor{
+		Fullname:   pbName,
+		TypeUrl:    typeURL,
+		Interfaces: ifaces[typeURL],
+		Fields:     make([]*FieldDescriptor, len(msgDesc.Field)),
+	}
+	described[pbName] = typ
+
+	for i, field := range msgDesc.Field {
+		typ.Fields[i] = &FieldDescriptor{
+			Name:     field.GetName(),
+			Number:   field.GetNumber(),
+			Type:     strings.ToLower(strings.TrimPrefix(field.GetType().String(), "TYPE_")),
+			TypeName: strings.TrimPrefix(field.GetTypeName(), "."),
+			Repeated: field.GetLabel() == descriptor.FieldDescriptorProto_LABEL_REPEATED,
+		}
+
+		if field.GetType() != descriptor.FieldDescriptorProto_TYPE_MESSAGE {
+			continue
+		}
+
+		// NOTE: map entries are not registered as standalone types, the
+		// key and value types of map fields are not described.
+		fieldMsg := newProtoMessage(typ.Fields[i].TypeName)
+		if fieldMsg == nil {
+			continue
+		}
+		if err := describeType(fieldMsg, "/"+typ.Fields[i].TypeName, ifaces, described); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newProtoMessage returns a new instance of the registered protobuf message with
+// the given fullname, or nil if no such message is registered.
+func newProtoMessage(name string) proto.Message {
+	typ := proto.MessageType(name)
+	if typ == nil {
+		return nil
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	return msg
+}
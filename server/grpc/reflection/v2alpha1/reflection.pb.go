@@ -1156,6 +1156,254 @@ func (m *GetTxDescriptorResponse) GetTx() *TxDescriptor {
 	return nil
 }
 
+// GetTypeDescriptorsRequest is the request used for the GetTypeDescriptors RPC
+type GetTypeDescriptorsRequest struct {
+	// type_urls is the list of type URLs of the types to describe, for instance
+	// "/cosmos.bank.v1beta1.MsgSend". If empty, all registered interface implementations are described.
+	TypeUrls []string `protobuf:"bytes,1,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+}
+
+func (m *GetTypeDescriptorsRequest) Reset()         { *m = GetTypeDescriptorsRequest{} }
+func (m *GetTypeDescriptorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTypeDescriptorsRequest) ProtoMessage()    {}
+func (*GetTypeDescriptorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{23}
+}
+func (m *GetTypeDescriptorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTypeDescriptorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTypeDescriptorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTypeDescriptorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTypeDescriptorsRequest.Merge(m, src)
+}
+func (m *GetTypeDescriptorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTypeDescriptorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTypeDescriptorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTypeDescriptorsRequest proto.InternalMessageInfo
+
+func (m *GetTypeDescriptorsRequest) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
+// GetTypeDescriptorsResponse is the response returned by the GetTypeDescriptors RPC
+type GetTypeDescriptorsResponse struct {
+	// types is the list of type descriptors, sorted by fullname
+	Types []*TypeDescriptor `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (m *GetTypeDescriptorsResponse) Reset()         { *m = GetTypeDescriptorsResponse{} }
+func (m *GetTypeDescriptorsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTypeDescriptorsResponse) ProtoMessage()    {}
+func (*GetTypeDescriptorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{24}
+}
+func (m *GetTypeDescriptorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTypeDescriptorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTypeDescriptorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTypeDescriptorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTypeDescriptorsResponse.Merge(m, src)
+}
+func (m *GetTypeDescriptorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTypeDescriptorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTypeDescriptorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTypeDescriptorsResponse proto.InternalMessageInfo
+
+func (m *GetTypeDescriptorsResponse) GetTypes() []*TypeDescriptor {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+// TypeDescriptor describes the fields of a protobuf message type
+type TypeDescriptor struct {
+	// fullname is the protobuf fullname of the type
+	Fullname string `protobuf:"bytes,1,opt,name=fullname,proto3" json:"fullname,omitempty"`
+	// type_url is the type URL used when marshalling the type as any
+	TypeUrl string `protobuf:"bytes,2,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// interfaces is the list of the registered interfaces the type implements
+	Interfaces []string `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	// fields is the list of fields of the type, in declaration order
+	Fields []*FieldDescriptor `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (m *TypeDescriptor) Reset()         { *m = TypeDescriptor{} }
+func (m *TypeDescriptor) String() string { return proto.CompactTextString(m) }
+func (*TypeDescriptor) ProtoMessage()    {}
+func (*TypeDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{25}
+}
+func (m *TypeDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypeDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypeDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypeDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypeDescriptor.Merge(m, src)
+}
+func (m *TypeDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypeDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypeDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypeDescriptor proto.InternalMessageInfo
+
+func (m *TypeDescriptor) GetFullname() string {
+	if m != nil {
+		return m.Fullname
+	}
+	return ""
+}
+
+func (m *TypeDescriptor) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *TypeDescriptor) GetInterfaces() []string {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+func (m *TypeDescriptor) GetFields() []*FieldDescriptor {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+// FieldDescriptor describes a field of a protobuf message type
+type FieldDescriptor struct {
+	// name is the protobuf name of the field
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// number is the protobuf field number
+	Number int32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// type is the protobuf type of the field, for instance "string", "uint64", "message" or "enum"
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// type_name is the protobuf fullname of the field type for message and enum fields
+	TypeName string `protobuf:"bytes,4,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`
+	// repeated is true if the field is a repeated field
+	Repeated bool `protobuf:"varint,5,opt,name=repeated,proto3" json:"repeated,omitempty"`
+}
+
+func (m *FieldDescriptor) Reset()         { *m = FieldDescriptor{} }
+func (m *FieldDescriptor) String() string { return proto.CompactTextString(m) }
+func (*FieldDescriptor) ProtoMessage()    {}
+func (*FieldDescriptor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{26}
+}
+func (m *FieldDescriptor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldDescriptor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldDescriptor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldDescriptor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldDescriptor.Merge(m, src)
+}
+func (m *FieldDescriptor) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldDescriptor) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldDescriptor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldDescriptor proto.InternalMessageInfo
+
+func (m *FieldDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FieldDescriptor) GetNumber() int32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *FieldDescriptor) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FieldDescriptor) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
+}
+
+func (m *FieldDescriptor) GetRepeated() bool {
+	if m != nil {
+		return m.Repeated
+	}
+	return false
+}
+
 // QueryServicesDescriptor contains the list of cosmos-sdk queriable services
 type QueryServicesDescriptor struct {
 	// query_services is a list of cosmos-sdk QueryServiceDescriptor
@@ -1166,7 +1414,7 @@ func (m *QueryServicesDescriptor) Reset()         { *m = QueryServicesDescriptor
 func (m *QueryServicesDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryServicesDescriptor) ProtoMessage()    {}
 func (*QueryServicesDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{23}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{27}
 }
 func (m *QueryServicesDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1216,7 +1464,7 @@ func (m *QueryServiceDescriptor) Reset()         { *m = QueryServiceDescriptor{}
 func (m *QueryServiceDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryServiceDescriptor) ProtoMessage()    {}
 func (*QueryServiceDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{24}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{28}
 }
 func (m *QueryServiceDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1529,7 @@ func (m *QueryMethodDescriptor) Reset()         { *m = QueryMethodDescriptor{} }
 func (m *QueryMethodDescriptor) String() string { return proto.CompactTextString(m) }
 func (*QueryMethodDescriptor) ProtoMessage()    {}
 func (*QueryMethodDescriptor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_15c91f0b8d6bf3d0, []int{25}
+	return fileDescriptor_15c91f0b8d6bf3d0, []int{29}
 }
 func (m *QueryMethodDescriptor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,6 +1596,10 @@ func init() {
 	proto.RegisterType((*GetQueryServicesDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetQueryServicesDescriptorResponse")
 	proto.RegisterType((*GetTxDescriptorRequest)(nil), "cosmos.base.reflection.v2alpha1.GetTxDescriptorRequest")
 	proto.RegisterType((*GetTxDescriptorResponse)(nil), "cosmos.base.reflection.v2alpha1.GetTxDescriptorResponse")
+	proto.RegisterType((*GetTypeDescriptorsRequest)(nil), "cosmos.base.reflection.v2alpha1.GetTypeDescriptorsRequest")
+	proto.RegisterType((*GetTypeDescriptorsResponse)(nil), "cosmos.base.reflection.v2alpha1.GetTypeDescriptorsResponse")
+	proto.RegisterType((*TypeDescriptor)(nil), "cosmos.base.reflection.v2alpha1.TypeDescriptor")
+	proto.RegisterType((*FieldDescriptor)(nil), "cosmos.base.reflection.v2alpha1.FieldDescriptor")
 	proto.RegisterType((*QueryServicesDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryServicesDescriptor")
 	proto.RegisterType((*QueryServiceDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryServiceDescriptor")
 	proto.RegisterType((*QueryMethodDescriptor)(nil), "cosmos.base.reflection.v2alpha1.QueryMethodDescriptor")
@@ -1358,80 +1610,89 @@ func init() {
 }
 
 var fileDescriptor_15c91f0b8d6bf3d0 = []byte{
-	// 1155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x3a, 0xbf, 0x5f, 0x9b, 0x46, 0x0c, 0x24, 0x71, 0xdd, 0xe2, 0xa6, 0x1b, 0x09, 0xf5,
-	0x52, 0xbb, 0x49, 0xa3, 0xb4, 0x82, 0x94, 0xca, 0x69, 0x68, 0x15, 0x89, 0xa0, 0xe0, 0xa4, 0x80,
-	0x10, 0xea, 0x6a, 0xbd, 0x3b, 0x5e, 0x8f, 0xf0, 0xee, 0x6c, 0x76, 0xc6, 0xc1, 0xb9, 0x72, 0xe0,
-	0x0c, 0xe2, 0x4f, 0xe0, 0xc0, 0x9d, 0xbf, 0x02, 0xc1, 0xa5, 0x12, 0x17, 0x8e, 0x28, 0x41, 0xe2,
-	0x00, 0x7f, 0x04, 0x9a, 0x1f, 0x76, 0xc6, 0xce, 0xda, 0xde, 0x24, 0x3d, 0x25, 0xb3, 0xef, 0xbd,
-	0x6f, 0xbe, 0xef, 0xed, 0xe8, 0x7d, 0xb3, 0x86, 0x07, 0x1e, 0x65, 0x21, 0x65, 0xe5, 0x9a, 0xcb,
-	0x70, 0x39, 0xc1, 0xf5, 0x26, 0xf6, 0x38, 0xa1, 0x51, 0xf9, 0x68, 0xcd, 0x6d, 0xc6, 0x0d, 0x77,
-	0xd5, 0x78, 0x56, 0x8a, 0x13, 0xca, 0x29, 0xba, 0xa3, 0x2a, 0x4a, 0xa2, 0xa2, 0x64, 0x44, 0x3b,
-	0x15, 0x85, 0xdb, 0x01, 0xa5, 0x41, 0x13, 0x97, 0xdd, 0x98, 0x94, 0xdd, 0x28, 0xa2, 0xdc, 0x15,
-	0x71, 0xa6, 0xca, 0xed, 0x7f, 0xc6, 0x61, 0xae, 0x12, 0xc7, 0xdb, 0x98, 0x79, 0x09, 0x89, 0x39,
-	0x4d, 0xd0, 0x73, 0x98, 0x74, 0x5b, 0xbc, 0x11, 0xe5, 0xad, 0x65, 0xeb, 0xde, 0xb5, 0xb5, 0x07,
-	0xa5, 0x11, 0x1b, 0x94, 0x2a, 0x22, 0xfb, 0x0c, 0xa0, 0xaa, 0xca, 0x05, 0x8e, 0xd7, 0x70, 0x49,
-	0x94, 0xcf, 0x65, 0xc4, 0x79, 0x26, 0xb2, 0x4d, 0x1c, 0x59, 0x2e, 0x71, 0xa8, 0x8f, 0xbd, 0xfc,
-	0x78, 0x56, 0x1c, 0x91, 0xdd, 0x83, 0x23, 0x1e, 0xa0, 0x57, 0x30, 0xe7, 0xd1, 0xa8, 0x4e, 0x82,
-	0x56, 0x22, 0x3b, 0x90, 0x9f, 0x90, 0x78, 0x8f, 0x33, 0xe0, 0x19, 0x55, 0x06, 0x6e, 0x2f, 0x1c,
-	0x72, 0xe0, 0xc6, 0x61, 0x0b, 0x27, 0xc7, 0x0e, 0xc3, 0xc9, 0x11, 0xf1, 0x30, 0xcb, 0x4f, 0x66,
-	0xdc, 0xe0, 0x53, 0x51, 0xb6, 0xaf, 0xab, 0xcc, 0x0d, 0x0e, 0xcd, 0x00, 0x7a, 0x02, 0x39, 0xde,
-	0xce, 0x4f, 0x49, 0xd0, 0xfb, 0x23, 0x41, 0x0f, 0xda, 0x06, 0x52, 0x8e, 0xb7, 0xed, 0x08, 0xae,
-	0x9b, 0xcf, 0x50, 0x01, 0x66, 0xea, 0xad, 0x66, 0x33, 0x72, 0x43, 0x2c, 0x5f, 0xf5, 0x6c, 0xb5,
-	0xbb, 0x46, 0x5b, 0x30, 0x11, 0xb2, 0x80, 0xe5, 0x73, 0xcb, 0xe3, 0xf7, 0xae, 0xad, 0x95, 0x46,
-	0x6e, 0xb6, 0xcb, 0x02, 0x63, 0x37, 0x59, 0x6b, 0x37, 0x60, 0xbe, 0xef, 0x64, 0xa0, 0x97, 0x00,
-	0x8c, 0x04, 0x91, 0x13, 0x52, 0x1f, 0xb3, 0xbc, 0x25, 0xc1, 0x37, 0x46, 0x82, 0xef, 0x93, 0x20,
-	0x22, 0x51, 0xb0, 0x4b, 0x7d, 0x6c, 0x6c, 0x32, 0x2b, 0x90, 0xc4, 0x33, 0x66, 0xff, 0x60, 0xc1,
-	0x42, 0x6a, 0x12, 0x42, 0x30, 0x61, 0xe8, 0x93, 0xff, 0xa3, 0x45, 0x98, 0x8a, 0x5a, 0x61, 0x0d,
-	0x27, 0xf2, 0x60, 0x4e, 0x56, 0xf5, 0x0a, 0x7d, 0x0c, 0x2b, 0xf2, 0xe0, 0x3a, 0x24, 0xaa, 0x53,
-	0x27, 0x4e, 0xe8, 0x11, 0xf1, 0x71, 0xe2, 0x84, 0x98, 0x37, 0xa8, 0xef, 0x74, 0x5b, 0x35, 0x2e,
-	0xa1, 0xee, 0xc8, 0xd4, 0x9d, 0xa8, 0x4e, 0xf7, 0x74, 0xe2, 0xae, 0xcc, 0x7b, 0xae, 0xd3, 0xec,
-	0xbb, 0x30, 0xdf, 0x77, 0x9e, 0xd1, 0x0d, 0xc8, 0x11, 0x5f, 0x53, 0xc9, 0x11, 0xdf, 0x0e, 0x60,
-	0xbe, 0xef, 0xa8, 0xa2, 0x03, 0x00, 0x12, 0x71, 0x9c, 0xd4, 0x5d, 0xaf, 0xdb, 0xa0, 0xf5, 0x91,
-	0x0d, 0xda, 0xe9, 0x94, 0x18, 0xed, 0x31, 0x70, 0xec, 0x5f, 0x72, 0xf0, 0x76, 0x4a, 0xce, 0xd0,
-	0x13, 0xf0, 0x9d, 0x05, 0xb7, 0xbb, 0x10, 0x8e, 0xeb, 0x79, 0x38, 0xe6, 0x24, 0x0a, 0x9c, 0x10,
-	0x33, 0xe6, 0x06, 0xb8, 0x73, 0x34, 0xb6, 0xb3, 0x93, 0xab, 0x74, 0x30, 0x76, 0x15, 0x84, 0x41,
-	0xb6, 0x40, 0x06, 0x25, 0x31, 0x74, 0x04, 0x8b, 0x67, 0x3c, 0x48, 0x18, 0x37, 0x71, 0x88, 0xc5,
-	0x9a, 0xe5, 0xc7, 0x25, 0x83, 0xa7, 0xd9, 0x19, 0xec, 0x9c, 0x55, 0x1b, 0x9b, 0x2f, 0x90, 0x94,
-	0x38, 0xb3, 0x3f, 0x87, 0xe2, 0xf0, 0xc2, 0xa1, 0xed, 0xbb, 0x09, 0x33, 0xfc, 0x38, 0xc6, 0x4e,
-	0x2b, 0x69, 0xca, 0x63, 0x36, 0x5b, 0x9d, 0x16, 0xeb, 0x97, 0x49, 0xd3, 0xfe, 0x06, 0x56, 0x32,
-	0xf4, 0x64, 0x28, 0xfa, 0x3a, 0x2c, 0xd6, 0x09, 0x6e, 0xfa, 0x8e, 0xdf, 0xcd, 0x77, 0x44, 0x40,
-	0xbd, 0x95, 0xd9, 0xea, 0x3b, 0x32, 0x7a, 0x06, 0xf6, 0x89, 0x88, 0xd9, 0x5f, 0xc1, 0xd2, 0x80,
-	0x51, 0x86, 0x2a, 0xf0, 0x6e, 0x0d, 0x7b, 0x8d, 0x87, 0x6b, 0xe2, 0x4d, 0xd3, 0x56, 0xc4, 0x1d,
-	0xd7, 0xf7, 0x13, 0xcc, 0x98, 0x13, 0x27, 0xb8, 0x4e, 0xda, 0x9a, 0x41, 0x41, 0x25, 0x55, 0x54,
-	0x4e, 0x45, 0xa5, 0xec, 0xc9, 0x0c, 0x7b, 0x15, 0xe6, 0x7a, 0xa6, 0x00, 0x5a, 0x86, 0xeb, 0x21,
-	0x0b, 0x9c, 0x6e, 0x1b, 0x14, 0x04, 0x84, 0x2c, 0x38, 0xd0, 0x9d, 0xb8, 0x05, 0x37, 0x5f, 0x60,
-	0xde, 0x6f, 0x1f, 0xf8, 0xb0, 0x85, 0x19, 0xb7, 0x7d, 0x28, 0xa4, 0x05, 0x59, 0x4c, 0x23, 0x86,
-	0xdf, 0x94, 0x49, 0x69, 0x0a, 0xfd, 0xce, 0xd3, 0x43, 0xe1, 0x5c, 0xf0, 0x8c, 0x82, 0xf2, 0x37,
-	0xeb, 0x4a, 0xfe, 0xd6, 0xa1, 0xd0, 0x67, 0x5a, 0xbd, 0x14, 0xfa, 0x83, 0x06, 0x05, 0x69, 0x8d,
-	0xd6, 0x95, 0xac, 0xd1, 0x5e, 0x81, 0xbb, 0x72, 0x97, 0x74, 0x9f, 0xd3, 0x54, 0x8e, 0xc0, 0x1e,
-	0x96, 0xa4, 0x29, 0xed, 0xc1, 0x94, 0xb2, 0x45, 0xcd, 0xe9, 0xf2, 0xf6, 0xaa, 0x71, 0x34, 0xb9,
-	0x41, 0x1e, 0xa9, 0xc9, 0xb5, 0x25, 0xb9, 0x81, 0x49, 0x9a, 0x5c, 0x15, 0xa6, 0x85, 0xa5, 0x12,
-	0x39, 0x5b, 0xaf, 0xe6, 0xcd, 0x1d, 0x20, 0x3b, 0x0f, 0x8b, 0x2f, 0x30, 0xef, 0x71, 0x5b, 0xcd,
-	0xe9, 0x0b, 0x58, 0x3a, 0x17, 0xd1, 0x44, 0x94, 0x95, 0x5b, 0x97, 0xb5, 0xf2, 0x63, 0x58, 0x1a,
-	0xc0, 0x0b, 0xbd, 0x3a, 0x77, 0x0b, 0x51, 0x2e, 0xf2, 0xe8, 0x42, 0x4a, 0x07, 0x5e, 0x42, 0xec,
-	0x9f, 0x2c, 0x58, 0x4c, 0xcf, 0x1c, 0x3a, 0xb1, 0x6e, 0xc1, 0x2c, 0x61, 0xc2, 0xf7, 0x5b, 0x4d,
-	0x2c, 0x07, 0xe2, 0x4c, 0x75, 0x86, 0xb0, 0x5d, 0xb9, 0x46, 0x7b, 0x30, 0xad, 0x5c, 0xb6, 0x33,
-	0xd3, 0x37, 0xb2, 0x91, 0x55, 0x96, 0x6b, 0xbe, 0x14, 0x0d, 0x63, 0xef, 0xc3, 0x42, 0x6a, 0x46,
-	0xea, 0x85, 0xe0, 0x3d, 0x98, 0x17, 0x3c, 0x1d, 0xd5, 0xb7, 0xd8, 0xe5, 0x0d, 0x3d, 0xb2, 0xe7,
-	0xc4, 0x63, 0x89, 0xb3, 0xe7, 0xf2, 0xc6, 0xda, 0xcf, 0x00, 0x6f, 0x55, 0xbb, 0x5c, 0xb4, 0x7e,
-	0xf4, 0xbb, 0x05, 0xe8, 0xfc, 0xa0, 0x42, 0xef, 0x8f, 0x94, 0x30, 0x70, 0xf4, 0x15, 0x3e, 0xb8,
-	0x54, 0xad, 0x3a, 0x5a, 0xf6, 0xe6, 0xb7, 0x7f, 0xfc, 0xfd, 0x63, 0x6e, 0x03, 0xad, 0x97, 0x07,
-	0x7d, 0x4a, 0xac, 0xd6, 0x30, 0x77, 0x57, 0xcb, 0x6e, 0x1c, 0x1b, 0xfe, 0x51, 0x56, 0x97, 0x76,
-	0xad, 0xa6, 0xff, 0xea, 0x92, 0x49, 0x4d, 0xfa, 0x14, 0xcd, 0xa6, 0x66, 0xc0, 0x90, 0xbd, 0xb4,
-	0x1a, 0xf5, 0xe9, 0xd0, 0x51, 0xd3, 0x77, 0xcb, 0xca, 0xa6, 0x26, 0x75, 0x20, 0x67, 0x54, 0x93,
-	0x3e, 0xaf, 0x2f, 0xaf, 0x46, 0x7e, 0xc0, 0xfc, 0x6b, 0x69, 0x33, 0x48, 0xf7, 0xf0, 0xad, 0x6c,
-	0xcc, 0x86, 0xcd, 0xf8, 0xc2, 0xb3, 0x2b, 0x61, 0x68, 0x95, 0xdb, 0x52, 0xe5, 0x87, 0x68, 0xf3,
-	0xc2, 0x2a, 0xcd, 0xcf, 0xa9, 0xff, 0x94, 0xda, 0x41, 0x73, 0x2e, 0x93, 0xda, 0xe1, 0xa6, 0x91,
-	0x4d, 0xed, 0x08, 0x4f, 0xb1, 0x3f, 0x92, 0x6a, 0x9f, 0xa2, 0x27, 0x17, 0x54, 0xdb, 0x3b, 0xa5,
-	0xd1, 0x6f, 0x16, 0xcc, 0xf7, 0xb9, 0x05, 0x7a, 0x94, 0x85, 0x5f, 0x8a, 0xf3, 0x14, 0x1e, 0x5f,
-	0xbc, 0xf0, 0x8a, 0xef, 0x8e, 0xb7, 0x8d, 0xd5, 0xd6, 0x67, 0xbf, 0x9e, 0x14, 0xad, 0xd7, 0x27,
-	0x45, 0xeb, 0xaf, 0x93, 0xa2, 0xf5, 0xfd, 0x69, 0x71, 0xec, 0xf5, 0x69, 0x71, 0xec, 0xcf, 0xd3,
-	0xe2, 0xd8, 0x97, 0x9b, 0x01, 0xe1, 0x8d, 0x56, 0xad, 0xe4, 0xd1, 0xb0, 0xb3, 0x83, 0xfa, 0x73,
-	0x9f, 0xf9, 0x5f, 0x97, 0x45, 0x37, 0x70, 0x52, 0x0e, 0x92, 0xd8, 0x4b, 0xfb, 0xf1, 0xa3, 0x36,
-	0x25, 0x7f, 0xb3, 0x78, 0xf8, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0xec, 0xac, 0x26,
-	0x11, 0x00, 0x00,
+	// 1301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xaf, 0x93, 0xec, 0x36, 0x79, 0xed, 0x36, 0x62, 0xa0, 0x5b, 0xd7, 0x2d, 0xe9, 0xd6, 0x95,
+	0x50, 0x2f, 0x4d, 0xba, 0xdb, 0xaa, 0xad, 0xd8, 0x96, 0x2a, 0xfd, 0x4b, 0x25, 0x16, 0x2d, 0xee,
+	0x16, 0x10, 0x42, 0xb5, 0x1c, 0x7b, 0xe2, 0x8c, 0x88, 0xff, 0xd4, 0xe3, 0x2c, 0xe9, 0x95, 0x03,
+	0x07, 0x4e, 0x20, 0x3e, 0x02, 0x9f, 0x00, 0x3e, 0x05, 0x82, 0x4b, 0x25, 0x2e, 0x1c, 0x51, 0x17,
+	0x89, 0x03, 0x5c, 0xb9, 0xa3, 0xf9, 0xe3, 0xc4, 0xf1, 0x3a, 0x89, 0x37, 0xe1, 0xb4, 0x3b, 0xf3,
+	0xde, 0xfb, 0xcd, 0xef, 0xcd, 0xbc, 0x99, 0xdf, 0x73, 0xe0, 0xaa, 0x1d, 0x50, 0x2f, 0xa0, 0xad,
+	0x8e, 0x45, 0x71, 0x2b, 0xc2, 0xdd, 0x3e, 0xb6, 0x63, 0x12, 0xf8, 0xad, 0xfd, 0x2d, 0xab, 0x1f,
+	0xf6, 0xac, 0xcd, 0xd4, 0x5c, 0x33, 0x8c, 0x82, 0x38, 0x40, 0x17, 0x44, 0x44, 0x93, 0x45, 0x34,
+	0x53, 0xd6, 0x24, 0x42, 0x3b, 0xef, 0x06, 0x81, 0xdb, 0xc7, 0x2d, 0x2b, 0x24, 0x2d, 0xcb, 0xf7,
+	0x83, 0xd8, 0x62, 0x76, 0x2a, 0xc2, 0xf5, 0xbf, 0xca, 0xb0, 0xd6, 0x0e, 0xc3, 0x07, 0x98, 0xda,
+	0x11, 0x09, 0xe3, 0x20, 0x42, 0x8f, 0x60, 0xc5, 0x1a, 0xc4, 0x3d, 0x5f, 0x55, 0x36, 0x94, 0xcb,
+	0x27, 0xb6, 0xae, 0x36, 0xe7, 0x2c, 0xd0, 0x6c, 0x33, 0xef, 0x31, 0x80, 0x21, 0xc2, 0x19, 0x8e,
+	0xdd, 0xb3, 0x88, 0xaf, 0x96, 0x0a, 0xe2, 0xdc, 0x67, 0xde, 0x69, 0x1c, 0x1e, 0xce, 0x71, 0x02,
+	0x07, 0xdb, 0x6a, 0xb9, 0x28, 0x0e, 0xf3, 0x9e, 0xc0, 0x61, 0x13, 0xe8, 0x39, 0xac, 0xd9, 0x81,
+	0xdf, 0x25, 0xee, 0x20, 0xe2, 0x3b, 0xa0, 0x56, 0x38, 0xde, 0xad, 0x02, 0x78, 0xa9, 0xa8, 0x14,
+	0xee, 0x24, 0x1c, 0x32, 0xe1, 0xd4, 0x8b, 0x01, 0x8e, 0x5e, 0x9a, 0x14, 0x47, 0xfb, 0xc4, 0xc6,
+	0x54, 0x5d, 0x29, 0xb8, 0xc0, 0x47, 0x2c, 0xec, 0xa9, 0x8c, 0x4a, 0x2f, 0xf0, 0x22, 0x6d, 0x40,
+	0x77, 0xa0, 0x14, 0x0f, 0xd5, 0x55, 0x0e, 0x7a, 0x65, 0x2e, 0xe8, 0xde, 0x30, 0x85, 0x54, 0x8a,
+	0x87, 0xba, 0x0f, 0x27, 0xd3, 0x73, 0x48, 0x83, 0x6a, 0x77, 0xd0, 0xef, 0xfb, 0x96, 0x87, 0xf9,
+	0x51, 0xd7, 0x8c, 0xd1, 0x18, 0xdd, 0x83, 0x8a, 0x47, 0x5d, 0xaa, 0x96, 0x36, 0xca, 0x97, 0x4f,
+	0x6c, 0x35, 0xe7, 0x2e, 0xb6, 0x43, 0xdd, 0xd4, 0x6a, 0x3c, 0x56, 0xef, 0x41, 0x3d, 0x53, 0x19,
+	0xe8, 0x19, 0x00, 0x25, 0xae, 0x6f, 0x7a, 0x81, 0x83, 0xa9, 0xaa, 0x70, 0xf0, 0x1b, 0x73, 0xc1,
+	0x9f, 0x12, 0xd7, 0x27, 0xbe, 0xbb, 0x13, 0x38, 0x38, 0xb5, 0x48, 0x8d, 0x21, 0xb1, 0x39, 0xaa,
+	0x7f, 0xa7, 0xc0, 0xe9, 0x5c, 0x27, 0x84, 0xa0, 0x92, 0xca, 0x8f, 0xff, 0x8f, 0xd6, 0x61, 0xd5,
+	0x1f, 0x78, 0x1d, 0x1c, 0xf1, 0xc2, 0x5c, 0x31, 0xe4, 0x08, 0x7d, 0x00, 0x97, 0x78, 0xe1, 0x9a,
+	0xc4, 0xef, 0x06, 0x66, 0x18, 0x05, 0xfb, 0xc4, 0xc1, 0x91, 0xe9, 0xe1, 0xb8, 0x17, 0x38, 0xe6,
+	0x68, 0xab, 0xca, 0x1c, 0xea, 0x02, 0x77, 0x7d, 0xe2, 0x77, 0x83, 0x5d, 0xe9, 0xb8, 0xc3, 0xfd,
+	0x1e, 0x49, 0x37, 0xfd, 0x22, 0xd4, 0x33, 0xf5, 0x8c, 0x4e, 0x41, 0x89, 0x38, 0x92, 0x4a, 0x89,
+	0x38, 0xba, 0x0b, 0xf5, 0x4c, 0xa9, 0xa2, 0x3d, 0x00, 0xe2, 0xc7, 0x38, 0xea, 0x5a, 0xf6, 0x68,
+	0x83, 0xae, 0xcf, 0xdd, 0xa0, 0x27, 0x49, 0x48, 0x6a, 0x7b, 0x52, 0x38, 0xfa, 0x4f, 0x25, 0x78,
+	0x33, 0xc7, 0x67, 0x66, 0x05, 0x7c, 0xad, 0xc0, 0xf9, 0x11, 0x84, 0x69, 0xd9, 0x36, 0x0e, 0x63,
+	0xe2, 0xbb, 0xa6, 0x87, 0x29, 0xb5, 0x5c, 0x9c, 0x94, 0xc6, 0x83, 0xe2, 0xe4, 0xda, 0x09, 0xc6,
+	0x8e, 0x80, 0x48, 0x91, 0xd5, 0xc8, 0x34, 0x27, 0x8a, 0xf6, 0x61, 0x7d, 0xcc, 0x83, 0x78, 0x61,
+	0x1f, 0x7b, 0x98, 0x8d, 0xa9, 0x5a, 0xe6, 0x0c, 0xee, 0x16, 0x67, 0xf0, 0x64, 0x1c, 0x9d, 0x5a,
+	0xfc, 0x34, 0xc9, 0xb1, 0x53, 0xfd, 0x13, 0x68, 0xcc, 0x0e, 0x9c, 0xb9, 0x7d, 0x67, 0xa1, 0x1a,
+	0xbf, 0x0c, 0xb1, 0x39, 0x88, 0xfa, 0xbc, 0xcc, 0x6a, 0xc6, 0x71, 0x36, 0x7e, 0x16, 0xf5, 0xf5,
+	0x2f, 0xe1, 0x52, 0x81, 0x3d, 0x99, 0x89, 0x7e, 0x1d, 0xd6, 0xbb, 0x04, 0xf7, 0x1d, 0xd3, 0x19,
+	0xf9, 0x9b, 0xcc, 0x20, 0x4e, 0xa5, 0x66, 0xbc, 0xc5, 0xad, 0x63, 0xb0, 0x0f, 0x99, 0x4d, 0xff,
+	0x1c, 0xce, 0x4c, 0x79, 0xca, 0x50, 0x1b, 0xde, 0xee, 0x60, 0xbb, 0x77, 0x6d, 0x8b, 0x9d, 0x74,
+	0x30, 0xf0, 0x63, 0xd3, 0x72, 0x9c, 0x08, 0x53, 0x6a, 0x86, 0x11, 0xee, 0x92, 0xa1, 0x64, 0xa0,
+	0x09, 0xa7, 0xb6, 0xf0, 0x69, 0x0b, 0x97, 0x5d, 0xee, 0xa1, 0x6f, 0xc2, 0xda, 0xc4, 0x2b, 0x80,
+	0x36, 0xe0, 0xa4, 0x47, 0x5d, 0x73, 0xb4, 0x0d, 0x02, 0x02, 0x3c, 0xea, 0xee, 0xc9, 0x9d, 0x38,
+	0x07, 0x67, 0x1f, 0xe3, 0x38, 0x2b, 0x1f, 0xf8, 0xc5, 0x00, 0xd3, 0x58, 0x77, 0x40, 0xcb, 0x33,
+	0xd2, 0x30, 0xf0, 0x29, 0xfe, 0xbf, 0x44, 0x4a, 0x52, 0xc8, 0x2a, 0xcf, 0x04, 0x85, 0x43, 0xc6,
+	0x31, 0x05, 0xa1, 0x6f, 0xca, 0x52, 0xfa, 0x96, 0x50, 0xc8, 0x88, 0xd6, 0x24, 0x85, 0xac, 0x31,
+	0x45, 0x81, 0x4b, 0xa3, 0xb2, 0x94, 0x34, 0xea, 0x97, 0xe0, 0x22, 0x5f, 0x25, 0x5f, 0xe7, 0x24,
+	0x95, 0x7d, 0xd0, 0x67, 0x39, 0x49, 0x4a, 0xbb, 0xb0, 0x2a, 0x64, 0x51, 0x55, 0x0a, 0xaa, 0xdf,
+	0x34, 0x44, 0x89, 0x23, 0xc9, 0x4d, 0xd3, 0x48, 0x49, 0x6e, 0x08, 0xfa, 0x2c, 0x27, 0x49, 0xce,
+	0x80, 0xe3, 0x4c, 0x52, 0x09, 0xa6, 0x85, 0xd9, 0x4d, 0x83, 0x4c, 0x80, 0x74, 0x15, 0xd6, 0x1f,
+	0xe3, 0x78, 0x6f, 0x78, 0x98, 0xd3, 0xa7, 0x70, 0xe6, 0x90, 0x45, 0x12, 0x11, 0x52, 0xae, 0x2c,
+	0x2a, 0xe5, 0xb7, 0x78, 0xc9, 0xb0, 0x6b, 0x34, 0x36, 0x50, 0xb9, 0x2c, 0x3a, 0x07, 0xb5, 0xe4,
+	0xce, 0x09, 0x09, 0xa9, 0x19, 0x55, 0xf9, 0xf6, 0x50, 0xdd, 0x06, 0x2d, 0x2f, 0x52, 0xd2, 0x7a,
+	0x08, 0x2b, 0xcc, 0x33, 0x51, 0x9e, 0xd6, 0x7c, 0x66, 0x13, 0x40, 0x86, 0x88, 0xd6, 0x7f, 0x54,
+	0xe0, 0xd4, 0xa4, 0x65, 0xc1, 0xb7, 0x12, 0x35, 0x26, 0xf4, 0xb0, 0xcc, 0x93, 0x49, 0xcd, 0xa0,
+	0xf7, 0x61, 0x95, 0x3f, 0x75, 0x54, 0xad, 0x6c, 0x94, 0x0b, 0xdd, 0x80, 0x47, 0x93, 0x2f, 0xa3,
+	0x21, 0xe3, 0xf5, 0x6f, 0x14, 0xa8, 0x67, 0x6c, 0x47, 0xea, 0x1e, 0x10, 0x54, 0x18, 0x69, 0xd9,
+	0x1e, 0xf0, 0xff, 0x47, 0x27, 0xc1, 0x41, 0x2a, 0x22, 0x6b, 0x36, 0xc1, 0x9e, 0x63, 0xb6, 0x23,
+	0x11, 0x0e, 0xb1, 0x15, 0x63, 0x87, 0x37, 0x8a, 0x55, 0x63, 0x34, 0xd6, 0x5f, 0xc2, 0x99, 0x29,
+	0x75, 0x87, 0x9e, 0x1f, 0xea, 0x32, 0xc5, 0x59, 0xdd, 0x3c, 0x52, 0x25, 0x4f, 0x6d, 0x32, 0xf5,
+	0x1f, 0x14, 0x58, 0xcf, 0xf7, 0x9c, 0x79, 0x86, 0xe7, 0xa0, 0x46, 0x28, 0xeb, 0xeb, 0x06, 0x7d,
+	0xcc, 0x77, 0xa6, 0x6a, 0x54, 0x09, 0xdd, 0xe1, 0x63, 0xb4, 0x0b, 0xc7, 0x45, 0x17, 0x95, 0x68,
+	0xf6, 0x8d, 0x62, 0x64, 0x45, 0x4b, 0x95, 0xbe, 0x74, 0x12, 0x46, 0x7f, 0x0a, 0xa7, 0x73, 0x3d,
+	0x72, 0x8f, 0xec, 0x1d, 0xa8, 0x33, 0x9e, 0xa6, 0xd8, 0xb7, 0xd0, 0x8a, 0x7b, 0xb2, 0xcc, 0xd6,
+	0xd8, 0x34, 0xc7, 0xd9, 0xb5, 0xe2, 0xde, 0xd6, 0xbf, 0x27, 0xe0, 0x0d, 0x63, 0xc4, 0x45, 0xe6,
+	0x8f, 0x7e, 0x55, 0x00, 0x1d, 0x16, 0x22, 0xf4, 0xee, 0xdc, 0x14, 0xa6, 0x4a, 0x9b, 0xb6, 0xbd,
+	0x50, 0xac, 0xb8, 0xa3, 0xfa, 0xed, 0xaf, 0x7e, 0xfb, 0xf3, 0xfb, 0xd2, 0x0d, 0x74, 0xbd, 0x35,
+	0xed, 0x53, 0x71, 0xb3, 0x83, 0x63, 0x6b, 0xb3, 0x65, 0x85, 0x61, 0xaa, 0x3f, 0x68, 0x89, 0x8f,
+	0x32, 0x99, 0x4d, 0xb6, 0x35, 0x2d, 0x94, 0x4d, 0xbe, 0x4a, 0x6a, 0xdb, 0x0b, 0xc5, 0x2e, 0x99,
+	0x8d, 0xf8, 0x34, 0x4c, 0xb2, 0xc9, 0x74, 0xd1, 0xc5, 0xb2, 0xc9, 0x15, 0x5c, 0x6d, 0x7b, 0xa1,
+	0xd8, 0x65, 0xb3, 0xe1, 0x1f, 0xa8, 0x7f, 0x2b, 0x52, 0xec, 0xf3, 0x7b, 0xb4, 0x7b, 0xc5, 0x98,
+	0xcd, 0xd2, 0x70, 0xed, 0xfe, 0x52, 0x18, 0x32, 0xcb, 0x07, 0x3c, 0xcb, 0xf7, 0xd0, 0xed, 0x23,
+	0x67, 0x99, 0xfe, 0x5c, 0xfe, 0x47, 0x64, 0x3b, 0xed, 0x9d, 0x2b, 0x94, 0xed, 0xec, 0xa6, 0x40,
+	0xbb, 0xbf, 0x14, 0x86, 0xcc, 0xf6, 0x21, 0xcf, 0xf6, 0x2e, 0xba, 0x73, 0xc4, 0x6c, 0x27, 0x5f,
+	0x69, 0xf4, 0x8b, 0x02, 0xf5, 0x4c, 0x37, 0x80, 0x6e, 0x16, 0xe1, 0x97, 0xd3, 0x59, 0x68, 0xb7,
+	0x8e, 0x1e, 0xb8, 0xe4, 0xd9, 0xc5, 0xc3, 0xd4, 0x28, 0xb9, 0x77, 0x99, 0x36, 0xa2, 0xd8, 0xbd,
+	0xcb, 0xef, 0x5a, 0xb4, 0xed, 0x85, 0x62, 0x97, 0xbc, 0x77, 0xbc, 0x5d, 0xb9, 0xf7, 0xf1, 0xcf,
+	0xaf, 0x1b, 0xca, 0xab, 0xd7, 0x0d, 0xe5, 0x8f, 0xd7, 0x0d, 0xe5, 0xdb, 0x83, 0xc6, 0xb1, 0x57,
+	0x07, 0x8d, 0x63, 0xbf, 0x1f, 0x34, 0x8e, 0x7d, 0x76, 0xdb, 0x25, 0x71, 0x6f, 0xd0, 0x69, 0xda,
+	0x81, 0x97, 0x20, 0x8b, 0x3f, 0x57, 0xa8, 0xf3, 0x45, 0x8b, 0x9d, 0x2d, 0x8e, 0x5a, 0x6e, 0x14,
+	0xda, 0x79, 0x3f, 0xd5, 0x75, 0x56, 0xf9, 0x2f, 0x6c, 0xd7, 0xfe, 0x1b, 0x00, 0x66, 0xb1, 0x2d,
+	0x68, 0xd4, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1460,6 +1721,10 @@ type ReflectionServiceClient interface {
 	GetQueryServicesDescriptor(ctx context.Context, in *GetQueryServicesDescriptorRequest, opts ...grpc.CallOption) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(ctx context.Context, in *GetTxDescriptorRequest, opts ...grpc.CallOption) (*GetTxDescriptorResponse, error)
+	// GetTypeDescriptors returns the field descriptors of protobuf types known to the application,
+	// alongside the descriptors of all the message types they reference. If no type URL is provided,
+	// all the implementations registered in the application's interface registry are described.
+	GetTypeDescriptors(ctx context.Context, in *GetTypeDescriptorsRequest, opts ...grpc.CallOption) (*GetTypeDescriptorsResponse, error)
 }
 
 type reflectionServiceClient struct {
@@ -1524,6 +1789,15 @@ func (c *reflectionServiceClient) GetTxDescriptor(ctx context.Context, in *GetTx
 	return out, nil
 }
 
+func (c *reflectionServiceClient) GetTypeDescriptors(ctx context.Context, in *GetTypeDescriptorsRequest, opts ...grpc.CallOption) (*GetTypeDescriptorsResponse, error) {
+	out := new(GetTypeDescriptorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.base.reflection.v2alpha1.ReflectionService/GetTypeDescriptors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReflectionServiceServer is the server API for ReflectionService service.
 type ReflectionServiceServer interface {
 	// GetAuthnDescriptor returns information on how to authenticate transactions in the application
@@ -1540,6 +1814,10 @@ type ReflectionServiceServer interface {
 	GetQueryServicesDescriptor(context.Context, *GetQueryServicesDescriptorRequest) (*GetQueryServicesDescriptorResponse, error)
 	// GetTxDescriptor returns information on the used transaction object and available msgs that can be used
 	GetTxDescriptor(context.Context, *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error)
+	// GetTypeDescriptors returns the field descriptors of protobuf types known to the application,
+	// alongside the descriptors of all the message types they reference. If no type URL is provided,
+	// all the implementations registered in the application's interface registry are described.
+	GetTypeDescriptors(context.Context, *GetTypeDescriptorsRequest) (*GetTypeDescriptorsResponse, error)
 }
 
 // UnimplementedReflectionServiceServer can be embedded to have forward compatible implementations.
@@ -1564,6 +1842,9 @@ func (*UnimplementedReflectionServiceServer) GetQueryServicesDescriptor(ctx cont
 func (*UnimplementedReflectionServiceServer) GetTxDescriptor(ctx context.Context, req *GetTxDescriptorRequest) (*GetTxDescriptorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxDescriptor not implemented")
 }
+func (*UnimplementedReflectionServiceServer) GetTypeDescriptors(ctx context.Context, req *GetTypeDescriptorsRequest) (*GetTypeDescriptorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTypeDescriptors not implemented")
+}
 
 func RegisterReflectionServiceServer(s grpc1.Server, srv ReflectionServiceServer) {
 	s.RegisterService(&_ReflectionService_serviceDesc, srv)
@@ -1677,6 +1958,24 @@ func _ReflectionService_GetTxDescriptor_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReflectionService_GetTypeDescriptors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTypeDescriptorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReflectionServiceServer).GetTypeDescriptors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.base.reflection.v2alpha1.ReflectionService/GetTypeDescriptors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReflectionServiceServer).GetTypeDescriptors(ctx, req.(*GetTypeDescriptorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.base.reflection.v2alpha1.ReflectionService",
 	HandlerType: (*ReflectionServiceServer)(nil),
@@ -1705,6 +2004,10 @@ var _ReflectionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxDescriptor",
 			Handler:    _ReflectionService_GetTxDescriptor_Handler,
 		},
+		{
+			MethodName: "GetTypeDescriptors",
+			Handler:    _ReflectionService_GetTypeDescriptors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/base/reflection/v2alpha1/reflection.proto",
@@ -2537,7 +2840,7 @@ func (m *GetTxDescriptorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
+func (m *GetTypeDescriptorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2547,20 +2850,52 @@ func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryServicesDescriptor) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTypeDescriptorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServicesDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTypeDescriptorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QueryServices) > 0 {
-		for iNdEx := len(m.QueryServices) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintReflection(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTypeDescriptorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTypeDescriptorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTypeDescriptorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.QueryServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Types[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2574,7 +2909,7 @@ func (m *QueryServicesDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryServiceDescriptor) Marshal() (dAtA []byte, err error) {
+func (m *TypeDescriptor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2584,20 +2919,20 @@ func (m *QueryServiceDescriptor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryServiceDescriptor) MarshalTo(dAtA []byte) (int, error) {
+func (m *TypeDescriptor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TypeDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Methods) > 0 {
-		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2605,18 +2940,24 @@ func (m *QueryServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintReflection(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x22
 		}
 	}
-	if m.IsModule {
-		i--
-		if m.IsModule {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Interfaces) > 0 {
+		for iNdEx := len(m.Interfaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Interfaces[iNdEx])
+			copy(dAtA[i:], m.Interfaces[iNdEx])
+			i = encodeVarintReflection(dAtA, i, uint64(len(m.Interfaces[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.TypeUrl)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Fullname) > 0 {
 		i -= len(m.Fullname)
@@ -2628,7 +2969,7 @@ func (m *QueryServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMethodDescriptor) Marshal() (dAtA []byte, err error) {
+func (m *FieldDescriptor) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2638,22 +2979,44 @@ func (m *QueryMethodDescriptor) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMethodDescriptor) MarshalTo(dAtA []byte) (int, error) {
+func (m *FieldDescriptor) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMethodDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FieldDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FullQueryPath) > 0 {
-		i -= len(m.FullQueryPath)
-		copy(dAtA[i:], m.FullQueryPath)
-		i = encodeVarintReflection(dAtA, i, uint64(len(m.FullQueryPath)))
+	if m.Repeated {
 		i--
-		dAtA[i] = 0x12
+		if m.Repeated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TypeName) > 0 {
+		i -= len(m.TypeName)
+		copy(dAtA[i:], m.TypeName)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.TypeName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Number != 0 {
+		i = encodeVarintReflection(dAtA, i, uint64(m.Number))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -2665,8 +3028,136 @@ func (m *QueryMethodDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintReflection(dAtA []byte, offset int, v uint64) int {
-	offset -= sovReflection(v)
+func (m *QueryServicesDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicesDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicesDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QueryServices) > 0 {
+		for iNdEx := len(m.QueryServices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryServices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Methods) > 0 {
+		for iNdEx := len(m.Methods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Methods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReflection(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.IsModule {
+		i--
+		if m.IsModule {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fullname) > 0 {
+		i -= len(m.Fullname)
+		copy(dAtA[i:], m.Fullname)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.Fullname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMethodDescriptor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMethodDescriptor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMethodDescriptor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FullQueryPath) > 0 {
+		i -= len(m.FullQueryPath)
+		copy(dAtA[i:], m.FullQueryPath)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.FullQueryPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintReflection(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReflection(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReflection(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -3010,6 +3501,92 @@ func (m *GetTxDescriptorResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetTypeDescriptorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTypeDescriptorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for _, e := range m.Types {
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TypeDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fullname)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if len(m.Interfaces) > 0 {
+		for _, s := range m.Interfaces {
+			l = len(s)
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovReflection(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldDescriptor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.Number != 0 {
+		n += 1 + sovReflection(uint64(m.Number))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	l = len(m.TypeName)
+	if l > 0 {
+		n += 1 + l + sovReflection(uint64(l))
+	}
+	if m.Repeated {
+		n += 2
+	}
+	return n
+}
+
 func (m *QueryServicesDescriptor) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5193,6 +5770,537 @@ func (m *GetTxDescriptorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetTypeDescriptorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTypeDescriptorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTypeDescriptorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTypeDescriptorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTypeDescriptorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTypeDescriptorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, &TypeDescriptor{})
+			if err := m.Types[len(m.Types)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TypeDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypeDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypeDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fullname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fullname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interfaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interfaces = append(m.Interfaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &FieldDescriptor{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldDescriptor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReflection
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldDescriptor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldDescriptor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReflection
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repeated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReflection
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Repeated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReflection(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReflection
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryServicesDescriptor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
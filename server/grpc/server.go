@@ -1,22 +1,122 @@
 package grpc
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/grpc/gogoreflection"
 	reflection "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// StartGRPCServer starts a gRPC server on the given address.
-func StartGRPCServer(clientCtx client.Context, app types.Application, address string) (*grpc.Server, error) {
-	grpcSrv := grpc.NewServer()
+// StartGRPCServer starts a gRPC server with the given configuration and
+// server options.
+func StartGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	grpcSrv, err := newGRPCServer(clientCtx, app, cfg, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsCfg, err := cfg.TLS.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := listen(cfg.Address, tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := serveGRPC(grpcSrv, listener); err != nil {
+		return nil, err
+	}
+
+	return grpcSrv, nil
+}
+
+// StartGRPCServerAndWeb starts a gRPC server and, if enabled, a gRPC-web server
+// wrapping it with the given configuration. gRPC-web is served on the gRPC server
+// address, unless configured with another one. The returned gRPC-web server is
+// nil if it is disabled. The calls to both servers are rate limited as
+// configured.
+func StartGRPCServerAndWeb(clientCtx client.Context, app types.Application, cfg config.Config) (*grpc.Server, *http.Server, error) {
+	limiter, err := ratelimit.NewLimiter("grpc", cfg.RateLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := limiter.ServerOptions()
+
+	if !cfg.GRPCWeb.Enable || !cfg.GRPCWeb.SharesGRPCAddress(cfg.GRPC.Address) {
+		grpcSrv, err := StartGRPCServer(clientCtx, app, cfg.GRPC, opts...)
+		if err != nil || !cfg.GRPCWeb.Enable {
+			return grpcSrv, nil, err
+		}
+
+		grpcWebSrv, err := StartGRPCWeb(grpcSrv, cfg)
+		if err != nil {
+			grpcSrv.Stop()
+			return nil, nil, err
+		}
+
+		return grpcSrv, grpcWebSrv, nil
+	}
+
+	grpcSrv, err := newGRPCServer(clientCtx, app, cfg.GRPC, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsCfg, err := cfg.GRPC.TLS.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	if tlsCfg != nil {
+		tlsCfg = preferHTTP1(tlsCfg)
+	}
+
+	listener, err := listen(cfg.GRPC.Address, tlsCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// native gRPC clients speak HTTP/2 while gRPC-web clients, i.e. browsers,
+	// speak HTTP/1. With TLS, the protocol is detected once the connection is
+	// decrypted.
+	mux := newProtocolMux(listener)
+
+	if err := serveGRPC(grpcSrv, mux.grpc); err != nil {
+		return nil, nil, err
+	}
+
+	grpcWebSrv := &http.Server{Handler: wrapGRPCWeb(grpcSrv, cfg.GRPCWeb)}
+	if err := serveGRPCWeb(grpcWebSrv, mux.http1); err != nil {
+		grpcSrv.Stop()
+		return nil, nil, err
+	}
+
+	return grpcSrv, grpcWebSrv, nil
+}
+
+// newGRPCServer returns a gRPC server with the app and node services registered.
+// The options of the app, if any, are applied after opts, so that its
+// interceptors run after the ones of opts.
+func newGRPCServer(clientCtx client.Context, app types.Application, cfg config.GRPCConfig, opts ...grpc.ServerOption) (*grpc.Server, error) {
+	if provider, ok := app.(types.GRPCServerOptionsProvider); ok {
+		opts = append(opts, provider.GRPCServerOptions()...)
+	}
+
+	grpcSrv := grpc.NewServer(opts...)
 	app.RegisterGRPCServer(grpcSrv)
 	// reflection allows consumers to build dynamic clients that can write
 	// to any cosmos-sdk application without relying on application packages at compile time
@@ -35,17 +135,63 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 	if err != nil {
 		return nil, err
 	}
-	// Reflection allows external clients to see what services and methods
-	// the gRPC server exposes.
-	gogoreflection.Register(grpcSrv)
+	if cfg.EnableReflection {
+		// Reflection allows external clients to see what services and methods
+		// the gRPC server exposes.
+		gogoreflection.Register(grpcSrv)
+	}
+	if cfg.EnableHealthCheck {
+		// The health service reports the server as serving as soon as it
+		// starts, and is stopped along with it.
+		healthSrv := health.NewServer()
+		healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+		healthpb.RegisterHealthServer(grpcSrv, healthSrv)
+	}
+
+	return grpcSrv, nil
+}
+
+// listen listens on the TCP address, and serves TLS if tlsCfg is not nil. The
+// connections are then decrypted by the listener, the servers serving them
+// being unaware of TLS.
+func listen(address string, tlsCfg *tls.Config) (net.Listener, error) {
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
 		return nil, err
 	}
 
+	if tlsCfg != nil {
+		listener = tls.NewListener(listener, tlsCfg)
+	}
+
+	return listener, nil
+}
+
+// preferHTTP1 returns a copy of tlsCfg negotiating HTTP/1 with the clients
+// supporting it, the HTTP/2 connections being handed over to the gRPC server
+// by the protocol mux. Native gRPC clients only support HTTP/2.
+func preferHTTP1(tlsCfg *tls.Config) *tls.Config {
+	http1Cfg := tlsCfg.Clone()
+	http1Cfg.NextProtos = []string{"http/1.1"}
+
+	tlsCfg = tlsCfg.Clone()
+	tlsCfg.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		for _, proto := range hello.SupportedProtos {
+			if proto == "http/1.1" {
+				return http1Cfg, nil
+			}
+		}
+		return nil, nil
+	}
+
+	return tlsCfg
+}
+
+// serveGRPC serves grpcSrv on listener.
+func serveGRPC(grpcSrv *grpc.Server, listener net.Listener) error {
 	errCh := make(chan error)
 	go func() {
-		err = grpcSrv.Serve(listener)
+		err := grpcSrv.Serve(listener)
 		if err != nil {
 			errCh <- fmt.Errorf("failed to serve: %w", err)
 		}
@@ -53,8 +199,8 @@ func StartGRPCServer(clientCtx client.Context, app types.Application, address st
 
 	select {
 	case err := <-errCh:
-		return nil, err
+		return err
 	case <-time.After(types.ServerStartTime): // assume server started successfully
-		return grpcSrv, nil
+		return nil
 	}
 }
@@ -4,8 +4,13 @@
 package grpc_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,12 +20,18 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/metadata"
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	reflectionv1 "github.com/cosmos/cosmos-sdk/client/grpc/reflection"
 	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	reflectionv2 "github.com/cosmos/cosmos-sdk/server/grpc/reflection/v2alpha1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
@@ -131,6 +142,116 @@ func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_HealthCheck() {
+	healthClient := healthpb.NewHealthClient(s.conn)
+	res, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_ReflectionAndHealthCheckDisabled() {
+	val0 := s.network.Validators[0]
+	_, port, err := server.FreeTCPAddr()
+	s.Require().NoError(err)
+	address := fmt.Sprintf("127.0.0.1:%s", port)
+
+	grpcSrv, err := servergrpc.StartGRPCServer(val0.ClientCtx, s.app, config.GRPCConfig{Address: address})
+	s.Require().NoError(err)
+	defer grpcSrv.Stop()
+
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	// the app services are still registered
+	_, err = testdata.NewQueryClient(conn).Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	s.Require().NoError(err)
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	s.Require().Equal(codes.Unimplemented, status.Code(err))
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	s.Require().NoError(err)
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+	_, err = stream.Recv()
+	s.Require().Equal(codes.Unimplemented, status.Code(err))
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_GRPCWebOnGRPCAddress() {
+	val0 := s.network.Validators[0]
+	_, port, err := server.FreeTCPAddr()
+	s.Require().NoError(err)
+	address := fmt.Sprintf("127.0.0.1:%s", port)
+
+	cfg := config.DefaultConfig()
+	cfg.GRPC.Address = address
+	cfg.GRPCWeb.Enable = true
+	cfg.GRPCWeb.CORSAllowedOrigins = []string{"https://allowed.example"}
+
+	grpcSrv, grpcWebSrv, err := servergrpc.StartGRPCServerAndWeb(val0.ClientCtx, s.app, *cfg)
+	s.Require().NoError(err)
+	s.Require().NotNil(grpcWebSrv)
+	defer grpcSrv.Stop()
+	defer grpcWebSrv.Close()
+
+	// native gRPC clients are served on the address
+	conn, err := grpc.Dial(address, grpc.WithInsecure())
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	_, err = testdata.NewQueryClient(conn).Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	s.Require().NoError(err)
+
+	// and so are gRPC-web clients
+	reqBz, err := (&testdata.EchoRequest{Message: "hello"}).Marshal()
+	s.Require().NoError(err)
+	frame := make([]byte, 5, 5+len(reqBz))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(reqBz)))
+	frame = append(frame, reqBz...)
+
+	url := fmt.Sprintf("http://%s/testdata.Query/Echo", address)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(frame))
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", "application/grpc-web")
+	res, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Equal("application/grpc-web", res.Header.Get("Content-Type"))
+	s.Require().Greater(len(body), 5)
+	var echoRes testdata.EchoResponse
+	s.Require().NoError(echoRes.Unmarshal(body[5 : 5+binary.BigEndian.Uint32(body[1:5])]))
+	s.Require().Equal("hello", echoRes.Message)
+
+	// only the configured origins are allowed
+	for _, tc := range []struct {
+		origin  string
+		allowed bool
+	}{
+		{"https://allowed.example", true},
+		{"https://other.example", false},
+	} {
+		req, err := http.NewRequest("OPTIONS", url, nil)
+		s.Require().NoError(err)
+		req.Header.Set("Origin", tc.origin)
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+		res, err := http.DefaultClient.Do(req)
+		s.Require().NoError(err)
+		res.Body.Close()
+
+		if tc.allowed {
+			s.Require().Equal(tc.origin, res.Header.Get("Access-Control-Allow-Origin"), tc.origin)
+		} else {
+			s.Require().Empty(res.Header.Get("Access-Control-Allow-Origin"), tc.origin)
+		}
+	}
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_InterfaceReflection() {
 	// this tests the application reflection capabilities and compatibility between v1 and v2
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
@@ -154,6 +275,49 @@ func (s *IntegrationTestSuite) TestGRPCServer_InterfaceReflection() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGRPCServer_TypeDescriptors() {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	client := reflectionv2.NewReflectionServiceClient(s.conn)
+	res, err := client.GetTypeDescriptors(ctx, &reflectionv2.GetTypeDescriptorsRequest{})
+	s.Require().NoError(err)
+
+	types := make(map[string]*reflectionv2.TypeDescriptor, len(res.Types))
+	for _, typ := range res.Types {
+		types[typ.Fullname] = typ
+	}
+
+	// all registered implementations are described, alongside the types they reference
+	for _, impl := range s.cfg.InterfaceRegistry.ListImplementations(sdk.MsgInterfaceProtoName) {
+		s.Require().Contains(types, strings.TrimPrefix(impl, "/"))
+	}
+
+	msgSend := types["cosmos.bank.v1beta1.MsgSend"]
+	s.Require().Equal("/cosmos.bank.v1beta1.MsgSend", msgSend.TypeUrl)
+	s.Require().Contains(msgSend.Interfaces, sdk.MsgInterfaceProtoName)
+	s.Require().Equal([]*reflectionv2.FieldDescriptor{
+		{Name: "from_address", Number: 1, Type: "string"},
+		{Name: "to_address", Number: 2, Type: "string"},
+		{Name: "amount", Number: 3, Type: "message", TypeName: "cosmos.base.v1beta1.Coin", Repeated: true},
+	}, msgSend.Fields)
+	s.Require().Contains(types, "cosmos.base.v1beta1.Coin")
+
+	// types which are not interface implementations can be described by type URL
+	res, err = client.GetTypeDescriptors(ctx, &reflectionv2.GetTypeDescriptorsRequest{
+		TypeUrls: []string{"/cosmos.bank.v1beta1.QueryBalanceRequest"},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Types, 1)
+	s.Require().Equal("cosmos.bank.v1beta1.QueryBalanceRequest", res.Types[0].Fullname)
+	s.Require().Empty(res.Types[0].Interfaces)
+
+	_, err = client.GetTypeDescriptors(ctx, &reflectionv2.GetTypeDescriptorsRequest{
+		TypeUrls: []string{"/cosmos.bank.v1beta1.Unknown"},
+	})
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) TestGRPCServer_GetTxsEvent() {
 	// Query the tx via gRPC without pagination. This used to panic, see
 	// https://github.com/cosmos/cosmos-sdk/issues/8038.
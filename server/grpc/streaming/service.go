@@ -0,0 +1,287 @@
+package streaming
+
+import (
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxSubscribers is the default maximum number of concurrent
+	// subscriptions to the Events service.
+	DefaultMaxSubscribers = 100
+
+	// DefaultBufferSize is the default number of blocks buffered for each
+	// subscriber, beyond which a subscriber too slow to receive them is
+	// unsubscribed.
+	DefaultBufferSize = 100
+)
+
+var (
+	_ EventsServer         = (*EventService)(nil)
+	_ baseapp.ABCIListener = (*EventService)(nil)
+)
+
+// EventService implements the Events service. It is hooked into the ABCI
+// methods of the app as an ABCIListener, collecting the events of the block
+// being executed and streaming them to the subscribers once the block is
+// committed, so that only the events of the finalized blocks are streamed.
+type EventService struct {
+	maxSubscribers int
+	bufferSize     int
+
+	// block collects the events of the block being executed, only accessed by
+	// the ABCI methods. It is nil if there was no subscriber when the block
+	// began.
+	block *SubscribeEventsResponse
+
+	mtx         sync.Mutex
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+type subscriber struct {
+	filters []EventFilter
+	// blocks is closed once the subscriber is unsubscribed, for being too slow
+	// or as the service is closed, with the error ending the subscription.
+	blocks chan *SubscribeEventsResponse
+	err    error
+	// active is true once a block began after the subscription, so that the
+	// subscriber only receives complete blocks.
+	active bool
+}
+
+// NewEventService returns an EventService serving at most maxSubscribers
+// subscriptions, each one buffering at most bufferSize blocks.
+func NewEventService(maxSubscribers, bufferSize int) *EventService {
+	return &EventService{
+		maxSubscribers: maxSubscribers,
+		bufferSize:     bufferSize,
+		subscribers:    make(map[*subscriber]struct{}),
+	}
+}
+
+// SubscribeEvents implements the Events/SubscribeEvents gRPC method.
+func (s *EventService) SubscribeEvents(req *SubscribeEventsRequest, stream Events_SubscribeEventsServer) error {
+	for _, filter := range req.Filters {
+		for _, attr := range filter.Attributes {
+			if attr.Key == "" {
+				return status.Error(codes.InvalidArgument, "attribute key cannot be empty")
+			}
+		}
+	}
+
+	sub, err := s.subscribe(req.Filters)
+	if err != nil {
+		return err
+	}
+	defer s.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, stream.Context().Err().Error())
+
+		case block, ok := <-sub.blocks:
+			if !ok {
+				return sub.err
+			}
+
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Subscribers returns the number of subscriptions being served.
+func (s *EventService) Subscribers() int {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return len(s.subscribers)
+}
+
+func (s *EventService) subscribe(filters []EventFilter) (*subscriber, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return nil, status.Error(codes.Unavailable, "the node is shutting down")
+	}
+	if len(s.subscribers) >= s.maxSubscribers {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many subscribers, max %d", s.maxSubscribers)
+	}
+
+	sub := &subscriber{
+		filters: filters,
+		blocks:  make(chan *SubscribeEventsResponse, s.bufferSize),
+	}
+	s.subscribers[sub] = struct{}{}
+
+	return sub, nil
+}
+
+func (s *EventService) unsubscribe(sub *subscriber) {
+	s.mtx.Lock()
+	delete(s.subscribers, sub)
+	s.mtx.Unlock()
+}
+
+// Close ends the subscriptions once they received the events of the committed
+// blocks buffered for them, and rejects the new ones. It is called by the app
+// on shutdown, once no more blocks are committed.
+func (s *EventService) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.closed = true
+	for sub := range s.subscribers {
+		delete(s.subscribers, sub)
+		sub.err = status.Error(codes.Unavailable, "the node is shutting down")
+		close(sub.blocks)
+	}
+
+	return nil
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (s *EventService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	s.mtx.Lock()
+	for sub := range s.subscribers {
+		sub.active = true
+	}
+	subscribed := len(s.subscribers) > 0
+	s.mtx.Unlock()
+
+	s.block = nil
+	if subscribed {
+		s.block = &SubscribeEventsResponse{
+			Height:           req.Header.Height,
+			BeginBlockEvents: res.Events,
+		}
+	}
+
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *EventService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	if s.block != nil {
+		s.block.Txs = append(s.block.Txs, TxEvents{
+			Index:  uint32(len(s.block.Txs)),
+			Hash:   tmhash.Sum(req.Tx),
+			Code:   res.Code,
+			Events: res.Events,
+		})
+	}
+
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *EventService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if s.block != nil {
+		s.block.EndBlockEvents = res.Events
+	}
+
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener. It streams the events of the
+// committed block to the subscribers, unsubscribing the ones whose buffer is
+// full.
+func (s *EventService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	block := s.block
+	s.block = nil
+	if block == nil {
+		return nil
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for sub := range s.subscribers {
+		if !sub.active {
+			continue
+		}
+
+		select {
+		case sub.blocks <- filterBlock(block, sub.filters):
+		default:
+			delete(s.subscribers, sub)
+			sub.err = status.Error(codes.ResourceExhausted, "too slow to receive the events, unsubscribed")
+			close(sub.blocks)
+		}
+	}
+
+	return nil
+}
+
+// filterBlock returns the events of block matching any of filters.
+func filterBlock(block *SubscribeEventsResponse, filters []EventFilter) *SubscribeEventsResponse {
+	if len(filters) == 0 {
+		return block
+	}
+
+	filtered := &SubscribeEventsResponse{
+		Height:           block.Height,
+		BeginBlockEvents: filterEvents(block.BeginBlockEvents, filters),
+		EndBlockEvents:   filterEvents(block.EndBlockEvents, filters),
+	}
+
+	for _, tx := range block.Txs {
+		if events := filterEvents(tx.Events, filters); len(events) > 0 {
+			tx.Events = events
+			filtered.Txs = append(filtered.Txs, tx)
+		}
+	}
+
+	return filtered
+}
+
+func filterEvents(events []abci.Event, filters []EventFilter) []abci.Event {
+	var filtered []abci.Event
+	for _, event := range events {
+		for _, filter := range filters {
+			if filter.Matches(event) {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+
+	return filtered
+}
+
+// Matches returns true if the event matches the filter: it is of the filter
+// type, if any, and has all the filter attributes.
+func (f EventFilter) Matches(event abci.Event) bool {
+	if f.Type != "" && f.Type != event.Type {
+		return false
+	}
+
+	for _, attr := range f.Attributes {
+		if !attr.matches(event.Attributes) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f AttributeFilter) matches(attrs []abci.EventAttribute) bool {
+	for _, attr := range attrs {
+		if string(attr.Key) == f.Key && (f.Value == "" || string(attr.Value) == f.Value) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,180 @@
+package streaming_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cosmos/cosmos-sdk/server/grpc/streaming"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func event(typ string, attrs ...string) abci.Event {
+	e := abci.Event{Type: typ}
+	for i := 0; i < len(attrs); i += 2 {
+		e.Attributes = append(e.Attributes, abci.EventAttribute{Key: []byte(attrs[i]), Value: []byte(attrs[i+1])})
+	}
+	return e
+}
+
+func startService(t *testing.T, service *streaming.EventService) streaming.EventsClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	streaming.RegisterEventsServer(server, service)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return streaming.NewEventsClient(conn)
+}
+
+// commitBlock runs the listener methods for a block of a single tx.
+func commitBlock(t *testing.T, service *streaming.EventService, height int64) {
+	var ctx sdk.Context
+	require.NoError(t, service.ListenBeginBlock(ctx,
+		abci.RequestBeginBlock{Header: tmproto.Header{Height: height}},
+		abci.ResponseBeginBlock{Events: []abci.Event{event("mint", "amount", "10stake")}},
+	))
+	require.NoError(t, service.ListenDeliverTx(ctx,
+		abci.RequestDeliverTx{Tx: []byte("tx")},
+		abci.ResponseDeliverTx{Events: []abci.Event{
+			event("message", "action", "send"),
+			event("transfer", "recipient", "alice", "amount", "5stake"),
+		}},
+	))
+	require.NoError(t, service.ListenEndBlock(ctx,
+		abci.RequestEndBlock{Height: height},
+		abci.ResponseEndBlock{Events: []abci.Event{event("transfer", "recipient", "bob")}},
+	))
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	service := streaming.NewEventService(streaming.DefaultMaxSubscribers, streaming.DefaultBufferSize)
+	client := startService(t, service)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	all, err := client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{})
+	require.NoError(t, err)
+	transfers, err := client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{
+		Filters: []streaming.EventFilter{{
+			Type:       "transfer",
+			Attributes: []streaming.AttributeFilter{{Key: "recipient", Value: "alice"}},
+		}},
+	})
+	require.NoError(t, err)
+
+	// the subscriptions are registered once the streams are served
+	require.Eventually(t, func() bool { return service.Subscribers() == 2 }, time.Second, 10*time.Millisecond)
+	commitBlock(t, service, 2)
+
+	res, err := all.Recv()
+	require.NoError(t, err)
+	require.Equal(t, &streaming.SubscribeEventsResponse{
+		Height:           2,
+		BeginBlockEvents: []abci.Event{event("mint", "amount", "10stake")},
+		Txs: []streaming.TxEvents{{
+			Index: 0,
+			Hash:  tmhash.Sum([]byte("tx")),
+			Events: []abci.Event{
+				event("message", "action", "send"),
+				event("transfer", "recipient", "alice", "amount", "5stake"),
+			},
+		}},
+		EndBlockEvents: []abci.Event{event("transfer", "recipient", "bob")},
+	}, res)
+
+	res, err = transfers.Recv()
+	require.NoError(t, err)
+	require.Equal(t, &streaming.SubscribeEventsResponse{
+		Height: 2,
+		Txs: []streaming.TxEvents{{
+			Index:  0,
+			Hash:   tmhash.Sum([]byte("tx")),
+			Events: []abci.Event{event("transfer", "recipient", "alice", "amount", "5stake")},
+		}},
+	}, res)
+}
+
+func TestSubscribeEventsLimits(t *testing.T) {
+	service := streaming.NewEventService(1, 1)
+	client := startService(t, service)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{
+		Filters: []streaming.EventFilter{{Attributes: []streaming.AttributeFilter{{}}}},
+	})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	slow, err := client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return service.Subscribers() == 1 }, time.Second, 10*time.Millisecond)
+
+	stream, err = client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the slow subscriber is unsubscribed once its buffer is full, after
+	// receiving the buffered blocks
+	for height := int64(1); service.Subscribers() > 0; height++ {
+		commitBlock(t, service, height)
+	}
+	for {
+		_, err = slow.Recv()
+		if err != nil {
+			break
+		}
+	}
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestEventServiceClose(t *testing.T) {
+	service := streaming.NewEventService(streaming.DefaultMaxSubscribers, streaming.DefaultBufferSize)
+	client := startService(t, service)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return service.Subscribers() == 1 }, time.Second, 10*time.Millisecond)
+	commitBlock(t, service, 2)
+	commitBlock(t, service, 3)
+
+	// the subscription ends once the buffered blocks are received
+	require.NoError(t, service.Close())
+	for _, height := range []int64{2, 3} {
+		res, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, res.Height)
+	}
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	stream, err = client.SubscribeEvents(ctx, &streaming.SubscribeEventsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
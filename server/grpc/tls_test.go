@@ -0,0 +1,186 @@
+//go:build norace
+// +build norace
+
+package grpc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+// testCertificates are a CA and the server and client certificates it signed,
+// written as PEM files.
+type testCertificates struct {
+	caFile, serverCertFile, serverKeyFile string
+
+	pool   *x509.CertPool
+	client tls.Certificate
+}
+
+func newTestCertificates(t *testing.T) testCertificates {
+	dir := t.TempDir()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	writePEM := func(name, typ string, bz []byte) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: bz}), 0o600))
+		return path
+	}
+
+	sign := func(serial int64, usage x509.ExtKeyUsage) ([]byte, *ecdsa.PrivateKey) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "127.0.0.1"},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:    time.Now().Add(-time.Hour),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		}
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+		require.NoError(t, err)
+		return der, key
+	}
+
+	certs := testCertificates{
+		caFile: writePEM("ca.pem", "CERTIFICATE", caDER),
+		pool:   x509.NewCertPool(),
+	}
+	certs.pool.AddCert(ca)
+
+	serverDER, serverKey := sign(2, x509.ExtKeyUsageServerAuth)
+	serverKeyDER, err := x509.MarshalECPrivateKey(serverKey)
+	require.NoError(t, err)
+	certs.serverCertFile = writePEM("server.pem", "CERTIFICATE", serverDER)
+	certs.serverKeyFile = writePEM("server-key.pem", "EC PRIVATE KEY", serverKeyDER)
+
+	clientDER, clientKey := sign(3, x509.ExtKeyUsageClientAuth)
+	certs.client = tls.Certificate{Certificate: [][]byte{clientDER}, PrivateKey: clientKey}
+
+	return certs
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_MutualTLS() {
+	val0 := s.network.Validators[0]
+	certs := newTestCertificates(s.T())
+
+	_, port, err := server.FreeTCPAddr()
+	s.Require().NoError(err)
+	address := fmt.Sprintf("127.0.0.1:%s", port)
+
+	cfg := config.DefaultConfig()
+	cfg.GRPC.Address = address
+	cfg.GRPC.TLS = config.TLSConfig{
+		CertFile:     certs.serverCertFile,
+		KeyFile:      certs.serverKeyFile,
+		ClientCAFile: certs.caFile,
+	}
+	cfg.GRPCWeb.Enable = true
+	cfg.GRPCWeb.CORSAllowedOrigins = []string{"https://allowed.example"}
+
+	grpcSrv, grpcWebSrv, err := servergrpc.StartGRPCServerAndWeb(val0.ClientCtx, s.app, *cfg)
+	s.Require().NoError(err)
+	defer grpcSrv.Stop()
+	defer grpcWebSrv.Close()
+
+	echo := func(tlsCfg *tls.Config) error {
+		conn, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsCfg)))
+		s.Require().NoError(err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = testdata.NewQueryClient(conn).Echo(ctx, &testdata.EchoRequest{Message: "hello"}, grpc.WaitForReady(false))
+		return err
+	}
+
+	// the clients authenticating with a certificate of the CA are served
+	s.Require().NoError(echo(&tls.Config{RootCAs: certs.pool, Certificates: []tls.Certificate{certs.client}}))
+	// the others are rejected
+	s.Require().Error(echo(&tls.Config{RootCAs: certs.pool}))
+
+	// gRPC-web is served over TLS on the same address
+	httpClient := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: certs.pool, Certificates: []tls.Certificate{certs.client}},
+	}}
+	req, err := http.NewRequest("OPTIONS", fmt.Sprintf("https://%s/testdata.Query/Echo", address), nil)
+	s.Require().NoError(err)
+	req.Header.Set("Origin", "https://allowed.example")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+	res, err := httpClient.Do(req)
+	s.Require().NoError(err)
+	res.Body.Close()
+	s.Require().Equal(http.StatusOK, res.StatusCode)
+	s.Require().Equal("https://allowed.example", res.Header.Get("Access-Control-Allow-Origin"))
+	s.Require().Equal(1, res.ProtoMajor)
+
+	// plaintext clients are not served
+	_, err = http.Get(fmt.Sprintf("http://%s/testdata.Query/Echo", address))
+	s.Require().Error(err)
+}
+
+func TestTLSConfigLoad(t *testing.T) {
+	certs := newTestCertificates(t)
+
+	tlsCfg, err := config.TLSConfig{}.Load()
+	require.NoError(t, err)
+	require.Nil(t, tlsCfg)
+
+	_, err = config.TLSConfig{KeyFile: certs.serverKeyFile}.Load()
+	require.EqualError(t, err, "tls-cert-file must be set along with tls-key-file and tls-client-ca-file")
+
+	tlsCfg, err = config.TLSConfig{CertFile: certs.serverCertFile, KeyFile: certs.serverKeyFile}.Load()
+	require.NoError(t, err)
+	require.Equal(t, tls.NoClientCert, tlsCfg.ClientAuth)
+
+	tlsCfg, err = config.TLSConfig{CertFile: certs.serverCertFile, KeyFile: certs.serverKeyFile, ClientCAFile: certs.caFile}.Load()
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, tlsCfg.ClientAuth)
+
+	_, err = config.TLSConfig{CertFile: certs.serverCertFile, KeyFile: certs.serverCertFile}.Load()
+	require.Error(t, err)
+
+	// a key is not a CA certificate
+	_, err = config.TLSConfig{CertFile: certs.serverCertFile, KeyFile: certs.serverKeyFile, ClientCAFile: certs.serverKeyFile}.Load()
+	require.Error(t, err)
+}
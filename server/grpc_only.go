@@ -0,0 +1,56 @@
+package server
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	cs "github.com/tendermint/tendermint/consensus"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+)
+
+// replayBlocks brings app up to date with the block store of cfg, replaying
+// the blocks it is missing, as Tendermint does on start, but without starting
+// the consensus and p2p stacks. It is used by the gRPC-only mode without p2p,
+// serving the queries on the state of the blocks stored locally, e.g. by state
+// sync.
+func replayBlocks(cfg *tmcfg.Config, app abci.Application, genDocProvider node.GenesisDocProvider, logger log.Logger) error {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+
+	state, genDoc, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, genDocProvider)
+	if err != nil {
+		return err
+	}
+
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app))
+	proxyApp.SetLogger(logger.With("module", "proxy"))
+	if err := proxyApp.Start(); err != nil {
+		return fmt.Errorf("failed to start the proxy app connections: %w", err)
+	}
+	defer func() { _ = proxyApp.Stop() }()
+
+	blockStore := store.NewBlockStore(blockStoreDB)
+	logger.Info("replaying the stored blocks", "height", blockStore.Height())
+
+	handshaker := cs.NewHandshaker(sm.NewStore(stateDB), state, blockStore, genDoc)
+	handshaker.SetLogger(logger.With("module", "consensus"))
+	if err := handshaker.Handshake(proxyApp); err != nil {
+		return fmt.Errorf("failed to replay the stored blocks: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+type initChainApp struct {
+	*abci.BaseApplication
+
+	initChains int
+}
+
+func (app *initChainApp) InitChain(req abci.RequestInitChain) abci.ResponseInitChain {
+	app.initChains++
+	return abci.ResponseInitChain{}
+}
+
+func TestReplayBlocks(t *testing.T) {
+	home := t.TempDir()
+	cfg := tmcfg.DefaultConfig()
+	cfg.SetRoot(home)
+	require.NoError(t, os.MkdirAll(filepath.Dir(cfg.GenesisFile()), 0755))
+
+	app := &initChainApp{BaseApplication: abci.NewBaseApplication()}
+	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
+
+	require.Error(t, replayBlocks(cfg, app, genDocProvider, log.NewNopLogger()))
+
+	genDoc := tmtypes.GenesisDoc{
+		ChainID:     "test",
+		GenesisTime: time.Now(),
+		Validators: []tmtypes.GenesisValidator{
+			{PubKey: ed25519.GenPrivKey().PubKey(), Power: 10},
+		},
+	}
+	require.NoError(t, genDoc.SaveAs(cfg.GenesisFile()))
+
+	// the chain is initialized by the replay, without blocks to replay
+	require.NoError(t, replayBlocks(cfg, app, genDocProvider, log.NewNopLogger()))
+	require.Equal(t, 1, app.initChains)
+
+	// the databases are closed once replayed
+	require.NoError(t, replayBlocks(cfg, app, genDocProvider, log.NewNopLogger()))
+}
@@ -1,22 +1,146 @@
 package server
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
 	"github.com/rs/zerolog"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 )
 
 var _ tmlog.Logger = (*ZeroLogWrapper)(nil)
 
+// logModuleKey is the key of the module of the loggers, e.g. "x/gov" or
+// "consensus", which their log levels are set by.
+const logModuleKey = "module"
+
+// defaultLogModule is the module of the log level of the modules which have
+// none set.
+const defaultLogModule = "*"
+
+// LogLevels defines the log levels of the modules of the node, parsed from a
+// comma-separated list of module:level pairs, e.g. "x/gov:debug,*:info", where
+// the level of "*" applies to the modules without one. A single level, e.g.
+// "debug", applies to all the modules. The levels can be changed at runtime,
+// and are safe for concurrent use.
+type LogLevels struct {
+	mtx          sync.RWMutex
+	defaultLevel zerolog.Level
+	moduleLevels map[string]zerolog.Level
+}
+
+// NewLogLevels returns the log levels parsed from levels.
+func NewLogLevels(levels string) (*LogLevels, error) {
+	l := &LogLevels{}
+	if err := l.Set(levels); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// Set replaces the log levels with the ones parsed from levels, leaving them
+// unchanged if they are invalid. All the modules log at the info level if
+// levels is empty.
+func (l *LogLevels) Set(levels string) error {
+	defaultLevel := zerolog.InfoLevel
+	moduleLevels := make(map[string]zerolog.Level)
+
+	var items []string
+	if strings.TrimSpace(levels) != "" {
+		items = strings.Split(levels, ",")
+	}
+
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			return fmt.Errorf("empty log level in %q", levels)
+		}
+
+		module, lvlStr := defaultLogModule, item
+		if i := strings.LastIndex(item, ":"); i >= 0 {
+			module, lvlStr = strings.TrimSpace(item[:i]), strings.TrimSpace(item[i+1:])
+		}
+
+		if module == "" {
+			return fmt.Errorf("empty module in log level %q", item)
+		}
+
+		lvl, err := zerolog.ParseLevel(lvlStr)
+		if err != nil || lvlStr == "" {
+			return fmt.Errorf("invalid log level %q of module %s", lvlStr, module)
+		}
+
+		if module == defaultLogModule {
+			defaultLevel = lvl
+		} else {
+			moduleLevels[module] = lvl
+		}
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.defaultLevel, l.moduleLevels = defaultLevel, moduleLevels
+	return nil
+}
+
+// Enabled returns true if the logs of module at level are written.
+func (l *LogLevels) Enabled(module string, level zerolog.Level) bool {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	lvl, ok := l.moduleLevels[module]
+	if !ok {
+		lvl = l.defaultLevel
+	}
+
+	return level >= lvl
+}
+
+// String returns the log levels in the format they are parsed from, with the
+// modules sorted and the level of "*" last.
+func (l *LogLevels) String() string {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	items := make([]string, 0, len(l.moduleLevels)+1)
+	for module, lvl := range l.moduleLevels {
+		items = append(items, fmt.Sprintf("%s:%s", module, lvl))
+	}
+	sort.Strings(items)
+
+	return strings.Join(append(items, fmt.Sprintf("%s:%s", defaultLogModule, l.defaultLevel)), ",")
+}
+
 // ZeroLogWrapper provides a wrapper around a zerolog.Logger instance. It implements
 // Tendermint's Logger interface.
 type ZeroLogWrapper struct {
 	zerolog.Logger
+
+	// levels filters the logs by the level of the module of the logger, set
+	// with the "module" key. The logs are only filtered by the level of the
+	// zerolog.Logger if nil.
+	levels *LogLevels
+	module string
+}
+
+// NewZeroLogWrapper returns a ZeroLogWrapper around logger whose logs are
+// filtered by levels.
+func NewZeroLogWrapper(logger zerolog.Logger, levels *LogLevels) ZeroLogWrapper {
+	return ZeroLogWrapper{Logger: logger, levels: levels, module: defaultLogModule}
 }
 
 // Info implements Tendermint's Logger interface and logs with level INFO. A set
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Info(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.InfoLevel) {
+		return
+	}
+
 	z.Logger.Info().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -24,6 +148,10 @@ func (z ZeroLogWrapper) Info(msg string, keyVals ...interface{}) {
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Error(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.ErrorLevel) {
+		return
+	}
+
 	z.Logger.Error().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
@@ -31,14 +159,30 @@ func (z ZeroLogWrapper) Error(msg string, keyVals ...interface{}) {
 // of key/value tuples may be provided to add context to the log. The number of
 // tuples must be even and the key of the tuple must be a string.
 func (z ZeroLogWrapper) Debug(msg string, keyVals ...interface{}) {
+	if !z.enabled(zerolog.DebugLevel) {
+		return
+	}
+
 	z.Logger.Debug().Fields(getLogFields(keyVals...)).Msg(msg)
 }
 
 // With returns a new wrapped logger with additional context provided by a set
 // of key/value tuples. The number of tuples must be even and the key of the
-// tuple must be a string.
+// tuple must be a string. The "module" key sets the module of the log level
+// of the new logger.
 func (z ZeroLogWrapper) With(keyVals ...interface{}) tmlog.Logger {
-	return ZeroLogWrapper{z.Logger.With().Fields(getLogFields(keyVals...)).Logger()}
+	fields := getLogFields(keyVals...)
+	if module, ok := fields[logModuleKey].(string); ok {
+		z.module = module
+	}
+
+	z.Logger = z.Logger.With().Fields(fields).Logger()
+	return z
+}
+
+// enabled returns true if the logs at level are written.
+func (z ZeroLogWrapper) enabled(level zerolog.Level) bool {
+	return z.levels == nil || z.levels.Enabled(z.module, level)
 }
 
 func getLogFields(keyVals ...interface{}) map[string]interface{} {
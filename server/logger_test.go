@@ -0,0 +1,90 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+func TestLogLevels(t *testing.T) {
+	testCases := map[string]struct {
+		levels string
+		exp    string
+		expErr bool
+	}{
+		"single level":        {"debug", "*:debug", false},
+		"module levels":       {"x/gov:debug, consensus:error,*:warn", "consensus:error,x/gov:debug,*:warn", false},
+		"default level info":  {"x/gov:debug", "x/gov:debug,*:info", false},
+		"invalid level":       {"x/gov:verbose,*:info", "", true},
+		"missing level":       {"x/gov:,*:info", "", true},
+		"missing module":      {":debug", "", true},
+		"empty":               {"", "*:info", false},
+		"trailing separator":  {"x/gov:debug,", "", true},
+		"module with a colon": {"a:b:debug", "a:b:debug,*:info", false},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			levels, err := server.NewLogLevels(tc.levels)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, levels.String())
+		})
+	}
+}
+
+func TestZeroLogWrapperLevels(t *testing.T) {
+	levels, err := server.NewLogLevels("x/gov:debug,*:info")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	logger := server.NewZeroLogWrapper(zerolog.New(&buf), levels)
+	gov := logger.With("module", "x/gov")
+	bank := logger.With("module", "x/bank")
+
+	readLogs := func() []map[string]interface{} {
+		var logs []map[string]interface{}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var log map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(line), &log))
+			logs = append(logs, log)
+		}
+		buf.Reset()
+		return logs
+	}
+
+	gov.Debug("tallying", "proposal_id", 1)
+	bank.Debug("sending")
+	bank.Info("sent", "amount", "10stake")
+	logs := readLogs()
+	require.Len(t, logs, 2)
+	require.Equal(t, map[string]interface{}{"level": "debug", "module": "x/gov", "proposal_id": float64(1), "message": "tallying"}, logs[0])
+	require.Equal(t, map[string]interface{}{"level": "info", "module": "x/bank", "amount": "10stake", "message": "sent"}, logs[1])
+
+	// the levels apply to the existing loggers once changed
+	require.NoError(t, levels.Set("x/bank:debug,*:error"))
+	gov.Info("tallied")
+	bank.Debug("sending")
+	gov.Error("failed")
+	logs = readLogs()
+	require.Len(t, logs, 2)
+	require.Equal(t, "sending", logs[0]["message"])
+	require.Equal(t, "failed", logs[1]["message"])
+
+	// invalid levels leave the levels unchanged
+	require.Error(t, levels.Set("x/bank:verbose"))
+	require.Equal(t, "x/bank:debug,*:error", levels.String())
+}
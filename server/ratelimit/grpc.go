@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// ServerOptions returns the options of a gRPC server rate limited by l, none
+// if l is nil.
+func (l *Limiter) ServerOptions() []grpc.ServerOption {
+	if l == nil {
+		return nil
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(l.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(l.StreamServerInterceptor()),
+	}
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor rejecting the unary calls
+// over the limits with the RESOURCE_EXHAUSTED code.
+func (l *Limiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := l.allowGRPC(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor rejecting the streams
+// over the limits with the RESOURCE_EXHAUSTED code.
+func (l *Limiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.allowGRPC(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func (l *Limiter) allowGRPC(ctx context.Context, method string) error {
+	if ok, limit := l.Allow(method, l.grpcClient(ctx)); !ok {
+		return status.Errorf(codes.ResourceExhausted, "%s rate limit exceeded for %s", limit, method)
+	}
+
+	return nil
+}
+
+// grpcClient returns the IP of the client of a gRPC call.
+func (l *Limiter) grpcClient(ctx context.Context) string {
+	if l.trustForwardedFor {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if client := forwardedFor(md.Get("x-forwarded-for")); client != "" {
+				return client
+			}
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	return hostOf(p.Addr.String())
+}
+
+// hostOf returns the host of addr, or addr if it has no port.
+func hostOf(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// Middleware returns a handler rejecting the requests to next over the limits
+// with the 429 Too Many Requests status. The method of a request is its path.
+// It returns next if l is nil.
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ok, limit := l.Allow(r.URL.Path, l.httpClient(r)); !ok {
+			w.Header().Set("Retry-After", "1")
+			rest.WriteErrorResponse(w, http.StatusTooManyRequests, fmt.Sprintf("%s rate limit exceeded for %s", limit, r.URL.Path))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// httpClient returns the IP of the client of an HTTP request.
+func (l *Limiter) httpClient(r *http.Request) string {
+	if l.trustForwardedFor {
+		if client := forwardedFor(r.Header.Values("X-Forwarded-For")); client != "" {
+			return client
+		}
+	}
+
+	return hostOf(r.RemoteAddr)
+}
+
+// forwardedFor returns the client IP of X-Forwarded-For header values, i.e.
+// the first of their comma separated IPs.
+func forwardedFor(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.Split(values[0], ",")[0])
+}
@@ -0,0 +1,209 @@
+// Package ratelimit limits the rate of the requests served by the API and
+// gRPC servers, globally, per client IP and per method.
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// The limits a request can be rejected by.
+const (
+	LimitGlobal = "global"
+	LimitClient = "client"
+	LimitMethod = "method"
+)
+
+// sweepInterval is the interval at which the buckets of the clients which
+// stopped sending requests are removed.
+const sweepInterval = time.Minute
+
+// Limiter rate limits requests with token buckets: a global one, and one per
+// client and method limit.
+type Limiter struct {
+	server            string
+	trustForwardedFor bool
+
+	global       *bucket
+	perClientRPS float64
+	methods      []methodLimit
+
+	mtx       sync.Mutex
+	clients   map[clientKey]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// methodLimit is the per-client limit of the methods starting with prefix.
+type methodLimit struct {
+	prefix string
+	rps    float64
+}
+
+type clientKey struct {
+	client string
+	// method is the prefix of the method limit of the bucket, empty for the
+	// per-client limit.
+	method string
+}
+
+// NewLimiter returns a Limiter enforcing the limits of cfg on the requests to
+// server, which labels the telemetry of the rejected requests. It returns nil
+// if rate limiting is disabled, and all the methods of a nil Limiter allow
+// the requests.
+func NewLimiter(server string, cfg config.RateLimitConfig) (*Limiter, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+
+	if cfg.GlobalRPS < 0 || cfg.PerClientRPS < 0 {
+		return nil, fmt.Errorf("rate limits must not be negative")
+	}
+
+	methods := make([]methodLimit, 0, len(cfg.MethodLimits))
+	for _, limit := range cfg.MethodLimits {
+		i := strings.LastIndex(limit, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid method limit %q, expected <method>=<rps>", limit)
+		}
+
+		rps, err := strconv.ParseFloat(strings.TrimSpace(limit[i+1:]), 64)
+		if err != nil || rps <= 0 {
+			return nil, fmt.Errorf("invalid method limit %q, expected a positive rps", limit)
+		}
+
+		methods = append(methods, methodLimit{prefix: strings.TrimSpace(limit[:i]), rps: rps})
+	}
+
+	// the longest matching prefix applies
+	sort.SliceStable(methods, func(i, j int) bool {
+		return len(methods[i].prefix) > len(methods[j].prefix)
+	})
+
+	l := &Limiter{
+		server:            server,
+		trustForwardedFor: cfg.TrustForwardedFor,
+		perClientRPS:      cfg.PerClientRPS,
+		methods:           methods,
+		clients:           make(map[clientKey]*bucket),
+		now:               time.Now,
+	}
+	if cfg.GlobalRPS > 0 {
+		l.global = newBucket(cfg.GlobalRPS)
+	}
+
+	return l, nil
+}
+
+// Allow returns true if the request of client to method is allowed, and
+// consumes its share of the limits. Otherwise, it returns the limit
+// rejecting the request, and records its rejection in the telemetry.
+func (l *Limiter) Allow(method, client string) (bool, string) {
+	if l == nil {
+		return true, ""
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	key := clientKey{client: client}
+	rps, limit := l.perClientRPS, LimitClient
+	for _, m := range l.methods {
+		if strings.HasPrefix(method, m.prefix) {
+			key.method, rps, limit = m.prefix, m.rps, LimitMethod
+			break
+		}
+	}
+
+	if rps > 0 {
+		b, ok := l.clients[key]
+		if !ok {
+			b = newBucket(rps)
+			l.clients[key] = b
+		}
+		if !b.take(now) {
+			l.reject(limit)
+			return false, limit
+		}
+	}
+
+	if l.global != nil && !l.global.take(now) {
+		l.reject(LimitGlobal)
+		return false, LimitGlobal
+	}
+
+	return true, ""
+}
+
+func (l *Limiter) reject(limit string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"rate_limit", "rejected"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("server", l.server), telemetry.NewLabel("limit", limit)},
+	)
+}
+
+// sweep removes the buckets which refilled, as these are equivalent to new
+// ones, at most once per sweepInterval.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.clients {
+		if b.full(now) {
+			delete(l.clients, key)
+		}
+	}
+}
+
+// bucket is a token bucket refilled with rate tokens per second, holding at
+// most one second of tokens.
+type bucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newBucket(rate float64) *bucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &bucket{rate: rate, burst: burst, tokens: burst}
+}
+
+func (b *bucket) refill(now time.Time) {
+	if !b.last.IsZero() {
+		b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// take consumes a token, and returns false if the bucket is empty.
+func (b *bucket) take(now time.Time) bool {
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+func (b *bucket) full(now time.Time) bool {
+	b.refill(now)
+	return b.tokens >= b.burst
+}
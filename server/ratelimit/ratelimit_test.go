@@ -0,0 +1,200 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+const denomOwners = "/cosmos.bank.v1beta1.Query/DenomOwners"
+
+// newTestLimiter returns a limiter whose clock is only advanced by the
+// returned function.
+func newTestLimiter(t *testing.T, cfg config.RateLimitConfig) (*Limiter, func(time.Duration)) {
+	cfg.Enable = true
+	l, err := NewLimiter("test", cfg)
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	l.now = func() time.Time { return now }
+
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+func requireAllowed(t *testing.T, l *Limiter, method, client string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		ok, limit := l.Allow(method, client)
+		require.True(t, ok, "request %d rejected by the %s limit", i, limit)
+	}
+}
+
+func TestNewLimiter(t *testing.T) {
+	l, err := NewLimiter("test", config.RateLimitConfig{GlobalRPS: 1})
+	require.NoError(t, err)
+	require.Nil(t, l, "disabled")
+
+	// a nil limiter allows everything
+	ok, _ := l.Allow(denomOwners, "client")
+	require.True(t, ok)
+	require.Empty(t, l.ServerOptions())
+	handler := http.NewServeMux()
+	require.Equal(t, handler, l.Middleware(handler))
+
+	for _, cfg := range []config.RateLimitConfig{
+		{PerClientRPS: -1},
+		{GlobalRPS: -1},
+		{MethodLimits: []string{denomOwners}},
+		{MethodLimits: []string{"=1"}},
+		{MethodLimits: []string{denomOwners + "=0"}},
+		{MethodLimits: []string{denomOwners + "=fast"}},
+	} {
+		cfg.Enable = true
+		_, err := NewLimiter("test", cfg)
+		require.Error(t, err, "%+v", cfg)
+	}
+}
+
+func TestLimiterPerClient(t *testing.T) {
+	l, advance := newTestLimiter(t, config.RateLimitConfig{PerClientRPS: 2})
+
+	requireAllowed(t, l, denomOwners, "a", 2)
+	ok, limit := l.Allow(denomOwners, "a")
+	require.False(t, ok)
+	require.Equal(t, LimitClient, limit)
+
+	// the other clients have their own limit
+	requireAllowed(t, l, denomOwners, "b", 2)
+
+	// the limit refills with time, up to one second of requests
+	advance(500 * time.Millisecond)
+	requireAllowed(t, l, denomOwners, "a", 1)
+	ok, _ = l.Allow(denomOwners, "a")
+	require.False(t, ok)
+
+	advance(time.Hour)
+	requireAllowed(t, l, denomOwners, "a", 2)
+	ok, _ = l.Allow(denomOwners, "a")
+	require.False(t, ok)
+}
+
+func TestLimiterGlobal(t *testing.T) {
+	l, advance := newTestLimiter(t, config.RateLimitConfig{GlobalRPS: 3, PerClientRPS: 2})
+
+	requireAllowed(t, l, denomOwners, "a", 2)
+	requireAllowed(t, l, denomOwners, "b", 1)
+	ok, limit := l.Allow(denomOwners, "c")
+	require.False(t, ok)
+	require.Equal(t, LimitGlobal, limit)
+
+	advance(time.Second)
+	requireAllowed(t, l, denomOwners, "c", 2)
+}
+
+func TestLimiterMethodLimits(t *testing.T) {
+	l, _ := newTestLimiter(t, config.RateLimitConfig{
+		PerClientRPS: 10,
+		MethodLimits: []string{
+			"/cosmos.bank.v1beta1.Query/=5",
+			denomOwners + " = 1",
+		},
+	})
+
+	// the longest prefix applies
+	requireAllowed(t, l, denomOwners, "a", 1)
+	ok, limit := l.Allow(denomOwners, "a")
+	require.False(t, ok)
+	require.Equal(t, LimitMethod, limit)
+
+	requireAllowed(t, l, "/cosmos.bank.v1beta1.Query/Balance", "a", 5)
+	ok, _ = l.Allow("/cosmos.bank.v1beta1.Query/AllBalances", "a")
+	require.False(t, ok)
+
+	// the method limits replace the per-client limit, which still has quota
+	requireAllowed(t, l, "/cosmos.staking.v1beta1.Query/Validators", "a", 10)
+	ok, limit = l.Allow("/cosmos.staking.v1beta1.Query/Validators", "a")
+	require.False(t, ok)
+	require.Equal(t, LimitClient, limit)
+}
+
+func TestLimiterSweep(t *testing.T) {
+	l, advance := newTestLimiter(t, config.RateLimitConfig{PerClientRPS: 1})
+
+	requireAllowed(t, l, denomOwners, "a", 1)
+	advance(sweepInterval)
+	requireAllowed(t, l, denomOwners, "b", 1)
+	require.Len(t, l.clients, 1, "the refilled bucket of a is removed")
+
+	ok, _ := l.Allow(denomOwners, "b")
+	require.False(t, ok, "the bucket of b is kept")
+}
+
+func TestMiddleware(t *testing.T) {
+	l, _ := newTestLimiter(t, config.RateLimitConfig{PerClientRPS: 1})
+	handler := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", "/cosmos/bank/v1beta1/balances/addr", nil)
+		req.RemoteAddr = remoteAddr
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1234", "").Code)
+	rec := serve("10.0.0.1:5678", "")
+	require.Equal(t, http.StatusTooManyRequests, rec.Code, "the client is its IP")
+	require.Equal(t, "1", rec.Header().Get("Retry-After"))
+	require.Contains(t, rec.Body.String(), "client rate limit exceeded")
+
+	// X-Forwarded-For is ignored unless trusted
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.1:1234", "192.168.0.1").Code)
+
+	l.trustForwardedFor = true
+	require.Equal(t, http.StatusOK, serve("10.0.0.1:1234", "192.168.0.1, 10.0.0.1").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve("10.0.0.2:1234", "192.168.0.1").Code)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l, _ := newTestLimiter(t, config.RateLimitConfig{PerClientRPS: 1})
+	interceptor := l.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: denomOwners}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	call := func(ctx context.Context) error {
+		_, err := interceptor(ctx, nil, info, handler)
+		return err
+	}
+	withPeer := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 1234}})
+	}
+
+	require.NoError(t, call(withPeer("10.0.0.1")))
+	err := call(withPeer("10.0.0.1"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	require.NoError(t, call(withPeer("10.0.0.2")))
+
+	l.trustForwardedFor = true
+	ctx := metadata.NewIncomingContext(withPeer("10.0.0.1"), metadata.Pairs("x-forwarded-for", "192.168.0.1"))
+	require.NoError(t, call(ctx))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call(ctx)))
+}
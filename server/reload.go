@@ -0,0 +1,154 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/ratelimit"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// configReloader reloads the settings of the node which can be changed at
+// runtime from the config.toml and app.toml files of its home directory: the
+// log levels, the minimum gas prices, the rate limits of the API server and the
+// global labels of the telemetry. The other settings take effect on restart.
+type configReloader struct {
+	home      string
+	logger    log.Logger
+	logLevels *LogLevels
+	app       types.Application
+	apiSrv    *api.Server
+
+	mtx sync.Mutex
+}
+
+// Reload reads the configuration files and applies their settings, once they
+// are all valid. The reloaded settings replace the ones set by flags or
+// environment variables.
+func (r *configReloader) Reload() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	for _, name := range []string{"config.toml", "app.toml"} {
+		v.SetConfigFile(filepath.Join(r.home, "config", name))
+		if err := v.MergeInConfig(); err != nil {
+			return fmt.Errorf("failed to read %s: %w", name, err)
+		}
+	}
+
+	logLevel := v.GetString(flags.FlagLogLevel)
+	if _, err := NewLogLevels(logLevel); err != nil {
+		return fmt.Errorf("invalid log level %q: %w", logLevel, err)
+	}
+
+	minGasPrices, err := sdk.ParseDecCoins(v.GetString("minimum-gas-prices"))
+	if err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %w", err)
+	}
+
+	// the gRPC server keeps the limits it was started with
+	limiter, err := ratelimit.NewLimiter("api", config.RateLimitConfig{
+		Enable:            v.GetBool("rate-limit.enable"),
+		GlobalRPS:         v.GetFloat64("rate-limit.global-rps"),
+		PerClientRPS:      v.GetFloat64("rate-limit.per-client-rps"),
+		MethodLimits:      v.GetStringSlice("rate-limit.method-limits"),
+		TrustForwardedFor: v.GetBool("rate-limit.trust-forwarded-for"),
+	})
+	if err != nil {
+		return fmt.Errorf("invalid rate limits: %w", err)
+	}
+
+	globalLabels, err := parseGlobalLabels(v.Get("telemetry.global-labels"))
+	if err != nil {
+		return err
+	}
+	if err := telemetry.SetGlobalLabels(globalLabels); err != nil {
+		return err
+	}
+
+	if r.logLevels != nil {
+		_ = r.logLevels.Set(logLevel)
+	}
+	if updater, ok := r.app.(types.MinGasPricesUpdater); ok {
+		updater.UpdateMinGasPrices(minGasPrices)
+	}
+	if r.apiSrv != nil {
+		r.apiSrv.SetRateLimiter(limiter)
+	}
+
+	r.logger.Info("configuration reloaded", "log_level", logLevel, "minimum-gas-prices", minGasPrices.String())
+
+	return nil
+}
+
+// parseGlobalLabels parses the global labels of the telemetry from their toml
+// value, an array of name and value pairs.
+func parseGlobalLabels(raw interface{}) ([][]string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	rawLabels, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid telemetry global labels, expected an array of name and value pairs")
+	}
+
+	labels := make([][]string, 0, len(rawLabels))
+	for _, rawLabel := range rawLabels {
+		pair, ok := rawLabel.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, fmt.Errorf("invalid telemetry global label %v, expected a name and value pair", rawLabel)
+		}
+
+		name, nameOk := pair[0].(string)
+		value, valueOk := pair[1].(string)
+		if !nameOk || !valueOk {
+			return nil, fmt.Errorf("invalid telemetry global label %v, expected a name and value pair", rawLabel)
+		}
+
+		labels = append(labels, []string{name, value})
+	}
+
+	return labels, nil
+}
+
+// reloadOnHangup reloads the configuration with r on the SIGHUP signals, until
+// the returned function is called.
+func reloadOnHangup(r *configReloader) func() {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-sigs:
+				if err := r.Reload(); err != nil {
+					r.logger.Error("failed to reload the configuration", "err", err)
+				}
+
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigs)
+		close(done)
+	}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type reloadTestApp struct {
+	types.Application
+
+	minGasPrices sdk.DecCoins
+}
+
+func (app *reloadTestApp) UpdateMinGasPrices(gasPrices sdk.DecCoins) {
+	app.minGasPrices = gasPrices
+}
+
+func writeReloadConfig(t *testing.T, home, logLevel string, appCfg *config.Config) {
+	tmCfg := tmcfg.DefaultConfig()
+	tmCfg.LogLevel = logLevel
+	tmcfg.WriteConfigFile(filepath.Join(home, "config", "config.toml"), tmCfg)
+	config.WriteConfigFile(filepath.Join(home, "config", "app.toml"), appCfg)
+}
+
+func TestConfigReloader(t *testing.T) {
+	home := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+
+	logLevels, err := NewLogLevels("info")
+	require.NoError(t, err)
+	app := &reloadTestApp{}
+	r := &configReloader{home: home, logger: log.NewNopLogger(), logLevels: logLevels, app: app}
+	t.Cleanup(func() { _ = telemetry.SetGlobalLabels(nil) })
+
+	require.Error(t, r.Reload())
+
+	appCfg := config.DefaultConfig()
+	appCfg.MinGasPrices = "0.025stake"
+	appCfg.Telemetry.GlobalLabels = [][]string{{"chain_id", "test"}}
+	writeReloadConfig(t, home, "x/gov:debug,*:error", appCfg)
+	require.NoError(t, r.Reload())
+	require.Equal(t, "x/gov:debug,*:error", logLevels.String())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(25, 3))), app.minGasPrices)
+
+	// nothing is applied if a setting is invalid
+	appCfg.MinGasPrices = "0.01atom"
+	appCfg.RateLimit.Enable = true
+	appCfg.RateLimit.GlobalRPS = -1
+	writeReloadConfig(t, home, "info", appCfg)
+	require.EqualError(t, r.Reload(), "invalid rate limits: rate limits must not be negative")
+	require.Equal(t, "x/gov:debug,*:error", logLevels.String())
+	require.Equal(t, "0.025000000000000000stake", app.minGasPrices.String())
+
+	appCfg.RateLimit.GlobalRPS = 10
+	writeReloadConfig(t, home, "info", appCfg)
+	require.NoError(t, r.Reload())
+	require.Equal(t, "*:info", logLevels.String())
+	require.Equal(t, "0.010000000000000000atom", app.minGasPrices.String())
+}
+
+func TestParseGlobalLabels(t *testing.T) {
+	labels, err := parseGlobalLabels([]interface{}{[]interface{}{"chain_id", "test"}})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"chain_id", "test"}}, labels)
+
+	labels, err = parseGlobalLabels(nil)
+	require.NoError(t, err)
+	require.Empty(t, labels)
+
+	_, err = parseGlobalLabels([]interface{}{[]interface{}{"chain_id"}})
+	require.Error(t, err)
+
+	_, err = parseGlobalLabels("chain_id")
+	require.Error(t, err)
+}
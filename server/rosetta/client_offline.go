@@ -6,6 +6,7 @@ import (
 
 	"github.com/coinbase/rosetta-sdk-go/types"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	crgerrs "github.com/cosmos/cosmos-sdk/server/rosetta/lib/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,6 +35,10 @@ func (c *Client) SupportedOperations() []string {
 	return c.supportedOperations
 }
 
+func (c *Client) CallMethods() []string {
+	return []string{CallMethodSuggestFee}
+}
+
 // ---------- cosmos-rosetta-gateway.types.OfflineClient implementation ------------ //
 
 func (c *Client) SignedTx(_ context.Context, txBytes []byte, signatures []*types.Signature) (signedTxBytes []byte, err error) {
@@ -97,12 +102,14 @@ func (c *Client) PreprocessOperationsToOptions(_ context.Context, req *types.Con
 		return nil, err
 	}
 
-	if meta.GasPrice == "" {
-		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "no gas prices")
+	// default to the suggested fee, so that the transactions
+	// can be constructed without a node
+	if meta.GasLimit == 0 {
+		meta.GasLimit = flags.DefaultGasLimit
 	}
 
-	if meta.GasLimit == 0 {
-		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "no gas limit")
+	if meta.GasPrice == "" {
+		meta.GasPrice = c.suggestFee(meta.GasLimit).String()
 	}
 
 	// prepare the options to return
@@ -123,6 +130,45 @@ func (c *Client) PreprocessOperationsToOptions(_ context.Context, req *types.Con
 	}, nil
 }
 
+func (c *Client) Call(_ context.Context, method string, params map[string]interface{}) (result map[string]interface{}, idempotent bool, err error) {
+	switch method {
+	case CallMethodSuggestFee:
+		req := new(SuggestFeeRequest)
+		if err := req.FromMetadata(params); err != nil {
+			return nil, false, err
+		}
+
+		if req.GasLimit == 0 {
+			req.GasLimit = flags.DefaultGasLimit
+		}
+
+		result, err = SuggestFeeResponse{
+			GasLimit: req.GasLimit,
+			GasPrice: c.suggestFee(req.GasLimit).String(),
+		}.ToMetadata()
+
+		// the min gas prices can change when restarting
+		return result, false, err
+
+	default:
+		return nil, false, crgerrs.WrapError(crgerrs.ErrBadArgument, "unsupported call method: "+method)
+	}
+}
+
+// suggestFee returns the fee of gasLimit at the first of the min gas prices,
+// which the fees can be paid in any of. The fee is empty if there are no min
+// gas prices.
+func (c *Client) suggestFee(gasLimit uint64) sdk.Coins {
+	if len(c.config.MinGasPrices) == 0 {
+		return sdk.Coins{}
+	}
+
+	gasPrice := c.config.MinGasPrices[0]
+	fee := gasPrice.Amount.Mul(sdk.NewDec(int64(gasLimit))).Ceil().RoundInt()
+
+	return sdk.NewCoins(sdk.NewCoin(gasPrice.Denom, fee))
+}
+
 func (c *Client) AccountIdentifierFromPublicKey(pubKey *types.PublicKey) (*types.AccountIdentifier, error) {
 	pk, err := c.converter.ToSDK().PubKey(pubKey)
 	if err != nil {
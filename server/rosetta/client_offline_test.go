@@ -0,0 +1,111 @@
+package rosetta
+
+import (
+	"context"
+	"testing"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newOfflineClient(t *testing.T, minGasPrices string) *Client {
+	gasPrices, err := sdk.ParseDecCoins(minGasPrices)
+	require.NoError(t, err)
+
+	conf := &Config{Network: DefaultNetwork, Offline: true, MinGasPrices: gasPrices}
+	cdc, ir := MakeCodec()
+	conf.WithCodec(ir, cdc)
+	require.NoError(t, conf.validate())
+
+	client, err := NewClient(conf)
+	require.NoError(t, err)
+
+	return client
+}
+
+func TestOfflineServerFromConfig(t *testing.T) {
+	conf := &Config{Network: DefaultNetwork, Offline: true}
+	cdc, ir := MakeCodec()
+	conf.WithCodec(ir, cdc)
+
+	_, err := ServerFromConfig(conf)
+	require.NoError(t, err)
+
+	// the endpoints are still required online
+	conf.Offline = false
+	_, err = ServerFromConfig(conf)
+	require.Error(t, err)
+}
+
+func TestCallSuggestFee(t *testing.T) {
+	client := newOfflineClient(t, "0.0025stake,0.1atom")
+	require.Equal(t, []string{CallMethodSuggestFee}, client.CallMethods())
+
+	testCases := []struct {
+		name   string
+		params map[string]interface{}
+		expRes SuggestFeeResponse
+	}{
+		{"default gas limit", nil, SuggestFeeResponse{GasLimit: flags.DefaultGasLimit, GasPrice: "20000atom"}},
+		{"gas limit", map[string]interface{}{"gas_limit": 1001}, SuggestFeeResponse{GasLimit: 1001, GasPrice: "101atom"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, idempotent, err := client.Call(context.Background(), CallMethodSuggestFee, tc.params)
+			require.NoError(t, err)
+			require.False(t, idempotent)
+
+			expRes, err := tc.expRes.ToMetadata()
+			require.NoError(t, err)
+			require.Equal(t, expRes, res)
+		})
+	}
+
+	_, _, err := client.Call(context.Background(), "unknown", nil)
+	require.Error(t, err)
+
+	// no fee is suggested without min gas prices
+	res, _, err := newOfflineClient(t, "").Call(context.Background(), CallMethodSuggestFee, nil)
+	require.NoError(t, err)
+	require.Equal(t, "", res["gas_price"])
+}
+
+func TestPreprocessSuggestsFee(t *testing.T) {
+	client := newOfflineClient(t, "0.0025stake")
+	delegator := sdk.AccAddress("delegator").String()
+
+	req := &rosettatypes.ConstructionPreprocessRequest{
+		Operations: []*rosettatypes.Operation{{
+			OperationIdentifier: &rosettatypes.OperationIdentifier{},
+			Type:                OperationWithdrawRewards,
+			Account:             &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata:            map[string]interface{}{"validator_address": sdk.ValAddress("validator").String()},
+		}},
+		Metadata: map[string]interface{}{"memo": "memo"},
+	}
+
+	res, err := client.PreprocessOperationsToOptions(context.Background(), req)
+	require.NoError(t, err)
+
+	options := new(PreprocessOperationsOptionsResponse)
+	require.NoError(t, options.FromMetadata(res.Options))
+	require.Equal(t, PreprocessOperationsOptionsResponse{
+		ExpectedSigners: []string{delegator},
+		Memo:            "memo",
+		GasLimit:        flags.DefaultGasLimit,
+		GasPrice:        "500stake",
+	}, *options)
+
+	// the provided fee is kept
+	req.Metadata = map[string]interface{}{"gas_limit": 100000, "gas_price": "1stake"}
+	res, err = client.PreprocessOperationsToOptions(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, options.FromMetadata(res.Options))
+	require.Equal(t, uint64(100000), options.GasLimit)
+	require.Equal(t, "1stake", options.GasPrice)
+}
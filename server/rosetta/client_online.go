@@ -77,6 +77,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		}
 	}
 
+	supportedOperations = append(supportedOperations, NamedOperations...)
 	supportedOperations = append(
 		supportedOperations,
 		bank.EventTypeCoinSpent,
@@ -6,6 +6,9 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankcodec "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrcodec "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govcodec "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingcodec "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // MakeCodec generates the codec required to interact
@@ -17,6 +20,9 @@ func MakeCodec() (*codec.ProtoCodec, codectypes.InterfaceRegistry) {
 	authcodec.RegisterInterfaces(ir)
 	bankcodec.RegisterInterfaces(ir)
 	cryptocodec.RegisterInterfaces(ir)
+	distrcodec.RegisterInterfaces(ir)
+	govcodec.RegisterInterfaces(ir)
+	stakingcodec.RegisterInterfaces(ir)
 
 	return cdc, ir
 }
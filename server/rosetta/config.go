@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // configuration defaults constants
@@ -30,6 +31,8 @@ const (
 	DefaultNetwork = "network"
 	// DefaultOffline defines the default offline value
 	DefaultOffline = false
+	// DefaultMinGasPrices defines the default min gas prices of the fee suggestion
+	DefaultMinGasPrices = ""
 )
 
 // configuration flags
@@ -41,6 +44,7 @@ const (
 	FlagAddr               = "addr"
 	FlagRetries            = "retries"
 	FlagOffline            = "offline"
+	FlagMinGasPrices       = "min-gas-prices"
 )
 
 // Config defines the configuration of the rosetta server
@@ -63,8 +67,12 @@ type Config struct {
 	// Retries defines the maximum number of retries
 	// rosetta will do before quitting
 	Retries int
-	// Offline defines if the server must be run in offline mode
+	// Offline defines if the server must be run in offline mode,
+	// serving only the construction endpoints not requiring the node
 	Offline bool
+	// MinGasPrices defines the gas prices the fees are suggested with,
+	// usually the min gas prices of the node
+	MinGasPrices sdk.DecCoins
 	// Codec overrides the default data and construction api client codecs
 	Codec *codec.ProtoCodec
 	// InterfaceRegistry overrides the default data and construction api interface registry
@@ -99,11 +107,11 @@ func (c *Config) validate() error {
 	if c.Network == "" {
 		return fmt.Errorf("network not provided")
 	}
+	// the endpoints are not used in offline mode
 	if c.Offline {
-		return fmt.Errorf("offline mode is not supported for stargate implementation due to how sigv2 works")
+		return nil
 	}
 
-	// these are optional but it must be online
 	if c.GRPCEndpoint == "" {
 		return fmt.Errorf("grpc endpoint not provided")
 	}
@@ -153,6 +161,14 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	minGasPricesStr, err := flags.GetString(FlagMinGasPrices)
+	if err != nil {
+		return nil, err
+	}
+	minGasPrices, err := sdk.ParseDecCoins(minGasPricesStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid min gas prices: %w", err)
+	}
 	conf := &Config{
 		Blockchain:    blockchain,
 		Network:       network,
@@ -161,6 +177,7 @@ func FromFlags(flags *pflag.FlagSet) (*Config, error) {
 		Addr:          addr,
 		Retries:       retries,
 		Offline:       offline,
+		MinGasPrices:  minGasPrices,
 	}
 	err = conf.validate()
 	if err != nil {
@@ -200,5 +217,6 @@ func SetFlags(flags *pflag.FlagSet) {
 	flags.String(FlagGRPCEndpoint, DefaultGRPCEndpoint, "the app gRPC endpoint")
 	flags.String(FlagAddr, DefaultAddr, "the address rosetta will bind to")
 	flags.Int(FlagRetries, DefaultRetries, "the number of retries that will be done before quitting")
-	flags.Bool(FlagOffline, DefaultOffline, "run rosetta only with construction API")
+	flags.Bool(FlagOffline, DefaultOffline, "run rosetta only with the construction endpoints not requiring the node")
+	flags.String(FlagMinGasPrices, DefaultMinGasPrices, "the gas prices the fees are suggested with, usually the min gas prices of the node (e.g. 0.025stake)")
 }
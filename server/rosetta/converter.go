@@ -148,19 +148,9 @@ func (c converter) UnsignedTx(ops []*rosettatypes.Operation) (tx authsigning.Tx,
 	for i := 0; i < len(ops); i++ {
 		op := ops[i]
 
-		protoMessage, err := c.ir.Resolve(op.Type)
+		msg, err := c.opMsg(op)
 		if err != nil {
-			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation not found: "+op.Type)
-		}
-
-		msg, ok := protoMessage.(sdk.Msg)
-		if !ok {
-			return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation is not a valid supported sdk.Msg: "+op.Type)
-		}
-
-		err = c.Msg(op.Metadata, msg)
-		if err != nil {
-			return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+			return nil, err
 		}
 
 		// verify message correctness
@@ -215,6 +205,32 @@ func (c converter) UnsignedTx(ops []*rosettatypes.Operation) (tx authsigning.Tx,
 
 }
 
+// opMsg returns the sdk.Msg of the given operation, built from its account and
+// metadata for the named operations, or unmarshaled from its metadata for the
+// operations typed by the type URL of the message.
+func (c converter) opMsg(op *rosettatypes.Operation) (sdk.Msg, error) {
+	if msg, ok, err := namedOpMsg(op); ok {
+		return msg, err
+	}
+
+	protoMessage, err := c.ir.Resolve(op.Type)
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation not found: "+op.Type)
+	}
+
+	msg, ok := protoMessage.(sdk.Msg)
+	if !ok {
+		return nil, crgerrs.WrapError(crgerrs.ErrBadArgument, "operation is not a valid supported sdk.Msg: "+op.Type)
+	}
+
+	err = c.Msg(op.Metadata, msg)
+	if err != nil {
+		return nil, crgerrs.WrapError(crgerrs.ErrCodec, err.Error())
+	}
+
+	return msg, nil
+}
+
 // Msg unmarshals the rosetta metadata to the given sdk.Msg
 func (c converter) Msg(meta map[string]interface{}, msg sdk.Msg) error {
 	metaBytes, err := json.Marshal(meta)
@@ -240,8 +256,16 @@ func (c converter) Meta(msg sdk.Msg) (meta map[string]interface{}, err error) {
 
 // Ops will create an operation for each msg signer
 // with the message proto name as type, and the raw fields
-// as metadata
+// as metadata. The staking, distribution and gov messages
+// having a named operation are mapped to it instead.
 func (c converter) Ops(status string, msg sdk.Msg) ([]*rosettatypes.Operation, error) {
+	if op, ok, err := namedOp(status, msg); ok {
+		if err != nil {
+			return nil, err
+		}
+		return []*rosettatypes.Operation{op}, nil
+	}
+
 	opName := sdk.MsgTypeURL(msg)
 
 	meta, err := c.Meta(msg)
@@ -22,6 +22,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type ConverterTestSuite struct {
@@ -186,6 +189,97 @@ func (s *ConverterTestSuite) TestOpsAndSigners() {
 	})
 }
 
+func (s *ConverterTestSuite) TestNamedOperations() {
+	delegator := sdk.AccAddress("delegator").String()
+	validator := sdk.ValAddress("validator").String()
+
+	msgs := []sdk.Msg{
+		&staking.MsgDelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: sdk.NewInt64Coin("stake", 10)},
+		&staking.MsgUndelegate{DelegatorAddress: delegator, ValidatorAddress: validator, Amount: sdk.NewInt64Coin("stake", 5)},
+		&distr.MsgWithdrawDelegatorReward{DelegatorAddress: delegator, ValidatorAddress: validator},
+		&gov.MsgVote{ProposalId: 1, Voter: delegator, Option: gov.OptionYes},
+	}
+	expectedOps := []*rosettatypes.Operation{
+		{
+			Type:     rosetta.OperationDelegate,
+			Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata: map[string]interface{}{"validator_address": validator, "amount": "10stake"},
+		},
+		{
+			Type:     rosetta.OperationUndelegate,
+			Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata: map[string]interface{}{"validator_address": validator, "amount": "5stake"},
+		},
+		{
+			Type:     rosetta.OperationWithdrawRewards,
+			Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata: map[string]interface{}{"validator_address": validator},
+		},
+		{
+			Type:     rosetta.OperationVote,
+			Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+			Metadata: map[string]interface{}{"proposal_id": "1", "option": "VOTE_OPTION_YES"},
+		},
+	}
+
+	var ops []*rosettatypes.Operation
+	for i, msg := range msgs {
+		msgOps, err := s.c.ToRosetta().Ops("", msg)
+		s.Require().NoError(err)
+		s.Require().Len(msgOps, 1)
+		s.Require().Equal(expectedOps[i].Type, msgOps[0].Type)
+		s.Require().Equal(expectedOps[i].Account, msgOps[0].Account)
+		s.Require().Equal(expectedOps[i].Metadata, msgOps[0].Metadata)
+
+		ops = append(ops, msgOps...)
+	}
+
+	// the operations are converted back to the same messages
+	tx, err := s.c.ToSDK().UnsignedTx(expectedOps)
+	s.Require().NoError(err)
+	s.Require().Equal(msgs, tx.GetMsgs())
+
+	// and parsed back to the same operations
+	txBytes, err := s.txConf.TxEncoder()(tx)
+	s.Require().NoError(err)
+	parsedOps, signers, err := s.c.ToRosetta().OpsAndSigners(txBytes)
+	s.Require().NoError(err)
+	s.Require().Equal(rosetta.AddOperationIndexes(ops, nil), parsedOps)
+	s.Require().Equal([]*rosettatypes.AccountIdentifier{{Address: delegator}}, signers)
+
+	s.Run("invalid operations", func() {
+		invalidOps := []*rosettatypes.Operation{
+			{Type: rosetta.OperationDelegate, Metadata: expectedOps[0].Metadata},
+			{
+				Type:     rosetta.OperationDelegate,
+				Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+				Metadata: map[string]interface{}{"validator_address": validator, "amount": "stake"},
+			},
+			{
+				Type:     rosetta.OperationUndelegate,
+				Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+				Metadata: map[string]interface{}{"validator_address": validator, "amount": "0stake"},
+			},
+			{
+				Type:     rosetta.OperationVote,
+				Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+				Metadata: map[string]interface{}{"proposal_id": "1", "option": "yes"},
+			},
+			{
+				Type:     rosetta.OperationVote,
+				Account:  &rosettatypes.AccountIdentifier{Address: delegator},
+				Metadata: map[string]interface{}{"proposal_id": "-1", "option": "VOTE_OPTION_YES"},
+			},
+		}
+
+		for _, op := range invalidOps {
+			op.OperationIdentifier = &rosettatypes.OperationIdentifier{}
+			_, err := s.c.ToSDK().UnsignedTx([]*rosettatypes.Operation{op})
+			s.Require().ErrorIs(err, crgerrs.ErrBadArgument)
+		}
+	})
+}
+
 func (s *ConverterTestSuite) TestBeginEndBlockAndHashToTxType() {
 	const deliverTxHex = "5229A67AA008B5C5F1A0AEA77D4DEBE146297A30AAEF01777AF10FAD62DD36AB"
 
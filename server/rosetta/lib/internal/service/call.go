@@ -0,0 +1,23 @@
+package service
+
+import (
+	"context"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+
+	"github.com/cosmos/cosmos-sdk/server/rosetta/lib/errors"
+)
+
+// Call invokes a network specific method, such as the fee suggestion, which is
+// available in offline mode too.
+func (on OnlineNetwork) Call(ctx context.Context, request *types.CallRequest) (*types.CallResponse, *types.Error) {
+	result, idempotent, err := on.client.Call(ctx, request.Method, request.Parameters)
+	if err != nil {
+		return nil, errors.ToRosetta(err)
+	}
+
+	return &types.CallResponse{
+		Result:     result,
+		Idempotent: idempotent,
+	}, nil
+}
@@ -66,6 +66,7 @@ func networkOptionsFromClient(client crgtypes.Client, genesisBlock *types.BlockI
 			Errors:                  crgerrs.SealAndListErrors(),
 			HistoricalBalanceLookup: true,
 			TimestampStartIndex:     tsi,
+			CallMethods:             client.CallMethods(),
 		},
 	}
 }
@@ -47,7 +47,7 @@ func NewServer(settings Settings) (Server, error) {
 		settings.Client.SupportedOperations(),
 		true,
 		[]*types.NetworkIdentifier{settings.Network},
-		nil,
+		settings.Client.CallMethods(),
 		false,
 	)
 	if err != nil {
@@ -72,6 +72,7 @@ func NewServer(settings Settings) (Server, error) {
 		server.NewNetworkAPIController(adapter, asserter),
 		server.NewMempoolAPIController(adapter, asserter),
 		server.NewConstructionAPIController(adapter, asserter),
+		server.NewCallAPIController(adapter, asserter),
 	)
 
 	return Server{
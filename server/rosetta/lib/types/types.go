@@ -19,6 +19,8 @@ type NetworkInformationProvider interface {
 	OperationStatuses() []*types.OperationStatus
 	// Version returns the version of the node
 	Version() string
+	// CallMethods returns the list of the methods supported by the call endpoint
+	CallMethods() []string
 }
 
 // Client defines the API the client implementation should provide.
@@ -83,6 +85,9 @@ type OfflineClient interface {
 	PreprocessOperationsToOptions(ctx context.Context, req *types.ConstructionPreprocessRequest) (resp *types.ConstructionPreprocessResponse, err error)
 	// AccountIdentifierFromPublicKey returns the account identifier given the public key
 	AccountIdentifierFromPublicKey(pubKey *types.PublicKey) (*types.AccountIdentifier, error)
+	// Call invokes the network specific method with the given parameters, and returns its
+	// result and if invoking it again with the same parameters always returns the same result
+	Call(ctx context.Context, method string, params map[string]interface{}) (result map[string]interface{}, idempotent bool, err error)
 }
 
 type BlockTransactionsResponse struct {
@@ -102,6 +107,7 @@ type BlockResponse struct {
 type API interface {
 	DataAPI
 	ConstructionAPI
+	server.CallAPIServicer
 }
 
 // DataAPI defines the full data API implementation
@@ -0,0 +1,159 @@
+package rosetta
+
+import (
+	"fmt"
+	"strconv"
+
+	rosettatypes "github.com/coinbase/rosetta-sdk-go/types"
+
+	crgerrs "github.com/cosmos/cosmos-sdk/server/rosetta/lib/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Operation types of the staking, distribution and gov messages. The messages
+// are mapped to these operations, instead of operations typed by their type
+// URL, so that integrations can support them without knowing their protobuf
+// definitions. The account of the operations is the signer of the message.
+const (
+	OperationDelegate        = "delegate"
+	OperationUndelegate      = "undelegate"
+	OperationWithdrawRewards = "withdraw_rewards"
+	OperationVote            = "vote"
+)
+
+// NamedOperations are the operation types mapped to messages by their name.
+var NamedOperations = []string{OperationDelegate, OperationUndelegate, OperationWithdrawRewards, OperationVote}
+
+// DelegationMetadata is the metadata of the delegate and undelegate operations.
+// The delegated amount is part of the metadata and not of the operation, the
+// balance changes being reported by the balance operations of the transaction.
+type DelegationMetadata struct {
+	ValidatorAddress string `json:"validator_address"`
+	Amount           string `json:"amount"`
+}
+
+// WithdrawRewardsMetadata is the metadata of the withdraw rewards operations.
+type WithdrawRewardsMetadata struct {
+	ValidatorAddress string `json:"validator_address"`
+}
+
+// VoteMetadata is the metadata of the vote operations. The proposal id is a
+// string as the JSON numbers cannot represent all the uint64 values, and the
+// option is the name of the vote option, e.g. "VOTE_OPTION_YES".
+type VoteMetadata struct {
+	ProposalID string `json:"proposal_id"`
+	Option     string `json:"option"`
+}
+
+// namedOp returns the named operation of msg, or false if msg is not mapped to
+// a named operation.
+func namedOp(status string, msg sdk.Msg) (*rosettatypes.Operation, bool, error) {
+	var (
+		opType string
+		signer string
+		meta   interface{}
+	)
+
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		opType, signer = OperationDelegate, msg.DelegatorAddress
+		meta = DelegationMetadata{ValidatorAddress: msg.ValidatorAddress, Amount: msg.Amount.String()}
+
+	case *stakingtypes.MsgUndelegate:
+		opType, signer = OperationUndelegate, msg.DelegatorAddress
+		meta = DelegationMetadata{ValidatorAddress: msg.ValidatorAddress, Amount: msg.Amount.String()}
+
+	case *distrtypes.MsgWithdrawDelegatorReward:
+		opType, signer = OperationWithdrawRewards, msg.DelegatorAddress
+		meta = WithdrawRewardsMetadata{ValidatorAddress: msg.ValidatorAddress}
+
+	case *govtypes.MsgVote:
+		opType, signer = OperationVote, msg.Voter
+		meta = VoteMetadata{ProposalID: strconv.FormatUint(msg.ProposalId, 10), Option: msg.Option.String()}
+
+	default:
+		return nil, false, nil
+	}
+
+	metadata, err := marshalMetadata(meta)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return &rosettatypes.Operation{
+		Type:     opType,
+		Status:   &status,
+		Account:  &rosettatypes.AccountIdentifier{Address: signer},
+		Metadata: metadata,
+	}, true, nil
+}
+
+// namedOpMsg returns the message of the named operation op, or false if op is
+// not a named operation.
+func namedOpMsg(op *rosettatypes.Operation) (sdk.Msg, bool, error) {
+	if !isNamedOp(op.Type) {
+		return nil, false, nil
+	}
+
+	if op.Account == nil || op.Account.Address == "" {
+		return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("%s operation has no account", op.Type))
+	}
+	signer := op.Account.Address
+
+	switch op.Type {
+	case OperationDelegate, OperationUndelegate:
+		meta := new(DelegationMetadata)
+		if err := unmarshalMetadata(op.Metadata, meta); err != nil {
+			return nil, true, err
+		}
+
+		amount, err := sdk.ParseCoinNormalized(meta.Amount)
+		if err != nil {
+			return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid %s amount: %s", op.Type, err))
+		}
+
+		if op.Type == OperationDelegate {
+			return &stakingtypes.MsgDelegate{DelegatorAddress: signer, ValidatorAddress: meta.ValidatorAddress, Amount: amount}, true, nil
+		}
+		return &stakingtypes.MsgUndelegate{DelegatorAddress: signer, ValidatorAddress: meta.ValidatorAddress, Amount: amount}, true, nil
+
+	case OperationWithdrawRewards:
+		meta := new(WithdrawRewardsMetadata)
+		if err := unmarshalMetadata(op.Metadata, meta); err != nil {
+			return nil, true, err
+		}
+
+		return &distrtypes.MsgWithdrawDelegatorReward{DelegatorAddress: signer, ValidatorAddress: meta.ValidatorAddress}, true, nil
+
+	default: // OperationVote
+		meta := new(VoteMetadata)
+		if err := unmarshalMetadata(op.Metadata, meta); err != nil {
+			return nil, true, err
+		}
+
+		proposalID, err := strconv.ParseUint(meta.ProposalID, 10, 64)
+		if err != nil {
+			return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, fmt.Sprintf("invalid vote proposal id: %s", meta.ProposalID))
+		}
+
+		option, err := govtypes.VoteOptionFromString(meta.Option)
+		if err != nil {
+			return nil, true, crgerrs.WrapError(crgerrs.ErrBadArgument, err.Error())
+		}
+
+		return &govtypes.MsgVote{ProposalId: proposalID, Voter: signer, Option: option}, true, nil
+	}
+}
+
+func isNamedOp(opType string) bool {
+	for _, t := range NamedOperations {
+		if t == opType {
+			return true
+		}
+	}
+
+	return false
+}
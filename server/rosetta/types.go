@@ -49,6 +49,34 @@ const (
 	Log = "log"
 )
 
+// call methods
+const (
+	// CallMethodSuggestFee is the call method returning the fee suggested for a
+	// gas limit, computed with the min gas prices of the configuration
+	CallMethodSuggestFee = "suggest_fee"
+)
+
+// SuggestFeeRequest is the parameters of the fee suggestion call method, the
+// gas limit defaulting to the one of the client
+type SuggestFeeRequest struct {
+	GasLimit uint64 `json:"gas_limit"`
+}
+
+func (c *SuggestFeeRequest) FromMetadata(meta map[string]interface{}) error {
+	return unmarshalMetadata(meta, c)
+}
+
+// SuggestFeeResponse is the result of the fee suggestion call method, which
+// can be used as the metadata of the preprocess request
+type SuggestFeeResponse struct {
+	GasLimit uint64 `json:"gas_limit"`
+	GasPrice string `json:"gas_price"`
+}
+
+func (c SuggestFeeResponse) ToMetadata() (map[string]interface{}, error) {
+	return marshalMetadata(c)
+}
+
 // ConstructionPreprocessMetadata is used to represent
 // the metadata rosetta can provide during preprocess options
 type ConstructionPreprocessMetadata struct {
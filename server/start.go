@@ -3,10 +3,13 @@ package server
 // DONTCOVER
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"runtime/pprof"
+	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,6 +19,7 @@ import (
 
 	"github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
@@ -30,9 +34,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/diagnostics"
 	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 	"github.com/cosmos/cosmos-sdk/server/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // Tendermint full-node start flags
@@ -56,16 +63,39 @@ const (
 	FlagPruningInterval   = "pruning-interval"
 	FlagIndexEvents       = "index-events"
 	FlagMinRetainBlocks   = "min-retain-blocks"
+	FlagTxDecodeMode      = "tx-decode-mode"
+
+	FlagReplayVerificationWindow = "replay-verification-window"
+	FlagGasUsageWindow           = "gas-usage-window"
+	FlagStateSizeInterval        = "state-size-interval"
+	FlagShutdownTimeout          = "shutdown-timeout"
+	FlagVerifyStore              = "verify-store"
 )
 
 // GRPC-related flags.
 const (
 	flagGRPCEnable     = "grpc.enable"
 	flagGRPCAddress    = "grpc.address"
+	flagGRPCReflection = "grpc.enable-reflection"
+	flagGRPCHealth     = "grpc.enable-health-check"
 	flagGRPCWebEnable  = "grpc-web.enable"
 	flagGRPCWebAddress = "grpc-web.address"
 )
 
+// gRPC-only mode flags.
+const (
+	FlagGRPCOnly      = "grpc-only"
+	FlagGRPCOnlyNoP2P = "grpc-only.no-p2p"
+)
+
+// Query cache-related flags.
+const (
+	FlagQueryCacheEnable     = "query-cache.enable"
+	FlagQueryCacheTTL        = "query-cache.ttl"
+	FlagQueryCacheMaxEntries = "query-cache.max-entries"
+	FlagQueryCacheMethods    = "query-cache.methods"
+)
+
 // State sync-related flags.
 const (
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -99,6 +129,16 @@ will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
+
+The '--verify-store' flag verifies the IAVL stores of the latest version of the state against its
+commit info before starting, as the 'verify-store' command does, and fails to start if a store is
+corrupt, before the node joins consensus.
+
+Query-serving replicas can be run with the '--grpc-only' flag, starting the application with the gRPC
+and gRPC-web servers only, without the Tendermint RPC server, the API server and Rosetta. The node still
+syncs the blocks, e.g. from a state sync snapshot, through the p2p network. With '--grpc-only.no-p2p',
+the p2p and consensus stacks are not started either: the blocks of the local block store are replayed
+into the application, whose state is then served as of the last stored block.
 `,
 		PreRunE: func(cmd *cobra.Command, _ []string) error {
 			serverCtx := GetServerContextFromCmd(cmd)
@@ -155,12 +195,28 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
+	cmd.Flags().String(FlagTxDecodeMode, "strict", "How unknown fields in the transactions decoded by the tx service queries are handled (strict|tolerant); tolerant accepts unknown non-critical fields in the tx body and auth info. Executed transactions are always decoded strictly")
+	cmd.Flags().Uint64(FlagReplayVerificationWindow, 0, "Number of trailing committed blocks re-executed in the background to verify their app hash (0 disables)")
+	cmd.Flags().Uint64(FlagGasUsageWindow, 0, "Number of trailing committed blocks whose gas consumption is broken down per message type (0 disables)")
+	cmd.Flags().Uint64(FlagStateSizeInterval, 0, "Interval of committed blocks at which the size of the stores of the state is measured in the background (0 disables)")
+	cmd.Flags().Uint64(FlagShutdownTimeout, 30, "Maximum duration, in seconds, for which the outstanding gRPC and API requests are served on shutdown")
+	cmd.Flags().Bool(FlagVerifyStore, false, "Verify the IAVL stores of the latest version of the state against its commit info before starting")
 
 	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
 	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "the gRPC server address to listen on")
+	cmd.Flags().Bool(flagGRPCReflection, true, "Define if the gRPC server reflection service should be registered")
+	cmd.Flags().Bool(flagGRPCHealth, true, "Define if the gRPC health checking service should be registered")
 
 	cmd.Flags().Bool(flagGRPCWebEnable, true, "Define if the gRPC-Web server should be enabled. (Note: gRPC must also be enabled.)")
-	cmd.Flags().String(flagGRPCWebAddress, config.DefaultGRPCWebAddress, "The gRPC-Web server address to listen on")
+	cmd.Flags().String(flagGRPCWebAddress, "", "The gRPC-Web server address to listen on (defaults to the gRPC server address)")
+
+	cmd.Flags().Bool(FlagQueryCacheEnable, false, "Define if the responses of the query-cache.methods gRPC queries should be cached")
+	cmd.Flags().Uint(FlagQueryCacheTTL, 5, "Maximum lifetime of the cached query responses, in seconds")
+	cmd.Flags().Uint(FlagQueryCacheMaxEntries, 1000, "Maximum number of cached query responses")
+	cmd.Flags().StringSlice(FlagQueryCacheMethods, config.DefaultQueryCacheMethods, "gRPC full method names of the cached queries")
+
+	cmd.Flags().Bool(FlagGRPCOnly, false, "Serve the gRPC queries only, without the Tendermint RPC server, the API server and Rosetta")
+	cmd.Flags().Bool(FlagGRPCOnlyNoP2P, false, "In gRPC-only mode, replay the stored blocks without starting the p2p and consensus stacks")
 
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
@@ -179,6 +235,9 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 	if err != nil {
 		return err
 	}
+	if err := verifyStoreOnStart(ctx, db); err != nil {
+		return err
+	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
 	traceWriter, err := openTraceWriter(traceWriterFile)
@@ -186,6 +245,11 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		return err
 	}
 
+	pagination := config.GetConfig(ctx.Viper).Pagination
+	if err := query.SetPaginationLimits(pagination.DefaultLimit, pagination.MaxLimit); err != nil {
+		return err
+	}
+
 	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
 
 	svr, err := server.NewServer(addr, transport, app)
@@ -204,6 +268,13 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 		if err = svr.Stop(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// the block being committed and the snapshot being taken are completed
+		if closer, ok := app.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				ctx.Logger.Error("failed to close the app", "err", err)
+			}
+		}
 	}()
 
 	// Wait for SIGINT or SIGTERM signal
@@ -214,7 +285,6 @@ func startStandAlone(ctx *Context, appCreator types.AppCreator) error {
 func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.AppCreator) error {
 	cfg := ctx.Config
 	home := cfg.RootDir
-	var cpuProfileCleanup func()
 
 	if cpuProfile := ctx.Viper.GetString(flagCPUProfile); cpuProfile != "" {
 		f, err := os.Create(cpuProfile)
@@ -227,11 +297,12 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			return err
 		}
 
-		cpuProfileCleanup = func() {
+		// the profile is flushed once everything else is stopped
+		defer func() {
 			ctx.Logger.Info("stopping CPU profiler", "profile", cpuProfile)
 			pprof.StopCPUProfile()
 			f.Close()
-		}
+		}()
 	}
 
 	traceWriterFile := ctx.Viper.GetString(flagTraceStore)
@@ -239,6 +310,9 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 	if err != nil {
 		return err
 	}
+	if err := verifyStoreOnStart(ctx, db); err != nil {
+		return err
+	}
 
 	traceWriter, err := openTraceWriter(traceWriterFile)
 	if err != nil {
@@ -252,45 +326,137 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			"(SDK v0.45). Please explicitly put the desired minimum-gas-prices in your app.toml.")
 	}
 
-	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
-
-	nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
-	if err != nil {
+	if err := query.SetPaginationLimits(config.Pagination.DefaultLimit, config.Pagination.MaxLimit); err != nil {
 		return err
 	}
 
-	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
-	tmNode, err := node.NewNode(
-		cfg,
-		pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
-		nodeKey,
-		proxy.NewLocalClientCreator(app),
-		genDocProvider,
-		node.DefaultDBProvider,
-		node.DefaultMetricsProvider(cfg.Instrumentation),
-		ctx.Logger,
-	)
-	if err != nil {
-		return err
+	grpcOnly := ctx.Viper.GetBool(FlagGRPCOnly)
+	noP2P := ctx.Viper.GetBool(FlagGRPCOnlyNoP2P)
+	if noP2P && !grpcOnly {
+		return fmt.Errorf("--%s requires --%s", FlagGRPCOnlyNoP2P, FlagGRPCOnly)
 	}
+	if grpcOnly {
+		if !config.GRPC.Enable {
+			return fmt.Errorf("the gRPC server must be enabled in gRPC-only mode")
+		}
 
-	ctx.Logger.Debug("initialization: tmNode created")
-	if err := tmNode.Start(); err != nil {
-		return err
+		ctx.Logger.Info("starting in gRPC-only mode", "p2p", !noP2P)
+		cfg.RPC.ListenAddress = ""
+		config.API.Enable = false
+		config.Rosetta.Enable = false
+	}
+
+	if config.Tracing.Enabled {
+		stopTracing, err := telemetry.StartTracing(config.Tracing)
+		if err != nil {
+			return err
+		}
+
+		// the spans are flushed once the node is stopped
+		defer func() {
+			if err := stopTracing(context.Background()); err != nil {
+				ctx.Logger.Error("failed to stop tracing", "err", err)
+			}
+		}()
+	}
+
+	app := appCreator(ctx.Logger, db, traceWriter, ctx.Viper)
+
+	// the node, the servers and the app are stopped on return, on the quit
+	// signal as on the errors starting them, the servers being drained first
+	// on the quit signal
+	var (
+		tmNode         *node.Node
+		apiSrv         *api.Server
+		grpcSrv        *grpc.Server
+		grpcWebSrv     *http.Server
+		diagnosticsSrv *diagnostics.Server
+		drained        <-chan struct{}
+	)
+	defer func() {
+		if drained == nil {
+			closeServers(apiSrv, grpcSrv, grpcWebSrv)
+		}
+
+		if tmNode != nil && tmNode.IsRunning() {
+			_ = tmNode.Stop()
+		}
+
+		// the block being committed and the snapshot being taken are completed,
+		// and the event streams are closed once they sent the events of the
+		// committed blocks
+		if closer, ok := app.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				ctx.Logger.Error("failed to close the app", "err", err)
+			}
+		}
+
+		if drained != nil {
+			<-drained
+		}
+
+		if diagnosticsSrv != nil {
+			_ = diagnosticsSrv.Close()
+		}
+
+		ctx.Logger.Info("exiting...")
+	}()
+
+	genDocProvider := node.DefaultGenesisDocProviderFunc(cfg)
+
+	// without p2p, there is no Tendermint node: the app only serves the state
+	// of the stored blocks
+	if noP2P {
+		if err := replayBlocks(cfg, app, genDocProvider, ctx.Logger); err != nil {
+			return err
+		}
+	} else {
+		nodeKey, err := p2p.LoadOrGenNodeKey(cfg.NodeKeyFile())
+		if err != nil {
+			return err
+		}
+
+		tmNode, err = node.NewNode(
+			cfg,
+			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
+			nodeKey,
+			proxy.NewLocalClientCreator(app),
+			genDocProvider,
+			node.DefaultDBProvider,
+			node.DefaultMetricsProvider(cfg.Instrumentation),
+			ctx.Logger,
+		)
+		if err != nil {
+			return err
+		}
+
+		ctx.Logger.Debug("initialization: tmNode created")
+		if err := tmNode.Start(); err != nil {
+			return err
+		}
+		ctx.Logger.Debug("initialization: tmNode started")
 	}
-	ctx.Logger.Debug("initialization: tmNode started")
 
 	// Add the tx service to the gRPC router. We only need to register this
 	// service if API or gRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local tendermint RPC client.
-	if config.API.Enable || config.GRPC.Enable {
+	if tmNode != nil && (config.API.Enable || config.GRPC.Enable) {
 		clientCtx = clientCtx.WithClient(local.New(tmNode))
 
 		app.RegisterTxService(clientCtx)
 		app.RegisterTendermintService(clientCtx)
 	}
 
-	var apiSrv *api.Server
+	// the metrics are shared by the API and diagnostics servers, as creating
+	// them replaces the global sink of the telemetry
+	var metrics *telemetry.Metrics
+	if config.Telemetry.Enabled && (config.API.Enable || config.Diagnostics.Enable) {
+		metrics, err = telemetry.New(config.Telemetry)
+		if err != nil {
+			return err
+		}
+	}
+
 	if config.API.Enable {
 		genDoc, err := genDocProvider()
 		if err != nil {
@@ -302,7 +468,11 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			WithChainID(genDoc.ChainID)
 
 		apiSrv = api.New(clientCtx, ctx.Logger.With("module", "api-server"))
+		apiSrv.SetTelemetry(metrics)
 		app.RegisterAPIRoutes(apiSrv, config.API)
+		if config.API.EnableLogLevelEndpoint && ctx.LogLevels != nil {
+			apiSrv.RegisterLogLevelRoute(ctx.LogLevels)
+		}
 		errCh := make(chan error)
 
 		go func() {
@@ -318,22 +488,12 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	var (
-		grpcSrv    *grpc.Server
-		grpcWebSrv *http.Server
-	)
 	if config.GRPC.Enable {
-		grpcSrv, err = servergrpc.StartGRPCServer(clientCtx, app, config.GRPC.Address)
+		grpcSrv, grpcWebSrv, err = servergrpc.StartGRPCServerAndWeb(clientCtx, app, config)
 		if err != nil {
+			ctx.Logger.Error("failed to start grpc servers: ", err)
 			return err
 		}
-		if config.GRPCWeb.Enable {
-			grpcWebSrv, err = servergrpc.StartGRPCWeb(grpcSrv, config)
-			if err != nil {
-				ctx.Logger.Error("failed to start grpc-web http server: ", err)
-				return err
-			}
-		}
 	}
 
 	var rosettaSrv crgserver.Server
@@ -351,6 +511,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			Addr:          config.Rosetta.Address,
 			Retries:       config.Rosetta.Retries,
 			Offline:       offlineMode,
+			MinGasPrices:  config.GetMinGasPrices(),
 		}
 		conf.WithCodec(clientCtx.InterfaceRegistry, clientCtx.Codec.(*codec.ProtoCodec))
 
@@ -372,29 +533,119 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 		}
 	}
 
-	defer func() {
-		if tmNode.IsRunning() {
-			_ = tmNode.Stop()
-		}
+	// the settings of the configuration which can be changed at runtime are
+	// reloaded on SIGHUP, or by the diagnostics server
+	reloader := &configReloader{
+		home:      home,
+		logger:    ctx.Logger,
+		logLevels: ctx.LogLevels,
+		app:       app,
+		apiSrv:    apiSrv,
+	}
+	defer reloadOnHangup(reloader)()
 
-		if cpuProfileCleanup != nil {
-			cpuProfileCleanup()
+	if config.Diagnostics.Enable {
+		diagnosticsSrv, err = diagnostics.New(config.Diagnostics, home, metrics, ctx.Logger.With("module", "diagnostics"))
+		if err != nil {
+			return err
 		}
+		diagnosticsSrv.SetReload(reloader.Reload)
 
-		if apiSrv != nil {
-			_ = apiSrv.Close()
+		errCh := make(chan error)
+		go func() {
+			if err := diagnosticsSrv.Start(config.Diagnostics.Address); err != nil {
+				errCh <- err
+			}
+		}()
+
+		select {
+		case err := <-errCh:
+			return err
+		case <-time.After(types.ServerStartTime): // assume server started successfully
 		}
+	}
+
+	// Wait for SIGINT or SIGTERM signal
+	quitErr := WaitForQuitSignals()
+	shutdownTimeout := time.Duration(config.ShutdownTimeout) * time.Second
+	ctx.Logger.Info("shutting down...", "timeout", shutdownTimeout)
 
-		if grpcSrv != nil {
-			grpcSrv.Stop()
-			if grpcWebSrv != nil {
+	// the servers stop accepting new requests, and serve the outstanding ones
+	// while the node is stopped on return
+	drained = drainServers(shutdownTimeout, ctx.Logger, apiSrv, grpcSrv, grpcWebSrv)
+
+	return quitErr
+}
+
+// closeServers closes the servers right away, interrupting the requests they
+// serve.
+func closeServers(apiSrv *api.Server, grpcSrv *grpc.Server, grpcWebSrv *http.Server) {
+	if apiSrv != nil {
+		_ = apiSrv.Close()
+	}
+
+	if grpcWebSrv != nil {
+		_ = grpcWebSrv.Close()
+	}
+
+	if grpcSrv != nil {
+		grpcSrv.Stop()
+	}
+}
+
+// drainServers stops the servers from accepting new requests, and returns a
+// channel closed once they served the outstanding ones, or once timeout
+// elapsed, interrupting the requests still served then.
+func drainServers(timeout time.Duration, logger log.Logger, apiSrv *api.Server, grpcSrv *grpc.Server, grpcWebSrv *http.Server) <-chan struct{} {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	var wg sync.WaitGroup
+
+	if apiSrv != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := apiSrv.Shutdown(ctx); err != nil {
+				logger.Error("failed to serve the outstanding API requests", "err", err)
+			}
+		}()
+	}
+
+	if grpcWebSrv != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := grpcWebSrv.Shutdown(ctx); err != nil {
+				logger.Error("failed to serve the outstanding gRPC-web requests", "err", err)
 				grpcWebSrv.Close()
 			}
-		}
+		}()
+	}
 
-		ctx.Logger.Info("exiting...")
+	if grpcSrv != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stopped := make(chan struct{})
+			go func() {
+				grpcSrv.GracefulStop()
+				close(stopped)
+			}()
+
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				logger.Error("failed to serve the outstanding gRPC requests", "err", ctx.Err())
+				grpcSrv.Stop()
+			}
+		}()
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		wg.Wait()
+		cancel()
+		close(drained)
 	}()
 
-	// Wait for SIGINT or SIGTERM signal
-	return WaitForQuitSignals()
+	return drained
 }
@@ -11,10 +11,12 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	googlegrpc "google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ServerStartTime defines the time duration that the server need to stay running after startup
@@ -53,6 +55,20 @@ type (
 		RegisterTendermintService(clientCtx client.Context)
 	}
 
+	// GRPCServerOptionsProvider is implemented by the applications providing
+	// options of their gRPC server, e.g. BaseApp with the interceptors added by
+	// the operators at the app construction.
+	GRPCServerOptionsProvider interface {
+		GRPCServerOptions() []googlegrpc.ServerOption
+	}
+
+	// MinGasPricesUpdater is implemented by the applications whose minimum gas
+	// prices can be replaced at runtime, e.g. BaseApp when the configuration of
+	// the node is reloaded.
+	MinGasPricesUpdater interface {
+		UpdateMinGasPrices(sdk.DecCoins)
+	}
+
 	// AppCreator is a function that allows us to lazily initialize an
 	// application using various configurations.
 	AppCreator func(log.Logger, dbm.DB, io.Writer, AppOptions) Application
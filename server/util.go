@@ -41,6 +41,10 @@ type Context struct {
 	Viper  *viper.Viper
 	Config *tmcfg.Config
 	Logger tmlog.Logger
+
+	// LogLevels are the log levels of the modules of Logger, which can be
+	// changed at runtime. It is nil if Logger is not filtered by them.
+	LogLevels *LogLevels
 }
 
 // ErrorCode contains the exit code for server exit.
@@ -56,12 +60,12 @@ func NewDefaultContext() *Context {
 	return NewContext(
 		viper.New(),
 		tmcfg.DefaultConfig(),
-		ZeroLogWrapper{log.Logger},
+		ZeroLogWrapper{Logger: log.Logger},
 	)
 }
 
 func NewContext(v *viper.Viper, config *tmcfg.Config, logger tmlog.Logger) *Context {
-	return &Context{v, config, logger}
+	return &Context{Viper: v, Config: config, Logger: logger}
 }
 
 func bindFlags(basename string, cmd *cobra.Command, v *viper.Viper) (err error) {
@@ -145,12 +149,13 @@ func InterceptConfigsPreRunHandler(cmd *cobra.Command, customAppConfigTemplate s
 	}
 
 	logLvlStr := serverCtx.Viper.GetString(flags.FlagLogLevel)
-	logLvl, err := zerolog.ParseLevel(logLvlStr)
+	logLevels, err := NewLogLevels(logLvlStr)
 	if err != nil {
 		return fmt.Errorf("failed to parse log level (%s): %w", logLvlStr, err)
 	}
 
-	serverCtx.Logger = ZeroLogWrapper{zerolog.New(logWriter).Level(logLvl).With().Timestamp().Logger()}
+	serverCtx.LogLevels = logLevels
+	serverCtx.Logger = NewZeroLogWrapper(zerolog.New(logWriter).With().Timestamp().Logger(), logLevels)
 
 	return SetCmdServerContext(cmd, serverCtx)
 }
@@ -279,6 +284,7 @@ func AddCommands(rootCmd *cobra.Command, defaultNodeHome string, appCreator type
 		UnsafeResetAllCmd(),
 		tendermintCmd,
 		ExportCmd(appExport, defaultNodeHome),
+		VerifyStoreCmd(defaultNodeHome),
 		version.NewVersionCommand(),
 	)
 }
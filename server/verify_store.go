@@ -0,0 +1,89 @@
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+// VerifyStoreCmd returns a command verifying the stores of the latest version
+// of the state of the node, without starting it.
+func VerifyStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-store",
+		Short: "Verify the integrity of the stores of the latest version of the state",
+		Long: `Verify the IAVL stores of the latest version of the application state against its
+committed commit info: the nodes of their trees must all be present and decodable, and their
+root hashes, stored and recomputed from the nodes, must match the ones of the commit info.
+
+The node must be stopped. The command fails if a store is corrupt, which the node would
+otherwise only detect once it serves or commits the corrupt state. The same verification
+is run before starting the node with 'start --verify-store'.
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			home, _ := cmd.Flags().GetString(flags.FlagHome)
+			serverCtx.Config.SetRoot(home)
+
+			db, err := openDB(serverCtx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return verifyStore(cmd.OutOrStdout(), db)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// verifyStore verifies the stores of the latest version of the state in db,
+// writing the result of each store to w, and returns an error if one of them
+// is corrupt.
+func verifyStore(w io.Writer, db dbm.DB) error {
+	report, err := rootmulti.VerifyLatestVersion(db)
+	if err != nil {
+		return fmt.Errorf("failed to verify the stores: %w", err)
+	}
+
+	fmt.Fprintf(w, "version %d, app hash %X\n", report.Version, report.AppHash)
+	for _, store := range report.Stores {
+		status := "ok"
+		if store.Err != nil {
+			status = fmt.Sprintf("CORRUPT: %s", store.Err)
+		}
+		fmt.Fprintf(w, "%s: %d nodes, %s\n", store.Name, store.Nodes, status)
+	}
+
+	return report.Err()
+}
+
+// verifyStoreOnStart verifies the stores of the latest version of the state in
+// db before the node starts, if enabled by the verify-store flag.
+func verifyStoreOnStart(ctx *Context, db dbm.DB) error {
+	if !ctx.Viper.GetBool(FlagVerifyStore) {
+		return nil
+	}
+
+	ctx.Logger.Info("verifying the stores of the latest version of the state...")
+	report, err := rootmulti.VerifyLatestVersion(db)
+	if err != nil {
+		return fmt.Errorf("failed to verify the stores: %w", err)
+	}
+	if err := report.Err(); err != nil {
+		return err
+	}
+
+	ctx.Logger.Info("verified the stores", "version", report.Version, "app_hash", fmt.Sprintf("%X", report.AppHash))
+
+	return nil
+}
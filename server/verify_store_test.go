@@ -0,0 +1,58 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestVerifyStoreCmd(t *testing.T) {
+	home := t.TempDir()
+
+	db, err := openDB(home)
+	require.NoError(t, err)
+	key := storetypes.NewKVStoreKey("bank")
+	cms := rootmulti.NewStore(db)
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.GetKVStore(key).Set([]byte("foo"), []byte("bar"))
+	commitID := cms.Commit()
+	require.NoError(t, db.Close())
+
+	cmd := VerifyStoreCmd(home)
+	cmd.SetArgs([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, home)})
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	ctx := context.WithValue(context.Background(), ServerContextKey, NewDefaultContext())
+	require.NoError(t, cmd.ExecuteContext(ctx))
+	require.Equal(t, fmt.Sprintf("version 1, app hash %X\nbank: 1 nodes, ok\n", commitID.Hash), out.String())
+
+	// the nodes of the store are missing
+	db, err = openDB(home)
+	require.NoError(t, err)
+	it, err := dbm.IteratePrefix(db, []byte("s/k:bank/n"))
+	require.NoError(t, err)
+	var nodeKeys [][]byte
+	for ; it.Valid(); it.Next() {
+		nodeKeys = append(nodeKeys, append([]byte{}, it.Key()...))
+	}
+	require.NoError(t, it.Close())
+	for _, k := range nodeKeys {
+		require.NoError(t, db.Delete(k))
+	}
+	require.NoError(t, db.Close())
+
+	out.Reset()
+	err = cmd.ExecuteContext(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "corrupt stores at version 1")
+	require.Contains(t, out.String(), "bank: 0 nodes, CORRUPT")
+}
@@ -1,12 +1,16 @@
 package simapp
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
@@ -17,12 +21,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/api/openapi"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/grpc/streaming"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -47,6 +55,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	circuitclient "github.com/cosmos/cosmos-sdk/x/circuit/client"
+	circuitkeeper "github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	circuittypes "github.com/cosmos/cosmos-sdk/x/circuit/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -54,20 +66,32 @@ import (
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	epochskeeper "github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	groupkeeper "github.com/cosmos/cosmos-sdk/x/group/keeper"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
@@ -104,9 +128,13 @@ var (
 		capability.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
+		epochs.AppModuleBasic{},
+		feemarket.AppModuleBasic{},
+		circuit.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			circuitclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -115,7 +143,9 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
+		groupmodule.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		nftmodule.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -142,6 +172,7 @@ type SimApp struct {
 	legacyAmino       *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -157,14 +188,27 @@ type SimApp struct {
 	StakingKeeper    stakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
 	MintKeeper       mintkeeper.Keeper
+	EpochsKeeper     epochskeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
 	GovKeeper        govkeeper.Keeper
 	CrisisKeeper     crisiskeeper.Keeper
 	UpgradeKeeper    upgradekeeper.Keeper
 	ParamsKeeper     paramskeeper.Keeper
 	AuthzKeeper      authzkeeper.Keeper
+	GroupKeeper      groupkeeper.Keeper
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
+	NFTKeeper        nftkeeper.Keeper
+	FeeMarketKeeper  feemarketkeeper.Keeper
+	CircuitKeeper    circuitkeeper.Keeper
+
+	// EventService streams the events of the committed blocks over gRPC
+	EventService *streaming.EventService
+
+	// stopBackgroundCheck stops the background invariant checker, if started,
+	// and backgroundCheckDone is closed once it exited
+	stopBackgroundCheck context.CancelFunc
+	backgroundCheckDone <-chan struct{}
 
 	// the module manager
 	mm *module.Manager
@@ -201,27 +245,45 @@ func NewSimApp(
 	bApp.SetVersion(version.Version)
 	bApp.SetInterfaceRegistry(interfaceRegistry)
 
+	eventService := streaming.NewEventService(streaming.DefaultMaxSubscribers, streaming.DefaultBufferSize)
+	bApp.SetABCIListeners(eventService)
+
 	keys := sdk.NewKVStoreKeys(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, group.StoreKey, epochstypes.StoreKey, nft.StoreKey,
+		feemarkettypes.StoreKey, circuittypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	// NOTE: The testingkey is just mounted for testing purposes. Actual applications should
 	// not include this key.
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, "testingkey")
 
+	// the transactions executed by the app are always decoded strictly, for all
+	// the validators to agree on them whatever their config, the tx decode mode
+	// only applying to the transactions decoded by the tx service queries
+	txDecodeMode, err := authtx.ParseDecodeMode(cast.ToString(appOpts.Get(server.FlagTxDecodeMode)))
+	if err != nil {
+		panic(err)
+	}
+	txConfig := encodingConfig.TxConfig
+	if txDecodeMode != authtx.DecodeModeStrict {
+		txConfig = authtx.NewTxConfigWithDecodeMode(codec.NewProtoCodec(interfaceRegistry), txConfig.SignModeHandler().Modes(), txDecodeMode)
+	}
+
 	app := &SimApp{
 		BaseApp:           bApp,
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          txConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
 		memKeys:           memKeys,
+		EventService:      eventService,
 	}
 
 	app.ParamsKeeper = initParamsKeeper(appCodec, legacyAmino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey])
@@ -234,54 +296,109 @@ func NewSimApp(
 	// their scoped modules in `NewApp` with `ScopeToModule`
 	app.CapabilityKeeper.Seal()
 
+	// the governance module account is the authority of the modules, updating
+	// their params and managing their circuit breakers through proposals
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 	// add keepers
 	app.AccountKeeper = authkeeper.NewAccountKeeper(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
+		govAuthority,
 	)
 	app.BankKeeper = bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
+		govAuthority,
 	)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
+		govAuthority,
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey], app.GetSubspace(minttypes.ModuleName), &stakingKeeper,
-		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, authtypes.FeeCollectorName, govAuthority,
+	)
+	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
+	app.MintKeeper.SetEpochKeeper(epochsKeeper)
+	// register the epoch hooks, with which the coins are minted at the end of
+	// the epochs when the mint params select an epoch identifier
+	app.EpochsKeeper = *epochsKeeper.SetHooks(
+		epochstypes.NewMultiEpochHooks(mint.NewEpochHooks(app.MintKeeper, nil)),
 	)
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(),
+		&stakingKeeper, authtypes.FeeCollectorName, app.ModuleAccountAddrs(), govAuthority,
 	)
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
-		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName),
+		appCodec, keys[slashingtypes.StoreKey], &stakingKeeper, app.GetSubspace(slashingtypes.ModuleName), govAuthority,
 	)
 	app.CrisisKeeper = crisiskeeper.NewKeeper(
 		app.GetSubspace(crisistypes.ModuleName), invCheckPeriod, app.BankKeeper, authtypes.FeeCollectorName,
 	)
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
+		appCodec, keys[feemarkettypes.StoreKey], app.GetSubspace(feemarkettypes.ModuleName), govAuthority,
+	)
+	app.NFTKeeper = nftkeeper.NewKeeper(appCodec, keys[nft.StoreKey])
+	app.CircuitKeeper = circuitkeeper.NewKeeper(appCodec, keys[circuittypes.StoreKey], govAuthority)
+	app.BaseApp.MsgServiceRouter().SetCircuitBreaker(app.CircuitKeeper)
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, keys[upgradetypes.StoreKey], appCodec, homePath, app.BaseApp)
+	app.UpgradeKeeper.SetAllowDownloadBinaries(cast.ToBool(appOpts.Get(upgrade.FlagAllowDownloadBinaries)))
+	if blocks := appOpts.Get(upgrade.FlagPreUpgradeCheckBlocks); blocks != nil {
+		app.UpgradeKeeper.SetPreUpgradeCheckBlocks(cast.ToInt64(blocks))
+	}
+	app.SlashingKeeper.SetUpgradeKeeper(app.UpgradeKeeper)
 
-	// register the staking hooks
+	// register the staking hooks, the distribution ones running first so that
+	// rewards are settled before other modules act on validator and delegation
+	// changes
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
-	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
-	)
+	stakingHooks, err := stakingtypes.NewStakingHooksRegistry().
+		Register(distrtypes.ModuleName, app.DistrKeeper.Hooks()).
+		Register(slashingtypes.ModuleName, app.SlashingKeeper.Hooks(), stakingtypes.RunHooksAfter(distrtypes.ModuleName)).
+		Resolve()
+	if err != nil {
+		panic(err)
+	}
+	for _, event := range stakingtypes.StakingHookEvents() {
+		logger.Info("staking hooks execution order", "event", event, "modules", strings.Join(stakingHooks.EventOrder(event), ","))
+	}
+	app.StakingKeeper = *stakingKeeper.SetHooks(stakingHooks.Hooks())
 
 	app.AuthzKeeper = authzkeeper.NewKeeper(keys[authzkeeper.StoreKey], appCodec, app.BaseApp.MsgServiceRouter())
+	app.AuthzKeeper.SetAcceptContextDecorators(app.DistrKeeper.AuthzAcceptContext)
+
+	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.BaseApp.MsgServiceRouter(), app.AccountKeeper, group.DefaultConfig())
+	app.GroupKeeper.SetWeightSource(groupkeeper.BondedStakeWeightSourceName, groupkeeper.NewBondedStakeWeightSource(app.StakingKeeper))
+
+	// register the Msgs to be executed through governance, signed by the
+	// governance module account
+	govMsgRoutes := govtypes.NewMsgRouteRegistry().
+		AddMsgRoute(authtypes.ModuleName, &authtypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(banktypes.ModuleName, &banktypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(stakingtypes.ModuleName, &stakingtypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(minttypes.ModuleName, &minttypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(distrtypes.ModuleName, &distrtypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(slashingtypes.ModuleName, &slashingtypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(feemarkettypes.ModuleName, &feemarkettypes.MsgUpdateParams{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(circuittypes.ModuleName, &circuittypes.MsgAuthorizeCircuitBreaker{Granter: govAuthority}, govAuthority).
+		AddMsgRoute(circuittypes.ModuleName, &circuittypes.MsgTripCircuitBreaker{Authority: govAuthority}, govAuthority).
+		AddMsgRoute(circuittypes.ModuleName, &circuittypes.MsgResetCircuitBreaker{Authority: govAuthority}, govAuthority)
 
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
+		AddRoute(govtypes.MsgExecutionRouterKey, gov.NewMsgExecutionProposalHandler(govMsgRoutes, app.BaseApp.MsgServiceRouter())).
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(circuittypes.RouterKey, circuit.NewCircuitBreakerPermissionsProposalHandler(app.CircuitKeeper))
 	govKeeper := govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
 		&stakingKeeper, govRouter,
 	)
 
-	app.GovKeeper = *govKeeper.SetHooks(
+	app.GovKeeper = *govKeeper.SetMsgRouteRegistry(govMsgRoutes).SetHooks(
 		govtypes.NewMultiGovHooks(
 		// register the governance hooks
 		),
@@ -314,7 +431,8 @@ func NewSimApp(
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
+		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -322,6 +440,10 @@ func NewSimApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper),
+		circuit.NewAppModule(appCodec, app.CircuitKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -330,28 +452,59 @@ func NewSimApp(
 	// NOTE: staking module is required if HistoricalEntries param > 0
 	// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
 	app.mm.SetOrderBeginBlockers(
-		upgradetypes.ModuleName, capabilitytypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName, slashingtypes.ModuleName,
-		evidencetypes.ModuleName, stakingtypes.ModuleName,
+		upgradetypes.ModuleName, capabilitytypes.ModuleName, epochstypes.ModuleName, minttypes.ModuleName, distrtypes.ModuleName,
+		slashingtypes.ModuleName, evidencetypes.ModuleName, stakingtypes.ModuleName, feemarkettypes.ModuleName,
+		circuittypes.ModuleName,
+	)
+	app.mm.SetOrderEndBlockers(
+		crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, group.ModuleName, feemarkettypes.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
 	// NOTE: Capability module must occur first so that it can initialize any capabilities
 	// so that other modules that want to create or claim capabilities afterwards in InitChain
 	// can do so safely.
+	// NOTE: The params module must occur last so that the restored history of
+	// the parameter changes replaces the changes recorded by the other modules
+	// setting their params.
 	app.mm.SetOrderInitGenesis(
 		capabilitytypes.ModuleName, authtypes.ModuleName, banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName, crisistypes.ModuleName,
 		genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName,
-		feegrant.ModuleName,
+		feegrant.ModuleName, group.ModuleName, epochstypes.ModuleName, nft.ModuleName,
+		feemarkettypes.ModuleName, circuittypes.ModuleName, paramstypes.ModuleName,
 	)
 
+	// record the migrations run by upgrades for the x/upgrade migration queries
+	app.mm.SetMigrationRecorder(app.UpgradeKeeper)
+	// check the preconditions of upgrades ahead of their heights
+	app.UpgradeKeeper.RegisterPreUpgradeCheckers(app.mm.Modules)
+
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	// fail at boot if a Msg registered for governance cannot be executed by it
+	if err := app.GovKeeper.ValidateMsgRoutes(app.MsgServiceRouter()); err != nil {
+		panic(err)
+	}
+
+	node.RegisterNodeService(app.GRPCQueryRouter(), node.Config{
+		Modules:   app.mm.GetVersionMap(),
+		SignModes: encodingConfig.TxConfig.SignModeHandler().Modes(),
+		Features: map[string]bool{
+			"event_streaming":     true,
+			"query_cache":         cast.ToBool(appOpts.Get(server.FlagQueryCacheEnable)),
+			"replay_verification": cast.ToUint64(appOpts.Get(server.FlagReplayVerificationWindow)) > 0,
+			"gas_usage":           cast.ToUint64(appOpts.Get(server.FlagGasUsageWindow)) > 0,
+			"state_size":          cast.ToUint64(appOpts.Get(server.FlagStateSizeInterval)) > 0,
+		},
+		StakingDenom:  app.StakingKeeper.BondDenom,
+		DenomMetadata: app.BankKeeper.GetDenomMetaData,
+	})
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
@@ -365,13 +518,18 @@ func NewSimApp(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
-		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil),
+		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		feemarket.NewAppModule(appCodec, app.FeeMarketKeeper),
+		circuit.NewAppModule(appCodec, app.CircuitKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -391,6 +549,8 @@ func NewSimApp(
 			BankKeeper:      app.BankKeeper,
 			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 			FeegrantKeeper:  app.FeeGrantKeeper,
+			FeeMarketKeeper: app.FeeMarketKeeper,
+			CircuitBreaker:  app.CircuitKeeper,
 			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		},
 	)
@@ -403,9 +563,31 @@ func NewSimApp(
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
+		if cast.ToBool(appOpts.Get(upgrade.FlagBackupBeforeUpgrade)) {
+			backup, err := app.UpgradeKeeper.BackupBeforeUpgrade(db)
+			if err != nil {
+				tmos.Exit(fmt.Sprintf("failed to back the application database up before the upgrade: %s", err))
+			}
+			if backup != "" {
+				logger.Info("backed the application database up before the upgrade", "dir", backup)
+			}
+		}
+
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		// the invariants are checked in the background against the committed
+		// state, while halting on a broken invariant in the EndBlocker is
+		// opted in with the invariant check period
+		if interval := cast.ToDuration(appOpts.Get(crisis.FlagBackgroundCheckInterval)); interval > 0 {
+			ctx, cancel := context.WithCancel(context.Background())
+			app.stopBackgroundCheck = cancel
+			app.backgroundCheckDone = crisiskeeper.NewBackgroundChecker(
+				app.CrisisKeeper, logger, interval, app.LastBlockHeight,
+				func(height int64) (sdk.Context, error) { return app.CreateQueryContext(height, false) },
+			).Start(ctx)
+		}
 	}
 
 	return app
@@ -434,6 +616,18 @@ func (app *SimApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.
 	return app.mm.InitGenesis(ctx, app.appCodec, genesisState)
 }
 
+// Close stops the background invariant checker and waits for it to exit, so
+// that no check runs on the committed state once the app is closed, then
+// closes the BaseApp.
+func (app *SimApp) Close() error {
+	if app.stopBackgroundCheck != nil {
+		app.stopBackgroundCheck()
+		<-app.backgroundCheckDone
+	}
+
+	return app.BaseApp.Close()
+}
+
 // LoadHeight loads a particular height
 func (app *SimApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height)
@@ -477,6 +671,13 @@ func (app *SimApp) GetKey(storeKey string) *sdk.KVStoreKey {
 	return app.keys[storeKey]
 }
 
+// GetKeys returns the KVStoreKeys of all the stores of the app, by name.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) GetKeys() map[string]*sdk.KVStoreKey {
+	return app.keys
+}
+
 // GetTKey returns the TransientStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -515,6 +716,8 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register the node information query routes from grpc-gateway.
+	node.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterRESTRoutes(clientCtx, apiSvr.Router)
@@ -522,13 +725,41 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 	// register swagger API from root so that other applications can override easily
 	if apiConfig.Swagger {
+		app.RegisterOpenAPI(apiSvr.Router)
 		RegisterSwaggerAPI(clientCtx, apiSvr.Router)
 	}
 }
 
+// RegisterOpenAPI registers the OpenAPI document generated from the query
+// services registered with the app at /swagger/openapi.json. It is also served
+// to the swagger UI in place of its vendored swagger.yaml, and must therefore
+// be registered before it.
+func (app *SimApp) RegisterOpenAPI(rtr *mux.Router) {
+	doc, err := openapi.Generate(openapi.Info{Title: appName, Version: version.Version}, app.GRPCQueryRouter().ServiceDescs())
+	if err != nil {
+		panic(err)
+	}
+
+	handler, err := openapi.Handler(doc)
+	if err != nil {
+		panic(err)
+	}
+
+	rtr.Handle("/swagger/openapi.json", handler)
+	rtr.Handle("/swagger/swagger.yaml", handler)
+}
+
+// RegisterGRPCServer implements the Application.RegisterGRPCServer method.
+func (app *SimApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	streaming.RegisterEventsServer(server, app.EventService)
+}
+
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	// the tx service decodes the queried transactions with the tx decode mode
+	// of the app, which may differ from the client's
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx.WithTxConfig(app.txConfig), app.BaseApp.Simulate, app.interfaceRegistry)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -568,6 +799,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(feemarkettypes.ModuleName)
 
 	return paramsKeeper
 }
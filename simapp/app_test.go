@@ -2,10 +2,12 @@ package simapp
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -13,26 +15,37 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	groupmodule "github.com/cosmos/cosmos-sdk/x/group/module"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestSimAppExportAndBlockedAddrs(t *testing.T) {
@@ -84,6 +97,8 @@ func TestRunMigrations(t *testing.T) {
 	bApp.SetInterfaceRegistry(encCfg.InterfaceRegistry)
 	app.BaseApp = bApp
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
+	// The new baseapp does not mount the x/upgrade store the migrations are recorded in.
+	app.mm.SetMigrationRecorder(nil)
 
 	// We register all modules on the Configurator, except x/bank. x/bank will
 	// serve as the test subject on which we run the migration tests.
@@ -171,9 +186,14 @@ func TestRunMigrations(t *testing.T) {
 					"authz":        authzmodule.AppModule{}.ConsensusVersion(),
 					"staking":      staking.AppModule{}.ConsensusVersion(),
 					"mint":         mint.AppModule{}.ConsensusVersion(),
+					"epochs":       epochs.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
+					"circuit":      circuit.AppModule{}.ConsensusVersion(),
 					"distribution": distribution.AppModule{}.ConsensusVersion(),
 					"slashing":     slashing.AppModule{}.ConsensusVersion(),
 					"gov":          gov.AppModule{}.ConsensusVersion(),
+					"group":        groupmodule.AppModule{}.ConsensusVersion(),
+					"nft":          nftmodule.AppModule{}.ConsensusVersion(),
 					"params":       params.AppModule{}.ConsensusVersion(),
 					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 					"vesting":      vesting.AppModule{}.ConsensusVersion(),
@@ -223,9 +243,14 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"authz":        authzmodule.AppModule{}.ConsensusVersion(),
 			"staking":      staking.AppModule{}.ConsensusVersion(),
 			"mint":         mint.AppModule{}.ConsensusVersion(),
+			"epochs":       epochs.AppModule{}.ConsensusVersion(),
+			"feemarket":    feemarket.AppModule{}.ConsensusVersion(),
+			"circuit":      circuit.AppModule{}.ConsensusVersion(),
 			"distribution": distribution.AppModule{}.ConsensusVersion(),
 			"slashing":     slashing.AppModule{}.ConsensusVersion(),
 			"gov":          gov.AppModule{}.ConsensusVersion(),
+			"group":        groupmodule.AppModule{}.ConsensusVersion(),
+			"nft":          nftmodule.AppModule{}.ConsensusVersion(),
 			"params":       params.AppModule{}.ConsensusVersion(),
 			"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 			"vesting":      vesting.AppModule{}.ConsensusVersion(),
@@ -237,6 +262,30 @@ func TestInitGenesisOnMigration(t *testing.T) {
 		},
 	)
 	require.NoError(t, err)
+
+	// only the new module is recorded as migrated
+	require.Equal(t, []upgradetypes.ModuleMigration{{Name: "mock", FromVersion: 0, ToVersion: 0}}, app.UpgradeKeeper.GetLastMigrations(ctx))
+}
+
+func TestRunMigrationsConstraints(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, encCfg, EmptyAppOptions{})
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	fromVM := app.mm.GetVersionMap()
+	bankVersion := bank.AppModule{}.ConsensusVersion()
+
+	_, err := app.mm.RunMigrations(ctx, app.configurator, fromVM,
+		module.MigrateAfter(banktypes.ModuleName, "auth"),
+		module.ExpectFromVersion(banktypes.ModuleName, bankVersion),
+	)
+	require.NoError(t, err)
+
+	// the from versions are verified before any module migrates
+	_, err = app.mm.RunMigrations(ctx, app.configurator, fromVM, module.ExpectFromVersion(banktypes.ModuleName, bankVersion-1))
+	require.EqualError(t, err, fmt.Sprintf("module bank migrates from version %d, expected %d: invalid version", bankVersion, bankVersion-1))
+
+	_, err = app.mm.RunMigrations(ctx, app.configurator, fromVM, module.MigrateAfter(banktypes.ModuleName, "foo"))
+	require.Error(t, err)
 }
 
 func TestUpgradeStateOnGenesis(t *testing.T) {
@@ -262,3 +311,23 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 		require.Equal(t, vm[v], i.ConsensusVersion())
 	}
 }
+
+func TestTxDecodeModeOnlyAppliesToTxService(t *testing.T) {
+	appOpts := viper.New()
+	appOpts.Set(server.FlagTxDecodeMode, string(authtx.DecodeModeTolerant))
+	app := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, 0, MakeTestEncodingConfig(), appOpts)
+
+	// a tx with an unknown non-critical field in its auth info
+	bodyBz, err := (&testdata.TestUpdatedTxBody{Memo: "foo"}).Marshal()
+	require.NoError(t, err)
+	authInfoBz, err := (&testdata.TestUpdatedAuthInfo{NewField_1024: []byte("xyz")}).Marshal()
+	require.NoError(t, err)
+	txBz, err := (&txtypes.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}).Marshal()
+	require.NoError(t, err)
+
+	// the tx service decodes it, but the app executing it does not
+	_, err = app.txConfig.TxDecoder()(txBz)
+	require.NoError(t, err)
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBz})
+	require.Equal(t, sdkerrors.ErrTxDecode.ABCICode(), res.Code)
+}
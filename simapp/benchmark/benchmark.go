@@ -0,0 +1,261 @@
+// Package benchmark measures the DeliverTx throughput of SimApp under
+// configurable mixes of transactions, so that the performance of the hot paths
+// of the state machine can be compared across changes:
+//
+//	func BenchmarkBankSends(b *testing.B) {
+//		benchmark.Run(b, benchmark.Config{
+//			DBBackend:   dbm.GoLevelDBBackend,
+//			Mix:         benchmark.TxMix{BankSend: 1},
+//			NumAccounts: 100,
+//			TxsPerBlock: 100,
+//		})
+//	}
+//
+// Besides the time and allocations per transaction, the benchmarks report the
+// transactions delivered per second as the txs/s metric.
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const chainID = "benchmark-chain"
+
+var (
+	genesisTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	// the balance of each account, conceivably higher than any benchmark spends
+	initialBalance = sdk.NewInt(1_000_000_000_000)
+	bondAmt        = sdk.NewInt(1_000_000)
+	amount         = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+)
+
+// TxMix weights the kinds of transactions delivered by a benchmark: each kind
+// makes up its weight out of the sum of the weights of the transactions.
+type TxMix struct {
+	// BankSend weights the bank sends of an account to another one.
+	BankSend int
+	// Delegate weights the delegations of an account to the validator.
+	Delegate int
+	// AuthzExec weights the execution by an account of a bank send granted by
+	// another one.
+	AuthzExec int
+}
+
+// Config is the configuration of a DeliverTx benchmark.
+type Config struct {
+	// DBBackend is the database backend of the app, e.g. dbm.MemDBBackend or
+	// dbm.GoLevelDBBackend on disk, in a temporary directory.
+	DBBackend   dbm.BackendType
+	Mix         TxMix
+	NumAccounts int // number of accounts sending the transactions, in turn
+	TxsPerBlock int // number of transactions delivered in each block
+}
+
+type txKind int
+
+const (
+	bankSend txKind = iota
+	delegate
+	authzExec
+)
+
+// kinds returns the kinds of successive transactions of the mix, repeated by
+// the benchmark.
+func (mix TxMix) kinds() []txKind {
+	var kinds []txKind
+	for kind, weight := range []int{mix.BankSend, mix.Delegate, mix.AuthzExec} {
+		for i := 0; i < weight; i++ {
+			kinds = append(kinds, txKind(kind))
+		}
+	}
+
+	return kinds
+}
+
+// Run delivers b.N transactions of the mix of cfg to a new SimApp with a single
+// validator, committing a block every cfg.TxsPerBlock transactions. Setting up
+// the app and signing the transactions are not measured.
+func Run(b *testing.B, cfg Config) {
+	kinds := cfg.Mix.kinds()
+	if len(kinds) == 0 || cfg.NumAccounts < 2 || cfg.TxsPerBlock < 1 {
+		b.Fatal("a benchmark needs transactions, at least two accounts and one transaction per block")
+	}
+
+	db, err := dbm.NewDB("application", cfg.DBBackend, b.TempDir())
+	require.NoError(b, err)
+	defer db.Close()
+
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 0, encCfg, simapp.EmptyAppOptions{})
+
+	privs := make([]cryptotypes.PrivKey, cfg.NumAccounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("benchmark account %d", i)))
+	}
+	valAddr := initChain(b, app, privs)
+
+	// precompute all the transactions, the sequence of each account increasing
+	// with its transactions
+	txEncoder := encCfg.TxConfig.TxEncoder()
+	seqs := make([]uint64, cfg.NumAccounts)
+	txs := make([][]byte, b.N)
+	for i := range txs {
+		sender := i % cfg.NumAccounts
+		msg := newMsg(kinds[i%len(kinds)], privs, sender, valAddr)
+
+		tx, err := helpers.GenTx(
+			encCfg.TxConfig, []sdk.Msg{msg}, sdk.Coins{}, helpers.DefaultGenTxGas, chainID,
+			[]uint64{uint64(sender)}, []uint64{seqs[sender]}, privs[sender],
+		)
+		require.NoError(b, err)
+		txs[i], err = txEncoder(tx)
+		require.NoError(b, err)
+
+		seqs[sender]++
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	start := time.Now()
+
+	for i := 0; i < len(txs); i += cfg.TxsPerBlock {
+		height := app.LastBlockHeight() + 1
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+			ChainID: chainID,
+			Height:  height,
+			Time:    genesisTime.Add(time.Duration(height) * time.Second),
+		}})
+
+		for j := i; j < i+cfg.TxsPerBlock && j < len(txs); j++ {
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: txs[j]})
+			if !res.IsOK() {
+				b.Fatalf("failed to deliver tx %d: %s", j, res.Log)
+			}
+		}
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	b.StopTimer()
+	b.ReportMetric(float64(len(txs))/time.Since(start).Seconds(), "txs/s")
+}
+
+// initChain initializes the chain of app with genesis accounts with the keys
+// privs, each account granting bank sends to the previous one, and a validator
+// to which the first account delegates, returning the address of the
+// validator.
+func initChain(b *testing.B, app *simapp.SimApp, privs []cryptotypes.PrivKey) sdk.ValAddress {
+	cdc := app.AppCodec()
+	genesisState := simapp.NewDefaultGenesisState(cdc)
+
+	genAccs := make(authtypes.GenesisAccounts, len(privs))
+	balances := make([]banktypes.Balance, len(privs))
+	grants := make([]authz.GrantAuthorization, len(privs))
+	sendAuthorization, err := codectypes.NewAnyWithValue(authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})))
+	require.NoError(b, err)
+
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		genAccs[i] = authtypes.NewBaseAccount(addr, nil, uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initialBalance)),
+		}
+		grants[i] = authz.GrantAuthorization{
+			Granter:       addr.String(),
+			Grantee:       sdk.AccAddress(privs[(i+len(privs)-1)%len(privs)].PubKey().Address()).String(),
+			Authorization: sendAuthorization,
+			Expiration:    genesisTime.AddDate(1, 0, 0),
+		}
+	}
+
+	packedAccs, err := authtypes.PackAccounts(genAccs)
+	require.NoError(b, err)
+	authGenesis := authtypes.DefaultGenesisState()
+	authGenesis.Accounts = packedAccs
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	consPubKey := ed25519.GenPrivKeyFromSecret([]byte("benchmark validator")).PubKey()
+	valAddr := sdk.ValAddress(consPubKey.Address())
+	validator, err := stakingtypes.NewValidator(valAddr, consPubKey, stakingtypes.Description{})
+	require.NoError(b, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = bondAmt
+	validator.DelegatorShares = bondAmt.ToDec()
+	delegation := stakingtypes.NewDelegation(genAccs[0].GetAddress(), valAddr, bondAmt.ToDec())
+	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{delegation})
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)),
+	})
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initialBalance.MulRaw(int64(len(privs))).Add(bondAmt)))
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	genesisState[authz.ModuleName] = cdc.MustMarshalJSON(authz.NewGenesisState(grants))
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(b, err)
+
+	// unlike the default consensus params of SimApp, the gas of the blocks is
+	// not limited, whatever the number of transactions per block
+	consensusParams := *simapp.DefaultConsensusParams
+	consensusParams.Block = &abci.BlockParams{MaxBytes: simapp.DefaultConsensusParams.Block.MaxBytes, MaxGas: -1}
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         chainID,
+		Time:            genesisTime,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	return valAddr
+}
+
+// newMsg returns the message of a transaction of the given kind sent by the
+// sender-th account.
+func newMsg(kind txKind, privs []cryptotypes.PrivKey, sender int, valAddr sdk.ValAddress) sdk.Msg {
+	senderAddr := sdk.AccAddress(privs[sender].PubKey().Address())
+	nextAddr := sdk.AccAddress(privs[(sender+1)%len(privs)].PubKey().Address())
+
+	switch kind {
+	case bankSend:
+		return banktypes.NewMsgSend(senderAddr, nextAddr, amount)
+
+	case delegate:
+		return stakingtypes.NewMsgDelegate(senderAddr, valAddr, amount[0])
+
+	case authzExec:
+		// the next account granted bank sends to the sender
+		msgExec := authz.NewMsgExec(senderAddr, []sdk.Msg{banktypes.NewMsgSend(nextAddr, senderAddr, amount)})
+		return &msgExec
+
+	default:
+		panic(fmt.Sprintf("unknown tx kind %d", kind))
+	}
+}
@@ -0,0 +1,41 @@
+package benchmark_test
+
+import (
+	"testing"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/simapp/benchmark"
+)
+
+var mixes = []struct {
+	name string
+	mix  benchmark.TxMix
+}{
+	{"BankSend", benchmark.TxMix{BankSend: 1}},
+	{"Delegate", benchmark.TxMix{Delegate: 1}},
+	{"AuthzExec", benchmark.TxMix{AuthzExec: 1}},
+	{"Mixed", benchmark.TxMix{BankSend: 6, Delegate: 3, AuthzExec: 1}},
+}
+
+func benchmarkDeliverTx(b *testing.B, backend dbm.BackendType) {
+	for _, tc := range mixes {
+		tc := tc
+		b.Run(tc.name, func(b *testing.B) {
+			benchmark.Run(b, benchmark.Config{
+				DBBackend:   backend,
+				Mix:         tc.mix,
+				NumAccounts: 100,
+				TxsPerBlock: 100,
+			})
+		})
+	}
+}
+
+func BenchmarkDeliverTxMemDB(b *testing.B) {
+	benchmarkDeliverTx(b, dbm.MemDBBackend)
+}
+
+func BenchmarkDeliverTxGoLevelDB(b *testing.B) {
+	benchmarkDeliverTx(b, dbm.GoLevelDBBackend)
+}
@@ -0,0 +1,68 @@
+package golden
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// DefaultCorpus exercises the messages of the bank, staking, distribution,
+// authz, feegrant and gov modules over a few blocks, the last one being empty.
+var DefaultCorpus = Corpus{
+	NumAccounts: 5,
+	Blocks:      defaultBlocks,
+}
+
+func defaultBlocks(accs []sdk.AccAddress, valAddr sdk.ValAddress) ([][]Tx, error) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	grant, err := authz.NewMsgGrant(accs[2], accs[3], authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), genesisTime.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	allowance, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{SpendLimit: coins(1000)}, accs[3], accs[4])
+	if err != nil {
+		return nil, err
+	}
+
+	exec := authz.NewMsgExec(accs[3], []sdk.Msg{banktypes.NewMsgSend(accs[2], accs[4], coins(50))})
+
+	proposal, err := govtypes.NewMsgSubmitProposal(govtypes.NewTextProposal("Golden", "A golden proposal"), coins(1_000_000), accs[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return [][]Tx{
+		{
+			{Signer: 0, Msgs: []sdk.Msg{banktypes.NewMsgSend(accs[0], accs[1], coins(100))}},
+			{Signer: 1, Msgs: []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(accs[1], coins(30))},
+				[]banktypes.Output{banktypes.NewOutput(accs[2], coins(10)), banktypes.NewOutput(accs[3], coins(20))},
+			)}},
+			{Signer: 1, Msgs: []sdk.Msg{stakingtypes.NewMsgDelegate(accs[1], valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))}},
+		},
+		{
+			{Signer: 0, Msgs: []sdk.Msg{distrtypes.NewMsgWithdrawDelegatorReward(accs[0], valAddr)}},
+			{Signer: 1, Msgs: []sdk.Msg{stakingtypes.NewMsgUndelegate(accs[1], valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400))}},
+			{Signer: 2, Msgs: []sdk.Msg{grant}},
+			{Signer: 3, Msgs: []sdk.Msg{allowance}},
+		},
+		{
+			{Signer: 3, Msgs: []sdk.Msg{&exec}},
+			{Signer: 4, Msgs: []sdk.Msg{proposal}},
+			{Signer: 0, Msgs: []sdk.Msg{govtypes.NewMsgDeposit(accs[0], 1, coins(9_000_000))}},
+		},
+		{
+			{Signer: 0, Msgs: []sdk.Msg{govtypes.NewMsgVote(accs[0], 1, govtypes.OptionYes)}},
+			{Signer: 1, Msgs: []sdk.Msg{govtypes.NewMsgVote(accs[1], 1, govtypes.OptionNo)}},
+		},
+		{},
+	}, nil
+}
@@ -0,0 +1,233 @@
+// Package golden replays a fixed corpus of blocks of transactions on SimApp and
+// asserts the app hash of each block and the genesis exported after the last
+// one against golden files, catching accidental non-determinism in the modules,
+// such as iterating over maps or reading the local time, as well as unintended
+// changes of the state machine:
+//
+//	func TestGolden(t *testing.T) {
+//		golden.AssertGolden(t, "default", golden.Replay(t, golden.DefaultCorpus))
+//	}
+//
+// Intended changes of the state machine regenerate the golden files with the
+// -update-goldens flag:
+//
+//	go test ./simapp/golden -update-goldens
+package golden
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	chainID = "golden-chain"
+	// blockTime is the time between the blocks of a replay
+	blockTime = 5 * time.Second
+)
+
+var updateGoldens = flag.Bool("update-goldens", false, "regenerate the golden files of the determinism tests")
+
+var (
+	genesisTime    = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	initialBalance = sdk.NewInt(1_000_000_000)
+	bondAmt        = sdk.NewInt(1_000_000)
+)
+
+// Tx is a transaction of a corpus, signed by the Signer-th account.
+type Tx struct {
+	Signer int
+	Msgs   []sdk.Msg
+}
+
+// Corpus is a fixed sequence of blocks of transactions.
+type Corpus struct {
+	// NumAccounts is the number of genesis accounts, each funded with the same
+	// balance of the bond denom.
+	NumAccounts int
+	// Blocks returns the transactions of each block, given the addresses of the
+	// genesis accounts and of the genesis validator, to which the first account
+	// delegates. All the transactions must succeed.
+	Blocks func(accs []sdk.AccAddress, valAddr sdk.ValAddress) ([][]Tx, error)
+}
+
+// Result is the outcome of the replay of a corpus.
+type Result struct {
+	// AppHashes are the hex encoded app hashes of the genesis and of each
+	// block.
+	AppHashes []string
+	// Genesis is the app state exported after the last block.
+	Genesis json.RawMessage
+}
+
+// Replay replays corpus on a new in-memory SimApp, from a genesis of which the
+// keys, the addresses and the time are always the same, committing each block
+// and exporting the state after the last one.
+func Replay(t testing.TB, corpus Corpus) Result {
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 0, encCfg, simapp.EmptyAppOptions{})
+
+	privs := make([]cryptotypes.PrivKey, corpus.NumAccounts)
+	accs := make([]sdk.AccAddress, corpus.NumAccounts)
+	for i := range privs {
+		privs[i] = secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("golden account %d", i)))
+		accs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+	}
+	valAddr := initChain(t, app, accs)
+
+	blocks, err := corpus.Blocks(accs, valAddr)
+	require.NoError(t, err)
+
+	result := Result{AppHashes: []string{hex.EncodeToString(app.LastCommitID().Hash)}}
+	txEncoder := encCfg.TxConfig.TxEncoder()
+	seqs := make([]uint64, corpus.NumAccounts)
+
+	for _, txs := range blocks {
+		height := app.LastBlockHeight() + 1
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{
+			ChainID: chainID,
+			Height:  height,
+			Time:    genesisTime.Add(time.Duration(height) * blockTime),
+		}})
+
+		for i, tx := range txs {
+			// without a memo, the signed transactions are always the same
+			sdkTx, err := helpers.GenTxWithMemo(
+				encCfg.TxConfig, tx.Msgs, "", sdk.Coins{}, helpers.DefaultGenTxGas, chainID,
+				[]uint64{uint64(tx.Signer)}, []uint64{seqs[tx.Signer]}, privs[tx.Signer],
+			)
+			require.NoError(t, err)
+			bz, err := txEncoder(sdkTx)
+			require.NoError(t, err)
+
+			res := app.DeliverTx(abci.RequestDeliverTx{Tx: bz})
+			require.True(t, res.IsOK(), "failed to deliver tx %d of block %d: %s", i, height, res.Log)
+			seqs[tx.Signer]++
+		}
+
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		res := app.Commit()
+		result.AppHashes = append(result.AppHashes, hex.EncodeToString(res.Data))
+	}
+
+	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+	result.Genesis = exported.AppState
+
+	return result
+}
+
+// initChain initializes the chain of app with the genesis accounts accs and a
+// validator to which the first account delegates, returning the address of the
+// validator.
+func initChain(t testing.TB, app *simapp.SimApp, accs []sdk.AccAddress) sdk.ValAddress {
+	cdc := app.AppCodec()
+	genesisState := simapp.NewDefaultGenesisState(cdc)
+
+	genAccs := make(authtypes.GenesisAccounts, len(accs))
+	balances := make([]banktypes.Balance, len(accs))
+	for i, addr := range accs {
+		genAccs[i] = authtypes.NewBaseAccount(addr, nil, uint64(i), 0)
+		balances[i] = banktypes.Balance{
+			Address: addr.String(),
+			Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initialBalance)),
+		}
+	}
+
+	packedAccs, err := authtypes.PackAccounts(genAccs)
+	require.NoError(t, err)
+	authGenesis := authtypes.DefaultGenesisState()
+	authGenesis.Accounts = packedAccs
+	genesisState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	consPubKey := ed25519.GenPrivKeyFromSecret([]byte("golden validator")).PubKey()
+	valAddr := sdk.ValAddress(consPubKey.Address())
+	validator, err := stakingtypes.NewValidator(valAddr, consPubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	validator.Status = stakingtypes.Bonded
+	validator.Tokens = bondAmt
+	validator.DelegatorShares = bondAmt.ToDec()
+	delegation := stakingtypes.NewDelegation(accs[0], valAddr, bondAmt.ToDec())
+	stakingGenesis := stakingtypes.NewGenesisState(stakingtypes.DefaultParams(), []stakingtypes.Validator{validator}, []stakingtypes.Delegation{delegation})
+	genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenesis)
+
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, bondAmt)),
+	})
+	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initialBalance.MulRaw(int64(len(accs))).Add(bondAmt)))
+	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{})
+	genesisState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenesis)
+
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         chainID,
+		Time:            genesisTime,
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+
+	return valAddr
+}
+
+// AssertGolden asserts that result matches the golden files of name in the
+// testdata directory: name.app_hashes.golden, listing the app hash of the
+// genesis and of each block, and name.genesis.golden.json, holding the exported genesis. With the
+// -update-goldens flag, the golden files are written from result instead.
+func AssertGolden(t *testing.T, name string, result Result) {
+	var appHashes strings.Builder
+	for i, appHash := range result.AppHashes {
+		if i == 0 {
+			fmt.Fprintf(&appHashes, "genesis %s\n", appHash)
+		} else {
+			fmt.Fprintf(&appHashes, "block %d %s\n", i, appHash)
+		}
+	}
+
+	// the exported genesis is indented for the diffs of the golden files to
+	// show the changes of the state
+	genesis, err := json.MarshalIndent(result.Genesis, "", "  ")
+	require.NoError(t, err)
+
+	assertGoldenFile(t, filepath.Join("testdata", name+".app_hashes.golden"), []byte(appHashes.String()))
+	assertGoldenFile(t, filepath.Join("testdata", name+".genesis.golden.json"), append(genesis, '\n'))
+}
+
+func assertGoldenFile(t *testing.T, path string, got []byte) {
+	if *updateGoldens {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, got, 0644))
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	require.NoError(t, err, "missing golden file, regenerate it with -update-goldens")
+	require.Equal(t, string(want), string(got),
+		"%s does not match; if the state machine changed intentionally, regenerate the golden files with -update-goldens", path)
+}
@@ -0,0 +1,19 @@
+package golden_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp/golden"
+)
+
+func TestDefaultCorpusGolden(t *testing.T) {
+	result := golden.Replay(t, golden.DefaultCorpus)
+
+	// a second replay in the same process has the same outcome, whatever the
+	// order of the iterations over maps
+	require.Equal(t, result, golden.Replay(t, golden.DefaultCorpus))
+
+	golden.AssertGolden(t, "default", result)
+}
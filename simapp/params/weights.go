@@ -25,4 +25,7 @@ const (
 	// feegrant
 	DefaultWeightGrantAllowance  int = 100
 	DefaultWeightRevokeAllowance int = 100
+
+	// nft
+	DefaultWeightSend int = 100
 )
@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -25,6 +28,7 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
@@ -70,6 +74,9 @@ func TestFullAppSimulation(t *testing.T) {
 	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
 	require.Equal(t, "SimApp", app.Name())
 
+	// report the stores the simulation never changes
+	config.CoverageStores = app.GetKeys()
+
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t,
@@ -175,6 +182,7 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[evidencetypes.StoreKey], newApp.keys[evidencetypes.StoreKey], [][]byte{}},
 		{app.keys[capabilitytypes.StoreKey], newApp.keys[capabilitytypes.StoreKey], [][]byte{}},
 		{app.keys[authzkeeper.StoreKey], newApp.keys[authzkeeper.StoreKey], [][]byte{}},
+		{app.keys[group.StoreKey], newApp.keys[group.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -333,3 +341,74 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 }
+
+// TestGenesisFileSimulationDeterminism exports the state of a simulation to a
+// genesis file and checks that the simulations started from it are
+// deterministic, the accounts of the genesis being mapped to new simulation
+// accounts.
+func TestGenesisFileSimulationDeterminism(t *testing.T) {
+	config, db, dir, logger, skip, err := SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation from genesis file")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	app := NewSimApp(logger, db, nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+
+	stopEarly, _, err := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		AppStateFn(app.AppCodec(), app.SimulationManager()),
+		simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+		SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+
+	if stopEarly {
+		fmt.Println("can't export or import a zero-validator genesis, exiting test...")
+		return
+	}
+
+	fmt.Printf("exporting genesis...\n")
+
+	exported, err := app.ExportAppStateAndValidators(true, []string{})
+	require.NoError(t, err)
+
+	config.GenesisFile = filepath.Join(dir, "genesis.json")
+	genesis := tmtypes.GenesisDoc{ChainID: config.ChainID, GenesisTime: time.Now().UTC(), AppState: exported.AppState}
+	require.NoError(t, genesis.SaveAs(config.GenesisFile))
+
+	numTimesToRun := 2
+	appHashList := make([]json.RawMessage, numTimesToRun)
+
+	for i := 0; i < numTimesToRun; i++ {
+		fmt.Printf("running simulation from genesis file, attempt: %d/%d\n", i+1, numTimesToRun)
+
+		newApp := NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, DefaultNodeHome, FlagPeriodValue, MakeTestEncodingConfig(), EmptyAppOptions{}, fauxMerkleModeOpt)
+
+		_, _, err = simulation.SimulateFromSeed(
+			t,
+			os.Stdout,
+			newApp.BaseApp,
+			AppStateFn(newApp.AppCodec(), newApp.SimulationManager()),
+			simtypes.RandomAccounts, // Replace with own random account function if using keys other than secp256k1
+			SimulationOperations(newApp, newApp.AppCodec(), config),
+			newApp.ModuleAccountAddrs(),
+			config,
+			newApp.AppCodec(),
+		)
+		require.NoError(t, err)
+
+		appHashList[i] = newApp.LastCommitID().Hash
+		require.Equal(t, string(appHashList[0]), string(appHashList[i]), "non-determinism in attempt %d/%d", i+1, numTimesToRun)
+	}
+}
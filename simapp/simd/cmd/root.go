@@ -5,12 +5,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -27,11 +29,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisiscli "github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradecli "github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -141,6 +150,22 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	keyFormats := keyformat.NewRegistry()
+	simapp.ModuleBasics.RegisterKeyFormats(keyFormats)
+
+	a := appCreator{encodingConfig}
+
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(
+		bankcli.NewBankDiffCmd(),
+		bankcli.NewBankReconcileCmd(),
+		debug.DecodeKeyCmd(keyFormats),
+		crisiscli.NewRunInvariantsCmd(a.runInvariants, simapp.DefaultNodeHome),
+	)
+
+	keysCmd := keys.Commands(simapp.DefaultNodeHome)
+	keysCmd.AddCommand(authcmd.GetSignTextCommand(), authcmd.GetVerifyTextCommand())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(simapp.ModuleBasics, simapp.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, simapp.DefaultNodeHome),
@@ -150,11 +175,11 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		AddGenesisAccountCmd(simapp.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		testnetCmd(simapp.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		debug.Cmd(),
+		debugCmd,
 		config.Cmd(),
+		upgradecli.NewUpgradeCmd(a.dryRunUpgrade, simapp.DefaultNodeHome),
 	)
 
-	a := appCreator{encodingConfig}
 	server.AddCommands(rootCmd, simapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
@@ -162,7 +187,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
-		keys.Commands(simapp.DefaultNodeHome),
+		keysCmd,
 	)
 
 	// add rosetta
@@ -171,6 +196,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	upgrade.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -211,6 +237,8 @@ func txCommand() *cobra.Command {
 		authcmd.GetSignBatchCommand(),
 		authcmd.GetMultiSignCommand(),
 		authcmd.GetMultiSignBatchCmd(),
+		authcmd.GetAuxSignCommand(),
+		authcmd.GetAuxCombineCommand(),
 		authcmd.GetValidateSignaturesCommand(),
 		authcmd.GetBroadcastCommand(),
 		authcmd.GetEncodeCommand(),
@@ -235,6 +263,16 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		cache = store.NewCommitKVStoreCacheManager()
 	}
 
+	var queryCache *baseapp.QueryCache
+
+	if cast.ToBool(appOpts.Get(server.FlagQueryCacheEnable)) {
+		queryCache = baseapp.NewQueryCache(
+			time.Duration(cast.ToUint(appOpts.Get(server.FlagQueryCacheTTL)))*time.Second,
+			cast.ToInt(appOpts.Get(server.FlagQueryCacheMaxEntries)),
+			cast.ToStringSlice(appOpts.Get(server.FlagQueryCacheMethods)),
+		)
+	}
+
 	skipUpgradeHeights := make(map[int64]bool)
 	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
 		skipUpgradeHeights[int64(h)] = true
@@ -266,6 +304,10 @@ func (a appCreator) newApp(logger log.Logger, db dbm.DB, traceStore io.Writer, a
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(server.FlagHaltHeight))),
 		baseapp.SetHaltTime(cast.ToUint64(appOpts.Get(server.FlagHaltTime))),
 		baseapp.SetMinRetainBlocks(cast.ToUint64(appOpts.Get(server.FlagMinRetainBlocks))),
+		baseapp.SetQueryCache(queryCache),
+		baseapp.SetReplayVerificationWindow(cast.ToUint64(appOpts.Get(server.FlagReplayVerificationWindow))),
+		baseapp.SetGasUsageWindow(cast.ToUint64(appOpts.Get(server.FlagGasUsageWindow))),
+		baseapp.SetStateSizeInterval(cast.ToUint64(appOpts.Get(server.FlagStateSizeInterval))),
 		baseapp.SetInterBlockCache(cache),
 		baseapp.SetTrace(cast.ToBool(appOpts.Get(server.FlagTrace))),
 		baseapp.SetIndexEvents(cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents))),
@@ -299,3 +341,55 @@ func (a appCreator) appExport(
 
 	return simApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs)
 }
+
+// runInvariants creates a new simapp (optionally at a given height) and checks
+// its registered invariants.
+func (a appCreator) runInvariants(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64,
+	appOpts servertypes.AppOptions) (crisistypes.InvariantsReport, error) {
+
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return crisistypes.InvariantsReport{}, errors.New("application home not set")
+	}
+
+	simApp := simapp.NewSimApp(logger, db, traceStore, height == -1, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
+	if height != -1 {
+		if err := simApp.LoadHeight(height); err != nil {
+			return crisistypes.InvariantsReport{}, err
+		}
+	}
+
+	// the invariants run on a cache of the state, which is never committed
+	ctx := simApp.NewContext(true, tmproto.Header{Height: simApp.LastBlockHeight()})
+	start := time.Now()
+	return crisistypes.NewInvariantsReport(ctx.BlockHeight(), simApp.CrisisKeeper.CheckInvariants(ctx), start), nil
+}
+
+// dryRunUpgrade creates a new simapp at its latest height and executes the
+// upgrade handler of the named plan at the next height, on a branch of its state.
+func (a appCreator) dryRunUpgrade(
+	logger log.Logger, db dbm.DB, traceStore io.Writer, planName string,
+	appOpts servertypes.AppOptions) (upgradetypes.DryRunReport, error) {
+
+	homePath, ok := appOpts.Get(flags.FlagHome).(string)
+	if !ok || homePath == "" {
+		return upgradetypes.DryRunReport{}, errors.New("application home not set")
+	}
+
+	simApp := simapp.NewSimApp(logger, db, traceStore, true, map[int64]bool{}, homePath, uint(1), a.encCfg, appOpts)
+	ctx := simApp.NewContext(true, tmproto.Header{Height: simApp.LastBlockHeight() + 1})
+
+	// the scheduled plan is used when it has the given name, e.g. for its info
+	plan, found := simApp.UpgradeKeeper.GetUpgradePlan(ctx)
+	if !found || plan.Name != planName {
+		plan = upgradetypes.Plan{Name: planName, Height: ctx.BlockHeight()}
+	}
+
+	storeKeys := make([]sdk.StoreKey, 0, len(simApp.GetKeys()))
+	for _, key := range simApp.GetKeys() {
+		storeKeys = append(storeKeys, key)
+	}
+
+	return simApp.UpgradeKeeper.DryRunUpgrade(ctx, plan, storeKeys)
+}
@@ -1,6 +1,7 @@
 package simapp
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -12,6 +13,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -192,7 +194,14 @@ func AppStateRandomizedFn(
 }
 
 // AppStateFromGenesisFileFn util function to generate the genesis AppState
-// from a genesis.json file.
+// from a genesis.json file, e.g. exported from a real network.
+//
+// Each account of the genesis, except the module accounts, is mapped to a new
+// simulation account, with a key derived from r, taking over its state: the
+// bech32 account and validator operator addresses of the account are replaced
+// by the ones of the simulation account throughout the app state, and its
+// public key is removed, to be set by the first tx of the simulation account.
+// The simulation is thus deterministic for a given genesis and seed.
 func AppStateFromGenesisFileFn(r io.Reader, cdc codec.JSONCodec, genesisFile string) (tmtypes.GenesisDoc, []simtypes.Account) {
 	bytes, err := ioutil.ReadFile(genesisFile)
 	if err != nil {
@@ -217,27 +226,107 @@ func AppStateFromGenesisFileFn(r io.Reader, cdc codec.JSONCodec, genesisFile str
 		cdc.MustUnmarshalJSON(appState[authtypes.ModuleName], &authGenesis)
 	}
 
-	newAccs := make([]simtypes.Account, len(authGenesis.Accounts))
+	newAccs := make([]simtypes.Account, 0, len(authGenesis.Accounts))
+	genAccs := make(authtypes.GenesisAccounts, len(authGenesis.Accounts))
+	addrMapping := make(map[string]string, 2*len(authGenesis.Accounts))
 	for i, acc := range authGenesis.Accounts {
-		// Pick a random private key, since we don't know the actual key
-		// This should be fine as it's only used for mock Tendermint validators
-		// and these keys are never actually used to sign by mock Tendermint.
+		a, ok := acc.GetCachedValue().(authtypes.GenesisAccount)
+		if !ok {
+			panic("expected genesis account")
+		}
+		genAccs[i] = a
+
+		// the module accounts have no key
+		if _, ok := a.(authtypes.ModuleAccountI); ok {
+			continue
+		}
+
+		// the simulation accounts must sign with a key matching their address
 		privkeySeed := make([]byte, 15)
 		if _, err := r.Read(privkeySeed); err != nil {
 			panic(err)
 		}
 
 		privKey := secp256k1.GenPrivKeyFromSecret(privkeySeed)
+		simAcc := simtypes.Account{
+			PrivKey: privKey,
+			PubKey:  privKey.PubKey(),
+			Address: sdk.AccAddress(privKey.PubKey().Address()),
+			ConsKey: ed25519.GenPrivKeyFromSecret(privkeySeed),
+		}
+		newAccs = append(newAccs, simAcc)
 
-		a, ok := acc.GetCachedValue().(authtypes.AccountI)
-		if !ok {
-			panic("expected account")
+		addr := a.GetAddress()
+		addrMapping[addr.String()] = simAcc.Address.String()
+		addrMapping[sdk.ValAddress(addr).String()] = sdk.ValAddress(simAcc.Address).String()
+
+		if err := a.SetPubKey(nil); err != nil {
+			panic(err)
+		}
+	}
+
+	if appState[authtypes.ModuleName] != nil {
+		authGenesis.Accounts, err = authtypes.PackAccounts(genAccs)
+		if err != nil {
+			panic(err)
 		}
+		appState[authtypes.ModuleName] = cdc.MustMarshalJSON(&authGenesis)
+	}
+
+	for name, state := range appState {
+		appState[name] = replaceAddresses(state, addrMapping)
+	}
 
-		// create simulator accounts
-		simAcc := simtypes.Account{PrivKey: privKey, PubKey: privKey.PubKey(), Address: a.GetAddress()}
-		newAccs[i] = simAcc
+	genesis.AppState, err = json.Marshal(appState)
+	if err != nil {
+		panic(err)
 	}
 
 	return genesis, newAccs
 }
+
+// replaceAddresses returns the JSON state whose string values and object keys
+// are replaced according to mapping.
+func replaceAddresses(state json.RawMessage, mapping map[string]string) json.RawMessage {
+	// the numbers are kept as they are, their precision being unbounded
+	dec := json.NewDecoder(bytes.NewReader(state))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		panic(err)
+	}
+
+	bz, err := json.Marshal(replaceJSONStrings(v, mapping))
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+func replaceJSONStrings(v interface{}, mapping map[string]string) interface{} {
+	switch v := v.(type) {
+	case string:
+		if replaced, ok := mapping[v]; ok {
+			return replaced
+		}
+		return v
+
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = replaceJSONStrings(elem, mapping)
+		}
+		return v
+
+	case map[string]interface{}:
+		replaced := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			replaced[replaceJSONStrings(key, mapping).(string)] = replaceJSONStrings(elem, mapping)
+		}
+		return replaced
+
+	default:
+		return v
+	}
+}
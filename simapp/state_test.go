@@ -0,0 +1,89 @@
+package simapp
+
+import (
+	"encoding/json"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAppStateFromGenesisFileFn(t *testing.T) {
+	encCfg := MakeTestEncodingConfig()
+	cdc := encCfg.Marshaler
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	acc := authtypes.NewBaseAccount(addr, pubKey, 3, 7)
+	modAcc := authtypes.NewEmptyModuleAccount(stakingtypes.BondedPoolName, authtypes.Staking)
+
+	accs, err := authtypes.PackAccounts(authtypes.GenesisAccounts{acc, modAcc})
+	require.NoError(t, err)
+	authGenesis := authtypes.DefaultGenesisState()
+	authGenesis.Accounts = accs
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))},
+		{Address: modAcc.GetAddress().String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+	}
+
+	stakingGenesis := stakingtypes.DefaultGenesisState()
+	stakingGenesis.Validators = []stakingtypes.Validator{{OperatorAddress: sdk.ValAddress(addr).String()}}
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		authtypes.ModuleName:    cdc.MustMarshalJSON(authGenesis),
+		banktypes.ModuleName:    cdc.MustMarshalJSON(bankGenesis),
+		stakingtypes.ModuleName: cdc.MustMarshalJSON(stakingGenesis),
+	})
+	require.NoError(t, err)
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, (&tmtypes.GenesisDoc{ChainID: "test-chain", AppState: appState}).SaveAs(genesisFile))
+
+	genesis, simAccs := AppStateFromGenesisFileFn(rand.New(rand.NewSource(1)), cdc, genesisFile)
+
+	// the account is mapped to a simulation account signing with its key
+	require.Len(t, simAccs, 1)
+	simAcc := simAccs[0]
+	require.Equal(t, simAcc.Address, sdk.AccAddress(simAcc.PubKey.Address()))
+	require.NotEqual(t, addr, simAcc.Address)
+
+	var state map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(genesis.AppState, &state))
+
+	var gotAuth authtypes.GenesisState
+	cdc.MustUnmarshalJSON(state[authtypes.ModuleName], &gotAuth)
+	gotAccs, err := authtypes.UnpackAccounts(gotAuth.Accounts)
+	require.NoError(t, err)
+	require.Len(t, gotAccs, 2)
+	require.Equal(t, simAcc.Address, gotAccs[0].GetAddress())
+	require.Nil(t, gotAccs[0].GetPubKey())
+	require.Equal(t, uint64(3), gotAccs[0].GetAccountNumber())
+	require.Equal(t, uint64(7), gotAccs[0].GetSequence())
+	require.Equal(t, modAcc.GetAddress(), gotAccs[1].GetAddress())
+
+	var gotBank banktypes.GenesisState
+	cdc.MustUnmarshalJSON(state[banktypes.ModuleName], &gotBank)
+	require.Equal(t, []banktypes.Balance{
+		{Address: simAcc.Address.String(), Coins: bankGenesis.Balances[0].Coins},
+		bankGenesis.Balances[1],
+	}, gotBank.Balances)
+
+	var gotStaking stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(state[stakingtypes.ModuleName], &gotStaking)
+	require.Equal(t, sdk.ValAddress(simAcc.Address).String(), gotStaking.Validators[0].OperatorAddress)
+
+	// the same seed gives the same simulation accounts and state
+	genesis2, simAccs2 := AppStateFromGenesisFileFn(rand.New(rand.NewSource(1)), cdc, genesisFile)
+	require.Equal(t, simAccs, simAccs2)
+	require.Equal(t, genesis.AppState, genesis2.AppState)
+}
@@ -118,6 +118,12 @@ func (st *Store) Commit() types.CommitID {
 	}
 }
 
+// WorkingHash returns the hash of the current working tree, i.e. the hash the
+// next commit would result in.
+func (st *Store) WorkingHash() []byte {
+	return st.tree.WorkingHash()
+}
+
 // LastCommitID implements Committer.
 func (st *Store) LastCommitID() types.CommitID {
 	return types.CommitID{
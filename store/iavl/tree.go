@@ -26,6 +26,7 @@ type (
 		DeleteVersions(versions ...int64) error
 		Version() int64
 		Hash() []byte
+		WorkingHash() []byte
 		VersionExists(version int64) bool
 		GetVersioned(key []byte, version int64) (int64, []byte)
 		GetVersionedWithProof(key []byte, version int64) ([]byte, *iavl.RangeProof, error)
@@ -65,6 +66,10 @@ func (it *immutableTree) SetInitialVersion(_ uint64) {
 	panic("cannot call 'SetInitialVersion' on an immutable IAVL tree")
 }
 
+func (it *immutableTree) WorkingHash() []byte {
+	return it.Hash()
+}
+
 func (it *immutableTree) VersionExists(version int64) bool {
 	return it.Version() == version
 }
@@ -0,0 +1,116 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/transient"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// VersionBranch is a writable branch of the state at a committed version,
+// whose writes are only kept in memory. It computes the app hash that
+// committing its writes would result in, without committing them.
+type VersionBranch struct {
+	version int64
+	stores  map[types.StoreKey]types.CacheWrapper
+	keys    map[string]types.StoreKey
+
+	// trees are the IAVL stores, by store name
+	trees map[string]*iavl.Store
+	// commitIDs are the commit IDs of the other committed stores, by store name
+	commitIDs map[string]types.CommitID
+}
+
+// BranchVersion returns a VersionBranch of the state at version. The IAVL
+// stores are loaded at version, the transient stores are empty, and the
+// memory stores are copied from their current state. It is safe to call
+// concurrently with the commits of the store, as long as version is not
+// pruned meanwhile.
+func (rs *Store) BranchVersion(version int64) (*VersionBranch, error) {
+	b := &VersionBranch{
+		version:   version,
+		stores:    make(map[types.StoreKey]types.CacheWrapper, len(rs.stores)),
+		keys:      rs.keysByName,
+		trees:     make(map[string]*iavl.Store),
+		commitIDs: make(map[string]types.CommitID),
+	}
+
+	for key, store := range rs.stores {
+		params := rs.storesParams[key]
+
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
+			db := params.db
+			if db != nil {
+				db = dbm.NewPrefixDB(db, []byte("s/_/"))
+			} else {
+				db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+key.Name()+"/"))
+			}
+
+			// a new tree over the same db leaves the store unaffected
+			tree, err := iavl.LoadStore(db, types.CommitID{Version: version}, true)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load store %s at version %d: %w", key.Name(), version, err)
+			}
+
+			b.stores[key] = tree
+			b.trees[key.Name()] = tree.(*iavl.Store)
+
+		case types.StoreTypeTransient:
+			b.stores[key] = transient.NewStore()
+
+		case types.StoreTypeMemory:
+			memStore := mem.NewStore()
+			itr := store.Iterator(nil, nil)
+			for ; itr.Valid(); itr.Next() {
+				memStore.Set(itr.Key(), itr.Value())
+			}
+			itr.Close()
+
+			b.stores[key] = memStore
+			b.commitIDs[key.Name()] = store.LastCommitID()
+
+		default:
+			// the writes of the branch must not reach the store
+			b.stores[key] = cachekv.NewStore(store)
+			b.commitIDs[key.Name()] = store.LastCommitID()
+		}
+	}
+
+	return b, nil
+}
+
+// Version returns the version the branch was created at.
+func (b *VersionBranch) Version() int64 {
+	return b.version
+}
+
+// CacheMultiStore returns a CacheMultiStore branching the VersionBranch,
+// whose writes are written to the branch.
+func (b *VersionBranch) CacheMultiStore() types.CacheMultiStore {
+	return cachemulti.NewStore(dbm.NewMemDB(), b.stores, b.keys, nil, nil, nil)
+}
+
+// WorkingHash returns the app hash of the next version, if the writes to the
+// branch were committed.
+func (b *VersionBranch) WorkingHash() []byte {
+	storeInfos := make([]types.StoreInfo, 0, len(b.trees)+len(b.commitIDs))
+	for name, tree := range b.trees {
+		storeInfos = append(storeInfos, types.StoreInfo{
+			Name:     name,
+			CommitId: types.CommitID{Version: b.version + 1, Hash: tree.WorkingHash()},
+		})
+	}
+
+	for name, id := range b.commitIDs {
+		storeInfos = append(storeInfos, types.StoreInfo{Name: name, CommitId: id})
+	}
+
+	return types.CommitInfo{Version: b.version + 1, StoreInfos: storeInfos}.Hash()
+}
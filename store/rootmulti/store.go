@@ -589,6 +589,17 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	return res
 }
 
+// GetCommitInfo returns the commit info, i.e. the per-store commit IDs that
+// compose the app hash, committed at the given version. The latest commit info
+// is served from memory as it may not have been flushed to disk yet.
+func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
+	if rs.lastCommitInfo != nil && ver == rs.lastCommitInfo.Version {
+		return rs.lastCommitInfo, nil
+	}
+
+	return getCommitInfo(rs.db, ver)
+}
+
 // SetInitialVersion sets the initial version of the IAVL tree. It is used when
 // starting a new chain at an arbitrary height.
 func (rs *Store) SetInitialVersion(version int64) error {
@@ -941,6 +952,12 @@ type storeParams struct {
 	initialVersion uint64
 }
 
+// GetLatestVersion returns the latest version committed to the database of a
+// root multi-store, or zero if none was.
+func GetLatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
 func getLatestVersion(db dbm.DB) int64 {
 	bz, err := db.Get([]byte(latestVersionKey))
 	if err != nil {
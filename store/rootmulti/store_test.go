@@ -112,6 +112,43 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestBranchVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	memKey := types.NewMemoryStoreKey("mem")
+	ms.MountStoreWithDB(memKey, types.StoreTypeMemory, nil)
+	ms.MountStoreWithDB(types.NewTransientStoreKey("trans"), types.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	store1 := ms.GetKVStore(ms.keysByName["store1"])
+	store1.Set([]byte("k1"), []byte("v1"))
+	ms.GetKVStore(memKey).Set([]byte("mem"), []byte("v"))
+	ms.Commit()
+
+	branch, err := ms.BranchVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), branch.Version())
+
+	// the same writes on the branch and the store result in the same app hash
+	write := func(cms types.CacheMultiStore) {
+		cms.GetKVStore(ms.keysByName["store1"]).Delete([]byte("k1"))
+		cms.GetKVStore(ms.keysByName["store2"]).Set([]byte("k2"), []byte("v2"))
+		require.Equal(t, []byte("v"), cms.GetKVStore(memKey).Get([]byte("mem")))
+		cms.Write()
+	}
+
+	cms := branch.CacheMultiStore()
+	write(cms)
+	require.Equal(t, []byte("v1"), store1.Get([]byte("k1")), "the store is unaffected")
+
+	write(ms.CacheMultiStore())
+	commitID := ms.Commit()
+	require.Equal(t, commitID.Hash, branch.WorkingHash())
+
+	_, err = ms.BranchVersion(3)
+	require.Error(t, err)
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -183,6 +220,30 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestGetCommitInfo(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	store.GetKVStore(store.keysByName["store1"]).Set([]byte("k"), []byte("v"))
+	commitID1 := store.Commit()
+	commitID2 := store.Commit()
+
+	// the latest version is served from memory
+	ci, err := store.GetCommitInfo(2)
+	require.NoError(t, err)
+	require.Equal(t, commitID2.Hash, ci.Hash())
+	require.Len(t, ci.StoreInfos, 3)
+
+	// older versions are read from disk
+	ci, err = store.GetCommitInfo(1)
+	require.NoError(t, err)
+	require.Equal(t, commitID1.Hash, ci.Hash())
+
+	_, err = store.GetCommitInfo(3)
+	require.Error(t, err)
+}
+
 func TestMultistoreLoadWithUpgrade(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	store := newMultiStoreWithMounts(db, types.PruneNothing)
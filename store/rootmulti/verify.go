@@ -0,0 +1,212 @@
+package rootmulti
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	iavltree "github.com/cosmos/iavl"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// verifyCacheSize is the number of nodes cached while verifying an IAVL tree.
+const verifyCacheSize = 10000
+
+// StoreVerification is the result of the verification of a store of the
+// latest version of a root multi-store.
+type StoreVerification struct {
+	Name string
+	// Nodes is the number of nodes of the IAVL tree of the store.
+	Nodes int64
+	// Err is the corruption found in the store, nil if it is intact.
+	Err error
+}
+
+// VerificationReport is the result of the verification of the latest version
+// of a root multi-store.
+type VerificationReport struct {
+	Version int64
+	// AppHash is the hash of the commit info of the version.
+	AppHash []byte
+	// Stores are the verifications of the stores of the commit info, sorted by
+	// name.
+	Stores []StoreVerification
+}
+
+// Err returns an error listing the corrupt stores of the report, or nil if all
+// the stores are intact.
+func (r VerificationReport) Err() error {
+	var corrupt []string
+	for _, store := range r.Stores {
+		if store.Err != nil {
+			corrupt = append(corrupt, fmt.Sprintf("%s: %s", store.Name, store.Err))
+		}
+	}
+
+	if len(corrupt) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("corrupt stores at version %d: %s", r.Version, strings.Join(corrupt, "; "))
+}
+
+// VerifyLatestVersion verifies the IAVL stores of the latest version committed
+// to the database of a root multi-store against its commit info: the nodes of
+// their trees must all be present and decodable, and their root hashes, stored
+// and recomputed from the nodes, must match the ones of the commit info. It
+// returns an error if the commit info can't be read, and the corrupt stores
+// in the report otherwise. The report is empty if no version was committed.
+func VerifyLatestVersion(db dbm.DB) (VerificationReport, error) {
+	version := getLatestVersion(db)
+	if version == 0 {
+		return VerificationReport{}, nil
+	}
+
+	cInfo, err := getCommitInfo(db, version)
+	if err != nil {
+		return VerificationReport{}, err
+	}
+
+	report := VerificationReport{
+		Version: version,
+		AppHash: cInfo.Hash(),
+		Stores:  make([]StoreVerification, 0, len(cInfo.StoreInfos)),
+	}
+	for _, info := range cInfo.StoreInfos {
+		storeDB := dbm.NewPrefixDB(db, []byte("s/k:"+info.Name+"/"))
+		nodes, err := verifyIAVLStore(storeDB, info.CommitId)
+		report.Stores = append(report.Stores, StoreVerification{Name: info.Name, Nodes: nodes, Err: err})
+	}
+	sort.Slice(report.Stores, func(i, j int) bool { return report.Stores[i].Name < report.Stores[j].Name })
+
+	return report, nil
+}
+
+// verifyIAVLStore verifies the IAVL tree of db at the version of id against its
+// hash, returning the number of nodes of the tree.
+func verifyIAVLStore(db dbm.DB, id types.CommitID) (nodes int64, err error) {
+	// the nodes missing or which can't be decoded make the IAVL trees panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	mtree, err := iavltree.NewMutableTree(db, verifyCacheSize)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := mtree.LazyLoadVersion(id.Version); err != nil {
+		return 0, err
+	}
+	tree, err := mtree.GetImmutable(id.Version)
+	if err != nil {
+		return 0, err
+	}
+
+	if hash := tree.Hash(); !bytes.Equal(hash, id.Hash) {
+		return 0, fmt.Errorf("root hash %X does not match the commit info hash %X", hash, id.Hash)
+	}
+
+	// the tree is traversed first in this goroutine, as the exporter traverses
+	// it in another one, where the missing nodes could not be recovered from
+	tree.Iterate(func(_, _ []byte) bool { return false })
+
+	hash, nodes, err := hashExport(tree.Export())
+	if err != nil {
+		return nodes, err
+	}
+	if !bytes.Equal(hash, id.Hash) {
+		return nodes, fmt.Errorf("root hash %X recomputed from the nodes does not match the commit info hash %X", hash, id.Hash)
+	}
+
+	return nodes, nil
+}
+
+// exportedNode is the hash and size of a node whose parent is not exported
+// yet.
+type exportedNode struct {
+	hash []byte
+	size int64
+}
+
+// hashExport recomputes the root hash of the tree exported by exporter from its
+// nodes, exported in post-order, as IAVL hashes them. It returns the number of
+// nodes exported.
+func hashExport(exporter *iavltree.Exporter) ([]byte, int64, error) {
+	defer exporter.Close()
+
+	var (
+		stack []exportedNode
+		nodes int64
+	)
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			break
+		}
+		if err != nil {
+			return nil, nodes, err
+		}
+		nodes++
+
+		var buf bytes.Buffer
+		writeVarint(&buf, int64(node.Height))
+
+		if node.Height == 0 {
+			valueHash := sha256.Sum256(node.Value)
+
+			writeVarint(&buf, 1)
+			writeVarint(&buf, node.Version)
+			writeBytes(&buf, node.Key)
+			writeBytes(&buf, valueHash[:])
+			stack = append(stack, exportedNode{hash: hashOf(buf.Bytes()), size: 1})
+			continue
+		}
+
+		if len(stack) < 2 {
+			return nil, nodes, fmt.Errorf("inner node of height %d without children", node.Height)
+		}
+		left, right := stack[len(stack)-2], stack[len(stack)-1]
+		stack = stack[:len(stack)-2]
+
+		size := left.size + right.size
+		writeVarint(&buf, size)
+		writeVarint(&buf, node.Version)
+		writeBytes(&buf, left.hash)
+		writeBytes(&buf, right.hash)
+		stack = append(stack, exportedNode{hash: hashOf(buf.Bytes()), size: size})
+	}
+
+	switch len(stack) {
+	case 0:
+		// the hash of an empty tree is the one of an empty input
+		return hashOf(nil), nodes, nil
+	case 1:
+		return stack[0].hash, nodes, nil
+	default:
+		return nil, nodes, fmt.Errorf("%d nodes without parent", len(stack))
+	}
+}
+
+func hashOf(bz []byte) []byte {
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// writeVarint and writeBytes write the encodings IAVL hashes the nodes with.
+func writeVarint(buf *bytes.Buffer, i int64) {
+	var bz [binary.MaxVarintLen64]byte
+	buf.Write(bz[:binary.PutVarint(bz[:], i)])
+}
+
+func writeBytes(buf *bytes.Buffer, bz []byte) {
+	var n [binary.MaxVarintLen64]byte
+	buf.Write(n[:binary.PutUvarint(n[:], uint64(len(bz)))])
+	buf.Write(bz)
+}
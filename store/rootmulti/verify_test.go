@@ -0,0 +1,82 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// corruptNodes applies corrupt to the IAVL nodes of store in db.
+func corruptNodes(t *testing.T, db dbm.DB, store string, corrupt func(key, node []byte)) {
+	prefix := []byte("s/k:" + store + "/n")
+	it, err := dbm.IteratePrefix(db, prefix)
+	require.NoError(t, err)
+	defer it.Close()
+
+	nodes := map[string][]byte{}
+	for ; it.Valid(); it.Next() {
+		nodes[string(it.Key())] = append([]byte{}, it.Value()...)
+	}
+	require.NotEmpty(t, nodes)
+
+	for key, node := range nodes {
+		corrupt([]byte(key), node)
+	}
+}
+
+func TestVerifyLatestVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+
+	// nothing to verify before the first commit
+	report, err := VerifyLatestVersion(db)
+	require.NoError(t, err)
+	require.Empty(t, report.Stores)
+	require.NoError(t, report.Err())
+
+	store := newMultiStoreWithMixedMountsAndBasicData(db)
+	report, err = VerifyLatestVersion(db)
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	require.Equal(t, int64(3), report.Version)
+	require.Equal(t, store.LastCommitID().Hash, report.AppHash)
+	require.Equal(t, []StoreVerification{
+		{Name: "iavl1", Nodes: 5},
+		{Name: "iavl2", Nodes: 5},
+		{Name: "iavl3", Nodes: 0},
+	}, report.Stores)
+
+	// the values of the leaves are the last bytes of their encoding, after
+	// their height of 0
+	corruptNodes(t, db, "iavl1", func(key, node []byte) {
+		if node[0] == 0 {
+			node[len(node)-1]++
+			require.NoError(t, db.Set(key, node))
+		}
+	})
+	corruptNodes(t, db, "iavl2", func(key, _ []byte) {
+		require.NoError(t, db.Delete(key))
+	})
+
+	report, err = VerifyLatestVersion(db)
+	require.NoError(t, err)
+	require.Len(t, report.Stores, 3)
+	require.Contains(t, report.Stores[0].Err.Error(), "recomputed from the nodes does not match the commit info hash")
+	require.Contains(t, report.Stores[1].Err.Error(), "Value missing for hash")
+	require.NoError(t, report.Stores[2].Err)
+	require.Error(t, report.Err())
+}
+
+func TestVerifyLatestVersionGeneratedData(t *testing.T) {
+	db := dbm.NewMemDB()
+	newMultiStoreWithGeneratedData(db, 3, 500)
+
+	report, err := VerifyLatestVersion(db)
+	require.NoError(t, err)
+	require.NoError(t, report.Err())
+	require.Len(t, report.Stores, 3)
+	for _, store := range report.Stores {
+		// the trees have 500 leaves and 499 inner nodes
+		require.Equal(t, int64(999), store.Nodes)
+	}
+}
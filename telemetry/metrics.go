@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
@@ -12,9 +13,41 @@ import (
 	"github.com/prometheus/common/expfmt"
 )
 
-// globalLabels defines the set of global labels that will be applied to all
-// metrics emitted using the telemetry package function wrappers.
-var globalLabels = []metrics.Label{}
+// globalLabels holds the []metrics.Label of global labels that will be applied
+// to all metrics emitted using the telemetry package function wrappers. They
+// are set by New, and can be replaced at runtime with SetGlobalLabels.
+var globalLabels atomic.Value
+
+// SetGlobalLabels replaces the global labels of the metrics emitted, given as
+// name/value tuples, e.g. when the configuration is reloaded.
+func SetGlobalLabels(labels [][]string) error {
+	parsed := make([]metrics.Label, len(labels))
+	for i, l := range labels {
+		if len(l) != 2 {
+			return fmt.Errorf("invalid global label %v, expected a name/value tuple", l)
+		}
+		parsed[i] = NewLabel(l[0], l[1])
+	}
+
+	globalLabels.Store(parsed)
+	return nil
+}
+
+// loadGlobalLabels returns the global labels of the metrics emitted.
+func loadGlobalLabels() []metrics.Label {
+	labels, _ := globalLabels.Load().([]metrics.Label)
+	return labels
+}
+
+// globalTelemetryEnabled is true once the global metrics collector of an
+// enabled telemetry configuration is registered.
+var globalTelemetryEnabled bool
+
+// IsTelemetryEnabled returns true if a Metrics object was created with telemetry
+// enabled, and the metrics emitted are collected.
+func IsTelemetryEnabled() bool {
+	return globalTelemetryEnabled
+}
 
 // Metrics supported format types.
 const (
@@ -76,13 +109,10 @@ func New(cfg Config) (*Metrics, error) {
 		return nil, nil
 	}
 
-	if numGlobalLables := len(cfg.GlobalLabels); numGlobalLables > 0 {
-		parsedGlobalLabels := make([]metrics.Label, numGlobalLables)
-		for i, gl := range cfg.GlobalLabels {
-			parsedGlobalLabels[i] = NewLabel(gl[0], gl[1])
+	if len(cfg.GlobalLabels) > 0 {
+		if err := SetGlobalLabels(cfg.GlobalLabels); err != nil {
+			return nil, err
 		}
-
-		globalLabels = parsedGlobalLabels
 	}
 
 	metricsConf := metrics.DefaultConfig(cfg.ServiceName)
@@ -112,6 +142,7 @@ func New(cfg Config) (*Metrics, error) {
 	if _, err := metrics.NewGlobal(metricsConf, fanout); err != nil {
 		return nil, err
 	}
+	globalTelemetryEnabled = true
 
 	return m, nil
 }
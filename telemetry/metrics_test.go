@@ -74,3 +74,44 @@ func emitMetrics() {
 		}
 	}
 }
+
+func TestKeeperMetrics(t *testing.T) {
+	m, err := New(Config{
+		Enabled:        true,
+		EnableHostname: false,
+		ServiceName:    "test",
+	})
+	require.NoError(t, err)
+	require.True(t, IsTelemetryEnabled())
+
+	KeeperIncrCounter("staking", "delegate", 1)
+	KeeperIncrCounter("staking", "delegate", 1)
+	KeeperAddSample("staking", "delegate", 10)
+	KeeperAddSample("staking", "delegate", 30)
+
+	data := m.memSink.Data()
+	require.NotEmpty(t, data)
+
+	counter, ok := data[len(data)-1].Counters["test.keeper_operations;module=staking;operation=delegate"]
+	require.True(t, ok)
+	require.Equal(t, 2, counter.Count)
+
+	sample, ok := data[len(data)-1].Samples["test.keeper_operation_amounts;module=staking;operation=delegate"]
+	require.True(t, ok)
+	require.Equal(t, 2, sample.Count)
+	require.Equal(t, 40.0, sample.Sum)
+}
+
+func TestSetGlobalLabels(t *testing.T) {
+	t.Cleanup(func() { _ = SetGlobalLabels(nil) })
+
+	require.NoError(t, SetGlobalLabels([][]string{{"chain_id", "test"}}))
+	require.Equal(t, []metrics.Label{NewLabel("chain_id", "test")}, loadGlobalLabels())
+
+	// the labels are kept if the new ones are invalid
+	require.Error(t, SetGlobalLabels([][]string{{"chain_id"}}))
+	require.Equal(t, []metrics.Label{NewLabel("chain_id", "test")}, loadGlobalLabels())
+
+	require.NoError(t, SetGlobalLabels(nil))
+	require.Empty(t, loadGlobalLabels())
+}
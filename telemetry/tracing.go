@@ -0,0 +1,106 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the tracer of the spans of the Cosmos SDK.
+const TracerName = "github.com/cosmos/cosmos-sdk"
+
+// Common span attribute keys
+const (
+	SpanAttributeKeyHeight  = attribute.Key("height")
+	SpanAttributeKeyTxHash  = attribute.Key("tx_hash")
+	SpanAttributeKeyMsgType = attribute.Key("msg_type")
+)
+
+// TracingConfig defines the configuration options for the OpenTelemetry
+// tracing of the block execution.
+type TracingConfig struct {
+	// Enabled enables the export of the spans of the block execution.
+	Enabled bool `mapstructure:"enabled"`
+
+	// Endpoint is the host and port of the OTLP/HTTP collector the spans are
+	// exported to, e.g. "localhost:4318".
+	Endpoint string `mapstructure:"endpoint"`
+
+	// Insecure exports the spans over HTTP instead of HTTPS.
+	Insecure bool `mapstructure:"insecure"`
+
+	// ServiceName is the name of the service the spans are attributed to.
+	ServiceName string `mapstructure:"service-name"`
+
+	// SampleRate is the fraction of the blocks whose execution is traced,
+	// between 0 and 1.
+	SampleRate float64 `mapstructure:"sample-rate"`
+}
+
+// ValidateBasic returns an error if the tracing configuration is invalid.
+func (c TracingConfig) ValidateBasic() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Endpoint == "" {
+		return fmt.Errorf("tracing endpoint cannot be empty")
+	}
+
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("tracing sample rate must be between 0 and 1: %v", c.SampleRate)
+	}
+
+	return nil
+}
+
+// StartTracing registers, as the global OpenTelemetry tracer provider, a
+// provider exporting the spans to the OTLP collector of the configuration, and
+// returns the function flushing the spans and stopping the export. The blocks
+// are sampled as a whole, the spans of their execution following the sampling
+// decision of the span of the block.
+func StartTracing(cfg TracingConfig) (shutdown func(context.Context) error, err error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(cfg.ServiceName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRate))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the spans of the Cosmos SDK, from the global
+// tracer provider. Its spans are not recorded unless tracing is started.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
@@ -8,9 +8,12 @@ import (
 
 // Common metric key constants
 const (
-	MetricKeyBeginBlocker = "begin_blocker"
-	MetricKeyEndBlocker   = "end_blocker"
-	MetricLabelNameModule = "module"
+	MetricKeyBeginBlocker           = "begin_blocker"
+	MetricKeyEndBlocker             = "end_blocker"
+	MetricKeyKeeperOperations       = "keeper_operations"
+	MetricKeyKeeperOperationAmounts = "keeper_operation_amounts"
+	MetricLabelNameModule           = "module"
+	MetricLabelNameOperation        = "operation"
 )
 
 // NewLabel creates a new instance of Label with name and value
@@ -25,7 +28,7 @@ func ModuleMeasureSince(module string, start time.Time, keys ...string) {
 	metrics.MeasureSinceWithLabels(
 		keys,
 		start.UTC(),
-		append([]metrics.Label{NewLabel(MetricLabelNameModule, module)}, globalLabels...),
+		append([]metrics.Label{NewLabel(MetricLabelNameModule, module)}, loadGlobalLabels()...),
 	)
 }
 
@@ -36,36 +39,88 @@ func ModuleSetGauge(module string, val float32, keys ...string) {
 	metrics.SetGaugeWithLabels(
 		keys,
 		val,
-		append([]metrics.Label{NewLabel(MetricLabelNameModule, module)}, globalLabels...),
+		append([]metrics.Label{NewLabel(MetricLabelNameModule, module)}, loadGlobalLabels()...),
 	)
 }
 
 // IncrCounter provides a wrapper functionality for emitting a counter metric with
 // global labels (if any).
 func IncrCounter(val float32, keys ...string) {
-	metrics.IncrCounterWithLabels(keys, val, globalLabels)
+	metrics.IncrCounterWithLabels(keys, val, loadGlobalLabels())
 }
 
 // IncrCounterWithLabels provides a wrapper functionality for emitting a counter
 // metric with global labels (if any) along with the provided labels.
 func IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {
-	metrics.IncrCounterWithLabels(keys, val, append(labels, globalLabels...))
+	metrics.IncrCounterWithLabels(keys, val, append(labels, loadGlobalLabels()...))
 }
 
 // SetGauge provides a wrapper functionality for emitting a gauge metric with
 // global labels (if any).
 func SetGauge(val float32, keys ...string) {
-	metrics.SetGaugeWithLabels(keys, val, globalLabels)
+	metrics.SetGaugeWithLabels(keys, val, loadGlobalLabels())
 }
 
 // SetGaugeWithLabels provides a wrapper functionality for emitting a gauge
 // metric with global labels (if any) along with the provided labels.
 func SetGaugeWithLabels(keys []string, val float32, labels []metrics.Label) {
-	metrics.SetGaugeWithLabels(keys, val, append(labels, globalLabels...))
+	metrics.SetGaugeWithLabels(keys, val, append(labels, loadGlobalLabels()...))
+}
+
+// AddSampleWithLabels provides a wrapper functionality for adding a sample to a
+// summary metric with global labels (if any) along with the provided labels.
+func AddSampleWithLabels(keys []string, val float32, labels []metrics.Label) {
+	metrics.AddSampleWithLabels(keys, val, append(labels, loadGlobalLabels()...))
 }
 
 // MeasureSince provides a wrapper functionality for emitting a a time measure
 // metric with global labels (if any).
 func MeasureSince(start time.Time, keys ...string) {
-	metrics.MeasureSinceWithLabels(keys, start.UTC(), globalLabels)
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), loadGlobalLabels())
+}
+
+// MeasureSinceWithLabels provides a wrapper functionality for emitting a time
+// measure metric with global labels (if any) along with the provided labels.
+func MeasureSinceWithLabels(keys []string, start time.Time, labels []metrics.Label) {
+	metrics.MeasureSinceWithLabels(keys, start.UTC(), append(labels, loadGlobalLabels()...))
+}
+
+// KeeperIncrCounter increments by val the counter of the keeper operations,
+// labeled by module and operation, e.g. "authz" and "grant". Operations are
+// named in snake case after what the keeper does. Additional labels must have a
+// low cardinality. Nothing is emitted if telemetry is disabled.
+func KeeperIncrCounter(module, operation string, val float32, labels ...metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.IncrCounterWithLabels(
+		[]string{MetricKeyKeeperOperations},
+		val,
+		keeperOperationLabels(module, operation, labels),
+	)
+}
+
+// KeeperAddSample adds val, e.g. the amount of tokens delegated, to the summary
+// of the amounts of the keeper operations, labeled by module and operation as
+// with KeeperIncrCounter. Nothing is emitted if telemetry is disabled.
+func KeeperAddSample(module, operation string, val float32, labels ...metrics.Label) {
+	if !IsTelemetryEnabled() {
+		return
+	}
+
+	metrics.AddSampleWithLabels(
+		[]string{MetricKeyKeeperOperationAmounts},
+		val,
+		keeperOperationLabels(module, operation, labels),
+	)
+}
+
+func keeperOperationLabels(module, operation string, labels []metrics.Label) []metrics.Label {
+	global := loadGlobalLabels()
+	all := make([]metrics.Label, 0, 2+len(labels)+len(global))
+	all = append(all, NewLabel(MetricLabelNameModule, module), NewLabel(MetricLabelNameOperation, operation))
+	all = append(all, labels...)
+
+	return append(all, global...)
 }
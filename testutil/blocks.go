@@ -0,0 +1,184 @@
+package testutil
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Blocker runs the BeginBlockers and EndBlockers of the modules of an app, as
+// the BeginBlocker and EndBlocker methods of SimApp.
+type Blocker interface {
+	BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock
+	EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock
+}
+
+// BlockAdvancer advances the block height and time of a test context through
+// the BeginBlockers and EndBlockers of an app, for the tests of what happens
+// over blocks, such as the maturation of unbondings or the end of voting
+// periods. The context of a block is the context of its transactions, its
+// BeginBlockers having run.
+//
+// The headers of the blocks chain the synthesized hashes of their previous
+// ones, and their last commits are signed by the validators of the previous
+// blocks: the validators given to NewBlockAdvancer, updated by the
+// EndBlockers with the delay of Tendermint.
+type BlockAdvancer struct {
+	blocker   Blocker
+	blockTime time.Duration
+
+	// the validators of the current and of the next block, by address
+	validators, nextValidators map[string]abci.Validator
+	absent                     map[string]bool
+}
+
+// NewBlockAdvancer returns a BlockAdvancer running the Begin and EndBlockers of
+// blocker, the time between its blocks being blockTime. The validators sign
+// the blocks from the first one.
+func NewBlockAdvancer(blocker Blocker, blockTime time.Duration, validators ...abci.ValidatorUpdate) *BlockAdvancer {
+	a := &BlockAdvancer{
+		blocker:   blocker,
+		blockTime: blockTime,
+		absent:    make(map[string]bool),
+	}
+	a.validators = applyValidatorUpdates(nil, validators)
+	a.nextValidators = a.validators
+
+	return a
+}
+
+// SetSigning sets whether the validator of consensus address consAddr signs the
+// blocks, e.g. for it to miss them.
+func (a *BlockAdvancer) SetSigning(consAddr sdk.ConsAddress, signing bool) {
+	if signing {
+		delete(a.absent, string(consAddr))
+	} else {
+		a.absent[string(consAddr)] = true
+	}
+}
+
+// NextBlock ends the block of ctx, with its EndBlockers, and begins the next
+// block, with its BeginBlockers, returning the context of the next block.
+func (a *BlockAdvancer) NextBlock(ctx sdk.Context) sdk.Context {
+	res := a.blocker.EndBlocker(ctx, abci.RequestEndBlock{Height: ctx.BlockHeight()})
+
+	lastHash := ctx.HeaderHash()
+	if len(lastHash) == 0 {
+		lastHash = hashHeader(ctx.BlockHeader())
+	}
+
+	header := ctx.BlockHeader()
+	header.Height++
+	header.Time = header.Time.Add(a.blockTime)
+	header.LastBlockId = tmproto.BlockID{Hash: lastHash}
+
+	// the validators of the previous block sign its commit, while the updates
+	// of its EndBlockers apply to the block after the next one
+	votes := a.votes()
+	a.validators, a.nextValidators = a.nextValidators, applyValidatorUpdates(a.nextValidators, res.ValidatorUpdates)
+	header.ProposerAddress = a.proposer(header.Height)
+
+	hash := hashHeader(header)
+	ctx = ctx.WithBlockHeader(header).WithHeaderHash(hash).WithEventManager(sdk.NewEventManager())
+	a.blocker.BeginBlocker(ctx, abci.RequestBeginBlock{
+		Hash:           hash,
+		Header:         header,
+		LastCommitInfo: abci.LastCommitInfo{Votes: votes},
+	})
+
+	return ctx
+}
+
+// AdvanceBlocks advances ctx by n blocks, returning the context of the last
+// one.
+func (a *BlockAdvancer) AdvanceBlocks(ctx sdk.Context, n int) sdk.Context {
+	for i := 0; i < n; i++ {
+		ctx = a.NextBlock(ctx)
+	}
+
+	return ctx
+}
+
+// AdvanceTime advances ctx by blocks until d has elapsed since its block time,
+// returning the context of the first block at or after that time.
+func (a *BlockAdvancer) AdvanceTime(ctx sdk.Context, d time.Duration) sdk.Context {
+	end := ctx.BlockTime().Add(d)
+	for ctx.BlockTime().Before(end) {
+		ctx = a.NextBlock(ctx)
+	}
+
+	return ctx
+}
+
+// votes returns the votes of the validators of the current block, sorted by
+// address.
+func (a *BlockAdvancer) votes() []abci.VoteInfo {
+	votes := make([]abci.VoteInfo, 0, len(a.validators))
+	for addr, val := range a.validators {
+		votes = append(votes, abci.VoteInfo{Validator: val, SignedLastBlock: !a.absent[addr]})
+	}
+	sort.Slice(votes, func(i, j int) bool {
+		return bytes.Compare(votes[i].Validator.Address, votes[j].Validator.Address) < 0
+	})
+
+	return votes
+}
+
+// proposer returns the address of the validator proposing the block at height,
+// the validators proposing in turn.
+func (a *BlockAdvancer) proposer(height int64) []byte {
+	if len(a.validators) == 0 {
+		return nil
+	}
+
+	addrs := make([]string, 0, len(a.validators))
+	for addr := range a.validators {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	return []byte(addrs[height%int64(len(addrs))])
+}
+
+// applyValidatorUpdates returns a copy of validators with the given updates,
+// the validators updated to a zero power being removed.
+func applyValidatorUpdates(validators map[string]abci.Validator, updates []abci.ValidatorUpdate) map[string]abci.Validator {
+	updated := make(map[string]abci.Validator, len(validators)+len(updates))
+	for addr, val := range validators {
+		updated[addr] = val
+	}
+
+	for _, update := range updates {
+		pk, err := cryptoenc.PubKeyFromProto(update.PubKey)
+		if err != nil {
+			panic(err)
+		}
+
+		addr := pk.Address()
+		if update.Power == 0 {
+			delete(updated, string(addr))
+		} else {
+			updated[string(addr)] = abci.Validator{Address: addr, Power: update.Power}
+		}
+	}
+
+	return updated
+}
+
+// hashHeader synthesizes the hash of header.
+func hashHeader(header tmproto.Header) []byte {
+	bz, err := header.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
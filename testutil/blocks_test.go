@@ -0,0 +1,76 @@
+package testutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestBlockAdvancer(t *testing.T) {
+	app := simapp.Setup(false)
+	genesisTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: genesisTime})
+
+	pks := simapp.CreateTestPubKeys(1)
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddr, consAddr := sdk.ValAddress(pks[0].Address()), sdk.ConsAddress(pks[0].Address())
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.UnbondingTime = time.Hour
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, pks[0], 100, true)
+
+	advancer := testutil.NewBlockAdvancer(app, 10*time.Minute)
+	next := advancer.NextBlock(ctx)
+	require.Equal(t, int64(2), next.BlockHeight())
+	require.Equal(t, genesisTime.Add(10*time.Minute), next.BlockTime())
+	require.NotEmpty(t, next.HeaderHash())
+
+	// the headers chain the hashes of their previous ones
+	ctx = advancer.NextBlock(next)
+	require.Equal(t, []byte(next.HeaderHash()), ctx.BlockHeader().LastBlockId.Hash)
+	require.NotEqual(t, next.HeaderHash(), ctx.HeaderHash())
+
+	// the validator bonded by the first EndBlocker signs the commit of the
+	// third block
+	ctx = advancer.NextBlock(ctx)
+	require.Equal(t, []byte(consAddr), ctx.BlockHeader().ProposerAddress)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.IndexOffset)
+	require.Zero(t, info.MissedBlocksCounter)
+
+	advancer.SetSigning(consAddr, false)
+	ctx = advancer.AdvanceBlocks(ctx, 2)
+	require.Equal(t, int64(6), ctx.BlockHeight())
+	info, _ = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+
+	// the unbonding matures once the unbonding time has elapsed
+	delAddr := sdk.AccAddress(valAddr)
+	tstaking.Ctx = ctx
+	tstaking.Undelegate(delAddr, valAddr, app.StakingKeeper.TokensFromConsensusPower(ctx, 10), true)
+	balance := app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom)
+
+	ctx = advancer.AdvanceTime(ctx, time.Hour)
+	require.Equal(t, genesisTime.Add(110*time.Minute), ctx.BlockTime())
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found, "the unbonding matures at the EndBlocker of the block")
+
+	ctx = advancer.NextBlock(ctx)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.False(t, found)
+	require.Equal(t,
+		balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))),
+		app.BankKeeper.GetBalance(ctx, delAddr, sdk.DefaultBondDenom),
+	)
+}
@@ -0,0 +1,100 @@
+// Package fixture builds the keeper of a single module in isolation, the
+// keepers of the other modules it depends on being replaced by the gomock mocks
+// of its expected keepers, generated in the testutil package of each module.
+//
+// The fixture of a module typically wraps a Fixture:
+//
+//	f := fixture.New(t, []string{types.StoreKey}, mint.AppModuleBasic{})
+//	accountKeeper := testutil.NewMockAccountKeeper(f.Ctrl)
+//	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(addr)
+//	k := keeper.NewKeeper(f.Codec(), f.StoreKey(types.StoreKey), f.Subspace(types.ModuleName), ...)
+package fixture
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Fixture is the environment of a keeper built in isolation: a context over
+// fresh in-memory stores, the codecs, the params keeper providing the params
+// subspaces, and the gomock controller of the mocked keepers.
+type Fixture struct {
+	Ctrl           *gomock.Controller
+	Ctx            sdk.Context
+	EncodingConfig simappparams.EncodingConfig
+	ParamsKeeper   paramskeeper.Keeper
+
+	keys map[string]*sdk.KVStoreKey
+}
+
+// New creates a fixture with a KV store for each of storeKeys, in addition to
+// the stores of the params keeper. The codecs register the types of the given
+// modules. The expectations of the mocks created with Ctrl are checked at the
+// end of the test.
+func New(t *testing.T, storeKeys []string, modules ...module.AppModuleBasic) *Fixture {
+	encCfg := simappparams.MakeTestEncodingConfig()
+	std.RegisterLegacyAminoCodec(encCfg.Amino)
+	std.RegisterInterfaces(encCfg.InterfaceRegistry)
+	basics := module.NewBasicManager(modules...)
+	basics.RegisterLegacyAminoCodec(encCfg.Amino)
+	basics.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	keys := sdk.NewKVStoreKeys(append(storeKeys, paramstypes.StoreKey)...)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	for _, key := range keys {
+		cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	for _, tkey := range tkeys {
+		cms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	}
+	if err := cms.LoadLatestVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	return &Fixture{
+		Ctrl:           gomock.NewController(t),
+		Ctx:            sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()),
+		EncodingConfig: encCfg,
+		ParamsKeeper:   paramskeeper.NewKeeper(encCfg.Marshaler, encCfg.Amino, keys[paramstypes.StoreKey], tkeys[paramstypes.TStoreKey]),
+		keys:           keys,
+	}
+}
+
+// Codec returns the codec of the fixture.
+func (f *Fixture) Codec() codec.Codec {
+	return f.EncodingConfig.Marshaler
+}
+
+// StoreKey returns the key of the KV store named name, which must be one of
+// the store keys the fixture was created with.
+func (f *Fixture) StoreKey(name string) *sdk.KVStoreKey {
+	key, ok := f.keys[name]
+	if !ok {
+		panic(fmt.Sprintf("no store %s in the fixture", name))
+	}
+
+	return key
+}
+
+// Subspace returns the params subspace of the module named name. It may only
+// be called once per module, as the subspaces of a params keeper.
+func (f *Fixture) Subspace(name string) paramstypes.Subspace {
+	return f.ParamsKeeper.Subspace(name)
+}
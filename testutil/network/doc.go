@@ -19,6 +19,12 @@ exposed. Due to this exact same limitation, only a single test network can exist
 at a time. A caller must be certain it calls Cleanup after it no longer needs
 the network.
 
+Faults can be injected into a running network to exercise the jailing, missed
+block and downtime paths: StopValidator and StartValidator stop and restart the
+node of a validator, other than the first one, and DropValidatorBlocks makes a
+validator neither propose nor vote while staying in sync. The minimum gas prices
+of each validator can be set with ValidatorMinGasPrices.
+
 A typical testing flow might look like the following:
 
 	type IntegrationTestSuite struct {
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/tendermint/tendermint/node"
+	pvm "github.com/tendermint/tendermint/privval"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// errBlocksDropped is returned to Tendermint when a validator dropping its
+// blocks is asked to sign.
+var errBlocksDropped = errors.New("the validator drops its blocks")
+
+// faultyPrivValidator is a file private validator which refuses to sign while
+// its validator drops its blocks.
+type faultyPrivValidator struct {
+	*pvm.FilePV
+
+	drop int32
+}
+
+func (pv *faultyPrivValidator) SignVote(chainID string, vote *tmproto.Vote) error {
+	if atomic.LoadInt32(&pv.drop) == 1 {
+		return errBlocksDropped
+	}
+
+	return pv.FilePV.SignVote(chainID, vote)
+}
+
+func (pv *faultyPrivValidator) SignProposal(chainID string, proposal *tmproto.Proposal) error {
+	if atomic.LoadInt32(&pv.drop) == 1 {
+		return errBlocksDropped
+	}
+
+	return pv.FilePV.SignProposal(chainID, proposal)
+}
+
+// dbProvider opens the Tendermint databases of the validator once for all its
+// nodes, Tendermint not closing them when a node is stopped.
+func (v *Validator) dbProvider(ctx *node.DBContext) (dbm.DB, error) {
+	if db, ok := v.dbs[ctx.ID]; ok {
+		return db, nil
+	}
+
+	db, err := node.DefaultDBProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.dbs == nil {
+		v.dbs = make(map[string]dbm.DB)
+	}
+	v.dbs[ctx.ID] = db
+
+	return db, nil
+}
+
+// StopValidator stops the Tendermint node of the i-th validator, which misses
+// the blocks of the network until it is restarted with StartValidator. The
+// first validator, serving the RPC, API and gRPC clients of the network, cannot
+// be stopped.
+func (n *Network) StopValidator(i int) error {
+	if i == 0 {
+		return errors.New("the first validator cannot be stopped")
+	}
+
+	val, err := n.validator(i)
+	if err != nil {
+		return err
+	}
+
+	if val.tmNode == nil || !val.tmNode.IsRunning() {
+		return fmt.Errorf("validator %d is not running", i)
+	}
+
+	if err := val.tmNode.Stop(); err != nil {
+		return err
+	}
+	val.tmNode.Wait()
+
+	return nil
+}
+
+// StartValidator restarts the Tendermint node of the i-th validator, stopped
+// with StopValidator. The node keeps its application and catches up with the
+// network from its peers.
+func (n *Network) StartValidator(i int) error {
+	val, err := n.validator(i)
+	if err != nil {
+		return err
+	}
+
+	if val.tmNode != nil && val.tmNode.IsRunning() {
+		return fmt.Errorf("validator %d is already running", i)
+	}
+
+	return startNode(val)
+}
+
+// DropValidatorBlocks sets whether the i-th validator drops its blocks: while
+// dropping them, it stays connected to the network and in sync with it but
+// neither proposes nor votes, missing the blocks of the network as if it was
+// down.
+func (n *Network) DropValidatorBlocks(i int, drop bool) error {
+	val, err := n.validator(i)
+	if err != nil {
+		return err
+	}
+
+	var flag int32
+	if drop {
+		flag = 1
+	}
+	atomic.StoreInt32(&val.privVal.drop, flag)
+
+	return nil
+}
+
+func (n *Network) validator(i int) (*Validator, error) {
+	if i < 0 || i >= len(n.Validators) {
+		return nil, fmt.Errorf("no validator %d in a network of %d validators", i, len(n.Validators))
+	}
+
+	return n.Validators[i], nil
+}
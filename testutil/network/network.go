@@ -91,6 +91,10 @@ type Config struct {
 	CleanupDir       bool                       // remove base temporary directory during cleanup
 	SigningAlgo      string                     // signing algorithm for keys
 	KeyringOptions   []keyring.Option
+
+	// ValidatorMinGasPrices are the minimum gas prices of each validator,
+	// overriding MinGasPrices for the validators they are set for.
+	ValidatorMinGasPrices []string
 }
 
 // DefaultConfig returns a sane default configuration suitable for nearly all
@@ -158,6 +162,9 @@ type (
 		ValAddress sdk.ValAddress
 		RPCClient  tmclient.Client
 
+		app     servertypes.Application
+		privVal *faultyPrivValidator
+		dbs     map[string]dbm.DB
 		tmNode  *node.Node
 		api     *api.Server
 		grpc    *grpc.Server
@@ -201,6 +208,9 @@ func New(t *testing.T, cfg Config) *Network {
 		appCfg := srvconfig.DefaultConfig()
 		appCfg.Pruning = cfg.PruningStrategy
 		appCfg.MinGasPrices = cfg.MinGasPrices
+		if i < len(cfg.ValidatorMinGasPrices) && cfg.ValidatorMinGasPrices[i] != "" {
+			appCfg.MinGasPrices = cfg.ValidatorMinGasPrices[i]
+		}
 		appCfg.API.Enable = true
 		appCfg.API.Swagger = false
 		appCfg.Telemetry.Enabled = false
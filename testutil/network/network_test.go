@@ -4,12 +4,15 @@
 package network_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 type IntegrationTestSuite struct {
@@ -21,7 +24,10 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.T().Log("setting up integration test suite")
 
-	s.network = network.New(s.T(), network.DefaultConfig())
+	cfg := network.DefaultConfig()
+	cfg.ValidatorMinGasPrices = []string{"", "0.1stake"}
+
+	s.network = network.New(s.T(), cfg)
 	s.Require().NotNil(s.network)
 
 	_, err := s.network.WaitForHeight(1)
@@ -38,6 +44,70 @@ func (s *IntegrationTestSuite) TestNetwork_Liveness() {
 	s.Require().NoError(err, "expected to reach 10 blocks; got %d", h)
 }
 
+func (s *IntegrationTestSuite) TestNetwork_FaultInjection() {
+	s.Require().Error(s.network.StopValidator(0))
+	s.Require().Error(s.network.DropValidatorBlocks(len(s.network.Validators), true))
+
+	// a validator dropping its blocks stays in sync but misses them
+	s.Require().NoError(s.network.DropValidatorBlocks(3, true))
+	h := s.waitForBlocks(2)
+	s.Require().False(s.signed(h, 3))
+
+	res, err := slashingtypes.NewQueryClient(s.network.Validators[0].ClientCtx).SigningInfo(
+		context.Background(),
+		&slashingtypes.QuerySigningInfoRequest{ConsAddress: sdk.ConsAddress(s.network.Validators[3].PubKey.Address()).String()},
+	)
+	s.Require().NoError(err)
+	s.Require().Positive(res.ValSigningInfo.MissedBlocksCounter)
+
+	s.Require().NoError(s.network.DropValidatorBlocks(3, false))
+	s.Require().Eventually(func() bool { return s.signed(s.waitForBlocks(1), 3) }, time.Minute, time.Second)
+
+	// a stopped validator misses the blocks until it is restarted
+	s.Require().NoError(s.network.StopValidator(2))
+	s.Require().Error(s.network.StopValidator(2))
+	h = s.waitForBlocks(2)
+	s.Require().False(s.signed(h, 2))
+
+	s.Require().NoError(s.network.StartValidator(2))
+	s.Require().Error(s.network.StartValidator(2))
+	s.Require().Eventually(func() bool { return s.signed(s.waitForBlocks(1), 2) }, time.Minute, time.Second)
+}
+
+func (s *IntegrationTestSuite) TestNetwork_ValidatorMinGasPrices() {
+	s.Require().Equal(s.network.Config.MinGasPrices, s.network.Validators[0].AppConfig.MinGasPrices)
+	s.Require().Equal("0.1stake", s.network.Validators[1].AppConfig.MinGasPrices)
+	s.Require().Equal(s.network.Config.MinGasPrices, s.network.Validators[2].AppConfig.MinGasPrices)
+}
+
+// waitForBlocks waits for n blocks to be committed and returns the latest
+// height.
+func (s *IntegrationTestSuite) waitForBlocks(n int64) int64 {
+	h, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+
+	h, err = s.network.WaitForHeightWithTimeout(h+n, time.Minute)
+	s.Require().NoError(err)
+
+	return h
+}
+
+// signed returns whether the commit of the block at height h is signed by the
+// i-th validator.
+func (s *IntegrationTestSuite) signed(h int64, i int) bool {
+	commit, err := s.network.Validators[0].RPCClient.Commit(context.Background(), &h)
+	s.Require().NoError(err)
+
+	addr := s.network.Validators[i].PubKey.Address()
+	for _, sig := range commit.Commit.Signatures {
+		if sig.ValidatorAddress.String() == addr.String() {
+			return sig.ForBlock()
+		}
+	}
+
+	return false
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
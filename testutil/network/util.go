@@ -25,43 +25,21 @@ import (
 
 func startInProcess(cfg Config, val *Validator) error {
 	logger := val.Ctx.Logger
-	tmCfg := val.Ctx.Config
-	tmCfg.Instrumentation.Prometheus = false
+	val.Ctx.Config.Instrumentation.Prometheus = false
 
 	if err := val.AppConfig.ValidateBasic(); err != nil {
 		return err
 	}
 
-	nodeKey, err := p2p.LoadOrGenNodeKey(tmCfg.NodeKeyFile())
-	if err != nil {
-		return err
-	}
-
 	app := cfg.AppConstructor(*val)
+	val.app = app
 
-	genDocProvider := node.DefaultGenesisDocProviderFunc(tmCfg)
-	tmNode, err := node.NewNode(
-		tmCfg,
-		pvm.LoadOrGenFilePV(tmCfg.PrivValidatorKeyFile(), tmCfg.PrivValidatorStateFile()),
-		nodeKey,
-		proxy.NewLocalClientCreator(app),
-		genDocProvider,
-		node.DefaultDBProvider,
-		node.DefaultMetricsProvider(tmCfg.Instrumentation),
-		logger.With("module", val.Moniker),
-	)
-	if err != nil {
-		return err
-	}
-
-	if err := tmNode.Start(); err != nil {
+	if err := startNode(val); err != nil {
 		return err
 	}
 
-	val.tmNode = tmNode
-
 	if val.RPCAddress != "" {
-		val.RPCClient = local.New(tmNode)
+		val.RPCClient = local.New(val.tmNode)
 	}
 
 	// We'll need a RPC client if the validator exposes a gRPC or REST endpoint.
@@ -98,21 +76,51 @@ func startInProcess(cfg Config, val *Validator) error {
 	}
 
 	if val.AppConfig.GRPC.Enable {
-		grpcSrv, err := servergrpc.StartGRPCServer(val.ClientCtx, app, val.AppConfig.GRPC.Address)
+		grpcSrv, grpcWebSrv, err := servergrpc.StartGRPCServerAndWeb(val.ClientCtx, app, *val.AppConfig)
 		if err != nil {
 			return err
 		}
 
 		val.grpc = grpcSrv
+		val.grpcWeb = grpcWebSrv
+	}
 
-		if val.AppConfig.GRPCWeb.Enable {
-			val.grpcWeb, err = servergrpc.StartGRPCWeb(grpcSrv, *val.AppConfig)
-			if err != nil {
-				return err
-			}
-		}
+	return nil
+}
+
+// startNode starts a Tendermint node running the application of val.
+func startNode(val *Validator) error {
+	tmCfg := val.Ctx.Config
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(tmCfg.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	if val.privVal == nil {
+		val.privVal = &faultyPrivValidator{FilePV: pvm.LoadOrGenFilePV(tmCfg.PrivValidatorKeyFile(), tmCfg.PrivValidatorStateFile())}
+	}
+
+	genDocProvider := node.DefaultGenesisDocProviderFunc(tmCfg)
+	tmNode, err := node.NewNode(
+		tmCfg,
+		val.privVal,
+		nodeKey,
+		proxy.NewLocalClientCreator(val.app),
+		genDocProvider,
+		val.dbProvider,
+		node.DefaultMetricsProvider(tmCfg.Instrumentation),
+		val.Ctx.Logger.With("module", val.Moniker),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tmNode.Start(); err != nil {
+		return err
 	}
 
+	val.tmNode = tmNode
 	return nil
 }
 
@@ -0,0 +1,67 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UpdateParamsTest describes the MsgUpdateParams of a module, for the checks
+// shared by the modules whose params are updated with the authority of the
+// module: the authority is required, the params are validated, and the last
+// changed height of the params is the height of their update.
+type UpdateParamsTest struct {
+	Authority string
+
+	// Params are valid params differing from the current params of the
+	// module, and Invalid params failing their validation.
+	Params  interface{}
+	Invalid interface{}
+
+	// Update sends a MsgUpdateParams of the params signed by the authority,
+	// and Query returns the params of the module with their last changed
+	// height.
+	Update func(ctx sdk.Context, authority string, params interface{}) error
+	Query  func(ctx sdk.Context) (params interface{}, lastChangedHeight int64)
+}
+
+// RunUpdateParamsTest runs the shared MsgUpdateParams checks of a module, each
+// on a branch of the state of ctx.
+func RunUpdateParamsTest(t *testing.T, ctx sdk.Context, test UpdateParamsTest) {
+	current, lastChangedHeight := test.Query(ctx)
+	require.NotEqual(t, test.Params, current, "the params must differ from the current params")
+	require.NotEqual(t, ctx.BlockHeight(), lastChangedHeight, "the params must not have changed at the height of ctx")
+
+	testCases := []struct {
+		name      string
+		authority string
+		params    interface{}
+		expErr    *sdkerrors.Error
+	}{
+		{"not the authority", sdk.AccAddress("addr1_______________").String(), test.Params, sdkerrors.ErrUnauthorized},
+		{"invalid params", test.Authority, test.Invalid, sdkerrors.ErrInvalidRequest},
+		{"valid params", test.Authority, test.Params, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+
+			err := test.Update(ctx, tc.authority, tc.params)
+			params, height := test.Query(ctx)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, current, params)
+				require.Equal(t, lastChangedHeight, height)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.params, params)
+			require.Equal(t, ctx.BlockHeight(), height)
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -59,13 +61,50 @@ func Compose(typ string, subAddresses []Addressable) ([]byte, error) {
 }
 
 // Module is a specialized version of a composed address for modules. Each module account
-// is constructed from a module name and module account key.
-func Module(moduleName string, key []byte) []byte {
-	mKey := append([]byte(moduleName), 0)
-	return Hash("module", append(mKey, key...))
+// is constructed from a module name and module account key, and its sub accounts are
+// derived from it with the following derivation keys, in order:
+//
+//	Module(name, key1, key2) == Derive(Module(name, key1), key2)
+//
+// Without derivation keys, it returns the legacy 20 bytes address of the module account
+// of the module name, see x/auth/types.NewModuleAddress.
+func Module(moduleName string, derivationKeys ...[]byte) []byte {
+	mKey := []byte(moduleName)
+	if len(derivationKeys) == 0 {
+		return crypto.AddressHash(mKey)
+	}
+
+	// the null byte separates the module name from the key, as it is not part of valid
+	// module names
+	mKey = append(mKey, 0)
+	addr := Hash("module", append(mKey, derivationKeys[0]...))
+	for _, k := range derivationKeys[1:] {
+		addr = Derive(addr, k)
+	}
+
+	return addr
 }
 
 // Derive derives a new address from the main `address` and a derivation `key`.
 func Derive(address []byte, key []byte) []byte {
 	return Hash(conv.UnsafeBytesToStr(address), key)
 }
+
+// DeriveWithPrefix derives a new address from the main `address` and a derivation `key`
+// scoped by a non empty `prefix`, e.g. the kind of the derived accounts, so that the
+// addresses derived with different prefixes from the same keys differ. The prefix is
+// length prefixed: the address derived with the prefix "ab" and the key "c" is not the
+// one derived with the prefix "a" and the key "bc". An address should not derive addresses
+// both with and without prefixes, as Derive(address, LengthPrefix(prefix) + key) is the
+// address derived with the prefix.
+func DeriveWithPrefix(address []byte, prefix string, key []byte) ([]byte, error) {
+	if len(prefix) == 0 {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "empty address derivation prefix")
+	}
+	p, err := LengthPrefix([]byte(prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	return Derive(address, append(p, key...)), nil
+}
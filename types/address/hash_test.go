@@ -2,10 +2,12 @@ package address
 
 import (
 	"crypto/sha256"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 func TestAddressSuite(t *testing.T) {
@@ -73,6 +75,17 @@ func (suite *AddressSuite) TestModule() {
 	addr3 := Module(modName, []byte{1, 2, 3})
 	assert.NotEqual(addr, addr3, "changing key must change address")
 	assert.NotEqual(addr2, addr3, "changing key must change address")
+
+	// module account without derivation keys
+	assert.Equal(crypto.AddressHash([]byte(modName)).Bytes(), Module(modName), "must be the legacy module address")
+
+	// module sub accounts
+	sub := Module(modName, key, []byte{3})
+	assert.Len(sub, Len, "must have address length")
+	assert.Equal(Derive(addr, []byte{3}), sub, "sub account must be derived from the module account")
+	assert.Equal(Derive(sub, []byte{4}), Module(modName, key, []byte{3}, []byte{4}), "sub accounts must be derived in order")
+	assert.NotEqual(addr3, sub, "derivation keys must not be concatenated")
+	assert.NotEqual(Module(modName, []byte{3}, key), sub, "changing the order of the keys must change address")
 }
 
 func (suite *AddressSuite) TestDerive() {
@@ -90,6 +103,60 @@ func (suite *AddressSuite) TestDerive() {
 	assert.NotEqual(d2, d3)
 }
 
+func (suite *AddressSuite) TestDeriveWithPrefix() {
+	assert := suite.Assert()
+	var addr, key = []byte{1, 2}, []byte{3, 4}
+	d1, err := DeriveWithPrefix(addr, "prefix", key)
+	assert.NoError(err)
+	assert.Len(d1, Len)
+	assert.NotEqual(Derive(addr, key), d1, "prefix must change address")
+
+	d2, err := DeriveWithPrefix(addr, "other", key)
+	assert.NoError(err)
+	assert.NotEqual(d1, d2, "changing prefix must change address")
+
+	// the prefix is not concatenated with the key
+	d3, err := DeriveWithPrefix(addr, "ab", []byte("c"))
+	assert.NoError(err)
+	d4, err := DeriveWithPrefix(addr, "a", []byte("bc"))
+	assert.NoError(err)
+	assert.NotEqual(d3, d4, "prefix and key must not be concatenated")
+
+	_, err = DeriveWithPrefix(addr, "", key)
+	assert.Error(err)
+}
+
+func (suite *AddressSuite) TestDerivationCollisions() {
+	require := suite.Require()
+	keys := [][]byte{nil, {0}, {1}, {0, 1}, {1, 0}, {1, 1}, []byte("mod"), []byte("mod\x00")}
+	modules := []string{"a", "ab", "mod", "mod2"}
+	prefixes := []string{"p", "pp", "mod"}
+
+	// addrs records the derivation of each address, to report the colliding ones
+	addrs := map[string]string{}
+	add := func(addr []byte, derivation string) {
+		require.Len(addr, Len, derivation)
+		prev, ok := addrs[string(addr)]
+		require.False(ok, "%s and %s derive the same address", prev, derivation)
+		addrs[string(addr)] = derivation
+	}
+
+	for _, m := range modules {
+		for _, k1 := range keys {
+			module := Module(m, k1)
+			add(module, fmt.Sprintf("Module(%q, %v)", m, k1))
+			for _, k2 := range keys {
+				add(Module(m, k1, k2), fmt.Sprintf("Module(%q, %v, %v)", m, k1, k2))
+				for _, p := range prefixes {
+					d, err := DeriveWithPrefix(module, p, k2)
+					require.NoError(err)
+					add(d, fmt.Sprintf("DeriveWithPrefix(Module(%q, %v), %q, %v)", m, k1, p, k2))
+				}
+			}
+		}
+	}
+}
+
 type addrMock struct {
 	Addr []byte
 }
@@ -0,0 +1,367 @@
+package types
+
+import (
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// propertyTestConfig is the configuration of the property tests, each checking
+// its property against randomly generated arguments. On failure, quick reports
+// the arguments falsifying the property.
+var propertyTestConfig = &quick.Config{MaxCount: 2000}
+
+// randBigInt returns a random big.Int, positive or negative, of a bit length
+// chosen uniformly up to maxBits, so that the values near the bounds are as
+// likely as the small ones.
+func randBigInt(r *rand.Rand, maxBits int) *big.Int {
+	bits := r.Intn(maxBits + 1)
+	i := new(big.Int)
+	if bits > 0 {
+		i.Rand(r, new(big.Int).Lsh(big.NewInt(1), uint(bits)))
+		i.SetBit(i, bits-1, 1)
+	}
+
+	if r.Intn(2) == 0 {
+		i.Neg(i)
+	}
+
+	return i
+}
+
+// arbitraryInt is an Int generated up to the bounds of Ints.
+type arbitraryInt struct{ Int }
+
+func (arbitraryInt) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(arbitraryInt{NewIntFromBigInt(randBigInt(r, maxBitLen))})
+}
+
+// arbitraryDec is a Dec of up to maxBitLen bits, the bound of the Decs parsed
+// from strings and of the Ints they are rounded to.
+type arbitraryDec struct{ Dec }
+
+func (arbitraryDec) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(arbitraryDec{NewDecFromBigIntWithPrec(randBigInt(r, maxBitLen), Precision)})
+}
+
+// wideDec is a Dec generated up to the bounds of the arithmetic of Decs, of
+// maxDecBitLen bits.
+type wideDec struct{ Dec }
+
+func (wideDec) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(wideDec{NewDecFromBigIntWithPrec(randBigInt(r, maxDecBitLen), Precision)})
+}
+
+// smallDec is a Dec small enough for the sums and products of a few of them
+// not to overflow.
+type smallDec struct{ Dec }
+
+func (smallDec) Generate(r *rand.Rand, _ int) reflect.Value {
+	return reflect.ValueOf(smallDec{NewDecFromBigIntWithPrec(randBigInt(r, maxDecBitLen/3), Precision)})
+}
+
+// arbitraryCoins are valid Coins of a few denoms, possibly empty.
+type arbitraryCoins struct{ Coins }
+
+func (arbitraryCoins) Generate(r *rand.Rand, _ int) reflect.Value {
+	coins := Coins{}
+	for _, denom := range []string{"atom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "stake", "uosmo"} {
+		if r.Intn(2) == 0 {
+			amount := new(big.Int).Abs(randBigInt(r, 128))
+			coins = append(coins, NewCoin(denom, NewIntFromBigInt(amount.Add(amount, big.NewInt(1)))))
+		}
+	}
+
+	return reflect.ValueOf(arbitraryCoins{coins})
+}
+
+// panics reports whether f panics.
+func panics(f func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+
+	f()
+	return false
+}
+
+// checkResult checks an operation against its reference: either the operation
+// panics and its reference result overflows, or both are equal.
+func checkResult(op func() Int, ref *big.Int, overflows bool) bool {
+	var res Int
+	if panics(func() { res = op() }) {
+		return overflows
+	}
+
+	return !overflows && res.BigInt().Cmp(ref) == 0
+}
+
+type arithmeticPropertyTestSuite struct {
+	suite.Suite
+}
+
+func TestArithmeticPropertyTestSuite(t *testing.T) {
+	suite.Run(t, new(arithmeticPropertyTestSuite))
+}
+
+func (s *arithmeticPropertyTestSuite) SetupSuite() {
+	s.T().Parallel()
+}
+
+func (s *arithmeticPropertyTestSuite) check(f interface{}) {
+	s.Require().NoError(quick.Check(f, propertyTestConfig))
+}
+
+func (s *arithmeticPropertyTestSuite) TestIntArithmeticMatchesBigInt() {
+	s.check(func(a, b arbitraryInt) bool {
+		sum := new(big.Int).Add(a.i, b.i)
+		return checkResult(func() Int { return a.Add(b.Int) }, sum, sum.BitLen() > maxBitLen)
+	})
+
+	s.check(func(a, b arbitraryInt) bool {
+		diff := new(big.Int).Sub(a.i, b.i)
+		return checkResult(func() Int { return a.Sub(b.Int) }, diff, diff.BitLen() > maxBitLen)
+	})
+
+	// the products are deemed overflowing from the bit lengths of their
+	// factors, even if they would fit
+	s.check(func(a, b arbitraryInt) bool {
+		prod := new(big.Int).Mul(a.i, b.i)
+		overflows := a.i.BitLen()+b.i.BitLen()-1 > maxBitLen || prod.BitLen() > maxBitLen
+		return checkResult(func() Int { return a.Mul(b.Int) }, prod, overflows)
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestIntOperationsDoNotMutate() {
+	s.check(func(a, b arbitraryInt) bool {
+		a0, b0 := new(big.Int).Set(a.i), new(big.Int).Set(b.i)
+		panics(func() { a.Add(b.Int) })
+		panics(func() { a.Sub(b.Int) })
+		panics(func() { a.Mul(b.Int) })
+		panics(func() { a.Quo(b.Int) })
+		panics(func() { a.Mod(b.Int) })
+		return a.i.Cmp(a0) == 0 && b.i.Cmp(b0) == 0
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestIntDivision() {
+	s.check(func(a, b arbitraryInt) bool {
+		if b.IsZero() {
+			return panics(func() { a.Quo(b.Int) }) && panics(func() { a.Mod(b.Int) })
+		}
+
+		// the quotient is truncated towards zero: a = q*b + r with |r| < |b|,
+		// the remainder having the sign of a
+		q := a.Quo(b.Int)
+		r := new(big.Int).Sub(a.i, new(big.Int).Mul(q.i, b.i))
+		if r.CmpAbs(b.i) >= 0 || (r.Sign() != 0 && r.Sign() != a.i.Sign()) {
+			return false
+		}
+
+		// the modulo is euclidean: 0 <= m < |b| and b divides a - m
+		m := a.Mod(b.Int)
+		return !m.IsNegative() && m.i.CmpAbs(b.i) < 0 &&
+			new(big.Int).Rem(new(big.Int).Sub(a.i, m.i), b.i).Sign() == 0
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestIntRoundTrips() {
+	s.check(func(a arbitraryInt) bool {
+		parsed, ok := NewIntFromString(a.String())
+		if !ok || !parsed.Equal(a.Int) {
+			return false
+		}
+
+		bz, err := a.Marshal()
+		if err != nil {
+			return false
+		}
+		var unmarshaled Int
+		if err := unmarshaled.Unmarshal(bz); err != nil || !unmarshaled.Equal(a.Int) {
+			return false
+		}
+
+		bz, err = a.MarshalJSON()
+		if err != nil {
+			return false
+		}
+		var unmarshaledJSON Int
+		return unmarshaledJSON.UnmarshalJSON(bz) == nil && unmarshaledJSON.Equal(a.Int)
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestDecAddition() {
+	s.check(func(a, b smallDec) bool {
+		return a.Add(b.Dec).Equal(b.Add(a.Dec))
+	})
+
+	s.check(func(a, b, c smallDec) bool {
+		return a.Add(b.Dec).Add(c.Dec).Equal(a.Add(b.Add(c.Dec)))
+	})
+
+	s.check(func(a, b smallDec) bool {
+		return a.Add(b.Dec).Sub(b.Dec).Equal(a.Dec)
+	})
+
+	s.check(func(a, b wideDec) bool {
+		sum := new(big.Int).Add(a.i, b.i)
+		var res Dec
+		if panics(func() { res = a.Add(b.Dec) }) {
+			return sum.BitLen() > maxDecBitLen
+		}
+		return sum.BitLen() <= maxDecBitLen && res.i.Cmp(sum) == 0
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestDecMultiplicationRounding() {
+	s.check(func(a, b smallDec) bool {
+		if !a.Mul(b.Dec).Equal(b.Mul(a.Dec)) {
+			return false
+		}
+
+		// the exact product, of twice the precision, is rounded half to even
+		// by Mul and truncated towards zero by MulTruncate
+		exact := new(big.Int).Mul(a.i, b.i)
+		quo, rem := new(big.Int).QuoRem(exact, precisionReuse, new(big.Int))
+		if a.MulTruncate(b.Dec).i.Cmp(quo) != 0 {
+			return false
+		}
+
+		rounded := new(big.Int).Set(quo)
+		switch cmp := new(big.Int).Abs(rem).Cmp(fivePrecision); {
+		case cmp > 0, cmp == 0 && quo.Bit(0) == 1:
+			if exact.Sign() < 0 {
+				rounded.Sub(rounded, oneInt)
+			} else {
+				rounded.Add(rounded, oneInt)
+			}
+		}
+
+		return a.Mul(b.Dec).i.Cmp(rounded) == 0
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestDecDivisionRounding() {
+	s.check(func(a, b smallDec) bool {
+		if b.IsZero() {
+			return panics(func() { a.Quo(b.Dec) }) && panics(func() { a.QuoTruncate(b.Dec) })
+		}
+
+		// QuoTruncate truncates the exact quotient towards zero, and Quo is
+		// within a unit of the last decimal of it
+		exact := new(big.Int).Mul(a.i, precisionReuse)
+		exact.Quo(exact, b.i)
+		truncated := a.QuoTruncate(b.Dec)
+		if truncated.i.Cmp(exact) != 0 {
+			return false
+		}
+
+		return new(big.Int).Sub(a.Quo(b.Dec).i, truncated.i).CmpAbs(oneInt) <= 0
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestDecIntegerRounding() {
+	s.check(func(d arbitraryDec) bool {
+		one := OneDec()
+		half := NewDecWithPrec(5, 1)
+
+		// the truncation is towards zero, the ceiling above and the rounding
+		// to the nearest integer
+		truncated := d.TruncateDec()
+		if truncated.Abs().GT(d.Abs()) || d.Sub(truncated).Abs().GTE(one) {
+			return false
+		}
+
+		var ceil Dec
+		if !panics(func() { ceil = d.Ceil() }) && (ceil.LT(d.Dec) || ceil.Sub(d.Dec).GTE(one) || !ceil.IsInteger()) {
+			return false
+		}
+
+		return d.Sub(d.RoundInt().ToDec()).Abs().LTE(half)
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestDecRoundTrips() {
+	s.check(func(d arbitraryDec) bool {
+		parsed, err := NewDecFromStr(d.String())
+		if err != nil || !parsed.Equal(d.Dec) {
+			return false
+		}
+
+		bz, err := d.Marshal()
+		if err != nil {
+			return false
+		}
+		var unmarshaled Dec
+		if err := unmarshaled.Unmarshal(bz); err != nil || !unmarshaled.Equal(d.Dec) {
+			return false
+		}
+
+		bz, err = d.MarshalJSON()
+		if err != nil {
+			return false
+		}
+		var unmarshaledJSON Dec
+		return unmarshaledJSON.UnmarshalJSON(bz) == nil && unmarshaledJSON.Equal(d.Dec)
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestCoinsAddition() {
+	s.check(func(a, b arbitraryCoins) bool {
+		sum := a.Add(b.Coins...)
+		return sum.IsValid() && sum.IsEqual(b.Add(a.Coins...)) && sum.IsAllGTE(a.Coins)
+	})
+
+	s.check(func(a, b, c arbitraryCoins) bool {
+		return a.Add(b.Coins...).Add(c.Coins...).IsEqual(a.Add(b.Add(c.Coins...)...))
+	})
+
+	s.check(func(a, b arbitraryCoins) bool {
+		return a.Add(b.Coins...).Sub(b.Coins).IsEqual(a.Coins)
+	})
+
+	// subtracting more than the coins is an error, not a negative amount
+	s.check(func(a, b arbitraryCoins) bool {
+		diff, hasNeg := a.SafeSub(a.Add(b.Coins...).Add(NewInt64Coin("stake", 1)))
+		return hasNeg && diff.IsAnyNegative()
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestCoinsRoundTrips() {
+	s.check(func(a arbitraryCoins) bool {
+		parsed, err := ParseCoinsNormalized(a.String())
+		if err != nil || !parsed.IsEqual(a.Coins) {
+			return false
+		}
+
+		for _, coin := range a.Coins {
+			parsedCoin, err := ParseCoinNormalized(coin.String())
+			if err != nil || !parsedCoin.IsEqual(coin) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+func (s *arithmeticPropertyTestSuite) TestDecCoinsTruncation() {
+	s.check(func(a arbitraryCoins, frac smallDec) bool {
+		decCoins := NewDecCoinsFromCoins(a.Coins...).MulDecTruncate(frac.Abs().Add(OneDec()))
+
+		// the truncated coins and the change add up to the decimal coins, the
+		// change being below a unit of each denom
+		truncated, change := decCoins.TruncateDecimal()
+		for _, coin := range change {
+			if coin.Amount.GTE(OneDec()) {
+				return false
+			}
+		}
+
+		return NewDecCoinsFromCoins(truncated...).Add(change...).IsEqual(decCoins)
+	})
+}
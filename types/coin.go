@@ -44,6 +44,10 @@ func (coin Coin) Validate() error {
 		return err
 	}
 
+	if coin.IsNil() {
+		return fmt.Errorf("nil coin amount")
+	}
+
 	if coin.Amount.IsNegative() {
 		return fmt.Errorf("negative coin amount: %v", coin.Amount)
 	}
@@ -215,6 +219,9 @@ func (coins Coins) Validate() error {
 		if err := ValidateDenom(coins[0].Denom); err != nil {
 			return err
 		}
+		if coins[0].IsNil() {
+			return fmt.Errorf("coin %s amount is nil", coins[0].Denom)
+		}
 		if !coins[0].IsPositive() {
 			return fmt.Errorf("coin %s amount is not positive", coins[0])
 		}
@@ -240,6 +247,9 @@ func (coins Coins) Validate() error {
 			if coin.Denom <= lowDenom {
 				return fmt.Errorf("denomination %s is not sorted", coin.Denom)
 			}
+			if coin.IsNil() {
+				return fmt.Errorf("coin %s amount is nil", coin.Denom)
+			}
 			if !coin.IsPositive() {
 				return fmt.Errorf("coin %s amount is not positive", coin.Denom)
 			}
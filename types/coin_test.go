@@ -88,6 +88,7 @@ func (s *coinTestSuite) TestCoinIsValid() {
 		expectPass bool
 	}{
 		{sdk.Coin{testDenom1, sdk.NewInt(-1)}, false},
+		{sdk.Coin{testDenom1, sdk.Int{}}, false},
 		{sdk.Coin{testDenom1, sdk.NewInt(0)}, true},
 		{sdk.Coin{testDenom1, sdk.OneInt()}, true},
 		{sdk.Coin{"Atom", sdk.OneInt()}, true},
@@ -635,6 +636,21 @@ func (s *coinTestSuite) TestCoins_Validate() {
 				{"mineral", sdk.OneInt()},
 			},
 			false,
+		},
+		{
+			"nil amount (1)",
+			sdk.Coins{
+				{"gas", sdk.Int{}},
+			},
+			false,
+		},
+		{
+			"nil amount (2)",
+			sdk.Coins{
+				{"gas", sdk.OneInt()},
+				{"mineral", sdk.Int{}},
+			},
+			false,
 		}, {
 			"duplicate denomination",
 			sdk.Coins{
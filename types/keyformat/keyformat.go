@@ -0,0 +1,316 @@
+// Package keyformat provides a registry where modules declare the layout of
+// the keys of their stores, and the types of the values stored under them, to
+// render raw store entries in a human-readable form.
+package keyformat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SegmentType is the type of the data a key segment holds.
+type SegmentType int
+
+const (
+	// TypeBytes is raw bytes, rendered as hex.
+	TypeBytes SegmentType = iota
+	// TypeString is a string.
+	TypeString
+	// TypeUint64 is a big endian uint64.
+	TypeUint64
+	// TypeUint64LE is a little endian uint64.
+	TypeUint64LE
+	// TypeTime is a time formatted by sdk.FormatTimeBytes.
+	TypeTime
+	// TypeAccAddress is an account address, rendered in bech32.
+	TypeAccAddress
+	// TypeValAddress is a validator operator address, rendered in bech32.
+	TypeValAddress
+	// TypeConsAddress is a validator consensus address, rendered in bech32.
+	TypeConsAddress
+)
+
+// Segment is a named part of a key following its prefix.
+type Segment struct {
+	name      string
+	typ       SegmentType
+	size      int
+	lengthPfx bool
+}
+
+// Bytes returns a segment of size raw bytes, or of the rest of the key if size
+// is 0.
+func Bytes(name string, size int) Segment {
+	return Segment{name: name, typ: TypeBytes, size: size}
+}
+
+// String returns a segment of a string taking the rest of the key.
+func String(name string) Segment {
+	return Segment{name: name, typ: TypeString}
+}
+
+// Uint64 returns a segment of a big endian uint64.
+func Uint64(name string) Segment {
+	return Segment{name: name, typ: TypeUint64, size: 8}
+}
+
+// Uint64LE returns a segment of a little endian uint64.
+func Uint64LE(name string) Segment {
+	return Segment{name: name, typ: TypeUint64LE, size: 8}
+}
+
+// Time returns a segment of a time formatted by sdk.FormatTimeBytes.
+func Time(name string) Segment {
+	return Segment{name: name, typ: TypeTime, size: len(sdk.SortableTimeFormat)}
+}
+
+// AccAddress returns a segment of a length prefixed account address, as
+// built by address.MustLengthPrefix.
+func AccAddress(name string) Segment {
+	return Segment{name: name, typ: TypeAccAddress, lengthPfx: true}
+}
+
+// ValAddress returns a segment of a length prefixed validator operator
+// address.
+func ValAddress(name string) Segment {
+	return Segment{name: name, typ: TypeValAddress, lengthPfx: true}
+}
+
+// ConsAddress returns a segment of a length prefixed validator consensus
+// address.
+func ConsAddress(name string) Segment {
+	return Segment{name: name, typ: TypeConsAddress, lengthPfx: true}
+}
+
+// LengthPrefixed returns the segment length prefixed, as the strings built by
+// address.MustLengthPrefix followed by other segments.
+func (s Segment) LengthPrefixed() Segment {
+	s.lengthPfx = true
+	return s
+}
+
+// Unprefixed returns the segment taking the rest of the key instead of being
+// length prefixed, as the keys ending with an address without its length.
+func (s Segment) Unprefixed() Segment {
+	s.lengthPfx = false
+	return s
+}
+
+// decode decodes the segment at the start of key, and returns its rendering
+// and the number of bytes it takes.
+func (s Segment) decode(key []byte) (string, int, error) {
+	var bz []byte
+	switch {
+	case s.lengthPfx:
+		if len(key) == 0 || len(key) < 1+int(key[0]) {
+			return "", 0, fmt.Errorf("segment %s: key too short for its length prefix", s.name)
+		}
+		bz = key[1 : 1+int(key[0])]
+	case s.size > 0:
+		if len(key) < s.size {
+			return "", 0, fmt.Errorf("segment %s: expected %d bytes, got %d", s.name, s.size, len(key))
+		}
+		bz = key[:s.size]
+	default:
+		bz = key
+	}
+	n := len(bz)
+	if s.lengthPfx {
+		n++
+	}
+
+	switch s.typ {
+	case TypeString:
+		return string(bz), n, nil
+	case TypeUint64:
+		return fmt.Sprint(binary.BigEndian.Uint64(bz)), n, nil
+	case TypeUint64LE:
+		return fmt.Sprint(binary.LittleEndian.Uint64(bz)), n, nil
+	case TypeTime:
+		t, err := sdk.ParseTimeBytes(bz)
+		if err != nil {
+			return "", 0, fmt.Errorf("segment %s: %w", s.name, err)
+		}
+		return t.Format(time.RFC3339Nano), n, nil
+	case TypeAccAddress:
+		return sdk.AccAddress(bz).String(), n, nil
+	case TypeValAddress:
+		return sdk.ValAddress(bz).String(), n, nil
+	case TypeConsAddress:
+		return sdk.ConsAddress(bz).String(), n, nil
+	default:
+		return hex.EncodeToString(bz), n, nil
+	}
+}
+
+// ValueDecoder renders a value in a form marshalable to JSON.
+type ValueDecoder func(cdc codec.Codec, value []byte) (interface{}, error)
+
+// ProtoValue returns a ValueDecoder rendering the values of the type of msg in
+// proto JSON. Values marshaled with codec.Codec.MarshalInterface are decoded
+// with a *types.Any.
+func ProtoValue(msg codec.ProtoMarshaler) ValueDecoder {
+	typ := reflect.TypeOf(msg).Elem()
+	return func(cdc codec.Codec, value []byte) (interface{}, error) {
+		msg := reflect.New(typ).Interface().(codec.ProtoMarshaler)
+		if err := cdc.Unmarshal(value, msg); err != nil {
+			return nil, err
+		}
+
+		bz, err := cdc.MarshalJSON(msg)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(bz), nil
+	}
+}
+
+// SegmentValue returns a ValueDecoder rendering the values holding a single
+// segment s, such as a raw address or a big endian uint64.
+func SegmentValue(s Segment) ValueDecoder {
+	s = s.Unprefixed()
+	return func(_ codec.Codec, value []byte) (interface{}, error) {
+		rendered, n, err := s.decode(value)
+		if err != nil {
+			return nil, err
+		}
+		if n != len(value) {
+			return nil, fmt.Errorf("expected %d bytes, got %d", n, len(value))
+		}
+		return rendered, nil
+	}
+}
+
+// KeyFormat is the layout of the keys starting with Prefix: the segments
+// following the prefix, and the decoder of their values. The keys of an index
+// with empty values have no decoder.
+type KeyFormat struct {
+	Name     string
+	Prefix   []byte
+	Segments []Segment
+	Value    ValueDecoder
+}
+
+// DecodedSegment is the rendering of a segment of a key.
+type DecodedSegment struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Decoded is the human-readable rendering of a store key and, optionally, of
+// its value.
+type Decoded struct {
+	Store    string           `json:"store"`
+	Format   string           `json:"format"`
+	Prefix   string           `json:"prefix"`
+	Segments []DecodedSegment `json:"segments"`
+	Value    interface{}      `json:"value,omitempty"`
+}
+
+// Registry holds the key formats of the stores of an application.
+type Registry struct {
+	stores map[string][]KeyFormat
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{stores: make(map[string][]KeyFormat)}
+}
+
+// Register declares the formats of the keys of the store with the given key
+// name. It panics if two formats of the store have the same prefix.
+func (r *Registry) Register(store string, formats ...KeyFormat) {
+	for _, format := range formats {
+		for _, existing := range r.stores[store] {
+			if bytes.Equal(existing.Prefix, format.Prefix) {
+				panic(fmt.Sprintf("key formats %s and %s of store %s have the same prefix %X", existing.Name, format.Name, store, format.Prefix))
+			}
+		}
+		r.stores[store] = append(r.stores[store], format)
+	}
+
+	// the longest prefixes are matched first
+	sort.SliceStable(r.stores[store], func(i, j int) bool {
+		return len(r.stores[store][i].Prefix) > len(r.stores[store][j].Prefix)
+	})
+}
+
+// Stores returns the sorted names of the stores having registered formats.
+func (r *Registry) Stores() []string {
+	stores := make([]string, 0, len(r.stores))
+	for store := range r.stores {
+		stores = append(stores, store)
+	}
+	sort.Strings(stores)
+	return stores
+}
+
+// Formats returns the key formats of store.
+func (r *Registry) Formats(store string) []KeyFormat {
+	return r.stores[store]
+}
+
+// Decode renders key of store using the format with the longest matching
+// prefix, and value if it is not nil.
+func (r *Registry) Decode(cdc codec.Codec, store string, key, value []byte) (Decoded, error) {
+	formats, ok := r.stores[store]
+	if !ok {
+		return Decoded{}, fmt.Errorf("no key formats registered for store %s", store)
+	}
+
+	for _, format := range formats {
+		if bytes.HasPrefix(key, format.Prefix) {
+			return format.decode(cdc, store, key, value)
+		}
+	}
+
+	return Decoded{}, fmt.Errorf("no key format of store %s matches key %X", store, key)
+}
+
+func (f KeyFormat) decode(cdc codec.Codec, store string, key, value []byte) (Decoded, error) {
+	decoded := Decoded{
+		Store:    store,
+		Format:   f.Name,
+		Prefix:   hex.EncodeToString(f.Prefix),
+		Segments: make([]DecodedSegment, 0, len(f.Segments)),
+	}
+
+	rest := key[len(f.Prefix):]
+	for _, segment := range f.Segments {
+		rendered, n, err := segment.decode(rest)
+		if err != nil {
+			return Decoded{}, fmt.Errorf("%s key: %w", f.Name, err)
+		}
+		decoded.Segments = append(decoded.Segments, DecodedSegment{Name: segment.name, Value: rendered})
+		rest = rest[n:]
+	}
+	if len(rest) > 0 {
+		return Decoded{}, fmt.Errorf("%s key: %d unexpected trailing bytes", f.Name, len(rest))
+	}
+
+	if value == nil {
+		return decoded, nil
+	}
+
+	if f.Value == nil {
+		decoded.Value = hex.EncodeToString(value)
+		return decoded, nil
+	}
+
+	v, err := f.Value(cdc, value)
+	if err != nil {
+		return Decoded{}, fmt.Errorf("%s value: %w", f.Name, err)
+	}
+	decoded.Value = v
+
+	return decoded, nil
+}
@@ -0,0 +1,139 @@
+package keyformat_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestRegistryDecode(t *testing.T) {
+	registry := keyformat.NewRegistry()
+	registry.Register("test",
+		keyformat.KeyFormat{
+			Name:     "short",
+			Prefix:   []byte{0x01},
+			Segments: []keyformat.Segment{keyformat.Bytes("rest", 0)},
+		},
+		keyformat.KeyFormat{
+			Name:     "long",
+			Prefix:   []byte{0x01, 0x02},
+			Segments: []keyformat.Segment{keyformat.Uint64LE("index"), keyformat.String("name")},
+			Value:    keyformat.SegmentValue(keyformat.Uint64("count")),
+		},
+	)
+	require.Panics(t, func() {
+		registry.Register("test", keyformat.KeyFormat{Name: "duplicate", Prefix: []byte{0x01}})
+	})
+	require.Equal(t, []string{"test"}, registry.Stores())
+
+	index := make([]byte, 8)
+	binary.LittleEndian.PutUint64(index, 7)
+	count := sdk.Uint64ToBigEndian(42)
+
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+
+	// the longest matching prefix is used
+	key := append(append([]byte{0x01, 0x02}, index...), "foo"...)
+	decoded, err := registry.Decode(cdc, "test", key, count)
+	require.NoError(t, err)
+	require.Equal(t, keyformat.Decoded{
+		Store:  "test",
+		Format: "long",
+		Prefix: "0102",
+		Segments: []keyformat.DecodedSegment{
+			{Name: "index", Value: "7"},
+			{Name: "name", Value: "foo"},
+		},
+		Value: "42",
+	}, decoded)
+
+	// the values without decoder are rendered in hex
+	decoded, err = registry.Decode(cdc, "test", []byte{0x01, 0xab}, []byte{0xcd})
+	require.NoError(t, err)
+	require.Equal(t, "short", decoded.Format)
+	require.Equal(t, []keyformat.DecodedSegment{{Name: "rest", Value: "ab"}}, decoded.Segments)
+	require.Equal(t, "cd", decoded.Value)
+
+	_, err = registry.Decode(cdc, "test", []byte{0x01, 0x02, 0x00}, nil)
+	require.EqualError(t, err, "long key: segment index: expected 8 bytes, got 1")
+
+	_, err = registry.Decode(cdc, "test", []byte{0x01, 0x02}, []byte{0x00})
+	require.Error(t, err)
+
+	_, err = registry.Decode(cdc, "test", []byte{0x03}, nil)
+	require.EqualError(t, err, "no key format of store test matches key 03")
+
+	_, err = registry.Decode(cdc, "unknown", []byte{0x01}, nil)
+	require.EqualError(t, err, "no key formats registered for store unknown")
+}
+
+func TestModuleKeyFormats(t *testing.T) {
+	registry := keyformat.NewRegistry()
+	simapp.ModuleBasics.RegisterKeyFormats(registry)
+	require.Contains(t, registry.Stores(), banktypes.StoreKey)
+	require.Contains(t, registry.Stores(), stakingtypes.StoreKey)
+
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	addr := sdk.AccAddress("addr________________")
+	valAddr := sdk.ValAddress("val_________________")
+
+	// a balance, whose key ends with the denom
+	coin := sdk.NewInt64Coin("stake", 10)
+	value, err := cdc.Marshal(&coin)
+	require.NoError(t, err)
+	key := append(banktypes.CreateAccountBalancesPrefix(addr), "stake"...)
+	decoded, err := registry.Decode(cdc, banktypes.StoreKey, key, value)
+	require.NoError(t, err)
+	require.Equal(t, "balance", decoded.Format)
+	require.Equal(t, []keyformat.DecodedSegment{
+		{Name: "address", Value: addr.String()},
+		{Name: "denom", Value: "stake"},
+	}, decoded.Segments)
+	require.JSONEq(t, `{"denom":"stake","amount":"10"}`, string(decoded.Value.(json.RawMessage)))
+
+	// an index entry, with an empty value
+	key = stakingtypes.GetUBDByValIndexKey(addr, valAddr)
+	decoded, err = registry.Decode(cdc, stakingtypes.StoreKey, key, nil)
+	require.NoError(t, err)
+	require.Equal(t, "unbonding_delegation_by_validator", decoded.Format)
+	require.Equal(t, hex.EncodeToString(stakingtypes.UnbondingDelegationByValIndexKey), decoded.Prefix)
+	require.Equal(t, []keyformat.DecodedSegment{
+		{Name: "validator", Value: valAddr.String()},
+		{Name: "delegator", Value: addr.String()},
+	}, decoded.Segments)
+	require.Nil(t, decoded.Value)
+
+	// a queue entry, starting with a time
+	endTime := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	key = govtypes.ActiveProposalQueueKey(3, endTime)
+	decoded, err = registry.Decode(cdc, govtypes.StoreKey, key, govtypes.GetProposalIDBytes(3))
+	require.NoError(t, err)
+	require.Equal(t, []keyformat.DecodedSegment{
+		{Name: "voting_end_time", Value: "2021-10-01T12:00:00Z"},
+		{Name: "proposal_id", Value: "3"},
+	}, decoded.Segments)
+	require.Equal(t, "3", decoded.Value)
+
+	// a length prefixed string followed by another one
+	key = nft.OwnerStoreKey("kitty", "kitty1")
+	decoded, err = registry.Decode(cdc, nft.StoreKey, key, addr)
+	require.NoError(t, err)
+	require.Equal(t, "owner", decoded.Format)
+	require.Equal(t, []keyformat.DecodedSegment{
+		{Name: "class_id", Value: "kitty"},
+		{Name: "id", Value: "kitty1"},
+	}, decoded.Segments)
+	require.Equal(t, addr.String(), decoded.Value)
+}
@@ -0,0 +1,134 @@
+package module
+
+import (
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrationOption declares a constraint on the migrations run by RunMigrations,
+// usually from within an upgrade handler.
+type MigrationOption func(*migrationConstraints)
+
+// MigrateAfter makes the migrations of module, or its InitGenesis if it is new,
+// run after the ones of dependencies, e.g. when they read the state migrated
+// by dependencies.
+func MigrateAfter(module string, dependencies ...string) MigrationOption {
+	return func(c *migrationConstraints) {
+		c.after[module] = append(c.after[module], dependencies...)
+	}
+}
+
+// ExpectFromVersion makes RunMigrations fail, before any migration runs, unless
+// module migrates from the given consensus version, zero meaning that the
+// module is new and initialized with InitGenesis.
+func ExpectFromVersion(module string, version uint64) MigrationOption {
+	return func(c *migrationConstraints) {
+		c.fromVersions[module] = version
+	}
+}
+
+type migrationConstraints struct {
+	after        map[string][]string
+	fromVersions map[string]uint64
+}
+
+func newMigrationConstraints(opts []MigrationOption) migrationConstraints {
+	c := migrationConstraints{after: map[string][]string{}, fromVersions: map[string]uint64{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// verifyFromVersions checks that the modules migrate from their expected
+// consensus versions.
+func (c migrationConstraints) verifyFromVersions(modules map[string]AppModule, fromVM VersionMap) error {
+	names := make([]string, 0, len(c.fromVersions))
+	for name := range c.fromVersions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := modules[name]; !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "expected from version of unknown module %s", name)
+		}
+		if expected, actual := c.fromVersions[name], fromVM[name]; expected != actual {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidVersion, "module %s migrates from version %d, expected %d", name, actual, expected)
+		}
+	}
+
+	return nil
+}
+
+// MigrationOrder returns the order in which RunMigrations migrates the modules
+// under the given constraints: a module migrates after its dependencies, the
+// modules not constrained relative to each other being ordered by name. It
+// returns an error if a constraint refers to an unknown module or if the
+// constraints are cyclic.
+func (m Manager) MigrationOrder(opts ...MigrationOption) ([]string, error) {
+	return m.migrationOrder(newMigrationConstraints(opts))
+}
+
+func (m Manager) migrationOrder(c migrationConstraints) ([]string, error) {
+	names := make([]string, 0, len(m.Modules))
+	for name := range m.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// successors[name] are the modules migrating after name
+	successors := make(map[string][]string, len(names))
+	predecessors := make(map[string]int, len(names))
+	for module, dependencies := range c.after {
+		if _, ok := m.Modules[module]; !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "migrations of unknown module %s are ordered", module)
+		}
+		for _, dependency := range dependencies {
+			if _, ok := m.Modules[dependency]; !ok {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "migrations of module %s are ordered after unknown module %s", module, dependency)
+			}
+			if dependency == module {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "migrations of module %s are ordered after themselves", module)
+			}
+
+			successors[dependency] = append(successors[dependency], module)
+			predecessors[module]++
+		}
+	}
+
+	// topological sort, picking the first module by name among the ones whose
+	// dependencies were all picked
+	order := make([]string, 0, len(names))
+	picked := make(map[string]bool, len(names))
+	for len(order) < len(names) {
+		next := ""
+		for _, name := range names {
+			if !picked[name] && predecessors[name] == 0 {
+				next = name
+				break
+			}
+		}
+
+		if next == "" {
+			var cyclic []string
+			for _, name := range names {
+				if !picked[name] {
+					cyclic = append(cyclic, name)
+				}
+			}
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cyclic migration dependencies between modules %s", strings.Join(cyclic, ", "))
+		}
+
+		picked[next] = true
+		for _, successor := range successors[next] {
+			predecessors[successor]--
+		}
+		order = append(order, next)
+	}
+
+	return order, nil
+}
@@ -31,7 +31,8 @@ package module
 import (
 	"encoding/json"
 	"fmt"
-	"sort"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -43,6 +44,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 // AppModuleBasic is the standard form for basic non-dependant elements of an application module.
@@ -146,6 +148,22 @@ func (bm BasicManager) AddQueryCommands(rootQueryCmd *cobra.Command) {
 	}
 }
 
+// HasKeyFormats is implemented by the modules declaring the layout of the keys
+// of their stores, used to render raw store entries.
+type HasKeyFormats interface {
+	RegisterKeyFormats(*keyformat.Registry)
+}
+
+// RegisterKeyFormats registers the key formats of the modules implementing
+// HasKeyFormats.
+func (bm BasicManager) RegisterKeyFormats(registry *keyformat.Registry) {
+	for _, b := range bm {
+		if m, ok := b.(HasKeyFormats); ok {
+			m.RegisterKeyFormats(registry)
+		}
+	}
+}
+
 // AppModuleGenesis is the standard form for an application module genesis functions
 type AppModuleGenesis interface {
 	AppModuleBasic
@@ -230,6 +248,8 @@ type Manager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+
+	migrationRecorder MigrationRecorder
 }
 
 // NewManager creates a new Manager object
@@ -271,6 +291,12 @@ func (m *Manager) SetOrderEndBlockers(moduleNames ...string) {
 	m.OrderEndBlockers = moduleNames
 }
 
+// SetMigrationRecorder sets the recorder notified by RunMigrations of the
+// outcome of the migrations of every module.
+func (m *Manager) SetMigrationRecorder(recorder MigrationRecorder) {
+	m.migrationRecorder = recorder
+}
+
 // RegisterInvariants registers all module invariants
 func (m *Manager) RegisterInvariants(ir sdk.InvariantRegistry) {
 	for _, module := range m.Modules {
@@ -342,6 +368,15 @@ type MigrationHandler func(sdk.Context) error
 // version from which we should perform the migration for each module.
 type VersionMap map[string]uint64
 
+// MigrationRecorder records the migrations run by RunMigrations. It is called
+// once for every module whose consensus version changed, or which was
+// initialized with InitGenesis (in which case fromVersion is zero), and for
+// every module whose migrations failed. The duration is wall-clock time and
+// must not be written to the state.
+type MigrationRecorder interface {
+	RecordMigration(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64, duration time.Duration, err error)
+}
+
 // RunMigrations performs in-place store migrations for all modules. This
 // function MUST be called insde an x/upgrade UpgradeHandler.
 //
@@ -366,6 +401,9 @@ type VersionMap map[string]uint64
 //      `InitGenesis` on that module.
 // - return the `updatedVM` to be persisted in the x/upgrade's store.
 //
+// The outcome of the migrations of each module is reported to the
+// MigrationRecorder set with SetMigrationRecorder, if any.
+//
 // As an app developer, if you wish to skip running InitGenesis for your new
 // module "foo", you need to manually pass a `fromVM` argument to this function
 // foo's module version set to its latest ConsensusVersion. That way, the diff
@@ -386,26 +424,41 @@ type VersionMap map[string]uint64
 //       return app.mm.RunMigrations(ctx, cfg, fromVM)
 //   })
 //
+// The modules migrate in the order of their names, unless constrained by opts:
+// MigrateAfter orders the migrations of a module after the ones of other
+// modules, and ExpectFromVersion verifies the version a module migrates from
+// before any migration runs, instead of relying on the content of `fromVM`.
+//
+// Example:
+//   app.UpgradeKeeper.SetUpgradeHandler("my-plan", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//       return app.mm.RunMigrations(ctx, cfg, fromVM,
+//           module.MigrateAfter("staking", "bank"),
+//           module.ExpectFromVersion("bank", 2),
+//       )
+//   })
+//
 // Please also refer to docs/core/upgrade.md for more information.
-func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap, opts ...MigrationOption) (VersionMap, error) {
 	c, ok := cfg.(configurator)
 	if !ok {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", configurator{}, cfg)
 	}
-	ctx.Logger().Info("Start running migrations")
 
-	updatedVM := make(VersionMap)
-	// for deterministic iteration order
-	// (as some migrations depend on other modules
-	// and the order of executing migrations matters)
-	// TODO: make the order user-configurable?
-	sortedModNames := make([]string, 0, len(m.Modules))
-	for key := range m.Modules {
-		sortedModNames = append(sortedModNames, key)
+	// the constraints are checked before any migration runs
+	constraints := newMigrationConstraints(opts)
+	if err := constraints.verifyFromVersions(m.Modules, fromVM); err != nil {
+		return nil, err
+	}
+	// some migrations depend on the state migrated by other modules, so the
+	// modules migrate in a deterministic order satisfying the constraints
+	orderedModNames, err := m.migrationOrder(constraints)
+	if err != nil {
+		return nil, err
 	}
-	sort.Strings(sortedModNames)
+	ctx.Logger().Info("Start running migrations", "order", strings.Join(orderedModNames, ","))
 
-	for _, moduleName := range sortedModNames {
+	updatedVM := make(VersionMap)
+	for _, moduleName := range orderedModNames {
 		module := m.Modules[moduleName]
 		fromVersion, exists := fromVM[moduleName]
 		toVersion := module.ConsensusVersion()
@@ -418,11 +471,16 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 		// empty genesis state.
 		// 2. An existing chain is upgrading to v043 for the first time. In this case,
 		// all modules have yet to be added to x/upgrade's VersionMap store.
+		start := time.Now()
 		if exists {
 			err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion)
 			if err != nil {
+				m.recordMigration(ctx, moduleName, fromVersion, toVersion, start, err)
 				return nil, err
 			}
+			if fromVersion != toVersion {
+				m.recordMigration(ctx, moduleName, fromVersion, toVersion, start, nil)
+			}
 		} else {
 			cfgtor, ok := cfg.(configurator)
 			if !ok {
@@ -437,8 +495,11 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 			// The module manager assumes only one module will update the
 			// validator set, and that it will not be by a new module.
 			if len(moduleValUpdates) > 0 {
-				return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis updates already set by a previous module")
+				err := sdkerrors.Wrapf(sdkerrors.ErrLogic, "validator InitGenesis updates already set by a previous module")
+				m.recordMigration(ctx, moduleName, 0, toVersion, start, err)
+				return nil, err
 			}
+			m.recordMigration(ctx, moduleName, 0, toVersion, start, nil)
 		}
 
 		updatedVM[moduleName] = toVersion
@@ -447,6 +508,15 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM Version
 	return updatedVM, nil
 }
 
+// recordMigration notifies the migration recorder, if any, of the outcome of
+// the migrations of a module which started at the given time.
+func (m Manager) recordMigration(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64, start time.Time, err error) {
+	if m.migrationRecorder == nil {
+		return
+	}
+	m.migrationRecorder.RecordMigration(ctx, moduleName, fromVersion, toVersion, time.Since(start), err)
+}
+
 // BeginBlock performs begin block functionality for all modules. It creates a
 // child context with an event manager to aggregate events emitted from all
 // modules.
@@ -285,3 +285,37 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+func TestManager_MigrationOrder(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	var modules []module.AppModule
+	for _, name := range []string{"staking", "bank", "auth", "distribution"} {
+		mockAppModule := mocks.NewMockAppModule(mockCtrl)
+		mockAppModule.EXPECT().Name().Times(2).Return(name)
+		modules = append(modules, mockAppModule)
+	}
+	mm := module.NewManager(modules...)
+
+	// unconstrained modules migrate in the order of their names
+	order, err := mm.MigrationOrder()
+	require.NoError(t, err)
+	require.Equal(t, []string{"auth", "bank", "distribution", "staking"}, order)
+
+	order, err = mm.MigrationOrder(
+		module.MigrateAfter("auth", "staking"),
+		module.MigrateAfter("distribution", "staking", "bank"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank", "staking", "auth", "distribution"}, order)
+
+	_, err = mm.MigrationOrder(module.MigrateAfter("auth", "staking"), module.MigrateAfter("staking", "auth"))
+	require.EqualError(t, err, "cyclic migration dependencies between modules auth, staking: internal logic error")
+
+	_, err = mm.MigrationOrder(module.MigrateAfter("auth", "foo"))
+	require.EqualError(t, err, "migrations of module auth are ordered after unknown module foo: not found")
+
+	_, err = mm.MigrationOrder(module.MigrateAfter("auth", "auth"))
+	require.Error(t, err)
+}
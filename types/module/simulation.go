@@ -85,11 +85,19 @@ func (sm *SimulationManager) GenerateParamChanges(seed int64) (paramChanges []si
 	return
 }
 
-// WeightedOperations returns all the modules' weighted operations of an application
+// WeightedOperations returns all the modules' weighted operations of an application.
+// The operations of the modules exposing their name are routed to it, so that
+// account profiles can bias the accounts sending their msgs.
 func (sm *SimulationManager) WeightedOperations(simState SimulationState) []simulation.WeightedOperation {
 	wOps := make([]simulation.WeightedOperation, 0, len(sm.Modules))
 	for _, module := range sm.Modules {
-		wOps = append(wOps, module.WeightedOperations(simState)...)
+		named, ok := module.(interface{ Name() string })
+		for _, op := range module.WeightedOperations(simState) {
+			if ok {
+				op = simulation.WithRoute(op, named.Name())
+			}
+			wOps = append(wOps, op)
+		}
 	}
 
 	return wOps
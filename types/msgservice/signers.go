@@ -0,0 +1,188 @@
+package msgservice
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// signerField is a field of a message type that is annotated with the
+// cosmos.msg.v1.signer option.
+type signerField struct {
+	name  string
+	index int
+}
+
+// signerFieldsCache caches the signer fields of each message struct type, as
+// resolving them requires decoding the message's file descriptor.
+var signerFieldsCache sync.Map // map[reflect.Type][]signerField
+
+// GetSigners returns the signers of msg, as declared by the cosmos.msg.v1.signer
+// option of its proto definition. The annotated fields must either hold bech32
+// account or validator addresses, or messages which are themselves annotated
+// with the option, in which case their signers are returned in order. Signers
+// are not deduplicated.
+func GetSigners(msg proto.Message) ([]sdk.AccAddress, error) {
+	v := reflect.ValueOf(msg)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return nil, fmt.Errorf("expected a non-nil message pointer, got %T", msg)
+	}
+
+	return getSigners(v.Elem())
+}
+
+// MustGetSigners returns the signers of msg like GetSigners, and panics on
+// error. It is meant to be used to implement sdk.Msg's GetSigners method.
+func MustGetSigners(msg proto.Message) []sdk.AccAddress {
+	signers, err := GetSigners(msg)
+	if err != nil {
+		panic(err)
+	}
+
+	return signers
+}
+
+func getSigners(v reflect.Value) ([]sdk.AccAddress, error) {
+	fields, err := getSignerFields(v.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	var signers []sdk.AccAddress
+	for _, field := range fields {
+		fieldSigners, err := getFieldSigners(v.Field(field.index))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", v.Type(), field.name, err)
+		}
+		signers = append(signers, fieldSigners...)
+	}
+
+	return signers, nil
+}
+
+func getFieldSigners(v reflect.Value) ([]sdk.AccAddress, error) {
+	switch v.Kind() {
+	case reflect.String:
+		signer, err := signerFromBech32(v.String())
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.AccAddress{signer}, nil
+
+	case reflect.Struct:
+		return getSigners(v)
+
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, fmt.Errorf("signer message is nil")
+		}
+		return getFieldSigners(v.Elem())
+
+	case reflect.Slice:
+		var signers []sdk.AccAddress
+		for i := 0; i < v.Len(); i++ {
+			elemSigners, err := getFieldSigners(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			signers = append(signers, elemSigners...)
+		}
+		return signers, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported signer field kind %s", v.Kind())
+	}
+}
+
+// getSignerFields returns the fields of the message struct type t annotated
+// with the cosmos.msg.v1.signer option.
+func getSignerFields(t reflect.Type) ([]signerField, error) {
+	if fields, ok := signerFieldsCache.Load(t); ok {
+		return fields.([]signerField), nil
+	}
+
+	msg, ok := reflect.New(t).Interface().(descriptor.Message)
+	if !ok {
+		return nil, fmt.Errorf("%s does not have a Descriptor() method", t)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	if md.Options == nil {
+		return nil, fmt.Errorf("%s has no %s option", t, E_Signer.Name)
+	}
+
+	ext, err := proto.GetExtension(md.Options, E_Signer)
+	if err == proto.ErrMissingExtension {
+		return nil, fmt.Errorf("%s has no %s option", t, E_Signer.Name)
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := ext.([]string)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("%s has no %s option", t, E_Signer.Name)
+	}
+
+	fields := make([]signerField, len(names))
+	for i, name := range names {
+		index, ok := fieldIndexByProtoName(t, name)
+		if !ok {
+			return nil, fmt.Errorf("%s has no field %s declared by its %s option", t, name, E_Signer.Name)
+		}
+		fields[i] = signerField{name: name, index: index}
+	}
+
+	signerFieldsCache.Store(t, fields)
+
+	return fields, nil
+}
+
+// fieldIndexByProtoName returns the index of the field of the struct type t
+// whose protobuf tag has the given name.
+func fieldIndexByProtoName(t reflect.Type, name string) (int, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		for _, part := range strings.Split(t.Field(i).Tag.Get("protobuf"), ",") {
+			if part == "name="+name {
+				return i, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// signerFromBech32 returns the account address of a bech32 account or
+// validator address.
+func signerFromBech32(address string) (sdk.AccAddress, error) {
+	if len(strings.TrimSpace(address)) == 0 {
+		return nil, fmt.Errorf("empty signer address")
+	}
+
+	hrp, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, err
+	}
+
+	config := sdk.GetConfig()
+	switch hrp {
+	case config.GetBech32AccountAddrPrefix():
+		return sdk.AccAddressFromBech32(address)
+
+	case config.GetBech32ValidatorAddrPrefix():
+		valAddr, err := sdk.ValAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.AccAddress(valAddr), nil
+
+	default:
+		return nil, fmt.Errorf("invalid signer address %s: expected an account or validator address", address)
+	}
+}
@@ -0,0 +1,104 @@
+package msgservice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGetSigners(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	valAddr := sdk.ValAddress(addr2)
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		signers []sdk.AccAddress
+		expErr  bool
+	}{
+		{
+			"account address",
+			banktypes.NewMsgSend(addr1, addr2, nil),
+			[]sdk.AccAddress{addr1},
+			false,
+		},
+		{
+			"validator address",
+			&stakingtypes.MsgEditValidator{ValidatorAddress: valAddr.String()},
+			[]sdk.AccAddress{addr2},
+			false,
+		},
+		{
+			"nested messages",
+			banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(addr2, nil), banktypes.NewInput(addr1, nil)},
+				[]banktypes.Output{banktypes.NewOutput(addr1, nil)},
+			),
+			[]sdk.AccAddress{addr2, addr1},
+			false,
+		},
+		{
+			"multiple signer fields",
+			&stakingtypes.MsgCreateValidator{DelegatorAddress: addr1.String(), ValidatorAddress: valAddr.String()},
+			[]sdk.AccAddress{addr1, addr2},
+			false,
+		},
+		{
+			"empty address",
+			&banktypes.MsgSend{},
+			nil,
+			true,
+		},
+		{
+			"invalid address",
+			&banktypes.MsgSend{FromAddress: "cosmos1invalid"},
+			nil,
+			true,
+		},
+		{
+			"missing annotation",
+			testdata.NewTestMsg(addr1),
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			signers, err := msgservice.GetSigners(tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Panics(t, func() { msgservice.MustGetSigners(tc.msg) })
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.signers, signers)
+			}
+		})
+	}
+}
+
+func TestValidateMsgSigners(t *testing.T) {
+	registry := simapp.MakeTestEncodingConfig().InterfaceRegistry
+	require.NotEmpty(t, registry.ListImplementations(sdk.MsgInterfaceProtoName))
+	require.Empty(t, msgservice.ValidateMsgSigners(registry))
+
+	registry = codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	registry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{}, &testdata.TestMsg{})
+	require.Equal(t, []msgservice.SignerMismatch{
+		{
+			MsgTypeURL: "/testdata.TestMsg",
+			Reason:     "testdata.TestMsg has no cosmos.msg.v1.signer option",
+		},
+	}, msgservice.ValidateMsgSigners(registry))
+}
@@ -0,0 +1,185 @@
+package msgservice
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxSignerSlots bounds the number of signer addresses a message is filled
+// with when validating it, as every combination of account and validator
+// addresses is tried.
+const maxSignerSlots = 10
+
+// SignerMismatch describes a Msg whose GetSigners implementation disagrees
+// with its cosmos.msg.v1.signer annotation.
+type SignerMismatch struct {
+	MsgTypeURL string `json:"msg_type_url"`
+	Reason     string `json:"reason"`
+}
+
+// ValidateMsgSigners checks every Msg registered in the registry against its
+// cosmos.msg.v1.signer annotation. The annotated fields of each message are
+// filled with distinct addresses, and the signers returned by the message's
+// GetSigners method are compared with the ones derived from the annotation.
+// As an annotated string field may either hold an account or a validator
+// address, a message is valid if both agree for any combination of them.
+// The mismatches are returned sorted by type URL.
+func ValidateMsgSigners(registry codectypes.InterfaceRegistry) []SignerMismatch {
+	var mismatches []SignerMismatch
+
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(typeURLs)
+
+	for _, typeURL := range typeURLs {
+		if err := validateMsgSigners(registry, typeURL); err != nil {
+			mismatches = append(mismatches, SignerMismatch{MsgTypeURL: typeURL, Reason: err.Error()})
+		}
+	}
+
+	return mismatches
+}
+
+func validateMsgSigners(registry codectypes.InterfaceRegistry, typeURL string) error {
+	msg, err := registry.Resolve(typeURL)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg, ok := msg.(sdk.Msg)
+	if !ok {
+		return fmt.Errorf("%T does not implement sdk.Msg", msg)
+	}
+
+	var slots []reflect.Value
+	if err := collectSignerSlots(reflect.ValueOf(msg).Elem(), &slots); err != nil {
+		return err
+	}
+
+	if len(slots) > maxSignerSlots {
+		return fmt.Errorf("too many signer fields: %d", len(slots))
+	}
+
+	var firstErr error
+	for combination := 0; combination < 1<<len(slots); combination++ {
+		for i, slot := range slots {
+			slot.SetString(slotAddress(i, combination&(1<<i) != 0))
+		}
+
+		expected, err := GetSigners(msg)
+		if err != nil {
+			return err
+		}
+
+		actual, err := callGetSigners(sdkMsg)
+		if err == nil && signersEqual(expected, actual) {
+			return nil
+		}
+
+		if firstErr == nil || (err == nil && isPanic(firstErr)) {
+			if err == nil {
+				err = fmt.Errorf("GetSigners returned %v, the %s option declares %v", actual, E_Signer.Name, expected)
+			}
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// collectSignerSlots allocates the signer fields of v, two elements for
+// repeated fields, and appends the string fields receiving the signer
+// addresses to slots.
+func collectSignerSlots(v reflect.Value, slots *[]reflect.Value) error {
+	fields, err := getSignerFields(v.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		if err := collectFieldSignerSlots(v.Field(field.index), slots); err != nil {
+			return fmt.Errorf("%s.%s: %w", v.Type(), field.name, err)
+		}
+	}
+
+	return nil
+}
+
+func collectFieldSignerSlots(v reflect.Value, slots *[]reflect.Value) error {
+	switch v.Kind() {
+	case reflect.String:
+		*slots = append(*slots, v)
+		return nil
+
+	case reflect.Struct:
+		return collectSignerSlots(v, slots)
+
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		return collectFieldSignerSlots(v.Elem(), slots)
+
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			if err := collectFieldSignerSlots(v.Index(i), slots); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported signer field kind %s", v.Kind())
+	}
+}
+
+// slotAddress returns the account or validator address filling the i-th
+// signer slot.
+func slotAddress(i int, validator bool) string {
+	bz := bytes.Repeat([]byte{byte(i + 1)}, 20)
+	if validator {
+		return sdk.ValAddress(bz).String()
+	}
+
+	return sdk.AccAddress(bz).String()
+}
+
+type getSignersPanic struct {
+	value interface{}
+}
+
+func (p getSignersPanic) Error() string {
+	return fmt.Sprintf("GetSigners panicked: %v", p.value)
+}
+
+func isPanic(err error) bool {
+	_, ok := err.(getSignersPanic)
+	return ok
+}
+
+func callGetSigners(msg sdk.Msg) (signers []sdk.AccAddress, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = getSignersPanic{value: r}
+		}
+	}()
+
+	return msg.GetSigners(), nil
+}
+
+func signersEqual(a, b []sdk.AccAddress) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !a[i].Equals(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -27,7 +27,6 @@ func FilteredPaginate(
 
 	offset := pageRequest.Offset
 	key := pageRequest.Key
-	limit := pageRequest.Limit
 	countTotal := pageRequest.CountTotal
 	reverse := pageRequest.Reverse
 
@@ -35,9 +34,12 @@ func FilteredPaginate(
 		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
 	}
 
-	if limit == 0 {
-		limit = DefaultLimit
+	limit, err := pageLimit(pageRequest.Limit)
+	if err != nil {
+		return nil, err
+	}
 
+	if pageRequest.Limit == 0 {
 		// count total results when the limit is zero/not supplied
 		countTotal = true
 	}
@@ -19,10 +19,51 @@ const DefaultLimit = 100
 // which equals the maximum value that can be stored in uint64
 const MaxLimit = math.MaxUint64
 
+// nodeDefaultLimit and nodeMaxLimit are the pagination limits of the node, set
+// by SetPaginationLimits.
+var (
+	nodeDefaultLimit uint64 = DefaultLimit
+	nodeMaxLimit     uint64
+)
+
+// SetPaginationLimits sets the pagination limits of the queries served by the
+// node. defaultLimit is used when the limit is not supplied, 0 meaning
+// DefaultLimit, and the page requests of more than maxLimit results are
+// rejected, 0 meaning no limit. It is not safe to call concurrently with the
+// queries, and so must be called before the node serves them.
+//
+// The limits are local to the node, so that the state machine must not
+// paginate, as its execution would depend on them.
+func SetPaginationLimits(defaultLimit, maxLimit uint64) error {
+	if defaultLimit == 0 {
+		defaultLimit = DefaultLimit
+	}
+	if maxLimit > 0 && defaultLimit > maxLimit {
+		return fmt.Errorf("default pagination limit %d exceeds the max pagination limit %d", defaultLimit, maxLimit)
+	}
+
+	nodeDefaultLimit = defaultLimit
+	nodeMaxLimit = maxLimit
+
+	return nil
+}
+
+// pageLimit returns the limit of a page request, or an error if it exceeds the
+// max limit of the node.
+func pageLimit(limit uint64) (uint64, error) {
+	if limit == 0 {
+		return nodeDefaultLimit, nil
+	}
+	if nodeMaxLimit > 0 && limit > nodeMaxLimit {
+		return 0, fmt.Errorf("limit %d exceeds the max limit %d of the node", limit, nodeMaxLimit)
+	}
+
+	return limit, nil
+}
+
 // ParsePagination validate PageRequest and returns page number & limit.
 func ParsePagination(pageReq *PageRequest) (page, limit int, err error) {
 	offset := 0
-	limit = DefaultLimit
 
 	if pageReq != nil {
 		offset = int(pageReq.Offset)
@@ -34,10 +75,14 @@ func ParsePagination(pageReq *PageRequest) (page, limit int, err error) {
 
 	if limit < 0 {
 		return 1, 0, status.Error(codes.InvalidArgument, "limit must greater than 0")
-	} else if limit == 0 {
-		limit = DefaultLimit
 	}
 
+	pageSize, err := pageLimit(uint64(limit))
+	if err != nil {
+		return 1, 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit = int(pageSize)
+
 	page = offset/limit + 1
 
 	return page, limit, nil
@@ -58,7 +103,6 @@ func Paginate(
 
 	offset := pageRequest.Offset
 	key := pageRequest.Key
-	limit := pageRequest.Limit
 	countTotal := pageRequest.CountTotal
 	reverse := pageRequest.Reverse
 
@@ -66,9 +110,12 @@ func Paginate(
 		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
 	}
 
-	if limit == 0 {
-		limit = DefaultLimit
+	limit, err := pageLimit(pageRequest.Limit)
+	if err != nil {
+		return nil, err
+	}
 
+	if pageRequest.Limit == 0 {
 		// count total results when the limit is zero/not supplied
 		countTotal = true
 	}
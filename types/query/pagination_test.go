@@ -59,6 +59,50 @@ func (s *paginationTestSuite) TestParsePagination() {
 	s.Require().Equal(limit, 10)
 }
 
+func (s *paginationTestSuite) TestPaginationLimits() {
+	app, ctx, _ := setupTest()
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	var balances sdk.Coins
+	for i := 0; i < numBalances; i++ {
+		balances = append(balances, sdk.NewInt64Coin(fmt.Sprintf("foo%ddenom", i), 100))
+	}
+
+	addr1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+	s.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, balances.Sort()))
+
+	s.Require().Error(query.SetPaginationLimits(underLimit+1, underLimit))
+	s.Require().NoError(query.SetPaginationLimits(underLimit, overLimit))
+	defer func() {
+		s.Require().NoError(query.SetPaginationLimits(query.DefaultLimit, 0))
+	}()
+
+	s.T().Log("verify empty page request uses the default limit of the node")
+	res, err := queryClient.AllBalances(gocontext.Background(), types.NewQueryAllBalancesRequest(addr1, &query.PageRequest{}))
+	s.Require().NoError(err)
+	s.Require().Equal(underLimit, res.Balances.Len())
+	s.Require().Equal(uint64(numBalances), res.Pagination.Total)
+
+	s.T().Log("verify page request with limit = max limit is served")
+	res, err = queryClient.AllBalances(gocontext.Background(), types.NewQueryAllBalancesRequest(addr1, &query.PageRequest{Limit: overLimit}))
+	s.Require().NoError(err)
+	s.Require().Equal(overLimit, res.Balances.Len())
+
+	s.T().Log("verify page request with limit > max limit is rejected")
+	_, err = queryClient.AllBalances(gocontext.Background(), types.NewQueryAllBalancesRequest(addr1, &query.PageRequest{Limit: overLimit + 1}))
+	s.Require().EqualError(err, "rpc error: code = InvalidArgument desc = paginate: limit 102 exceeds the max limit 101 of the node")
+
+	s.T().Log("verify ParsePagination enforces the limits of the node")
+	_, limit, err := query.ParsePagination(&query.PageRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(underLimit, limit)
+	_, _, err = query.ParsePagination(&query.PageRequest{Limit: overLimit + 1})
+	s.Require().Error(err)
+}
+
 func (s *paginationTestSuite) TestPagination() {
 	app, ctx, _ := setupTest()
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
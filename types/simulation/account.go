@@ -18,6 +18,7 @@ type Account struct {
 	PubKey  cryptotypes.PubKey
 	Address sdk.AccAddress
 	ConsKey cryptotypes.PrivKey
+	Profile string // name of the account's behavior profile, if any
 }
 
 // Equals returns true if two accounts are equal
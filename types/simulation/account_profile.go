@@ -0,0 +1,158 @@
+package simulation
+
+import (
+	"math/rand"
+)
+
+// AccountProfile describes the behavior of a class of simulation accounts,
+// e.g. whales or bots: which accounts behave this way and how likely they are
+// to send the msgs of each route.
+type AccountProfile struct {
+	Name string
+
+	// Share is the relative number of accounts randomly assigned the profile.
+	Share int
+
+	// Validators assigns the profile to the operators of the genesis
+	// validators, before the other accounts are randomly assigned a profile.
+	Validators bool
+
+	// Propensities are the relative propensities of the profile's accounts to
+	// send the msgs of each route, i.e. module name. DefaultPropensity is used
+	// for the routes missing from Propensities, and by the operations whose
+	// route is unknown.
+	Propensities      map[string]int
+	DefaultPropensity int
+}
+
+// Propensity returns the propensity of the profile's accounts to send the msgs
+// of a route.
+func (p AccountProfile) Propensity(route string) int {
+	if propensity, ok := p.Propensities[route]; ok {
+		return propensity
+	}
+
+	return p.DefaultPropensity
+}
+
+// DefaultAccountProfiles returns account profiles modeling the traffic
+// patterns of a live chain: a few whales moving and staking funds, bots
+// acting on behalf of other accounts through authz and fee grants, a majority
+// of mostly passive holders and the operators of the genesis validators.
+func DefaultAccountProfiles() []AccountProfile {
+	return []AccountProfile{
+		{
+			Name:              "whale",
+			Share:             1,
+			Propensities:      map[string]int{"bank": 10, "staking": 10, "distribution": 5},
+			DefaultPropensity: 2,
+		},
+		{
+			Name:              "bot",
+			Share:             2,
+			Propensities:      map[string]int{"authz": 20, "feegrant": 10, "bank": 5},
+			DefaultPropensity: 1,
+		},
+		{
+			Name:              "passive-holder",
+			Share:             7,
+			Propensities:      map[string]int{"gov": 2},
+			DefaultPropensity: 1,
+		},
+		{
+			Name:              "validator",
+			Validators:        true,
+			Propensities:      map[string]int{"staking": 20, "distribution": 10, "slashing": 10, "gov": 5},
+			DefaultPropensity: 1,
+		},
+	}
+}
+
+// AssignAccountProfiles returns a copy of accs where each account is assigned
+// the name of one of the profiles. The operators of the genesis validators, as
+// reported by isValidator, are assigned the first profile with Validators set,
+// if any. The other accounts are randomly assigned a profile, weighted by the
+// profiles' shares.
+func AssignAccountProfiles(r *rand.Rand, accs []Account, profiles []AccountProfile, isValidator func(Account) bool) []Account {
+	var (
+		validatorProfile string
+		hasValidators    bool
+		totalShare       int
+	)
+
+	for _, p := range profiles {
+		if p.Validators && !hasValidators {
+			validatorProfile, hasValidators = p.Name, true
+		}
+		totalShare += p.Share
+	}
+
+	assigned := make([]Account, len(accs))
+	for i, acc := range accs {
+		switch {
+		case hasValidators && isValidator != nil && isValidator(acc):
+			acc.Profile = validatorProfile
+
+		case totalShare > 0:
+			x := r.Intn(totalShare)
+			for _, p := range profiles {
+				if x < p.Share {
+					acc.Profile = p.Name
+					break
+				}
+				x -= p.Share
+			}
+		}
+
+		assigned[i] = acc
+	}
+
+	return assigned
+}
+
+// WeightedProfileAccounts returns accs where each account is repeated as many
+// times as the propensity of its profile to send the msgs of route, so that
+// operations randomly drawing an account from the result favor the accounts
+// likely to send their msgs. Every account is kept at least once, as many
+// operations look up the accounts of existing addresses, e.g. of delegators.
+func WeightedProfileAccounts(accs []Account, profiles []AccountProfile, route string) []Account {
+	propensities := make(map[string]int, len(profiles))
+	for _, p := range profiles {
+		propensities[p.Name] = p.Propensity(route)
+	}
+
+	weighted := make([]Account, 0, len(accs))
+	for _, acc := range accs {
+		n := propensities[acc.Profile]
+		if n < 1 {
+			n = 1
+		}
+
+		for i := 0; i < n; i++ {
+			weighted = append(weighted, acc)
+		}
+	}
+
+	return weighted
+}
+
+// RoutedWeightedOperation is a WeightedOperation which knows the route of the
+// msgs it sends, which lets account profiles bias the accounts sending them.
+type RoutedWeightedOperation interface {
+	WeightedOperation
+	Route() string
+}
+
+type routedWeightedOperation struct {
+	WeightedOperation
+	route string
+}
+
+func (op routedWeightedOperation) Route() string {
+	return op.route
+}
+
+// WithRoute returns op as a RoutedWeightedOperation sending the msgs of route.
+func WithRoute(op WeightedOperation, route string) RoutedWeightedOperation {
+	return routedWeightedOperation{WeightedOperation: op, route: route}
+}
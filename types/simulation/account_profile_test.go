@@ -0,0 +1,85 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestAccountProfilePropensity(t *testing.T) {
+	p := simulation.AccountProfile{Propensities: map[string]int{"bank": 5, "gov": 0}, DefaultPropensity: 1}
+
+	require.Equal(t, 5, p.Propensity("bank"))
+	require.Equal(t, 0, p.Propensity("gov"))
+	require.Equal(t, 1, p.Propensity("staking"))
+	require.Equal(t, 1, p.Propensity(""))
+}
+
+func TestAssignAccountProfiles(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simulation.RandomAccounts(r, 100)
+	validator := accs[3]
+	isValidator := func(acc simulation.Account) bool { return acc.Equals(validator) }
+
+	profiles := []simulation.AccountProfile{
+		{Name: "whale", Share: 1},
+		{Name: "holder", Share: 3},
+		{Name: "validator", Validators: true},
+	}
+
+	assigned := simulation.AssignAccountProfiles(r, accs, profiles, isValidator)
+	require.Len(t, assigned, len(accs))
+
+	counts := map[string]int{}
+	for i, acc := range assigned {
+		require.True(t, acc.Equals(accs[i]))
+		require.Empty(t, accs[i].Profile, "the accounts are copied")
+		counts[acc.Profile]++
+	}
+
+	require.Equal(t, "validator", assigned[3].Profile)
+	require.Equal(t, 1, counts["validator"])
+	require.Equal(t, len(accs)-1, counts["whale"]+counts["holder"])
+	require.Greater(t, counts["holder"], counts["whale"])
+
+	// without profiles, accounts have none
+	for _, acc := range simulation.AssignAccountProfiles(r, accs, nil, isValidator) {
+		require.Empty(t, acc.Profile)
+	}
+}
+
+func TestWeightedProfileAccounts(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	profiles := []simulation.AccountProfile{
+		{Name: "bot", Share: 1, Propensities: map[string]int{"authz": 3}},
+		{Name: "holder", Share: 1, DefaultPropensity: 2, Propensities: map[string]int{"authz": 0}},
+	}
+	accs := simulation.AssignAccountProfiles(r, simulation.RandomAccounts(r, 50), profiles, nil)
+
+	count := func(accs []simulation.Account) map[string]int {
+		counts := map[string]int{}
+		for _, acc := range accs {
+			counts[acc.Profile]++
+		}
+		return counts
+	}
+	counts := count(accs)
+
+	// accounts are repeated by propensity, and kept at least once
+	weighted := simulation.WeightedProfileAccounts(accs, profiles, "authz")
+	require.Equal(t, map[string]int{"bot": 3 * counts["bot"], "holder": counts["holder"]}, count(weighted))
+
+	weighted = simulation.WeightedProfileAccounts(accs, profiles, "bank")
+	require.Equal(t, map[string]int{"bot": counts["bot"], "holder": 2 * counts["holder"]}, count(weighted))
+
+	for _, acc := range accs {
+		_, found := simulation.FindAccount(weighted, acc.Address)
+		require.True(t, found)
+	}
+
+	// without profiles, the accounts are unchanged
+	require.Equal(t, accs, simulation.WeightedProfileAccounts(accs, nil, "bank"))
+}
@@ -1,5 +1,7 @@
 package simulation
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // Config contains the necessary configuration flags for the simulator
 type Config struct {
 	GenesisFile string // custom simulation genesis file; cannot be used with params file
@@ -9,6 +11,7 @@ type Config struct {
 	ExportParamsHeight int    // height to which export the randomly generated params
 	ExportStatePath    string // custom file path to save the exported app state JSON
 	ExportStatsPath    string // custom file path to save the exported simulation statistics JSON
+	ExportCoveragePath string // custom file path to save the exported coverage report JSON
 
 	Seed               int64  // simulation random seed
 	InitialBlockHeight int    // initial block to start the simulation
@@ -21,4 +24,8 @@ type Config struct {
 
 	OnOperation   bool // run slow invariants every operation
 	AllInvariants bool // print all failed invariants if a broken invariant is found
+
+	AccountProfiles []AccountProfile // behavior profiles of the simulation accounts; all accounts behave alike if empty
+
+	CoverageStores map[string]*sdk.KVStoreKey // stores whose changes are reported in the coverage report, by name
 }
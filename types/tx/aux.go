@@ -0,0 +1,80 @@
+package tx
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Interface implementation checks.
+var _, _ codectypes.UnpackInterfacesMessage = &SignDocDirectAux{}, &AuxSignerData{}
+
+// ValidateBasic performs stateless validation of the sign doc.
+func (s *SignDocDirectAux) ValidateBasic() error {
+	if len(s.BodyBytes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "body bytes cannot be empty")
+	}
+
+	if s.PublicKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "public key cannot be empty")
+	}
+
+	if s.ChainId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidChainID, "chain id cannot be empty")
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (s *SignDocDirectAux) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(s.PublicKey, new(cryptotypes.PubKey))
+}
+
+// ValidateBasic performs stateless validation of the auxiliary signer data.
+func (a AuxSignerData) ValidateBasic() error {
+	if a.Address == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	if a.SignDoc == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sign doc cannot be empty")
+	}
+
+	if a.Mode != signing.SignMode_SIGN_MODE_DIRECT_AUX {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "AuxSignerData mode must be %s, got %s", signing.SignMode_SIGN_MODE_DIRECT_AUX, a.Mode)
+	}
+
+	if len(a.Sig) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "signature cannot be empty")
+	}
+
+	return a.SignDoc.ValidateBasic()
+}
+
+// GetSignatureV2 gets the SignatureV2 of the auxiliary signer.
+func (a AuxSignerData) GetSignatureV2() (signing.SignatureV2, error) {
+	pk, ok := a.SignDoc.PublicKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return signing.SignatureV2{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (cryptotypes.PubKey)(nil), pk)
+	}
+
+	return signing.SignatureV2{
+		PubKey: pk,
+		Data: &signing.SingleSignatureData{
+			SignMode:  a.Mode,
+			Signature: a.Sig,
+		},
+		Sequence: a.SignDoc.Sequence,
+	}, nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (a *AuxSignerData) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if a.SignDoc == nil {
+		return nil
+	}
+
+	return a.SignDoc.UnpackInterfaces(unpacker)
+}
@@ -6,19 +6,24 @@ package tx
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	signing "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -26,6 +31,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -111,7 +117,16 @@ type GetTxsEventRequest struct {
 	Events []string `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	// pagination defines an pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	OrderBy    OrderBy            `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// order_by defines the order of the transactions, by height and then by
+	// index in the block. It defaults to descending when unspecified and
+	// pagination.reverse is set, and to ascending otherwise.
+	OrderBy OrderBy `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=cosmos.tx.v1beta1.OrderBy" json:"order_by,omitempty"`
+	// start_time, when set, only selects the transactions of the blocks with a
+	// time after or equal to it.
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end_time, when set, only selects the transactions of the blocks with a
+	// time before or equal to it.
+	EndTime *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
 }
 
 func (m *GetTxsEventRequest) Reset()         { *m = GetTxsEventRequest{} }
@@ -168,6 +183,20 @@ func (m *GetTxsEventRequest) GetOrderBy() OrderBy {
 	return OrderBy_ORDER_BY_UNSPECIFIED
 }
 
+func (m *GetTxsEventRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *GetTxsEventRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
 // GetTxsEventResponse is the response type for the Service.TxsByEvents
 // RPC method.
 type GetTxsEventResponse struct {
@@ -175,7 +204,9 @@ type GetTxsEventResponse struct {
 	Txs []*Tx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 	// tx_responses is the list of queried TxResponses.
 	TxResponses []*types.TxResponse `protobuf:"bytes,2,rep,name=tx_responses,json=txResponses,proto3" json:"tx_responses,omitempty"`
-	// pagination defines an pagination for the response.
+	// pagination defines an pagination for the response. Its total, the exact
+	// number of transactions matching the request, is only set if the request
+	// has no pagination or sets pagination.count_total.
 	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
@@ -553,6 +584,294 @@ func (m *GetTxResponse) GetTxResponse() *types.TxResponse {
 	return nil
 }
 
+// GetTxDecodingInfoRequest is the request type for the Service.GetTxDecodingInfo
+// RPC method.
+type GetTxDecodingInfoRequest struct {
+}
+
+func (m *GetTxDecodingInfoRequest) Reset()         { *m = GetTxDecodingInfoRequest{} }
+func (m *GetTxDecodingInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxDecodingInfoRequest) ProtoMessage()    {}
+func (*GetTxDecodingInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *GetTxDecodingInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxDecodingInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxDecodingInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxDecodingInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxDecodingInfoRequest.Merge(m, src)
+}
+func (m *GetTxDecodingInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxDecodingInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxDecodingInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxDecodingInfoRequest proto.InternalMessageInfo
+
+// GetTxDecodingInfoResponse is the response type for the
+// Service.GetTxDecodingInfo RPC method.
+type GetTxDecodingInfoResponse struct {
+	// decode_mode is the mode the node's tx service decodes the queried
+	// transactions with, either "strict" or "tolerant". The transactions executed
+	// by the node are always decoded strictly.
+	DecodeMode string `protobuf:"bytes,1,opt,name=decode_mode,json=decodeMode,proto3" json:"decode_mode,omitempty"`
+	// tolerates_unknown_non_critical_fields is true if unknown non-critical
+	// fields are accepted in both the TxBody and the AuthInfo of a queried
+	// transaction.
+	ToleratesUnknownNonCriticalFields bool `protobuf:"varint,2,opt,name=tolerates_unknown_non_critical_fields,json=toleratesUnknownNonCriticalFields,proto3" json:"tolerates_unknown_non_critical_fields,omitempty"`
+}
+
+func (m *GetTxDecodingInfoResponse) Reset()         { *m = GetTxDecodingInfoResponse{} }
+func (m *GetTxDecodingInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxDecodingInfoResponse) ProtoMessage()    {}
+func (*GetTxDecodingInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *GetTxDecodingInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTxDecodingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTxDecodingInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetTxDecodingInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxDecodingInfoResponse.Merge(m, src)
+}
+func (m *GetTxDecodingInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTxDecodingInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxDecodingInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxDecodingInfoResponse proto.InternalMessageInfo
+
+func (m *GetTxDecodingInfoResponse) GetDecodeMode() string {
+	if m != nil {
+		return m.DecodeMode
+	}
+	return ""
+}
+
+func (m *GetTxDecodingInfoResponse) GetToleratesUnknownNonCriticalFields() bool {
+	if m != nil {
+		return m.ToleratesUnknownNonCriticalFields
+	}
+	return false
+}
+
+// EstimateTxSizeRequest is the request type for the Service.EstimateTxSize
+// RPC method.
+type EstimateTxSizeRequest struct {
+	// tx_bytes is the raw unsigned transaction. Its signer infos and signatures,
+	// if any, are replaced by the ones of the signers.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// signers are the signers of the transaction, in order.
+	Signers []*TxSizeSigner `protobuf:"bytes,2,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *EstimateTxSizeRequest) Reset()         { *m = EstimateTxSizeRequest{} }
+func (m *EstimateTxSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateTxSizeRequest) ProtoMessage()    {}
+func (*EstimateTxSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{10}
+}
+func (m *EstimateTxSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateTxSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateTxSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateTxSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTxSizeRequest.Merge(m, src)
+}
+func (m *EstimateTxSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateTxSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTxSizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateTxSizeRequest proto.InternalMessageInfo
+
+func (m *EstimateTxSizeRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *EstimateTxSizeRequest) GetSigners() []*TxSizeSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// TxSizeSigner describes a signer of a transaction whose size is estimated.
+type TxSizeSigner struct {
+	// key_type is the type of the signer's public key, one of "secp256k1",
+	// "ed25519", "secp256r1" or "bls12381".
+	KeyType string `protobuf:"bytes,1,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	// sequence is the sequence of the signer's account.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sign_mode is the mode the signer signs with, SIGN_MODE_DIRECT if
+	// unspecified.
+	SignMode signing.SignMode `protobuf:"varint,3,opt,name=sign_mode,json=signMode,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"sign_mode,omitempty"`
+}
+
+func (m *TxSizeSigner) Reset()         { *m = TxSizeSigner{} }
+func (m *TxSizeSigner) String() string { return proto.CompactTextString(m) }
+func (*TxSizeSigner) ProtoMessage()    {}
+func (*TxSizeSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{11}
+}
+func (m *TxSizeSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxSizeSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxSizeSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxSizeSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSizeSigner.Merge(m, src)
+}
+func (m *TxSizeSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxSizeSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSizeSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSizeSigner proto.InternalMessageInfo
+
+func (m *TxSizeSigner) GetKeyType() string {
+	if m != nil {
+		return m.KeyType
+	}
+	return ""
+}
+
+func (m *TxSizeSigner) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TxSizeSigner) GetSignMode() signing.SignMode {
+	if m != nil {
+		return m.SignMode
+	}
+	return signing.SignMode_SIGN_MODE_UNSPECIFIED
+}
+
+// EstimateTxSizeResponse is the response type for the Service.EstimateTxSize
+// RPC method.
+type EstimateTxSizeResponse struct {
+	// tx_size is the size in bytes of the signed transaction.
+	TxSize uint64 `protobuf:"varint,1,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	// gas_limit is the gas limit of the transaction.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// min_fee lists the minimum fee of the transaction in each denom of the
+	// node's minimum gas prices, given its gas limit. Paying the fee in any of
+	// these denoms satisfies the node.
+	MinFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_fee,json=minFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fee"`
+}
+
+func (m *EstimateTxSizeResponse) Reset()         { *m = EstimateTxSizeResponse{} }
+func (m *EstimateTxSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateTxSizeResponse) ProtoMessage()    {}
+func (*EstimateTxSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{12}
+}
+func (m *EstimateTxSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateTxSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateTxSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EstimateTxSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateTxSizeResponse.Merge(m, src)
+}
+func (m *EstimateTxSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateTxSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateTxSizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateTxSizeResponse proto.InternalMessageInfo
+
+func (m *EstimateTxSizeResponse) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+func (m *EstimateTxSizeResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *EstimateTxSizeResponse) GetMinFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	golang_proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
@@ -574,6 +893,16 @@ func init() {
 	golang_proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	golang_proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*GetTxDecodingInfoRequest)(nil), "cosmos.tx.v1beta1.GetTxDecodingInfoRequest")
+	golang_proto.RegisterType((*GetTxDecodingInfoRequest)(nil), "cosmos.tx.v1beta1.GetTxDecodingInfoRequest")
+	proto.RegisterType((*GetTxDecodingInfoResponse)(nil), "cosmos.tx.v1beta1.GetTxDecodingInfoResponse")
+	golang_proto.RegisterType((*GetTxDecodingInfoResponse)(nil), "cosmos.tx.v1beta1.GetTxDecodingInfoResponse")
+	proto.RegisterType((*EstimateTxSizeRequest)(nil), "cosmos.tx.v1beta1.EstimateTxSizeRequest")
+	golang_proto.RegisterType((*EstimateTxSizeRequest)(nil), "cosmos.tx.v1beta1.EstimateTxSizeRequest")
+	proto.RegisterType((*TxSizeSigner)(nil), "cosmos.tx.v1beta1.TxSizeSigner")
+	golang_proto.RegisterType((*TxSizeSigner)(nil), "cosmos.tx.v1beta1.TxSizeSigner")
+	proto.RegisterType((*EstimateTxSizeResponse)(nil), "cosmos.tx.v1beta1.EstimateTxSizeResponse")
+	golang_proto.RegisterType((*EstimateTxSizeResponse)(nil), "cosmos.tx.v1beta1.EstimateTxSizeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
@@ -582,59 +911,86 @@ func init() {
 }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x3b, 0x65, 0x93, 0xbe, 0x64, 0x4b, 0x3a, 0xbb, 0x14, 0x93, 0x82, 0x37, 0x75, 0xc9,
-	0x36, 0x44, 0xc2, 0x56, 0x03, 0x48, 0x08, 0x71, 0x89, 0x93, 0x74, 0x59, 0x41, 0x9b, 0x6a, 0xb2,
-	0x08, 0x15, 0x21, 0x45, 0x4e, 0x32, 0xf5, 0x5a, 0x6c, 0x3c, 0x59, 0xcf, 0x64, 0xe5, 0xa8, 0xad,
-	0x90, 0x38, 0x72, 0x42, 0xe2, 0x67, 0xf0, 0x27, 0x38, 0x72, 0x5c, 0x89, 0x0b, 0x47, 0xb4, 0xe1,
-	0x47, 0x70, 0x44, 0x1e, 0x4f, 0x12, 0x27, 0xeb, 0x74, 0x11, 0xa7, 0xbc, 0xc9, 0x7c, 0xef, 0x7b,
-	0xdf, 0xfb, 0xe6, 0xcd, 0x18, 0xf6, 0x07, 0x94, 0x8d, 0x28, 0xb3, 0x78, 0x68, 0x9d, 0x3f, 0xec,
-	0x13, 0xee, 0x3c, 0xb4, 0x18, 0x09, 0xce, 0xbd, 0x01, 0x31, 0xc7, 0x01, 0xe5, 0x14, 0xdd, 0x8e,
-	0x01, 0x26, 0x0f, 0x4d, 0x09, 0x28, 0xbd, 0xeb, 0x52, 0xea, 0x9e, 0x12, 0xcb, 0x19, 0x7b, 0x96,
-	0xe3, 0xfb, 0x94, 0x3b, 0xdc, 0xa3, 0x3e, 0x8b, 0x13, 0x4a, 0xf7, 0x25, 0x63, 0xdf, 0x61, 0xc4,
-	0x72, 0xfa, 0x03, 0x6f, 0x41, 0x1c, 0x2d, 0x24, 0xa8, 0x74, 0xb5, 0x2c, 0x0f, 0xe5, 0xde, 0x9e,
-	0x4b, 0x5d, 0x2a, 0x42, 0x2b, 0x8a, 0xe4, 0xbf, 0xb5, 0x24, 0xed, 0xd9, 0x84, 0x04, 0xd3, 0x45,
-	0xe6, 0xd8, 0x71, 0x3d, 0x5f, 0x68, 0x88, 0xb1, 0xc6, 0xaf, 0x0a, 0xa0, 0x43, 0xc2, 0x8f, 0x43,
-	0xd6, 0x3e, 0x27, 0x3e, 0xc7, 0xe4, 0x6c, 0x42, 0x18, 0x47, 0x77, 0x60, 0x9b, 0x44, 0x6b, 0xa6,
-	0x29, 0xe5, 0x4c, 0xf5, 0x26, 0x96, 0x2b, 0xf4, 0x08, 0x60, 0x49, 0xa1, 0xa9, 0x65, 0xa5, 0x9a,
-	0xaf, 0x1f, 0x98, 0xb2, 0xef, 0xa8, 0x9e, 0x29, 0xea, 0xcd, 0xfb, 0x37, 0x9f, 0x3a, 0x2e, 0x91,
-	0x9c, 0x38, 0x91, 0x89, 0x3e, 0x81, 0x1c, 0x0d, 0x86, 0x24, 0xe8, 0xf5, 0xa7, 0x5a, 0xa6, 0xac,
-	0x54, 0x6f, 0xd5, 0x4b, 0xe6, 0x15, 0xf7, 0xcc, 0x4e, 0x04, 0xb1, 0xa7, 0x38, 0x4b, 0xe3, 0xc0,
-	0xb8, 0x50, 0x60, 0x77, 0x45, 0x2d, 0x1b, 0x53, 0x9f, 0x11, 0xf4, 0x00, 0x32, 0x3c, 0x8c, 0xb5,
-	0xe6, 0xeb, 0x6f, 0xa5, 0x30, 0x1d, 0x87, 0x38, 0x42, 0xa0, 0x43, 0x28, 0xf0, 0xb0, 0x17, 0xc8,
-	0x3c, 0xa6, 0xa9, 0x22, 0xe3, 0xfd, 0x95, 0x0e, 0x84, 0xf7, 0x89, 0x44, 0x09, 0xc6, 0x79, 0xbe,
-	0x88, 0x23, 0xa2, 0xa4, 0x11, 0x19, 0x61, 0xc4, 0x83, 0x6b, 0x8d, 0x90, 0x4c, 0x89, 0x54, 0x83,
-	0x00, 0xb2, 0x03, 0xea, 0x0c, 0x07, 0x0e, 0xe3, 0x51, 0xb1, 0xd8, 0xff, 0x77, 0x20, 0xc7, 0xc3,
-	0x5e, 0x7f, 0xca, 0x49, 0xd4, 0x95, 0x52, 0x2d, 0xe0, 0x2c, 0x0f, 0xed, 0x68, 0x89, 0x3e, 0x86,
-	0x1b, 0x23, 0x3a, 0x24, 0xc2, 0xfc, 0x5b, 0xf5, 0x72, 0x4a, 0xb3, 0x0b, 0xbe, 0xc7, 0x74, 0x48,
-	0xb0, 0x40, 0x1b, 0xdf, 0xc1, 0xee, 0x4a, 0x19, 0x69, 0x5c, 0x1b, 0xf2, 0x09, 0x3f, 0x44, 0xa9,
-	0xff, 0x6a, 0x07, 0x2c, 0xed, 0x30, 0xbe, 0x81, 0x37, 0xbb, 0xde, 0x68, 0x72, 0xea, 0xf0, 0xf9,
-	0x69, 0xa3, 0x0f, 0x40, 0xe5, 0xa1, 0x24, 0x4c, 0x3f, 0x11, 0x5b, 0xd5, 0x14, 0xac, 0xf2, 0x70,
-	0xa5, 0x59, 0x75, 0xa5, 0x59, 0xe3, 0x27, 0x05, 0x8a, 0x4b, 0x66, 0x29, 0xfa, 0x73, 0xc8, 0xb9,
-	0x0e, 0xeb, 0x79, 0xfe, 0x73, 0x2a, 0x0b, 0xdc, 0xdb, 0xac, 0xf8, 0xd0, 0x61, 0x47, 0xfe, 0x73,
-	0x8a, 0xb3, 0x6e, 0x1c, 0xa0, 0x4f, 0x61, 0x3b, 0x20, 0x6c, 0x72, 0xca, 0xe5, 0xf8, 0x96, 0x37,
-	0xe7, 0x62, 0x81, 0xc3, 0x12, 0x6f, 0x18, 0x50, 0x10, 0xc3, 0x37, 0x6f, 0x11, 0xc1, 0x8d, 0x13,
-	0x87, 0x9d, 0x08, 0x0d, 0x37, 0xb1, 0x88, 0x8d, 0x57, 0xb0, 0x23, 0x31, 0x52, 0x6c, 0xe5, 0x5a,
-	0x1f, 0x84, 0x07, 0x6b, 0x07, 0xa1, 0xfe, 0xbf, 0x83, 0xa8, 0x7d, 0x01, 0x59, 0x79, 0x69, 0x90,
-	0x06, 0x7b, 0x1d, 0xdc, 0x6a, 0xe3, 0x9e, 0xfd, 0xac, 0xf7, 0xf5, 0x93, 0xee, 0xd3, 0x76, 0xf3,
-	0xe8, 0xd1, 0x51, 0xbb, 0x55, 0xdc, 0x42, 0x45, 0x28, 0x2c, 0x76, 0x1a, 0xdd, 0x66, 0x51, 0x41,
-	0xb7, 0x61, 0x67, 0xf1, 0x4f, 0xab, 0xdd, 0x6d, 0x16, 0xd5, 0xda, 0x4b, 0xd8, 0x59, 0x99, 0x23,
-	0xa4, 0x43, 0xc9, 0xc6, 0x9d, 0x46, 0xab, 0xd9, 0xe8, 0x1e, 0xf7, 0x1e, 0x77, 0x5a, 0xed, 0x35,
-	0x56, 0x0d, 0xf6, 0xd6, 0xf6, 0xed, 0xaf, 0x3a, 0xcd, 0x2f, 0x8b, 0x0a, 0x7a, 0x1b, 0x76, 0xd7,
-	0x76, 0xba, 0xcf, 0x9e, 0x34, 0x8b, 0x6a, 0x4a, 0x4a, 0x43, 0xec, 0x64, 0xea, 0xff, 0x64, 0x20,
-	0xdb, 0x8d, 0x1f, 0x57, 0xf4, 0x02, 0x72, 0xf3, 0x11, 0x40, 0x46, 0x8a, 0x83, 0x6b, 0x93, 0x57,
-	0xba, 0xff, 0x5a, 0x8c, 0x9c, 0xd8, 0x83, 0x1f, 0xff, 0xf8, 0xfb, 0x17, 0xb5, 0x6c, 0xdc, 0xb5,
-	0x52, 0x5e, 0x75, 0x09, 0xfe, 0x4c, 0xa9, 0xa1, 0x33, 0x78, 0x43, 0x9c, 0x27, 0xda, 0x4f, 0x61,
-	0x4d, 0x4e, 0x43, 0xa9, 0xbc, 0x19, 0x20, 0x6b, 0x56, 0x44, 0xcd, 0x7d, 0xf4, 0x9e, 0x95, 0xf6,
-	0xa4, 0x33, 0xeb, 0x45, 0x34, 0x41, 0xaf, 0xd0, 0x0f, 0x90, 0x4f, 0x5c, 0x55, 0x54, 0x79, 0xdd,
-	0x0d, 0x5f, 0x96, 0x3f, 0xb8, 0x0e, 0x26, 0x45, 0xdc, 0x13, 0x22, 0xee, 0x1a, 0x77, 0xd2, 0x45,
-	0x44, 0x3d, 0xbf, 0x84, 0x7c, 0xe2, 0x91, 0x4d, 0x15, 0x70, 0xf5, 0x93, 0x91, 0x2a, 0x20, 0xe5,
-	0xad, 0x36, 0x74, 0x21, 0x40, 0x43, 0x1b, 0x04, 0xd8, 0xcd, 0xdf, 0x2f, 0x75, 0xe5, 0xe2, 0x52,
-	0x57, 0xfe, 0xba, 0xd4, 0x95, 0x9f, 0x67, 0xfa, 0xd6, 0x6f, 0x33, 0x5d, 0xb9, 0x98, 0xe9, 0x5b,
-	0x7f, 0xce, 0xf4, 0xad, 0x6f, 0x2b, 0xae, 0xc7, 0x4f, 0x26, 0x7d, 0x73, 0x40, 0x47, 0xf3, 0xfc,
-	0xf8, 0xe7, 0x43, 0x36, 0xfc, 0xde, 0xe2, 0xd3, 0x31, 0x89, 0x08, 0xfb, 0xdb, 0xe2, 0xeb, 0xf6,
-	0xd1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x86, 0xcd, 0x5c, 0xb4, 0x07, 0x00, 0x00,
+	// 1255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x69, 0xec, 0x3c, 0xa7, 0xc5, 0x9d, 0xfe, 0x73, 0xb7, 0x60, 0xbb, 0x5b, 0x92,
+	0xba, 0x29, 0x78, 0x69, 0x00, 0x09, 0x0a, 0x12, 0xc4, 0x8e, 0x53, 0x2a, 0xda, 0xa6, 0x5a, 0xbb,
+	0x42, 0x45, 0x48, 0xab, 0xb5, 0x77, 0xb2, 0x1d, 0xc5, 0xde, 0x71, 0x77, 0xc6, 0x65, 0xdd, 0x3f,
+	0x42, 0xe2, 0x82, 0xc4, 0x01, 0x55, 0x70, 0xe1, 0x23, 0x00, 0x67, 0x3e, 0x00, 0xc7, 0x1e, 0x2b,
+	0x71, 0xe1, 0x44, 0x51, 0xc3, 0x07, 0x41, 0x33, 0x3b, 0xeb, 0xac, 0x9d, 0x4d, 0x13, 0x71, 0xda,
+	0xf9, 0xf3, 0x7b, 0xef, 0xfd, 0xde, 0xef, 0xcd, 0xbe, 0x19, 0x28, 0x77, 0x29, 0xeb, 0x53, 0x66,
+	0xf2, 0xd0, 0x7c, 0x70, 0xa5, 0x83, 0xb9, 0x73, 0xc5, 0x64, 0x38, 0x78, 0x40, 0xba, 0xb8, 0x36,
+	0x08, 0x28, 0xa7, 0xe8, 0x78, 0x04, 0xa8, 0xf1, 0xb0, 0xa6, 0x00, 0xfa, 0xeb, 0x1e, 0xa5, 0x5e,
+	0x0f, 0x9b, 0xce, 0x80, 0x98, 0x8e, 0xef, 0x53, 0xee, 0x70, 0x42, 0x7d, 0x16, 0x19, 0xe8, 0x17,
+	0x94, 0xc7, 0x8e, 0xc3, 0xb0, 0xe9, 0x74, 0xba, 0x64, 0xec, 0x58, 0x4c, 0x14, 0x48, 0xdf, 0x1b,
+	0x96, 0x87, 0x6a, 0xef, 0xa4, 0x47, 0x3d, 0x2a, 0x87, 0xa6, 0x18, 0xa9, 0xd5, 0xb2, 0x0a, 0x2a,
+	0x67, 0x9d, 0xe1, 0x96, 0xc9, 0x49, 0x1f, 0x33, 0xee, 0xf4, 0x07, 0x0a, 0xb0, 0x92, 0x8c, 0x7b,
+	0x7f, 0x88, 0x83, 0xd1, 0xd8, 0xf5, 0xc0, 0xf1, 0x88, 0x2f, 0x49, 0x2a, 0x6c, 0x29, 0x89, 0x8d,
+	0x51, 0x5d, 0x4a, 0xe2, 0xfd, 0x8b, 0xbb, 0xf4, 0x18, 0xf1, 0x7c, 0xe2, 0x7b, 0xbb, 0xea, 0x44,
+	0xf3, 0x08, 0x68, 0xfc, 0x9a, 0x01, 0x74, 0x0d, 0xf3, 0x76, 0xc8, 0x9a, 0x0f, 0xb0, 0xcf, 0x2d,
+	0x7c, 0x7f, 0x88, 0x19, 0x47, 0xa7, 0x61, 0x1e, 0x8b, 0x39, 0x2b, 0x6a, 0x95, 0xd9, 0xea, 0x82,
+	0xa5, 0x66, 0x68, 0x03, 0x60, 0x97, 0x4b, 0x31, 0x53, 0xd1, 0xaa, 0xf9, 0xd5, 0xe5, 0x9a, 0x52,
+	0x58, 0x90, 0xa9, 0x49, 0xe2, 0xb1, 0xd2, 0xb5, 0xdb, 0x8e, 0x87, 0x95, 0x4f, 0x2b, 0x61, 0x89,
+	0xde, 0x87, 0x1c, 0x0d, 0x5c, 0x1c, 0xd8, 0x9d, 0x51, 0x71, 0xb6, 0xa2, 0x55, 0x8f, 0xad, 0xea,
+	0xb5, 0x3d, 0x75, 0xaa, 0x6d, 0x0a, 0x48, 0x7d, 0x64, 0x65, 0x69, 0x34, 0x40, 0x9f, 0x00, 0x30,
+	0xee, 0x04, 0xdc, 0x16, 0xda, 0x15, 0xe7, 0x64, 0x78, 0xbd, 0x16, 0x09, 0x5b, 0x8b, 0x85, 0xad,
+	0xb5, 0x63, 0x61, 0xeb, 0x73, 0x4f, 0x5f, 0x94, 0x35, 0x6b, 0x41, 0xda, 0x88, 0x55, 0xf4, 0x11,
+	0xe4, 0xb0, 0xef, 0x46, 0xe6, 0x47, 0x0e, 0x69, 0x9e, 0xc5, 0xbe, 0x2b, 0xd6, 0x8c, 0xe7, 0x1a,
+	0x9c, 0x98, 0xd0, 0x8a, 0x0d, 0xa8, 0xcf, 0x30, 0xba, 0x08, 0xb3, 0x3c, 0x8c, 0x94, 0xca, 0xaf,
+	0x9e, 0x4a, 0xc9, 0xa3, 0x1d, 0x5a, 0x02, 0x81, 0xae, 0xc1, 0x22, 0x0f, 0xed, 0x40, 0xd9, 0xb1,
+	0x62, 0x46, 0x5a, 0xbc, 0x39, 0xa1, 0x9f, 0x3c, 0x63, 0x09, 0x43, 0x05, 0xb6, 0xf2, 0x7c, 0x3c,
+	0x16, 0x8e, 0x92, 0x65, 0x98, 0x95, 0x89, 0x5c, 0x3c, 0xb0, 0x0c, 0xca, 0x53, 0xc2, 0xd4, 0xc0,
+	0x80, 0xea, 0x01, 0x75, 0xdc, 0xae, 0xc3, 0x78, 0x3b, 0x54, 0x95, 0x42, 0x67, 0x21, 0xc7, 0x43,
+	0xbb, 0x33, 0xe2, 0x58, 0x64, 0xa5, 0x55, 0x17, 0xad, 0x2c, 0x0f, 0xeb, 0x62, 0x8a, 0xde, 0x83,
+	0xb9, 0x3e, 0x75, 0xb1, 0x2c, 0xfd, 0xb1, 0xd5, 0x4a, 0x4a, 0xb2, 0x63, 0x7f, 0x37, 0xa9, 0x8b,
+	0x2d, 0x89, 0x36, 0xbe, 0x82, 0x13, 0x13, 0x61, 0x94, 0x70, 0x4d, 0xc8, 0x27, 0xf4, 0x90, 0xa1,
+	0x0e, 0x2b, 0x07, 0xec, 0xca, 0x61, 0x7c, 0x01, 0xaf, 0xb5, 0x48, 0x7f, 0xd8, 0x73, 0x78, 0x7c,
+	0xd6, 0xd0, 0x25, 0xc8, 0xf0, 0x50, 0x39, 0x4c, 0xaf, 0x48, 0x3d, 0x53, 0xd4, 0xac, 0x0c, 0x0f,
+	0x27, 0x92, 0xcd, 0x4c, 0x24, 0x6b, 0x7c, 0xaf, 0x41, 0x61, 0xd7, 0xb3, 0x22, 0xfd, 0x31, 0xe4,
+	0x3c, 0x87, 0xd9, 0xc4, 0xdf, 0xa2, 0x2a, 0xc0, 0xf9, 0xfd, 0x19, 0x5f, 0x73, 0xd8, 0x75, 0x7f,
+	0x8b, 0x5a, 0x59, 0x2f, 0x1a, 0xa0, 0x0f, 0x60, 0x3e, 0xc0, 0x6c, 0xd8, 0xe3, 0xea, 0xe7, 0xa9,
+	0xec, 0x6f, 0x6b, 0x49, 0x9c, 0xa5, 0xf0, 0x86, 0x01, 0x8b, 0xf2, 0xf0, 0xc5, 0x29, 0x22, 0x98,
+	0xbb, 0xe7, 0xb0, 0x7b, 0x92, 0xc3, 0x82, 0x25, 0xc7, 0xc6, 0x13, 0x38, 0xaa, 0x30, 0x8a, 0xec,
+	0xd2, 0x81, 0x3a, 0x48, 0x0d, 0xa6, 0x0a, 0x91, 0xf9, 0x9f, 0x85, 0xd0, 0xa1, 0x28, 0xc3, 0xaf,
+	0xe3, 0x2e, 0x75, 0x89, 0xef, 0xc9, 0xd4, 0x23, 0xba, 0xc6, 0x0f, 0x1a, 0x9c, 0x4d, 0xd9, 0x54,
+	0x3c, 0xcb, 0x90, 0x77, 0xc5, 0x3a, 0xb6, 0xe5, 0xe9, 0x8a, 0x72, 0x82, 0x68, 0x49, 0x9c, 0x23,
+	0x74, 0x1b, 0x96, 0x38, 0xed, 0xe1, 0xc0, 0xe1, 0x98, 0xd9, 0x43, 0x7f, 0xdb, 0xa7, 0x5f, 0xfb,
+	0xb6, 0x4f, 0x7d, 0xbb, 0x1b, 0x10, 0x4e, 0xba, 0x4e, 0xcf, 0xde, 0x22, 0xb8, 0xe7, 0x46, 0x25,
+	0xcc, 0x59, 0xe7, 0xc7, 0xe0, 0x3b, 0x11, 0xf6, 0x16, 0xf5, 0x1b, 0x0a, 0xb9, 0x21, 0x81, 0x46,
+	0x1f, 0x4e, 0x35, 0x19, 0x27, 0x7d, 0x87, 0xe3, 0x76, 0xd8, 0x22, 0x0f, 0xf1, 0x21, 0x4e, 0xff,
+	0x87, 0x90, 0x15, 0xed, 0x13, 0x07, 0xf1, 0xbf, 0x5b, 0x4e, 0xd5, 0x54, 0x78, 0x6b, 0x49, 0x9c,
+	0x15, 0xe3, 0x8d, 0xef, 0x34, 0x58, 0x4c, 0xee, 0x88, 0x30, 0xdb, 0x78, 0x64, 0xf3, 0xd1, 0x20,
+	0xce, 0x37, 0xbb, 0x8d, 0x47, 0xed, 0xd1, 0x00, 0x23, 0x1d, 0x72, 0x4c, 0x90, 0xf1, 0xbb, 0x51,
+	0x2d, 0xe6, 0xac, 0xf1, 0x1c, 0x7d, 0x0a, 0x0b, 0xc2, 0x65, 0xa4, 0x53, 0xd4, 0x3a, 0x2f, 0x24,
+	0x48, 0xc4, 0xdd, 0x3d, 0x26, 0x23, 0x82, 0xc9, 0x1f, 0x31, 0xc7, 0xd4, 0xc8, 0xf8, 0x5d, 0x83,
+	0xd3, 0xd3, 0x99, 0xab, 0x32, 0x9c, 0x81, 0x2c, 0x0f, 0x6d, 0x46, 0x1e, 0x46, 0x94, 0xe6, 0xac,
+	0x79, 0x2e, 0x01, 0xe8, 0x1c, 0x2c, 0x88, 0x43, 0xdf, 0x23, 0x7d, 0xc2, 0x63, 0x4a, 0x9e, 0xc3,
+	0x6e, 0x88, 0x39, 0x72, 0x21, 0xdb, 0x27, 0xbe, 0xbd, 0x85, 0x05, 0x21, 0xa1, 0xca, 0xd9, 0x89,
+	0x93, 0x13, 0x53, 0x69, 0x50, 0xe2, 0xd7, 0xdf, 0x79, 0xf6, 0x77, 0x79, 0xe6, 0xb7, 0x17, 0xe5,
+	0xaa, 0x47, 0xf8, 0xbd, 0x61, 0xa7, 0xd6, 0xa5, 0x7d, 0x53, 0xdd, 0x55, 0xd1, 0xe7, 0x6d, 0xe6,
+	0x6e, 0x9b, 0x42, 0x14, 0x26, 0x0d, 0x98, 0x35, 0xdf, 0x27, 0xfe, 0x06, 0xc6, 0x2b, 0x9f, 0x41,
+	0x56, 0xdd, 0x07, 0xa8, 0x08, 0x27, 0x37, 0xad, 0xf5, 0xa6, 0x65, 0xd7, 0xef, 0xda, 0x77, 0x6e,
+	0xb5, 0x6e, 0x37, 0x1b, 0xd7, 0x37, 0xae, 0x37, 0xd7, 0x0b, 0x33, 0xa8, 0x00, 0x8b, 0xe3, 0x9d,
+	0xb5, 0x56, 0xa3, 0xa0, 0xa1, 0xe3, 0x70, 0x74, 0xbc, 0xb2, 0xde, 0x6c, 0x35, 0x0a, 0x99, 0x95,
+	0xc7, 0x70, 0x74, 0xa2, 0x49, 0xa1, 0x12, 0xe8, 0x75, 0x6b, 0x73, 0x6d, 0xbd, 0xb1, 0xd6, 0x6a,
+	0xdb, 0x37, 0x37, 0xd7, 0x9b, 0x53, 0x5e, 0x8b, 0x70, 0x72, 0x6a, 0xbf, 0x7e, 0x63, 0xb3, 0xf1,
+	0x79, 0x41, 0x43, 0x67, 0xe0, 0xc4, 0xd4, 0x4e, 0xeb, 0xee, 0xad, 0x46, 0x21, 0x93, 0x62, 0xb2,
+	0x26, 0x77, 0x66, 0x57, 0x7f, 0x99, 0x87, 0x6c, 0x2b, 0x7a, 0xa1, 0xa0, 0x47, 0x90, 0x8b, 0xfb,
+	0x0b, 0x32, 0x52, 0x8e, 0xd2, 0x54, 0x5b, 0xd3, 0x2f, 0xbc, 0x12, 0xa3, 0xfe, 0xc2, 0xe5, 0x6f,
+	0xff, 0xfc, 0xf7, 0xa7, 0x4c, 0xc5, 0x38, 0x67, 0xa6, 0x3c, 0x8d, 0x14, 0xf8, 0xaa, 0xb6, 0x82,
+	0xee, 0xc3, 0x11, 0xf9, 0x43, 0xa2, 0xb4, 0x43, 0x9c, 0x6c, 0x35, 0x7a, 0x65, 0x7f, 0x80, 0x8a,
+	0xb9, 0x24, 0x63, 0x96, 0xd1, 0x1b, 0x66, 0xda, 0xbb, 0x88, 0x99, 0x8f, 0x44, 0x7b, 0x7a, 0x82,
+	0xbe, 0x81, 0x7c, 0xe2, 0x1e, 0x40, 0x4b, 0xaf, 0xba, 0x3e, 0x76, 0xc3, 0x2f, 0x1f, 0x04, 0x53,
+	0x24, 0xce, 0x4b, 0x12, 0xe7, 0x8c, 0xd3, 0xe9, 0x24, 0x44, 0xce, 0x8f, 0x21, 0x9f, 0xb8, 0xc1,
+	0x53, 0x09, 0xec, 0x7d, 0x0d, 0xe9, 0xcb, 0x07, 0xc1, 0x14, 0x81, 0x92, 0x24, 0x50, 0x44, 0xfb,
+	0x10, 0x40, 0x3f, 0x6b, 0x70, 0x7c, 0x4f, 0x0f, 0x44, 0x97, 0xf7, 0xf3, 0x9e, 0xd2, 0x46, 0xf5,
+	0xb7, 0x0e, 0x07, 0x56, 0x84, 0xaa, 0x92, 0x90, 0x81, 0x2a, 0x29, 0x84, 0x5c, 0x65, 0x20, 0x6f,
+	0x32, 0xf4, 0xa3, 0x06, 0xc7, 0x26, 0x9b, 0x02, 0xaa, 0xa6, 0x84, 0x4a, 0xed, 0x98, 0xfa, 0xa5,
+	0x43, 0x20, 0x15, 0xa3, 0xcb, 0x92, 0xd1, 0x92, 0x91, 0xc6, 0x08, 0x2b, 0x13, 0xd9, 0x80, 0xae,
+	0x6a, 0x2b, 0xf5, 0xc6, 0xb3, 0x97, 0x25, 0xed, 0xf9, 0xcb, 0x92, 0xf6, 0xcf, 0xcb, 0x92, 0xf6,
+	0x74, 0xa7, 0x34, 0xf3, 0xc7, 0x4e, 0x49, 0x7b, 0xbe, 0x53, 0x9a, 0xf9, 0x6b, 0xa7, 0x34, 0xf3,
+	0xe5, 0xd2, 0xc1, 0x2d, 0xc4, 0xe4, 0x61, 0x67, 0x5e, 0x3e, 0xec, 0xde, 0xfd, 0x6f, 0x00, 0x06,
+	0xdd, 0x83, 0xef, 0x29, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -657,6 +1013,12 @@ type ServiceClient interface {
 	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
+	// GetTxDecodingInfo returns how the node's tx service decodes the queried
+	// transactions.
+	GetTxDecodingInfo(ctx context.Context, in *GetTxDecodingInfoRequest, opts ...grpc.CallOption) (*GetTxDecodingInfoResponse, error)
+	// EstimateTxSize returns the exact size of an unsigned transaction once
+	// signed by the given signers, and the minimum fee the node accepts for it.
+	EstimateTxSize(ctx context.Context, in *EstimateTxSizeRequest, opts ...grpc.CallOption) (*EstimateTxSizeResponse, error)
 }
 
 type serviceClient struct {
@@ -703,6 +1065,24 @@ func (c *serviceClient) GetTxsEvent(ctx context.Context, in *GetTxsEventRequest,
 	return out, nil
 }
 
+func (c *serviceClient) GetTxDecodingInfo(ctx context.Context, in *GetTxDecodingInfoRequest, opts ...grpc.CallOption) (*GetTxDecodingInfoResponse, error) {
+	out := new(GetTxDecodingInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTxDecodingInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) EstimateTxSize(ctx context.Context, in *EstimateTxSizeRequest, opts ...grpc.CallOption) (*EstimateTxSizeResponse, error) {
+	out := new(EstimateTxSizeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/EstimateTxSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -713,6 +1093,12 @@ type ServiceServer interface {
 	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
 	// GetTxsEvent fetches txs by event.
 	GetTxsEvent(context.Context, *GetTxsEventRequest) (*GetTxsEventResponse, error)
+	// GetTxDecodingInfo returns how the node's tx service decodes the queried
+	// transactions.
+	GetTxDecodingInfo(context.Context, *GetTxDecodingInfoRequest) (*GetTxDecodingInfoResponse, error)
+	// EstimateTxSize returns the exact size of an unsigned transaction once
+	// signed by the given signers, and the minimum fee the node accepts for it.
+	EstimateTxSize(context.Context, *EstimateTxSizeRequest) (*EstimateTxSizeResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -731,6 +1117,12 @@ func (*UnimplementedServiceServer) BroadcastTx(ctx context.Context, req *Broadca
 func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsEventRequest) (*GetTxsEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTxsEvent not implemented")
 }
+func (*UnimplementedServiceServer) GetTxDecodingInfo(ctx context.Context, req *GetTxDecodingInfoRequest) (*GetTxDecodingInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxDecodingInfo not implemented")
+}
+func (*UnimplementedServiceServer) EstimateTxSize(ctx context.Context, req *EstimateTxSizeRequest) (*EstimateTxSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxSize not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -808,6 +1200,42 @@ func _Service_GetTxsEvent_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetTxDecodingInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxDecodingInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetTxDecodingInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetTxDecodingInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetTxDecodingInfo(ctx, req.(*GetTxDecodingInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_EstimateTxSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateTxSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).EstimateTxSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/EstimateTxSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).EstimateTxSize(ctx, req.(*EstimateTxSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -828,6 +1256,14 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxsEvent",
 			Handler:    _Service_GetTxsEvent_Handler,
 		},
+		{
+			MethodName: "GetTxDecodingInfo",
+			Handler:    _Service_GetTxDecodingInfo_Handler,
+		},
+		{
+			MethodName: "EstimateTxSize",
+			Handler:    _Service_EstimateTxSize_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -853,6 +1289,26 @@ func (m *GetTxsEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintService(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintService(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.OrderBy != 0 {
 		i = encodeVarintService(dAtA, i, uint64(m.OrderBy))
 		i--
@@ -1181,46 +1637,248 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetTxDecodingInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetTxsEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for _, s := range m.Events {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.OrderBy != 0 {
-		n += 1 + sovService(uint64(m.OrderBy))
-	}
-	return n
+
+func (m *GetTxDecodingInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxsEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetTxDecodingInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxDecodingInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxDecodingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxDecodingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToleratesUnknownNonCriticalFields {
+		i--
+		if m.ToleratesUnknownNonCriticalFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DecodeMode) > 0 {
+		i -= len(m.DecodeMode)
+		copy(dAtA[i:], m.DecodeMode)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DecodeMode)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateTxSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateTxSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateTxSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxSizeSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxSizeSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxSizeSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignMode != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.SignMode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintService(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateTxSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateTxSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateTxSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinFee) > 0 {
+		for iNdEx := len(m.MinFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetTxsEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovService(uint64(m.OrderBy))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxsEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
 		for _, e := range m.Txs {
 			l = e.Size()
 			n += 1 + l + sovService(uint64(l))
@@ -1332,6 +1990,90 @@ func (m *GetTxResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetTxDecodingInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTxDecodingInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DecodeMode)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ToleratesUnknownNonCriticalFields {
+		n += 2
+	}
+	return n
+}
+
+func (m *EstimateTxSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxSizeSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovService(uint64(m.Sequence))
+	}
+	if m.SignMode != 0 {
+		n += 1 + sovService(uint64(m.SignMode))
+	}
+	return n
+}
+
+func (m *EstimateTxSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxSize != 0 {
+		n += 1 + sovService(uint64(m.TxSize))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovService(uint64(m.GasLimit))
+	}
+	if len(m.MinFee) > 0 {
+		for _, e := range m.MinFee {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1454,21 +2196,93 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1574,9 +2388,526 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BroadcastMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResponse == nil {
+				m.TxResponse = &types.TxResponse{}
+			}
+			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1586,27 +2917,23 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1629,7 +2956,7 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1652,17 +2979,17 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1672,31 +2999,33 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
 			}
-			m.Mode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1706,11 +3035,28 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= BroadcastMode(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResponse == nil {
+				m.TxResponse = &types.TxResponse{}
+			}
+			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1732,7 +3078,7 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTxDecodingInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1755,48 +3101,12 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTxDecodingInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTxDecodingInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TxResponse == nil {
-				m.TxResponse = &types.TxResponse{}
-			}
-			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1818,7 +3128,7 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTxDecodingInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,17 +3151,17 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTxDecodingInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTxDecodingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1861,33 +3171,29 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DecodeMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToleratesUnknownNonCriticalFields", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1897,26 +3203,12 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
-			}
-			iNdEx = postIndex
+			m.ToleratesUnknownNonCriticalFields = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -1938,7 +3230,7 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateTxSizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,17 +3253,17 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTxSizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTxSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -1981,31 +3273,29 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasInfo == nil {
-				m.GasInfo = &types.GasInfo{}
-			}
-			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2032,10 +3322,8 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &types.Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signers = append(m.Signers, &TxSizeSigner{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2060,7 +3348,7 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
+func (m *TxSizeSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2083,15 +3371,15 @@ func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxSizeSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxSizeSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2119,8 +3407,46 @@ func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.KeyType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignMode", wireType)
+			}
+			m.SignMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignMode |= signing.SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -2142,7 +3468,7 @@ func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateTxSizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2165,17 +3491,17 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateTxSizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTxSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
 			}
-			var msglen int
+			m.TxSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -2185,31 +3511,33 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TxSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2236,10 +3564,8 @@ func (m *GetTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxResponse == nil {
-				m.TxResponse = &types.TxResponse{}
-			}
-			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MinFee = append(m.MinFee, types.Coin{})
+			if err := m.MinFee[len(m.MinFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -244,6 +244,92 @@ func (m *SignDoc) GetAccountNumber() uint64 {
 	return 0
 }
 
+// SignDocDirectAux is the type used for generating sign bytes for
+// SIGN_MODE_DIRECT_AUX.
+type SignDocDirectAux struct {
+	// body_bytes is protobuf serialization of a TxBody that matches the
+	// representation in TxRaw.
+	BodyBytes []byte `protobuf:"bytes,1,opt,name=body_bytes,json=bodyBytes,proto3" json:"body_bytes,omitempty"`
+	// public_key is the public key of the signing account.
+	PublicKey *types.Any `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// chain_id is the identifier of the chain this transaction targets.
+	// It prevents signed transactions from being used on another chain by an
+	// attacker.
+	ChainId string `protobuf:"bytes,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// account_number is the account number of the account in state.
+	AccountNumber uint64 `protobuf:"varint,4,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the sequence number of the signing account.
+	Sequence uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *SignDocDirectAux) Reset()         { *m = SignDocDirectAux{} }
+func (m *SignDocDirectAux) String() string { return proto.CompactTextString(m) }
+func (*SignDocDirectAux) ProtoMessage()    {}
+func (*SignDocDirectAux) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{3}
+}
+func (m *SignDocDirectAux) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignDocDirectAux) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignDocDirectAux.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignDocDirectAux) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignDocDirectAux.Merge(m, src)
+}
+func (m *SignDocDirectAux) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignDocDirectAux) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignDocDirectAux.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignDocDirectAux proto.InternalMessageInfo
+
+func (m *SignDocDirectAux) GetBodyBytes() []byte {
+	if m != nil {
+		return m.BodyBytes
+	}
+	return nil
+}
+
+func (m *SignDocDirectAux) GetPublicKey() *types.Any {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SignDocDirectAux) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *SignDocDirectAux) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *SignDocDirectAux) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // TxBody is the body of a transaction that all signers sign over.
 type TxBody struct {
 	// messages is a list of messages to be executed. The required signers of
@@ -275,7 +361,7 @@ func (m *TxBody) Reset()         { *m = TxBody{} }
 func (m *TxBody) String() string { return proto.CompactTextString(m) }
 func (*TxBody) ProtoMessage()    {}
 func (*TxBody) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{3}
+	return fileDescriptor_96d1575ffde80842, []int{4}
 }
 func (m *TxBody) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +444,7 @@ func (m *AuthInfo) Reset()         { *m = AuthInfo{} }
 func (m *AuthInfo) String() string { return proto.CompactTextString(m) }
 func (*AuthInfo) ProtoMessage()    {}
 func (*AuthInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{4}
+	return fileDescriptor_96d1575ffde80842, []int{5}
 }
 func (m *AuthInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +507,7 @@ func (m *SignerInfo) Reset()         { *m = SignerInfo{} }
 func (m *SignerInfo) String() string { return proto.CompactTextString(m) }
 func (*SignerInfo) ProtoMessage()    {}
 func (*SignerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{5}
+	return fileDescriptor_96d1575ffde80842, []int{6}
 }
 func (m *SignerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +572,7 @@ func (m *ModeInfo) Reset()         { *m = ModeInfo{} }
 func (m *ModeInfo) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo) ProtoMessage()    {}
 func (*ModeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{6}
+	return fileDescriptor_96d1575ffde80842, []int{7}
 }
 func (m *ModeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -572,7 +658,7 @@ func (m *ModeInfo_Single) Reset()         { *m = ModeInfo_Single{} }
 func (m *ModeInfo_Single) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo_Single) ProtoMessage()    {}
 func (*ModeInfo_Single) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{6, 0}
+	return fileDescriptor_96d1575ffde80842, []int{7, 0}
 }
 func (m *ModeInfo_Single) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +707,7 @@ func (m *ModeInfo_Multi) Reset()         { *m = ModeInfo_Multi{} }
 func (m *ModeInfo_Multi) String() string { return proto.CompactTextString(m) }
 func (*ModeInfo_Multi) ProtoMessage()    {}
 func (*ModeInfo_Multi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{6, 1}
+	return fileDescriptor_96d1575ffde80842, []int{7, 1}
 }
 func (m *ModeInfo_Multi) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +773,7 @@ func (m *Fee) Reset()         { *m = Fee{} }
 func (m *Fee) String() string { return proto.CompactTextString(m) }
 func (*Fee) ProtoMessage()    {}
 func (*Fee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_96d1575ffde80842, []int{7}
+	return fileDescriptor_96d1575ffde80842, []int{8}
 }
 func (m *Fee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,10 +830,90 @@ func (m *Fee) GetGranter() string {
 	return ""
 }
 
+// AuxSignerData is the intermediary format that an auxiliary signer (e.g. a
+// signer that only signs over the messages of a transaction) sends to the fee
+// payer, who then builds and broadcasts the actual transaction. AuxSignerData
+// is not a valid transaction in itself, and will be rejected by the node if
+// sent directly as-is.
+type AuxSignerData struct {
+	// address is the bech32-encoded address of the auxiliary signer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sign_doc is the SIGN_MODE_DIRECT_AUX sign doc that the auxiliary signer
+	// signs.
+	SignDoc *SignDocDirectAux `protobuf:"bytes,2,opt,name=sign_doc,json=signDoc,proto3" json:"sign_doc,omitempty"`
+	// mode is the signing mode of the auxiliary signer. Only
+	// SIGN_MODE_DIRECT_AUX is currently supported.
+	Mode signing.SignMode `protobuf:"varint,3,opt,name=mode,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"mode,omitempty"`
+	// sig is the signature of the sign doc.
+	Sig []byte `protobuf:"bytes,4,opt,name=sig,proto3" json:"sig,omitempty"`
+}
+
+func (m *AuxSignerData) Reset()         { *m = AuxSignerData{} }
+func (m *AuxSignerData) String() string { return proto.CompactTextString(m) }
+func (*AuxSignerData) ProtoMessage()    {}
+func (*AuxSignerData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{9}
+}
+func (m *AuxSignerData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuxSignerData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuxSignerData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuxSignerData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuxSignerData.Merge(m, src)
+}
+func (m *AuxSignerData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuxSignerData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuxSignerData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuxSignerData proto.InternalMessageInfo
+
+func (m *AuxSignerData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AuxSignerData) GetSignDoc() *SignDocDirectAux {
+	if m != nil {
+		return m.SignDoc
+	}
+	return nil
+}
+
+func (m *AuxSignerData) GetMode() signing.SignMode {
+	if m != nil {
+		return m.Mode
+	}
+	return signing.SignMode_SIGN_MODE_UNSPECIFIED
+}
+
+func (m *AuxSignerData) GetSig() []byte {
+	if m != nil {
+		return m.Sig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.v1beta1.Tx")
 	proto.RegisterType((*TxRaw)(nil), "cosmos.tx.v1beta1.TxRaw")
 	proto.RegisterType((*SignDoc)(nil), "cosmos.tx.v1beta1.SignDoc")
+	proto.RegisterType((*SignDocDirectAux)(nil), "cosmos.tx.v1beta1.SignDocDirectAux")
 	proto.RegisterType((*TxBody)(nil), "cosmos.tx.v1beta1.TxBody")
 	proto.RegisterType((*AuthInfo)(nil), "cosmos.tx.v1beta1.AuthInfo")
 	proto.RegisterType((*SignerInfo)(nil), "cosmos.tx.v1beta1.SignerInfo")
@@ -755,65 +921,72 @@ func init() {
 	proto.RegisterType((*ModeInfo_Single)(nil), "cosmos.tx.v1beta1.ModeInfo.Single")
 	proto.RegisterType((*ModeInfo_Multi)(nil), "cosmos.tx.v1beta1.ModeInfo.Multi")
 	proto.RegisterType((*Fee)(nil), "cosmos.tx.v1beta1.Fee")
+	proto.RegisterType((*AuxSignerData)(nil), "cosmos.tx.v1beta1.AuxSignerData")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 843 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0x5e, 0xef, 0x5f, 0xd6, 0x27, 0x49, 0x4b, 0x47, 0x11, 0xda, 0x6c, 0x54, 0x37, 0x18, 0x15,
-	0xf6, 0x26, 0x76, 0x9b, 0x5e, 0xf0, 0x23, 0x24, 0xc8, 0x16, 0xaa, 0x54, 0xa5, 0x20, 0x4d, 0x72,
-	0xd5, 0x1b, 0x6b, 0xec, 0x9d, 0x78, 0x47, 0x5d, 0xcf, 0x2c, 0x9e, 0x71, 0xb1, 0x1f, 0x02, 0xa9,
-	0x42, 0x42, 0xbc, 0x03, 0x2f, 0xc0, 0x2b, 0xf4, 0xb2, 0x97, 0x5c, 0x41, 0x95, 0x3c, 0x08, 0x68,
-	0xc6, 0x63, 0x27, 0x82, 0x55, 0x72, 0xc3, 0x95, 0xe7, 0x9c, 0xf9, 0xce, 0x37, 0x9f, 0xcf, 0x1f,
-	0x4c, 0x12, 0x21, 0x33, 0x21, 0x43, 0x55, 0x86, 0xaf, 0x1e, 0xc6, 0x54, 0x91, 0x87, 0xa1, 0x2a,
-	0x83, 0x55, 0x2e, 0x94, 0x40, 0x77, 0xea, 0xbb, 0x40, 0x95, 0x81, 0xbd, 0x9b, 0xec, 0xa4, 0x22,
-	0x15, 0xe6, 0x36, 0xd4, 0xa7, 0x1a, 0x38, 0x39, 0xb0, 0x24, 0x49, 0x5e, 0xad, 0x94, 0x08, 0xb3,
-	0x62, 0xa9, 0x98, 0x64, 0x69, 0xcb, 0xd8, 0x38, 0x2c, 0xdc, 0xb3, 0xf0, 0x98, 0x48, 0xda, 0x62,
-	0x12, 0xc1, 0xb8, 0xbd, 0xff, 0xf8, 0x52, 0x93, 0x64, 0x29, 0x67, 0xfc, 0x92, 0xc9, 0xda, 0x16,
-	0xb8, 0x9b, 0x0a, 0x91, 0x2e, 0x69, 0x68, 0xac, 0xb8, 0x38, 0x0b, 0x09, 0xaf, 0xea, 0x2b, 0xff,
-	0x27, 0x07, 0xba, 0xa7, 0x25, 0x3a, 0x80, 0x7e, 0x2c, 0xe6, 0xd5, 0xd8, 0xd9, 0x77, 0xa6, 0x9b,
-	0x87, 0xbb, 0xc1, 0x7f, 0xfe, 0x28, 0x38, 0x2d, 0x67, 0x62, 0x5e, 0x61, 0x03, 0x43, 0x9f, 0x82,
-	0x4b, 0x0a, 0xb5, 0x88, 0x18, 0x3f, 0x13, 0xe3, 0xae, 0x89, 0xd9, 0x5b, 0x13, 0x73, 0x54, 0xa8,
-	0xc5, 0x53, 0x7e, 0x26, 0xf0, 0x88, 0xd8, 0x13, 0xf2, 0x00, 0xb4, 0x36, 0xa2, 0x8a, 0x9c, 0xca,
-	0x71, 0x6f, 0xbf, 0x37, 0xdd, 0xc2, 0x57, 0x3c, 0x3e, 0x87, 0xc1, 0x69, 0x89, 0xc9, 0x8f, 0xe8,
-	0x2e, 0x80, 0x7e, 0x2a, 0x8a, 0x2b, 0x45, 0xa5, 0xd1, 0xb5, 0x85, 0x5d, 0xed, 0x99, 0x69, 0x07,
-	0xfa, 0x08, 0x6e, 0xb7, 0x0a, 0x2c, 0xa6, 0x6b, 0x30, 0xdb, 0xcd, 0x53, 0x35, 0xee, 0xa6, 0xf7,
-	0x7e, 0x76, 0x60, 0xe3, 0x84, 0xa5, 0xfc, 0x6b, 0x91, 0xfc, 0x5f, 0x4f, 0xee, 0xc2, 0x28, 0x59,
-	0x10, 0xc6, 0x23, 0x36, 0x1f, 0xf7, 0xf6, 0x9d, 0xa9, 0x8b, 0x37, 0x8c, 0xfd, 0x74, 0x8e, 0xee,
-	0xc3, 0x2d, 0x92, 0x24, 0xa2, 0xe0, 0x2a, 0xe2, 0x45, 0x16, 0xd3, 0x7c, 0xdc, 0xdf, 0x77, 0xa6,
-	0x7d, 0xbc, 0x6d, 0xbd, 0xdf, 0x19, 0xa7, 0xff, 0x4b, 0x17, 0x86, 0x75, 0xbe, 0xd1, 0x03, 0x18,
-	0x65, 0x54, 0x4a, 0x92, 0x1a, 0x45, 0xbd, 0xe9, 0xe6, 0xe1, 0x4e, 0x50, 0x57, 0x33, 0x68, 0xaa,
-	0x19, 0x1c, 0xf1, 0x0a, 0xb7, 0x28, 0x84, 0xa0, 0x9f, 0xd1, 0xac, 0x2e, 0x8b, 0x8b, 0xcd, 0x59,
-	0xbf, 0xab, 0x58, 0x46, 0x45, 0xa1, 0xa2, 0x05, 0x65, 0xe9, 0x42, 0x19, 0x61, 0x7d, 0xbc, 0x6d,
-	0xbd, 0xc7, 0xc6, 0x89, 0x66, 0x70, 0x87, 0x96, 0x8a, 0x72, 0xc9, 0x04, 0x8f, 0xc4, 0x4a, 0x31,
-	0xc1, 0xe5, 0xf8, 0xef, 0x8d, 0x6b, 0x9e, 0x7d, 0xaf, 0xc5, 0x7f, 0x5f, 0xc3, 0xd1, 0x0b, 0xf0,
-	0xb8, 0xe0, 0x51, 0x92, 0x33, 0xc5, 0x12, 0xb2, 0x8c, 0xd6, 0x10, 0xde, 0xbe, 0x86, 0x70, 0x8f,
-	0x0b, 0xfe, 0xd8, 0xc6, 0x7e, 0xf3, 0x2f, 0x6e, 0xff, 0x15, 0x8c, 0x9a, 0x96, 0x42, 0x5f, 0xc1,
-	0x96, 0x2e, 0x23, 0xcd, 0x4d, 0x3d, 0x9a, 0xe4, 0xdc, 0x5d, 0xd3, 0x85, 0x27, 0x06, 0x66, 0xfa,
-	0x70, 0x53, 0xb6, 0x67, 0x89, 0xa6, 0xd0, 0x3b, 0xa3, 0xd4, 0xb6, 0xef, 0xfb, 0x6b, 0x02, 0x9f,
-	0x50, 0x8a, 0x35, 0xc4, 0xff, 0xd5, 0x01, 0xb8, 0x64, 0x41, 0x8f, 0x00, 0x56, 0x45, 0xbc, 0x64,
-	0x49, 0xf4, 0x92, 0x36, 0x23, 0xb3, 0xfe, 0x6f, 0xdc, 0x1a, 0xf7, 0x8c, 0x9a, 0x91, 0xc9, 0xc4,
-	0x9c, 0xde, 0x34, 0x32, 0xcf, 0xc5, 0x9c, 0xd6, 0x23, 0x93, 0xd9, 0x13, 0x9a, 0xc0, 0x48, 0xd2,
-	0x1f, 0x0a, 0xca, 0x13, 0x6a, 0xcb, 0xd6, 0xda, 0xfe, 0xbb, 0x2e, 0x8c, 0x9a, 0x10, 0xf4, 0x05,
-	0x0c, 0x25, 0xe3, 0xe9, 0x92, 0x5a, 0x4d, 0xfe, 0x35, 0xfc, 0xc1, 0x89, 0x41, 0x1e, 0x77, 0xb0,
-	0x8d, 0x41, 0x9f, 0xc1, 0xc0, 0xec, 0x1f, 0x2b, 0xee, 0x83, 0xeb, 0x82, 0x9f, 0x6b, 0xe0, 0x71,
-	0x07, 0xd7, 0x11, 0x93, 0x23, 0x18, 0xd6, 0x74, 0xe8, 0x13, 0xe8, 0x6b, 0xdd, 0x46, 0xc0, 0xad,
-	0xc3, 0x0f, 0xaf, 0x70, 0x34, 0x1b, 0xe9, 0x6a, 0x55, 0x34, 0x1f, 0x36, 0x01, 0x93, 0xd7, 0x0e,
-	0x0c, 0x0c, 0x2b, 0x7a, 0x06, 0xa3, 0x98, 0x29, 0x92, 0xe7, 0xa4, 0xc9, 0x6d, 0xd8, 0xd0, 0xd4,
-	0x7b, 0x33, 0x68, 0xd7, 0x64, 0xc3, 0xf5, 0x58, 0x64, 0x2b, 0x92, 0xa8, 0x19, 0x53, 0x47, 0x3a,
-	0x0c, 0xb7, 0x04, 0xe8, 0x73, 0x80, 0x36, 0xeb, 0x7a, 0x5c, 0x7b, 0x37, 0xa5, 0xdd, 0x6d, 0xd2,
-	0x2e, 0x67, 0x03, 0xe8, 0xc9, 0x22, 0xf3, 0x7f, 0x77, 0xa0, 0xf7, 0x84, 0x52, 0x94, 0xc0, 0x90,
-	0x64, 0x7a, 0x48, 0x6d, 0xab, 0xb5, 0x4b, 0x52, 0xaf, 0xe7, 0x2b, 0x52, 0x18, 0x9f, 0x3d, 0x78,
-	0xf3, 0xe7, 0xbd, 0xce, 0x6f, 0x7f, 0xdd, 0x9b, 0xa6, 0x4c, 0x2d, 0x8a, 0x38, 0x48, 0x44, 0x16,
-	0x36, 0xab, 0xdf, 0x7c, 0x0e, 0xe4, 0xfc, 0x65, 0xa8, 0xaa, 0x15, 0x95, 0x26, 0x40, 0x62, 0x4b,
-	0x8d, 0xf6, 0xc0, 0x4d, 0x89, 0x8c, 0x96, 0x2c, 0x63, 0xca, 0x14, 0xa2, 0x8f, 0x47, 0x29, 0x91,
-	0xdf, 0x6a, 0x1b, 0xed, 0xc0, 0x60, 0x45, 0x2a, 0x9a, 0xdb, 0xad, 0x52, 0x1b, 0x68, 0x0c, 0x1b,
-	0x69, 0x4e, 0xb8, 0xb2, 0xcb, 0xc4, 0xc5, 0x8d, 0x39, 0xfb, 0xf2, 0xcd, 0xb9, 0xe7, 0xbc, 0x3d,
-	0xf7, 0x9c, 0x77, 0xe7, 0x9e, 0xf3, 0xfa, 0xc2, 0xeb, 0xbc, 0xbd, 0xf0, 0x3a, 0x7f, 0x5c, 0x78,
-	0x9d, 0x17, 0xf7, 0x6f, 0x16, 0x16, 0xaa, 0x32, 0x1e, 0x9a, 0x66, 0x7e, 0xf4, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xd4, 0xb7, 0x75, 0x7d, 0xfd, 0x06, 0x00, 0x00,
+	// 934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0xc7, 0xbb, 0xc9, 0xfa, 0x35, 0x69, 0xd3, 0x51, 0x84, 0x36, 0x1b, 0x75, 0x1b, 0xb6,
+	0x2a, 0xec, 0x25, 0x76, 0xff, 0x1c, 0x28, 0x08, 0x01, 0xbb, 0x0d, 0x55, 0xaa, 0x52, 0x90, 0x26,
+	0x39, 0xf5, 0x62, 0x8d, 0xed, 0x89, 0x77, 0xd4, 0xf5, 0xcc, 0xe2, 0x19, 0x17, 0xfb, 0x43, 0x20,
+	0x55, 0x48, 0x88, 0xef, 0xc0, 0x19, 0x89, 0x2f, 0xc0, 0xa1, 0xc7, 0x1e, 0x39, 0x41, 0x95, 0x7c,
+	0x10, 0x90, 0xc7, 0x63, 0x67, 0x09, 0x69, 0x16, 0x04, 0xa7, 0x9d, 0xf7, 0xe6, 0xf7, 0x7e, 0xf3,
+	0xdb, 0xf7, 0xcf, 0xd0, 0x0f, 0x85, 0x4c, 0x84, 0xf4, 0x54, 0xee, 0xbd, 0xb8, 0x1b, 0x50, 0x45,
+	0xee, 0x7a, 0x2a, 0x77, 0xe7, 0xa9, 0x50, 0x02, 0x5d, 0xaf, 0xee, 0x5c, 0x95, 0xbb, 0xe6, 0xae,
+	0xbf, 0x15, 0x8b, 0x58, 0xe8, 0x5b, 0xaf, 0x3c, 0x55, 0xc0, 0xfe, 0x9e, 0x21, 0x09, 0xd3, 0x62,
+	0xae, 0x84, 0x97, 0x64, 0x33, 0xc5, 0x24, 0x8b, 0x1b, 0xc6, 0xda, 0x61, 0xe0, 0x03, 0x03, 0x0f,
+	0x88, 0xa4, 0x0d, 0x26, 0x14, 0x8c, 0x9b, 0xfb, 0xf7, 0xcf, 0x34, 0x49, 0x16, 0x73, 0xc6, 0xcf,
+	0x98, 0x8c, 0x6d, 0x80, 0xdb, 0xb1, 0x10, 0xf1, 0x8c, 0x7a, 0xda, 0x0a, 0xb2, 0x63, 0x8f, 0xf0,
+	0xa2, 0xba, 0x1a, 0x7e, 0x6b, 0xc1, 0xca, 0x51, 0x8e, 0xf6, 0xa0, 0x1d, 0x88, 0xa8, 0xe8, 0x59,
+	0xbb, 0xd6, 0xe8, 0xca, 0xbd, 0x6d, 0xf7, 0x6f, 0xff, 0xc8, 0x3d, 0xca, 0x27, 0x22, 0x2a, 0xb0,
+	0x86, 0xa1, 0x07, 0xe0, 0x90, 0x4c, 0x4d, 0x7d, 0xc6, 0x8f, 0x45, 0x6f, 0x45, 0xc7, 0xec, 0x5c,
+	0x10, 0x33, 0xce, 0xd4, 0xf4, 0x31, 0x3f, 0x16, 0xb8, 0x4b, 0xcc, 0x09, 0x0d, 0x00, 0x4a, 0x6d,
+	0x44, 0x65, 0x29, 0x95, 0x3d, 0x7b, 0xd7, 0x1e, 0xad, 0xe3, 0x05, 0xcf, 0x90, 0x43, 0xe7, 0x28,
+	0xc7, 0xe4, 0x1b, 0x74, 0x03, 0xa0, 0x7c, 0xca, 0x0f, 0x0a, 0x45, 0xa5, 0xd6, 0xb5, 0x8e, 0x9d,
+	0xd2, 0x33, 0x29, 0x1d, 0xe8, 0x3d, 0xb8, 0xd6, 0x28, 0x30, 0x98, 0x15, 0x8d, 0xd9, 0xa8, 0x9f,
+	0xaa, 0x70, 0xcb, 0xde, 0xfb, 0xce, 0x82, 0xb5, 0x43, 0x16, 0xf3, 0x7d, 0x11, 0xfe, 0x5f, 0x4f,
+	0x6e, 0x43, 0x37, 0x9c, 0x12, 0xc6, 0x7d, 0x16, 0xf5, 0xec, 0x5d, 0x6b, 0xe4, 0xe0, 0x35, 0x6d,
+	0x3f, 0x8e, 0xd0, 0x6d, 0xb8, 0x4a, 0xc2, 0x50, 0x64, 0x5c, 0xf9, 0x3c, 0x4b, 0x02, 0x9a, 0xf6,
+	0xda, 0xbb, 0xd6, 0xa8, 0x8d, 0x37, 0x8c, 0xf7, 0x4b, 0xed, 0x1c, 0xfe, 0x62, 0xc1, 0xa6, 0x11,
+	0xb5, 0xcf, 0x52, 0x1a, 0xaa, 0x71, 0x96, 0x2f, 0x53, 0x77, 0x1f, 0x60, 0x9e, 0x05, 0x33, 0x16,
+	0xfa, 0xcf, 0x69, 0x61, 0x6a, 0xb2, 0xe5, 0x56, 0x85, 0x77, 0xeb, 0xc2, 0xbb, 0x63, 0x5e, 0x60,
+	0xa7, 0xc2, 0x3d, 0xa1, 0xc5, 0x7f, 0x97, 0x8a, 0xfa, 0xd0, 0x95, 0xf4, 0xeb, 0x8c, 0xf2, 0x90,
+	0xf6, 0x3a, 0x1a, 0xd0, 0xd8, 0xc3, 0xef, 0x57, 0x60, 0xb5, 0x6a, 0x1b, 0x74, 0x07, 0xba, 0x09,
+	0x95, 0x92, 0xc4, 0x5a, 0xba, 0xfd, 0x56, 0x6d, 0x0d, 0x0a, 0x21, 0x68, 0x27, 0x34, 0xa9, 0xba,
+	0xcb, 0xc1, 0xfa, 0x5c, 0x6a, 0x52, 0x2c, 0xa1, 0x22, 0x53, 0xfe, 0x94, 0xb2, 0x78, 0xaa, 0xb4,
+	0xe8, 0x36, 0xde, 0x30, 0xde, 0x03, 0xed, 0x44, 0x13, 0xb8, 0x4e, 0x73, 0x45, 0xb9, 0x64, 0x82,
+	0xfb, 0x62, 0xae, 0x98, 0xe0, 0xb2, 0xf7, 0xc7, 0xda, 0x25, 0xcf, 0x6e, 0x36, 0xf8, 0xaf, 0x2a,
+	0x38, 0x7a, 0x06, 0x03, 0x2e, 0xb8, 0x1f, 0xa6, 0x4c, 0xb1, 0x90, 0xcc, 0xfc, 0x0b, 0x08, 0xaf,
+	0x5d, 0x42, 0xb8, 0xc3, 0x05, 0x7f, 0x68, 0x62, 0x3f, 0x3f, 0xc7, 0x3d, 0x7c, 0x01, 0xdd, 0x7a,
+	0x32, 0xd0, 0x67, 0xb0, 0x5e, 0x76, 0x23, 0x4d, 0x75, 0x5b, 0xd5, 0xc9, 0xb9, 0x71, 0xc1, 0x30,
+	0x1d, 0x6a, 0x98, 0x1e, 0xa7, 0x2b, 0xb2, 0x39, 0x4b, 0x34, 0x02, 0xfb, 0x98, 0x52, 0x53, 0xf1,
+	0x77, 0x2e, 0x08, 0x7c, 0x44, 0x29, 0x2e, 0x21, 0xc3, 0x1f, 0x2c, 0x80, 0x33, 0x96, 0x73, 0x1d,
+	0x63, 0xfd, 0xb3, 0x8e, 0x79, 0x00, 0x4e, 0x22, 0x22, 0xba, 0x6c, 0xf2, 0x9f, 0x8a, 0x88, 0x56,
+	0x93, 0x9f, 0x98, 0xd3, 0x5f, 0x3a, 0xc5, 0x3e, 0xd7, 0x29, 0x6f, 0x56, 0xa0, 0x5b, 0x87, 0xa0,
+	0x8f, 0x61, 0x55, 0x32, 0x1e, 0xcf, 0xa8, 0xd1, 0x34, 0xbc, 0x84, 0xdf, 0x3d, 0xd4, 0xc8, 0x83,
+	0x16, 0x36, 0x31, 0xe8, 0x43, 0xe8, 0xe8, 0x35, 0x6a, 0xc4, 0xbd, 0x7b, 0x59, 0xf0, 0xd3, 0x12,
+	0x78, 0xd0, 0xc2, 0x55, 0x44, 0x7f, 0x0c, 0xab, 0x15, 0x1d, 0xfa, 0x00, 0xda, 0xa5, 0x6e, 0x2d,
+	0xe0, 0xea, 0xbd, 0x5b, 0x0b, 0x1c, 0xf5, 0x62, 0x5d, 0xac, 0x4a, 0xc9, 0x87, 0x75, 0x40, 0xff,
+	0xa5, 0x05, 0x1d, 0xcd, 0x8a, 0x9e, 0x40, 0x37, 0x60, 0x8a, 0xa4, 0x29, 0xa9, 0x73, 0xeb, 0xd5,
+	0x34, 0xd5, 0xfa, 0x77, 0x9b, 0x6d, 0x5f, 0x73, 0x3d, 0x14, 0xc9, 0x9c, 0x84, 0x6a, 0xc2, 0xd4,
+	0xb8, 0x0c, 0xc3, 0x0d, 0x01, 0xfa, 0x08, 0xa0, 0xc9, 0x7a, 0xb9, 0x75, 0xec, 0x65, 0x69, 0x77,
+	0xea, 0xb4, 0xcb, 0x49, 0x07, 0x6c, 0x99, 0x25, 0xc3, 0x9f, 0x2d, 0xb0, 0x1f, 0x51, 0x8a, 0x42,
+	0x58, 0x25, 0x49, 0x39, 0xc0, 0xa6, 0xd5, 0x9a, 0x5d, 0x5f, 0x7e, 0x65, 0x16, 0xa4, 0x30, 0x3e,
+	0xb9, 0xf3, 0xea, 0xb7, 0x9b, 0xad, 0x1f, 0x7f, 0xbf, 0x39, 0x8a, 0x99, 0x9a, 0x66, 0x81, 0x1b,
+	0x8a, 0xc4, 0xab, 0xbf, 0x60, 0xfa, 0x67, 0x4f, 0x46, 0xcf, 0x3d, 0x55, 0xcc, 0xa9, 0xd4, 0x01,
+	0x12, 0x1b, 0x6a, 0xb4, 0x03, 0x4e, 0x4c, 0xa4, 0x3f, 0x63, 0x09, 0x53, 0xba, 0x10, 0x6d, 0xdc,
+	0x8d, 0x89, 0xfc, 0xa2, 0xb4, 0xd1, 0x16, 0x74, 0xe6, 0xa4, 0xa0, 0xa9, 0xd9, 0x38, 0x95, 0x81,
+	0x7a, 0xb0, 0x16, 0xa7, 0x84, 0x2b, 0xb3, 0x68, 0x1c, 0x5c, 0x9b, 0xc3, 0x9f, 0x2c, 0xd8, 0x18,
+	0x67, 0x79, 0xd5, 0xb9, 0xfb, 0x44, 0x91, 0x12, 0x4b, 0xa2, 0x28, 0xa5, 0xb2, 0xda, 0x83, 0x0e,
+	0xae, 0x4d, 0xf4, 0x09, 0x74, 0xcb, 0x0a, 0xf9, 0x91, 0x08, 0x4d, 0x03, 0xdc, 0x7a, 0xcb, 0x28,
+	0x2d, 0xee, 0x56, 0xbc, 0x26, 0x2b, 0x4f, 0x53, 0x78, 0xfb, 0x5f, 0x16, 0x1e, 0x6d, 0x82, 0x2d,
+	0x59, 0xac, 0xa5, 0xaf, 0xe3, 0xf2, 0x38, 0xf9, 0xf4, 0xd5, 0xc9, 0xc0, 0x7a, 0x7d, 0x32, 0xb0,
+	0xde, 0x9c, 0x0c, 0xac, 0x97, 0xa7, 0x83, 0xd6, 0xeb, 0xd3, 0x41, 0xeb, 0xd7, 0xd3, 0x41, 0xeb,
+	0xd9, 0xed, 0xe5, 0xf9, 0xf4, 0x54, 0x1e, 0xac, 0xea, 0x19, 0xbc, 0xff, 0xe7, 0x00, 0x41, 0x6d,
+	0xe2, 0x3c, 0x7b, 0x08, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -967,6 +1140,65 @@ func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SignDocDirectAux) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignDocDirectAux) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignDocDirectAux) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BodyBytes) > 0 {
+		i -= len(m.BodyBytes)
+		copy(dAtA[i:], m.BodyBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BodyBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TxBody) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1356,6 +1588,60 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AuxSignerData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuxSignerData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuxSignerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sig) > 0 {
+		i -= len(m.Sig)
+		copy(dAtA[i:], m.Sig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignDoc != nil {
+		{
+			size, err := m.SignDoc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1437,6 +1723,33 @@ func (m *SignDoc) Size() (n int) {
 	return n
 }
 
+func (m *SignDocDirectAux) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BodyBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovTx(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *TxBody) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1603,6 +1916,30 @@ func (m *Fee) Size() (n int) {
 	return n
 }
 
+func (m *AuxSignerData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SignDoc != nil {
+		l = m.SignDoc.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	l = len(m.Sig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2082,7 +2419,7 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxBody) Unmarshal(dAtA []byte) error {
+func (m *SignDocDirectAux) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2105,17 +2442,17 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxBody: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignDocDirectAux: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxBody: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignDocDirectAux: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyBytes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2125,7 +2462,197 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyBytes = append(m.BodyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyBytes == nil {
+				m.BodyBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PublicKey == nil {
+				m.PublicKey = &types.Any{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxBody) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxBody: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxBody: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3022,6 +3549,177 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AuxSignerData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuxSignerData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuxSignerData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignDoc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SignDoc == nil {
+				m.SignDoc = &SignDocDirectAux{}
+			}
+			if err := m.SignDoc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= signing.SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sig == nil {
+				m.Sig = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
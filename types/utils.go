@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/syndtr/goleveldb/leveldb/opt"
 	dbm "github.com/tendermint/tm-db"
 )
 
@@ -95,6 +96,22 @@ func NewLevelDB(name, dir string) (db dbm.DB, err error) {
 	return dbm.NewDB(name, backend, dir)
 }
 
+// NewReadOnlyLevelDB opens an existing LevelDB instance in read-only mode,
+// which is only supported by the goleveldb backend. The database cannot be
+// opened while a process, such as a running node, holds it open for writing.
+func NewReadOnlyLevelDB(name, dir string) (dbm.DB, error) {
+	if backend != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("read-only access is not supported by the %s backend", backend)
+	}
+
+	db, err := dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{ReadOnly: true, ErrorIfMissing: true})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open db read-only: %w", err)
+	}
+
+	return db, nil
+}
+
 // copy bytes
 func CopyBytes(bz []byte) (ret []byte) {
 	if bz == nil {
@@ -109,3 +109,27 @@ func (s *utilsTestSuite) TestParseTimeBytes() {
 	_, err = sdk.ParseTimeBytes([]byte{})
 	s.Require().Error(err)
 }
+
+func (s *utilsTestSuite) TestNewReadOnlyLevelDB() {
+	dir := s.T().TempDir()
+
+	_, err := sdk.NewReadOnlyLevelDB("test", dir)
+	s.Require().Error(err, "a missing db is not created")
+
+	db, err := sdk.NewLevelDB("test", dir)
+	s.Require().NoError(err)
+	s.Require().NoError(db.Set([]byte("key"), []byte("value")))
+
+	_, err = sdk.NewReadOnlyLevelDB("test", dir)
+	s.Require().Error(err, "the db is locked by the writer")
+	s.Require().NoError(db.Close())
+
+	db, err = sdk.NewReadOnlyLevelDB("test", dir)
+	s.Require().NoError(err)
+	defer db.Close()
+
+	value, err := db.Get([]byte("key"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("value"), value)
+	s.Require().Error(db.Set([]byte("key"), []byte("other")))
+}
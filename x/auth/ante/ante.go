@@ -13,6 +13,8 @@ type HandlerOptions struct {
 	AccountKeeper   AccountKeeper
 	BankKeeper      types.BankKeeper
 	FeegrantKeeper  FeegrantKeeper
+	FeeMarketKeeper FeeMarketKeeper
+	CircuitBreaker  CircuitBreaker
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
 }
@@ -41,7 +43,15 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
-		NewMempoolFeeDecorator(),
+	}
+	if options.CircuitBreaker != nil {
+		anteDecorators = append(anteDecorators, NewCircuitBreakerDecorator(options.CircuitBreaker))
+	}
+	anteDecorators = append(anteDecorators, NewMempoolFeeDecorator())
+	if options.FeeMarketKeeper != nil {
+		anteDecorators = append(anteDecorators, NewBaseFeeDecorator(options.FeeMarketKeeper))
+	}
+	anteDecorators = append(anteDecorators,
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
@@ -52,7 +62,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
 		NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 		NewIncrementSequenceDecorator(options.AccountKeeper),
-	}
+	)
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
@@ -0,0 +1,46 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeDecorator checks that the transaction's fee covers the base fee of
+// the fee market for its gas, when the base fee is enforced. Unlike the
+// minimum gas prices of the validators, the base fee is part of the state and
+// is checked in DeliverTx too, except for the genesis transactions.
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewBaseFeeDecorator(fk FeeMarketKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		feeMarketKeeper: fk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee, enforced := bfd.feeMarketKeeper.EnforcedBaseFee(ctx)
+	if !enforced {
+		return next(ctx, tx, simulate)
+	}
+
+	gas := feeTx.GetGas()
+	required := sdk.NewCoin(baseFee.Denom, baseFee.Amount.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt())
+	paid := sdk.NewCoin(baseFee.Denom, feeTx.GetFee().AmountOf(baseFee.Denom))
+	if paid.IsLT(required) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base fee of %s per unit of gas; got: %s required: %s", baseFee, paid, required)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,65 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func (suite *AnteTestSuite) TestBaseFee() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	bfd := ante.NewBaseFeeDecorator(suite.app.FeeMarketKeeper)
+	antehandler := sdk.ChainAnteDecorators(bfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures, paying 150atom for 100000 gas
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the base fee is not enforced by default
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDec(1))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	params := feemarkettypes.DefaultParams()
+	params.Enabled = true
+	params.BaseFeeDenom = "atom"
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+
+	// a base fee of 1atom per unit of gas requires 100000atom, in DeliverTx
+	// as in CheckTx
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// but neither in simulations nor at genesis
+	_, err = antehandler(suite.ctx, tx, true)
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx.WithBlockHeight(0), tx, false)
+	suite.Require().NoError(err)
+
+	// a base fee of 0.001atom per unit of gas requires 100atom
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, sdk.NewDecWithPrec(1, 3))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the base fee is paid in its denom
+	params.BaseFeeDenom = "stake"
+	suite.app.FeeMarketKeeper.SetParams(suite.ctx, params)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+}
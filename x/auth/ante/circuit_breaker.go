@@ -0,0 +1,35 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CircuitBreakerDecorator rejects the transactions holding a Msg whose circuit
+// breaker is tripped, so that they neither enter the mempool nor pay fees in a
+// block. The Msgs executed by other Msgs, e.g. by the authz MsgExec, are only
+// checked by the Msg service router when they are executed.
+type CircuitBreakerDecorator struct {
+	circuitBreaker CircuitBreaker
+}
+
+func NewCircuitBreakerDecorator(cb CircuitBreaker) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitBreaker: cb,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		typeURL := sdk.MsgTypeURL(msg)
+		allowed, err := cbd.circuitBreaker.IsAllowed(ctx, typeURL)
+		if err != nil {
+			return ctx, err
+		}
+		if !allowed {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "circuit breaker disallows execution of message %s", typeURL)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
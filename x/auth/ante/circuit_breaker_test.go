@@ -0,0 +1,43 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestCircuitBreaker() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	cbd := ante.NewCircuitBreakerDecorator(suite.app.CircuitKeeper)
+	antehandler := sdk.ChainAnteDecorators(cbd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the transactions holding a tripped msg are rejected, in CheckTx too
+	suite.app.CircuitKeeper.DisableMsg(suite.ctx, sdk.MsgTypeURL(msg))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = antehandler(suite.ctx.WithIsCheckTx(true), tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.app.CircuitKeeper.EnableMsg(suite.ctx, sdk.MsgTypeURL(msg))
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+}
@@ -18,3 +18,13 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected feemarket keeper.
+type FeeMarketKeeper interface {
+	EnforcedBaseFee(ctx sdk.Context) (sdk.DecCoin, bool)
+}
+
+// CircuitBreaker defines the expected circuit breaker, e.g. the circuit keeper.
+type CircuitBreaker interface {
+	IsAllowed(ctx sdk.Context, typeURL string) (bool, error)
+}
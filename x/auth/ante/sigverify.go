@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -74,8 +75,9 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+		// Only make check if simulate=false. The public key of an account whose
+		// multisig public key was rotated doesn't match its address.
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && !spkd.isAccountPubKey(ctx, signers[i], pk) {
 			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
 				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
 		}
@@ -126,6 +128,12 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// isAccountPubKey returns whether pk is the public key of the account of addr.
+func (spkd SetPubKeyDecorator) isAccountPubKey(ctx sdk.Context, addr sdk.AccAddress, pk cryptotypes.PubKey) bool {
+	acc := spkd.ak.GetAccount(ctx, addr)
+	return acc != nil && acc.GetPubKey() != nil && acc.GetPubKey().Equals(pk)
+}
+
 // Consume parameter-defined amount of gas for each signature according to the passed-in SignatureVerificationGasConsumer function
 // before calling the next AnteHandler
 // CONTRACT: Pubkeys are set in context for all signers before this decorator runs
@@ -252,6 +260,19 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// the single secp256k1 signatures are verified together once the signers
+	// are checked, concurrently in CheckTx only, and the others individually
+	batch := secp256k1.NewBatchVerifier()
+	if ctx.IsCheckTx() {
+		batch = secp256k1.NewConcurrentBatchVerifier()
+	}
+	type batchedSig struct {
+		pubKey     cryptotypes.PubKey
+		signerData authsigning.SignerData
+		data       signing.SignatureData
+	}
+	var batched []batchedSig
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -280,24 +301,37 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			accNum = acc.GetAccountNumber()
 		}
 		signerData := authsigning.SignerData{
+			Address:       acc.GetAddress().String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
 			Sequence:      acc.GetSequence(),
+			PubKey:        pubKey,
 		}
 
-		if !simulate {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, tx)
-			if err != nil {
-				var errMsg string
-				if OnlyLegacyAminoSigners(sig.Data) {
-					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
-					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
-				} else {
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
-				}
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+		if simulate {
+			continue
+		}
 
+		// the EIP-712 signatures are made over a digest of the sign bytes
+		if data, ok := sig.Data.(*signing.SingleSignatureData); ok && data.SignMode != signing.SignMode_SIGN_MODE_EIP712 {
+			signBytes, err := svd.signModeHandler.GetSignBytes(data.SignMode, signerData, tx)
+			if err == nil && batch.Add(pubKey, signBytes, data.Signature) == nil {
+				batched = append(batched, batchedSig{pubKey: pubKey, signerData: signerData, data: sig.Data})
+				continue
+			}
+		}
+
+		if err := svd.verifySignature(tx, pubKey, signerData, sig.Data); err != nil {
+			return ctx, err
+		}
+	}
+
+	// on failure, the batched signatures are verified individually to report
+	// the error of the first invalid one
+	if ok, _ := batch.Verify(); !ok {
+		for _, sig := range batched {
+			if err := svd.verifySignature(tx, sig.pubKey, sig.signerData, sig.data); err != nil {
+				return ctx, err
 			}
 		}
 	}
@@ -305,6 +339,24 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 	return next(ctx, tx, simulate)
 }
 
+func (svd SigVerificationDecorator) verifySignature(tx sdk.Tx, pubKey cryptotypes.PubKey, signerData authsigning.SignerData, sigData signing.SignatureData) error {
+	err := authsigning.VerifySignature(pubKey, signerData, sigData, svd.signModeHandler, tx)
+	if err == nil {
+		return nil
+	}
+
+	var errMsg string
+	if OnlyLegacyAminoSigners(sigData) {
+		// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+		// and therefore communicate sequence number as a potential cause of error.
+		errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", signerData.AccountNumber, signerData.Sequence, signerData.ChainID)
+	} else {
+		errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", signerData.AccountNumber, signerData.ChainID)
+	}
+
+	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+}
+
 // IncrementSequenceDecorator handles incrementing sequences of all signers.
 // Use the IncrementSequenceDecorator decorator to prevent replay attacks. Note,
 // there is no need to execute IncrementSequenceDecorator on RecheckTX since
@@ -401,6 +453,10 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBLS12381(), "ante verify: bls12381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -3,8 +3,11 @@ package ante_test
 import (
 	"fmt"
 
+	"github.com/btcsuite/btcd/btcec"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -14,10 +17,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/eip712"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func (suite *AnteTestSuite) TestSetPubKey() {
@@ -64,6 +71,42 @@ func (suite *AnteTestSuite) TestSetPubKey() {
 	}
 }
 
+func (suite *AnteTestSuite) TestSetRotatedPubKey() {
+	suite.SetupTest(true) // setup
+	require := suite.Require()
+
+	// the public key of the account of addr1 was rotated to pub2
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, _ := testdata.KeyTestPubAddr()
+	priv3, _, _ := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	require.NoError(acc.SetPubKey(pub2))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewSetPubKeyDecorator(suite.app.AccountKeeper))
+	for _, tc := range []struct {
+		priv   cryptotypes.PrivKey
+		expErr bool
+	}{
+		{priv2, false},
+		{priv3, true},
+	} {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		tx, err := suite.CreateTestTx([]cryptotypes.PrivKey{tc.priv}, []uint64{acc.GetAccountNumber()}, []uint64{0}, suite.ctx.ChainID())
+		require.NoError(err)
+
+		_, err = antehandler(suite.ctx, tx, false)
+		if tc.expErr {
+			require.ErrorIs(err, sdkerrors.ErrInvalidPubKey)
+		} else {
+			require.NoError(err)
+		}
+	}
+}
+
 func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 	params := types.DefaultParams()
 	msg := []byte{1, 2, 3, 4}
@@ -98,6 +141,7 @@ func (suite *AnteTestSuite) TestConsumeSignatureVerificationGas() {
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyBLS12381", args{sdk.NewInfiniteGasMeter(), nil, bls12381.GenPrivKey().PubKey(), params}, p.SigVerifyCostBLS12381(), false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -388,3 +432,46 @@ func (suite *AnteTestSuite) TestIncrementSequenceDecorator() {
 		suite.Require().Equal(tc.expectedSeq, suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
 	}
 }
+
+func (suite *AnteTestSuite) TestSigVerification_EIP712() {
+	suite.SetupTest(false) // deliver mode
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	accounts := suite.CreateTestAccounts(2)
+	priv, acc := accounts[0].priv, accounts[0].acc
+	msg := banktypes.NewMsgSend(acc.GetAddress(), accounts[1].acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	// the signer info must be set before the sign bytes are computed
+	sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_EIP712}
+	sig := signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData, Sequence: acc.GetSequence()}
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sig))
+
+	signerData := xauthsigning.SignerData{
+		ChainID:       suite.ctx.ChainID(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+	signBytes, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_EIP712, signerData, suite.txBuilder.GetTx())
+	suite.Require().NoError(err)
+
+	// a SHA-256 signature of the sign bytes is rejected
+	sigData.Signature, err = priv.Sign(signBytes)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sig))
+	_, err = suite.anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// sign the Keccak-256 digest with a recovery byte, like an Ethereum wallet
+	btcPriv, _ := btcec.PrivKeyFromBytes(btcec.S256(), priv.(*secp256k1.PrivKey).Key)
+	btcSig, err := btcPriv.Sign(eip712.Keccak256(signBytes))
+	suite.Require().NoError(err)
+	sigData.Signature = append(append(btcSig.R.FillBytes(make([]byte, 32)), btcSig.S.FillBytes(make([]byte, 32))...), 27)
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sig))
+
+	_, err = suite.anteHandler(suite.ctx, suite.txBuilder.GetTx(), false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(acc.GetSequence()+1, suite.app.AccountKeeper.GetAccount(suite.ctx, acc.GetAddress()).GetSequence())
+}
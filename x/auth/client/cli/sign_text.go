@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/offchain"
+)
+
+// GetSignTextCommand returns a command signing arbitrary text off-chain, as
+// specified by ADR-036, meant to be registered under the keys command.
+func GetSignTextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-text [name] [text]",
+		Short: "Sign arbitrary text off-chain with a key",
+		Long: fmt.Sprintf(`Sign arbitrary text with a key of the keyring, to prove the ownership of its
+account to an off-chain service, e.g. an airdrop claim or a login. The text is
+signed as specified by ADR-036, so that the signature can never be the one of
+an on-chain transaction, and can be verified by the verify-text command or any
+ADR-036 compatible wallet.
+
+Example:
+$ %s keys sign-text mykey "login to example.com, nonce 5d1a"
+`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sig, err := offchain.Sign(clientCtx.Keyring, args[0], []byte(args[1]))
+			if err != nil {
+				return err
+			}
+
+			bz, err := offchain.ModuleCdc.MarshalJSON(sig)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(append(bz, '\n'))
+		},
+	}
+
+	return cmd
+}
+
+// GetVerifyTextCommand returns a command verifying the off-chain signature of
+// arbitrary text, as output by the sign-text command.
+func GetVerifyTextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-text [address] [text] [signature-file]",
+		Short: "Verify the off-chain signature of arbitrary text by an account",
+		Long: fmt.Sprintf(`Verify that the signature in the given file, as output by the sign-text command,
+is the ADR-036 signature of the text by the account of the given address.
+
+Example:
+$ %s keys verify-text cosmos1... "login to example.com, nonce 5d1a" signature.json
+`, version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			bz, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var sig legacytx.StdSignature
+			if err := offchain.ModuleCdc.UnmarshalJSON(bz, &sig); err != nil {
+				return fmt.Errorf("failed to decode the signature: %w", err)
+			}
+
+			if err := offchain.Verify(args[0], []byte(args[1]), sig); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("signature verified for %s\n", args[0]))
+		},
+	}
+
+	return cmd
+}
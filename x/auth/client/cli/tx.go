@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetTxCmd returns the transaction commands for the auth module.
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Auth transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(NewRotateMultisigPubKeyCmd())
+
+	return txCmd
+}
+
+// NewRotateMultisigPubKeyCmd returns a CLI command handler for creating a
+// MsgRotateMultisigPubKey transaction.
+func NewRotateMultisigPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-multisig [from_key_or_address] [new_multisig_key]",
+		Short: "Replace the multisig public key of an account, keeping its address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the threshold multisig public key of an account by the one of the
+multisig key [new_multisig_key] of the keyring, e.g. with changed members or threshold.
+The account keeps its address, and so its funds. Note, the '--from' flag is ignored
+as it is implied from [from_key_or_address].
+
+The transaction must be signed by the members of the current multisig public key of
+the account:
+
+$ %[1]s tx auth rotate-multisig <address> k1k2k4 --generate-only > tx.json
+$ %[1]s tx sign tx.json --multisig <address> --from k1 --output-document k1sig.json
+$ %[1]s tx sign tx.json --multisig <address> --from k2 --output-document k2sig.json
+$ %[1]s tx multisign tx.json k1k2k3 k1sig.json k2sig.json > signed.json
+
+As the address of the account is not the one of the new multisig public key, the
+transactions of the account are then signed with the --multisig flag set to the
+address of the account, with 'tx sign' and with 'tx multisign'.
+
+The public key of an account can only be rotated to a multisig public key and from a
+multisig public key. Otherwise, see 'tx bank migrate-multisig-funds' to move the funds
+of the account to the address of the new key.
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			multisigInfo, err := getMultisigInfo(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateMultisigPubKey(clientCtx.GetFromAddress(), multisigInfo.GetPubKey())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
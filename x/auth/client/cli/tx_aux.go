@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// GetAuxSignCommand returns the transaction aux-sign command.
+func GetAuxSignCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-sign [file]",
+		Short: "Sign the messages of a transaction generated offline as an auxiliary signer",
+		Long: `Sign the body of a transaction created with the --generate-only flag in
+SIGN_MODE_DIRECT_AUX. It will read a transaction from [file], sign its messages,
+memo and timeout height, and print the resulting auxiliary signer data as JSON.
+
+The auxiliary signer data does not commit to any fee, and is not a valid transaction
+in itself. It is meant to be handed over to a fee payer, who completes and broadcasts
+the transaction with the 'aux-combine' command.
+
+The --offline flag makes sure that the client will not reach out to full node.
+As a result, the account and sequence number queries will not be performed and
+it is required to set such parameters manually.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeAuxSignCmd(),
+		Args:   cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeAuxSignCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		clientCtx, txF, newTx, err := readTxAndInitContexts(clientCtx, cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		if txF.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
+		txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(newTx)
+		if err != nil {
+			return err
+		}
+
+		sigTx := txBuilder.GetTx()
+
+		info, err := txF.Keybase().Key(clientCtx.GetFromName())
+		if err != nil {
+			return fmt.Errorf("error getting account from keybase: %w", err)
+		}
+
+		addr := sdk.AccAddress(info.GetPubKey().Address())
+		if !isTxSigner(addr, sigTx.GetSigners()) {
+			return fmt.Errorf("%s: %s", sdkerrors.ErrorInvalidSigner, clientCtx.GetFromName())
+		}
+
+		accNum, accSeq := txF.AccountNumber(), txF.Sequence()
+		if !clientCtx.Offline {
+			accNum, accSeq, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+			if err != nil {
+				return err
+			}
+		}
+
+		auxBuilder := tx.NewAuxTxBuilder()
+		auxBuilder.SetAddress(addr.String())
+		auxBuilder.SetChainID(txF.ChainID())
+		auxBuilder.SetAccountNumber(accNum)
+		auxBuilder.SetSequence(accSeq)
+		auxBuilder.SetMemo(sigTx.GetMemo())
+		auxBuilder.SetTimeoutHeight(sigTx.GetTimeoutHeight())
+
+		if err := auxBuilder.SetMsgs(sigTx.GetMsgs()...); err != nil {
+			return err
+		}
+
+		if err := auxBuilder.SetPubKey(info.GetPubKey()); err != nil {
+			return err
+		}
+
+		if err := auxBuilder.SetSignMode(signing.SignMode_SIGN_MODE_DIRECT_AUX); err != nil {
+			return err
+		}
+
+		signBz, err := auxBuilder.GetSignBytes()
+		if err != nil {
+			return err
+		}
+
+		sig, _, err := txF.Keybase().Sign(clientCtx.GetFromName(), signBz)
+		if err != nil {
+			return err
+		}
+
+		auxBuilder.SetSignature(sig)
+
+		auxSignerData, err := auxBuilder.GetAuxSignerData()
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+		defer closeFunc()
+
+		return clientCtx.WithOutput(cmd.OutOrStdout()).PrintProto(&auxSignerData)
+	}
+}
+
+// GetAuxCombineCommand returns the transaction aux-combine command.
+func GetAuxCombineCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aux-combine [aux-signer-data-file]...",
+		Short: "Complete a transaction signed by auxiliary signers as its fee payer, then broadcast it",
+		Long: `Build a transaction out of the auxiliary signer data files generated with the
+'aux-sign' command, set the --from account as its fee payer, sign it and broadcast it.
+Every message signer of the transaction must have provided auxiliary signer data over
+the same transaction body, and the fee payer must not be a message signer itself. The
+fee and gas limit are set from the --fees, --gas-prices and --gas flags.
+
+If the --generate-only flag is set, the combined transaction is printed unsigned
+instead, and can be signed later by the fee payer with the 'sign' command.
+
+The --offline flag makes sure that the client will not reach out to full node.
+As a result, the account and sequence number queries will not be performed and
+it is required to set such parameters manually.
+`,
+		PreRun: preSignCmd,
+		RunE:   makeAuxCombineCmd(),
+		Args:   cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeAuxCombineCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		txF := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		if txF.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
+		if txF.SimulateAndExecute() {
+			return errors.New("gas estimation is not supported for aux signed transactions, set the gas limit with --gas")
+		}
+
+		auxSignerData := make([]txtypes.AuxSignerData, len(args))
+		for i, filename := range args {
+			bz, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &auxSignerData[i]); err != nil {
+				return fmt.Errorf("failed to parse aux signer data %s: %w", filename, err)
+			}
+		}
+
+		feePayer := clientCtx.GetFromAddress()
+
+		txBuilder, err := tx.BuildTxFromAuxSignerData(txF, feePayer, auxSignerData...)
+		if err != nil {
+			return err
+		}
+
+		if clientCtx.GenerateOnly {
+			json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+		}
+
+		if !clientCtx.Offline {
+			accNum, accSeq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, feePayer)
+			if err != nil {
+				return err
+			}
+
+			txF = txF.WithAccountNumber(accNum).WithSequence(accSeq)
+		}
+
+		if err := tx.Sign(txF, clientCtx.GetFromName(), txBuilder, false); err != nil {
+			return err
+		}
+
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		res, err := clientCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintProto(res)
+	}
+}
+
+func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
+	for _, s := range signers {
+		if s.Equals(user) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -42,17 +42,27 @@ Read one or more signatures from one or more [signature] file, generate a multis
 multisig key [name], and attach the key name to the transaction read from [file].
 
 Example:
-$ %s tx multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
+$ %[1]s tx multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 
 If --signature-only flag is on, output a JSON representation
 of only the generated signature.
 
+If the multisig public key of the account was rotated, the address of the account, which
+is not the one of the multisig key [name], must be set with the --multisig flag.
+
+The members of [name] may be multisig keys themselves. Their signatures are generated by
+'multisign' with the --signature-only flag, and the --multisig flag set to the address of
+the account that the transaction signs on behalf of, then passed as [signature] files:
+
+$ %[1]s tx multisign transaction.json k1k2 k1sig.json k2sig.json --multisig <address> --signature-only > k1k2sig.json
+$ %[1]s tx multisign transaction.json k1k2k3 k1k2sig.json k3sig.json
+
 If the --offline flag is on, the client will not reach out to an external node.
 Account number or sequence number lookups are not performed so you must
 set these parameters manually.
 
 The current multisig implementation defaults to amino-json sign mode.
-The SIGN_MODE_DIRECT sign mode is not supported.'
+The SIGN_MODE_DIRECT and SIGN_MODE_EIP712 sign modes are not supported.'
 `,
 				version.AppName,
 			),
@@ -64,6 +74,10 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
 	cmd.Flags().Bool(flagAmino, false, "Generate Amino-encoded JSON suitable for submitting to the txs REST endpoint")
+	cmd.Flags().String(
+		flagMultisig, "",
+		"Address of the multisig account that the transaction signs on behalf of, when it is not the address of the multisig key",
+	)
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flags.FlagChainID, "", "network chain ID")
 
@@ -100,7 +114,12 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 		multisigPub := multisigInfo.GetPubKey().(*kmultisig.LegacyAminoPubKey)
 		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
 		if !clientCtx.Offline {
-			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
+			multisigAddr, err := getMultisigAddress(cmd, multisigInfo)
+			if err != nil {
+				return err
+			}
+
+			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigAddr)
 			if err != nil {
 				return err
 			}
@@ -126,6 +145,10 @@ func makeMultiSignCmd() func(cmd *cobra.Command, args []string) (err error) {
 			}
 
 			for _, sig := range sigs {
+				if err := checkMultisigSignModes(sig.Data); err != nil {
+					return err
+				}
+
 				err = signing.VerifySignature(sig.PubKey, signingData, sig.Data, txCfg.SignModeHandler(), txBuilder.GetTx())
 				if err != nil {
 					addr, _ := sdk.AccAddressFromHex(sig.PubKey.Address().String())
@@ -213,7 +236,7 @@ Example:
 $ %s tx multisign-batch transactions.json multisigk1k2k3 k1sigs.json k2sigs.json k3sig.json
 
 The current multisig implementation defaults to amino-json sign mode.
-The SIGN_MODE_DIRECT sign mode is not supported.'
+The SIGN_MODE_DIRECT and SIGN_MODE_EIP712 sign modes are not supported.'
 `, version.AppName,
 			),
 		),
@@ -233,6 +256,24 @@ The SIGN_MODE_DIRECT sign mode is not supported.'
 	return cmd
 }
 
+// checkMultisigSignModes checks that the sign modes of the signatures, and of
+// the ones nested in multisignatures, are supported by multisigs.
+func checkMultisigSignModes(data signingtypes.SignatureData) error {
+	switch data := data.(type) {
+	case *signingtypes.SingleSignatureData:
+		return authclient.CheckMultisigSignMode(data.SignMode)
+	case *signingtypes.MultiSignatureData:
+		for _, sig := range data.Signatures {
+			if err := checkMultisigSignModes(sig); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected signature data %T", data)
+	}
+}
+
 func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) (err error) {
 		var clientCtx client.Context
@@ -280,7 +321,12 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 		}
 
 		if !clientCtx.Offline {
-			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigInfo.GetAddress())
+			multisigAddr, err := getMultisigAddress(cmd, multisigInfo)
+			if err != nil {
+				return err
+			}
+
+			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, multisigAddr)
 			if err != nil {
 				return err
 			}
@@ -312,6 +358,10 @@ func makeBatchMultisignCmd() func(cmd *cobra.Command, args []string) error {
 			}
 
 			for _, sig := range signatureBatch {
+				if err := checkMultisigSignModes(sig[i].Data); err != nil {
+					return err
+				}
+
 				err = signing.VerifySignature(sig[i].PubKey, signingData, sig[i].Data, txCfg.SignModeHandler(), txBldr.GetTx())
 				if err != nil {
 					return fmt.Errorf("couldn't verify signature: %w %v", err, sig)
@@ -414,3 +464,15 @@ func getMultisigInfo(clientCtx client.Context, name string) (keyring.Info, error
 
 	return multisigInfo, nil
 }
+
+// getMultisigAddress returns the address of the multisig account, the one set
+// with the --multisig flag, e.g. when the multisig public key of the account
+// was rotated, or else the address of the multisig key.
+func getMultisigAddress(cmd *cobra.Command, multisigInfo keyring.Info) (sdk.AccAddress, error) {
+	multisigAddr, _ := cmd.Flags().GetString(flagMultisig)
+	if multisigAddr == "" {
+		return multisigInfo.GetAddress(), nil
+	}
+
+	return sdk.AccAddressFromBech32(multisigAddr)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -92,8 +93,14 @@ func printAndValidateSigs(
 		)
 
 		if i >= len(signers) || !sigAddr.Equals(signers[i]) {
-			sigSanity = "ERROR: signature does not match its respective signer"
-			success = false
+			// the public key of an account whose multisig public key was
+			// rotated doesn't match its address
+			if !offline && i < len(signers) && isAccountPubKey(clientCtx, signers[i], pubKey) {
+				sigAddr = signers[i]
+			} else {
+				sigSanity = "ERROR: signature does not match its respective signer"
+				success = false
+			}
 		}
 
 		// validate the actual signature over the transaction bytes since we can
@@ -106,9 +113,11 @@ func printAndValidateSigs(
 			}
 
 			signingData := authsigning.SignerData{
+				Address:       sigAddr.String(),
 				ChainID:       chainID,
 				AccountNumber: accNum,
 				Sequence:      accSeq,
+				PubKey:        pubKey,
 			}
 			err = authsigning.VerifySignature(pubKey, signingData, sig.Data, signModeHandler, sigTx)
 			if err != nil {
@@ -124,6 +133,13 @@ func printAndValidateSigs(
 	return success
 }
 
+// isAccountPubKey returns whether pubKey is the public key of the account of
+// addr.
+func isAccountPubKey(clientCtx client.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) bool {
+	acc, err := clientCtx.AccountRetriever.GetAccount(clientCtx, addr)
+	return err == nil && acc.GetPubKey() != nil && acc.GetPubKey().Equals(pubKey)
+}
+
 func readTxAndInitContexts(clientCtx client.Context, cmd *cobra.Command, filename string) (client.Context, tx.Factory, sdk.Tx, error) {
 	stdTx, err := authclient.ReadTxFromFile(clientCtx, filename)
 	if err != nil {
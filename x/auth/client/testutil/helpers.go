@@ -67,6 +67,15 @@ func TxMultiSignExec(clientCtx client.Context, from string, filename string, ext
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetMultiSignCommand(), append(args, extraArgs...))
 }
 
+func TxRotateMultisigPubKeyExec(clientCtx client.Context, from fmt.Stringer, newMultisig string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		from.String(),
+		newMultisig,
+	}
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cli.NewRotateMultisigPubKeyCmd(), append(args, extraArgs...))
+}
+
 func TxSignBatchExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{
 		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
@@ -86,6 +95,37 @@ func TxDecodeExec(clientCtx client.Context, encodedTx string, extraArgs ...strin
 	return clitestutil.ExecTestCLICmd(clientCtx, cli.GetDecodeCommand(), append(args, extraArgs...))
 }
 
+func TxAuxSignExec(clientCtx client.Context, from fmt.Stringer, filename string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--from=%s", from.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, strings.Replace(clientCtx.HomeDir, "simd", "simcli", 1)),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, clientCtx.ChainID),
+		filename,
+	}
+
+	cmd := cli.GetAuxSignCommand()
+	tmcli.PrepareBaseCmd(cmd, "", "")
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmd, append(args, extraArgs...))
+}
+
+func TxAuxCombineExec(clientCtx client.Context, from fmt.Stringer, filenames []string, extraArgs ...string) (testutil.BufferWriter, error) {
+	args := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagKeyringBackend, keyring.BackendTest),
+		fmt.Sprintf("--from=%s", from.String()),
+		fmt.Sprintf("--%s=%s", flags.FlagHome, strings.Replace(clientCtx.HomeDir, "simd", "simcli", 1)),
+		fmt.Sprintf("--%s=%s", flags.FlagChainID, clientCtx.ChainID),
+	}
+
+	args = append(args, filenames...)
+
+	cmd := cli.GetAuxCombineCommand()
+	tmcli.PrepareBaseCmd(cmd, "", "")
+
+	return clitestutil.ExecTestCLICmd(clientCtx, cmd, append(args, extraArgs...))
+}
+
 func QueryAccountExec(clientCtx client.Context, address fmt.Stringer, extraArgs ...string) (testutil.BufferWriter, error) {
 	args := []string{address.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
 
@@ -893,6 +893,148 @@ func (s *IntegrationTestSuite) TestCLIMultisign() {
 	s.Require().NoError(s.network.WaitForNextBlock())
 }
 
+func (s *IntegrationTestSuite) TestCLIMultisignNested() {
+	val1 := s.network.Validators[0]
+	clientCtx := val1.ClientCtx
+	clientCtx.BroadcastMode = flags.BroadcastBlock
+	kb := clientCtx.Keyring
+
+	// The 2 of 2 multisig nestedMulti has the 2 of 2 multisig nestedMultiInner
+	// of the members 0 and 1, and the member 2.
+	members := make([]keyring.Info, 3)
+	for i := range members {
+		info, _, err := kb.NewMnemonic(fmt.Sprintf("nestedMember%d", i), keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+		members[i] = info
+	}
+	innerInfo, err := kb.SaveMultisig("nestedMultiInner", kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{members[0].GetPubKey(), members[1].GetPubKey()}))
+	s.Require().NoError(err)
+	multisigInfo, err := kb.SaveMultisig("nestedMulti", kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{innerInfo.GetPubKey(), members[2].GetPubKey()}))
+	s.Require().NoError(err)
+	addr := multisigInfo.GetAddress()
+
+	_, err = s.createBankMsg(val1, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 100)))
+	s.Require().NoError(err)
+
+	multiGeneratedTx, err := bankcli.MsgSendExec(clientCtx, addr, val1.Address,
+		sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 5)),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	txFile := testutil.WriteToNewTempFile(s.T(), multiGeneratedTx.String()).Name()
+
+	// SIGN_MODE_DIRECT cannot be used by the members of multisigs.
+	_, err = TxSignExec(clientCtx, members[0].GetAddress(), txFile, "--multisig", addr.String(),
+		fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeDirect))
+	s.Require().EqualError(err, "SIGN_MODE_DIRECT is not supported by multisig signatures, use SIGN_MODE_LEGACY_AMINO_JSON")
+
+	sigFiles := make([]string, len(members))
+	for i, member := range members {
+		sig, err := TxSignExec(clientCtx, member.GetAddress(), txFile, "--multisig", addr.String())
+		s.Require().NoError(err)
+		sigFiles[i] = testutil.WriteToNewTempFile(s.T(), sig.String()).Name()
+	}
+
+	// The signatures of the members 0 and 1 are combined in the signature of
+	// the nested multisig, on behalf of the account of nestedMulti.
+	innerSig, err := TxMultiSignExec(clientCtx, innerInfo.GetName(), txFile,
+		sigFiles[0], sigFiles[1], "--signature-only", "--multisig", addr.String())
+	s.Require().NoError(err)
+	innerSigFile := testutil.WriteToNewTempFile(s.T(), innerSig.String()).Name()
+
+	signedTx, err := TxMultiSignExec(clientCtx, multisigInfo.GetName(), txFile, innerSigFile, sigFiles[2])
+	s.Require().NoError(err)
+	signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String()).Name()
+
+	_, err = TxValidateSignaturesExec(clientCtx, signedTxFile)
+	s.Require().NoError(err)
+
+	out, err := TxBroadcastExec(clientCtx, signedTxFile)
+	s.Require().NoError(err)
+	var txRes sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+	s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+}
+
+func (s *IntegrationTestSuite) TestCLIRotateMultisigPubKey() {
+	val1 := s.network.Validators[0]
+	clientCtx := val1.ClientCtx
+	clientCtx.BroadcastMode = flags.BroadcastBlock
+	kb := clientCtx.Keyring
+
+	members := make([]keyring.Info, 3)
+	for i := range members {
+		info, _, err := kb.NewMnemonic(fmt.Sprintf("rotateMember%d", i), keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+		members[i] = info
+	}
+	multisigInfo, err := kb.SaveMultisig("rotateMulti", kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{members[0].GetPubKey(), members[1].GetPubKey()}))
+	s.Require().NoError(err)
+	newMultisigInfo, err := kb.SaveMultisig("rotateMultiNew", kmultisig.NewLegacyAminoPubKey(2, []cryptotypes.PubKey{members[1].GetPubKey(), members[2].GetPubKey()}))
+	s.Require().NoError(err)
+	addr := multisigInfo.GetAddress()
+
+	_, err = s.createBankMsg(val1, addr, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1000)))
+	s.Require().NoError(err)
+
+	// signAndBroadcast signs the tx on behalf of the account with the members of
+	// the multisig key, and broadcasts it
+	signAndBroadcast := func(txFile string, multisig string, signers ...keyring.Info) {
+		sigFiles := make([]string, len(signers))
+		for i, signer := range signers {
+			sig, err := TxSignExec(clientCtx, signer.GetAddress(), txFile, "--multisig", addr.String())
+			s.Require().NoError(err)
+			sigFiles[i] = testutil.WriteToNewTempFile(s.T(), sig.String()).Name()
+		}
+
+		signedTx, err := TxMultiSignExec(clientCtx, multisig, txFile, append(sigFiles, "--multisig", addr.String())...)
+		s.Require().NoError(err)
+		signedTxFile := testutil.WriteToNewTempFile(s.T(), signedTx.String()).Name()
+
+		_, err = TxValidateSignaturesExec(clientCtx, signedTxFile)
+		s.Require().NoError(err)
+
+		out, err := TxBroadcastExec(clientCtx, signedTxFile)
+		s.Require().NoError(err)
+		var txRes sdk.TxResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txRes))
+		s.Require().Equal(uint32(0), txRes.Code, txRes.RawLog)
+	}
+
+	// rotate the multisig public key of the account with the current members
+	rotateTx, err := TxRotateMultisigPubKeyExec(clientCtx, addr, newMultisigInfo.GetName(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	signAndBroadcast(testutil.WriteToNewTempFile(s.T(), rotateTx.String()).Name(), multisigInfo.GetName(), members[0], members[1])
+
+	out, err := QueryAccountExec(clientCtx, addr)
+	s.Require().NoError(err)
+	var acc authtypes.AccountI
+	s.Require().NoError(clientCtx.Codec.UnmarshalInterfaceJSON(out.Bytes(), &acc))
+	s.Require().True(newMultisigInfo.GetPubKey().Equals(acc.GetPubKey()))
+
+	// the funds of the account are then sent with the new members
+	fees := sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10))
+	migrateTx, err := bankcli.MsgMigrateMultisigFundsExec(clientCtx, addr, val1.Address,
+		fmt.Sprintf("--%s=%s", flags.FlagFees, fees),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	)
+	s.Require().NoError(err)
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(migrateTx.Bytes())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 980)), tx.GetMsgs()[0].(*banktypes.MsgSend).Amount)
+	signAndBroadcast(testutil.WriteToNewTempFile(s.T(), migrateTx.String()).Name(), newMultisigInfo.GetName(), members[1], members[2])
+
+	out, err = bankcli.QueryBalancesExec(clientCtx, addr)
+	s.Require().NoError(err)
+	var balRes banktypes.QueryAllBalancesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &balRes))
+	s.Require().True(balRes.Balances.IsZero())
+}
+
 func (s *IntegrationTestSuite) TestSignBatchMultisig() {
 	val := s.network.Validators[0]
 
@@ -1296,6 +1438,83 @@ func (s *IntegrationTestSuite) TestSignWithMultiSignersAminoJSON() {
 	require.Equal(sdk.NewCoins(val0Coin, val1Coin), queryRes.Balances)
 }
 
+func (s *IntegrationTestSuite) TestAuxSignAndCombine() {
+	// test case:
+	// Create a transaction with a message signed by val1 only, which is signed
+	// by val1 as an auxiliary signer. val0 then completes the tx as the fee
+	// payer, signs it in DIRECT mode and broadcasts it.
+
+	require := s.Require()
+	val0, val1 := s.network.Validators[0], s.network.Validators[1]
+	val1Coin := sdk.NewCoin(fmt.Sprintf("%stoken", val1.Moniker), sdk.NewInt(10))
+	_, _, addr1 := testdata.KeyTestPubAddr()
+
+	// Write the unsigned tx of val1 into a file.
+	txBuilder := val1.ClientCtx.TxConfig.NewTxBuilder()
+	require.NoError(txBuilder.SetMsgs(banktypes.NewMsgSend(val1.Address, addr1, sdk.NewCoins(val1Coin))))
+	txBuilder.SetMemo("aux")
+	txJSON, err := val1.ClientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(err)
+	unsignedTxFile := testutil.WriteToNewTempFile(s.T(), string(txJSON))
+
+	// val1 only signs over the tx body.
+	val1AccNum, val1Seq, err := val0.ClientCtx.AccountRetriever.GetAccountNumberSequence(val0.ClientCtx, val1.Address)
+	require.NoError(err)
+	auxSignerDataJSON, err := TxAuxSignExec(
+		val1.ClientCtx, val1.Address, unsignedTxFile.Name(),
+		"--offline", fmt.Sprintf("--account-number=%d", val1AccNum), fmt.Sprintf("--sequence=%d", val1Seq),
+	)
+	require.NoError(err)
+	var auxSignerData tx.AuxSignerData
+	require.NoError(val1.ClientCtx.Codec.UnmarshalJSON(auxSignerDataJSON.Bytes(), &auxSignerData))
+	require.Equal(val1.Address.String(), auxSignerData.Address)
+	require.Equal(signing.SignMode_SIGN_MODE_DIRECT_AUX, auxSignerData.Mode)
+	auxSignerDataFile := testutil.WriteToNewTempFile(s.T(), auxSignerDataJSON.String())
+
+	// A message signer cannot be the fee payer.
+	_, err = TxAuxCombineExec(val1.ClientCtx, val1.Address, []string{auxSignerDataFile.Name()}, "--generate-only")
+	require.Error(err)
+
+	// val0 completes the tx with the fee, signs it and broadcasts it.
+	res, err := TxAuxCombineExec(
+		val0.ClientCtx, val0.Address, []string{auxSignerDataFile.Name()},
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	)
+	require.NoError(err)
+	var txRes sdk.TxResponse
+	require.NoError(val0.ClientCtx.Codec.UnmarshalJSON(res.Bytes(), &txRes))
+	require.Equal(uint32(0), txRes.Code, txRes.RawLog)
+
+	// Make sure the addr1's balance got funded.
+	queryResJSON, err := bankcli.QueryBalancesExec(val0.ClientCtx, addr1)
+	require.NoError(err)
+	var queryRes banktypes.QueryAllBalancesResponse
+	require.NoError(val0.ClientCtx.Codec.UnmarshalJSON(queryResJSON.Bytes(), &queryRes))
+	require.Equal(sdk.NewCoins(val1Coin), queryRes.Balances)
+}
+
+func (s *IntegrationTestSuite) TestSignVerifyText() {
+	val := s.network.Validators[0]
+	text := "login nonce 1"
+
+	res, err := clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.GetSignTextCommand(), []string{val.Moniker, text})
+	s.Require().NoError(err)
+	sigFile := testutil.WriteToNewTempFile(s.T(), res.String())
+
+	res, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.GetVerifyTextCommand(), []string{val.Address.String(), text, sigFile.Name()})
+	s.Require().NoError(err)
+	s.Require().Contains(res.String(), "signature verified")
+
+	// the signature is not valid for other texts or accounts
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.GetVerifyTextCommand(), []string{val.Address.String(), "login nonce 2", sigFile.Name()})
+	s.Require().Error(err)
+
+	_, err = clitestutil.ExecTestCLICmd(val.ClientCtx, authcli.GetVerifyTextCommand(), []string{s.network.Validators[1].Address.String(), text, sigFile.Name()})
+	s.Require().Error(err)
+}
+
 func (s *IntegrationTestSuite) createBankMsg(val *network.Validator, toAddr sdk.AccAddress, amount sdk.Coins, extraFlags ...string) (testutil.BufferWriter, error) {
 	flags := []string{fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
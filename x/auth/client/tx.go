@@ -76,6 +76,9 @@ func SignTxWithSignerAddress(txFactory tx.Factory, clientCtx client.Context, add
 	if txFactory.SignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
 		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
 	}
+	if err := CheckMultisigSignMode(txFactory.SignMode()); err != nil {
+		return err
+	}
 
 	// check whether the address is a signer
 	if !isTxSigner(addr, txBuilder.GetTx().GetSigners()) {
@@ -92,6 +95,20 @@ func SignTxWithSignerAddress(txFactory tx.Factory, clientCtx client.Context, add
 	return tx.Sign(txFactory, name, txBuilder, overwrite)
 }
 
+// CheckMultisigSignMode returns an error if the signatures of the members of a
+// multisig, possibly nested in other multisigs, cannot use the sign mode. The
+// SIGN_MODE_DIRECT sign bytes include the signer infos, with the mode infos of
+// the multisig which are only known once the signatures are combined, and the
+// SIGN_MODE_EIP712 signatures are not verified by multisig public keys.
+func CheckMultisigSignMode(signMode signing.SignMode) error {
+	switch signMode {
+	case signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_EIP712:
+		return fmt.Errorf("%s is not supported by multisig signatures, use %s", signMode, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	default:
+		return nil
+	}
+}
+
 // Read and decode a StdTx from the given filename.  Can pass "-" to read from stdin.
 func ReadTxFromFile(ctx client.Context, filename string) (tx sdk.Tx, err error) {
 	var bytes []byte
@@ -81,5 +81,5 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 	params := ak.GetParams(ctx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, LastChangedHeight: ak.paramSubspace.GetLastChangeHeight(ctx)}, nil
 }
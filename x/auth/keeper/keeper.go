@@ -54,6 +54,10 @@ type AccountKeeper struct {
 
 	// The prototypical AccountI constructor.
 	proto func() types.AccountI
+
+	// authority is the address allowed to update the parameters of the
+	// module, usually the gov module account.
+	authority string
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -64,10 +68,14 @@ var _ AccountKeeperI = &AccountKeeper{}
 // types.PermissionsForAddress and is used in keeper.ValidatePermissions. Permissions are plain strings,
 // and don't have to fit into any predefined structure. This auth module does not use account permissions internally, though other modules
 // may use auth.Keeper to access the accounts permissions map.
+// `authority` is the address allowed to update the parameters, usually the gov module account.
 func NewAccountKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string,
+	maccPerms map[string][]string, authority string,
 ) AccountKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid auth authority %s", authority))
+	}
 
 	// set KeyTable if it has not already been set
 	if !paramstore.HasKeyTable() {
@@ -85,9 +93,16 @@ func NewAccountKeeper(
 		cdc:           cdc,
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the parameters of the
+// module.
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -132,7 +132,7 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Marshaler
 	keeper := keeper.NewAccountKeeper(
 		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms,
+		types.ProtoBaseAccount, maccPerms, app.AccountKeeper.GetAuthority(),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+type msgServer struct {
+	AccountKeeper
+}
+
+// NewMsgServerImpl returns an implementation of the auth MsgServer interface
+// for the provided AccountKeeper.
+func NewMsgServerImpl(ak AccountKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: ak}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams implements MsgServer.UpdateParams method.
+// The memo, signature and tx size limits and the signature verification
+// costs of the ante handler are replaced, by the authority of the module only.
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if msg.Authority != ms.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected authority %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RotateMultisigPubKey implements MsgServer.RotateMultisigPubKey method.
+// The multisig public key of the account is replaced by the new one, keeping
+// the address of the account.
+func (ms msgServer) RotateMultisigPubKey(goCtx context.Context, msg *types.MsgRotateMultisigPubKey) (*types.MsgRotateMultisigPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	newPubKey, err := msg.GetNewMultisigPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := ms.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	pubKey, ok := acc.GetPubKey().(multisig.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "the public key of account %s is not a multisig public key", addr)
+	}
+	if pubKey.Equals(newPubKey) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "the public key of account %s is already the new public key", addr)
+	}
+
+	if err := acc.SetPubKey(newPubKey); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ms.SetAccount(ctx, acc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address),
+		),
+	)
+
+	return &types.MsgRotateMultisigPubKeyResponse{}, nil
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	params := app.AccountKeeper.GetParams(ctx)
+	params.MaxMemoCharacters = 512
+	invalid := params
+	invalid.TxSigLimit = 0
+
+	testutil.RunUpdateParamsTest(t, ctx, testutil.UpdateParamsTest{
+		Authority: app.AccountKeeper.GetAuthority(),
+		Params:    params,
+		Invalid:   invalid,
+		Update: func(ctx sdk.Context, authority string, params interface{}) error {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: params.(types.Params)})
+			return err
+		},
+		Query: func(ctx sdk.Context) (interface{}, int64) {
+			res, err := app.AccountKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+			require.NoError(t, err)
+			return res.Params, res.LastChangedHeight
+		},
+	})
+}
+
+func TestRotateMultisigPubKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.AccountKeeper)
+
+	pubKeys := simapp.CreateTestPubKeys(3)
+	pubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys[:2])
+	newPubKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys[1:])
+	addr := sdk.AccAddress(pubKey.Address())
+
+	msg, err := types.NewMsgRotateMultisigPubKey(addr, newPubKey)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	// the account must exist
+	_, err = msgServer.RotateMultisigPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+
+	// the public key of the account must be a multisig public key
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(pubKeys[0]))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	_, err = msgServer.RotateMultisigPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	require.NoError(t, acc.SetPubKey(pubKey))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	_, err = msgServer.RotateMultisigPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the account keeps its address
+	acc = app.AccountKeeper.GetAccount(ctx, addr)
+	require.Equal(t, addr, acc.GetAddress())
+	require.True(t, newPubKey.Equals(acc.GetPubKey()))
+
+	_, err = msgServer.RotateMultisigPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	// the new public key must be a valid multisig public key
+	msg, err = types.NewMsgRotateMultisigPubKey(addr, pubKeys[0])
+	require.NoError(t, err)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidPubKey)
+
+	pubKey.Threshold = 3
+	msg, err = types.NewMsgRotateMultisigPubKey(addr, pubKey)
+	require.NoError(t, err)
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidPubKey)
+}
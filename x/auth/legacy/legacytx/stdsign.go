@@ -167,7 +167,7 @@ func pubKeySigToSigData(cdc *codec.LegacyAmino, key cryptotypes.PubKey, sig []by
 			}
 
 			sigDatas[sigIdx] = data
-			multisig.AddSignature(signatures, data, sigIdx)
+			multisig.AddSignature(signatures, data, i)
 			sigIdx++
 		}
 	}
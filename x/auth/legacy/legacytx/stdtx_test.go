@@ -233,6 +233,29 @@ func TestSignatureV2Conversions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, multiPK, sigV2.PubKey)
 	require.Equal(t, msigData, sigV2.Data)
+
+	// nested multisigs, with the signature of the nested multisig only
+	_, pubKey3, _ := testdata.KeyTestPubAddr()
+	nestedMultiPK := kmultisig.NewLegacyAminoPubKey(1, []cryptotypes.PubKey{
+		pubKey3, multiPK,
+	})
+	nestedBitArray := types.NewCompactBitArray(2)
+	nestedBitArray.SetIndex(1, true)
+	nestedMsigData := &signing.MultiSignatureData{
+		BitArray:   nestedBitArray,
+		Signatures: []signing.SignatureData{msigData},
+	}
+
+	nestedMsig, err := SignatureDataToAminoSignature(cdc, nestedMsigData)
+	require.NoError(t, err)
+
+	sigV2, err = StdSignatureToSignatureV2(cdc, StdSignature{
+		PubKey:    nestedMultiPK,
+		Signature: nestedMsig,
+	})
+	require.NoError(t, err)
+	require.Equal(t, nestedMultiPK, sigV2.PubKey)
+	require.Equal(t, nestedMsigData, sigV2.Data)
 }
 
 func TestGetSignaturesV2(t *testing.T) {
@@ -0,0 +1,30 @@
+package offchain
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+)
+
+// RegisterLegacyAminoCodec registers the off-chain messages on the provided
+// LegacyAmino codec, under the names expected by ADR-036 wallets.
+//
+// MsgSignArbitraryData is deliberately not registered as an sdk.Msg
+// implementation on the InterfaceRegistry, so that txs including it can never
+// be decoded, let alone routed, on-chain.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSignArbitraryData{}, "sign/MsgSignData", nil)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc is the Amino codec the sign bytes of the off-chain messages are
+	// encoded with.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
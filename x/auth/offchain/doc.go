@@ -0,0 +1,13 @@
+/*
+Package offchain implements the ADR-036 signing of arbitrary data, which lets
+off-chain flows such as airdrop claims or logins authenticate the owner of an
+account the same way wallets and ledger devices sign txs.
+
+The data is wrapped in a MsgSignArbitraryData and signed in the legacy Amino
+JSON sign mode, with an empty chain ID, memo and fee and a zero account number
+and sequence. Such a signature is never valid for an on-chain tx. It is however
+valid for any verifier of the same data: applications which must not accept a
+signature twice, or one made for another application, should make the signed
+data unique, e.g. by including a domain and a nonce in it.
+*/
+package offchain
@@ -0,0 +1,54 @@
+package offchain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// TypeMsgSignArbitraryData is the type of MsgSignArbitraryData.
+const TypeMsgSignArbitraryData = "sign_arbitrary_data"
+
+var _ legacytx.LegacyMsg = &MsgSignArbitraryData{}
+
+// NewMsgSignArbitraryData returns a MsgSignArbitraryData of data signed by
+// signer.
+//nolint:interfacer
+func NewMsgSignArbitraryData(signer sdk.AccAddress, data []byte) *MsgSignArbitraryData {
+	return &MsgSignArbitraryData{Signer: signer.String(), Data: data}
+}
+
+// Route implements legacytx.LegacyMsg. MsgSignArbitraryData has no route, as
+// it is never sent on-chain.
+func (msg MsgSignArbitraryData) Route() string { return "" }
+
+// Type implements legacytx.LegacyMsg.
+func (msg MsgSignArbitraryData) Type() string { return TypeMsgSignArbitraryData }
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgSignArbitraryData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if len(msg.Data) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "data cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg MsgSignArbitraryData) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg.
+func (msg MsgSignArbitraryData) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{signer}
+}
@@ -0,0 +1,63 @@
+package offchain
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// SignBytes returns the bytes signed by signer to sign data off-chain.
+func SignBytes(signer sdk.AccAddress, data []byte) []byte {
+	msg := NewMsgSignArbitraryData(signer, data)
+	return legacytx.StdSignBytes("", 0, 0, 0, legacytx.StdFee{}, []sdk.Msg{msg}, "")
+}
+
+// Sign signs data off-chain with the key uid of the keyring.
+func Sign(kr keyring.Keyring, uid string, data []byte) (legacytx.StdSignature, error) {
+	info, err := kr.Key(uid)
+	if err != nil {
+		return legacytx.StdSignature{}, err
+	}
+
+	msg := NewMsgSignArbitraryData(info.GetAddress(), data)
+	if err := msg.ValidateBasic(); err != nil {
+		return legacytx.StdSignature{}, err
+	}
+
+	sig, pubKey, err := kr.Sign(uid, SignBytes(info.GetAddress(), data))
+	if err != nil {
+		return legacytx.StdSignature{}, err
+	}
+
+	return legacytx.StdSignature{PubKey: pubKey, Signature: sig}, nil
+}
+
+// Verify verifies that sig is the off-chain signature of data by the account
+// of the bech32 address signer.
+func Verify(signer string, data []byte, sig legacytx.StdSignature) error {
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if err := NewMsgSignArbitraryData(signerAddr, data).ValidateBasic(); err != nil {
+		return err
+	}
+
+	if sig.PubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	if !bytes.Equal(sig.PubKey.Address(), signerAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key does not match signer address %s", signer)
+	}
+
+	if !sig.PubKey.VerifySignature(SignBytes(signerAddr, data), sig.Signature) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+	}
+
+	return nil
+}
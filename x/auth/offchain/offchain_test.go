@@ -0,0 +1,66 @@
+package offchain_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/offchain"
+)
+
+func TestSignBytes(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	expected := fmt.Sprintf(
+		`{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"ZGF0YQ==","signer":"%s"}}],"sequence":"0"}`,
+		addr,
+	)
+	require.Equal(t, expected, string(offchain.SignBytes(addr, []byte("data"))))
+}
+
+func TestSignVerify(t *testing.T) {
+	kr := keyring.NewInMemory()
+	info, _, err := kr.NewMnemonic("signer", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	signer := info.GetAddress().String()
+
+	_, err = offchain.Sign(kr, "missing", []byte("data"))
+	require.Error(t, err)
+
+	_, err = offchain.Sign(kr, "signer", nil)
+	require.Error(t, err)
+
+	sig, err := offchain.Sign(kr, "signer", []byte("data"))
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), sig.PubKey)
+	require.NoError(t, offchain.Verify(signer, []byte("data"), sig))
+
+	_, otherPubKey, otherAddr := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name   string
+		signer string
+		data   []byte
+		sig    legacytx.StdSignature
+	}{
+		{"invalid signer", "invalid", []byte("data"), sig},
+		{"other signer", otherAddr.String(), []byte("data"), sig},
+		{"other data", signer, []byte("other data"), sig},
+		{"empty data", signer, nil, sig},
+		{"missing public key", signer, []byte("data"), legacytx.StdSignature{Signature: sig.Signature}},
+		{"other public key", signer, []byte("data"), legacytx.StdSignature{PubKey: otherPubKey, Signature: sig.Signature}},
+		{"invalid signature", signer, []byte("data"), legacytx.StdSignature{PubKey: sig.PubKey, Signature: []byte("invalid")}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, offchain.Verify(tc.signer, tc.data, tc.sig))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package signing
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
@@ -23,6 +24,11 @@ type SignModeHandler interface {
 // SignerData is the specific information needed to sign a transaction that generally
 // isn't included in the transaction body itself
 type SignerData struct {
+	// Address is the bech32-encoded address of the signer. It is only needed
+	// by sign modes which depend on the signer's role in the transaction, such
+	// as SIGN_MODE_DIRECT_AUX.
+	Address string
+
 	// ChainID is the chain that this transaction is targeted
 	ChainID string
 
@@ -34,4 +40,8 @@ type SignerData struct {
 	// since in SIGN_MODE_DIRECT the account sequence is already in the signer
 	// info.
 	Sequence uint64
+
+	// PubKey is the public key of the signer. It is only needed by sign modes
+	// which sign over the public key, such as SIGN_MODE_DIRECT_AUX.
+	PubKey cryptotypes.PubKey
 }
@@ -3,6 +3,9 @@ package signing
 import (
 	"fmt"
 
+	"golang.org/x/crypto/sha3"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +21,9 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 		if err != nil {
 			return err
 		}
+		if data.SignMode == signing.SignMode_SIGN_MODE_EIP712 {
+			return verifyEIP712Signature(pubKey, signBytes, data.Signature)
+		}
 		if !pubKey.VerifySignature(signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature")
 		}
@@ -39,3 +45,21 @@ func VerifySignature(pubKey cryptotypes.PubKey, signerData SignerData, sigData s
 		return fmt.Errorf("unexpected SignatureData %T", sigData)
 	}
 }
+
+// verifyEIP712Signature verifies a secp256k1 signature made by an Ethereum
+// wallet over the Keccak-256 digest of the EIP-712 sign bytes.
+func verifyEIP712Signature(pubKey cryptotypes.PubKey, signBytes, sig []byte) error {
+	secpPubKey, ok := pubKey.(*secp256k1.PubKey)
+	if !ok {
+		return fmt.Errorf("%s requires a %T, got %T", signing.SignMode_SIGN_MODE_EIP712, secpPubKey, pubKey)
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(signBytes)
+
+	if !secpPubKey.VerifyDigestSignature(hasher.Sum(nil), sig) {
+		return fmt.Errorf("unable to verify single signer signature")
+	}
+
+	return nil
+}
@@ -28,6 +28,10 @@ type wrapper struct {
 	authInfoBz []byte
 
 	txBodyHasUnknownNonCriticals bool
+
+	// authInfoHasUnknownNonCriticals is only ever set when the tx was decoded
+	// with DecodeModeTolerant
+	authInfoHasUnknownNonCriticals bool
 }
 
 var (
@@ -96,6 +100,13 @@ func (w *wrapper) getAuthInfoBytes() []byte {
 	return w.authInfoBz
 }
 
+// hasUnknownNonCriticals returns true if either the body or the auth info
+// contained unknown non-critical fields when the tx was decoded. Such fields
+// are not covered by the sign modes that re-encode the tx.
+func (w *wrapper) hasUnknownNonCriticals() bool {
+	return w.txBodyHasUnknownNonCriticals || w.authInfoHasUnknownNonCriticals
+}
+
 func (w *wrapper) GetSigners() []sdk.AccAddress {
 	return w.tx.GetSigners()
 }
@@ -0,0 +1,81 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ signing.SignModeHandler = signModeCanonicalJSONHandler{}
+
+// signModeCanonicalJSONHandler defines the SIGN_MODE_CANONICAL_JSON
+// SignModeHandler.
+type signModeCanonicalJSONHandler struct {
+	registry codectypes.InterfaceRegistry
+}
+
+// canonicalJSONSignDoc is the document signed with SIGN_MODE_CANONICAL_JSON. It
+// holds the same data as the SIGN_MODE_DIRECT SignDoc, with the body and auth
+// info rendered as canonical proto JSON instead of their binary encoding.
+type canonicalJSONSignDoc struct {
+	AccountNumber string          `json:"account_number"`
+	AuthInfo      json.RawMessage `json:"auth_info"`
+	Body          json.RawMessage `json:"body"`
+	ChainID       string          `json:"chain_id"`
+}
+
+func (s signModeCanonicalJSONHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON
+}
+
+func (s signModeCanonicalJSONHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON}
+}
+
+// GetSignBytes returns the canonical JSON encoding of the sign doc, with the
+// account number rendered as a string like other 64-bit integers in proto JSON.
+func (s signModeCanonicalJSONHandler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	// unknown fields are dropped when the body and auth info are rendered as
+	// JSON, so they would not be covered by the signature
+	if protoTx.hasUnknownNonCriticals() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "protobuf transaction contains unknown non-critical fields, which cannot be rendered with SIGN_MODE_CANONICAL_JSON")
+	}
+
+	body, err := codec.ProtoMarshalCanonicalJSON(protoTx.tx.Body, s.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	authInfo, err := codec.ProtoMarshalCanonicalJSON(protoTx.tx.AuthInfo, s.registry)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(canonicalJSONSignDoc{
+		AccountNumber: strconv.FormatUint(data.AccountNumber, 10),
+		AuthInfo:      authInfo,
+		Body:          body,
+		ChainID:       data.ChainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.CanonicalizeJSON(bz)
+}
@@ -0,0 +1,51 @@
+package tx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestCanonicalJSONHandler_GetSignBytes(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+
+	bldr := newBuilder()
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := signModeCanonicalJSONHandler{registry: registry}
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+	}
+
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON, signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`{"account_number":"7",`+
+		`"auth_info":{"fee":{"amount":[{"amount":"10","denom":"foocoin"}],"gas_limit":"10000"}},`+
+		`"body":{"memo":"foo","messages":[{"@type":"/testdata.TestMsg","signers":["%s","%s"]}],"timeout_height":"10"},`+
+		`"chain_id":"test-chain"}`, addr1, addr2), string(signBz))
+
+	// sign bytes commit to the signer data
+	signingData.AccountNumber++
+	otherSignBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherSignBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with unknown non-critical fields, which are not rendered
+	bldr.txBodyHasUnknownNonCriticals = true
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON, signingData, bldr.GetTx())
+	require.Error(t, err)
+}
@@ -19,18 +19,26 @@ type config struct {
 	jsonDecoder sdk.TxDecoder
 	jsonEncoder sdk.TxEncoder
 	protoCodec  codec.ProtoCodecMarshaler
+	decodeMode  DecodeMode
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
+	return NewTxConfigWithDecodeMode(protoCodec, enabledSignModes, DecodeModeStrict)
+}
+
+// NewTxConfigWithDecodeMode returns a new protobuf TxConfig like NewTxConfig,
+// whose TxDecoder handles unknown fields according to the given DecodeMode.
+func NewTxConfigWithDecodeMode(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, decodeMode DecodeMode) client.TxConfig {
 	return &config{
-		handler:     makeSignModeHandler(enabledSignModes),
-		decoder:     DefaultTxDecoder(protoCodec),
+		handler:     makeSignModeHandler(enabledSignModes, protoCodec.InterfaceRegistry()),
+		decoder:     NewTxDecoder(protoCodec, decodeMode),
 		encoder:     DefaultTxEncoder(),
 		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
 		jsonEncoder: DefaultJSONTxEncoder(protoCodec),
 		protoCodec:  protoCodec,
+		decodeMode:  decodeMode,
 	}
 }
 
@@ -67,3 +75,8 @@ func (g config) TxJSONEncoder() sdk.TxEncoder {
 func (g config) TxJSONDecoder() sdk.TxDecoder {
 	return g.jsonDecoder
 }
+
+// DecodeMode returns the DecodeMode of the config's TxDecoder.
+func (g config) DecodeMode() DecodeMode {
+	return g.decodeMode
+}
@@ -12,8 +12,43 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
+// DecodeMode defines how a TxDecoder treats unknown protobuf fields.
+type DecodeMode string
+
+const (
+	// DecodeModeStrict rejects all unknown fields in TxRaw and AuthInfo, and
+	// only allows unknown non-critical fields in TxBody.
+	DecodeModeStrict DecodeMode = "strict"
+	// DecodeModeTolerant rejects unknown critical fields, but allows unknown
+	// non-critical fields in both TxBody and AuthInfo, so that transactions
+	// built against newer proto revisions can still be decoded. TxRaw is
+	// always decoded strictly.
+	DecodeModeTolerant DecodeMode = "tolerant"
+)
+
+// ParseDecodeMode returns the DecodeMode with the given name. An empty name
+// defaults to DecodeModeStrict.
+func ParseDecodeMode(name string) (DecodeMode, error) {
+	switch mode := DecodeMode(name); mode {
+	case "":
+		return DecodeModeStrict, nil
+	case DecodeModeStrict, DecodeModeTolerant:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid tx decode mode %q, expected %q or %q", name, DecodeModeStrict, DecodeModeTolerant)
+	}
+}
+
 // DefaultTxDecoder returns a default protobuf TxDecoder using the provided Marshaler.
 func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
+	return NewTxDecoder(cdc, DecodeModeStrict)
+}
+
+// NewTxDecoder returns a protobuf TxDecoder using the provided Marshaler, which
+// handles unknown fields according to the given DecodeMode.
+func NewTxDecoder(cdc codec.ProtoCodecMarshaler, mode DecodeMode) sdk.TxDecoder {
+	allowAuthInfoUnknownNonCriticals := mode == DecodeModeTolerant
+
 	return func(txBytes []byte) (sdk.Tx, error) {
 		// Make sure txBytes follow ADR-027.
 		err := rejectNonADR027TxRaw(txBytes)
@@ -49,8 +84,9 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 
 		var authInfo tx.AuthInfo
 
-		// reject all unknown proto fields in AuthInfo
-		err = unknownproto.RejectUnknownFieldsStrict(raw.AuthInfoBytes, &authInfo, cdc.InterfaceRegistry())
+		// reject unknown proto fields in AuthInfo, except for non-critical ones
+		// in tolerant mode
+		authInfoHasUnknownNonCriticals, err := unknownproto.RejectUnknownFields(raw.AuthInfoBytes, &authInfo, allowAuthInfoUnknownNonCriticals, cdc.InterfaceRegistry())
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
 		}
@@ -67,10 +103,11 @@ func DefaultTxDecoder(cdc codec.ProtoCodecMarshaler) sdk.TxDecoder {
 		}
 
 		return &wrapper{
-			tx:                           theTx,
-			bodyBz:                       raw.BodyBytes,
-			authInfoBz:                   raw.AuthInfoBytes,
-			txBodyHasUnknownNonCriticals: txBodyHasUnknownNonCriticals,
+			tx:                             theTx,
+			bodyBz:                         raw.BodyBytes,
+			authInfoBz:                     raw.AuthInfoBytes,
+			txBodyHasUnknownNonCriticals:   txBodyHasUnknownNonCriticals,
+			authInfoHasUnknownNonCriticals: authInfoHasUnknownNonCriticals,
 		}, nil
 	}
 }
@@ -0,0 +1,110 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestDirectAuxHandler(t *testing.T) {
+	_, auxPubKey, auxAddr := testdata.KeyTestPubAddr()
+	_, feePayerPubKey, feePayerAddr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_DIRECT_AUX})
+	txBuilder := txConfig.NewTxBuilder()
+
+	memo := "sometestmemo"
+	msgs := []sdk.Msg{testdata.NewTestMsg(auxAddr)}
+	accSeq := uint64(2) // Arbitrary account sequence
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+	}
+	sig := signingtypes.SignatureV2{
+		PubKey:   auxPubKey,
+		Data:     sigData,
+		Sequence: accSeq,
+	}
+	feePayerSig := signingtypes.SignatureV2{
+		PubKey:   feePayerPubKey,
+		Data:     sigData,
+		Sequence: accSeq,
+	}
+
+	fee := txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 150)), GasLimit: 20000}
+
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetMemo(memo)
+	txBuilder.SetFeeAmount(fee.Amount)
+	txBuilder.SetGasLimit(fee.GasLimit)
+	txBuilder.(*wrapper).SetFeePayer(feePayerAddr)
+	require.NoError(t, txBuilder.SetSignatures(sig, feePayerSig))
+
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	signingData := signing.SignerData{
+		Address:       auxAddr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		PubKey:        auxPubKey,
+	}
+
+	signBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// the sign bytes only depend on the body, and not on the fee or the
+	// signer infos
+	bodyBz := txBuilder.(*wrapper).getBodyBytes()
+	pkAny, err := codectypes.NewAnyWithValue(auxPubKey)
+	require.NoError(t, err)
+	expectedSignBytes, err := (&txtypes.SignDocDirectAux{
+		BodyBytes:     bodyBz,
+		PublicKey:     pkAny,
+		ChainId:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+	}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBytes, signBytes)
+
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 300)))
+	require.NoError(t, txBuilder.SetSignatures(sig))
+	otherSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, signBytes, otherSignBytes)
+
+	t.Log("verify fee payer cannot use SIGN_MODE_DIRECT_AUX")
+	feePayerSigningData := signing.SignerData{
+		Address:       feePayerAddr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		PubKey:        feePayerPubKey,
+	}
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, feePayerSigningData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify signer data is required")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignerData{Address: auxAddr.String()}, txBuilder.GetTx())
+	require.Error(t, err)
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT_AUX, signing.SignerData{PubKey: auxPubKey}, txBuilder.GetTx())
+	require.Error(t, err)
+
+	t.Log("verify GetSignBytes with wrong mode fails")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+}
@@ -0,0 +1,71 @@
+package tx
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/eip712"
+)
+
+var _ signing.SignModeHandler = signModeEIP712Handler{}
+
+// signModeEIP712Handler defines the SIGN_MODE_EIP712 SignModeHandler.
+type signModeEIP712Handler struct {
+	registry codectypes.InterfaceRegistry
+}
+
+func (s signModeEIP712Handler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_EIP712
+}
+
+func (s signModeEIP712Handler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP712}
+}
+
+// GetSignBytes returns the EIP-712 encoding of the transaction typed data. The
+// signature is expected over the Keccak-256 digest of these bytes.
+func (s signModeEIP712Handler) GetSignBytes(mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP712 {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP712, mode)
+	}
+
+	typedData, err := GetEIP712TypedData(s.registry, data, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// GetEIP712TypedData returns the EIP-712 typed data a signer must sign with
+// SIGN_MODE_EIP712, e.g. through an Ethereum wallet's eth_signTypedData_v4.
+func GetEIP712TypedData(registry codectypes.InterfaceRegistry, data signing.SignerData, tx sdk.Tx) (eip712.TypedData, error) {
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return eip712.TypedData{}, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	if protoTx.hasUnknownNonCriticals() {
+		return eip712.TypedData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "protobuf transaction contains unknown non-critical fields, which cannot be rendered with SIGN_MODE_EIP712")
+	}
+
+	body := protoTx.tx.Body
+
+	if len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
+		return eip712.TypedData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "SIGN_MODE_EIP712 does not support protobuf extension options.")
+	}
+
+	typedData, err := eip712.NewTypedData(
+		registry, data.ChainID, data.AccountNumber, data.Sequence, body.TimeoutHeight,
+		protoTx.tx.AuthInfo.Fee, tx.GetMsgs(), body.Memo,
+	)
+	if err != nil {
+		return eip712.TypedData{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return typedData, nil
+}
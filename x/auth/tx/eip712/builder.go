@@ -0,0 +1,406 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	anyTypeName       = ".google.protobuf.Any"
+	timestampTypeName = ".google.protobuf.Timestamp"
+	durationTypeName  = ".google.protobuf.Duration"
+
+	// emptyAnyType is the EIP-712 type of unset Any fields and of empty
+	// repeated Any fields, whose concrete type cannot be known.
+	emptyAnyType = "google_protobuf_Any"
+)
+
+// descriptors caches message descriptors by proto full name, as they are
+// decompressed from the gzipped file descriptors on every lookup otherwise.
+var descriptors sync.Map
+
+// NewTypedData renders the sign doc of a transaction as EIP-712 typed data.
+// The EIP-712 struct types are generated deterministically from the protobuf
+// descriptors of the messages registered in the interface registry, and the
+// values are taken from their protobuf JSON encoding.
+func NewTypedData(
+	registry codectypes.InterfaceRegistry, chainID string, accNum, sequence, timeoutHeight uint64,
+	fee *tx.Fee, msgs []sdk.Msg, memo string,
+) (TypedData, error) {
+	b := &builder{
+		registry:   registry,
+		types:      Types{},
+		inProgress: map[string]bool{},
+	}
+
+	b.types[DomainType] = []Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+	}
+
+	if fee == nil {
+		fee = &tx.Fee{}
+	}
+
+	feeType, feeValue, err := b.protoMessage(fee)
+	if err != nil {
+		return TypedData{}, fmt.Errorf("failed to render fee: %w", err)
+	}
+
+	txTypes := []Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: feeType},
+		{Name: "memo", Type: "string"},
+	}
+	message := map[string]interface{}{
+		"account_number": strconv.FormatUint(accNum, 10),
+		"chain_id":       chainID,
+		"fee":            feeValue,
+		"memo":           memo,
+	}
+
+	// Messages are rendered as distinct members rather than as an array, as
+	// EIP-712 arrays must be homogeneous while transactions may mix msg types.
+	for i, msg := range msgs {
+		msgType, msgValue, err := b.anyMessage(sdk.MsgTypeURL(msg), msg)
+		if err != nil {
+			return TypedData{}, fmt.Errorf("failed to render msg %d: %w", i, err)
+		}
+
+		name := fmt.Sprintf("msg%d", i)
+		txTypes = append(txTypes, Type{Name: name, Type: msgType})
+		message[name] = msgValue
+	}
+
+	b.types[PrimaryType] = append(txTypes,
+		Type{Name: "sequence", Type: "uint64"},
+		Type{Name: "timeout_height", Type: "uint64"},
+	)
+	message["sequence"] = strconv.FormatUint(sequence, 10)
+	message["timeout_height"] = strconv.FormatUint(timeoutHeight, 10)
+
+	return TypedData{
+		Types:       b.types,
+		PrimaryType: PrimaryType,
+		Domain: map[string]interface{}{
+			"name":    DomainName,
+			"version": DomainVersion,
+		},
+		Message: message,
+	}, nil
+}
+
+type builder struct {
+	registry codectypes.InterfaceRegistry
+	types    Types
+	// inProgress holds the types being zero-filled, to reject recursive types
+	// which cannot be expanded into a finite EIP-712 value.
+	inProgress map[string]bool
+}
+
+// protoMessage renders msg as an EIP-712 struct value and returns its type.
+func (b *builder) protoMessage(msg proto.Message) (string, map[string]interface{}, error) {
+	bz, err := codec.ProtoMarshalJSON(msg, b.registry)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var obj map[string]interface{}
+	if err := decodeJSON(bz, &obj); err != nil {
+		return "", nil, err
+	}
+
+	return b.message(proto.MessageName(msg), obj)
+}
+
+// anyMessage renders a packed message as an EIP-712 struct holding its type
+// URL and value.
+func (b *builder) anyMessage(typeURL string, msg proto.Message) (string, map[string]interface{}, error) {
+	valueType, value, err := b.protoMessage(msg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return b.anyWrapper(typeURL, valueType, value)
+}
+
+func (b *builder) anyWrapper(typeURL, valueType string, value map[string]interface{}) (string, map[string]interface{}, error) {
+	typeName := "Any_" + valueType
+	if err := b.addType(typeName, []Type{
+		{Name: "type_url", Type: "string"},
+		{Name: "value", Type: valueType},
+	}); err != nil {
+		return "", nil, err
+	}
+
+	return typeName, map[string]interface{}{
+		"type_url": typeURL,
+		"value":    value,
+	}, nil
+}
+
+// message renders the proto JSON object of the message with the given full
+// name. A nil object is rendered as the zero value of the message.
+func (b *builder) message(fullName string, obj map[string]interface{}) (string, map[string]interface{}, error) {
+	md, err := messageDescriptor(fullName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	typeName := strings.ReplaceAll(fullName, ".", "_")
+
+	if obj == nil {
+		if b.inProgress[typeName] {
+			return "", nil, fmt.Errorf("recursive message %s cannot be rendered", fullName)
+		}
+
+		b.inProgress[typeName] = true
+		defer delete(b.inProgress, typeName)
+	}
+
+	types := make([]Type, 0, len(md.Field))
+	value := make(map[string]interface{}, len(md.Field))
+
+	for _, fd := range md.Field {
+		fieldType, fieldValue, err := b.field(fd, obj[fd.GetName()])
+		if err != nil {
+			return "", nil, fmt.Errorf("%s.%s: %w", fullName, fd.GetName(), err)
+		}
+
+		types = append(types, Type{Name: fd.GetName(), Type: fieldType})
+		value[fd.GetName()] = fieldValue
+	}
+
+	if err := b.addType(typeName, types); err != nil {
+		return "", nil, err
+	}
+
+	return typeName, value, nil
+}
+
+func (b *builder) field(fd *descriptor.FieldDescriptorProto, raw interface{}) (string, interface{}, error) {
+	if !fd.IsRepeated() {
+		return b.singular(fd, raw)
+	}
+
+	elems, ok := raw.([]interface{})
+	if raw != nil && !ok {
+		return "", nil, fmt.Errorf("expected array, got %T", raw)
+	}
+
+	values := make([]interface{}, 0, len(elems))
+	elemType := ""
+
+	for _, elem := range elems {
+		typ, value, err := b.singular(fd, elem)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if elemType != "" && typ != elemType {
+			return "", nil, fmt.Errorf("heterogeneous arrays are not supported: %s and %s", elemType, typ)
+		}
+
+		elemType = typ
+		values = append(values, value)
+	}
+
+	if elemType == "" {
+		// the element type of an empty array is only needed for the type encoding
+		typ, _, err := b.singular(fd, nil)
+		if err != nil {
+			return "", nil, err
+		}
+
+		elemType = typ
+	}
+
+	return elemType + "[]", values, nil
+}
+
+func (b *builder) singular(fd *descriptor.FieldDescriptorProto, raw interface{}) (string, interface{}, error) {
+	switch fd.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_STRING:
+		return scalar("string", raw, "")
+
+	case descriptor.FieldDescriptorProto_TYPE_BOOL:
+		if raw == nil {
+			return "bool", false, nil
+		}
+
+		v, ok := raw.(bool)
+		if !ok {
+			return "", nil, fmt.Errorf("expected bool, got %T", raw)
+		}
+
+		return "bool", v, nil
+
+	case descriptor.FieldDescriptorProto_TYPE_INT64, descriptor.FieldDescriptorProto_TYPE_SINT64,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED64:
+		return scalar("int64", raw, "0")
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT64, descriptor.FieldDescriptorProto_TYPE_FIXED64:
+		return scalar("uint64", raw, "0")
+
+	case descriptor.FieldDescriptorProto_TYPE_INT32, descriptor.FieldDescriptorProto_TYPE_SINT32,
+		descriptor.FieldDescriptorProto_TYPE_SFIXED32:
+		return scalar("int32", raw, "0")
+
+	case descriptor.FieldDescriptorProto_TYPE_UINT32, descriptor.FieldDescriptorProto_TYPE_FIXED32:
+		return scalar("uint32", raw, "0")
+
+	case descriptor.FieldDescriptorProto_TYPE_BYTES:
+		s, ok := raw.(string)
+		if raw != nil && !ok {
+			return "", nil, fmt.Errorf("expected base64 string, got %T", raw)
+		}
+
+		bz, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "bytes", "0x" + hex.EncodeToString(bz), nil
+
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return scalar("string", raw, enumDefault(fd.GetTypeName()))
+
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		return b.messageField(fd, raw)
+
+	default:
+		return "", nil, fmt.Errorf("unsupported field type %s", fd.GetType())
+	}
+}
+
+func (b *builder) messageField(fd *descriptor.FieldDescriptorProto, raw interface{}) (string, interface{}, error) {
+	obj, ok := raw.(map[string]interface{})
+	if raw != nil && !ok {
+		// well-known types such as timestamps are rendered as JSON strings
+		if _, isString := raw.(string); !isString {
+			return "", nil, fmt.Errorf("expected object, got %T", raw)
+		}
+	}
+
+	switch fd.GetTypeName() {
+	case timestampTypeName, durationTypeName:
+		return scalar("string", raw, "")
+
+	case anyTypeName:
+		if obj == nil {
+			if err := b.addType(emptyAnyType, []Type{
+				{Name: "type_url", Type: "string"},
+				{Name: "value", Type: "bytes"},
+			}); err != nil {
+				return "", nil, err
+			}
+
+			return emptyAnyType, map[string]interface{}{"type_url": "", "value": "0x"}, nil
+		}
+
+		typeURL, ok := obj["@type"].(string)
+		if !ok {
+			return "", nil, fmt.Errorf("Any without @type")
+		}
+
+		msg, err := b.registry.Resolve(typeURL)
+		if err != nil {
+			return "", nil, err
+		}
+
+		valueType, value, err := b.message(proto.MessageName(msg), obj)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return b.anyWrapper(typeURL, valueType, value)
+
+	default:
+		return b.message(strings.TrimPrefix(fd.GetTypeName(), "."), obj)
+	}
+}
+
+// addType registers a struct type, making sure that a type name is never
+// bound to two different definitions.
+func (b *builder) addType(name string, members []Type) error {
+	if existing, ok := b.types[name]; ok {
+		if !reflect.DeepEqual(existing, members) {
+			return fmt.Errorf("conflicting definitions of type %s", name)
+		}
+
+		return nil
+	}
+
+	b.types[name] = members
+
+	return nil
+}
+
+// scalar renders a JSON string or number as a string value of type typ.
+func scalar(typ string, raw interface{}, zero string) (string, interface{}, error) {
+	switch v := raw.(type) {
+	case nil:
+		return typ, zero, nil
+	case string:
+		return typ, v, nil
+	case json.Number:
+		return typ, v.String(), nil
+	default:
+		return "", nil, fmt.Errorf("expected %s, got %T", typ, raw)
+	}
+}
+
+func enumDefault(typeName string) string {
+	for name, value := range proto.EnumValueMap(strings.TrimPrefix(typeName, ".")) {
+		if value == 0 {
+			return name
+		}
+	}
+
+	return ""
+}
+
+func messageDescriptor(fullName string) (*descriptor.DescriptorProto, error) {
+	if md, ok := descriptors.Load(fullName); ok {
+		return md.(*descriptor.DescriptorProto), nil
+	}
+
+	typ := proto.MessageType(fullName)
+	if typ == nil {
+		return nil, fmt.Errorf("unknown message %s", fullName)
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(descriptor.Message)
+	if !ok {
+		// map entries are registered as map types rather than messages
+		return nil, fmt.Errorf("unsupported message %s", fullName)
+	}
+
+	_, md := descriptor.ForMessage(msg)
+	descriptors.Store(fullName, md)
+
+	return md, nil
+}
+
+func decodeJSON(bz []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
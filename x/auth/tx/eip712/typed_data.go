@@ -0,0 +1,322 @@
+package eip712
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// DomainName is the name of the EIP-712 signing domain of Cosmos SDK transactions.
+	DomainName = "Cosmos SDK"
+	// DomainVersion is the version of the EIP-712 signing domain of Cosmos SDK transactions.
+	DomainVersion = "1"
+
+	// DomainType is the name of the EIP-712 domain struct type.
+	DomainType = "EIP712Domain"
+	// PrimaryType is the name of the EIP-712 struct type describing a transaction.
+	PrimaryType = "Tx"
+)
+
+// Type is a member of an EIP-712 struct type.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Types maps the name of every EIP-712 struct type to its members.
+type Types map[string][]Type
+
+// TypedData is an EIP-712 typed data document, in the format expected by
+// eth_signTypedData_v4 compatible wallets.
+type TypedData struct {
+	Types       Types                  `json:"types"`
+	PrimaryType string                 `json:"primaryType"`
+	Domain      map[string]interface{} `json:"domain"`
+	Message     map[string]interface{} `json:"message"`
+}
+
+// SignBytes returns the EIP-712 encoding of the typed data, i.e.
+// "\x19\x01" ‖ domainSeparator ‖ hashStruct(message). Signers sign over the
+// Keccak-256 digest of these bytes.
+func (td TypedData) SignBytes() ([]byte, error) {
+	domainSeparator, err := td.HashStruct(DomainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash domain: %w", err)
+	}
+
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash message: %w", err)
+	}
+
+	return append(append([]byte{0x19, 0x01}, domainSeparator...), messageHash...), nil
+}
+
+// Digest returns the Keccak-256 digest of the typed data sign bytes.
+func (td TypedData) Digest() ([]byte, error) {
+	signBytes, err := td.SignBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256(signBytes), nil
+}
+
+// HashStruct returns hashStruct(s) = keccak256(typeHash ‖ encodeData(s)) of
+// the given value of struct type typeName.
+func (td TypedData) HashStruct(typeName string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := td.encodeData(typeName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256(encoded), nil
+}
+
+// EncodeType returns the EIP-712 encoding of a struct type, followed by the
+// alphabetically sorted encodings of all the struct types it references.
+func (td TypedData) EncodeType(typeName string) (string, error) {
+	deps := make(map[string]bool)
+	if err := td.dependencies(typeName, deps); err != nil {
+		return "", err
+	}
+
+	delete(deps, typeName)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+
+	sort.Strings(sorted)
+
+	var buf strings.Builder
+
+	for _, name := range append([]string{typeName}, sorted...) {
+		buf.WriteString(name)
+		buf.WriteString("(")
+
+		for i, member := range td.Types[name] {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+
+			buf.WriteString(member.Type)
+			buf.WriteString(" ")
+			buf.WriteString(member.Name)
+		}
+
+		buf.WriteString(")")
+	}
+
+	return buf.String(), nil
+}
+
+// TypeHash returns the Keccak-256 digest of the encoded struct type.
+func (td TypedData) TypeHash(typeName string) ([]byte, error) {
+	encodedType, err := td.EncodeType(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return Keccak256([]byte(encodedType)), nil
+}
+
+func (td TypedData) dependencies(typeName string, deps map[string]bool) error {
+	if deps[typeName] {
+		return nil
+	}
+
+	members, ok := td.Types[typeName]
+	if !ok {
+		return fmt.Errorf("unknown type %s", typeName)
+	}
+
+	deps[typeName] = true
+
+	for _, member := range members {
+		elem := strings.TrimSuffix(member.Type, "[]")
+		if _, ok := td.Types[elem]; ok {
+			if err := td.dependencies(elem, deps); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (td TypedData) encodeData(typeName string, data map[string]interface{}) ([]byte, error) {
+	typeHash, err := td.TypeHash(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.NewBuffer(typeHash)
+
+	for _, member := range td.Types[typeName] {
+		value, ok := data[member.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value for %s.%s", typeName, member.Name)
+		}
+
+		encoded, err := td.encodeValue(member.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %w", typeName, member.Name, err)
+		}
+
+		buf.Write(encoded)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeValue returns the 32 bytes encoding of a single member value.
+func (td TypedData) encodeValue(typ string, value interface{}) ([]byte, error) {
+	if strings.HasSuffix(typ, "[]") {
+		elems, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array, got %T", value)
+		}
+
+		var buf bytes.Buffer
+
+		for _, elem := range elems {
+			encoded, err := td.encodeValue(strings.TrimSuffix(typ, "[]"), elem)
+			if err != nil {
+				return nil, err
+			}
+
+			buf.Write(encoded)
+		}
+
+		return Keccak256(buf.Bytes()), nil
+	}
+
+	if _, ok := td.Types[typ]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected object of type %s, got %T", typ, value)
+		}
+
+		return td.HashStruct(typ, data)
+	}
+
+	switch typ {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+
+		return Keccak256([]byte(s)), nil
+
+	case "bytes":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected hex string, got %T", value)
+		}
+
+		bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		if err != nil {
+			return nil, err
+		}
+
+		return Keccak256(bz), nil
+
+	case "address":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected hex address, got %T", value)
+		}
+
+		bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		if err != nil || len(bz) != 20 {
+			return nil, fmt.Errorf("invalid address %q", s)
+		}
+
+		return append(make([]byte, 12), bz...), nil
+
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+
+		encoded := make([]byte, 32)
+		if b {
+			encoded[31] = 1
+		}
+
+		return encoded, nil
+	}
+
+	return encodeInteger(typ, value)
+}
+
+// encodeInteger encodes a decimal string as an intN or uintN EIP-712 value,
+// i.e. as a big-endian 256 bits two's complement integer.
+func encodeInteger(typ string, value interface{}) ([]byte, error) {
+	var (
+		signed bool
+		bits   string
+	)
+
+	switch {
+	case strings.HasPrefix(typ, "uint"):
+		bits = strings.TrimPrefix(typ, "uint")
+	case strings.HasPrefix(typ, "int"):
+		signed, bits = true, strings.TrimPrefix(typ, "int")
+	default:
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+
+	size, err := strconv.Atoi(bits)
+	if err != nil || size <= 0 || size > 256 || size%8 != 0 {
+		return nil, fmt.Errorf("unsupported type %s", typ)
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected decimal string, got %T", value)
+	}
+
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(size))
+	if signed {
+		limit.Rsh(limit, 1)
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("%s overflows %s", s, typ)
+		}
+	} else if n.Sign() < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%s overflows %s", s, typ)
+	}
+
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+
+	encoded := make([]byte, 32)
+	n.FillBytes(encoded)
+
+	return encoded, nil
+}
+
+// Keccak256 returns the legacy Keccak-256 digest of bz, as used by Ethereum.
+func Keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+
+	return hasher.Sum(nil)
+}
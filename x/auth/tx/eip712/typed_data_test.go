@@ -0,0 +1,106 @@
+package eip712_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/eip712"
+)
+
+// TestSpecExample checks the encoding against the example of the EIP-712
+// specification: https://eips.ethereum.org/EIPS/eip-712
+func TestSpecExample(t *testing.T) {
+	td := eip712.TypedData{
+		Types: eip712.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: map[string]interface{}{
+			"name":              "Ether Mail",
+			"version":           "1",
+			"chainId":           "1",
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]interface{}{
+			"from": map[string]interface{}{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]interface{}{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	encodedType, err := td.EncodeType("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodedType)
+
+	domainSeparator, err := td.HashStruct("EIP712Domain", td.Domain)
+	require.NoError(t, err)
+	require.Equal(t, "f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToString(domainSeparator))
+
+	messageHash, err := td.HashStruct("Mail", td.Message)
+	require.NoError(t, err)
+	require.Equal(t, "c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToString(messageHash))
+
+	digest, err := td.Digest()
+	require.NoError(t, err)
+	require.Equal(t, "be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(digest))
+}
+
+func TestEncodeErrors(t *testing.T) {
+	newTypedData := func(typ string, value interface{}) eip712.TypedData {
+		return eip712.TypedData{
+			Types:   eip712.Types{"T": {{Name: "v", Type: typ}}},
+			Message: map[string]interface{}{"v": value},
+		}
+	}
+
+	testCases := []struct {
+		name  string
+		td    eip712.TypedData
+		valid bool
+	}{
+		{"uint8 in range", newTypedData("uint8", "255"), true},
+		{"uint8 overflow", newTypedData("uint8", "256"), false},
+		{"negative uint", newTypedData("uint64", "-1"), false},
+		{"int8 lower bound", newTypedData("int8", "-128"), true},
+		{"int8 underflow", newTypedData("int8", "-129"), false},
+		{"invalid integer", newTypedData("int64", "1.5"), false},
+		{"unknown type", newTypedData("float", "1"), false},
+		{"bool as string", newTypedData("bool", "true"), false},
+		{"invalid bytes", newTypedData("bytes", "0xzz"), false},
+		{"missing value", eip712.TypedData{Types: eip712.Types{"T": {{Name: "v", Type: "string"}}}, Message: map[string]interface{}{}}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.td.HashStruct("T", tc.td.Message)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package tx
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/eip712"
+)
+
+func TestEIP712Handler_GetSignBytes(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+
+	bldr := newBuilder()
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	handler := signModeEIP712Handler{registry: registry}
+	signingData := signing.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 7,
+		Sequence:      3,
+	}
+
+	typedData, err := GetEIP712TypedData(registry, signingData, tx)
+	require.NoError(t, err)
+	require.Equal(t, eip712.PrimaryType, typedData.PrimaryType)
+	require.Equal(t, []eip712.Type{
+		{Name: "account_number", Type: "uint64"},
+		{Name: "chain_id", Type: "string"},
+		{Name: "fee", Type: "cosmos_tx_v1beta1_Fee"},
+		{Name: "memo", Type: "string"},
+		{Name: "msg0", Type: "Any_testdata_TestMsg"},
+		{Name: "sequence", Type: "uint64"},
+		{Name: "timeout_height", Type: "uint64"},
+	}, typedData.Types[eip712.PrimaryType])
+	require.Equal(t, []eip712.Type{{Name: "signers", Type: "string[]"}}, typedData.Types["testdata_TestMsg"])
+	require.Equal(t, "10", typedData.Message["timeout_height"])
+	require.Equal(t, "/testdata.TestMsg", typedData.Message["msg0"].(map[string]interface{})["type_url"])
+
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP712, signingData, tx)
+	require.NoError(t, err)
+
+	expectedSignBz, err := typedData.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, expectedSignBz, signBz)
+
+	// typed data handed over to a wallet as JSON hashes to the same sign bytes
+	bz, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	var decoded eip712.TypedData
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	decodedSignBz, err := decoded.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, signBz, decodedSignBz)
+
+	// sign bytes commit to the signer data
+	signingData.Sequence++
+	otherSignBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP712, signingData, tx)
+	require.NoError(t, err)
+	require.NotEqual(t, signBz, otherSignBz)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
+	require.Error(t, err)
+
+	// expect error with extension options
+	bldr = newBuilder()
+	buildTx(t, bldr)
+	any, err := cdctypes.NewAnyWithValue(testdata.NewTestMsg())
+	require.NoError(t, err)
+	bldr.tx.Body.ExtensionOptions = []*cdctypes.Any{any}
+	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP712, signingData, bldr.GetTx())
+	require.Error(t, err)
+}
+
+func TestEIP712Handler_VerifySignature(t *testing.T) {
+	registry := cdctypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	handler := makeSignModeHandler([]signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP712}, registry)
+
+	bldr := newBuilder()
+	buildTx(t, bldr)
+	tx := bldr.GetTx()
+
+	signingData := signing.SignerData{ChainID: "test-chain", AccountNumber: 7, Sequence: 3}
+	signBz, err := handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP712, signingData, tx)
+	require.NoError(t, err)
+
+	// sign the Keccak-256 digest like an Ethereum wallet would, with a
+	// trailing recovery byte
+	privKey := secp256k1.GenPrivKey()
+	btcPriv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey.Key)
+	btcSig, err := btcPriv.Sign(eip712.Keccak256(signBz))
+	require.NoError(t, err)
+	sig := append(append(padTo32(btcSig.R), padTo32(btcSig.S)...), 27)
+
+	sigData := &signingtypes.SingleSignatureData{
+		SignMode:  signingtypes.SignMode_SIGN_MODE_EIP712,
+		Signature: sig,
+	}
+	require.NoError(t, signing.VerifySignature(privKey.PubKey(), signingData, sigData, handler, tx))
+
+	// SHA-256 based signatures of the sign bytes are rejected
+	cosmosSig, err := privKey.Sign(signBz)
+	require.NoError(t, err)
+	sigData.Signature = cosmosSig
+	require.Error(t, signing.VerifySignature(privKey.PubKey(), signingData, sigData, handler, tx))
+
+	// a different sequence changes the digest
+	sigData.Signature = sig
+	signingData.Sequence++
+	require.Error(t, signing.VerifySignature(privKey.PubKey(), signingData, sigData, handler, tx))
+}
+
+func padTo32(n *big.Int) []byte {
+	bz := make([]byte, 32)
+	return n.FillBytes(bz)
+}
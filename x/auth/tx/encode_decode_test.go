@@ -161,6 +161,55 @@ func TestUnknownFields(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTolerantDecodeMode(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	decoder := NewTxDecoder(cdc, DecodeModeTolerant)
+
+	encodeTx := func(body *testdata.TestUpdatedTxBody, authInfo *testdata.TestUpdatedAuthInfo) []byte {
+		bodyBz, err := body.Marshal()
+		require.NoError(t, err)
+		authInfoBz, err := authInfo.Marshal()
+		require.NoError(t, err)
+		txBz, err := (&tx.TxRaw{BodyBytes: bodyBz, AuthInfoBytes: authInfoBz}).Marshal()
+		require.NoError(t, err)
+		return txBz
+	}
+
+	t.Log("non-critical fields in AuthInfo should not error on decode, but should error with amino")
+	txBz := encodeTx(&testdata.TestUpdatedTxBody{Memo: "foo"}, &testdata.TestUpdatedAuthInfo{NewField_1024: []byte("xyz")})
+	_, err := DefaultTxDecoder(cdc)(txBz)
+	require.Error(t, err)
+	theTx, err := decoder(txBz)
+	require.NoError(t, err)
+	_, err = signModeLegacyAminoJSONHandler{}.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signing.SignerData{}, theTx)
+	require.EqualError(t, err, fmt.Sprintf("%s: %s", aminoNonCriticalFieldsError, sdkerrors.ErrInvalidRequest.Error()))
+
+	t.Log("critical fields should still error on decode")
+	_, err = decoder(encodeTx(&testdata.TestUpdatedTxBody{Memo: "foo"}, &testdata.TestUpdatedAuthInfo{NewField_3: []byte("xyz")}))
+	require.Error(t, err)
+	_, err = decoder(encodeTx(&testdata.TestUpdatedTxBody{Memo: "foo", SomeNewField: 10}, &testdata.TestUpdatedAuthInfo{}))
+	require.Error(t, err)
+
+	t.Log("new \"non-critical\" field in TxRaw should still fail")
+	txBz, err = (&testdata.TestUpdatedTxRaw{NewField_1024: []byte("abc")}).Marshal()
+	require.NoError(t, err)
+	_, err = decoder(txBz)
+	require.Error(t, err)
+}
+
+func TestParseDecodeMode(t *testing.T) {
+	mode, err := ParseDecodeMode("")
+	require.NoError(t, err)
+	require.Equal(t, DecodeModeStrict, mode)
+
+	mode, err = ParseDecodeMode("tolerant")
+	require.NoError(t, err)
+	require.Equal(t, DecodeModeTolerant, mode)
+
+	_, err = ParseDecodeMode("lenient")
+	require.Error(t, err)
+}
+
 func TestRejectNonADR027(t *testing.T) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
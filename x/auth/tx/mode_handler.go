@@ -3,6 +3,7 @@ package tx
 import (
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -11,11 +12,14 @@ import (
 var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+	signingtypes.SignMode_SIGN_MODE_EIP712,
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_CANONICAL_JSON,
+// SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_EIP712.
+func makeSignModeHandler(modes []signingtypes.SignMode, registry codectypes.InterfaceRegistry) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -26,8 +30,14 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 		switch mode {
 		case signingtypes.SignMode_SIGN_MODE_DIRECT:
 			handlers[i] = signModeDirectHandler{}
+		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
+			handlers[i] = signModeDirectAuxHandler{}
+		case signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON:
+			handlers[i] = signModeCanonicalJSONHandler{registry: registry}
 		case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
 			handlers[i] = signModeLegacyAminoJSONHandler{}
+		case signingtypes.SignMode_SIGN_MODE_EIP712:
+			handlers[i] = signModeEIP712Handler{registry: registry}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -64,6 +64,63 @@ func QueryTxsByEvents(clientCtx client.Context, events []string, page, limit int
 	return result, nil
 }
 
+// QueryHeightsByTime returns the range of the heights of the blocks whose time
+// is within [start, end], found by binary search over the block times known
+// to the node. A nil bound leaves the range unbounded on that side. The range
+// is empty, with minHeight greater than maxHeight, if no block matches.
+func QueryHeightsByTime(clientCtx client.Context, start, end *time.Time) (minHeight, maxHeight int64, err error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return 0, 0, err
+	}
+	minHeight, maxHeight = status.SyncInfo.EarliestBlockHeight, status.SyncInfo.LatestBlockHeight
+	if minHeight < 1 {
+		minHeight = 1
+	}
+
+	// search returns the first height within [minHeight, maxHeight+1] at which
+	// match holds, match being monotonic in the block time.
+	search := func(match func(blockTime time.Time) bool) (int64, error) {
+		lo, hi := minHeight, maxHeight+1
+		for lo < hi {
+			height := lo + (hi-lo)/2
+			res, err := node.Commit(context.Background(), &height)
+			if err != nil {
+				return 0, err
+			}
+
+			if match(res.Header.Time) {
+				hi = height
+			} else {
+				lo = height + 1
+			}
+		}
+		return lo, nil
+	}
+
+	first, last := minHeight, maxHeight
+	if start != nil {
+		first, err = search(func(blockTime time.Time) bool { return !blockTime.Before(*start) })
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if end != nil {
+		after, err := search(func(blockTime time.Time) bool { return blockTime.After(*end) })
+		if err != nil {
+			return 0, 0, err
+		}
+		last = after - 1
+	}
+
+	return first, last, nil
+}
+
 // QueryTx queries for a single transaction by a hash string in hex format. An
 // error is returned if the transaction does not exist or cannot be queried.
 func QueryTx(clientCtx client.Context, hashHexStr string) (*sdk.TxResponse, error) {
@@ -54,6 +54,9 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		return nil, err
 	}
 	orderBy := parseOrderBy(req.OrderBy)
+	if req.OrderBy == txtypes.OrderBy_ORDER_BY_UNSPECIFIED && req.Pagination != nil && req.Pagination.Reverse {
+		orderBy = "desc"
+	}
 
 	if len(req.Events) == 0 {
 		return nil, status.Error(codes.InvalidArgument, "must declare at least one event to search")
@@ -65,15 +68,49 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		}
 	}
 
-	result, err := QueryTxsByEvents(s.clientCtx, req.Events, page, limit, orderBy)
+	events := req.Events
+	if req.StartTime != nil || req.EndTime != nil {
+		if req.StartTime != nil && req.EndTime != nil && req.EndTime.Before(*req.StartTime) {
+			return nil, status.Error(codes.InvalidArgument, "end time must not be before start time")
+		}
+
+		minHeight, maxHeight, err := QueryHeightsByTime(s.clientCtx, req.StartTime, req.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		if minHeight > maxHeight {
+			return &txtypes.GetTxsEventResponse{Pagination: &pagination.PageResponse{}}, nil
+		}
+
+		events = append(events[:len(events):len(events)],
+			fmt.Sprintf("tx.height>=%d", minHeight), fmt.Sprintf("tx.height<=%d", maxHeight))
+	}
+
+	result, err := QueryTxsByEvents(s.clientCtx, events, page, limit, orderBy)
 	if err != nil {
 		return nil, err
 	}
 
+	// Tendermint only paginates by pages, so an offset which is not a multiple
+	// of the limit requires the start of the next page as well.
+	txResponses := result.Txs
+	if req.Pagination != nil {
+		if skip := int(req.Pagination.Offset) % limit; skip > 0 {
+			txResponses = txResponses[minInt(skip, len(txResponses)):]
+			if uint64(page*limit) < result.TotalCount {
+				next, err := QueryTxsByEvents(s.clientCtx, events, page+1, limit, orderBy)
+				if err != nil {
+					return nil, err
+				}
+				txResponses = append(txResponses, next.Txs[:minInt(skip, len(next.Txs))]...)
+			}
+		}
+	}
+
 	// Create a proto codec, we need it to unmarshal the tx bytes.
-	txsList := make([]*txtypes.Tx, len(result.Txs))
+	txsList := make([]*txtypes.Tx, len(txResponses))
 
-	for i, tx := range result.Txs {
+	for i, tx := range txResponses {
 		protoTx, ok := tx.Tx.GetCachedValue().(*txtypes.Tx)
 		if !ok {
 			return nil, status.Errorf(codes.Internal, "expected %T, got %T", txtypes.Tx{}, tx.Tx.GetCachedValue())
@@ -82,12 +119,16 @@ func (s txServer) GetTxsEvent(ctx context.Context, req *txtypes.GetTxsEventReque
 		txsList[i] = protoTx
 	}
 
+	// the total count is omitted when the request asks for a page without it
+	pageRes := &pagination.PageResponse{}
+	if req.Pagination == nil || req.Pagination.CountTotal {
+		pageRes.Total = result.TotalCount
+	}
+
 	return &txtypes.GetTxsEventResponse{
 		Txs:         txsList,
-		TxResponses: result.Txs,
-		Pagination: &pagination.PageResponse{
-			Total: result.TotalCount,
-		},
+		TxResponses: txResponses,
+		Pagination:  pageRes,
 	}, nil
 }
 
@@ -153,6 +194,65 @@ func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxReque
 	return client.TxServiceBroadcast(ctx, s.clientCtx, req)
 }
 
+// GetTxDecodingInfo implements the ServiceServer.GetTxDecodingInfo RPC method.
+func (s txServer) GetTxDecodingInfo(ctx context.Context, req *txtypes.GetTxDecodingInfoRequest) (*txtypes.GetTxDecodingInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	// TxConfigs not created by this package decode transactions strictly, as
+	// DefaultTxDecoder does
+	mode := DecodeModeStrict
+	if cfg, ok := s.clientCtx.TxConfig.(interface{ DecodeMode() DecodeMode }); ok {
+		mode = cfg.DecodeMode()
+	}
+
+	return &txtypes.GetTxDecodingInfoResponse{
+		DecodeMode:                        string(mode),
+		ToleratesUnknownNonCriticalFields: mode == DecodeModeTolerant,
+	}, nil
+}
+
+// EstimateTxSize implements the ServiceServer.EstimateTxSize RPC method.
+func (s txServer) EstimateTxSize(ctx context.Context, req *txtypes.EstimateTxSizeRequest) (*txtypes.EstimateTxSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	size, err := SignedTxSize(req.TxBytes, req.Signers)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(req.TxBytes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+
+	var authInfo txtypes.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+
+	var gasLimit uint64
+	if authInfo.Fee != nil {
+		gasLimit = authInfo.Fee.GasLimit
+	}
+
+	// the gRPC query router runs the query with the node's minimum gas prices
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &txtypes.EstimateTxSizeResponse{
+		TxSize:   size,
+		GasLimit: gasLimit,
+		MinFee:   MinTxFee(gasLimit, sdkCtx.MinGasPrices()),
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
@@ -182,3 +282,10 @@ func parseOrderBy(orderBy txtypes.OrderBy) string {
 		return "" // Defaults to Tendermint's default, which is `asc` now.
 	}
 }
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -248,8 +249,98 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPC() {
 	}
 }
 
+func (s IntegrationTestSuite) TestGetTxEvents_GRPCOrderAndTimeRange() {
+	// the tx timestamps are truncated to the second
+	txTime := s.txTime()
+	txTimeEnd := txTime.Add(time.Second)
+	later := txTime.Add(time.Hour)
+	earlier := txTime.Add(-time.Hour)
+
+	s.Run("descending order", func() {
+		res, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:  []string{bankMsgSendEventAction},
+			OrderBy: tx.OrderBy_ORDER_BY_DESC,
+		})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(res.TxResponses)
+		for i := 1; i < len(res.TxResponses); i++ {
+			s.Require().GreaterOrEqual(res.TxResponses[i-1].Height, res.TxResponses[i].Height)
+		}
+
+		// pagination.reverse defaults to the descending order
+		reversed, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:     []string{bankMsgSendEventAction},
+			Pagination: &query.PageRequest{Reverse: true, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(res.TxResponses, reversed.TxResponses)
+		s.Require().Equal(res.Pagination.Total, reversed.Pagination.Total)
+	})
+
+	s.Run("offset not multiple of the limit", func() {
+		all, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events: []string{bankMsgSendEventAction},
+		})
+		s.Require().NoError(err)
+
+		res, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:     []string{bankMsgSendEventAction},
+			Pagination: &query.PageRequest{Offset: 1, Limit: 2},
+		})
+		s.Require().NoError(err)
+		end := len(all.TxResponses)
+		if end > 3 {
+			end = 3
+		}
+		s.Require().Len(res.TxResponses, end-1)
+		for i, txRes := range res.TxResponses {
+			s.Require().Equal(all.TxResponses[i+1].TxHash, txRes.TxHash)
+		}
+		s.Require().Zero(res.Pagination.Total)
+	})
+
+	s.Run("time range", func() {
+		res, err := s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:    []string{bankMsgSendEventAction},
+			StartTime: &txTime,
+			EndTime:   &txTimeEnd,
+		})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(res.TxResponses)
+		for _, txRes := range res.TxResponses {
+			s.Require().Equal(s.txRes.Height, txRes.Height)
+		}
+		s.Require().Equal(uint64(len(res.TxResponses)), res.Pagination.Total)
+
+		res, err = s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:    []string{bankMsgSendEventAction},
+			StartTime: &later,
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(res.TxResponses)
+		s.Require().Zero(res.Pagination.Total)
+
+		res, err = s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:  []string{bankMsgSendEventAction},
+			EndTime: &earlier,
+		})
+		s.Require().NoError(err)
+		s.Require().Empty(res.TxResponses)
+
+		_, err = s.queryClient.GetTxsEvent(context.Background(), &tx.GetTxsEventRequest{
+			Events:    []string{bankMsgSendEventAction},
+			StartTime: &later,
+			EndTime:   &earlier,
+		})
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "end time must not be before start time")
+	})
+}
+
 func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 	val := s.network.Validators[0]
+	txTime := s.txTime()
+	startTime, endTime := txTime.Format(time.RFC3339), txTime.Add(time.Second).Format(time.RFC3339)
 	testCases := []struct {
 		name      string
 		url       string
@@ -286,6 +377,12 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 			false,
 			"",
 		},
+		{
+			"valid request: time range",
+			fmt.Sprintf("%s/cosmos/tx/v1beta1/txs?events=%s&start_time=%s&end_time=%s", val.APIAddress, bankMsgSendEventAction, startTime, endTime),
+			false,
+			"",
+		},
 		{
 			"invalid request: invalid order by",
 			fmt.Sprintf("%s/cosmos/tx/v1beta1/txs?events=%s&events=%s&order_by=invalid_order", val.APIAddress, bankMsgSendEventAction, "message.module='bank'"),
@@ -323,6 +420,15 @@ func (s IntegrationTestSuite) TestGetTxEvents_GRPCGateway() {
 	}
 }
 
+// txTime returns the time of the block including the tx of the suite.
+func (s IntegrationTestSuite) txTime() time.Time {
+	res, err := s.queryClient.GetTx(context.Background(), &tx.GetTxRequest{Hash: s.txRes.TxHash})
+	s.Require().NoError(err)
+	txTime, err := time.Parse(time.RFC3339, res.TxResponse.Timestamp)
+	s.Require().NoError(err)
+	return txTime
+}
+
 func (s IntegrationTestSuite) TestGetTx_GRPC() {
 	testCases := []struct {
 		name      string
@@ -397,6 +503,100 @@ func (s IntegrationTestSuite) TestGetTx_GRPCGateway() {
 	}
 }
 
+func (s IntegrationTestSuite) TestGetTxDecodingInfo_GRPC() {
+	_, err := s.queryClient.GetTxDecodingInfo(context.Background(), nil)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "request cannot be nil")
+
+	res, err := s.queryClient.GetTxDecodingInfo(context.Background(), &tx.GetTxDecodingInfoRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal("strict", res.DecodeMode)
+	s.Require().False(res.ToleratesUnknownNonCriticalFields)
+}
+
+func (s IntegrationTestSuite) TestGetTxDecodingInfo_GRPCGateway() {
+	val := s.network.Validators[0]
+	res, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/decoding_info", val.APIAddress))
+	s.Require().NoError(err)
+
+	var result tx.GetTxDecodingInfoResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result))
+	s.Require().Equal("strict", result.DecodeMode)
+	s.Require().False(result.ToleratesUnknownNonCriticalFields)
+}
+
+func (s IntegrationTestSuite) TestEstimateTxSize_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	s.Require().NoError(err)
+	signedBz, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	// estimate the size of the tx before it was signed
+	s.Require().NoError(txBuilder.SetSignatures())
+	unsignedBz, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.EstimateTxSizeRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.EstimateTxSizeRequest{}, true, "empty txBytes is not allowed"},
+		{"unsupported key type", &tx.EstimateTxSizeRequest{
+			TxBytes: unsignedBz,
+			Signers: []*tx.TxSizeSigner{{KeyType: "sr25519"}},
+		}, true, "unsupported key type"},
+		{"valid request", &tx.EstimateTxSizeRequest{
+			TxBytes: unsignedBz,
+			Signers: []*tx.TxSizeSigner{{KeyType: "secp256k1", Sequence: sigs[0].Sequence}},
+		}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.EstimateTxSize(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(uint64(len(signedBz)), res.TxSize)
+				s.Require().Equal(testdata.NewTestGasLimit(), res.GasLimit)
+				// 0.000006stake per gas for 100000 gas, rounded up
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1)), res.MinFee)
+			}
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestEstimateTxSize_GRPCGateway() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkTxBuilder()
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	s.Require().NoError(err)
+	signedBz, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	req, err := val.ClientCtx.Codec.MarshalJSON(&tx.EstimateTxSizeRequest{
+		TxBytes: signedBz,
+		Signers: []*tx.TxSizeSigner{{KeyType: "secp256k1", Sequence: sigs[0].Sequence}},
+	})
+	s.Require().NoError(err)
+
+	res, err := rest.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/estimate_size", val.APIAddress), "application/json", req)
+	s.Require().NoError(err)
+
+	var result tx.EstimateTxSizeResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result), string(res))
+	s.Require().Equal(uint64(len(signedBz)), result.TxSize)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 1)), result.MinFee)
+}
+
 func (s IntegrationTestSuite) TestBroadcastTx_GRPC() {
 	val := s.network.Validators[0]
 	txBuilder := s.mkTxBuilder()
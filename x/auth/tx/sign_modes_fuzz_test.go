@@ -0,0 +1,197 @@
+//go:build go1.18
+// +build go1.18
+
+package tx
+
+import (
+	"fmt"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/eip712"
+)
+
+// fuzzSignModes are the sign modes FuzzSignModes compares, new sign modes
+// being added to them.
+var fuzzSignModes = []signingtypes.SignMode{
+	signingtypes.SignMode_SIGN_MODE_DIRECT,
+	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
+	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	signingtypes.SignMode_SIGN_MODE_CANONICAL_JSON,
+	signingtypes.SignMode_SIGN_MODE_EIP712,
+}
+
+// FuzzSignModes builds random valid transactions and asserts that the sign
+// mode handlers agree on them: the signers, the fee and the sign bytes of a
+// transaction survive its encoding, the sign bytes of each mode commit to the
+// fee, the memo and the signer data, and a signature made with one mode is
+// rejected by all the others. Run it with:
+//
+//	go test ./x/auth/tx -run '^$' -fuzz '^FuzzSignModes$' -fuzztime 1m
+func FuzzSignModes(f *testing.F) {
+	f.Add(uint64(0), uint8(1), "", uint32(0), uint64(0), uint64(0), "test-chain", uint64(0), uint64(0))
+	f.Add(uint64(1), uint8(2), "foo", uint32(150), uint64(20000), uint64(10), "test-chain", uint64(7), uint64(3))
+	f.Add(uint64(2), uint8(3), "<&>\"\\ memo ✓", uint32(1<<32-1), uint64(1<<64-1), uint64(1<<64-1), "cosmoshub-4", uint64(1<<64-1), uint64(1<<64-1))
+
+	registry := codectypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	txConfig := NewTxConfig(codec.NewProtoCodec(registry), fuzzSignModes)
+	handler := txConfig.SignModeHandler()
+
+	f.Fuzz(func(t *testing.T, seed uint64, numSigners uint8, memo string, feeAmt uint32, gasLimit, timeout uint64, chainID string, accNum, accSeq uint64) {
+		if !utf8.ValidString(memo) || !utf8.ValidString(chainID) || chainID == "" {
+			t.Skip("transactions with invalid UTF-8 strings or without chain-id are invalid")
+		}
+
+		privKeys := make([]*secp256k1.PrivKey, numSigners%3+1)
+		addrs := make([]sdk.AccAddress, len(privKeys))
+		for i := range privKeys {
+			privKeys[i] = secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("%d/%d", seed, i)))
+			addrs[i] = sdk.AccAddress(privKeys[i].PubKey().Address())
+		}
+
+		var fee sdk.Coins
+		if feeAmt > 0 {
+			fee = sdk.NewCoins(sdk.NewInt64Coin("stake", int64(feeAmt)))
+		}
+
+		bldr := txConfig.NewTxBuilder()
+		require.NoError(t, bldr.SetMsgs(testdata.NewTestMsg(addrs...)))
+		bldr.SetMemo(memo)
+		bldr.SetFeeAmount(fee)
+		bldr.SetGasLimit(gasLimit)
+		bldr.SetTimeoutHeight(timeout)
+		tx := bldr.GetTx()
+
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		decodedSdkTx, err := txConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+		decoded := decodedSdkTx.(signing.Tx)
+
+		require.Equal(t, tx.GetSigners(), decoded.GetSigners())
+		require.Equal(t, tx.FeePayer(), decoded.FeePayer())
+		require.True(t, tx.GetFee().IsEqual(decoded.GetFee()))
+		require.Equal(t, tx.GetGas(), decoded.GetGas())
+
+		for i, privKey := range privKeys {
+			signerData := signing.SignerData{
+				Address:       addrs[i].String(),
+				ChainID:       chainID,
+				AccountNumber: accNum,
+				Sequence:      accSeq,
+				PubKey:        privKey.PubKey(),
+			}
+
+			signBytes := make(map[signingtypes.SignMode][]byte, len(fuzzSignModes))
+			for _, mode := range fuzzSignModes {
+				signBz, err := handler.GetSignBytes(mode, signerData, tx)
+				if mode == signingtypes.SignMode_SIGN_MODE_DIRECT_AUX && i == 0 {
+					require.Error(t, err, "the fee payer cannot sign with %s", mode)
+					continue
+				}
+				require.NoError(t, err, mode)
+
+				decodedSignBz, err := handler.GetSignBytes(mode, signerData, decoded)
+				require.NoError(t, err, mode)
+				require.Equal(t, signBz, decodedSignBz, "the sign bytes of %s change with the encoding of the tx", mode)
+
+				requireSignBytesCommit(t, mode, signBz, signerData, tx)
+
+				for other, otherBz := range signBytes {
+					require.NotEqual(t, otherBz, signBz, "%s and %s have the same sign bytes", other, mode)
+				}
+				signBytes[mode] = signBz
+			}
+
+			for mode, signBz := range signBytes {
+				sig := signFuzz(t, privKey, mode, signBz)
+				for _, other := range fuzzSignModes {
+					if _, ok := signBytes[other]; !ok {
+						continue
+					}
+
+					sigData := &signingtypes.SingleSignatureData{SignMode: other, Signature: sig}
+					err := signing.VerifySignature(privKey.PubKey(), signerData, sigData, handler, decoded)
+					if other == mode {
+						require.NoError(t, err, "a signature of %s is rejected", mode)
+					} else {
+						require.Error(t, err, "a signature of %s verifies with %s", mode, other)
+					}
+				}
+			}
+		}
+	})
+}
+
+// requireSignBytesCommit asserts that the sign bytes signBz of mode decode to
+// the fee, the memo and the signer data of tx, for the modes whose sign bytes
+// can be decoded.
+func requireSignBytesCommit(t *testing.T, mode signingtypes.SignMode, signBz []byte, data signing.SignerData, tx signing.Tx) {
+	protoTx := tx.(*wrapper)
+
+	switch mode {
+	case signingtypes.SignMode_SIGN_MODE_DIRECT:
+		var signDoc txtypes.SignDoc
+		require.NoError(t, signDoc.Unmarshal(signBz))
+		require.Equal(t, data.ChainID, signDoc.ChainId)
+		require.Equal(t, data.AccountNumber, signDoc.AccountNumber)
+		require.Equal(t, protoTx.getBodyBytes(), signDoc.BodyBytes)
+
+		var authInfo txtypes.AuthInfo
+		require.NoError(t, authInfo.Unmarshal(signDoc.AuthInfoBytes))
+		require.True(t, tx.GetFee().IsEqual(authInfo.Fee.Amount))
+		require.Equal(t, tx.GetGas(), authInfo.Fee.GasLimit)
+
+	case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
+		var signDoc txtypes.SignDocDirectAux
+		require.NoError(t, signDoc.Unmarshal(signBz))
+		require.Equal(t, data.ChainID, signDoc.ChainId)
+		require.Equal(t, data.AccountNumber, signDoc.AccountNumber)
+		require.Equal(t, data.Sequence, signDoc.Sequence)
+		require.Equal(t, protoTx.getBodyBytes(), signDoc.BodyBytes)
+
+	case signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		var signDoc legacytx.StdSignDoc
+		require.NoError(t, legacy.Cdc.UnmarshalJSON(signBz, &signDoc))
+		require.Equal(t, data.ChainID, signDoc.ChainID)
+		require.Equal(t, data.AccountNumber, signDoc.AccountNumber)
+		require.Equal(t, data.Sequence, signDoc.Sequence)
+		require.Equal(t, protoTx.GetTimeoutHeight(), signDoc.TimeoutHeight)
+		require.Equal(t, protoTx.GetMemo(), signDoc.Memo)
+
+		var fee legacytx.StdFee
+		require.NoError(t, legacy.Cdc.UnmarshalJSON(signDoc.Fee, &fee))
+		require.True(t, tx.GetFee().IsEqual(fee.Amount))
+		require.Equal(t, tx.GetGas(), fee.Gas)
+	}
+}
+
+// signFuzz signs signBz with privKey as a signer using mode would: over the
+// Keccak-256 digest, with a recovery byte, for SIGN_MODE_EIP712, and over the
+// SHA-256 digest otherwise.
+func signFuzz(t *testing.T, privKey *secp256k1.PrivKey, mode signingtypes.SignMode, signBz []byte) []byte {
+	if mode != signingtypes.SignMode_SIGN_MODE_EIP712 {
+		sig, err := privKey.Sign(signBz)
+		require.NoError(t, err)
+		return sig
+	}
+
+	btcPriv, _ := btcec.PrivKeyFromBytes(btcec.S256(), privKey.Key)
+	btcSig, err := btcPriv.Sign(eip712.Keccak256(signBz))
+	require.NoError(t, err)
+	return append(append(padTo32(btcSig.R), padTo32(btcSig.S)...), 27)
+}
@@ -0,0 +1,105 @@
+package tx
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// SignedTxSize returns the exact size of the encoding of the raw tx txBytes
+// once signed by the given signers. The signer infos and signatures of the tx,
+// if any, are replaced by the ones of the signers.
+func SignedTxSize(txBytes []byte, signers []*tx.TxSizeSigner) (uint64, error) {
+	var raw tx.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	var authInfo tx.AuthInfo
+	if err := authInfo.Unmarshal(raw.AuthInfoBytes); err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	authInfo.SignerInfos = make([]*tx.SignerInfo, len(signers))
+	raw.Signatures = make([][]byte, len(signers))
+
+	for i, signer := range signers {
+		pubKey, signatureSize, err := placeholderKey(signer.KeyType)
+		if err != nil {
+			return 0, err
+		}
+
+		pkAny, err := codectypes.NewAnyWithValue(pubKey)
+		if err != nil {
+			return 0, err
+		}
+
+		signMode := signer.SignMode
+		if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+			signMode = signing.SignMode_SIGN_MODE_DIRECT
+		}
+
+		authInfo.SignerInfos[i] = &tx.SignerInfo{
+			PublicKey: pkAny,
+			ModeInfo: &tx.ModeInfo{
+				Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: signMode}},
+			},
+			Sequence: signer.Sequence,
+		}
+		raw.Signatures[i] = make([]byte, signatureSize)
+	}
+
+	authInfoBytes, err := authInfo.Marshal()
+	if err != nil {
+		return 0, err
+	}
+	raw.AuthInfoBytes = authInfoBytes
+
+	return uint64(raw.Size()), nil
+}
+
+// MinTxFee returns the minimum fee of a tx with the given gas limit in each
+// denom of minGasPrices, as required by the mempool fee check of the ante
+// handler.
+func MinTxFee(gasLimit uint64, minGasPrices sdk.DecCoins) sdk.Coins {
+	glDec := sdk.NewDec(int64(gasLimit))
+
+	fees := make([]sdk.Coin, len(minGasPrices))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(glDec)
+		fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+
+	return sdk.NewCoins(fees...)
+}
+
+// placeholderKey returns a public key of the given type, whose encoding has
+// the size of the encoding of any key of that type, and the size of the
+// signatures of the keys of that type.
+func placeholderKey(keyType string) (cryptotypes.PubKey, int, error) {
+	switch keyType {
+	case "secp256k1":
+		return secp256k1.GenPrivKeyFromSecret([]byte(keyType)).PubKey(), 64, nil
+	case "ed25519":
+		return ed25519.GenPrivKeyFromSecret([]byte(keyType)).PubKey(), ed25519.SignatureSize, nil
+	case "secp256r1":
+		sk, err := secp256r1.GenPrivKey()
+		if err != nil {
+			return nil, 0, err
+		}
+		return sk.PubKey(), 64, nil
+	case "bls12381":
+		return bls12381.GenPrivKey().PubKey(), bls12381.SignatureSize, nil
+	default:
+		return nil, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, fmt.Sprintf("unsupported key type %q", keyType))
+	}
+}
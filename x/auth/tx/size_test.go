@@ -0,0 +1,92 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestSignedTxSize(t *testing.T) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	txConfig := NewTxConfig(codec.NewProtoCodec(interfaceRegistry), DefaultSignModes)
+
+	r1Key, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	keys := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), ed25519.GenPrivKey(), r1Key, bls12381.GenPrivKey()}
+	signers := []*txtypes.TxSizeSigner{
+		{KeyType: "secp256k1", Sequence: 5},
+		{KeyType: "ed25519", Sequence: 300},
+		{KeyType: "secp256r1", Sequence: 0, SignMode: signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON},
+		{KeyType: "bls12381", Sequence: 12},
+	}
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(keys[0].PubKey().Address()))))
+	txBuilder.SetMemo("memo")
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	unsignedBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// sign the tx with real keys
+	sigs := make([]signingtypes.SignatureV2, len(keys))
+	for i, key := range keys {
+		signMode := signers[i].SignMode
+		if signMode == signingtypes.SignMode_SIGN_MODE_UNSPECIFIED {
+			signMode = signingtypes.SignMode_SIGN_MODE_DIRECT
+		}
+
+		sig, err := key.Sign(unsignedBz)
+		require.NoError(t, err)
+		sigs[i] = signingtypes.SignatureV2{
+			PubKey:   key.PubKey(),
+			Data:     &signingtypes.SingleSignatureData{SignMode: signMode, Signature: sig},
+			Sequence: signers[i].Sequence,
+		}
+	}
+	require.NoError(t, txBuilder.SetSignatures(sigs...))
+	signedBz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	size, err := SignedTxSize(unsignedBz, signers)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(signedBz)), size)
+
+	// signer infos and signatures of signed txs are replaced
+	size, err = SignedTxSize(signedBz, signers)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(signedBz)), size)
+
+	size, err = SignedTxSize(signedBz, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(unsignedBz)), size)
+
+	_, err = SignedTxSize(unsignedBz, []*txtypes.TxSizeSigner{{KeyType: "sr25519"}})
+	require.Error(t, err)
+
+	_, err = SignedTxSize([]byte("invalid"), signers)
+	require.Error(t, err)
+}
+
+func TestMinTxFee(t *testing.T) {
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.MustNewDecFromStr("0.000006")), sdk.NewInt64DecCoin("atom", 2))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 200000), sdk.NewInt64Coin("stake", 1)), MinTxFee(100000, minGasPrices))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 1)), MinTxFee(1, minGasPrices))
+	require.True(t, MinTxFee(0, minGasPrices).Empty())
+	require.True(t, MinTxFee(100000, nil).Empty())
+}
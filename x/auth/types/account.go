@@ -250,6 +250,14 @@ func (ma ModuleAccount) String() string {
 	return out.(string)
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (ma ModuleAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if ma.BaseAccount == nil {
+		return nil
+	}
+	return ma.BaseAccount.UnpackInterfaces(unpacker)
+}
+
 // MarshalYAML returns the YAML representation of a ModuleAccount.
 func (ma ModuleAccount) MarshalYAML() (interface{}, error) {
 	accAddr, err := sdk.AccAddressFromBech32(ma.Address)
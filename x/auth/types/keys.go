@@ -1,7 +1,11 @@
 package types
 
 import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -30,3 +34,18 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// KeyFormats are the layouts of the keys of the auth store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "account",
+		Prefix:   AddressStoreKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("address").Unprefixed()},
+		Value:    keyformat.ProtoValue(&codectypes.Any{}),
+	},
+	{
+		Name:   "global_account_number",
+		Prefix: GlobalAccountNumberKey,
+		Value:  keyformat.ProtoValue(&gogotypes.UInt64Value{}),
+	},
+}
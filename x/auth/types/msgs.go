@@ -0,0 +1,118 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// auth message types
+const (
+	TypeMsgUpdateParams         = "update_params"
+	TypeMsgRotateMultisigPubKey = "rotate_multisig_pub_key"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRotateMultisigPubKey{}
+
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateMultisigPubKey)(nil)
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance, updating the auth
+// parameters with the authority of the module.
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgRotateMultisigPubKey creates a new MsgRotateMultisigPubKey instance,
+// replacing the multisig public key of the account of addr by newPubKey.
+//nolint:interfacer
+func NewMsgRotateMultisigPubKey(addr sdk.AccAddress, newPubKey cryptotypes.PubKey) (*MsgRotateMultisigPubKey, error) {
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotateMultisigPubKey{
+		Address:   addr.String(),
+		NewPubKey: pkAny,
+	}, nil
+}
+
+func (msg MsgRotateMultisigPubKey) Route() string { return ModuleName }
+func (msg MsgRotateMultisigPubKey) Type() string  { return TypeMsgRotateMultisigPubKey }
+func (msg MsgRotateMultisigPubKey) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRotateMultisigPubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRotateMultisigPubKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	newPubKey, err := msg.GetNewMultisigPubKey()
+	if err != nil {
+		return err
+	}
+
+	threshold := newPubKey.GetThreshold()
+	if threshold == 0 || threshold > uint(len(newPubKey.GetPubKeys())) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "invalid threshold %d of %d keys", threshold, len(newPubKey.GetPubKeys()))
+	}
+
+	return nil
+}
+
+// GetNewMultisigPubKey returns the new multisig public key of the account.
+func (msg MsgRotateMultisigPubKey) GetNewMultisigPubKey() (multisig.PubKey, error) {
+	if msg.NewPubKey == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "empty new public key")
+	}
+
+	newPubKey, ok := msg.NewPubKey.GetCachedValue().(multisig.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "expected a multisig public key, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	return newPubKey, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateMultisigPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
@@ -79,6 +79,16 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostBLS12381 returns gas fee of bls12381 signature verification.
+// Set by benchmarking current implementation:
+//     BenchmarkVerification/secp256k1     2701    517330 ns/op    4184 B/op    85 allocs/op
+//     BenchmarkVerification/bls12381       296   4474435 ns/op   84360 B/op   249 allocs/op
+// Based on the results above the verification of bls12381 signatures, computing two
+// pairings, is 8.6x slower than the one of secp256k1 signatures.
+func (p Params) SigVerifyCostBLS12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 9
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
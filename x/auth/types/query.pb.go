@@ -263,6 +263,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_changed_height is the height at which the parameters last changed.
+	LastChangedHeight int64 `protobuf:"varint,2,opt,name=last_changed_height,json=lastChangedHeight,proto3" json:"last_changed_height,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -305,6 +307,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangedHeight() int64 {
+	if m != nil {
+		return m.LastChangedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -317,41 +326,43 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x77, 0xda, 0xff, 0x3f, 0x89, 0x53, 0x4f, 0xd3, 0x08, 0x71, 0x6b, 0x77, 0xcb, 0x8a,
-	0x26, 0x29, 0x74, 0x86, 0xc6, 0x53, 0x45, 0x84, 0x46, 0x50, 0xbc, 0xc5, 0xc5, 0x93, 0x07, 0x65,
-	0x36, 0x19, 0xb7, 0x41, 0xb3, 0xb3, 0xcd, 0xec, 0x8a, 0x41, 0x04, 0xf1, 0xd4, 0x9b, 0x82, 0x5f,
-	0x20, 0x37, 0xbf, 0x80, 0x1f, 0xa2, 0x78, 0x2a, 0x78, 0xf1, 0x24, 0x92, 0x78, 0xf0, 0x63, 0x48,
-	0x66, 0xde, 0x89, 0x8d, 0xac, 0x26, 0xa7, 0xdd, 0x99, 0x79, 0x9f, 0xe7, 0xf9, 0xbd, 0xef, 0x0c,
-	0xf6, 0xbb, 0x52, 0x0d, 0xa4, 0x62, 0x3c, 0xcf, 0x8e, 0xd8, 0x8b, 0xfd, 0x48, 0x64, 0x7c, 0x9f,
-	0x1d, 0xe7, 0x62, 0x38, 0xa2, 0xe9, 0x50, 0x66, 0x92, 0x6c, 0x9a, 0x02, 0x3a, 0x2b, 0xa0, 0x50,
-	0xe0, 0xee, 0x82, 0x2a, 0xe2, 0x4a, 0x98, 0xea, 0xb9, 0x36, 0xe5, 0x71, 0x3f, 0xe1, 0x59, 0x5f,
-	0x26, 0xc6, 0xc0, 0xad, 0xc6, 0x32, 0x96, 0xfa, 0x97, 0xcd, 0xfe, 0x60, 0xf7, 0x72, 0x2c, 0x65,
-	0xfc, 0x5c, 0x30, 0xbd, 0x8a, 0xf2, 0xa7, 0x8c, 0x27, 0x90, 0xe8, 0x5e, 0x81, 0x23, 0x9e, 0xf6,
-	0x19, 0x4f, 0x12, 0x99, 0x69, 0x37, 0x05, 0xa7, 0x5e, 0x11, 0xb0, 0x86, 0x03, 0x63, 0x73, 0xfe,
-	0xc4, 0x24, 0x02, 0xbc, 0x5e, 0x04, 0x8f, 0x71, 0xf5, 0xc1, 0x8c, 0xf5, 0xb0, 0xdb, 0x95, 0x79,
-	0x92, 0xa9, 0x50, 0x1c, 0xe7, 0x42, 0x65, 0xe4, 0x2e, 0xc6, 0xbf, 0xa9, 0x6b, 0x68, 0x07, 0x35,
-	0x36, 0x5a, 0xd7, 0x29, 0x48, 0x67, 0x2d, 0x52, 0x33, 0x10, 0x48, 0xa3, 0x1d, 0x1e, 0x0b, 0xd0,
-	0x86, 0xe7, 0x94, 0xc1, 0x18, 0xe1, 0x4b, 0x7f, 0x04, 0xa8, 0x54, 0x26, 0x4a, 0x90, 0xdb, 0xb8,
-	0xc2, 0x61, 0xaf, 0x86, 0x76, 0xd6, 0x1b, 0x1b, 0xad, 0x2a, 0x35, 0x5d, 0x52, 0x3b, 0x00, 0x7a,
-	0x98, 0x8c, 0xda, 0x17, 0x3f, 0x7f, 0xda, 0xab, 0x80, 0xfa, 0x7e, 0x38, 0xd7, 0x90, 0x7b, 0x0b,
-	0x84, 0x6b, 0x9a, 0xb0, 0xbe, 0x94, 0xd0, 0x84, 0x2f, 0x20, 0x1e, 0xe0, 0xcd, 0xf3, 0x84, 0x76,
-	0x02, 0x35, 0x5c, 0xe6, 0xbd, 0xde, 0x50, 0x28, 0xa5, 0xdb, 0xbf, 0x10, 0xda, 0xe5, 0xcd, 0xca,
-	0xc9, 0xd8, 0x77, 0x7e, 0x8e, 0x7d, 0x27, 0x78, 0xb8, 0x38, 0xbd, 0x79, 0x6f, 0xb7, 0x70, 0x19,
-	0x38, 0x61, 0x74, 0xab, 0xb4, 0x66, 0x25, 0x41, 0x15, 0x13, 0xed, 0xda, 0xe1, 0x43, 0x3e, 0xb0,
-	0x37, 0x12, 0x74, 0x00, 0xd3, 0xee, 0x42, 0xd4, 0x01, 0x2e, 0xa5, 0x7a, 0x07, 0x92, 0xb6, 0x68,
-	0xc1, 0xe3, 0xa4, 0x46, 0xd4, 0xfe, 0xef, 0xf4, 0x9b, 0xef, 0x84, 0x20, 0x68, 0x7d, 0x5c, 0xc7,
-	0xff, 0x6b, 0x4b, 0x72, 0x82, 0xb0, 0xe5, 0x50, 0xa4, 0x59, 0xe8, 0x50, 0xf4, 0x4a, 0xdc, 0xdd,
-	0x55, 0x4a, 0x0d, 0x68, 0x70, 0xed, 0xed, 0x97, 0x1f, 0x1f, 0xd6, 0x7c, 0xb2, 0xcd, 0x0a, 0x5f,
-	0xab, 0x4d, 0x7f, 0x87, 0x70, 0x19, 0xb4, 0xa4, 0xb1, 0xd4, 0xde, 0x82, 0x34, 0x57, 0xa8, 0x04,
-	0x0e, 0xa6, 0x39, 0x9a, 0xa4, 0xfe, 0x4f, 0x0e, 0xf6, 0x0a, 0x6e, 0xfb, 0x35, 0x79, 0x83, 0x70,
-	0xc9, 0xcc, 0x8f, 0xd4, 0xff, 0x1e, 0xb3, 0x70, 0x59, 0x6e, 0x63, 0x79, 0x21, 0xe0, 0x5c, 0xd5,
-	0x38, 0xdb, 0x64, 0xab, 0x10, 0xc7, 0xdc, 0x54, 0xfb, 0xce, 0xe9, 0xc4, 0x43, 0x67, 0x13, 0x0f,
-	0x7d, 0x9f, 0x78, 0xe8, 0xfd, 0xd4, 0x73, 0xce, 0xa6, 0x9e, 0xf3, 0x75, 0xea, 0x39, 0x8f, 0x9a,
-	0x71, 0x3f, 0x3b, 0xca, 0x23, 0xda, 0x95, 0x03, 0x6b, 0x60, 0x3e, 0x7b, 0xaa, 0xf7, 0x8c, 0xbd,
-	0x34, 0x6e, 0xd9, 0x28, 0x15, 0x2a, 0x2a, 0xe9, 0xb7, 0x77, 0xe3, 0x57, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x2a, 0xe1, 0x81, 0xd3, 0xdf, 0x04, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6e, 0x13, 0x3f,
+	0x10, 0xc7, 0xd7, 0xcd, 0xef, 0x97, 0x04, 0x97, 0x0b, 0x4e, 0x90, 0x42, 0x4a, 0x37, 0xd5, 0x22,
+	0x48, 0x52, 0xa9, 0xb6, 0x1a, 0x4e, 0x45, 0x08, 0xa9, 0xa9, 0xc4, 0x9f, 0x5b, 0x59, 0x71, 0xe2,
+	0x40, 0xe4, 0x24, 0x66, 0x13, 0xd1, 0xac, 0xb7, 0xb1, 0x17, 0x11, 0x21, 0xa4, 0x8a, 0x53, 0x6f,
+	0x20, 0xf1, 0x02, 0xb9, 0xf1, 0x02, 0x3c, 0x44, 0xc5, 0xa9, 0x12, 0x17, 0x4e, 0x08, 0x25, 0x1c,
+	0x78, 0x0c, 0x14, 0x7b, 0x36, 0x34, 0x68, 0x21, 0x39, 0xed, 0xda, 0x33, 0xdf, 0xf9, 0x7e, 0x66,
+	0x3c, 0xb8, 0xd2, 0x91, 0x6a, 0x20, 0x15, 0xe3, 0xb1, 0xee, 0xb1, 0x97, 0xbb, 0x6d, 0xa1, 0xf9,
+	0x2e, 0x3b, 0x8e, 0xc5, 0x70, 0x44, 0xa3, 0xa1, 0xd4, 0x92, 0x14, 0x6c, 0x02, 0x9d, 0x25, 0x50,
+	0x48, 0x28, 0x6f, 0x83, 0xaa, 0xcd, 0x95, 0xb0, 0xd9, 0x73, 0x6d, 0xc4, 0x83, 0x7e, 0xc8, 0x75,
+	0x5f, 0x86, 0xb6, 0x40, 0xb9, 0x18, 0xc8, 0x40, 0x9a, 0x5f, 0x36, 0xfb, 0x83, 0xdb, 0x6b, 0x81,
+	0x94, 0xc1, 0x91, 0x60, 0xe6, 0xd4, 0x8e, 0x9f, 0x33, 0x1e, 0x82, 0x63, 0xf9, 0x3a, 0x84, 0x78,
+	0xd4, 0x67, 0x3c, 0x0c, 0xa5, 0x36, 0xd5, 0x14, 0x44, 0xdd, 0x34, 0x60, 0x03, 0x07, 0x85, 0x6d,
+	0xbc, 0x65, 0x1d, 0x01, 0xde, 0x1c, 0xbc, 0x67, 0xb8, 0xf8, 0x78, 0xc6, 0xba, 0xdf, 0xe9, 0xc8,
+	0x38, 0xd4, 0xca, 0x17, 0xc7, 0xb1, 0x50, 0x9a, 0xdc, 0xc7, 0xf8, 0x37, 0x75, 0x09, 0x6d, 0xa1,
+	0xda, 0x7a, 0xe3, 0x16, 0x05, 0xe9, 0xac, 0x45, 0x6a, 0x07, 0x02, 0x6e, 0xf4, 0x90, 0x07, 0x02,
+	0xb4, 0xfe, 0x05, 0xa5, 0x37, 0x46, 0xf8, 0xea, 0x1f, 0x06, 0x2a, 0x92, 0xa1, 0x12, 0xe4, 0x1e,
+	0xce, 0x73, 0xb8, 0x2b, 0xa1, 0xad, 0x4c, 0x6d, 0xbd, 0x51, 0xa4, 0xb6, 0x4b, 0x9a, 0x0c, 0x80,
+	0xee, 0x87, 0xa3, 0xe6, 0xe5, 0xcf, 0x9f, 0x76, 0xf2, 0xa0, 0x7e, 0xe4, 0xcf, 0x35, 0xe4, 0xc1,
+	0x02, 0xe1, 0x9a, 0x21, 0xac, 0x2e, 0x25, 0xb4, 0xe6, 0x0b, 0x88, 0x7b, 0xb8, 0x70, 0x91, 0x30,
+	0x99, 0x40, 0x09, 0xe7, 0x78, 0xb7, 0x3b, 0x14, 0x4a, 0x99, 0xf6, 0x2f, 0xf9, 0xc9, 0xf1, 0x4e,
+	0xfe, 0x74, 0x5c, 0x71, 0x7e, 0x8e, 0x2b, 0x8e, 0xf7, 0x64, 0x71, 0x7a, 0xf3, 0xde, 0xee, 0xe2,
+	0x1c, 0x70, 0xc2, 0xe8, 0x56, 0x69, 0x2d, 0x91, 0x78, 0x45, 0x4c, 0x4c, 0xd5, 0x43, 0x3e, 0xe4,
+	0x83, 0xe4, 0x45, 0xbc, 0x13, 0x84, 0x0b, 0x0b, 0xd7, 0xe0, 0xb5, 0x87, 0xb3, 0x91, 0xb9, 0x01,
+	0xab, 0x0d, 0x9a, 0xb2, 0x9d, 0xd4, 0x8a, 0x9a, 0xff, 0x9d, 0x7d, 0xab, 0x38, 0x3e, 0x08, 0x08,
+	0xc5, 0x85, 0x23, 0xae, 0x74, 0xab, 0xd3, 0xe3, 0x61, 0x20, 0xba, 0xad, 0x9e, 0xe8, 0x07, 0x3d,
+	0x6d, 0x66, 0x99, 0xf1, 0xaf, 0xcc, 0x42, 0x07, 0x36, 0xf2, 0xd0, 0x04, 0x1a, 0x1f, 0x33, 0xf8,
+	0x7f, 0x83, 0x40, 0x4e, 0x11, 0x4e, 0xc0, 0x15, 0xa9, 0xa7, 0x3a, 0xa6, 0xad, 0x55, 0x79, 0x7b,
+	0x95, 0x54, 0xdb, 0x98, 0x77, 0xf3, 0xed, 0x97, 0x1f, 0x1f, 0xd6, 0x2a, 0x64, 0x93, 0xa5, 0xae,
+	0x77, 0xe2, 0xfe, 0x0e, 0xe1, 0x1c, 0x68, 0x49, 0x6d, 0x69, 0xf9, 0x04, 0xa4, 0xbe, 0x42, 0x26,
+	0x70, 0x30, 0xc3, 0x51, 0x27, 0xd5, 0x7f, 0x72, 0xb0, 0xd7, 0xb0, 0x1e, 0x6f, 0xc8, 0x09, 0xc2,
+	0x59, 0x3b, 0x6f, 0x52, 0xfd, 0xbb, 0xcd, 0xc2, 0xeb, 0x96, 0x6b, 0xcb, 0x13, 0x01, 0xe7, 0x86,
+	0xc1, 0xd9, 0x24, 0x1b, 0xa9, 0x38, 0xf6, 0x65, 0x9b, 0x07, 0x67, 0x13, 0x17, 0x9d, 0x4f, 0x5c,
+	0xf4, 0x7d, 0xe2, 0xa2, 0xf7, 0x53, 0xd7, 0x39, 0x9f, 0xba, 0xce, 0xd7, 0xa9, 0xeb, 0x3c, 0xad,
+	0x07, 0x7d, 0xdd, 0x8b, 0xdb, 0xb4, 0x23, 0x07, 0x49, 0x01, 0xfb, 0xd9, 0x51, 0xdd, 0x17, 0xec,
+	0x95, 0xad, 0xa6, 0x47, 0x91, 0x50, 0xed, 0xac, 0x59, 0xd6, 0xdb, 0xbf, 0x06, 0x00, 0x12, 0xca,
+	0xb2, 0x97, 0x10, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -708,6 +719,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastChangedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -807,6 +823,9 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangedHeight))
+	}
 	return n
 }
 
@@ -1302,6 +1321,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedHeight", wireType)
+			}
+			m.LastChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
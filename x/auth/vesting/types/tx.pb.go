@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -153,33 +154,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbd, 0xae, 0xd3, 0x30,
-	0x14, 0x8e, 0x6f, 0x2e, 0xf7, 0xc7, 0x17, 0x09, 0x91, 0x16, 0x1a, 0x3a, 0xc4, 0x21, 0x53, 0x16,
-	0x6c, 0x5a, 0x90, 0x90, 0xba, 0x35, 0x1d, 0x51, 0x97, 0x08, 0x31, 0xb0, 0x54, 0x4e, 0x62, 0xd2,
-	0x88, 0x26, 0xae, 0x62, 0xb7, 0x6a, 0x37, 0x46, 0x46, 0x1e, 0x81, 0x99, 0xa7, 0x60, 0xec, 0xd8,
-	0x91, 0x29, 0xa0, 0x76, 0x61, 0xee, 0x13, 0xa0, 0xc4, 0x49, 0x61, 0x68, 0x91, 0x98, 0xec, 0xa3,
-	0xef, 0xc7, 0xe7, 0x7c, 0x3e, 0x10, 0x85, 0x5c, 0xa4, 0x5c, 0x90, 0x25, 0x13, 0x32, 0xc9, 0x62,
-	0xb2, 0xec, 0x05, 0x4c, 0xd2, 0x1e, 0x91, 0x2b, 0x3c, 0xcf, 0xb9, 0xe4, 0xc6, 0x63, 0x45, 0xc0,
-	0x35, 0x01, 0xd7, 0x84, 0x6e, 0x3b, 0xe6, 0x31, 0xaf, 0x28, 0xa4, 0xbc, 0x29, 0x76, 0xd7, 0xaa,
-	0xed, 0x02, 0x2a, 0xd8, 0xd1, 0x2b, 0xe4, 0x49, 0xa6, 0x70, 0xe7, 0xdb, 0x05, 0xec, 0x8c, 0x45,
-	0x3c, 0xca, 0x19, 0x95, 0xec, 0xad, 0xb2, 0x1c, 0x86, 0x21, 0x5f, 0x64, 0xd2, 0x18, 0xc0, 0xfb,
-	0xef, 0x73, 0x9e, 0x4e, 0x68, 0x14, 0xe5, 0x4c, 0x08, 0x13, 0xd8, 0xc0, 0xbd, 0xf5, 0x3a, 0x87,
-	0x02, 0xb5, 0xd6, 0x34, 0x9d, 0x0d, 0x9c, 0xbf, 0x51, 0xc7, 0xbf, 0x2b, 0xcb, 0xa1, 0xaa, 0x8c,
-	0x97, 0x10, 0x4a, 0x7e, 0x54, 0x5e, 0x54, 0xca, 0x47, 0x87, 0x02, 0x3d, 0x54, 0xca, 0x3f, 0x98,
-	0xe3, 0xdf, 0x4a, 0xde, 0xa8, 0x42, 0x78, 0x45, 0xd3, 0xf2, 0x6d, 0x53, 0xb7, 0x75, 0xf7, 0xae,
-	0xff, 0x04, 0xd7, 0xc3, 0x96, 0xed, 0x37, 0x93, 0xe2, 0x11, 0x4f, 0x32, 0xef, 0xf9, 0xa6, 0x40,
-	0xda, 0xd7, 0x1f, 0xc8, 0x8d, 0x13, 0x39, 0x5d, 0x04, 0x38, 0xe4, 0x29, 0xa9, 0x67, 0x55, 0xc7,
-	0x33, 0x11, 0x7d, 0x20, 0x72, 0x3d, 0x67, 0xa2, 0x12, 0x08, 0xbf, 0xb6, 0x36, 0x30, 0xbc, 0x61,
-	0x59, 0x34, 0x91, 0x49, 0xca, 0xcc, 0x4b, 0x1b, 0xb8, 0xba, 0xd7, 0x3a, 0x14, 0xe8, 0x81, 0x6a,
-	0xac, 0x41, 0x1c, 0xff, 0x9a, 0x65, 0xd1, 0x9b, 0x24, 0x65, 0x86, 0x09, 0xaf, 0x23, 0x36, 0xa3,
-	0x6b, 0x16, 0x99, 0xf7, 0x6c, 0xe0, 0xde, 0xf8, 0x4d, 0x39, 0xb8, 0xfc, 0xf5, 0x05, 0x01, 0xe7,
-	0x29, 0x44, 0x67, 0x12, 0xf4, 0x99, 0x98, 0xf3, 0x4c, 0xb0, 0xfe, 0x27, 0x00, 0xf5, 0xb1, 0x88,
-	0x8d, 0x8f, 0x00, 0xb6, 0x4f, 0x46, 0x4d, 0xf0, 0xe9, 0x5f, 0xc5, 0x67, 0x9c, 0xbb, 0xaf, 0xfe,
-	0x53, 0xd0, 0xb4, 0xe2, 0xbd, 0xde, 0xec, 0x2c, 0xb0, 0xdd, 0x59, 0xe0, 0xe7, 0xce, 0x02, 0x9f,
-	0xf7, 0x96, 0xb6, 0xdd, 0x5b, 0xda, 0xf7, 0xbd, 0xa5, 0xbd, 0xeb, 0xfd, 0x33, 0xc9, 0x15, 0xa1,
-	0x0b, 0x39, 0x3d, 0xae, 0x65, 0x15, 0x6c, 0x70, 0x55, 0x2d, 0xd1, 0x8b, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xe7, 0x28, 0xaf, 0xe5, 0xb5, 0x02, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xf7, 0xd5, 0xd0, 0x3f, 0x57, 0x24, 0x84, 0xdb, 0x12, 0x93, 0xc1, 0x67, 0x3c, 0x59, 0x48,
+	0xdc, 0x91, 0x82, 0x84, 0x94, 0xad, 0xee, 0x88, 0xba, 0x58, 0x88, 0x81, 0xa5, 0x3a, 0xdb, 0x87,
+	0x6b, 0xd1, 0xf3, 0x45, 0xbe, 0x4b, 0xd5, 0x6c, 0x88, 0x89, 0x91, 0x8f, 0xc0, 0xcc, 0xc4, 0xc7,
+	0xc8, 0x18, 0x36, 0x26, 0x83, 0x92, 0x01, 0xe6, 0x7c, 0x02, 0x64, 0xdf, 0x39, 0x80, 0x94, 0x20,
+	0x75, 0x3a, 0x3f, 0xfd, 0xfe, 0xbc, 0xf7, 0x7e, 0x7e, 0x10, 0xa5, 0x42, 0x72, 0x21, 0xc9, 0x15,
+	0x93, 0xaa, 0x28, 0x73, 0x72, 0x35, 0x48, 0x98, 0xa2, 0x03, 0xa2, 0xae, 0xf1, 0xa8, 0x12, 0x4a,
+	0x38, 0xf7, 0x35, 0x01, 0x1b, 0x02, 0x36, 0x84, 0xfe, 0x61, 0x2e, 0x72, 0xd1, 0x52, 0x48, 0xf3,
+	0xa5, 0xd9, 0x7d, 0xcf, 0xd8, 0x25, 0x54, 0xb2, 0x95, 0x57, 0x2a, 0x8a, 0xd2, 0xe0, 0x3d, 0x83,
+	0x73, 0xd9, 0xb4, 0x6a, 0x1e, 0x0d, 0x04, 0x5f, 0xb7, 0x60, 0xef, 0x4c, 0xe6, 0xa7, 0x15, 0xa3,
+	0x8a, 0xbd, 0xd2, 0xbd, 0x4e, 0xd2, 0x54, 0x8c, 0x4b, 0xe5, 0x0c, 0xe1, 0x9d, 0x37, 0x95, 0xe0,
+	0xe7, 0x34, 0xcb, 0x2a, 0x26, 0xa5, 0x0b, 0x7c, 0x10, 0xee, 0x45, 0xbd, 0x65, 0x8d, 0x0e, 0x26,
+	0x94, 0x5f, 0x0e, 0x83, 0xbf, 0xd1, 0x20, 0xde, 0x6f, 0xca, 0x13, 0x5d, 0x39, 0xcf, 0x20, 0x54,
+	0x62, 0xa5, 0xdc, 0x6a, 0x95, 0x47, 0xcb, 0x1a, 0xdd, 0xd3, 0xca, 0x3f, 0x58, 0x10, 0xef, 0x29,
+	0xd1, 0xa9, 0x52, 0xb8, 0x4d, 0x79, 0xd3, 0xdb, 0xb5, 0x7d, 0x3b, 0xdc, 0x3f, 0x7e, 0x80, 0x4d,
+	0x0a, 0xcd, 0x5e, 0x5d, 0x04, 0xf8, 0x54, 0x14, 0x65, 0xf4, 0x64, 0x5a, 0x23, 0xeb, 0xf3, 0x77,
+	0x14, 0xe6, 0x85, 0xba, 0x18, 0x27, 0x38, 0x15, 0x9c, 0x98, 0x25, 0xf5, 0xf3, 0x58, 0x66, 0x6f,
+	0x89, 0x9a, 0x8c, 0x98, 0x6c, 0x05, 0x32, 0x36, 0xd6, 0x0e, 0x86, 0xbb, 0xac, 0xcc, 0xce, 0x55,
+	0xc1, 0x99, 0x7b, 0xcb, 0x07, 0xa1, 0x1d, 0x1d, 0x2c, 0x6b, 0x74, 0x57, 0x0f, 0xd6, 0x21, 0x41,
+	0xbc, 0xc3, 0xca, 0xec, 0x65, 0xc1, 0x99, 0xe3, 0xc2, 0x9d, 0x8c, 0x5d, 0xd2, 0x09, 0xcb, 0xdc,
+	0xdb, 0x3e, 0x08, 0x77, 0xe3, 0xae, 0x1c, 0x1e, 0xfd, 0xfa, 0x84, 0xc0, 0xfb, 0x9f, 0x5f, 0x1e,
+	0xfd, 0x93, 0x53, 0xf0, 0x10, 0xa2, 0x0d, 0x91, 0xc6, 0x4c, 0x8e, 0x44, 0x29, 0xd9, 0xf1, 0x07,
+	0x00, 0xed, 0x33, 0x99, 0x3b, 0xef, 0x00, 0x3c, 0x5c, 0x9b, 0x3d, 0xc1, 0xeb, 0xff, 0x3f, 0xde,
+	0xe0, 0xdc, 0x7f, 0x7e, 0x43, 0x41, 0x37, 0x4a, 0xf4, 0x62, 0x3a, 0xf7, 0xc0, 0x6c, 0xee, 0x81,
+	0x1f, 0x73, 0x0f, 0x7c, 0x5c, 0x78, 0xd6, 0x6c, 0xe1, 0x59, 0xdf, 0x16, 0x9e, 0xf5, 0x7a, 0xf0,
+	0xdf, 0x68, 0xaf, 0x09, 0x1d, 0xab, 0x8b, 0xd5, 0x01, 0xb7, 0x49, 0x27, 0xdb, 0xed, 0x55, 0x3d,
+	0xfd, 0x3d, 0x00, 0x02, 0xe2, 0xae, 0x4b, 0xdf, 0x02, 0x00, 0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
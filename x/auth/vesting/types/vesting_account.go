@@ -6,6 +6,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -161,6 +162,15 @@ func (bva BaseVestingAccount) Validate() error {
 	return bva.BaseAccount.Validate()
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces. The
+// vesting accounts embedding a nil BaseVestingAccount have nothing to unpack.
+func (bva *BaseVestingAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if bva == nil || bva.BaseAccount == nil {
+		return nil
+	}
+	return bva.BaseAccount.UnpackInterfaces(unpacker)
+}
+
 type vestingAccountYAML struct {
 	Address          sdk.AccAddress `json:"address" yaml:"address"`
 	PubKey           string         `json:"public_key" yaml:"public_key"`
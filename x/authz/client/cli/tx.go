@@ -16,6 +16,7 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distribution "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -26,9 +27,14 @@ const (
 	FlagExpiration        = "expiration"
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
+	FlagContinueOnError   = "continue-on-error"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
+
+	FlagAllowedWithdrawAddresses = "allowed-withdraw-addresses"
+	withdrawRewards              = "withdraw-rewards"
+	withdrawCommission           = "withdraw-commission"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -45,6 +51,7 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRenewAuthorization(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -53,7 +60,7 @@ func GetTxCmd() *cobra.Command {
 
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"|\"withdraw-rewards\"|\"withdraw-commission\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`grant authorization to an address to execute a transaction on your behalf:
@@ -61,7 +68,9 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %s tx %s grant cosmos1skjw.. send %s --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. withdraw-rewards --allowed-withdraw-addresses=cosmos1sk.. --from=cosmos1sk..
+	`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName,
+				version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -156,6 +165,30 @@ Examples:
 					return err
 				}
 
+			case withdrawRewards, withdrawCommission:
+				allowWithdrawAddrs, err := cmd.Flags().GetStringSlice(FlagAllowedWithdrawAddresses)
+				if err != nil {
+					return err
+				}
+
+				allowed := make([]sdk.AccAddress, len(allowWithdrawAddrs))
+				for i, addr := range allowWithdrawAddrs {
+					allowed[i], err = sdk.AccAddressFromBech32(addr)
+					if err != nil {
+						return err
+					}
+				}
+
+				authzType := distribution.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD
+				if args[1] == withdrawCommission {
+					authzType = distribution.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION
+				}
+
+				authorization, err = distribution.NewWithdrawRewardsAuthorization(allowed, authzType)
+				if err != nil {
+					return err
+				}
+
 			default:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
@@ -173,6 +206,7 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
+	cmd.Flags().StringSlice(FlagAllowedWithdrawAddresses, []string{}, "Allowed withdraw addresses of the granter separated by ,")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 	return cmd
 }
@@ -210,6 +244,45 @@ Example:
 	return cmd
 }
 
+func NewCmdRenewAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renew [grantee] [msg_type] --expiration [expiration] --from=[granter]",
+		Short: "extend the expiration of an authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`extend the expiration of an authorization from a granter to a grantee, keeping
+the authorization and its remaining limits as they are:
+Example:
+ $ %s tx %s renew cosmos1skj.. %s --expiration=1672531200 --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			granter := clientCtx.GetFromAddress()
+			msg := authz.NewMsgRenewGrant(granter, grantee, args[1], time.Unix(exp, 0))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The new Unix timestamp. Default is one year.")
+	return cmd
+}
+
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "exec [msg_tx_json_file] --from [grantee]",
@@ -219,7 +292,8 @@ func NewCmdExecAuthorization() *cobra.Command {
 Example:
  $ %s tx %s exec tx.json --from grantee
  $ %s tx bank send <granter> <recipient> --from <granter> --chain-id <chain-id> --generate-only > tx.json && %s tx %s exec tx.json --from grantee
-			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName),
+ $ %s tx %s exec tx.json --from grantee --continue-on-error
+			`, version.AppName, authz.ModuleName, version.AppName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -240,10 +314,16 @@ Example:
 			}
 			msg := authz.NewMsgExec(grantee, theTx.GetMsgs())
 
+			msg.ContinueOnError, err = cmd.Flags().GetBool(FlagContinueOnError)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagContinueOnError, false, "Skip the messages which fail instead of failing the whole exec")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
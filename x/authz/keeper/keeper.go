@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -20,8 +21,15 @@ type Keeper struct {
 	storeKey sdk.StoreKey
 	cdc      codec.BinaryCodec
 	router   *baseapp.MsgServiceRouter
+
+	acceptContextDecorators []AcceptContextDecorator
 }
 
+// AcceptContextDecorator decorates the context authorizations are accepted with,
+// for instance to make the keepers some authorizations depend on available
+// through sdk.Context.Value.
+type AcceptContextDecorator func(ctx sdk.Context) sdk.Context
+
 // NewKeeper constructs a message authorization Keeper
 func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryCodec, router *baseapp.MsgServiceRouter) Keeper {
 	return Keeper{
@@ -31,6 +39,16 @@ func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryCodec, router *baseapp.Msg
 	}
 }
 
+// SetAcceptContextDecorators sets the decorators applied, in order, to the
+// context passed to Authorization.Accept.
+func (k *Keeper) SetAcceptContextDecorators(decorators ...AcceptContextDecorator) {
+	if k.acceptContextDecorators != nil {
+		panic("accept context decorators already set")
+	}
+
+	k.acceptContextDecorators = decorators
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", authz.ModuleName))
@@ -75,57 +93,121 @@ func (k Keeper) update(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccA
 func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([][]byte, error) {
 	var results = make([][]byte, len(msgs))
 	for i, msg := range msgs {
-		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
-		}
-		granter := signers[0]
-		// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
-		if !granter.Equals(grantee) {
-			authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			if authorization == nil {
-				return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
-			}
-			resp, err := authorization.Accept(ctx, msg)
-			if err != nil {
-				return nil, err
-			}
-			if resp.Delete {
-				err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
-			} else if resp.Updated != nil {
-				err = k.update(ctx, grantee, granter, resp.Updated)
-			}
-			if err != nil {
-				return nil, err
-			}
-			if !resp.Accept {
-				return nil, sdkerrors.ErrUnauthorized
-			}
+		data, err := k.dispatchAction(ctx, grantee, msg)
+		if err != nil {
+			return nil, err
 		}
+		results[i] = data
+	}
 
-		handler := k.router.Handler(msg)
-		if handler == nil {
-			return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
-		}
+	return results, nil
+}
 
-		msgResp, err := handler(ctx, msg)
+// DispatchActionsContinueOnError attempts to execute the provided messages like
+// DispatchActions, but skips the messages which fail, reverting their state
+// changes and events, instead of failing. It returns the outcome of each
+// message, which is also emitted as an EventExecResult. The results of the
+// failed messages only hold the ABCI codespace and code of their errors, as the
+// results are part of the tx data, the error texts being emitted and logged.
+func (k Keeper) DispatchActionsContinueOnError(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) ([]authz.ExecResult, error) {
+	var results = make([]authz.ExecResult, len(msgs))
+	for i, msg := range msgs {
+		// the cache context has its own event manager, so the events of the
+		// failed messages are dropped along with their state changes
+		cacheCtx, write := ctx.CacheContext()
+		data, err := k.dispatchAction(cacheCtx, grantee, msg)
+		var errText string
 		if err != nil {
-			return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+			codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+			results[i] = authz.ExecResult{Codespace: codespace, Code: code}
+			errText = err.Error()
+			k.Logger(ctx).Debug("skipped failed message", "index", i, "msg", sdk.MsgTypeURL(msg), "grantee", grantee.String(), "err", errText)
+		} else {
+			write()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			results[i] = authz.ExecResult{Success: true, Data: data}
 		}
-		results[i] = msgResp.Data
 
-		// emit the events from the dispatched actions
-		events := msgResp.Events
-		sdkEvents := make([]sdk.Event, 0, len(events))
-		for i := 0; i < len(events); i++ {
-			sdkEvents = append(sdkEvents, sdk.Event(events[i]))
+		err = ctx.EventManager().EmitTypedEvent(&authz.EventExecResult{
+			MsgIndex:   uint32(i),
+			MsgTypeUrl: sdk.MsgTypeURL(msg),
+			Grantee:    grantee.String(),
+			Success:    results[i].Success,
+			Error:      errText,
+			Codespace:  results[i].Codespace,
+			Code:       results[i].Code,
+		})
+		if err != nil {
+			return nil, err
 		}
-		ctx.EventManager().EmitEvents(sdkEvents)
 	}
 
 	return results, nil
 }
 
+// dispatchAction executes msg via the authorization grant from its signer to
+// the grantee, and returns the data of its response.
+func (k Keeper) dispatchAction(ctx sdk.Context, grantee sdk.AccAddress, msg sdk.Msg) ([]byte, error) {
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("authorization can be given to msg with only one signer")
+	}
+	granter := signers[0]
+	// if granter != grantee then check authorization.Accept, otherwise we implicitly accept.
+	if !granter.Equals(grantee) {
+		authorization, _ := k.GetCleanAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+		if authorization == nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrap("authorization not found")
+		}
+		resp, err := authorization.Accept(k.acceptContext(ctx), msg)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Delete {
+			err = k.DeleteGrant(ctx, grantee, granter, sdk.MsgTypeURL(msg))
+		} else if resp.Updated != nil {
+			err = k.update(ctx, grantee, granter, resp.Updated)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !resp.Accept {
+			return nil, sdkerrors.ErrUnauthorized
+		}
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	msgResp, err := handler(ctx, msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "failed to execute message; message %v", msg)
+	}
+
+	// emit the events from the dispatched actions
+	events := msgResp.Events
+	sdkEvents := make([]sdk.Event, 0, len(events))
+	for i := 0; i < len(events); i++ {
+		sdkEvents = append(sdkEvents, sdk.Event(events[i]))
+	}
+	ctx.EventManager().EmitEvents(sdkEvents)
+
+	telemetry.KeeperIncrCounter(authz.ModuleName, "exec", 1)
+
+	return msgResp.Data, nil
+}
+
+// acceptContext returns the context authorizations are accepted with.
+func (k Keeper) acceptContext(ctx sdk.Context) sdk.Context {
+	for _, decorate := range k.acceptContextDecorators {
+		ctx = decorate(ctx)
+	}
+
+	return ctx
+}
+
 // SaveGrant method grants the provided authorization to the grantee on the granter's account
 // with the provided expiration time. If there is an existing authorization grant for the
 // same `sdk.Msg` type, this grant overwrites that.
@@ -140,6 +222,9 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 	bz := k.cdc.MustMarshal(&grant)
 	skey := grantStoreKey(grantee, granter, authorization.MsgTypeURL())
 	store.Set(skey, bz)
+
+	telemetry.KeeperIncrCounter(authz.ModuleName, "grant", 1)
+
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
 		Granter:    granter.String(),
@@ -157,6 +242,9 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
 	store.Delete(skey)
+
+	telemetry.KeeperIncrCounter(authz.ModuleName, "revoke", 1)
+
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
@@ -164,6 +252,35 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// RenewGrantExpiration extends the expiration of the grant for the provided message type
+// granted to the grantee by the granter. The authorization is kept as it is, so
+// that the limits it accumulated, e.g. the remaining spend limit of a
+// SendAuthorization, are preserved, and the grantee never loses its authority.
+func (k Keeper) RenewGrantExpiration(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string, expiration time.Time) error {
+	skey := grantStoreKey(grantee, granter, msgType)
+	grant, found := k.getGrant(ctx, skey)
+	blockTime := ctx.BlockHeader().Time
+	if !found || grant.Expiration.Before(blockTime) {
+		return sdkerrors.ErrNotFound.Wrap("authorization not found")
+	}
+
+	if !expiration.After(blockTime) {
+		return authz.ErrInvalidExpirationTime
+	}
+	if !expiration.After(grant.Expiration) {
+		return sdkerrors.Wrapf(authz.ErrInvalidExpirationTime, "expiration must be after the current expiration (%v), got %v", grant.Expiration.Format(time.RFC3339), expiration.Format(time.RFC3339))
+	}
+
+	grant.Expiration = expiration
+	ctx.KVStore(k.storeKey).Set(skey, k.cdc.MustMarshal(&grant))
+	return ctx.EventManager().EmitTypedEvent(&authz.EventRenewGrant{
+		MsgTypeUrl: msgType,
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		Expiration: expiration,
+	})
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) (authorizations []authz.Authorization) {
 	store := ctx.KVStore(k.storeKey)
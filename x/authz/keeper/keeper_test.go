@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -11,7 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -123,6 +126,60 @@ func (s *TestSuite) TestKeeperIter() {
 
 }
 
+func (s *TestSuite) TestRenewGrantExpiration() {
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	s.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := ctx.BlockHeader().Time
+
+	s.T().Log("verify renewing a missing grant fails")
+	err := app.AuthzKeeper.RenewGrantExpiration(ctx, granteeAddr, granterAddr, bankSendAuthMsgType, now.Add(2*time.Hour))
+	s.Require().Error(err)
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	err = app.AuthzKeeper.SaveGrant(ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: spendLimit}, now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	msgs := authz.NewMsgExec(granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 30)),
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	})
+	executeMsgs, err := msgs.GetMessages()
+	s.Require().NoError(err)
+	_, err = app.AuthzKeeper.DispatchActions(ctx, granteeAddr, executeMsgs)
+	s.Require().NoError(err)
+
+	s.T().Log("verify the expiration must be extended")
+	err = app.AuthzKeeper.RenewGrantExpiration(ctx, granteeAddr, granterAddr, bankSendAuthMsgType, now.Add(time.Hour))
+	s.Require().ErrorIs(err, authz.ErrInvalidExpirationTime)
+	err = app.AuthzKeeper.RenewGrantExpiration(ctx, granteeAddr, granterAddr, bankSendAuthMsgType, now.Add(-time.Hour))
+	s.Require().ErrorIs(err, authz.ErrInvalidExpirationTime)
+
+	s.T().Log("verify renewing keeps the remaining spend limit")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = app.AuthzKeeper.RenewGrantExpiration(ctx, granteeAddr, granterAddr, bankSendAuthMsgType, now.Add(2*time.Hour))
+	s.Require().NoError(err)
+	authorization, expiration := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	s.Require().NotNil(authorization)
+	s.Require().True(now.Add(2 * time.Hour).Equal(expiration))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 70)), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	events := ctx.EventManager().Events()
+	s.Require().Len(events, 1)
+	s.Require().Equal("cosmos.authz.v1beta1.EventRenewGrant", events[0].Type)
+
+	s.T().Log("verify an expired grant can't be renewed")
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: now.Add(3 * time.Hour)})
+	err = app.AuthzKeeper.RenewGrantExpiration(ctx, granteeAddr, granterAddr, bankSendAuthMsgType, now.Add(4*time.Hour))
+	s.Require().EqualError(err, "authorization not found: not found")
+}
+
 func (s *TestSuite) TestKeeperFees() {
 	app, addrs := s.app, s.addrs
 
@@ -247,6 +304,126 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestExecContinueOnError() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(simapp.FundAccount(app.BankKeeper, s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := s.ctx.BlockHeader().Time
+
+	err := app.AuthzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 20))}, now.Add(time.Hour))
+	require.NoError(err)
+
+	send := func(from sdk.AccAddress, amount int64) sdk.Msg {
+		return &banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", amount)),
+			FromAddress: from.String(),
+			ToAddress:   recipientAddr.String(),
+		}
+	}
+	msg := authz.NewMsgExec(granteeAddr, []sdk.Msg{
+		send(granterAddr, 2),
+		send(recipientAddr, 5), // no grant
+		send(granterAddr, 100), // over the spend limit
+		send(granterAddr, 3),
+	})
+	recipientBalance := app.BankKeeper.GetBalance(s.ctx, recipientAddr, "steak")
+
+	s.T().Log("verify one failed message fails the whole exec by default")
+	ctx, _ := s.ctx.CacheContext()
+	_, err = app.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), &msg)
+	require.Error(err)
+
+	s.T().Log("verify the failed messages are skipped when continuing on error")
+	msg.ContinueOnError = true
+	ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	res, err := app.AuthzKeeper.Exec(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(err)
+	require.Len(res.Results, 4)
+	require.Len(res.ExecResults, 4)
+	for i, succeeded := range []bool{true, false, false, true} {
+		require.Equal(succeeded, res.ExecResults[i].Success, i)
+		require.Equal(succeeded, res.ExecResults[i].Code == 0, i)
+		require.Equal(res.ExecResults[i].Data, res.Results[i], i)
+	}
+	require.Equal(sdkerrors.ErrUnauthorized.Codespace(), res.ExecResults[1].Codespace)
+	require.Equal(sdkerrors.ErrUnauthorized.ABCICode(), res.ExecResults[1].Code)
+	require.Equal(sdkerrors.ErrInsufficientFunds.Codespace(), res.ExecResults[2].Codespace)
+	require.Equal(sdkerrors.ErrInsufficientFunds.ABCICode(), res.ExecResults[2].Code)
+
+	// only the successful messages were executed, and spent the authorization
+	require.Equal(recipientBalance.AddAmount(sdk.NewInt(5)), app.BankKeeper.GetBalance(ctx, recipientAddr, "steak"))
+	authorization, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, granteeAddr, granterAddr, bankSendAuthMsgType)
+	require.Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 15)), authorization.(*banktypes.SendAuthorization).SpendLimit)
+
+	var results []authz.EventExecResult
+	transfers := 0
+	for _, e := range ctx.EventManager().ABCIEvents() {
+		switch e.Type {
+		case banktypes.EventTypeTransfer:
+			transfers++
+		case proto.MessageName(&authz.EventExecResult{}):
+			event, err := sdk.ParseTypedEvent(e)
+			require.NoError(err)
+			results = append(results, *event.(*authz.EventExecResult))
+		}
+	}
+	require.Equal(2, transfers, "the events of the failed messages are dropped")
+	require.Len(results, 4)
+	for i, result := range results {
+		require.Equal(uint32(i), result.MsgIndex)
+		require.Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), result.MsgTypeUrl)
+		require.Equal(granteeAddr.String(), result.Grantee)
+		require.Equal(res.ExecResults[i].Success, result.Success)
+		require.Equal(res.ExecResults[i].Codespace, result.Codespace)
+		require.Equal(res.ExecResults[i].Code, result.Code)
+	}
+	require.Contains(results[1].Error, "authorization not found")
+	require.Contains(results[2].Error, "more than spend limit")
+}
+
+func (s *TestSuite) TestAcceptContextDecorators() {
+	require := s.Require()
+	app, addrs := s.app, s.addrs
+	granterAddr := addrs[0]
+	granteeAddr := addrs[1]
+	recipientAddr := addrs[2]
+	require.NoError(simapp.FundAccount(app.BankKeeper, s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000))))
+	now := s.ctx.BlockHeader().Time
+
+	type decoratorKey struct{}
+	var accepted bool
+	authzKeeper := authzkeeper.NewKeeper(app.GetKey(authzkeeper.StoreKey), app.AppCodec(), app.MsgServiceRouter())
+	authzKeeper.SetAcceptContextDecorators(
+		func(ctx sdk.Context) sdk.Context { return ctx.WithValue(decoratorKey{}, "first") },
+		func(ctx sdk.Context) sdk.Context {
+			// decorators are applied in order
+			accepted = ctx.Value(decoratorKey{}) == "first"
+			return ctx
+		},
+	)
+	require.Panics(func() { authzKeeper.SetAcceptContextDecorators() })
+
+	err := authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 20))}, now.Add(time.Hour))
+	require.NoError(err)
+
+	msgs := []sdk.Msg{
+		&banktypes.MsgSend{
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 2)),
+			FromAddress: granterAddr.String(),
+			ToAddress:   recipientAddr.String(),
+		},
+	}
+	_, err = authzKeeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	require.NoError(err)
+	require.True(accepted)
+
+	// the decorated context is only used to accept the authorization
+	require.Nil(s.ctx.Value(decoratorKey{}))
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
@@ -49,4 +50,14 @@ func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAdd
 func firstAddressFromGrantStoreKey(key []byte) sdk.AccAddress {
 	addrLen := key[0]
 	return sdk.AccAddress(key[1 : 1+addrLen])
-}
\ No newline at end of file
+}
+
+// KeyFormats are the layouts of the keys of the authz store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "grant",
+		Prefix:   GrantKey,
+		Segments: []keyformat.Segment{keyformat.AccAddress("granter"), keyformat.AccAddress("grantee"), keyformat.String("msg_type_url")},
+		Value:    keyformat.ProtoValue(&authz.Grant{}),
+	},
+}
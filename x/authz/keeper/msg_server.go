@@ -59,6 +59,26 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RenewGrant implements the MsgServer.RenewGrant method.
+func (k Keeper) RenewGrant(goCtx context.Context, msg *authz.MsgRenewGrant) (*authz.MsgRenewGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.RenewGrantExpiration(ctx, grantee, granter, msg.MsgTypeUrl, msg.Expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRenewGrantResponse{}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -70,9 +90,21 @@ func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecR
 	if err != nil {
 		return nil, err
 	}
-	results, err := k.DispatchActions(ctx, grantee, msgs)
+	if !msg.ContinueOnError {
+		results, err := k.DispatchActions(ctx, grantee, msgs)
+		if err != nil {
+			return nil, err
+		}
+		return &authz.MsgExecResponse{Results: results}, nil
+	}
+
+	execResults, err := k.DispatchActionsContinueOnError(ctx, grantee, msgs)
 	if err != nil {
 		return nil, err
 	}
-	return &authz.MsgExecResponse{Results: results}, nil
+	results := make([][]byte, len(execResults))
+	for i, res := range execResults {
+		results[i] = res.Data
+	}
+	return &authz.MsgExecResponse{Results: results, ExecResults: execResults}, nil
 }
@@ -9,6 +9,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 )
 
@@ -16,11 +17,13 @@ var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
 	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgRenewGrant{}
 
 	// For amino support.
 	_ legacytx.LegacyMsg = &MsgGrant{}
 	_ legacytx.LegacyMsg = &MsgRevoke{}
 	_ legacytx.LegacyMsg = &MsgExec{}
+	_ legacytx.LegacyMsg = &MsgRenewGrant{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
 	_ cdctypes.UnpackInterfacesMessage = &MsgExec{}
@@ -43,11 +46,7 @@ func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress, a Authorization
 
 // GetSigners implements Msg
 func (msg MsgGrant) GetSigners() []sdk.AccAddress {
-	granter, err := sdk.AccAddressFromBech32(msg.Granter)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{granter}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // ValidateBasic implements Msg
@@ -131,11 +130,7 @@ func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL str
 
 // GetSigners implements Msg
 func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
-	granter, err := sdk.AccAddressFromBech32(msg.Granter)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{granter}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // ValidateBasic implements MsgRequest.ValidateBasic
@@ -175,6 +170,63 @@ func (msg MsgRevoke) GetSignBytes() []byte {
 	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
 }
 
+// NewMsgRenewGrant creates a new MsgRenewGrant
+//nolint:interfacer
+func NewMsgRenewGrant(granter sdk.AccAddress, grantee sdk.AccAddress, msgTypeURL string, expiration time.Time) MsgRenewGrant {
+	return MsgRenewGrant{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeUrl: msgTypeURL,
+		Expiration: expiration,
+	}
+}
+
+// GetSigners implements Msg
+func (msg MsgRenewGrant) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// ValidateBasic implements Msg
+func (msg MsgRenewGrant) ValidateBasic() error {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid granter address")
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid grantee address")
+	}
+
+	if granter.Equals(grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "granter and grantee cannot be same")
+	}
+
+	if msg.MsgTypeUrl == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing method name")
+	}
+
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "missing expiration")
+	}
+
+	return nil
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgRenewGrant) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgRenewGrant) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgRenewGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 //nolint:interfacer
 func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
@@ -210,11 +262,7 @@ func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
 
 // GetSigners implements Msg
 func (msg MsgExec) GetSigners() []sdk.AccAddress {
-	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{grantee}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // ValidateBasic implements Msg
@@ -66,6 +66,31 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRenewGrant(t *testing.T) {
+	tests := []struct {
+		title            string
+		granter, grantee sdk.AccAddress
+		msgType          string
+		expiration       time.Time
+		expectPass       bool
+	}{
+		{"nil Granter address", nil, grantee, "hello", time.Now(), false},
+		{"nil Grantee address", granter, nil, "hello", time.Now(), false},
+		{"same Granter and Grantee address", granter, granter, "hello", time.Now(), false},
+		{"missing msg type", granter, grantee, "", time.Now(), false},
+		{"missing expiration", granter, grantee, "hello", time.Time{}, false},
+		{"valid test case", granter, grantee, "hello", time.Now(), true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRenewGrant(tc.granter, tc.grantee, tc.msgType, tc.expiration)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgGrantAuthorization(t *testing.T) {
 	tests := []struct {
 		title            string
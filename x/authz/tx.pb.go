@@ -8,9 +8,11 @@ import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -19,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -75,7 +79,12 @@ var xxx_messageInfo_MsgGrant proto.InternalMessageInfo
 
 // MsgExecResponse defines the Msg/MsgExecResponse response type.
 type MsgExecResponse struct {
+	// results are the data of the responses to the messages, empty for the
+	// messages which failed when continue_on_error is set.
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// exec_results are the outcomes of each message, only set when
+	// continue_on_error is set.
+	ExecResults []ExecResult `protobuf:"bytes,2,rep,name=exec_results,json=execResults,proto3" json:"exec_results"`
 }
 
 func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
@@ -111,6 +120,51 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// ExecResult is the outcome of a message executed by MsgExec.
+type ExecResult struct {
+	// success is false if the message failed, and was skipped.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// data is the data of the message response.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// codespace is the ABCI codespace of the error the message failed with.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the ABCI code of the error the message failed with.
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *ExecResult) Reset()         { *m = ExecResult{} }
+func (m *ExecResult) String() string { return proto.CompactTextString(m) }
+func (*ExecResult) ProtoMessage()    {}
+func (*ExecResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+}
+func (m *ExecResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecResult.Merge(m, src)
+}
+func (m *ExecResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecResult proto.InternalMessageInfo
+
 // MsgExec attempts to execute the provided messages using
 // authorizations granted to the grantee. Each message should have only
 // one signer corresponding to the granter of the authorization.
@@ -120,13 +174,16 @@ type MsgExec struct {
 	// The x/authz will try to find a grant matching (msg.signers[0], grantee, MsgTypeURL(msg))
 	// triple and validate it.
 	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// continue_on_error makes the messages which fail be skipped, their state
+	// changes being reverted, instead of failing the whole MsgExec.
+	ContinueOnError bool `protobuf:"varint,3,opt,name=continue_on_error,json=continueOnError,proto3" json:"continue_on_error,omitempty"`
 }
 
 func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{2}
+	return fileDescriptor_3ceddab7d8589ad1, []int{3}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +220,7 @@ func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
 func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGrantResponse) ProtoMessage()    {}
 func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{3}
+	return fileDescriptor_3ceddab7d8589ad1, []int{4}
 }
 func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +261,7 @@ func (m *MsgRevoke) Reset()         { *m = MsgRevoke{} }
 func (m *MsgRevoke) String() string { return proto.CompactTextString(m) }
 func (*MsgRevoke) ProtoMessage()    {}
 func (*MsgRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{4}
+	return fileDescriptor_3ceddab7d8589ad1, []int{5}
 }
 func (m *MsgRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -241,7 +298,7 @@ func (m *MsgRevokeResponse) Reset()         { *m = MsgRevokeResponse{} }
 func (m *MsgRevokeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeResponse) ProtoMessage()    {}
 func (*MsgRevokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3ceddab7d8589ad1, []int{5}
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
 }
 func (m *MsgRevokeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,50 +327,147 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgRenewGrant extends the expiration of the grant with the provided sdk.Msg
+// type on the granter's account that has been granted to the grantee.
+type MsgRenewGrant struct {
+	Granter    string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee    string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// expiration is the new expiration time of the grant, which must be later
+	// than its current one.
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *MsgRenewGrant) Reset()         { *m = MsgRenewGrant{} }
+func (m *MsgRenewGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewGrant) ProtoMessage()    {}
+func (*MsgRenewGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgRenewGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewGrant.Merge(m, src)
+}
+func (m *MsgRenewGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewGrant proto.InternalMessageInfo
+
+// MsgRenewGrantResponse defines the Msg/MsgRenewGrant response type.
+type MsgRenewGrantResponse struct {
+}
+
+func (m *MsgRenewGrantResponse) Reset()         { *m = MsgRenewGrantResponse{} }
+func (m *MsgRenewGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewGrantResponse) ProtoMessage()    {}
+func (*MsgRenewGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{8}
+}
+func (m *MsgRenewGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewGrantResponse.Merge(m, src)
+}
+func (m *MsgRenewGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewGrantResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
+	proto.RegisterType((*ExecResult)(nil), "cosmos.authz.v1beta1.ExecResult")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.authz.v1beta1.MsgExec")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgRenewGrant)(nil), "cosmos.authz.v1beta1.MsgRenewGrant")
+	proto.RegisterType((*MsgRenewGrantResponse)(nil), "cosmos.authz.v1beta1.MsgRenewGrantResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0x4d, 0xda, 0x92, 0x6d, 0x25, 0xc0, 0xe4, 0xe0, 0x1a, 0xea, 0x58, 0xe6, 0x5f,
-	0x24, 0xe8, 0x5a, 0x0d, 0x07, 0xce, 0x8d, 0x84, 0x90, 0x10, 0x16, 0x92, 0x05, 0x17, 0x2e, 0xd1,
-	0x3a, 0x5d, 0x36, 0x56, 0x62, 0xaf, 0xe5, 0x59, 0x97, 0xa4, 0x4f, 0xc1, 0xc3, 0xf0, 0x10, 0x11,
-	0xa7, 0x1e, 0x39, 0x21, 0x48, 0x5e, 0x82, 0x23, 0xf2, 0xee, 0x3a, 0x14, 0x94, 0x16, 0x89, 0x53,
-	0x76, 0xe6, 0xfb, 0x65, 0xe6, 0xf3, 0xe7, 0x35, 0x3e, 0x1c, 0x09, 0x48, 0x05, 0x04, 0xb4, 0x94,
-	0xe3, 0xf3, 0xe0, 0xec, 0x38, 0x66, 0x92, 0x1e, 0x07, 0x72, 0x46, 0xf2, 0x42, 0x48, 0x61, 0x75,
-	0xb4, 0x4c, 0x94, 0x4c, 0x8c, 0xec, 0x1c, 0xe8, 0xee, 0x50, 0x31, 0x81, 0x41, 0x54, 0xe1, 0x74,
-	0xb8, 0xe0, 0x42, 0xf7, 0xab, 0x93, 0xe9, 0x76, 0xb9, 0x10, 0x7c, 0xca, 0x02, 0x55, 0xc5, 0xe5,
-	0x87, 0x40, 0x26, 0x29, 0x03, 0x49, 0xd3, 0xdc, 0x00, 0x07, 0x7f, 0x03, 0x34, 0x9b, 0x1b, 0xe9,
-	0xbe, 0x71, 0x18, 0x53, 0x60, 0x01, 0x8d, 0x47, 0xc9, 0xda, 0x65, 0x55, 0x18, 0xc8, 0xdb, 0xf8,
-	0x18, 0xda, 0xb5, 0x22, 0xfc, 0x8f, 0xf8, 0x46, 0x08, 0xfc, 0x65, 0x41, 0x33, 0x69, 0xd9, 0x78,
-	0x97, 0x57, 0x07, 0x56, 0xd8, 0xc8, 0x43, 0xbd, 0x76, 0x54, 0x97, 0xbf, 0x15, 0x66, 0x6f, 0x5d,
-	0x56, 0x98, 0xf5, 0x1c, 0x6f, 0xab, 0xa3, 0xdd, 0xf4, 0x50, 0x6f, 0xaf, 0x7f, 0x97, 0x6c, 0x4a,
-	0x86, 0xa8, 0xf9, 0x83, 0xd6, 0xe2, 0x5b, 0xb7, 0x11, 0x69, 0xde, 0x7f, 0x82, 0x6f, 0x86, 0xc0,
-	0x5f, 0xcc, 0xd8, 0x28, 0x62, 0x90, 0x8b, 0x0c, 0x58, 0xb5, 0xa5, 0x60, 0x50, 0x4e, 0x25, 0xd8,
-	0xc8, 0x6b, 0xf6, 0xf6, 0xa3, 0xba, 0xf4, 0x05, 0xde, 0x35, 0xf0, 0x65, 0x2b, 0xe8, 0x4f, 0x2b,
-	0xaf, 0x70, 0x2b, 0x05, 0x0e, 0xf6, 0x96, 0xd7, 0xec, 0xed, 0xf5, 0x3b, 0x44, 0x67, 0x47, 0xea,
-	0xec, 0xc8, 0x49, 0x36, 0x1f, 0x78, 0x5f, 0x3e, 0x1f, 0xdd, 0x83, 0xd3, 0x09, 0x09, 0x81, 0x3f,
-	0xf5, 0xb4, 0xc9, 0x93, 0x52, 0x8e, 0x45, 0x91, 0x9c, 0x53, 0x99, 0x88, 0x2c, 0x52, 0x33, 0x7c,
-	0x0b, 0xdf, 0xaa, 0x63, 0xa9, 0xed, 0xf9, 0x14, 0xb7, 0x43, 0xe0, 0x11, 0x3b, 0x13, 0x13, 0xf6,
-	0x5f, 0x59, 0x79, 0x78, 0x3f, 0x05, 0x3e, 0x94, 0xf3, 0x9c, 0x0d, 0xcb, 0x62, 0xaa, 0x22, 0x6b,
-	0x47, 0x38, 0x05, 0xfe, 0x76, 0x9e, 0xb3, 0x77, 0xc5, 0xd4, 0xbf, 0x83, 0x6f, 0xaf, 0x57, 0xd4,
-	0x7b, 0xfb, 0x3f, 0x11, 0x6e, 0x86, 0xc0, 0xad, 0x37, 0x78, 0x5b, 0xbf, 0x27, 0x77, 0x73, 0xc8,
-	0xb5, 0x61, 0xe7, 0xd1, 0xf5, 0xfa, 0x3a, 0xef, 0xd7, 0xb8, 0xa5, 0x22, 0x3d, 0xbc, 0x92, 0xaf,
-	0x64, 0xe7, 0xe1, 0xb5, 0xf2, 0x7a, 0x5a, 0x84, 0x77, 0x4c, 0x36, 0xdd, 0x2b, 0xff, 0xa0, 0x01,
-	0xe7, 0xf1, 0x3f, 0x80, 0x7a, 0xe6, 0x60, 0xb0, 0xf8, 0xe1, 0x36, 0x16, 0x4b, 0x17, 0x5d, 0x2c,
-	0x5d, 0xf4, 0x7d, 0xe9, 0xa2, 0x4f, 0x2b, 0xb7, 0x71, 0xb1, 0x72, 0x1b, 0x5f, 0x57, 0x6e, 0xe3,
-	0xfd, 0x03, 0x9e, 0xc8, 0x71, 0x19, 0x93, 0x91, 0x48, 0xcd, 0xd7, 0x66, 0x7e, 0x8e, 0xe0, 0x74,
-	0x12, 0xcc, 0xf4, 0x3d, 0x8f, 0x77, 0xd4, 0x05, 0x78, 0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe3,
-	0x02, 0xf3, 0xbe, 0xd3, 0x03, 0x00, 0x00,
+	// 690 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x49, 0x80, 0xe4, 0x24, 0x88, 0x8b, 0x2f, 0x57, 0x04, 0x5f, 0x70, 0xac, 0xd0, 0x9f,
+	0x88, 0x16, 0x5b, 0xa4, 0x8b, 0x4a, 0xdd, 0x11, 0x15, 0x55, 0xad, 0x1a, 0x21, 0x59, 0x74, 0xd3,
+	0x45, 0x23, 0xc7, 0x99, 0x0e, 0x16, 0xb1, 0xc7, 0xf2, 0x8c, 0xd3, 0x84, 0x65, 0xa5, 0xee, 0x79,
+	0x8d, 0xee, 0xba, 0xe8, 0xa6, 0x6f, 0x80, 0xba, 0x62, 0xc9, 0xaa, 0x3f, 0xb0, 0xe8, 0x6b, 0x54,
+	0x1e, 0xcf, 0x98, 0x40, 0x03, 0x54, 0xac, 0x3c, 0xe7, 0x7c, 0xdf, 0x9c, 0xdf, 0xcf, 0x03, 0xab,
+	0x2e, 0xa1, 0x3e, 0xa1, 0x96, 0x13, 0xb3, 0xbd, 0x03, 0x6b, 0xb0, 0xd9, 0x45, 0xcc, 0xd9, 0xb4,
+	0xd8, 0xd0, 0x0c, 0x23, 0xc2, 0x88, 0xba, 0x98, 0xc2, 0x26, 0x87, 0x4d, 0x01, 0x6b, 0xcb, 0xa9,
+	0xb7, 0xc3, 0x39, 0x96, 0xa0, 0x70, 0x43, 0x5b, 0xc4, 0x04, 0x93, 0xd4, 0x9f, 0x9c, 0x84, 0xb7,
+	0x86, 0x09, 0xc1, 0x7d, 0x64, 0x71, 0xab, 0x1b, 0xbf, 0xb5, 0x98, 0xe7, 0x23, 0xca, 0x1c, 0x3f,
+	0x14, 0x84, 0xe5, 0xcb, 0x04, 0x27, 0x18, 0x09, 0x68, 0x4d, 0x54, 0xd8, 0x75, 0x28, 0xb2, 0x9c,
+	0xae, 0xeb, 0x65, 0x55, 0x26, 0x86, 0x20, 0x19, 0x13, 0xdb, 0x48, 0xab, 0x4e, 0x19, 0x4b, 0x82,
+	0xe1, 0x53, 0x6c, 0x0d, 0x36, 0x93, 0x4f, 0x0a, 0xd4, 0x3f, 0x28, 0x50, 0x6c, 0x53, 0xfc, 0x2c,
+	0x72, 0x02, 0xa6, 0x56, 0x61, 0x16, 0x27, 0x07, 0x14, 0x55, 0x15, 0x43, 0x69, 0x94, 0x6c, 0x69,
+	0x9e, 0x23, 0xa8, 0x3a, 0x35, 0x8e, 0x20, 0xf5, 0x31, 0x4c, 0xf3, 0x63, 0x35, 0x6f, 0x28, 0x8d,
+	0x72, 0xf3, 0x7f, 0x73, 0xd2, 0xcc, 0x4c, 0x1e, 0xbf, 0x55, 0x38, 0xfa, 0x56, 0xcb, 0xd9, 0x29,
+	0xff, 0x49, 0xe5, 0xfd, 0xaf, 0x4f, 0xeb, 0x32, 0x41, 0x7d, 0x00, 0xf3, 0x6d, 0x8a, 0xb7, 0x87,
+	0xc8, 0xb5, 0x11, 0x0d, 0x49, 0x40, 0x51, 0x92, 0x33, 0x42, 0x34, 0xee, 0x33, 0x5a, 0x55, 0x8c,
+	0x7c, 0xa3, 0x62, 0x4b, 0x53, 0x7d, 0x0e, 0x15, 0x34, 0x44, 0x6e, 0x47, 0xc2, 0x53, 0x46, 0xbe,
+	0x51, 0x6e, 0x1a, 0x93, 0x53, 0x8b, 0x98, 0x71, 0x5f, 0xe6, 0x2f, 0xa3, 0xcc, 0x43, 0xeb, 0x7d,
+	0x80, 0x73, 0x42, 0x92, 0x92, 0xc6, 0xae, 0x8b, 0x28, 0xe5, 0x03, 0x28, 0xda, 0xd2, 0x54, 0x55,
+	0x28, 0xf4, 0x1c, 0xe6, 0xf0, 0xee, 0x2b, 0x36, 0x3f, 0xab, 0x2b, 0x50, 0x72, 0x49, 0x0f, 0xd1,
+	0xd0, 0x71, 0x11, 0x6f, 0xbf, 0x64, 0x9f, 0x3b, 0x92, 0x1b, 0x89, 0x51, 0x2d, 0x18, 0x4a, 0x63,
+	0xce, 0xe6, 0xe7, 0xfa, 0x47, 0x05, 0x66, 0x45, 0x9b, 0xe3, 0x23, 0x55, 0x2e, 0x8e, 0xf4, 0x05,
+	0x14, 0x7c, 0x8a, 0x65, 0x5b, 0x8b, 0x66, 0xaa, 0x0e, 0x53, 0xaa, 0xc3, 0xdc, 0x0a, 0x46, 0x2d,
+	0xe3, 0xeb, 0xe7, 0x8d, 0x15, 0xda, 0xdb, 0x37, 0xdb, 0x14, 0x3f, 0x34, 0xd2, 0x8e, 0xb7, 0x62,
+	0xb6, 0x47, 0x22, 0xef, 0xc0, 0x61, 0x1e, 0x09, 0x6c, 0x1e, 0x43, 0x5d, 0x87, 0x05, 0x97, 0x04,
+	0xcc, 0x0b, 0x62, 0xd4, 0x21, 0x41, 0x07, 0x45, 0x11, 0x89, 0x78, 0xad, 0x45, 0x7b, 0x5e, 0x02,
+	0x3b, 0xc1, 0x76, 0xe2, 0xbe, 0xb0, 0x11, 0x54, 0x57, 0xe1, 0x1f, 0x29, 0x0c, 0xb9, 0x92, 0x3a,
+	0x81, 0x52, 0x9b, 0x62, 0x1b, 0x0d, 0xc8, 0x3e, 0xba, 0x95, 0x5a, 0x0c, 0xa8, 0xf8, 0x14, 0x77,
+	0xd8, 0x28, 0x44, 0x9d, 0x38, 0xea, 0x8b, 0xa9, 0x81, 0x4f, 0xf1, 0xee, 0x28, 0x44, 0xaf, 0xa2,
+	0xfe, 0x25, 0x59, 0xfc, 0x0b, 0x0b, 0x59, 0xc2, 0xac, 0x8a, 0x2f, 0x0a, 0xcc, 0x71, 0x6f, 0x80,
+	0xde, 0xdd, 0x5e, 0xb8, 0x37, 0x96, 0xa2, 0x3e, 0x05, 0x40, 0xc3, 0xd0, 0x8b, 0xf8, 0x3c, 0xf9,
+	0x1e, 0xcb, 0x4d, 0xed, 0x8f, 0x6d, 0xec, 0xca, 0x9f, 0xb9, 0x55, 0x4c, 0xe4, 0x75, 0xf8, 0xbd,
+	0xa6, 0xd8, 0x63, 0xf7, 0x2e, 0x35, 0xb4, 0x04, 0xff, 0x5d, 0x28, 0x5d, 0x36, 0xd5, 0x3c, 0x99,
+	0x82, 0x7c, 0x9b, 0x62, 0x75, 0x07, 0xa6, 0xd3, 0x9e, 0xf4, 0xc9, 0x72, 0x96, 0x3b, 0xd1, 0xee,
+	0x5d, 0x8f, 0x67, 0xbf, 0xd1, 0x4b, 0x28, 0x70, 0xbd, 0xad, 0x5e, 0xc9, 0x4f, 0x60, 0xed, 0xee,
+	0xb5, 0x70, 0x16, 0xcd, 0x86, 0x19, 0xb1, 0xfe, 0xda, 0x95, 0x17, 0x52, 0x82, 0x76, 0xff, 0x06,
+	0x42, 0x16, 0xf3, 0x0d, 0xc0, 0xd8, 0x2e, 0xd7, 0xae, 0xb9, 0x26, 0x49, 0xda, 0x83, 0xbf, 0x20,
+	0xc9, 0xf8, 0xad, 0xd6, 0xd1, 0x4f, 0x3d, 0x77, 0x74, 0xaa, 0x2b, 0xc7, 0xa7, 0xba, 0xf2, 0xe3,
+	0x54, 0x57, 0x0e, 0xcf, 0xf4, 0xdc, 0xf1, 0x99, 0x9e, 0x3b, 0x39, 0xd3, 0x73, 0xaf, 0xef, 0x60,
+	0x8f, 0xed, 0xc5, 0x5d, 0xd3, 0x25, 0xbe, 0x78, 0xcc, 0xc5, 0x67, 0x83, 0xf6, 0xf6, 0xad, 0x61,
+	0xfa, 0x8c, 0x76, 0x67, 0xf8, 0xbe, 0x1f, 0xfd, 0x1e, 0x00, 0xe7, 0x4a, 0x87, 0x81, 0x32, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +494,10 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RenewGrant extends the expiration of the grant for the provided method
+	// name on the granter's account. The authorization of the grant, and the
+	// limits it accumulated, are kept as they are.
+	RenewGrant(ctx context.Context, in *MsgRenewGrant, opts ...grpc.CallOption) (*MsgRenewGrantResponse, error)
 }
 
 type msgClient struct {
@@ -377,6 +535,15 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RenewGrant(ctx context.Context, in *MsgRenewGrant, opts ...grpc.CallOption) (*MsgRenewGrantResponse, error) {
+	out := new(MsgRenewGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RenewGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -391,6 +558,10 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RenewGrant extends the expiration of the grant for the provided method
+	// name on the granter's account. The authorization of the grant, and the
+	// limits it accumulated, are kept as they are.
+	RenewGrant(context.Context, *MsgRenewGrant) (*MsgRenewGrantResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -406,6 +577,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) RenewGrant(ctx context.Context, req *MsgRenewGrant) (*MsgRenewGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewGrant not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -465,6 +639,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RenewGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RenewGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewGrant(ctx, req.(*MsgRenewGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -481,6 +673,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RenewGrant",
+			Handler:    _Msg_RenewGrant_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -553,6 +749,20 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecResults) > 0 {
+		for iNdEx := len(m.ExecResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Results) > 0 {
 		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Results[iNdEx])
@@ -565,6 +775,58 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgExec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -585,6 +847,16 @@ func (m *MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ContinueOnError {
+		i--
+		if m.ContinueOnError {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -699,6 +971,81 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRenewGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -741,6 +1088,35 @@ func (m *MsgExecResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if len(m.ExecResults) > 0 {
+		for _, e := range m.ExecResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovTx(uint64(m.Code))
+	}
 	return n
 }
 
@@ -760,6 +1136,9 @@ func (m *MsgExec) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.ContinueOnError {
+		n += 2
+	}
 	return n
 }
 
@@ -802,6 +1181,38 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRenewGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRenewGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1016,8 +1427,197 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
 			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecResults = append(m.ExecResults, ExecResult{})
+			if err := m.ExecResults[len(m.ExecResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -1132,6 +1732,26 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOnError", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContinueOnError = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1399,6 +2019,235 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRenewGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
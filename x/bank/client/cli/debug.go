@@ -0,0 +1,290 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	FlagHeightA   = "height-a"
+	FlagHeightB   = "height-b"
+	FlagDenoms    = "denoms"
+	FlagAddresses = "addresses"
+	FlagCSV       = "csv"
+)
+
+// BalanceDiff is the difference between the balances of a denom held by an
+// account at two heights.
+type BalanceDiff struct {
+	Address string  `json:"address"`
+	Denom   string  `json:"denom"`
+	AmountA sdk.Int `json:"amount_a"`
+	AmountB sdk.Int `json:"amount_b"`
+	Diff    sdk.Int `json:"diff"`
+}
+
+// NewBankDiffCmd returns a command listing the balances which differ between
+// two heights, meant to be registered under the debug command.
+func NewBankDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bank-diff",
+		Short: "List the account balances which differ between two heights",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the per-account, per-denom balances which differ between two heights, to
+verify that an airdrop or a store migration touched exactly the intended
+accounts. The balances of all the accounts existing at either height are
+compared, unless --addresses is set. Both heights must still be available on
+the queried node, which requires it not to have pruned them.
+
+Example:
+  $ %s debug bank-diff --height-a 1000 --height-b 1001
+  $ %s debug bank-diff --height-a 1000 --height-b 1001 --denoms=stake,atom --csv
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			heightA, err := cmd.Flags().GetInt64(FlagHeightA)
+			if err != nil {
+				return err
+			}
+
+			heightB, err := cmd.Flags().GetInt64(FlagHeightB)
+			if err != nil {
+				return err
+			}
+
+			if heightA <= 0 || heightB <= 0 {
+				return fmt.Errorf("--%s and --%s must be positive heights", FlagHeightA, FlagHeightB)
+			}
+
+			denoms, err := cmd.Flags().GetStringSlice(FlagDenoms)
+			if err != nil {
+				return err
+			}
+
+			addrStrs, err := cmd.Flags().GetStringSlice(FlagAddresses)
+			if err != nil {
+				return err
+			}
+
+			var addrs []string
+			if len(addrStrs) > 0 {
+				for _, addrStr := range addrStrs {
+					if _, err := sdk.AccAddressFromBech32(addrStr); err != nil {
+						return err
+					}
+				}
+				addrs = addrStrs
+			} else {
+				// compare the balances of all the accounts existing at either height
+				addrs, err = queryAccountAddresses(cmd.Context(), clientCtx, heightA, heightB)
+				if err != nil {
+					return err
+				}
+			}
+
+			balancesA, err := queryBalances(cmd.Context(), clientCtx, addrs, heightA)
+			if err != nil {
+				return err
+			}
+
+			balancesB, err := queryBalances(cmd.Context(), clientCtx, addrs, heightB)
+			if err != nil {
+				return err
+			}
+
+			diffs := DiffBalances(balancesA, balancesB, denoms)
+
+			csvOutput, err := cmd.Flags().GetBool(FlagCSV)
+			if err != nil {
+				return err
+			}
+
+			if csvOutput {
+				return writeBalanceDiffsCSV(cmd, diffs)
+			}
+
+			if diffs == nil {
+				diffs = []BalanceDiff{}
+			}
+
+			bz, err := json.MarshalIndent(diffs, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(append(bz, '\n'))
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeightA, 0, "The height of the balances to compare from")
+	cmd.Flags().Int64(FlagHeightB, 0, "The height of the balances to compare to")
+	cmd.Flags().StringSlice(FlagDenoms, nil, "Only list the balances of these denominations")
+	cmd.Flags().StringSlice(FlagAddresses, nil, "Only list the balances of these accounts")
+	cmd.Flags().Bool(FlagCSV, false, "Print the differences as CSV")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagHeightA)
+	_ = cmd.MarkFlagRequired(FlagHeightB)
+
+	return cmd
+}
+
+// queryAccountAddresses returns the sorted addresses of the accounts existing
+// at any of the given heights.
+func queryAccountAddresses(ctx context.Context, clientCtx client.Context, heights ...int64) ([]string, error) {
+	seen := make(map[string]bool)
+
+	for _, height := range heights {
+		queryClient := authtypes.NewQueryClient(clientCtx.WithHeight(height))
+
+		pageReq := &query.PageRequest{}
+		for {
+			res, err := queryClient.Accounts(ctx, &authtypes.QueryAccountsRequest{Pagination: pageReq})
+			if err != nil {
+				return nil, fmt.Errorf("failed to query accounts at height %d: %w", height, err)
+			}
+
+			for _, any := range res.Accounts {
+				var acc authtypes.AccountI
+				if err := clientCtx.InterfaceRegistry.UnpackAny(any, &acc); err != nil {
+					return nil, err
+				}
+				seen[acc.GetAddress().String()] = true
+			}
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+
+	addrs := make([]string, 0, len(seen))
+	for addr := range seen {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+
+	return addrs, nil
+}
+
+// queryBalances returns the balances of the given accounts at a height.
+func queryBalances(ctx context.Context, clientCtx client.Context, addrs []string, height int64) (map[string]sdk.Coins, error) {
+	queryClient := types.NewQueryClient(clientCtx.WithHeight(height))
+	balances := make(map[string]sdk.Coins, len(addrs))
+
+	for _, addr := range addrs {
+		pageReq := &query.PageRequest{}
+		for {
+			res, err := queryClient.AllBalances(ctx, &types.QueryAllBalancesRequest{Address: addr, Pagination: pageReq})
+			if err != nil {
+				return nil, fmt.Errorf("failed to query the balances of %s at height %d: %w", addr, height, err)
+			}
+
+			balances[addr] = balances[addr].Add(res.Balances...)
+
+			if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+				break
+			}
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+
+	return balances, nil
+}
+
+// DiffBalances returns the balances which differ between two sets of account
+// balances, sorted by address and denom. Balances missing from a set are zero.
+// If denoms is not empty, only the balances of these denoms are compared.
+func DiffBalances(balancesA, balancesB map[string]sdk.Coins, denoms []string) []BalanceDiff {
+	denomFilter := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		denomFilter[denom] = true
+	}
+
+	addrs := make(map[string]bool, len(balancesA))
+	for addr := range balancesA {
+		addrs[addr] = true
+	}
+	for addr := range balancesB {
+		addrs[addr] = true
+	}
+
+	var diffs []BalanceDiff
+	for addr := range addrs {
+		coinsA, coinsB := balancesA[addr], balancesB[addr]
+
+		accDenoms := make(map[string]bool)
+		for _, coin := range coinsA.Add(coinsB...) {
+			accDenoms[coin.Denom] = true
+		}
+
+		for denom := range accDenoms {
+			if len(denomFilter) > 0 && !denomFilter[denom] {
+				continue
+			}
+
+			amountA, amountB := coinsA.AmountOf(denom), coinsB.AmountOf(denom)
+			if amountA.Equal(amountB) {
+				continue
+			}
+
+			diffs = append(diffs, BalanceDiff{
+				Address: addr,
+				Denom:   denom,
+				AmountA: amountA,
+				AmountB: amountB,
+				Diff:    amountB.Sub(amountA),
+			})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].Address != diffs[j].Address {
+			return diffs[i].Address < diffs[j].Address
+		}
+		return diffs[i].Denom < diffs[j].Denom
+	})
+
+	return diffs
+}
+
+func writeBalanceDiffsCSV(cmd *cobra.Command, diffs []BalanceDiff) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+
+	if err := w.Write([]string{"address", "denom", "amount_a", "amount_b", "diff"}); err != nil {
+		return err
+	}
+
+	for _, diff := range diffs {
+		if err := w.Write([]string{diff.Address, diff.Denom, diff.AmountA.String(), diff.AmountB.String(), diff.Diff.String()}); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(),
+		GetBalancesByAddressesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
 	)
@@ -101,6 +102,59 @@ Example:
 	return cmd
 }
 
+// GetBalancesByAddressesCmd defines the cobra command to query the balances of
+// a list of accounts.
+func GetBalancesByAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances-by-addresses [address]...",
+		Short: "Query for the balances of a list of accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balances of up to %d accounts at once, optionally restricted to some denominations.
+
+Example:
+  $ %s query %s balances-by-addresses [address] [address]
+  $ %s query %s balances-by-addresses [address] [address] --denom=[denom],[denom]
+`,
+				types.MaxBalancesByAddresses, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, types.MaxBalancesByAddresses),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			denoms, err := cmd.Flags().GetStringSlice(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addrs := make([]sdk.AccAddress, len(args))
+			for i, arg := range args {
+				addrs[i], err = sdk.AccAddressFromBech32(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQueryBalancesByAddressesRequest(addrs, denoms)
+			res, err := queryClient.BalancesByAddresses(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagDenom, []string{}, "The specific balance denominations to query for")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdDenomsMetadata defines the cobra command to query client denomination metadata.
 func GetCmdDenomsMetadata() *cobra.Command {
 	cmd := &cobra.Command{
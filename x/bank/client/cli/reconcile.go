@@ -0,0 +1,437 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// The causes of the balance deltas which are not caused by a message.
+const (
+	CauseFee        = "fee"
+	CauseBeginBlock = "begin_block"
+	CauseEndBlock   = "end_block"
+)
+
+// BalanceDelta is a change of the balance of a denom held by an account in a
+// block. MsgType is the type URL of the message causing it, or one of the
+// causes CauseFee, CauseBeginBlock or CauseEndBlock, e.g. for the fees, the
+// minted coins, the slashes or the completed unbondings. TxHash is empty if the
+// delta is not caused by a transaction.
+type BalanceDelta struct {
+	Height  int64   `json:"height"`
+	TxHash  string  `json:"tx_hash,omitempty"`
+	MsgType string  `json:"msg_type"`
+	Address string  `json:"address"`
+	Denom   string  `json:"denom"`
+	Amount  sdk.Int `json:"amount"`
+}
+
+// BalanceReconciliation compares the balance of a denom held by an account at
+// the end height of a reconciliation with its balance at the start height plus
+// the sum of its deltas.
+type BalanceReconciliation struct {
+	Address    string  `json:"address"`
+	Denom      string  `json:"denom"`
+	AmountA    sdk.Int `json:"amount_a"`
+	AmountB    sdk.Int `json:"amount_b"`
+	Delta      sdk.Int `json:"delta"`
+	Reconciled bool    `json:"reconciled"`
+}
+
+// ReconciliationReport is the report of the reconciliation of the balances of
+// accounts between two heights.
+type ReconciliationReport struct {
+	HeightA    int64                   `json:"height_a"`
+	HeightB    int64                   `json:"height_b"`
+	Deltas     []BalanceDelta          `json:"deltas"`
+	Balances   []BalanceReconciliation `json:"balances"`
+	Reconciled bool                    `json:"reconciled"`
+}
+
+// NewBankReconcileCmd returns a command exporting the balance deltas of
+// accounts between two heights with their causes, meant to be registered
+// under the debug command.
+func NewBankReconcileCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bank-reconcile",
+		Short: "Export the balance deltas of accounts between two heights with their causes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the per-block balance deltas of the given accounts between two heights,
+with the hash of the transaction and the type of the message causing them, and
+reconcile them with the balances at both heights. The deltas are read from the
+coin_spent and coin_received events of the blocks after --height-a up to
+--height-b, including the transfers initiated by the modules at the beginning
+and the end of the blocks, such as the minted coins, the slashes and the
+completed unbondings.
+
+The report is printed as JSON. With --csv, the deltas are printed as CSV while
+the blocks are fetched, and the command fails at the end if the balances are
+not reconciled. Both heights and the blocks in between must still be available
+on the queried node, which requires it not to have pruned them.
+
+Example:
+  $ %s debug bank-reconcile --height-a 1000 --height-b 2000 --addresses=cosmos1...
+  $ %s debug bank-reconcile --height-a 1000 --height-b 2000 --addresses=cosmos1... --denoms=stake --csv
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			heightA, err := cmd.Flags().GetInt64(FlagHeightA)
+			if err != nil {
+				return err
+			}
+
+			heightB, err := cmd.Flags().GetInt64(FlagHeightB)
+			if err != nil {
+				return err
+			}
+
+			if heightA <= 0 || heightB < heightA {
+				return fmt.Errorf("--%s must be a positive height, not greater than --%s", FlagHeightA, FlagHeightB)
+			}
+
+			denoms, err := cmd.Flags().GetStringSlice(FlagDenoms)
+			if err != nil {
+				return err
+			}
+
+			addrs, err := cmd.Flags().GetStringSlice(FlagAddresses)
+			if err != nil {
+				return err
+			}
+
+			if len(addrs) == 0 {
+				return fmt.Errorf("--%s is required", FlagAddresses)
+			}
+
+			for _, addr := range addrs {
+				if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+					return err
+				}
+			}
+
+			csvOutput, err := cmd.Flags().GetBool(FlagCSV)
+			if err != nil {
+				return err
+			}
+
+			var w *csv.Writer
+			if csvOutput {
+				w = csv.NewWriter(cmd.OutOrStdout())
+				if err := w.Write([]string{"height", "tx_hash", "msg_type", "address", "denom", "amount"}); err != nil {
+					return err
+				}
+			}
+
+			report, err := reconcileBalances(cmd.Context(), clientCtx, addrs, denoms, heightA, heightB, func(deltas []BalanceDelta) error {
+				if w == nil {
+					return nil
+				}
+
+				return writeBalanceDeltasCSV(w, deltas)
+			})
+			if err != nil {
+				return err
+			}
+
+			if csvOutput {
+				for _, balance := range report.Balances {
+					if !balance.Reconciled {
+						return fmt.Errorf(
+							"balance of %s in %s not reconciled: %s at height %d plus %s is not %s at height %d",
+							balance.Address, balance.Denom, balance.AmountA, heightA, balance.Delta, balance.AmountB, heightB,
+						)
+					}
+				}
+
+				return nil
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(append(bz, '\n'))
+		},
+	}
+
+	cmd.Flags().Int64(FlagHeightA, 0, "The height of the balances to reconcile from")
+	cmd.Flags().Int64(FlagHeightB, 0, "The height of the balances to reconcile to")
+	cmd.Flags().StringSlice(FlagDenoms, nil, "Only reconcile the balances of these denominations")
+	cmd.Flags().StringSlice(FlagAddresses, nil, "The accounts whose balances are reconciled")
+	cmd.Flags().Bool(FlagCSV, false, "Print the deltas as CSV")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagHeightA)
+	_ = cmd.MarkFlagRequired(FlagHeightB)
+	_ = cmd.MarkFlagRequired(FlagAddresses)
+
+	return cmd
+}
+
+// reconcileBalances fetches the balance deltas of the given accounts in the
+// blocks after heightA up to heightB, calling onBlock with the deltas of each
+// block, and reconciles them with the balances at both heights.
+func reconcileBalances(
+	ctx context.Context, clientCtx client.Context, addrs, denoms []string, heightA, heightB int64, onBlock func([]BalanceDelta) error,
+) (ReconciliationReport, error) {
+	report := ReconciliationReport{HeightA: heightA, HeightB: heightB, Deltas: []BalanceDelta{}}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return report, err
+	}
+
+	balancesA, err := queryBalances(ctx, clientCtx, addrs, heightA)
+	if err != nil {
+		return report, err
+	}
+
+	watched := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		watched[addr] = true
+	}
+
+	for height := heightA + 1; height <= heightB; height++ {
+		h := height
+
+		block, err := node.Block(ctx, &h)
+		if err != nil {
+			return report, fmt.Errorf("failed to query the block at height %d: %w", height, err)
+		}
+
+		results, err := node.BlockResults(ctx, &h)
+		if err != nil {
+			return report, fmt.Errorf("failed to query the block results at height %d: %w", height, err)
+		}
+
+		deltas, err := BlockBalanceDeltas(block.Block.Txs, results, watched, denoms)
+		if err != nil {
+			return report, err
+		}
+
+		if err := onBlock(deltas); err != nil {
+			return report, err
+		}
+
+		report.Deltas = append(report.Deltas, deltas...)
+	}
+
+	balancesB, err := queryBalances(ctx, clientCtx, addrs, heightB)
+	if err != nil {
+		return report, err
+	}
+
+	report.Balances = ReconcileBalances(balancesA, balancesB, report.Deltas, denoms)
+	report.Reconciled = true
+	for _, balance := range report.Balances {
+		report.Reconciled = report.Reconciled && balance.Reconciled
+	}
+
+	return report, nil
+}
+
+// BlockBalanceDeltas returns the balance deltas of the watched accounts in the
+// block of the given transactions and results, in the order of their events.
+// If denoms is not empty, only the deltas of these denoms are returned.
+func BlockBalanceDeltas(txs tmtypes.Txs, results *ctypes.ResultBlockResults, watched map[string]bool, denoms []string) ([]BalanceDelta, error) {
+	if len(txs) != len(results.TxsResults) {
+		return nil, fmt.Errorf("block at height %d has %d txs but %d tx results", results.Height, len(txs), len(results.TxsResults))
+	}
+
+	p := deltaParser{height: results.Height, watched: watched, denoms: make(map[string]bool, len(denoms))}
+	for _, denom := range denoms {
+		p.denoms[denom] = true
+	}
+
+	if err := p.parse(results.BeginBlockEvents, "", CauseBeginBlock); err != nil {
+		return nil, err
+	}
+
+	for i, txResult := range results.TxsResults {
+		// the events preceding the events of the first message are emitted by
+		// the ante handler, and are kept even if the transaction failed
+		if err := p.parse(txResult.Events, fmt.Sprintf("%X", txs[i].Hash()), CauseFee); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.parse(results.EndBlockEvents, "", CauseEndBlock); err != nil {
+		return nil, err
+	}
+
+	return p.deltas, nil
+}
+
+// deltaParser parses the balance deltas of the watched accounts from the bank
+// events of a block.
+type deltaParser struct {
+	height  int64
+	watched map[string]bool
+	denoms  map[string]bool
+	deltas  []BalanceDelta
+}
+
+// parse parses the deltas of events, caused by cause until the event of a
+// message, which then causes the deltas of the following events.
+func (p *deltaParser) parse(events []abci.Event, txHash, cause string) error {
+	for _, event := range events {
+		switch event.Type {
+		case sdk.EventTypeMessage:
+			if action, ok := eventAttribute(event, sdk.AttributeKeyAction); ok {
+				cause = action
+			}
+
+		case types.EventTypeCoinSpent:
+			if err := p.add(event, types.AttributeKeySpender, txHash, cause, true); err != nil {
+				return err
+			}
+
+		case types.EventTypeCoinReceived:
+			if err := p.add(event, types.AttributeKeyReceiver, txHash, cause, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *deltaParser) add(event abci.Event, addrKey, txHash, cause string, spent bool) error {
+	addr, _ := eventAttribute(event, addrKey)
+	if !p.watched[addr] {
+		return nil
+	}
+
+	amount, _ := eventAttribute(event, sdk.AttributeKeyAmount)
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	if err != nil {
+		return fmt.Errorf("invalid %s amount at height %d: %w", event.Type, p.height, err)
+	}
+
+	for _, coin := range coins {
+		if len(p.denoms) > 0 && !p.denoms[coin.Denom] {
+			continue
+		}
+
+		delta := coin.Amount
+		if spent {
+			delta = delta.Neg()
+		}
+
+		p.deltas = append(p.deltas, BalanceDelta{
+			Height:  p.height,
+			TxHash:  txHash,
+			MsgType: cause,
+			Address: addr,
+			Denom:   coin.Denom,
+			Amount:  delta,
+		})
+	}
+
+	return nil
+}
+
+func eventAttribute(event abci.Event, key string) (string, bool) {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value), true
+		}
+	}
+
+	return "", false
+}
+
+// ReconcileBalances compares the balances at the end height of a
+// reconciliation with the balances at its start height plus the sum of the
+// deltas in between, sorted by address and denom. Balances missing from a set
+// are zero. If denoms is not empty, only the balances of these denoms are
+// compared.
+func ReconcileBalances(balancesA, balancesB map[string]sdk.Coins, deltas []BalanceDelta, denoms []string) []BalanceReconciliation {
+	denomFilter := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		denomFilter[denom] = true
+	}
+
+	type balanceKey struct{ addr, denom string }
+	sums := make(map[balanceKey]sdk.Int)
+	keep := func(denom string) bool { return len(denomFilter) == 0 || denomFilter[denom] }
+
+	for _, balances := range []map[string]sdk.Coins{balancesA, balancesB} {
+		for addr, coins := range balances {
+			for _, coin := range coins {
+				if keep(coin.Denom) {
+					sums[balanceKey{addr, coin.Denom}] = sdk.ZeroInt()
+				}
+			}
+		}
+	}
+	for _, delta := range deltas {
+		key := balanceKey{delta.Address, delta.Denom}
+		if sum, ok := sums[key]; ok {
+			sums[key] = sum.Add(delta.Amount)
+		} else if keep(delta.Denom) {
+			sums[key] = delta.Amount
+		}
+	}
+
+	balances := make([]BalanceReconciliation, 0, len(sums))
+	for key, sum := range sums {
+		amountA, amountB := balancesA[key.addr].AmountOf(key.denom), balancesB[key.addr].AmountOf(key.denom)
+
+		balances = append(balances, BalanceReconciliation{
+			Address:    key.addr,
+			Denom:      key.denom,
+			AmountA:    amountA,
+			AmountB:    amountB,
+			Delta:      sum,
+			Reconciled: amountA.Add(sum).Equal(amountB),
+		})
+	}
+
+	sort.Slice(balances, func(i, j int) bool {
+		if balances[i].Address != balances[j].Address {
+			return balances[i].Address < balances[j].Address
+		}
+		return balances[i].Denom < balances[j].Denom
+	})
+
+	return balances
+}
+
+func writeBalanceDeltasCSV(w *csv.Writer, deltas []BalanceDelta) error {
+	for _, delta := range deltas {
+		row := []string{strconv.FormatInt(delta.Height, 10), delta.TxHash, delta.MsgType, delta.Address, delta.Denom, delta.Amount.String()}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
@@ -1,12 +1,18 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -20,7 +26,10 @@ func NewTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	txCmd.AddCommand(NewSendTxCmd())
+	txCmd.AddCommand(
+		NewSendTxCmd(),
+		NewMigrateMultisigFundsCmd(),
+	)
 
 	return txCmd
 }
@@ -58,3 +67,82 @@ ignored as it is implied from [from_key_or_address].`,
 
 	return cmd
 }
+
+// NewMigrateMultisigFundsCmd returns a CLI command handler for creating a
+// MsgSend transaction moving all the funds of a multisig account to the
+// address of a new key.
+func NewMigrateMultisigFundsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-multisig-funds [from_key_or_address] [to_key_or_address]",
+		Short: "Send all the funds of a multisig account to a new key",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send all the funds of a multisig account, less the fees set with the --fees
+flag, to the address of a new key, when the public key of the account cannot be rotated
+with 'tx auth rotate-multisig', e.g. to move the funds to a key which is not a multisig
+key. Note, the '--from' flag is ignored as it is implied from [from_key_or_address].
+
+The transaction must be signed by the members of the multisig public key of the account:
+
+$ %[1]s tx bank migrate-multisig-funds <address> <new address> --fees 5000stake --generate-only > tx.json
+$ %[1]s tx sign tx.json --multisig <address> --from k1 --output-document k1sig.json
+$ %[1]s tx sign tx.json --multisig <address> --from k2 --output-document k2sig.json
+$ %[1]s tx multisign tx.json k1k2k3 k1sig.json k2sig.json > signed.json
+
+The locked coins of vesting accounts cannot be sent.
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				info, err := clientCtx.Keyring.Key(args[1])
+				if err != nil {
+					return fmt.Errorf("%q is neither an address nor a key: %w", args[1], err)
+				}
+				toAddr = info.GetAddress()
+			}
+
+			fromAddr := clientCtx.GetFromAddress()
+			queryClient := types.NewQueryClient(clientCtx)
+			var balances sdk.Coins
+			req := &types.QueryAllBalancesRequest{Address: fromAddr.String(), Pagination: &query.PageRequest{}}
+			for {
+				res, err := queryClient.AllBalances(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				balances = balances.Add(res.Balances...)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				req.Pagination.Key = res.Pagination.NextKey
+			}
+
+			fees := tx.NewFactoryCLI(clientCtx, cmd.Flags()).Fees()
+			coins, hasNeg := balances.SafeSub(fees)
+			if hasNeg {
+				return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than the fees %s", balances, fees)
+			}
+			if coins.IsZero() {
+				return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "no funds to migrate from %s", fromAddr)
+			}
+
+			msg := types.NewMsgSend(fromAddr, toAddr, coins)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
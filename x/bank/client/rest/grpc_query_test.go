@@ -67,7 +67,16 @@ func (s *IntegrationTestSuite) TestTotalSupplyGRPCHandler() {
 			},
 		},
 		{
-			"Query params shouldn't be considered as height",
+			"Query for `height` > 1 with the height query param",
+			fmt.Sprintf("%s/cosmos/bank/v1beta1/supply/%s?height=2", baseURL, s.cfg.BondDenom),
+			map[string]string{},
+			&types.QuerySupplyOfResponse{},
+			&types.QuerySupplyOfResponse{
+				Amount: sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Add(sdk.NewInt(20))),
+			},
+		},
+		{
+			"The height header takes precedence over the height query param",
 			fmt.Sprintf("%s/cosmos/bank/v1beta1/supply/%s?height=2", baseURL, s.cfg.BondDenom),
 			map[string]string{
 				grpctypes.GRPCBlockHeightHeader: "1",
@@ -1,7 +1,9 @@
 package testutil
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
@@ -162,6 +164,83 @@ func (s *IntegrationTestSuite) TestGetBalancesCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetBalancesByAddressesCmd() {
+	val := s.network.Validators[0]
+	bondBalance := sdk.NewCoin(s.cfg.BondDenom, s.cfg.StakingTokens.Sub(s.cfg.BondedTokens))
+	unfunded := sdk.AccAddress("unfunded_address")
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expected  proto.Message
+	}{
+		{"no address provided", []string{}, true, nil},
+		{"invalid address", []string{"invalid"}, true, nil},
+		{
+			"total account balances",
+			[]string{
+				val.Address.String(),
+				unfunded.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QueryBalancesByAddressesResponse{
+				Balances: []types.AddressBalances{
+					{
+						Address: val.Address.String(),
+						Balances: sdk.NewCoins(
+							sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), s.cfg.AccountTokens),
+							bondBalance,
+						),
+					},
+					{
+						Address:  unfunded.String(),
+						Balances: sdk.NewCoins(),
+					},
+				},
+			},
+		},
+		{
+			"account balances of specific denoms",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=%s,foobar", cli.FlagDenom, s.cfg.BondDenom),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			&types.QueryBalancesByAddressesResponse{
+				Balances: []types.AddressBalances{
+					{
+						Address:  val.Address.String(),
+						Balances: sdk.Coins{bondBalance, sdk.NewCoin("foobar", sdk.ZeroInt())},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetBalancesByAddressesCmd()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				var res types.QueryBalancesByAddressesResponse
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				s.Require().Equal(tc.expected.String(), res.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	val := s.network.Validators[0]
 
@@ -470,6 +549,125 @@ func (s *IntegrationTestSuite) TestNewSendTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestBankDiffCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	recipient := sdk.AccAddress("bank_diff_recipient_")
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+
+	bz, err := MsgSendExec(clientCtx, val.Address, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(7))),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+	s.Require().Zero(txResp.Code, txResp.RawLog)
+
+	heightArgs := []string{
+		fmt.Sprintf("--%s=%d", cli.FlagHeightA, txResp.Height-1),
+		fmt.Sprintf("--%s=%d", cli.FlagHeightB, txResp.Height),
+	}
+	addressesArg := fmt.Sprintf("--%s=%s,%s", cli.FlagAddresses, val.Address, recipient)
+
+	// the fee is deducted from the sender's balance
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankDiffCmd(), append(heightArgs, addressesArg))
+	s.Require().NoError(err)
+	var diffs []cli.BalanceDiff
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &diffs), out.String())
+	s.Require().Len(diffs, 3)
+
+	// all the accounts are compared without an address filter
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankDiffCmd(), append(heightArgs,
+		fmt.Sprintf("--%s=%s", cli.FlagDenoms, denom), fmt.Sprintf("--%s", cli.FlagCSV),
+	))
+	s.Require().NoError(err)
+
+	rows := []string{
+		"address,denom,amount_a,amount_b,diff",
+		fmt.Sprintf("%s,%s,0,7,7", recipient, denom),
+		fmt.Sprintf("%s,%s,%s,%s,-7", val.Address, denom, s.cfg.AccountTokens, s.cfg.AccountTokens.SubRaw(7)),
+	}
+	if val.Address.String() < recipient.String() {
+		rows[1], rows[2] = rows[2], rows[1]
+	}
+	s.Require().Equal(strings.Join(rows, "\n")+"\n", out.String())
+
+	// balances are unchanged between identical heights
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankDiffCmd(), []string{
+		fmt.Sprintf("--%s=%d", cli.FlagHeightA, txResp.Height),
+		fmt.Sprintf("--%s=%d", cli.FlagHeightB, txResp.Height),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("[]\n", out.String())
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankDiffCmd(), []string{fmt.Sprintf("--%s=%d", cli.FlagHeightA, txResp.Height)})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestBankReconcileCmd() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	recipient := sdk.AccAddress("bank_reconcile_recv_")
+	denom := fmt.Sprintf("%stoken", val.Moniker)
+	fee := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	send := func(amount sdk.Int) sdk.TxResponse {
+		bz, err := MsgSendExec(clientCtx, val.Address, recipient, sdk.NewCoins(sdk.NewCoin(denom, amount)),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, fee.String()),
+		)
+		s.Require().NoError(err)
+		var txResp sdk.TxResponse
+		s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+		return txResp
+	}
+
+	sendResp := send(sdk.NewInt(7))
+	s.Require().Zero(sendResp.Code, sendResp.RawLog)
+
+	// the fee of a transaction failing in DeliverTx is still deducted
+	failedResp := send(s.cfg.AccountTokens.MulRaw(2))
+	s.Require().NotZero(failedResp.Code)
+
+	args := []string{
+		fmt.Sprintf("--%s=%d", cli.FlagHeightA, sendResp.Height-1),
+		fmt.Sprintf("--%s=%d", cli.FlagHeightB, failedResp.Height),
+		fmt.Sprintf("--%s=%s,%s", cli.FlagAddresses, val.Address, recipient),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankReconcileCmd(), args)
+	s.Require().NoError(err)
+	var report cli.ReconciliationReport
+	s.Require().NoError(json.Unmarshal(out.Bytes(), &report), out.String())
+
+	msgSend := sdk.MsgTypeURL(&types.MsgSend{})
+	s.Require().Equal([]cli.BalanceDelta{
+		{Height: sendResp.Height, TxHash: sendResp.TxHash, MsgType: cli.CauseFee, Address: val.Address.String(), Denom: s.cfg.BondDenom, Amount: sdk.NewInt(-10)},
+		{Height: sendResp.Height, TxHash: sendResp.TxHash, MsgType: msgSend, Address: val.Address.String(), Denom: denom, Amount: sdk.NewInt(-7)},
+		{Height: sendResp.Height, TxHash: sendResp.TxHash, MsgType: msgSend, Address: recipient.String(), Denom: denom, Amount: sdk.NewInt(7)},
+		{Height: failedResp.Height, TxHash: failedResp.TxHash, MsgType: cli.CauseFee, Address: val.Address.String(), Denom: s.cfg.BondDenom, Amount: sdk.NewInt(-10)},
+	}, report.Deltas)
+	s.Require().True(report.Reconciled)
+	s.Require().Len(report.Balances, 3)
+
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankReconcileCmd(), append(args,
+		fmt.Sprintf("--%s=%s", cli.FlagDenoms, denom), fmt.Sprintf("--%s", cli.FlagCSV),
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(strings.Join([]string{
+		"height,tx_hash,msg_type,address,denom,amount",
+		fmt.Sprintf("%d,%s,%s,%s,%s,-7", sendResp.Height, sendResp.TxHash, msgSend, val.Address, denom),
+		fmt.Sprintf("%d,%s,%s,%s,%s,7", sendResp.Height, sendResp.TxHash, msgSend, recipient, denom),
+	}, "\n")+"\n", out.String())
+
+	// the accounts must be given
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.NewBankReconcileCmd(), args[:2])
+	s.Require().Error(err)
+}
+
 func NewCoin(denom string, amount sdk.Int) *sdk.Coin {
 	coin := sdk.NewCoin(denom, amount)
 	return &coin
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -43,10 +42,11 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 
 // ExportGenesis returns the bank module's genesis state.
 func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	totalSupply, _, err := k.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: query.MaxLimit})
-	if err != nil {
-		panic(fmt.Errorf("unable to fetch total supply %v", err))
-	}
+	totalSupply := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+		totalSupply = totalSupply.Add(supply)
+		return false
+	})
 
 	return types.NewGenesisState(
 		k.GetParams(ctx),
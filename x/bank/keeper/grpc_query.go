@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -76,6 +77,51 @@ func (k BaseKeeper) AllBalances(ctx context.Context, req *types.QueryAllBalances
 	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
 }
 
+// BalancesByAddresses implements the Query/BalancesByAddresses gRPC method
+func (k BaseKeeper) BalancesByAddresses(ctx context.Context, req *types.QueryBalancesByAddressesRequest) (*types.QueryBalancesByAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Addresses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "addresses cannot be empty")
+	}
+
+	if len(req.Addresses) > types.MaxBalancesByAddresses {
+		return nil, status.Errorf(codes.InvalidArgument, "too many addresses, got %d, max %d", len(req.Addresses), types.MaxBalancesByAddresses)
+	}
+
+	for _, denom := range req.Denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	balances := make([]types.AddressBalances, len(req.Addresses))
+	for i, address := range req.Addresses {
+		balances[i] = types.AddressBalances{Address: address, Balances: sdk.NewCoins()}
+
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			balances[i].Error = fmt.Sprintf("invalid address: %s", err)
+			continue
+		}
+
+		if len(req.Denoms) == 0 {
+			balances[i].Balances = k.GetAllBalances(sdkCtx, addr)
+			continue
+		}
+
+		for _, denom := range req.Denoms {
+			balances[i].Balances = append(balances[i].Balances, k.GetBalance(sdkCtx, addr, denom))
+		}
+	}
+
+	return &types.QueryBalancesByAddressesResponse{Balances: balances}, nil
+}
+
 // TotalSupply implements the Query/TotalSupply gRPC method
 func (k BaseKeeper) TotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -112,7 +158,7 @@ func (k BaseKeeper) Params(ctx context.Context, req *types.QueryParamsRequest) (
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	params := k.GetParams(sdkCtx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, LastChangedHeight: k.paramSpace.GetLastChangeHeight(sdkCtx)}, nil
 }
 
 // DenomsMetadata implements Query/DenomsMetadata gRPC method.
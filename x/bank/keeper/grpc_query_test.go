@@ -86,6 +86,49 @@ func (suite *IntegrationTestSuite) TestQueryAllBalances() {
 	suite.Nil(res.Pagination.NextKey)
 }
 
+func (suite *IntegrationTestSuite) TestQueryBalancesByAddresses() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	_, err := queryClient.BalancesByAddresses(gocontext.Background(), &types.QueryBalancesByAddressesRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.BalancesByAddresses(gocontext.Background(), &types.QueryBalancesByAddressesRequest{
+		Addresses: make([]string, types.MaxBalancesByAddresses+1),
+	})
+	suite.Require().Error(err)
+
+	_, err = queryClient.BalancesByAddresses(gocontext.Background(), types.NewQueryBalancesByAddressesRequest([]sdk.AccAddress{addr1}, []string{"!"}))
+	suite.Require().Error(err)
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	suite.Require().NoError(simapp.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(50), newBarCoin(30))))
+
+	req := types.NewQueryBalancesByAddressesRequest([]sdk.AccAddress{addr1, addr2}, nil)
+	req.Addresses = append(req.Addresses, "invalid")
+	res, err := queryClient.BalancesByAddresses(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Balances, 3)
+	suite.Require().Equal(addr1.String(), res.Balances[0].Address)
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(30), newFooCoin(50)), res.Balances[0].Balances)
+	suite.Require().Empty(res.Balances[0].Error)
+	suite.Require().Equal(addr2.String(), res.Balances[1].Address)
+	suite.Require().True(res.Balances[1].Balances.IsZero())
+	suite.Require().Empty(res.Balances[1].Error)
+	suite.Require().Equal("invalid", res.Balances[2].Address)
+	suite.Require().Contains(res.Balances[2].Error, "invalid address")
+
+	suite.T().Log("query the balances of some denoms")
+	req = types.NewQueryBalancesByAddressesRequest([]sdk.AccAddress{addr1, addr2}, []string{fooDenom})
+	res, err = queryClient.BalancesByAddresses(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Balances, 2)
+	suite.Require().Equal(sdk.Coins{newFooCoin(50)}, res.Balances[0].Balances)
+	suite.Require().Equal(sdk.Coins{newFooCoin(0)}, res.Balances[1].Balances)
+}
+
 func (suite *IntegrationTestSuite) TestQueryTotalSupply() {
 	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
 	expectedTotalSupply := sdk.NewCoins(sdk.NewInt64Coin("test", 400000000))
@@ -45,6 +45,8 @@ type Keeper interface {
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	GetAuthority() string
+
 	types.QueryServer
 }
 
@@ -56,6 +58,10 @@ type BaseKeeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   sdk.StoreKey
 	paramSpace paramtypes.Subspace
+
+	// authority is the address allowed to update the parameters of the
+	// module, usually the gov module account.
+	authority string
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -89,14 +95,19 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The authority is the
+// address allowed to update the parameters, usually the gov module account.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey sdk.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid bank authority %s", authority))
+	}
 
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -109,9 +120,16 @@ func NewBaseKeeper(
 		cdc:            cdc,
 		storeKey:       storeKey,
 		paramSpace:     paramSpace,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the parameters of the
+// module.
+func (k BaseKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
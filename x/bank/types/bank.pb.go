@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -430,44 +431,46 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x35, 0x8d, 0x49, 0x2f, 0xb0, 0x1c, 0x15, 0x72, 0x23, 0x61, 0x1b, 0x4b, 0x48, 0x29,
-	0xa2, 0x4e, 0x0a, 0x0c, 0x28, 0x0b, 0x52, 0xca, 0x0f, 0x75, 0x40, 0x20, 0x57, 0x08, 0x09, 0x86,
-	0xe8, 0x9c, 0xbb, 0x06, 0xab, 0xf6, 0x9d, 0x95, 0x3b, 0x57, 0xf5, 0x7f, 0xc0, 0x04, 0x8c, 0x8c,
-	0x9d, 0x59, 0xe1, 0x7f, 0xa0, 0x63, 0x05, 0x0b, 0x53, 0x40, 0xc9, 0xc2, 0xdc, 0xbf, 0x00, 0xf9,
-	0xce, 0xf9, 0x51, 0x29, 0x20, 0x06, 0x06, 0xa6, 0xbc, 0xef, 0xbd, 0xef, 0x7d, 0xef, 0xe9, 0xbb,
-	0xe7, 0x40, 0xbb, 0xcf, 0x45, 0xc2, 0x45, 0x2b, 0xc4, 0xec, 0xa0, 0x75, 0xb8, 0x1d, 0x52, 0x89,
-	0xb7, 0x15, 0xf0, 0xd3, 0x21, 0x97, 0x1c, 0x5d, 0xd6, 0x75, 0x5f, 0xa5, 0xca, 0x7a, 0x63, 0x7d,
-	0xc0, 0x07, 0x5c, 0xd5, 0x5b, 0x45, 0xa4, 0xa9, 0x8d, 0x0d, 0x4d, 0xed, 0xe9, 0x42, 0xd9, 0xa7,
-	0x4b, 0xf3, 0x29, 0x82, 0xce, 0xa6, 0xf4, 0x79, 0xc4, 0x74, 0xdd, 0xfb, 0x0a, 0xa0, 0xf9, 0x14,
-	0x0f, 0x71, 0x22, 0xd0, 0x3e, 0xbc, 0x28, 0x28, 0x23, 0x3d, 0xca, 0x70, 0x18, 0x53, 0x62, 0x01,
-	0xb7, 0xd2, 0xac, 0xdf, 0x72, 0xfd, 0x25, 0x7b, 0xf8, 0x7b, 0x94, 0x91, 0x07, 0x9a, 0xd7, 0xbd,
-	0x76, 0x36, 0x72, 0xae, 0xe6, 0x38, 0x89, 0x3b, 0xde, 0x62, 0xff, 0x4d, 0x9e, 0x44, 0x92, 0x26,
-	0xa9, 0xcc, 0xbd, 0xa0, 0x2e, 0xe6, 0x7c, 0xf4, 0x12, 0xae, 0x13, 0xba, 0x8f, 0xb3, 0x58, 0xf6,
-	0xce, 0xcd, 0x5b, 0x71, 0x41, 0xb3, 0xd6, 0xdd, 0x3c, 0x1b, 0x39, 0xd7, 0xb5, 0xda, 0x32, 0xd6,
-	0xa2, 0x2a, 0x2a, 0x09, 0x0b, 0xcb, 0x74, 0x56, 0xdf, 0x1f, 0x3b, 0x86, 0xf7, 0x08, 0xd6, 0x17,
-	0x92, 0x68, 0x1d, 0x56, 0x09, 0x65, 0x3c, 0xb1, 0x80, 0x0b, 0x9a, 0x6b, 0x81, 0x06, 0xc8, 0x82,
-	0x17, 0xce, 0x8d, 0x0e, 0xa6, 0xb0, 0x53, 0x2b, 0x44, 0x7e, 0x1e, 0x3b, 0xc0, 0x7b, 0x03, 0x60,
-	0x75, 0x97, 0xa5, 0x99, 0x2c, 0xd8, 0x98, 0x90, 0x21, 0x15, 0xa2, 0x54, 0x99, 0x42, 0x84, 0x61,
-	0xb5, 0x30, 0x54, 0x58, 0x2b, 0xca, 0xb0, 0x8d, 0xb9, 0x61, 0x82, 0xce, 0x0c, 0xdb, 0xe1, 0x11,
-	0xeb, 0xb6, 0x4f, 0x46, 0x8e, 0xf1, 0xe1, 0xbb, 0xd3, 0x1c, 0x44, 0xf2, 0x55, 0x16, 0xfa, 0x7d,
-	0x9e, 0x94, 0xaf, 0x55, 0xfe, 0x6c, 0x09, 0x72, 0xd0, 0x92, 0x79, 0x4a, 0x85, 0x6a, 0x10, 0x81,
-	0x56, 0xee, 0xd4, 0x5e, 0xeb, 0x85, 0x0c, 0xef, 0x2d, 0x80, 0xe6, 0x93, 0x4c, 0xfe, 0x47, 0x1b,
-	0x7d, 0x04, 0xd0, 0xdc, 0xcb, 0xd2, 0x34, 0xce, 0x8b, 0xb9, 0x92, 0x4b, 0x1c, 0x97, 0xa7, 0xf3,
-	0x6f, 0xe7, 0x2a, 0xe5, 0xce, 0xc3, 0x72, 0x2e, 0xf8, 0xf2, 0x69, 0xeb, 0xee, 0x8d, 0x3f, 0x76,
-	0x1f, 0xe9, 0x4f, 0x2b, 0xa6, 0x03, 0xdc, 0xcf, 0x5b, 0x87, 0xed, 0x3b, 0x6d, 0x5f, 0xef, 0xb9,
-	0x6b, 0x01, 0xef, 0x39, 0x5c, 0xbb, 0x5f, 0x5c, 0xc1, 0x33, 0x16, 0xc9, 0xdf, 0xdc, 0x47, 0x03,
-	0xd6, 0xe8, 0x51, 0xca, 0x19, 0x65, 0x52, 0x1d, 0xc8, 0xa5, 0x60, 0x86, 0x95, 0xf7, 0x71, 0x84,
-	0x05, 0x15, 0x56, 0xc5, 0xad, 0x28, 0xef, 0x35, 0xf4, 0x3e, 0x03, 0x58, 0x7b, 0x4c, 0x25, 0x26,
-	0x58, 0x62, 0xe4, 0xc2, 0x3a, 0xa1, 0xa2, 0x3f, 0x8c, 0x52, 0x19, 0x71, 0x56, 0xca, 0x2f, 0xa6,
-	0xd0, 0xbd, 0x82, 0xc1, 0x78, 0xd2, 0xcb, 0x58, 0x24, 0xa7, 0x0f, 0x66, 0x2f, 0xfd, 0xe6, 0x66,
-	0xfb, 0x06, 0x90, 0x4c, 0x43, 0x81, 0x10, 0x5c, 0x2d, 0xec, 0xb5, 0x2a, 0x4a, 0x5b, 0xc5, 0xc5,
-	0x76, 0x24, 0x12, 0x69, 0x8c, 0x73, 0x6b, 0x55, 0x5f, 0x46, 0x09, 0x0b, 0x36, 0xc3, 0x09, 0xb5,
-	0xaa, 0x9a, 0x5d, 0xc4, 0xe8, 0x0a, 0x34, 0x45, 0x9e, 0x84, 0x3c, 0xb6, 0x4c, 0x95, 0x2d, 0x51,
-	0x77, 0xe7, 0x64, 0x6c, 0x83, 0xd3, 0xb1, 0x0d, 0x7e, 0x8c, 0x6d, 0xf0, 0x6e, 0x62, 0x1b, 0xa7,
-	0x13, 0xdb, 0xf8, 0x36, 0xb1, 0x8d, 0x17, 0x9b, 0x7f, 0xe3, 0xbb, 0x7a, 0xbc, 0xd0, 0x54, 0x7f,
-	0x33, 0xb7, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x03, 0xbf, 0xe9, 0xee, 0x04, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xbf, 0x6f, 0xd3, 0x4e,
+	0x1c, 0xcd, 0x35, 0x4d, 0xbe, 0xe9, 0xe5, 0xcb, 0x72, 0x44, 0xe0, 0x56, 0xc2, 0x09, 0x96, 0x90,
+	0xd2, 0x8a, 0xda, 0x2d, 0x30, 0xa0, 0x2c, 0x48, 0x29, 0x3f, 0xd4, 0x01, 0x81, 0x5c, 0x21, 0x24,
+	0x18, 0xa2, 0x73, 0xee, 0x1a, 0xac, 0xda, 0x77, 0x56, 0xee, 0x5c, 0xd5, 0x2b, 0x13, 0x1b, 0x8c,
+	0x88, 0xa9, 0x33, 0x13, 0x12, 0xfc, 0x0f, 0x74, 0xac, 0x60, 0x61, 0x2a, 0xa8, 0x1d, 0x60, 0xee,
+	0x5f, 0x80, 0xee, 0x87, 0xd3, 0x54, 0x2a, 0x88, 0x05, 0x31, 0xf9, 0x3e, 0xf7, 0x79, 0xf7, 0xde,
+	0xd3, 0xbb, 0xcf, 0x19, 0xba, 0x43, 0x2e, 0x52, 0x2e, 0x82, 0x08, 0xb3, 0xad, 0x60, 0x7b, 0x35,
+	0xa2, 0x12, 0xaf, 0xea, 0xc2, 0xcf, 0xc6, 0x5c, 0x72, 0x74, 0xde, 0xf4, 0x7d, 0xbd, 0x65, 0xfb,
+	0x0b, 0xad, 0x11, 0x1f, 0x71, 0xdd, 0x0f, 0xd4, 0xca, 0x40, 0x17, 0xe6, 0x0d, 0x74, 0x60, 0x1a,
+	0xf6, 0x9c, 0x69, 0x9d, 0xa8, 0x08, 0x3a, 0x51, 0x19, 0xf2, 0x98, 0xd9, 0xfe, 0x45, 0xdb, 0x4f,
+	0xc5, 0x28, 0xd8, 0x5e, 0x55, 0x1f, 0xd3, 0xf0, 0x3e, 0x03, 0x58, 0x7f, 0x88, 0xc7, 0x38, 0x15,
+	0x68, 0x13, 0xfe, 0x2f, 0x28, 0x23, 0x03, 0xca, 0x70, 0x94, 0x50, 0xe2, 0x80, 0x4e, 0xb5, 0xdb,
+	0xbc, 0xd6, 0xf1, 0xcf, 0x30, 0xe8, 0x6f, 0x50, 0x46, 0xee, 0x18, 0x5c, 0xff, 0xf2, 0xf1, 0x41,
+	0xfb, 0x52, 0x81, 0xd3, 0xa4, 0xe7, 0x4d, 0x9f, 0xbf, 0xca, 0xd3, 0x58, 0xd2, 0x34, 0x93, 0x85,
+	0x17, 0x36, 0xc5, 0x09, 0x1e, 0x3d, 0x85, 0x2d, 0x42, 0x37, 0x71, 0x9e, 0xc8, 0xc1, 0x29, 0xbd,
+	0x99, 0x0e, 0xe8, 0x36, 0xfa, 0x8b, 0xc7, 0x07, 0xed, 0x2b, 0x86, 0xed, 0x2c, 0xd4, 0x34, 0x2b,
+	0xb2, 0x80, 0x29, 0x33, 0xbd, 0xd9, 0xd7, 0xbb, 0xed, 0x8a, 0x77, 0x0f, 0x36, 0xa7, 0x36, 0x51,
+	0x0b, 0xd6, 0x08, 0x65, 0x3c, 0x75, 0x40, 0x07, 0x74, 0xe7, 0x42, 0x53, 0x20, 0x07, 0xfe, 0x77,
+	0x4a, 0x3a, 0x2c, 0xcb, 0x5e, 0x43, 0x91, 0xfc, 0xd8, 0x6d, 0x03, 0xef, 0x0d, 0x80, 0xb5, 0x75,
+	0x96, 0xe5, 0x52, 0xa1, 0x31, 0x21, 0x63, 0x2a, 0x84, 0x65, 0x29, 0x4b, 0x84, 0x61, 0x4d, 0x25,
+	0x2d, 0x9c, 0x19, 0x1d, 0xd8, 0xfc, 0x49, 0x60, 0x82, 0x4e, 0x02, 0x5b, 0xe3, 0x31, 0xeb, 0xaf,
+	0xec, 0x1d, 0xb4, 0x2b, 0x6f, 0xbf, 0xb6, 0xbb, 0xa3, 0x58, 0x3e, 0xcb, 0x23, 0x7f, 0xc8, 0x53,
+	0x7b, 0x8d, 0xf6, 0xb3, 0x2c, 0xc8, 0x56, 0x20, 0x8b, 0x8c, 0x0a, 0x7d, 0x40, 0x84, 0x86, 0xb9,
+	0xd7, 0x7a, 0x61, 0x0c, 0x55, 0x9e, 0x7f, 0x7f, 0xb7, 0x54, 0x0a, 0x7b, 0x2f, 0x01, 0xac, 0x3f,
+	0xc8, 0xe5, 0x3f, 0x77, 0xd7, 0x28, 0xdd, 0x79, 0xef, 0x01, 0xac, 0x6f, 0xe4, 0x59, 0x96, 0x14,
+	0x4a, 0x57, 0x72, 0x89, 0x13, 0x07, 0xfc, 0x05, 0x5d, 0xcd, 0xdc, 0xbb, 0x6b, 0x75, 0xc1, 0xa7,
+	0x0f, 0xcb, 0x37, 0x97, 0x7e, 0x7b, 0x7a, 0xc7, 0xbc, 0xbf, 0x84, 0x8e, 0xf0, 0xb0, 0x08, 0xb6,
+	0x57, 0x6e, 0xac, 0xf8, 0xc6, 0xe7, 0xba, 0x03, 0xbc, 0xc7, 0x70, 0xee, 0xb6, 0x9a, 0x88, 0x47,
+	0x2c, 0x96, 0xbf, 0x98, 0x95, 0x05, 0xd8, 0xa0, 0x3b, 0x19, 0x67, 0x94, 0x49, 0x3d, 0x2c, 0xe7,
+	0xc2, 0x49, 0xad, 0xb3, 0x4f, 0x62, 0x2c, 0xa8, 0x70, 0xaa, 0x9d, 0xaa, 0xce, 0xde, 0x94, 0xde,
+	0x47, 0x00, 0x1b, 0xf7, 0xa9, 0xc4, 0x04, 0x4b, 0x8c, 0x3a, 0xb0, 0x49, 0xa8, 0x18, 0x8e, 0xe3,
+	0x4c, 0xc6, 0x9c, 0x59, 0xfa, 0xe9, 0x2d, 0x74, 0x4b, 0x21, 0x18, 0x4f, 0x07, 0x39, 0x8b, 0x65,
+	0x79, 0x61, 0xee, 0x99, 0xef, 0x6f, 0xe2, 0x37, 0x84, 0xa4, 0x5c, 0x0a, 0x84, 0xe0, 0xac, 0x8a,
+	0xd7, 0xa9, 0x6a, 0x6e, 0xbd, 0x56, 0xee, 0x48, 0x2c, 0xb2, 0x04, 0x17, 0xce, 0xac, 0x99, 0x0c,
+	0x5b, 0x2a, 0x34, 0xc3, 0x29, 0x75, 0x6a, 0x06, 0xad, 0xd6, 0xe8, 0x02, 0xac, 0x8b, 0x22, 0x8d,
+	0x78, 0xe2, 0xd4, 0xf5, 0xae, 0xad, 0xfa, 0x6b, 0x7b, 0x87, 0x2e, 0xd8, 0x3f, 0x74, 0xc1, 0xb7,
+	0x43, 0x17, 0xbc, 0x3a, 0x72, 0x2b, 0xfb, 0x47, 0x6e, 0xe5, 0xcb, 0x91, 0x5b, 0x79, 0xb2, 0xf8,
+	0x27, 0xb9, 0xeb, 0xcb, 0x8b, 0xea, 0xfa, 0x97, 0x73, 0xfd, 0xe7, 0x00, 0xd8, 0xdf, 0xae, 0x31,
+	0x13, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -55,3 +56,25 @@ func AddressFromBalancesStore(key []byte) (sdk.AccAddress, error) {
 func CreateAccountBalancesPrefix(addr []byte) []byte {
 	return append(BalancesPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// KeyFormats are the layouts of the keys of the bank store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "supply",
+		Prefix:   SupplyKey,
+		Segments: []keyformat.Segment{keyformat.String("denom")},
+		Value:    keyformat.SegmentValue(keyformat.String("amount")),
+	},
+	{
+		Name:     "denom_metadata",
+		Prefix:   DenomMetadataPrefix,
+		Segments: []keyformat.Segment{keyformat.String("denom")},
+		Value:    keyformat.ProtoValue(&Metadata{}),
+	},
+	{
+		Name:     "balance",
+		Prefix:   BalancesPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("address"), keyformat.String("denom")},
+		Value:    keyformat.ProtoValue(&sdk.Coin{}),
+	},
+}
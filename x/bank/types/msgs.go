@@ -3,12 +3,14 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend         = "send"
+	TypeMsgMultiSend    = "multisend"
+	TypeMsgUpdateParams = "update_params"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -55,11 +57,7 @@ func (msg MsgSend) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgSend) GetSigners() []sdk.AccAddress {
-	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{from}
+	return msgservice.MustGetSigners(&msg)
 }
 
 var _ sdk.Msg = &MsgMultiSend{}
@@ -97,13 +95,7 @@ func (msg MsgMultiSend) GetSignBytes() []byte {
 
 // GetSigners Implements Msg.
 func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
-	addrs := make([]sdk.AccAddress, len(msg.Inputs))
-	for i, in := range msg.Inputs {
-		addr, _ := sdk.AccAddressFromBech32(in.Address)
-		addrs[i] = addr
-	}
-
-	return addrs
+	return msgservice.MustGetSigners(&msg)
 }
 
 // ValidateBasic - validate transaction input
@@ -151,6 +143,40 @@ func (out Output) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams - construct a msg to update the bank parameters with the
+// authority of the module.
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority.String(), Params: params}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
 // NewOutput - create a transaction output, used with MsgMultiSend
 //nolint:interfacer
 func NewOutput(addr sdk.AccAddress, coins sdk.Coins) Output {
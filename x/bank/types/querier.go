@@ -13,6 +13,10 @@ const (
 	QuerySupplyOf    = "supply_of"
 )
 
+// MaxBalancesByAddresses is the maximum number of addresses of a
+// QueryBalancesByAddressesRequest.
+const MaxBalancesByAddresses = 1000
+
 // NewQueryBalanceRequest creates a new instance of QueryBalanceRequest.
 //nolint:interfacer
 func NewQueryBalanceRequest(addr sdk.AccAddress, denom string) *QueryBalanceRequest {
@@ -25,6 +29,17 @@ func NewQueryAllBalancesRequest(addr sdk.AccAddress, req *query.PageRequest) *Qu
 	return &QueryAllBalancesRequest{Address: addr.String(), Pagination: req}
 }
 
+// NewQueryBalancesByAddressesRequest creates a new instance of
+// QueryBalancesByAddressesRequest.
+func NewQueryBalancesByAddressesRequest(addrs []sdk.AccAddress, denoms []string) *QueryBalancesByAddressesRequest {
+	addresses := make([]string, len(addrs))
+	for i, addr := range addrs {
+		addresses[i] = addr.String()
+	}
+
+	return &QueryBalancesByAddressesRequest{Addresses: addresses, Denoms: denoms}
+}
+
 // QueryTotalSupplyParams defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
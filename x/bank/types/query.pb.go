@@ -216,6 +216,163 @@ func (m *QueryAllBalancesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryBalancesByAddressesRequest is the request type for the
+// Query/BalancesByAddresses RPC method.
+type QueryBalancesByAddressesRequest struct {
+	// addresses are the addresses to query balances for.
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// denoms are the optional coin denoms to query balances for, all the coins
+	// being queried if empty.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryBalancesByAddressesRequest) Reset()         { *m = QueryBalancesByAddressesRequest{} }
+func (m *QueryBalancesByAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesByAddressesRequest) ProtoMessage()    {}
+func (*QueryBalancesByAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{4}
+}
+func (m *QueryBalancesByAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesByAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesByAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesByAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesByAddressesRequest.Merge(m, src)
+}
+func (m *QueryBalancesByAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesByAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesByAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesByAddressesRequest proto.InternalMessageInfo
+
+// QueryBalancesByAddressesResponse is the response type for the
+// Query/BalancesByAddresses RPC method.
+type QueryBalancesByAddressesResponse struct {
+	// balances are the balances of the addresses, in the order of the request.
+	Balances []AddressBalances `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *QueryBalancesByAddressesResponse) Reset()         { *m = QueryBalancesByAddressesResponse{} }
+func (m *QueryBalancesByAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalancesByAddressesResponse) ProtoMessage()    {}
+func (*QueryBalancesByAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{5}
+}
+func (m *QueryBalancesByAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalancesByAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalancesByAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBalancesByAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalancesByAddressesResponse.Merge(m, src)
+}
+func (m *QueryBalancesByAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalancesByAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalancesByAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBalancesByAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryBalancesByAddressesResponse) GetBalances() []AddressBalances {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// AddressBalances defines the balances of an address queried by
+// Query/BalancesByAddresses.
+type AddressBalances struct {
+	// address is the queried address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// balances are the balances of the address, including the zero balances of
+	// the queried denoms.
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// error is the reason why the balances of the address could not be queried,
+	// empty on success.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AddressBalances) Reset()         { *m = AddressBalances{} }
+func (m *AddressBalances) String() string { return proto.CompactTextString(m) }
+func (*AddressBalances) ProtoMessage()    {}
+func (*AddressBalances) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{6}
+}
+func (m *AddressBalances) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressBalances) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressBalances.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressBalances) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBalances.Merge(m, src)
+}
+func (m *AddressBalances) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressBalances) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBalances.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBalances proto.InternalMessageInfo
+
+func (m *AddressBalances) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressBalances) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *AddressBalances) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // QueryTotalSupplyRequest is the request type for the Query/TotalSupply RPC
 // method.
 type QueryTotalSupplyRequest struct {
@@ -229,7 +386,7 @@ func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest
 func (m *QueryTotalSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyRequest) ProtoMessage()    {}
 func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{4}
+	return fileDescriptor_9c6fc1939682df13, []int{7}
 }
 func (m *QueryTotalSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +430,7 @@ func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyRespon
 func (m *QueryTotalSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyResponse) ProtoMessage()    {}
 func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{5}
+	return fileDescriptor_9c6fc1939682df13, []int{8}
 }
 func (m *QueryTotalSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -326,7 +483,7 @@ func (m *QuerySupplyOfRequest) Reset()         { *m = QuerySupplyOfRequest{} }
 func (m *QuerySupplyOfRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyOfRequest) ProtoMessage()    {}
 func (*QuerySupplyOfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{6}
+	return fileDescriptor_9c6fc1939682df13, []int{9}
 }
 func (m *QuerySupplyOfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +529,7 @@ func (m *QuerySupplyOfResponse) Reset()         { *m = QuerySupplyOfResponse{} }
 func (m *QuerySupplyOfResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyOfResponse) ProtoMessage()    {}
 func (*QuerySupplyOfResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{7}
+	return fileDescriptor_9c6fc1939682df13, []int{10}
 }
 func (m *QuerySupplyOfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +573,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{8}
+	return fileDescriptor_9c6fc1939682df13, []int{11}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,13 +605,15 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 // QueryParamsResponse defines the response type for querying x/bank parameters.
 type QueryParamsResponse struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_changed_height is the height at which the parameters last changed.
+	LastChangedHeight int64 `protobuf:"varint,2,opt,name=last_changed_height,json=lastChangedHeight,proto3" json:"last_changed_height,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{9}
+	return fileDescriptor_9c6fc1939682df13, []int{12}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +649,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangedHeight() int64 {
+	if m != nil {
+		return m.LastChangedHeight
+	}
+	return 0
+}
+
 // QueryDenomsMetadataRequest is the request type for the Query/DenomsMetadata RPC method.
 type QueryDenomsMetadataRequest struct {
 	// pagination defines an optional pagination for the request.
@@ -500,7 +666,7 @@ func (m *QueryDenomsMetadataRequest) Reset()         { *m = QueryDenomsMetadataR
 func (m *QueryDenomsMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomsMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{10}
+	return fileDescriptor_9c6fc1939682df13, []int{13}
 }
 func (m *QueryDenomsMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,7 +715,7 @@ func (m *QueryDenomsMetadataResponse) Reset()         { *m = QueryDenomsMetadata
 func (m *QueryDenomsMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomsMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomsMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{11}
+	return fileDescriptor_9c6fc1939682df13, []int{14}
 }
 func (m *QueryDenomsMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -602,7 +768,7 @@ func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataReq
 func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{12}
+	return fileDescriptor_9c6fc1939682df13, []int{15}
 }
 func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +815,7 @@ func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataRe
 func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{13}
+	return fileDescriptor_9c6fc1939682df13, []int{16}
 }
 func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,6 +856,9 @@ func init() {
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
 	proto.RegisterType((*QueryAllBalancesRequest)(nil), "cosmos.bank.v1beta1.QueryAllBalancesRequest")
 	proto.RegisterType((*QueryAllBalancesResponse)(nil), "cosmos.bank.v1beta1.QueryAllBalancesResponse")
+	proto.RegisterType((*QueryBalancesByAddressesRequest)(nil), "cosmos.bank.v1beta1.QueryBalancesByAddressesRequest")
+	proto.RegisterType((*QueryBalancesByAddressesResponse)(nil), "cosmos.bank.v1beta1.QueryBalancesByAddressesResponse")
+	proto.RegisterType((*AddressBalances)(nil), "cosmos.bank.v1beta1.AddressBalances")
 	proto.RegisterType((*QueryTotalSupplyRequest)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyRequest")
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "cosmos.bank.v1beta1.QueryTotalSupplyResponse")
 	proto.RegisterType((*QuerySupplyOfRequest)(nil), "cosmos.bank.v1beta1.QuerySupplyOfRequest")
@@ -705,59 +874,69 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6b, 0x13, 0x5b,
-	0x18, 0xce, 0xe9, 0xbd, 0x4d, 0xd3, 0x37, 0xdc, 0xbb, 0x38, 0xcd, 0xe5, 0xa6, 0xd3, 0xdb, 0xe4,
-	0x32, 0xd5, 0x36, 0xad, 0xe9, 0x4c, 0x9b, 0x0a, 0x7e, 0x6c, 0xa4, 0xa9, 0xe8, 0x42, 0xa4, 0x31,
-	0xba, 0x12, 0xa4, 0x9c, 0x24, 0xe3, 0x18, 0x9a, 0xcc, 0x99, 0xe6, 0x4c, 0xc4, 0x50, 0x0a, 0x22,
-	0x08, 0xae, 0x54, 0x70, 0x23, 0xb8, 0xa9, 0x1b, 0x41, 0xb7, 0xfe, 0x89, 0x2e, 0x5c, 0x14, 0xdc,
-	0xb8, 0x52, 0x69, 0x5d, 0xf8, 0x33, 0x24, 0xe7, 0x23, 0x9d, 0x24, 0xd3, 0x64, 0x16, 0x71, 0x95,
-	0x99, 0x77, 0xde, 0x8f, 0xe7, 0x79, 0xce, 0xbc, 0xcf, 0x04, 0xd2, 0x65, 0xca, 0xea, 0x94, 0x99,
-	0x25, 0xe2, 0x6c, 0x9b, 0x0f, 0x57, 0x4b, 0x96, 0x47, 0x56, 0xcd, 0x9d, 0xa6, 0xd5, 0x68, 0x19,
-	0x6e, 0x83, 0x7a, 0x14, 0x4f, 0x89, 0x04, 0xa3, 0x9d, 0x60, 0xc8, 0x04, 0x6d, 0xa9, 0x53, 0xc5,
-	0x2c, 0x91, 0xdd, 0xa9, 0x75, 0x89, 0x5d, 0x75, 0x88, 0x57, 0xa5, 0x8e, 0x68, 0xa0, 0x25, 0x6c,
-	0x6a, 0x53, 0x7e, 0x69, 0xb6, 0xaf, 0x64, 0xf4, 0x3f, 0x9b, 0x52, 0xbb, 0x66, 0x99, 0xc4, 0xad,
-	0x9a, 0xc4, 0x71, 0xa8, 0xc7, 0x4b, 0x98, 0x7c, 0x9a, 0xf2, 0xf7, 0x57, 0x9d, 0xcb, 0xb4, 0xea,
-	0xf4, 0x3d, 0xf7, 0xa1, 0xe6, 0x08, 0xf9, 0x73, 0x7d, 0x13, 0xa6, 0x6e, 0xb5, 0x51, 0xe5, 0x49,
-	0x8d, 0x38, 0x65, 0xab, 0x68, 0xed, 0x34, 0x2d, 0xe6, 0xe1, 0x24, 0x4c, 0x90, 0x4a, 0xa5, 0x61,
-	0x31, 0x96, 0x44, 0xff, 0xa3, 0xcc, 0x64, 0x51, 0xdd, 0xe2, 0x04, 0x8c, 0x57, 0x2c, 0x87, 0xd6,
-	0x93, 0x63, 0x3c, 0x2e, 0x6e, 0x2e, 0xc7, 0x9e, 0xed, 0xa7, 0x23, 0x3f, 0xf7, 0xd3, 0x11, 0xfd,
-	0x06, 0x24, 0xba, 0x1b, 0x32, 0x97, 0x3a, 0xcc, 0xc2, 0x6b, 0x30, 0x51, 0x12, 0x21, 0xde, 0x31,
-	0x9e, 0x9b, 0x36, 0x3a, 0x7a, 0x31, 0x4b, 0xe9, 0x65, 0x6c, 0xd0, 0xaa, 0x53, 0x54, 0x99, 0xfa,
-	0x53, 0x04, 0xff, 0xf2, 0x6e, 0xeb, 0xb5, 0x9a, 0x6c, 0xc8, 0x86, 0x43, 0xbc, 0x06, 0x70, 0xa2,
-	0x2d, 0xc7, 0x19, 0xcf, 0xcd, 0x77, 0x4d, 0x13, 0xc7, 0xa6, 0x66, 0x16, 0x88, 0xad, 0x88, 0x17,
-	0x7d, 0x95, 0x3e, 0x52, 0x9f, 0x10, 0x24, 0xfb, 0x71, 0x48, 0x66, 0x36, 0xc4, 0x24, 0xde, 0x36,
-	0x92, 0x3f, 0x06, 0x52, 0xcb, 0xaf, 0x1c, 0x7c, 0x4d, 0x47, 0x3e, 0x7c, 0x4b, 0x67, 0xec, 0xaa,
-	0xf7, 0xa0, 0x59, 0x32, 0xca, 0xb4, 0x6e, 0xca, 0x23, 0x12, 0x3f, 0xcb, 0xac, 0xb2, 0x6d, 0x7a,
-	0x2d, 0xd7, 0x62, 0xbc, 0x80, 0x15, 0x3b, 0xcd, 0xf1, 0xf5, 0x00, 0x5e, 0x0b, 0x43, 0x79, 0x09,
-	0x94, 0x7e, 0x62, 0xfa, 0xb6, 0x54, 0xf5, 0x0e, 0xf5, 0x48, 0xed, 0x76, 0xd3, 0x75, 0x6b, 0x2d,
-	0xa5, 0x6a, 0xb7, 0x76, 0x68, 0x04, 0xda, 0x1d, 0x28, 0xed, 0xba, 0xa6, 0x49, 0xed, 0xca, 0x10,
-	0x65, 0x3c, 0xf2, 0x3b, 0x94, 0x93, 0xad, 0x47, 0xa7, 0x5b, 0x56, 0xbe, 0xdb, 0x82, 0xc4, 0xe6,
-	0x7d, 0x25, 0x5a, 0x67, 0x27, 0x90, 0x6f, 0x27, 0xf4, 0x02, 0xfc, 0xd3, 0x93, 0x2d, 0x49, 0x5f,
-	0x80, 0x28, 0xa9, 0xd3, 0xa6, 0xe3, 0x0d, 0xdd, 0x84, 0xfc, 0x9f, 0x6d, 0xd2, 0x45, 0x99, 0xae,
-	0x27, 0x00, 0xf3, 0x8e, 0x05, 0xd2, 0x20, 0x75, 0xb5, 0x08, 0x7a, 0x41, 0xae, 0xb0, 0x8a, 0xca,
-	0x29, 0x97, 0x20, 0xea, 0xf2, 0x88, 0x9c, 0x32, 0x63, 0x04, 0xf8, 0x93, 0x21, 0x8a, 0xd4, 0x1c,
-	0x51, 0xa0, 0x57, 0x40, 0xe3, 0x1d, 0xaf, 0xb6, 0x79, 0xb0, 0x9b, 0x96, 0x47, 0x2a, 0xc4, 0x23,
-	0x23, 0x7e, 0x45, 0xf4, 0xf7, 0x08, 0x66, 0x02, 0xc7, 0x48, 0x02, 0xeb, 0x30, 0x59, 0x97, 0x31,
-	0xb5, 0x58, 0xb3, 0x81, 0x1c, 0x54, 0xa5, 0x64, 0x71, 0x52, 0x35, 0xba, 0x93, 0x5f, 0x85, 0xe9,
-	0x13, 0xa8, 0xbd, 0x82, 0x04, 0x1f, 0xff, 0x3d, 0xbf, 0x88, 0x7d, 0xe4, 0xae, 0x40, 0x4c, 0xc1,
-	0x94, 0x12, 0x86, 0xe2, 0xd6, 0x29, 0xca, 0x7d, 0x8c, 0xc1, 0x38, 0xef, 0x8f, 0x5f, 0x23, 0x98,
-	0x90, 0xa6, 0x84, 0x33, 0x81, 0x4d, 0x02, 0x1c, 0x5e, 0x5b, 0x0c, 0x91, 0x29, 0xb0, 0xea, 0x17,
-	0x9f, 0x7c, 0xfe, 0xf1, 0x6a, 0x2c, 0x87, 0x57, 0xcc, 0xe0, 0x8f, 0x89, 0xb0, 0x27, 0x73, 0x57,
-	0xfa, 0xef, 0x9e, 0x59, 0x6a, 0x6d, 0x71, 0x0d, 0xf0, 0x1b, 0x04, 0x71, 0x9f, 0x65, 0xe2, 0xec,
-	0xe9, 0x43, 0xfb, 0x1d, 0x5e, 0x5b, 0x0e, 0x99, 0x2d, 0x61, 0x9a, 0x1c, 0xe6, 0x22, 0x5e, 0x08,
-	0x09, 0x13, 0xbf, 0x40, 0x10, 0xf7, 0x99, 0xd2, 0x20, 0x74, 0xfd, 0x4e, 0x39, 0x08, 0x5d, 0x80,
-	0xd3, 0xe9, 0x73, 0x1c, 0xdd, 0x2c, 0x9e, 0x09, 0x44, 0x27, 0x9d, 0xea, 0x39, 0x82, 0x98, 0xb2,
-	0x0b, 0x3c, 0xe0, 0x84, 0x7a, 0x0c, 0x48, 0x5b, 0x0a, 0x93, 0x2a, 0x81, 0x9c, 0xe3, 0x40, 0xce,
-	0xe2, 0xb9, 0x01, 0x40, 0xcc, 0x5d, 0x7e, 0x7e, 0x7b, 0xf8, 0x31, 0x82, 0xa8, 0xb0, 0x08, 0xbc,
-	0x70, 0xfa, 0x8c, 0x2e, 0x3f, 0xd2, 0x32, 0xc3, 0x13, 0x43, 0x69, 0x22, 0xcc, 0x08, 0xbf, 0x43,
-	0xf0, 0x57, 0xd7, 0x0e, 0x61, 0xe3, 0xf4, 0x01, 0x41, 0xfb, 0xa9, 0x99, 0xa1, 0xf3, 0x25, 0xae,
-	0xf3, 0x1c, 0x97, 0x81, 0xb3, 0x81, 0xb8, 0xb8, 0x34, 0x6c, 0x4b, 0x6d, 0x62, 0x47, 0xab, 0xb7,
-	0x08, 0xfe, 0xee, 0xb6, 0x32, 0x3c, 0x6c, 0x72, 0xaf, 0xb7, 0x6a, 0x2b, 0xe1, 0x0b, 0x24, 0xd6,
-	0x2c, 0xc7, 0x3a, 0x8f, 0xcf, 0x84, 0xc1, 0x9a, 0xdf, 0x38, 0x38, 0x4a, 0xa1, 0xc3, 0xa3, 0x14,
-	0xfa, 0x7e, 0x94, 0x42, 0x2f, 0x8f, 0x53, 0x91, 0xc3, 0xe3, 0x54, 0xe4, 0xcb, 0x71, 0x2a, 0x72,
-	0x77, 0x71, 0xe0, 0x67, 0xf5, 0x91, 0x68, 0xcb, 0xbf, 0xae, 0xa5, 0x28, 0xff, 0xeb, 0xb8, 0xf6,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x6f, 0x29, 0xd7, 0x12, 0x0b, 0x00, 0x00,
+	// 984 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x24, 0xc4, 0x89, 0x5f, 0x04, 0x88, 0xb1, 0x01, 0x77, 0xd3, 0xda, 0xd5, 0xb6, 0x34,
+	0x7f, 0x70, 0x76, 0x13, 0xb7, 0x88, 0x3f, 0x17, 0x94, 0x04, 0x15, 0x24, 0x84, 0x1a, 0x0c, 0x27,
+	0x24, 0x64, 0x8d, 0xed, 0x61, 0x63, 0x62, 0xef, 0xb8, 0x9e, 0x35, 0xc2, 0xaa, 0x2a, 0x55, 0x48,
+	0x48, 0x9c, 0x00, 0x89, 0x0b, 0x12, 0x97, 0x72, 0x41, 0xa2, 0x1f, 0x80, 0xcf, 0x90, 0x03, 0x87,
+	0x4a, 0x5c, 0x38, 0x01, 0x4a, 0x38, 0x70, 0xe0, 0x43, 0x20, 0xcf, 0xbc, 0x59, 0xef, 0xda, 0x1b,
+	0x7b, 0x91, 0xd2, 0x53, 0x3c, 0x33, 0xef, 0xcf, 0xef, 0xfd, 0xe6, 0xcd, 0xef, 0x6d, 0xa0, 0xdc,
+	0x14, 0xb2, 0x2b, 0xa4, 0xdb, 0x60, 0xfe, 0xb1, 0xfb, 0xd9, 0x6e, 0x83, 0x07, 0x6c, 0xd7, 0xbd,
+	0x3b, 0xe0, 0xfd, 0xa1, 0xd3, 0xeb, 0x8b, 0x40, 0xd0, 0xbc, 0x36, 0x70, 0x46, 0x06, 0x0e, 0x1a,
+	0x58, 0x5b, 0xa1, 0x97, 0xe4, 0xda, 0x3a, 0xf4, 0xed, 0x31, 0xaf, 0xed, 0xb3, 0xa0, 0x2d, 0x7c,
+	0x1d, 0xc0, 0x2a, 0x78, 0xc2, 0x13, 0xea, 0xa7, 0x3b, 0xfa, 0x85, 0xbb, 0x97, 0x3d, 0x21, 0xbc,
+	0x0e, 0x77, 0x59, 0xaf, 0xed, 0x32, 0xdf, 0x17, 0x81, 0x72, 0x91, 0x78, 0x5a, 0x8a, 0xc6, 0x37,
+	0x91, 0x9b, 0xa2, 0xed, 0x4f, 0x9d, 0x47, 0x50, 0x8f, 0x16, 0xfa, 0xdc, 0xbe, 0x03, 0xf9, 0xf7,
+	0x47, 0xa8, 0xf6, 0x59, 0x87, 0xf9, 0x4d, 0x5e, 0xe3, 0x77, 0x07, 0x5c, 0x06, 0xb4, 0x08, 0xcb,
+	0xac, 0xd5, 0xea, 0x73, 0x29, 0x8b, 0xe4, 0x2a, 0xd9, 0xc8, 0xd5, 0xcc, 0x92, 0x16, 0x60, 0xa9,
+	0xc5, 0x7d, 0xd1, 0x2d, 0x2e, 0xa8, 0x7d, 0xbd, 0x78, 0x63, 0xe5, 0xab, 0x87, 0xe5, 0xcc, 0x3f,
+	0x0f, 0xcb, 0x19, 0xfb, 0x5d, 0x28, 0xc4, 0x03, 0xca, 0x9e, 0xf0, 0x25, 0xa7, 0x37, 0x61, 0xb9,
+	0xa1, 0xb7, 0x54, 0xc4, 0xd5, 0xea, 0x25, 0x27, 0xe4, 0x4b, 0x72, 0xc3, 0x97, 0x73, 0x20, 0xda,
+	0x7e, 0xcd, 0x58, 0xda, 0x5f, 0x12, 0x78, 0x51, 0x45, 0xdb, 0xeb, 0x74, 0x30, 0xa0, 0x9c, 0x0f,
+	0xf1, 0x36, 0xc0, 0x98, 0x5b, 0x85, 0x73, 0xb5, 0x7a, 0x23, 0x96, 0x4d, 0x5f, 0x9b, 0xc9, 0x79,
+	0xc8, 0x3c, 0x53, 0x78, 0x2d, 0xe2, 0x19, 0x29, 0xea, 0x57, 0x02, 0xc5, 0x69, 0x1c, 0x58, 0x99,
+	0x07, 0x2b, 0x88, 0x77, 0x84, 0x64, 0x71, 0x66, 0x69, 0xfb, 0x3b, 0x27, 0x7f, 0x94, 0x33, 0x8f,
+	0xfe, 0x2c, 0x6f, 0x78, 0xed, 0xe0, 0x68, 0xd0, 0x70, 0x9a, 0xa2, 0xeb, 0xe2, 0x15, 0xe9, 0x3f,
+	0xdb, 0xb2, 0x75, 0xec, 0x06, 0xc3, 0x1e, 0x97, 0xca, 0x41, 0xd6, 0xc2, 0xe0, 0xf4, 0xed, 0x84,
+	0xba, 0xd6, 0xe7, 0xd6, 0xa5, 0x51, 0x46, 0x0b, 0xb3, 0x19, 0x94, 0xa3, 0x77, 0x24, 0xf7, 0x87,
+	0x7b, 0x9a, 0xba, 0x31, 0xbb, 0x97, 0x21, 0xc7, 0xcc, 0x9e, 0xaa, 0x2a, 0x57, 0x1b, 0x6f, 0xd0,
+	0x17, 0x20, 0xab, 0xee, 0x5d, 0x16, 0x17, 0xd4, 0x11, 0xae, 0x22, 0x8c, 0x7d, 0x0a, 0x57, 0xcf,
+	0x4f, 0x81, 0xc4, 0xdd, 0x9e, 0x22, 0xee, 0xba, 0x93, 0xf0, 0x86, 0x1c, 0xf4, 0x0c, 0x43, 0x3d,
+	0x35, 0xe2, 0x70, 0xcc, 0x8b, 0xfd, 0x88, 0xc0, 0xb3, 0x13, 0x36, 0x33, 0xba, 0x23, 0x7a, 0x5d,
+	0x0b, 0x4f, 0xf2, 0xba, 0x0a, 0xb0, 0xc4, 0xfb, 0x7d, 0xd1, 0x2f, 0x2e, 0xea, 0x97, 0xa2, 0x16,
+	0xf6, 0x31, 0x76, 0xf4, 0x87, 0x22, 0x60, 0x9d, 0x0f, 0x06, 0xbd, 0x5e, 0x67, 0x68, 0x38, 0x8f,
+	0xf7, 0x2d, 0xb9, 0x80, 0xbe, 0x3d, 0x31, 0x7d, 0x1b, 0xcb, 0x86, 0xf4, 0x37, 0x21, 0x2b, 0xd5,
+	0xce, 0x93, 0xe8, 0x5a, 0x0c, 0x7d, 0x71, 0x3d, 0x5b, 0x41, 0x5d, 0xd1, 0x45, 0xdc, 0xf9, 0xc4,
+	0x90, 0x16, 0xea, 0x11, 0x89, 0xe8, 0x91, 0x7d, 0x08, 0xcf, 0x4f, 0x58, 0x63, 0xd1, 0xaf, 0x42,
+	0x96, 0x75, 0xc5, 0xc0, 0x0f, 0xe6, 0xaa, 0x10, 0xb6, 0x19, 0x9a, 0xdb, 0x05, 0xa0, 0x2a, 0xe2,
+	0x21, 0xeb, 0xb3, 0xae, 0x79, 0x26, 0xf6, 0x03, 0x02, 0xf9, 0xd8, 0x36, 0xa6, 0x79, 0x1d, 0xb2,
+	0x3d, 0xb5, 0x83, 0x69, 0xd6, 0x12, 0x1b, 0x5b, 0x3b, 0x99, 0x44, 0xda, 0x81, 0x3a, 0x90, 0xef,
+	0x30, 0x19, 0xd4, 0x9b, 0x47, 0xcc, 0xf7, 0x78, 0xab, 0x7e, 0xc4, 0xdb, 0xde, 0x51, 0xa0, 0xa8,
+	0x5b, 0xac, 0x3d, 0x37, 0x3a, 0x3a, 0xd0, 0x27, 0xef, 0xa8, 0x03, 0xbb, 0x05, 0x96, 0x42, 0xf0,
+	0x96, 0x7a, 0x82, 0xef, 0xf1, 0x80, 0xb5, 0x58, 0xc0, 0x2e, 0xb8, 0xa7, 0xec, 0x9f, 0x09, 0xac,
+	0x25, 0xa6, 0xc1, 0x82, 0xf7, 0x20, 0xd7, 0xc5, 0x3d, 0xf3, 0x98, 0xaf, 0x24, 0xd6, 0x6c, 0x3c,
+	0xb1, 0xea, 0xb1, 0xd7, 0xc5, 0xb5, 0xca, 0x2e, 0x5c, 0x1a, 0x43, 0x9d, 0x24, 0x24, 0xb9, 0x5f,
+	0x3e, 0x06, 0x2b, 0xc9, 0x05, 0x8b, 0x7b, 0x13, 0x56, 0x0c, 0x4c, 0xa4, 0x30, 0x55, 0x6d, 0xa1,
+	0x53, 0xf5, 0xdf, 0x1c, 0x2c, 0xa9, 0xf8, 0xf4, 0x7b, 0x02, 0xcb, 0x28, 0x52, 0x74, 0x23, 0x31,
+	0x48, 0xc2, 0x38, 0xb6, 0x36, 0x53, 0x58, 0x6a, 0xac, 0xf6, 0x6b, 0x5f, 0xfc, 0xf6, 0xf7, 0x77,
+	0x0b, 0x55, 0xba, 0xe3, 0x26, 0x4f, 0x7e, 0x65, 0x2d, 0xdd, 0x7b, 0x28, 0x87, 0xf7, 0xdd, 0xc6,
+	0xb0, 0xae, 0x38, 0xa0, 0x3f, 0x10, 0x58, 0x8d, 0xcc, 0x37, 0x5a, 0x39, 0x3f, 0xe9, 0xf4, 0x38,
+	0xb6, 0xb6, 0x53, 0x5a, 0x23, 0x4c, 0x57, 0xc1, 0xdc, 0xa4, 0xeb, 0x29, 0x61, 0xd2, 0x5f, 0x08,
+	0xe4, 0x13, 0x86, 0x09, 0xbd, 0x35, 0x97, 0x9a, 0x84, 0xf1, 0x66, 0xbd, 0xf2, 0x3f, 0xbd, 0x10,
+	0x75, 0x55, 0xa1, 0xae, 0xd0, 0xad, 0x99, 0xa8, 0xeb, 0x8d, 0x61, 0x7d, 0x3c, 0x2b, 0xbf, 0x21,
+	0xb0, 0x1a, 0x91, 0xdf, 0x59, 0xb4, 0x4e, 0xcf, 0x04, 0x6b, 0x3b, 0xa5, 0x35, 0x02, 0xbc, 0xa6,
+	0x00, 0x5e, 0xa1, 0x6b, 0x89, 0x00, 0x51, 0x93, 0xbf, 0x26, 0xb0, 0x62, 0x84, 0x91, 0xce, 0x68,
+	0xad, 0x09, 0xa9, 0xb5, 0xb6, 0xd2, 0x98, 0x22, 0x90, 0x97, 0x15, 0x90, 0x97, 0xe8, 0xb5, 0x19,
+	0x40, 0xdc, 0x7b, 0xaa, 0xf1, 0xee, 0xd3, 0x07, 0x04, 0xb2, 0x5a, 0x0b, 0xe9, 0xfa, 0xf9, 0x39,
+	0x62, 0xca, 0x6b, 0x6d, 0xcc, 0x37, 0x4c, 0xc5, 0x09, 0xaa, 0xee, 0x4f, 0x04, 0x9e, 0x8e, 0x3d,
+	0x7e, 0xea, 0x9c, 0x9f, 0x20, 0x49, 0x58, 0x2c, 0x37, 0xb5, 0x3d, 0xe2, 0xba, 0xa5, 0x70, 0x39,
+	0xb4, 0x92, 0x88, 0x4b, 0x7f, 0x51, 0xd5, 0x8d, 0x84, 0x84, 0x5c, 0xfd, 0x48, 0xe0, 0x99, 0xb8,
+	0x06, 0xd3, 0x79, 0x99, 0x27, 0x87, 0x82, 0xb5, 0x93, 0xde, 0x01, 0xb1, 0x56, 0x14, 0xd6, 0x1b,
+	0xf4, 0x7a, 0x1a, 0xac, 0xfb, 0x07, 0x27, 0xa7, 0x25, 0xf2, 0xf8, 0xb4, 0x44, 0xfe, 0x3a, 0x2d,
+	0x91, 0x6f, 0xcf, 0x4a, 0x99, 0xc7, 0x67, 0xa5, 0xcc, 0xef, 0x67, 0xa5, 0xcc, 0x47, 0x9b, 0x33,
+	0x3f, 0x20, 0x3e, 0xd7, 0x61, 0xd5, 0x77, 0x44, 0x23, 0xab, 0xfe, 0x41, 0xb9, 0xf9, 0xdf, 0x00,
+	0xb8, 0xd1, 0x3a, 0xec, 0x78, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -776,6 +955,9 @@ type QueryClient interface {
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
 	// AllBalances queries the balance of all coins for a single account.
 	AllBalances(ctx context.Context, in *QueryAllBalancesRequest, opts ...grpc.CallOption) (*QueryAllBalancesResponse, error)
+	// BalancesByAddresses queries the balances of a list of accounts, optionally
+	// restricted to some coins.
+	BalancesByAddresses(ctx context.Context, in *QueryBalancesByAddressesRequest, opts ...grpc.CallOption) (*QueryBalancesByAddressesResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
 	// SupplyOf queries the supply of a single coin.
@@ -814,6 +996,15 @@ func (c *queryClient) AllBalances(ctx context.Context, in *QueryAllBalancesReque
 	return out, nil
 }
 
+func (c *queryClient) BalancesByAddresses(ctx context.Context, in *QueryBalancesByAddressesRequest, opts ...grpc.CallOption) (*QueryBalancesByAddressesResponse, error) {
+	out := new(QueryBalancesByAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/BalancesByAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error) {
 	out := new(QueryTotalSupplyResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/TotalSupply", in, out, opts...)
@@ -865,6 +1056,9 @@ type QueryServer interface {
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
 	// AllBalances queries the balance of all coins for a single account.
 	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
+	// BalancesByAddresses queries the balances of a list of accounts, optionally
+	// restricted to some coins.
+	BalancesByAddresses(context.Context, *QueryBalancesByAddressesRequest) (*QueryBalancesByAddressesResponse, error)
 	// TotalSupply queries the total supply of all coins.
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
 	// SupplyOf queries the supply of a single coin.
@@ -887,6 +1081,9 @@ func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceR
 func (*UnimplementedQueryServer) AllBalances(ctx context.Context, req *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
 }
+func (*UnimplementedQueryServer) BalancesByAddresses(ctx context.Context, req *QueryBalancesByAddressesRequest) (*QueryBalancesByAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesByAddresses not implemented")
+}
 func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalSupply not implemented")
 }
@@ -943,6 +1140,24 @@ func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BalancesByAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesByAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalancesByAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/BalancesByAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalancesByAddresses(ctx, req.(*QueryBalancesByAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalSupplyRequest)
 	if err := dec(in); err != nil {
@@ -1045,6 +1260,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllBalances",
 			Handler:    _Query_AllBalances_Handler,
 		},
+		{
+			MethodName: "BalancesByAddresses",
+			Handler:    _Query_BalancesByAddresses_Handler,
+		},
 		{
 			MethodName: "TotalSupply",
 			Handler:    _Query_TotalSupply_Handler,
@@ -1233,6 +1452,135 @@ func (m *QueryAllBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBalancesByAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesByAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesByAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalancesByAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalancesByAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalancesByAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTotalSupplyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1423,6 +1771,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastChangedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1660,50 +2013,109 @@ func (m *QueryAllBalancesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryTotalSupplyRequest) Size() (n int) {
+func (m *QueryBalancesByAddressesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryTotalSupplyResponse) Size() (n int) {
+func (m *QueryBalancesByAddressesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Supply) > 0 {
-		for _, e := range m.Supply {
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QuerySupplyOfRequest) Size() (n int) {
+func (m *AddressBalances) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Denom)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyOfRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
 
 func (m *QuerySupplyOfResponse) Size() (n int) {
 	if m == nil {
@@ -1733,6 +2145,9 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangedHeight))
+	}
 	return n
 }
 
@@ -2236,6 +2651,352 @@ func (m *QueryAllBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBalancesByAddressesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesByAddressesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesByAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBalancesByAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBalancesByAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBalancesByAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, AddressBalances{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2719,6 +3480,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedHeight", wireType)
+			}
+			m.LastChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -44,6 +44,9 @@ func (a SendAuthorization) ValidateBasic() error {
 	if a.SpendLimit == nil {
 		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
 	}
+	if err := a.SpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
 	if !a.SpendLimit.IsAllPositive() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit cannot be negitive")
 	}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the cli query commands for the capability module.
+func GetQueryCmd() *cobra.Command {
+	capabilityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	capabilityQueryCmd.AddCommand(
+		GetCmdQueryOwners(),
+		GetCmdQueryAllOwners(),
+		GetCmdQueryModuleCapabilities(),
+	)
+
+	return capabilityQueryCmd
+}
+
+// GetCmdQueryOwners implements a command to return the owners of the
+// capability of an index.
+func GetCmdQueryOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owners [index]",
+		Short: "Query the owners of the capability of an index",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid capability index %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.Owners(cmd.Context(), &types.QueryOwnersRequest{Index: index})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllOwners implements a command to return the owners of all the
+// capabilities.
+func GetCmdQueryAllOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-owners",
+		Short: "Query the owners of all the capabilities",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllOwners(cmd.Context(), &types.QueryAllOwnersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-owners")
+
+	return cmd
+}
+
+// GetCmdQueryModuleCapabilities implements a command to return the
+// capabilities owned by a module.
+func GetCmdQueryModuleCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-capabilities [module]",
+		Short: "Query the capabilities owned by a module, with the names it owns them under",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ModuleCapabilities(cmd.Context(), &types.QueryModuleCapabilitiesRequest{
+				Module:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module-capabilities")
+
+	return cmd
+}
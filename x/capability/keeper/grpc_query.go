@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Owners implements the Query/Owners gRPC method
+func (k Keeper) Owners(c context.Context, req *types.QueryOwnersRequest) (*types.QueryOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owners, found := k.GetOwners(ctx, req.Index)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "capability %d not found", req.Index)
+	}
+
+	return &types.QueryOwnersResponse{Owners: owners.Owners}, nil
+}
+
+// AllOwners implements the Query/AllOwners gRPC method
+func (k Keeper) AllOwners(c context.Context, req *types.QueryAllOwnersRequest) (*types.QueryAllOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var owners []types.IndexedOwners
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var capOwners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &capOwners); err != nil {
+			return err
+		}
+
+		owners = append(owners, types.IndexedOwners{Index: types.IndexFromKey(key), Owners: capOwners.Owners})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllOwnersResponse{Owners: owners, Pagination: pageRes}, nil
+}
+
+// ModuleCapabilities implements the Query/ModuleCapabilities gRPC method
+func (k Keeper) ModuleCapabilities(c context.Context, req *types.QueryModuleCapabilitiesRequest) (*types.QueryModuleCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if strings.TrimSpace(req.Module) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty module name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var capabilities []types.ModuleCapability
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var capOwners types.CapabilityOwners
+		if err := k.cdc.Unmarshal(value, &capOwners); err != nil {
+			return false, err
+		}
+
+		// a module may own a capability under several names
+		var owned []types.ModuleCapability
+		for _, owner := range capOwners.Owners {
+			if owner.Module == req.Module {
+				owned = append(owned, types.ModuleCapability{Name: owner.Name, Index: types.IndexFromKey(key)})
+			}
+		}
+		if len(owned) == 0 {
+			return false, nil
+		}
+
+		if accumulate {
+			capabilities = append(capabilities, owned...)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryCapabilities() {
+	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingtypes.ModuleName)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, *suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	caps := make([]*types.Capability, 5)
+	for i := range caps {
+		cap, err := sk1.NewCapability(suite.ctx, fmt.Sprintf("transfer-%d", i))
+		suite.Require().NoError(err)
+		caps[i] = cap
+	}
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, caps[1], "bond"))
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, caps[3], "unbond"))
+
+	// owners of a capability
+	res, err := queryClient.Owners(ctx, &types.QueryOwnersRequest{Index: caps[1].GetIndex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Owner{
+		types.NewOwner(banktypes.ModuleName, "transfer-1"),
+		types.NewOwner(stakingtypes.ModuleName, "bond"),
+	}, res.Owners)
+
+	_, err = queryClient.Owners(ctx, &types.QueryOwnersRequest{Index: caps[4].GetIndex() + 1})
+	suite.Require().Error(err)
+
+	// owners of all the capabilities
+	allRes, err := queryClient.AllOwners(ctx, &types.QueryAllOwnersRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(allRes.Owners, 3)
+	suite.Require().Equal(caps[0].GetIndex(), allRes.Owners[0].Index)
+	suite.Require().Equal(uint64(len(caps)), allRes.Pagination.Total)
+	suite.Require().NotNil(allRes.Pagination.NextKey)
+
+	// capabilities of a module
+	modRes, err := queryClient.ModuleCapabilities(ctx, &types.QueryModuleCapabilitiesRequest{Module: stakingtypes.ModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Name: "bond", Index: caps[1].GetIndex()},
+		{Name: "unbond", Index: caps[3].GetIndex()},
+	}, modRes.Capabilities)
+
+	modRes, err = queryClient.ModuleCapabilities(ctx, &types.QueryModuleCapabilitiesRequest{
+		Module:     banktypes.ModuleName,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(modRes.Capabilities, 2)
+	suite.Require().Equal(uint64(len(caps)), modRes.Pagination.Total)
+
+	_, err = queryClient.ModuleCapabilities(ctx, &types.QueryModuleCapabilitiesRequest{})
+	suite.Require().Error(err)
+}
@@ -305,6 +305,92 @@ func (suite KeeperTestSuite) TestRevertCapability() {
 	suite.Require().Equal(cap, got, "did not get correct capability from context")
 }
 
+func (suite *KeeperTestSuite) TestMigrateOwnerModule() {
+	oldSk := suite.keeper.ScopeToModule("old")
+	newSk := suite.keeper.ScopeToModule("new")
+	sk := suite.keeper.ScopeToModule(banktypes.ModuleName)
+
+	cap1, err := oldSk.NewCapability(suite.ctx, "port")
+	suite.Require().NoError(err)
+	cap2, err := sk.NewCapability(suite.ctx, "channel")
+	suite.Require().NoError(err)
+	suite.Require().NoError(oldSk.ClaimCapability(suite.ctx, cap2, "channel"))
+	cap3, err := sk.NewCapability(suite.ctx, "other")
+	suite.Require().NoError(err)
+
+	suite.Require().Error(suite.keeper.MigrateOwnerModule(suite.ctx, "", "new"))
+	suite.Require().Error(suite.keeper.MigrateOwnerModule(suite.ctx, "old", "old"))
+	suite.Require().ErrorIs(suite.keeper.MigrateOwnerModule(suite.ctx, "old", "unscoped"), types.ErrModuleNotScoped)
+
+	suite.Require().NoError(suite.keeper.MigrateOwnerModule(suite.ctx, "old", "new"))
+
+	// the new module owns the capabilities of the old module, under the same names
+	for name, cap := range map[string]*types.Capability{"port": cap1, "channel": cap2} {
+		got, ok := newSk.GetCapability(suite.ctx, name)
+		suite.Require().True(ok)
+		suite.Require().Equal(cap, got)
+		suite.Require().True(newSk.AuthenticateCapability(suite.ctx, cap, name))
+
+		_, ok = oldSk.GetCapability(suite.ctx, name)
+		suite.Require().False(ok)
+		suite.Require().False(oldSk.AuthenticateCapability(suite.ctx, cap, name))
+	}
+
+	mods, _, err := sk.LookupModules(suite.ctx, "channel")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{banktypes.ModuleName, "new"}, mods)
+
+	owners, ok := suite.keeper.GetOwners(suite.ctx, cap3.GetIndex())
+	suite.Require().True(ok)
+	suite.Require().Equal([]types.Owner{types.NewOwner(banktypes.ModuleName, "other")}, owners.Owners)
+
+	// the migrated capabilities can be released by the new module
+	suite.Require().NoError(newSk.ReleaseCapability(suite.ctx, cap1))
+	_, ok = suite.keeper.GetOwners(suite.ctx, cap1.GetIndex())
+	suite.Require().False(ok)
+}
+
+func (suite *KeeperTestSuite) TestMigrateOwnerModuleConflict() {
+	oldSk := suite.keeper.ScopeToModule("old")
+	newSk := suite.keeper.ScopeToModule("new")
+
+	cap1, err := oldSk.NewCapability(suite.ctx, "port")
+	suite.Require().NoError(err)
+	cap2, err := oldSk.NewCapability(suite.ctx, "channel")
+	suite.Require().NoError(err)
+	suite.Require().NoError(newSk.ClaimCapability(suite.ctx, cap2, "channel"))
+
+	suite.Require().ErrorIs(suite.keeper.MigrateOwnerModule(suite.ctx, "old", "new"), types.ErrOwnerClaimed)
+
+	// nothing is migrated
+	got, ok := oldSk.GetCapability(suite.ctx, "port")
+	suite.Require().True(ok)
+	suite.Require().Equal(cap1, got)
+	_, ok = newSk.GetCapability(suite.ctx, "port")
+	suite.Require().False(ok)
+}
+
+func (suite *KeeperTestSuite) TestMigrateOwnerModuleBeforeMemStoreInit() {
+	oldSk := suite.keeper.ScopeToModule("old")
+	cap, err := oldSk.NewCapability(suite.ctx, "port")
+	suite.Require().NoError(err)
+
+	// a keeper of the same persisted capabilities, whose memory store is not
+	// initialized yet, as on restart
+	restarted := keeper.NewKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.GetMemKey("testingkey"))
+	newSk := restarted.ScopeToModule("new")
+	restarted.Seal()
+	suite.Require().False(restarted.IsInitialized(suite.ctx))
+
+	suite.Require().NoError(restarted.MigrateOwnerModule(suite.ctx, "old", "new"))
+	restarted.InitMemStore(suite.ctx)
+
+	got, ok := newSk.GetCapability(suite.ctx, "port")
+	suite.Require().True(ok)
+	suite.Require().Equal(cap.GetIndex(), got.GetIndex())
+	suite.Require().True(newSk.AuthenticateCapability(suite.ctx, got, "port"))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
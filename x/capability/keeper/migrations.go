@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// MigrateOwnerModule transfers the ownership of all the capabilities owned by
+// oldModule to newModule, under the same names. It is meant to be called by the
+// upgrade handler renaming or replacing the module oldModule, which must no
+// longer be used, by newModule, which must have been scoped a ScopedKeeper.
+//
+// Both the persisted capability owners and, if it has already been
+// initialized, the in-memory store are updated. Nothing is updated if
+// newModule already owns one of the capabilities of oldModule.
+func (k Keeper) MigrateOwnerModule(ctx sdk.Context, oldModule, newModule string) error {
+	if strings.TrimSpace(oldModule) == "" || strings.TrimSpace(newModule) == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "module names cannot be empty")
+	}
+	if oldModule == newModule {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot migrate the capabilities of %s to itself", oldModule)
+	}
+	if _, ok := k.scopedModules[newModule]; !ok {
+		return sdkerrors.Wrap(types.ErrModuleNotScoped, newModule)
+	}
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+
+	// compute all the new owners before updating any store, so that the
+	// migration is applied either entirely or not at all
+	migrations, err := k.ownerMigrations(prefixStore, oldModule, newModule)
+	if err != nil {
+		return err
+	}
+
+	// the in-memory store is otherwise initialized from the migrated owners
+	memInitialized := k.IsInitialized(ctx)
+	memStore := ctx.KVStore(k.memKey)
+
+	for _, m := range migrations {
+		prefixStore.Set(types.IndexToKey(m.index), k.cdc.MustMarshal(&m.owners))
+
+		if !memInitialized {
+			continue
+		}
+
+		cap := k.capMap[m.index]
+		if cap == nil {
+			panic(fmt.Sprintf("capability %d found in store is missing from map", m.index))
+		}
+
+		for _, name := range m.names {
+			memStore.Delete(types.FwdCapabilityKey(oldModule, cap))
+			memStore.Delete(types.RevCapabilityKey(oldModule, name))
+
+			memStore.Set(types.FwdCapabilityKey(newModule, cap), []byte(name))
+			memStore.Set(types.RevCapabilityKey(newModule, name), sdk.Uint64ToBigEndian(m.index))
+		}
+	}
+
+	logger(ctx).Info("migrated capability owners", "from", oldModule, "to", newModule, "capabilities", len(migrations))
+
+	return nil
+}
+
+// ownerMigration is the new set of owners of a capability, once the names
+// owned by oldModule are owned by newModule.
+type ownerMigration struct {
+	index  uint64
+	owners types.CapabilityOwners
+	names  []string
+}
+
+func (k Keeper) ownerMigrations(prefixStore sdk.KVStore, oldModule, newModule string) ([]ownerMigration, error) {
+	var migrations []ownerMigration
+
+	iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		index := types.IndexFromKey(iterator.Key())
+
+		var owners types.CapabilityOwners
+		k.cdc.MustUnmarshal(iterator.Value(), &owners)
+
+		m := ownerMigration{index: index}
+		for _, owner := range owners.Owners {
+			if owner.Module == oldModule {
+				owner.Module = newModule
+				m.names = append(m.names, owner.Name)
+			}
+			if err := m.owners.Set(owner); err != nil {
+				return nil, sdkerrors.Wrapf(err, "capability %d", index)
+			}
+		}
+
+		if len(m.names) > 0 {
+			migrations = append(migrations, m)
+		}
+	}
+
+	return migrations, nil
+}
@@ -0,0 +1,25 @@
+package circuit
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// BeginBlocker prunes the permissions which expired at the block time.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	for _, addr := range k.PruneExpiredPermissions(ctx) {
+		k.Logger(ctx).Info("circuit breaker permissions expired", "address", addr.String())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePermissionsExpired,
+				sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			),
+		)
+	}
+}
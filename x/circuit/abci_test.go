@@ -0,0 +1,61 @@
+package circuit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestBeginBlockerPrunesExpiredPermissions(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	k := app.CircuitKeeper
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	expiration := now.Add(time.Hour)
+	k.SetPermissions(ctx, addrs[0], types.NewPermissions(types.LevelAllMsgs, nil, &expiration))
+	k.SetPermissions(ctx, addrs[1], types.NewPermissions(types.LevelAllMsgs, nil, nil))
+
+	circuit.BeginBlocker(ctx, k)
+	_, found := k.GetPermissions(ctx, addrs[0])
+	require.True(t, found)
+
+	ctx = ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	circuit.BeginBlocker(ctx, k)
+	_, found = k.GetPermissions(ctx, addrs[0])
+	require.False(t, found)
+	_, found = k.GetPermissions(ctx, addrs[1])
+	require.True(t, found)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypePermissionsExpired, events[0].Type)
+	require.Equal(t, addrs[0].String(), string(events[0].Attributes[0].Value))
+}
+
+func TestCircuitBreakerPermissionsProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now()})
+	handler := circuit.NewCircuitBreakerPermissionsProposalHandler(app.CircuitKeeper)
+
+	addr := sdk.AccAddress("addr________________")
+	permissions := types.NewPermissions(types.LevelSomeMsgs, []string{"/cosmos.bank.v1beta1.MsgSend"}, nil)
+	require.NoError(t, handler(ctx, types.NewCircuitBreakerPermissionsProposal("title", "description", addr, permissions)))
+
+	got, found := app.CircuitKeeper.GetPermissions(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, permissions, got)
+
+	// LevelNone revokes the permissions
+	require.NoError(t, handler(ctx, types.NewCircuitBreakerPermissionsProposal("title", "description", addr, types.Permissions{})))
+	_, found = app.CircuitKeeper.GetPermissions(ctx, addr)
+	require.False(t, found)
+}
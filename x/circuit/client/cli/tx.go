@@ -0,0 +1,268 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	FlagLimitTypeURLs = "limit-type-urls"
+	FlagExpiration    = "expiration"
+)
+
+// GetTxCmd returns the transaction commands for the circuit module.
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewCmdAuthorize(),
+		NewCmdTrip(),
+		NewCmdReset(),
+	)
+
+	return circuitTxCmd
+}
+
+// NewCmdAuthorize returns a CLI command handler for creating a
+// MsgAuthorizeCircuitBreaker transaction.
+func NewCmdAuthorize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] --from [granter]",
+		Short: "Set the circuit breaker permissions of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the permissions of an account to trip and reset circuit breakers, as a
+super admin. The level is one of none, some-msgs, all-msgs or super-admin, none
+revoking the permissions. The messages of the some-msgs level are given by
+--%s, and the permissions expire at the time given by --%s, if any.
+
+Example:
+  $ %s tx circuit authorize cosmos1... some-msgs --%s /cosmos.bank.v1beta1.MsgSend --%s 2022-01-01T00:00:00Z --from admin
+`,
+				FlagLimitTypeURLs, FlagExpiration, version.AppName, FlagLimitTypeURLs, FlagExpiration,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			permissions, err := parsePermissions(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress(), grantee, permissions)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addPermissionsFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdTrip returns a CLI command handler for creating a
+// MsgTripCircuitBreaker transaction.
+func NewCmdTrip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]... --from [authority]",
+		Short: "Disable the execution of messages by tripping their circuit breakers",
+		Example: fmt.Sprintf("%s tx circuit trip /cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend --from admin",
+			version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdReset returns a CLI command handler for creating a
+// MsgResetCircuitBreaker transaction.
+func NewCmdReset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]... --from [authority]",
+		Short: "Enable again the execution of messages by resetting their circuit breakers",
+		Example: fmt.Sprintf("%s tx circuit reset /cosmos.bank.v1beta1.MsgSend --from admin",
+			version.AppName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitCircuitBreakerPermissionsProposal implements a command handler
+// for submitting a circuit breaker permissions proposal transaction.
+func NewCmdSubmitCircuitBreakerPermissionsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker-permissions [grantee] [level] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to set the circuit breaker permissions of an account",
+		Long: "Submit a proposal to set the permissions of an account to trip and reset circuit breakers,\n" +
+			"along with an initial deposit. The level is one of none, some-msgs, all-msgs or super-admin,\n" +
+			"none revoking the permissions.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			permissions, err := parsePermissions(cmd, args[1])
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCircuitBreakerPermissionsProposal(title, description, grantee, permissions)
+			msg, err := gov.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	addPermissionsFlags(cmd)
+
+	return cmd
+}
+
+func addPermissionsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(FlagLimitTypeURLs, nil, "The type URLs of the messages of the some-msgs level")
+	cmd.Flags().String(FlagExpiration, "", "The time (RFC3339) at which the permissions expire")
+}
+
+// parsePermissions parses the permissions of the level and of the flags.
+func parsePermissions(cmd *cobra.Command, levelStr string) (types.Permissions, error) {
+	level, err := ParseLevel(levelStr)
+	if err != nil {
+		return types.Permissions{}, err
+	}
+
+	limitTypeURLs, err := cmd.Flags().GetStringSlice(FlagLimitTypeURLs)
+	if err != nil {
+		return types.Permissions{}, err
+	}
+
+	expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+	if err != nil {
+		return types.Permissions{}, err
+	}
+
+	var expiration *time.Time
+	if expirationStr != "" {
+		t, err := time.Parse(time.RFC3339, expirationStr)
+		if err != nil {
+			return types.Permissions{}, fmt.Errorf("invalid expiration: %w", err)
+		}
+		expiration = &t
+	}
+
+	permissions := types.NewPermissions(level, limitTypeURLs, expiration)
+	return permissions, permissions.Validate()
+}
+
+// ParseLevel parses a permissions level, either as one of none, some-msgs,
+// all-msgs and super-admin, or as the name of its enum value.
+func ParseLevel(s string) (types.Permissions_Level, error) {
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if name == "NONE" {
+		return types.LevelNone, nil
+	}
+	if !strings.HasPrefix(name, "LEVEL_") {
+		name = "LEVEL_" + name
+	}
+
+	level, ok := types.Permissions_Level_value[name]
+	if !ok {
+		return types.LevelNone, fmt.Errorf("unknown permissions level %s, expected one of none, some-msgs, all-msgs or super-admin", s)
+	}
+
+	return types.Permissions_Level(level), nil
+}
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// PermissionsProposalRequest defines a proposal to set the circuit breaker
+// permissions of an account.
+type PermissionsProposalRequest struct {
+	BaseReq       rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title         string       `json:"title" yaml:"title"`
+	Description   string       `json:"description" yaml:"description"`
+	Deposit       sdk.Coins    `json:"deposit" yaml:"deposit"`
+	Grantee       string       `json:"grantee" yaml:"grantee"`
+	Level         int32        `json:"level" yaml:"level"`
+	LimitTypeURLs []string     `json:"limit_type_urls" yaml:"limit_type_urls"`
+	Expiration    *time.Time   `json:"expiration" yaml:"expiration"`
+}
+
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "circuit_breaker_permissions",
+		Handler:  newPostPermissionsProposalHandler(clientCtx),
+	}
+}
+
+func newPostPermissionsProposalHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PermissionsProposalRequest
+
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		permissions := types.NewPermissions(types.Permissions_Level(req.Level), req.LimitTypeURLs, req.Expiration)
+		content := types.NewCircuitBreakerPermissionsProposal(req.Title, req.Description, grantee, permissions)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -0,0 +1,38 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// InitGenesis sets the permissions of the accounts and trips the circuit
+// breakers of the disabled Msg types of the genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
+	for _, ap := range data.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(ap.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		k.SetPermissions(ctx, addr, ap.Permissions)
+	}
+
+	for _, typeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, typeURL)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	accountPermissions := []types.AccountPermissions{}
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, permissions types.Permissions) bool {
+		accountPermissions = append(accountPermissions, types.AccountPermissions{
+			Address:     addr.String(),
+			Permissions: permissions,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(accountPermissions, k.GetDisabledList(ctx))
+}
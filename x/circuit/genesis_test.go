@@ -0,0 +1,49 @@
+package circuit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: now})
+	k := app.CircuitKeeper
+
+	expiration := now.Add(time.Hour)
+	genesis := types.NewGenesisState(
+		[]types.AccountPermissions{
+			{
+				Address:     sdk.AccAddress("addr1_______________").String(),
+				Permissions: types.NewPermissions(types.LevelSomeMsgs, []string{"/cosmos.bank.v1beta1.MsgSend"}, &expiration),
+			},
+			{
+				Address:     sdk.AccAddress("addr2_______________").String(),
+				Permissions: types.NewPermissions(types.LevelSuperAdmin, nil, nil),
+			},
+		},
+		[]string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+	)
+	require.NoError(t, genesis.Validate())
+
+	circuit.InitGenesis(ctx, k, genesis)
+	allowed, err := k.IsAllowed(ctx, "/cosmos.bank.v1beta1.MsgMultiSend")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	exported := circuit.ExportGenesis(ctx, k)
+	require.ElementsMatch(t, genesis.AccountPermissions, exported.AccountPermissions)
+	require.Equal(t, genesis.DisabledTypeUrls, exported.DisabledTypeUrls)
+
+	// the expiring permissions are queued for pruning
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress("addr1_______________")}, k.PruneExpiredPermissions(ctx.WithBlockTime(expiration)))
+}
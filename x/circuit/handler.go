@@ -0,0 +1,33 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewCircuitBreakerPermissionsProposalHandler creates a governance handler
+// setting the permissions of the accounts to trip and reset circuit breakers,
+// on behalf of the authority of the circuit keeper.
+func NewCircuitBreakerPermissionsProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.CircuitBreakerPermissionsProposal:
+			return handleCircuitBreakerPermissionsProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized circuit proposal content type: %T", c)
+		}
+	}
+}
+
+func handleCircuitBreakerPermissionsProposal(ctx sdk.Context, k keeper.Keeper, p *types.CircuitBreakerPermissionsProposal) error {
+	grantee, err := sdk.AccAddressFromBech32(p.Grantee)
+	if err != nil {
+		return err
+	}
+
+	return k.Authorize(ctx, k.GetAuthority(), grantee, p.Permissions)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Authorize sets the permissions of the grantee on behalf of the granter,
+// which must be the authority or a super admin. The permissions granted by a
+// super admin cannot outlive its own, so that its expiration cannot be evaded
+// by granting permissions to another account.
+func (k Keeper) Authorize(ctx sdk.Context, granter string, grantee sdk.AccAddress, permissions types.Permissions) error {
+	if err := permissions.Validate(); err != nil {
+		return err
+	}
+	if permissions.IsExpired(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidPermissions, "expiration %s is not after the block time", permissions.Expiration)
+	}
+
+	if granter != k.authority {
+		granterAddr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			return err
+		}
+
+		granterPermissions, found := k.GetPermissions(ctx, granterAddr)
+		if !found || !granterPermissions.CanGrant() {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot grant permissions", granter)
+		}
+		if permissions.Level != types.LevelNone && granterPermissions.Expiration != nil &&
+			(permissions.Expiration == nil || permissions.Expiration.After(*granterPermissions.Expiration)) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "permissions cannot expire after the ones of the granter, at %s", granterPermissions.Expiration.Format(time.RFC3339))
+		}
+	}
+
+	k.SetPermissions(ctx, grantee, permissions)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuthorizeCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyLevel, permissions.Level.String()),
+		),
+	)
+
+	return nil
+}
+
+// TripCircuitBreakers disables the execution of the Msg types on behalf of
+// the authority, which must be the authority of the module or an account
+// allowed to trip their circuit breakers. The Msgs of the module, and the gov
+// and authz Msgs needed to reset the circuit breakers, cannot be disabled.
+func (k Keeper) TripCircuitBreakers(ctx sdk.Context, authority string, typeURLs []string) error {
+	if err := k.checkCanTrip(ctx, authority, typeURLs); err != nil {
+		return err
+	}
+
+	for _, typeURL := range typeURLs {
+		if allowed, _ := k.IsAllowed(ctx, typeURL); !allowed {
+			return sdkerrors.Wrap(types.ErrAlreadyDisabled, typeURL)
+		}
+
+		k.DisableMsg(ctx, typeURL)
+		k.Logger(ctx).Info("circuit breaker tripped", "msg_type_url", typeURL, "authority", authority)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			),
+		)
+	}
+
+	return nil
+}
+
+// ResetCircuitBreakers enables again the execution of the Msg types on behalf
+// of the authority, which must be the authority of the module or an account
+// allowed to reset their circuit breakers.
+func (k Keeper) ResetCircuitBreakers(ctx sdk.Context, authority string, typeURLs []string) error {
+	if err := k.checkCanTrip(ctx, authority, typeURLs); err != nil {
+		return err
+	}
+
+	for _, typeURL := range typeURLs {
+		if allowed, _ := k.IsAllowed(ctx, typeURL); allowed {
+			return sdkerrors.Wrap(types.ErrNotDisabled, typeURL)
+		}
+
+		k.EnableMsg(ctx, typeURL)
+		k.Logger(ctx).Info("circuit breaker reset", "msg_type_url", typeURL, "authority", authority)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+				sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			),
+		)
+	}
+
+	return nil
+}
+
+// checkCanTrip returns an error if the authority cannot trip and reset the
+// circuit breakers of all the Msg type URLs.
+func (k Keeper) checkCanTrip(ctx sdk.Context, authority string, typeURLs []string) error {
+	for _, typeURL := range typeURLs {
+		if types.IsProtectedMsg(typeURL) {
+			return sdkerrors.Wrapf(types.ErrInvalidTypeURL, "circuit breaker of %s cannot be tripped", typeURL)
+		}
+	}
+
+	if authority == k.authority {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(authority)
+	if err != nil {
+		return err
+	}
+
+	permissions, found := k.GetPermissions(ctx, addr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnauthorized, "%s has no permissions", authority)
+	}
+	for _, typeURL := range typeURLs {
+		if !permissions.CanTrip(typeURL) {
+			return sdkerrors.Wrapf(types.ErrUnauthorized, "%s cannot trip the circuit breaker of %s", authority, typeURL)
+		}
+	}
+
+	return nil
+}
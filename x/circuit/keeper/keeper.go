@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+
+	// authority is the address allowed to grant any permissions and to trip
+	// and reset any circuit breaker, usually the gov module account.
+	authority string
+}
+
+// NewKeeper creates a new circuit Keeper instance. The authority is the
+// address granting the permissions, usually the gov module account.
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid circuit authority %s", authority))
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the address of the authority of the module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetPermissions returns the permissions of the account, and false if it has
+// none or if they expired.
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) (types.Permissions, bool) {
+	permissions, found := k.getPermissions(ctx, addr)
+	if !found || permissions.IsExpired(ctx.BlockTime()) {
+		return types.Permissions{}, false
+	}
+
+	return permissions, true
+}
+
+func (k Keeper) getPermissions(ctx sdk.Context, addr sdk.AccAddress) (types.Permissions, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccountPermissionsKey(addr))
+	if bz == nil {
+		return types.Permissions{}, false
+	}
+
+	var permissions types.Permissions
+	k.cdc.MustUnmarshal(bz, &permissions)
+	return permissions, true
+}
+
+// SetPermissions sets the permissions of the account, removing them with
+// LevelNone. Permissions with an expiration are queued to be pruned once they
+// expire.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, permissions types.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if previous, found := k.getPermissions(ctx, addr); found && previous.Expiration != nil {
+		store.Delete(types.PermissionsExpiryQueueKey(*previous.Expiration, addr))
+	}
+
+	if permissions.Level == types.LevelNone {
+		store.Delete(types.AccountPermissionsKey(addr))
+		return
+	}
+
+	store.Set(types.AccountPermissionsKey(addr), k.cdc.MustMarshal(&permissions))
+	if permissions.Expiration != nil {
+		store.Set(types.PermissionsExpiryQueueKey(*permissions.Expiration, addr), []byte{})
+	}
+}
+
+// IteratePermissions iterates over the permissions of the accounts, including
+// the expired ones not pruned yet, and stops when cb returns true.
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, permissions types.Permissions) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AccountPermissionsKeyPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var permissions types.Permissions
+		k.cdc.MustUnmarshal(it.Value(), &permissions)
+
+		// skip the prefix and the length prefix of the address
+		addr := sdk.AccAddress(it.Key()[len(types.AccountPermissionsKeyPrefix)+1:])
+		if cb(addr, permissions) {
+			break
+		}
+	}
+}
+
+// PruneExpiredPermissions removes the permissions which expired at the block
+// time, and returns the addresses of their accounts.
+func (k Keeper) PruneExpiredPermissions(ctx sdk.Context) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.PermissionsExpiryQueueTimePrefix(ctx.BlockTime()))
+	it := store.Iterator(types.PermissionsExpiryQueuePrefix, end)
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	addrs := make([]sdk.AccAddress, 0, len(keys))
+	for _, key := range keys {
+		addr := types.SplitPermissionsExpiryQueueKey(key)
+		store.Delete(key)
+		store.Delete(types.AccountPermissionsKey(addr))
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
+
+// IsAllowed returns false if the circuit breaker of the Msg type URL is
+// tripped. It implements the circuit breaker of the Msg service router.
+func (k Keeper) IsAllowed(ctx sdk.Context, typeURL string) (bool, error) {
+	return !ctx.KVStore(k.storeKey).Has(types.DisabledTypeURLKey(typeURL)), nil
+}
+
+// DisableMsg trips the circuit breaker of the Msg type URL.
+func (k Keeper) DisableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Set(types.DisabledTypeURLKey(typeURL), []byte{})
+}
+
+// EnableMsg resets the circuit breaker of the Msg type URL.
+func (k Keeper) EnableMsg(ctx sdk.Context, typeURL string) {
+	ctx.KVStore(k.storeKey).Delete(types.DisabledTypeURLKey(typeURL))
+}
+
+// GetDisabledList returns the type URLs of the Msgs whose circuit breakers are
+// tripped.
+func (k Keeper) GetDisabledList(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DisabledTypeURLKeyPrefix)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	typeURLs := []string{}
+	for ; it.Valid(); it.Next() {
+		typeURLs = append(typeURLs, string(it.Key()))
+	}
+
+	return typeURLs
+}
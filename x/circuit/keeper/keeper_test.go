@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	msgSendURL      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSendURL = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient types.QueryClient
+	msgSrvr     types.MsgServer
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.msgSrvr = keeper.NewMsgServerImpl(app.CircuitKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestPermissions() {
+	k, ctx, addr := suite.app.CircuitKeeper, suite.ctx, suite.addrs[0]
+
+	_, found := k.GetPermissions(ctx, addr)
+	suite.Require().False(found)
+
+	expiration := ctx.BlockTime().Add(time.Hour)
+	permissions := types.NewPermissions(types.LevelAllMsgs, nil, &expiration)
+	k.SetPermissions(ctx, addr, permissions)
+	got, found := k.GetPermissions(ctx, addr)
+	suite.Require().True(found)
+	suite.Require().Equal(permissions, got)
+
+	// expired permissions are ignored until they are pruned
+	_, found = k.GetPermissions(ctx.WithBlockTime(expiration), addr)
+	suite.Require().False(found)
+
+	// LevelNone removes the permissions and their expiry
+	k.SetPermissions(ctx, addr, types.Permissions{})
+	_, found = k.GetPermissions(ctx, addr)
+	suite.Require().False(found)
+	suite.Require().Empty(k.PruneExpiredPermissions(ctx.WithBlockTime(expiration)))
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredPermissions() {
+	k, ctx, addrs := suite.app.CircuitKeeper, suite.ctx, suite.addrs
+
+	soon, later := ctx.BlockTime().Add(time.Hour), ctx.BlockTime().Add(2*time.Hour)
+	k.SetPermissions(ctx, addrs[0], types.NewPermissions(types.LevelAllMsgs, nil, &soon))
+	k.SetPermissions(ctx, addrs[1], types.NewPermissions(types.LevelAllMsgs, nil, &soon))
+	k.SetPermissions(ctx, addrs[2], types.NewPermissions(types.LevelAllMsgs, nil, nil))
+
+	// extending the permissions moves them in the expiry queue
+	k.SetPermissions(ctx, addrs[1], types.NewPermissions(types.LevelAllMsgs, nil, &later))
+
+	suite.Require().Empty(k.PruneExpiredPermissions(ctx))
+	suite.Require().Equal([]sdk.AccAddress{addrs[0]}, k.PruneExpiredPermissions(ctx.WithBlockTime(soon)))
+	suite.Require().Equal([]sdk.AccAddress{addrs[1]}, k.PruneExpiredPermissions(ctx.WithBlockTime(later.Add(time.Second))))
+
+	var remaining []sdk.AccAddress
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, _ types.Permissions) bool {
+		remaining = append(remaining, addr)
+		return false
+	})
+	suite.Require().Equal([]sdk.AccAddress{addrs[2]}, remaining)
+}
+
+func (suite *KeeperTestSuite) TestAuthorize() {
+	k, ctx, addrs := suite.app.CircuitKeeper, suite.ctx, suite.addrs
+	authority := k.GetAuthority()
+	admin, grantee := addrs[0], addrs[1]
+
+	// only the authority and the super admins can grant permissions
+	superAdmin := types.NewPermissions(types.LevelSuperAdmin, nil, nil)
+	err := k.Authorize(ctx, admin.String(), grantee, superAdmin)
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(k.Authorize(ctx, authority, admin, superAdmin))
+	suite.Require().NoError(k.Authorize(ctx, admin.String(), grantee, types.NewPermissions(types.LevelSomeMsgs, []string{msgSendURL}, nil)))
+
+	permissions, found := k.GetPermissions(ctx, grantee)
+	suite.Require().True(found)
+	suite.Require().Equal(types.LevelSomeMsgs, permissions.Level)
+
+	// an account with all the msgs cannot grant permissions
+	err = k.Authorize(ctx, grantee.String(), addrs[2], types.NewPermissions(types.LevelAllMsgs, nil, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the permissions must not be expired
+	past := ctx.BlockTime().Add(-time.Hour)
+	err = k.Authorize(ctx, authority, grantee, types.NewPermissions(types.LevelAllMsgs, nil, &past))
+	suite.Require().ErrorIs(err, types.ErrInvalidPermissions)
+
+	// the permissions granted by an expiring super admin cannot outlive its own
+	expiration := ctx.BlockTime().Add(time.Hour)
+	later := expiration.Add(time.Hour)
+	suite.Require().NoError(k.Authorize(ctx, authority, admin, types.NewPermissions(types.LevelSuperAdmin, nil, &expiration)))
+	err = k.Authorize(ctx, admin.String(), grantee, types.NewPermissions(types.LevelAllMsgs, nil, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	err = k.Authorize(ctx, admin.String(), grantee, types.NewPermissions(types.LevelAllMsgs, nil, &later))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(k.Authorize(ctx, admin.String(), grantee, types.NewPermissions(types.LevelAllMsgs, nil, &expiration)))
+
+	// but can be revoked
+	suite.Require().NoError(k.Authorize(ctx, admin.String(), grantee, types.Permissions{}))
+	_, found = k.GetPermissions(ctx, grantee)
+	suite.Require().False(found)
+
+	// once expired, the super admin cannot grant permissions anymore
+	err = k.Authorize(ctx.WithBlockTime(expiration), admin.String(), grantee, types.Permissions{})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestTripAndReset() {
+	k, ctx, addrs := suite.app.CircuitKeeper, suite.ctx, suite.addrs
+	authority := k.GetAuthority()
+	someMsgs, allMsgs := addrs[0], addrs[1]
+	k.SetPermissions(ctx, someMsgs, types.NewPermissions(types.LevelSomeMsgs, []string{msgSendURL}, nil))
+	k.SetPermissions(ctx, allMsgs, types.NewPermissions(types.LevelAllMsgs, nil, nil))
+
+	// the accounts can only trip the circuit breakers of their msgs
+	err := k.TripCircuitBreakers(ctx, someMsgs.String(), []string{msgSendURL, msgMultiSendURL})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	err = k.TripCircuitBreakers(ctx, addrs[2].String(), []string{msgSendURL})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	suite.Require().NoError(k.TripCircuitBreakers(ctx, someMsgs.String(), []string{msgSendURL}))
+	suite.Require().NoError(k.TripCircuitBreakers(ctx, allMsgs.String(), []string{msgMultiSendURL}))
+	suite.Require().Equal([]string{msgMultiSendURL, msgSendURL}, k.GetDisabledList(ctx))
+
+	allowed, err := k.IsAllowed(ctx, msgSendURL)
+	suite.Require().NoError(err)
+	suite.Require().False(allowed)
+
+	err = k.TripCircuitBreakers(ctx, authority, []string{msgSendURL})
+	suite.Require().ErrorIs(err, types.ErrAlreadyDisabled)
+
+	// the circuit breakers of the module cannot be tripped
+	err = k.TripCircuitBreakers(ctx, authority, []string{sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{})})
+	suite.Require().ErrorIs(err, types.ErrInvalidTypeURL)
+
+	err = k.ResetCircuitBreakers(ctx, someMsgs.String(), []string{msgMultiSendURL})
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	suite.Require().NoError(k.ResetCircuitBreakers(ctx, someMsgs.String(), []string{msgSendURL}))
+	suite.Require().NoError(k.ResetCircuitBreakers(ctx, authority, []string{msgMultiSendURL}))
+	suite.Require().Empty(k.GetDisabledList(ctx))
+
+	err = k.ResetCircuitBreakers(ctx, authority, []string{msgSendURL})
+	suite.Require().ErrorIs(err, types.ErrNotDisabled)
+}
+
+func (suite *KeeperTestSuite) TestGovResetsAfterTripAll() {
+	k, ctx, subcommittee := suite.app.CircuitKeeper, suite.ctx, suite.addrs[0]
+	k.SetPermissions(ctx, subcommittee, types.NewPermissions(types.LevelAllMsgs, nil, nil))
+
+	// the subcommittee trips all the circuit breakers it can, but the ones of
+	// the Msgs governance needs to reset them
+	var tripped []string
+	for _, typeURL := range suite.app.InterfaceRegistry().ListImplementations(sdk.MsgInterfaceProtoName) {
+		err := k.TripCircuitBreakers(ctx, subcommittee.String(), []string{typeURL})
+		if types.IsProtectedMsg(typeURL) {
+			suite.Require().ErrorIs(err, types.ErrInvalidTypeURL, typeURL)
+			continue
+		}
+		suite.Require().NoError(err, typeURL)
+		tripped = append(tripped, typeURL)
+	}
+	suite.Require().Contains(tripped, msgSendURL)
+
+	for _, msg := range []sdk.Msg{&govtypes.MsgSubmitProposal{}, &govtypes.MsgDeposit{}, &govtypes.MsgVote{}, &authz.MsgExec{}} {
+		allowed, err := k.IsAllowed(ctx, sdk.MsgTypeURL(msg))
+		suite.Require().NoError(err)
+		suite.Require().True(allowed, sdk.MsgTypeURL(msg))
+	}
+
+	// so a passed proposal resets them, even once the permissions of the
+	// subcommittee are revoked
+	k.SetPermissions(ctx, subcommittee, types.NewPermissions(types.LevelNone, nil, nil))
+	proposal, err := govtypes.NewMsgExecutionProposal("reset", "reset the circuit breakers", []sdk.Msg{
+		&types.MsgResetCircuitBreaker{Authority: k.GetAuthority(), MsgTypeUrls: tripped},
+	})
+	suite.Require().NoError(err)
+	handler := suite.app.GovKeeper.Router().GetRoute(proposal.ProposalRoute())
+	suite.Require().NoError(handler(ctx, proposal))
+	suite.Require().Empty(k.GetDisabledList(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgServer() {
+	k, ctx, addrs := suite.app.CircuitKeeper, suite.ctx, suite.addrs
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := suite.msgSrvr.AuthorizeCircuitBreaker(goCtx, &types.MsgAuthorizeCircuitBreaker{
+		Granter:     k.GetAuthority(),
+		Grantee:     addrs[0].String(),
+		Permissions: types.NewPermissions(types.LevelAllMsgs, nil, nil),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgSrvr.TripCircuitBreaker(goCtx, types.NewMsgTripCircuitBreaker(addrs[0], []string{msgSendURL}))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{msgSendURL}, k.GetDisabledList(ctx))
+
+	// the Msg service router refuses to execute the tripped msgs
+	msg := banktypes.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)))
+	_, err = suite.app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	suite.Require().Error(err)
+
+	_, err = suite.msgSrvr.ResetCircuitBreaker(goCtx, types.NewMsgResetCircuitBreaker(addrs[0], []string{msgSendURL}))
+	suite.Require().NoError(err)
+	_, err = suite.app.MsgServiceRouter().Handler(msg)(ctx, msg)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	k, ctx, addrs, queryClient := suite.app.CircuitKeeper, suite.ctx, suite.addrs, suite.queryClient
+
+	permissions := types.NewPermissions(types.LevelSomeMsgs, []string{msgSendURL}, nil)
+	k.SetPermissions(ctx, addrs[0], permissions)
+	k.SetPermissions(ctx, addrs[1], types.NewPermissions(types.LevelSuperAdmin, nil, nil))
+	k.DisableMsg(ctx, msgSendURL)
+
+	account, err := queryClient.Account(gocontext.Background(), &types.QueryAccountRequest{Address: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(permissions, account.Permissions)
+
+	account, err = queryClient.Account(gocontext.Background(), &types.QueryAccountRequest{Address: addrs[2].String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.LevelNone, account.Permissions.Level)
+
+	_, err = queryClient.Account(gocontext.Background(), &types.QueryAccountRequest{Address: "invalid"})
+	suite.Require().Error(err)
+
+	accounts, err := queryClient.Accounts(gocontext.Background(), &types.QueryAccountsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(accounts.Accounts, 2)
+
+	disabled, err := queryClient.DisabledList(gocontext.Background(), &types.QueryDisabledListRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{msgSendURL}, disabled.DisabledList)
+}
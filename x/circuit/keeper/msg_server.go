@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the circuit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AuthorizeCircuitBreaker implements MsgServer.AuthorizeCircuitBreaker method.
+func (k msgServer) AuthorizeCircuitBreaker(goCtx context.Context, msg *types.MsgAuthorizeCircuitBreaker) (*types.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Authorize(ctx, msg.Granter, grantee, msg.Permissions); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker implements MsgServer.TripCircuitBreaker method.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.TripCircuitBreakers(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker implements MsgServer.ResetCircuitBreaker method.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ResetCircuitBreakers(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding circuit type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.AccountPermissionsKeyPrefix):
+			var permissionsA, permissionsB types.Permissions
+			cdc.MustUnmarshal(kvA.Value, &permissionsA)
+			cdc.MustUnmarshal(kvB.Value, &permissionsB)
+			return fmt.Sprintf("%v\n%v", permissionsA, permissionsB)
+		case bytes.Equal(kvA.Key[:1], types.DisabledTypeURLKeyPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Key[1:], kvB.Key[1:])
+		case bytes.Equal(kvA.Key[:1], types.PermissionsExpiryQueuePrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
+		default:
+			panic(fmt.Sprintf("invalid circuit key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/circuit/simulation"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	addr := sdk.AccAddress("addr________________")
+	permissions := types.NewPermissions(types.LevelAllMsgs, nil, nil)
+	expiryKey := types.PermissionsExpiryQueueKey(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), addr)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.AccountPermissionsKey(addr), Value: cdc.MustMarshal(&permissions)},
+			{Key: types.DisabledTypeURLKey("/cosmos.bank.v1beta1.MsgSend"), Value: []byte{}},
+			{Key: expiryKey, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	require.Equal(t, fmt.Sprintf("%v\n%v", permissions, permissions), dec(kvPairs.Pairs[0], kvPairs.Pairs[0]))
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend\n/cosmos.bank.v1beta1.MsgSend", dec(kvPairs.Pairs[1], kvPairs.Pairs[1]))
+	require.Equal(t, fmt.Sprintf("%X\n%X", expiryKey[1:], expiryKey[1:]), dec(kvPairs.Pairs[2], kvPairs.Pairs[2]))
+	require.Panics(t, func() { dec(kvPairs.Pairs[3], kvPairs.Pairs[3]) })
+}
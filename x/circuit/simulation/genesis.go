@@ -0,0 +1,14 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+// RandomizedGenState generates a GenesisState for circuit with no permissions
+// and no tripped circuit breaker, so that every simulated Msg can be executed.
+func RandomizedGenState(simState *module.SimulationState) {
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 2, "invalid permissions")
+	ErrUnauthorized       = sdkerrors.Register(ModuleName, 3, "account not authorized")
+	ErrAlreadyDisabled    = sdkerrors.Register(ModuleName, 4, "message already disabled")
+	ErrNotDisabled        = sdkerrors.Register(ModuleName, 5, "message not disabled")
+	ErrInvalidTypeURL     = sdkerrors.Register(ModuleName, 6, "invalid message type URL")
+)
@@ -0,0 +1,18 @@
+package types
+
+// circuit module event types
+const (
+	EventTypeAuthorizeCircuitBreaker = "authorize_circuit_breaker"
+	EventTypeTripCircuitBreaker      = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker     = "reset_circuit_breaker"
+	EventTypePermissionsExpired      = "circuit_permissions_expired"
+
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyLevel      = "level"
+	AttributeKeyAuthority  = "authority"
+	AttributeKeyMsgTypeURL = "msg_type_url"
+	AttributeKeyAddress    = "address"
+
+	AttributeValueCategory = ModuleName
+)
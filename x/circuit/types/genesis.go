@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState instance.
+func NewGenesisState(accountPermissions []AccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns a default circuit module genesis state, with no
+// permissions and no tripped circuit breaker.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]AccountPermissions{}, []string{})
+}
+
+// Validate performs basic validation of the circuit genesis state, returning
+// an error for an invalid or repeated account, invalid permissions, or
+// invalid or protected disabled Msg type URLs.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.AccountPermissions))
+	for _, ap := range gs.AccountPermissions {
+		if _, err := sdk.AccAddressFromBech32(ap.Address); err != nil {
+			return fmt.Errorf("invalid account address %s: %w", ap.Address, err)
+		}
+		if seen[ap.Address] {
+			return fmt.Errorf("duplicate permissions of account %s", ap.Address)
+		}
+		seen[ap.Address] = true
+
+		if ap.Permissions.Level == LevelNone {
+			return fmt.Errorf("permissions of account %s with level LEVEL_NONE_UNSPECIFIED", ap.Address)
+		}
+		if err := ap.Permissions.Validate(); err != nil {
+			return fmt.Errorf("invalid permissions of account %s: %w", ap.Address, err)
+		}
+	}
+
+	for _, typeURL := range gs.DisabledTypeUrls {
+		if IsProtectedMsg(typeURL) {
+			return fmt.Errorf("circuit breaker of %s cannot be tripped", typeURL)
+		}
+	}
+
+	return ValidateTypeURLs(gs.DisabledTypeUrls)
+}
@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	addr := sdk.AccAddress("addr________________").String()
+	allMsgs := types.NewPermissions(types.LevelAllMsgs, nil, nil)
+	tests := []struct {
+		name    string
+		genesis *types.GenesisState
+	}{
+		{"invalid address", types.NewGenesisState([]types.AccountPermissions{{Address: "invalid", Permissions: allMsgs}}, nil)},
+		{"duplicate address", types.NewGenesisState([]types.AccountPermissions{{addr, allMsgs}, {addr, allMsgs}}, nil)},
+		{"level none", types.NewGenesisState([]types.AccountPermissions{{Address: addr}}, nil)},
+		{"some msgs without type URLs", types.NewGenesisState([]types.AccountPermissions{{addr, types.NewPermissions(types.LevelSomeMsgs, nil, nil)}}, nil)},
+		{"invalid disabled type URL", types.NewGenesisState(nil, []string{"cosmos.bank.v1beta1.MsgSend"})},
+		{"duplicate disabled type URL", types.NewGenesisState(nil, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"})},
+		{"protected disabled type URL", types.NewGenesisState(nil, []string{"/cosmos.gov.v1beta1.MsgSubmitProposal"})},
+	}
+	for _, tc := range tests {
+		require.Error(t, tc.genesis.Validate(), tc.name)
+	}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "circuit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// AccountPermissionsKeyPrefix is the prefix of the keys of the permissions
+	// of the accounts, followed by their length prefixed addresses.
+	AccountPermissionsKeyPrefix = []byte{0x01}
+
+	// DisabledTypeURLKeyPrefix is the prefix of the keys of the type URLs of
+	// the Msgs whose circuit breakers are tripped.
+	DisabledTypeURLKeyPrefix = []byte{0x02}
+
+	// PermissionsExpiryQueuePrefix is the prefix of the keys of the queue of
+	// the permissions by expiration, followed by the expiration and the length
+	// prefixed address of the account.
+	PermissionsExpiryQueuePrefix = []byte{0x03}
+)
+
+// AccountPermissionsKey returns the key of the permissions of the account.
+func AccountPermissionsKey(addr sdk.AccAddress) []byte {
+	return append(AccountPermissionsKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// DisabledTypeURLKey returns the key of the Msg type URL in the disabled list.
+func DisabledTypeURLKey(typeURL string) []byte {
+	return append(DisabledTypeURLKeyPrefix, []byte(typeURL)...)
+}
+
+// PermissionsExpiryQueueTimePrefix returns the prefix of the keys of the
+// permissions expiring at the time.
+func PermissionsExpiryQueueTimePrefix(expiration time.Time) []byte {
+	return append(PermissionsExpiryQueuePrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// PermissionsExpiryQueueKey returns the key of the permissions of the account
+// in the expiry queue.
+func PermissionsExpiryQueueKey(expiration time.Time, addr sdk.AccAddress) []byte {
+	return append(PermissionsExpiryQueueTimePrefix(expiration), address.MustLengthPrefix(addr)...)
+}
+
+// SplitPermissionsExpiryQueueKey returns the address of the account of a key
+// of the expiry queue.
+func SplitPermissionsExpiryQueueKey(key []byte) sdk.AccAddress {
+	addr := key[len(PermissionsExpiryQueuePrefix)+len(sdk.SortableTimeFormat):]
+	return sdk.AccAddress(addr[1:])
+}
+
+// KeyFormats are the layouts of the keys of the circuit store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "account_permissions",
+		Prefix:   AccountPermissionsKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("address")},
+		Value:    keyformat.ProtoValue(&Permissions{}),
+	},
+	{
+		Name:     "disabled_type_url",
+		Prefix:   DisabledTypeURLKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.String("msg_type_url")},
+	},
+	{
+		Name:     "permissions_expiry_queue",
+		Prefix:   PermissionsExpiryQueuePrefix,
+		Segments: []keyformat.Segment{keyformat.Time("expiration"), keyformat.AccAddress("address")},
+	},
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// circuit message types
+const (
+	TypeMsgAuthorizeCircuitBreaker = "authorize_circuit_breaker"
+	TypeMsgTripCircuitBreaker      = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker     = "reset_circuit_breaker"
+)
+
+var (
+	_ sdk.Msg = &MsgAuthorizeCircuitBreaker{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker
+// instance.
+//nolint:interfacer
+func NewMsgAuthorizeCircuitBreaker(granter, grantee sdk.AccAddress, permissions Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter.String(),
+		Grantee:     grantee.String(),
+		Permissions: permissions,
+	}
+}
+
+func (msg MsgAuthorizeCircuitBreaker) Route() string { return RouterKey }
+func (msg MsgAuthorizeCircuitBreaker) Type() string  { return TypeMsgAuthorizeCircuitBreaker }
+func (msg MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Granter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+
+	return msg.Permissions.Validate()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance.
+//nolint:interfacer
+func NewMsgTripCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority.String(),
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+func (msg MsgTripCircuitBreaker) Route() string { return RouterKey }
+func (msg MsgTripCircuitBreaker) Type() string  { return TypeMsgTripCircuitBreaker }
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	return validateAuthorityAndTypeURLs(msg.Authority, msg.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance.
+//nolint:interfacer
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority.String(),
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+func (msg MsgResetCircuitBreaker) Route() string { return RouterKey }
+func (msg MsgResetCircuitBreaker) Type() string  { return TypeMsgResetCircuitBreaker }
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	return validateAuthorityAndTypeURLs(msg.Authority, msg.MsgTypeUrls)
+}
+
+func validateAuthorityAndTypeURLs(authority string, typeURLs []string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if len(typeURLs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidTypeURL, "no msg type URLs")
+	}
+
+	return ValidateTypeURLs(typeURLs)
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// IsProtectedMsg returns true if the circuit breaker of the Msg type URL
+// cannot be tripped: the Msgs of the module, and the Msgs the governance, its
+// authority, needs to reset the circuit breakers, possibly through authz.
+func IsProtectedMsg(typeURL string) bool {
+	switch typeURL {
+	case sdk.MsgTypeURL(&MsgAuthorizeCircuitBreaker{}),
+		sdk.MsgTypeURL(&MsgTripCircuitBreaker{}),
+		sdk.MsgTypeURL(&MsgResetCircuitBreaker{}),
+		sdk.MsgTypeURL(&govtypes.MsgSubmitProposal{}),
+		sdk.MsgTypeURL(&govtypes.MsgDeposit{}),
+		sdk.MsgTypeURL(&govtypes.MsgVote{}),
+		sdk.MsgTypeURL(&govtypes.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govtypes.MsgVetoProposal{}),
+		sdk.MsgTypeURL(&authz.MsgExec{}):
+		return true
+	default:
+		return false
+	}
+}
+
+// NewPermissions returns the permissions of the level, limited to the Msg type
+// URLs with LevelSomeMsgs, and expiring at expiration if it is not nil.
+func NewPermissions(level Permissions_Level, limitTypeURLs []string, expiration *time.Time) Permissions {
+	return Permissions{Level: level, LimitTypeUrls: limitTypeURLs, Expiration: expiration}
+}
+
+// Validate returns an error if the permissions are invalid: the Msg type URLs
+// are limited with LevelSomeMsgs, and only with it.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "unknown level %d", p.Level)
+	}
+
+	if p.Level == LevelSomeMsgs {
+		if len(p.LimitTypeUrls) == 0 {
+			return sdkerrors.Wrap(ErrInvalidPermissions, "no msg type URLs for level LEVEL_SOME_MSGS")
+		}
+	} else if len(p.LimitTypeUrls) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "msg type URLs limited for level %s", p.Level)
+	}
+
+	if err := ValidateTypeURLs(p.LimitTypeUrls); err != nil {
+		return err
+	}
+
+	if p.Expiration != nil && p.Level == LevelNone {
+		return sdkerrors.Wrap(ErrInvalidPermissions, "expiration set for level LEVEL_NONE_UNSPECIFIED")
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the permissions expired at the time.
+func (p Permissions) IsExpired(t time.Time) bool {
+	return p.Expiration != nil && !p.Expiration.After(t)
+}
+
+// CanGrant returns true if the permissions allow to grant permissions to other
+// accounts.
+func (p Permissions) CanGrant() bool {
+	return p.Level == LevelSuperAdmin
+}
+
+// CanTrip returns true if the permissions allow to trip and reset the circuit
+// breaker of the Msg type URL.
+func (p Permissions) CanTrip(typeURL string) bool {
+	switch p.Level {
+	case LevelAllMsgs, LevelSuperAdmin:
+		return true
+	case LevelSomeMsgs:
+		for _, limit := range p.LimitTypeUrls {
+			if limit == typeURL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateTypeURLs returns an error if a Msg type URL is empty, does not start
+// with a slash, or is repeated.
+func ValidateTypeURLs(typeURLs []string) error {
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if len(typeURL) < 2 || typeURL[0] != '/' {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "%q", typeURL)
+		}
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(ErrInvalidTypeURL, "duplicate %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
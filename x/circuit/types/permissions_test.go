@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/circuit/types"
+)
+
+func TestPermissionsValidate(t *testing.T) {
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+
+	tests := []struct {
+		name        string
+		permissions types.Permissions
+		expectErr   bool
+	}{
+		{"none", types.Permissions{}, false},
+		{"none expiring", types.NewPermissions(types.LevelNone, nil, &expiration), true},
+		{"some msgs", types.NewPermissions(types.LevelSomeMsgs, []string{msgSend}, &expiration), false},
+		{"some msgs without type URLs", types.NewPermissions(types.LevelSomeMsgs, nil, nil), true},
+		{"some msgs with invalid type URL", types.NewPermissions(types.LevelSomeMsgs, []string{"/"}, nil), true},
+		{"all msgs with type URLs", types.NewPermissions(types.LevelAllMsgs, []string{msgSend}, nil), true},
+		{"super admin", types.NewPermissions(types.LevelSuperAdmin, nil, nil), false},
+		{"unknown level", types.NewPermissions(types.Permissions_Level(7), nil, nil), true},
+	}
+	for _, tc := range tests {
+		err := tc.permissions.Validate()
+		if tc.expectErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
+func TestPermissionsCanTrip(t *testing.T) {
+	msgSend, msgMultiSend := "/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgMultiSend"
+
+	someMsgs := types.NewPermissions(types.LevelSomeMsgs, []string{msgSend}, nil)
+	require.True(t, someMsgs.CanTrip(msgSend))
+	require.False(t, someMsgs.CanTrip(msgMultiSend))
+	require.False(t, someMsgs.CanGrant())
+
+	allMsgs := types.NewPermissions(types.LevelAllMsgs, nil, nil)
+	require.True(t, allMsgs.CanTrip(msgMultiSend))
+	require.False(t, allMsgs.CanGrant())
+
+	superAdmin := types.NewPermissions(types.LevelSuperAdmin, nil, nil)
+	require.True(t, superAdmin.CanTrip(msgMultiSend))
+	require.True(t, superAdmin.CanGrant())
+
+	require.False(t, types.Permissions{}.CanTrip(msgSend))
+
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiring := types.NewPermissions(types.LevelAllMsgs, nil, &expiration)
+	require.False(t, expiring.IsExpired(expiration.Add(-time.Second)))
+	require.True(t, expiring.IsExpired(expiration))
+	require.False(t, allMsgs.IsExpired(expiration))
+}
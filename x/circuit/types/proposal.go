@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gov "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeCircuitBreakerPermissions string = "CircuitBreakerPermissions"
+)
+
+// NewCircuitBreakerPermissionsProposal creates a new
+// CircuitBreakerPermissionsProposal instance.
+//nolint:interfacer
+func NewCircuitBreakerPermissionsProposal(title, description string, grantee sdk.AccAddress, permissions Permissions) gov.Content {
+	return &CircuitBreakerPermissionsProposal{title, description, grantee.String(), permissions}
+}
+
+// Implements Proposal Interface
+var _ gov.Content = &CircuitBreakerPermissionsProposal{}
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeCircuitBreakerPermissions)
+	gov.RegisterProposalTypeCodec(&CircuitBreakerPermissionsProposal{}, "cosmos-sdk/CircuitBreakerPermissionsProposal")
+}
+
+func (p *CircuitBreakerPermissionsProposal) GetTitle() string       { return p.Title }
+func (p *CircuitBreakerPermissionsProposal) GetDescription() string { return p.Description }
+func (p *CircuitBreakerPermissionsProposal) ProposalRoute() string  { return RouterKey }
+func (p *CircuitBreakerPermissionsProposal) ProposalType() string {
+	return ProposalTypeCircuitBreakerPermissions
+}
+func (p *CircuitBreakerPermissionsProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Grantee); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid grantee address: %s", err)
+	}
+	if err := p.Permissions.Validate(); err != nil {
+		return err
+	}
+	return gov.ValidateAbstract(p)
+}
+
+func (p CircuitBreakerPermissionsProposal) String() string {
+	return fmt.Sprintf(`Circuit Breaker Permissions Proposal:
+  Title:       %s
+  Description: %s
+  Grantee:     %s
+  Level:       %s
+`, p.Title, p.Description, p.Grantee, p.Permissions.Level)
+}
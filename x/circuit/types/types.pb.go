@@ -0,0 +1,1014 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/circuit/v1beta1/types.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Level is the level of the permissions of an account.
+type Permissions_Level int32
+
+const (
+	// LEVEL_NONE_UNSPECIFIED indicates that the account has no permissions.
+	LevelNone Permissions_Level = 0
+	// LEVEL_SOME_MSGS indicates that the account can trip and reset the
+	// circuit breakers of the Msg types of limit_type_urls.
+	LevelSomeMsgs Permissions_Level = 1
+	// LEVEL_ALL_MSGS indicates that the account can trip and reset the circuit
+	// breakers of all the Msg types.
+	LevelAllMsgs Permissions_Level = 2
+	// LEVEL_SUPER_ADMIN indicates that the account can trip and reset the
+	// circuit breakers of all the Msg types, and grant permissions to other
+	// accounts.
+	LevelSuperAdmin Permissions_Level = 3
+)
+
+var Permissions_Level_name = map[int32]string{
+	0: "LEVEL_NONE_UNSPECIFIED",
+	1: "LEVEL_SOME_MSGS",
+	2: "LEVEL_ALL_MSGS",
+	3: "LEVEL_SUPER_ADMIN",
+}
+
+var Permissions_Level_value = map[string]int32{
+	"LEVEL_NONE_UNSPECIFIED": 0,
+	"LEVEL_SOME_MSGS":        1,
+	"LEVEL_ALL_MSGS":         2,
+	"LEVEL_SUPER_ADMIN":      3,
+}
+
+func (x Permissions_Level) String() string {
+	return proto.EnumName(Permissions_Level_name, int32(x))
+}
+
+func (Permissions_Level) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{0, 0}
+}
+
+// Permissions are the permissions of an account to trip and reset the circuit
+// breakers of Msg types.
+type Permissions struct {
+	// level is the level of the permissions.
+	Level Permissions_Level `protobuf:"varint,1,opt,name=level,proto3,enum=cosmos.circuit.v1beta1.Permissions_Level" json:"level,omitempty"`
+	// limit_type_urls are the type URLs of the Msgs whose circuit breakers the
+	// account can trip and reset with LEVEL_SOME_MSGS.
+	LimitTypeUrls []string `protobuf:"bytes,2,rep,name=limit_type_urls,json=limitTypeUrls,proto3" json:"limit_type_urls,omitempty" yaml:"limit_type_urls"`
+	// expiration is the time at which the permissions expire, if any.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *Permissions) Reset()         { *m = Permissions{} }
+func (m *Permissions) String() string { return proto.CompactTextString(m) }
+func (*Permissions) ProtoMessage()    {}
+func (*Permissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{0}
+}
+func (m *Permissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Permissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Permissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Permissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permissions.Merge(m, src)
+}
+func (m *Permissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *Permissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Permissions proto.InternalMessageInfo
+
+func (m *Permissions) GetLevel() Permissions_Level {
+	if m != nil {
+		return m.Level
+	}
+	return LevelNone
+}
+
+func (m *Permissions) GetLimitTypeUrls() []string {
+	if m != nil {
+		return m.LimitTypeUrls
+	}
+	return nil
+}
+
+func (m *Permissions) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// AccountPermissions are the permissions of an account.
+type AccountPermissions struct {
+	Address     string      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Permissions Permissions `protobuf:"bytes,2,opt,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *AccountPermissions) Reset()         { *m = AccountPermissions{} }
+func (m *AccountPermissions) String() string { return proto.CompactTextString(m) }
+func (*AccountPermissions) ProtoMessage()    {}
+func (*AccountPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{1}
+}
+func (m *AccountPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountPermissions.Merge(m, src)
+}
+func (m *AccountPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountPermissions proto.InternalMessageInfo
+
+func (m *AccountPermissions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountPermissions) GetPermissions() Permissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return Permissions{}
+}
+
+// CircuitBreakerPermissionsProposal is a gov Content type to set the
+// permissions of an account to trip and reset circuit breakers, LEVEL_NONE
+// revoking them.
+type CircuitBreakerPermissionsProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Grantee     string      `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Permissions Permissions `protobuf:"bytes,4,opt,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *CircuitBreakerPermissionsProposal) Reset()      { *m = CircuitBreakerPermissionsProposal{} }
+func (*CircuitBreakerPermissionsProposal) ProtoMessage() {}
+func (*CircuitBreakerPermissionsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45b02a6a30e2e950, []int{2}
+}
+func (m *CircuitBreakerPermissionsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerPermissionsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerPermissionsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerPermissionsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerPermissionsProposal.Merge(m, src)
+}
+func (m *CircuitBreakerPermissionsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerPermissionsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerPermissionsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerPermissionsProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos.circuit.v1beta1.Permissions_Level", Permissions_Level_name, Permissions_Level_value)
+	proto.RegisterType((*Permissions)(nil), "cosmos.circuit.v1beta1.Permissions")
+	proto.RegisterType((*AccountPermissions)(nil), "cosmos.circuit.v1beta1.AccountPermissions")
+	proto.RegisterType((*CircuitBreakerPermissionsProposal)(nil), "cosmos.circuit.v1beta1.CircuitBreakerPermissionsProposal")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/circuit/v1beta1/types.proto", fileDescriptor_45b02a6a30e2e950)
+}
+
+var fileDescriptor_45b02a6a30e2e950 = []byte{
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x14, 0x85, 0xed, 0x24, 0xfd, 0x7f, 0x32, 0xa1, 0x6d, 0x6a, 0xaa, 0x2a, 0xf2, 0xc2, 0x36, 0x06,
+	0xa1, 0x14, 0x81, 0xad, 0x96, 0x5d, 0x37, 0x10, 0xb7, 0x2e, 0x8a, 0x48, 0xd2, 0xc8, 0x69, 0x58,
+	0xb0, 0xb1, 0x1c, 0x67, 0x30, 0xa3, 0xda, 0x1e, 0x6b, 0x66, 0x5c, 0x35, 0xe2, 0x05, 0x4a, 0x56,
+	0x5d, 0xb2, 0x89, 0x54, 0x89, 0x37, 0xe0, 0x29, 0xba, 0x60, 0xd1, 0x25, 0xab, 0x82, 0x92, 0x37,
+	0xe0, 0x09, 0x50, 0xc6, 0x09, 0x58, 0x15, 0x0b, 0x24, 0x56, 0xf6, 0x1d, 0x7f, 0xe7, 0xfa, 0x1e,
+	0x1f, 0x5f, 0xa0, 0xfb, 0x98, 0x46, 0x98, 0x9a, 0x3e, 0x22, 0x7e, 0x8a, 0x98, 0x79, 0xba, 0x33,
+	0x80, 0xcc, 0xdb, 0x31, 0xd9, 0x28, 0x81, 0xd4, 0x48, 0x08, 0x66, 0x58, 0xda, 0xca, 0x18, 0x63,
+	0xc1, 0x18, 0x0b, 0x46, 0xde, 0x0c, 0x70, 0x80, 0x39, 0x62, 0xce, 0xef, 0x32, 0x5a, 0x56, 0x03,
+	0x8c, 0x83, 0x10, 0x9a, 0xbc, 0x1a, 0xa4, 0x6f, 0x4d, 0x86, 0x22, 0x48, 0x99, 0x17, 0x25, 0x19,
+	0xa0, 0x7f, 0x28, 0x82, 0x4a, 0x17, 0x92, 0x08, 0x51, 0x8a, 0x70, 0x4c, 0xa5, 0xe7, 0x60, 0x25,
+	0x84, 0xa7, 0x30, 0xac, 0x89, 0x9a, 0x58, 0x5f, 0xdb, 0xdd, 0x36, 0xfe, 0xfc, 0x3a, 0x23, 0xa7,
+	0x31, 0x5a, 0x73, 0x81, 0x93, 0xe9, 0x24, 0x0b, 0xac, 0x87, 0x28, 0x42, 0xcc, 0x9d, 0x0f, 0xed,
+	0xa6, 0x24, 0xa4, 0xb5, 0x82, 0x56, 0xac, 0x97, 0x2d, 0xf9, 0xc7, 0x8d, 0xba, 0x35, 0xf2, 0xa2,
+	0x70, 0x4f, 0xbf, 0x05, 0xe8, 0xce, 0x2a, 0x3f, 0x39, 0x1e, 0x25, 0xb0, 0x4f, 0x42, 0x2a, 0xbd,
+	0x00, 0x00, 0x9e, 0x25, 0x88, 0x78, 0x0c, 0xe1, 0xb8, 0x56, 0xd4, 0xc4, 0x7a, 0x65, 0x57, 0x36,
+	0x32, 0x2b, 0xc6, 0xd2, 0x8a, 0x71, 0xbc, 0xb4, 0x62, 0x95, 0x2e, 0xbe, 0xa9, 0xa2, 0x93, 0xd3,
+	0xe8, 0x9f, 0x45, 0xb0, 0xc2, 0xc7, 0x92, 0xb6, 0xc1, 0x56, 0xcb, 0x7e, 0x6d, 0xb7, 0xdc, 0xce,
+	0x51, 0xc7, 0x76, 0xfb, 0x9d, 0x5e, 0xd7, 0xde, 0x6f, 0x1e, 0x36, 0xed, 0x83, 0xaa, 0x20, 0xaf,
+	0x8e, 0x27, 0x5a, 0x99, 0x63, 0x1d, 0x1c, 0x43, 0xe9, 0x11, 0x58, 0xcf, 0xd0, 0xde, 0x51, 0xdb,
+	0x76, 0xdb, 0xbd, 0x97, 0xbd, 0xaa, 0x28, 0x6f, 0x8c, 0x27, 0xda, 0x2a, 0x67, 0x7a, 0x38, 0x82,
+	0x6d, 0x1a, 0x50, 0xe9, 0x21, 0x58, 0xcb, 0xb8, 0x46, 0xab, 0x95, 0x61, 0x05, 0xb9, 0x3a, 0x9e,
+	0x68, 0x77, 0x39, 0xd6, 0x08, 0x43, 0x4e, 0x3d, 0x06, 0x1b, 0x8b, 0x6e, 0xfd, 0xae, 0xed, 0xb8,
+	0x8d, 0x83, 0x76, 0xb3, 0x53, 0x2d, 0xca, 0xf7, 0xc6, 0x13, 0x6d, 0x3d, 0xeb, 0x97, 0x26, 0x90,
+	0x34, 0x86, 0x11, 0x8a, 0xe5, 0xd2, 0xf9, 0x27, 0x45, 0xd0, 0xdf, 0x03, 0xa9, 0xe1, 0xfb, 0x38,
+	0x8d, 0x59, 0x3e, 0x91, 0x1a, 0xf8, 0xdf, 0x1b, 0x0e, 0x09, 0xa4, 0x94, 0x67, 0x52, 0x76, 0x96,
+	0xa5, 0xf4, 0x0a, 0x54, 0x92, 0xdf, 0x60, 0xad, 0xc0, 0xbf, 0xd3, 0x83, 0xbf, 0x48, 0xcc, 0x2a,
+	0x5d, 0xdd, 0xa8, 0x82, 0x93, 0x57, 0xeb, 0x5f, 0x44, 0x70, 0x7f, 0x3f, 0x93, 0x58, 0x04, 0x7a,
+	0x27, 0x90, 0xe4, 0x04, 0x5d, 0x82, 0x13, 0x4c, 0xbd, 0x50, 0xda, 0x04, 0x2b, 0x0c, 0xb1, 0x10,
+	0x2e, 0x46, 0xc9, 0x0a, 0x49, 0x03, 0x95, 0x21, 0xa4, 0x3e, 0x41, 0x09, 0x0f, 0xac, 0xc0, 0x9f,
+	0xe5, 0x8f, 0xe6, 0x26, 0x02, 0xe2, 0xc5, 0x0c, 0x42, 0x1e, 0x67, 0xd9, 0x59, 0x96, 0xb7, 0x4d,
+	0x94, 0xfe, 0xc5, 0xc4, 0xde, 0x9d, 0xf3, 0x4b, 0x55, 0xf8, 0x78, 0xa9, 0x0a, 0xd6, 0xe1, 0xd5,
+	0x54, 0x11, 0xaf, 0xa7, 0x8a, 0xf8, 0x7d, 0xaa, 0x88, 0x17, 0x33, 0x45, 0xb8, 0x9e, 0x29, 0xc2,
+	0xd7, 0x99, 0x22, 0xbc, 0x79, 0x12, 0x20, 0xf6, 0x2e, 0x1d, 0x18, 0x3e, 0x8e, 0xcc, 0xe5, 0xbe,
+	0xf1, 0xcb, 0x53, 0x3a, 0x3c, 0x31, 0xcf, 0x7e, 0x2d, 0x1f, 0x5f, 0xba, 0xc1, 0x7f, 0xfc, 0x77,
+	0x7b, 0xf6, 0x73, 0x00, 0xe8, 0x49, 0xf2, 0xca, 0x9b, 0x03, 0x00, 0x00,
+}
+
+func (m *Permissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Permissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Permissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LimitTypeUrls) > 0 {
+		for iNdEx := len(m.LimitTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LimitTypeUrls[iNdEx])
+			copy(dAtA[i:], m.LimitTypeUrls[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.LimitTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Level != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerPermissionsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerPermissionsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerPermissionsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Permissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovTypes(uint64(m.Level))
+	}
+	if len(m.LimitTypeUrls) > 0 {
+		for _, s := range m.LimitTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Permissions.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerPermissionsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Permissions.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Permissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Permissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Permissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= Permissions_Level(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitTypeUrls = append(m.LimitTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerPermissionsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerPermissionsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerPermissionsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)
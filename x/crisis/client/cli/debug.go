@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// FlagHeight is the height of the state to check the invariants of.
+const FlagHeight = "height"
+
+// InvariantsRunner loads the application from db at height, or at its latest
+// height if height is -1, and checks all its registered invariants, e.g. with
+// the crisis keeper's CheckInvariants.
+type InvariantsRunner func(logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, appOpts servertypes.AppOptions) (types.InvariantsReport, error)
+
+// NewRunInvariantsCmd returns a command checking the invariants of the state of
+// a node's data directory, meant to be registered under the debug command.
+func NewRunInvariantsCmd(runner InvariantsRunner, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run-invariants",
+		Short: "Check the invariants of the state of a node's data directory",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Open the application database of a node's data directory read-only, load the
+state at the given height and check all the registered invariants, printing a
+report of their results. Unlike the crisis module, a broken invariant does not
+halt anything: the command fails after printing the report.
+
+The database being opened read-only, it cannot be opened while the node is
+running with the goleveldb backend, which locks it; check a copy of the data
+directory instead, e.g. from a filesystem snapshot.
+
+Example:
+  $ %s debug run-invariants --home ~/.simapp-copy --height 1000
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			height, err := cmd.Flags().GetInt64(FlagHeight)
+			if err != nil {
+				return err
+			}
+
+			// the errors below are not caused by the usage of the command
+			cmd.SilenceUsage = true
+
+			db, err := sdk.NewReadOnlyLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("failed to open the application database, which cannot be opened while a node is running on it: %w", err)
+			}
+			defer db.Close()
+
+			report, err := runner(serverCtx.Logger, db, nil, height, serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error checking the invariants: %w", err)
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			if report.Broken > 0 {
+				return fmt.Errorf("%d of %d invariants are broken at height %d", report.Broken, len(report.Results), report.Height)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, -1, "Check the state of a particular height (-1 means latest height)")
+
+	return cmd
+}
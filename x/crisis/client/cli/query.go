@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns the cli query commands for the crisis module.
+func GetQueryCmd() *cobra.Command {
+	crisisQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	crisisQueryCmd.AddCommand(
+		GetCmdQueryInvariantsReport(),
+	)
+
+	return crisisQueryCmd
+}
+
+// GetCmdQueryInvariantsReport implements a command to return the report of
+// the last background check of the invariants by the queried node.
+func GetCmdQueryInvariantsReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariants-report",
+		Short: "Query the report of the last background check of the invariants by the node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InvariantsReport(cmd.Context(), &types.QueryInvariantsReportRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Report)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
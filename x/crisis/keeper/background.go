@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// invariantsReportStore holds the report of the last background check of the
+// invariants. It is node-local, and never part of the state.
+type invariantsReportStore struct {
+	mtx    sync.RWMutex
+	report *types.InvariantsReport
+}
+
+// SetLastInvariantsReport records the report of the last background check of
+// the invariants, returned by the InvariantsReport query.
+func (k Keeper) SetLastInvariantsReport(report types.InvariantsReport) {
+	k.lastReport.mtx.Lock()
+	defer k.lastReport.mtx.Unlock()
+
+	k.lastReport.report = &report
+}
+
+// GetLastInvariantsReport returns the report of the last background check of
+// the invariants, and false if none ran yet.
+func (k Keeper) GetLastInvariantsReport() (types.InvariantsReport, bool) {
+	k.lastReport.mtx.RLock()
+	defer k.lastReport.mtx.RUnlock()
+
+	if k.lastReport.report == nil {
+		return types.InvariantsReport{}, false
+	}
+	return *k.lastReport.report, true
+}
+
+// BackgroundChecker checks the invariants on a node-local schedule against the
+// latest committed state, off the consensus path: the blocks are executed
+// while the invariants are checked, and a broken invariant is reported through
+// telemetry, the logs and the InvariantsReport query instead of halting the
+// node. Halting on a broken invariant remains an opt-in of the EndBlocker,
+// with the invariant check period of the keeper.
+type BackgroundChecker struct {
+	keeper   Keeper
+	logger   log.Logger
+	interval time.Duration
+
+	// lastHeight returns the latest committed height, and newCtx a context on
+	// a branch of the committed state of a height, e.g. with the
+	// LastBlockHeight and CreateQueryContext methods of BaseApp.
+	lastHeight func() int64
+	newCtx     func(height int64) (sdk.Context, error)
+
+	checkedHeight int64
+}
+
+// NewBackgroundChecker creates a BackgroundChecker checking the invariants of
+// the keeper every interval, with contexts on the committed state of the
+// heights returned by newCtx.
+func NewBackgroundChecker(
+	k Keeper, logger log.Logger, interval time.Duration,
+	lastHeight func() int64, newCtx func(height int64) (sdk.Context, error),
+) *BackgroundChecker {
+	return &BackgroundChecker{
+		keeper:     k,
+		logger:     logger.With("module", "x/"+types.ModuleName),
+		interval:   interval,
+		lastHeight: lastHeight,
+		newCtx:     newCtx,
+	}
+}
+
+// Start checks the invariants every interval in a new goroutine, until ctx is
+// done. The returned channel is closed once the goroutine exited, after the
+// check in progress, if any, completed.
+func (c *BackgroundChecker) Start(ctx context.Context) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, _, err := c.Check(); err != nil {
+					c.logger.Error("failed to check the invariants", "err", err)
+				}
+			}
+		}
+	}()
+
+	return done
+}
+
+// Check checks the invariants at the latest committed height, unless they
+// were already checked at it, records the report and publishes it through
+// telemetry. It returns false if no check ran.
+func (c *BackgroundChecker) Check() (types.InvariantsReport, bool, error) {
+	height := c.lastHeight()
+	if height == 0 || height == c.checkedHeight {
+		return types.InvariantsReport{}, false, nil
+	}
+
+	ctx, err := c.newCtx(height)
+	if err != nil {
+		return types.InvariantsReport{}, false, err
+	}
+
+	start := time.Now()
+	report := types.NewInvariantsReport(height, c.keeper.CheckInvariants(ctx), start)
+	c.checkedHeight = height
+	c.keeper.SetLastInvariantsReport(report)
+
+	for _, res := range report.Results {
+		broken := float32(0)
+		if res.Broken {
+			broken = 1
+			c.logger.Error("invariant broken", "height", height, "module", res.ModuleName, "route", res.Route, "message", res.Message)
+		}
+		telemetry.SetGaugeWithLabels(
+			[]string{types.ModuleName, "invariant", "broken"}, broken,
+			[]metrics.Label{telemetry.NewLabel("module", res.ModuleName), telemetry.NewLabel("route", res.Route)},
+		)
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, float32(report.Broken), "invariants", "broken")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(height), "invariants", "checked_height")
+	telemetry.ModuleMeasureSince(types.ModuleName, start, "invariants", "check")
+
+	c.logger.Info("checked invariants in the background", "height", height, "broken", report.Broken, "duration", report.Duration)
+
+	return report, true, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestBackgroundChecker(t *testing.T) {
+	app := simapp.Setup(false)
+
+	_, err := app.CrisisKeeper.InvariantsReport(sdk.WrapSDKContext(app.NewContext(true, tmproto.Header{})), &types.QueryInvariantsReportRequest{})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+	app.Commit()
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(ctx sdk.Context) (string, bool) { return "broken", true })
+	checker := keeper.NewBackgroundChecker(
+		app.CrisisKeeper, log.NewNopLogger(), time.Minute, app.LastBlockHeight,
+		func(height int64) (sdk.Context, error) { return app.CreateQueryContext(height, false) },
+	)
+
+	report, checked, err := checker.Check()
+	require.NoError(t, err)
+	require.True(t, checked)
+	require.Equal(t, app.LastBlockHeight(), report.Height)
+	require.Equal(t, 1, report.Broken)
+	require.Equal(t, len(app.CrisisKeeper.Routes()), len(report.Results))
+
+	// the invariants are not checked again at the same height
+	_, checked, err = checker.Check()
+	require.NoError(t, err)
+	require.False(t, checked)
+
+	res, err := app.CrisisKeeper.InvariantsReport(sdk.WrapSDKContext(app.NewContext(true, tmproto.Header{})), &types.QueryInvariantsReportRequest{})
+	require.NoError(t, err)
+	require.Equal(t, report, res.Report)
+}
+
+func TestBackgroundCheckerStop(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+
+	checker := keeper.NewBackgroundChecker(
+		app.CrisisKeeper, log.NewNopLogger(), time.Millisecond, app.LastBlockHeight,
+		func(height int64) (sdk.Context, error) { return app.CreateQueryContext(height, false) },
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := checker.Start(ctx)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the background checker did not exit")
+	}
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InvariantsReport returns the report of the last background check of the
+// invariants run by the node.
+func (k Keeper) InvariantsReport(_ context.Context, _ *types.QueryInvariantsReportRequest) (*types.QueryInvariantsReportResponse, error) {
+	report, found := k.GetLastInvariantsReport()
+	if !found {
+		return nil, status.Error(codes.NotFound, "the invariants have not been checked in the background yet")
+	}
+
+	return &types.QueryInvariantsReportResponse{Report: report}, nil
+}
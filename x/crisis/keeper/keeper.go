@@ -17,14 +17,18 @@ type Keeper struct {
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
-	supplyKeeper types.SupplyKeeper
+	bankKeeper types.BankKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// lastReport is the report of the last background check of the
+	// invariants, shared by the copies of the keeper
+	lastReport *invariantsReportStore
 }
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
+	paramSpace paramtypes.Subspace, invCheckPeriod uint, bankKeeper types.BankKeeper,
 	feeCollectorName string,
 ) Keeper {
 
@@ -37,8 +41,9 @@ func NewKeeper(
 		routes:           make([]types.InvarRoute, 0),
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
-		supplyKeeper:     supplyKeeper,
+		bankKeeper:       bankKeeper,
 		feeCollectorName: feeCollectorName,
+		lastReport:       &invariantsReportStore{},
 	}
 }
 
@@ -90,10 +95,35 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// CheckInvariants checks all registered invariants and returns their results
+// instead of panicking when one is broken. An invariant panicking, e.g. on
+// state it is unable to decode, is reported as broken.
+func (k Keeper) CheckInvariants(ctx sdk.Context) []types.InvariantResult {
+	results := make([]types.InvariantResult, len(k.routes))
+	for i, ir := range k.routes {
+		results[i] = checkInvariant(ctx, ir)
+	}
+
+	return results
+}
+
+func checkInvariant(ctx sdk.Context, ir types.InvarRoute) (res types.InvariantResult) {
+	res = types.InvariantResult{ModuleName: ir.ModuleName, Route: ir.Route}
+	defer func() {
+		if r := recover(); r != nil {
+			res.Broken = true
+			res.Message = fmt.Sprintf("invariant panicked: %v", r)
+		}
+	}()
+
+	res.Message, res.Broken = ir.Invar(ctx)
+	return res
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
 // SendCoinsFromAccountToFeeCollector transfers amt to the fee collector account.
 func (k Keeper) SendCoinsFromAccountToFeeCollector(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
-	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
 }
@@ -2,13 +2,18 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/testutil"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -44,3 +49,40 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	app.Commit()
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+
+	ctx := app.NewContext(true, tmproto.Header{})
+	n := len(app.CrisisKeeper.Routes())
+
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute1", func(sdk.Context) (string, bool) { return "ok", false })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "broken", true })
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute3", func(sdk.Context) (string, bool) { panic("corrupted") })
+
+	var results []types.InvariantResult
+	require.NotPanics(t, func() { results = app.CrisisKeeper.CheckInvariants(ctx) })
+	require.Len(t, results, n+3)
+	for _, res := range results[:n] {
+		require.False(t, res.Broken, res.Message)
+	}
+	require.Equal(t, []types.InvariantResult{
+		{ModuleName: "testModule", Route: "testRoute1", Broken: false, Message: "ok"},
+		{ModuleName: "testModule", Route: "testRoute2", Broken: true, Message: "broken"},
+		{ModuleName: "testModule", Route: "testRoute3", Broken: true, Message: "invariant panicked: corrupted"},
+	}, results[n:])
+
+	report := types.NewInvariantsReport(ctx.BlockHeight(), results, time.Now())
+	require.Equal(t, 2, report.Broken)
+}
+
+func TestSendCoinsFromAccountToFeeCollector(t *testing.T) {
+	f := testutil.NewFixture(t)
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	fee := f.CrisisKeeper.GetConstantFee(f.Ctx)
+
+	f.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), addr, authtypes.FeeCollectorName, sdk.NewCoins(fee)).Return(nil)
+	require.NoError(t, f.CrisisKeeper.SendCoinsFromAccountToFeeCollector(f.Ctx, addr, sdk.NewCoins(fee)))
+}
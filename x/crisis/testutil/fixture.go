@@ -0,0 +1,31 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/fixture"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// Fixture is a crisis keeper built in isolation, with a mocked bank keeper.
+type Fixture struct {
+	*fixture.Fixture
+
+	CrisisKeeper keeper.Keeper
+	BankKeeper   *MockBankKeeper
+}
+
+// NewFixture creates a crisis keeper with a mocked bank keeper, no registered
+// invariants and the default params.
+func NewFixture(t *testing.T) *Fixture {
+	f := &Fixture{Fixture: fixture.New(t, nil, crisis.AppModuleBasic{})}
+	f.BankKeeper = NewMockBankKeeper(f.Ctrl)
+
+	f.CrisisKeeper = keeper.NewKeeper(f.Subspace(types.ModuleName), 1, f.BankKeeper, authtypes.FeeCollectorName)
+	f.CrisisKeeper.SetConstantFee(f.Ctx, types.DefaultGenesisState().ConstantFee)
+
+	return f
+}
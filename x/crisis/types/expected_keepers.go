@@ -4,7 +4,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// SupplyKeeper defines the expected supply keeper (noalias)
-type SupplyKeeper interface {
+// BankKeeper defines the expected bank keeper (noalias)
+type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// SupplyKeeper defines the expected supply keeper.
+//
+// Deprecated: use BankKeeper, the keepers of all the modules being named after
+// the module providing them.
+type SupplyKeeper = BankKeeper
@@ -80,7 +80,7 @@ func init() {
 }
 
 var fileDescriptor_7a9c2781aa8a27ae = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
+	// 235 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
 	0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
@@ -95,7 +95,7 @@ var fileDescriptor_7a9c2781aa8a27ae = []byte{
 	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d,
 	0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x16, 0x02, 0x60, 0x4a, 0xb7, 0x38,
 	0x25, 0x5b, 0xbf, 0x02, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x87, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x43, 0x06, 0xff, 0x2c, 0x01, 0x00, 0x00,
+	0x03, 0x06, 0x00, 0x2f, 0x43, 0x06, 0xff, 0x2c, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,16 @@
+package types
+
+import "time"
+
+// NewInvariantsReport creates the report of the given results at height,
+// checked from start.
+func NewInvariantsReport(height int64, results []InvariantResult, start time.Time) InvariantsReport {
+	report := InvariantsReport{Height: height, Results: results, Time: start.UTC(), Duration: time.Since(start)}
+	for _, res := range results {
+		if res.Broken {
+			report.Broken++
+		}
+	}
+
+	return report
+}
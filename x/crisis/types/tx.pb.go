@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -113,27 +114,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/crisis/v1beta1/tx.proto", fileDescriptor_61276163172fe867) }
 
 var fileDescriptor_61276163172fe867 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2c, 0xce, 0x2c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x85, 0xc8, 0xeb, 0x41,
-	0xe4, 0xf5, 0xa0, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20, 0x16, 0x44,
-	0xb1, 0xd2, 0x45, 0x46, 0x2e, 0x21, 0xdf, 0xe2, 0xf4, 0xb0, 0xd4, 0xa2, 0xcc, 0xb4, 0x4a, 0xcf,
-	0xbc, 0xb2, 0xc4, 0xa2, 0xcc, 0xc4, 0xbc, 0x12, 0x21, 0x31, 0x2e, 0xb6, 0xe2, 0xd4, 0xbc, 0x94,
-	0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x28, 0x4f, 0x28, 0x84, 0x4b, 0x34, 0x13,
-	0xa6, 0x28, 0x3e, 0x37, 0x3f, 0xa5, 0x34, 0x27, 0x35, 0x3e, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x09,
-	0xa4, 0xcc, 0x49, 0xe1, 0xd3, 0x3d, 0x79, 0x99, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0xac, 0xca,
-	0x94, 0x82, 0x84, 0xe1, 0xe2, 0xbe, 0x60, 0x61, 0xbf, 0xc4, 0xdc, 0x54, 0x21, 0x67, 0x2e, 0x7e,
-	0x84, 0xf2, 0xa2, 0xfc, 0xd2, 0x92, 0x54, 0x09, 0x66, 0xb0, 0x79, 0x52, 0x9f, 0xee, 0xc9, 0x8b,
-	0xa1, 0x9b, 0x07, 0x56, 0xa0, 0x14, 0xc4, 0x07, 0x17, 0x09, 0x02, 0x09, 0x58, 0x71, 0x74, 0x2c,
-	0x90, 0x67, 0x78, 0xb1, 0x40, 0x9e, 0x41, 0x49, 0x86, 0x4b, 0x0a, 0xd3, 0x4b, 0x41, 0xa9, 0xc5,
-	0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46, 0x65, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0xf9, 0x5c, 0xfc,
-	0xe8, 0x9e, 0xd6, 0xd4, 0xc3, 0x1a, 0x72, 0x7a, 0x98, 0x86, 0x49, 0x19, 0x12, 0xad, 0x14, 0x66,
-	0xaf, 0x93, 0xeb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa7, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0xe2, 0x16, 0x4c, 0xe9, 0x16, 0xa7,
-	0x64, 0xeb, 0x57, 0xc0, 0x22, 0xba, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x6f, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x60, 0x68, 0x5a, 0x06, 0x02, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0x6e, 0xf2, 0x30,
+	0x14, 0xc5, 0x93, 0x0f, 0x09, 0xe9, 0xf3, 0x50, 0xa4, 0x50, 0x28, 0x8a, 0x90, 0x83, 0x32, 0xf5,
+	0x8f, 0x1a, 0x2b, 0xed, 0xc6, 0x48, 0xd5, 0xa1, 0x03, 0x1d, 0xa2, 0xaa, 0x43, 0x17, 0x14, 0xc0,
+	0x4d, 0xa3, 0xe2, 0x18, 0xf9, 0x9a, 0x08, 0xd6, 0x4e, 0x1d, 0xfb, 0x08, 0x3c, 0x42, 0x1f, 0xa3,
+	0x23, 0x63, 0x87, 0x0a, 0x55, 0x30, 0xb4, 0x33, 0x4f, 0x50, 0xc5, 0x49, 0xa8, 0x04, 0x0c, 0x9d,
+	0xae, 0x7d, 0xce, 0x4f, 0xc7, 0xbe, 0xbe, 0x46, 0xb8, 0xc7, 0x81, 0x71, 0x20, 0x3d, 0x11, 0x42,
+	0x08, 0x24, 0x76, 0xbb, 0x54, 0xfa, 0x2e, 0x91, 0x63, 0x67, 0x28, 0xb8, 0xe4, 0x46, 0x25, 0xf5,
+	0x9d, 0xd4, 0x77, 0x32, 0xdf, 0xdc, 0x0f, 0x78, 0xc0, 0x15, 0x41, 0x92, 0x55, 0x0a, 0x9b, 0x07,
+	0x59, 0x18, 0x83, 0x80, 0xc4, 0x6e, 0x52, 0x52, 0xc3, 0xfe, 0xd0, 0x91, 0xd1, 0x86, 0xe0, 0x96,
+	0x8a, 0xf0, 0x7e, 0x72, 0x15, 0xc5, 0xbe, 0x08, 0xfd, 0x48, 0x1a, 0x55, 0x54, 0x04, 0x1a, 0xf5,
+	0xa9, 0xa8, 0xe9, 0x0d, 0xfd, 0xf0, 0xbf, 0x97, 0xed, 0x8c, 0x1b, 0x54, 0x09, 0x73, 0xa8, 0xc3,
+	0x78, 0x7f, 0x34, 0xa0, 0x9d, 0xc8, 0x67, 0xb4, 0xf6, 0x2f, 0xc1, 0x5a, 0x8d, 0xd5, 0xdc, 0xaa,
+	0x4f, 0x7c, 0x36, 0x68, 0xda, 0x3b, 0x31, 0xdb, 0x2b, 0xaf, 0xf5, 0xb6, 0x92, 0xaf, 0x7d, 0x46,
+	0x8d, 0x0b, 0x54, 0xfa, 0xc5, 0x05, 0x1f, 0x49, 0x5a, 0x2b, 0xa8, 0x3c, 0x73, 0x35, 0xb7, 0xaa,
+	0x9b, 0x79, 0x0a, 0xb0, 0xbd, 0xbd, 0xb5, 0xe2, 0x25, 0x42, 0xb3, 0xfc, 0x3c, 0xb5, 0xb4, 0xef,
+	0xa9, 0xa5, 0x3d, 0x7d, 0xbd, 0x1e, 0x67, 0xf7, 0xb5, 0xeb, 0xc8, 0xdc, 0xee, 0xce, 0xa3, 0x30,
+	0xe4, 0x11, 0xd0, 0xb3, 0x18, 0x15, 0xda, 0x10, 0x18, 0x1c, 0x95, 0x36, 0xfb, 0x3f, 0x72, 0x76,
+	0xbe, 0xae, 0xb3, 0x1d, 0x66, 0xba, 0x7f, 0x46, 0xf3, 0x73, 0x5b, 0x97, 0x6f, 0x0b, 0xac, 0xcf,
+	0x16, 0x58, 0xff, 0x5c, 0x60, 0xfd, 0x65, 0x89, 0xb5, 0xd9, 0x12, 0x6b, 0xef, 0x4b, 0xac, 0xdd,
+	0x9d, 0x04, 0xa1, 0x7c, 0x18, 0x75, 0x9d, 0x1e, 0x67, 0x24, 0x9f, 0xbf, 0x2a, 0xa7, 0xd0, 0x7f,
+	0x24, 0xe3, 0xfc, 0x33, 0xc8, 0xc9, 0x90, 0x42, 0xb7, 0xa8, 0x46, 0x78, 0xfe, 0x33, 0x00, 0x43,
+	0x3d, 0xd9, 0x10, 0x2a, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -41,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetCommissionSplitCmd(),
 	)
 
 	return distTxCmd
@@ -266,6 +267,61 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+func NewSetCommissionSplitCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-commission-split [recipient:weight]...",
+		Short: "Split the withdrawn commission of a validator between recipients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the recipients the withdrawn commission of the validator operated by the
+sender is split between, by weight. The weights must sum up to 1. Without
+recipients, the split is removed and the commission is sent to the withdraw
+address of the validator again.
+
+Example:
+$ %s tx distribution set-commission-split %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p:0.6 %s1hxr8m38zqtn7yc9fkkwa6kp4vvcuxd8aqars7r:0.4 --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			recipients := make([]types.CommissionSplitRecipient, len(args))
+			for i, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid recipient %s, expected recipient:weight", arg)
+				}
+
+				addr, err := sdk.AccAddressFromBech32(parts[0])
+				if err != nil {
+					return err
+				}
+				weight, err := sdk.NewDecFromStr(parts[1])
+				if err != nil {
+					return fmt.Errorf("invalid weight of recipient %s: %w", parts[0], err)
+				}
+
+				recipients[i] = types.NewCommissionSplitRecipient(addr, weight)
+			}
+
+			msg := types.NewMsgSetCommissionSplit(valAddr, recipients)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// sendCommission sends the withdrawn commission of a validator to the
+// recipients of its commission split, or to its withdraw address if it has
+// none.
+func (k Keeper) sendCommission(ctx sdk.Context, valAddr sdk.ValAddress, commission sdk.Coins) error {
+	split, found := k.GetCommissionSplit(ctx, valAddr)
+	if !found {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+	}
+
+	for i, amount := range split.Split(commission) {
+		recipient := split.Recipients[i]
+		if !amount.IsZero() {
+			recipientAddr, err := sdk.AccAddressFromBech32(recipient.Address)
+			if err != nil {
+				return err
+			}
+
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, amount); err != nil {
+				return err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCommissionSplit,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// SetValidatorCommissionSplit sets the recipients the commission of a validator
+// is split between when it is withdrawn. No recipients removes the split.
+func (k Keeper) SetValidatorCommissionSplit(ctx sdk.Context, valAddr sdk.ValAddress, recipients []types.CommissionSplitRecipient) error {
+	if k.stakingKeeper.Validator(ctx, valAddr) == nil {
+		return types.ErrNoValidatorExists
+	}
+
+	if len(recipients) == 0 {
+		k.DeleteCommissionSplit(ctx, valAddr)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetCommissionSplit,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	} else {
+		split := types.CommissionSplit{Recipients: recipients}
+		if err := k.validateCommissionSplit(split); err != nil {
+			return err
+		}
+		k.SetCommissionSplit(ctx, valAddr, split)
+	}
+
+	for _, recipient := range recipients {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetCommissionSplit,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.Address),
+				sdk.NewAttribute(types.AttributeKeyWeight, recipient.Weight.String()),
+			),
+		)
+	}
+
+	return nil
+}
+
+// validateCommissionSplit validates split, whose recipients must not be blocked
+// from receiving funds, as the module accounts, for the commission to be
+// withdrawn.
+func (k Keeper) validateCommissionSplit(split types.CommissionSplit) error {
+	if err := split.Validate(); err != nil {
+		return err
+	}
+
+	for _, recipient := range split.Recipients {
+		if k.blockedAddrs[recipient.Address] {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", recipient.Address)
+		}
+	}
+
+	return nil
+}
@@ -85,6 +85,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, split := range data.ValidatorCommissionSplits {
+		valAddr, err := sdk.ValAddressFromBech32(split.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		if err := k.validateCommissionSplit(split.Split); err != nil {
+			panic(fmt.Sprintf("invalid commission split of validator %s: %s", split.ValidatorAddress, err))
+		}
+		k.SetCommissionSplit(ctx, valAddr, split.Split)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -190,5 +200,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	splits := make([]types.ValidatorCommissionSplitRecord, 0)
+	k.IterateCommissionSplits(ctx,
+		func(val sdk.ValAddress, split types.CommissionSplit) (stop bool) {
+			splits = append(splits, types.ValidatorCommissionSplitRecord{
+				ValidatorAddress: val.String(),
+				Split:            split,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, splits)
 }
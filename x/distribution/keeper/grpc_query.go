@@ -22,7 +22,7 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	var params types.Params
 	k.paramSpace.GetParamSet(ctx, &params)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, LastChangedHeight: k.paramSpace.GetLastChangeHeight(ctx)}, nil
 }
 
 // ValidatorOutstandingRewards queries rewards of a validator address
@@ -67,6 +67,27 @@ func (k Keeper) ValidatorCommission(c context.Context, req *types.QueryValidator
 	return &types.QueryValidatorCommissionResponse{Commission: commission}, nil
 }
 
+// CommissionSplit queries the commission split of a validator
+func (k Keeper) CommissionSplit(c context.Context, req *types.QueryCommissionSplitRequest) (*types.QueryCommissionSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	split, _ := k.GetCommissionSplit(ctx, valAdr)
+
+	return &types.QueryCommissionSplitResponse{Split: split}, nil
+}
+
 // ValidatorSlashes queries slash events of a validator
 func (k Keeper) ValidatorSlashes(c context.Context, req *types.QueryValidatorSlashesRequest) (*types.QueryValidatorSlashesResponse, error) {
 	if req == nil {
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -16,6 +15,18 @@ var _ stakingtypes.StakingHooks = Hooks{}
 // Create new distribution hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
+// StakingHookEvents implements stakingtypes.StakingHooksEventFilter.
+func (h Hooks) StakingHookEvents() []string {
+	return []string{
+		stakingtypes.HookAfterValidatorCreated,
+		stakingtypes.HookAfterValidatorRemoved,
+		stakingtypes.HookBeforeDelegationCreated,
+		stakingtypes.HookBeforeDelegationSharesModified,
+		stakingtypes.HookAfterDelegationModified,
+		stakingtypes.HookBeforeValidatorSlashed,
+	}
+}
+
 // initialize validator distribution record
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
@@ -41,12 +52,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
 		h.k.SetFeePool(ctx, feePool)
 
-		// add to validator account
+		// add to validator account, or to the recipients of its commission split
 		if !coins.IsZero() {
-			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
-
-			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+			if err := h.k.sendCommission(ctx, valAddr, coins); err != nil {
 				panic(err)
 			}
 		}
@@ -73,6 +81,9 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 
 	// clear current rewards
 	h.k.DeleteValidatorCurrentRewards(ctx, valAddr)
+
+	// clear commission split
+	h.k.DeleteCommissionSplit(ctx, valAddr)
 }
 
 // increment period
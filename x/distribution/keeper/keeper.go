@@ -6,6 +6,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -24,14 +25,22 @@ type Keeper struct {
 	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	// authority is the address allowed to update the parameters of the
+	// module, usually the gov module account.
+	authority string
 }
 
-// NewKeeper creates a new distribution Keeper instance
+// NewKeeper creates a new distribution Keeper instance. The authority is the
+// address allowed to update the parameters, usually the gov module account.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, blockedAddrs map[string]bool,
+	feeCollectorName string, blockedAddrs map[string]bool, authority string,
 ) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid distribution authority %s", authority))
+	}
 
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -52,9 +61,23 @@ func NewKeeper(
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
 		blockedAddrs:     blockedAddrs,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the parameters of the
+// module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// AuthzAcceptContext returns a copy of ctx carrying the keeper as the
+// WithdrawAddrGetter of WithdrawRewardsAuthorization. It is meant to be set as
+// an authz keeper AcceptContextDecorator.
+func (k Keeper) AuthzAcceptContext(ctx sdk.Context) sdk.Context {
+	return types.ContextWithWithdrawAddrGetter(ctx, k)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -109,6 +132,9 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 
 	// reinitialize the delegation
 	k.initializeDelegation(ctx, valAddr, delAddr)
+
+	telemetry.KeeperIncrCounter(types.ModuleName, "withdraw_rewards", 1)
+
 	return rewards, nil
 }
 
@@ -128,10 +154,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
-		if err != nil {
+		if err := k.sendCommission(ctx, valAddr, commission); err != nil {
 			return nil, err
 		}
 	}
@@ -143,6 +166,8 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		),
 	)
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "withdraw_commission", 1)
+
 	return commission, nil
 }
 
@@ -171,5 +196,7 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.
 	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
 	k.SetFeePool(ctx, feePool)
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "fund_community_pool", 1)
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,7 +10,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -86,6 +89,79 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestWithdrawValidatorCommissionSplit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	recipient1, recipient2 := sdk.AccAddress([]byte("recipient1__________")), sdk.AccAddress([]byte("recipient2__________"))
+
+	// the validator must exist
+	recipients := []types.CommissionSplitRecipient{
+		types.NewCommissionSplitRecipient(recipient1, sdk.NewDecWithPrec(7, 1)),
+		types.NewCommissionSplitRecipient(recipient2, sdk.NewDecWithPrec(3, 1)),
+	}
+	require.ErrorIs(t, app.DistrKeeper.SetValidatorCommissionSplit(ctx, valAddrs[0], recipients), types.ErrNoValidatorExists)
+
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	require.ErrorIs(t, app.DistrKeeper.SetValidatorCommissionSplit(ctx, valAddrs[0], recipients[:1]), types.ErrInvalidCommissionSplit)
+
+	// the module accounts cannot receive the commission, in a tx as in genesis
+	blockedRecipients := []types.CommissionSplitRecipient{
+		recipients[0],
+		types.NewCommissionSplitRecipient(distrAcc.GetAddress(), sdk.NewDecWithPrec(3, 1)),
+	}
+	require.ErrorIs(t, app.DistrKeeper.SetValidatorCommissionSplit(ctx, valAddrs[0], blockedRecipients), sdkerrors.ErrUnauthorized)
+	genState := app.DistrKeeper.ExportGenesis(ctx)
+	genState.ValidatorCommissionSplits = []types.ValidatorCommissionSplitRecord{{
+		ValidatorAddress: valAddrs[0].String(),
+		Split:            types.CommissionSplit{Recipients: blockedRecipients},
+	}}
+	require.PanicsWithValue(t,
+		fmt.Sprintf("invalid commission split of validator %s: %s is not allowed to receive external funds: unauthorized", valAddrs[0], distrAcc.GetAddress()),
+		func() { app.DistrKeeper.InitGenesis(ctx, *genState) },
+	)
+
+	require.NoError(t, app.DistrKeeper.SetValidatorCommissionSplit(ctx, valAddrs[0], recipients))
+
+	split, found := app.DistrKeeper.GetCommissionSplit(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, recipients, split.Recipients)
+
+	// set commission
+	valCommission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(155, 1))}
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 16))))
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: valCommission})
+	app.DistrKeeper.SetValidatorAccumulatedCommission(ctx, valAddrs[0], types.ValidatorAccumulatedCommission{Commission: valCommission})
+
+	balance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[0]), "stake")
+
+	// the withdrawn commission is split, the last recipient receiving the
+	// truncated amounts
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	commission, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), commission)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), app.BankKeeper.GetBalance(ctx, recipient1, "stake"))
+	require.Equal(t, sdk.NewInt64Coin("stake", 5), app.BankKeeper.GetBalance(ctx, recipient2, "stake"))
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddrs[0]), "stake"))
+
+	var splitEvents int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeCommissionSplit {
+			splitEvents++
+		}
+	}
+	require.Equal(t, 2, splitEvents)
+
+	// no recipients removes the split
+	require.NoError(t, app.DistrKeeper.SetValidatorCommissionSplit(ctx, valAddrs[0], nil))
+	_, found = app.DistrKeeper.GetCommissionSplit(ctx, valAddrs[0])
+	require.False(t, found)
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
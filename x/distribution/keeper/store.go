@@ -382,3 +382,42 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// get the commission split of a validator
+func (k Keeper) GetCommissionSplit(ctx sdk.Context, val sdk.ValAddress) (split types.CommissionSplit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetCommissionSplitKey(val))
+	if b == nil {
+		return split, false
+	}
+	k.cdc.MustUnmarshal(b, &split)
+	return split, true
+}
+
+// set the commission split of a validator
+func (k Keeper) SetCommissionSplit(ctx sdk.Context, val sdk.ValAddress, split types.CommissionSplit) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&split)
+	store.Set(types.GetCommissionSplitKey(val), b)
+}
+
+// delete the commission split of a validator
+func (k Keeper) DeleteCommissionSplit(ctx sdk.Context, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCommissionSplitKey(val))
+}
+
+// iterate over commission splits
+func (k Keeper) IterateCommissionSplits(ctx sdk.Context, handler func(val sdk.ValAddress, split types.CommissionSplit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.CommissionSplitPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var split types.CommissionSplit
+		k.cdc.MustUnmarshal(iter.Value(), &split)
+		addr := types.GetCommissionSplitAddress(iter.Key())
+		if handler(addr, split) {
+			break
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerIteration is the gas consumed for each allowed withdraw address
+// compared against the withdraw address of the granter.
+const gasCostPerIteration = uint64(10)
+
+var (
+	_ authz.Authorization = &WithdrawRewardsAuthorization{}
+)
+
+// WithdrawAddrGetter defines the interface WithdrawRewardsAuthorization uses to
+// look up the withdraw address and the commission split of the granter.
+type WithdrawAddrGetter interface {
+	GetDelegatorWithdrawAddr(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress
+	GetCommissionSplit(ctx sdk.Context, val sdk.ValAddress) (split CommissionSplit, found bool)
+}
+
+type withdrawAddrGetterKey struct{}
+
+// ContextWithWithdrawAddrGetter returns a copy of ctx carrying the
+// WithdrawAddrGetter WithdrawRewardsAuthorization.Accept looks up the withdraw
+// address of the granter with.
+func ContextWithWithdrawAddrGetter(ctx sdk.Context, getter WithdrawAddrGetter) sdk.Context {
+	return ctx.WithValue(withdrawAddrGetterKey{}, getter)
+}
+
+// NewWithdrawRewardsAuthorization creates a new WithdrawRewardsAuthorization object.
+func NewWithdrawRewardsAuthorization(allowed []sdk.AccAddress, authzType WithdrawAuthorizationType) (*WithdrawRewardsAuthorization, error) {
+	if len(allowed) == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("allowed withdraw addresses cannot be empty")
+	}
+
+	allowedAddrs := make([]string, len(allowed))
+	for i, addr := range allowed {
+		allowedAddrs[i] = addr.String()
+	}
+
+	return &WithdrawRewardsAuthorization{
+		AllowedWithdrawAddresses: allowedAddrs,
+		AuthorizationType:        authzType,
+	}, nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a WithdrawRewardsAuthorization) MsgTypeURL() string {
+	authzType, err := normalizeWithdrawAuthzType(a.AuthorizationType)
+	if err != nil {
+		panic(err)
+	}
+	return authzType
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a WithdrawRewardsAuthorization) ValidateBasic() error {
+	if len(a.AllowedWithdrawAddresses) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("allowed withdraw addresses cannot be empty")
+	}
+	for _, addr := range a.AllowedWithdrawAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed withdraw address %s: %s", addr, err)
+		}
+	}
+	if _, err := normalizeWithdrawAuthzType(a.AuthorizationType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Accept implements Authorization.Accept. The rewards or commission are sent to
+// the withdraw address of the delegator or the validator operator, which must
+// be one of the allowed withdraw addresses. The commission of a validator with a
+// commission split is sent to the recipients of the split instead, which must
+// all be allowed withdraw addresses.
+func (a WithdrawRewardsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	getter, ok := ctx.Value(withdrawAddrGetterKey{}).(WithdrawAddrGetter)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrLogic.Wrap("withdraw address getter not found in context")
+	}
+
+	var owner sdk.AccAddress
+
+	switch msg := msg.(type) {
+	case *MsgWithdrawDelegatorReward:
+		delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		owner = delAddr
+	case *MsgWithdrawValidatorCommission:
+		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		owner = sdk.AccAddress(valAddr)
+
+		if split, found := getter.GetCommissionSplit(ctx, valAddr); found {
+			for _, recipient := range split.Recipients {
+				if !a.isAllowed(ctx, recipient.Address) {
+					return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot withdraw to commission split recipient %s", recipient.Address)
+				}
+			}
+			return authz.AcceptResponse{Accept: true}, nil
+		}
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
+	}
+
+	withdrawAddr := getter.GetDelegatorWithdrawAddr(ctx, owner).String()
+	if !a.isAllowed(ctx, withdrawAddr) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot withdraw to %s", withdrawAddr)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// isAllowed returns whether addr is one of the allowed withdraw addresses.
+func (a WithdrawRewardsAuthorization) isAllowed(ctx sdk.Context, addr string) bool {
+	for _, allowed := range a.AllowedWithdrawAddresses {
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "withdraw rewards authorization")
+		if allowed == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normalizeWithdrawAuthzType(authzType WithdrawAuthorizationType) (string, error) {
+	switch authzType {
+	case WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD:
+		return sdk.MsgTypeURL(&MsgWithdrawDelegatorReward{}), nil
+	case WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION:
+		return sdk.MsgTypeURL(&MsgWithdrawValidatorCommission{}), nil
+	default:
+		return "", sdkerrors.ErrInvalidType.Wrapf("unknown authorization type %s", authzType)
+	}
+}
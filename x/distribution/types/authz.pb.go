@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/distribution/v1beta1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WithdrawAuthorizationType defines the type of distribution module withdraw authorization
+type WithdrawAuthorizationType int32
+
+const (
+	// WITHDRAW_AUTHORIZATION_TYPE_UNSPECIFIED specifies an unknown authorization type
+	WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_UNSPECIFIED WithdrawAuthorizationType = 0
+	// WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD defines an authorization type for Msg/WithdrawDelegatorReward
+	WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD WithdrawAuthorizationType = 1
+	// WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION defines an authorization type for
+	// Msg/WithdrawValidatorCommission
+	WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION WithdrawAuthorizationType = 2
+)
+
+var WithdrawAuthorizationType_name = map[int32]string{
+	0: "WITHDRAW_AUTHORIZATION_TYPE_UNSPECIFIED",
+	1: "WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD",
+	2: "WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION",
+}
+
+var WithdrawAuthorizationType_value = map[string]int32{
+	"WITHDRAW_AUTHORIZATION_TYPE_UNSPECIFIED":          0,
+	"WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD":     1,
+	"WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION": 2,
+}
+
+func (x WithdrawAuthorizationType) String() string {
+	return proto.EnumName(WithdrawAuthorizationType_name, int32(x))
+}
+
+func (WithdrawAuthorizationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6f4334195c58df3b, []int{0}
+}
+
+// WithdrawRewardsAuthorization defines authorization for withdrawing delegation rewards or
+// validator commission, only if they are sent to one of the allowed withdraw addresses.
+type WithdrawRewardsAuthorization struct {
+	// allowed_withdraw_addresses is the list of addresses the granter's withdraw address
+	// must be one of for the withdrawal to be authorized.
+	AllowedWithdrawAddresses []string `protobuf:"bytes,1,rep,name=allowed_withdraw_addresses,json=allowedWithdrawAddresses,proto3" json:"allowed_withdraw_addresses,omitempty"`
+	// authorization_type defines one of WithdrawAuthorizationType.
+	AuthorizationType WithdrawAuthorizationType `protobuf:"varint,2,opt,name=authorization_type,json=authorizationType,proto3,enum=cosmos.distribution.v1beta1.WithdrawAuthorizationType" json:"authorization_type,omitempty"`
+}
+
+func (m *WithdrawRewardsAuthorization) Reset()         { *m = WithdrawRewardsAuthorization{} }
+func (m *WithdrawRewardsAuthorization) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRewardsAuthorization) ProtoMessage()    {}
+func (*WithdrawRewardsAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f4334195c58df3b, []int{0}
+}
+func (m *WithdrawRewardsAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawRewardsAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawRewardsAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawRewardsAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRewardsAuthorization.Merge(m, src)
+}
+func (m *WithdrawRewardsAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawRewardsAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRewardsAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRewardsAuthorization proto.InternalMessageInfo
+
+func (m *WithdrawRewardsAuthorization) GetAllowedWithdrawAddresses() []string {
+	if m != nil {
+		return m.AllowedWithdrawAddresses
+	}
+	return nil
+}
+
+func (m *WithdrawRewardsAuthorization) GetAuthorizationType() WithdrawAuthorizationType {
+	if m != nil {
+		return m.AuthorizationType
+	}
+	return WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.distribution.v1beta1.WithdrawAuthorizationType", WithdrawAuthorizationType_name, WithdrawAuthorizationType_value)
+	proto.RegisterType((*WithdrawRewardsAuthorization)(nil), "cosmos.distribution.v1beta1.WithdrawRewardsAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/distribution/v1beta1/authz.proto", fileDescriptor_6f4334195c58df3b)
+}
+
+var fileDescriptor_6f4334195c58df3b = []byte{
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x0e, 0xd2, 0x30,
+	0x1c, 0xc6, 0x57, 0x4c, 0x4c, 0x6c, 0xa2, 0x81, 0x9d, 0x00, 0xcd, 0x42, 0xbc, 0x40, 0x54, 0x36,
+	0x50, 0xe3, 0xc1, 0x78, 0xa9, 0x6c, 0x4a, 0x23, 0x30, 0x52, 0x86, 0x8b, 0x5c, 0x9a, 0x8e, 0x35,
+	0x6e, 0x11, 0x2c, 0x59, 0x3b, 0x27, 0x3c, 0x85, 0x8f, 0xe2, 0xc1, 0x87, 0xf0, 0x48, 0x3c, 0x18,
+	0x8f, 0x06, 0x5e, 0xc4, 0x30, 0x86, 0x01, 0x13, 0x39, 0x35, 0xed, 0xff, 0xf7, 0xfd, 0xd2, 0xf4,
+	0x2b, 0x6c, 0xce, 0x85, 0x5c, 0x0a, 0x69, 0x85, 0xb1, 0x54, 0x49, 0x1c, 0xa4, 0x2a, 0x16, 0x1f,
+	0xad, 0x4f, 0xdd, 0x80, 0x2b, 0xd6, 0xb5, 0x58, 0xaa, 0xa2, 0x8d, 0xb9, 0x4a, 0x84, 0x12, 0xfa,
+	0xdd, 0x23, 0x68, 0x9e, 0x83, 0x66, 0x01, 0xd6, 0x6b, 0xc7, 0x21, 0xcd, 0x51, 0xab, 0x20, 0xf3,
+	0xcd, 0xfd, 0x9f, 0x00, 0xde, 0xf3, 0x63, 0x15, 0x85, 0x09, 0xcb, 0x08, 0xcf, 0x58, 0x12, 0x4a,
+	0x94, 0xaa, 0x48, 0x24, 0xf1, 0x86, 0x1d, 0x1c, 0xfa, 0x0b, 0x58, 0x67, 0x8b, 0x85, 0xc8, 0x78,
+	0x48, 0xb3, 0x82, 0xa3, 0x2c, 0x0c, 0x13, 0x2e, 0x25, 0x97, 0x55, 0xd0, 0xb8, 0xd1, 0xba, 0x45,
+	0xaa, 0x05, 0x71, 0x12, 0xa1, 0xd3, 0x5c, 0xe7, 0x50, 0x67, 0xe7, 0x3a, 0xaa, 0xd6, 0x2b, 0x5e,
+	0x2d, 0x35, 0x40, 0xeb, 0xce, 0xe3, 0x67, 0xe6, 0x95, 0x3b, 0x9b, 0x7f, 0x5d, 0xe7, 0x71, 0x6f,
+	0xbd, 0xe2, 0xa4, 0xc2, 0xfe, 0x3d, 0x7a, 0x5e, 0xf9, 0xf1, 0xad, 0x7d, 0xfb, 0x82, 0x7c, 0xf0,
+	0x15, 0xc0, 0xda, 0x7f, 0x1d, 0xfa, 0x43, 0xd8, 0xf4, 0xb1, 0xd7, 0xb7, 0x09, 0xf2, 0x29, 0x9a,
+	0x7a, 0x7d, 0x97, 0xe0, 0x19, 0xf2, 0xb0, 0x3b, 0xa2, 0xde, 0xbb, 0xb1, 0x43, 0xa7, 0xa3, 0xc9,
+	0xd8, 0xe9, 0xe1, 0x57, 0xd8, 0xb1, 0xcb, 0x9a, 0xde, 0x81, 0x8f, 0xae, 0xc1, 0xb6, 0x33, 0x70,
+	0x5e, 0x23, 0xcf, 0x25, 0x94, 0x38, 0x3e, 0x22, 0x76, 0x19, 0xe8, 0x4f, 0x61, 0xe7, 0x5a, 0xe2,
+	0x2d, 0x1a, 0x60, 0x3b, 0x4f, 0xf4, 0xdc, 0xe1, 0x10, 0x4f, 0x26, 0xd8, 0x1d, 0x95, 0x4b, 0x2f,
+	0xdf, 0x7c, 0xdf, 0x19, 0x60, 0xbb, 0x33, 0xc0, 0xef, 0x9d, 0x01, 0xbe, 0xec, 0x0d, 0x6d, 0xbb,
+	0x37, 0xb4, 0x5f, 0x7b, 0x43, 0x9b, 0x75, 0xdf, 0xc7, 0x2a, 0x4a, 0x03, 0x73, 0x2e, 0x96, 0x45,
+	0x7d, 0xc5, 0xd2, 0x96, 0xe1, 0x07, 0xeb, 0xf3, 0xe5, 0xf7, 0x38, 0x3c, 0xb1, 0x0c, 0x6e, 0xe6,
+	0xfd, 0x3e, 0xf9, 0x33, 0x00, 0x38, 0xbb, 0xc0, 0xeb, 0x42, 0x02, 0x00, 0x00,
+}
+
+func (m *WithdrawRewardsAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawRewardsAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawRewardsAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuthorizationType != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.AuthorizationType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedWithdrawAddresses) > 0 {
+		for iNdEx := len(m.AllowedWithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedWithdrawAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedWithdrawAddresses[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedWithdrawAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WithdrawRewardsAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedWithdrawAddresses) > 0 {
+		for _, s := range m.AllowedWithdrawAddresses {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.AuthorizationType != 0 {
+		n += 1 + sovAuthz(uint64(m.AuthorizationType))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WithdrawRewardsAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawRewardsAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawRewardsAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedWithdrawAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedWithdrawAddresses = append(m.AllowedWithdrawAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationType", wireType)
+			}
+			m.AuthorizationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthorizationType |= WithdrawAuthorizationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
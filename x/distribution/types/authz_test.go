@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+var (
+	granterAddr  = sdk.AccAddress("_____granter________")
+	compoundAddr = sdk.AccAddress("_____compound_______")
+	otherAddr    = sdk.AccAddress("_____other__________")
+	valAddr      = sdk.ValAddress(granterAddr)
+)
+
+func TestWithdrawRewardsAuthorization(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// verify the allowed withdraw addresses cannot be empty
+	_, err := types.NewWithdrawRewardsAuthorization(nil, types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD)
+	require.Error(t, err)
+	require.Error(t, types.WithdrawRewardsAuthorization{
+		AuthorizationType: types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD,
+	}.ValidateBasic())
+
+	// verify ValidateBasic returns error for an invalid allowed withdraw address
+	require.Error(t, types.WithdrawRewardsAuthorization{
+		AllowedWithdrawAddresses: []string{"invalid"},
+		AuthorizationType:        types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD,
+	}.ValidateBasic())
+
+	// verify ValidateBasic returns error for the UNSPECIFIED authorization type
+	unspecifiedAuth, err := types.NewWithdrawRewardsAuthorization([]sdk.AccAddress{compoundAddr}, types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_UNSPECIFIED)
+	require.NoError(t, err)
+	require.Error(t, unspecifiedAuth.ValidateBasic())
+
+	// verify MsgTypeURL
+	rewardAuth, err := types.NewWithdrawRewardsAuthorization([]sdk.AccAddress{compoundAddr}, types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_DELEGATOR_REWARD)
+	require.NoError(t, err)
+	require.NoError(t, rewardAuth.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgWithdrawDelegatorReward{}), rewardAuth.MsgTypeURL())
+
+	commissionAuth, err := types.NewWithdrawRewardsAuthorization([]sdk.AccAddress{compoundAddr}, types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION)
+	require.NoError(t, err)
+	require.NoError(t, commissionAuth.ValidateBasic())
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgWithdrawValidatorCommission{}), commissionAuth.MsgTypeURL())
+
+	rewardMsg := types.NewMsgWithdrawDelegatorReward(granterAddr, valAddr)
+	commissionMsg := types.NewMsgWithdrawValidatorCommission(valAddr)
+
+	// verify the withdraw address cannot be looked up without the keeper in the context
+	_, err = rewardAuth.Accept(ctx, rewardMsg)
+	require.Error(t, err)
+
+	acceptCtx := app.DistrKeeper.AuthzAcceptContext(ctx)
+
+	testCases := []struct {
+		msg          string
+		auth         *types.WithdrawRewardsAuthorization
+		withdrawAddr sdk.AccAddress
+		srvMsg       sdk.Msg
+		expectErr    bool
+	}{
+		{"granter is not in the allow list", rewardAuth, granterAddr, rewardMsg, true},
+		{"withdraw address in the allow list", rewardAuth, compoundAddr, rewardMsg, false},
+		{"withdraw address not in the allow list", rewardAuth, otherAddr, rewardMsg, true},
+		{"commission withdraw address in the allow list", commissionAuth, compoundAddr, commissionMsg, false},
+		{"commission withdraw address not in the allow list", commissionAuth, otherAddr, commissionMsg, true},
+		{"unknown msg type", rewardAuth, compoundAddr, types.NewMsgSetWithdrawAddress(granterAddr, otherAddr), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.msg, func(t *testing.T) {
+			app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, granterAddr, tc.withdrawAddr)
+
+			resp, err := tc.auth.Accept(acceptCtx, tc.srvMsg)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.False(t, resp.Accept)
+			} else {
+				require.NoError(t, err)
+				require.True(t, resp.Accept)
+				require.False(t, resp.Delete)
+				require.Nil(t, resp.Updated)
+			}
+		})
+	}
+
+	// the commission of a validator with a commission split is sent to the
+	// recipients of the split, which must all be allowed
+	app.DistrKeeper.SetDelegatorWithdrawAddr(ctx, granterAddr, compoundAddr)
+	app.DistrKeeper.SetCommissionSplit(ctx, valAddr, types.CommissionSplit{Recipients: []types.CommissionSplitRecipient{
+		types.NewCommissionSplitRecipient(compoundAddr, sdk.NewDecWithPrec(5, 1)),
+		types.NewCommissionSplitRecipient(otherAddr, sdk.NewDecWithPrec(5, 1)),
+	}})
+	_, err = commissionAuth.Accept(acceptCtx, commissionMsg)
+	require.Error(t, err)
+
+	bothAuth, err := types.NewWithdrawRewardsAuthorization([]sdk.AccAddress{compoundAddr, otherAddr}, types.WithdrawAuthorizationType_WITHDRAW_AUTHORIZATION_TYPE_VALIDATOR_COMMISSION)
+	require.NoError(t, err)
+	resp, err := bothAuth.Accept(acceptCtx, commissionMsg)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+}
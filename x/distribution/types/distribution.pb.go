@@ -79,11 +79,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -605,6 +606,88 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// CommissionSplitRecipient defines a recipient of a share of the commission of
+// a validator.
+type CommissionSplitRecipient struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the withdrawn commission sent to the recipient.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *CommissionSplitRecipient) Reset()         { *m = CommissionSplitRecipient{} }
+func (m *CommissionSplitRecipient) String() string { return proto.CompactTextString(m) }
+func (*CommissionSplitRecipient) ProtoMessage()    {}
+func (*CommissionSplitRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *CommissionSplitRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSplitRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSplitRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSplitRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSplitRecipient.Merge(m, src)
+}
+func (m *CommissionSplitRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSplitRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSplitRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSplitRecipient proto.InternalMessageInfo
+
+// CommissionSplit defines how the commission of a validator is split between
+// its recipients when it is withdrawn, instead of being sent to the withdraw
+// address of the validator.
+type CommissionSplit struct {
+	// recipients are the recipients of the commission, whose weights sum up to 1.
+	Recipients []CommissionSplitRecipient `protobuf:"bytes,1,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *CommissionSplit) Reset()         { *m = CommissionSplit{} }
+func (m *CommissionSplit) String() string { return proto.CompactTextString(m) }
+func (*CommissionSplit) ProtoMessage()    {}
+func (*CommissionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *CommissionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSplit.Merge(m, src)
+}
+func (m *CommissionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSplit proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -618,6 +701,8 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*CommissionSplitRecipient)(nil), "cosmos.distribution.v1beta1.CommissionSplitRecipient")
+	proto.RegisterType((*CommissionSplit)(nil), "cosmos.distribution.v1beta1.CommissionSplit")
 }
 
 func init() {
@@ -625,76 +710,80 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x24, 0x8e, 0x93, 0x4e, 0xf3, 0xab, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x8d, 0x46, 0x6a,
-	0x15, 0x04, 0x75, 0x9a, 0xf6, 0x82, 0x72, 0x40, 0x8a, 0x9d, 0x44, 0x14, 0x01, 0x8d, 0xb6, 0x01,
-	0x24, 0x2e, 0xd6, 0x78, 0x77, 0x62, 0x8f, 0x62, 0xef, 0x2c, 0x33, 0x63, 0x27, 0x39, 0x20, 0x24,
-	0x4e, 0x5c, 0x10, 0x20, 0x2e, 0x1c, 0x00, 0xe5, 0xc8, 0xaf, 0x3f, 0xa4, 0xc7, 0xde, 0x40, 0x20,
-	0x2d, 0x28, 0x11, 0x12, 0xe2, 0xe8, 0x1b, 0x37, 0xb4, 0x3b, 0xb3, 0xbb, 0xb6, 0x6b, 0xaa, 0xb8,
-	0x52, 0x4f, 0xf6, 0x7e, 0xf3, 0xe6, 0xbd, 0xef, 0xbd, 0xf7, 0xed, 0x7b, 0x0b, 0x4b, 0x0e, 0x97,
-	0x2d, 0x2e, 0x37, 0x5d, 0x26, 0x95, 0x60, 0xb5, 0xb6, 0x62, 0xdc, 0xdb, 0xec, 0x6c, 0xd5, 0xa8,
-	0x22, 0x5b, 0x7d, 0x60, 0xc9, 0x17, 0x5c, 0x71, 0xb4, 0xaa, 0xed, 0x4b, 0x7d, 0x47, 0xc6, 0x7e,
-	0x25, 0x5f, 0xe7, 0x75, 0x1e, 0xd9, 0x6d, 0x86, 0xff, 0xf4, 0x95, 0x95, 0xa2, 0x09, 0x51, 0x23,
-	0x92, 0x26, 0xae, 0x1d, 0xce, 0x8c, 0x4b, 0xfc, 0xcb, 0x38, 0xcc, 0x1d, 0x10, 0x41, 0x5a, 0x12,
-	0x1d, 0xc3, 0x19, 0x87, 0xb7, 0x5a, 0x6d, 0x8f, 0xa9, 0xb3, 0xaa, 0x22, 0xa7, 0x05, 0xb0, 0x0e,
-	0x36, 0xae, 0x95, 0xf7, 0x1f, 0x07, 0x56, 0xe6, 0xb7, 0xc0, 0xba, 0x5d, 0x67, 0xaa, 0xd1, 0xae,
-	0x95, 0x1c, 0xde, 0xda, 0x34, 0x4e, 0xf5, 0xcf, 0x1d, 0xe9, 0x1e, 0x6f, 0xaa, 0x33, 0x9f, 0xca,
-	0xd2, 0x2e, 0x75, 0xba, 0x81, 0x95, 0x3f, 0x23, 0xad, 0xe6, 0x36, 0xee, 0x73, 0x86, 0xed, 0xe9,
-	0xe4, 0xf9, 0x90, 0x9c, 0xa2, 0x8f, 0x61, 0x3e, 0xa4, 0x54, 0xf5, 0x05, 0xf7, 0xb9, 0xa4, 0xa2,
-	0x2a, 0xe8, 0x09, 0x11, 0x6e, 0x61, 0x2c, 0x8a, 0xf9, 0xf6, 0xc8, 0x31, 0x57, 0x75, 0xcc, 0x61,
-	0x3e, 0xb1, 0x8d, 0x42, 0xf8, 0xc0, 0xa0, 0x76, 0x04, 0xa2, 0x4f, 0x00, 0x5c, 0xac, 0x71, 0xaf,
-	0x2d, 0x9f, 0xa2, 0x30, 0x1e, 0x51, 0x78, 0x67, 0x64, 0x0a, 0x6b, 0x86, 0xc2, 0x30, 0xa7, 0xd8,
-	0x5e, 0x88, 0xf0, 0x01, 0x12, 0x87, 0x70, 0xf1, 0x84, 0xa9, 0x86, 0x2b, 0xc8, 0x49, 0x95, 0xb8,
-	0xae, 0xa8, 0x52, 0x8f, 0xd4, 0x9a, 0xd4, 0x2d, 0x64, 0xd7, 0xc1, 0xc6, 0x54, 0x79, 0x3d, 0xf5,
-	0x3a, 0xd4, 0x0c, 0xdb, 0x0b, 0x31, 0xbe, 0xe3, 0xba, 0x62, 0x4f, 0xa3, 0xdb, 0xd9, 0xaf, 0xcf,
-	0xad, 0x0c, 0xfe, 0x7c, 0x0c, 0xae, 0xbc, 0x47, 0x9a, 0xcc, 0x25, 0x8a, 0x8b, 0x37, 0x98, 0x54,
-	0x5c, 0x30, 0x87, 0x34, 0x75, 0x64, 0x89, 0x7e, 0x02, 0x70, 0xd9, 0x69, 0xb7, 0xda, 0x4d, 0xa2,
-	0x58, 0x87, 0x1a, 0x9a, 0x55, 0x41, 0x14, 0xe3, 0x05, 0xb0, 0x3e, 0xbe, 0x71, 0xfd, 0xde, 0x9a,
-	0x91, 0x67, 0x29, 0xac, 0x5e, 0x2c, 0xb3, 0x30, 0xd7, 0x0a, 0x67, 0x5e, 0xf9, 0xdd, 0xb0, 0x3e,
-	0xdd, 0xc0, 0x2a, 0x9a, 0x66, 0x0f, 0x77, 0x85, 0x7f, 0xfc, 0xc3, 0x7a, 0xe5, 0x6a, 0x15, 0x0c,
-	0xbd, 0x4a, 0x7b, 0x31, 0x75, 0xa4, 0x99, 0xda, 0xa1, 0x1b, 0x54, 0x81, 0x73, 0x82, 0x1e, 0x51,
-	0x41, 0x3d, 0x87, 0x56, 0x1d, 0xde, 0xf6, 0x54, 0xa4, 0x94, 0x99, 0xf2, 0x4a, 0x37, 0xb0, 0x96,
-	0x34, 0x85, 0x01, 0x03, 0x6c, 0xcf, 0x26, 0x48, 0x25, 0x02, 0xbe, 0x03, 0x70, 0x39, 0xa9, 0x48,
-	0xa5, 0x2d, 0x04, 0xf5, 0x54, 0x5c, 0x8e, 0x63, 0x38, 0xa9, 0x79, 0xcb, 0x2b, 0x65, 0x7f, 0x3f,
-	0xcc, 0x7e, 0xd4, 0xdc, 0xe2, 0x08, 0x68, 0x09, 0xe6, 0x7c, 0x2a, 0x18, 0xd7, 0x72, 0xcf, 0xda,
-	0xe6, 0x09, 0x7f, 0x05, 0x60, 0x31, 0x21, 0xb8, 0xe3, 0x98, 0x52, 0x50, 0xb7, 0xc2, 0x5b, 0x2d,
-	0x26, 0x25, 0xe3, 0x1e, 0xfa, 0x10, 0x42, 0x27, 0x79, 0x7a, 0x71, 0x54, 0x7b, 0x82, 0xe0, 0x6f,
-	0x00, 0x5c, 0x4d, 0x58, 0x3d, 0x6c, 0x2b, 0xa9, 0x88, 0xe7, 0x32, 0xaf, 0x1e, 0x97, 0xee, 0xa3,
-	0xd1, 0x4a, 0xb7, 0x67, 0x84, 0x33, 0x1b, 0x77, 0x2d, 0xba, 0x8a, 0x9f, 0xb7, 0x98, 0xf8, 0x07,
-	0x00, 0x17, 0x12, 0x7a, 0x8f, 0x9a, 0x44, 0x36, 0xf6, 0x3a, 0xd4, 0x53, 0x68, 0x1f, 0xce, 0x77,
-	0x62, 0xb8, 0x6a, 0xca, 0x1d, 0x4e, 0xb4, 0x6c, 0x79, 0xb5, 0x1b, 0x58, 0xcb, 0x3a, 0xfa, 0xa0,
-	0x05, 0xb6, 0xe7, 0x12, 0xe8, 0x20, 0x42, 0xd0, 0x9b, 0x70, 0xea, 0x48, 0x10, 0x27, 0x9c, 0xb5,
-	0x66, 0x3a, 0x95, 0x46, 0x1b, 0x0d, 0x76, 0x72, 0x1f, 0xff, 0x0c, 0x60, 0x7e, 0x08, 0x57, 0x89,
-	0x3e, 0x03, 0x70, 0x29, 0xe5, 0x22, 0xc3, 0x93, 0x2a, 0x8d, 0x8e, 0x4c, 0x4d, 0xef, 0x96, 0x9e,
-	0x31, 0xfb, 0x4b, 0x43, 0x7c, 0x96, 0x6f, 0x99, 0x3a, 0xbf, 0x34, 0x98, 0x69, 0xaf, 0x77, 0x6c,
-	0xe7, 0x3b, 0x43, 0xf8, 0x98, 0x11, 0xf2, 0x2d, 0x80, 0x93, 0xfb, 0x94, 0x1e, 0x70, 0xde, 0x44,
-	0x5f, 0x02, 0x38, 0x9b, 0x4e, 0x74, 0x9f, 0xf3, 0xe6, 0x95, 0xba, 0xfd, 0x96, 0x61, 0xb1, 0x38,
-	0xb8, 0x13, 0x42, 0x0f, 0x23, 0x37, 0x3d, 0x5d, 0x50, 0x21, 0x27, 0xfc, 0x17, 0x80, 0x2b, 0x95,
-	0x5e, 0xe4, 0x91, 0x4f, 0x3d, 0x57, 0xcf, 0x58, 0xd2, 0x44, 0x79, 0x38, 0xa1, 0x98, 0x6a, 0x52,
-	0xbd, 0xc8, 0x6c, 0xfd, 0x80, 0xd6, 0xe1, 0x75, 0x97, 0x4a, 0x47, 0x30, 0x3f, 0x6d, 0xa9, 0xdd,
-	0x0b, 0xa1, 0x35, 0x78, 0x4d, 0x50, 0x87, 0xf9, 0x8c, 0x7a, 0x4a, 0x6f, 0x03, 0x3b, 0x05, 0x90,
-	0x03, 0x73, 0xa4, 0x15, 0x4d, 0xa0, 0x6c, 0x94, 0xff, 0xcd, 0xa1, 0xf9, 0x47, 0xc9, 0xdf, 0x35,
-	0xaf, 0xde, 0xc6, 0x15, 0x72, 0xd4, 0x09, 0x1a, 0xd7, 0xdb, 0xd3, 0x9f, 0x9e, 0x5b, 0x99, 0xb0,
-	0x07, 0x7f, 0x87, 0x7d, 0xf8, 0x17, 0xc0, 0xc5, 0x5d, 0xda, 0xa4, 0xf5, 0xa8, 0x4d, 0x8a, 0x08,
-	0xc5, 0xbc, 0xfa, 0x03, 0xef, 0x28, 0x9a, 0x8b, 0xbe, 0xa0, 0x1d, 0xc6, 0xc3, 0x95, 0xd3, 0xab,
-	0xf1, 0x9e, 0xb9, 0x38, 0x60, 0x80, 0xed, 0xd9, 0x18, 0x31, 0x0a, 0x3f, 0x84, 0x13, 0x52, 0x91,
-	0x63, 0x6a, 0xe4, 0xfd, 0xfa, 0xc8, 0x9b, 0x6f, 0x5a, 0x07, 0x8a, 0x9c, 0x60, 0x5b, 0x3b, 0x43,
-	0x7b, 0x30, 0xd7, 0xa0, 0xac, 0xde, 0xd0, 0x25, 0xcc, 0x96, 0xef, 0xfc, 0x13, 0x58, 0x73, 0x8e,
-	0xa0, 0xe1, 0x3c, 0xf7, 0xaa, 0xfa, 0x28, 0x25, 0x39, 0x70, 0x80, 0x6d, 0x73, 0x19, 0xff, 0x0e,
-	0xe0, 0x4d, 0x93, 0x3b, 0xe3, 0x5e, 0x52, 0x05, 0xb3, 0x40, 0x1f, 0xc0, 0x1b, 0xa9, 0xb0, 0xc3,
-	0xd5, 0x48, 0xa5, 0x34, 0xdf, 0x2d, 0x6b, 0xdd, 0xc0, 0x2a, 0x0c, 0x6a, 0xdf, 0x98, 0x60, 0x3b,
-	0x9d, 0x0d, 0x3b, 0x1a, 0x42, 0x0c, 0xe6, 0x92, 0x6f, 0x90, 0x17, 0x34, 0x55, 0x4d, 0x80, 0xed,
-	0x29, 0xd3, 0x5d, 0x80, 0xcf, 0xc7, 0xe0, 0xad, 0xff, 0x57, 0xf0, 0xfb, 0x4c, 0x35, 0x76, 0xa9,
-	0xcf, 0x25, 0x53, 0xe8, 0x76, 0x9f, 0x98, 0xcb, 0xf3, 0x69, 0xd9, 0x23, 0x18, 0xc7, 0xf2, 0x7e,
-	0x6d, 0x88, 0xbc, 0xcb, 0x4b, 0xdd, 0xc0, 0x42, 0xda, 0xba, 0xe7, 0x10, 0xf7, 0xcb, 0xfe, 0xde,
-	0x53, 0xb2, 0x2f, 0xe7, 0xbb, 0x81, 0x35, 0x1f, 0xcf, 0x69, 0x73, 0x84, 0x7b, 0x5f, 0x86, 0x97,
-	0x7b, 0x5e, 0x86, 0xf0, 0xc2, 0x8d, 0x6e, 0x60, 0xcd, 0xe8, 0x0b, 0x1a, 0xc7, 0xb1, 0xa4, 0xd1,
-	0xab, 0x70, 0xd2, 0xd5, 0xb9, 0x14, 0x26, 0x22, 0x5b, 0x94, 0x2e, 0x01, 0x73, 0x80, 0xed, 0xd8,
-	0x24, 0x2d, 0x51, 0xf9, 0xe1, 0xf7, 0x17, 0x45, 0xf0, 0xf8, 0xa2, 0x08, 0x9e, 0x5c, 0x14, 0xc1,
-	0x9f, 0x17, 0x45, 0xf0, 0xc5, 0x65, 0x31, 0xf3, 0xe4, 0xb2, 0x98, 0xf9, 0xf5, 0xb2, 0x98, 0xf9,
-	0x60, 0xeb, 0x99, 0xf5, 0x3f, 0xed, 0xff, 0xb4, 0x8e, 0xda, 0x51, 0xcb, 0x45, 0x5f, 0xbe, 0xf7,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x1d, 0xb8, 0x42, 0x7e, 0x0b, 0x00, 0x00,
+	// 1164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x26, 0x8e, 0x93, 0x4e, 0xf3, 0xab, 0x13, 0x27, 0x71, 0x93, 0xe0, 0x8d, 0x46, 0x6a,
+	0x15, 0x04, 0x75, 0x9a, 0x56, 0x48, 0x28, 0x07, 0xa4, 0xac, 0x93, 0x88, 0x22, 0xa0, 0xd1, 0x26,
+	0x80, 0x04, 0x07, 0x6b, 0xbc, 0x3b, 0xb1, 0x47, 0x59, 0xef, 0x2c, 0x33, 0x63, 0x27, 0x39, 0x20,
+	0x24, 0x0e, 0x88, 0x0b, 0x02, 0xc4, 0x85, 0x03, 0xa0, 0x1c, 0xf9, 0xf5, 0x87, 0xf4, 0xd8, 0x1b,
+	0x08, 0x24, 0x83, 0x12, 0x21, 0x21, 0x8e, 0xbe, 0x71, 0x43, 0xbb, 0x33, 0xbb, 0x6b, 0xbb, 0x6e,
+	0x14, 0x57, 0xea, 0x29, 0xd9, 0x6f, 0xde, 0xbc, 0xf7, 0xbd, 0xf7, 0xbe, 0x7d, 0x6f, 0x0d, 0x4a,
+	0x0e, 0x13, 0x0d, 0x26, 0xd6, 0x5d, 0x2a, 0x24, 0xa7, 0xd5, 0xa6, 0xa4, 0xcc, 0x5f, 0x6f, 0x6d,
+	0x54, 0x89, 0xc4, 0x1b, 0x3d, 0x60, 0x29, 0xe0, 0x4c, 0x32, 0xb8, 0xac, 0xec, 0x4b, 0x3d, 0x47,
+	0xda, 0x7e, 0x29, 0x5f, 0x63, 0x35, 0x16, 0xd9, 0xad, 0x87, 0xff, 0xa9, 0x2b, 0x4b, 0x45, 0x1d,
+	0xa2, 0x8a, 0x05, 0x49, 0x5c, 0x3b, 0x8c, 0x6a, 0x97, 0xe8, 0xd7, 0x51, 0x90, 0xdb, 0xc3, 0x1c,
+	0x37, 0x04, 0x3c, 0x02, 0x53, 0x0e, 0x6b, 0x34, 0x9a, 0x3e, 0x95, 0xa7, 0x15, 0x89, 0x4f, 0x0a,
+	0xc6, 0xaa, 0xb1, 0x76, 0xcd, 0xda, 0x7d, 0xd4, 0x36, 0x33, 0xbf, 0xb7, 0xcd, 0xdb, 0x35, 0x2a,
+	0xeb, 0xcd, 0x6a, 0xc9, 0x61, 0x8d, 0x75, 0xed, 0x54, 0xfd, 0xb9, 0x23, 0xdc, 0xa3, 0x75, 0x79,
+	0x1a, 0x10, 0x51, 0xda, 0x26, 0x4e, 0xa7, 0x6d, 0xe6, 0x4f, 0x71, 0xc3, 0xdb, 0x44, 0x3d, 0xce,
+	0x90, 0x3d, 0x99, 0x3c, 0x1f, 0xe0, 0x13, 0xf8, 0x31, 0xc8, 0x87, 0x94, 0x2a, 0x01, 0x67, 0x01,
+	0x13, 0x84, 0x57, 0x38, 0x39, 0xc6, 0xdc, 0x2d, 0x8c, 0x44, 0x31, 0xdf, 0x1a, 0x3a, 0xe6, 0xb2,
+	0x8a, 0x39, 0xc8, 0x27, 0xb2, 0x61, 0x08, 0xef, 0x69, 0xd4, 0x8e, 0x40, 0xf8, 0x89, 0x01, 0xe6,
+	0xab, 0xcc, 0x6f, 0x8a, 0x27, 0x28, 0x8c, 0x46, 0x14, 0xde, 0x1e, 0x9a, 0xc2, 0x8a, 0xa6, 0x30,
+	0xc8, 0x29, 0xb2, 0xe7, 0x22, 0xbc, 0x8f, 0xc4, 0x01, 0x98, 0x3f, 0xa6, 0xb2, 0xee, 0x72, 0x7c,
+	0x5c, 0xc1, 0xae, 0xcb, 0x2b, 0xc4, 0xc7, 0x55, 0x8f, 0xb8, 0x85, 0xec, 0xaa, 0xb1, 0x36, 0x61,
+	0xad, 0xa6, 0x5e, 0x07, 0x9a, 0x21, 0x7b, 0x2e, 0xc6, 0xb7, 0x5c, 0x97, 0xef, 0x28, 0x74, 0x33,
+	0xfb, 0xcd, 0x99, 0x99, 0x41, 0x5f, 0x8c, 0x80, 0xa5, 0x77, 0xb1, 0x47, 0x5d, 0x2c, 0x19, 0x7f,
+	0x9d, 0x0a, 0xc9, 0x38, 0x75, 0xb0, 0xa7, 0x22, 0x0b, 0xf8, 0xb3, 0x01, 0x16, 0x9d, 0x66, 0xa3,
+	0xe9, 0x61, 0x49, 0x5b, 0x44, 0xd3, 0xac, 0x70, 0x2c, 0x29, 0x2b, 0x18, 0xab, 0xa3, 0x6b, 0xd7,
+	0xef, 0xad, 0x68, 0x79, 0x96, 0xc2, 0xea, 0xc5, 0x32, 0x0b, 0x73, 0x2d, 0x33, 0xea, 0x5b, 0xef,
+	0x84, 0xf5, 0xe9, 0xb4, 0xcd, 0xa2, 0x6e, 0xf6, 0x60, 0x57, 0xe8, 0xa7, 0x3f, 0xcd, 0x97, 0xae,
+	0x56, 0xc1, 0xd0, 0xab, 0xb0, 0xe7, 0x53, 0x47, 0x8a, 0xa9, 0x1d, 0xba, 0x81, 0x65, 0x30, 0xc3,
+	0xc9, 0x21, 0xe1, 0xc4, 0x77, 0x48, 0xc5, 0x61, 0x4d, 0x5f, 0x46, 0x4a, 0x99, 0xb2, 0x96, 0x3a,
+	0x6d, 0x73, 0x41, 0x51, 0xe8, 0x33, 0x40, 0xf6, 0x74, 0x82, 0x94, 0x23, 0xe0, 0x7b, 0x03, 0x2c,
+	0x26, 0x15, 0x29, 0x37, 0x39, 0x27, 0xbe, 0x8c, 0xcb, 0x71, 0x04, 0xc6, 0x15, 0x6f, 0x71, 0xa5,
+	0xec, 0xef, 0x87, 0xd9, 0x0f, 0x9b, 0x5b, 0x1c, 0x01, 0x2e, 0x80, 0x5c, 0x40, 0x38, 0x65, 0x4a,
+	0xee, 0x59, 0x5b, 0x3f, 0xa1, 0xaf, 0x0d, 0x50, 0x4c, 0x08, 0x6e, 0x39, 0xba, 0x14, 0xc4, 0x2d,
+	0xb3, 0x46, 0x83, 0x0a, 0x41, 0x99, 0x0f, 0x3f, 0x04, 0xc0, 0x49, 0x9e, 0x9e, 0x1f, 0xd5, 0xae,
+	0x20, 0xe8, 0x5b, 0x03, 0x2c, 0x27, 0xac, 0x1e, 0x36, 0xa5, 0x90, 0xd8, 0x77, 0xa9, 0x5f, 0x8b,
+	0x4b, 0xf7, 0xd1, 0x70, 0xa5, 0xdb, 0xd1, 0xc2, 0x99, 0x8e, 0xbb, 0x16, 0x5d, 0x45, 0xcf, 0x5a,
+	0x4c, 0xf4, 0xa3, 0x01, 0xe6, 0x12, 0x7a, 0xfb, 0x1e, 0x16, 0xf5, 0x9d, 0x16, 0xf1, 0x25, 0xdc,
+	0x05, 0xb3, 0xad, 0x18, 0xae, 0xe8, 0x72, 0x87, 0x13, 0x2d, 0x6b, 0x2d, 0x77, 0xda, 0xe6, 0xa2,
+	0x8a, 0xde, 0x6f, 0x81, 0xec, 0x99, 0x04, 0xda, 0x8b, 0x10, 0xf8, 0x06, 0x98, 0x38, 0xe4, 0xd8,
+	0x09, 0x67, 0xad, 0x9e, 0x4e, 0xa5, 0xe1, 0x46, 0x83, 0x9d, 0xdc, 0x47, 0xbf, 0x18, 0x20, 0x3f,
+	0x80, 0xab, 0x80, 0x9f, 0x1b, 0x60, 0x21, 0xe5, 0x22, 0xc2, 0x93, 0x0a, 0x89, 0x8e, 0x74, 0x4d,
+	0xef, 0x96, 0x2e, 0x99, 0xfd, 0xa5, 0x01, 0x3e, 0xad, 0x5b, 0xba, 0xce, 0x2f, 0xf4, 0x67, 0xda,
+	0xed, 0x1d, 0xd9, 0xf9, 0xd6, 0x00, 0x3e, 0x7a, 0x84, 0x7c, 0x67, 0x80, 0xf1, 0x5d, 0x42, 0xf6,
+	0x18, 0xf3, 0xe0, 0x57, 0x06, 0x98, 0x4e, 0x27, 0x7a, 0xc0, 0x98, 0x77, 0xa5, 0x6e, 0xbf, 0xa9,
+	0x59, 0xcc, 0xf7, 0xef, 0x84, 0xd0, 0xc3, 0xd0, 0x4d, 0x4f, 0x17, 0x54, 0xc8, 0x09, 0xfd, 0x6d,
+	0x80, 0xa5, 0x72, 0x37, 0xb2, 0x1f, 0x10, 0xdf, 0x55, 0x33, 0x16, 0x7b, 0x30, 0x0f, 0xc6, 0x24,
+	0x95, 0x1e, 0x51, 0x8b, 0xcc, 0x56, 0x0f, 0x70, 0x15, 0x5c, 0x77, 0x89, 0x70, 0x38, 0x0d, 0xd2,
+	0x96, 0xda, 0xdd, 0x10, 0x5c, 0x01, 0xd7, 0x38, 0x71, 0x68, 0x40, 0x89, 0x2f, 0xd5, 0x36, 0xb0,
+	0x53, 0x00, 0x3a, 0x20, 0x87, 0x1b, 0xd1, 0x04, 0xca, 0x46, 0xf9, 0xdf, 0x1c, 0x98, 0x7f, 0x94,
+	0xfc, 0x5d, 0xfd, 0xea, 0xad, 0x5d, 0x21, 0x47, 0x95, 0xa0, 0x76, 0xbd, 0x39, 0xf9, 0xd9, 0x99,
+	0x99, 0x09, 0x7b, 0xf0, 0x4f, 0xd8, 0x87, 0xff, 0x0c, 0x30, 0xbf, 0x4d, 0x3c, 0x52, 0x8b, 0xda,
+	0x24, 0x31, 0x97, 0xd4, 0xaf, 0x3d, 0xf0, 0x0f, 0xa3, 0xb9, 0x18, 0x70, 0xd2, 0xa2, 0x2c, 0x5c,
+	0x39, 0xdd, 0x1a, 0xef, 0x9a, 0x8b, 0x7d, 0x06, 0xc8, 0x9e, 0x8e, 0x11, 0xad, 0xf0, 0x03, 0x30,
+	0x26, 0x24, 0x3e, 0x22, 0x5a, 0xde, 0xaf, 0x0d, 0xbd, 0xf9, 0x26, 0x55, 0xa0, 0xc8, 0x09, 0xb2,
+	0x95, 0x33, 0xb8, 0x03, 0x72, 0x75, 0x42, 0x6b, 0x75, 0x55, 0xc2, 0xac, 0x75, 0xe7, 0xdf, 0xb6,
+	0x39, 0xe3, 0x70, 0x82, 0xc3, 0x1a, 0x57, 0xd4, 0x51, 0x4a, 0xb2, 0xef, 0x00, 0xd9, 0xfa, 0x32,
+	0xfa, 0xc3, 0x00, 0x37, 0x75, 0xee, 0x94, 0xf9, 0x49, 0x15, 0xf4, 0x02, 0x7d, 0x00, 0x6e, 0xa4,
+	0xc2, 0x0e, 0x57, 0x23, 0x11, 0x42, 0x7f, 0xb7, 0xac, 0x74, 0xda, 0x66, 0xa1, 0x5f, 0xfb, 0xda,
+	0x04, 0xd9, 0xe9, 0x6c, 0xd8, 0x52, 0x10, 0xa4, 0x20, 0x97, 0x7c, 0x83, 0x3c, 0xa7, 0xa9, 0xaa,
+	0x03, 0x6c, 0x4e, 0xe8, 0xee, 0x1a, 0xe8, 0x6c, 0x04, 0xdc, 0x7a, 0xba, 0x82, 0xdf, 0xa3, 0xb2,
+	0xbe, 0x4d, 0x02, 0x26, 0xa8, 0x84, 0xb7, 0x7b, 0xc4, 0x6c, 0xcd, 0xa6, 0x65, 0x8f, 0x60, 0x14,
+	0xcb, 0xfb, 0xd5, 0x01, 0xf2, 0xb6, 0x16, 0x3a, 0x6d, 0x13, 0x2a, 0xeb, 0xae, 0x43, 0xd4, 0x2b,
+	0xfb, 0x7b, 0x4f, 0xc8, 0xde, 0xca, 0x77, 0xda, 0xe6, 0x6c, 0x3c, 0xa7, 0xf5, 0x11, 0xea, 0x7e,
+	0x19, 0x5e, 0xec, 0x7a, 0x19, 0xc2, 0x0b, 0x37, 0x3a, 0x6d, 0x73, 0x4a, 0x5d, 0x50, 0x38, 0x8a,
+	0x25, 0x0d, 0x5f, 0x06, 0xe3, 0xae, 0xca, 0xa5, 0x30, 0x16, 0xd9, 0xc2, 0x74, 0x09, 0xe8, 0x03,
+	0x64, 0xc7, 0x26, 0x5d, 0x25, 0xfa, 0xd4, 0x00, 0x85, 0x74, 0x01, 0xee, 0x07, 0x1e, 0x95, 0x76,
+	0x12, 0xbf, 0x00, 0xc6, 0x7b, 0xba, 0x6e, 0xc7, 0x8f, 0x70, 0x17, 0xe4, 0x8e, 0x95, 0xfc, 0x9e,
+	0x6d, 0x68, 0xeb, 0xdb, 0x8a, 0x48, 0xf4, 0x16, 0x9e, 0x80, 0x99, 0x3e, 0x1e, 0xf0, 0x03, 0x00,
+	0x92, 0x5a, 0xc4, 0x93, 0xfa, 0x95, 0x4b, 0x27, 0xf5, 0xd3, 0x32, 0xb1, 0xb2, 0x21, 0x3f, 0xbb,
+	0xcb, 0x5d, 0x1a, 0xd9, 0x7a, 0xf8, 0xc3, 0x79, 0xd1, 0x78, 0x74, 0x5e, 0x34, 0x1e, 0x9f, 0x17,
+	0x8d, 0xbf, 0xce, 0x8b, 0xc6, 0x97, 0x17, 0xc5, 0xcc, 0xe3, 0x8b, 0x62, 0xe6, 0xb7, 0x8b, 0x62,
+	0xe6, 0xfd, 0x8d, 0x4b, 0x33, 0x3a, 0xe9, 0xfd, 0x75, 0x11, 0x25, 0x58, 0xcd, 0x45, 0x1f, 0xff,
+	0xf7, 0xff, 0x1f, 0x00, 0xf5, 0x7e, 0xde, 0xb8, 0x81, 0x0c, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1571,6 +1660,83 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionSplitRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSplitRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSplitRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1802,6 +1968,36 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *CommissionSplitRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *CommissionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3253,6 +3449,206 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommissionSplitRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionSplitRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionSplitRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, CommissionSplitRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
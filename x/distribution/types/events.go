@@ -8,9 +8,13 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetCommissionSplit = "set_commission_split"
+	EventTypeCommissionSplit    = "commission_split"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyWeight          = "weight"
 
 	AttributeValueCategory = ModuleName
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	splits []ValidatorCommissionSplitRecord,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -22,6 +25,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		ValidatorCommissionSplits:       splits,
 	}
 }
 
@@ -38,6 +42,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		ValidatorCommissionSplits:       []ValidatorCommissionSplitRecord{},
 	}
 }
 
@@ -46,5 +51,10 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, split := range gs.ValidatorCommissionSplits {
+		if err := split.Split.Validate(); err != nil {
+			return fmt.Errorf("invalid commission split of validator %s: %w", split.ValidatorAddress, err)
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -324,6 +324,47 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// ValidatorCommissionSplitRecord is used for import / export via genesis json.
+type ValidatorCommissionSplitRecord struct {
+	// validator_address is the address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// split is the commission split of the validator.
+	Split CommissionSplit `protobuf:"bytes,2,opt,name=split,proto3" json:"split" yaml:"split"`
+}
+
+func (m *ValidatorCommissionSplitRecord) Reset()         { *m = ValidatorCommissionSplitRecord{} }
+func (m *ValidatorCommissionSplitRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionSplitRecord) ProtoMessage()    {}
+func (*ValidatorCommissionSplitRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *ValidatorCommissionSplitRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionSplitRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionSplitRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionSplitRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionSplitRecord.Merge(m, src)
+}
+func (m *ValidatorCommissionSplitRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionSplitRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionSplitRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionSplitRecord proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
@@ -346,13 +387,15 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events" yaml:"validator_slash_events"`
+	// validator_commission_splits defines the commission splits of the validators at genesis.
+	ValidatorCommissionSplits []ValidatorCommissionSplitRecord `protobuf:"bytes,11,rep,name=validator_commission_splits,json=validatorCommissionSplits,proto3" json:"validator_commission_splits" yaml:"validator_commission_splits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,6 +432,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*ValidatorCommissionSplitRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCommissionSplitRecord")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -397,71 +441,76 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 1024 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1c, 0xf5, 0x3a, 0x25, 0x49, 0x27, 0x29, 0x0d, 0xdb, 0x7c, 0xb8, 0x4e, 0xea, 0x4d, 0xa7, 0x45,
-	0x04, 0x55, 0xac, 0x9b, 0x80, 0x00, 0x05, 0x81, 0x94, 0x4d, 0x29, 0xf4, 0xd4, 0x30, 0x91, 0x00,
-	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xcd, 0x8c, 0x1d, 0xc2, 0x3f, 0x00, 0x47,
-	0x24, 0xc4, 0xa9, 0x1c, 0x72, 0x44, 0x88, 0x63, 0xef, 0x5c, 0x7b, 0xec, 0x91, 0x03, 0x0a, 0x28,
-	0xb9, 0x70, 0xce, 0x81, 0x03, 0x27, 0xb4, 0x33, 0xb3, 0x5f, 0xf6, 0xda, 0x38, 0xa1, 0x39, 0x25,
-	0x1e, 0xff, 0xf6, 0xbd, 0xf7, 0x7b, 0xf3, 0xfb, 0x58, 0x83, 0xd7, 0x3d, 0xca, 0x3b, 0x94, 0x57,
-	0x7d, 0xc2, 0x05, 0x23, 0xf5, 0x9e, 0x20, 0x34, 0xa8, 0xf6, 0x37, 0xeb, 0x58, 0xb8, 0x9b, 0xd5,
-	0x26, 0x0e, 0x30, 0x27, 0xdc, 0xee, 0x32, 0x2a, 0xa8, 0xb9, 0xaa, 0x42, 0xed, 0x74, 0xa8, 0xad,
-	0x43, 0xcb, 0x8b, 0x4d, 0xda, 0xa4, 0x32, 0xae, 0x1a, 0xfe, 0xa7, 0x1e, 0x29, 0x57, 0x34, 0x7a,
-	0xdd, 0xe5, 0x38, 0x46, 0xf5, 0x28, 0x09, 0xf4, 0xf7, 0xf6, 0x38, 0xf6, 0x0c, 0x8f, 0x8c, 0x87,
-	0x4f, 0x0d, 0xb0, 0xf4, 0x00, 0xb7, 0x71, 0xd3, 0x15, 0x94, 0x7d, 0x46, 0x44, 0xcb, 0x67, 0xee,
-	0xc1, 0xa3, 0xa0, 0x41, 0xcd, 0x47, 0xe0, 0x15, 0x3f, 0xfa, 0xa2, 0xe6, 0xfa, 0x3e, 0xc3, 0x9c,
-	0x97, 0x8c, 0x75, 0x63, 0xe3, 0xaa, 0xb3, 0x76, 0x76, 0x6c, 0x95, 0x0e, 0xdd, 0x4e, 0x7b, 0x1b,
-	0x0e, 0x85, 0x40, 0xb4, 0x10, 0x9f, 0xed, 0xa8, 0x23, 0xf3, 0x21, 0x58, 0x38, 0xd0, 0xd0, 0x31,
-	0x52, 0x51, 0x22, 0xad, 0x9e, 0x1d, 0x5b, 0x2b, 0x0a, 0x69, 0x30, 0x02, 0xa2, 0xeb, 0xd1, 0x91,
-	0xc6, 0xd9, 0x9e, 0xfd, 0xf6, 0xc8, 0x2a, 0xfc, 0x75, 0x64, 0x15, 0xe0, 0x93, 0x22, 0xb8, 0xfd,
-	0xa9, 0xdb, 0x26, 0x7e, 0x48, 0xf3, 0xb8, 0x27, 0xb8, 0x70, 0x03, 0x9f, 0x04, 0x4d, 0x84, 0x0f,
-	0x5c, 0xe6, 0x73, 0x84, 0x3d, 0xca, 0xfc, 0x30, 0x85, 0x7e, 0x14, 0x34, 0x3a, 0x85, 0xa1, 0x10,
-	0x88, 0x16, 0xe2, 0xb3, 0x28, 0x85, 0x23, 0x03, 0xdc, 0xa0, 0x09, 0x4f, 0x8d, 0x29, 0xa2, 0x52,
-	0x71, 0x7d, 0x6a, 0x63, 0x6e, 0x6b, 0x4d, 0xdb, 0x6e, 0x87, 0xd7, 0x12, 0xdd, 0xa0, 0xfd, 0x00,
-	0x7b, 0xbb, 0x94, 0x04, 0xce, 0x27, 0xcf, 0x8e, 0xad, 0xc2, 0xd9, 0xb1, 0x55, 0x56, 0x7c, 0x39,
-	0x30, 0xf0, 0xe7, 0x3f, 0xac, 0x7b, 0x4d, 0x22, 0x5a, 0xbd, 0xba, 0xed, 0xd1, 0x4e, 0x55, 0x5f,
-	0xa2, 0xfa, 0xf3, 0x06, 0xf7, 0xbf, 0xac, 0x8a, 0xc3, 0x2e, 0xe6, 0x11, 0x22, 0x47, 0x26, 0x1d,
-	0xca, 0x39, 0xe5, 0xce, 0xdf, 0x06, 0xb8, 0x1b, 0xbb, 0xb3, 0xe3, 0x79, 0xbd, 0x4e, 0xaf, 0xed,
-	0x0a, 0xec, 0xef, 0xd2, 0x4e, 0x87, 0x70, 0x4e, 0x68, 0xf0, 0xe2, 0x0d, 0x3a, 0x04, 0x73, 0x6e,
-	0xc2, 0x24, 0xaf, 0x77, 0x6e, 0xeb, 0x3d, 0x7b, 0x4c, 0x85, 0xdb, 0xe3, 0x25, 0x3a, 0x65, 0x6d,
-	0x9b, 0xa9, 0x54, 0xa4, 0xd0, 0x21, 0x4a, 0x73, 0xa5, 0x12, 0xff, 0xc7, 0x00, 0xeb, 0x31, 0xea,
-	0xc7, 0x84, 0x0b, 0xca, 0x88, 0xe7, 0xb6, 0x2f, 0xad, 0x2a, 0x96, 0xc1, 0x74, 0x17, 0x33, 0x42,
-	0x55, 0xbe, 0x57, 0x90, 0xfe, 0x64, 0x12, 0x30, 0x13, 0x15, 0xc8, 0x94, 0x34, 0xe2, 0x9d, 0xc9,
-	0x8c, 0x18, 0x92, 0xec, 0x2c, 0x6b, 0x13, 0x5e, 0x56, 0xaa, 0xa2, 0x7a, 0x41, 0x11, 0x7e, 0x2a,
-	0xf9, 0xdf, 0x0d, 0x70, 0x2b, 0x46, 0xda, 0xed, 0x31, 0x86, 0x03, 0x71, 0x69, 0x99, 0x37, 0x92,
-	0x0c, 0xd5, 0x55, 0xbf, 0x35, 0x59, 0x86, 0x59, 0x5d, 0xe7, 0x49, 0xef, 0x69, 0x11, 0xac, 0xc6,
-	0x93, 0x6a, 0x5f, 0xb8, 0x4c, 0x90, 0xa0, 0x19, 0x4e, 0xaa, 0x24, 0xb9, 0x17, 0x35, 0xaf, 0x72,
-	0x7d, 0x2a, 0x5e, 0xc8, 0xa7, 0x1e, 0xb8, 0xc6, 0xb5, 0xd6, 0x1a, 0x09, 0x1a, 0x54, 0xd7, 0xc3,
-	0xd6, 0x58, 0xb7, 0x72, 0xd3, 0x74, 0xd6, 0xb4, 0x57, 0x8b, 0x8a, 0x3e, 0x03, 0x0b, 0xd1, 0x3c,
-	0x4f, 0xc5, 0xa6, 0x6c, 0xfb, 0xb1, 0x08, 0x6e, 0xc6, 0xee, 0xef, 0xb7, 0x5d, 0xde, 0xfa, 0xb0,
-	0x2f, 0x2f, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0x25, 0xa2, 0x5e, 0x50, 0x9f, 0x52, 0x3d,
-	0x32, 0x95, 0xe9, 0x91, 0xaf, 0xc1, 0x52, 0x82, 0xcb, 0x43, 0x61, 0x35, 0x1c, 0x2a, 0x2b, 0x5d,
-	0x91, 0x0e, 0xdd, 0x9f, 0xac, 0x9e, 0x92, 0x8c, 0x9c, 0x45, 0xed, 0xcf, 0xbc, 0x12, 0x2d, 0xc1,
-	0x20, 0xba, 0xd1, 0x1f, 0x0e, 0x4d, 0xd9, 0xf3, 0xcd, 0x1c, 0x98, 0xff, 0x48, 0x2d, 0xe5, 0x7d,
-	0xe1, 0x0a, 0x6c, 0x22, 0x30, 0xdd, 0x75, 0x99, 0xdb, 0x51, 0x36, 0xcc, 0x6d, 0xdd, 0x19, 0xab,
-	0x63, 0x4f, 0x86, 0x3a, 0x4b, 0x9a, 0xfa, 0x9a, 0xa2, 0x56, 0x00, 0x10, 0x69, 0x24, 0xf3, 0x73,
-	0x30, 0xdb, 0xc0, 0xb8, 0xd6, 0xa5, 0xb4, 0xad, 0xbb, 0xe5, 0xee, 0x58, 0xd4, 0x87, 0x18, 0xef,
-	0x51, 0xda, 0x76, 0x56, 0x34, 0xec, 0x75, 0x05, 0x1b, 0x61, 0x40, 0x34, 0xd3, 0x50, 0x11, 0xe6,
-	0x0f, 0x06, 0x28, 0x25, 0x25, 0x1d, 0xaf, 0xd0, 0xb0, 0x24, 0xc2, 0xd1, 0x33, 0x35, 0x79, 0xa9,
-	0xa5, 0x77, 0xbf, 0xf3, 0x9a, 0x26, 0xb6, 0x06, 0x9b, 0x26, 0xcb, 0x00, 0xd1, 0xb2, 0x9f, 0xf7,
-	0xbc, 0xec, 0xa0, 0x2e, 0xc3, 0x7d, 0x42, 0x7b, 0xbc, 0xd6, 0x65, 0xb4, 0x4b, 0x39, 0x66, 0xf2,
-	0x62, 0x33, 0x75, 0x35, 0x14, 0x02, 0xd1, 0x42, 0x74, 0xb6, 0xa7, 0x8f, 0xcc, 0xef, 0x47, 0x6c,
-	0xde, 0x97, 0x64, 0x76, 0x1f, 0x4c, 0x56, 0x26, 0xa3, 0x5e, 0x11, 0x1c, 0xf8, 0xdf, 0xbb, 0x39,
-	0x6f, 0xd9, 0x9a, 0xbf, 0x1a, 0xe0, 0x76, 0xaa, 0x2d, 0x92, 0x6d, 0x54, 0xf3, 0xe2, 0x0d, 0xc6,
-	0x4b, 0xd3, 0x52, 0xe3, 0xce, 0xff, 0xd8, 0x82, 0x5a, 0xe6, 0x7d, 0x2d, 0x73, 0x63, 0xa8, 0x21,
-	0xf3, 0x99, 0x21, 0xb2, 0xfa, 0x63, 0x71, 0xb9, 0xf9, 0x8b, 0x01, 0xd6, 0x12, 0x9c, 0x56, 0xbc,
-	0x79, 0x62, 0x83, 0x67, 0xa4, 0xf8, 0xf7, 0x2f, 0xb8, 0xb9, 0xb4, 0xf0, 0x7b, 0x5a, 0xf8, 0x9d,
-	0x41, 0xe1, 0xc3, 0x84, 0x10, 0x95, 0xfb, 0x23, 0xe1, 0xc2, 0x17, 0xb0, 0x9b, 0xc9, 0xd3, 0x9e,
-	0x5a, 0x23, 0xb1, 0xd6, 0x59, 0xa9, 0x75, 0xfb, 0x22, 0x3b, 0x48, 0x0b, 0xdd, 0xd0, 0x42, 0xd7,
-	0x07, 0x85, 0x0e, 0x50, 0x41, 0xb4, 0xd2, 0xcf, 0x07, 0x32, 0x9f, 0x64, 0x9a, 0x31, 0x33, 0x9f,
-	0x79, 0xe9, 0xaa, 0x54, 0xf8, 0xee, 0xf9, 0xe7, 0xbe, 0xd6, 0x37, 0xb2, 0x25, 0xb3, 0x3c, 0xe9,
-	0x96, 0x4c, 0xa3, 0xf0, 0xb0, 0x8f, 0x96, 0x73, 0x07, 0x2e, 0x2f, 0x01, 0xa9, 0xed, 0xed, 0xf3,
-	0x4e, 0x5c, 0xad, 0xec, 0x55, 0xad, 0xec, 0xd6, 0xa0, 0x73, 0x69, 0x0e, 0x88, 0x16, 0x73, 0x06,
-	0x71, 0x6a, 0xbf, 0x3b, 0x8f, 0x7f, 0x3a, 0xa9, 0x18, 0xcf, 0x4e, 0x2a, 0xc6, 0xf3, 0x93, 0x8a,
-	0xf1, 0xe7, 0x49, 0xc5, 0xf8, 0xee, 0xb4, 0x52, 0x78, 0x7e, 0x5a, 0x29, 0xfc, 0x76, 0x5a, 0x29,
-	0x7c, 0xb1, 0x39, 0xf6, 0xed, 0xf8, 0xab, 0xec, 0xef, 0x1d, 0xf9, 0xb2, 0x5c, 0x9f, 0x96, 0xbf,
-	0x70, 0xde, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xee, 0xfe, 0x4a, 0x91, 0x0d, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x69, 0x92, 0x8e, 0x53, 0x1a, 0xb6, 0xf9, 0xb1, 0x71, 0x52, 0xdb, 0x9d, 0x16,
+	0x11, 0x28, 0xd8, 0x4d, 0x40, 0x80, 0x82, 0x40, 0xca, 0xa6, 0x14, 0x7a, 0x6a, 0x98, 0x48, 0x50,
+	0x71, 0xb1, 0xd6, 0xbb, 0x63, 0x7b, 0x84, 0xbd, 0x63, 0xed, 0xac, 0x1d, 0xc2, 0x5f, 0xc0, 0x11,
+	0x09, 0x71, 0x2a, 0x87, 0xdc, 0x40, 0x88, 0x0b, 0x52, 0xef, 0x5c, 0x7b, 0xa3, 0x47, 0x0e, 0x28,
+	0xa0, 0xe4, 0xc2, 0x39, 0x07, 0x0e, 0x9c, 0xd0, 0xce, 0xcc, 0xee, 0x8e, 0xd7, 0x6b, 0xe3, 0x84,
+	0xe4, 0x94, 0x78, 0xf6, 0xed, 0xf7, 0x7d, 0xef, 0x9b, 0xf7, 0xe6, 0xcd, 0x82, 0x57, 0x6c, 0xca,
+	0x3a, 0x94, 0x55, 0x1d, 0xc2, 0x7c, 0x8f, 0xd4, 0x7b, 0x3e, 0xa1, 0x6e, 0xb5, 0xbf, 0x51, 0xc7,
+	0xbe, 0xb5, 0x51, 0x6d, 0x62, 0x17, 0x33, 0xc2, 0x2a, 0x5d, 0x8f, 0xfa, 0x54, 0x5f, 0x15, 0xa1,
+	0x15, 0x35, 0xb4, 0x22, 0x43, 0x0b, 0x0b, 0x4d, 0xda, 0xa4, 0x3c, 0xae, 0x1a, 0xfc, 0x27, 0x5e,
+	0x29, 0x14, 0x25, 0x7a, 0xdd, 0x62, 0x38, 0x42, 0xb5, 0x29, 0x71, 0xe5, 0xf3, 0xca, 0x38, 0xf6,
+	0x01, 0x1e, 0x1e, 0x0f, 0x9f, 0x6a, 0x60, 0xf1, 0x3e, 0x6e, 0xe3, 0xa6, 0xe5, 0x53, 0xef, 0x53,
+	0xe2, 0xb7, 0x1c, 0xcf, 0xda, 0x7f, 0xe8, 0x36, 0xa8, 0xfe, 0x10, 0xbc, 0xe8, 0x84, 0x0f, 0x6a,
+	0x96, 0xe3, 0x78, 0x98, 0x31, 0x43, 0x2b, 0x6b, 0xeb, 0x57, 0xcd, 0xb5, 0xd3, 0xa3, 0x92, 0x71,
+	0x60, 0x75, 0xda, 0x5b, 0x70, 0x28, 0x04, 0xa2, 0xf9, 0x68, 0x6d, 0x5b, 0x2c, 0xe9, 0x0f, 0xc0,
+	0xfc, 0xbe, 0x84, 0x8e, 0x90, 0xb2, 0x1c, 0x69, 0xf5, 0xf4, 0xa8, 0xb4, 0x2c, 0x90, 0x92, 0x11,
+	0x10, 0x5d, 0x0f, 0x97, 0x24, 0xce, 0xd6, 0xec, 0x57, 0x87, 0xa5, 0xcc, 0x5f, 0x87, 0xa5, 0x0c,
+	0x7c, 0x92, 0x05, 0xb7, 0x3e, 0xb1, 0xda, 0xc4, 0x09, 0x68, 0x1e, 0xf5, 0x7c, 0xe6, 0x5b, 0xae,
+	0x43, 0xdc, 0x26, 0xc2, 0xfb, 0x96, 0xe7, 0x30, 0x84, 0x6d, 0xea, 0x39, 0x41, 0x0a, 0xfd, 0x30,
+	0x68, 0x74, 0x0a, 0x43, 0x21, 0x10, 0xcd, 0x47, 0x6b, 0x61, 0x0a, 0x87, 0x1a, 0xb8, 0x41, 0x63,
+	0x9e, 0x9a, 0x27, 0x88, 0x8c, 0x6c, 0x39, 0xb7, 0x9e, 0xdf, 0x5c, 0x93, 0xb6, 0x57, 0x82, 0x6d,
+	0x09, 0x77, 0xb0, 0x72, 0x1f, 0xdb, 0x3b, 0x94, 0xb8, 0xe6, 0xc7, 0xcf, 0x8e, 0x4a, 0x99, 0xd3,
+	0xa3, 0x52, 0x41, 0xf0, 0xa5, 0xc0, 0xc0, 0x1f, 0xff, 0x28, 0xdd, 0x6d, 0x12, 0xbf, 0xd5, 0xab,
+	0x57, 0x6c, 0xda, 0xa9, 0xca, 0x4d, 0x14, 0x7f, 0x5e, 0x67, 0xce, 0xe7, 0x55, 0xff, 0xa0, 0x8b,
+	0x59, 0x88, 0xc8, 0x90, 0x4e, 0x87, 0x72, 0x56, 0xdc, 0xf9, 0x5b, 0x03, 0x77, 0x22, 0x77, 0xb6,
+	0x6d, 0xbb, 0xd7, 0xe9, 0xb5, 0x2d, 0x1f, 0x3b, 0x3b, 0xb4, 0xd3, 0x21, 0x8c, 0x11, 0xea, 0x5e,
+	0xbc, 0x41, 0x07, 0x20, 0x6f, 0xc5, 0x4c, 0x7c, 0x7b, 0xf3, 0x9b, 0xef, 0x56, 0xc6, 0x54, 0x78,
+	0x65, 0xbc, 0x44, 0xb3, 0x20, 0x6d, 0xd3, 0x85, 0x0a, 0x05, 0x1d, 0x22, 0x95, 0x4b, 0x49, 0xfc,
+	0x1f, 0x0d, 0x94, 0x23, 0xd4, 0x8f, 0x08, 0xf3, 0xa9, 0x47, 0x6c, 0xab, 0x7d, 0x69, 0x55, 0xb1,
+	0x04, 0xa6, 0xbb, 0xd8, 0x23, 0x54, 0xe4, 0x3b, 0x85, 0xe4, 0x2f, 0x9d, 0x80, 0x99, 0xb0, 0x40,
+	0x72, 0xdc, 0x88, 0xb7, 0x27, 0x33, 0x62, 0x48, 0xb2, 0xb9, 0x24, 0x4d, 0x78, 0x41, 0xa8, 0x0a,
+	0xeb, 0x05, 0x85, 0xf8, 0x4a, 0xf2, 0xbf, 0x6b, 0xe0, 0x66, 0x84, 0xb4, 0xd3, 0xf3, 0x3c, 0xec,
+	0xfa, 0x97, 0x96, 0x79, 0x23, 0xce, 0x50, 0x6c, 0xf5, 0x9b, 0x93, 0x65, 0x38, 0xa8, 0xeb, 0x2c,
+	0xe9, 0x3d, 0xcd, 0x82, 0xd5, 0xe8, 0xa4, 0xda, 0xf3, 0x2d, 0xcf, 0x27, 0x6e, 0x33, 0x38, 0xa9,
+	0xe2, 0xe4, 0x2e, 0xea, 0xbc, 0x4a, 0xf5, 0x29, 0x7b, 0x2e, 0x9f, 0x7a, 0xe0, 0x1a, 0x93, 0x5a,
+	0x6b, 0xc4, 0x6d, 0x50, 0x59, 0x0f, 0x9b, 0x63, 0xdd, 0x4a, 0x4d, 0xd3, 0x5c, 0x93, 0x5e, 0x2d,
+	0x08, 0xfa, 0x01, 0x58, 0x88, 0xe6, 0x98, 0x12, 0xab, 0xd8, 0xf6, 0x5d, 0x16, 0xac, 0x44, 0xee,
+	0xef, 0xb5, 0x2d, 0xd6, 0xfa, 0xa0, 0xcf, 0x37, 0xe0, 0x12, 0x7a, 0xa1, 0x85, 0x49, 0xb3, 0xe5,
+	0x87, 0xbd, 0x20, 0x7e, 0x29, 0x3d, 0x92, 0x1b, 0xe8, 0x91, 0x2f, 0xc1, 0x62, 0x8c, 0xcb, 0x02,
+	0x61, 0x35, 0x1c, 0x28, 0x33, 0xa6, 0xb8, 0x43, 0xf7, 0x26, 0xab, 0xa7, 0x38, 0x23, 0x73, 0x41,
+	0xfa, 0x33, 0x27, 0x44, 0x73, 0x30, 0x88, 0x6e, 0xf4, 0x87, 0x43, 0x15, 0x7b, 0x7e, 0xd5, 0x40,
+	0x31, 0x2e, 0xce, 0xe8, 0xf0, 0xd9, 0xeb, 0xb6, 0xc9, 0x25, 0x78, 0xf4, 0x18, 0x5c, 0x61, 0x01,
+	0xb2, 0xec, 0x99, 0xd7, 0xc6, 0xe6, 0x98, 0x50, 0x93, 0xcc, 0x8f, 0x03, 0x41, 0x24, 0x00, 0x95,
+	0x8c, 0x7e, 0x9e, 0x03, 0x73, 0x1f, 0x8a, 0x6b, 0xc6, 0x9e, 0x6f, 0xf9, 0x58, 0x47, 0x60, 0xba,
+	0x6b, 0x79, 0x56, 0x47, 0x88, 0xce, 0x6f, 0xde, 0x1e, 0xcb, 0xba, 0xcb, 0x43, 0xcd, 0x45, 0x49,
+	0x76, 0x4d, 0x90, 0x09, 0x00, 0x88, 0x24, 0x92, 0xfe, 0x18, 0xcc, 0x36, 0x30, 0xae, 0x75, 0x29,
+	0x6d, 0xcb, 0x5c, 0xee, 0x8c, 0x45, 0x7d, 0x80, 0xf1, 0x2e, 0xa5, 0x6d, 0x73, 0x59, 0xc2, 0x5e,
+	0x17, 0xb0, 0x21, 0x06, 0x44, 0x33, 0x0d, 0x11, 0xa1, 0x7f, 0xab, 0x01, 0x23, 0x6e, 0xd2, 0xe8,
+	0x52, 0x10, 0x14, 0x79, 0x70, 0x98, 0xe6, 0x26, 0x6f, 0x1e, 0xf5, 0x36, 0x63, 0xbe, 0x2c, 0x89,
+	0x4b, 0xc9, 0x63, 0x60, 0x90, 0x01, 0xa2, 0x25, 0x27, 0xed, 0x7d, 0x7e, 0x26, 0x74, 0x3d, 0xdc,
+	0x27, 0xb4, 0xc7, 0x6a, 0x5d, 0x8f, 0x76, 0x29, 0xc3, 0x9e, 0x31, 0x95, 0xac, 0x82, 0xa1, 0x10,
+	0x88, 0xe6, 0xc3, 0xb5, 0x5d, 0xb9, 0xa4, 0x7f, 0x33, 0xe2, 0x2e, 0x71, 0x85, 0x67, 0xf7, 0xfe,
+	0x64, 0x85, 0x3f, 0xea, 0xd2, 0x63, 0xc2, 0xff, 0xbe, 0x6d, 0xa4, 0x5d, 0x1f, 0xf4, 0x5f, 0x34,
+	0x70, 0x4b, 0x29, 0xe2, 0x78, 0xbe, 0xd6, 0xec, 0xa8, 0x10, 0x99, 0x31, 0xcd, 0x35, 0x6e, 0xff,
+	0x8f, 0xb9, 0x2e, 0x65, 0xde, 0x93, 0x32, 0xd7, 0x87, 0xda, 0x27, 0x9d, 0x19, 0xa2, 0x52, 0x7f,
+	0x2c, 0x2e, 0xd3, 0x7f, 0xd2, 0xc0, 0x5a, 0x8c, 0xd3, 0x8a, 0x66, 0x69, 0x64, 0xf0, 0x0c, 0x17,
+	0xff, 0xde, 0x39, 0x67, 0xb1, 0x14, 0x7e, 0x57, 0x0a, 0xbf, 0x9d, 0x14, 0x3e, 0x4c, 0x08, 0x51,
+	0xa1, 0x3f, 0x12, 0x2e, 0xb8, 0x52, 0xae, 0xc4, 0x6f, 0xdb, 0x62, 0x30, 0x46, 0x5a, 0x67, 0xb9,
+	0xd6, 0xad, 0xf3, 0x4c, 0x55, 0x29, 0x74, 0x5d, 0x0a, 0x2d, 0x27, 0x85, 0x26, 0xa8, 0x20, 0x5a,
+	0xee, 0xa7, 0x03, 0xe9, 0x4f, 0x06, 0x9a, 0x71, 0x60, 0xe2, 0x30, 0xe3, 0x2a, 0x57, 0xf8, 0xce,
+	0xd9, 0x27, 0x99, 0xd4, 0x37, 0xb2, 0x25, 0x07, 0x79, 0xd4, 0x96, 0x54, 0x51, 0x58, 0xd0, 0x47,
+	0x4b, 0xa9, 0x23, 0x84, 0x19, 0x80, 0x6b, 0x7b, 0xeb, 0xac, 0x33, 0x44, 0x2a, 0x7b, 0x49, 0x2a,
+	0xbb, 0x99, 0x74, 0x4e, 0xe5, 0x80, 0x68, 0x21, 0x65, 0xb4, 0x30, 0xfd, 0x7b, 0x0d, 0xac, 0x2a,
+	0x5e, 0x47, 0xf5, 0x59, 0xe3, 0x07, 0x35, 0x33, 0xf2, 0xe5, 0xdc, 0xe4, 0x37, 0xe3, 0xd4, 0x89,
+	0x64, 0xbe, 0x2a, 0xf5, 0xc1, 0xa1, 0x9d, 0x4d, 0xb2, 0x41, 0xb4, 0xd2, 0x1f, 0x81, 0xa5, 0xdc,
+	0xad, 0xcc, 0x47, 0x3f, 0x1c, 0x17, 0xb5, 0x67, 0xc7, 0x45, 0xed, 0xf9, 0x71, 0x51, 0xfb, 0xf3,
+	0xb8, 0xa8, 0x7d, 0x7d, 0x52, 0xcc, 0x3c, 0x3f, 0x29, 0x66, 0x7e, 0x3b, 0x29, 0x66, 0x3e, 0xdb,
+	0x18, 0xfb, 0x65, 0xf2, 0xc5, 0xe0, 0xb7, 0x26, 0xff, 0x50, 0xa9, 0x4f, 0xf3, 0xaf, 0xcb, 0x37,
+	0xfe, 0x1d, 0x00, 0x9d, 0x01, 0x20, 0xe6, 0x0d, 0x0f, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -767,6 +816,46 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorCommissionSplitRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionSplitRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionSplitRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Split.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -787,6 +876,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorCommissionSplits) > 0 {
+		for iNdEx := len(m.ValidatorCommissionSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1050,6 +1153,21 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorCommissionSplitRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Split.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1106,6 +1224,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorCommissionSplits) > 0 {
+		for _, e := range m.ValidatorCommissionSplits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2009,6 +2133,121 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorCommissionSplitRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionSplitRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionSplitRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2374,6 +2613,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCommissionSplits = append(m.ValidatorCommissionSplits, ValidatorCommissionSplitRecord{})
+			if err := m.ValidatorCommissionSplits[len(m.ValidatorCommissionSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
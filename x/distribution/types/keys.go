@@ -3,8 +3,11 @@ package types
 import (
 	"encoding/binary"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -41,6 +44,8 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<valAddrLen (1 Byte)><valAddr_Bytes>: CommissionSplit
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -52,6 +57,7 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	CommissionSplitPrefix                = []byte{0x09} // key for validator commission split
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -139,6 +145,20 @@ func GetValidatorAccumulatedCommissionAddress(key []byte) (valAddr sdk.ValAddres
 	return sdk.ValAddress(addr)
 }
 
+// GetCommissionSplitAddress creates the address from a validator's commission split key.
+func GetCommissionSplitAddress(key []byte) (valAddr sdk.ValAddress) {
+	// key is in the format:
+	// 0x09<valAddrLen (1 Byte)><valAddr_Bytes>: CommissionSplit
+
+	// Remove prefix and address length.
+	addr := key[2:]
+	if len(addr) != int(key[1]) {
+		panic("unexpected key length")
+	}
+
+	return sdk.ValAddress(addr)
+}
+
 // GetValidatorSlashEventAddressHeight creates the height from a validator's slash event key.
 func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, height uint64) {
 	// key is in the format:
@@ -212,3 +232,62 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetCommissionSplitKey creates the key for a validator's commission split.
+func GetCommissionSplitKey(v sdk.ValAddress) []byte {
+	return append(CommissionSplitPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// KeyFormats are the layouts of the keys of the distribution store.
+var KeyFormats = []keyformat.KeyFormat{
+	{Name: "fee_pool", Prefix: FeePoolKey, Value: keyformat.ProtoValue(&FeePool{})},
+	{Name: "proposer", Prefix: ProposerKey, Value: keyformat.ProtoValue(&gogotypes.BytesValue{})},
+	{
+		Name:     "validator_outstanding_rewards",
+		Prefix:   ValidatorOutstandingRewardsPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator")},
+		Value:    keyformat.ProtoValue(&ValidatorOutstandingRewards{}),
+	},
+	{
+		Name:     "delegator_withdraw_address",
+		Prefix:   DelegatorWithdrawAddrPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("delegator")},
+		Value:    keyformat.SegmentValue(keyformat.AccAddress("withdraw_address")),
+	},
+	{
+		Name:     "delegator_starting_info",
+		Prefix:   DelegatorStartingInfoPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator"), keyformat.AccAddress("delegator")},
+		Value:    keyformat.ProtoValue(&DelegatorStartingInfo{}),
+	},
+	{
+		Name:     "validator_historical_rewards",
+		Prefix:   ValidatorHistoricalRewardsPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator"), keyformat.Uint64LE("period")},
+		Value:    keyformat.ProtoValue(&ValidatorHistoricalRewards{}),
+	},
+	{
+		Name:     "validator_current_rewards",
+		Prefix:   ValidatorCurrentRewardsPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator")},
+		Value:    keyformat.ProtoValue(&ValidatorCurrentRewards{}),
+	},
+	{
+		Name:     "validator_accumulated_commission",
+		Prefix:   ValidatorAccumulatedCommissionPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator")},
+		Value:    keyformat.ProtoValue(&ValidatorAccumulatedCommission{}),
+	},
+	{
+		Name:     "validator_slash_event",
+		Prefix:   ValidatorSlashEventPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator"), keyformat.Uint64("height"), keyformat.Uint64("period")},
+		Value:    keyformat.ProtoValue(&ValidatorSlashEvent{}),
+	},
+	{
+		Name:     "commission_split",
+		Prefix:   CommissionSplitPrefix,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator")},
+		Value:    keyformat.ProtoValue(&CommissionSplit{}),
+	},
+}
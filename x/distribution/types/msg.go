@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 // distribution message types
@@ -11,10 +12,12 @@ const (
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetCommissionSplit          = "set_commission_split"
+	TypeMsgUpdateParams                = "update_params"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var _, _, _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgSetCommissionSplit{}, &MsgUpdateParams{}
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -28,11 +31,7 @@ func (msg MsgSetWithdrawAddress) Type() string  { return TypeMsgSetWithdrawAddre
 
 // Return address that must sign over msg.GetSignBytes()
 func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
-	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{delAddr}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // get the bytes for the message signer to sign on
@@ -65,11 +64,7 @@ func (msg MsgWithdrawDelegatorReward) Type() string  { return TypeMsgWithdrawDel
 
 // Return address that must sign over msg.GetSignBytes()
 func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
-	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{delAddr}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // get the bytes for the message signer to sign on
@@ -100,11 +95,7 @@ func (msg MsgWithdrawValidatorCommission) Type() string  { return TypeMsgWithdra
 
 // Return address that must sign over msg.GetSignBytes()
 func (msg MsgWithdrawValidatorCommission) GetSigners() []sdk.AccAddress {
-	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{valAddr.Bytes()}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // get the bytes for the message signer to sign on
@@ -139,11 +130,7 @@ func (msg MsgFundCommunityPool) Type() string { return TypeMsgFundCommunityPool
 // GetSigners returns the signer addresses that are expected to sign the result
 // of GetSignBytes.
 func (msg MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
-	depoAddr, err := sdk.AccAddressFromBech32(msg.Depositor)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{depoAddr}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // GetSignBytes returns the raw bytes for a MsgFundCommunityPool message that
@@ -164,3 +151,82 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetCommissionSplit returns a new MsgSetCommissionSplit splitting the
+// commission of valAddr between recipients.
+func NewMsgSetCommissionSplit(valAddr sdk.ValAddress, recipients []CommissionSplitRecipient) *MsgSetCommissionSplit {
+	return &MsgSetCommissionSplit{
+		ValidatorAddress: valAddr.String(),
+		Recipients:       recipients,
+	}
+}
+
+// Route returns the MsgSetCommissionSplit message route.
+func (msg MsgSetCommissionSplit) Route() string { return ModuleName }
+
+// Type returns the MsgSetCommissionSplit message type.
+func (msg MsgSetCommissionSplit) Type() string { return TypeMsgSetCommissionSplit }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetCommissionSplit) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetCommissionSplit message that
+// the expected signer needs to sign.
+func (msg MsgSetCommissionSplit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetCommissionSplit message validation. No
+// recipients are valid, removing the split.
+func (msg MsgSetCommissionSplit) ValidateBasic() error {
+	if msg.ValidatorAddress == "" {
+		return ErrEmptyValidatorAddr
+	}
+	if len(msg.Recipients) == 0 {
+		return nil
+	}
+
+	return CommissionSplit{Recipients: msg.Recipients}.Validate()
+}
+
+// NewMsgUpdateParams returns a new MsgUpdateParams, updating the distribution
+// parameters with the authority of the module.
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+// Route returns the MsgUpdateParams message route.
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+
+// Type returns the MsgUpdateParams message type.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// GetSignBytes returns the raw bytes for a MsgUpdateParams message that
+// the expected signer needs to sign.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgUpdateParams message validation.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return msg.Params.ValidateBasic()
+}
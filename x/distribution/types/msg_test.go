@@ -73,6 +73,33 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgSetCommissionSplit
+func TestMsgSetCommissionSplit(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		validatorAddr sdk.ValAddress
+		recipients    []CommissionSplitRecipient
+		expectPass    bool
+	}{
+		{valAddr1, nil, true},
+		{valAddr1, []CommissionSplitRecipient{NewCommissionSplitRecipient(delAddr1, sdk.OneDec())}, true},
+		{valAddr1, []CommissionSplitRecipient{NewCommissionSplitRecipient(delAddr1, half), NewCommissionSplitRecipient(delAddr2, half)}, true},
+		{emptyValAddr, nil, false},
+		{valAddr1, []CommissionSplitRecipient{NewCommissionSplitRecipient(delAddr1, half)}, false},
+		{valAddr1, []CommissionSplitRecipient{NewCommissionSplitRecipient(delAddr1, half), NewCommissionSplitRecipient(delAddr1, half)}, false},
+		{valAddr1, []CommissionSplitRecipient{NewCommissionSplitRecipient(delAddr1, sdk.NewDec(2)), NewCommissionSplitRecipient(delAddr2, sdk.NewDec(-1))}, false},
+		{valAddr1, []CommissionSplitRecipient{NewCommissionSplitRecipient(emptyDelAddr, sdk.OneDec())}, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetCommissionSplit(tc.validatorAddr, tc.recipients)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDepositIntoCommunityPool
 func TestMsgDepositIntoCommunityPool(t *testing.T) {
 	tests := []struct {
@@ -73,6 +73,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_changed_height is the height at which the parameters last changed.
+	LastChangedHeight int64 `protobuf:"varint,2,opt,name=last_changed_height,json=lastChangedHeight,proto3" json:"last_changed_height,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -115,6 +117,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangedHeight() int64 {
+	if m != nil {
+		return m.LastChangedHeight
+	}
+	return 0
+}
+
 // QueryValidatorOutstandingRewardsRequest is the request type for the
 // Query/ValidatorOutstandingRewards RPC method.
 type QueryValidatorOutstandingRewardsRequest struct {
@@ -306,6 +315,101 @@ func (m *QueryValidatorCommissionResponse) GetCommission() ValidatorAccumulatedC
 	return ValidatorAccumulatedCommission{}
 }
 
+// QueryCommissionSplitRequest is the request type for the
+// Query/CommissionSplit RPC method
+type QueryCommissionSplitRequest struct {
+	// validator_address defines the validator address to query for.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryCommissionSplitRequest) Reset()         { *m = QueryCommissionSplitRequest{} }
+func (m *QueryCommissionSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionSplitRequest) ProtoMessage()    {}
+func (*QueryCommissionSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{6}
+}
+func (m *QueryCommissionSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionSplitRequest.Merge(m, src)
+}
+func (m *QueryCommissionSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionSplitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionSplitRequest proto.InternalMessageInfo
+
+func (m *QueryCommissionSplitRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+// QueryCommissionSplitResponse is the response type for the
+// Query/CommissionSplit RPC method
+type QueryCommissionSplitResponse struct {
+	// split defines the commission split of the validator, without recipients if
+	// the validator has none.
+	Split CommissionSplit `protobuf:"bytes,1,opt,name=split,proto3" json:"split"`
+}
+
+func (m *QueryCommissionSplitResponse) Reset()         { *m = QueryCommissionSplitResponse{} }
+func (m *QueryCommissionSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommissionSplitResponse) ProtoMessage()    {}
+func (*QueryCommissionSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{7}
+}
+func (m *QueryCommissionSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommissionSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommissionSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCommissionSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommissionSplitResponse.Merge(m, src)
+}
+func (m *QueryCommissionSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommissionSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommissionSplitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCommissionSplitResponse proto.InternalMessageInfo
+
+func (m *QueryCommissionSplitResponse) GetSplit() CommissionSplit {
+	if m != nil {
+		return m.Split
+	}
+	return CommissionSplit{}
+}
+
 // QueryValidatorSlashesRequest is the request type for the
 // Query/ValidatorSlashes RPC method
 type QueryValidatorSlashesRequest struct {
@@ -323,7 +427,7 @@ func (m *QueryValidatorSlashesRequest) Reset()         { *m = QueryValidatorSlas
 func (m *QueryValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesRequest) ProtoMessage()    {}
 func (*QueryValidatorSlashesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{6}
+	return fileDescriptor_5efd02cbc06efdc9, []int{8}
 }
 func (m *QueryValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +469,7 @@ func (m *QueryValidatorSlashesResponse) Reset()         { *m = QueryValidatorSla
 func (m *QueryValidatorSlashesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryValidatorSlashesResponse) ProtoMessage()    {}
 func (*QueryValidatorSlashesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{7}
+	return fileDescriptor_5efd02cbc06efdc9, []int{9}
 }
 func (m *QueryValidatorSlashesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +525,7 @@ func (m *QueryDelegationRewardsRequest) Reset()         { *m = QueryDelegationRe
 func (m *QueryDelegationRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{8}
+	return fileDescriptor_5efd02cbc06efdc9, []int{10}
 }
 func (m *QueryDelegationRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -461,7 +565,7 @@ func (m *QueryDelegationRewardsResponse) Reset()         { *m = QueryDelegationR
 func (m *QueryDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{9}
+	return fileDescriptor_5efd02cbc06efdc9, []int{11}
 }
 func (m *QueryDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +612,7 @@ func (m *QueryDelegationTotalRewardsRequest) Reset()         { *m = QueryDelegat
 func (m *QueryDelegationTotalRewardsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsRequest) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{10}
+	return fileDescriptor_5efd02cbc06efdc9, []int{12}
 }
 func (m *QueryDelegationTotalRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +654,7 @@ func (m *QueryDelegationTotalRewardsResponse) Reset()         { *m = QueryDelega
 func (m *QueryDelegationTotalRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegationTotalRewardsResponse) ProtoMessage()    {}
 func (*QueryDelegationTotalRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{11}
+	return fileDescriptor_5efd02cbc06efdc9, []int{13}
 }
 func (m *QueryDelegationTotalRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -604,7 +708,7 @@ func (m *QueryDelegatorValidatorsRequest) Reset()         { *m = QueryDelegatorV
 func (m *QueryDelegatorValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsRequest) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{12}
+	return fileDescriptor_5efd02cbc06efdc9, []int{14}
 }
 func (m *QueryDelegatorValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +748,7 @@ func (m *QueryDelegatorValidatorsResponse) Reset()         { *m = QueryDelegator
 func (m *QueryDelegatorValidatorsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorValidatorsResponse) ProtoMessage()    {}
 func (*QueryDelegatorValidatorsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{13}
+	return fileDescriptor_5efd02cbc06efdc9, []int{15}
 }
 func (m *QueryDelegatorValidatorsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +788,7 @@ func (m *QueryDelegatorWithdrawAddressRequest) Reset()         { *m = QueryDeleg
 func (m *QueryDelegatorWithdrawAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressRequest) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{14}
+	return fileDescriptor_5efd02cbc06efdc9, []int{16}
 }
 func (m *QueryDelegatorWithdrawAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +828,7 @@ func (m *QueryDelegatorWithdrawAddressResponse) Reset()         { *m = QueryDele
 func (m *QueryDelegatorWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDelegatorWithdrawAddressResponse) ProtoMessage()    {}
 func (*QueryDelegatorWithdrawAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{15}
+	return fileDescriptor_5efd02cbc06efdc9, []int{17}
 }
 func (m *QueryDelegatorWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -762,7 +866,7 @@ func (m *QueryCommunityPoolRequest) Reset()         { *m = QueryCommunityPoolReq
 func (m *QueryCommunityPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolRequest) ProtoMessage()    {}
 func (*QueryCommunityPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{16}
+	return fileDescriptor_5efd02cbc06efdc9, []int{18}
 }
 func (m *QueryCommunityPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +906,7 @@ func (m *QueryCommunityPoolResponse) Reset()         { *m = QueryCommunityPoolRe
 func (m *QueryCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCommunityPoolResponse) ProtoMessage()    {}
 func (*QueryCommunityPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5efd02cbc06efdc9, []int{17}
+	return fileDescriptor_5efd02cbc06efdc9, []int{19}
 }
 func (m *QueryCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,6 +949,8 @@ func init() {
 	proto.RegisterType((*QueryValidatorOutstandingRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorOutstandingRewardsResponse")
 	proto.RegisterType((*QueryValidatorCommissionRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionRequest")
 	proto.RegisterType((*QueryValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorCommissionResponse")
+	proto.RegisterType((*QueryCommissionSplitRequest)(nil), "cosmos.distribution.v1beta1.QueryCommissionSplitRequest")
+	proto.RegisterType((*QueryCommissionSplitResponse)(nil), "cosmos.distribution.v1beta1.QueryCommissionSplitResponse")
 	proto.RegisterType((*QueryValidatorSlashesRequest)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesRequest")
 	proto.RegisterType((*QueryValidatorSlashesResponse)(nil), "cosmos.distribution.v1beta1.QueryValidatorSlashesResponse")
 	proto.RegisterType((*QueryDelegationRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegationRewardsRequest")
@@ -864,76 +970,81 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x6e, 0xda, 0xd2, 0x57, 0x4a, 0xd2, 0x69, 0x85, 0xcc, 0x26, 0xd8, 0xd1, 0x86,
-	0x92, 0x40, 0x54, 0x6f, 0x93, 0x48, 0x05, 0x5a, 0x10, 0xe4, 0x57, 0xa9, 0xd4, 0x2a, 0x4d, 0x4d,
-	0x95, 0x84, 0x5f, 0x8a, 0x26, 0xde, 0xd1, 0x7a, 0x55, 0x7b, 0xc7, 0xdd, 0x19, 0x27, 0x44, 0x55,
-	0x2f, 0x04, 0x24, 0x2e, 0x48, 0x48, 0x5c, 0x7a, 0xcc, 0x99, 0x3b, 0x17, 0xfe, 0x82, 0x1e, 0x2b,
-	0x21, 0xa1, 0x9e, 0x00, 0x25, 0x08, 0x55, 0x42, 0x9c, 0xb9, 0x22, 0xcf, 0xcc, 0xda, 0xbb, 0xf6,
-	0x7a, 0xfd, 0x4b, 0x3d, 0xc5, 0x7a, 0x3b, 0xef, 0x3b, 0xef, 0xf3, 0x66, 0xde, 0xbc, 0xa7, 0xc0,
-	0x74, 0x91, 0xf1, 0x0a, 0xe3, 0x96, 0xed, 0x72, 0xe1, 0xbb, 0x3b, 0x35, 0xe1, 0x32, 0xcf, 0xda,
-	0x9d, 0xdb, 0xa1, 0x82, 0xcc, 0x59, 0x0f, 0x6a, 0xd4, 0xdf, 0xcf, 0x57, 0x7d, 0x26, 0x18, 0x1e,
-	0x57, 0x0b, 0xf3, 0xe1, 0x85, 0x79, 0xbd, 0xd0, 0x78, 0x5b, 0xab, 0xec, 0x10, 0x4e, 0x95, 0x57,
-	0x43, 0xa3, 0x4a, 0x1c, 0xd7, 0x23, 0x72, 0xb5, 0x14, 0x32, 0x2e, 0x3a, 0xcc, 0x61, 0xf2, 0xa7,
-	0x55, 0xff, 0xa5, 0xad, 0x13, 0x0e, 0x63, 0x4e, 0x99, 0x5a, 0xa4, 0xea, 0x5a, 0xc4, 0xf3, 0x98,
-	0x90, 0x2e, 0x5c, 0x7f, 0xcd, 0x86, 0xf5, 0x03, 0xe5, 0x22, 0x73, 0x03, 0xcd, 0x7c, 0x12, 0x45,
-	0x24, 0x62, 0xb9, 0xde, 0xbc, 0x08, 0xf8, 0x6e, 0x3d, 0xca, 0x75, 0xe2, 0x93, 0x0a, 0x2f, 0xd0,
-	0x07, 0x35, 0xca, 0x85, 0xb9, 0x05, 0x17, 0x22, 0x56, 0x5e, 0x65, 0x1e, 0xa7, 0x78, 0x11, 0x4e,
-	0x55, 0xa5, 0x25, 0x83, 0x26, 0xd1, 0xcc, 0xd9, 0xf9, 0xa9, 0x7c, 0x42, 0x2a, 0xf2, 0xca, 0x79,
-	0x69, 0xe4, 0xc9, 0xef, 0xb9, 0x54, 0x41, 0x3b, 0x9a, 0x1b, 0x30, 0x2d, 0x95, 0x37, 0x48, 0xd9,
-	0xb5, 0x89, 0x60, 0xfe, 0x9d, 0x9a, 0xe0, 0x82, 0x78, 0xb6, 0xeb, 0x39, 0x05, 0xba, 0x47, 0x7c,
-	0x3b, 0x08, 0x02, 0xcf, 0xc2, 0xf9, 0xdd, 0x60, 0xd5, 0x36, 0xb1, 0x6d, 0x9f, 0x72, 0xb5, 0xf1,
-	0x99, 0xc2, 0x58, 0xe3, 0xc3, 0xa2, 0xb2, 0x9b, 0xdf, 0x20, 0x98, 0xe9, 0x2e, 0xac, 0x39, 0xb6,
-	0xe0, 0xb4, 0xaf, 0x4c, 0x1a, 0xe4, 0xdd, 0x44, 0x90, 0x04, 0x49, 0x4d, 0x17, 0xc8, 0x99, 0x6b,
-	0x90, 0x8b, 0x46, 0xb1, 0xcc, 0x2a, 0x15, 0x97, 0x73, 0x97, 0x79, 0x03, 0x61, 0x7d, 0x8b, 0x60,
-	0xb2, 0xb3, 0xa0, 0xc6, 0x21, 0x00, 0xc5, 0x86, 0x55, 0x13, 0x5d, 0xef, 0x8d, 0x68, 0xb1, 0x58,
-	0xac, 0x55, 0x6a, 0x65, 0x22, 0xa8, 0xdd, 0x14, 0xd6, 0x50, 0x21, 0x51, 0xf3, 0x1f, 0x04, 0x13,
-	0xd1, 0x38, 0x3e, 0x29, 0x13, 0x5e, 0xa2, 0x03, 0x1d, 0x16, 0x9e, 0x86, 0x51, 0x2e, 0x88, 0x2f,
-	0x5c, 0xcf, 0xd9, 0x2e, 0x51, 0xd7, 0x29, 0x89, 0x4c, 0x7a, 0x12, 0xcd, 0x8c, 0x14, 0x5e, 0x09,
-	0xcc, 0x37, 0xa5, 0x15, 0x4f, 0xc1, 0x39, 0x2a, 0xd3, 0x1d, 0x2c, 0x3b, 0x21, 0x97, 0xbd, 0xac,
-	0x8c, 0x7a, 0xd1, 0x0d, 0x80, 0x66, 0x69, 0x65, 0x46, 0x24, 0xfe, 0x9b, 0x01, 0x7e, 0xbd, 0x4e,
-	0xf2, 0xaa, 0x7a, 0x9b, 0xf7, 0xd2, 0xa1, 0x3a, 0xec, 0x42, 0xc8, 0xf3, 0xda, 0x4b, 0xdf, 0x1d,
-	0xe6, 0x52, 0x8f, 0x0f, 0x73, 0xc8, 0xfc, 0x05, 0xc1, 0xeb, 0x1d, 0x68, 0x75, 0xca, 0xd7, 0xe1,
-	0x34, 0x57, 0xa6, 0x0c, 0x9a, 0x3c, 0x31, 0x73, 0x76, 0xfe, 0x4a, 0x6f, 0xf9, 0x96, 0x3a, 0xab,
-	0xbb, 0xd4, 0x13, 0xc1, 0xcd, 0xd1, 0x32, 0xf8, 0xe3, 0x08, 0x45, 0x5a, 0x52, 0x4c, 0x77, 0xa5,
-	0x50, 0xe1, 0x84, 0x31, 0xcc, 0x83, 0x20, 0xf8, 0x15, 0x5a, 0xa6, 0x8e, 0xb4, 0xb5, 0x17, 0x96,
-	0xad, 0xbe, 0xb5, 0x9f, 0x55, 0xe3, 0x43, 0x70, 0x56, 0xb1, 0x07, 0x9b, 0x8e, 0x3f, 0x58, 0x95,
-	0xc2, 0xe7, 0x87, 0xb9, 0x94, 0xf9, 0x3d, 0x82, 0x6c, 0xa7, 0x28, 0x74, 0x0e, 0xef, 0x87, 0xab,
-	0xb0, 0x9e, 0xc3, 0x89, 0x08, 0x6e, 0x00, 0xba, 0x42, 0x8b, 0xcb, 0xcc, 0xf5, 0x96, 0x16, 0xea,
-	0xf9, 0xfa, 0xe9, 0x8f, 0xdc, 0xac, 0xe3, 0x8a, 0x52, 0x6d, 0x27, 0x5f, 0x64, 0x15, 0x4b, 0x3f,
-	0x76, 0xea, 0xcf, 0x65, 0x6e, 0xdf, 0xb7, 0xc4, 0x7e, 0x95, 0xf2, 0xc0, 0x87, 0x37, 0x0b, 0xf3,
-	0x73, 0x30, 0x5b, 0xc2, 0xb9, 0xc7, 0x04, 0x29, 0x0f, 0x91, 0x99, 0x10, 0xec, 0xdf, 0x08, 0xa6,
-	0x12, 0xd5, 0x35, 0xf1, 0x46, 0x2b, 0xf1, 0xd5, 0xc4, 0x5b, 0xd3, 0x54, 0x5b, 0x09, 0xf6, 0x56,
-	0x8a, 0x2d, 0xaf, 0x0e, 0x76, 0xe0, 0xa4, 0xa8, 0xef, 0x97, 0x49, 0xbf, 0xa8, 0x3c, 0x2a, 0x7d,
-	0x73, 0x4b, 0x3f, 0x6f, 0x8d, 0x78, 0x1a, 0x17, 0x7b, 0xd8, 0x14, 0xde, 0xd6, 0xef, 0x5c, 0xac,
-	0xb2, 0x4e, 0x5f, 0x16, 0xa0, 0x71, 0xe3, 0x54, 0x06, 0xcf, 0x14, 0x42, 0x96, 0x90, 0xda, 0x97,
-	0xf0, 0x46, 0x54, 0x6d, 0xd3, 0x15, 0x25, 0xdb, 0x27, 0x7b, 0x7a, 0xe3, 0x21, 0x83, 0xfd, 0x02,
-	0x2e, 0x75, 0x91, 0xd7, 0x11, 0xbf, 0x05, 0x63, 0x7b, 0xfa, 0x53, 0x8b, 0xfc, 0xe8, 0x5e, 0xd4,
-	0x25, 0xa4, 0x3e, 0x0e, 0xaf, 0x49, 0xf5, 0xfa, 0x83, 0x5c, 0xf3, 0x5c, 0xb1, 0xbf, 0xce, 0x58,
-	0x39, 0xe8, 0xcc, 0x07, 0x08, 0x8c, 0xb8, 0xaf, 0x7a, 0x43, 0x0a, 0x23, 0x55, 0xc6, 0xca, 0x2f,
-	0xae, 0xa0, 0xa4, 0xfc, 0xfc, 0xb3, 0x51, 0x38, 0x29, 0xa3, 0xc0, 0x8f, 0x11, 0x9c, 0x52, 0x8d,
-	0x1e, 0x5b, 0x89, 0x97, 0xb9, 0x7d, 0xca, 0x30, 0xae, 0xf4, 0xee, 0xa0, 0xf0, 0xcc, 0xd9, 0xaf,
-	0x7f, 0xfd, 0xeb, 0xc7, 0xf4, 0x25, 0x3c, 0x65, 0x25, 0x8d, 0x39, 0x6a, 0xd4, 0xc0, 0x07, 0x69,
-	0x18, 0x4f, 0x68, 0xdd, 0x78, 0xa5, 0xfb, 0xf6, 0xdd, 0xa7, 0x14, 0x63, 0x75, 0x48, 0x15, 0x4d,
-	0xb6, 0x29, 0xc9, 0xee, 0xe2, 0x3b, 0x89, 0x64, 0xcd, 0xcb, 0x6e, 0x3d, 0x6c, 0x7b, 0x95, 0x1f,
-	0x59, 0xac, 0xa9, 0xbf, 0x1d, 0xbc, 0x0d, 0x47, 0x08, 0x2e, 0xc4, 0x0c, 0x0f, 0xf8, 0xfd, 0x3e,
-	0xe2, 0x6e, 0x1b, 0x62, 0x8c, 0x0f, 0x06, 0xf4, 0xd6, 0xb4, 0x6b, 0x92, 0xf6, 0x26, 0xbe, 0x31,
-	0x0c, 0x6d, 0x73, 0x3c, 0xc1, 0xbf, 0x21, 0x18, 0x6b, 0xed, 0xd5, 0xf8, 0xbd, 0x3e, 0x62, 0x8c,
-	0x4e, 0x33, 0xc6, 0xb5, 0x41, 0x5c, 0x35, 0xdb, 0x2d, 0xc9, 0xb6, 0x8a, 0x97, 0x87, 0x61, 0x0b,
-	0xa6, 0x82, 0x7f, 0x11, 0x9c, 0x6f, 0xeb, 0xa0, 0xb8, 0x87, 0xf0, 0x3a, 0x35, 0x7f, 0xe3, 0xfa,
-	0x40, 0xbe, 0x9a, 0x6d, 0x5b, 0xb2, 0x7d, 0x8a, 0x37, 0x13, 0xd9, 0x1a, 0x2f, 0x27, 0xb7, 0x1e,
-	0xb6, 0x3d, 0xaf, 0x8f, 0x2c, 0x7d, 0x33, 0xe3, 0xb8, 0xf1, 0x73, 0x04, 0xaf, 0xc6, 0x37, 0x51,
-	0xfc, 0x61, 0x3f, 0x81, 0xc7, 0x34, 0x77, 0xe3, 0xa3, 0xc1, 0x05, 0xfa, 0x3a, 0xda, 0xde, 0xf0,
-	0x65, 0x61, 0xc6, 0x74, 0xbb, 0x5e, 0x0a, 0xb3, 0x73, 0xfb, 0xed, 0xa5, 0x30, 0x13, 0x5a, 0x6c,
-	0x8f, 0x85, 0xd9, 0x85, 0xb0, 0x79, 0xb7, 0xf1, 0x7f, 0x08, 0x32, 0x9d, 0xba, 0x24, 0x5e, 0xec,
-	0x23, 0xd6, 0xf8, 0x06, 0x6e, 0x2c, 0x0d, 0x23, 0xa1, 0x99, 0xef, 0x49, 0xe6, 0x35, 0x7c, 0x7b,
-	0x18, 0xe6, 0xd6, 0x36, 0x8f, 0x7f, 0x46, 0x70, 0x2e, 0xd2, 0xa3, 0xf1, 0xd5, 0xee, 0xb1, 0xc6,
-	0xb5, 0x7c, 0xe3, 0x9d, 0xbe, 0xfd, 0x34, 0xd8, 0x82, 0x04, 0xbb, 0x8c, 0x67, 0x13, 0xc1, 0x8a,
-	0x81, 0xef, 0x76, 0xbd, 0xb5, 0x2f, 0xdd, 0x7a, 0x72, 0x94, 0x45, 0x4f, 0x8f, 0xb2, 0xe8, 0xcf,
-	0xa3, 0x2c, 0xfa, 0xe1, 0x38, 0x9b, 0x7a, 0x7a, 0x9c, 0x4d, 0x3d, 0x3b, 0xce, 0xa6, 0x3e, 0x9b,
-	0x4b, 0x9c, 0x13, 0xbe, 0x8a, 0xaa, 0xcb, 0xb1, 0x61, 0xe7, 0x94, 0xfc, 0x27, 0xc3, 0xc2, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x68, 0x0b, 0x91, 0xae, 0x5c, 0x11, 0x00, 0x00,
+	// 1182 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x98, 0xcb, 0x6f, 0x5b, 0x45,
+	0x14, 0xc6, 0x3d, 0xce, 0xa3, 0xf4, 0x94, 0x92, 0x64, 0x52, 0x21, 0x73, 0x13, 0xec, 0xe8, 0x86,
+	0x92, 0x40, 0xa8, 0x6f, 0x93, 0x48, 0xa5, 0x0f, 0x2a, 0xc8, 0xab, 0x44, 0xb4, 0x4a, 0x53, 0xb7,
+	0x4a, 0xca, 0x4b, 0xd6, 0xc4, 0x77, 0x74, 0x7d, 0x55, 0xfb, 0x8e, 0xeb, 0x19, 0x27, 0x44, 0x55,
+	0x37, 0x04, 0xa4, 0x6e, 0x90, 0x90, 0xd8, 0x74, 0x99, 0x35, 0x7b, 0x84, 0xc4, 0x5f, 0xd0, 0x65,
+	0x25, 0x24, 0xc4, 0x02, 0x01, 0x4a, 0x10, 0xaa, 0x84, 0x58, 0xb3, 0x45, 0x9e, 0x99, 0x6b, 0x5f,
+	0xbf, 0xae, 0x5f, 0xed, 0x2a, 0xd6, 0x99, 0x39, 0xdf, 0x9c, 0xdf, 0x99, 0xf1, 0xf1, 0xa7, 0xc0,
+	0x4c, 0x86, 0xf1, 0x3c, 0xe3, 0x96, 0xed, 0x72, 0x51, 0x74, 0x77, 0x4a, 0xc2, 0x65, 0x9e, 0xb5,
+	0x3b, 0xbf, 0x43, 0x05, 0x99, 0xb7, 0xee, 0x97, 0x68, 0x71, 0x3f, 0x59, 0x28, 0x32, 0xc1, 0xf0,
+	0x84, 0xda, 0x98, 0x0c, 0x6e, 0x4c, 0xea, 0x8d, 0xc6, 0xdb, 0x5a, 0x65, 0x87, 0x70, 0xaa, 0xb2,
+	0x2a, 0x1a, 0x05, 0xe2, 0xb8, 0x1e, 0x91, 0xbb, 0xa5, 0x90, 0x71, 0xc6, 0x61, 0x0e, 0x93, 0x1f,
+	0xad, 0xf2, 0x27, 0x1d, 0x9d, 0x74, 0x18, 0x73, 0x72, 0xd4, 0x22, 0x05, 0xd7, 0x22, 0x9e, 0xc7,
+	0x84, 0x4c, 0xe1, 0x7a, 0x35, 0x1e, 0xd4, 0xf7, 0x95, 0x33, 0xcc, 0xf5, 0x35, 0x93, 0x61, 0x14,
+	0x35, 0x15, 0xcb, 0xfd, 0xe6, 0x19, 0xc0, 0xb7, 0xca, 0x55, 0x6e, 0x92, 0x22, 0xc9, 0xf3, 0x14,
+	0xbd, 0x5f, 0xa2, 0x5c, 0x98, 0x8f, 0x10, 0x8c, 0xd7, 0x84, 0x79, 0x81, 0x79, 0x9c, 0xe2, 0x25,
+	0x18, 0x2e, 0xc8, 0x48, 0x0c, 0x4d, 0xa1, 0xd9, 0x53, 0x0b, 0xd3, 0xc9, 0x90, 0x5e, 0x24, 0x55,
+	0xf2, 0xf2, 0xe0, 0x93, 0xdf, 0x13, 0x91, 0x94, 0x4e, 0xc4, 0x49, 0x18, 0xcf, 0x11, 0x2e, 0xd2,
+	0x99, 0x2c, 0xf1, 0x1c, 0x6a, 0xa7, 0xb3, 0xd4, 0x75, 0xb2, 0x22, 0x16, 0x9d, 0x42, 0xb3, 0x03,
+	0xa9, 0xb1, 0xf2, 0xd2, 0x8a, 0x5a, 0x59, 0x97, 0x0b, 0xe6, 0x16, 0xcc, 0xc8, 0x4a, 0xb6, 0x48,
+	0xce, 0xb5, 0x89, 0x60, 0xc5, 0x9b, 0x25, 0xc1, 0x05, 0xf1, 0x6c, 0xd7, 0x73, 0x52, 0x74, 0x8f,
+	0x14, 0x6d, 0xbf, 0x6a, 0x3c, 0x07, 0x63, 0xbb, 0xfe, 0xae, 0x34, 0xb1, 0xed, 0x22, 0xe5, 0xaa,
+	0xd0, 0x93, 0xa9, 0xd1, 0xca, 0xc2, 0x92, 0x8a, 0x9b, 0x5f, 0x21, 0x98, 0x6d, 0x2f, 0xac, 0xb9,
+	0xef, 0xc2, 0x89, 0xa2, 0x0a, 0x69, 0xf0, 0x8b, 0xa1, 0xe0, 0x21, 0x92, 0xba, 0x1b, 0xbe, 0x9c,
+	0xb9, 0x01, 0x89, 0xda, 0x2a, 0x56, 0x58, 0x3e, 0xef, 0x72, 0xee, 0x32, 0xaf, 0x27, 0xac, 0xaf,
+	0x11, 0x4c, 0xb5, 0x16, 0xd4, 0x38, 0x04, 0x20, 0x53, 0x89, 0x6a, 0xa2, 0x2b, 0x9d, 0x11, 0x2d,
+	0x65, 0x32, 0xa5, 0x7c, 0x29, 0x47, 0x04, 0xb5, 0xab, 0xc2, 0x1a, 0x2a, 0x20, 0x6a, 0x7e, 0x04,
+	0x13, 0xb2, 0x8c, 0xea, 0xa6, 0xdb, 0x85, 0x9c, 0x2b, 0x7a, 0x62, 0xca, 0xc2, 0x64, 0x73, 0x2d,
+	0x8d, 0xb3, 0x0e, 0x43, 0xbc, 0x1c, 0xd0, 0x24, 0xef, 0x84, 0x92, 0xd4, 0x89, 0xe8, 0xd2, 0x95,
+	0x80, 0xf9, 0x0f, 0xd2, 0x47, 0x55, 0x78, 0x6f, 0xe7, 0x08, 0xcf, 0xd2, 0x9e, 0x9e, 0x18, 0x9e,
+	0x81, 0x11, 0x2e, 0x48, 0x51, 0xb8, 0x9e, 0x13, 0x7c, 0xe6, 0x83, 0xa9, 0x57, 0xfc, 0xb0, 0x7a,
+	0xe3, 0x78, 0x1a, 0x4e, 0x53, 0xcf, 0x0e, 0x6c, 0x1b, 0x90, 0xdb, 0x5e, 0x56, 0x41, 0xbd, 0xe9,
+	0x1a, 0x40, 0x75, 0x82, 0xc4, 0x06, 0x25, 0xea, 0x9b, 0x3e, 0x6a, 0x79, 0x1c, 0x24, 0xd5, 0x90,
+	0xaa, 0x7e, 0xfb, 0x1c, 0xaa, 0xcb, 0x4e, 0x05, 0x32, 0x2f, 0xbf, 0xf4, 0xe8, 0x30, 0x11, 0x79,
+	0x7c, 0x98, 0x40, 0xe6, 0x4f, 0x08, 0x5e, 0x6f, 0x41, 0xab, 0x3b, 0xbb, 0x09, 0x27, 0xb8, 0x0a,
+	0xc5, 0xd0, 0xd4, 0xc0, 0xec, 0xa9, 0x85, 0xf3, 0x9d, 0xbd, 0x12, 0xa9, 0xb3, 0xb6, 0x4b, 0x3d,
+	0xbf, 0xbf, 0xbe, 0x0c, 0xfe, 0xb0, 0x86, 0x22, 0x2a, 0x29, 0x66, 0xda, 0x52, 0xa8, 0x72, 0x82,
+	0x18, 0xe6, 0x81, 0x5f, 0xfc, 0x2a, 0xcd, 0x51, 0x47, 0xc6, 0x1a, 0xc7, 0x81, 0xad, 0xd6, 0x1a,
+	0xef, 0xaa, 0xb2, 0xe0, 0xdf, 0x55, 0xd3, 0x8b, 0x8d, 0x36, 0xbf, 0x58, 0xd5, 0xc2, 0x67, 0x87,
+	0x89, 0x88, 0xf9, 0x0d, 0x82, 0x78, 0xab, 0x2a, 0x74, 0x0f, 0xef, 0x05, 0x67, 0x47, 0xb9, 0x87,
+	0x93, 0x35, 0xb8, 0x3e, 0xe8, 0x2a, 0xcd, 0xac, 0x30, 0xd7, 0x5b, 0x5e, 0x2c, 0xf7, 0xeb, 0xfb,
+	0x3f, 0x12, 0x73, 0x8e, 0x2b, 0xb2, 0xa5, 0x9d, 0x64, 0x86, 0xe5, 0x2d, 0x3d, 0xd3, 0xd5, 0x9f,
+	0x73, 0xdc, 0xbe, 0x67, 0x89, 0xfd, 0x02, 0xe5, 0x7e, 0x0e, 0xaf, 0x8e, 0x93, 0x4f, 0xc1, 0xac,
+	0x2b, 0xe7, 0x0e, 0x13, 0x24, 0xd7, 0x47, 0x67, 0x02, 0xb0, 0x7f, 0x23, 0x98, 0x0e, 0x55, 0xd7,
+	0xc4, 0x5b, 0xf5, 0xc4, 0x17, 0x42, 0x5f, 0x4d, 0x55, 0x6d, 0xd5, 0x3f, 0x5b, 0x29, 0xd6, 0xcd,
+	0x4a, 0xec, 0xc0, 0x90, 0x28, 0x9f, 0x17, 0x8b, 0xbe, 0xa8, 0x3e, 0x2a, 0x7d, 0xf3, 0xae, 0x1e,
+	0xca, 0x95, 0x7a, 0x2a, 0x0f, 0xbb, 0xdf, 0x16, 0xde, 0x80, 0xa9, 0xd6, 0xca, 0xba, 0x7d, 0x71,
+	0x80, 0xca, 0x8b, 0x53, 0x1d, 0x3c, 0x99, 0x0a, 0x44, 0x02, 0x6a, 0x9f, 0xc3, 0x1b, 0xb5, 0x6a,
+	0xdb, 0xae, 0xc8, 0xda, 0x45, 0xb2, 0xa7, 0x0f, 0xee, 0xb3, 0xd8, 0xcf, 0xe0, 0x6c, 0x1b, 0x79,
+	0x5d, 0xf1, 0x5b, 0x30, 0xba, 0xa7, 0x97, 0xea, 0xe4, 0x47, 0xf6, 0x6a, 0x53, 0x02, 0xea, 0x13,
+	0xf0, 0x5a, 0x65, 0xaa, 0x97, 0x3c, 0x57, 0xec, 0x6f, 0x32, 0x96, 0xf3, 0x0d, 0xc8, 0x01, 0x02,
+	0xa3, 0xd9, 0xaa, 0x3e, 0x90, 0xc2, 0x60, 0x81, 0xb1, 0xdc, 0x8b, 0xfb, 0x42, 0x49, 0xf9, 0x85,
+	0x1f, 0xc7, 0x60, 0x48, 0x56, 0x81, 0x1f, 0x23, 0x18, 0x56, 0x76, 0x06, 0x5b, 0xa1, 0x8f, 0xb9,
+	0xd1, 0x4c, 0x19, 0xe7, 0x3b, 0x4f, 0x50, 0x78, 0xe6, 0xdc, 0x97, 0x3f, 0xff, 0xf5, 0x5d, 0xf4,
+	0x2c, 0x9e, 0xb6, 0xc2, 0xdc, 0x9c, 0x36, 0x54, 0x07, 0x51, 0x98, 0x08, 0x31, 0x1c, 0x78, 0xb5,
+	0xfd, 0xf1, 0xed, 0xbd, 0x95, 0xb1, 0xd6, 0xa7, 0x8a, 0x26, 0xdb, 0x96, 0x64, 0xb7, 0xf0, 0xcd,
+	0x50, 0xb2, 0xea, 0x63, 0xb7, 0x1e, 0x34, 0x4c, 0xe5, 0x87, 0x16, 0xab, 0xea, 0xa7, 0xfd, 0xd9,
+	0x70, 0x84, 0x60, 0xbc, 0x89, 0xe5, 0xc1, 0xef, 0x75, 0x51, 0x77, 0x83, 0xf5, 0x32, 0xae, 0xf6,
+	0x98, 0xad, 0x69, 0x37, 0x24, 0xed, 0x3a, 0xbe, 0xd6, 0x0f, 0x6d, 0xd5, 0x54, 0xe1, 0xdf, 0x10,
+	0x8c, 0xd4, 0xf9, 0x17, 0x7c, 0xb1, 0x7d, 0x89, 0xcd, 0x3d, 0x98, 0x71, 0xa9, 0x87, 0x4c, 0x0d,
+	0x76, 0x47, 0x82, 0x6d, 0xe0, 0x1b, 0xcf, 0x07, 0x2c, 0x2d, 0xdd, 0x17, 0xfe, 0x05, 0xc1, 0x68,
+	0xbd, 0x15, 0xc1, 0x97, 0xba, 0xb8, 0x82, 0x5a, 0xb3, 0x66, 0x5c, 0xee, 0x25, 0x55, 0x13, 0x5e,
+	0x97, 0x84, 0x6b, 0x78, 0xa5, 0x1f, 0x42, 0xdf, 0xf4, 0xfc, 0x8b, 0x60, 0xac, 0xc1, 0x20, 0xe0,
+	0x0e, 0xca, 0x6b, 0xe5, 0x6d, 0x8c, 0x2b, 0x3d, 0xe5, 0x6a, 0xb6, 0xb4, 0x64, 0xfb, 0x18, 0x6f,
+	0x87, 0xb2, 0x55, 0x7e, 0x18, 0xb8, 0xf5, 0xa0, 0xe1, 0xd7, 0xe3, 0xa1, 0xa5, 0xbf, 0x78, 0xcd,
+	0xb8, 0xf1, 0x33, 0x04, 0xaf, 0x36, 0xf7, 0x08, 0xf8, 0xfd, 0x6e, 0x0a, 0x6f, 0xe2, 0x5d, 0x8c,
+	0x0f, 0x7a, 0x17, 0xe8, 0xea, 0x6a, 0x3b, 0xc3, 0x97, 0x73, 0xa7, 0xc9, 0x8f, 0x79, 0x27, 0x73,
+	0xa7, 0xb5, 0xbb, 0x30, 0xae, 0xf6, 0x98, 0xdd, 0xd5, 0xdc, 0x69, 0x43, 0x58, 0x7d, 0xdb, 0xf8,
+	0x3f, 0x04, 0xb1, 0x56, 0x26, 0x00, 0x2f, 0x75, 0x51, 0x6b, 0x73, 0x7f, 0x62, 0x2c, 0xf7, 0x23,
+	0xd1, 0xd5, 0x48, 0x6a, 0xc3, 0x5c, 0xef, 0x62, 0xf0, 0x0f, 0x08, 0x4e, 0xd7, 0x58, 0x10, 0x7c,
+	0xa1, 0xb3, 0xa9, 0x59, 0xef, 0x68, 0x8c, 0x77, 0xbb, 0xce, 0xd3, 0x60, 0x8b, 0x12, 0xec, 0x1c,
+	0x9e, 0x0b, 0x05, 0xcb, 0xf8, 0xb9, 0xe9, 0xb2, 0x73, 0x59, 0xbe, 0xfe, 0xe4, 0x28, 0x8e, 0x9e,
+	0x1e, 0xc5, 0xd1, 0x9f, 0x47, 0x71, 0xf4, 0xed, 0x71, 0x3c, 0xf2, 0xf4, 0x38, 0x1e, 0xf9, 0xf5,
+	0x38, 0x1e, 0xf9, 0x64, 0x3e, 0xd4, 0x06, 0x7d, 0x51, 0xab, 0x2e, 0x5d, 0xd1, 0xce, 0xb0, 0xfc,
+	0x57, 0xd1, 0xe2, 0xff, 0x03, 0x00, 0x68, 0x1f, 0x83, 0x4a, 0x22, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -954,6 +1065,8 @@ type QueryClient interface {
 	ValidatorOutstandingRewards(ctx context.Context, in *QueryValidatorOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(ctx context.Context, in *QueryValidatorCommissionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionResponse, error)
+	// CommissionSplit queries the commission split of a validator.
+	CommissionSplit(ctx context.Context, in *QueryCommissionSplitRequest, opts ...grpc.CallOption) (*QueryCommissionSplitResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -1004,6 +1117,15 @@ func (c *queryClient) ValidatorCommission(ctx context.Context, in *QueryValidato
 	return out, nil
 }
 
+func (c *queryClient) CommissionSplit(ctx context.Context, in *QueryCommissionSplitRequest, opts ...grpc.CallOption) (*QueryCommissionSplitResponse, error) {
+	out := new(QueryCommissionSplitResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/CommissionSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ValidatorSlashes(ctx context.Context, in *QueryValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryValidatorSlashesResponse, error) {
 	out := new(QueryValidatorSlashesResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ValidatorSlashes", in, out, opts...)
@@ -1066,6 +1188,8 @@ type QueryServer interface {
 	ValidatorOutstandingRewards(context.Context, *QueryValidatorOutstandingRewardsRequest) (*QueryValidatorOutstandingRewardsResponse, error)
 	// ValidatorCommission queries accumulated commission for a validator.
 	ValidatorCommission(context.Context, *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error)
+	// CommissionSplit queries the commission split of a validator.
+	CommissionSplit(context.Context, *QueryCommissionSplitRequest) (*QueryCommissionSplitResponse, error)
 	// ValidatorSlashes queries slash events of a validator.
 	ValidatorSlashes(context.Context, *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error)
 	// DelegationRewards queries the total rewards accrued by a delegation.
@@ -1094,6 +1218,9 @@ func (*UnimplementedQueryServer) ValidatorOutstandingRewards(ctx context.Context
 func (*UnimplementedQueryServer) ValidatorCommission(ctx context.Context, req *QueryValidatorCommissionRequest) (*QueryValidatorCommissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommission not implemented")
 }
+func (*UnimplementedQueryServer) CommissionSplit(ctx context.Context, req *QueryCommissionSplitRequest) (*QueryCommissionSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommissionSplit not implemented")
+}
 func (*UnimplementedQueryServer) ValidatorSlashes(ctx context.Context, req *QueryValidatorSlashesRequest) (*QueryValidatorSlashesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashes not implemented")
 }
@@ -1171,6 +1298,24 @@ func _Query_ValidatorCommission_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CommissionSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommissionSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommissionSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/CommissionSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommissionSplit(ctx, req.(*QueryCommissionSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryValidatorSlashesRequest)
 	if err := dec(in); err != nil {
@@ -1295,6 +1440,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidatorCommission",
 			Handler:    _Query_ValidatorCommission_Handler,
 		},
+		{
+			MethodName: "CommissionSplit",
+			Handler:    _Query_CommissionSplit_Handler,
+		},
 		{
 			MethodName: "ValidatorSlashes",
 			Handler:    _Query_ValidatorSlashes_Handler,
@@ -1367,6 +1516,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastChangedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1506,6 +1660,69 @@ func (m *QueryValidatorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCommissionSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommissionSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommissionSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommissionSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Split.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1972,6 +2189,9 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangedHeight))
+	}
 	return n
 }
 
@@ -2023,6 +2243,30 @@ func (m *QueryValidatorCommissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCommissionSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommissionSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Split.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryValidatorSlashesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2327,6 +2571,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedHeight", wireType)
+			}
+			m.LastChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2678,6 +2941,171 @@ func (m *QueryValidatorCommissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCommissionSplitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionSplitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommissionSplitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommissionSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommissionSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // create a new ValidatorHistoricalRewards
@@ -46,3 +47,69 @@ func (vs ValidatorSlashEvents) String() string {
 	}
 	return strings.TrimSpace(out)
 }
+
+// MaxCommissionSplitRecipients is the maximum number of recipients of a
+// commission split.
+const MaxCommissionSplitRecipients = 16
+
+// NewCommissionSplitRecipient creates a new CommissionSplitRecipient.
+func NewCommissionSplitRecipient(addr sdk.AccAddress, weight sdk.Dec) CommissionSplitRecipient {
+	return CommissionSplitRecipient{
+		Address: addr.String(),
+		Weight:  weight,
+	}
+}
+
+// Validate checks that the split has between 1 and MaxCommissionSplitRecipients
+// distinct recipients, whose positive weights sum up to 1.
+func (cs CommissionSplit) Validate() error {
+	if len(cs.Recipients) == 0 {
+		return sdkerrors.Wrap(ErrInvalidCommissionSplit, "no recipients")
+	}
+	if len(cs.Recipients) > MaxCommissionSplitRecipients {
+		return sdkerrors.Wrapf(ErrInvalidCommissionSplit, "%d recipients, max %d", len(cs.Recipients), MaxCommissionSplitRecipients)
+	}
+
+	total := sdk.ZeroDec()
+	seen := make(map[string]bool, len(cs.Recipients))
+	for _, r := range cs.Recipients {
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address %s: %s", r.Address, err)
+		}
+		if seen[r.Address] {
+			return sdkerrors.Wrapf(ErrInvalidCommissionSplit, "duplicate recipient %s", r.Address)
+		}
+		seen[r.Address] = true
+
+		if r.Weight.IsNil() || !r.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidCommissionSplit, "weight of recipient %s must be positive", r.Address)
+		}
+		total = total.Add(r.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidCommissionSplit, "weights sum up to %s, not 1", total)
+	}
+
+	return nil
+}
+
+// Split splits coins between the recipients by weight, returning the amount of
+// each recipient. The last recipient receives the amounts left over by the
+// truncation of the others.
+func (cs CommissionSplit) Split(coins sdk.Coins) []sdk.Coins {
+	amounts := make([]sdk.Coins, len(cs.Recipients))
+	remaining := coins
+	for i, r := range cs.Recipients {
+		if i == len(cs.Recipients)-1 {
+			amounts[i] = remaining
+			break
+		}
+
+		amount, _ := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(r.Weight).TruncateDecimal()
+		amounts[i] = amount
+		remaining = remaining.Sub(amount)
+	}
+
+	return amounts
+}
@@ -0,0 +1,54 @@
+package epochs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// BeginBlocker starts the epochs whose start time was reached, ending the
+// current ones first. At most one epoch of each identifier starts per block,
+// the epochs catching up over the next blocks after a halt.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	k.IterateEpochInfo(ctx, func(epoch types.EpochInfo) bool {
+		if ctx.BlockTime().Before(epoch.NextEpochStartTime()) {
+			return false
+		}
+
+		if epoch.EpochCountingStarted {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochEnd,
+					sdk.NewAttribute(types.AttributeKeyIdentifier, epoch.Identifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epoch.CurrentEpoch)),
+				),
+			)
+			k.AfterEpochEnd(ctx, epoch.Identifier, epoch.CurrentEpoch)
+		}
+
+		epoch.CurrentEpochStartTime = epoch.NextEpochStartTime()
+		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		epoch.CurrentEpoch++
+		epoch.EpochCountingStarted = true
+		k.SetEpochInfo(ctx, epoch)
+
+		k.Logger(ctx).Info("starting epoch", "identifier", epoch.Identifier, "number", epoch.CurrentEpoch)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochStart,
+				sdk.NewAttribute(types.AttributeKeyIdentifier, epoch.Identifier),
+				sdk.NewAttribute(types.AttributeKeyEpochNumber, fmt.Sprint(epoch.CurrentEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEpochStartTime, epoch.CurrentEpochStartTime.Format(time.RFC3339Nano)),
+			),
+		)
+		k.BeforeEpochStart(ctx, epoch.Identifier, epoch.CurrentEpoch)
+
+		return false
+	})
+}
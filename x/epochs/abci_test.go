@@ -0,0 +1,129 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// hookCall records a call of the epoch hooks
+type hookCall struct {
+	hook        string
+	identifier  string
+	epochNumber int64
+}
+
+type recordingHooks struct {
+	calls *[]hookCall
+}
+
+func (h recordingHooks) AfterEpochEnd(_ sdk.Context, identifier string, epochNumber int64) {
+	*h.calls = append(*h.calls, hookCall{"AfterEpochEnd", identifier, epochNumber})
+}
+
+func (h recordingHooks) BeforeEpochStart(_ sdk.Context, identifier string, epochNumber int64) {
+	*h.calls = append(*h.calls, hookCall{"BeforeEpochStart", identifier, epochNumber})
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *[]hookCall) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	k := keeper.NewKeeper(simapp.MakeTestEncodingConfig().Marshaler, key)
+
+	calls := &[]hookCall{}
+	k.SetHooks(recordingHooks{calls})
+
+	return ctx, k, calls
+}
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, k, calls := setupKeeper(t)
+
+	genesis := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: genesis})
+	epochs.InitGenesis(ctx, k, types.NewGenesisState([]types.EpochInfo{
+		types.NewGenesisEpochInfo("hour", time.Hour),
+		{Identifier: "later", StartTime: genesis.Add(24 * time.Hour), Duration: time.Hour},
+	}))
+
+	// the first epoch starts at genesis, the later one not yet
+	epochs.BeginBlocker(ctx, k)
+	require.Equal(t, []hookCall{{"BeforeEpochStart", "hour", 1}}, *calls)
+
+	hour, found := k.GetEpochInfo(ctx, "hour")
+	require.True(t, found)
+	require.Equal(t, types.EpochInfo{
+		Identifier:              "hour",
+		StartTime:               genesis,
+		Duration:                time.Hour,
+		CurrentEpoch:            1,
+		CurrentEpochStartTime:   genesis,
+		EpochCountingStarted:    true,
+		CurrentEpochStartHeight: 1,
+	}, hour)
+
+	later, found := k.GetEpochInfo(ctx, "later")
+	require.True(t, found)
+	require.False(t, later.EpochCountingStarted)
+	require.Equal(t, genesis.Add(24*time.Hour), later.NextEpochStartTime())
+
+	// nothing happens before the end of the epoch
+	*calls = nil
+	epochs.BeginBlocker(ctx.WithBlockHeader(tmproto.Header{Height: 2, Time: genesis.Add(59 * time.Minute)}), k)
+	require.Empty(t, *calls)
+
+	// the epoch ends, and the next one starts when the current one ends even
+	// if the block comes later
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 3, Time: genesis.Add(61 * time.Minute)})
+	epochs.BeginBlocker(ctx, k)
+	require.Equal(t, []hookCall{{"AfterEpochEnd", "hour", 1}, {"BeforeEpochStart", "hour", 2}}, *calls)
+
+	hour, _ = k.GetEpochInfo(ctx, "hour")
+	require.Equal(t, int64(2), hour.CurrentEpoch)
+	require.Equal(t, genesis.Add(time.Hour), hour.CurrentEpochStartTime)
+	require.Equal(t, int64(3), hour.CurrentEpochStartHeight)
+
+	// the epochs catch up one per block after a halt
+	*calls = nil
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 4, Time: genesis.Add(5 * time.Hour)})
+	epochs.BeginBlocker(ctx, k)
+	epochs.BeginBlocker(ctx, k)
+	require.Equal(t, []hookCall{
+		{"AfterEpochEnd", "hour", 2}, {"BeforeEpochStart", "hour", 3},
+		{"AfterEpochEnd", "hour", 3}, {"BeforeEpochStart", "hour", 4},
+	}, *calls)
+}
+
+func TestMintAtEpochEnd(t *testing.T) {
+	app := simapp.Setup(false)
+	genesis := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: genesis})
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.EpochIdentifier = "day"
+	app.MintKeeper.SetParams(ctx, params)
+	app.EpochsKeeper.SetEpochInfo(ctx, types.EpochInfo{Identifier: "day", StartTime: genesis, Duration: 24 * time.Hour})
+
+	// the first epoch starts without minting
+	epochs.BeginBlocker(ctx, app.EpochsKeeper)
+	require.Empty(t, app.MintKeeper.GetLastDistribution(ctx).Distributions)
+
+	next, err := app.MintKeeper.NextMint(sdk.WrapSDKContext(ctx), &minttypes.QueryNextMintRequest{})
+	require.NoError(t, err)
+	require.Equal(t, genesis.Add(24*time.Hour), *next.Time)
+
+	// the coins are minted at the end of the epoch
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 2, Time: genesis.Add(24 * time.Hour)})
+	epochs.BeginBlocker(ctx, app.EpochsKeeper)
+	require.Equal(t, int64(2), app.MintKeeper.GetLastDistribution(ctx).Height)
+}
@@ -0,0 +1,86 @@
+package testutil
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/x/epochs/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+}
+
+func NewIntegrationTestSuite(cfg network.Config) *IntegrationTestSuite {
+	return &IntegrationTestSuite{cfg: cfg}
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	s.network = network.New(s.T(), s.cfg)
+
+	_, err := s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryEpochInfos() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryEpochInfos(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res types.QueryEpochInfosResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	s.Require().Len(res.Epochs, len(types.DefaultGenesisState().Epochs))
+	for _, epoch := range res.Epochs {
+		s.Require().True(epoch.EpochCountingStarted, epoch.Identifier)
+		s.Require().Equal(int64(1), epoch.CurrentEpoch, epoch.Identifier)
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryCurrentEpoch() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"known identifier", []string{"week", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, false},
+		{"unknown identifier", []string{"fortnight", fmt.Sprintf("--%s=json", tmcli.OutputFlag)}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryCurrentEpoch(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res types.QueryCurrentEpochResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Equal(int64(1), res.CurrentEpoch)
+			s.Require().False(res.NextEpochStartTime.IsZero())
+		})
+	}
+}
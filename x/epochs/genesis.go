@@ -0,0 +1,33 @@
+package epochs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// InitGenesis initializes the epochs of the genesis state, the epochs without
+// a start time starting at genesis.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
+	for _, epoch := range data.Epochs {
+		if _, found := k.GetEpochInfo(ctx, epoch.Identifier); found {
+			panic(fmt.Sprintf("duplicate epoch identifier: %s", epoch.Identifier))
+		}
+
+		if epoch.StartTime.IsZero() {
+			epoch.StartTime = ctx.BlockTime()
+		}
+		if !epoch.EpochCountingStarted {
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		}
+
+		k.SetEpochInfo(ctx, epoch)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.AllEpochInfos(ctx))
+}
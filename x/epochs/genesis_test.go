@@ -0,0 +1,43 @@
+package epochs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestInitExportGenesis(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 1, Time: now})
+
+	started := types.EpochInfo{
+		Identifier:              "day",
+		StartTime:               now.Add(-48 * time.Hour),
+		Duration:                24 * time.Hour,
+		CurrentEpoch:            2,
+		CurrentEpochStartTime:   now.Add(-24 * time.Hour),
+		EpochCountingStarted:    true,
+		CurrentEpochStartHeight: 100,
+	}
+	epochs.InitGenesis(ctx, k, types.NewGenesisState([]types.EpochInfo{
+		started,
+		types.NewGenesisEpochInfo("hour", time.Hour),
+	}))
+
+	// the epochs without a start time start at genesis
+	require.Equal(t, types.NewGenesisState([]types.EpochInfo{
+		started,
+		{Identifier: "hour", StartTime: now, Duration: time.Hour, CurrentEpochStartHeight: 1},
+	}), epochs.ExportGenesis(ctx, k))
+
+	require.Panics(t, func() {
+		epochs.InitGenesis(ctx, k, types.NewGenesisState([]types.EpochInfo{started}))
+	})
+}
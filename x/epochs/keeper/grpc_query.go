@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// EpochInfos returns all the series of epochs.
+func (k Keeper) EpochInfos(c context.Context, _ *types.QueryEpochInfosRequest) (*types.QueryEpochInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryEpochInfosResponse{Epochs: k.AllEpochInfos(ctx)}, nil
+}
+
+// CurrentEpoch returns the current epoch of an identifier and the time at
+// which the next one starts.
+func (k Keeper) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if strings.TrimSpace(req.Identifier) == "" {
+		return nil, status.Error(codes.InvalidArgument, "identifier cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	epoch, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch %s not found", req.Identifier)
+	}
+
+	return &types.QueryCurrentEpochResponse{
+		CurrentEpoch:       epoch.CurrentEpoch,
+		NextEpochStartTime: epoch.NextEpochStartTime(),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Keeper of the epochs store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+	hooks    types.EpochHooks
+}
+
+// NewKeeper creates a new epochs Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: key,
+	}
+}
+
+// SetHooks sets the epoch hooks
+func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set epoch hooks twice")
+	}
+
+	k.hooks = eh
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetEpochInfo returns the epoch info of the identifier.
+func (k Keeper) GetEpochInfo(ctx sdk.Context, identifier string) (types.EpochInfo, bool) {
+	var epoch types.EpochInfo
+	bz := ctx.KVStore(k.storeKey).Get(types.EpochInfoKey(identifier))
+	if bz == nil {
+		return epoch, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch, true
+}
+
+// SetEpochInfo sets the epoch info of its identifier.
+func (k Keeper) SetEpochInfo(ctx sdk.Context, epoch types.EpochInfo) {
+	ctx.KVStore(k.storeKey).Set(types.EpochInfoKey(epoch.Identifier), k.cdc.MustMarshal(&epoch))
+}
+
+// DeleteEpochInfo deletes the epoch info of the identifier.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) {
+	ctx.KVStore(k.storeKey).Delete(types.EpochInfoKey(identifier))
+}
+
+// IterateEpochInfo iterates over the epoch infos, in the order of their
+// identifiers, until cb returns true.
+func (k Keeper) IterateEpochInfo(ctx sdk.Context, cb func(epoch types.EpochInfo) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochInfoKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &epoch)
+
+		if cb(epoch) {
+			break
+		}
+	}
+}
+
+// AllEpochInfos returns all the epoch infos.
+func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
+	var epochs []types.EpochInfo
+	k.IterateEpochInfo(ctx, func(epoch types.EpochInfo) bool {
+		epochs = append(epochs, epoch)
+		return false
+	})
+
+	return epochs
+}
+
+// NextEpochTime returns the time at which the current epoch of the identifier
+// ends, or at which the first one starts if it did not start yet.
+func (k Keeper) NextEpochTime(ctx sdk.Context, identifier string) (time.Time, bool) {
+	epoch, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return time.Time{}, false
+	}
+
+	return epoch.NextEpochStartTime(), true
+}
+
+// AfterEpochEnd calls the AfterEpochEnd hook if it is registered.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart calls the BeforeEpochStart hook if it is registered.
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
+	if k.hooks != nil {
+		k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+	}
+}
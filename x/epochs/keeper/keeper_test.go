@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochsKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestEpochInfo() {
+	app, ctx := suite.app, suite.ctx
+
+	// the default epochs are initialized at genesis
+	suite.Require().Len(app.EpochsKeeper.AllEpochInfos(ctx), len(types.DefaultGenesisState().Epochs))
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch := types.EpochInfo{
+		Identifier:            "minute",
+		StartTime:             start,
+		Duration:              time.Minute,
+		CurrentEpoch:          3,
+		CurrentEpochStartTime: start.Add(2 * time.Minute),
+		EpochCountingStarted:  true,
+	}
+	app.EpochsKeeper.SetEpochInfo(ctx, epoch)
+
+	stored, found := app.EpochsKeeper.GetEpochInfo(ctx, "minute")
+	suite.Require().True(found)
+	suite.Require().Equal(epoch, stored)
+
+	next, found := app.EpochsKeeper.NextEpochTime(ctx, "minute")
+	suite.Require().True(found)
+	suite.Require().Equal(start.Add(3*time.Minute), next)
+
+	app.EpochsKeeper.DeleteEpochInfo(ctx, "minute")
+	_, found = app.EpochsKeeper.GetEpochInfo(ctx, "minute")
+	suite.Require().False(found)
+	_, found = app.EpochsKeeper.NextEpochTime(ctx, "minute")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	infos, err := queryClient.EpochInfos(gocontext.Background(), &types.QueryEpochInfosRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.EpochsKeeper.AllEpochInfos(ctx), infos.Epochs)
+
+	week, _ := app.EpochsKeeper.GetEpochInfo(ctx, "week")
+	current, err := queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{Identifier: "week"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(week.CurrentEpoch, current.CurrentEpoch)
+	suite.Require().Equal(week.NextEpochStartTime(), current.NextEpochStartTime)
+
+	_, err = queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{Identifier: "unknown"})
+	suite.Require().Error(err)
+	_, err = queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{})
+	suite.Require().Error(err)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
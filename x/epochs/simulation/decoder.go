@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding epochs type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.EpochInfoKeyPrefix):
+			var epochA, epochB types.EpochInfo
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+		default:
+			panic(fmt.Sprintf("invalid epochs key %X", kvA.Key))
+		}
+	}
+}
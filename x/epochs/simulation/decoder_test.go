@@ -0,0 +1,31 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epochs/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	epoch := types.NewGenesisEpochInfo("hour", time.Hour)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.EpochInfoKey(epoch.Identifier), Value: cdc.MustMarshal(&epoch)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	require.Equal(t, fmt.Sprintf("%v\n%v", epoch, epoch), dec(kvPairs.Pairs[0], kvPairs.Pairs[0]))
+	require.Panics(t, func() { dec(kvPairs.Pairs[1], kvPairs.Pairs[1]) })
+}
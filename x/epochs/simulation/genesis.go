@@ -0,0 +1,45 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	Epochs = "epochs"
+)
+
+// GenEpochs randomized Epochs, lasting from a minute to a day
+func GenEpochs(r *rand.Rand) []types.EpochInfo {
+	return []types.EpochInfo{
+		types.NewGenesisEpochInfo("day", 24*time.Hour),
+		types.NewGenesisEpochInfo("hour", time.Hour),
+		types.NewGenesisEpochInfo("random", time.Duration(1+r.Intn(24*60))*time.Minute),
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for epochs
+func RandomizedGenState(simState *module.SimulationState) {
+	var epochs []types.EpochInfo
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Epochs, &epochs, simState.Rand,
+		func(r *rand.Rand) { epochs = GenEpochs(r) },
+	)
+
+	epochsGenesis := types.NewGenesisState(epochs)
+
+	bz, err := json.MarshalIndent(&epochsGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epochs:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(epochsGenesis)
+}
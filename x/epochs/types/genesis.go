@@ -0,0 +1,79 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(epochs []EpochInfo) *GenesisState {
+	return &GenesisState{Epochs: epochs}
+}
+
+// DefaultGenesisState returns the default genesis state, with hourly, daily
+// and weekly epochs starting at genesis.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]EpochInfo{
+		NewGenesisEpochInfo("day", 24*time.Hour),
+		NewGenesisEpochInfo("hour", time.Hour),
+		NewGenesisEpochInfo("week", 7*24*time.Hour),
+	})
+}
+
+// NewGenesisEpochInfo returns the epoch info of epochs of the given duration
+// starting at genesis.
+func NewGenesisEpochInfo(identifier string, duration time.Duration) EpochInfo {
+	return EpochInfo{
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Validate performs a basic validation of the genesis state: the identifiers
+// of the epochs must be unique.
+func (gs GenesisState) Validate() error {
+	identifiers := make(map[string]bool, len(gs.Epochs))
+	for _, epoch := range gs.Epochs {
+		if identifiers[epoch.Identifier] {
+			return fmt.Errorf("duplicate epoch identifier: %s", epoch.Identifier)
+		}
+		identifiers[epoch.Identifier] = true
+
+		if err := epoch.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the epoch info.
+func (epoch EpochInfo) Validate() error {
+	if strings.TrimSpace(epoch.Identifier) == "" {
+		return errors.New("epoch identifier cannot be blank")
+	}
+	if epoch.Duration <= 0 {
+		return fmt.Errorf("duration of epoch %s must be positive: %s", epoch.Identifier, epoch.Duration)
+	}
+	if epoch.CurrentEpoch < 0 {
+		return fmt.Errorf("current epoch of %s cannot be negative: %d", epoch.Identifier, epoch.CurrentEpoch)
+	}
+	if epoch.CurrentEpochStartHeight < 0 {
+		return fmt.Errorf("current epoch start height of %s cannot be negative: %d", epoch.Identifier, epoch.CurrentEpochStartHeight)
+	}
+
+	return nil
+}
+
+// NextEpochStartTime returns the time at which the next epoch starts, i.e. at
+// which the current one ends, or at which the first one starts if it did not
+// start yet.
+func (epoch EpochInfo) NextEpochStartTime() time.Time {
+	if !epoch.EpochCountingStarted {
+		return epoch.StartTime
+	}
+
+	return epoch.CurrentEpochStartTime.Add(epoch.Duration)
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/epochs/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+	require.NoError(t, types.NewGenesisState(nil).Validate())
+
+	tests := []struct {
+		name  string
+		epoch types.EpochInfo
+	}{
+		{"blank identifier", types.NewGenesisEpochInfo(" ", time.Hour)},
+		{"zero duration", types.NewGenesisEpochInfo("hour", 0)},
+		{"negative current epoch", types.EpochInfo{Identifier: "hour", Duration: time.Hour, CurrentEpoch: -1}},
+		{"negative start height", types.EpochInfo{Identifier: "hour", Duration: time.Hour, CurrentEpochStartHeight: -1}},
+	}
+	for _, tc := range tests {
+		require.Error(t, types.NewGenesisState([]types.EpochInfo{tc.epoch}).Validate(), tc.name)
+	}
+
+	duplicate := types.NewGenesisState([]types.EpochInfo{
+		types.NewGenesisEpochInfo("hour", time.Hour),
+		types.NewGenesisEpochInfo("hour", 2*time.Hour),
+	})
+	require.Error(t, duplicate.Validate())
+}
+
+func TestNextEpochStartTime(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch := types.EpochInfo{Identifier: "hour", StartTime: start, Duration: time.Hour}
+	require.Equal(t, start, epoch.NextEpochStartTime())
+
+	epoch.EpochCountingStarted = true
+	epoch.CurrentEpochStartTime = start.Add(2 * time.Hour)
+	require.Equal(t, start.Add(3*time.Hour), epoch.NextEpochStartTime())
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks are the event hooks of the epochs module, called in BeginBlock.
+type EpochHooks interface {
+	// AfterEpochEnd is called when an epoch ends, before the next one starts,
+	// with the number of the epoch ending.
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	// BeforeEpochStart is called when an epoch starts, with the number of the
+	// epoch starting.
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+}
+
+var _ EpochHooks = MultiEpochHooks{}
+
+// MultiEpochHooks combines multiple epoch hooks, all of them being called in
+// order.
+type MultiEpochHooks []EpochHooks
+
+// NewMultiEpochHooks returns the combination of the hooks.
+func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
+	return hooks
+}
+
+// AfterEpochEnd calls AfterEpochEnd of all the hooks.
+func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range h {
+		h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+	}
+}
+
+// BeforeEpochStart calls BeforeEpochStart of all the hooks.
+func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	for i := range h {
+		h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+	}
+}
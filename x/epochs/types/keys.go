@@ -0,0 +1,33 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/types/keyformat"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "epochs"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+// EpochInfoKeyPrefix is the prefix of the keys of the epoch infos, followed by
+// their identifiers.
+var EpochInfoKeyPrefix = []byte{0x01}
+
+// EpochInfoKey returns the key of the epoch info of the identifier.
+func EpochInfoKey(identifier string) []byte {
+	return append(EpochInfoKeyPrefix, []byte(identifier)...)
+}
+
+// KeyFormats are the layouts of the keys of the epochs store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "epoch_info",
+		Prefix:   EpochInfoKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.String("identifier")},
+		Value:    keyformat.ProtoValue(&EpochInfo{}),
+	},
+}
@@ -27,8 +27,9 @@ func GetQueryCmd() *cobra.Command {
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
 $ %s query %s --page=2 --limit=50
+$ %s query %s by-type /cosmos.evidence.v1beta1.Equivocation
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
@@ -40,6 +41,54 @@ $ %s query %s --page=2 --limit=50
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(GetCmdQueryEvidenceByType())
+
+	return cmd
+}
+
+// GetCmdQueryEvidenceByType implements a command to return the (paginated)
+// evidence of a type URL.
+func GetCmdQueryEvidenceByType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-type [type-url]",
+		Short: "Query for all (paginated) submitted evidence of a type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all (paginated) submitted evidence of a type URL:
+
+Example:
+$ %s query %s by-type /cosmos.evidence.v1beta1.Equivocation --page=2 --limit=50
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EvidenceByType(cmd.Context(), &types.QueryEvidenceByTypeRequest{
+				EvidenceType: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "evidence by type")
+
 	return cmd
 }
 
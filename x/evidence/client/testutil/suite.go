@@ -53,6 +53,11 @@ func (s *IntegrationTestSuite) TestGetQueryCmd() {
 			"evidence: []\npagination:\n  next_key: null\n  total: \"0\"",
 			false,
 		},
+		"evidence by type (default pagination)": {
+			[]string{"by-type", "/cosmos.evidence.v1beta1.Equivocation"},
+			"evidence: []\npagination:\n  next_key: null\n  total: \"0\"",
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
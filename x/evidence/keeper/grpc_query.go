@@ -86,3 +86,39 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// EvidenceByType implements the Query/EvidenceByType gRPC method
+func (k Keeper) EvidenceByType(c context.Context, req *types.QueryEvidenceByTypeRequest) (*types.QueryEvidenceByTypeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.EvidenceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty evidence type")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var evidence []*codectypes.Any
+	store := ctx.KVStore(k.storeKey)
+	evidenceStore := prefix.NewStore(store, types.EvidenceByTypePrefix(req.EvidenceType))
+
+	pageRes, err := query.Paginate(evidenceStore, req.Pagination, func(key []byte, _ []byte) error {
+		result, ok := k.GetEvidence(ctx, key)
+		if !ok {
+			return status.Errorf(codes.Internal, "evidence %X indexed by type %s not found", key, req.EvidenceType)
+		}
+
+		evidenceAny, err := codectypes.NewAnyWithValue(result)
+		if err != nil {
+			return err
+		}
+		evidence = append(evidence, evidenceAny)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEvidenceByTypeResponse{Evidence: evidence, Pagination: pageRes}, nil
+}
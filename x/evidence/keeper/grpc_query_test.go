@@ -141,3 +141,78 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryEvidenceByType() {
+	var (
+		req      *types.QueryEvidenceByTypeRequest
+		evidence []exported.Evidence
+	)
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expPass   bool
+		posttests func(res *types.QueryEvidenceByTypeResponse)
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryEvidenceByTypeRequest{}
+			},
+			false,
+			func(res *types.QueryEvidenceByTypeResponse) {},
+		},
+		{
+			"success without evidence of the type",
+			func() {
+				_ = suite.populateEvidence(suite.ctx, 10)
+				req = &types.QueryEvidenceByTypeRequest{EvidenceType: "/cosmos.evidence.v1beta1.Unknown"}
+			},
+			true,
+			func(res *types.QueryEvidenceByTypeResponse) {
+				suite.Require().Empty(res.Evidence)
+			},
+		},
+		{
+			"success",
+			func() {
+				evidence = suite.populateEvidence(suite.ctx, 100)
+				req = &types.QueryEvidenceByTypeRequest{
+					EvidenceType: types.EvidenceTypeURL(&types.Equivocation{}),
+					Pagination:   &query.PageRequest{Limit: 50, CountTotal: true},
+				}
+			},
+			true,
+			func(res *types.QueryEvidenceByTypeResponse) {
+				suite.Require().Len(res.Evidence, 50)
+				suite.Require().NotNil(res.Pagination.NextKey)
+				suite.Require().Equal(uint64(len(evidence)), res.Pagination.Total)
+
+				var evi exported.Evidence
+				suite.Require().NoError(suite.app.InterfaceRegistry().UnpackAny(res.Evidence[0], &evi))
+				suite.Require().Contains(evidence, evi)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.EvidenceByType(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+
+			tc.posttests(res)
+		})
+	}
+}
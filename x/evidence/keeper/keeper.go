@@ -83,6 +83,12 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 		return sdkerrors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
 
+	if validator := k.router.GetValidator(evidence.Route()); validator != nil {
+		if err := validator(ctx, evidence); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidEvidence, err.Error())
+		}
+	}
+
 	handler := k.router.GetRoute(evidence.Route())
 	if err := handler(ctx, evidence); err != nil {
 		return sdkerrors.Wrap(types.ErrInvalidEvidence, err.Error())
@@ -99,10 +105,17 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	return nil
 }
 
-// SetEvidence sets Evidence by hash in the module's KVStore.
+// SetEvidence sets Evidence by hash in the module's KVStore, and indexes it by
+// type.
 func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEvidence)
 	store.Set(evidence.Hash(), k.MustMarshalEvidence(evidence))
+
+	k.setEvidenceByType(ctx, evidence)
+}
+
+func (k Keeper) setEvidenceByType(ctx sdk.Context, evidence exported.Evidence) {
+	ctx.KVStore(k.storeKey).Set(types.EvidenceByTypeKey(types.EvidenceTypeURL(evidence), evidence.Hash()), []byte{})
 }
 
 // GetEvidence retrieves Evidence by hash if it exists. If no Evidence exists for
@@ -135,6 +148,26 @@ func (k Keeper) IterateEvidence(ctx sdk.Context, cb func(exported.Evidence) bool
 	}
 }
 
+// IterateEvidenceByType iterates over the stored Evidence of a type URL, e.g.
+// "/cosmos.evidence.v1beta1.Equivocation". For each Evidence object, cb will be
+// called. If the cb returns true, the iterator will close and stop.
+func (k Keeper) IterateEvidenceByType(ctx sdk.Context, typeURL string, cb func(exported.Evidence) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EvidenceByTypePrefix(typeURL))
+	iterator := sdk.KVStorePrefixIterator(store, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		evidence, ok := k.GetEvidence(ctx, iterator.Key())
+		if !ok {
+			panic(fmt.Sprintf("evidence %X indexed by type %s not found", iterator.Key(), typeURL))
+		}
+
+		if cb(evidence) {
+			break
+		}
+	}
+}
+
 // GetAllEvidence returns all stored Evidence objects.
 func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []exported.Evidence) {
 	k.IterateEvidence(ctx, func(e exported.Evidence) bool {
@@ -211,3 +211,74 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+func (suite *KeeperTestSuite) TestSubmitEvidenceValidator() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	evidenceKeeper := keeper.NewKeeper(
+		suite.app.AppCodec(), suite.app.GetKey(types.StoreKey), suite.app.StakingKeeper, suite.app.SlashingKeeper,
+	)
+	router := types.NewRouter()
+	router = router.AddRouteWithValidator(
+		types.RouteEquivocation, testEquivocationHandler(*evidenceKeeper),
+		func(ctx sdk.Context, e exported.Evidence) error {
+			if e.GetHeight() > ctx.BlockHeight() {
+				return fmt.Errorf("evidence height %d is in the future", e.GetHeight())
+			}
+			return nil
+		},
+	)
+	evidenceKeeper.SetRouter(router)
+
+	pk := ed25519.GenPrivKey()
+	e := &types.Equivocation{
+		Height:           3,
+		Power:            100,
+		Time:             time.Now().UTC(),
+		ConsensusAddress: sdk.ConsAddress(pk.PubKey().Address().Bytes()).String(),
+	}
+
+	err := evidenceKeeper.SubmitEvidence(ctx.WithBlockHeight(2), e)
+	suite.ErrorIs(err, types.ErrInvalidEvidence)
+	_, ok := evidenceKeeper.GetEvidence(ctx, e.Hash())
+	suite.False(ok)
+
+	suite.NoError(evidenceKeeper.SubmitEvidence(ctx.WithBlockHeight(3), e))
+	_, ok = evidenceKeeper.GetEvidence(ctx, e.Hash())
+	suite.True(ok)
+}
+
+func (suite *KeeperTestSuite) TestIterateEvidenceByType() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	numEvidence := 10
+	evidence := suite.populateEvidence(ctx, numEvidence)
+
+	var byType []exported.Evidence
+	suite.app.EvidenceKeeper.IterateEvidenceByType(ctx, types.EvidenceTypeURL(&types.Equivocation{}), func(e exported.Evidence) bool {
+		byType = append(byType, e)
+		return false
+	})
+	suite.ElementsMatch(evidence, byType)
+
+	suite.app.EvidenceKeeper.IterateEvidenceByType(ctx, "/cosmos.evidence.v1beta1.Unknown", func(e exported.Evidence) bool {
+		suite.Fail("unexpected evidence", e)
+		return false
+	})
+}
+
+func (suite *KeeperTestSuite) TestMigrate1to2() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	evidence := suite.populateEvidence(ctx, 10)
+
+	// remove the index, which did not exist in version 1
+	store := ctx.KVStore(suite.app.GetKey(types.StoreKey))
+	for _, e := range evidence {
+		store.Delete(types.EvidenceByTypeKey(types.EvidenceTypeURL(e), e.Hash()))
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(suite.app.EvidenceKeeper).Migrate1to2(ctx))
+
+	for _, e := range evidence {
+		suite.True(store.Has(types.EvidenceByTypeKey(types.EvidenceTypeURL(e), e.Hash())))
+	}
+}
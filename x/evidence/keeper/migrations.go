@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The migration indexes the stored
+// evidence by type.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.IterateEvidence(ctx, func(evidence exported.Evidence) bool {
+		m.keeper.setEvidenceByType(ctx, evidence)
+		return false
+	})
+
+	return nil
+}
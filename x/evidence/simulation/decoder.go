@@ -30,6 +30,8 @@ func NewDecodeStore(cdc EvidenceUnmarshaler) func(kvA, kvB kv.Pair) string {
 			}
 
 			return fmt.Sprintf("%v\n%v", evidenceA, evidenceB)
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEvidenceByType):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -37,6 +37,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.KeyPrefixEvidence,
 				Value: evBz,
 			},
+			{
+				Key:   types.EvidenceByTypeKey(types.EvidenceTypeURL(ev), ev.Hash()),
+				Value: []byte{},
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -48,6 +52,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Evidence", fmt.Sprintf("%v\n%v", ev, ev)},
+		{"EvidenceByType", fmt.Sprintf("%X\n%X", types.EvidenceByTypeKey(types.EvidenceTypeURL(ev), ev.Hash()), types.EvidenceByTypeKey(types.EvidenceTypeURL(ev), ev.Hash()))},
 		{"other", ""},
 	}
 
@@ -1,5 +1,12 @@
 package types
 
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -16,5 +23,24 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixEvidence       = []byte{0x00}
+	KeyPrefixEvidenceByType = []byte{0x01}
 )
+
+// EvidenceTypeURL returns the type URL of the evidence, e.g.
+// "/cosmos.evidence.v1beta1.Equivocation", which the evidence is indexed by.
+func EvidenceTypeURL(evidence exported.Evidence) string {
+	return "/" + proto.MessageName(evidence)
+}
+
+// EvidenceByTypePrefix returns the prefix of the index of the evidence of a
+// type URL, which is followed by the hashes of the evidence.
+func EvidenceByTypePrefix(typeURL string) []byte {
+	return append(append([]byte{}, KeyPrefixEvidenceByType...), address.MustLengthPrefix([]byte(typeURL))...)
+}
+
+// EvidenceByTypeKey returns the key of the index of the evidence of a type URL
+// and hash.
+func EvidenceByTypeKey(typeURL string, hash []byte) []byte {
+	return append(EvidenceByTypePrefix(typeURL), hash...)
+}
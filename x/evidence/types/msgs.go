@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 )
 
@@ -67,12 +68,7 @@ func (m MsgSubmitEvidence) GetSignBytes() []byte {
 
 // GetSigners returns the single expected signer for a MsgSubmitEvidence.
 func (m MsgSubmitEvidence) GetSigners() []sdk.AccAddress {
-	accAddr, err := sdk.AccAddressFromBech32(m.Submitter)
-	if err != nil {
-		return nil
-	}
-
-	return []sdk.AccAddress{accAddr}
+	return msgservice.MustGetSigners(&m)
 }
 
 func (m MsgSubmitEvidence) GetEvidence() exported.Evidence {
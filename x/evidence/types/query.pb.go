@@ -227,11 +227,126 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryEvidenceByTypeRequest is the request type for the Query/EvidenceByType
+// RPC method.
+type QueryEvidenceByTypeRequest struct {
+	// evidence_type defines the type URL of the requested evidence, e.g.
+	// "/cosmos.evidence.v1beta1.Equivocation".
+	EvidenceType string `protobuf:"bytes,1,opt,name=evidence_type,json=evidenceType,proto3" json:"evidence_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvidenceByTypeRequest) Reset()         { *m = QueryEvidenceByTypeRequest{} }
+func (m *QueryEvidenceByTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceByTypeRequest) ProtoMessage()    {}
+func (*QueryEvidenceByTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceByTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceByTypeRequest.Merge(m, src)
+}
+func (m *QueryEvidenceByTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceByTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceByTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceByTypeRequest proto.InternalMessageInfo
+
+func (m *QueryEvidenceByTypeRequest) GetEvidenceType() string {
+	if m != nil {
+		return m.EvidenceType
+	}
+	return ""
+}
+
+func (m *QueryEvidenceByTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEvidenceByTypeResponse is the response type for the Query/EvidenceByType
+// RPC method.
+type QueryEvidenceByTypeResponse struct {
+	// evidence returns the evidence of the requested type.
+	Evidence []*types.Any `protobuf:"bytes,1,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEvidenceByTypeResponse) Reset()         { *m = QueryEvidenceByTypeResponse{} }
+func (m *QueryEvidenceByTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceByTypeResponse) ProtoMessage()    {}
+func (*QueryEvidenceByTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceByTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceByTypeResponse.Merge(m, src)
+}
+func (m *QueryEvidenceByTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceByTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceByTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceByTypeResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceByTypeResponse) GetEvidence() []*types.Any {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *QueryEvidenceByTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryEvidenceByTypeRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceByTypeRequest")
+	proto.RegisterType((*QueryEvidenceByTypeResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceByTypeResponse")
 }
 
 func init() {
@@ -239,37 +354,42 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x22, 0xd0, 0xe4, 0x8d, 0x8b, 0x55, 0xb4, 0x11, 0xa1, 0x00, 0x99, 0xc4, 0x2f,
-	0xa9, 0xf6, 0xb2, 0x71, 0x80, 0xe3, 0x2a, 0xc1, 0xc6, 0x0d, 0x72, 0x44, 0x42, 0xc8, 0x69, 0x4d,
-	0x12, 0x91, 0xda, 0x59, 0xed, 0x4c, 0x8d, 0x10, 0x17, 0xfe, 0x02, 0x24, 0xc4, 0x91, 0x1b, 0x7f,
-	0x0c, 0x27, 0x54, 0x89, 0x0b, 0x27, 0x84, 0x5a, 0xfe, 0x0a, 0x4e, 0x28, 0xb6, 0xd3, 0xa6, 0xbf,
-	0x28, 0x9c, 0xf2, 0x62, 0xbf, 0xf7, 0xfd, 0x7e, 0xfc, 0xde, 0x83, 0xfb, 0x5d, 0x21, 0xfb, 0x42,
-	0x12, 0x76, 0x9e, 0xf4, 0x18, 0xef, 0x32, 0x72, 0xee, 0x87, 0x4c, 0x51, 0x9f, 0x9c, 0xe5, 0x6c,
-	0x50, 0xe0, 0x6c, 0x20, 0x94, 0x40, 0xbb, 0x26, 0x09, 0x57, 0x49, 0xd8, 0x26, 0x39, 0xf7, 0x6c,
-	0x75, 0x48, 0x25, 0x33, 0x15, 0xd3, 0xfa, 0x8c, 0x46, 0x09, 0xa7, 0x2a, 0x11, 0xdc, 0x88, 0x38,
-	0xad, 0x48, 0x44, 0x42, 0x87, 0xa4, 0x8c, 0xec, 0xe9, 0xd5, 0x48, 0x88, 0x28, 0x65, 0x44, 0xff,
-	0x85, 0xf9, 0x2b, 0x42, 0xb9, 0x75, 0x75, 0xae, 0xd9, 0x2b, 0x9a, 0x25, 0x84, 0x72, 0x2e, 0x94,
-	0x56, 0x93, 0xe6, 0xd6, 0xcb, 0x61, 0xeb, 0x59, 0x69, 0xf8, 0xc8, 0x32, 0x05, 0xec, 0x2c, 0x67,
-	0x52, 0xa1, 0x17, 0xf0, 0x72, 0x85, 0xf9, 0x32, 0xa6, 0x32, 0xde, 0x03, 0x37, 0xc0, 0x9d, 0x9d,
-	0xce, 0x83, 0xdf, 0x3f, 0xae, 0xdf, 0x8f, 0x12, 0x15, 0xe7, 0x21, 0xee, 0x8a, 0x3e, 0x51, 0x8c,
-	0xf7, 0xd8, 0xa0, 0x9f, 0x70, 0x55, 0x0f, 0xd3, 0x24, 0x94, 0x24, 0x2c, 0x14, 0x93, 0xf8, 0x94,
-	0x0d, 0x3b, 0x65, 0x10, 0xec, 0x54, 0x72, 0xa7, 0x54, 0xc6, 0xde, 0x13, 0x78, 0x65, 0xc1, 0x56,
-	0x66, 0x82, 0x4b, 0x86, 0x0e, 0xe0, 0x56, 0x95, 0xa8, 0x2d, 0xb7, 0x0f, 0x5b, 0xd8, 0x3c, 0x00,
-	0x57, 0x6f, 0xc3, 0xc7, 0xbc, 0x08, 0xa6, 0x59, 0x1e, 0x85, 0xbb, 0x5a, 0xea, 0x38, 0x4d, 0x17,
-	0x1f, 0xf1, 0x18, 0xc2, 0x59, 0xff, 0xac, 0xdc, 0x2d, 0x6c, 0xa7, 0x50, 0x36, 0x1b, 0x9b, 0xf1,
-	0xd8, 0x66, 0xe3, 0xa7, 0x34, 0xaa, 0x6a, 0x83, 0x5a, 0xa5, 0xf7, 0x11, 0xc0, 0xbd, 0x65, 0x8f,
-	0x95, 0xc4, 0x17, 0x36, 0x13, 0xa3, 0x93, 0x39, 0xac, 0xa6, 0xc6, 0xba, 0xbd, 0x11, 0xcb, 0xd8,
-	0xd5, 0xb9, 0x0e, 0xbf, 0x36, 0xe1, 0x45, 0xcd, 0x85, 0x3e, 0x03, 0xb8, 0x55, 0x91, 0xa1, 0x36,
-	0x5e, 0xb3, 0x68, 0x78, 0xd5, 0xa8, 0x1d, 0xfc, 0xaf, 0xe9, 0x86, 0xc0, 0x7b, 0xf8, 0xee, 0xdb,
-	0xaf, 0x0f, 0xcd, 0x23, 0xe4, 0x93, 0x75, 0x4b, 0x3f, 0x3d, 0x78, 0x33, 0xb7, 0x43, 0x6f, 0xd1,
-	0x27, 0x00, 0xb7, 0x6b, 0x3d, 0x44, 0x07, 0x7f, 0xb7, 0x5e, 0x1e, 0xa9, 0xe3, 0xff, 0x47, 0x85,
-	0xe5, 0xbd, 0xab, 0x79, 0xf7, 0xd1, 0xcd, 0x8d, 0xbc, 0x9d, 0x93, 0x2f, 0x63, 0x17, 0x8c, 0xc6,
-	0x2e, 0xf8, 0x39, 0x76, 0xc1, 0xfb, 0x89, 0xdb, 0x18, 0x4d, 0xdc, 0xc6, 0xf7, 0x89, 0xdb, 0x78,
-	0xde, 0xae, 0x2d, 0xbd, 0x95, 0x31, 0x9f, 0xb6, 0xec, 0xbd, 0x26, 0xc3, 0x99, 0xa6, 0x2a, 0x32,
-	0x26, 0xc3, 0x4b, 0x7a, 0xf4, 0x47, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x50, 0xfb, 0x8a, 0x39,
-	0x18, 0x04, 0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x14, 0xc7, 0x73, 0xad, 0x7e, 0x3f, 0x95, 0x6b, 0x61, 0x38, 0x05, 0xb5, 0x18, 0x64, 0xc0, 0x91,
+	0x80, 0x82, 0x72, 0x57, 0x37, 0x1d, 0x60, 0x6c, 0x24, 0xda, 0xb2, 0x41, 0xc4, 0x84, 0x84, 0xaa,
+	0x73, 0x72, 0x38, 0x16, 0xc9, 0x9d, 0x9b, 0x3b, 0x57, 0xb5, 0x10, 0x0b, 0x1b, 0x1b, 0x12, 0x42,
+	0x62, 0x61, 0x63, 0xe6, 0xef, 0x60, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x5f, 0xc1, 0x02, 0xf2,
+	0xdd, 0x39, 0xb1, 0xdb, 0x94, 0xb4, 0x2c, 0x4c, 0xb9, 0xd8, 0xef, 0x7d, 0xdf, 0xe7, 0x7d, 0xdf,
+	0x3b, 0xc3, 0x5a, 0x5b, 0xc8, 0xbe, 0x90, 0x84, 0xed, 0x47, 0x1d, 0xc6, 0xdb, 0x8c, 0xec, 0xfb,
+	0x01, 0x53, 0xd4, 0x27, 0x7b, 0x09, 0x1b, 0xa4, 0x38, 0x1e, 0x08, 0x25, 0xd0, 0xb2, 0x09, 0xc2,
+	0x79, 0x10, 0xb6, 0x41, 0xce, 0x6d, 0x9b, 0x1d, 0x50, 0xc9, 0x4c, 0xc6, 0x38, 0x3f, 0xa6, 0x61,
+	0xc4, 0xa9, 0x8a, 0x04, 0x37, 0x22, 0x4e, 0x35, 0x14, 0xa1, 0xd0, 0x47, 0x92, 0x9d, 0xec, 0xd3,
+	0x4b, 0xa1, 0x10, 0x61, 0x8f, 0x11, 0xfd, 0x2f, 0x48, 0x9e, 0x11, 0xca, 0x6d, 0x55, 0xe7, 0x8a,
+	0x7d, 0x45, 0xe3, 0x88, 0x50, 0xce, 0x85, 0xd2, 0x6a, 0xd2, 0xbc, 0xf5, 0x12, 0x58, 0x7d, 0x94,
+	0x15, 0xbc, 0x6f, 0x99, 0x5a, 0x6c, 0x2f, 0x61, 0x52, 0xa1, 0xa7, 0xf0, 0x7c, 0x8e, 0xb9, 0xdb,
+	0xa5, 0xb2, 0xbb, 0x02, 0xae, 0x81, 0x5b, 0x4b, 0xcd, 0xbb, 0x3f, 0xbf, 0x5d, 0xdd, 0x08, 0x23,
+	0xd5, 0x4d, 0x02, 0xdc, 0x16, 0x7d, 0xa2, 0x18, 0xef, 0xb0, 0x41, 0x3f, 0xe2, 0xaa, 0x78, 0xec,
+	0x45, 0x81, 0x24, 0x41, 0xaa, 0x98, 0xc4, 0x3b, 0xec, 0xa0, 0x99, 0x1d, 0x5a, 0x4b, 0xb9, 0xdc,
+	0x0e, 0x95, 0x5d, 0xef, 0x01, 0xbc, 0x78, 0xa4, 0xac, 0x8c, 0x05, 0x97, 0x0c, 0xad, 0xc1, 0x85,
+	0x3c, 0x50, 0x97, 0x5c, 0x5c, 0xaf, 0x62, 0xd3, 0x00, 0xce, 0x7b, 0xc3, 0x9b, 0x3c, 0x6d, 0x8d,
+	0xa3, 0x3c, 0x0a, 0x97, 0xb5, 0xd4, 0x66, 0xaf, 0x77, 0xb4, 0x89, 0x2d, 0x08, 0x27, 0xfe, 0x59,
+	0xb9, 0x1b, 0xd8, 0x4e, 0x21, 0x33, 0x1b, 0x9b, 0xf1, 0x58, 0xb3, 0xf1, 0x43, 0x1a, 0xe6, 0xb9,
+	0xad, 0x42, 0xa6, 0xf7, 0x0e, 0xc0, 0x95, 0xe3, 0x35, 0xa6, 0x12, 0xcf, 0xcf, 0x26, 0x46, 0xdb,
+	0x25, 0xac, 0x39, 0x8d, 0x75, 0x73, 0x26, 0x96, 0x29, 0x57, 0xe2, 0x7a, 0x0d, 0xa0, 0x53, 0xb2,
+	0xb1, 0x99, 0x3e, 0x4e, 0xe3, 0x71, 0xfb, 0xb5, 0xc2, 0x0c, 0x55, 0x1a, 0x1b, 0x43, 0xcf, 0x4d,
+	0x26, 0x91, 0xc5, 0xa2, 0xad, 0x29, 0x30, 0x7f, 0xe3, 0xd1, 0x7b, 0x00, 0x2f, 0x4f, 0x65, 0xf9,
+	0xe7, 0x36, 0xad, 0xff, 0x9a, 0x87, 0xff, 0x69, 0x34, 0xf4, 0x11, 0xc0, 0x85, 0x9c, 0x0f, 0xd5,
+	0xf1, 0x09, 0xf7, 0x11, 0x4f, 0xbb, 0x11, 0x0e, 0x3e, 0x6d, 0xb8, 0x21, 0xf0, 0xee, 0xbd, 0xfa,
+	0xf2, 0xe3, 0xed, 0x5c, 0x03, 0xf9, 0xe4, 0xa4, 0x6f, 0xc3, 0xf8, 0xc1, 0x8b, 0xd2, 0x55, 0x7b,
+	0x89, 0x3e, 0x00, 0xb8, 0x58, 0x58, 0x35, 0xb4, 0xf6, 0xe7, 0xd2, 0xc7, 0x37, 0xdf, 0xf1, 0xcf,
+	0x90, 0x61, 0x79, 0x57, 0x35, 0x6f, 0x0d, 0x5d, 0x9f, 0xc9, 0x8b, 0x3e, 0x01, 0x78, 0xa1, 0x3c,
+	0x66, 0xd4, 0x38, 0x9d, 0x3b, 0xa5, 0x05, 0x75, 0x36, 0xce, 0x96, 0x64, 0x41, 0x7d, 0x0d, 0x7a,
+	0x07, 0xad, 0xce, 0x04, 0xdd, 0x0d, 0x52, 0xbd, 0xf8, 0xcd, 0xed, 0xcf, 0x43, 0x17, 0x1c, 0x0e,
+	0x5d, 0xf0, 0x7d, 0xe8, 0x82, 0x37, 0x23, 0xb7, 0x72, 0x38, 0x72, 0x2b, 0x5f, 0x47, 0x6e, 0xe5,
+	0x49, 0xbd, 0xf0, 0x31, 0xb3, 0x72, 0xe6, 0xa7, 0x2e, 0x3b, 0xcf, 0xc9, 0xc1, 0x44, 0x3b, 0xd3,
+	0x91, 0xc1, 0xff, 0x7a, 0x57, 0x1b, 0xbf, 0x07, 0x00, 0x91, 0x1e, 0xf7, 0x22, 0xf0, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +408,8 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// EvidenceByType queries all evidence of a type.
+	EvidenceByType(ctx context.Context, in *QueryEvidenceByTypeRequest, opts ...grpc.CallOption) (*QueryEvidenceByTypeResponse, error)
 }
 
 type queryClient struct {
@@ -316,12 +438,23 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) EvidenceByType(ctx context.Context, in *QueryEvidenceByTypeRequest, opts ...grpc.CallOption) (*QueryEvidenceByTypeResponse, error) {
+	out := new(QueryEvidenceByTypeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/EvidenceByType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// EvidenceByType queries all evidence of a type.
+	EvidenceByType(context.Context, *QueryEvidenceByTypeRequest) (*QueryEvidenceByTypeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -334,6 +467,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) EvidenceByType(ctx context.Context, req *QueryEvidenceByTypeRequest) (*QueryEvidenceByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvidenceByType not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -375,6 +511,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EvidenceByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EvidenceByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/EvidenceByType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EvidenceByType(ctx, req.(*QueryEvidenceByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -387,6 +541,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "EvidenceByType",
+			Handler:    _Query_EvidenceByType_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -541,6 +699,97 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryEvidenceByTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceByTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceByTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvidenceType) > 0 {
+		i -= len(m.EvidenceType)
+		copy(dAtA[i:], m.EvidenceType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvidenceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceByTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceByTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceByTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Evidence) > 0 {
+		for iNdEx := len(m.Evidence) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Evidence[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -610,6 +859,42 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEvidenceByTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceByTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		for _, e := range m.Evidence {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -992,6 +1277,244 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEvidenceByTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceByTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceByTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence, &types.Any{})
+			if err := m.Evidence[len(m.Evidence)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,25 +14,35 @@ type (
 	// slashing and potential jailing.
 	Handler func(sdk.Context, exported.Evidence) error
 
+	// Validator defines an optional stateful validation of an Evidence type,
+	// run before its Handler when the evidence is submitted, e.g. checking that
+	// the evidence is not too old or that the misbehaving party exists. The
+	// stateless validation is done by the ValidateBasic method of the evidence.
+	Validator func(sdk.Context, exported.Evidence) error
+
 	// Router defines a contract for which any Evidence handling module must
 	// implement in order to route Evidence to registered Handlers.
 	Router interface {
 		AddRoute(r string, h Handler) Router
+		AddRouteWithValidator(r string, h Handler, v Validator) Router
 		HasRoute(r string) bool
 		GetRoute(path string) Handler
+		GetValidator(path string) Validator
 		Seal()
 		Sealed() bool
 	}
 
 	router struct {
-		routes map[string]Handler
-		sealed bool
+		routes     map[string]Handler
+		validators map[string]Validator
+		sealed     bool
 	}
 )
 
 func NewRouter() Router {
 	return &router{
-		routes: make(map[string]Handler),
+		routes:     make(map[string]Handler),
+		validators: make(map[string]Validator),
 	}
 }
 
@@ -67,6 +77,19 @@ func (rtr *router) AddRoute(path string, h Handler) Router {
 	return rtr
 }
 
+// AddRouteWithValidator adds an Evidence handler for a given path along with
+// the stateful validation of its evidence, run before the handler. It returns
+// the Router so AddRoute calls can be linked. It will panic if the router is
+// sealed.
+func (rtr *router) AddRouteWithValidator(path string, h Handler, v Validator) Router {
+	rtr.AddRoute(path, h)
+	if v != nil {
+		rtr.validators[path] = v
+	}
+
+	return rtr
+}
+
 // HasRoute returns true if the router has a path registered or false otherwise.
 func (rtr *router) HasRoute(path string) bool {
 	return rtr.routes[path] != nil
@@ -79,3 +102,9 @@ func (rtr *router) GetRoute(path string) Handler {
 	}
 	return rtr.routes[path]
 }
+
+// GetValidator returns the Validator registered for a given path, or nil if
+// none was registered with the handler.
+func (rtr *router) GetValidator(path string) Validator {
+	return rtr.validators[path]
+}
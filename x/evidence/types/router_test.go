@@ -26,3 +26,14 @@ func TestRouter(t *testing.T) {
 	require.Panics(t, func() { r.AddRoute("test", testHandler) })
 	require.Panics(t, func() { r.AddRoute("    ", testHandler) })
 }
+
+func TestRouterValidator(t *testing.T) {
+	r := types.NewRouter()
+	r.AddRoute("test", testHandler)
+	require.Nil(t, r.GetValidator("test"))
+
+	r.AddRouteWithValidator("validated", testHandler, testHandler)
+	require.True(t, r.HasRoute("validated"))
+	require.NotNil(t, r.GetValidator("validated"))
+	require.Panics(t, func() { r.AddRouteWithValidator("validated", testHandler, testHandler) })
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -125,27 +126,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/evidence/v1beta1/tx.proto", fileDescriptor_3e3242cb23c956e0) }
 
 var fileDescriptor_3e3242cb23c956e0 = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x60, 0x8e,
-	0x52, 0x35, 0x97, 0xa0, 0x6f, 0x71, 0x7a, 0x70, 0x69, 0x52, 0x6e, 0x66, 0x89, 0x2b, 0xd4, 0x22,
-	0x21, 0x19, 0x2e, 0xce, 0x62, 0xb0, 0x48, 0x49, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67,
-	0x10, 0x42, 0x40, 0xc8, 0x8e, 0x8b, 0x03, 0xe6, 0x24, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x11, 0x3d, 0x88, 0xdd, 0x7a, 0x30, 0xbb, 0xf5, 0x1c, 0xf3, 0x2a, 0x9d, 0x78, 0x4e, 0x6d, 0xd1,
-	0xe5, 0x80, 0x99, 0x19, 0x04, 0xd7, 0x63, 0xc5, 0xd1, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79,
-	0x06, 0x25, 0x7d, 0x2e, 0x49, 0x0c, 0xcb, 0x83, 0x52, 0x8b, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85,
-	0x84, 0xb8, 0x58, 0x32, 0x12, 0x8b, 0x33, 0x24, 0x58, 0x14, 0x18, 0x35, 0x78, 0x82, 0xc0, 0x6c,
-	0xa3, 0x72, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x02, 0x2e, 0x3e, 0x34, 0x17, 0x6b, 0xe9, 0xe1,
-	0x08, 0x2c, 0x3d, 0x0c, 0x0b, 0xa4, 0x8c, 0x88, 0x57, 0x0b, 0x73, 0x8c, 0x93, 0xf7, 0x8a, 0x47,
-	0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9b, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x0b, 0x0d, 0x5d, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad,
-	0x5f, 0x81, 0x88, 0xe3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x30, 0x19, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xa7, 0x78, 0xdf, 0xa8, 0x03, 0x02, 0x00, 0x00,
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4f, 0x02, 0x31,
+	0x14, 0xc7, 0xaf, 0x4a, 0x0c, 0x54, 0x62, 0x62, 0x43, 0x14, 0x88, 0x29, 0x17, 0x26, 0x42, 0x42,
+	0x1b, 0x70, 0x73, 0x30, 0x91, 0xc4, 0xc9, 0xb0, 0x9c, 0x9b, 0x8b, 0xe1, 0xa0, 0x16, 0xa2, 0x77,
+	0xbd, 0xd0, 0x82, 0xb0, 0x3a, 0xe9, 0xe6, 0x47, 0x60, 0x74, 0x74, 0xf0, 0x43, 0x18, 0x27, 0x46,
+	0x47, 0x73, 0x0c, 0xfa, 0x31, 0x0c, 0xd7, 0xd6, 0x4b, 0x24, 0x26, 0x4e, 0xef, 0xdd, 0x7b, 0xff,
+	0xf7, 0x7f, 0xbf, 0xbc, 0x2b, 0x74, 0x7b, 0x42, 0x06, 0x42, 0x52, 0x36, 0x19, 0xf6, 0x59, 0xd8,
+	0x63, 0x74, 0xd2, 0xf4, 0x99, 0xea, 0x36, 0xa9, 0x9a, 0x92, 0x68, 0x24, 0x94, 0x40, 0xfb, 0x5a,
+	0x41, 0xac, 0x82, 0x18, 0x45, 0xb9, 0xc0, 0x05, 0x17, 0x89, 0x86, 0xae, 0x32, 0x2d, 0x2f, 0x97,
+	0xb8, 0x10, 0xfc, 0x86, 0xd1, 0xe4, 0xcb, 0x1f, 0x5f, 0xd1, 0x6e, 0x38, 0xb3, 0x2d, 0xed, 0x74,
+	0xa9, 0x67, 0x8c, 0xad, 0x6e, 0x99, 0x25, 0x34, 0x90, 0x9c, 0x4e, 0x9a, 0xab, 0xa0, 0x1b, 0xd5,
+	0x07, 0x00, 0x77, 0x3b, 0x92, 0x9f, 0x8f, 0xfd, 0x60, 0xa8, 0x4e, 0x0d, 0x02, 0x3a, 0x80, 0x39,
+	0x99, 0x54, 0x14, 0x1b, 0x15, 0x81, 0x0b, 0x6a, 0x39, 0x2f, 0x2d, 0xa0, 0x63, 0x98, 0xb5, 0xb0,
+	0xc5, 0x0d, 0x17, 0xd4, 0xb6, 0x5b, 0x05, 0xa2, 0xa9, 0x88, 0xa5, 0x22, 0x27, 0xe1, 0xac, 0x9d,
+	0x7f, 0x7b, 0x69, 0x64, 0xad, 0xa7, 0xf7, 0x33, 0x73, 0xb4, 0x77, 0x3f, 0xaf, 0x38, 0x5f, 0xf3,
+	0x8a, 0x73, 0xf7, 0xf9, 0x5c, 0x4f, 0x7d, 0xab, 0x14, 0x96, 0xd6, 0x50, 0x3c, 0x26, 0x23, 0x11,
+	0x4a, 0x86, 0x10, 0xcc, 0x0c, 0xba, 0x72, 0x50, 0xcc, 0xb8, 0xa0, 0x96, 0xf7, 0x92, 0xbc, 0x75,
+	0x0b, 0x37, 0x3b, 0x92, 0xa3, 0x08, 0xee, 0xfc, 0xe2, 0xaf, 0x93, 0x3f, 0x8e, 0x4a, 0xd6, 0x16,
+	0x94, 0x5b, 0xff, 0xd7, 0x5a, 0x98, 0xf6, 0xd9, 0x53, 0x8c, 0xc1, 0x6b, 0x8c, 0xc1, 0x22, 0xc6,
+	0xe0, 0x23, 0xc6, 0xe0, 0x71, 0x89, 0x9d, 0xc5, 0x12, 0x3b, 0xef, 0x4b, 0xec, 0x5c, 0x34, 0xf8,
+	0x50, 0x0d, 0xc6, 0x3e, 0xe9, 0x89, 0xc0, 0xfc, 0x05, 0x13, 0x1a, 0xb2, 0x7f, 0x4d, 0xa7, 0xe9,
+	0x5b, 0x50, 0xb3, 0x88, 0x49, 0x7f, 0x2b, 0x39, 0xda, 0xe1, 0xf7, 0x00, 0x85, 0x07, 0xee, 0x5e,
+	0x2b, 0x02, 0x00, 0x00,
 }
 
 func (this *MsgSubmitEvidenceResponse) Equal(that interface{}) bool {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -52,6 +53,10 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *feegrant.MsgGrantA
 		return nil, err
 	}
 
+	// the allowances updated when used are also set with GrantAllowance, so the
+	// grants are recorded here
+	telemetry.KeeperIncrCounter(feegrant.ModuleName, "grant", 1)
+
 	return &feegrant.MsgGrantAllowanceResponse{}, nil
 }
 
@@ -74,5 +79,7 @@ func (k msgServer) RevokeAllowance(goCtx context.Context, msg *feegrant.MsgRevok
 		return nil, err
 	}
 
+	telemetry.KeeperIncrCounter(feegrant.ModuleName, "revoke", 1)
+
 	return &feegrant.MsgRevokeAllowanceResponse{}, nil
 }
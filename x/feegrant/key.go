@@ -3,6 +3,7 @@ package feegrant
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -34,3 +35,13 @@ func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
 func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
 	return append(FeeAllowanceKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
 }
+
+// KeyFormats are the layouts of the keys of the feegrant store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "fee_allowance",
+		Prefix:   FeeAllowanceKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("grantee"), keyformat.AccAddress("granter")},
+		Value:    keyformat.ProtoValue(&Grant{}),
+	},
+}
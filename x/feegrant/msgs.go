@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
 )
 
@@ -58,11 +59,7 @@ func (msg MsgGrantAllowance) ValidateBasic() error {
 
 // GetSigners gets the granter account associated with an allowance
 func (msg MsgGrantAllowance) GetSigners() []sdk.AccAddress {
-	granter, err := sdk.AccAddressFromBech32(msg.Granter)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{granter}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // Type implements the LegacyMsg.Type method.
@@ -121,11 +118,7 @@ func (msg MsgRevokeAllowance) ValidateBasic() error {
 // GetSigners gets the granter address associated with an Allowance
 // to revoke.
 func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
-	granter, err := sdk.AccAddressFromBech32(msg.Granter)
-	if err != nil {
-		panic(err)
-	}
-	return []sdk.AccAddress{granter}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // Type implements the LegacyMsg.Type method.
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -234,29 +235,30 @@ func init() {
 func init() { proto.RegisterFile("cosmos/feegrant/v1beta1/tx.proto", fileDescriptor_dd44ad7946dad783) }
 
 var fileDescriptor_dd44ad7946dad783 = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4b, 0x4d, 0x4d, 0x2f, 0x4a, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xa8,
-	0xd0, 0x83, 0xa9, 0xd0, 0x83, 0xaa, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab, 0xd1, 0x07,
-	0xb1, 0x20, 0xca, 0xa5, 0x24, 0xd3, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2,
-	0x34, 0xfd, 0xc4, 0xbc, 0x4a, 0x98, 0x14, 0xc4, 0xa4, 0x78, 0x88, 0x1e, 0xa8, 0xb1, 0x60, 0x8e,
-	0x52, 0x1f, 0x23, 0x97, 0xa0, 0x6f, 0x71, 0xba, 0x3b, 0xc8, 0x02, 0xc7, 0x9c, 0x9c, 0xfc, 0xf2,
-	0xc4, 0xbc, 0xe4, 0x54, 0x21, 0x09, 0x2e, 0x76, 0xb0, 0x95, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c,
-	0x1a, 0x9c, 0x41, 0x30, 0x2e, 0x42, 0x26, 0x55, 0x82, 0x09, 0x59, 0x26, 0x55, 0xc8, 0x95, 0x8b,
-	0x33, 0x11, 0x66, 0x80, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x88, 0x1e, 0xc4, 0x4d, 0x7a,
-	0x30, 0x37, 0xe9, 0x39, 0xe6, 0x55, 0x3a, 0x09, 0x9e, 0xda, 0xa2, 0xcb, 0xeb, 0x96, 0x9a, 0x0a,
-	0xb7, 0xce, 0x33, 0x08, 0xa1, 0x53, 0x49, 0x9a, 0x4b, 0x12, 0xc3, 0x3d, 0x41, 0xa9, 0xc5, 0x05,
-	0xf9, 0x79, 0xc5, 0xa9, 0x4a, 0x1e, 0x5c, 0x42, 0xbe, 0xc5, 0xe9, 0x41, 0xa9, 0x65, 0xf9, 0xd9,
-	0xa9, 0x14, 0xb9, 0x56, 0x49, 0x86, 0x4b, 0x0a, 0xd3, 0x24, 0x98, 0x3d, 0x46, 0x6f, 0x18, 0xb9,
-	0x98, 0x7d, 0x8b, 0xd3, 0x85, 0x0a, 0xb8, 0xf8, 0xd0, 0x42, 0x46, 0x4b, 0x0f, 0x47, 0xac, 0xe8,
-	0x61, 0xb8, 0x5a, 0xca, 0x88, 0x78, 0xb5, 0x30, 0x9b, 0x85, 0x8a, 0xb9, 0xf8, 0xd1, 0xbd, 0xa7,
-	0x8d, 0xcf, 0x18, 0x34, 0xc5, 0x52, 0xc6, 0x24, 0x28, 0x86, 0x59, 0xea, 0xe4, 0x78, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xea, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xd0, 0x74, 0x03, 0xa5, 0x74, 0x8b, 0x53, 0xb2, 0xf5, 0x2b, 0xe0, 0xa9, 0x37,
-	0x89, 0x0d, 0x1c, 0xc5, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x47, 0xc8, 0xf2, 0xd7,
-	0x02, 0x00, 0x00,
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xbf, 0x4e, 0x32, 0x41,
+	0x14, 0xc5, 0x99, 0x8f, 0xe4, 0x33, 0x8c, 0xff, 0xc2, 0x86, 0xc4, 0x65, 0x35, 0x1b, 0x42, 0x23,
+	0xc1, 0x30, 0x13, 0xa0, 0xb3, 0x83, 0x44, 0x8d, 0x05, 0xcd, 0x16, 0x16, 0x36, 0x66, 0x17, 0x2f,
+	0xa3, 0x01, 0xf6, 0x12, 0x66, 0x41, 0x68, 0x7d, 0x02, 0x1f, 0xc0, 0x87, 0xb0, 0xf0, 0x21, 0x8c,
+	0x15, 0xa5, 0xa5, 0x81, 0xc2, 0xc6, 0x87, 0x30, 0xec, 0xec, 0x80, 0x59, 0xa2, 0xd1, 0x58, 0xcd,
+	0x4e, 0xce, 0x99, 0xf3, 0x3b, 0xb3, 0x73, 0x69, 0xae, 0x89, 0xb2, 0x8b, 0x92, 0xb7, 0x00, 0x44,
+	0xdf, 0xf5, 0x03, 0x3e, 0x2c, 0x7b, 0x10, 0xb8, 0x65, 0x1e, 0x8c, 0x58, 0xaf, 0x8f, 0x01, 0x1a,
+	0x3b, 0xca, 0xc1, 0xb4, 0x83, 0x45, 0x0e, 0x2b, 0x23, 0x50, 0x60, 0xe8, 0xe1, 0xf3, 0x2f, 0x65,
+	0xb7, 0xb2, 0x02, 0x51, 0x74, 0x80, 0x87, 0x3b, 0x6f, 0xd0, 0xe2, 0xae, 0x3f, 0xd6, 0x92, 0x4a,
+	0xba, 0x50, 0x67, 0xa2, 0x58, 0x25, 0x45, 0x10, 0xde, 0x95, 0x82, 0x0f, 0xcb, 0xf3, 0x45, 0x09,
+	0xf9, 0x7b, 0x42, 0xd3, 0x0d, 0x29, 0x4e, 0xe6, 0xe4, 0x5a, 0xa7, 0x83, 0x37, 0xae, 0xdf, 0x04,
+	0xc3, 0xa4, 0x6b, 0x61, 0x17, 0xe8, 0x9b, 0x24, 0x47, 0x0a, 0x29, 0x47, 0x6f, 0x97, 0x0a, 0x98,
+	0xff, 0x3e, 0x2b, 0x60, 0x1c, 0xd1, 0x94, 0xab, 0x03, 0xcc, 0x64, 0x8e, 0x14, 0xd6, 0x2b, 0x19,
+	0xa6, 0xca, 0x32, 0x5d, 0x96, 0xd5, 0xfc, 0x71, 0x3d, 0xfd, 0xfc, 0x58, 0xda, 0x3c, 0x06, 0x58,
+	0xe0, 0x4e, 0x9d, 0xe5, 0xc9, 0xc3, 0x8d, 0xdb, 0xb7, 0x87, 0xa2, 0xc6, 0xe5, 0x77, 0x69, 0x76,
+	0xa5, 0x9d, 0x03, 0xb2, 0x87, 0xbe, 0x84, 0xfc, 0x19, 0x35, 0x1a, 0x52, 0x38, 0x30, 0xc4, 0x36,
+	0xfc, 0xa9, 0x7b, 0x0c, 0xba, 0x47, 0xad, 0xd5, 0x5c, 0x4d, 0xad, 0xbc, 0x13, 0x9a, 0x6c, 0x48,
+	0x61, 0xf4, 0xe8, 0x56, 0xec, 0xaf, 0x15, 0xd9, 0x17, 0x4f, 0xc9, 0x56, 0xee, 0x60, 0x55, 0x7e,
+	0xee, 0xd5, 0x64, 0x43, 0xd2, 0xed, 0xf8, 0x65, 0x0f, 0xbe, 0x8b, 0x89, 0x99, 0xad, 0xea, 0x2f,
+	0xcc, 0x1a, 0x5a, 0xaf, 0x3d, 0x4d, 0x6d, 0x32, 0x99, 0xda, 0xe4, 0x75, 0x6a, 0x93, 0xbb, 0x99,
+	0x9d, 0x98, 0xcc, 0xec, 0xc4, 0xcb, 0xcc, 0x4e, 0x9c, 0xef, 0x8b, 0xeb, 0xe0, 0x6a, 0xe0, 0xb1,
+	0x26, 0x76, 0xa3, 0x61, 0x8b, 0x96, 0x92, 0xbc, 0x6c, 0xf3, 0xd1, 0x62, 0xe4, 0xbd, 0xff, 0xe1,
+	0xf3, 0x57, 0x3f, 0x06, 0x00, 0x4f, 0x20, 0x7f, 0xcc, 0x0c, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
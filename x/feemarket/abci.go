@@ -0,0 +1,42 @@
+package feemarket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// BeginBlocker adjusts the base fee of the block to the gas used by the last
+// block.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	baseFee := k.UpdateBaseFee(ctx)
+
+	telemetry.ModuleSetGauge(types.ModuleName, float32(baseFee.MustFloat64()), "base_fee")
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, fmt.Sprint(k.GetBlockGasUsed(ctx))),
+		),
+	)
+}
+
+// EndBlocker records the gas used by the block, which sets the base fee of the
+// next one.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var gasUsed uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		gasUsed = meter.GasConsumedToLimit()
+	}
+
+	k.SetBlockGasUsed(ctx, gasUsed)
+}
@@ -0,0 +1,72 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestBaseFeeAdjustment(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1}).
+		WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 1000}})
+	k := app.FeeMarketKeeper
+
+	params := types.DefaultParams()
+	feemarket.InitGenesis(ctx, k, types.NewGenesisState(params, sdk.NewDec(1), 0))
+
+	// a full block increases the base fee of the next one by 1/8
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000))
+	ctx.BlockGasMeter().ConsumeGas(1000, "txs")
+	feemarket.EndBlocker(ctx, k)
+	require.Equal(t, uint64(1000), k.GetBlockGasUsed(ctx))
+
+	ctx = ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	feemarket.BeginBlocker(ctx, k)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 3), k.GetBaseFee(ctx))
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, sdk.NewDecWithPrec(1125, 3).String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, "1000"),
+		),
+	}, ctx.EventManager().Events())
+
+	// empty blocks decrease it down to the min base fee
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000))
+	for height := int64(3); height < 100; height++ {
+		feemarket.EndBlocker(ctx, k)
+		feemarket.BeginBlocker(ctx.WithBlockHeight(height), k)
+	}
+	require.Equal(t, params.MinBaseFee, k.GetBaseFee(ctx))
+
+	// which the base fee query reports along with whether it is enforced
+	baseFee, enforced := k.EnforcedBaseFee(ctx)
+	require.False(t, enforced)
+	require.Equal(t, sdk.DecCoin{}, baseFee)
+
+	params.Enabled = true
+	k.SetParams(ctx, params)
+	baseFee, enforced = k.EnforcedBaseFee(ctx)
+	require.True(t, enforced)
+	require.Equal(t, sdk.NewDecCoinFromDec(params.BaseFeeDenom, params.MinBaseFee), baseFee)
+}
+
+func TestExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// the default genesis state is initialized by simapp
+	require.Equal(t, types.DefaultGenesisState(), feemarket.ExportGenesis(ctx, app.FeeMarketKeeper))
+
+	genesis := types.NewGenesisState(types.NewParams(true, "atom", 16, 3, sdk.NewDecWithPrec(1, 3)), sdk.NewDecWithPrec(5, 3), 12345)
+	feemarket.InitGenesis(ctx, app.FeeMarketKeeper, genesis)
+	require.Equal(t, genesis, feemarket.ExportGenesis(ctx, app.FeeMarketKeeper))
+}
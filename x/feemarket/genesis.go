@@ -0,0 +1,20 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// InitGenesis sets the params, the base fee and the gas used by the last block
+// of the genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetBaseFee(ctx, data.BaseFee)
+	k.SetBlockGasUsed(ctx, data.BlockGasUsed)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetBaseFee(ctx), k.GetBlockGasUsed(ctx))
+}
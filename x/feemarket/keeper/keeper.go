@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+
+	// authority is the address allowed to update the parameters of the
+	// module, usually the gov module account.
+	authority string
+}
+
+// NewKeeper creates a new feemarket Keeper instance. The authority is the
+// address allowed to update the parameters, usually the gov module account.
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid feemarket authority %s", authority))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		paramSpace: paramSpace,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the address allowed to update the parameters of the
+// module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBaseFee returns the current base fee per unit of gas.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var baseFee sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &baseFee)
+	return baseFee.Dec
+}
+
+// SetBaseFee sets the current base fee per unit of gas.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseFeeKey, k.cdc.MustMarshal(&sdk.DecProto{Dec: baseFee}))
+}
+
+// GetBlockGasUsed returns the gas used by the last block.
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BlockGasUsedKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetBlockGasUsed sets the gas used by the last block.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockGasUsedKey, sdk.Uint64ToBigEndian(gas))
+}
+
+// EnforcedBaseFee returns the base fee per unit of gas the transactions must
+// pay, and false if the base fee is not enforced.
+func (k Keeper) EnforcedBaseFee(ctx sdk.Context) (sdk.DecCoin, bool) {
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return sdk.DecCoin{}, false
+	}
+
+	return sdk.NewDecCoinFromDec(params.BaseFeeDenom, k.GetBaseFee(ctx)), true
+}
+
+// UpdateBaseFee sets the base fee of the block of the context from the gas
+// used by the last block, and returns it.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context) sdk.Dec {
+	var maxBlockGas int64
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		maxBlockGas = cp.Block.MaxGas
+	}
+
+	baseFee := k.GetParams(ctx).NextBaseFee(k.GetBaseFee(ctx), k.GetBlockGasUsed(ctx), maxBlockGas)
+	k.SetBaseFee(ctx, baseFee)
+
+	return baseFee
+}
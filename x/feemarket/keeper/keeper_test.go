@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeMarketKeeper.GetParams(ctx), params.Params)
+
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(3, 2))
+	baseFee, err := queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(3, 2)), baseFee.BaseFee)
+	suite.Require().False(baseFee.Enabled)
+
+	app.FeeMarketKeeper.SetBlockGasUsed(ctx, 42)
+	blockGas, err := queryClient.BlockGas(gocontext.Background(), &types.QueryBlockGasRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(42), blockGas.Gas)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
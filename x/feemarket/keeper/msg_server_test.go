@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	msgServer := keeper.NewMsgServerImpl(app.FeeMarketKeeper)
+
+	params := app.FeeMarketKeeper.GetParams(ctx)
+	params.Enabled = true
+	invalid := params
+	invalid.BaseFeeChangeDenominator = 0
+
+	testutil.RunUpdateParamsTest(t, ctx, testutil.UpdateParamsTest{
+		Authority: app.FeeMarketKeeper.GetAuthority(),
+		Params:    params,
+		Invalid:   invalid,
+		Update: func(ctx sdk.Context, authority string, params interface{}) error {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: params.(types.Params)})
+			return err
+		},
+		Query: func(ctx sdk.Context) (interface{}, int64) {
+			res, err := app.FeeMarketKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+			require.NoError(t, err)
+			return res.Params, res.LastChangedHeight
+		},
+	})
+}
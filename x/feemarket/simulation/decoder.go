@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding feemarket type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.BaseFeeKey):
+			var baseFeeA, baseFeeB sdk.DecProto
+			cdc.MustUnmarshal(kvA.Value, &baseFeeA)
+			cdc.MustUnmarshal(kvB.Value, &baseFeeB)
+			return fmt.Sprintf("%v\n%v", baseFeeA.Dec, baseFeeB.Dec)
+		case bytes.Equal(kvA.Key, types.BlockGasUsedKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid feemarket key %X", kvA.Key))
+		}
+	}
+}
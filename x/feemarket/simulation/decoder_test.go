@@ -0,0 +1,33 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	baseFee := sdk.NewDecWithPrec(25, 4)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.BaseFeeKey, Value: cdc.MustMarshal(&sdk.DecProto{Dec: baseFee})},
+			{Key: types.BlockGasUsedKey, Value: sdk.Uint64ToBigEndian(1000)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	require.Equal(t, fmt.Sprintf("%v\n%v", baseFee, baseFee), dec(kvPairs.Pairs[0], kvPairs.Pairs[0]))
+	require.Equal(t, "1000\n1000", dec(kvPairs.Pairs[1], kvPairs.Pairs[1]))
+	require.Panics(t, func() { dec(kvPairs.Pairs[2], kvPairs.Pairs[2]) })
+}
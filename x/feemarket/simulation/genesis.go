@@ -0,0 +1,68 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	BaseFeeChangeDenominator = "base_fee_change_denominator"
+	ElasticityMultiplier     = "elasticity_multiplier"
+	MinBaseFee               = "min_base_fee"
+)
+
+// GenBaseFeeChangeDenominator randomized BaseFeeChangeDenominator
+func GenBaseFeeChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// GenElasticityMultiplier randomized ElasticityMultiplier
+func GenElasticityMultiplier(r *rand.Rand) uint32 {
+	return uint32(r.Intn(4) + 1)
+}
+
+// GenMinBaseFee randomized MinBaseFee
+func GenMinBaseFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(1+r.Intn(99)), 4)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The base
+// fee is tracked but not enforced, the simulated transactions paying random
+// fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	var baseFeeChangeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseFeeChangeDenominator, &baseFeeChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { baseFeeChangeDenominator = GenBaseFeeChangeDenominator(r) },
+	)
+
+	var elasticityMultiplier uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ElasticityMultiplier, &elasticityMultiplier, simState.Rand,
+		func(r *rand.Rand) { elasticityMultiplier = GenElasticityMultiplier(r) },
+	)
+
+	var minBaseFee sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinBaseFee, &minBaseFee, simState.Rand,
+		func(r *rand.Rand) { minBaseFee = GenMinBaseFee(r) },
+	)
+
+	params := types.NewParams(false, sdk.DefaultBondDenom, baseFeeChangeDenominator, elasticityMultiplier, minBaseFee)
+	feemarketGenesis := types.NewGenesisState(params, minBaseFee, 0)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
@@ -0,0 +1,35 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+const (
+	keyBaseFeeChangeDenominator = "BaseFeeChangeDenominator"
+	keyElasticityMultiplier     = "ElasticityMultiplier"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keyBaseFeeChangeDenominator,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenBaseFeeChangeDenominator(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyElasticityMultiplier,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%d", GenElasticityMultiplier(r))
+			},
+		),
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the next block following EIP-1559: it
+// increases when the gas used by the last block is above the gas the blocks
+// target, the max gas of the blocks divided by the elasticity multiplier, and
+// decreases when it is below, by up to 1 / BaseFeeChangeDenominator of the
+// base fee, but not below MinBaseFee. It increases by at least the smallest
+// decimal, so that a small base fee does not round to the same one, and does
+// not change when the blocks have no max gas.
+func (p Params) NextBaseFee(baseFee sdk.Dec, blockGasUsed uint64, maxBlockGas int64) sdk.Dec {
+	if maxBlockGas <= 0 {
+		return sdk.MaxDec(baseFee, p.MinBaseFee)
+	}
+
+	target := sdk.NewInt(maxBlockGas / int64(p.ElasticityMultiplier))
+	if !target.IsPositive() {
+		return sdk.MaxDec(baseFee, p.MinBaseFee)
+	}
+
+	used := sdk.NewIntFromUint64(blockGasUsed)
+	delta := baseFee.MulInt(used.Sub(target).Abs()).QuoInt(target).QuoInt64(int64(p.BaseFeeChangeDenominator))
+	switch {
+	case used.GT(target):
+		baseFee = baseFee.Add(sdk.MaxDec(delta, sdk.SmallestDec()))
+	case used.LT(target):
+		baseFee = baseFee.Sub(delta)
+	}
+
+	return sdk.MaxDec(baseFee, p.MinBaseFee)
+}
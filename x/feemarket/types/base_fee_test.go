@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.NewDecWithPrec(1, 2)
+	baseFee := sdk.NewDec(1)
+
+	tests := []struct {
+		name        string
+		baseFee     sdk.Dec
+		gasUsed     uint64
+		maxGas      int64
+		expectedFee sdk.Dec
+	}{
+		{"no max gas", baseFee, 1000, -1, baseFee},
+		{"at target", baseFee, 500, 1000, baseFee},
+		{"full block", baseFee, 1000, 1000, sdk.NewDecWithPrec(1125, 3)},
+		{"above target", baseFee, 750, 1000, sdk.NewDecWithPrec(10625, 4)},
+		{"empty block", baseFee, 0, 1000, sdk.NewDecWithPrec(875, 3)},
+		{"below target", baseFee, 250, 1000, sdk.NewDecWithPrec(9375, 4)},
+		{"min base fee", sdk.NewDecWithPrec(1, 2), 0, 1000, sdk.NewDecWithPrec(1, 2)},
+		{"below min base fee", sdk.ZeroDec(), 1000, -1, sdk.NewDecWithPrec(1, 2)},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.expectedFee, params.NextBaseFee(tc.baseFee, tc.gasUsed, tc.maxGas), tc.name)
+	}
+
+	// the smallest base fee, whose delta rounds to zero, still increases
+	params.MinBaseFee = sdk.SmallestDec()
+	require.Equal(t, sdk.SmallestDec().MulInt64(2), params.NextBaseFee(sdk.SmallestDec(), 1000, 1000))
+}
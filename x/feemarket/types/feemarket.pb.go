@@ -0,0 +1,498 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/feemarket/v1beta1/feemarket.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params holds parameters for the feemarket module.
+type Params struct {
+	// enabled makes the transactions pay at least the base fee for their gas,
+	// the base fee being tracked either way
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// denom of the base fee
+	BaseFeeDenom string `protobuf:"bytes,2,opt,name=base_fee_denom,json=baseFeeDenom,proto3" json:"base_fee_denom,omitempty" yaml:"base_fee_denom"`
+	// bounds the change of the base fee between two blocks, to 1 /
+	// base_fee_change_denominator of it
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,3,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty" yaml:"base_fee_change_denominator"`
+	// divides the max gas of the blocks into the gas the blocks target, above
+	// which the base fee increases and below which it decreases
+	ElasticityMultiplier uint32 `protobuf:"varint,4,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty" yaml:"elasticity_multiplier"`
+	// minimum base fee per unit of gas
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3047acb548fa7c8, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeDenom() string {
+	if m != nil {
+		return m.BaseFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "cosmos.feemarket.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/feemarket/v1beta1/feemarket.proto", fileDescriptor_f3047acb548fa7c8)
+}
+
+var fileDescriptor_f3047acb548fa7c8 = []byte{
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xbd, 0x6a, 0xe3, 0x40,
+	0x14, 0x85, 0xa5, 0xb5, 0xd7, 0xbb, 0x3b, 0x78, 0xb7, 0xd0, 0xda, 0x30, 0xfb, 0x83, 0x24, 0x54,
+	0x18, 0x35, 0x2b, 0x61, 0xb6, 0x73, 0xb3, 0xa0, 0x75, 0x42, 0x9a, 0x40, 0x10, 0xa4, 0x49, 0x23,
+	0x46, 0xf2, 0xb5, 0x3c, 0x58, 0xa3, 0x31, 0xd2, 0x38, 0xc4, 0x6f, 0xe1, 0x32, 0x65, 0x1e, 0xc7,
+	0xa5, 0xcb, 0x90, 0x42, 0x04, 0xfb, 0x0d, 0xf4, 0x04, 0xc1, 0x92, 0x6c, 0x39, 0x3f, 0xa4, 0x9a,
+	0xb9, 0xe7, 0x7e, 0x9c, 0x33, 0x97, 0x3b, 0xc8, 0x0c, 0x78, 0xca, 0x78, 0x6a, 0x8f, 0x01, 0x18,
+	0x49, 0xa6, 0x20, 0xec, 0xeb, 0xbe, 0x0f, 0x82, 0xf4, 0x6b, 0xc5, 0x9a, 0x25, 0x5c, 0x70, 0x05,
+	0x97, 0xa4, 0x55, 0xeb, 0x15, 0xf9, 0xb3, 0x13, 0xf2, 0x90, 0x17, 0x90, 0xbd, 0xbb, 0x95, 0xbc,
+	0xb1, 0x6c, 0xa0, 0xd6, 0x05, 0x49, 0x08, 0x4b, 0x15, 0x8c, 0x3e, 0x41, 0x4c, 0xfc, 0x08, 0x46,
+	0x58, 0xd6, 0x65, 0xf3, 0xb3, 0xbb, 0x2f, 0x95, 0x7f, 0xe8, 0x9b, 0x4f, 0x52, 0xf0, 0xc6, 0x00,
+	0xde, 0x08, 0x62, 0xce, 0xf0, 0x07, 0x5d, 0x36, 0xbf, 0x38, 0x3f, 0xf2, 0x4c, 0xeb, 0x2e, 0x08,
+	0x8b, 0x06, 0xc6, 0xf3, 0xbe, 0xe1, 0xb6, 0x77, 0xc2, 0x29, 0xc0, 0x70, 0x57, 0x2a, 0x80, 0x7e,
+	0x1d, 0x80, 0x60, 0x42, 0xe2, 0xb0, 0xe2, 0x68, 0x4c, 0x04, 0x4f, 0x70, 0x43, 0x97, 0xcd, 0xaf,
+	0x4e, 0x2f, 0xcf, 0x34, 0xe3, 0x85, 0xdb, 0x6b, 0xd8, 0x70, 0x71, 0x65, 0xfd, 0xbf, 0xe8, 0x0d,
+	0xeb, 0x96, 0x72, 0x89, 0xba, 0x10, 0x91, 0x54, 0xd0, 0x80, 0x8a, 0x85, 0xc7, 0xe6, 0x91, 0xa0,
+	0xb3, 0x88, 0x42, 0x82, 0x9b, 0x45, 0x80, 0x9e, 0x67, 0xda, 0xef, 0x32, 0xe0, 0x4d, 0xcc, 0x70,
+	0x3b, 0xb5, 0x7e, 0x7e, 0x90, 0x95, 0x10, 0xb5, 0x19, 0x8d, 0xbd, 0xfd, 0xa3, 0xf0, 0xc7, 0x62,
+	0xf8, 0x93, 0x55, 0xa6, 0x49, 0x0f, 0x99, 0xd6, 0x0b, 0xa9, 0x98, 0xcc, 0x7d, 0x2b, 0xe0, 0xcc,
+	0xae, 0xd6, 0x54, 0x1e, 0x7f, 0xd2, 0xd1, 0xd4, 0x16, 0x8b, 0x19, 0xa4, 0xd6, 0x10, 0x82, 0x3c,
+	0xd3, 0xbe, 0x97, 0xd9, 0xc7, 0x5e, 0x86, 0x8b, 0x18, 0x8d, 0x9d, 0x72, 0xa0, 0x41, 0xf3, 0xf6,
+	0x4e, 0x93, 0x9c, 0xb3, 0xd5, 0x46, 0x95, 0xd7, 0x1b, 0x55, 0x7e, 0xdc, 0xa8, 0xf2, 0x72, 0xab,
+	0x4a, 0xeb, 0xad, 0x2a, 0xdd, 0x6f, 0x55, 0xe9, 0xca, 0x7a, 0x37, 0xea, 0xe6, 0xe8, 0x7b, 0x14,
+	0xb1, 0x7e, 0xab, 0xd8, 0xf1, 0xdf, 0xa7, 0x01, 0x00, 0xb9, 0xf6, 0xc4, 0xf1, 0x3f, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BaseFeeDenom) > 0 {
+		i -= len(m.BaseFeeDenom)
+		copy(dAtA[i:], m.BaseFeeDenom)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.BaseFeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.BaseFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeemarket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeemarket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeemarket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeemarket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeemarket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeemarket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeemarket = fmt.Errorf("proto: unexpected end of group")
+)
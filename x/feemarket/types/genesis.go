@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.Dec, blockGasUsed uint64) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		BaseFee:      baseFee,
+		BlockGasUsed: blockGasUsed,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, the base fee starting
+// at its minimum.
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseFee, 0)
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() || gs.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", gs.BaseFee)
+	}
+
+	return nil
+}
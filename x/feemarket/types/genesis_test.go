@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	require.NoError(t, types.DefaultGenesisState().Validate())
+
+	params := types.DefaultParams()
+	tests := []struct {
+		name    string
+		genesis *types.GenesisState
+	}{
+		{"blank denom", types.NewGenesisState(types.NewParams(true, " ", 8, 2, sdk.OneDec()), sdk.ZeroDec(), 0)},
+		{"zero change denominator", types.NewGenesisState(types.NewParams(true, "stake", 0, 2, sdk.OneDec()), sdk.ZeroDec(), 0)},
+		{"zero elasticity multiplier", types.NewGenesisState(types.NewParams(true, "stake", 8, 0, sdk.OneDec()), sdk.ZeroDec(), 0)},
+		{"negative min base fee", types.NewGenesisState(types.NewParams(true, "stake", 8, 2, sdk.NewDec(-1)), sdk.ZeroDec(), 0)},
+		{"zero min base fee", types.NewGenesisState(types.NewParams(true, "stake", 8, 2, sdk.ZeroDec()), sdk.ZeroDec(), 0)},
+		{"negative base fee", types.NewGenesisState(params, sdk.NewDec(-1), 0)},
+		{"nil base fee", types.NewGenesisState(params, sdk.Dec{}, 0)},
+	}
+	for _, tc := range tests {
+		require.Error(t, tc.genesis.Validate(), tc.name)
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	// BaseFeeKey is the key of the current base fee.
+	BaseFeeKey = []byte{0x01}
+
+	// BlockGasUsedKey is the key of the gas used by the last block.
+	BlockGasUsedKey = []byte{0x02}
+)
+
+// KeyFormats are the layouts of the keys of the feemarket store.
+var KeyFormats = []keyformat.KeyFormat{
+	{Name: "base_fee", Prefix: BaseFeeKey, Value: keyformat.ProtoValue(&sdk.DecProto{})},
+	{Name: "block_gas_used", Prefix: BlockGasUsedKey, Value: keyformat.SegmentValue(keyformat.Uint64("gas"))},
+}
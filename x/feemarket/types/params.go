@@ -0,0 +1,147 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                  = []byte("Enabled")
+	KeyBaseFeeDenom             = []byte("BaseFeeDenom")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+)
+
+// ParamKeyTable returns the key table of the feemarket params.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object.
+func NewParams(
+	enabled bool, baseFeeDenom string, baseFeeChangeDenominator, elasticityMultiplier uint32, minBaseFee sdk.Dec,
+) Params {
+	return Params{
+		Enabled:                  enabled,
+		BaseFeeDenom:             baseFeeDenom,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		ElasticityMultiplier:     elasticityMultiplier,
+		MinBaseFee:               minBaseFee,
+	}
+}
+
+// DefaultParams returns the default feemarket parameters, those of EIP-1559.
+// The base fee is tracked but not enforced until enabled.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  false,
+		BaseFeeDenom:             sdk.DefaultBondDenom,
+		BaseFeeChangeDenominator: 8,
+		ElasticityMultiplier:     2,
+		MinBaseFee:               sdk.NewDecWithPrec(25, 4),
+	}
+}
+
+// Validate validates the params.
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateBaseFeeDenom(p.BaseFeeDenom); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+
+	return validateMinBaseFee(p.MinBaseFee)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyBaseFeeDenom, &p.BaseFeeDenom, validateBaseFeeDenom),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return errors.New("base fee denom cannot be blank")
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base fee change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("elasticity multiplier must be positive")
+	}
+
+	return nil
+}
+
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a base fee of zero would never increase again
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", v)
+	}
+
+	return nil
+}
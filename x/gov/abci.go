@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // EndBlocker called every block, process inflation, update validator set.
@@ -31,6 +32,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
 			),
 		)
+		telemetry.KeeperIncrCounter(types.ModuleName, types.AttributeValueProposalDropped, 1)
 
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
@@ -43,48 +45,76 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		return false
 	})
 
+	// execute queued proposals whose execution delay has elapsed
+	keeper.IterateExecutionQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
+		var tagValue, logMsg string
+
+		// another proposal of the exclusion group was executed during the
+		// execution delay of the proposal
+		if keeper.IsExcluded(ctx, proposal) {
+			proposal.Status = types.StatusRejected
+			tagValue = types.AttributeValueProposalRejected
+			logMsg = fmt.Sprintf("rejected, a proposal of exclusion group %s passed within the exclusion window", proposal.ExclusionGroup)
+		} else {
+			tagValue, logMsg = executeProposal(ctx, keeper, &proposal)
+		}
+
+		keeper.SetProposal(ctx, proposal)
+		keeper.RemoveFromExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+
+		logger.Info(
+			"queued proposal executed",
+			"proposal", proposal.ProposalId,
+			"title", proposal.GetTitle(),
+			"result", logMsg,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQueuedProposal,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
+			),
+		)
+		telemetry.KeeperIncrCounter(types.ModuleName, tagValue, 1)
+		return false
+	})
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// another proposal of the exclusion group passed within the exclusion
+		// window, so that the proposal is rejected despite its tally
+		excluded := passes && keeper.IsExcluded(ctx, proposal)
+
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalId)
 		} else {
 			keeper.RefundDeposits(ctx, proposal.ProposalId)
 		}
 
-		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
-			cacheCtx, writeCache := ctx.CacheContext()
-
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
-			if err == nil {
-				proposal.Status = types.StatusPassed
-				tagValue = types.AttributeValueProposalPassed
-				logMsg = "passed"
-
-				// The cached context is created with a new EventManager. However, since
-				// the proposal handler execution was successful, we want to track/keep
-				// any events emitted, so we re-emit to "merge" the events into the
-				// original Context's EventManager.
-				ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
-
-				// write state to the underlying multi-store
-				writeCache()
+		if passes && !excluded {
+			if delay := keeper.GetTallyParams(ctx).ExecutionDelay(proposal.ProposalType()); delay > 0 {
+				// the proposal waits in the execution queue, during which the
+				// veto authority can still veto it
+				proposal.Status = types.StatusQueued
+				proposal.ExecutionTime = ctx.BlockHeader().Time.Add(delay)
+				keeper.InsertExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+				tagValue = types.AttributeValueProposalQueued
+				logMsg = fmt.Sprintf("passed, queued for execution at %s", proposal.ExecutionTime)
 			} else {
-				proposal.Status = types.StatusFailed
-				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but failed on execution: %s", err)
+				tagValue, logMsg = executeProposal(ctx, keeper, &proposal)
 			}
 		} else {
 			proposal.Status = types.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
+			if excluded {
+				logMsg = fmt.Sprintf("rejected, a proposal of exclusion group %s passed within the exclusion window", proposal.ExclusionGroup)
+			}
 		}
 
 		proposal.FinalTallyResult = tallyResults
@@ -109,6 +139,42 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				sdk.NewAttribute(types.AttributeKeyProposalResult, tagValue),
 			),
 		)
+		telemetry.KeeperIncrCounter(types.ModuleName, tagValue, 1)
 		return false
 	})
 }
+
+// executeProposal runs the handler of a passed proposal and sets its status to
+// passed or failed depending on the outcome, starting the exclusion window of
+// its exclusion group if it passed. It returns the event tag value and the log
+// message of the outcome.
+func executeProposal(ctx sdk.Context, keeper keeper.Keeper, proposal *types.Proposal) (tagValue, logMsg string) {
+	handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// The proposal handler may execute state mutating logic depending
+	// on the proposal content. If the handler fails, no state mutation
+	// is written and the error message is logged. The parameters changed
+	// by the handler are recorded as changed by the proposal.
+	err := handler(paramtypes.ContextWithProposalID(cacheCtx, proposal.ProposalId), proposal.GetContent())
+	if err != nil {
+		proposal.Status = types.StatusFailed
+		return types.AttributeValueProposalFailed, fmt.Sprintf("passed, but failed on execution: %s", err)
+	}
+
+	proposal.Status = types.StatusPassed
+	if proposal.ExclusionGroup != "" {
+		keeper.SetExclusionGroupPassTime(ctx, proposal.ExclusionGroup, ctx.BlockHeader().Time)
+	}
+
+	// The cached context is created with a new EventManager. However, since
+	// the proposal handler execution was successful, we want to track/keep
+	// any events emitted, so we re-emit to "merge" the events into the
+	// original Context's EventManager.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	// write state to the underlying multi-store
+	writeCache()
+
+	return types.AttributeValueProposalPassed, "passed"
+}
@@ -11,8 +11,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -362,3 +364,277 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerExclusionGroup(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.ExclusionWindow = time.Hour
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	votingPeriod := app.GovKeeper.GetVotingParams(ctx).VotingPeriod
+	submitAndVote := func(exclusionGroup string) uint64 {
+		proposal, err := app.GovKeeper.SubmitProposalWithExclusionGroup(ctx, TestProposal, exclusionGroup)
+		require.NoError(t, err)
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+		handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+		err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+		require.NoError(t, err)
+
+		return proposal.ProposalId
+	}
+	requireStatus := func(proposalID uint64, status types.ProposalStatus) {
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, status, proposal.Status)
+	}
+
+	first := submitAndVote("staking/MaxValidators")
+	second := submitAndVote("staking/MaxValidators")
+	other := submitAndVote("")
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(votingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// only the first proposal of the group passes
+	requireStatus(first, types.StatusPassed)
+	requireStatus(second, types.StatusRejected)
+	requireStatus(other, types.StatusPassed)
+
+	passTime, ok := app.GovKeeper.GetExclusionGroupPassTime(ctx, "staking/MaxValidators")
+	require.True(t, ok)
+	require.Equal(t, ctx.BlockHeader().Time, passTime)
+
+	// the proposals of the group pass again once the exclusion window is over
+	third := submitAndVote("staking/MaxValidators")
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(votingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+	requireStatus(third, types.StatusPassed)
+}
+
+func TestEndBlockerExecutionDelay(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.ExecutionDelays = []types.ExecutionDelay{{ProposalType: types.ProposalTypeText, Delay: time.Hour}}
+	tallyParams.VetoAuthority = addrs[1].String()
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	votingPeriod := app.GovKeeper.GetVotingParams(ctx).VotingPeriod
+	submitAndVote := func() uint64 {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+		require.NoError(t, err)
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+		handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+		err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+		require.NoError(t, err)
+
+		return proposal.ProposalId
+	}
+	requireStatus := func(proposalID uint64, status types.ProposalStatus) {
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, status, proposal.Status)
+	}
+
+	executed := submitAndVote()
+	vetoed := submitAndVote()
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(votingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the passed proposals wait in the execution queue
+	requireStatus(executed, types.StatusQueued)
+	requireStatus(vetoed, types.StatusQueued)
+
+	res, err := app.GovKeeper.ExecutionQueue(sdk.WrapSDKContext(ctx), &types.QueryExecutionQueueRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Proposals, 2)
+	require.Equal(t, ctx.BlockHeader().Time.Add(time.Hour), res.Proposals[0].ExecutionTime)
+
+	// only the veto authority can veto
+	handler := gov.NewHandler(app.GovKeeper)
+	_, err = handler(ctx, types.NewMsgVetoProposal(addrs[0], vetoed))
+	require.ErrorIs(t, err, types.ErrUnauthorizedVeto)
+	_, err = handler(ctx, types.NewMsgVetoProposal(addrs[1], vetoed))
+	require.NoError(t, err)
+	requireStatus(vetoed, types.StatusVetoed)
+
+	// a vetoed proposal cannot be vetoed again
+	_, err = handler(ctx, types.NewMsgVetoProposal(addrs[1], vetoed))
+	require.ErrorIs(t, err, types.ErrNotQueuedProposal)
+
+	// the proposal is not executed before its execution time
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour - time.Second))
+	gov.EndBlocker(ctx, app.GovKeeper)
+	requireStatus(executed, types.StatusQueued)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Second))
+	gov.EndBlocker(ctx, app.GovKeeper)
+	requireStatus(executed, types.StatusPassed)
+	requireStatus(vetoed, types.StatusVetoed)
+
+	res, err = app.GovKeeper.ExecutionQueue(sdk.WrapSDKContext(ctx), &types.QueryExecutionQueueRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Proposals)
+}
+
+func TestEndBlockerMsgExecutionProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	bankParams := app.BankKeeper.GetParams(ctx)
+	bankParams.DefaultSendEnabled = false
+	mintParams := app.MintKeeper.GetParams(ctx)
+	mintParams.BlocksPerYear = 1000
+
+	handler := gov.NewHandler(app.GovKeeper)
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	submit := func(msgs ...sdk.Msg) (uint64, error) {
+		content, err := types.NewMsgExecutionProposal("test", "test", msgs)
+		require.NoError(t, err)
+		msg, err := types.NewMsgSubmitProposal(content, proposalCoins, addrs[0])
+		require.NoError(t, err)
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return 0, err
+		}
+
+		var resp types.MsgSubmitProposalResponse
+		require.NoError(t, proto.Unmarshal(res.Data, &resp))
+		return resp.ProposalId, nil
+	}
+
+	// the Msgs not registered for execution through governance are rejected
+	_, err := submit(banktypes.NewMsgSend(govAddr, addrs[0], proposalCoins))
+	require.ErrorIs(t, err, types.ErrInvalidProposalContent)
+
+	// as well as the Msgs not signed by their authority, or with invalid params
+	_, err = submit(banktypes.NewMsgUpdateParams(addrs[0], bankParams))
+	require.ErrorIs(t, err, types.ErrInvalidProposalContent)
+	invalidParams := mintParams
+	invalidParams.BlocksPerYear = 0
+	_, err = submit(minttypes.NewMsgUpdateParams(govAddr, invalidParams))
+	require.ErrorIs(t, err, types.ErrInvalidProposalContent)
+
+	proposalID, err := submit(
+		banktypes.NewMsgUpdateParams(govAddr, bankParams),
+		minttypes.NewMsgUpdateParams(govAddr, mintParams),
+	)
+	require.NoError(t, err)
+
+	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	require.NoError(t, err)
+
+	// the params are only updated once the proposal is executed
+	require.True(t, app.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, bankParams, app.BankKeeper.GetParams(ctx))
+	require.Equal(t, mintParams, app.MintKeeper.GetParams(ctx))
+}
+
+func TestEndBlockerExecutionDelayExclusionGroup(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.ExclusionWindow = time.Hour
+	tallyParams.ExecutionDelays = []types.ExecutionDelay{{ProposalType: types.ProposalTypeText, Delay: time.Hour}}
+	tallyParams.VetoAuthority = addrs[1].String()
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	const exclusionGroup = "staking/MaxValidators"
+	votingPeriod := app.GovKeeper.GetVotingParams(ctx).VotingPeriod
+	submitAndVote := func() uint64 {
+		proposal, err := app.GovKeeper.SubmitProposalWithExclusionGroup(ctx, TestProposal, exclusionGroup)
+		require.NoError(t, err)
+
+		proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+		handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+		err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+		require.NoError(t, err)
+
+		return proposal.ProposalId
+	}
+	requireStatus := func(proposalID uint64, status types.ProposalStatus) {
+		proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+		require.True(t, ok)
+		require.Equal(t, status, proposal.Status)
+	}
+
+	vetoed := submitAndVote()
+	executed := submitAndVote()
+	excluded := submitAndVote()
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(votingPeriod))
+	gov.EndBlocker(ctx, app.GovKeeper)
+	requireStatus(vetoed, types.StatusQueued)
+	requireStatus(executed, types.StatusQueued)
+	requireStatus(excluded, types.StatusQueued)
+
+	// the queued proposals do not start the exclusion window, nor does the
+	// vetoed one
+	_, err := gov.NewHandler(app.GovKeeper)(ctx, types.NewMsgVetoProposal(addrs[1], vetoed))
+	require.NoError(t, err)
+	_, ok := app.GovKeeper.GetExclusionGroupPassTime(ctx, exclusionGroup)
+	require.False(t, ok)
+
+	// the first executed proposal of the group starts the exclusion window,
+	// rejecting the other ones
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	gov.EndBlocker(ctx, app.GovKeeper)
+	requireStatus(vetoed, types.StatusVetoed)
+	requireStatus(executed, types.StatusPassed)
+	requireStatus(excluded, types.StatusRejected)
+
+	passTime, ok := app.GovKeeper.GetExclusionGroupPassTime(ctx, exclusionGroup)
+	require.True(t, ok)
+	require.Equal(t, ctx.BlockHeader().Time, passTime)
+}
@@ -37,6 +37,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryMsgRoutes(),
+		GetCmdQueryExecutionQueue(),
 	)
 
 	return govQueryCmd
@@ -607,6 +609,86 @@ $ %s query gov params
 	return cmd
 }
 
+// GetCmdQueryMsgRoutes implements the query msg-routes command.
+func GetCmdQueryMsgRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-routes",
+		Short: "Query the Msgs registered for execution through governance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the Msgs that modules registered for execution through governance,
+along with the module and the authority address each of them requires as signer.
+
+Example:
+$ %s query gov msg-routes
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MsgRoutes(cmd.Context(), &types.QueryMsgRoutesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryExecutionQueue implements the query execution-queue command.
+func GetCmdQueryExecutionQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-queue",
+		Short: "Query the proposals queued for execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the passed proposals that wait for their execution delay,
+ordered by execution time.
+
+Example:
+$ %s query gov execution-queue
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExecutionQueue(cmd.Context(), &types.QueryExecutionQueueRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "execution-queue")
+
+	return cmd
+}
+
 // GetCmdQueryParam implements the query param command.
 func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
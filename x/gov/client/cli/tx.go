@@ -26,6 +26,9 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+
+	// FlagExclusionGroup is the exclusion group of a submitted proposal
+	FlagExclusionGroup = "exclusion-group"
 )
 
 type proposal struct {
@@ -69,6 +72,7 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 		NewCmdDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdVeto(),
 		cmdSubmitProp,
 	)
 
@@ -126,6 +130,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return fmt.Errorf("invalid message: %w", err)
 			}
 
+			exclusionGroup, _ := cmd.Flags().GetString(FlagExclusionGroup)
+			msg.SetExclusionGroup(exclusionGroup)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -135,6 +142,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(FlagProposalType, "", "The proposal Type")
 	cmd.Flags().String(FlagDeposit, "", "The proposal deposit")
 	cmd.Flags().String(FlagProposal, "", "Proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().String(FlagExclusionGroup, "", "The exclusion group of the proposal, of which at most one proposal can pass within the exclusion window")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -288,3 +296,43 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdVeto implements vetoing a proposal queued for execution.
+func NewCmdVeto() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Veto a proposal queued for execution",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Veto a passed proposal during its execution delay. Only the
+veto authority of the tally params can veto. You can find the queued proposals
+by running "%s query gov execution-queue".
+
+Example:
+$ %s tx gov veto 1 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgVetoProposal(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -123,6 +123,15 @@ voting_params:
 	}
 }
 
+func (s *IntegrationTestSuite) TestCmdMsgRoutes() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetCmdQueryMsgRoutes()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().Equal(`{"msg_routes":[]}`, strings.TrimSpace(out.String()))
+}
+
 func (s *IntegrationTestSuite) TestCmdParam() {
 	val := s.network.Validators[0]
 
@@ -62,6 +62,10 @@ func NormalizeProposalStatus(status string) string {
 		return types.StatusPassed.String()
 	case "Rejected", "rejected":
 		return types.StatusRejected.String()
+	case "Queued", "queued":
+		return types.StatusQueued.String()
+	case "Vetoed", "vetoed":
+		return types.StatusVetoed.String()
 	default:
 		return status
 	}
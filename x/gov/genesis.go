@@ -31,12 +31,18 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		k.SetVote(ctx, vote)
 	}
 
+	for _, passTime := range data.ExclusionGroupPassTimes {
+		k.SetExclusionGroupPassTime(ctx, passTime.ExclusionGroup, passTime.PassTime)
+	}
+
 	for _, proposal := range data.Proposals {
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
 		case types.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		case types.StatusQueued:
+			k.InsertExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
 		}
 		k.SetProposal(ctx, proposal)
 	}
@@ -72,12 +78,13 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		DepositParams:      depositParams,
-		VotingParams:       votingParams,
-		TallyParams:        tallyParams,
+		StartingProposalId:      startingProposalID,
+		Deposits:                proposalsDeposits,
+		Votes:                   proposalsVotes,
+		Proposals:               proposals,
+		DepositParams:           depositParams,
+		VotingParams:            votingParams,
+		TallyParams:             tallyParams,
+		ExclusionGroupPassTimes: k.GetExclusionGroupPassTimes(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package gov_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -112,6 +113,34 @@ func TestImportExportQueues(t *testing.T) {
 	require.True(t, proposal2.Status == types.StatusRejected)
 }
 
+func TestImportExportExclusionGroupPassTimes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	passTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	app.GovKeeper.SetExclusionGroupPassTime(ctx, "upgrades", passTime)
+	app.GovKeeper.SetExclusionGroupPassTime(ctx, "community-pool", passTime.Add(time.Hour))
+
+	// the pass times are exported, and imported into a new app
+	bz := app.AppCodec().MustMarshalJSON(gov.ExportGenesis(ctx, app.GovKeeper))
+	var genState types.GenesisState
+	app.AppCodec().MustUnmarshalJSON(bz, &genState)
+	require.NoError(t, types.ValidateGenesis(&genState))
+	require.Equal(t, []types.ExclusionGroupPassTime{
+		{ExclusionGroup: "community-pool", PassTime: passTime.Add(time.Hour)},
+		{ExclusionGroup: "upgrades", PassTime: passTime},
+	}, genState.ExclusionGroupPassTimes)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	gov.InitGenesis(ctx2, app2.AccountKeeper, app2.BankKeeper, app2.GovKeeper, &genState)
+
+	importedTime, ok := app2.GovKeeper.GetExclusionGroupPassTime(ctx2, "upgrades")
+	require.True(t, ok)
+	require.Equal(t, passTime, importedTime)
+	require.True(t, genState.Equal(*gov.ExportGenesis(ctx2, app2.GovKeeper)))
+}
+
 func TestImportExportQueues_ErrorUnconsistentState(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// GetExclusionGroupPassTime returns the last time a proposal of the exclusion
+// group passed, if any did.
+func (keeper Keeper) GetExclusionGroupPassTime(ctx sdk.Context, exclusionGroup string) (time.Time, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	bz := store.Get(types.ExclusionGroupKey(exclusionGroup))
+	if bz == nil {
+		return time.Time{}, false
+	}
+
+	passTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return passTime, true
+}
+
+// SetExclusionGroupPassTime sets the last time a proposal of the exclusion
+// group passed.
+func (keeper Keeper) SetExclusionGroupPassTime(ctx sdk.Context, exclusionGroup string, passTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ExclusionGroupKey(exclusionGroup), sdk.FormatTimeBytes(passTime))
+}
+
+// IterateExclusionGroupPassTimes iterates over the last times a proposal of
+// the exclusion groups passed, sorted by exclusion group, and performs a
+// callback function.
+func (keeper Keeper) IterateExclusionGroupPassTimes(ctx sdk.Context, cb func(passTime types.ExclusionGroupPassTime) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ExclusionGroupsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		passTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		exclusionGroup := string(iterator.Key()[len(types.ExclusionGroupsKeyPrefix):])
+		if cb(types.ExclusionGroupPassTime{ExclusionGroup: exclusionGroup, PassTime: passTime}) {
+			break
+		}
+	}
+}
+
+// GetExclusionGroupPassTimes returns the last times a proposal of the
+// exclusion groups passed, sorted by exclusion group.
+func (keeper Keeper) GetExclusionGroupPassTimes(ctx sdk.Context) (passTimes []types.ExclusionGroupPassTime) {
+	keeper.IterateExclusionGroupPassTimes(ctx, func(passTime types.ExclusionGroupPassTime) bool {
+		passTimes = append(passTimes, passTime)
+		return false
+	})
+
+	return passTimes
+}
+
+// IsExcluded returns true if another proposal of the exclusion group of the
+// proposal passed within the exclusion window, in which case the proposal
+// cannot pass.
+func (keeper Keeper) IsExcluded(ctx sdk.Context, proposal types.Proposal) bool {
+	if proposal.ExclusionGroup == "" {
+		return false
+	}
+
+	passTime, ok := keeper.GetExclusionGroupPassTime(ctx, proposal.ExclusionGroup)
+	if !ok {
+		return false
+	}
+
+	exclusionWindow := keeper.GetTallyParams(ctx).ExclusionWindow
+	return ctx.BlockHeader().Time.Before(passTime.Add(exclusionWindow))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -267,7 +268,8 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 	case proposal.Status == types.StatusDepositPeriod:
 		tallyResult = types.EmptyTallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
+	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected ||
+		proposal.Status == types.StatusQueued || proposal.Status == types.StatusVetoed:
 		tallyResult = proposal.FinalTallyResult
 
 	default:
@@ -277,3 +279,43 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// MsgRoutes returns the Msgs registered by modules for execution through
+// governance
+func (q Keeper) MsgRoutes(c context.Context, req *types.QueryMsgRoutesRequest) (*types.QueryMsgRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryMsgRoutesResponse{MsgRoutes: q.GetMsgRoutes()}, nil
+}
+
+// ExecutionQueue returns the proposals queued for execution, ordered by
+// execution time
+func (q Keeper) ExecutionQueue(c context.Context, req *types.QueryExecutionQueueRequest) (*types.QueryExecutionQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var proposals types.Proposals
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	queueStore := prefix.NewStore(store, types.ExecutionQueuePrefix)
+
+	pageRes, err := query.Paginate(queueStore, req.Pagination, func(key []byte, value []byte) error {
+		proposal, ok := q.GetProposal(ctx, types.GetProposalIDFromBytes(value))
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", types.GetProposalIDFromBytes(value))
+		}
+
+		proposals = append(proposals, proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExecutionQueueResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
@@ -816,3 +816,18 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryMsgRoutes() {
+	res, err := suite.queryClient.MsgRoutes(gocontext.Background(), &types.QueryMsgRoutesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.MsgRoutes, 10)
+
+	modules := make(map[string]int)
+	for _, route := range res.MsgRoutes {
+		modules[route.Module]++
+		suite.Require().Equal(suite.app.AccountKeeper.GetModuleAddress(types.ModuleName).String(), route.Authority)
+	}
+	suite.Require().Equal(map[string]int{
+		"auth": 1, "bank": 1, "staking": 1, "mint": 1, "distribution": 1, "slashing": 1, "feemarket": 1, "circuit": 3,
+	}, modules)
+}
@@ -34,6 +34,9 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// Msgs registered by modules for execution through governance
+	msgRoutes types.MsgRouteRegistry
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -123,6 +126,19 @@ func (keeper Keeper) RemoveFromInactiveProposalQueue(ctx sdk.Context, proposalID
 	store.Delete(types.InactiveProposalQueueKey(proposalID, endTime))
 }
 
+// InsertExecutionQueue inserts a ProposalID into the execution queue at executionTime
+func (keeper Keeper) InsertExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := types.GetProposalIDBytes(proposalID)
+	store.Set(types.ExecutionQueueKey(proposalID, executionTime), bz)
+}
+
+// RemoveFromExecutionQueue removes a proposalID from the Execution Queue
+func (keeper Keeper) RemoveFromExecutionQueue(ctx sdk.Context, proposalID uint64, executionTime time.Time) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ExecutionQueueKey(proposalID, executionTime))
+}
+
 // Iterators
 
 // IterateActiveProposalsQueue iterates over the proposals in the active proposal queue
@@ -163,6 +179,25 @@ func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time
 	}
 }
 
+// IterateExecutionQueue iterates over the proposals in the execution queue
+// and performs a callback function
+func (keeper Keeper) IterateExecutionQueue(ctx sdk.Context, executionTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
+	iterator := keeper.ExecutionQueueIterator(ctx, executionTime)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitExecutionQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // ActiveProposalQueueIterator returns an sdk.Iterator for all the proposals in the Active Queue that expire by endTime
 func (keeper Keeper) ActiveProposalQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(keeper.storeKey)
@@ -174,3 +209,9 @@ func (keeper Keeper) InactiveProposalQueueIterator(ctx sdk.Context, endTime time
 	store := ctx.KVStore(keeper.storeKey)
 	return store.Iterator(types.InactiveProposalQueuePrefix, sdk.PrefixEndBytes(types.InactiveProposalByTimeKey(endTime)))
 }
+
+// ExecutionQueueIterator returns an sdk.Iterator for all the proposals in the Execution Queue that are due by executionTime
+func (keeper Keeper) ExecutionQueueIterator(ctx sdk.Context, executionTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(keeper.storeKey)
+	return store.Iterator(types.ExecutionQueuePrefix, sdk.PrefixEndBytes(types.ExecutionQueueByTimeKey(executionTime)))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// SetMsgRouteRegistry sets the registry of Msgs that modules intend to be
+// executed through governance. The registry is sealed so that no further routes
+// can be added once the keeper holds it.
+func (keeper *Keeper) SetMsgRouteRegistry(registry types.MsgRouteRegistry) *Keeper {
+	if keeper.msgRoutes != nil {
+		panic("cannot set governance msg route registry twice")
+	}
+
+	registry.Seal()
+	keeper.msgRoutes = registry
+
+	return keeper
+}
+
+// MsgRouteRegistry returns the gov Keeper's MsgRouteRegistry, which is nil if
+// none was set.
+func (keeper Keeper) MsgRouteRegistry() types.MsgRouteRegistry {
+	return keeper.msgRoutes
+}
+
+// GetMsgRoutes returns the registered Msg routes, sorted by Msg type URL.
+func (keeper Keeper) GetMsgRoutes() []types.MsgRoute {
+	if keeper.msgRoutes == nil {
+		return []types.MsgRoute{}
+	}
+
+	return keeper.msgRoutes.MsgRoutes()
+}
+
+// ValidateMsgRoutes checks that every registered Msg route can be executed
+// through governance: the Msg must have a handler in the given router, and
+// its authority must be the governance module account and the only signer of
+// the registered template Msg. It is meant to be called at app startup, once
+// all the Msg services are registered, so that a misconfigured authority fails
+// at boot rather than at proposal execution time.
+func (keeper Keeper) ValidateMsgRoutes(router *baseapp.MsgServiceRouter) error {
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+
+	for _, route := range keeper.GetMsgRoutes() {
+		_, template, _ := keeper.msgRoutes.GetMsgRoute(route.MsgTypeUrl)
+
+		if router.HandlerByTypeURL(route.MsgTypeUrl) == nil {
+			return sdkerrors.Wrapf(types.ErrInvalidMsgRoute, "%s registered by module %s has no Msg service handler", route.MsgTypeUrl, route.Module)
+		}
+
+		authority, err := sdk.AccAddressFromBech32(route.Authority)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidMsgRoute, "%s registered by module %s has an invalid authority: %s", route.MsgTypeUrl, route.Module, err)
+		}
+
+		if !authority.Equals(govAddr) {
+			return sdkerrors.Wrapf(types.ErrInvalidMsgRoute, "%s registered by module %s expects authority %s, but governance executes Msgs as %s", route.MsgTypeUrl, route.Module, authority, govAddr)
+		}
+
+		signers := template.GetSigners()
+		if len(signers) != 1 || !bytes.Equal(signers[0], authority) {
+			return sdkerrors.Wrapf(types.ErrInvalidMsgRoute, "%s registered by module %s must be signed by its authority %s only, got signers %v", route.MsgTypeUrl, route.Module, authority, signers)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestValidateMsgRoutes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	govAddr := authtypes.NewModuleAddress(types.ModuleName)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+
+	newKeeper := func() keeper.Keeper {
+		return keeper.NewKeeper(
+			app.AppCodec(), app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
+			app.AccountKeeper, app.BankKeeper, app.StakingKeeper, types.NewRouter(),
+		)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(registry types.MsgRouteRegistry)
+		expErrMsg string
+	}{
+		{
+			"no routes",
+			func(types.MsgRouteRegistry) {},
+			"",
+		},
+		{
+			"valid route",
+			func(registry types.MsgRouteRegistry) {
+				registry.AddMsgRoute(banktypes.ModuleName, &banktypes.MsgSend{FromAddress: govAddr.String()}, govAddr.String())
+			},
+			"",
+		},
+		{
+			"no msg service handler",
+			func(registry types.MsgRouteRegistry) {
+				registry.AddMsgRoute("testdata", &testdata.TestMsg{Signers: []string{govAddr.String()}}, govAddr.String())
+			},
+			"has no Msg service handler",
+		},
+		{
+			"invalid authority",
+			func(registry types.MsgRouteRegistry) {
+				registry.AddMsgRoute(banktypes.ModuleName, &banktypes.MsgSend{FromAddress: govAddr.String()}, "invalid")
+			},
+			"has an invalid authority",
+		},
+		{
+			"authority is not the governance account",
+			func(registry types.MsgRouteRegistry) {
+				registry.AddMsgRoute(banktypes.ModuleName, &banktypes.MsgSend{FromAddress: addrs[0].String()}, addrs[0].String())
+			},
+			"but governance executes Msgs as",
+		},
+		{
+			"msg not signed by the authority",
+			func(registry types.MsgRouteRegistry) {
+				registry.AddMsgRoute(banktypes.ModuleName, &banktypes.MsgSend{FromAddress: addrs[0].String()}, govAddr.String())
+			},
+			"must be signed by its authority",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			registry := types.NewMsgRouteRegistry()
+			tc.malleate(registry)
+
+			govKeeper := newKeeper()
+			govKeeper.SetMsgRouteRegistry(registry)
+
+			err := govKeeper.ValidateMsgRoutes(app.MsgServiceRouter())
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidMsgRoute)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			}
+		})
+	}
+
+	// a keeper without a registry has no routes to validate
+	govKeeper := newKeeper()
+	require.Empty(t, govKeeper.GetMsgRoutes())
+	require.NoError(t, govKeeper.ValidateMsgRoutes(app.MsgServiceRouter()))
+
+	registry := types.NewMsgRouteRegistry()
+	govKeeper.SetMsgRouteRegistry(registry)
+	require.Panics(t, func() { govKeeper.SetMsgRouteRegistry(types.NewMsgRouteRegistry()) })
+	require.Panics(t, func() {
+		registry.AddMsgRoute(banktypes.ModuleName, &banktypes.MsgSend{FromAddress: govAddr.String()}, govAddr.String())
+	})
+}
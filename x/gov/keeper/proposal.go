@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -11,6 +12,16 @@ import (
 
 // SubmitProposal create new proposal given a content
 func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+	return keeper.SubmitProposalWithExclusionGroup(ctx, content, "")
+}
+
+// SubmitProposalWithExclusionGroup create new proposal given a content, in the
+// given exclusion group
+func (keeper Keeper) SubmitProposalWithExclusionGroup(ctx sdk.Context, content types.Content, exclusionGroup string) (types.Proposal, error) {
+	if err := types.ValidateExclusionGroup(exclusionGroup); err != nil {
+		return types.Proposal{}, err
+	}
+
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -36,6 +47,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if err != nil {
 		return types.Proposal{}, err
 	}
+	proposal.ExclusionGroup = exclusionGroup
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -51,6 +63,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		),
 	)
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "submit_proposal", 1)
+
 	return proposal, nil
 }
 
@@ -194,6 +208,36 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
 }
 
+// VetoProposal vetoes a proposal queued for execution. Only the veto authority
+// of the tally params can veto, and only before the execution time.
+func (keeper Keeper) VetoProposal(ctx sdk.Context, proposalID uint64, authority string) error {
+	vetoAuthority := keeper.GetTallyParams(ctx).VetoAuthority
+	if vetoAuthority == "" || authority != vetoAuthority {
+		return sdkerrors.Wrapf(types.ErrUnauthorizedVeto, "%s is not the veto authority", authority)
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	if proposal.Status != types.StatusQueued {
+		return sdkerrors.Wrapf(types.ErrNotQueuedProposal, "%d", proposalID)
+	}
+
+	proposal.Status = types.StatusVetoed
+	keeper.SetProposal(ctx, proposal)
+	keeper.RemoveFromExecutionQueue(ctx, proposal.ProposalId, proposal.ExecutionTime)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVeto,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		),
+	)
+
+	return nil
+}
+
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
 	if err != nil {
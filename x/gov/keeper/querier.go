@@ -169,7 +169,8 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	case proposal.Status == types.StatusDepositPeriod:
 		tallyResult = types.EmptyTallyResult()
 
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
+	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected ||
+		proposal.Status == types.StatusQueued || proposal.Status == types.StatusVetoed:
 		tallyResult = proposal.FinalTallyResult
 
 	default:
@@ -100,6 +100,7 @@ func TestMigrate(t *testing.T) {
 		"min_deposit": []
 	},
 	"deposits": [],
+	"exclusion_group_pass_times": [],
 	"proposals": [
 		{
 			"content": {
@@ -108,6 +109,8 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_text"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"exclusion_group": "",
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -135,6 +138,8 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_community"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"exclusion_group": "",
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -155,6 +160,8 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_cancel_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"exclusion_group": "",
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -182,6 +189,8 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_software_upgrade"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"exclusion_group": "",
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -209,6 +218,8 @@ func TestMigrate(t *testing.T) {
 				"title": "foo_param_change"
 			},
 			"deposit_end_time": "0001-01-01T00:00:00Z",
+			"exclusion_group": "",
+			"execution_time": "0001-01-01T00:00:00Z",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -225,8 +236,11 @@ func TestMigrate(t *testing.T) {
 	],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"exclusion_window": "0s",
+		"execution_delays": [],
 		"quorum": "0",
 		"threshold": "0",
+		"veto_authority": "",
 		"veto_threshold": "0"
 	},
 	"votes": [],
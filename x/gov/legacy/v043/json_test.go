@@ -53,11 +53,15 @@ func TestMigrateJSON(t *testing.T) {
 		"min_deposit": []
 	},
 	"deposits": [],
+	"exclusion_group_pass_times": [],
 	"proposals": [],
 	"starting_proposal_id": "0",
 	"tally_params": {
+		"exclusion_window": "0s",
+		"execution_delays": [],
 		"quorum": "0",
 		"threshold": "0",
+		"veto_authority": "",
 		"veto_threshold": "0"
 	},
 	"votes": [
@@ -0,0 +1,74 @@
+package gov
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewMsgExecutionProposalHandler creates a governance Handler executing the
+// Msgs of a MsgExecutionProposal through the router. Only the Msgs registered
+// in msgRoutes can be executed, and they must be signed by the authority of
+// their route only.
+func NewMsgExecutionProposalHandler(msgRoutes types.MsgRouteRegistry, router *baseapp.MsgServiceRouter) types.Handler {
+	return func(ctx sdk.Context, content types.Content) error {
+		switch c := content.(type) {
+		case *types.MsgExecutionProposal:
+			return handleMsgExecutionProposal(ctx, msgRoutes, router, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized msg execution proposal content type: %T", c)
+		}
+	}
+}
+
+func handleMsgExecutionProposal(ctx sdk.Context, msgRoutes types.MsgRouteRegistry, router *baseapp.MsgServiceRouter, p *types.MsgExecutionProposal) error {
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		route, _, found := msgRoutes.GetMsgRoute(msgTypeURL)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidMsgRoute, "message %d: %s is not registered for execution through governance", i, msgTypeURL)
+		}
+
+		authority, err := sdk.AccAddressFromBech32(route.Authority)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidMsgRoute, "message %d: %s has an invalid authority: %s", i, msgTypeURL, err)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !bytes.Equal(signers[0], authority) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message %d: %s must be signed by %s only, got signers %v", i, msgTypeURL, authority, signers)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+
+		handler := router.Handler(msg)
+		if handler == nil {
+			return sdkerrors.ErrUnknownRequest.Wrapf("message %d: unrecognized message route: %s", i, msgTypeURL)
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "failed to execute message %d: %s", i, msgTypeURL)
+		}
+
+		// emit the events of the executed Msgs
+		events := make(sdk.Events, len(res.Events))
+		for j, event := range res.Events {
+			events[j] = sdk.Event(event)
+		}
+		ctx.EventManager().EmitEvents(events)
+	}
+
+	return nil
+}
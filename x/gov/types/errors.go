@@ -14,4 +14,8 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 7, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "no handler exists for proposal type")
+	ErrInvalidMsgRoute         = sdkerrors.Register(ModuleName, 10, "invalid governance msg route")
+	ErrInvalidExclusionGroup   = sdkerrors.Register(ModuleName, 11, "invalid exclusion group")
+	ErrUnauthorizedVeto        = sdkerrors.Register(ModuleName, 12, "unauthorized veto")
+	ErrNotQueuedProposal       = sdkerrors.Register(ModuleName, 13, "proposal not queued for execution")
 )
@@ -7,15 +7,19 @@ const (
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeQueuedProposal   = "queued_proposal"
+	EventTypeProposalVeto     = "proposal_veto"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
+	AttributeKeyExecutionTime      = "execution_time"
 	AttributeValueCategory         = "governance"
 	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
 	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
+	AttributeValueProposalQueued   = "proposal_queued"   // met vote quorum, waiting for its execution delay
 	AttributeKeyProposalType       = "proposal_type"
 )
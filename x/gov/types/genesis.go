@@ -34,7 +34,22 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		exclusionGroupPassTimesEqual(data.ExclusionGroupPassTimes, other.ExclusionGroupPassTimes)
+}
+
+func exclusionGroupPassTimesEqual(passTimes, other []ExclusionGroupPassTime) bool {
+	if len(passTimes) != len(other) {
+		return false
+	}
+
+	for i, passTime := range passTimes {
+		if passTime.ExclusionGroup != other[i].ExclusionGroup || !passTime.PassTime.Equal(other[i].PassTime) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // Empty returns true if a GenesisState is empty
@@ -56,11 +71,34 @@ func ValidateGenesis(data *GenesisState) error {
 			veto.String())
 	}
 
+	if data.TallyParams.ExclusionWindow < 0 {
+		return fmt.Errorf("governance exclusion window cannot be negative, is %s",
+			data.TallyParams.ExclusionWindow)
+	}
+
+	if err := validateExecutionParams(data.TallyParams); err != nil {
+		return fmt.Errorf("governance %w", err)
+	}
+
 	if !data.DepositParams.MinDeposit.IsValid() {
 		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
 			data.DepositParams.MinDeposit.String())
 	}
 
+	exclusionGroups := make(map[string]bool, len(data.ExclusionGroupPassTimes))
+	for _, passTime := range data.ExclusionGroupPassTimes {
+		if passTime.ExclusionGroup == "" {
+			return fmt.Errorf("governance exclusion group pass time without exclusion group")
+		}
+		if err := ValidateExclusionGroup(passTime.ExclusionGroup); err != nil {
+			return fmt.Errorf("governance exclusion group %q: %w", passTime.ExclusionGroup, err)
+		}
+		if exclusionGroups[passTime.ExclusionGroup] {
+			return fmt.Errorf("governance exclusion group %s has duplicate pass times", passTime.ExclusionGroup)
+		}
+		exclusionGroups[passTime.ExclusionGroup] = true
+	}
+
 	return nil
 }
 
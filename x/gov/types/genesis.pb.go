@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +43,9 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// exclusion_group_pass_times are the last times a proposal of the exclusion
+	// groups passed.
+	ExclusionGroupPassTimes []ExclusionGroupPassTime `protobuf:"bytes,8,rep,name=exclusion_group_pass_times,json=exclusionGroupPassTimes,proto3" json:"exclusion_group_pass_times" yaml:"exclusion_group_pass_times"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,41 +130,113 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetExclusionGroupPassTimes() []ExclusionGroupPassTime {
+	if m != nil {
+		return m.ExclusionGroupPassTimes
+	}
+	return nil
+}
+
+// ExclusionGroupPassTime is the last time a proposal of an exclusion group
+// passed, the proposals of the group not passing within the exclusion window
+// after it.
+type ExclusionGroupPassTime struct {
+	ExclusionGroup string    `protobuf:"bytes,1,opt,name=exclusion_group,json=exclusionGroup,proto3" json:"exclusion_group,omitempty" yaml:"exclusion_group"`
+	PassTime       time.Time `protobuf:"bytes,2,opt,name=pass_time,json=passTime,proto3,stdtime" json:"pass_time" yaml:"pass_time"`
+}
+
+func (m *ExclusionGroupPassTime) Reset()         { *m = ExclusionGroupPassTime{} }
+func (m *ExclusionGroupPassTime) String() string { return proto.CompactTextString(m) }
+func (*ExclusionGroupPassTime) ProtoMessage()    {}
+func (*ExclusionGroupPassTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43cd825e0fa7a627, []int{1}
+}
+func (m *ExclusionGroupPassTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExclusionGroupPassTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExclusionGroupPassTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExclusionGroupPassTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExclusionGroupPassTime.Merge(m, src)
+}
+func (m *ExclusionGroupPassTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExclusionGroupPassTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExclusionGroupPassTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExclusionGroupPassTime proto.InternalMessageInfo
+
+func (m *ExclusionGroupPassTime) GetExclusionGroup() string {
+	if m != nil {
+		return m.ExclusionGroup
+	}
+	return ""
+}
+
+func (m *ExclusionGroupPassTime) GetPassTime() time.Time {
+	if m != nil {
+		return m.PassTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1beta1.GenesisState")
+	proto.RegisterType((*ExclusionGroupPassTime)(nil), "cosmos.gov.v1beta1.ExclusionGroupPassTime")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/genesis.proto", fileDescriptor_43cd825e0fa7a627) }
 
 var fileDescriptor_43cd825e0fa7a627 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x63, 0x9a, 0x94, 0xf6, 0x92, 0x20, 0x38, 0x82, 0x64, 0x35, 0xc1, 0x36, 0x9e, 0xb2,
-	0x60, 0xab, 0x65, 0x43, 0x62, 0xb1, 0x90, 0x50, 0x07, 0xa4, 0x62, 0x10, 0x03, 0x4b, 0x74, 0x89,
-	0x4f, 0x87, 0x85, 0xdd, 0x67, 0xf9, 0x1d, 0x16, 0xf9, 0x16, 0x7c, 0x0e, 0x3e, 0x49, 0xc7, 0x8e,
-	0x4c, 0x01, 0x25, 0x13, 0x6b, 0x3f, 0x01, 0xf2, 0xdd, 0x19, 0x1c, 0x61, 0x3a, 0x25, 0x7e, 0xf7,
-	0xbf, 0xdf, 0xef, 0xdd, 0xd3, 0x23, 0xde, 0x0a, 0x30, 0x07, 0x0c, 0x05, 0x54, 0x61, 0x75, 0xba,
-	0xe4, 0x92, 0x9d, 0x86, 0x82, 0x5f, 0x72, 0x4c, 0x31, 0x28, 0x4a, 0x90, 0x40, 0xa9, 0x4e, 0x04,
-	0x02, 0xaa, 0xc0, 0x24, 0x4e, 0x26, 0x02, 0x04, 0xa8, 0xe3, 0xb0, 0xfe, 0xa7, 0x93, 0x27, 0xb3,
-	0x2e, 0x16, 0x54, 0xfa, 0xd4, 0xff, 0xd5, 0x27, 0xa3, 0x57, 0x9a, 0xfc, 0x56, 0x32, 0xc9, 0xe9,
-	0x1b, 0x32, 0x41, 0xc9, 0x4a, 0x99, 0x5e, 0x8a, 0x45, 0x51, 0x42, 0x01, 0xc8, 0xb2, 0x45, 0x9a,
-	0xd8, 0x96, 0x67, 0xcd, 0xfb, 0x91, 0x7b, 0xb3, 0x71, 0xa7, 0x6b, 0x96, 0x67, 0xcf, 0xfd, 0xae,
-	0x94, 0x1f, 0xd3, 0xa6, 0x7c, 0x61, 0xaa, 0xe7, 0x09, 0x3d, 0x27, 0x47, 0x09, 0x2f, 0x00, 0x53,
-	0x89, 0xf6, 0x1d, 0xef, 0x60, 0x3e, 0x3c, 0x9b, 0x06, 0xff, 0xb6, 0x1f, 0xbc, 0xd4, 0x99, 0xe8,
-	0xfe, 0xd5, 0xc6, 0xed, 0x7d, 0xfb, 0xe1, 0x1e, 0x99, 0x02, 0xc6, 0x7f, 0xae, 0xd3, 0x17, 0x64,
-	0x50, 0x81, 0xe4, 0x68, 0x1f, 0x28, 0x8e, 0xdd, 0xc5, 0x79, 0x0f, 0x92, 0x47, 0x63, 0x03, 0x19,
-	0xd4, 0x5f, 0x18, 0xeb, 0x5b, 0xf4, 0x35, 0x39, 0x6e, 0xba, 0x45, 0xbb, 0xaf, 0x10, 0xb3, 0x2e,
-	0x44, 0xd3, 0x7c, 0xf4, 0xc0, 0x60, 0x8e, 0x9b, 0x0a, 0xc6, 0x7f, 0x09, 0x54, 0x90, 0x7b, 0xa6,
-	0xb3, 0x45, 0xc1, 0x4a, 0x96, 0xa3, 0x3d, 0xf0, 0xac, 0xf9, 0xf0, 0xec, 0xc9, 0x2d, 0xcf, 0xbb,
-	0x50, 0xc1, 0xe8, 0x71, 0x0d, 0xbe, 0xd9, 0xb8, 0x8f, 0xf4, 0x30, 0xf7, 0x31, 0x7e, 0x3c, 0x4e,
-	0xda, 0x69, 0xba, 0x22, 0xe3, 0x0a, 0xf4, 0xb0, 0xb5, 0xe7, 0x50, 0x79, 0xbc, 0xff, 0x3c, 0xbf,
-	0x1e, 0xbf, 0xd6, 0xcc, 0x8c, 0x66, 0xa2, 0x35, 0x7b, 0x10, 0x3f, 0x1e, 0x55, 0xad, 0x2c, 0x5d,
-	0x90, 0x91, 0x64, 0x59, 0xb6, 0x6e, 0x1c, 0x77, 0x95, 0xc3, 0xed, 0x72, 0xbc, 0xab, 0x73, 0x46,
-	0x31, 0x35, 0x8a, 0x87, 0x5a, 0xd1, 0x46, 0xf8, 0xf1, 0x50, 0xb6, 0x92, 0xd1, 0xd5, 0xd6, 0xb1,
-	0xae, 0xb7, 0x8e, 0xf5, 0x73, 0xeb, 0x58, 0x5f, 0x77, 0x4e, 0xef, 0x7a, 0xe7, 0xf4, 0xbe, 0xef,
-	0x9c, 0xde, 0x87, 0xb9, 0x48, 0xe5, 0xc7, 0xcf, 0xcb, 0x60, 0x05, 0x79, 0x68, 0xd6, 0x55, 0xff,
-	0x3c, 0xc5, 0xe4, 0x53, 0xf8, 0x45, 0xed, 0xae, 0x5c, 0x17, 0x1c, 0x97, 0x87, 0x6a, 0x6d, 0x9f,
-	0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x63, 0x67, 0xf6, 0x22, 0x03, 0x00, 0x00,
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x41, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc7, 0xe3, 0xb6, 0xe9, 0x97, 0x4c, 0x9b, 0x7e, 0x65, 0x08, 0xc5, 0x4a, 0x82, 0x9d, 0x7a,
+	0x15, 0x90, 0xb0, 0xd5, 0xb2, 0x43, 0x62, 0x63, 0x40, 0x55, 0x17, 0x48, 0xc1, 0x04, 0x16, 0x6c,
+	0x2c, 0x27, 0x19, 0x06, 0x0b, 0x3b, 0x63, 0xe5, 0x4d, 0xac, 0xe6, 0x16, 0x15, 0xc7, 0x60, 0xcd,
+	0x21, 0xba, 0xec, 0x92, 0x55, 0x8a, 0x92, 0x1b, 0xe4, 0x00, 0x08, 0x79, 0x66, 0x9c, 0x26, 0xe0,
+	0xb2, 0x4a, 0x3c, 0xfe, 0xbf, 0xdf, 0x6f, 0xe6, 0xd9, 0xcf, 0xa8, 0x3d, 0x60, 0x10, 0x33, 0x70,
+	0x28, 0x4b, 0x9d, 0xf4, 0xa4, 0x4f, 0x78, 0x70, 0xe2, 0x50, 0x32, 0x22, 0x10, 0x82, 0x9d, 0x8c,
+	0x19, 0x67, 0x18, 0xcb, 0x84, 0x4d, 0x59, 0x6a, 0xab, 0x44, 0xa3, 0x4e, 0x19, 0x65, 0xe2, 0xb6,
+	0x93, 0xfd, 0x93, 0xc9, 0x46, 0xab, 0x88, 0xc5, 0x52, 0x75, 0xd7, 0xa4, 0x8c, 0xd1, 0x88, 0x38,
+	0xe2, 0xaa, 0x3f, 0xf9, 0xe4, 0xf0, 0x30, 0x26, 0xc0, 0x83, 0x38, 0x91, 0x01, 0xeb, 0x57, 0x19,
+	0xed, 0x9f, 0x49, 0xf5, 0x3b, 0x1e, 0x70, 0x82, 0xdf, 0xa2, 0x3a, 0xf0, 0x60, 0xcc, 0xc3, 0x11,
+	0xf5, 0x93, 0x31, 0x4b, 0x18, 0x04, 0x91, 0x1f, 0x0e, 0x75, 0xad, 0xad, 0x75, 0x76, 0x5c, 0x73,
+	0x39, 0x33, 0x9b, 0xd3, 0x20, 0x8e, 0x9e, 0x5b, 0x45, 0x29, 0xcb, 0xc3, 0xf9, 0x72, 0x57, 0xad,
+	0x9e, 0x0f, 0xf1, 0x39, 0xaa, 0x0c, 0x49, 0xc2, 0x20, 0xe4, 0xa0, 0x6f, 0xb5, 0xb7, 0x3b, 0x7b,
+	0xa7, 0x4d, 0xfb, 0xef, 0xf3, 0xd9, 0xaf, 0x64, 0xc6, 0x3d, 0xbc, 0x9a, 0x99, 0xa5, 0x6f, 0x37,
+	0x66, 0x45, 0x2d, 0x80, 0xb7, 0x2a, 0xc7, 0x2f, 0x50, 0x39, 0x65, 0x9c, 0x80, 0xbe, 0x2d, 0x38,
+	0x7a, 0x11, 0xe7, 0x03, 0xe3, 0xc4, 0xad, 0x29, 0x48, 0x39, 0xbb, 0x02, 0x4f, 0x56, 0xe1, 0x37,
+	0xa8, 0x9a, 0xef, 0x16, 0xf4, 0x1d, 0x81, 0x68, 0x15, 0x21, 0xf2, 0xcd, 0xbb, 0xf7, 0x14, 0xa6,
+	0x9a, 0xaf, 0x80, 0x77, 0x4b, 0xc0, 0x14, 0x1d, 0xa8, 0x9d, 0xf9, 0x49, 0x30, 0x0e, 0x62, 0xd0,
+	0xcb, 0x6d, 0xad, 0xb3, 0x77, 0x7a, 0xfc, 0x8f, 0xe3, 0x75, 0x45, 0xd0, 0x7d, 0x94, 0x81, 0x97,
+	0x33, 0xf3, 0x81, 0x6c, 0xe6, 0x26, 0xc6, 0xf2, 0x6a, 0xc3, 0xf5, 0x34, 0x1e, 0xa0, 0x5a, 0xca,
+	0x64, 0xb3, 0xa5, 0x67, 0x57, 0x78, 0xda, 0x77, 0x1c, 0x3f, 0x6b, 0xbf, 0xd4, 0xb4, 0x94, 0xa6,
+	0x2e, 0x35, 0x1b, 0x10, 0xcb, 0xdb, 0x4f, 0xd7, 0xb2, 0xd8, 0x47, 0xfb, 0x3c, 0x88, 0xa2, 0x69,
+	0xee, 0xf8, 0x4f, 0x38, 0xcc, 0x22, 0x47, 0x2f, 0xcb, 0x29, 0x45, 0x53, 0x29, 0xee, 0x4b, 0xc5,
+	0x3a, 0xc2, 0xf2, 0xf6, 0xf8, 0x6d, 0x12, 0x7f, 0xd5, 0x50, 0x83, 0x5c, 0x0c, 0xa2, 0x09, 0x84,
+	0x6c, 0xe4, 0xd3, 0x31, 0x9b, 0x24, 0x7e, 0x12, 0x00, 0xf8, 0xe2, 0xa5, 0xd4, 0x2b, 0xe2, 0x79,
+	0x3c, 0x29, 0xf2, 0xbd, 0xce, 0xab, 0xce, 0xb2, 0xa2, 0x6e, 0x00, 0xd0, 0x0b, 0x63, 0xe2, 0x3e,
+	0x56, 0xea, 0x63, 0xa9, 0xbe, 0x9b, 0x6d, 0x79, 0x0f, 0x49, 0x21, 0x02, 0xac, 0xef, 0x1a, 0x3a,
+	0x2a, 0xc6, 0xe3, 0x97, 0xe8, 0xff, 0x3f, 0x90, 0x62, 0x0a, 0xaa, 0x6e, 0x63, 0x39, 0x33, 0x8f,
+	0x0a, 0x9d, 0x96, 0x77, 0xb0, 0x29, 0xc2, 0xef, 0x51, 0x75, 0xb5, 0x0f, 0x7d, 0x4b, 0xb4, 0xb4,
+	0x61, 0xcb, 0xa9, 0xb4, 0xf3, 0xa9, 0xb4, 0x7b, 0xf9, 0x54, 0xae, 0x1e, 0xd8, 0xa1, 0xc4, 0xaf,
+	0x4a, 0xad, 0xcb, 0x1b, 0x53, 0xf3, 0x2a, 0x49, 0x7e, 0x74, 0xf7, 0x6a, 0x6e, 0x68, 0xd7, 0x73,
+	0x43, 0xfb, 0x39, 0x37, 0xb4, 0xcb, 0x85, 0x51, 0xba, 0x5e, 0x18, 0xa5, 0x1f, 0x0b, 0xa3, 0xf4,
+	0xb1, 0x43, 0x43, 0xfe, 0x79, 0xd2, 0xb7, 0x07, 0x2c, 0x76, 0xd4, 0xb7, 0x41, 0xfe, 0x3c, 0x85,
+	0xe1, 0x17, 0xe7, 0x42, 0x7c, 0x28, 0xf8, 0x34, 0x21, 0xd0, 0xdf, 0x15, 0xfe, 0x67, 0xbf, 0x07,
+	0x00, 0x0b, 0xd1, 0x39, 0x30, 0x8f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -180,6 +259,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExclusionGroupPassTimes) > 0 {
+		for iNdEx := len(m.ExclusionGroupPassTimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExclusionGroupPassTimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -260,6 +353,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExclusionGroupPassTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExclusionGroupPassTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExclusionGroupPassTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.PassTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.PassTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ExclusionGroup) > 0 {
+		i -= len(m.ExclusionGroup)
+		copy(dAtA[i:], m.ExclusionGroup)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExclusionGroup)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -304,6 +435,27 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ExclusionGroupPassTimes) > 0 {
+		for _, e := range m.ExclusionGroupPassTimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExclusionGroupPassTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExclusionGroup)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.PassTime)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -562,6 +714,155 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionGroupPassTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionGroupPassTimes = append(m.ExclusionGroupPassTimes, ExclusionGroupPassTime{})
+			if err := m.ExclusionGroupPassTimes[len(m.ExclusionGroupPassTimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExclusionGroupPassTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExclusionGroupPassTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExclusionGroupPassTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.PassTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
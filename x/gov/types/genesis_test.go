@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,59 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExecutionParams(t *testing.T) {
+	authority := sdk.AccAddress("_____authority______").String()
+	tests := []struct {
+		name       string
+		delays     []ExecutionDelay
+		authority  string
+		expectPass bool
+	}{
+		{"no delays", nil, "", true},
+		{"valid delays", []ExecutionDelay{{ProposalTypeText, time.Hour}, {"ParameterChange", 0}}, authority, true},
+		{"empty proposal type", []ExecutionDelay{{"", time.Hour}}, authority, false},
+		{"duplicate proposal type", []ExecutionDelay{{ProposalTypeText, time.Hour}, {ProposalTypeText, time.Minute}}, authority, false},
+		{"negative delay", []ExecutionDelay{{ProposalTypeText, -time.Second}}, authority, false},
+		{"invalid veto authority", nil, "invalid", false},
+	}
+
+	for _, tc := range tests {
+		state := DefaultGenesisState()
+		state.TallyParams.ExecutionDelays = tc.delays
+		state.TallyParams.VetoAuthority = tc.authority
+		if tc.expectPass {
+			require.NoError(t, ValidateGenesis(state), tc.name)
+		} else {
+			require.Error(t, ValidateGenesis(state), tc.name)
+		}
+	}
+
+	passTime := time.Now().UTC()
+	passTimeTests := []struct {
+		name       string
+		passTimes  []ExclusionGroupPassTime
+		expectPass bool
+	}{
+		{"valid pass times", []ExclusionGroupPassTime{{"upgrades", passTime}, {"community-pool", passTime}}, true},
+		{"empty exclusion group", []ExclusionGroupPassTime{{"", passTime}}, false},
+		{"invalid exclusion group", []ExclusionGroupPassTime{{" upgrades", passTime}}, false},
+		{"duplicate exclusion group", []ExclusionGroupPassTime{{"upgrades", passTime}, {"upgrades", passTime}}, false},
+	}
+
+	for _, tc := range passTimeTests {
+		state := DefaultGenesisState()
+		state.ExclusionGroupPassTimes = tc.passTimes
+		if tc.expectPass {
+			require.NoError(t, ValidateGenesis(state), tc.name)
+		} else {
+			require.Error(t, ValidateGenesis(state), tc.name)
+		}
+	}
+
+	params := NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	params.ExecutionDelays = []ExecutionDelay{{ProposalTypeText, time.Hour}}
+	require.Equal(t, time.Hour, params.ExecutionDelay(ProposalTypeText))
+	require.Zero(t, params.ExecutionDelay("ParameterChange"))
+	require.False(t, params.Equal(DefaultTallyParams()))
+}
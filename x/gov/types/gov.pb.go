@@ -5,9 +5,9 @@ package types
 
 import (
 	fmt "fmt"
-	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -93,6 +93,12 @@ const (
 	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
 	// failed.
 	StatusFailed ProposalStatus = 5
+	// PROPOSAL_STATUS_QUEUED defines a proposal status of a proposal that has
+	// passed, and is queued for execution until its execution delay ends.
+	StatusQueued ProposalStatus = 6
+	// PROPOSAL_STATUS_VETOED defines a proposal status of a proposal that has
+	// passed, but was vetoed by the veto authority during its execution delay.
+	StatusVetoed ProposalStatus = 7
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -102,6 +108,8 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_PASSED",
 	4: "PROPOSAL_STATUS_REJECTED",
 	5: "PROPOSAL_STATUS_FAILED",
+	6: "PROPOSAL_STATUS_QUEUED",
+	7: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -111,6 +119,8 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_PASSED":         3,
 	"PROPOSAL_STATUS_REJECTED":       4,
 	"PROPOSAL_STATUS_FAILED":         5,
+	"PROPOSAL_STATUS_QUEUED":         6,
+	"PROPOSAL_STATUS_VETOED":         7,
 }
 
 func (x ProposalStatus) String() string {
@@ -200,6 +210,48 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// MsgExecutionProposal defines a proposal executing Msgs once approved, signed
+// by the governance module account. Only the Msgs registered by the modules for
+// execution through governance can be executed.
+type MsgExecutionProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// messages are the Msgs executed, in order, once the proposal is approved.
+	Messages []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MsgExecutionProposal) Reset()      { *m = MsgExecutionProposal{} }
+func (*MsgExecutionProposal) ProtoMessage() {}
+func (*MsgExecutionProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+}
+func (m *MsgExecutionProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecutionProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecutionProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecutionProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecutionProposal.Merge(m, src)
+}
+func (m *MsgExecutionProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecutionProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecutionProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecutionProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -211,7 +263,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{2}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +295,7 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 // Proposal defines the core field members of a governance proposal.
 type Proposal struct {
 	ProposalId       uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id" yaml:"id"`
-	Content          *types1.Any                              `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Content          *types.Any                               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Status           ProposalStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.v1beta1.ProposalStatus" json:"status,omitempty" yaml:"proposal_status"`
 	FinalTallyResult TallyResult                              `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result" yaml:"final_tally_result"`
 	SubmitTime       time.Time                                `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
@@ -251,12 +303,18 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// exclusion_group is the name of the exclusion group of the proposal, of
+	// which at most one proposal can pass within the exclusion window.
+	ExclusionGroup string `protobuf:"bytes,10,opt,name=exclusion_group,json=exclusionGroup,proto3" json:"exclusion_group,omitempty" yaml:"exclusion_group,omitempty"`
+	// execution_time is the time a queued proposal is executed at, once the
+	// execution delay of its proposal type passed.
+	ExecutionTime time.Time `protobuf:"bytes,11,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time" yaml:"execution_time"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{3}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +354,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{4}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +399,7 @@ type Vote struct {
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{5}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +440,7 @@ type DepositParams struct {
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{6}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +478,7 @@ type VotingParams struct {
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{7}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,12 +517,22 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_threshold,omitempty" yaml:"veto_threshold"`
+	//  Duration after a proposal of an exclusion group passes during which the
+	//  other proposals of the group are rejected. Zero disables the exclusion.
+	ExclusionWindow time.Duration `protobuf:"bytes,4,opt,name=exclusion_window,json=exclusionWindow,proto3,stdduration" json:"exclusion_window,omitempty" yaml:"exclusion_window"`
+	//  Delays between the passing of the proposals of a type and their
+	//  execution, during which the veto authority can veto them. The proposals
+	//  of the other types are executed when they pass.
+	ExecutionDelays []ExecutionDelay `protobuf:"bytes,5,rep,name=execution_delays,json=executionDelays,proto3" json:"execution_delays,omitempty" yaml:"execution_delays"`
+	//  Address allowed to veto the proposals during their execution delay. No
+	//  proposal can be vetoed if empty.
+	VetoAuthority string `protobuf:"bytes,6,opt,name=veto_authority,json=vetoAuthority,proto3" json:"veto_authority,omitempty" yaml:"veto_authority"`
 }
 
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e82113c1a9a4b7c, []int{8}
+	return fileDescriptor_6e82113c1a9a4b7c, []int{9}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,11 +561,97 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// ExecutionDelay defines the delay between the passing of the proposals of a
+// type and their execution.
+type ExecutionDelay struct {
+	// proposal_type is the type of the proposal content, e.g. "Text".
+	ProposalType string `protobuf:"bytes,1,opt,name=proposal_type,json=proposalType,proto3" json:"proposal_type,omitempty" yaml:"proposal_type"`
+	// delay is the duration proposals of the type are queued for once passed.
+	Delay time.Duration `protobuf:"bytes,2,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *ExecutionDelay) Reset()      { *m = ExecutionDelay{} }
+func (*ExecutionDelay) ProtoMessage() {}
+func (*ExecutionDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{10}
+}
+func (m *ExecutionDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionDelay.Merge(m, src)
+}
+func (m *ExecutionDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionDelay proto.InternalMessageInfo
+
+// MsgRoute declares an sdk.Msg that a module intends to be executed through
+// governance, along with the authority address the module requires as the
+// Msg signer.
+type MsgRoute struct {
+	// module is the name of the module that registered the route.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// msg_type_url is the type URL of the sdk.Msg.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// authority is the bech32 address the module requires as the Msg signer.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgRoute) Reset()      { *m = MsgRoute{} }
+func (*MsgRoute) ProtoMessage() {}
+func (*MsgRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e82113c1a9a4b7c, []int{11}
+}
+func (m *MsgRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRoute.Merge(m, src)
+}
+func (m *MsgRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRoute proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.v1beta1.TextProposal")
+	proto.RegisterType((*MsgExecutionProposal)(nil), "cosmos.gov.v1beta1.MsgExecutionProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1beta1.TallyResult")
@@ -505,103 +659,126 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1beta1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1beta1.TallyParams")
+	proto.RegisterType((*ExecutionDelay)(nil), "cosmos.gov.v1beta1.ExecutionDelay")
+	proto.RegisterType((*MsgRoute)(nil), "cosmos.gov.v1beta1.MsgRoute")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/gov.proto", fileDescriptor_6e82113c1a9a4b7c) }
 
 var fileDescriptor_6e82113c1a9a4b7c = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x51, 0x68, 0xdb, 0x56,
-	0x17, 0xb6, 0x6c, 0xc7, 0x89, 0xaf, 0x9d, 0x44, 0xbd, 0x49, 0x13, 0xc7, 0x7f, 0x7f, 0xc9, 0xbf,
-	0xfe, 0x9f, 0x12, 0x4a, 0xeb, 0xb4, 0xf9, 0xc7, 0xc6, 0x52, 0xd8, 0x66, 0xc5, 0xca, 0xea, 0x51,
-	0x6c, 0x23, 0xab, 0x0e, 0xed, 0x1e, 0x84, 0x62, 0xdf, 0x3a, 0xda, 0x2c, 0x5d, 0xcf, 0xba, 0x4e,
-	0x13, 0xf6, 0xb2, 0xc7, 0xe2, 0xc1, 0xe8, 0x63, 0x61, 0x18, 0x0a, 0x63, 0x2f, 0x7b, 0xde, 0xf3,
-	0x9e, 0xc3, 0x18, 0xac, 0xec, 0xa9, 0x6c, 0xe0, 0xae, 0x09, 0x8c, 0x92, 0xc7, 0x3c, 0xec, 0x79,
-	0x48, 0xf7, 0x2a, 0x96, 0xed, 0xb0, 0xd4, 0x7b, 0x8a, 0x74, 0xee, 0xf9, 0xbe, 0x73, 0xce, 0xe7,
-	0x73, 0xce, 0x55, 0xc0, 0x95, 0x1a, 0x76, 0x2c, 0xec, 0xac, 0x35, 0xf0, 0xde, 0xda, 0xde, 0xad,
-	0x1d, 0x44, 0x8c, 0x5b, 0xee, 0x73, 0xb6, 0xd5, 0xc6, 0x04, 0x43, 0x48, 0x4f, 0xb3, 0xae, 0x85,
-	0x9d, 0xa6, 0x05, 0x86, 0xd8, 0x31, 0x1c, 0x74, 0x06, 0xa9, 0x61, 0xd3, 0xa6, 0x98, 0xf4, 0x62,
-	0x03, 0x37, 0xb0, 0xf7, 0xb8, 0xe6, 0x3e, 0x31, 0xeb, 0x0a, 0x45, 0xe9, 0xf4, 0x80, 0xd1, 0xd2,
-	0x23, 0xb1, 0x81, 0x71, 0xa3, 0x89, 0xd6, 0xbc, 0xb7, 0x9d, 0xce, 0xc3, 0x35, 0x62, 0x5a, 0xc8,
-	0x21, 0x86, 0xd5, 0xf2, 0xb1, 0xa3, 0x0e, 0x86, 0x7d, 0xc0, 0x8e, 0x84, 0xd1, 0xa3, 0x7a, 0xa7,
-	0x6d, 0x10, 0x13, 0xb3, 0x64, 0xa4, 0x6f, 0x39, 0x00, 0xb7, 0x91, 0xd9, 0xd8, 0x25, 0xa8, 0x5e,
-	0xc5, 0x04, 0x95, 0x5a, 0xee, 0x21, 0x7c, 0x1b, 0xc4, 0xb0, 0xf7, 0x94, 0xe2, 0x32, 0xdc, 0xea,
-	0xdc, 0xba, 0x90, 0x1d, 0x2f, 0x34, 0x3b, 0xf0, 0x57, 0x99, 0x37, 0xdc, 0x06, 0xb1, 0x47, 0x1e,
-	0x5b, 0x2a, 0x9c, 0xe1, 0x56, 0xe3, 0xf2, 0xfb, 0x87, 0x7d, 0x31, 0xf4, 0x6b, 0x5f, 0xbc, 0xda,
-	0x30, 0xc9, 0x6e, 0x67, 0x27, 0x5b, 0xc3, 0x16, 0xab, 0x8d, 0xfd, 0xb9, 0xe1, 0xd4, 0x3f, 0x5d,
-	0x23, 0x07, 0x2d, 0xe4, 0x64, 0xf3, 0xa8, 0x76, 0xda, 0x17, 0x67, 0x0f, 0x0c, 0xab, 0xb9, 0x21,
-	0x51, 0x16, 0x49, 0x65, 0x74, 0xd2, 0x36, 0x48, 0x6a, 0x68, 0x9f, 0x94, 0xdb, 0xb8, 0x85, 0x1d,
-	0xa3, 0x09, 0x17, 0xc1, 0x14, 0x31, 0x49, 0x13, 0x79, 0xf9, 0xc5, 0x55, 0xfa, 0x02, 0x33, 0x20,
-	0x51, 0x47, 0x4e, 0xad, 0x6d, 0xd2, 0xdc, 0xbd, 0x1c, 0xd4, 0xa0, 0x69, 0x63, 0xfe, 0xf5, 0x33,
-	0x91, 0xfb, 0xe5, 0xfb, 0x1b, 0xd3, 0x9b, 0xd8, 0x26, 0xc8, 0x26, 0xd2, 0xcf, 0x1c, 0x98, 0xce,
-	0xa3, 0x16, 0x76, 0x4c, 0x02, 0xdf, 0x01, 0x89, 0x16, 0x0b, 0xa0, 0x9b, 0x75, 0x8f, 0x3a, 0x2a,
-	0x2f, 0x9d, 0xf6, 0x45, 0x48, 0x93, 0x0a, 0x1c, 0x4a, 0x2a, 0xf0, 0xdf, 0x0a, 0x75, 0x78, 0x05,
-	0xc4, 0xeb, 0x94, 0x03, 0xb7, 0x59, 0xd4, 0x81, 0x01, 0xd6, 0x40, 0xcc, 0xb0, 0x70, 0xc7, 0x26,
-	0xa9, 0x48, 0x26, 0xb2, 0x9a, 0x58, 0x5f, 0xf1, 0xc5, 0x74, 0x3b, 0xe4, 0x4c, 0xcd, 0x4d, 0x6c,
-	0xda, 0xf2, 0x4d, 0x57, 0xaf, 0xef, 0x5e, 0x8a, 0xab, 0x6f, 0xa0, 0x97, 0x0b, 0x70, 0x54, 0x46,
-	0xbd, 0x31, 0xf3, 0xf8, 0x99, 0x18, 0x7a, 0xfd, 0x4c, 0x0c, 0x49, 0x7f, 0xc6, 0xc0, 0xcc, 0x99,
-	0x4e, 0x6f, 0x9d, 0x57, 0xd2, 0xc2, 0x49, 0x5f, 0x0c, 0x9b, 0xf5, 0xd3, 0xbe, 0x18, 0xa7, 0x85,
-	0x8d, 0xd6, 0x73, 0x1b, 0x4c, 0xd7, 0xa8, 0x3e, 0x5e, 0x35, 0x89, 0xf5, 0xc5, 0x2c, 0xed, 0xa3,
-	0xac, 0xdf, 0x47, 0xd9, 0x9c, 0x7d, 0x20, 0x27, 0x7e, 0x1c, 0x08, 0xa9, 0xfa, 0x08, 0x58, 0x05,
-	0x31, 0x87, 0x18, 0xa4, 0xe3, 0xa4, 0x22, 0x5e, 0xef, 0x48, 0xe7, 0xf5, 0x8e, 0x9f, 0x60, 0xc5,
-	0xf3, 0x94, 0xd3, 0xa7, 0x7d, 0x71, 0x69, 0x44, 0x64, 0x4a, 0x22, 0xa9, 0x8c, 0x0d, 0xb6, 0x00,
-	0x7c, 0x68, 0xda, 0x46, 0x53, 0x27, 0x46, 0xb3, 0x79, 0xa0, 0xb7, 0x91, 0xd3, 0x69, 0x92, 0x54,
-	0xd4, 0xcb, 0x4f, 0x3c, 0x2f, 0x86, 0xe6, 0xfa, 0xa9, 0x9e, 0x9b, 0xfc, 0x1f, 0x57, 0xd8, 0xd3,
-	0xbe, 0xb8, 0x42, 0x83, 0x8c, 0x13, 0x49, 0x2a, 0xef, 0x19, 0x03, 0x20, 0xf8, 0x31, 0x48, 0x38,
-	0x9d, 0x1d, 0xcb, 0x24, 0xba, 0x3b, 0x71, 0xa9, 0x29, 0x2f, 0x54, 0x7a, 0x4c, 0x0a, 0xcd, 0x1f,
-	0x47, 0x59, 0x60, 0x51, 0x58, 0xbf, 0x04, 0xc0, 0xd2, 0x93, 0x97, 0x22, 0xa7, 0x02, 0x6a, 0x71,
-	0x01, 0xd0, 0x04, 0x3c, 0x6b, 0x11, 0x1d, 0xd9, 0x75, 0x1a, 0x21, 0x76, 0x61, 0x84, 0xff, 0xb2,
-	0x08, 0xcb, 0x34, 0xc2, 0x28, 0x03, 0x0d, 0x33, 0xc7, 0xcc, 0x8a, 0x5d, 0xf7, 0x42, 0x3d, 0xe6,
-	0xc0, 0x2c, 0xc1, 0xc4, 0x68, 0xea, 0xec, 0x20, 0x35, 0x7d, 0x51, 0x23, 0xde, 0x61, 0x71, 0x16,
-	0x69, 0x9c, 0x21, 0xb4, 0x34, 0x51, 0x83, 0x26, 0x3d, 0xac, 0x3f, 0x62, 0x4d, 0x70, 0x69, 0x0f,
-	0x13, 0xd3, 0x6e, 0xb8, 0x3f, 0x6f, 0x9b, 0x09, 0x3b, 0x73, 0x61, 0xd9, 0xff, 0x63, 0xe9, 0xa4,
-	0x68, 0x3a, 0x63, 0x14, 0xb4, 0xee, 0x79, 0x6a, 0xaf, 0xb8, 0x66, 0xaf, 0xf0, 0x87, 0x80, 0x99,
-	0x06, 0x12, 0xc7, 0x2f, 0x8c, 0x25, 0xb1, 0x58, 0x4b, 0x43, 0xb1, 0x86, 0x15, 0x9e, 0xa5, 0x56,
-	0x26, 0xf0, 0x46, 0xd4, 0xdd, 0x2a, 0xd2, 0x61, 0x18, 0x24, 0x82, 0xed, 0xf3, 0x01, 0x88, 0x1c,
-	0x20, 0x87, 0x6e, 0x28, 0x39, 0x3b, 0xc1, 0x26, 0x2c, 0xd8, 0x44, 0x75, 0xa1, 0xf0, 0x0e, 0x98,
-	0x36, 0x76, 0x1c, 0x62, 0x98, 0x6c, 0x97, 0x4d, 0xcc, 0xe2, 0xc3, 0xe1, 0x7b, 0x20, 0x6c, 0x63,
-	0x6f, 0x20, 0x27, 0x27, 0x09, 0xdb, 0x18, 0x36, 0x40, 0xd2, 0xc6, 0xfa, 0x23, 0x93, 0xec, 0xea,
-	0x7b, 0x88, 0x60, 0x6f, 0xec, 0xe2, 0xb2, 0x32, 0x19, 0xd3, 0x69, 0x5f, 0x5c, 0xa0, 0xa2, 0x06,
-	0xb9, 0x24, 0x15, 0xd8, 0x78, 0xdb, 0x24, 0xbb, 0x55, 0x44, 0x30, 0x93, 0xf2, 0x98, 0x03, 0x51,
-	0xf7, 0x7a, 0xf9, 0xe7, 0x2b, 0x79, 0x11, 0x4c, 0xed, 0x61, 0x82, 0xfc, 0x75, 0x4c, 0x5f, 0xe0,
-	0xc6, 0xd9, 0xbd, 0x16, 0x79, 0x93, 0x7b, 0x4d, 0x0e, 0xa7, 0xb8, 0xb3, 0xbb, 0x6d, 0x0b, 0x4c,
-	0xd3, 0x27, 0x27, 0x15, 0xf5, 0xc6, 0xe7, 0xea, 0x79, 0xe0, 0xf1, 0xcb, 0x54, 0x8e, 0xba, 0x2a,
-	0xa9, 0x3e, 0x78, 0x63, 0xe6, 0xa9, 0xbf, 0xa9, 0x7f, 0x08, 0x83, 0x59, 0x36, 0x18, 0x65, 0xa3,
-	0x6d, 0x58, 0x0e, 0xfc, 0x9a, 0x03, 0x09, 0xcb, 0xb4, 0xcf, 0xe6, 0x94, 0xbb, 0x68, 0x4e, 0x75,
-	0x97, 0xfb, 0xa4, 0x2f, 0x5e, 0x0e, 0xa0, 0xae, 0x63, 0xcb, 0x24, 0xc8, 0x6a, 0x91, 0x83, 0x81,
-	0x4e, 0x81, 0xe3, 0xc9, 0xc6, 0x17, 0x58, 0xa6, 0xed, 0x0f, 0xef, 0x57, 0x1c, 0x80, 0x96, 0xb1,
-	0xef, 0x13, 0xe9, 0x2d, 0xd4, 0x36, 0x71, 0x9d, 0x5d, 0x11, 0x2b, 0x63, 0x23, 0x95, 0x67, 0x9f,
-	0x1a, 0xb4, 0x4d, 0x4e, 0xfa, 0xe2, 0x95, 0x71, 0xf0, 0x50, 0xae, 0x6c, 0x39, 0x8f, 0x7b, 0x49,
-	0x4f, 0xdd, 0xa1, 0xe3, 0x2d, 0x63, 0xdf, 0x97, 0x8b, 0x9a, 0xbf, 0xe4, 0x40, 0xb2, 0xea, 0x4d,
-	0x22, 0xd3, 0xef, 0x73, 0xc0, 0x26, 0xd3, 0xcf, 0x8d, 0xbb, 0x28, 0xb7, 0xdb, 0x2c, 0xb7, 0xe5,
-	0x21, 0xdc, 0x50, 0x5a, 0x8b, 0x43, 0x8b, 0x20, 0x98, 0x51, 0x92, 0xda, 0x58, 0x36, 0xbf, 0xf9,
-	0xf3, 0xcf, 0x92, 0x79, 0x00, 0x62, 0x9f, 0x75, 0x70, 0xbb, 0x63, 0x79, 0x59, 0x24, 0x65, 0x79,
-	0xb2, 0x8f, 0xa1, 0x93, 0xbe, 0xc8, 0x53, 0xfc, 0x20, 0x1b, 0x95, 0x31, 0xc2, 0x1a, 0x88, 0x93,
-	0xdd, 0x36, 0x72, 0x76, 0x71, 0x93, 0xfe, 0x00, 0xc9, 0x89, 0x86, 0x91, 0xd2, 0x2f, 0x9c, 0x51,
-	0x04, 0x22, 0x0c, 0x78, 0x61, 0x97, 0x03, 0x73, 0xee, 0x84, 0xea, 0x83, 0x50, 0x11, 0x2f, 0x54,
-	0x6d, 0xe2, 0x50, 0xa9, 0x61, 0x9e, 0x21, 0x7d, 0x2f, 0x33, 0x7d, 0x87, 0x3c, 0x24, 0x75, 0xd6,
-	0x35, 0x68, 0xfe, 0xfb, 0xb5, 0x3f, 0x38, 0x00, 0x02, 0x5f, 0xa8, 0xd7, 0xc1, 0x72, 0xb5, 0xa4,
-	0x29, 0x7a, 0xa9, 0xac, 0x15, 0x4a, 0x45, 0xfd, 0x5e, 0xb1, 0x52, 0x56, 0x36, 0x0b, 0x5b, 0x05,
-	0x25, 0xcf, 0x87, 0xd2, 0xf3, 0xdd, 0x5e, 0x26, 0x41, 0x1d, 0x15, 0x37, 0x08, 0x94, 0xc0, 0x7c,
-	0xd0, 0xfb, 0xbe, 0x52, 0xe1, 0xb9, 0xf4, 0x6c, 0xb7, 0x97, 0x89, 0x53, 0xaf, 0xfb, 0xc8, 0x81,
-	0xd7, 0xc0, 0x42, 0xd0, 0x27, 0x27, 0x57, 0xb4, 0x5c, 0xa1, 0xc8, 0x87, 0xd3, 0x97, 0xba, 0xbd,
-	0xcc, 0x2c, 0xf5, 0xcb, 0xb1, 0x75, 0x9a, 0x01, 0x73, 0x41, 0xdf, 0x62, 0x89, 0x8f, 0xa4, 0x93,
-	0xdd, 0x5e, 0x66, 0x86, 0xba, 0x15, 0x31, 0x5c, 0x07, 0xa9, 0x61, 0x0f, 0x7d, 0xbb, 0xa0, 0xdd,
-	0xd1, 0xab, 0x8a, 0x56, 0xe2, 0xa3, 0xe9, 0xc5, 0x6e, 0x2f, 0xc3, 0xfb, 0xbe, 0xfe, 0xee, 0x4b,
-	0x47, 0x1f, 0x7f, 0x23, 0x84, 0xae, 0xfd, 0x14, 0x06, 0x73, 0xc3, 0x9f, 0x47, 0x30, 0x0b, 0xfe,
-	0x55, 0x56, 0x4b, 0xe5, 0x52, 0x25, 0x77, 0x57, 0xaf, 0x68, 0x39, 0xed, 0x5e, 0x65, 0xa4, 0x60,
-	0xaf, 0x14, 0xea, 0x5c, 0x34, 0x9b, 0xf0, 0x36, 0x10, 0x46, 0xfd, 0xf3, 0x4a, 0xb9, 0x54, 0x29,
-	0x68, 0x7a, 0x59, 0x51, 0x0b, 0xa5, 0x3c, 0xcf, 0xa5, 0x97, 0xbb, 0xbd, 0xcc, 0x02, 0x85, 0x0c,
-	0x0d, 0x15, 0x7c, 0x17, 0xfc, 0x7b, 0x14, 0x5c, 0x2d, 0x69, 0x85, 0xe2, 0x87, 0x3e, 0x36, 0x9c,
-	0x5e, 0xea, 0xf6, 0x32, 0x90, 0x62, 0xab, 0x81, 0x09, 0x80, 0xd7, 0xc1, 0xd2, 0x28, 0xb4, 0x9c,
-	0xab, 0x54, 0x94, 0x3c, 0x1f, 0x49, 0xf3, 0xdd, 0x5e, 0x26, 0x49, 0x31, 0x65, 0xc3, 0x71, 0x50,
-	0x1d, 0xde, 0x04, 0xa9, 0x51, 0x6f, 0x55, 0xf9, 0x48, 0xd9, 0xd4, 0x94, 0x3c, 0x1f, 0x4d, 0xc3,
-	0x6e, 0x2f, 0x33, 0x47, 0xfd, 0x55, 0xf4, 0x09, 0xaa, 0x11, 0x74, 0x2e, 0xff, 0x56, 0xae, 0x70,
-	0x57, 0xc9, 0xf3, 0x53, 0x41, 0xfe, 0x2d, 0xc3, 0x6c, 0xa2, 0x3a, 0x95, 0x53, 0x2e, 0x1e, 0xbe,
-	0x12, 0x42, 0x2f, 0x5e, 0x09, 0xa1, 0x2f, 0x8e, 0x84, 0xd0, 0xe1, 0x91, 0xc0, 0x3d, 0x3f, 0x12,
-	0xb8, 0xdf, 0x8f, 0x04, 0xee, 0xc9, 0xb1, 0x10, 0x7a, 0x7e, 0x2c, 0x84, 0x5e, 0x1c, 0x0b, 0xa1,
-	0x07, 0x7f, 0xbf, 0x10, 0xf7, 0xbd, 0x7f, 0xff, 0xbc, 0x7e, 0xde, 0x89, 0x79, 0x3b, 0xe4, 0xff,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x14, 0xb4, 0xf2, 0xfe, 0x19, 0x0e, 0x00, 0x00,
+	// 1789 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6f, 0xe3, 0xc6,
+	0x15, 0x17, 0x25, 0x59, 0x96, 0x46, 0xb2, 0xcd, 0x8c, 0x1d, 0x5b, 0xab, 0x6e, 0x45, 0x95, 0x0d,
+	0x82, 0xc5, 0x62, 0x23, 0x6f, 0xb6, 0x5f, 0x58, 0x2f, 0xd2, 0x56, 0x5c, 0x71, 0xb3, 0x2a, 0xb2,
+	0x96, 0x42, 0xc9, 0x36, 0x92, 0x16, 0x20, 0x68, 0x69, 0x56, 0x66, 0x4b, 0x72, 0x54, 0x71, 0xe8,
+	0xb5, 0x90, 0x4b, 0x6f, 0x5d, 0xa8, 0x1f, 0xc8, 0x31, 0x40, 0x21, 0x60, 0x81, 0xa2, 0x40, 0xd1,
+	0x5e, 0x7b, 0xee, 0x79, 0xd1, 0x4b, 0x83, 0x9c, 0x82, 0x1e, 0x94, 0x66, 0x17, 0x28, 0x02, 0x1f,
+	0xfd, 0x17, 0x14, 0xc3, 0x19, 0x52, 0xa4, 0xac, 0x54, 0x71, 0x7a, 0xb2, 0xe6, 0xbd, 0xdf, 0x7b,
+	0xef, 0x37, 0xef, 0xcd, 0xbc, 0x37, 0x34, 0xb8, 0xde, 0xc5, 0xae, 0x8d, 0xdd, 0xdd, 0x3e, 0x3e,
+	0xdd, 0x3d, 0x7d, 0xf3, 0x18, 0x11, 0xe3, 0x4d, 0xfa, 0xbb, 0x3a, 0x18, 0x62, 0x82, 0x21, 0x64,
+	0xda, 0x2a, 0x95, 0x70, 0x6d, 0xa9, 0xcc, 0x2d, 0x8e, 0x0d, 0x17, 0x85, 0x26, 0x5d, 0x6c, 0x3a,
+	0xcc, 0xa6, 0xb4, 0xd5, 0xc7, 0x7d, 0xec, 0xff, 0xdc, 0xa5, 0xbf, 0xb8, 0xf4, 0x1a, 0xb3, 0xd2,
+	0x99, 0x82, 0xbb, 0x65, 0x2a, 0xa9, 0x8f, 0x71, 0xdf, 0x42, 0xbb, 0xfe, 0xea, 0xd8, 0x7b, 0xbc,
+	0x4b, 0x4c, 0x1b, 0xb9, 0xc4, 0xb0, 0x07, 0x81, 0xed, 0x3c, 0xc0, 0x70, 0x46, 0x5c, 0x55, 0x9e,
+	0x57, 0xf5, 0xbc, 0xa1, 0x41, 0x4c, 0xcc, 0xc9, 0xc8, 0x7f, 0x12, 0x00, 0x3c, 0x42, 0x66, 0xff,
+	0x84, 0xa0, 0xde, 0x21, 0x26, 0xa8, 0x39, 0xa0, 0x4a, 0xf8, 0x7d, 0x90, 0xc1, 0xfe, 0xaf, 0xa2,
+	0x50, 0x11, 0x6e, 0xac, 0xdf, 0x29, 0x57, 0x2f, 0x6f, 0xb4, 0x3a, 0xc3, 0x6b, 0x1c, 0x0d, 0x8f,
+	0x40, 0xe6, 0x89, 0xef, 0xad, 0x98, 0xac, 0x08, 0x37, 0x72, 0xca, 0x8f, 0x9e, 0x4f, 0xa5, 0xc4,
+	0xbf, 0xa6, 0xd2, 0xeb, 0x7d, 0x93, 0x9c, 0x78, 0xc7, 0xd5, 0x2e, 0xb6, 0xf9, 0xde, 0xf8, 0x9f,
+	0x37, 0xdc, 0xde, 0x2f, 0x76, 0xc9, 0x68, 0x80, 0xdc, 0x6a, 0x1d, 0x75, 0x2f, 0xa6, 0xd2, 0xda,
+	0xc8, 0xb0, 0xad, 0x3d, 0x99, 0x79, 0x91, 0x35, 0xee, 0x4e, 0x3e, 0x02, 0x85, 0x0e, 0x3a, 0x23,
+	0xad, 0x21, 0x1e, 0x60, 0xd7, 0xb0, 0xe0, 0x16, 0x58, 0x21, 0x26, 0xb1, 0x90, 0xcf, 0x2f, 0xa7,
+	0xb1, 0x05, 0xac, 0x80, 0x7c, 0x0f, 0xb9, 0xdd, 0xa1, 0xc9, 0xb8, 0xfb, 0x1c, 0xb4, 0xa8, 0x68,
+	0x6f, 0xe3, 0x8b, 0x67, 0x92, 0xf0, 0xc9, 0xdf, 0xde, 0x58, 0xbd, 0x8f, 0x1d, 0x82, 0x1c, 0x22,
+	0xff, 0x4e, 0x00, 0x5b, 0x8f, 0xdc, 0xbe, 0x7a, 0x86, 0xba, 0x1e, 0x45, 0xfc, 0xbf, 0x11, 0xe0,
+	0x6d, 0x90, 0xb5, 0x91, 0xeb, 0x1a, 0x7d, 0xe4, 0x16, 0x53, 0x95, 0xd4, 0x8d, 0xfc, 0x9d, 0xad,
+	0x2a, 0x2b, 0x42, 0x35, 0x28, 0x42, 0xb5, 0xe6, 0x8c, 0xb4, 0x10, 0xb5, 0x97, 0x8f, 0xf2, 0xf9,
+	0xa7, 0x00, 0x56, 0xeb, 0x68, 0x80, 0x5d, 0x93, 0xc0, 0x1f, 0x80, 0xfc, 0x80, 0xd3, 0xd1, 0xcd,
+	0x9e, 0x4f, 0x24, 0xad, 0x6c, 0x5f, 0x4c, 0x25, 0xc8, 0x92, 0x14, 0x51, 0xca, 0x1a, 0x08, 0x56,
+	0x8d, 0x1e, 0xbc, 0x0e, 0x72, 0x3d, 0xe6, 0x03, 0x0f, 0x39, 0xc7, 0x99, 0x00, 0x76, 0x41, 0xc6,
+	0xb0, 0xb1, 0xe7, 0x10, 0xce, 0xef, 0x5a, 0x50, 0x5c, 0x7a, 0x62, 0xc3, 0xea, 0xde, 0xc7, 0xa6,
+	0xa3, 0xdc, 0xa6, 0xf5, 0xfb, 0xcb, 0x67, 0xd2, 0x8d, 0xaf, 0x50, 0x3f, 0x6a, 0xe0, 0x6a, 0xdc,
+	0xf5, 0x5e, 0xf6, 0xe9, 0x33, 0x29, 0xf1, 0xc5, 0x33, 0x29, 0x21, 0xff, 0x35, 0x0b, 0xb2, 0x61,
+	0x56, 0xbf, 0xbb, 0x68, 0x4b, 0x9b, 0xe7, 0x53, 0x29, 0x69, 0xf6, 0x2e, 0xa6, 0x52, 0x8e, 0x6d,
+	0x6c, 0x7e, 0x3f, 0xf7, 0xc0, 0x6a, 0x97, 0xe5, 0xc7, 0xdf, 0xcd, 0x97, 0xa4, 0x54, 0xc9, 0xff,
+	0x63, 0x96, 0x48, 0x2d, 0xb0, 0x80, 0x87, 0x20, 0xe3, 0x12, 0x83, 0x78, 0xb4, 0x1c, 0xf4, 0x2c,
+	0xcb, 0x8b, 0xce, 0x72, 0x40, 0xb0, 0xed, 0x23, 0x95, 0xd2, 0xc5, 0x54, 0xda, 0x9e, 0x4b, 0x32,
+	0x73, 0x22, 0x6b, 0xdc, 0x1b, 0x1c, 0x00, 0xf8, 0xd8, 0x74, 0x0c, 0x4b, 0x27, 0x86, 0x65, 0x8d,
+	0xf4, 0x21, 0x72, 0x3d, 0x8b, 0x14, 0xd3, 0x3e, 0x3f, 0x69, 0x51, 0x8c, 0x0e, 0xc5, 0x69, 0x3e,
+	0x4c, 0xf9, 0x16, 0x4d, 0xec, 0xc5, 0x54, 0xba, 0xc6, 0x82, 0x5c, 0x76, 0x24, 0x6b, 0xa2, 0x2f,
+	0x8c, 0x18, 0xc1, 0x9f, 0x82, 0xbc, 0xeb, 0x1d, 0xdb, 0x26, 0xd1, 0x69, 0x07, 0x28, 0xae, 0xf8,
+	0xa1, 0x4a, 0x97, 0x52, 0xd1, 0x09, 0xda, 0x83, 0x52, 0xe6, 0x51, 0xf8, 0x79, 0x89, 0x18, 0xcb,
+	0x1f, 0x7e, 0x26, 0x09, 0x1a, 0x60, 0x12, 0x6a, 0x00, 0x4d, 0x20, 0xf2, 0x23, 0xa2, 0x23, 0xa7,
+	0xc7, 0x22, 0x64, 0x96, 0x46, 0xf8, 0x36, 0x8f, 0xb0, 0xc3, 0x22, 0xcc, 0x7b, 0x60, 0x61, 0xd6,
+	0xb9, 0x58, 0x75, 0x7a, 0x7e, 0xa8, 0xa7, 0x02, 0x58, 0x23, 0x98, 0x18, 0x96, 0xce, 0x15, 0xc5,
+	0xd5, 0x65, 0x07, 0xf1, 0x21, 0x8f, 0xb3, 0xc5, 0xe2, 0xc4, 0xac, 0xe5, 0x2b, 0x1d, 0xd0, 0x82,
+	0x6f, 0x1b, 0x5c, 0x31, 0x0b, 0xbc, 0x72, 0x8a, 0x89, 0xe9, 0xf4, 0x69, 0x79, 0x87, 0x3c, 0xb1,
+	0xd9, 0xa5, 0xdb, 0x7e, 0x8d, 0xd3, 0x29, 0x32, 0x3a, 0x97, 0x5c, 0xb0, 0x7d, 0x6f, 0x30, 0x79,
+	0x9b, 0x8a, 0xfd, 0x8d, 0x3f, 0x06, 0x5c, 0x34, 0x4b, 0x71, 0x6e, 0x69, 0x2c, 0x99, 0xc7, 0xda,
+	0x8e, 0xc5, 0x8a, 0x67, 0x78, 0x8d, 0x49, 0x83, 0x04, 0x3f, 0x02, 0x1b, 0xe8, 0xac, 0x6b, 0x79,
+	0xae, 0x89, 0x1d, 0xbd, 0x3f, 0xc4, 0xde, 0xa0, 0x08, 0xfc, 0x7e, 0xfc, 0xda, 0xc5, 0x54, 0xaa,
+	0x30, 0x3f, 0x73, 0x80, 0x5b, 0xd8, 0x36, 0x09, 0xb2, 0x07, 0x64, 0x24, 0x6b, 0xeb, 0xa1, 0xee,
+	0x6d, 0xaa, 0x82, 0x3d, 0xb0, 0x8e, 0x82, 0xfe, 0xc8, 0x58, 0xe7, 0x97, 0xb2, 0x0e, 0x0e, 0xf8,
+	0xab, 0x41, 0xb4, 0xa8, 0x3d, 0x27, 0x1d, 0x0a, 0xa9, 0xd9, 0x5e, 0x9a, 0xb6, 0x66, 0xf9, 0x79,
+	0x12, 0xe4, 0xa3, 0x67, 0xfe, 0xc7, 0x20, 0x35, 0x42, 0x2e, 0x6b, 0xc2, 0x4a, 0xf5, 0x0a, 0xe3,
+	0xa4, 0xe1, 0x10, 0x8d, 0x9a, 0xc2, 0x87, 0x60, 0xd5, 0x38, 0x76, 0x89, 0x61, 0xf2, 0x76, 0x7d,
+	0x65, 0x2f, 0x81, 0x39, 0xfc, 0x21, 0x48, 0x3a, 0xb8, 0x98, 0xfa, 0x5a, 0x4e, 0x92, 0x0e, 0x86,
+	0x7d, 0x50, 0x70, 0xb0, 0xfe, 0xc4, 0x24, 0x27, 0xfa, 0x29, 0x22, 0xd8, 0xef, 0x15, 0x39, 0x45,
+	0xbd, 0x9a, 0xa7, 0x8b, 0xa9, 0xb4, 0xc9, 0x72, 0x1a, 0xf5, 0x25, 0x6b, 0xc0, 0xc1, 0x47, 0x26,
+	0x39, 0x39, 0x44, 0x04, 0xf3, 0x54, 0xbe, 0x14, 0x40, 0x9a, 0xce, 0xe8, 0xaf, 0x3f, 0x47, 0xb6,
+	0xc0, 0xca, 0x29, 0x26, 0x28, 0x98, 0x21, 0x6c, 0x01, 0xf7, 0xc2, 0xc7, 0x41, 0xea, 0xab, 0x3c,
+	0x0e, 0x94, 0x64, 0x51, 0x08, 0x1f, 0x08, 0x0f, 0xc0, 0x2a, 0xfb, 0xe5, 0x16, 0xd3, 0xfe, 0x9d,
+	0x7f, 0x7d, 0x91, 0xf1, 0xe5, 0x17, 0x89, 0x92, 0xa6, 0x59, 0xd2, 0x02, 0xe3, 0xbd, 0xec, 0x47,
+	0xc1, 0x78, 0xf9, 0x7b, 0x12, 0xac, 0xf1, 0xdb, 0xdc, 0x32, 0x86, 0x86, 0xed, 0xc2, 0x3f, 0x08,
+	0x20, 0x6f, 0x9b, 0x4e, 0xd8, 0x5c, 0x84, 0x65, 0xcd, 0x45, 0xa7, 0xbe, 0xcf, 0xa7, 0xd2, 0xab,
+	0x11, 0xab, 0xd9, 0x7d, 0x98, 0xe5, 0x29, 0xa2, 0xbe, 0x5a, 0xcf, 0x01, 0xb6, 0xe9, 0x04, 0x1d,
+	0xe7, 0xf7, 0x02, 0x80, 0xb6, 0x71, 0x16, 0x38, 0xd2, 0x07, 0x68, 0x68, 0xe2, 0x1e, 0x9f, 0x6b,
+	0xd7, 0x2e, 0xdd, 0xa8, 0x3a, 0x7f, 0xaf, 0xb1, 0x63, 0x72, 0x3e, 0x95, 0xae, 0x5f, 0x36, 0x8e,
+	0x71, 0xe5, 0x13, 0xe5, 0x32, 0x4a, 0xfe, 0x88, 0x5e, 0x3a, 0xd1, 0x36, 0xce, 0x82, 0x74, 0x31,
+	0xf1, 0x6f, 0x04, 0x50, 0x38, 0xf4, 0xdb, 0x07, 0xcf, 0xdf, 0x07, 0x80, 0xb7, 0x93, 0x80, 0x9b,
+	0xb0, 0x8c, 0xdb, 0x3d, 0xce, 0x6d, 0x27, 0x66, 0x17, 0xa3, 0xb5, 0x15, 0xeb, 0x5e, 0x51, 0x46,
+	0x05, 0x26, 0xe3, 0x6c, 0x3e, 0x59, 0xe1, 0xf7, 0x9f, 0x93, 0x79, 0x1f, 0x64, 0x7e, 0xe9, 0xe1,
+	0xa1, 0x67, 0xfb, 0x2c, 0x0a, 0x8a, 0x72, 0xb5, 0x17, 0xe5, 0xf9, 0x54, 0x12, 0x99, 0xfd, 0x8c,
+	0x8d, 0xc6, 0x3d, 0xc2, 0x2e, 0xc8, 0x91, 0x93, 0x21, 0x72, 0x4f, 0xb0, 0xc5, 0x0a, 0x50, 0x50,
+	0xd4, 0x2b, 0xbb, 0xdf, 0x0c, 0x5d, 0x44, 0x22, 0xcc, 0xfc, 0xc2, 0xb1, 0x00, 0xd6, 0xe9, 0x0d,
+	0xd5, 0x67, 0xa1, 0x52, 0x7e, 0xa8, 0xee, 0x95, 0x43, 0x15, 0xe3, 0x7e, 0x62, 0xf9, 0xe5, 0x7d,
+	0x36, 0x8e, 0x90, 0xb5, 0x35, 0x2a, 0xe8, 0x84, 0x64, 0x7e, 0x2d, 0x00, 0x71, 0xd6, 0xf8, 0x9f,
+	0x98, 0x4e, 0x0f, 0x3f, 0x29, 0xa6, 0x97, 0x95, 0xb7, 0xc6, 0xcb, 0x5b, 0x9a, 0x37, 0x8d, 0x31,
+	0xd8, 0x99, 0x9f, 0x2b, 0x0c, 0xc3, 0x8a, 0x3c, 0x9b, 0x47, 0x47, 0xbe, 0x14, 0xfe, 0xd6, 0x67,
+	0x12, 0x0c, 0x85, 0x1e, 0xb2, 0x8c, 0x91, 0x5b, 0x5c, 0xf1, 0x6f, 0xea, 0xc2, 0x07, 0x5a, 0xf8,
+	0x40, 0xaf, 0x53, 0xa8, 0xf2, 0xd6, 0x8c, 0x52, 0xdc, 0xc7, 0x62, 0x4a, 0x71, 0x8c, 0xac, 0x6d,
+	0x84, 0x22, 0xdf, 0x9d, 0x0b, 0x7f, 0xc6, 0x8b, 0x64, 0x78, 0xe4, 0x04, 0x0f, 0x4d, 0x32, 0xf2,
+	0xdf, 0x3e, 0x39, 0xe5, 0x7b, 0x61, 0xda, 0x43, 0xcd, 0x97, 0xa6, 0x3d, 0x44, 0xf0, 0xb4, 0xd7,
+	0xc2, 0x35, 0x3d, 0x03, 0xf1, 0x0d, 0xc0, 0xb7, 0xc0, 0x5a, 0xd8, 0x76, 0x69, 0x8d, 0xf9, 0x84,
+	0x2b, 0xce, 0xae, 0x4a, 0x4c, 0x2d, 0x6b, 0x85, 0x60, 0xdd, 0x19, 0x0d, 0x10, 0xbc, 0x0b, 0x56,
+	0xfc, 0xbd, 0x2c, 0xef, 0x1b, 0x59, 0x9a, 0x29, 0xbf, 0x06, 0xcc, 0x42, 0xfe, 0x00, 0x64, 0x1f,
+	0xb9, 0x7d, 0x0d, 0x7b, 0x04, 0xc1, 0x6d, 0x90, 0xb1, 0x71, 0xcf, 0x0b, 0xbf, 0x72, 0xf8, 0x0a,
+	0xde, 0x05, 0x05, 0xdb, 0xed, 0xfb, 0x91, 0x75, 0x6f, 0x68, 0xf1, 0xc1, 0xb9, 0x33, 0x9b, 0x3d,
+	0x51, 0xad, 0xac, 0x01, 0xdb, 0xed, 0x53, 0x5a, 0x07, 0x43, 0x8b, 0x7e, 0x7b, 0xcc, 0x92, 0x98,
+	0x62, 0xdf, 0x1e, 0xa1, 0xe0, 0xe6, 0x7f, 0x04, 0x00, 0x22, 0xdf, 0x99, 0xb7, 0xc0, 0xce, 0x61,
+	0xb3, 0xa3, 0xea, 0xcd, 0x56, 0xa7, 0xd1, 0xdc, 0xd7, 0x0f, 0xf6, 0xdb, 0x2d, 0xf5, 0x7e, 0xe3,
+	0x41, 0x43, 0xad, 0x8b, 0x89, 0xd2, 0xc6, 0x78, 0x52, 0xc9, 0x33, 0xa0, 0x4a, 0xd3, 0x0d, 0x65,
+	0xb0, 0x11, 0x45, 0xbf, 0xa7, 0xb6, 0x45, 0xa1, 0xb4, 0x36, 0x9e, 0x54, 0x72, 0x0c, 0xf5, 0x1e,
+	0x72, 0xe1, 0x4d, 0xb0, 0x19, 0xc5, 0xd4, 0x94, 0x76, 0xa7, 0xd6, 0xd8, 0x17, 0x93, 0xa5, 0x57,
+	0xc6, 0x93, 0xca, 0x1a, 0xc3, 0xd5, 0xf8, 0x3c, 0xaf, 0x80, 0xf5, 0x28, 0x76, 0xbf, 0x29, 0xa6,
+	0x4a, 0x85, 0xf1, 0xa4, 0x92, 0x65, 0xb0, 0x7d, 0x0c, 0xef, 0x80, 0x62, 0x1c, 0xa1, 0x1f, 0x35,
+	0x3a, 0x0f, 0xf5, 0x43, 0xb5, 0xd3, 0x14, 0xd3, 0xa5, 0xad, 0xf1, 0xa4, 0x22, 0x06, 0xd8, 0x60,
+	0xf8, 0x96, 0xd2, 0x4f, 0xff, 0x58, 0x4e, 0xdc, 0xfc, 0x73, 0x0a, 0xac, 0xc7, 0x3f, 0x2a, 0x60,
+	0x15, 0x7c, 0xa3, 0xa5, 0x35, 0x5b, 0xcd, 0x76, 0xed, 0x1d, 0xbd, 0xdd, 0xa9, 0x75, 0x0e, 0xda,
+	0x73, 0x1b, 0xf6, 0xb7, 0xc2, 0xc0, 0xfb, 0xa6, 0x05, 0xef, 0x81, 0xf2, 0x3c, 0xbe, 0xae, 0xb6,
+	0x9a, 0xed, 0x46, 0x47, 0x6f, 0xa9, 0x5a, 0xa3, 0x59, 0x17, 0x85, 0xd2, 0xce, 0x78, 0x52, 0xd9,
+	0x64, 0x26, 0xb1, 0xae, 0x0e, 0xef, 0x82, 0x6f, 0xce, 0x1b, 0x1f, 0x36, 0x3b, 0x8d, 0xfd, 0xb7,
+	0x03, 0xdb, 0x64, 0x69, 0x7b, 0x3c, 0xa9, 0x40, 0x66, 0x7b, 0x18, 0x69, 0xc1, 0xf0, 0x16, 0xd8,
+	0x9e, 0x37, 0x6d, 0xd5, 0xda, 0x6d, 0xb5, 0x2e, 0xa6, 0x4a, 0xe2, 0x78, 0x52, 0x29, 0x30, 0x9b,
+	0x96, 0xe1, 0xba, 0xa8, 0x07, 0x6f, 0x83, 0xe2, 0x3c, 0x5a, 0x53, 0x7f, 0xa2, 0xde, 0xef, 0xa8,
+	0x75, 0x31, 0x5d, 0x82, 0xe3, 0x49, 0x65, 0x9d, 0xe1, 0x35, 0xf4, 0x73, 0xd4, 0x25, 0x68, 0xa1,
+	0xff, 0x07, 0xb5, 0xc6, 0x3b, 0x6a, 0x5d, 0x5c, 0x89, 0xfa, 0x7f, 0x60, 0x98, 0xd6, 0x62, 0xf4,
+	0xbb, 0x07, 0xea, 0x81, 0x5a, 0x17, 0x33, 0x51, 0xf4, 0xbb, 0x1e, 0xf2, 0x16, 0xa3, 0x69, 0xb1,
+	0xd4, 0xba, 0xb8, 0x1a, 0x45, 0xd3, 0x42, 0xa1, 0x1e, 0x2b, 0x95, 0xb2, 0xff, 0xfc, 0xf3, 0x72,
+	0xe2, 0xd3, 0xcf, 0xcb, 0x89, 0x5f, 0xbd, 0x28, 0x27, 0x9e, 0xbf, 0x28, 0x0b, 0x1f, 0xbf, 0x28,
+	0x0b, 0xff, 0x7e, 0x51, 0x16, 0x3e, 0x7c, 0x59, 0x4e, 0x7c, 0xfc, 0xb2, 0x9c, 0xf8, 0xf4, 0x65,
+	0x39, 0xf1, 0xfe, 0xff, 0x9e, 0xf6, 0x67, 0xfe, 0x3f, 0x88, 0xfc, 0x66, 0x7d, 0x9c, 0xf1, 0x2f,
+	0xe1, 0x77, 0xfe, 0x3b, 0x00, 0xb4, 0x7c, 0xb4, 0x7b, 0x3b, 0x12, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -682,6 +859,12 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.ExclusionGroup != that1.ExclusionGroup {
+		return false
+	}
+	if !this.ExecutionTime.Equal(that1.ExecutionTime) {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -792,6 +975,57 @@ func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgExecutionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecutionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecutionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -861,21 +1095,36 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintGov(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x4a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	dAtA[i] = 0x5a
+	if len(m.ExclusionGroup) > 0 {
+		i -= len(m.ExclusionGroup)
+		copy(dAtA[i:], m.ExclusionGroup)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExclusionGroup)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintGov(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x42
 	if len(m.TotalDeposit) > 0 {
 		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
@@ -891,21 +1140,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintGov(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x32
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintGov(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGov(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x2a
 	{
 		size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
@@ -1079,12 +1328,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintGov(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.MinDeposit) > 0 {
@@ -1124,12 +1373,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintGov(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1155,6 +1404,35 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VetoAuthority) > 0 {
+		i -= len(m.VetoAuthority)
+		copy(dAtA[i:], m.VetoAuthority)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoAuthority)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ExecutionDelays) > 0 {
+		for iNdEx := len(m.ExecutionDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ExclusionWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExclusionWindow):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
 	{
 		size := m.VetoThreshold.Size()
 		i -= size
@@ -1188,6 +1466,88 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProposalType) > 0 {
+		i -= len(m.ProposalType)
+		copy(dAtA[i:], m.ProposalType)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1230,6 +1590,29 @@ func (m *TextProposal) Size() (n int) {
 	return n
 }
 
+func (m *MsgExecutionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1284,6 +1667,12 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	l = len(m.ExclusionGroup)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExecutionTime)
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
@@ -1369,13 +1758,61 @@ func (m *TallyParams) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = m.VetoThreshold.Size()
 	n += 1 + l + sovGov(uint64(l))
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ExclusionWindow)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.ExecutionDelays) > 0 {
+		for _, e := range m.ExecutionDelays {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.VetoAuthority)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalType)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *MsgRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
 	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
@@ -1595,6 +2032,154 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgExecutionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecutionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecutionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1704,7 +2289,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
+			m.Amount = append(m.Amount, types1.Coin{})
 			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1808,7 +2393,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Content == nil {
-				m.Content = &types1.Any{}
+				m.Content = &types.Any{}
 			}
 			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1961,7 +2546,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			m.TotalDeposit = append(m.TotalDeposit, types1.Coin{})
 			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2032,6 +2617,71 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExecutionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2451,7 +3101,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
 			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2721,6 +3371,366 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ExclusionWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionDelays = append(m.ExecutionDelays, ExecutionDelay{})
+			if err := m.ExecutionDelays[len(m.ExecutionDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
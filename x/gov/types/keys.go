@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -19,6 +20,10 @@ const (
 	// RouterKey is the message route for gov
 	RouterKey = ModuleName
 
+	// MsgExecutionRouterKey is the proposal route of the Msg execution
+	// proposals
+	MsgExecutionRouterKey = "msgexecution"
+
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 )
@@ -34,18 +39,25 @@ const (
 //
 // - 0x03: nextProposalID
 //
+// - 0x04<executionTime_Bytes><proposalID_Bytes>: queuedProposalID
+//
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<exclusionGroup_Bytes>: lastPassTime
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
 	InactiveProposalQueuePrefix = []byte{0x02}
 	ProposalIDKey               = []byte{0x03}
+	ExecutionQueuePrefix        = []byte{0x04}
 
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	ExclusionGroupsKeyPrefix = []byte{0x30}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -87,6 +99,16 @@ func InactiveProposalQueueKey(proposalID uint64, endTime time.Time) []byte {
 	return append(InactiveProposalByTimeKey(endTime), GetProposalIDBytes(proposalID)...)
 }
 
+// ExecutionQueueByTimeKey gets the execution queue key by executionTime
+func ExecutionQueueByTimeKey(executionTime time.Time) []byte {
+	return append(ExecutionQueuePrefix, sdk.FormatTimeBytes(executionTime)...)
+}
+
+// ExecutionQueueKey returns the key for a proposalID in the executionQueue
+func ExecutionQueueKey(proposalID uint64, executionTime time.Time) []byte {
+	return append(ExecutionQueueByTimeKey(executionTime), GetProposalIDBytes(proposalID)...)
+}
+
 // DepositsKey gets the first part of the deposits key based on the proposalID
 func DepositsKey(proposalID uint64) []byte {
 	return append(DepositsKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -107,6 +129,12 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// ExclusionGroupKey gets the key of the last time a proposal of the exclusion
+// group passed
+func ExclusionGroupKey(exclusionGroup string) []byte {
+	return append(ExclusionGroupsKeyPrefix, exclusionGroup...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -128,6 +156,11 @@ func SplitInactiveProposalQueueKey(key []byte) (proposalID uint64, endTime time.
 	return splitKeyWithTime(key)
 }
 
+// SplitExecutionQueueKey split the execution queue key and returns the proposal id and executionTime
+func SplitExecutionQueueKey(key []byte) (proposalID uint64, executionTime time.Time) {
+	return splitKeyWithTime(key)
+}
+
 // SplitKeyDeposit split the deposits key and returns the proposal id and depositor address
 func SplitKeyDeposit(key []byte) (proposalID uint64, depositorAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
@@ -161,3 +194,50 @@ func splitKeyWithAddress(key []byte) (proposalID uint64, addr sdk.AccAddress) {
 	addr = sdk.AccAddress(key[10:])
 	return
 }
+
+// KeyFormats are the layouts of the keys of the gov store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "proposal",
+		Prefix:   ProposalsKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("proposal_id")},
+		Value:    keyformat.ProtoValue(&Proposal{}),
+	},
+	{
+		Name:     "active_proposal_queue",
+		Prefix:   ActiveProposalQueuePrefix,
+		Segments: []keyformat.Segment{keyformat.Time("voting_end_time"), keyformat.Uint64("proposal_id")},
+		Value:    keyformat.SegmentValue(keyformat.Uint64("proposal_id")),
+	},
+	{
+		Name:     "inactive_proposal_queue",
+		Prefix:   InactiveProposalQueuePrefix,
+		Segments: []keyformat.Segment{keyformat.Time("deposit_end_time"), keyformat.Uint64("proposal_id")},
+		Value:    keyformat.SegmentValue(keyformat.Uint64("proposal_id")),
+	},
+	{Name: "next_proposal_id", Prefix: ProposalIDKey, Value: keyformat.SegmentValue(keyformat.Uint64("proposal_id"))},
+	{
+		Name:     "execution_queue",
+		Prefix:   ExecutionQueuePrefix,
+		Segments: []keyformat.Segment{keyformat.Time("execution_time"), keyformat.Uint64("proposal_id")},
+		Value:    keyformat.SegmentValue(keyformat.Uint64("proposal_id")),
+	},
+	{
+		Name:     "deposit",
+		Prefix:   DepositsKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("proposal_id"), keyformat.AccAddress("depositor")},
+		Value:    keyformat.ProtoValue(&Deposit{}),
+	},
+	{
+		Name:     "vote",
+		Prefix:   VotesKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("proposal_id"), keyformat.AccAddress("voter")},
+		Value:    keyformat.ProtoValue(&Vote{}),
+	},
+	{
+		Name:     "exclusion_group",
+		Prefix:   ExclusionGroupsKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.String("exclusion_group")},
+		Value:    keyformat.SegmentValue(keyformat.Time("last_pass_time")),
+	},
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ MsgRouteRegistry = (*msgRouteRegistry)(nil)
+
+// MsgRouteRegistry is a registry where modules declare which of their Msgs are
+// intended to be executed through governance, along with the authority address
+// they require as the Msg signer. The registered routes are validated by the
+// governance keeper at app startup.
+type MsgRouteRegistry interface {
+	AddMsgRoute(module string, msg sdk.Msg, authority string) (registry MsgRouteRegistry)
+	HasMsgRoute(msgTypeURL string) bool
+	GetMsgRoute(msgTypeURL string) (route MsgRoute, template sdk.Msg, found bool)
+	MsgRoutes() []MsgRoute
+	Seal()
+}
+
+type msgRouteEntry struct {
+	route    MsgRoute
+	template sdk.Msg
+}
+
+type msgRouteRegistry struct {
+	routes map[string]msgRouteEntry
+	sealed bool
+}
+
+// NewMsgRouteRegistry creates a new MsgRouteRegistry interface instance
+func NewMsgRouteRegistry() MsgRouteRegistry {
+	return &msgRouteRegistry{
+		routes: make(map[string]msgRouteEntry),
+	}
+}
+
+// Seal seals the registry which prohibits any subsequent routes to be added.
+// Seal will panic if called more than once.
+func (r *msgRouteRegistry) Seal() {
+	if r.sealed {
+		panic("msg route registry already sealed")
+	}
+	r.sealed = true
+}
+
+// AddMsgRoute registers msg as a Msg of the given module to be executed through
+// governance, signed by authority. msg is used as a template when validating
+// the route, so its signer must be set to authority. It returns the
+// MsgRouteRegistry so AddMsgRoute calls can be linked. It will panic if the
+// registry is sealed or if a route is already registered for the Msg type.
+func (r *msgRouteRegistry) AddMsgRoute(module string, msg sdk.Msg, authority string) MsgRouteRegistry {
+	if r.sealed {
+		panic("msg route registry sealed; cannot add msg route")
+	}
+
+	if module == "" {
+		panic("msg route module cannot be empty")
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	if r.HasMsgRoute(msgTypeURL) {
+		panic(fmt.Sprintf("msg route %s has already been registered by module %s", msgTypeURL, r.routes[msgTypeURL].route.Module))
+	}
+
+	r.routes[msgTypeURL] = msgRouteEntry{
+		route: MsgRoute{
+			Module:     module,
+			MsgTypeUrl: msgTypeURL,
+			Authority:  authority,
+		},
+		template: msg,
+	}
+	return r
+}
+
+// HasMsgRoute returns true if a route is registered for the Msg type URL or
+// false otherwise.
+func (r *msgRouteRegistry) HasMsgRoute(msgTypeURL string) bool {
+	_, ok := r.routes[msgTypeURL]
+	return ok
+}
+
+// GetMsgRoute returns the route and the template Msg registered for the Msg
+// type URL.
+func (r *msgRouteRegistry) GetMsgRoute(msgTypeURL string) (MsgRoute, sdk.Msg, bool) {
+	entry, ok := r.routes[msgTypeURL]
+	return entry.route, entry.template, ok
+}
+
+// MsgRoutes returns all the registered routes, sorted by Msg type URL.
+func (r *msgRouteRegistry) MsgRoutes() []MsgRoute {
+	routes := make([]MsgRoute, 0, len(r.routes))
+	for _, entry := range r.routes {
+		routes = append(routes, entry.route)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].MsgTypeUrl < routes[j].MsgTypeUrl
+	})
+
+	return routes
+}
+
+// String implements stringer interface
+func (mr MsgRoute) String() string {
+	out, _ := yaml.Marshal(mr)
+	return string(out)
+}
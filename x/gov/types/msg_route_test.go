@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMsgRouteRegistry(t *testing.T) {
+	authority := sdk.AccAddress("authority").String()
+	registry := types.NewMsgRouteRegistry()
+
+	registry.AddMsgRoute(banktypes.ModuleName, &banktypes.MsgSend{FromAddress: authority}, authority).
+		AddMsgRoute(banktypes.ModuleName, &banktypes.MsgMultiSend{}, authority)
+
+	require.True(t, registry.HasMsgRoute(sdk.MsgTypeURL(&banktypes.MsgSend{})))
+	require.False(t, registry.HasMsgRoute("/cosmos.gov.v1beta1.MsgVote"))
+
+	route, template, found := registry.GetMsgRoute(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.True(t, found)
+	require.Equal(t, types.MsgRoute{Module: banktypes.ModuleName, MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Authority: authority}, route)
+	require.Equal(t, &banktypes.MsgSend{FromAddress: authority}, template)
+
+	// routes are sorted by type URL
+	routes := registry.MsgRoutes()
+	require.Len(t, routes, 2)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgMultiSend", routes[0].MsgTypeUrl)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", routes[1].MsgTypeUrl)
+
+	require.Panics(t, func() { registry.AddMsgRoute("other", &banktypes.MsgSend{}, authority) })
+	require.Panics(t, func() { registry.AddMsgRoute("", &banktypes.MsgSend{}, authority) })
+
+	registry.Seal()
+	require.Panics(t, func() { registry.Seal() })
+	require.Panics(t, func() { registry.AddMsgRoute("gov", &banktypes.MsgSend{}, authority) })
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
 // Governance message types and routes
@@ -18,11 +19,12 @@ const (
 	TypeMsgVote           = "vote"
 	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgVetoProposal   = "veto_proposal"
 )
 
 var (
-	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
-	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
+	_, _, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgVetoProposal{}
+	_             types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -62,6 +64,10 @@ func (m *MsgSubmitProposal) SetProposer(address fmt.Stringer) {
 	m.Proposer = address.String()
 }
 
+func (m *MsgSubmitProposal) SetExclusionGroup(exclusionGroup string) {
+	m.ExclusionGroup = exclusionGroup
+}
+
 func (m *MsgSubmitProposal) SetContent(content Content) error {
 	msg, ok := content.(proto.Message)
 	if !ok {
@@ -92,6 +98,9 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 	if m.InitialDeposit.IsAnyNegative() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.InitialDeposit.String())
 	}
+	if err := ValidateExclusionGroup(m.ExclusionGroup); err != nil {
+		return err
+	}
 
 	content := m.GetContent()
 	if content == nil {
@@ -115,8 +124,7 @@ func (m MsgSubmitProposal) GetSignBytes() []byte {
 
 // GetSigners implements Msg
 func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
-	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
-	return []sdk.AccAddress{proposer}
+	return msgservice.MustGetSigners(&m)
 }
 
 // String implements the Stringer interface
@@ -172,8 +180,7 @@ func (msg MsgDeposit) GetSignBytes() []byte {
 
 // GetSigners implements Msg
 func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
-	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
-	return []sdk.AccAddress{depositor}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // NewMsgVote creates a message to cast a vote on an active proposal
@@ -215,8 +222,7 @@ func (msg MsgVote) GetSignBytes() []byte {
 
 // GetSigners implements Msg
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
-	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
-	return []sdk.AccAddress{voter}
+	return msgservice.MustGetSigners(&msg)
 }
 
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
@@ -279,6 +285,43 @@ func (msg MsgVoteWeighted) GetSignBytes() []byte {
 
 // GetSigners implements Msg
 func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
-	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
-	return []sdk.AccAddress{voter}
+	return msgservice.MustGetSigners(&msg)
+}
+
+// NewMsgVetoProposal creates a message to veto a proposal queued for execution
+//nolint:interfacer
+func NewMsgVetoProposal(authority sdk.AccAddress, proposalID uint64) *MsgVetoProposal {
+	return &MsgVetoProposal{proposalID, authority.String()}
+}
+
+// Route implements Msg
+func (msg MsgVetoProposal) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVetoProposal) Type() string { return TypeMsgVetoProposal }
+
+// ValidateBasic implements Msg
+func (msg MsgVetoProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgVetoProposal) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVetoProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVetoProposal) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
 }
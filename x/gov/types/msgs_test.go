@@ -59,6 +59,35 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitProposalExclusionGroup(t *testing.T) {
+	tests := []struct {
+		exclusionGroup string
+		expectPass     bool
+	}{
+		{"", true},
+		{"staking/MaxValidators", true},
+		{strings.Repeat("#", MaxExclusionGroupLength), true},
+		{strings.Repeat("#", MaxExclusionGroupLength+1), false},
+		{" staking/MaxValidators", false},
+	}
+
+	for i, tc := range tests {
+		msg, err := NewMsgSubmitProposal(
+			ContentFromProposalType("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText),
+			coinsPos,
+			addrs[0],
+		)
+		require.NoError(t, err)
+		msg.SetExclusionGroup(tc.exclusionGroup)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidExclusionGroup, "test: %v", i)
+		}
+	}
+}
+
 func TestMsgDepositGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgDeposit(addr, 0, coinsPos)
@@ -174,3 +203,11 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 		`{"type":"cosmos-sdk/MsgSubmitProposal","value":{"content":{"type":"cosmos-sdk/TextProposal","value":{"description":"abcd","title":"test"}},"initial_deposit":[]}}`,
 		string(bz))
 }
+
+func TestMsgVetoProposal(t *testing.T) {
+	msg := NewMsgVetoProposal(addrs[0], 1)
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Authority = ""
+	require.Error(t, msg.ValidateBasic())
+}
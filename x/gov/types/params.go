@@ -98,7 +98,32 @@ func DefaultTallyParams() TallyParams {
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.VetoThreshold.Equal(other.VetoThreshold) &&
+		tp.ExclusionWindow == other.ExclusionWindow && tp.VetoAuthority == other.VetoAuthority &&
+		executionDelaysEqual(tp.ExecutionDelays, other.ExecutionDelays)
+}
+
+func executionDelaysEqual(a, b []ExecutionDelay) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ExecutionDelay returns the delay between the passing and the execution of
+// proposals of the given type, or zero if none is configured.
+func (tp TallyParams) ExecutionDelay(proposalType string) time.Duration {
+	for _, d := range tp.ExecutionDelays {
+		if d.ProposalType == proposalType {
+			return d.Delay
+		}
+	}
+	return 0
 }
 
 // String implements stringer insterface
@@ -107,6 +132,12 @@ func (tp TallyParams) String() string {
 	return string(out)
 }
 
+// String implements stringer insterface
+func (d ExecutionDelay) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
 func validateTallyParams(i interface{}) error {
 	v, ok := i.(TallyParams)
 	if !ok {
@@ -131,6 +162,36 @@ func validateTallyParams(i interface{}) error {
 	if v.VetoThreshold.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExclusionWindow < 0 {
+		return fmt.Errorf("exclusion window cannot be negative: %s", v.ExclusionWindow)
+	}
+	if err := validateExecutionParams(v); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateExecutionParams checks the execution delays and the veto authority
+// of the tally params.
+func validateExecutionParams(tp TallyParams) error {
+	seen := make(map[string]bool, len(tp.ExecutionDelays))
+	for _, d := range tp.ExecutionDelays {
+		if d.ProposalType == "" {
+			return fmt.Errorf("execution delay proposal type cannot be empty")
+		}
+		if seen[d.ProposalType] {
+			return fmt.Errorf("duplicate execution delay for proposal type: %s", d.ProposalType)
+		}
+		seen[d.ProposalType] = true
+		if d.Delay < 0 {
+			return fmt.Errorf("execution delay cannot be negative: %s", d.Delay)
+		}
+	}
+	if tp.VetoAuthority != "" {
+		if _, err := sdk.AccAddressFromBech32(tp.VetoAuthority); err != nil {
+			return fmt.Errorf("invalid veto authority: %w", err)
+		}
+	}
 
 	return nil
 }
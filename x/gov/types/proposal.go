@@ -16,6 +16,10 @@ import (
 // DefaultStartingProposalID is 1
 const DefaultStartingProposalID uint64 = 1
 
+// MaxExclusionGroupLength is the maximum length of the name of an exclusion
+// group
+const MaxExclusionGroupLength int = 64
+
 // NewProposal creates a new Proposal instance
 func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
 	msg, ok := content.(proto.Message)
@@ -41,6 +45,19 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 	return p, nil
 }
 
+// ValidateExclusionGroup validates the name of the exclusion group of a
+// proposal, which is empty for the proposals outside of any exclusion group.
+func ValidateExclusionGroup(exclusionGroup string) error {
+	if len(exclusionGroup) > MaxExclusionGroupLength {
+		return sdkerrors.Wrapf(ErrInvalidExclusionGroup, "exclusion group is longer than max length of %d", MaxExclusionGroupLength)
+	}
+	if strings.TrimSpace(exclusionGroup) != exclusionGroup {
+		return sdkerrors.Wrap(ErrInvalidExclusionGroup, "exclusion group cannot have leading or trailing whitespaces")
+	}
+
+	return nil
+}
+
 // String implements stringer interface
 func (p Proposal) String() string {
 	out, _ := yaml.Marshal(p)
@@ -149,7 +166,9 @@ func ValidProposalStatus(status ProposalStatus) bool {
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
 		status == StatusRejected ||
-		status == StatusFailed {
+		status == StatusFailed ||
+		status == StatusQueued ||
+		status == StatusVetoed {
 		return true
 	}
 	return false
@@ -180,7 +199,8 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText         string = "Text"
+	ProposalTypeMsgExecution string = "MsgExecution"
 )
 
 // Implements Content Interface
@@ -212,8 +232,109 @@ func (tp TextProposal) String() string {
 	return string(out)
 }
 
+// Implements Content Interface
+var (
+	_ Content                       = &MsgExecutionProposal{}
+	_ types.UnpackInterfacesMessage = &MsgExecutionProposal{}
+)
+
+// NewMsgExecutionProposal creates a proposal Content executing msgs once
+// approved.
+func NewMsgExecutionProposal(title, description string, msgs []sdk.Msg) (*MsgExecutionProposal, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return &MsgExecutionProposal{Title: title, Description: description, Messages: msgsAny}, nil
+}
+
+// GetTitle returns the proposal title
+func (p *MsgExecutionProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the proposal description
+func (p *MsgExecutionProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the route of the Msg execution proposal handler
+func (p *MsgExecutionProposal) ProposalRoute() string { return MsgExecutionRouterKey }
+
+// ProposalType is "MsgExecution"
+func (p *MsgExecutionProposal) ProposalType() string { return ProposalTypeMsgExecution }
+
+// GetMsgs returns the Msgs executed by the proposal, unpacked from its
+// messages.
+func (p *MsgExecutionProposal) GetMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(p.Messages))
+	for i, msgAny := range p.Messages {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d is not an sdk.Msg: %s", i, msgAny.GetTypeUrl())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// ValidateBasic validates the title and description of the proposal, and its
+// Msgs.
+func (p *MsgExecutionProposal) ValidateBasic() error {
+	if err := ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.Messages) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "proposal must execute at least one message")
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalContent, "message %d: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// String implements Stringer interface
+func (p MsgExecutionProposal) String() string {
+	msgTypeURLs := make([]string, len(p.Messages))
+	for i, msgAny := range p.Messages {
+		msgTypeURLs[i] = msgAny.GetTypeUrl()
+	}
+
+	return fmt.Sprintf(`Msg Execution Proposal:
+  Title:       %s
+  Description: %s
+  Messages:    %s
+`, p.Title, p.Description, strings.Join(msgTypeURLs, ", "))
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p *MsgExecutionProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, msgAny := range p.Messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(msgAny, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:         {},
+	ProposalTypeMsgExecution: {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
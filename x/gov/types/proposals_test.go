@@ -3,8 +3,14 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +28,40 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestMsgExecutionProposal(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	send := banktypes.NewMsgSend(addr, addr, coins)
+
+	p, err := NewMsgExecutionProposal("title", "description", []sdk.Msg{send})
+	require.NoError(t, err)
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, ProposalTypeMsgExecution, p.ProposalType())
+	require.Equal(t, MsgExecutionRouterKey, p.ProposalRoute())
+
+	// the Msgs are unpacked with the proposal
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	proposal, err := NewProposal(p, 1, time.Now().UTC(), time.Now().UTC())
+	require.NoError(t, err)
+	bz, err := cdc.Marshal(&proposal)
+	require.NoError(t, err)
+	var decoded Proposal
+	require.NoError(t, cdc.Unmarshal(bz, &decoded))
+	msgs, err := decoded.GetContent().(*MsgExecutionProposal).GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	// a proposal must execute valid Msgs
+	p, err = NewMsgExecutionProposal("title", "description", nil)
+	require.NoError(t, err)
+	require.ErrorIs(t, p.ValidateBasic(), ErrInvalidProposalContent)
+
+	p, err = NewMsgExecutionProposal("title", "description", []sdk.Msg{banktypes.NewMsgSend(addr, addr, nil)})
+	require.NoError(t, err)
+	require.ErrorIs(t, p.ValidateBasic(), ErrInvalidProposalContent)
+}
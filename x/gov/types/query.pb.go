@@ -817,6 +817,193 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
+// QueryMsgRoutesRequest is the request type for the Query/MsgRoutes RPC method.
+type QueryMsgRoutesRequest struct {
+}
+
+func (m *QueryMsgRoutesRequest) Reset()         { *m = QueryMsgRoutesRequest{} }
+func (m *QueryMsgRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgRoutesRequest) ProtoMessage()    {}
+func (*QueryMsgRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{16}
+}
+func (m *QueryMsgRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgRoutesRequest.Merge(m, src)
+}
+func (m *QueryMsgRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgRoutesRequest proto.InternalMessageInfo
+
+// QueryMsgRoutesResponse is the response type for the Query/MsgRoutes RPC
+// method.
+type QueryMsgRoutesResponse struct {
+	// msg_routes are the registered Msg routes, sorted by Msg type URL.
+	MsgRoutes []MsgRoute `protobuf:"bytes,1,rep,name=msg_routes,json=msgRoutes,proto3" json:"msg_routes"`
+}
+
+func (m *QueryMsgRoutesResponse) Reset()         { *m = QueryMsgRoutesResponse{} }
+func (m *QueryMsgRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMsgRoutesResponse) ProtoMessage()    {}
+func (*QueryMsgRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{17}
+}
+func (m *QueryMsgRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMsgRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMsgRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMsgRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMsgRoutesResponse.Merge(m, src)
+}
+func (m *QueryMsgRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMsgRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMsgRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMsgRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryMsgRoutesResponse) GetMsgRoutes() []MsgRoute {
+	if m != nil {
+		return m.MsgRoutes
+	}
+	return nil
+}
+
+// QueryExecutionQueueRequest is the request type for the Query/ExecutionQueue
+// RPC method.
+type QueryExecutionQueueRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionQueueRequest) Reset()         { *m = QueryExecutionQueueRequest{} }
+func (m *QueryExecutionQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionQueueRequest) ProtoMessage()    {}
+func (*QueryExecutionQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{18}
+}
+func (m *QueryExecutionQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionQueueRequest.Merge(m, src)
+}
+func (m *QueryExecutionQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionQueueRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExecutionQueueResponse is the response type for the
+// Query/ExecutionQueue RPC method.
+type QueryExecutionQueueResponse struct {
+	// proposals are the proposals queued for execution.
+	Proposals []Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionQueueResponse) Reset()         { *m = QueryExecutionQueueResponse{} }
+func (m *QueryExecutionQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionQueueResponse) ProtoMessage()    {}
+func (*QueryExecutionQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e35c0d133e91c0a2, []int{19}
+}
+func (m *QueryExecutionQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionQueueResponse.Merge(m, src)
+}
+func (m *QueryExecutionQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionQueueResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionQueueResponse) GetProposals() []Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryExecutionQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryProposalRequest)(nil), "cosmos.gov.v1beta1.QueryProposalRequest")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.gov.v1beta1.QueryProposalResponse")
@@ -834,73 +1021,85 @@ func init() {
 	proto.RegisterType((*QueryDepositsResponse)(nil), "cosmos.gov.v1beta1.QueryDepositsResponse")
 	proto.RegisterType((*QueryTallyResultRequest)(nil), "cosmos.gov.v1beta1.QueryTallyResultRequest")
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.gov.v1beta1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryMsgRoutesRequest)(nil), "cosmos.gov.v1beta1.QueryMsgRoutesRequest")
+	proto.RegisterType((*QueryMsgRoutesResponse)(nil), "cosmos.gov.v1beta1.QueryMsgRoutesResponse")
+	proto.RegisterType((*QueryExecutionQueueRequest)(nil), "cosmos.gov.v1beta1.QueryExecutionQueueRequest")
+	proto.RegisterType((*QueryExecutionQueueResponse)(nil), "cosmos.gov.v1beta1.QueryExecutionQueueResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/query.proto", fileDescriptor_e35c0d133e91c0a2) }
 
 var fileDescriptor_e35c0d133e91c0a2 = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x4b, 0x9c, 0xd6, 0x9e, 0xb4, 0x01, 0x86, 0x00, 0xd6, 0x12, 0xec, 0xb0, 0xa2, 0xad,
-	0x49, 0xa9, 0x97, 0x24, 0x05, 0xd4, 0x16, 0x50, 0x89, 0x50, 0x5b, 0x54, 0x09, 0x15, 0xa7, 0x02,
-	0x89, 0x03, 0xd1, 0xa6, 0x5e, 0x2d, 0x2b, 0x1c, 0xbf, 0xad, 0xdf, 0xb3, 0x45, 0x14, 0x22, 0x24,
-	0x4e, 0x20, 0x2e, 0xa0, 0x22, 0x6e, 0x88, 0x4a, 0x95, 0xf8, 0x2d, 0x3d, 0x56, 0x82, 0x03, 0x27,
-	0x84, 0x12, 0x0e, 0x88, 0xdf, 0xc0, 0x01, 0xed, 0x7b, 0xf3, 0xd6, 0xbb, 0xce, 0x3a, 0xbb, 0x29,
-	0x55, 0x4f, 0xb1, 0xe7, 0x7d, 0x33, 0xf3, 0x7d, 0x33, 0xf3, 0xe6, 0xc5, 0x50, 0xbf, 0xc5, 0xc5,
-	0x16, 0x17, 0x8e, 0xcf, 0x87, 0xce, 0x70, 0x79, 0xd3, 0x93, 0xee, 0xb2, 0x73, 0x7b, 0xe0, 0xf5,
-	0xb7, 0x5b, 0x61, 0x9f, 0x4b, 0x8e, 0xa8, 0xcf, 0x5b, 0x3e, 0x1f, 0xb6, 0xe8, 0xdc, 0x5a, 0x22,
-	0x9f, 0x4d, 0x57, 0x78, 0x1a, 0x1c, 0xbb, 0x86, 0xae, 0x1f, 0xf4, 0x5c, 0x19, 0xf0, 0x9e, 0xf6,
-	0xb7, 0xe6, 0x7d, 0xee, 0x73, 0xf5, 0xd1, 0x89, 0x3e, 0x91, 0x75, 0xc1, 0xe7, 0xdc, 0xef, 0x7a,
-	0x8e, 0x1b, 0x06, 0x8e, 0xdb, 0xeb, 0x71, 0xa9, 0x5c, 0x84, 0x39, 0xcd, 0xe0, 0x14, 0xe5, 0x57,
-	0xa7, 0xf6, 0x1b, 0x30, 0xff, 0x41, 0x94, 0xf3, 0x46, 0x9f, 0x87, 0x5c, 0xb8, 0xdd, 0xb6, 0x77,
-	0x7b, 0xe0, 0x09, 0x89, 0x0d, 0x98, 0x0d, 0xc9, 0xb4, 0x11, 0x74, 0x6a, 0x6c, 0x91, 0x35, 0xcb,
-	0x6d, 0x30, 0xa6, 0xf7, 0x3a, 0xf6, 0x47, 0xf0, 0xcc, 0x98, 0xa3, 0x08, 0x79, 0x4f, 0x78, 0xf8,
-	0x36, 0x54, 0x0c, 0x4c, 0xb9, 0xcd, 0xae, 0x2c, 0xb4, 0x0e, 0xca, 0x6e, 0x19, 0xbf, 0xb5, 0xf2,
-	0xfd, 0x3f, 0x1a, 0xa5, 0x76, 0xec, 0x63, 0xff, 0xc3, 0xc6, 0x22, 0x0b, 0xc3, 0xe9, 0x3a, 0x3c,
-	0x11, 0x73, 0x12, 0xd2, 0x95, 0x03, 0xa1, 0x12, 0xcc, 0xad, 0xd8, 0x87, 0x25, 0x58, 0x57, 0xc8,
-	0xf6, 0x5c, 0x98, 0xfa, 0x8e, 0xf3, 0x30, 0x33, 0xe4, 0xd2, 0xeb, 0xd7, 0xa6, 0x16, 0x59, 0xb3,
-	0xda, 0xd6, 0x5f, 0x70, 0x01, 0xaa, 0x1d, 0x2f, 0xe4, 0x22, 0x90, 0xbc, 0x5f, 0x9b, 0x56, 0x27,
-	0x23, 0x03, 0x5e, 0x01, 0x18, 0xb5, 0xa4, 0x56, 0x56, 0xe2, 0x4e, 0x9b, 0xdc, 0x51, 0xff, 0x5a,
-	0xba, 0xd9, 0x31, 0x05, 0xd7, 0xf7, 0x88, 0x7c, 0x3b, 0xe1, 0x79, 0xb1, 0xf2, 0xf5, 0xdd, 0x46,
-	0xe9, 0xef, 0xbb, 0x8d, 0x92, 0x7d, 0x8f, 0xc1, 0xb3, 0xe3, 0x62, 0xa9, 0x8e, 0x97, 0xa1, 0x6a,
-	0x28, 0x47, 0x3a, 0xa7, 0x0b, 0x16, 0x72, 0xe4, 0x84, 0x57, 0x53, 0x74, 0xa7, 0x14, 0xdd, 0x33,
-	0xb9, 0x74, 0x75, 0xfa, 0x24, 0x5f, 0x7b, 0x1d, 0x9e, 0x54, 0x24, 0x3f, 0xe4, 0xd2, 0x2b, 0x3a,
-	0x20, 0xd9, 0x05, 0x4e, 0x48, 0xbf, 0x0a, 0x4f, 0x25, 0x82, 0x92, 0xe8, 0x15, 0x28, 0x47, 0x38,
-	0x1a, 0x9c, 0x5a, 0x96, 0xde, 0x08, 0x4f, 0x5a, 0x15, 0xd6, 0xfe, 0x22, 0x11, 0x48, 0x14, 0xa6,
-	0x77, 0x25, 0xa3, 0x38, 0x0f, 0xd1, 0x4b, 0xfb, 0x0e, 0x03, 0x4c, 0xa6, 0x27, 0x21, 0xe7, 0xb5,
-	0x7a, 0xd3, 0xb9, 0x3c, 0x25, 0x1a, 0xfc, 0xe8, 0x3a, 0xf6, 0x1a, 0x91, 0xba, 0xe1, 0xf6, 0xdd,
-	0xad, 0x54, 0x51, 0x94, 0x61, 0x43, 0x6e, 0x87, 0xba, 0xc8, 0xd5, 0xc8, 0x2d, 0x32, 0xdd, 0xdc,
-	0x0e, 0x3d, 0xfb, 0x5f, 0x06, 0x4f, 0xa7, 0xfc, 0x48, 0xcd, 0x75, 0x38, 0x39, 0xe4, 0x32, 0xe8,
-	0xf9, 0x1b, 0x1a, 0x4c, 0xfd, 0x59, 0x9c, 0xa0, 0x2a, 0xe8, 0xf9, 0x3a, 0x00, 0xa9, 0x3b, 0x31,
-	0x4c, 0xd8, 0xf0, 0x7d, 0x98, 0xa3, 0x2b, 0x65, 0xa2, 0x69, 0xa1, 0x2f, 0x66, 0x45, 0x7b, 0x57,
-	0x23, 0x53, 0xe1, 0x4e, 0x76, 0x92, 0x46, 0xbc, 0x06, 0x27, 0xa4, 0xdb, 0xed, 0x6e, 0x9b, 0x68,
-	0xd3, 0x2a, 0x5a, 0x23, 0x2b, 0xda, 0xcd, 0x08, 0x97, 0x8a, 0x35, 0x2b, 0x47, 0x26, 0xfb, 0x13,
-	0x52, 0x4f, 0x49, 0x0b, 0xcf, 0x52, 0x6a, 0x6b, 0x4c, 0x8d, 0x6d, 0x8d, 0xc4, 0xc8, 0xaf, 0xd3,
-	0xb2, 0x8d, 0xe3, 0x53, 0x79, 0x2f, 0xc1, 0x71, 0x82, 0x53, 0x61, 0x9f, 0x3f, 0xa4, 0x14, 0x44,
-	0xdc, 0x78, 0xd8, 0x5f, 0xa6, 0x83, 0x3e, 0xfe, 0x1b, 0xf0, 0xb3, 0x59, 0xd8, 0x23, 0x06, 0xa4,
-	0xeb, 0x2d, 0xa8, 0x10, 0x4b, 0x73, 0x0f, 0x0a, 0x08, 0x8b, 0x5d, 0x1e, 0xdd, 0x6d, 0xb8, 0x08,
-	0xcf, 0x29, 0x82, 0xaa, 0xfd, 0x6d, 0x4f, 0x0c, 0xba, 0xf2, 0x08, 0xef, 0x5c, 0xed, 0xa0, 0x6f,
-	0xdc, 0xb7, 0x19, 0x35, 0x3e, 0xd4, 0xb5, 0xc9, 0x23, 0xa7, 0xfd, 0xcc, 0x5d, 0x57, 0x3e, 0x2b,
-	0xbf, 0x55, 0x61, 0x46, 0x45, 0xc6, 0x1f, 0x18, 0x54, 0xcc, 0x16, 0xc7, 0x66, 0x56, 0x90, 0xac,
-	0x27, 0xda, 0x7a, 0xb9, 0x00, 0x52, 0x13, 0xb5, 0x57, 0xbf, 0xfa, 0xf5, 0xaf, 0x3b, 0x53, 0xe7,
-	0xf0, 0xac, 0x93, 0xf1, 0xcf, 0x40, 0xfc, 0x60, 0x38, 0x3b, 0x89, 0x52, 0xec, 0xe2, 0x37, 0x0c,
-	0xaa, 0xf1, 0xb3, 0x84, 0xf9, 0xd9, 0xcc, 0xe4, 0x59, 0x4b, 0x45, 0xa0, 0xc4, 0xec, 0x94, 0x62,
-	0xd6, 0xc0, 0x17, 0x0e, 0x65, 0x86, 0x3f, 0x32, 0x28, 0x47, 0xeb, 0x12, 0x5f, 0x9a, 0x18, 0x3b,
-	0xf1, 0x38, 0x59, 0xa7, 0x72, 0x50, 0x94, 0xfc, 0x1d, 0x95, 0xfc, 0x12, 0x5e, 0x38, 0x42, 0x59,
-	0x1c, 0xb5, 0xa9, 0x9d, 0x1d, 0xf5, 0x9c, 0xed, 0xe2, 0xf7, 0x0c, 0x66, 0xd4, 0xe6, 0xc7, 0xc3,
-	0x73, 0xc6, 0xc5, 0x39, 0x9d, 0x07, 0x23, 0x6e, 0x17, 0x14, 0xb7, 0x55, 0x5c, 0x3e, 0x32, 0x37,
-	0xfc, 0x96, 0xc1, 0x31, 0xda, 0x8d, 0x93, 0xb3, 0xa5, 0x5e, 0x06, 0xeb, 0x4c, 0x2e, 0x8e, 0x68,
-	0xbd, 0xaa, 0x68, 0x2d, 0x61, 0x33, 0x93, 0x96, 0xc2, 0x3a, 0x3b, 0x89, 0x47, 0x66, 0x17, 0x7f,
-	0x61, 0x70, 0x9c, 0x6e, 0x38, 0x4e, 0x4e, 0x93, 0x5e, 0xb9, 0x56, 0x33, 0x1f, 0x48, 0x84, 0xae,
-	0x29, 0x42, 0x6b, 0x78, 0xf9, 0x28, 0x75, 0x32, 0x2b, 0xc6, 0xd9, 0x89, 0xd7, 0xf4, 0x2e, 0xfe,
-	0xc4, 0xa0, 0x62, 0x56, 0x18, 0xe6, 0x12, 0x10, 0xf9, 0xd7, 0x70, 0x7c, 0x1f, 0xda, 0x6f, 0x2a,
-	0xae, 0xaf, 0xe3, 0xf9, 0x87, 0xe1, 0x8a, 0xf7, 0x18, 0xcc, 0x26, 0xb6, 0x09, 0x9e, 0x9d, 0x98,
-	0xf8, 0xe0, 0x9e, 0xb3, 0x5e, 0x29, 0x06, 0xfe, 0x3f, 0xc3, 0xa7, 0xd6, 0xda, 0xda, 0xda, 0xfd,
-	0xbd, 0x3a, 0x7b, 0xb0, 0x57, 0x67, 0x7f, 0xee, 0xd5, 0xd9, 0x77, 0xfb, 0xf5, 0xd2, 0x83, 0xfd,
-	0x7a, 0xe9, 0xf7, 0xfd, 0x7a, 0xe9, 0xe3, 0xa6, 0x1f, 0xc8, 0x4f, 0x07, 0x9b, 0xad, 0x5b, 0x7c,
-	0xcb, 0x84, 0xd5, 0x7f, 0xce, 0x89, 0xce, 0x67, 0xce, 0xe7, 0x2a, 0x47, 0x34, 0x32, 0x62, 0xf3,
-	0x98, 0xfa, 0x6d, 0xb2, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x86, 0xe2, 0x1a, 0x4f,
-	0x0d, 0x00, 0x00,
+	// 1090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf5, 0x24, 0x4e, 0x6b, 0xdf, 0xa4, 0xf9, 0xfd, 0xb8, 0x84, 0xd6, 0xda, 0x06, 0x3b, 0x2c,
+	0x24, 0x35, 0x09, 0xf5, 0x92, 0xa4, 0x80, 0xda, 0x02, 0x6a, 0x23, 0x68, 0x8b, 0x2a, 0x50, 0xea,
+	0x54, 0x20, 0x81, 0x84, 0xb5, 0x89, 0x57, 0x8b, 0x85, 0xed, 0xd9, 0x78, 0xd6, 0x56, 0xa3, 0x10,
+	0x21, 0xf5, 0x89, 0x3f, 0x2f, 0xa0, 0x22, 0xde, 0x80, 0x4a, 0x95, 0x10, 0x1f, 0xa5, 0x8f, 0x95,
+	0x78, 0xe1, 0x09, 0xa1, 0x84, 0x07, 0xc4, 0x67, 0xe0, 0x01, 0xed, 0xec, 0x9d, 0xf5, 0xae, 0xb3,
+	0xf6, 0x6e, 0x42, 0x85, 0x78, 0x8a, 0x3d, 0x73, 0xee, 0x3d, 0xe7, 0xdc, 0xb9, 0x33, 0xd7, 0x81,
+	0xe2, 0x16, 0x17, 0x2d, 0x2e, 0x0c, 0x9b, 0xf7, 0x8c, 0xde, 0xf2, 0xa6, 0xe5, 0x9a, 0xcb, 0xc6,
+	0x76, 0xd7, 0xea, 0xec, 0x54, 0x9c, 0x0e, 0x77, 0x39, 0xa2, 0xbf, 0x5f, 0xb1, 0x79, 0xaf, 0x42,
+	0xfb, 0xda, 0x22, 0xc5, 0x6c, 0x9a, 0xc2, 0xf2, 0xc1, 0x41, 0xa8, 0x63, 0xda, 0x8d, 0xb6, 0xe9,
+	0x36, 0x78, 0xdb, 0x8f, 0xd7, 0x66, 0x6c, 0x6e, 0x73, 0xf9, 0xd1, 0xf0, 0x3e, 0xd1, 0xea, 0xac,
+	0xcd, 0xb9, 0xdd, 0xb4, 0x0c, 0xd3, 0x69, 0x18, 0x66, 0xbb, 0xcd, 0x5d, 0x19, 0x22, 0xd4, 0x6e,
+	0x8c, 0x26, 0x8f, 0x5f, 0xee, 0xea, 0xaf, 0xc0, 0xcc, 0x2d, 0x8f, 0x73, 0xbd, 0xc3, 0x1d, 0x2e,
+	0xcc, 0x66, 0xd5, 0xda, 0xee, 0x5a, 0xc2, 0xc5, 0x12, 0x4c, 0x3a, 0xb4, 0x54, 0x6b, 0xd4, 0x0b,
+	0x6c, 0x8e, 0x95, 0xb3, 0x55, 0x50, 0x4b, 0x6f, 0xd5, 0xf5, 0xf7, 0xe0, 0xa9, 0x81, 0x40, 0xe1,
+	0xf0, 0xb6, 0xb0, 0xf0, 0x75, 0xc8, 0x29, 0x98, 0x0c, 0x9b, 0x5c, 0x99, 0xad, 0x1c, 0xb6, 0x5d,
+	0x51, 0x71, 0x6b, 0xd9, 0x87, 0xbf, 0x96, 0x32, 0xd5, 0x20, 0x46, 0xff, 0x93, 0x0d, 0x64, 0x16,
+	0x4a, 0xd3, 0x4d, 0xf8, 0x5f, 0xa0, 0x49, 0xb8, 0xa6, 0xdb, 0x15, 0x92, 0x60, 0x7a, 0x45, 0x1f,
+	0x45, 0xb0, 0x21, 0x91, 0xd5, 0x69, 0x27, 0xf2, 0x1d, 0x67, 0x60, 0xa2, 0xc7, 0x5d, 0xab, 0x53,
+	0x18, 0x9b, 0x63, 0xe5, 0x7c, 0xd5, 0xff, 0x82, 0xb3, 0x90, 0xaf, 0x5b, 0x0e, 0x17, 0x0d, 0x97,
+	0x77, 0x0a, 0xe3, 0x72, 0xa7, 0xbf, 0x80, 0xd7, 0x00, 0xfa, 0x47, 0x52, 0xc8, 0x4a, 0x73, 0x0b,
+	0x8a, 0xdb, 0x3b, 0xbf, 0x8a, 0x7f, 0xd8, 0x81, 0x04, 0xd3, 0xb6, 0x48, 0x7c, 0x35, 0x14, 0x79,
+	0x29, 0xf7, 0xd9, 0xfd, 0x52, 0xe6, 0x8f, 0xfb, 0xa5, 0x8c, 0xfe, 0x80, 0xc1, 0xe9, 0x41, 0xb3,
+	0x54, 0xc7, 0x2b, 0x90, 0x57, 0x92, 0x3d, 0x9f, 0xe3, 0x29, 0x0b, 0xd9, 0x0f, 0xc2, 0xeb, 0x11,
+	0xb9, 0x63, 0x52, 0xee, 0xb9, 0x44, 0xb9, 0x3e, 0x7d, 0x58, 0xaf, 0xbe, 0x01, 0xff, 0x97, 0x22,
+	0xdf, 0xe5, 0xae, 0x95, 0xb6, 0x41, 0xe2, 0x0b, 0x1c, 0xb2, 0x7e, 0x1d, 0x9e, 0x08, 0x25, 0x25,
+	0xd3, 0x2b, 0x90, 0xf5, 0x70, 0xd4, 0x38, 0x85, 0x38, 0xbf, 0x1e, 0x9e, 0xbc, 0x4a, 0xac, 0xfe,
+	0x49, 0x28, 0x91, 0x48, 0x2d, 0xef, 0x5a, 0x4c, 0x71, 0x8e, 0x71, 0x96, 0xfa, 0x3d, 0x06, 0x18,
+	0xa6, 0x27, 0x23, 0x17, 0x7c, 0xf7, 0xea, 0xe4, 0x92, 0x9c, 0xf8, 0xe0, 0xc7, 0x77, 0x62, 0x2f,
+	0x91, 0xa8, 0x75, 0xb3, 0x63, 0xb6, 0x22, 0x45, 0x91, 0x0b, 0x35, 0x77, 0xc7, 0xf1, 0x8b, 0x9c,
+	0xaf, 0x82, 0xbf, 0x74, 0x7b, 0xc7, 0xb1, 0xf4, 0xbf, 0x18, 0x3c, 0x19, 0x89, 0x23, 0x37, 0x37,
+	0xe1, 0x54, 0x8f, 0xbb, 0x8d, 0xb6, 0x5d, 0xf3, 0xc1, 0x74, 0x3e, 0x73, 0x43, 0x5c, 0x35, 0xda,
+	0xb6, 0x9f, 0x80, 0xdc, 0x4d, 0xf5, 0x42, 0x6b, 0xf8, 0x0e, 0x4c, 0xd3, 0x95, 0x52, 0xd9, 0x7c,
+	0xa3, 0xcf, 0xc4, 0x65, 0x7b, 0xc3, 0x47, 0x46, 0xd2, 0x9d, 0xaa, 0x87, 0x17, 0xf1, 0x06, 0x4c,
+	0xb9, 0x66, 0xb3, 0xb9, 0xa3, 0xb2, 0x8d, 0xcb, 0x6c, 0xa5, 0xb8, 0x6c, 0xb7, 0x3d, 0x5c, 0x24,
+	0xd7, 0xa4, 0xdb, 0x5f, 0xd2, 0x3f, 0x24, 0xf7, 0x44, 0x9a, 0xba, 0x97, 0x22, 0xaf, 0xc6, 0xd8,
+	0xc0, 0xab, 0x11, 0x6a, 0xf9, 0x0d, 0x98, 0x89, 0xe6, 0xa7, 0xf2, 0x5e, 0x86, 0x93, 0x04, 0xa7,
+	0xc2, 0x9e, 0x1d, 0x51, 0x0a, 0x12, 0xae, 0x22, 0xf4, 0x4f, 0xa3, 0x49, 0xff, 0xfd, 0x1b, 0xf0,
+	0x83, 0x7a, 0xb0, 0xfb, 0x0a, 0xc8, 0xd7, 0x6b, 0x90, 0x23, 0x95, 0xea, 0x1e, 0xa4, 0x30, 0x16,
+	0x84, 0x3c, 0xbe, 0xdb, 0x70, 0x09, 0xce, 0x48, 0x81, 0xf2, 0xf8, 0xab, 0x96, 0xe8, 0x36, 0xdd,
+	0x23, 0xcc, 0xb9, 0xc2, 0xe1, 0xd8, 0xe0, 0xdc, 0x26, 0x64, 0xfb, 0x14, 0x58, 0x42, 0xcb, 0xf9,
+	0x71, 0xea, 0xae, 0xcb, 0x18, 0xfd, 0x0c, 0x55, 0xed, 0x6d, 0x61, 0x57, 0x79, 0xb7, 0xff, 0x74,
+	0xe9, 0x1f, 0xc0, 0xe9, 0xc1, 0x0d, 0xe2, 0xbb, 0x0a, 0xd0, 0x12, 0x76, 0xad, 0x23, 0x57, 0x47,
+	0xcd, 0x04, 0x15, 0xaa, 0x66, 0x42, 0x4b, 0xa5, 0xd2, 0xeb, 0xa0, 0xc9, 0xe4, 0x6f, 0xde, 0xb1,
+	0xb6, 0xba, 0x5e, 0x71, 0x6e, 0x75, 0xad, 0x6e, 0xf0, 0xa8, 0x47, 0x5b, 0x82, 0x1d, 0xbb, 0x25,
+	0x7e, 0x62, 0x70, 0x36, 0x96, 0xe6, 0x3f, 0x37, 0xdb, 0x56, 0xee, 0x4e, 0xc1, 0x84, 0x94, 0x8a,
+	0xdf, 0x30, 0xc8, 0x29, 0x42, 0x2c, 0xc7, 0xc9, 0x89, 0xfb, 0xa5, 0xa4, 0x3d, 0x9f, 0x02, 0xe9,
+	0xf3, 0xea, 0xab, 0x77, 0x7f, 0xfe, 0xfd, 0xde, 0xd8, 0x79, 0x5c, 0x32, 0x62, 0x7e, 0x93, 0x05,
+	0xde, 0x8c, 0xdd, 0x50, 0x47, 0xee, 0xe1, 0xe7, 0x0c, 0xf2, 0xeb, 0x81, 0xef, 0x64, 0x36, 0xd5,
+	0x47, 0xda, 0x62, 0x1a, 0x28, 0x29, 0x9b, 0x97, 0xca, 0x4a, 0xf8, 0xf4, 0x48, 0x65, 0xf8, 0x2d,
+	0x83, 0xac, 0x37, 0xb5, 0xf0, 0xb9, 0xa1, 0xb9, 0x43, 0xbf, 0x11, 0xb4, 0xf9, 0x04, 0x14, 0x91,
+	0x5f, 0x95, 0xe4, 0x97, 0xf1, 0xe2, 0x11, 0xca, 0x62, 0xc8, 0x81, 0x69, 0xec, 0x7a, 0x7f, 0x3a,
+	0x7b, 0xf8, 0x35, 0x83, 0x09, 0x2f, 0xa7, 0xc0, 0xd1, 0x9c, 0x41, 0x71, 0x16, 0x92, 0x60, 0xa4,
+	0xed, 0xa2, 0xd4, 0xb6, 0x8a, 0xcb, 0x47, 0xd6, 0x86, 0x5f, 0x32, 0x38, 0x41, 0x23, 0x6a, 0x38,
+	0x5b, 0x64, 0x40, 0x6b, 0xe7, 0x12, 0x71, 0x24, 0xeb, 0x45, 0x29, 0x6b, 0x11, 0xcb, 0xb1, 0xb2,
+	0x24, 0xd6, 0xd8, 0x0d, 0xcd, 0xfa, 0x3d, 0xfc, 0x91, 0xc1, 0x49, 0x7a, 0x68, 0x71, 0x38, 0x4d,
+	0x74, 0xf2, 0x69, 0xe5, 0x64, 0x20, 0x09, 0xba, 0x21, 0x05, 0xad, 0xe1, 0x95, 0xa3, 0xd4, 0x49,
+	0xbd, 0xf4, 0xc6, 0x6e, 0x30, 0x2d, 0xf7, 0xf0, 0x3b, 0x06, 0x39, 0xca, 0x2e, 0x30, 0x51, 0x80,
+	0x48, 0xbe, 0x86, 0x83, 0x63, 0x49, 0x7f, 0x55, 0x6a, 0x7d, 0x19, 0x2f, 0x1c, 0x47, 0x2b, 0x3e,
+	0x60, 0x30, 0x19, 0x7a, 0xd4, 0x71, 0x69, 0x28, 0xf1, 0xe1, 0x71, 0xa3, 0xbd, 0x90, 0x0e, 0xfc,
+	0x4f, 0x9a, 0x4f, 0x4e, 0x17, 0xfc, 0x82, 0x41, 0x3e, 0x18, 0x20, 0x23, 0x5e, 0x8d, 0xc1, 0xe9,
+	0xa3, 0x2d, 0xa6, 0x81, 0x92, 0xbe, 0x05, 0xa9, 0x6f, 0x0e, 0x8b, 0x71, 0xfa, 0xfa, 0x93, 0x0a,
+	0xbf, 0x67, 0x30, 0x1d, 0x9d, 0x04, 0x58, 0x19, 0x4a, 0x13, 0x3b, 0x99, 0x34, 0x23, 0x35, 0x9e,
+	0xb4, 0x2d, 0x49, 0x6d, 0xf3, 0xf8, 0x6c, 0x9c, 0x36, 0x4b, 0xc5, 0xd4, 0xb6, 0xbd, 0xa0, 0xb5,
+	0xb5, 0x87, 0xfb, 0x45, 0xf6, 0x68, 0xbf, 0xc8, 0x7e, 0xdb, 0x2f, 0xb2, 0xaf, 0x0e, 0x8a, 0x99,
+	0x47, 0x07, 0xc5, 0xcc, 0x2f, 0x07, 0xc5, 0xcc, 0xfb, 0x65, 0xbb, 0xe1, 0x7e, 0xd4, 0xdd, 0xac,
+	0x6c, 0xf1, 0x96, 0x4a, 0xe4, 0xff, 0x39, 0x2f, 0xea, 0x1f, 0x1b, 0x77, 0x64, 0x56, 0xef, 0x82,
+	0x89, 0xcd, 0x13, 0xf2, 0x1f, 0xea, 0xd5, 0xbf, 0x07, 0x00, 0xc0, 0x3a, 0x45, 0x77, 0x04, 0x10,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -931,6 +1130,12 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MsgRoutes queries the Msgs registered by modules for execution through
+	// governance.
+	MsgRoutes(ctx context.Context, in *QueryMsgRoutesRequest, opts ...grpc.CallOption) (*QueryMsgRoutesResponse, error)
+	// ExecutionQueue queries the proposals queued for execution, by execution
+	// time.
+	ExecutionQueue(ctx context.Context, in *QueryExecutionQueueRequest, opts ...grpc.CallOption) (*QueryExecutionQueueResponse, error)
 }
 
 type queryClient struct {
@@ -1013,6 +1218,24 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MsgRoutes(ctx context.Context, in *QueryMsgRoutesRequest, opts ...grpc.CallOption) (*QueryMsgRoutesResponse, error) {
+	out := new(QueryMsgRoutesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/MsgRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionQueue(ctx context.Context, in *QueryExecutionQueueRequest, opts ...grpc.CallOption) (*QueryExecutionQueueResponse, error) {
+	out := new(QueryExecutionQueueResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Query/ExecutionQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Proposal queries proposal details based on ProposalID.
@@ -1031,6 +1254,12 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MsgRoutes queries the Msgs registered by modules for execution through
+	// governance.
+	MsgRoutes(context.Context, *QueryMsgRoutesRequest) (*QueryMsgRoutesResponse, error)
+	// ExecutionQueue queries the proposals queued for execution, by execution
+	// time.
+	ExecutionQueue(context.Context, *QueryExecutionQueueRequest) (*QueryExecutionQueueResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1061,6 +1290,12 @@ func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDeposit
 func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (*UnimplementedQueryServer) MsgRoutes(ctx context.Context, req *QueryMsgRoutesRequest) (*QueryMsgRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MsgRoutes not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionQueue(ctx context.Context, req *QueryExecutionQueueRequest) (*QueryExecutionQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionQueue not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1210,6 +1445,42 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MsgRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMsgRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MsgRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/MsgRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MsgRoutes(ctx, req.(*QueryMsgRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Query/ExecutionQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionQueue(ctx, req.(*QueryExecutionQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1246,6 +1517,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MsgRoutes",
+			Handler:    _Query_MsgRoutes_Handler,
+		},
+		{
+			MethodName: "ExecutionQueue",
+			Handler:    _Query_ExecutionQueue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta1/query.proto",
@@ -1873,89 +2152,233 @@ func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryMsgRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	return n
+
+func (m *QueryMsgRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMsgRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryMsgRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryMsgRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMsgRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.MsgRoutes) > 0 {
+		for iNdEx := len(m.MsgRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryExecutionQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.ProposalId != 0 {
 		n += 1 + sovQuery(uint64(m.ProposalId))
 	}
@@ -2125,6 +2548,62 @@ func (m *QueryTallyResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMsgRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMsgRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgRoutes) > 0 {
+		for _, e := range m.MsgRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExecutionQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutionQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3773,6 +4252,346 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMsgRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMsgRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMsgRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMsgRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgRoutes = append(m.MsgRoutes, MsgRoute{})
+			if err := m.MsgRoutes[len(m.MsgRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -38,6 +39,9 @@ type MsgSubmitProposal struct {
 	Content        *types.Any                               `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       string                                   `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// exclusion_group is the optional name of the exclusion group of the
+	// proposal.
+	ExclusionGroup string `protobuf:"bytes,4,opt,name=exclusion_group,json=exclusionGroup,proto3" json:"exclusion_group,omitempty" yaml:"exclusion_group"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -349,6 +353,82 @@ func (m *MsgDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
+// MsgVetoProposal defines a message for the veto authority to veto a proposal
+// queued for execution.
+type MsgVetoProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Authority  string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()      { *m = MsgVetoProposal{} }
+func (*MsgVetoProposal) ProtoMessage() {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c053992595e3dce, []int{8}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+// MsgVetoProposalResponse defines the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c053992595e3dce, []int{9}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1beta1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1beta1.MsgSubmitProposalResponse")
@@ -358,54 +438,62 @@ func init() {
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.gov.v1beta1.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.gov.v1beta1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.gov.v1beta1.MsgVetoProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta1/tx.proto", fileDescriptor_3c053992595e3dce) }
 
 var fileDescriptor_3c053992595e3dce = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x6f, 0xd3, 0x5e,
-	0x14, 0xb5, 0x93, 0xfe, 0x9a, 0x5f, 0x5f, 0x50, 0x4b, 0x9f, 0xa2, 0x92, 0xb8, 0x95, 0x1d, 0x19,
-	0xb5, 0x8a, 0x84, 0x6a, 0xd3, 0x20, 0x81, 0x54, 0x26, 0x52, 0x54, 0x01, 0x52, 0x04, 0x18, 0x09,
-	0x24, 0x96, 0xe2, 0x24, 0xae, 0x6b, 0x91, 0xf8, 0x5a, 0x79, 0x2f, 0x51, 0xb3, 0x31, 0x32, 0x01,
-	0x23, 0x63, 0x67, 0x36, 0x24, 0x26, 0x3e, 0x41, 0xc5, 0xd4, 0x91, 0x01, 0x05, 0xd4, 0x2e, 0x80,
-	0x98, 0xfa, 0x09, 0x90, 0xdf, 0x1f, 0xb7, 0x34, 0x6e, 0x54, 0x50, 0xa7, 0xe4, 0xdd, 0x73, 0xcf,
-	0xf1, 0x3d, 0xf7, 0xdd, 0x6b, 0xa3, 0xf9, 0x26, 0x90, 0x0e, 0x10, 0xdb, 0x87, 0xbe, 0xdd, 0x5f,
-	0x69, 0x78, 0xd4, 0x5d, 0xb1, 0xe9, 0xb6, 0x15, 0x75, 0x81, 0x02, 0xc6, 0x1c, 0xb4, 0x7c, 0xe8,
-	0x5b, 0x02, 0xd4, 0x74, 0x41, 0x68, 0xb8, 0xc4, 0x4b, 0x18, 0x4d, 0x08, 0x42, 0xce, 0xd1, 0x16,
-	0x52, 0x04, 0x63, 0x3e, 0x47, 0x4b, 0x1c, 0xdd, 0x60, 0x27, 0x5b, 0xc8, 0x73, 0xa8, 0xe0, 0x83,
-	0x0f, 0x3c, 0x1e, 0xff, 0x93, 0x04, 0x1f, 0xc0, 0x6f, 0x7b, 0x36, 0x3b, 0x35, 0x7a, 0x9b, 0xb6,
-	0x1b, 0x0e, 0x38, 0x64, 0xbe, 0xce, 0xa0, 0xd9, 0x3a, 0xf1, 0x1f, 0xf5, 0x1a, 0x9d, 0x80, 0x3e,
-	0xe8, 0x42, 0x04, 0xc4, 0x6d, 0xe3, 0x9b, 0x28, 0xd7, 0x84, 0x90, 0x7a, 0x21, 0x2d, 0xaa, 0x65,
-	0xb5, 0x92, 0xaf, 0x16, 0x2c, 0x2e, 0x61, 0x49, 0x09, 0xeb, 0x56, 0x38, 0xa8, 0xe5, 0x3f, 0x7d,
-	0x58, 0xce, 0xad, 0xf1, 0x44, 0x47, 0x32, 0xf0, 0x2b, 0x15, 0xcd, 0x04, 0x61, 0x40, 0x03, 0xb7,
-	0xbd, 0xd1, 0xf2, 0x22, 0x20, 0x01, 0x2d, 0x66, 0xca, 0xd9, 0x4a, 0xbe, 0x5a, 0xb2, 0x44, 0xb1,
-	0xb1, 0x6f, 0xd9, 0x0c, 0x6b, 0x0d, 0x82, 0xb0, 0x76, 0x6f, 0x77, 0x68, 0x28, 0x87, 0x43, 0x63,
-	0x6e, 0xe0, 0x76, 0xda, 0xab, 0xe6, 0x09, 0xbe, 0xf9, 0xee, 0xab, 0x51, 0xf1, 0x03, 0xba, 0xd5,
-	0x6b, 0x58, 0x4d, 0xe8, 0x08, 0xcf, 0xe2, 0x67, 0x99, 0xb4, 0x9e, 0xdb, 0x74, 0x10, 0x79, 0x84,
-	0x49, 0x11, 0x67, 0x5a, 0xb0, 0x6f, 0x73, 0x32, 0xd6, 0xd0, 0xff, 0x11, 0x73, 0xe6, 0x75, 0x8b,
-	0xd9, 0xb2, 0x5a, 0x99, 0x72, 0x92, 0xf3, 0xea, 0xc5, 0x97, 0x3b, 0x86, 0xf2, 0x76, 0xc7, 0x50,
-	0xbe, 0xef, 0x18, 0xca, 0x8b, 0x2f, 0x65, 0xc5, 0x6c, 0xa2, 0xd2, 0x48, 0x43, 0x1c, 0x8f, 0x44,
-	0x10, 0x12, 0x0f, 0xaf, 0xa3, 0x7c, 0x24, 0x62, 0x1b, 0x41, 0x8b, 0x35, 0x67, 0xa2, 0xb6, 0xf8,
-	0x73, 0x68, 0x1c, 0x0f, 0x1f, 0x0e, 0x0d, 0xcc, 0x6d, 0x1c, 0x0b, 0x9a, 0x0e, 0x92, 0xa7, 0xbb,
-	0x2d, 0xf3, 0xbd, 0x8a, 0x72, 0x75, 0xe2, 0x3f, 0x06, 0x7a, 0x6e, 0x9a, 0xb8, 0x80, 0xfe, 0xeb,
-	0x03, 0xf5, 0xba, 0xc5, 0x0c, 0xf3, 0xc8, 0x0f, 0xf8, 0x3a, 0x9a, 0x84, 0x88, 0x06, 0x10, 0x32,
-	0xeb, 0xd3, 0x55, 0xdd, 0x1a, 0x9d, 0x47, 0x2b, 0xae, 0xe3, 0x3e, 0xcb, 0x72, 0x44, 0x76, 0x4a,
-	0x63, 0x66, 0xd1, 0x8c, 0x28, 0x59, 0xb6, 0xc3, 0xfc, 0xa8, 0x26, 0xb1, 0x27, 0x5e, 0xe0, 0x6f,
-	0x51, 0xaf, 0x85, 0x6f, 0xa4, 0xd9, 0x99, 0xfb, 0xe7, 0xfa, 0xd7, 0x51, 0x8e, 0x57, 0x44, 0x8a,
-	0x59, 0x36, 0x44, 0x4b, 0x69, 0x06, 0xe4, 0xd3, 0x8f, 0x8c, 0xd4, 0x26, 0xe2, 0x89, 0x72, 0x24,
-	0x39, 0xc5, 0x4f, 0x09, 0x5d, 0x3a, 0x51, 0x7b, 0xe2, 0xeb, 0x87, 0x8a, 0x50, 0x9d, 0xf8, 0x72,
-	0x80, 0xce, 0xeb, 0x86, 0x16, 0xd0, 0x94, 0x18, 0x68, 0x90, 0x2e, 0x8f, 0x02, 0xb8, 0x89, 0x26,
-	0xdd, 0x0e, 0xf4, 0x42, 0x2a, 0x8c, 0x8e, 0xd9, 0x96, 0xab, 0xb1, 0xb7, 0xbf, 0xda, 0x09, 0x21,
-	0x9d, 0xd2, 0x86, 0x02, 0xc2, 0x47, 0x56, 0x65, 0x07, 0xaa, 0xbf, 0x32, 0x28, 0x5b, 0x27, 0x3e,
-	0xde, 0x44, 0xd3, 0x27, 0xde, 0x0d, 0x8b, 0x69, 0xfd, 0x1f, 0xd9, 0x18, 0x6d, 0xf9, 0x4c, 0x69,
-	0xc9, 0x62, 0xdd, 0x41, 0x13, 0x6c, 0x19, 0xe6, 0x4f, 0xa1, 0xc5, 0xa0, 0x76, 0x79, 0x0c, 0x98,
-	0x28, 0x3d, 0x43, 0x17, 0xfe, 0x98, 0xc7, 0x71, 0x24, 0x99, 0xa4, 0x5d, 0x39, 0x43, 0x52, 0xf2,
-	0x84, 0x87, 0x28, 0x27, 0x27, 0x43, 0x3f, 0x85, 0x27, 0x70, 0x6d, 0x69, 0x3c, 0x2e, 0x25, 0x6b,
-	0xb5, 0xdd, 0x7d, 0x5d, 0xdd, 0xdb, 0xd7, 0xd5, 0x6f, 0xfb, 0xba, 0xfa, 0xe6, 0x40, 0x57, 0xf6,
-	0x0e, 0x74, 0xe5, 0xf3, 0x81, 0xae, 0x3c, 0x1d, 0x7f, 0xc5, 0xdb, 0xec, 0x13, 0xc1, 0x2e, 0xba,
-	0x31, 0xc9, 0xde, 0xcd, 0xd7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x96, 0x26, 0x77, 0x3f, 0x8e,
-	0x06, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3d, 0x6c, 0xd3, 0x5a,
+	0x14, 0xb6, 0x9b, 0xbc, 0xe6, 0xf5, 0xe6, 0x29, 0x55, 0xad, 0xa8, 0x4d, 0xdc, 0x3e, 0x3b, 0xf2,
+	0x53, 0xab, 0xe8, 0x55, 0xb5, 0x69, 0x90, 0x40, 0x0a, 0x13, 0x29, 0x2a, 0x3f, 0x52, 0x04, 0x18,
+	0x09, 0x24, 0x96, 0xe0, 0x24, 0xae, 0x6b, 0x91, 0xf8, 0x58, 0xb9, 0x37, 0x51, 0xb3, 0x21, 0x26,
+	0x26, 0xc4, 0x06, 0x63, 0x67, 0x26, 0x06, 0x36, 0x56, 0x86, 0x8a, 0x01, 0x75, 0x64, 0x40, 0x01,
+	0xda, 0x01, 0xc4, 0xd8, 0x85, 0x15, 0xd9, 0xf7, 0x5e, 0x27, 0x4d, 0x93, 0x50, 0x50, 0xa7, 0xe4,
+	0x9e, 0xef, 0x7c, 0xe7, 0x9e, 0xef, 0xf3, 0x39, 0x36, 0x5a, 0xac, 0x01, 0x6e, 0x02, 0x36, 0x1c,
+	0xe8, 0x18, 0x9d, 0xf5, 0xaa, 0x4d, 0xac, 0x75, 0x83, 0xec, 0xe8, 0x7e, 0x0b, 0x08, 0x48, 0x12,
+	0x05, 0x75, 0x07, 0x3a, 0x3a, 0x03, 0x65, 0x85, 0x11, 0xaa, 0x16, 0xb6, 0x23, 0x46, 0x0d, 0x5c,
+	0x8f, 0x72, 0xe4, 0xa5, 0x11, 0x05, 0x03, 0x3e, 0x45, 0xb3, 0x14, 0xad, 0x84, 0x27, 0x83, 0x95,
+	0xa7, 0x50, 0xda, 0x01, 0x07, 0x68, 0x3c, 0xf8, 0xc7, 0x09, 0x0e, 0x80, 0xd3, 0xb0, 0x8d, 0xf0,
+	0x54, 0x6d, 0x6f, 0x19, 0x96, 0xd7, 0x65, 0xd0, 0x02, 0xbb, 0xa9, 0x89, 0x1d, 0xa3, 0xb3, 0x1e,
+	0xfc, 0x50, 0x40, 0xfb, 0x32, 0x85, 0xe6, 0xca, 0xd8, 0xb9, 0xd3, 0xae, 0x36, 0x5d, 0x72, 0xab,
+	0x05, 0x3e, 0x60, 0xab, 0x21, 0x5d, 0x42, 0x89, 0x1a, 0x78, 0xc4, 0xf6, 0x48, 0x46, 0xcc, 0x89,
+	0xf9, 0x64, 0x21, 0xad, 0xd3, 0xda, 0x3a, 0xaf, 0xad, 0x5f, 0xf6, 0xba, 0xa5, 0xe4, 0xbb, 0xd7,
+	0x6b, 0x89, 0x0d, 0x9a, 0x68, 0x72, 0x86, 0xf4, 0x54, 0x44, 0xb3, 0xae, 0xe7, 0x12, 0xd7, 0x6a,
+	0x54, 0xea, 0xb6, 0x0f, 0xd8, 0x25, 0x99, 0xa9, 0x5c, 0x2c, 0x9f, 0x2c, 0x64, 0x75, 0xa6, 0x22,
+	0x30, 0x84, 0xbb, 0xa4, 0x6f, 0x80, 0xeb, 0x95, 0x6e, 0xec, 0xf5, 0x54, 0xe1, 0xa8, 0xa7, 0xce,
+	0x77, 0xad, 0x66, 0xa3, 0xa8, 0x0d, 0xf1, 0xb5, 0x97, 0x9f, 0xd4, 0xbc, 0xe3, 0x92, 0xed, 0x76,
+	0x55, 0xaf, 0x41, 0x93, 0x99, 0xc1, 0x7e, 0xd6, 0x70, 0xfd, 0xa1, 0x41, 0xba, 0xbe, 0x8d, 0xc3,
+	0x52, 0xd8, 0x4c, 0x31, 0xf6, 0x15, 0x4a, 0x96, 0x64, 0xf4, 0xb7, 0x1f, 0x2a, 0xb3, 0x5b, 0x99,
+	0x58, 0x4e, 0xcc, 0xcf, 0x98, 0xd1, 0x59, 0xda, 0x40, 0xb3, 0xf6, 0x4e, 0xad, 0xd1, 0xc6, 0x2e,
+	0x78, 0x15, 0xa7, 0x05, 0x6d, 0x3f, 0x13, 0x0f, 0x52, 0x4a, 0x72, 0xbf, 0x99, 0xa1, 0x04, 0xcd,
+	0x4c, 0x45, 0x91, 0xab, 0x41, 0xa0, 0xf8, 0xef, 0x93, 0x5d, 0x55, 0x78, 0xb1, 0xab, 0x0a, 0xdf,
+	0x76, 0x55, 0xe1, 0xd1, 0xc7, 0x9c, 0xf0, 0xf8, 0xeb, 0xab, 0xff, 0xa3, 0x3b, 0xb4, 0x1a, 0xca,
+	0x9e, 0xb0, 0xd8, 0xb4, 0xb1, 0x0f, 0x1e, 0xb6, 0xa5, 0x4d, 0x94, 0xf4, 0x59, 0xac, 0xe2, 0xd6,
+	0x43, 0xbb, 0xe3, 0xa5, 0xe5, 0xef, 0x3d, 0x75, 0x30, 0x7c, 0xd4, 0x53, 0x25, 0xda, 0xcb, 0x40,
+	0x50, 0x33, 0x11, 0x3f, 0x5d, 0xaf, 0x6b, 0x6f, 0x44, 0x94, 0x28, 0x63, 0xe7, 0x2e, 0x90, 0x33,
+	0xab, 0x29, 0xa5, 0xd1, 0x5f, 0x1d, 0x20, 0x76, 0x2b, 0x33, 0x15, 0xba, 0x46, 0x0f, 0xd2, 0x05,
+	0x34, 0x0d, 0x3e, 0x71, 0xc1, 0x0b, 0xcd, 0x4c, 0x15, 0x14, 0xfd, 0xe4, 0xe8, 0xeb, 0x41, 0x1f,
+	0x37, 0xc3, 0x2c, 0x93, 0x65, 0x17, 0xe5, 0x51, 0x2e, 0xd1, 0x9a, 0xda, 0x1c, 0x9a, 0x65, 0xcd,
+	0x73, 0x63, 0xb4, 0xb7, 0x62, 0x14, 0xbb, 0x67, 0xbb, 0xce, 0x36, 0xb1, 0xeb, 0xd2, 0xc5, 0x51,
+	0xc2, 0xe6, 0xff, 0x58, 0xc9, 0x26, 0x4a, 0xd0, 0xde, 0x70, 0x26, 0x16, 0x0e, 0xe8, 0xca, 0x28,
+	0x29, 0xfc, 0xf6, 0xbe, 0xa4, 0x52, 0x3c, 0x98, 0x56, 0x93, 0x93, 0x27, 0x2a, 0xcb, 0xa2, 0x85,
+	0x21, 0x15, 0x91, 0xc2, 0x1f, 0x22, 0x42, 0x65, 0xec, 0xf0, 0x31, 0x3d, 0xab, 0xa7, 0xb6, 0x84,
+	0x66, 0xd8, 0xda, 0x00, 0xd7, 0xdb, 0x0f, 0x48, 0x35, 0x34, 0x6d, 0x35, 0xa1, 0xed, 0x91, 0x4c,
+	0xec, 0x57, 0x3b, 0x79, 0x2e, 0x50, 0xf9, 0x5b, 0x9b, 0xc7, 0x4a, 0x17, 0x95, 0x51, 0x86, 0xf4,
+	0x9b, 0xd0, 0xd2, 0x48, 0xea, 0x0b, 0x8f, 0xfc, 0x78, 0xce, 0x9e, 0xb8, 0x4d, 0x20, 0x7a, 0x13,
+	0x9d, 0xa1, 0x29, 0x56, 0x9b, 0x6c, 0x43, 0xcb, 0x25, 0x5d, 0x6e, 0x4a, 0x14, 0x18, 0xd3, 0x6f,
+	0x84, 0xf3, 0x87, 0x38, 0xd0, 0x18, 0x6f, 0xba, 0xf0, 0x3e, 0x86, 0x62, 0x65, 0xec, 0x48, 0x5b,
+	0x28, 0x35, 0xf4, 0x12, 0x5d, 0x1e, 0x35, 0x4c, 0x27, 0x5e, 0x04, 0xf2, 0xda, 0xa9, 0xd2, 0xa2,
+	0xf7, 0xc5, 0x35, 0x14, 0x0f, 0x77, 0x7c, 0x71, 0x0c, 0x2d, 0x00, 0xe5, 0xff, 0x26, 0x80, 0x51,
+	0xa5, 0x07, 0xe8, 0x9f, 0x63, 0xcb, 0x35, 0x89, 0xc4, 0x93, 0xe4, 0xd5, 0x53, 0x24, 0x45, 0x37,
+	0xdc, 0x46, 0x09, 0x3e, 0xdc, 0xca, 0x18, 0x1e, 0xc3, 0xe5, 0x95, 0xc9, 0xf8, 0xb1, 0xa6, 0x07,
+	0xe7, 0x63, 0x6c, 0xd3, 0x03, 0x49, 0xf2, 0xea, 0x29, 0x92, 0xf8, 0x0d, 0xa5, 0xd2, 0xde, 0x81,
+	0x22, 0xee, 0x1f, 0x28, 0xe2, 0xe7, 0x03, 0x45, 0x7c, 0x76, 0xa8, 0x08, 0xfb, 0x87, 0x8a, 0xf0,
+	0xe1, 0x50, 0x11, 0xee, 0x4f, 0xde, 0x83, 0x9d, 0xf0, 0x33, 0x1e, 0x6e, 0x43, 0x75, 0x3a, 0xfc,
+	0x4c, 0x9e, 0xff, 0x39, 0x00, 0xc6, 0x28, 0xf4, 0xed, 0x32, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -430,6 +518,9 @@ type MsgClient interface {
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// VetoProposal defines a method for the veto authority to veto a queued
+	// proposal.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 }
 
 type msgClient struct {
@@ -476,6 +567,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -488,6 +588,9 @@ type MsgServer interface {
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// VetoProposal defines a method for the veto authority to veto a queued
+	// proposal.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -506,6 +609,9 @@ func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWei
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -583,6 +689,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -603,6 +727,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta1/tx.proto",
@@ -628,6 +756,13 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExclusionGroup) > 0 {
+		i -= len(m.ExclusionGroup)
+		copy(dAtA[i:], m.ExclusionGroup)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExclusionGroup)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -899,6 +1034,64 @@ func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -930,6 +1123,10 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.ExclusionGroup)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1035,6 +1232,31 @@ func (m *MsgDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1172,6 +1394,38 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1802,6 +2056,157 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
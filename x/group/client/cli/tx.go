@@ -0,0 +1,732 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// Flag names and values
+const (
+	FlagExec = "exec"
+	ExecTry  = "try"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        group.ModuleName,
+		Short:                      "Group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		MsgCreateGroupCmd(),
+		MsgUpdateGroupMembersCmd(),
+		MsgUpdateGroupAdminCmd(),
+		MsgUpdateGroupMetadataCmd(),
+		MsgCreateGroupPolicyCmd(),
+		MsgUpdateGroupPolicyAdminCmd(),
+		MsgUpdateGroupPolicyDecisionPolicyCmd(),
+		MsgUpdateGroupPolicyMetadataCmd(),
+		MsgSubmitProposalCmd(),
+		MsgWithdrawProposalCmd(),
+		MsgVoteCmd(),
+		MsgExecCmd(),
+		MsgVetoProposalCmd(),
+		MsgLeaveGroupCmd(),
+	)
+
+	return txCmd
+}
+
+// MsgCreateGroupCmd creates a CLI command for Msg/CreateGroup.
+func MsgCreateGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group [admin] [metadata] [members-json-file]",
+		Short: "Create a group which is an aggregation of member accounts with associated weights and an administrator account.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group which is an aggregation of member accounts with associated weights and an
+administrator account. Note, the '--from' flag is ignored as it is implied from [admin].
+Members accounts can be given through a members JSON file that contains an array of members.
+
+Example:
+$ %s tx %s create-group [admin] [metadata] [members-json-file]
+
+Where members.json contains:
+
+[
+	{
+		"address": "addr1",
+		"weight": "1",
+		"metadata": "some metadata"
+	},
+	{
+		"address": "addr2",
+		"weight": "1",
+		"metadata": "some metadata"
+	}
+]
+`, version.AppName, group.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgCreateGroup{
+				Admin:    clientCtx.GetFromAddress().String(),
+				Members:  members,
+				Metadata: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgUpdateGroupMembersCmd creates a CLI command for Msg/UpdateGroupMembers.
+func MsgUpdateGroupMembersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-members [admin] [group-id] [members-json-file]",
+		Short: "Update a group's members. Set a member's weight to \"0\" to delete it.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group's members. Note, the '--from' flag is ignored as it is implied from [admin].
+The members JSON file has the format of create-group's, and the members of weight "0" are removed.
+
+Example:
+$ %s tx %s update-group-members [admin] [group-id] [members-json-file]
+`, version.AppName, group.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			members, err := parseMembers(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupMembers{
+				Admin:         clientCtx.GetFromAddress().String(),
+				GroupId:       groupID,
+				MemberUpdates: members,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgUpdateGroupAdminCmd creates a CLI command for Msg/UpdateGroupAdmin.
+func MsgUpdateGroupAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-admin [admin] [group-id] [new-admin]",
+		Short: "Update a group's admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupAdmin{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				NewAdmin: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgUpdateGroupMetadataCmd creates a CLI command for Msg/UpdateGroupMetadata.
+func MsgUpdateGroupMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-metadata [admin] [group-id] [metadata]",
+		Short: "Update a group's metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupMetadata{
+				Admin:    clientCtx.GetFromAddress().String(),
+				GroupId:  groupID,
+				Metadata: args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgCreateGroupPolicyCmd creates a CLI command for Msg/CreateGroupPolicy.
+func MsgCreateGroupPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-group-policy [admin] [group-id] [metadata] [decision-policy-json-file]",
+		Short: "Create a group policy which is an account associated with a group and a decision policy.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Create a group policy which is an account associated with a group and a decision policy.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx %s create-group-policy [admin] [group-id] [metadata] policy.json
+
+Where policy.json contains:
+
+{
+	"@type": "/cosmos.group.v1beta1.ThresholdDecisionPolicy",
+	"threshold": "1",
+	"windows": {
+		"voting_period": "120h",
+		"min_execution_period": "0s"
+	}
+}
+`, version.AppName, group.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			policy, err := parseDecisionPolicy(clientCtx, args[3])
+			if err != nil {
+				return err
+			}
+
+			msg, err := group.NewMsgCreateGroupPolicy(clientCtx.GetFromAddress(), groupID, args[2], policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgUpdateGroupPolicyAdminCmd creates a CLI command for Msg/UpdateGroupPolicyAdmin.
+func MsgUpdateGroupPolicyAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-admin [admin] [group-policy-account] [new-admin]",
+		Short: "Update a group policy admin",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupPolicyAdmin{
+				Admin:              clientCtx.GetFromAddress().String(),
+				GroupPolicyAddress: args[1],
+				NewAdmin:           args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgUpdateGroupPolicyDecisionPolicyCmd creates a CLI command for
+// Msg/UpdateGroupPolicyDecisionPolicy.
+func MsgUpdateGroupPolicyDecisionPolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-decision-policy [admin] [group-policy-account] [decision-policy-json-file]",
+		Short: "Update a group policy's decision policy",
+		Long:  "Update a group policy's decision policy. The decision policy JSON file has the format of create-group-policy's, and the pending proposals of the group policy are aborted.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			policy, err := parseDecisionPolicy(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg, err := group.NewMsgUpdateGroupPolicyDecisionPolicy(clientCtx.GetFromAddress(), addr, policy)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgUpdateGroupPolicyMetadataCmd creates a CLI command for Msg/UpdateGroupPolicyMetadata.
+func MsgUpdateGroupPolicyMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-metadata [admin] [group-policy-account] [new-metadata]",
+		Short: "Update a group policy's metadata",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupPolicyMetadata{
+				Admin:              clientCtx.GetFromAddress().String(),
+				GroupPolicyAddress: args[1],
+				Metadata:           args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgSubmitProposalCmd creates a CLI command for Msg/SubmitProposal.
+func MsgSubmitProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-proposal [group-policy-account] [proposer[,proposer]*] [msg_tx_json_file] [metadata]",
+		Short: "Submit a new proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a new proposal to a group policy. The messages of the proposal are the messages of the
+transaction of [msg_tx_json_file], which must be signed by the group policy account, and can be generated with
+the '--generate-only' flag. Note, the '--from' flag is ignored as it is implied from the first proposer.
+
+Example:
+$ %s tx %s submit-proposal [group-policy-account] [proposer[,proposer]*] msg_tx.json "metadata" --exec try
+`, version.AppName, group.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposers := strings.Split(args[1], ",")
+			for i := range proposers {
+				proposers[i] = strings.TrimSpace(proposers[i])
+			}
+
+			if err := cmd.Flags().Set(flags.FlagFrom, proposers[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theTx, err := authclient.ReadTxFromFile(clientCtx, args[2])
+			if err != nil {
+				return err
+			}
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := group.NewMsgSubmitProposal(args[0], proposers, theTx.GetMsgs(), args[3], exec)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 'try' to vote yes with the proposers and try to execute the proposal immediately")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgWithdrawProposalCmd creates a CLI command for Msg/WithdrawProposal.
+func MsgWithdrawProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-proposal [proposal-id] [group-policy-admin-or-proposer]",
+		Short: "Withdraw a submitted proposal",
+		Long:  "Withdraw a submitted proposal. Note, the '--from' flag is ignored as it is implied from [group-policy-admin-or-proposer].",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgWithdrawProposal{
+				ProposalId: proposalID,
+				Address:    clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgVoteCmd creates a CLI command for Msg/Vote.
+func MsgVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [proposal-id] [voter] [vote-option] [metadata]",
+		Short: "Vote on a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Vote on a proposal. Note, the '--from' flag is ignored as it is implied from [voter].
+
+Parameters:
+	proposal-id: unique ID of the proposal
+	voter: voter account addresses.
+	vote-option: choice of the voter(s)
+		VOTE_OPTION_UNSPECIFIED: no-op
+		VOTE_OPTION_NO: no
+		VOTE_OPTION_YES: yes
+		VOTE_OPTION_ABSTAIN: abstain
+		VOTE_OPTION_NO_WITH_VETO: no-with-veto
+	Metadata: metadata for the vote
+
+Example:
+$ %s tx %s vote 1 [voter] VOTE_OPTION_YES "metadata" --exec try
+`, version.AppName, group.ModuleName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			option, err := parseVoteOption(args[2])
+			if err != nil {
+				return err
+			}
+			exec, err := parseExec(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgVote{
+				ProposalId: proposalID,
+				Voter:      clientCtx.GetFromAddress().String(),
+				Option:     option,
+				Metadata:   args[3],
+				Exec:       exec,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExec, "", "Set to 'try' to try to execute the proposal immediately after voting")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgExecCmd creates a CLI command for Msg/Exec.
+func MsgExecCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [proposal-id]",
+		Short: "Execute a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgExec{
+				ProposalId: proposalID,
+				Executor:   clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgVetoProposalCmd creates a CLI command for Msg/VetoProposal.
+func MsgVetoProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-proposal [proposal-id] [group-policy-admin]",
+		Short: "Veto an accepted proposal during its execution delay",
+		Long:  "Veto an accepted proposal during its execution delay. Note, the '--from' flag is ignored as it is implied from [group-policy-admin].",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgVetoProposal{
+				ProposalId: proposalID,
+				Admin:      clientCtx.GetFromAddress().String(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// MsgLeaveGroupCmd creates a CLI command for Msg/LeaveGroup.
+func MsgLeaveGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave-group [member-address] [group-id]",
+		Short: "Remove member from the group",
+		Long:  "Remove member from the group. Note, the '--from' flag is ignored as it is implied from [member-address].",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgLeaveGroup{
+				Address: clientCtx.GetFromAddress().String(),
+				GroupId: groupID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMembers reads the JSON array of members of the file membersFile.
+func parseMembers(membersFile string) ([]group.Member, error) {
+	var members []group.Member
+	bz, err := ioutil.ReadFile(membersFile)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, &members); err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// parseDecisionPolicy reads the decision policy, in the JSON of an Any, of the
+// file policyFile.
+func parseDecisionPolicy(clientCtx client.Context, policyFile string) (group.DecisionPolicy, error) {
+	bz, err := ioutil.ReadFile(policyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy group.DecisionPolicy
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(bz, &policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// parseVoteOption parses a vote option, given either by its enum name or by
+// its short name, e.g. VOTE_OPTION_YES or yes.
+func parseVoteOption(s string) (group.VoteOption, error) {
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if !strings.HasPrefix(name, "VOTE_OPTION_") {
+		name = "VOTE_OPTION_" + name
+	}
+
+	option, ok := group.VoteOption_value[name]
+	if !ok {
+		return group.VOTE_OPTION_UNSPECIFIED, fmt.Errorf("'%s' is not a valid vote option", s)
+	}
+
+	return group.VoteOption(option), nil
+}
+
+// parseExec returns the execution mode of the exec flag.
+func parseExec(cmd *cobra.Command) (group.Exec, error) {
+	exec, err := cmd.Flags().GetString(FlagExec)
+	if err != nil {
+		return group.EXEC_UNSPECIFIED, err
+	}
+
+	switch exec {
+	case "":
+		return group.EXEC_UNSPECIFIED, nil
+	case ExecTry:
+		return group.EXEC_TRY, nil
+	default:
+		return group.EXEC_UNSPECIFIED, fmt.Errorf("invalid --%s value %q, expected %q", FlagExec, exec, ExecTry)
+	}
+}
@@ -0,0 +1,22 @@
+package group
+
+import "time"
+
+// Config is the configuration of the group module, set by the app.
+type Config struct {
+	// MaxExecutionPeriod is the period after the end of the voting period of a
+	// proposal during which it can be executed. Past it, MsgExec fails.
+	MaxExecutionPeriod time.Duration
+
+	// MaxMetadataLen is the maximum length of the metadata of the groups, the
+	// group policies, the members, the proposals and the votes.
+	MaxMetadataLen uint64
+}
+
+// DefaultConfig returns the default configuration of the group module.
+func DefaultConfig() Config {
+	return Config{
+		MaxExecutionPeriod: 2 * 7 * 24 * time.Hour, // two weeks
+		MaxMetadataLen:     255,
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package group allows the creation and management of on-chain multisig accounts,
+and the submission of proposals, voted upon by weighted members, to execute
+messages from them.
+
+A group is an aggregation of member accounts with weights, administered by an
+admin account. A group policy is an account associated with a group and with a
+decision policy, such as ThresholdDecisionPolicy or PercentageDecisionPolicy,
+which decides whether the proposals submitted to it are accepted, given the
+votes of the members of its group. The messages of an accepted proposal are
+executed, with MsgExec, through the Msg service router, signed by the group
+policy account.
+
+The groups, the group policies and the proposals are versioned: updating the
+members of a group or the decision policy of a group policy aborts the pending
+proposals submitted for the previous versions.
+*/
+package group
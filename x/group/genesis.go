@@ -0,0 +1,138 @@
+package group
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state with default values.
+func NewGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// DefaultGenesisState returns the default genesis state of the group module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState()
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure: the IDs must not exceed their sequences, and the members, the group
+// policies and the proposals must belong to groups, and the votes to
+// proposals, of the genesis.
+func (s GenesisState) Validate() error {
+	groups := make(map[uint64]GroupInfo, len(s.Groups))
+	for _, g := range s.Groups {
+		if err := g.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group")
+		}
+		if g.Id > s.GroupSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "group id %d exceeds the group sequence %d", g.Id, s.GroupSeq)
+		}
+		if _, ok := groups[g.Id]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group id %d", g.Id)
+		}
+		groups[g.Id] = *g
+	}
+
+	// the total weights of the groups are the sums of their members' weights
+	totalWeights := make(map[uint64]sdk.Dec, len(groups))
+	members := make(map[string]bool, len(s.GroupMembers))
+	for _, gm := range s.GroupMembers {
+		if err := gm.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group member")
+		}
+		if _, ok := groups[gm.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "group member %s of unknown group %d", gm.Member.Address, gm.GroupId)
+		}
+		key := fmt.Sprintf("%d/%s", gm.GroupId, gm.Member.Address)
+		if members[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "member %s of group %d", gm.Member.Address, gm.GroupId)
+		}
+		members[key] = true
+
+		weight, _ := ParseWeight(gm.Member.Weight)
+		if total, ok := totalWeights[gm.GroupId]; ok {
+			totalWeights[gm.GroupId] = total.Add(weight)
+		} else {
+			totalWeights[gm.GroupId] = weight
+		}
+	}
+	for id, g := range groups {
+		total, ok := totalWeights[id]
+		if !ok {
+			total = sdk.ZeroDec()
+		}
+		if groupTotal, _ := ParseWeight(g.TotalWeight); !groupTotal.Equal(total) {
+			return sdkerrors.Wrapf(ErrInvalid, "total weight %s of group %d is not the sum %s of the weights of its members", g.TotalWeight, id, total)
+		}
+	}
+
+	policies := make(map[string]GroupPolicyInfo, len(s.GroupPolicies))
+	for _, p := range s.GroupPolicies {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group policy")
+		}
+		if _, ok := groups[p.GroupId]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "group policy %s of unknown group %d", p.Address, p.GroupId)
+		}
+		if _, ok := policies[p.Address]; ok {
+			return sdkerrors.Wrapf(ErrDuplicate, "group policy %s", p.Address)
+		}
+		policies[p.Address] = *p
+	}
+
+	proposals := make(map[uint64]bool, len(s.Proposals))
+	for _, p := range s.Proposals {
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "proposal")
+		}
+		if p.Id > s.ProposalSeq {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal id %d exceeds the proposal sequence %d", p.Id, s.ProposalSeq)
+		}
+		if _, ok := policies[p.GroupPolicyAddress]; !ok {
+			return sdkerrors.Wrapf(ErrInvalid, "proposal %d of unknown group policy %s", p.Id, p.GroupPolicyAddress)
+		}
+		if proposals[p.Id] {
+			return sdkerrors.Wrapf(ErrDuplicate, "proposal id %d", p.Id)
+		}
+		proposals[p.Id] = true
+	}
+
+	votes := make(map[string]bool, len(s.Votes))
+	for _, v := range s.Votes {
+		if err := v.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "vote")
+		}
+		if !proposals[v.ProposalId] {
+			return sdkerrors.Wrapf(ErrInvalid, "vote of %s on unknown proposal %d", v.Voter, v.ProposalId)
+		}
+		key := fmt.Sprintf("%d/%s", v.ProposalId, v.Voter)
+		if votes[key] {
+			return sdkerrors.Wrapf(ErrDuplicate, "vote of %s on proposal %d", v.Voter, v.ProposalId)
+		}
+		votes[key] = true
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (s GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, p := range s.GroupPolicies {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, p := range s.Proposals {
+		if err := p.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
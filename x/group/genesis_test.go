@@ -0,0 +1,82 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	now := time.Now().UTC()
+	policyInfo, err := group.NewGroupPolicyInfo(policy, 1, admin, "", 1, group.NewThresholdDecisionPolicy("1", time.Hour, 0), now)
+	require.NoError(t, err)
+
+	validState := func() group.GenesisState {
+		return group.GenesisState{
+			GroupSeq: 1,
+			Groups:   []*group.GroupInfo{{Id: 1, Admin: admin.String(), TotalWeight: "3", Version: 1}},
+			GroupMembers: []*group.GroupMember{
+				{GroupId: 1, Member: &group.Member{Address: member1.String(), Weight: "1"}},
+				{GroupId: 1, Member: &group.Member{Address: member2.String(), Weight: "2"}},
+			},
+			GroupPolicySeq: 1,
+			GroupPolicies:  []*group.GroupPolicyInfo{&policyInfo},
+			ProposalSeq:    1,
+			Proposals: []*group.Proposal{{
+				Id:                 1,
+				GroupPolicyAddress: policy.String(),
+				Proposers:          []string{member1.String()},
+				SubmitTime:         now,
+				GroupVersion:       1,
+				GroupPolicyVersion: 1,
+				Status:             group.PROPOSAL_STATUS_SUBMITTED,
+				FinalTallyResult:   group.DefaultTallyResult(),
+				VotingPeriodEnd:    now.Add(time.Hour),
+				ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+			}},
+			Votes: []*group.Vote{{ProposalId: 1, Voter: member1.String(), Option: group.VOTE_OPTION_YES, SubmitTime: now}},
+		}
+	}
+
+	tests := []struct {
+		title      string
+		malleate   func(s *group.GenesisState)
+		expectPass bool
+	}{
+		{"default genesis", func(s *group.GenesisState) { *s = *group.DefaultGenesisState() }, true},
+		{"valid genesis", func(s *group.GenesisState) {}, true},
+		{"group id above the sequence", func(s *group.GenesisState) { s.GroupSeq = 0 }, false},
+		{"total weight mismatch", func(s *group.GenesisState) { s.Groups[0].TotalWeight = "2" }, false},
+		{"member of unknown group", func(s *group.GenesisState) { s.GroupMembers[0].GroupId = 2 }, false},
+		{"duplicate member", func(s *group.GenesisState) { s.GroupMembers[1].Member.Address = member1.String() }, false},
+		{"policy of unknown group", func(s *group.GenesisState) { s.Groups[0].Id, s.GroupMembers = 2, nil }, false},
+		{"proposal of unknown policy", func(s *group.GenesisState) { s.Proposals[0].GroupPolicyAddress = admin.String() }, false},
+		{"proposal id above the sequence", func(s *group.GenesisState) { s.ProposalSeq = 0 }, false},
+		{"snapshotted weights", func(s *group.GenesisState) {
+			s.Proposals[0].TotalWeight = "3"
+			s.Proposals[0].MemberWeights = []group.MemberWeight{{Address: member1.String(), Weight: "3"}}
+		}, true},
+		{"snapshotted weights without total weight", func(s *group.GenesisState) {
+			s.Proposals[0].MemberWeights = []group.MemberWeight{{Address: member1.String(), Weight: "3"}}
+		}, false},
+		{"duplicate snapshotted weight", func(s *group.GenesisState) {
+			s.Proposals[0].TotalWeight = "3"
+			w := group.MemberWeight{Address: member1.String(), Weight: "1"}
+			s.Proposals[0].MemberWeights = []group.MemberWeight{w, w}
+		}, false},
+		{"vote on unknown proposal", func(s *group.GenesisState) { s.Votes[0].ProposalId = 2 }, false},
+		{"duplicate vote", func(s *group.GenesisState) { s.Votes = append(s.Votes, s.Votes[0]) }, false},
+	}
+	for _, tc := range tests {
+		state := validState()
+		tc.malleate(&state)
+		if tc.expectPass {
+			require.NoError(t, state.Validate(), tc.title)
+		} else {
+			require.Error(t, state.Validate(), tc.title)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// InitGenesis initializes the group module's state from a genesis state. The
+// accounts of the group policies are part of the genesis state of x/auth.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *group.GenesisState) {
+	k.setSequence(ctx, GroupSeqKey, data.GroupSeq)
+	k.setSequence(ctx, GroupPolicySeqKey, data.GroupPolicySeq)
+	k.setSequence(ctx, ProposalSeqKey, data.ProposalSeq)
+
+	for _, g := range data.Groups {
+		k.setGroupInfo(ctx, *g)
+	}
+	for _, m := range data.GroupMembers {
+		k.setGroupMember(ctx, *m)
+	}
+	for _, p := range data.GroupPolicies {
+		k.setGroupPolicyInfo(ctx, *p)
+	}
+	for _, p := range data.Proposals {
+		k.setProposal(ctx, *p)
+		if p.Status == group.PROPOSAL_STATUS_SUBMITTED {
+			ctx.KVStore(k.storeKey).Set(proposalByVotingPeriodEndKey(p.VotingPeriodEnd, p.Id), []byte{})
+		}
+		if p.Status == group.PROPOSAL_STATUS_ACCEPTED && p.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS && !p.ExecutionTime.IsZero() {
+			ctx.KVStore(k.storeKey).Set(proposalByExecutionTimeKey(p.ExecutionTime, p.Id), []byte{})
+		}
+	}
+	for _, v := range data.Votes {
+		k.setVote(ctx, *v)
+	}
+}
+
+// ExportGenesis returns the group module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *group.GenesisState {
+	data := group.NewGenesisState()
+	data.GroupSeq = k.getSequence(ctx, GroupSeqKey)
+	data.GroupPolicySeq = k.getSequence(ctx, GroupPolicySeqKey)
+	data.ProposalSeq = k.getSequence(ctx, ProposalSeqKey)
+
+	store := ctx.KVStore(k.storeKey)
+	iterate := func(prefix []byte, cb func(value []byte)) {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			cb(iter.Value())
+		}
+	}
+
+	iterate(GroupKeyPrefix, func(value []byte) {
+		var g group.GroupInfo
+		k.cdc.MustUnmarshal(value, &g)
+		data.Groups = append(data.Groups, &g)
+	})
+	iterate(GroupMemberKeyPrefix, func(value []byte) {
+		var m group.GroupMember
+		k.cdc.MustUnmarshal(value, &m)
+		data.GroupMembers = append(data.GroupMembers, &m)
+	})
+	iterate(GroupPolicyKeyPrefix, func(value []byte) {
+		var p group.GroupPolicyInfo
+		k.cdc.MustUnmarshal(value, &p)
+		data.GroupPolicies = append(data.GroupPolicies, &p)
+	})
+	iterate(ProposalKeyPrefix, func(value []byte) {
+		var p group.Proposal
+		k.cdc.MustUnmarshal(value, &p)
+		data.Proposals = append(data.Proposals, &p)
+	})
+	iterate(VoteKeyPrefix, func(value []byte) {
+		var v group.Vote
+		k.cdc.MustUnmarshal(value, &v)
+		data.Votes = append(data.Votes, &v)
+	})
+
+	return data
+}
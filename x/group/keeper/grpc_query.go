@@ -0,0 +1,373 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var _ group.QueryServer = Keeper{}
+
+// GroupInfo implements the Query/GroupInfo gRPC method.
+func (k Keeper) GroupInfo(c context.Context, req *group.QueryGroupInfoRequest) (*group.QueryGroupInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	g, err := k.GetGroupInfo(sdk.UnwrapSDKContext(c), req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupInfoResponse{Info: &g}, nil
+}
+
+// GroupPolicyInfo implements the Query/GroupPolicyInfo gRPC method.
+func (k Keeper) GroupPolicyInfo(c context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	p, err := k.GetGroupPolicyInfo(sdk.UnwrapSDKContext(c), addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPolicyInfoResponse{Info: &p}, nil
+}
+
+// GroupMembers implements the Query/GroupMembers gRPC method.
+func (k Keeper) GroupMembers(c context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var members []*group.GroupMember
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), groupMembersPrefix(req.GroupId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var m group.GroupMember
+		if err := k.cdc.Unmarshal(value, &m); err != nil {
+			return err
+		}
+		members = append(members, &m)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &group.QueryGroupMembersResponse{Members: members, Pagination: pageRes}, nil
+}
+
+// GroupsByAdmin implements the Query/GroupsByAdmin gRPC method.
+func (k Keeper) GroupsByAdmin(c context.Context, req *group.QueryGroupsByAdminRequest) (*group.QueryGroupsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, pageRes, err := k.paginateGroups(sdk.UnwrapSDKContext(c), groupByAdminPrefix(admin), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByAdminResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// GroupsByMember implements the Query/GroupsByMember gRPC method.
+func (k Keeper) GroupsByMember(c context.Context, req *group.QueryGroupsByMemberRequest) (*group.QueryGroupsByMemberResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	member, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, pageRes, err := k.paginateGroups(sdk.UnwrapSDKContext(c), groupByMemberPrefix(member), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupsByMemberResponse{Groups: groups, Pagination: pageRes}, nil
+}
+
+// paginateGroups paginates the groups of the index under indexPrefix, whose
+// keys end with the group ids.
+func (k Keeper) paginateGroups(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]*group.GroupInfo, *query.PageResponse, error) {
+	var groups []*group.GroupInfo
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		g, err := k.GetGroupInfo(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		groups = append(groups, &g)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return groups, pageRes, nil
+}
+
+// GroupPoliciesByGroup implements the Query/GroupPoliciesByGroup gRPC method.
+func (k Keeper) GroupPoliciesByGroup(c context.Context, req *group.QueryGroupPoliciesByGroupRequest) (*group.QueryGroupPoliciesByGroupResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	policies, pageRes, err := k.paginateGroupPolicies(sdk.UnwrapSDKContext(c), groupPolicyByGroupPrefix(req.GroupId), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPoliciesByGroupResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// GroupPoliciesByAdmin implements the Query/GroupPoliciesByAdmin gRPC method.
+func (k Keeper) GroupPoliciesByAdmin(c context.Context, req *group.QueryGroupPoliciesByAdminRequest) (*group.QueryGroupPoliciesByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, pageRes, err := k.paginateGroupPolicies(sdk.UnwrapSDKContext(c), groupPolicyByAdminPrefix(admin), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryGroupPoliciesByAdminResponse{GroupPolicies: policies, Pagination: pageRes}, nil
+}
+
+// paginateGroupPolicies paginates the group policies of the index under
+// indexPrefix, whose keys end with the length-prefixed group policy addresses.
+func (k Keeper) paginateGroupPolicies(ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]*group.GroupPolicyInfo, *query.PageResponse, error) {
+	var policies []*group.GroupPolicyInfo
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		p, err := k.GetGroupPolicyInfo(ctx, lengthPrefixedAddress(key))
+		if err != nil {
+			return err
+		}
+		policies = append(policies, &p)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return policies, pageRes, nil
+}
+
+// Proposal implements the Query/Proposal gRPC method.
+func (k Keeper) Proposal(c context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	p, err := k.GetProposal(sdk.UnwrapSDKContext(c), req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryProposalResponse{Proposal: &p}, nil
+}
+
+// ProposalsByGroupPolicy implements the Query/ProposalsByGroupPolicy gRPC
+// method.
+func (k Keeper) ProposalsByGroupPolicy(c context.Context, req *group.QueryProposalsByGroupPolicyRequest) (*group.QueryProposalsByGroupPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var proposals []*group.Proposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), proposalByGroupPolicyPrefix(addr))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		p, err := k.GetProposal(ctx, sdk.BigEndianToUint64(key))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &p)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &group.QueryProposalsByGroupPolicyResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+// VoteByProposalVoter implements the Query/VoteByProposalVoter gRPC method.
+func (k Keeper) VoteByProposalVoter(c context.Context, req *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+	v, err := k.GetVote(sdk.UnwrapSDKContext(c), req.ProposalId, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryVoteByProposalVoterResponse{Vote: &v}, nil
+}
+
+// VotesByProposal implements the Query/VotesByProposal gRPC method.
+func (k Keeper) VotesByProposal(c context.Context, req *group.QueryVotesByProposalRequest) (*group.QueryVotesByProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var votes []*group.Vote
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), votesByProposalPrefix(req.ProposalId))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		var v group.Vote
+		if err := k.cdc.Unmarshal(value, &v); err != nil {
+			return err
+		}
+		votes = append(votes, &v)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &group.QueryVotesByProposalResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// VotesByVoter implements the Query/VotesByVoter gRPC method.
+func (k Keeper) VotesByVoter(c context.Context, req *group.QueryVotesByVoterRequest) (*group.QueryVotesByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var votes []*group.Vote
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), votesByVoterPrefix(voter))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		v, err := k.GetVote(ctx, sdk.BigEndianToUint64(key), voter)
+		if err != nil {
+			return err
+		}
+		votes = append(votes, &v)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &group.QueryVotesByVoterResponse{Votes: votes, Pagination: pageRes}, nil
+}
+
+// TallyResult implements the Query/TallyResult gRPC method. It returns the
+// current tally of the proposal, which is final once the proposal is no longer
+// submitted.
+func (k Keeper) TallyResult(c context.Context, req *group.QueryTallyResultRequest) (*group.QueryTallyResultResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	p, err := k.GetProposal(sdk.UnwrapSDKContext(c), req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryTallyResultResponse{Tally: p.FinalTallyResult}, nil
+}
+
+// SimulateProposal implements the Query/SimulateProposal gRPC method. It
+// executes the messages of the proposal in order in a branch of the current
+// state which is then discarded, and stops at the first failed message as the
+// execution of the proposal would.
+func (k Keeper) SimulateProposal(c context.Context, req *group.QuerySimulateProposalRequest) (*group.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	p, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	addr := mustAccAddressFromBech32(p.GroupPolicyAddress)
+	results := make([]group.MsgSimulationResult, 0, len(msgs))
+	for _, msg := range msgs {
+		res := group.MsgSimulationResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+		res.Result, err = k.dispatchMsg(cacheCtx, addr, msg)
+		if err != nil {
+			res.Error = err.Error()
+			results = append(results, res)
+			break
+		}
+		results = append(results, res)
+	}
+
+	return &group.QuerySimulateProposalResponse{Results: results}, nil
+}
+
+// ExecutionQueue implements the Query/ExecutionQueue gRPC method.
+func (k Keeper) ExecutionQueue(c context.Context, req *group.QueryExecutionQueueRequest) (*group.QueryExecutionQueueResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var proposals []*group.Proposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ProposalByExecutionTimeIndex)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		p, err := k.GetProposal(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if err != nil {
+			return err
+		}
+		proposals = append(proposals, &p)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &group.QueryExecutionQueueResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// RegisterInvariants registers the group module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(group.ModuleName, "group-total-weight", GroupTotalWeightInvariant(k))
+}
+
+// GroupTotalWeightInvariant checks that the total weight of each group is the
+// sum of the weights of its members.
+func GroupTotalWeightInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GroupKeyPrefix)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			var g group.GroupInfo
+			k.cdc.MustUnmarshal(iter.Value(), &g)
+
+			membersWeight := sdk.ZeroDec()
+			k.IterateGroupMembers(ctx, g.Id, func(m group.GroupMember) bool {
+				weight, err := group.ParseWeight(m.Member.Weight)
+				if err != nil {
+					msg += fmt.Sprintf("\tmember %s of group %d has an invalid weight: %s\n", m.Member.Address, g.Id, err)
+					broken = true
+					return false
+				}
+				membersWeight = membersWeight.Add(weight)
+				return false
+			})
+
+			totalWeight, err := group.ParseWeight(g.TotalWeight)
+			if err != nil || !totalWeight.Equal(membersWeight) {
+				msg += fmt.Sprintf("\tgroup %d has a total weight of %s, its members a weight of %s\n", g.Id, g.TotalWeight, membersWeight)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(group.ModuleName, "group total weight", msg), broken
+	}
+}
@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// groupPolicyAddressPrefix is the key the addresses of the group policy
+// accounts are derived from, along with the group policy sequence.
+var groupPolicyAddressPrefix = []byte("group_policy")
+
+type Keeper struct {
+	storeKey  sdk.StoreKey
+	cdc       codec.BinaryCodec
+	router    *baseapp.MsgServiceRouter
+	accKeeper group.AccountKeeper
+
+	config        group.Config
+	weightSources map[string]group.WeightSource
+}
+
+// NewKeeper constructs a group Keeper. The proposals are executed with the
+// message handlers of router.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.BinaryCodec, router *baseapp.MsgServiceRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+	return Keeper{
+		storeKey:      storeKey,
+		cdc:           cdc,
+		router:        router,
+		accKeeper:     accKeeper,
+		config:        config,
+		weightSources: make(map[string]group.WeightSource),
+	}
+}
+
+// SetWeightSource registers source under name, for decision policies to
+// derive the voting weights of the members of their groups from.
+func (k *Keeper) SetWeightSource(name string, source group.WeightSource) {
+	if name == "" {
+		panic("weight source name cannot be empty")
+	}
+	if _, ok := k.weightSources[name]; ok {
+		panic(fmt.Sprintf("weight source %s already set", name))
+	}
+
+	k.weightSources[name] = source
+}
+
+// getWeightSource returns the weight source registered under name.
+func (k Keeper) getWeightSource(name string) (group.WeightSource, error) {
+	source, ok := k.weightSources[name]
+	if !ok {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "unknown weight source %s", name)
+	}
+
+	return source, nil
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+}
+
+// GetConfig returns the configuration of the group module.
+func (k Keeper) GetConfig() group.Config {
+	return k.config
+}
+
+// getSequence returns the last value of the sequence stored at key.
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setSequence(ctx sdk.Context, key []byte, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(key, sdk.Uint64ToBigEndian(seq))
+}
+
+// nextSequence increments the sequence stored at key, and returns its new
+// value. Sequences start at 1.
+func (k Keeper) nextSequence(ctx sdk.Context, key []byte) uint64 {
+	seq := k.getSequence(ctx, key) + 1
+	k.setSequence(ctx, key, seq)
+	return seq
+}
+
+// GetGroupInfo returns the group of id.
+func (k Keeper) GetGroupInfo(ctx sdk.Context, id uint64) (group.GroupInfo, error) {
+	var g group.GroupInfo
+	bz := ctx.KVStore(k.storeKey).Get(groupKey(id))
+	if bz == nil {
+		return g, sdkerrors.ErrNotFound.Wrapf("group %d", id)
+	}
+	k.cdc.MustUnmarshal(bz, &g)
+	return g, nil
+}
+
+// setGroupInfo stores g, indexed by its admin. The index of the previous admin
+// of g, if it changed, must be deleted by the caller.
+func (k Keeper) setGroupInfo(ctx sdk.Context, g group.GroupInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(groupKey(g.Id), k.cdc.MustMarshal(&g))
+	store.Set(groupByAdminKey(mustAccAddressFromBech32(g.Admin), g.Id), []byte{})
+}
+
+// GetGroupMember returns the member of the group of id with address member.
+func (k Keeper) GetGroupMember(ctx sdk.Context, id uint64, member sdk.AccAddress) (group.GroupMember, error) {
+	var m group.GroupMember
+	bz := ctx.KVStore(k.storeKey).Get(groupMemberKey(id, member))
+	if bz == nil {
+		return m, sdkerrors.ErrNotFound.Wrapf("member %s of group %d", member, id)
+	}
+	k.cdc.MustUnmarshal(bz, &m)
+	return m, nil
+}
+
+// setGroupMember stores m, indexed by its address.
+func (k Keeper) setGroupMember(ctx sdk.Context, m group.GroupMember) {
+	store := ctx.KVStore(k.storeKey)
+	addr := mustAccAddressFromBech32(m.Member.Address)
+	store.Set(groupMemberKey(m.GroupId, addr), k.cdc.MustMarshal(&m))
+	store.Set(groupByMemberKey(addr, m.GroupId), []byte{})
+}
+
+func (k Keeper) deleteGroupMember(ctx sdk.Context, id uint64, member sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(groupMemberKey(id, member))
+	store.Delete(groupByMemberKey(member, id))
+}
+
+// IterateGroupMembers iterates over the members of the group of id, in the
+// order of their addresses, until cb returns true.
+func (k Keeper) IterateGroupMembers(ctx sdk.Context, id uint64, cb func(m group.GroupMember) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), groupMembersPrefix(id))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var m group.GroupMember
+		k.cdc.MustUnmarshal(iter.Value(), &m)
+		if cb(m) {
+			break
+		}
+	}
+}
+
+// GetGroupPolicyInfo returns the group policy of account address addr.
+func (k Keeper) GetGroupPolicyInfo(ctx sdk.Context, addr sdk.AccAddress) (group.GroupPolicyInfo, error) {
+	var p group.GroupPolicyInfo
+	bz := ctx.KVStore(k.storeKey).Get(groupPolicyKey(addr))
+	if bz == nil {
+		return p, sdkerrors.ErrNotFound.Wrapf("group policy %s", addr)
+	}
+	k.cdc.MustUnmarshal(bz, &p)
+	return p, nil
+}
+
+// setGroupPolicyInfo stores p, indexed by its group and its admin. The index
+// of the previous admin of p, if it changed, must be deleted by the caller.
+func (k Keeper) setGroupPolicyInfo(ctx sdk.Context, p group.GroupPolicyInfo) {
+	store := ctx.KVStore(k.storeKey)
+	addr := mustAccAddressFromBech32(p.Address)
+	store.Set(groupPolicyKey(addr), k.cdc.MustMarshal(&p))
+	store.Set(groupPolicyByGroupKey(p.GroupId, addr), []byte{})
+	store.Set(groupPolicyByAdminKey(mustAccAddressFromBech32(p.Admin), addr), []byte{})
+}
+
+// iterateGroupPoliciesByGroup iterates over the group policies of the group of
+// id until cb returns true.
+func (k Keeper) iterateGroupPoliciesByGroup(ctx sdk.Context, id uint64, cb func(p group.GroupPolicyInfo) (stop bool)) error {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), groupPolicyByGroupPrefix(id))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addr := lengthPrefixedAddress(iter.Key()[len(groupPolicyByGroupPrefix(id)):])
+		p, err := k.GetGroupPolicyInfo(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if cb(p) {
+			break
+		}
+	}
+	return nil
+}
+
+// createGroupPolicyAccount creates the account of a new group policy, and
+// returns its address. The address is derived from the group policy sequence,
+// which is incremented until it yields an address no account has.
+func (k Keeper) createGroupPolicyAccount(ctx sdk.Context) sdk.AccAddress {
+	for {
+		seq := k.nextSequence(ctx, GroupPolicySeqKey)
+		key := append(append([]byte{}, groupPolicyAddressPrefix...), sdk.Uint64ToBigEndian(seq)...)
+		addr := sdk.AccAddress(address.Module(group.ModuleName, key))
+		if k.accKeeper.GetAccount(ctx, addr) != nil {
+			continue
+		}
+
+		acc := k.accKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+		k.accKeeper.SetAccount(ctx, acc)
+		return addr
+	}
+}
+
+// GetProposal returns the proposal of id.
+func (k Keeper) GetProposal(ctx sdk.Context, id uint64) (group.Proposal, error) {
+	var p group.Proposal
+	bz := ctx.KVStore(k.storeKey).Get(proposalKey(id))
+	if bz == nil {
+		return p, sdkerrors.ErrNotFound.Wrapf("proposal %d", id)
+	}
+	k.cdc.MustUnmarshal(bz, &p)
+	return p, nil
+}
+
+// setProposal stores p, indexed by its group policy.
+func (k Keeper) setProposal(ctx sdk.Context, p group.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(proposalKey(p.Id), k.cdc.MustMarshal(&p))
+	store.Set(proposalByGroupPolicyKey(mustAccAddressFromBech32(p.GroupPolicyAddress), p.Id), []byte{})
+}
+
+// iterateProposalsByGroupPolicy iterates over the proposals submitted to the
+// group policy of account address addr until cb returns true.
+func (k Keeper) iterateProposalsByGroupPolicy(ctx sdk.Context, addr sdk.AccAddress, cb func(p group.Proposal) (stop bool)) error {
+	prefix := proposalByGroupPolicyPrefix(addr)
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		p, err := k.GetProposal(ctx, sdk.BigEndianToUint64(iter.Key()[len(prefix):]))
+		if err != nil {
+			return err
+		}
+		if cb(p) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetVote returns the vote of voter on the proposal of id.
+func (k Keeper) GetVote(ctx sdk.Context, id uint64, voter sdk.AccAddress) (group.Vote, error) {
+	var v group.Vote
+	bz := ctx.KVStore(k.storeKey).Get(voteKey(id, voter))
+	if bz == nil {
+		return v, sdkerrors.ErrNotFound.Wrapf("vote of %s on proposal %d", voter, id)
+	}
+	k.cdc.MustUnmarshal(bz, &v)
+	return v, nil
+}
+
+// setVote stores v, indexed by its voter.
+func (k Keeper) setVote(ctx sdk.Context, v group.Vote) {
+	store := ctx.KVStore(k.storeKey)
+	voter := mustAccAddressFromBech32(v.Voter)
+	store.Set(voteKey(v.ProposalId, voter), k.cdc.MustMarshal(&v))
+	store.Set(voteByVoterKey(voter, v.ProposalId), []byte{})
+}
+
+// assertMetadataLength returns an error if metadata is longer than the
+// maximum length of the module configuration.
+func (k Keeper) assertMetadataLength(metadata string, description string) error {
+	if uint64(len(metadata)) > k.config.MaxMetadataLen {
+		return sdkerrors.Wrapf(group.ErrMaxLimit, "%s exceeds %d bytes", description, k.config.MaxMetadataLen)
+	}
+	return nil
+}
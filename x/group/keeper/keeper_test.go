@@ -0,0 +1,468 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+
+	groupID    uint64
+	policyAddr sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// SetupTest creates a group of addrs[1] and addrs[2], of weights 1 and 2,
+// administered by addrs[0], and a funded group policy of threshold 2.
+func (s *TestSuite) SetupTest() {
+	s.app = simapp.Setup(false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: tmtime.Now()})
+	s.addrs = simapp.AddTestAddrsIncremental(s.app, s.ctx, 4, sdk.NewInt(30000000))
+
+	res, err := s.app.GroupKeeper.CreateGroup(sdk.WrapSDKContext(s.ctx), &group.MsgCreateGroup{
+		Admin: s.addrs[0].String(),
+		Members: []group.Member{
+			{Address: s.addrs[1].String(), Weight: "1"},
+			{Address: s.addrs[2].String(), Weight: "2"},
+		},
+	})
+	s.Require().NoError(err)
+	s.groupID = res.GroupId
+
+	s.policyAddr = s.createGroupPolicy(group.NewThresholdDecisionPolicy("2", time.Hour, 0))
+	s.Require().NoError(simapp.FundAccount(s.app.BankKeeper, s.ctx, s.policyAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+}
+
+func (s *TestSuite) createGroupPolicy(policy group.DecisionPolicy) sdk.AccAddress {
+	msg, err := group.NewMsgCreateGroupPolicy(s.addrs[0], s.groupID, "", policy)
+	s.Require().NoError(err)
+	res, err := s.app.GroupKeeper.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	addr, err := sdk.AccAddressFromBech32(res.Address)
+	s.Require().NoError(err)
+	return addr
+}
+
+// submitSend submits a proposal of addrs[1] for the group policy to send 100
+// stake to addrs[3].
+func (s *TestSuite) submitSend(exec group.Exec) uint64 {
+	send := &banktypes.MsgSend{
+		FromAddress: s.policyAddr.String(),
+		ToAddress:   s.addrs[3].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+	msg, err := group.NewMsgSubmitProposal(s.policyAddr.String(), []string{s.addrs[1].String()}, []sdk.Msg{send}, "", exec)
+	s.Require().NoError(err)
+	res, err := s.app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	return res.ProposalId
+}
+
+func (s *TestSuite) vote(id uint64, voter sdk.AccAddress, option group.VoteOption, exec group.Exec) error {
+	_, err := s.app.GroupKeeper.Vote(sdk.WrapSDKContext(s.ctx), &group.MsgVote{
+		ProposalId: id,
+		Voter:      voter.String(),
+		Option:     option,
+		Exec:       exec,
+	})
+	return err
+}
+
+func (s *TestSuite) requireWeight(expected, actual string) {
+	weight, err := group.ParseWeight(actual)
+	s.Require().NoError(err)
+	s.Require().True(sdk.MustNewDecFromStr(expected).Equal(weight), "expected weight %s, got %s", expected, actual)
+}
+
+func (s *TestSuite) proposal(id uint64) group.Proposal {
+	p, err := s.app.GroupKeeper.GetProposal(s.ctx, id)
+	s.Require().NoError(err)
+	return p
+}
+
+func (s *TestSuite) TestCreateGroup() {
+	g, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, s.groupID)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[0].String(), g.Admin)
+	s.requireWeight("3", g.TotalWeight)
+	s.Require().Equal(uint64(1), g.Version)
+
+	m, err := s.app.GroupKeeper.GetGroupMember(s.ctx, s.groupID, s.addrs[2])
+	s.Require().NoError(err)
+	s.requireWeight("2", m.Member.Weight)
+	s.Require().Equal(s.ctx.BlockTime(), m.Member.AddedAt)
+
+	// the group policy has an account
+	s.Require().NotNil(s.app.AccountKeeper.GetAccount(s.ctx, s.policyAddr))
+	policyInfo, err := s.app.GroupKeeper.GetGroupPolicyInfo(s.ctx, s.policyAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(s.groupID, policyInfo.GroupId)
+
+	// a second group policy gets a distinct address
+	s.Require().NotEqual(s.policyAddr, s.createGroupPolicy(group.NewPercentageDecisionPolicy("0.5", time.Hour, 0)))
+
+	// only the admin can create group policies
+	msg, err := group.NewMsgCreateGroupPolicy(s.addrs[1], s.groupID, "", group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	s.Require().NoError(err)
+	_, err = s.app.GroupKeeper.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, group.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestUpdateGroupMembers() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	_, err := s.app.GroupKeeper.UpdateGroupMembers(goCtx, &group.MsgUpdateGroupMembers{
+		Admin:   s.addrs[0].String(),
+		GroupId: s.groupID,
+		MemberUpdates: []group.Member{
+			{Address: s.addrs[1].String(), Weight: "0"},
+			{Address: s.addrs[3].String(), Weight: "5"},
+		},
+	})
+	s.Require().NoError(err)
+
+	g, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, s.groupID)
+	s.Require().NoError(err)
+	s.requireWeight("7", g.TotalWeight)
+	s.Require().Equal(uint64(2), g.Version)
+	_, err = s.app.GroupKeeper.GetGroupMember(s.ctx, s.groupID, s.addrs[1])
+	s.Require().Error(err)
+
+	// removing a non member fails
+	_, err = s.app.GroupKeeper.UpdateGroupMembers(goCtx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrs[0].String(),
+		GroupId:       s.groupID,
+		MemberUpdates: []group.Member{{Address: s.addrs[1].String(), Weight: "0"}},
+	})
+	s.Require().Error(err)
+
+	// only the admin can update the members
+	_, err = s.app.GroupKeeper.UpdateGroupMembers(goCtx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrs[2].String(),
+		GroupId:       s.groupID,
+		MemberUpdates: []group.Member{{Address: s.addrs[1].String(), Weight: "1"}},
+	})
+	s.Require().ErrorIs(err, group.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestSubmitVoteExec() {
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	p := s.proposal(id)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, p.Status)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Hour), p.VotingPeriodEnd)
+
+	// non members can neither propose nor vote
+	_, err := s.app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(s.ctx), &group.MsgSubmitProposal{
+		GroupPolicyAddress: s.policyAddr.String(),
+		Proposers:          []string{s.addrs[3].String()},
+	})
+	s.Require().ErrorIs(err, group.ErrUnauthorized)
+	s.Require().Error(s.vote(id, s.addrs[3], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+
+	// the proposal cannot be executed before the threshold is reached
+	s.Require().NoError(s.vote(id, s.addrs[1], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+	s.Require().ErrorIs(s.vote(id, s.addrs[1], group.VOTE_OPTION_NO, group.EXEC_UNSPECIFIED), group.ErrDuplicate)
+	_, err = s.app.GroupKeeper.Exec(sdk.WrapSDKContext(s.ctx), &group.MsgExec{ProposalId: id, Executor: s.addrs[1].String()})
+	s.Require().Error(err)
+
+	s.Require().NoError(s.vote(id, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+	res, err := s.app.GroupKeeper.Exec(sdk.WrapSDKContext(s.ctx), &group.MsgExec{ProposalId: id, Executor: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+
+	p = s.proposal(id)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, p.Status)
+	s.requireWeight("3", p.FinalTallyResult.YesCount)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 900), s.app.BankKeeper.GetBalance(s.ctx, s.policyAddr, "stake"))
+
+	// a successful proposal cannot be executed twice
+	_, err = s.app.GroupKeeper.Exec(sdk.WrapSDKContext(s.ctx), &group.MsgExec{ProposalId: id, Executor: s.addrs[1].String()})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestExecTry() {
+	// the vote of the proposer alone does not reach the threshold
+	id := s.submitSend(group.EXEC_TRY)
+	p := s.proposal(id)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, p.Status)
+	s.requireWeight("1", p.FinalTallyResult.YesCount)
+
+	s.Require().NoError(s.vote(id, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_TRY))
+	p = s.proposal(id)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, p.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, p.ExecutorResult)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 900), s.app.BankKeeper.GetBalance(s.ctx, s.policyAddr, "stake"))
+}
+
+func (s *TestSuite) TestExecFailure() {
+	// the group policy cannot send more than its balance
+	send := &banktypes.MsgSend{
+		FromAddress: s.policyAddr.String(),
+		ToAddress:   s.addrs[3].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 5000)),
+	}
+	msg, err := group.NewMsgSubmitProposal(s.policyAddr.String(), []string{s.addrs[2].String()}, []sdk.Msg{send}, "", group.EXEC_TRY)
+	s.Require().NoError(err)
+	res, err := s.app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	p := s.proposal(res.ProposalId)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, p.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, p.ExecutorResult)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 1000), s.app.BankKeeper.GetBalance(s.ctx, s.policyAddr, "stake"))
+
+	// messages must be signed by the group policy
+	send = &banktypes.MsgSend{
+		FromAddress: s.addrs[1].String(),
+		ToAddress:   s.addrs[3].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+	}
+	msg, err = group.NewMsgSubmitProposal(s.policyAddr.String(), []string{s.addrs[1].String()}, []sdk.Msg{send}, "", group.EXEC_UNSPECIFIED)
+	s.Require().NoError(err)
+	_, err = s.app.GroupKeeper.SubmitProposal(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, group.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestSimulateProposal() {
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	res, err := s.app.GroupKeeper.SimulateProposal(goCtx, &group.QuerySimulateProposalRequest{ProposalId: id})
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 1)
+	s.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), res.Results[0].MsgTypeUrl)
+	s.Require().Empty(res.Results[0].Error)
+	s.Require().NotEmpty(res.Results[0].Result.Events)
+
+	// the simulation leaves the state untouched
+	s.Require().Equal(sdk.NewInt64Coin("stake", 1000), s.app.BankKeeper.GetBalance(s.ctx, s.policyAddr, "stake"))
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, s.proposal(id).ExecutorResult)
+
+	// the simulation stops at the first failed message
+	send := &banktypes.MsgSend{
+		FromAddress: s.policyAddr.String(),
+		ToAddress:   s.addrs[3].String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 600)),
+	}
+	msg, err := group.NewMsgSubmitProposal(s.policyAddr.String(), []string{s.addrs[1].String()}, []sdk.Msg{send, send, send}, "", group.EXEC_UNSPECIFIED)
+	s.Require().NoError(err)
+	submitRes, err := s.app.GroupKeeper.SubmitProposal(goCtx, msg)
+	s.Require().NoError(err)
+	res, err = s.app.GroupKeeper.SimulateProposal(goCtx, &group.QuerySimulateProposalRequest{ProposalId: submitRes.ProposalId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 2)
+	s.Require().Empty(res.Results[0].Error)
+	s.Require().Nil(res.Results[1].Result)
+	s.Require().Contains(res.Results[1].Error, "insufficient funds")
+
+	_, err = s.app.GroupKeeper.SimulateProposal(goCtx, &group.QuerySimulateProposalRequest{ProposalId: 100})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestWeightSource() {
+	// the decision policy must name a registered weight source
+	policy := group.NewPercentageDecisionPolicy("0.5", time.Hour, 0).(*group.PercentageDecisionPolicy)
+	policy.WeightSource = "unknown"
+	msg, err := group.NewMsgCreateGroupPolicy(s.addrs[0], s.groupID, "", policy)
+	s.Require().NoError(err)
+	_, err = s.app.GroupKeeper.CreateGroupPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, group.ErrInvalid)
+
+	policy.WeightSource = keeper.BondedStakeWeightSourceName
+	s.policyAddr = s.createGroupPolicy(policy)
+	s.Require().NoError(simapp.FundAccount(s.app.BankKeeper, s.ctx, s.policyAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+
+	// addrs[0] runs a validator, bonded at the end of the block
+	sh := teststaking.NewHelper(s.T(), s.ctx, s.app.StakingKeeper)
+	valAddr := sdk.ValAddress(s.addrs[0])
+	sh.CreateValidator(valAddr, ed25519.GenPrivKey().PubKey(), sdk.NewInt(1000000), true)
+	sh.Delegate(s.addrs[1], valAddr, sdk.NewInt(1000000))
+	sh.Delegate(s.addrs[2], valAddr, sdk.NewInt(3000000))
+	sh.TurnBlock(s.ctx.BlockTime())
+
+	// the weights are snapshotted at submission
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	p := s.proposal(id)
+	s.requireWeight("4000000", p.TotalWeight)
+	s.Require().Len(p.MemberWeights, 2)
+	sh.Delegate(s.addrs[1], valAddr, sdk.NewInt(5000000))
+
+	s.Require().NoError(s.vote(id, s.addrs[1], group.VOTE_OPTION_NO, group.EXEC_UNSPECIFIED))
+	s.requireWeight("1000000", s.proposal(id).FinalTallyResult.NoCount)
+
+	// the stake of addrs[2] alone reaches the percentage, unlike its group weight
+	s.Require().NoError(s.vote(id, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_TRY))
+	p = s.proposal(id)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, p.Status)
+	s.requireWeight("3000000", p.FinalTallyResult.YesCount)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, p.ExecutorResult)
+}
+
+func (s *TestSuite) TestExecutionDelay() {
+	policy := group.NewThresholdDecisionPolicy("2", time.Hour, 0).(*group.ThresholdDecisionPolicy)
+	policy.Windows.ExecutionDelay = time.Hour
+	s.policyAddr = s.createGroupPolicy(policy)
+	s.Require().NoError(simapp.FundAccount(s.app.BankKeeper, s.ctx, s.policyAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	// accepted proposals are queued until the end of their execution delay
+	executed := s.submitSend(group.EXEC_TRY)
+	vetoed := s.submitSend(group.EXEC_TRY)
+	s.Require().NoError(s.vote(executed, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_TRY))
+	s.Require().NoError(s.vote(vetoed, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_TRY))
+	p := s.proposal(executed)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, p.Status)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, p.ExecutorResult)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Hour), p.ExecutionTime)
+	queue, err := s.app.GroupKeeper.ExecutionQueue(goCtx, &group.QueryExecutionQueueRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queue.Proposals, 2)
+	_, err = s.app.GroupKeeper.Exec(goCtx, &group.MsgExec{ProposalId: executed, Executor: s.addrs[1].String()})
+	s.Require().ErrorIs(err, group.ErrInvalid)
+
+	// only the group policy admin can veto them
+	_, err = s.app.GroupKeeper.VetoProposal(goCtx, &group.MsgVetoProposal{ProposalId: vetoed, Admin: s.addrs[1].String()})
+	s.Require().ErrorIs(err, group.ErrUnauthorized)
+	_, err = s.app.GroupKeeper.VetoProposal(goCtx, &group.MsgVetoProposal{ProposalId: vetoed, Admin: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_VETOED, s.proposal(vetoed).Status)
+	queue, err = s.app.GroupKeeper.ExecutionQueue(goCtx, &group.QueryExecutionQueueRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(queue.Proposals, 1)
+	s.Require().Equal(executed, queue.Proposals[0].Id)
+
+	// once the delay ended, the proposal can be executed and can no longer be
+	// vetoed
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	goCtx = sdk.WrapSDKContext(s.ctx)
+	s.Require().NoError(s.app.GroupKeeper.EndBlocker(s.ctx))
+	_, err = s.app.GroupKeeper.VetoProposal(goCtx, &group.MsgVetoProposal{ProposalId: executed, Admin: s.addrs[0].String()})
+	s.Require().ErrorIs(err, group.ErrExpired)
+	_, err = s.app.GroupKeeper.Exec(goCtx, &group.MsgExec{ProposalId: vetoed, Executor: s.addrs[1].String()})
+	s.Require().Error(err)
+	res, err := s.app.GroupKeeper.Exec(goCtx, &group.MsgExec{ProposalId: executed, Executor: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+	queue, err = s.app.GroupKeeper.ExecutionQueue(goCtx, &group.QueryExecutionQueueRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(queue.Proposals)
+}
+
+func (s *TestSuite) TestWithdrawProposal() {
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.app.GroupKeeper.WithdrawProposal(goCtx, &group.MsgWithdrawProposal{ProposalId: id, Address: s.addrs[2].String()})
+	s.Require().ErrorIs(err, group.ErrUnauthorized)
+
+	_, err = s.app.GroupKeeper.WithdrawProposal(goCtx, &group.MsgWithdrawProposal{ProposalId: id, Address: s.addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_WITHDRAWN, s.proposal(id).Status)
+	s.Require().Error(s.vote(id, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+}
+
+func (s *TestSuite) TestUpdatesAbortProposals() {
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	_, err := s.app.GroupKeeper.UpdateGroupMetadata(sdk.WrapSDKContext(s.ctx), &group.MsgUpdateGroupMetadata{
+		Admin:    s.addrs[0].String(),
+		GroupId:  s.groupID,
+		Metadata: "updated",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, s.proposal(id).Status)
+
+	id = s.submitSend(group.EXEC_UNSPECIFIED)
+	msg, err := group.NewMsgUpdateGroupPolicyDecisionPolicy(s.addrs[0], s.policyAddr, group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	s.Require().NoError(err)
+	_, err = s.app.GroupKeeper.UpdateGroupPolicyDecisionPolicy(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, s.proposal(id).Status)
+
+	policyInfo, err := s.app.GroupKeeper.GetGroupPolicyInfo(s.ctx, s.policyAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), policyInfo.Version)
+}
+
+func (s *TestSuite) TestLeaveGroup() {
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	_, err := s.app.GroupKeeper.LeaveGroup(sdk.WrapSDKContext(s.ctx), &group.MsgLeaveGroup{Address: s.addrs[2].String(), GroupId: s.groupID})
+	s.Require().NoError(err)
+
+	g, err := s.app.GroupKeeper.GetGroupInfo(s.ctx, s.groupID)
+	s.Require().NoError(err)
+	s.requireWeight("1", g.TotalWeight)
+	s.Require().Equal(group.PROPOSAL_STATUS_ABORTED, s.proposal(id).Status)
+
+	_, err = s.app.GroupKeeper.LeaveGroup(sdk.WrapSDKContext(s.ctx), &group.MsgLeaveGroup{Address: s.addrs[2].String(), GroupId: s.groupID})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestEndBlocker() {
+	accepted := s.submitSend(group.EXEC_UNSPECIFIED)
+	rejected := s.submitSend(group.EXEC_UNSPECIFIED)
+	s.Require().NoError(s.vote(accepted, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+	s.Require().NoError(s.vote(rejected, s.addrs[2], group.VOTE_OPTION_NO, group.EXEC_UNSPECIFIED))
+
+	// proposals are tallied once their voting period ended only
+	s.Require().NoError(s.app.GroupKeeper.EndBlocker(s.ctx))
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, s.proposal(accepted).Status)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.app.GroupKeeper.EndBlocker(s.ctx))
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, s.proposal(accepted).Status)
+	s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, s.proposal(rejected).Status)
+	s.Require().Error(s.vote(accepted, s.addrs[1], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+
+	// the accepted proposal can be executed until its execution period ends
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(s.app.GroupKeeper.GetConfig().MaxExecutionPeriod + time.Second))
+	_, err := s.app.GroupKeeper.Exec(sdk.WrapSDKContext(s.ctx), &group.MsgExec{ProposalId: accepted, Executor: s.addrs[1].String()})
+	s.Require().ErrorIs(err, group.ErrExpired)
+}
+
+func (s *TestSuite) TestGenesis() {
+	id := s.submitSend(group.EXEC_UNSPECIFIED)
+	s.Require().NoError(s.vote(id, s.addrs[2], group.VOTE_OPTION_YES, group.EXEC_UNSPECIFIED))
+
+	genesis := s.app.GroupKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Len(genesis.Groups, 1)
+	s.Require().Len(genesis.GroupMembers, 2)
+	s.Require().Len(genesis.GroupPolicies, 1)
+	s.Require().Len(genesis.Proposals, 1)
+	s.Require().Len(genesis.Votes, 1)
+
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: s.ctx.BlockTime()})
+	app.GroupKeeper.InitGenesis(ctx, genesis)
+	s.Require().Equal(genesis, app.GroupKeeper.ExportGenesis(ctx))
+
+	// the imported proposal is tallied at the end of its voting period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(app.GroupKeeper.EndBlocker(ctx))
+	p, err := app.GroupKeeper.GetProposal(ctx, id)
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, p.Status)
+}
+
+func (s *TestSuite) TestInvariant() {
+	msg, broken := keeper.GroupTotalWeightInvariant(s.app.GroupKeeper)(s.ctx)
+	s.Require().False(broken, msg)
+}
@@ -0,0 +1,277 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// Keys for store prefixes
+var (
+	GroupSeqKey              = []byte{0x00}
+	GroupKeyPrefix           = []byte{0x01}
+	GroupByAdminIndexPrefix  = []byte{0x02}
+	GroupMemberKeyPrefix     = []byte{0x10}
+	GroupMemberByMemberIndex = []byte{0x11}
+
+	GroupPolicySeqKey              = []byte{0x20}
+	GroupPolicyKeyPrefix           = []byte{0x21}
+	GroupPolicyByGroupIndexPrefix  = []byte{0x22}
+	GroupPolicyByAdminIndexPrefix  = []byte{0x23}
+	ProposalSeqKey                 = []byte{0x30}
+	ProposalKeyPrefix              = []byte{0x31}
+	ProposalByGroupPolicyIndex     = []byte{0x32}
+	ProposalByVotingPeriodEndIndex = []byte{0x33}
+	ProposalByExecutionTimeIndex   = []byte{0x34}
+	VoteKeyPrefix                  = []byte{0x40}
+	VoteByVoterIndexPrefix         = []byte{0x41}
+)
+
+// StoreKey is the store key string for group
+const StoreKey = group.StoreKey
+
+// groupKey returns the key of the group of id:
+//
+// - 0x01<groupID_Bytes>: GroupInfo
+func groupKey(id uint64) []byte {
+	return append(GroupKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// groupByAdminKey returns the key indexing the group of id by its admin:
+//
+// - 0x02<adminLen (1 Byte)><admin_Bytes><groupID_Bytes>: []byte{}
+func groupByAdminKey(admin sdk.AccAddress, id uint64) []byte {
+	return append(groupByAdminPrefix(admin), sdk.Uint64ToBigEndian(id)...)
+}
+
+func groupByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupByAdminIndexPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// groupMemberKey returns the key of the member of the group of id:
+//
+// - 0x10<groupID_Bytes><memberLen (1 Byte)><member_Bytes>: GroupMember
+func groupMemberKey(id uint64, member sdk.AccAddress) []byte {
+	return append(groupMembersPrefix(id), address.MustLengthPrefix(member)...)
+}
+
+func groupMembersPrefix(id uint64) []byte {
+	return append(GroupMemberKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// groupByMemberKey returns the key indexing the group of id by one of its
+// members:
+//
+// - 0x11<memberLen (1 Byte)><member_Bytes><groupID_Bytes>: []byte{}
+func groupByMemberKey(member sdk.AccAddress, id uint64) []byte {
+	return append(groupByMemberPrefix(member), sdk.Uint64ToBigEndian(id)...)
+}
+
+func groupByMemberPrefix(member sdk.AccAddress) []byte {
+	return append(GroupMemberByMemberIndex, address.MustLengthPrefix(member)...)
+}
+
+// groupPolicyKey returns the key of the group policy of account address addr:
+//
+// - 0x21<addrLen (1 Byte)><addr_Bytes>: GroupPolicyInfo
+func groupPolicyKey(addr sdk.AccAddress) []byte {
+	return append(GroupPolicyKeyPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// groupPolicyByGroupKey returns the key indexing the group policy of account
+// address addr by its group:
+//
+// - 0x22<groupID_Bytes><addrLen (1 Byte)><addr_Bytes>: []byte{}
+func groupPolicyByGroupKey(id uint64, addr sdk.AccAddress) []byte {
+	return append(groupPolicyByGroupPrefix(id), address.MustLengthPrefix(addr)...)
+}
+
+func groupPolicyByGroupPrefix(id uint64) []byte {
+	return append(GroupPolicyByGroupIndexPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// groupPolicyByAdminKey returns the key indexing the group policy of account
+// address addr by its admin:
+//
+// - 0x23<adminLen (1 Byte)><admin_Bytes><addrLen (1 Byte)><addr_Bytes>: []byte{}
+func groupPolicyByAdminKey(admin, addr sdk.AccAddress) []byte {
+	return append(groupPolicyByAdminPrefix(admin), address.MustLengthPrefix(addr)...)
+}
+
+func groupPolicyByAdminPrefix(admin sdk.AccAddress) []byte {
+	return append(GroupPolicyByAdminIndexPrefix, address.MustLengthPrefix(admin)...)
+}
+
+// proposalKey returns the key of the proposal of id:
+//
+// - 0x31<proposalID_Bytes>: Proposal
+func proposalKey(id uint64) []byte {
+	return append(ProposalKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// proposalByGroupPolicyKey returns the key indexing the proposal of id by its
+// group policy:
+//
+// - 0x32<addrLen (1 Byte)><addr_Bytes><proposalID_Bytes>: []byte{}
+func proposalByGroupPolicyKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(proposalByGroupPolicyPrefix(addr), sdk.Uint64ToBigEndian(id)...)
+}
+
+func proposalByGroupPolicyPrefix(addr sdk.AccAddress) []byte {
+	return append(ProposalByGroupPolicyIndex, address.MustLengthPrefix(addr)...)
+}
+
+// proposalByVotingPeriodEndKey returns the key queuing the submitted proposal
+// of id for its tally at the end of its voting period:
+//
+// - 0x33<votingPeriodEnd_Bytes><proposalID_Bytes>: []byte{}
+func proposalByVotingPeriodEndKey(end time.Time, id uint64) []byte {
+	return append(proposalByVotingPeriodEndPrefix(end), sdk.Uint64ToBigEndian(id)...)
+}
+
+func proposalByVotingPeriodEndPrefix(end time.Time) []byte {
+	return append(ProposalByVotingPeriodEndIndex, sdk.FormatTimeBytes(end)...)
+}
+
+// proposalByExecutionTimeKey returns the key queuing the accepted proposal of
+// id until the end of its execution delay:
+//
+// - 0x34<executionTime_Bytes><proposalID_Bytes>: []byte{}
+func proposalByExecutionTimeKey(t time.Time, id uint64) []byte {
+	return append(proposalByExecutionTimePrefix(t), sdk.Uint64ToBigEndian(id)...)
+}
+
+func proposalByExecutionTimePrefix(t time.Time) []byte {
+	return append(ProposalByExecutionTimeIndex, sdk.FormatTimeBytes(t)...)
+}
+
+// voteKey returns the key of the vote of voter on the proposal of id:
+//
+// - 0x40<proposalID_Bytes><voterLen (1 Byte)><voter_Bytes>: Vote
+func voteKey(id uint64, voter sdk.AccAddress) []byte {
+	return append(votesByProposalPrefix(id), address.MustLengthPrefix(voter)...)
+}
+
+func votesByProposalPrefix(id uint64) []byte {
+	return append(VoteKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// voteByVoterKey returns the key indexing the vote of voter on the proposal
+// of id by its voter:
+//
+// - 0x41<voterLen (1 Byte)><voter_Bytes><proposalID_Bytes>: []byte{}
+func voteByVoterKey(voter sdk.AccAddress, id uint64) []byte {
+	return append(votesByVoterPrefix(voter), sdk.Uint64ToBigEndian(id)...)
+}
+
+func votesByVoterPrefix(voter sdk.AccAddress) []byte {
+	return append(VoteByVoterIndexPrefix, address.MustLengthPrefix(voter)...)
+}
+
+// KeyFormats are the layouts of the keys of the group store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:   "group_seq",
+		Prefix: GroupSeqKey,
+		Value:  keyformat.SegmentValue(keyformat.Uint64("group_seq")),
+	},
+	{
+		Name:     "group",
+		Prefix:   GroupKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("group_id")},
+		Value:    keyformat.ProtoValue(&group.GroupInfo{}),
+	},
+	{
+		Name:     "group_by_admin",
+		Prefix:   GroupByAdminIndexPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("admin"), keyformat.Uint64("group_id")},
+	},
+	{
+		Name:     "group_member",
+		Prefix:   GroupMemberKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("group_id"), keyformat.AccAddress("member")},
+		Value:    keyformat.ProtoValue(&group.GroupMember{}),
+	},
+	{
+		Name:     "group_by_member",
+		Prefix:   GroupMemberByMemberIndex,
+		Segments: []keyformat.Segment{keyformat.AccAddress("member"), keyformat.Uint64("group_id")},
+	},
+	{
+		Name:   "group_policy_seq",
+		Prefix: GroupPolicySeqKey,
+		Value:  keyformat.SegmentValue(keyformat.Uint64("group_policy_seq")),
+	},
+	{
+		Name:     "group_policy",
+		Prefix:   GroupPolicyKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("address")},
+		Value:    keyformat.ProtoValue(&group.GroupPolicyInfo{}),
+	},
+	{
+		Name:     "group_policy_by_group",
+		Prefix:   GroupPolicyByGroupIndexPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("group_id"), keyformat.AccAddress("address")},
+	},
+	{
+		Name:     "group_policy_by_admin",
+		Prefix:   GroupPolicyByAdminIndexPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("admin"), keyformat.AccAddress("address")},
+	},
+	{
+		Name:   "proposal_seq",
+		Prefix: ProposalSeqKey,
+		Value:  keyformat.SegmentValue(keyformat.Uint64("proposal_seq")),
+	},
+	{
+		Name:     "proposal",
+		Prefix:   ProposalKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("proposal_id")},
+		Value:    keyformat.ProtoValue(&group.Proposal{}),
+	},
+	{
+		Name:     "proposal_by_group_policy",
+		Prefix:   ProposalByGroupPolicyIndex,
+		Segments: []keyformat.Segment{keyformat.AccAddress("address"), keyformat.Uint64("proposal_id")},
+	},
+	{
+		Name:     "proposal_by_voting_period_end",
+		Prefix:   ProposalByVotingPeriodEndIndex,
+		Segments: []keyformat.Segment{keyformat.Time("voting_period_end"), keyformat.Uint64("proposal_id")},
+	},
+	{
+		Name:     "proposal_by_execution_time",
+		Prefix:   ProposalByExecutionTimeIndex,
+		Segments: []keyformat.Segment{keyformat.Time("execution_time"), keyformat.Uint64("proposal_id")},
+	},
+	{
+		Name:     "vote",
+		Prefix:   VoteKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.Uint64("proposal_id"), keyformat.AccAddress("voter")},
+		Value:    keyformat.ProtoValue(&group.Vote{}),
+	},
+	{
+		Name:     "vote_by_voter",
+		Prefix:   VoteByVoterIndexPrefix,
+		Segments: []keyformat.Segment{keyformat.AccAddress("voter"), keyformat.Uint64("proposal_id")},
+	},
+}
+
+// lengthPrefixedAddress returns the address bz starts with, bz being a key
+// suffix starting with a length-prefixed address.
+func lengthPrefixedAddress(bz []byte) sdk.AccAddress {
+	return sdk.AccAddress(bz[1 : 1+int(bz[0])])
+}
+
+// mustAccAddressFromBech32 parses the address of a stored object, which was
+// validated when it was stored.
+func mustAccAddressFromBech32(addr string) sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		panic(err)
+	}
+	return acc
+}
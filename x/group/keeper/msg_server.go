@@ -0,0 +1,624 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var _ group.MsgServer = Keeper{}
+
+// CreateGroup implements the MsgServer.CreateGroup method.
+func (k Keeper) CreateGroup(goCtx context.Context, req *group.MsgCreateGroup) (*group.MsgCreateGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataLength(req.Metadata, "group metadata"); err != nil {
+		return nil, err
+	}
+
+	id := k.nextSequence(ctx, GroupSeqKey)
+	totalWeight := sdk.ZeroDec()
+	for _, m := range req.Members {
+		if err := k.assertMetadataLength(m.Metadata, "member metadata"); err != nil {
+			return nil, err
+		}
+
+		weight, err := group.ParseWeight(m.Weight)
+		if err != nil {
+			return nil, err
+		}
+		totalWeight = totalWeight.Add(weight)
+
+		k.setGroupMember(ctx, group.GroupMember{
+			GroupId: id,
+			Member: &group.Member{
+				Address:  m.Address,
+				Weight:   m.Weight,
+				Metadata: m.Metadata,
+				AddedAt:  ctx.BlockTime(),
+			},
+		})
+	}
+
+	k.setGroupInfo(ctx, group.GroupInfo{
+		Id:          id,
+		Admin:       req.Admin,
+		Metadata:    req.Metadata,
+		Version:     1,
+		TotalWeight: totalWeight.String(),
+		CreatedAt:   ctx.BlockTime(),
+	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventCreateGroup{GroupId: id}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCreateGroupResponse{GroupId: id}, nil
+}
+
+// UpdateGroupMembers implements the MsgServer.UpdateGroupMembers method. A
+// member update of weight zero removes the member from the group.
+func (k Keeper) UpdateGroupMembers(goCtx context.Context, req *group.MsgUpdateGroupMembers) (*group.MsgUpdateGroupMembersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.updateGroup(ctx, req.GroupId, req.Admin, func(g *group.GroupInfo) error {
+		totalWeight, err := group.ParseWeight(g.TotalWeight)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range req.MemberUpdates {
+			if err := k.assertMetadataLength(m.Metadata, "member metadata"); err != nil {
+				return err
+			}
+
+			weight, err := group.ParseWeight(m.Weight)
+			if err != nil {
+				return err
+			}
+
+			addr := mustAccAddressFromBech32(m.Address)
+			prev, err := k.GetGroupMember(ctx, g.Id, addr)
+			found := err == nil
+			if found {
+				prevWeight, err := group.ParseWeight(prev.Member.Weight)
+				if err != nil {
+					return err
+				}
+				totalWeight = totalWeight.Sub(prevWeight)
+			}
+
+			if weight.IsZero() {
+				if !found {
+					return sdkerrors.ErrNotFound.Wrapf("member %s of group %d", m.Address, g.Id)
+				}
+				k.deleteGroupMember(ctx, g.Id, addr)
+				continue
+			}
+
+			addedAt := ctx.BlockTime()
+			if found {
+				addedAt = prev.Member.AddedAt
+			}
+			totalWeight = totalWeight.Add(weight)
+			k.setGroupMember(ctx, group.GroupMember{
+				GroupId: g.Id,
+				Member: &group.Member{
+					Address:  m.Address,
+					Weight:   m.Weight,
+					Metadata: m.Metadata,
+					AddedAt:  addedAt,
+				},
+			})
+		}
+
+		g.TotalWeight = totalWeight.String()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupMembersResponse{}, nil
+}
+
+// UpdateGroupAdmin implements the MsgServer.UpdateGroupAdmin method.
+func (k Keeper) UpdateGroupAdmin(goCtx context.Context, req *group.MsgUpdateGroupAdmin) (*group.MsgUpdateGroupAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.updateGroup(ctx, req.GroupId, req.Admin, func(g *group.GroupInfo) error {
+		ctx.KVStore(k.storeKey).Delete(groupByAdminKey(mustAccAddressFromBech32(g.Admin), g.Id))
+		g.Admin = req.NewAdmin
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupAdminResponse{}, nil
+}
+
+// UpdateGroupMetadata implements the MsgServer.UpdateGroupMetadata method.
+func (k Keeper) UpdateGroupMetadata(goCtx context.Context, req *group.MsgUpdateGroupMetadata) (*group.MsgUpdateGroupMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.updateGroup(ctx, req.GroupId, req.Admin, func(g *group.GroupInfo) error {
+		if err := k.assertMetadataLength(req.Metadata, "group metadata"); err != nil {
+			return err
+		}
+		g.Metadata = req.Metadata
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupMetadataResponse{}, nil
+}
+
+// updateGroup applies update to the group of id, whose admin must be admin,
+// bumps its version and aborts the pending proposals of its group policies.
+func (k Keeper) updateGroup(ctx sdk.Context, id uint64, admin string, update func(g *group.GroupInfo) error) error {
+	g, err := k.GetGroupInfo(ctx, id)
+	if err != nil {
+		return err
+	}
+	if g.Admin != admin {
+		return sdkerrors.Wrap(group.ErrUnauthorized, "not group admin")
+	}
+
+	if err := update(&g); err != nil {
+		return err
+	}
+
+	return k.bumpGroupVersion(ctx, g)
+}
+
+// bumpGroupVersion stores the modified group g with its next version, and
+// aborts the pending proposals of its group policies.
+func (k Keeper) bumpGroupVersion(ctx sdk.Context, g group.GroupInfo) error {
+	g.Version++
+	k.setGroupInfo(ctx, g)
+	if err := k.abortGroupProposals(ctx, g.Id); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroup{GroupId: g.Id})
+}
+
+// CreateGroupPolicy implements the MsgServer.CreateGroupPolicy method. The
+// group policy account is created along with the group policy.
+func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGroupPolicy) (*group.MsgCreateGroupPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataLength(req.Metadata, "group policy metadata"); err != nil {
+		return nil, err
+	}
+
+	g, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	if g.Admin != req.Admin {
+		return nil, sdkerrors.Wrap(group.ErrUnauthorized, "not group admin")
+	}
+
+	policy, err := req.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateDecisionPolicy(g, policy); err != nil {
+		return nil, err
+	}
+
+	addr := k.createGroupPolicyAccount(ctx)
+	policyInfo, err := group.NewGroupPolicyInfo(addr, g.Id, mustAccAddressFromBech32(req.Admin), req.Metadata, 1, policy, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+	k.setGroupPolicyInfo(ctx, policyInfo)
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventCreateGroupPolicy{Address: addr.String()}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgCreateGroupPolicyResponse{Address: addr.String()}, nil
+}
+
+// UpdateGroupPolicyAdmin implements the MsgServer.UpdateGroupPolicyAdmin method.
+func (k Keeper) UpdateGroupPolicyAdmin(goCtx context.Context, req *group.MsgUpdateGroupPolicyAdmin) (*group.MsgUpdateGroupPolicyAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.updateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, func(p *group.GroupPolicyInfo) error {
+		ctx.KVStore(k.storeKey).Delete(groupPolicyByAdminKey(mustAccAddressFromBech32(p.Admin), mustAccAddressFromBech32(p.Address)))
+		p.Admin = req.NewAdmin
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyAdminResponse{}, nil
+}
+
+// UpdateGroupPolicyDecisionPolicy implements the
+// MsgServer.UpdateGroupPolicyDecisionPolicy method.
+func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *group.MsgUpdateGroupPolicyDecisionPolicy) (*group.MsgUpdateGroupPolicyDecisionPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.updateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, func(p *group.GroupPolicyInfo) error {
+		policy, err := req.GetDecisionPolicy()
+		if err != nil {
+			return err
+		}
+
+		g, err := k.GetGroupInfo(ctx, p.GroupId)
+		if err != nil {
+			return err
+		}
+		if err := k.validateDecisionPolicy(g, policy); err != nil {
+			return err
+		}
+
+		return p.SetDecisionPolicy(policy)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyDecisionPolicyResponse{}, nil
+}
+
+// UpdateGroupPolicyMetadata implements the MsgServer.UpdateGroupPolicyMetadata
+// method.
+func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, req *group.MsgUpdateGroupPolicyMetadata) (*group.MsgUpdateGroupPolicyMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.updateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, func(p *group.GroupPolicyInfo) error {
+		if err := k.assertMetadataLength(req.Metadata, "group policy metadata"); err != nil {
+			return err
+		}
+		p.Metadata = req.Metadata
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
+}
+
+// validateDecisionPolicy validates policy against the group g and the module
+// configuration, and checks that its weight source is registered.
+func (k Keeper) validateDecisionPolicy(g group.GroupInfo, policy group.DecisionPolicy) error {
+	if err := policy.Validate(g, k.config); err != nil {
+		return err
+	}
+	if name := policy.GetWeightSource(); name != "" {
+		if _, err := k.getWeightSource(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateGroupPolicy applies update to the group policy of account address
+// addr, whose admin must be admin, bumps its version and aborts its pending
+// proposals.
+func (k Keeper) updateGroupPolicy(ctx sdk.Context, addr string, admin string, update func(p *group.GroupPolicyInfo) error) error {
+	policyAddr := mustAccAddressFromBech32(addr)
+	p, err := k.GetGroupPolicyInfo(ctx, policyAddr)
+	if err != nil {
+		return err
+	}
+	if p.Admin != admin {
+		return sdkerrors.Wrap(group.ErrUnauthorized, "not group policy admin")
+	}
+
+	if err := update(&p); err != nil {
+		return err
+	}
+
+	p.Version++
+	k.setGroupPolicyInfo(ctx, p)
+	if err := k.abortProposals(ctx, policyAddr); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventUpdateGroupPolicy{Address: addr})
+}
+
+// SubmitProposal implements the MsgServer.SubmitProposal method. With
+// EXEC_TRY, the proposers vote yes, and the proposal is executed if these
+// votes are enough for it to be accepted.
+func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataLength(req.Metadata, "proposal metadata"); err != nil {
+		return nil, err
+	}
+
+	policyAddr := mustAccAddressFromBech32(req.GroupPolicyAddress)
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, policyAddr)
+	if err != nil {
+		return nil, err
+	}
+	g, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	for _, proposer := range req.Proposers {
+		if _, err := k.GetGroupMember(ctx, g.Id, mustAccAddressFromBech32(proposer)); err != nil {
+			return nil, sdkerrors.Wrapf(group.ErrUnauthorized, "proposer %s is not a member of group %d", proposer, g.Id)
+		}
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range msgs {
+		if err := assertSignedBy(msg, policyAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	p := group.Proposal{
+		Id:                 k.nextSequence(ctx, ProposalSeqKey),
+		GroupPolicyAddress: req.GroupPolicyAddress,
+		Metadata:           req.Metadata,
+		Proposers:          req.Proposers,
+		SubmitTime:         ctx.BlockTime(),
+		GroupVersion:       g.Version,
+		GroupPolicyVersion: policyInfo.Version,
+		Status:             group.PROPOSAL_STATUS_SUBMITTED,
+		FinalTallyResult:   group.DefaultTallyResult(),
+		VotingPeriodEnd:    ctx.BlockTime().Add(policy.GetVotingPeriod()),
+		ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+		Messages:           req.Messages,
+	}
+	if err := k.snapshotWeights(ctx, g, policy, &p); err != nil {
+		return nil, err
+	}
+	k.setProposal(ctx, p)
+	ctx.KVStore(k.storeKey).Set(proposalByVotingPeriodEndKey(p.VotingPeriodEnd, p.Id), []byte{})
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: p.Id}); err != nil {
+		return nil, err
+	}
+
+	if req.Exec == group.EXEC_TRY {
+		for _, proposer := range req.Proposers {
+			if err := k.vote(ctx, &p, proposer, group.VOTE_OPTION_YES, ""); err != nil {
+				return nil, err
+			}
+		}
+		if err := k.tryExec(ctx, &p, policyInfo); err != nil {
+			return nil, err
+		}
+		k.setProposal(ctx, p)
+	}
+
+	return &group.MsgSubmitProposalResponse{ProposalId: p.Id}, nil
+}
+
+// WithdrawProposal implements the MsgServer.WithdrawProposal method. A
+// submitted proposal can be withdrawn by one of its proposers or by the admin
+// of its group policy.
+func (k Keeper) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawProposal) (*group.MsgWithdrawProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	p, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "cannot withdraw a proposal with the status %s", p.Status)
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, mustAccAddressFromBech32(p.GroupPolicyAddress))
+	if err != nil {
+		return nil, err
+	}
+	authorized := req.Address == policyInfo.Admin
+	for _, proposer := range p.Proposers {
+		authorized = authorized || req.Address == proposer
+	}
+	if !authorized {
+		return nil, sdkerrors.Wrapf(group.ErrUnauthorized, "%s is neither a proposer nor the group policy admin", req.Address)
+	}
+
+	p.Status = group.PROPOSAL_STATUS_WITHDRAWN
+	k.setProposal(ctx, p)
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventWithdrawProposal{ProposalId: p.Id}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgWithdrawProposalResponse{}, nil
+}
+
+// Vote implements the MsgServer.Vote method. With EXEC_TRY, the proposal is
+// executed if the vote makes it accepted.
+func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertMetadataLength(req.Metadata, "vote metadata"); err != nil {
+		return nil, err
+	}
+
+	p, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.vote(ctx, &p, req.Voter, req.Option, req.Metadata); err != nil {
+		return nil, err
+	}
+
+	if req.Exec == group.EXEC_TRY {
+		policyInfo, err := k.GetGroupPolicyInfo(ctx, mustAccAddressFromBech32(p.GroupPolicyAddress))
+		if err != nil {
+			return nil, err
+		}
+		if err := k.tryExec(ctx, &p, policyInfo); err != nil {
+			return nil, err
+		}
+	}
+	k.setProposal(ctx, p)
+
+	return &group.MsgVoteResponse{}, nil
+}
+
+// vote casts the vote of voter, weighted by its weight in the group, on the
+// submitted proposal p, and adds it to the tally result of p.
+func (k Keeper) vote(ctx sdk.Context, p *group.Proposal, voter string, option group.VoteOption, metadata string) error {
+	if p.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return sdkerrors.Wrapf(group.ErrInvalid, "cannot vote on a proposal with the status %s", p.Status)
+	}
+	if !ctx.BlockTime().Before(p.VotingPeriodEnd) {
+		return sdkerrors.Wrap(group.ErrExpired, "voting period has ended already")
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, mustAccAddressFromBech32(p.GroupPolicyAddress))
+	if err != nil {
+		return err
+	}
+	voterAddr := mustAccAddressFromBech32(voter)
+	member, err := k.GetGroupMember(ctx, policyInfo.GroupId, voterAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(group.ErrUnauthorized, "voter %s is not a member of group %d", voter, policyInfo.GroupId)
+	}
+	if _, err := k.GetVote(ctx, p.Id, voterAddr); err == nil {
+		return sdkerrors.Wrapf(group.ErrDuplicate, "%s already voted on proposal %d", voter, p.Id)
+	}
+
+	if err := p.FinalTallyResult.Add(option, memberWeight(*p, member)); err != nil {
+		return err
+	}
+	k.setVote(ctx, group.Vote{
+		ProposalId: p.Id,
+		Voter:      voter,
+		Option:     option,
+		Metadata:   metadata,
+		SubmitTime: ctx.BlockTime(),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventVote{ProposalId: p.Id})
+}
+
+// tryExec executes the proposal p if its tally makes it accepted and its
+// execution period is open, and does nothing otherwise.
+func (k Keeper) tryExec(ctx sdk.Context, p *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	if err := k.tally(ctx, p, policyInfo); err != nil {
+		return err
+	}
+	if p.Status != group.PROPOSAL_STATUS_ACCEPTED {
+		return nil
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+	if ctx.BlockTime().Before(p.SubmitTime.Add(policy.GetMinExecutionPeriod())) || ctx.BlockTime().Before(p.ExecutionTime) {
+		return nil
+	}
+
+	return k.execProposal(ctx, p, policyInfo)
+}
+
+// Exec implements the MsgServer.Exec method. Anyone can execute an accepted
+// proposal until it succeeds.
+func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	p, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if !isPending(p) {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "cannot execute a proposal with the status %s and the executor result %s", p.Status, p.ExecutorResult)
+	}
+
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, mustAccAddressFromBech32(p.GroupPolicyAddress))
+	if err != nil {
+		return nil, err
+	}
+	if err := k.tally(ctx, &p, policyInfo); err != nil {
+		return nil, err
+	}
+	if p.Status != group.PROPOSAL_STATUS_ACCEPTED {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "cannot execute a proposal with the status %s", p.Status)
+	}
+
+	if err := k.execProposal(ctx, &p, policyInfo); err != nil {
+		return nil, err
+	}
+	k.setProposal(ctx, p)
+
+	return &group.MsgExecResponse{Result: p.ExecutorResult}, nil
+}
+
+// VetoProposal implements the MsgServer.VetoProposal method. The group policy
+// admin can veto an accepted proposal until its execution delay ends.
+func (k Keeper) VetoProposal(goCtx context.Context, req *group.MsgVetoProposal) (*group.MsgVetoProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	p, err := k.GetProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	policyInfo, err := k.GetGroupPolicyInfo(ctx, mustAccAddressFromBech32(p.GroupPolicyAddress))
+	if err != nil {
+		return nil, err
+	}
+	if policyInfo.Admin != req.Admin {
+		return nil, sdkerrors.Wrapf(group.ErrUnauthorized, "%s is not the group policy admin", req.Admin)
+	}
+	if p.Status != group.PROPOSAL_STATUS_ACCEPTED || p.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+		return nil, sdkerrors.Wrapf(group.ErrInvalid, "cannot veto a proposal with the status %s and the executor result %s", p.Status, p.ExecutorResult)
+	}
+	if !ctx.BlockTime().Before(p.ExecutionTime) {
+		return nil, sdkerrors.Wrapf(group.ErrExpired, "proposal could only be vetoed until %s", p.ExecutionTime)
+	}
+
+	p.Status = group.PROPOSAL_STATUS_VETOED
+	k.setProposal(ctx, p)
+	k.dequeueExecution(ctx, p)
+
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventVetoProposal{ProposalId: p.Id}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVetoProposalResponse{}, nil
+}
+
+// LeaveGroup implements the MsgServer.LeaveGroup method.
+func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*group.MsgLeaveGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	g, err := k.GetGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := mustAccAddressFromBech32(req.Address)
+	m, err := k.GetGroupMember(ctx, g.Id, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight, err := group.ParseWeight(g.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+	weight, err := group.ParseWeight(m.Member.Weight)
+	if err != nil {
+		return nil, err
+	}
+	g.TotalWeight = totalWeight.Sub(weight).String()
+	k.deleteGroupMember(ctx, g.Id, addr)
+
+	if err := k.bumpGroupVersion(ctx, g); err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&group.EventLeaveGroup{GroupId: g.Id, Address: req.Address}); err != nil {
+		return nil, err
+	}
+
+	return &group.MsgLeaveGroupResponse{}, nil
+}
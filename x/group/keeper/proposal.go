@@ -0,0 +1,296 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// isPending returns whether the proposal p can still be executed.
+func isPending(p group.Proposal) bool {
+	switch p.Status {
+	case group.PROPOSAL_STATUS_SUBMITTED:
+		return true
+	case group.PROPOSAL_STATUS_ACCEPTED:
+		return p.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+	default:
+		return false
+	}
+}
+
+// abortProposals aborts the pending proposals of the group policy of account
+// address addr, after the group policy or its group was modified: the votes on
+// them were cast for a group or a decision policy which no longer exist.
+func (k Keeper) abortProposals(ctx sdk.Context, addr sdk.AccAddress) error {
+	var pending []group.Proposal
+	err := k.iterateProposalsByGroupPolicy(ctx, addr, func(p group.Proposal) bool {
+		if isPending(p) {
+			pending = append(pending, p)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range pending {
+		p.Status = group.PROPOSAL_STATUS_ABORTED
+		k.setProposal(ctx, p)
+		k.dequeueExecution(ctx, p)
+	}
+
+	return nil
+}
+
+// abortGroupProposals aborts the pending proposals of all the group policies
+// of the group of id.
+func (k Keeper) abortGroupProposals(ctx sdk.Context, id uint64) error {
+	var addrs []sdk.AccAddress
+	err := k.iterateGroupPoliciesByGroup(ctx, id, func(p group.GroupPolicyInfo) bool {
+		addrs = append(addrs, mustAccAddressFromBech32(p.Address))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if err := k.abortProposals(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// snapshotWeights derives the voting weights of the members of the group g on
+// the proposal p, and the total weight of the group, from the weight source
+// of policy. It leaves p untouched if policy uses the weights set in g.
+func (k Keeper) snapshotWeights(ctx sdk.Context, g group.GroupInfo, policy group.DecisionPolicy, p *group.Proposal) error {
+	name := policy.GetWeightSource()
+	if name == "" {
+		return nil
+	}
+	source, err := k.getWeightSource(name)
+	if err != nil {
+		return err
+	}
+
+	total := sdk.ZeroDec()
+	var weights []group.MemberWeight
+	k.IterateGroupMembers(ctx, g.Id, func(m group.GroupMember) bool {
+		var weight sdk.Dec
+		weight, err = source.Weight(ctx, g, *m.Member)
+		if err != nil {
+			err = sdkerrors.Wrapf(err, "weight source %s", name)
+			return true
+		}
+		if weight.IsNegative() {
+			err = sdkerrors.Wrapf(group.ErrInvalid, "weight source %s returned the negative weight %s for %s", name, weight, m.Member.Address)
+			return true
+		}
+		total = total.Add(weight)
+		weights = append(weights, group.MemberWeight{Address: m.Member.Address, Weight: weight.String()})
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	p.TotalWeight = total.String()
+	p.MemberWeights = weights
+	return nil
+}
+
+// memberWeight returns the voting weight of member on the proposal p: the
+// weight snapshotted at submission if any, else the weight set in the group.
+func memberWeight(p group.Proposal, member group.GroupMember) string {
+	if p.TotalWeight == "" {
+		return member.Member.Weight
+	}
+	for _, w := range p.MemberWeights {
+		if w.Address == member.Member.Address {
+			return w.Weight
+		}
+	}
+
+	return "0"
+}
+
+// tally updates the status of the submitted proposal p with the decision
+// policy of its group policy: p is accepted or rejected once its tally result
+// is final, or once its voting period ended.
+func (k Keeper) tally(ctx sdk.Context, p *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	if p.Status != group.PROPOSAL_STATUS_SUBMITTED {
+		return nil
+	}
+
+	g, err := k.GetGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return err
+	}
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	totalWeight := g.TotalWeight
+	if p.TotalWeight != "" {
+		totalWeight = p.TotalWeight
+	}
+	result, err := policy.Allow(p.FinalTallyResult, totalWeight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "policy allow")
+	}
+
+	votingEnded := !ctx.BlockTime().Before(p.VotingPeriodEnd)
+	switch {
+	case result.Allow && (result.Final || votingEnded):
+		p.Status = group.PROPOSAL_STATUS_ACCEPTED
+		if delay := policy.GetExecutionDelay(); delay > 0 {
+			p.ExecutionTime = ctx.BlockTime().Add(delay)
+			ctx.KVStore(k.storeKey).Set(proposalByExecutionTimeKey(p.ExecutionTime, p.Id), []byte{})
+		}
+	case result.Final || votingEnded:
+		p.Status = group.PROPOSAL_STATUS_REJECTED
+	}
+
+	return nil
+}
+
+// execProposal executes the messages of the accepted proposal p on behalf of
+// its group policy account, if its execution period is open. The messages are
+// executed atomically: if one fails, the state changes of all of them are
+// reverted, and the proposal can be executed again.
+func (k Keeper) execProposal(ctx sdk.Context, p *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return err
+	}
+
+	if minExec := p.SubmitTime.Add(policy.GetMinExecutionPeriod()); ctx.BlockTime().Before(minExec) {
+		return sdkerrors.Wrapf(group.ErrInvalid, "proposal can only be executed after %s", minExec)
+	}
+	if maxExec := p.VotingPeriodEnd.Add(k.config.MaxExecutionPeriod); ctx.BlockTime().After(maxExec) {
+		return sdkerrors.Wrapf(group.ErrExpired, "proposal could only be executed until %s", maxExec)
+	}
+	if ctx.BlockTime().Before(p.ExecutionTime) {
+		return sdkerrors.Wrapf(group.ErrInvalid, "proposal can only be executed after its execution delay, at %s", p.ExecutionTime)
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	var logs string
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.dispatchMsgs(cacheCtx, mustAccAddressFromBech32(policyInfo.Address), msgs); err != nil {
+		p.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_FAILURE
+		logs = err.Error()
+		k.Logger(ctx).Info("proposal execution failed", "proposal", p.Id, "err", err)
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		p.ExecutorResult = group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+		k.dequeueExecution(ctx, *p)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventExec{
+		ProposalId: p.Id,
+		Result:     p.ExecutorResult,
+		Logs:       logs,
+	})
+}
+
+// dispatchMsgs executes msgs, all signed by the group policy account addr,
+// with the Msg service router.
+func (k Keeper) dispatchMsgs(ctx sdk.Context, addr sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if _, err := k.dispatchMsg(ctx, addr, msg); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// dispatchMsg executes msg, signed by the group policy account addr, with the
+// Msg service router, and emits its events.
+func (k Keeper) dispatchMsg(ctx sdk.Context, addr sdk.AccAddress, msg sdk.Msg) (*sdk.Result, error) {
+	if err := assertSignedBy(msg, addr); err != nil {
+		return nil, err
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return nil, sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	msgResp, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	events := msgResp.Events
+	sdkEvents := make([]sdk.Event, 0, len(events))
+	for _, event := range events {
+		sdkEvents = append(sdkEvents, sdk.Event(event))
+	}
+	ctx.EventManager().EmitEvents(sdkEvents)
+
+	return msgResp, nil
+}
+
+// assertSignedBy returns an error unless the group policy account addr is the
+// only signer of msg.
+func assertSignedBy(msg sdk.Msg, addr sdk.AccAddress) error {
+	for _, signer := range msg.GetSigners() {
+		if !signer.Equals(addr) {
+			return sdkerrors.Wrapf(group.ErrUnauthorized, "msg %s is signed by %s, not the group policy account %s", sdk.MsgTypeURL(msg), signer, addr)
+		}
+	}
+
+	return nil
+}
+
+// dequeueExecution removes the proposal p from the execution queue, if it was
+// accepted with an execution delay.
+func (k Keeper) dequeueExecution(ctx sdk.Context, p group.Proposal) {
+	ctx.KVStore(k.storeKey).Delete(proposalByExecutionTimeKey(p.ExecutionTime, p.Id))
+}
+
+// EndBlocker tallies the submitted proposals whose voting period ended, which
+// are then accepted or rejected.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(ProposalByVotingPeriodEndIndex, sdk.PrefixEndBytes(proposalByVotingPeriodEndPrefix(ctx.BlockTime())))
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		p, err := k.GetProposal(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if err != nil {
+			return err
+		}
+		if p.Status != group.PROPOSAL_STATUS_SUBMITTED {
+			continue
+		}
+
+		policyInfo, err := k.GetGroupPolicyInfo(ctx, mustAccAddressFromBech32(p.GroupPolicyAddress))
+		if err != nil {
+			return err
+		}
+		if err := k.tally(ctx, &p, policyInfo); err != nil {
+			return err
+		}
+		k.setProposal(ctx, p)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// BondedStakeWeightSourceName is the name the bonded stake weight source is
+// registered under in simapp.
+const BondedStakeWeightSourceName = "bonded_stake"
+
+var _ group.WeightSource = BondedStakeWeightSource{}
+
+// BondedStakeWeightSource weighs a group member by the tokens it delegated to
+// bonded validators.
+type BondedStakeWeightSource struct {
+	stakingKeeper group.StakingKeeper
+}
+
+// NewBondedStakeWeightSource returns a BondedStakeWeightSource reading the
+// delegations from sk.
+func NewBondedStakeWeightSource(sk group.StakingKeeper) BondedStakeWeightSource {
+	return BondedStakeWeightSource{stakingKeeper: sk}
+}
+
+// Weight implements group.WeightSource.
+func (s BondedStakeWeightSource) Weight(ctx sdk.Context, _ group.GroupInfo, member group.Member) (sdk.Dec, error) {
+	delegator, err := sdk.AccAddressFromBech32(member.Address)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	weight := sdk.ZeroDec()
+	s.stakingKeeper.IterateDelegations(ctx, delegator, func(_ int64, del stakingtypes.DelegationI) bool {
+		val := s.stakingKeeper.Validator(ctx, del.GetValidatorAddr())
+		if val != nil && val.IsBonded() {
+			weight = weight.Add(val.TokensFromShares(del.GetShares()))
+		}
+		return false
+	})
+
+	return weight, nil
+}
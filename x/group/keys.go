@@ -0,0 +1,12 @@
+package group
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "group"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for group
+	RouterKey = ModuleName
+)
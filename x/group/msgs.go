@@ -0,0 +1,606 @@
+package group
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateGroup{}
+	_ sdk.Msg = &MsgUpdateGroupMembers{}
+	_ sdk.Msg = &MsgUpdateGroupAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupMetadata{}
+	_ sdk.Msg = &MsgCreateGroupPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyAdmin{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ sdk.Msg = &MsgUpdateGroupPolicyMetadata{}
+	_ sdk.Msg = &MsgSubmitProposal{}
+	_ sdk.Msg = &MsgWithdrawProposal{}
+	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgExec{}
+	_ sdk.Msg = &MsgVetoProposal{}
+	_ sdk.Msg = &MsgLeaveGroup{}
+
+	// For amino support.
+	_ legacytx.LegacyMsg = &MsgCreateGroup{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupMembers{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupAdmin{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupMetadata{}
+	_ legacytx.LegacyMsg = &MsgCreateGroupPolicy{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupPolicyAdmin{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupPolicyDecisionPolicy{}
+	_ legacytx.LegacyMsg = &MsgUpdateGroupPolicyMetadata{}
+	_ legacytx.LegacyMsg = &MsgSubmitProposal{}
+	_ legacytx.LegacyMsg = &MsgWithdrawProposal{}
+	_ legacytx.LegacyMsg = &MsgVote{}
+	_ legacytx.LegacyMsg = &MsgExec{}
+	_ legacytx.LegacyMsg = &MsgVetoProposal{}
+	_ legacytx.LegacyMsg = &MsgLeaveGroup{}
+
+	_ codectypes.UnpackInterfacesMessage = MsgCreateGroupPolicy{}
+	_ codectypes.UnpackInterfacesMessage = MsgUpdateGroupPolicyDecisionPolicy{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitProposal{}
+)
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreateGroup) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreateGroup) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreateGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateGroup.
+func (m MsgCreateGroup) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCreateGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	return validateMembers(m.Members, false)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupMembers) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupMembers) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupMembers.
+func (m MsgUpdateGroupMembers) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupMembers) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if len(m.MemberUpdates) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "member updates")
+	}
+
+	return validateMembers(m.MemberUpdates, true)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupAdmin) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupAdmin) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupAdmin.
+func (m MsgUpdateGroupAdmin) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupAdmin) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	if admin.Equals(newAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+
+	return nil
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupMetadata) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupMetadata) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupMetadata.
+func (m MsgUpdateGroupMetadata) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupMetadata) ValidateBasic() error {
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	return nil
+}
+
+// NewMsgCreateGroupPolicy creates a new MsgCreateGroupPolicy.
+func NewMsgCreateGroupPolicy(admin sdk.AccAddress, group uint64, metadata string, decisionPolicy DecisionPolicy) (*MsgCreateGroupPolicy, error) {
+	m := &MsgCreateGroupPolicy{
+		Admin:    admin.String(),
+		GroupId:  group,
+		Metadata: metadata,
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetDecisionPolicy returns the decision policy of the message.
+func (m *MsgCreateGroupPolicy) GetDecisionPolicy() (DecisionPolicy, error) {
+	return unpackDecisionPolicy(m.DecisionPolicy)
+}
+
+// SetDecisionPolicy sets the decision policy of the message.
+func (m *MsgCreateGroupPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgCreateGroupPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgCreateGroupPolicy) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgCreateGroupPolicy) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgCreateGroupPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateGroupPolicy.
+func (m MsgCreateGroupPolicy) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgCreateGroupPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	policy, err := m.GetDecisionPolicy()
+	if err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	return policy.ValidateBasic()
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupPolicyAdmin) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupPolicyAdmin) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupPolicyAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupPolicyAdmin.
+func (m MsgUpdateGroupPolicyAdmin) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupPolicyAdmin) ValidateBasic() error {
+	admin, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "new admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+	if admin.Equals(newAdmin) {
+		return sdkerrors.Wrap(ErrInvalid, "new and old admin are the same")
+	}
+
+	return nil
+}
+
+// NewMsgUpdateGroupPolicyDecisionPolicy creates a new
+// MsgUpdateGroupPolicyDecisionPolicy.
+func NewMsgUpdateGroupPolicyDecisionPolicy(admin, address sdk.AccAddress, decisionPolicy DecisionPolicy) (*MsgUpdateGroupPolicyDecisionPolicy, error) {
+	m := &MsgUpdateGroupPolicyDecisionPolicy{
+		Admin:              admin.String(),
+		GroupPolicyAddress: address.String(),
+	}
+	err := m.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetDecisionPolicy returns the decision policy of the message.
+func (m *MsgUpdateGroupPolicyDecisionPolicy) GetDecisionPolicy() (DecisionPolicy, error) {
+	return unpackDecisionPolicy(m.DecisionPolicy)
+}
+
+// SetDecisionPolicy sets the decision policy of the message.
+func (m *MsgUpdateGroupPolicyDecisionPolicy) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	m.DecisionPolicy = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgUpdateGroupPolicyDecisionPolicy) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(m.DecisionPolicy, &decisionPolicy)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupPolicyDecisionPolicy) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupPolicyDecisionPolicy) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupPolicyDecisionPolicy.
+func (m MsgUpdateGroupPolicyDecisionPolicy) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupPolicyDecisionPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	policy, err := m.GetDecisionPolicy()
+	if err != nil {
+		return sdkerrors.Wrap(err, "decision policy")
+	}
+
+	return policy.ValidateBasic()
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgUpdateGroupPolicyMetadata) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgUpdateGroupPolicyMetadata) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgUpdateGroupPolicyMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupPolicyMetadata.
+func (m MsgUpdateGroupPolicyMetadata) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgUpdateGroupPolicyMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	return nil
+}
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+func NewMsgSubmitProposal(address string, proposers []string, msgs []sdk.Msg, metadata string, exec Exec) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		GroupPolicyAddress: address,
+		Proposers:          proposers,
+		Metadata:           metadata,
+		Exec:               exec,
+	}
+	err := m.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// GetMsgs unpacks the messages of the proposal.
+func (m MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(m.Messages)
+}
+
+// SetMsgs packs msgs into the messages of the proposal.
+func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	m.Messages = anys
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, m.Messages)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgSubmitProposal) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgSubmitProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSubmitProposal.
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+	if err := validateProposers(m.Proposers); err != nil {
+		return err
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	return validateMsgs(msgs)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgWithdrawProposal) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgWithdrawProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgWithdrawProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgWithdrawProposal.
+func (m MsgWithdrawProposal) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgWithdrawProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgVote) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgVote) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgVote.
+func (m MsgVote) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if m.Option == VOTE_OPTION_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "vote option")
+	}
+	if _, ok := VoteOption_name[int32(m.Option)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "vote option")
+	}
+
+	return nil
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgExec) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgExec) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgExec) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgExec.
+func (m MsgExec) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Executor); err != nil {
+		return sdkerrors.Wrap(err, "executor")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgVetoProposal) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgVetoProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgVetoProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgVetoProposal.
+func (m MsgVetoProposal) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgVetoProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
+// Route implements the LegacyMsg.Route method.
+func (m MsgLeaveGroup) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg.Type method.
+func (m MsgLeaveGroup) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (m MsgLeaveGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgLeaveGroup.
+func (m MsgLeaveGroup) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&m)
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgLeaveGroup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "group member")
+	}
+	if m.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group id")
+	}
+
+	return nil
+}
+
+// validateMembers checks the addresses and the weights of members, which must
+// be distinct. Zero weights, removing members, are only valid in updates.
+func validateMembers(members []Member, update bool) error {
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		if err := member.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "member")
+		}
+		if !update {
+			if _, err := parsePositiveDec(member.Weight); err != nil {
+				return sdkerrors.Wrap(err, "member weight")
+			}
+		}
+		if seen[member.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", member.Address)
+		}
+		seen[member.Address] = true
+	}
+
+	return nil
+}
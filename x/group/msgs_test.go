@@ -0,0 +1,149 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+var (
+	admin   = sdk.AccAddress("_______admin________")
+	member1 = sdk.AccAddress("_______member1______")
+	member2 = sdk.AccAddress("_______member2______")
+	policy  = sdk.AccAddress("_______policy_______")
+)
+
+func TestMsgCreateGroup(t *testing.T) {
+	tests := []struct {
+		title      string
+		admin      string
+		members    []group.Member
+		expectPass bool
+	}{
+		{"invalid admin", "invalid", nil, false},
+		{"no members", admin.String(), nil, true},
+		{"valid members", admin.String(), []group.Member{
+			{Address: member1.String(), Weight: "1"},
+			{Address: member2.String(), Weight: "2.5"},
+		}, true},
+		{"invalid member address", admin.String(), []group.Member{{Address: "invalid", Weight: "1"}}, false},
+		{"zero weight", admin.String(), []group.Member{{Address: member1.String(), Weight: "0"}}, false},
+		{"negative weight", admin.String(), []group.Member{{Address: member1.String(), Weight: "-1"}}, false},
+		{"duplicate members", admin.String(), []group.Member{
+			{Address: member1.String(), Weight: "1"},
+			{Address: member1.String(), Weight: "2"},
+		}, false},
+	}
+	for _, tc := range tests {
+		msg := &group.MsgCreateGroup{Admin: tc.admin, Members: tc.members}
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgUpdateGroupMembers(t *testing.T) {
+	// a zero weight removes a member in an update
+	msg := &group.MsgUpdateGroupMembers{
+		Admin:         admin.String(),
+		GroupId:       1,
+		MemberUpdates: []group.Member{{Address: member1.String(), Weight: "0"}},
+	}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.GroupId = 0
+	require.Error(t, msg.ValidateBasic())
+
+	msg.GroupId = 1
+	msg.MemberUpdates = nil
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateGroupPolicy(t *testing.T) {
+	tests := []struct {
+		title      string
+		groupID    uint64
+		policy     group.DecisionPolicy
+		expectPass bool
+	}{
+		{"valid threshold policy", 1, group.NewThresholdDecisionPolicy("1", time.Hour, 0), true},
+		{"valid percentage policy", 1, group.NewPercentageDecisionPolicy("0.5", time.Hour, time.Minute), true},
+		{"no group id", 0, group.NewThresholdDecisionPolicy("1", time.Hour, 0), false},
+		{"zero threshold", 1, group.NewThresholdDecisionPolicy("0", time.Hour, 0), false},
+		{"percentage above one", 1, group.NewPercentageDecisionPolicy("1.5", time.Hour, 0), false},
+		{"zero voting period", 1, group.NewThresholdDecisionPolicy("1", 0, 0), false},
+		{"negative min execution period", 1, group.NewThresholdDecisionPolicy("1", time.Hour, -time.Second), false},
+		{"negative execution delay", 1, &group.ThresholdDecisionPolicy{
+			Threshold: "1",
+			Windows:   group.DecisionPolicyWindows{VotingPeriod: time.Hour, ExecutionDelay: -time.Second},
+		}, false},
+	}
+	for _, tc := range tests {
+		msg, err := group.NewMsgCreateGroupPolicy(admin, tc.groupID, "", tc.policy)
+		require.NoError(t, err, tc.title)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgSubmitProposal(t *testing.T) {
+	send := &banktypes.MsgSend{
+		FromAddress: policy.String(),
+		ToAddress:   member1.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("steak", 10)),
+	}
+	tests := []struct {
+		title      string
+		proposers  []string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"no proposers", nil, nil, false},
+		{"no msgs", []string{member1.String()}, nil, true},
+		{"valid msgs", []string{member1.String(), member2.String()}, []sdk.Msg{send}, true},
+		{"duplicate proposers", []string{member1.String(), member1.String()}, nil, false},
+		{"invalid msg", []string{member1.String()}, []sdk.Msg{&banktypes.MsgSend{FromAddress: policy.String()}}, false},
+	}
+	for _, tc := range tests {
+		msg, err := group.NewMsgSubmitProposal(policy.String(), tc.proposers, tc.msgs, "", group.EXEC_UNSPECIFIED)
+		require.NoError(t, err, tc.title)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), tc.title)
+		} else {
+			require.Error(t, msg.ValidateBasic(), tc.title)
+		}
+	}
+}
+
+func TestMsgVetoProposal(t *testing.T) {
+	msg := &group.MsgVetoProposal{ProposalId: 1, Admin: admin.String()}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.ProposalId = 0
+	require.Error(t, msg.ValidateBasic())
+
+	msg.ProposalId, msg.Admin = 1, "invalid"
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgVote(t *testing.T) {
+	msg := &group.MsgVote{ProposalId: 1, Voter: member1.String(), Option: group.VOTE_OPTION_YES}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Option = group.VOTE_OPTION_UNSPECIFIED
+	require.Error(t, msg.ValidateBasic())
+
+	msg.Option = group.VOTE_OPTION_NO
+	msg.ProposalId = 0
+	require.Error(t, msg.ValidateBasic())
+}
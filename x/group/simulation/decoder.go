@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding group type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch prefix := kvA.Key[:1]; {
+		case bytes.Equal(prefix, keeper.GroupSeqKey),
+			bytes.Equal(prefix, keeper.GroupPolicySeqKey),
+			bytes.Equal(prefix, keeper.ProposalSeqKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(prefix, keeper.GroupKeyPrefix):
+			var groupA, groupB group.GroupInfo
+			cdc.MustUnmarshal(kvA.Value, &groupA)
+			cdc.MustUnmarshal(kvB.Value, &groupB)
+			return fmt.Sprintf("%v\n%v", groupA, groupB)
+
+		case bytes.Equal(prefix, keeper.GroupMemberKeyPrefix):
+			var memberA, memberB group.GroupMember
+			cdc.MustUnmarshal(kvA.Value, &memberA)
+			cdc.MustUnmarshal(kvB.Value, &memberB)
+			return fmt.Sprintf("%v\n%v", memberA, memberB)
+
+		case bytes.Equal(prefix, keeper.GroupPolicyKeyPrefix):
+			var policyA, policyB group.GroupPolicyInfo
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
+
+		case bytes.Equal(prefix, keeper.ProposalKeyPrefix):
+			var proposalA, proposalB group.Proposal
+			cdc.MustUnmarshal(kvA.Value, &proposalA)
+			cdc.MustUnmarshal(kvB.Value, &proposalB)
+			return fmt.Sprintf("%v\n%v", proposalA, proposalB)
+
+		case bytes.Equal(prefix, keeper.VoteKeyPrefix):
+			var voteA, voteB group.Vote
+			cdc.MustUnmarshal(kvA.Value, &voteA)
+			cdc.MustUnmarshal(kvB.Value, &voteB)
+			return fmt.Sprintf("%v\n%v", voteA, voteB)
+
+		case bytes.Equal(prefix, keeper.GroupByAdminIndexPrefix),
+			bytes.Equal(prefix, keeper.GroupMemberByMemberIndex),
+			bytes.Equal(prefix, keeper.GroupPolicyByGroupIndexPrefix),
+			bytes.Equal(prefix, keeper.GroupPolicyByAdminIndexPrefix),
+			bytes.Equal(prefix, keeper.ProposalByGroupPolicyIndex),
+			bytes.Equal(prefix, keeper.ProposalByVotingPeriodEndIndex),
+			bytes.Equal(prefix, keeper.ProposalByExecutionTimeIndex),
+			bytes.Equal(prefix, keeper.VoteByVoterIndexPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		default:
+			panic(fmt.Sprintf("invalid group key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/group/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	g := group.GroupInfo{Id: 1, Admin: sdk.AccAddress("admin").String(), TotalWeight: "1", Version: 1}
+	groupBz, err := cdc.Marshal(&g)
+	require.NoError(t, err)
+	vote := group.Vote{ProposalId: 1, Voter: sdk.AccAddress("voter").String(), Option: group.VOTE_OPTION_YES}
+	voteBz, err := cdc.Marshal(&vote)
+	require.NoError(t, err)
+	indexKey := append(append([]byte{}, keeper.GroupByAdminIndexPrefix...), 0x01)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.GroupSeqKey, Value: sdk.Uint64ToBigEndian(3)},
+			{Key: append(append([]byte{}, keeper.GroupKeyPrefix...), sdk.Uint64ToBigEndian(1)...), Value: groupBz},
+			{Key: append(append([]byte{}, keeper.VoteKeyPrefix...), sdk.Uint64ToBigEndian(1)...), Value: voteBz},
+			{Key: indexKey, Value: []byte{}},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"GroupSeq", false, "3\n3"},
+		{"Group", false, fmt.Sprintf("%v\n%v", g, g)},
+		{"Vote", false, fmt.Sprintf("%v\n%v", vote, vote)},
+		{"GroupByAdminIndex", false, fmt.Sprintf("%X\n%X", indexKey, indexKey)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package simulation
+
+import (
+	"math/rand"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+// genGroups returns groups administered by the first accounts, whose members
+// are random accounts of random weights.
+func genGroups(r *rand.Rand, accounts []simtypes.Account) ([]*group.GroupInfo, []*group.GroupMember) {
+	var (
+		groups  []*group.GroupInfo
+		members []*group.GroupMember
+	)
+
+	numGroups := r.Intn(len(accounts)/2 + 1)
+	for i := 0; i < numGroups; i++ {
+		id := uint64(i + 1)
+		total := 0
+		for _, j := range r.Perm(len(accounts))[:r.Intn(3)+1] {
+			weight := r.Intn(10) + 1
+			total += weight
+			members = append(members, &group.GroupMember{
+				GroupId: id,
+				Member: &group.Member{
+					Address: accounts[j].Address.String(),
+					Weight:  strconv.Itoa(weight),
+				},
+			})
+		}
+
+		groups = append(groups, &group.GroupInfo{
+			Id:          id,
+			Admin:       accounts[i].Address.String(),
+			Version:     1,
+			TotalWeight: strconv.Itoa(total),
+		})
+	}
+
+	return groups, members
+}
+
+// RandomizedGenState generates a random GenesisState for group.
+func RandomizedGenState(simState *module.SimulationState) {
+	groups, members := genGroups(simState.Rand, simState.Accounts)
+
+	groupGenesis := group.NewGenesisState()
+	groupGenesis.GroupSeq = uint64(len(groups))
+	groupGenesis.Groups = groups
+	groupGenesis.GroupMembers = members
+
+	simState.GenState[group.ModuleName] = simState.Cdc.MustMarshalJSON(groupGenesis)
+}
@@ -0,0 +1,41 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/simulation"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	app := simapp.Setup(false)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          app.AppCodec(),
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 6),
+		InitialStake: 1000,
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var groupGenesis group.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[group.ModuleName], &groupGenesis)
+
+	require.NotEmpty(t, groupGenesis.Groups)
+	require.LessOrEqual(t, len(groupGenesis.Groups), len(simState.Accounts)/2)
+	require.Equal(t, uint64(len(groupGenesis.Groups)), groupGenesis.GroupSeq)
+	require.NoError(t, groupGenesis.Validate())
+}
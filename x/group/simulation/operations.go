@@ -0,0 +1,391 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// group message types
+var (
+	TypeMsgCreateGroup       = sdk.MsgTypeURL(&group.MsgCreateGroup{})
+	TypeMsgCreateGroupPolicy = sdk.MsgTypeURL(&group.MsgCreateGroupPolicy{})
+	TypeMsgSubmitProposal    = sdk.MsgTypeURL(&group.MsgSubmitProposal{})
+	TypeMsgVote              = sdk.MsgTypeURL(&group.MsgVote{})
+	TypeMsgExec              = sdk.MsgTypeURL(&group.MsgExec{})
+	TypeMsgVetoProposal      = sdk.MsgTypeURL(&group.MsgVetoProposal{})
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateGroup       = "op_weight_msg_create_group"
+	OpWeightMsgCreateGroupPolicy = "op_weight_msg_create_group_policy"
+	OpWeightMsgSubmitProposal    = "op_weight_msg_submit_proposal"
+	OpWeightMsgVote              = "op_weight_msg_vote"
+	OpWeightMsgExec              = "op_weight_msg_exec"
+	OpWeightMsgVetoProposal      = "op_weight_msg_veto_proposal"
+)
+
+// group operations weights
+const (
+	WeightMsgCreateGroup       = 100
+	WeightMsgCreateGroupPolicy = 50
+	WeightMsgSubmitProposal    = 90
+	WeightMsgVote              = 90
+	WeightMsgExec              = 90
+	WeightMsgVetoProposal      = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateGroup       int
+		weightMsgCreateGroupPolicy int
+		weightMsgSubmitProposal    int
+		weightMsgVote              int
+		weightMsgExec              int
+		weightMsgVetoProposal      int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroup, &weightMsgCreateGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroup = WeightMsgCreateGroup
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateGroupPolicy, &weightMsgCreateGroupPolicy, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateGroupPolicy = WeightMsgCreateGroupPolicy
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitProposal, &weightMsgSubmitProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitProposal = WeightMsgSubmitProposal
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgVote, &weightMsgVote, nil,
+		func(_ *rand.Rand) {
+			weightMsgVote = WeightMsgVote
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgExec, &weightMsgExec, nil,
+		func(_ *rand.Rand) {
+			weightMsgExec = WeightMsgExec
+		},
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgVetoProposal, &weightMsgVetoProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgVetoProposal = WeightMsgVetoProposal
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateGroup, SimulateMsgCreateGroup(ak, bk)),
+		simulation.NewWeightedOperation(weightMsgCreateGroupPolicy, SimulateMsgCreateGroupPolicy(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSubmitProposal, SimulateMsgSubmitProposal(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgVote, SimulateMsgVote(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgExec, SimulateMsgExec(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgVetoProposal, SimulateMsgVetoProposal(ak, bk, k)),
+	}
+}
+
+// SimulateMsgCreateGroup generates a MsgCreateGroup of random members.
+func SimulateMsgCreateGroup(ak group.AccountKeeper, bk group.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+
+		var members []group.Member
+		for _, i := range r.Perm(len(accs))[:r.Intn(3)+1] {
+			members = append(members, group.Member{
+				Address:  accs[i].Address.String(),
+				Weight:   fmt.Sprintf("%d", r.Intn(10)+1),
+				Metadata: simtypes.RandStringOfLength(r, 10),
+			})
+		}
+
+		msg := &group.MsgCreateGroup{
+			Admin:    admin.Address.String(),
+			Members:  members,
+			Metadata: simtypes.RandStringOfLength(r, 10),
+		}
+		return deliver(r, app, ctx, ak, bk, admin, msg, chainID)
+	}
+}
+
+// SimulateMsgCreateGroupPolicy generates a MsgCreateGroupPolicy of a random
+// threshold decision policy for a group of a random admin.
+func SimulateMsgCreateGroupPolicy(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		admin, _ := simtypes.RandomAcc(r, accs)
+		res, err := k.GroupsByAdmin(sdk.WrapSDKContext(ctx), &group.QueryGroupsByAdminRequest{Admin: admin.Address.String()})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, err.Error()), nil, err
+		}
+		if len(res.Groups) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, "admin has no groups"), nil, nil
+		}
+		g := res.Groups[r.Intn(len(res.Groups))]
+
+		policy := group.NewThresholdDecisionPolicy(
+			fmt.Sprintf("%d", r.Intn(10)+1),
+			time.Duration(simtypes.RandIntBetween(r, 60, 3600))*time.Second,
+			time.Duration(r.Intn(120))*time.Second,
+		).(*group.ThresholdDecisionPolicy)
+		if r.Intn(2) == 0 {
+			policy.Windows.ExecutionDelay = time.Duration(r.Intn(3600)) * time.Second
+		}
+		msg, err := group.NewMsgCreateGroupPolicy(admin.Address, g.Id, simtypes.RandStringOfLength(r, 10), policy)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgCreateGroupPolicy, err.Error()), nil, err
+		}
+		return deliver(r, app, ctx, ak, bk, admin, msg, chainID)
+	}
+}
+
+// SimulateMsgSubmitProposal generates a MsgSubmitProposal, without messages,
+// of a random member to a group policy of its group.
+func SimulateMsgSubmitProposal(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposer, policy, err := randomGroupPolicy(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+		if policy == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitProposal, "no account is in a group with a group policy"), nil, nil
+		}
+
+		exec := group.EXEC_UNSPECIFIED
+		if r.Intn(2) == 0 {
+			exec = group.EXEC_TRY
+		}
+		msg, err := group.NewMsgSubmitProposal(policy.Address, []string{proposer.Address.String()}, nil, simtypes.RandStringOfLength(r, 10), exec)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgSubmitProposal, err.Error()), nil, err
+		}
+		return deliver(r, app, ctx, ak, bk, proposer, msg, chainID)
+	}
+}
+
+// SimulateMsgVote generates a MsgVote of a random member on a proposal it did
+// not vote on yet.
+func SimulateMsgVote(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		voter, proposal, err := randomProposal(r, ctx, k, accs, func(member sdk.AccAddress, _ *group.GroupPolicyInfo, p *group.Proposal) bool {
+			if p.Status != group.PROPOSAL_STATUS_SUBMITTED || !ctx.BlockTime().Before(p.VotingPeriodEnd) {
+				return false
+			}
+			_, err := k.GetVote(ctx, p.Id, member)
+			return err != nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVote, err.Error()), nil, err
+		}
+		if proposal == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVote, "no proposal to vote on"), nil, nil
+		}
+
+		msg := &group.MsgVote{
+			ProposalId: proposal.Id,
+			Voter:      voter.Address.String(),
+			Option:     group.VoteOption(simtypes.RandIntBetween(r, 1, 5)),
+			Metadata:   simtypes.RandStringOfLength(r, 10),
+		}
+		if r.Intn(2) == 0 {
+			msg.Exec = group.EXEC_TRY
+		}
+		return deliver(r, app, ctx, ak, bk, voter, msg, chainID)
+	}
+}
+
+// SimulateMsgExec generates a MsgExec of a random member of a group on an
+// accepted proposal of the group, whose execution period is open.
+func SimulateMsgExec(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		executor, proposal, err := randomProposal(r, ctx, k, accs, func(_ sdk.AccAddress, policy *group.GroupPolicyInfo, p *group.Proposal) bool {
+			if p.Status != group.PROPOSAL_STATUS_ACCEPTED || p.ExecutorResult == group.PROPOSAL_EXECUTOR_RESULT_SUCCESS ||
+				ctx.BlockTime().After(p.VotingPeriodEnd.Add(k.GetConfig().MaxExecutionPeriod)) {
+				return false
+			}
+			decisionPolicy, err := policy.GetDecisionPolicy()
+			return err == nil && !ctx.BlockTime().Before(p.SubmitTime.Add(decisionPolicy.GetMinExecutionPeriod())) &&
+				!ctx.BlockTime().Before(p.ExecutionTime)
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgExec, err.Error()), nil, err
+		}
+		if proposal == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgExec, "no proposal to execute"), nil, nil
+		}
+
+		msg := &group.MsgExec{
+			ProposalId: proposal.Id,
+			Executor:   executor.Address.String(),
+		}
+		return deliver(r, app, ctx, ak, bk, executor, msg, chainID)
+	}
+}
+
+// SimulateMsgVetoProposal generates a MsgVetoProposal of a group policy admin
+// on a random proposal of the execution queue.
+func SimulateMsgVetoProposal(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		goCtx := sdk.WrapSDKContext(ctx)
+		res, err := k.ExecutionQueue(goCtx, &group.QueryExecutionQueueRequest{})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVetoProposal, err.Error()), nil, err
+		}
+		if len(res.Proposals) == 0 {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVetoProposal, "no proposal within its execution delay"), nil, nil
+		}
+		p := res.Proposals[r.Intn(len(res.Proposals))]
+		if !ctx.BlockTime().Before(p.ExecutionTime) {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVetoProposal, "execution delay ended"), nil, nil
+		}
+
+		policy, err := k.GroupPolicyInfo(goCtx, &group.QueryGroupPolicyInfoRequest{Address: p.GroupPolicyAddress})
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVetoProposal, err.Error()), nil, err
+		}
+		adminAddr, err := sdk.AccAddressFromBech32(policy.Info.Admin)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVetoProposal, err.Error()), nil, err
+		}
+		admin, found := simtypes.FindAccount(accs, adminAddr)
+		if !found {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgVetoProposal, "group policy admin not found"), nil, nil
+		}
+
+		msg := &group.MsgVetoProposal{
+			ProposalId: p.Id,
+			Admin:      admin.Address.String(),
+		}
+		return deliver(r, app, ctx, ak, bk, admin, msg, chainID)
+	}
+}
+
+// randomGroupPolicy returns a random account of accs together with a random
+// group policy of a group the account is a member of. The account is
+// zero-valued and the policy nil if no account is a member of such a group.
+func randomGroupPolicy(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, *group.GroupPolicyInfo, error) {
+	goCtx := sdk.WrapSDKContext(ctx)
+	for _, i := range r.Perm(len(accs)) {
+		groups, err := k.GroupsByMember(goCtx, &group.QueryGroupsByMemberRequest{Address: accs[i].Address.String()})
+		if err != nil {
+			return simtypes.Account{}, nil, err
+		}
+
+		var policies []*group.GroupPolicyInfo
+		for _, g := range groups.Groups {
+			res, err := k.GroupPoliciesByGroup(goCtx, &group.QueryGroupPoliciesByGroupRequest{GroupId: g.Id})
+			if err != nil {
+				return simtypes.Account{}, nil, err
+			}
+			policies = append(policies, res.GroupPolicies...)
+		}
+		if len(policies) > 0 {
+			return accs[i], policies[r.Intn(len(policies))], nil
+		}
+	}
+
+	return simtypes.Account{}, nil, nil
+}
+
+// randomProposal returns a random account of accs together with a random
+// proposal matching filter of a group policy of a group the account is a
+// member of. The account is zero-valued and the proposal nil if there is none.
+func randomProposal(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+	filter func(member sdk.AccAddress, policy *group.GroupPolicyInfo, p *group.Proposal) bool,
+) (simtypes.Account, *group.Proposal, error) {
+	goCtx := sdk.WrapSDKContext(ctx)
+	for _, i := range r.Perm(len(accs)) {
+		member := accs[i].Address
+		groups, err := k.GroupsByMember(goCtx, &group.QueryGroupsByMemberRequest{Address: member.String()})
+		if err != nil {
+			return simtypes.Account{}, nil, err
+		}
+
+		var proposals []*group.Proposal
+		for _, g := range groups.Groups {
+			policies, err := k.GroupPoliciesByGroup(goCtx, &group.QueryGroupPoliciesByGroupRequest{GroupId: g.Id})
+			if err != nil {
+				return simtypes.Account{}, nil, err
+			}
+			for _, policy := range policies.GroupPolicies {
+				res, err := k.ProposalsByGroupPolicy(goCtx, &group.QueryProposalsByGroupPolicyRequest{Address: policy.Address})
+				if err != nil {
+					return simtypes.Account{}, nil, err
+				}
+				for _, p := range res.Proposals {
+					if filter(member, policy, p) {
+						proposals = append(proposals, p)
+					}
+				}
+			}
+		}
+		if len(proposals) > 0 {
+			return accs[i], proposals[r.Intn(len(proposals))], nil
+		}
+	}
+
+	return simtypes.Account{}, nil, nil
+}
+
+// deliver delivers a tx of msg signed by signer, paying random fees.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak group.AccountKeeper, bk group.BankKeeper,
+	signer simtypes.Account, msg sdk.Msg, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	account := ak.GetAccount(ctx, signer.Address)
+	spendableCoins := bk.SpendableCoins(ctx, signer.Address)
+	fees, err := simtypes.RandomFees(r, ctx, spendableCoins.FilterDenoms([]string{sdk.DefaultBondDenom}))
+	if err != nil {
+		return simtypes.NoOpMsg(group.ModuleName, msgType, "fee error"), nil, err
+	}
+
+	txCfg := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := helpers.GenTx(
+		txCfg,
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		signer.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(group.ModuleName, msgType, "unable to generate mock tx"), nil, err
+	}
+
+	_, _, err = app.Deliver(txCfg.TxEncoder(), tx)
+	if err != nil {
+		return simtypes.NoOpMsg(group.ModuleName, msgType, "unable to deliver tx"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+}
@@ -0,0 +1,643 @@
+package group
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DecisionPolicyResult is the result of whether a proposal passes or not a
+// decision policy.
+type DecisionPolicyResult struct {
+	// Allow determines if the proposal is allowed to pass.
+	Allow bool
+	// Final determines if the tally result is final or not. If final, the
+	// votes still to come cannot change the result.
+	Final bool
+}
+
+// DecisionPolicy decides, given the votes of the members of a group, whether
+// the proposals submitted to a group policy are accepted.
+type DecisionPolicy interface {
+	codec.ProtoMarshaler
+
+	// GetVotingPeriod returns the duration after proposal submission where
+	// votes are accepted.
+	GetVotingPeriod() time.Duration
+	// GetMinExecutionPeriod returns the minimum duration after submission a
+	// proposal must wait to be executed.
+	GetMinExecutionPeriod() time.Duration
+	// GetExecutionDelay returns the duration after acceptance a proposal must
+	// wait to be executed, during which the group policy admin can veto it.
+	GetExecutionDelay() time.Duration
+	// GetWeightSource returns the name of the weight source the voting weights
+	// of the members are derived from, empty for the weights set in the group.
+	GetWeightSource() string
+	// Allow defines policy-specific logic to allow a proposal to pass or not,
+	// based on its tally result and the total weight of the group.
+	Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error)
+
+	ValidateBasic() error
+	// Validate validates the policy against the group it is created for, and
+	// the module configuration.
+	Validate(g GroupInfo, config Config) error
+}
+
+var (
+	_ DecisionPolicy = &ThresholdDecisionPolicy{}
+	_ DecisionPolicy = &PercentageDecisionPolicy{}
+)
+
+// NewThresholdDecisionPolicy creates a threshold DecisionPolicy.
+func NewThresholdDecisionPolicy(threshold string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &ThresholdDecisionPolicy{
+		Threshold: threshold,
+		Windows:   DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod},
+	}
+}
+
+// GetVotingPeriod returns the voting period of ThresholdDecisionPolicy.
+func (p ThresholdDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of
+// ThresholdDecisionPolicy.
+func (p ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// GetExecutionDelay returns the execution delay of ThresholdDecisionPolicy.
+func (p ThresholdDecisionPolicy) GetExecutionDelay() time.Duration {
+	return p.Windows.ExecutionDelay
+}
+
+// ValidateBasic does basic validation on ThresholdDecisionPolicy.
+func (p ThresholdDecisionPolicy) ValidateBasic() error {
+	if _, err := parsePositiveDec(p.Threshold); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+
+	return p.Windows.validateBasic()
+}
+
+// Validate validates the policy against the group.
+func (p ThresholdDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return p.Windows.validate(config)
+}
+
+// Allow allows a proposal to pass when its yes votes reach the threshold, or
+// the whole weight of the group when the latter is less than the threshold.
+// It rejects it when the votes still to come are not enough to reach it.
+func (p ThresholdDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	threshold, err := parsePositiveDec(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "threshold")
+	}
+	total, err := parseNonNegativeDec(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "group total weight")
+	}
+	yes, err := parseNonNegativeDec(tallyResult.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+
+	// the threshold of a group whose total weight is less than it is the
+	// total weight, for the proposals of the group to be able to pass
+	threshold = sdk.MinDec(threshold, total)
+	if yes.GTE(threshold) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided, err := tallyResult.undecided(total)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yes.Add(undecided).LT(threshold) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// NewPercentageDecisionPolicy creates a new percentage DecisionPolicy.
+func NewPercentageDecisionPolicy(percentage string, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{
+		Percentage: percentage,
+		Windows:    DecisionPolicyWindows{VotingPeriod: votingPeriod, MinExecutionPeriod: minExecutionPeriod},
+	}
+}
+
+// GetVotingPeriod returns the voting period of PercentageDecisionPolicy.
+func (p PercentageDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of
+// PercentageDecisionPolicy.
+func (p PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// GetExecutionDelay returns the execution delay of PercentageDecisionPolicy.
+func (p PercentageDecisionPolicy) GetExecutionDelay() time.Duration {
+	return p.Windows.ExecutionDelay
+}
+
+// ValidateBasic does basic validation on PercentageDecisionPolicy.
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	percentage, err := parsePositiveDec(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+	if percentage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrap(ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+
+	return p.Windows.validateBasic()
+}
+
+// Validate validates the policy against the group.
+func (p PercentageDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return p.Windows.validate(config)
+}
+
+// Allow allows a proposal to pass when the percentage of the total weight of
+// the group which voted yes reaches the percentage of the policy. It rejects
+// it when the votes still to come are not enough to reach it.
+func (p PercentageDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	percentage, err := parsePositiveDec(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "percentage")
+	}
+	total, err := parseNonNegativeDec(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "group total weight")
+	}
+	yes, err := parseNonNegativeDec(tallyResult.YesCount)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "yes count")
+	}
+
+	// a group without members cannot accept any proposal
+	if total.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	if yes.Quo(total).GTE(percentage) {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+
+	undecided, err := tallyResult.undecided(total)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yes.Add(undecided).Quo(total).LT(percentage) {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+func (w DecisionPolicyWindows) validateBasic() error {
+	if w.VotingPeriod <= 0 {
+		return sdkerrors.Wrap(ErrInvalid, "voting period must be positive")
+	}
+	if w.MinExecutionPeriod < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "min execution period cannot be negative")
+	}
+	if w.ExecutionDelay < 0 {
+		return sdkerrors.Wrap(ErrInvalid, "execution delay cannot be negative")
+	}
+
+	return nil
+}
+
+// validate checks that the proposals can be executed before their execution
+// period ends.
+func (w DecisionPolicyWindows) validate(config Config) error {
+	if w.MinExecutionPeriod > w.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrapf(ErrInvalid, "min execution period must be at most the voting period plus the max execution period %s", config.MaxExecutionPeriod)
+	}
+	if w.ExecutionDelay > config.MaxExecutionPeriod {
+		return sdkerrors.Wrapf(ErrInvalid, "execution delay must be at most the max execution period %s", config.MaxExecutionPeriod)
+	}
+
+	return nil
+}
+
+// DefaultTallyResult returns a TallyResult with all counts set to 0.
+func DefaultTallyResult() TallyResult {
+	return TallyResult{
+		YesCount:        "0",
+		AbstainCount:    "0",
+		NoCount:         "0",
+		NoWithVetoCount: "0",
+	}
+}
+
+// Add adds the weight of a vote with the given option to the tally result.
+func (t *TallyResult) Add(option VoteOption, weight string) error {
+	w, err := parseNonNegativeDec(weight)
+	if err != nil {
+		return sdkerrors.Wrap(err, "vote weight")
+	}
+
+	var count *string
+	switch option {
+	case VOTE_OPTION_YES:
+		count = &t.YesCount
+	case VOTE_OPTION_ABSTAIN:
+		count = &t.AbstainCount
+	case VOTE_OPTION_NO:
+		count = &t.NoCount
+	case VOTE_OPTION_NO_WITH_VETO:
+		count = &t.NoWithVetoCount
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown vote option %s", option)
+	}
+
+	c, err := parseNonNegativeDec(*count)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "%s count", option)
+	}
+	*count = c.Add(w).String()
+
+	return nil
+}
+
+// TotalCounts is the sum of all the weights of the votes of the tally result.
+func (t TallyResult) TotalCounts() (sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	for _, count := range []string{t.YesCount, t.AbstainCount, t.NoCount, t.NoWithVetoCount} {
+		c, err := parseNonNegativeDec(count)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		total = total.Add(c)
+	}
+
+	return total, nil
+}
+
+// undecided returns the weight of the members of a group of total weight
+// total which did not vote yet.
+func (t TallyResult) undecided(total sdk.Dec) (sdk.Dec, error) {
+	counts, err := t.TotalCounts()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// the weights of the members who voted may exceed the total weight only if
+	// the group changed since, in which case the proposal is aborted anyway
+	if counts.GT(total) {
+		return sdk.ZeroDec(), nil
+	}
+
+	return total.Sub(counts), nil
+}
+
+// ValidateBasic performs stateless validation of the member.
+func (m Member) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return sdkerrors.Wrap(err, "address")
+	}
+	if _, err := parseNonNegativeDec(m.Weight); err != nil {
+		return sdkerrors.Wrap(err, "weight")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the group info.
+func (g GroupInfo) ValidateBasic() error {
+	if g.Id == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group's GroupId")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+	if _, err := parseNonNegativeDec(g.TotalWeight); err != nil {
+		return sdkerrors.Wrap(err, "total weight")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "version")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless validation of the group member.
+func (gm GroupMember) ValidateBasic() error {
+	if gm.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group member's group id")
+	}
+	if gm.Member == nil {
+		return sdkerrors.Wrap(ErrEmpty, "group member")
+	}
+	if err := gm.Member.ValidateBasic(); err != nil {
+		return err
+	}
+	if _, err := parsePositiveDec(gm.Member.Weight); err != nil {
+		return sdkerrors.Wrap(err, "group member's weight")
+	}
+
+	return nil
+}
+
+var _ codectypes.UnpackInterfacesMessage = GroupPolicyInfo{}
+
+// NewGroupPolicyInfo creates a new GroupPolicyInfo instance.
+func NewGroupPolicyInfo(address sdk.AccAddress, group uint64, admin sdk.AccAddress, metadata string,
+	version uint64, decisionPolicy DecisionPolicy, createdAt time.Time) (GroupPolicyInfo, error) {
+	p := GroupPolicyInfo{
+		Address:   address.String(),
+		GroupId:   group,
+		Admin:     admin.String(),
+		Metadata:  metadata,
+		Version:   version,
+		CreatedAt: createdAt,
+	}
+
+	err := p.SetDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return GroupPolicyInfo{}, err
+	}
+
+	return p, nil
+}
+
+// SetDecisionPolicy sets the decision policy of the group policy.
+func (g *GroupPolicyInfo) SetDecisionPolicy(decisionPolicy DecisionPolicy) error {
+	any, err := packDecisionPolicy(decisionPolicy)
+	if err != nil {
+		return err
+	}
+	g.DecisionPolicy = any
+	return nil
+}
+
+// GetDecisionPolicy returns the decision policy of the group policy.
+func (g GroupPolicyInfo) GetDecisionPolicy() (DecisionPolicy, error) {
+	return unpackDecisionPolicy(g.DecisionPolicy)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g GroupPolicyInfo) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var decisionPolicy DecisionPolicy
+	return unpacker.UnpackAny(g.DecisionPolicy, &decisionPolicy)
+}
+
+// ValidateBasic performs stateless validation of the group policy info.
+func (g GroupPolicyInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Admin); err != nil {
+		return sdkerrors.Wrap(err, "group policy admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(g.Address); err != nil {
+		return sdkerrors.Wrap(err, "group policy account address")
+	}
+	if g.GroupId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group policy's group id")
+	}
+	if g.Version == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "group policy version")
+	}
+
+	policy, err := g.GetDecisionPolicy()
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy decision policy")
+	}
+
+	return policy.ValidateBasic()
+}
+
+var _ codectypes.UnpackInterfacesMessage = Proposal{}
+
+// GetMsgs unpacks the messages of the proposal.
+func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
+	return unpackMsgs(p.Messages)
+}
+
+// SetMsgs packs msgs into the messages of the proposal.
+func (p *Proposal) SetMsgs(msgs []sdk.Msg) error {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return err
+	}
+	p.Messages = anys
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpackMsgAnys(unpacker, p.Messages)
+}
+
+// ValidateBasic performs stateless validation of the proposal.
+func (p Proposal) ValidateBasic() error {
+	if p.Id == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal id")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.GroupPolicyAddress); err != nil {
+		return sdkerrors.Wrap(err, "proposal group policy address")
+	}
+	if p.GroupVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group version")
+	}
+	if p.GroupPolicyVersion == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposal group policy version")
+	}
+	if err := validateProposers(p.Proposers); err != nil {
+		return err
+	}
+	if p.Status == PROPOSAL_STATUS_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "proposal status")
+	}
+	if p.ExecutorResult == PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "proposal executor result")
+	}
+	if err := validateMemberWeights(p.TotalWeight, p.MemberWeights); err != nil {
+		return err
+	}
+
+	msgs, err := p.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	return validateMsgs(msgs)
+}
+
+// ValidateBasic performs stateless validation of the vote.
+func (v Vote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return sdkerrors.Wrap(err, "voter")
+	}
+	if v.ProposalId == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "voter ProposalId")
+	}
+	if v.Option == VOTE_OPTION_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrEmpty, "voter vote option")
+	}
+	if _, ok := VoteOption_name[int32(v.Option)]; !ok {
+		return sdkerrors.Wrap(ErrInvalid, "vote option")
+	}
+
+	return nil
+}
+
+func packDecisionPolicy(decisionPolicy DecisionPolicy) (*codectypes.Any, error) {
+	msg, ok := decisionPolicy.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrPackAny, "can't proto marshal %T", decisionPolicy)
+	}
+
+	return codectypes.NewAnyWithValue(msg)
+}
+
+func unpackDecisionPolicy(any *codectypes.Any) (DecisionPolicy, error) {
+	if any == nil {
+		return nil, sdkerrors.Wrap(ErrEmpty, "decision policy")
+	}
+
+	decisionPolicy, ok := any.GetCachedValue().(DecisionPolicy)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", (DecisionPolicy)(nil), any.GetCachedValue())
+	}
+
+	return decisionPolicy, nil
+}
+
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+
+	return anys, nil
+}
+
+func unpackMsgs(anys []*codectypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anys))
+	for i, any := range anys {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "messages contains %T which is not a sdk.Msg", any.GetCachedValue())
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+func unpackMsgAnys(unpacker codectypes.AnyUnpacker, anys []*codectypes.Any) error {
+	for _, any := range anys {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMsgs(msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d", i)
+		}
+	}
+
+	return nil
+}
+
+func validateProposers(proposers []string) error {
+	if len(proposers) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "proposers")
+	}
+
+	seen := make(map[string]bool, len(proposers))
+	for _, proposer := range proposers {
+		if _, err := sdk.AccAddressFromBech32(proposer); err != nil {
+			return sdkerrors.Wrapf(err, "proposer %s", proposer)
+		}
+		if seen[proposer] {
+			return sdkerrors.Wrapf(ErrDuplicate, "address: %s", proposer)
+		}
+		seen[proposer] = true
+	}
+
+	return nil
+}
+
+// validateMemberWeights checks the weights snapshotted on a proposal, which
+// are either all empty, or a total weight and distinct member weights.
+func validateMemberWeights(totalWeight string, weights []MemberWeight) error {
+	if totalWeight == "" {
+		if len(weights) > 0 {
+			return sdkerrors.Wrap(ErrEmpty, "proposal total weight")
+		}
+		return nil
+	}
+	if _, err := parseNonNegativeDec(totalWeight); err != nil {
+		return sdkerrors.Wrap(err, "proposal total weight")
+	}
+
+	seen := make(map[string]bool, len(weights))
+	for _, w := range weights {
+		if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+			return sdkerrors.Wrap(err, "member weight address")
+		}
+		if _, err := parseNonNegativeDec(w.Weight); err != nil {
+			return sdkerrors.Wrapf(err, "weight of member %s", w.Address)
+		}
+		if seen[w.Address] {
+			return sdkerrors.Wrapf(ErrDuplicate, "weight of member %s", w.Address)
+		}
+		seen[w.Address] = true
+	}
+
+	return nil
+}
+
+// parseNonNegativeDec parses a decimal weight, which must not be negative.
+func parseNonNegativeDec(s string) (sdk.Dec, error) {
+	d, err := sdk.NewDecFromStr(s)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+	if d.IsNegative() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "expected a non-negative decimal, got %s", s)
+	}
+
+	return d, nil
+}
+
+// parsePositiveDec parses a decimal weight, which must be positive.
+func parsePositiveDec(s string) (sdk.Dec, error) {
+	d, err := parseNonNegativeDec(s)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if d.IsZero() {
+		return sdk.Dec{}, sdkerrors.Wrapf(ErrInvalid, "expected a positive decimal, got %s", s)
+	}
+
+	return d, nil
+}
+
+// ParseWeight parses the weight of a member, or a sum of weights, which must
+// be a non-negative decimal.
+func ParseWeight(weight string) (sdk.Dec, error) {
+	return parseNonNegativeDec(weight)
+}
@@ -0,0 +1,140 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestThresholdDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewThresholdDecisionPolicy("3", time.Hour, 0)
+	tests := []struct {
+		title       string
+		tally       group.TallyResult
+		totalPower  string
+		expResult   group.DecisionPolicyResult
+		expectError bool
+	}{
+		{
+			"yes votes reach the threshold",
+			group.TallyResult{YesCount: "3", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"yes votes may still reach the threshold",
+			group.TallyResult{YesCount: "2", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+			false,
+		},
+		{
+			"yes votes cannot reach the threshold anymore",
+			group.TallyResult{YesCount: "1", NoCount: "8", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+			false,
+		},
+		{
+			"threshold capped to the total weight",
+			group.TallyResult{YesCount: "2", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"2",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+			false,
+		},
+		{
+			"invalid total weight",
+			group.DefaultTallyResult(),
+			"invalid",
+			group.DecisionPolicyResult{},
+			true,
+		},
+	}
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, tc.totalPower)
+		if tc.expectError {
+			require.Error(t, err, tc.title)
+			continue
+		}
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.expResult, res, tc.title)
+	}
+}
+
+func TestPercentageDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewPercentageDecisionPolicy("0.5", time.Hour, 0)
+	tests := []struct {
+		title      string
+		tally      group.TallyResult
+		totalPower string
+		expResult  group.DecisionPolicyResult
+	}{
+		{
+			"yes votes reach the percentage",
+			group.TallyResult{YesCount: "5", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"yes votes may still reach the percentage",
+			group.TallyResult{YesCount: "4", NoCount: "1", AbstainCount: "0", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"yes votes cannot reach the percentage anymore",
+			group.TallyResult{YesCount: "1", NoCount: "5", AbstainCount: "1", NoWithVetoCount: "0"},
+			"10",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"group without members",
+			group.DefaultTallyResult(),
+			"0",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tc := range tests {
+		res, err := policy.Allow(tc.tally, tc.totalPower)
+		require.NoError(t, err, tc.title)
+		require.Equal(t, tc.expResult, res, tc.title)
+	}
+}
+
+func TestDecisionPolicyValidate(t *testing.T) {
+	config := group.DefaultConfig()
+	g := group.GroupInfo{Id: 1, Admin: admin.String(), TotalWeight: "1", Version: 1}
+
+	policy := group.NewThresholdDecisionPolicy("1", time.Hour, time.Hour+config.MaxExecutionPeriod)
+	require.NoError(t, policy.Validate(g, config))
+
+	policy = group.NewThresholdDecisionPolicy("1", time.Hour, time.Hour+config.MaxExecutionPeriod+time.Second)
+	require.Error(t, policy.Validate(g, config))
+
+	delayed := &group.ThresholdDecisionPolicy{
+		Threshold: "1",
+		Windows:   group.DecisionPolicyWindows{VotingPeriod: time.Hour, ExecutionDelay: config.MaxExecutionPeriod},
+	}
+	require.NoError(t, delayed.Validate(g, config))
+	delayed.Windows.ExecutionDelay++
+	require.Error(t, delayed.Validate(g, config))
+}
+
+func TestTallyResultAdd(t *testing.T) {
+	tally := group.DefaultTallyResult()
+	require.NoError(t, tally.Add(group.VOTE_OPTION_YES, "1.5"))
+	require.NoError(t, tally.Add(group.VOTE_OPTION_YES, "2"))
+	require.NoError(t, tally.Add(group.VOTE_OPTION_NO_WITH_VETO, "1"))
+	require.Error(t, tally.Add(group.VOTE_OPTION_UNSPECIFIED, "1"))
+	require.Error(t, tally.Add(group.VOTE_OPTION_NO, "-1"))
+
+	require.Equal(t, "3.500000000000000000", tally.YesCount)
+	require.Equal(t, "0", tally.NoCount)
+	total, err := tally.TotalCounts()
+	require.NoError(t, err)
+	require.Equal(t, "4.500000000000000000", total.String())
+}
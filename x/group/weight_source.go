@@ -0,0 +1,18 @@
+package group
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WeightSource derives the voting weights of the members of a group from
+// state outside of the group, e.g. the stake of the members, in place of the
+// weights set in the group. Decision policies name the weight source they
+// use, which the group keeper must have registered.
+//
+// The weights are derived once, at proposal submission, and the votes on the
+// proposal weigh the weights derived then.
+type WeightSource interface {
+	// Weight returns the voting weight of member of group g. It must not be
+	// negative.
+	Weight(ctx sdk.Context, g GroupInfo, member Member) (sdk.Dec, error)
+}
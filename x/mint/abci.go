@@ -9,23 +9,35 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-// BeginBlocker mints new tokens for the previous block.
-func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+// BeginBlocker mints new tokens for the previous block, at the inflation rate
+// computed by ic, unless the tokens are minted at the end of epochs.
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculationFn) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
-	// fetch stored minter & params
-	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
+	if params.IsEpochMinting() {
+		// minted by the epoch hooks
+		return
+	}
+
+	mintProvision(ctx, k, ic, params, types.Minter.BlockProvision)
+}
+
+// mintProvision recalculates the inflation rate and mints the provision of
+// the block or epoch.
+func mintProvision(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculationFn, params types.Params, provision func(types.Minter, types.Params) sdk.Coin) {
+	// fetch stored minter
+	minter := k.GetMinter(ctx)
 
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.Inflation = ic(ctx, minter, params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
+	mintedCoin := provision(minter, params)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -33,8 +45,9 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
-	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
+	// send the minted coins to the fee collector account, or to the module
+	// accounts of the distribution proportions
+	err = k.DistributeMintedCoins(ctx, params, mintedCoin)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,144 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/testutil"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestBeginBlocker(t *testing.T) {
+	f := testutil.NewFixture(t)
+	params := f.MintKeeper.GetParams(f.Ctx)
+
+	supply := sdk.NewInt(1_000_000_000_000)
+	bondedRatio := sdk.NewDecWithPrec(50, 2)
+	f.StakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(supply)
+	f.StakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(bondedRatio)
+
+	minter := types.DefaultInitialMinter()
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+	minted := sdk.NewCoins(minter.BlockProvision(params))
+
+	// the minted coins are sent to the fee collector
+	gomock.InOrder(
+		f.BankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted).Return(nil),
+		f.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, minted).Return(nil),
+	)
+
+	mint.BeginBlocker(f.Ctx, f.MintKeeper, types.DefaultInflationCalculationFn)
+	require.Equal(t, minter, f.MintKeeper.GetMinter(f.Ctx))
+}
+
+func TestBeginBlockerInflationCalculationFn(t *testing.T) {
+	f := testutil.NewFixture(t)
+	params := f.MintKeeper.GetParams(f.Ctx)
+
+	supply := sdk.NewInt(1_000_000_000_000)
+	f.StakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(supply)
+	f.StakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(sdk.NewDecWithPrec(50, 2))
+
+	// a fixed inflation rate, ignoring the bonded ratio
+	inflation := sdk.NewDecWithPrec(5, 2)
+	ic := func(_ sdk.Context, _ types.Minter, _ types.Params, _ sdk.Dec) sdk.Dec {
+		return inflation
+	}
+
+	minter := types.NewMinter(inflation, inflation.MulInt(supply))
+	minted := sdk.NewCoins(minter.BlockProvision(params))
+	gomock.InOrder(
+		f.BankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted).Return(nil),
+		f.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, minted).Return(nil),
+	)
+
+	mint.BeginBlocker(f.Ctx, f.MintKeeper, ic)
+	require.Equal(t, minter, f.MintKeeper.GetMinter(f.Ctx))
+}
+
+func TestBeginBlockerDistributionProportions(t *testing.T) {
+	f := testutil.NewFixture(t)
+	params := f.MintKeeper.GetParams(f.Ctx)
+	params.DistributionProportions = []types.DistributionProportion{
+		{Module: authtypes.FeeCollectorName, Proportion: sdk.NewDecWithPrec(75, 2)},
+		{Module: "incentives", Proportion: sdk.NewDecWithPrec(25, 2)},
+	}
+	f.MintKeeper.SetParams(f.Ctx, params)
+
+	supply := sdk.NewInt(1_000_000_000_000)
+	f.StakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(supply)
+	f.StakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(sdk.NewDecWithPrec(50, 2))
+
+	minter := types.DefaultInitialMinter()
+	minter.Inflation = minter.NextInflationRate(params, sdk.NewDecWithPrec(50, 2))
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+	minted := minter.BlockProvision(params)
+	feeCollectorAmount := minted.Amount.ToDec().Mul(sdk.NewDecWithPrec(75, 2)).TruncateInt()
+	feeCollectorCoins := sdk.NewCoins(sdk.NewCoin(minted.Denom, feeCollectorAmount))
+	incentivesCoins := sdk.NewCoins(sdk.NewCoin(minted.Denom, minted.Amount.Sub(feeCollectorAmount)))
+
+	gomock.InOrder(
+		f.BankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, sdk.NewCoins(minted)).Return(nil),
+		f.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, feeCollectorCoins).Return(nil),
+		f.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "incentives", incentivesCoins).Return(nil),
+	)
+
+	mint.BeginBlocker(f.Ctx, f.MintKeeper, types.DefaultInflationCalculationFn)
+	require.Equal(t, types.MintDistribution{
+		Height: f.Ctx.BlockHeight(),
+		Distributions: []types.ModuleDistribution{
+			{Module: authtypes.FeeCollectorName, Amount: feeCollectorCoins},
+			{Module: "incentives", Amount: incentivesCoins},
+		},
+	}, f.MintKeeper.GetLastDistribution(f.Ctx))
+
+	var distributionEvents int
+	for _, event := range f.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMintDistribution {
+			distributionEvents++
+		}
+	}
+	require.Equal(t, 2, distributionEvents)
+}
+
+func TestEpochMinting(t *testing.T) {
+	f := testutil.NewFixture(t)
+	params := f.MintKeeper.GetParams(f.Ctx)
+	params.EpochIdentifier = "day"
+	f.MintKeeper.SetParams(f.Ctx, params)
+
+	// nothing is minted every block
+	mint.BeginBlocker(f.Ctx, f.MintKeeper, types.DefaultInflationCalculationFn)
+	require.Equal(t, types.DefaultInitialMinter(), f.MintKeeper.GetMinter(f.Ctx))
+
+	// nor at the end of the epochs of other identifiers
+	hooks := mint.NewEpochHooks(f.MintKeeper, nil)
+	hooks.AfterEpochEnd(f.Ctx, "week", 1)
+	require.Equal(t, types.DefaultInitialMinter(), f.MintKeeper.GetMinter(f.Ctx))
+
+	supply := sdk.NewInt(1_000_000_000_000)
+	bondedRatio := sdk.NewDecWithPrec(50, 2)
+	f.StakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(supply)
+	f.StakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(bondedRatio)
+
+	// the inflation rate changes by epoch rather than by block
+	minter := types.DefaultInitialMinter()
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+	minted := sdk.NewCoins(minter.EpochProvision(params))
+	require.Equal(t, supply.ToDec().Mul(minter.Inflation).QuoInt64(365).TruncateInt(), minted.AmountOf(params.MintDenom))
+
+	gomock.InOrder(
+		f.BankKeeper.EXPECT().MintCoins(gomock.Any(), types.ModuleName, minted).Return(nil),
+		f.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, minted).Return(nil),
+	)
+
+	hooks.AfterEpochEnd(f.Ctx, "day", 1)
+	require.Equal(t, minter, f.MintKeeper.GetMinter(f.Ctx))
+}
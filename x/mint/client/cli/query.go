@@ -2,11 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -24,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryLastDistribution(),
+		GetCmdQueryNextMint(),
+		GetCmdQueryInflationProjection(),
 	)
 
 	return mintingQueryCmd
@@ -118,3 +124,107 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryLastDistribution implements a command to return the distribution
+// of the coins minted last among the module accounts.
+func GetCmdQueryLastDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-distribution",
+		Short: "Query the distribution of the coins minted last among the module accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryLastDistributionRequest{}
+			res, err := queryClient.LastDistribution(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Distribution)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryNextMint implements a command to return when the coins are minted
+// next and their amount at the current inflation rate.
+func GetCmdQueryNextMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-mint",
+		Short: "Query when the coins are minted next and their amount at the current inflation rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryNextMintRequest{}
+			res, err := queryClient.NextMint(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryInflationProjection implements a command to return the projected
+// inflation rate and staking token supply at a future height or time.
+func GetCmdQueryInflationProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflation-projection [height|time]",
+		Short: "Query the projected inflation rate and staking token supply at a future height or RFC 3339 time",
+		Long: `Query the projected inflation rate and staking token supply at a future height
+or RFC 3339 time, under the current parameters and bonded ratio and the default
+inflation calculation.`,
+		Example: fmt.Sprintf("$ %s query mint inflation-projection 2000000\n$ %s query mint inflation-projection 2022-01-01T00:00:00Z", version.AppName, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryInflationProjectionRequest{}
+			if height, err := strconv.ParseInt(args[0], 10, 64); err == nil {
+				params.Height = height
+			} else {
+				t, err := time.Parse(time.RFC3339, args[0])
+				if err != nil {
+					return fmt.Errorf("%s is neither a height nor an RFC 3339 time", args[0])
+				}
+				params.Time = &t
+			}
+
+			res, err := queryClient.InflationProjection(cmd.Context(), params)
+
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
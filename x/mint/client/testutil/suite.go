@@ -66,12 +66,15 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","distribution_proportions":[],"epoch_identifier":"","epochs_per_year":"365"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+distribution_proportions: []
+epoch_identifier: ""
+epochs_per_year: "365"
 goal_bonded: "0.670000000000000000"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
@@ -161,3 +164,92 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryLastDistribution() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			`{"height":"1","distributions":[{"module":"fee_collector","amount":[{"denom":"stake","amount":"79"}]}]}`,
+		},
+		{
+			"text output",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			`distributions:
+- amount:
+  - amount: "79"
+    denom: stake
+  module: fee_collector
+height: "1"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryLastDistribution()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryNextMint() {
+	val := s.network.Validators[0]
+
+	cmd := cli.GetCmdQueryNextMint()
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+
+	var res minttypes.QueryNextMintResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+	// the coins are minted every block, at the inflation rate of the genesis
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 79), res.Amount)
+	s.Require().Positive(res.Height)
+	s.Require().Nil(res.Time)
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryInflationProjection() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"height", []string{"1000000"}, false},
+		{"time", []string{"2100-01-01T00:00:00Z"}, false},
+		{"past height", []string{"1"}, true},
+		{"invalid", []string{"tomorrow"}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryInflationProjection()
+			out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, append(tc.args, fmt.Sprintf("--%s=json", tmcli.OutputFlag)))
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var res minttypes.QueryInflationProjectionResponse
+			s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Positive(res.Mints)
+			// the inflation is bound to 100% in the genesis
+			s.Require().Equal(sdk.OneDec(), res.Inflation)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks mints the provision of an epoch at its end when the coins are
+// minted at the end of the epochs of the epoch identifier param rather than
+// every block. It is registered with the hooks of the epochs module.
+type EpochHooks struct {
+	keeper              keeper.Keeper
+	inflationCalculator types.InflationCalculationFn
+}
+
+// NewEpochHooks returns the epoch hooks of the mint module. If the
+// InflationCalculationFn argument is nil, types.DefaultInflationCalculationFn
+// is used.
+func NewEpochHooks(k keeper.Keeper, ic types.InflationCalculationFn) EpochHooks {
+	if ic == nil {
+		ic = types.DefaultInflationCalculationFn
+	}
+
+	return EpochHooks{keeper: k, inflationCalculator: ic}
+}
+
+// BeforeEpochStart is called when an epoch starts; the coins are minted at the
+// end of the epochs.
+func (EpochHooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd mints the provision of the epoch if the coins are minted at
+// the end of the epochs of the identifier.
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := h.keeper.GetParams(ctx)
+	if !params.IsEpochMinting() || params.EpochIdentifier != epochIdentifier {
+		return
+	}
+
+	h.keeper.Logger(ctx).Debug("minting the provision of the epoch", "epoch", epochIdentifier, "number", epochNumber)
+	mintProvision(ctx, h.keeper, h.inflationCalculator, params, types.Minter.EpochProvision)
+}
@@ -2,6 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -14,7 +18,7 @@ func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.Q
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
 
-	return &types.QueryParamsResponse{Params: params}, nil
+	return &types.QueryParamsResponse{Params: params, LastChangedHeight: k.paramSpace.GetLastChangeHeight(ctx)}, nil
 }
 
 // Inflation returns minter.Inflation of the mint module.
@@ -32,3 +36,80 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// LastDistribution returns the distribution of the coins minted last.
+func (k Keeper) LastDistribution(c context.Context, _ *types.QueryLastDistributionRequest) (*types.QueryLastDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryLastDistributionResponse{Distribution: k.GetLastDistribution(ctx)}, nil
+}
+
+// NextMint returns when the coins are minted next and their amount at the
+// current inflation rate.
+func (k Keeper) NextMint(c context.Context, _ *types.QueryNextMintRequest) (*types.QueryNextMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	minter := k.GetMinter(ctx)
+
+	if !params.IsEpochMinting() {
+		return &types.QueryNextMintResponse{Amount: minter.BlockProvision(params), Height: ctx.BlockHeight() + 1}, nil
+	}
+
+	res := &types.QueryNextMintResponse{Amount: minter.EpochProvision(params)}
+	if k.epochKeeper != nil {
+		if t, ok := k.epochKeeper.NextEpochTime(ctx, params.EpochIdentifier); ok {
+			res.Time = &t
+		}
+	}
+
+	return res, nil
+}
+
+// projectionYear is the duration of a year, in which BlocksPerYear and
+// EpochsPerYear are expected.
+const projectionYear = 8766 * time.Hour
+
+// InflationProjection returns the projected inflation rate and staking token
+// supply at a future height or time, under the current params and bonded
+// ratio and the default inflation calculation.
+func (k Keeper) InflationProjection(c context.Context, req *types.QueryInflationProjectionRequest) (*types.QueryInflationProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if (req.Height == 0) == (req.Time == nil) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of height and time must be set")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	// the number of mints until the height or time, the heights being converted
+	// to epochs with the expected blocks and epochs per year
+	var mints sdk.Int
+	if req.Height != 0 {
+		if req.Height <= ctx.BlockHeight() {
+			return nil, status.Errorf(codes.InvalidArgument, "height %d is not after the current height %d", req.Height, ctx.BlockHeight())
+		}
+		mints = sdk.NewInt(req.Height - ctx.BlockHeight())
+		if params.IsEpochMinting() {
+			mints = mints.Mul(sdk.NewIntFromUint64(params.EpochsPerYear)).Quo(sdk.NewIntFromUint64(params.BlocksPerYear))
+		}
+	} else {
+		if !req.Time.After(ctx.BlockTime()) {
+			return nil, status.Errorf(codes.InvalidArgument, "time %s is not after the current block time %s", req.Time, ctx.BlockTime())
+		}
+		mints = sdk.NewInt(int64(req.Time.Sub(ctx.BlockTime()))).Mul(sdk.NewIntFromUint64(params.MintsPerYear())).QuoRaw(int64(projectionYear))
+	}
+	if !mints.IsUint64() {
+		return nil, status.Error(codes.InvalidArgument, "projection too far in the future")
+	}
+
+	minter, supply := k.GetMinter(ctx).Project(params, k.BondedRatio(ctx), k.StakingTokenSupply(ctx), mints.Uint64())
+
+	return &types.QueryInflationProjectionResponse{
+		Inflation:          minter.Inflation,
+		AnnualProvisions:   minter.AnnualProvisions,
+		StakingTokenSupply: supply,
+		Mints:              mints.Uint64(),
+	}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -51,6 +52,94 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCLastDistribution() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.LastDistribution(gocontext.Background(), &types.QueryLastDistributionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Distribution.Distributions)
+
+	distribution := types.MintDistribution{
+		Height:        10,
+		Distributions: []types.ModuleDistribution{{Module: "fee_collector", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}},
+	}
+	app.MintKeeper.SetLastDistribution(ctx, distribution)
+
+	res, err = queryClient.LastDistribution(gocontext.Background(), &types.QueryLastDistributionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(distribution, res.Distribution)
+}
+
+type epochKeeper map[string]time.Time
+
+func (ek epochKeeper) NextEpochTime(_ sdk.Context, identifier string) (time.Time, bool) {
+	t, ok := ek[identifier]
+	return t, ok
+}
+
+func (suite *MintTestSuite) TestGRPCNextMint() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+	minter := app.MintKeeper.GetMinter(ctx)
+	params := app.MintKeeper.GetParams(ctx)
+
+	res, err := app.MintKeeper.NextMint(sdk.WrapSDKContext(ctx), &types.QueryNextMintRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryNextMintResponse{Amount: minter.BlockProvision(params), Height: 11}, res)
+
+	params.EpochIdentifier = "fortnight"
+	app.MintKeeper.SetParams(ctx, params)
+
+	// the end of the epoch is unknown if the epochs keeper does not know it
+	res, err = app.MintKeeper.NextMint(sdk.WrapSDKContext(ctx), &types.QueryNextMintRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryNextMintResponse{Amount: minter.EpochProvision(params)}, res)
+
+	epochEnd := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	app.MintKeeper.SetEpochKeeper(epochKeeper{"fortnight": epochEnd})
+	res, err = app.MintKeeper.NextMint(sdk.WrapSDKContext(ctx), &types.QueryNextMintRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryNextMintResponse{Amount: minter.EpochProvision(params), Time: &epochEnd}, res)
+}
+
+func (suite *MintTestSuite) TestGRPCInflationProjection() {
+	app := suite.app
+	blockTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(blockTime)
+	goCtx := sdk.WrapSDKContext(ctx)
+	params := app.MintKeeper.GetParams(ctx)
+	minter := app.MintKeeper.GetMinter(ctx)
+	bondedRatio, supply := app.MintKeeper.BondedRatio(ctx), app.MintKeeper.StakingTokenSupply(ctx)
+
+	_, err := app.MintKeeper.InflationProjection(goCtx, &types.QueryInflationProjectionRequest{})
+	suite.Require().Error(err)
+	_, err = app.MintKeeper.InflationProjection(goCtx, &types.QueryInflationProjectionRequest{Height: 20, Time: &blockTime})
+	suite.Require().Error(err)
+	_, err = app.MintKeeper.InflationProjection(goCtx, &types.QueryInflationProjectionRequest{Height: 10})
+	suite.Require().Error(err)
+	_, err = app.MintKeeper.InflationProjection(goCtx, &types.QueryInflationProjectionRequest{Time: &blockTime})
+	suite.Require().Error(err)
+
+	res, err := app.MintKeeper.InflationProjection(goCtx, &types.QueryInflationProjectionRequest{Height: 110})
+	suite.Require().NoError(err)
+	projected, projectedSupply := minter.Project(params, bondedRatio, supply, 100)
+	suite.Require().Equal(&types.QueryInflationProjectionResponse{
+		Inflation:          projected.Inflation,
+		AnnualProvisions:   projected.AnnualProvisions,
+		StakingTokenSupply: projectedSupply,
+		Mints:              100,
+	}, res)
+
+	// a year later, minting at the end of daily epochs
+	params.EpochIdentifier = "day"
+	app.MintKeeper.SetParams(ctx, params)
+	yearLater := blockTime.Add(8766 * time.Hour)
+	res, err = app.MintKeeper.InflationProjection(goCtx, &types.QueryInflationProjectionRequest{Time: &yearLater})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.EpochsPerYear, res.Mints)
+	projected, _ = minter.Project(params, bondedRatio, supply, params.EpochsPerYear)
+	suite.Require().Equal(projected.Inflation, res.Inflation)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
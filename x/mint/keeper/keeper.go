@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -15,16 +16,27 @@ type Keeper struct {
 	storeKey         sdk.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	bankKeeper       types.BankKeeper
+	epochKeeper      types.EpochKeeper
 	feeCollectorName string
+
+	// authority is the address allowed to update the parameters of the
+	// module, usually the gov module account.
+	authority string
 }
 
-// NewKeeper creates a new mint Keeper instance
+// NewKeeper creates a new mint Keeper instance. The authority is the address
+// allowed to update the parameters, usually the gov module account.
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid mint authority %s", authority))
+	}
+
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the mint module account has not been set")
@@ -40,11 +52,25 @@ func NewKeeper(
 		storeKey:         key,
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		bankKeeper:       bk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the parameters of the
+// module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetEpochKeeper sets the epochs keeper telling when the epochs at the end of
+// which the coins are minted end.
+func (k *Keeper) SetEpochKeeper(ek types.EpochKeeper) {
+	k.epochKeeper = ek
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -69,6 +95,25 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// GetLastDistribution returns the distribution of the coins minted last.
+func (k Keeper) GetLastDistribution(ctx sdk.Context) (distribution types.MintDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.LastDistributionKey)
+	if b == nil {
+		return distribution
+	}
+
+	k.cdc.MustUnmarshal(b, &distribution)
+	return distribution
+}
+
+// SetLastDistribution sets the distribution of the coins minted last.
+func (k Keeper) SetLastDistribution(ctx sdk.Context, distribution types.MintDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&distribution)
+	store.Set(types.LastDistributionKey, b)
+}
+
 // GetParams returns the total set of minting parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -108,3 +153,44 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// ValidateDistributionModules returns an error if a module of the distribution
+// proportions of params has no module account, the minted coins failing to be
+// sent to it.
+func (k Keeper) ValidateDistributionModules(params types.Params) error {
+	for _, p := range params.DistributionProportions {
+		if k.authKeeper.GetModuleAddress(p.Module) == nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "distribution module account %s does not exist", p.Module)
+		}
+	}
+
+	return nil
+}
+
+// DistributeMintedCoins sends the minted coin to the module accounts according
+// to the distribution proportions of params, or to the fee collector if there
+// are none, records the distribution and emits an event for each module
+// account.
+func (k Keeper) DistributeMintedCoins(ctx sdk.Context, params types.Params, minted sdk.Coin) error {
+	distribution := types.MintDistribution{
+		Height:        ctx.BlockHeight(),
+		Distributions: params.SplitMintedCoin(minted, k.feeCollectorName),
+	}
+
+	for _, d := range distribution.Distributions {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, d.Module, d.Amount); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMintDistribution,
+				sdk.NewAttribute(types.AttributeKeyModule, d.Module),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, d.Amount.String()),
+			),
+		)
+	}
+
+	k.SetLastDistribution(ctx, distribution)
+	return nil
+}
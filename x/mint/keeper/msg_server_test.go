@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	msgServer := keeper.NewMsgServerImpl(app.MintKeeper)
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.BlocksPerYear = 1000
+	invalid := params
+	invalid.BlocksPerYear = 0
+
+	testutil.RunUpdateParamsTest(t, ctx, testutil.UpdateParamsTest{
+		Authority: app.MintKeeper.GetAuthority(),
+		Params:    params,
+		Invalid:   invalid,
+		Update: func(ctx sdk.Context, authority string, params interface{}) error {
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateParams{Authority: authority, Params: params.(types.Params)})
+			return err
+		},
+		Query: func(ctx sdk.Context) (interface{}, int64) {
+			res, err := app.MintKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+			require.NoError(t, err)
+			return res.Params, res.LastChangedHeight
+		},
+	})
+}
+
+func TestUpdateParamsDistributionModules(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+	msgServer := keeper.NewMsgServerImpl(app.MintKeeper)
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.DistributionProportions = []types.DistributionProportion{
+		{Module: authtypes.FeeCollectorName, Proportion: sdk.NewDecWithPrec(75, 2)},
+		{Module: "distrbution", Proportion: sdk.NewDecWithPrec(25, 2)},
+	}
+
+	// the minted coins can only be distributed to existing module accounts
+	msg := &types.MsgUpdateParams{Authority: app.MintKeeper.GetAuthority(), Params: params}
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownAddress)
+	require.Empty(t, app.MintKeeper.GetParams(ctx).DistributionProportions)
+
+	params.DistributionProportions[1].Module = distrtypes.ModuleName
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, params, app.MintKeeper.GetParams(ctx))
+}
@@ -0,0 +1,23 @@
+package v045
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Setting the distribution proportions parameter to none, the minted coins
+// still being sent to the fee collector
+// - Setting the epoch identifier parameter to none, the coins still being
+// minted every block, and the epochs per year to their default
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultParams := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyDistributionProportions, []types.DistributionProportion{})
+	paramSpace.Set(ctx, types.KeyEpochIdentifier, defaultParams.EpochIdentifier)
+	paramSpace.Set(ctx, types.KeyEpochsPerYear, defaultParams.EpochsPerYear)
+
+	return nil
+}
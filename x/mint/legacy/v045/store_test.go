@@ -0,0 +1,40 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045mint "github.com/cosmos/cosmos-sdk/x/mint/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestParamsMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tParamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+	ctx := testutil.DefaultContext(paramsKey, tParamsKey)
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tParamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	// the params of v0.43 have no distribution proportions
+	require.False(t, paramSpace.Has(ctx, types.KeyDistributionProportions))
+
+	require.NoError(t, v045mint.MigrateParams(ctx, paramSpace))
+
+	var proportions []types.DistributionProportion
+	paramSpace.Get(ctx, types.KeyDistributionProportions, &proportions)
+	require.Empty(t, proportions)
+
+	var epochIdentifier string
+	paramSpace.Get(ctx, types.KeyEpochIdentifier, &epochIdentifier)
+	require.Empty(t, epochIdentifier)
+
+	var epochsPerYear uint64
+	paramSpace.Get(ctx, types.KeyEpochsPerYear, &epochsPerYear)
+	require.Equal(t, types.DefaultParams().EpochsPerYear, epochsPerYear)
+}
@@ -8,7 +8,9 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -26,3 +28,16 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := app.AccountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.ModuleName))
 	require.NotNil(t, acc)
 }
+
+func TestInitGenesisDistributionModules(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.DistributionProportions = []types.DistributionProportion{
+		{Module: "distrbution", Proportion: sdk.OneDec()},
+	}
+	require.PanicsWithError(t, "distribution module account distrbution does not exist: unknown address", func() {
+		mint.InitGenesis(ctx, app.MintKeeper, app.AccountKeeper, genesis)
+	})
+}
@@ -19,6 +19,11 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.Equal(kvA.Key, types.LastDistributionKey):
+			var distributionA, distributionB types.MintDistribution
+			cdc.MustUnmarshal(kvA.Value, &distributionA)
+			cdc.MustUnmarshal(kvB.Value, &distributionB)
+			return fmt.Sprintf("%v\n%v", distributionA, distributionB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
@@ -18,10 +18,15 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	minter := types.NewMinter(sdk.OneDec(), sdk.NewDec(15))
+	distribution := types.MintDistribution{
+		Height:        1,
+		Distributions: []types.ModuleDistribution{{Module: "fee_collector", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 15))}},
+	}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: types.LastDistributionKey, Value: cdc.MustMarshal(&distribution)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -30,6 +35,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"MintDistribution", fmt.Sprintf("%v\n%v", distribution, distribution)},
 		{"other", ""},
 	}
 
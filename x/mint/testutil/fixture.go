@@ -0,0 +1,43 @@
+package testutil
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/fixture"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// Fixture is a mint keeper built in isolation, with mocked staking, account
+// and bank keepers.
+type Fixture struct {
+	*fixture.Fixture
+
+	MintKeeper    keeper.Keeper
+	StakingKeeper *MockStakingKeeper
+	AccountKeeper *MockAccountKeeper
+	BankKeeper    *MockBankKeeper
+}
+
+// NewFixture creates a mint keeper with mocked dependencies and the default
+// params and minter.
+func NewFixture(t *testing.T) *Fixture {
+	f := &Fixture{Fixture: fixture.New(t, []string{types.StoreKey}, mint.AppModuleBasic{})}
+	f.StakingKeeper = NewMockStakingKeeper(f.Ctrl)
+	f.AccountKeeper = NewMockAccountKeeper(f.Ctrl)
+	f.BankKeeper = NewMockBankKeeper(f.Ctrl)
+
+	f.AccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(authtypes.NewModuleAddress(types.ModuleName))
+	f.MintKeeper = keeper.NewKeeper(
+		f.Codec(), f.StoreKey(types.StoreKey), f.Subspace(types.ModuleName),
+		f.StakingKeeper, f.AccountKeeper, f.BankKeeper, authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	f.MintKeeper.SetParams(f.Ctx, types.DefaultParams())
+	f.MintKeeper.SetMinter(f.Ctx, types.DefaultInitialMinter())
+
+	return f
+}
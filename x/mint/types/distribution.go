@@ -0,0 +1,35 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SplitMintedCoin splits the minted coin among the module accounts according to
+// the distribution proportions, all of it going to defaultModule if there are
+// none. The amounts are truncated, the remainder going to the last module
+// account, so that the whole coin is distributed. The module accounts receiving
+// no coins are omitted.
+func (p Params) SplitMintedCoin(minted sdk.Coin, defaultModule string) []ModuleDistribution {
+	if len(p.DistributionProportions) == 0 {
+		return []ModuleDistribution{{Module: defaultModule, Amount: sdk.NewCoins(minted)}}
+	}
+
+	distributions := make([]ModuleDistribution, 0, len(p.DistributionProportions))
+	remaining := minted.Amount
+	for i, proportion := range p.DistributionProportions {
+		amount := remaining
+		if i < len(p.DistributionProportions)-1 {
+			amount = proportion.Proportion.MulInt(minted.Amount).TruncateInt()
+			remaining = remaining.Sub(amount)
+		}
+
+		if amount.IsPositive() {
+			distributions = append(distributions, ModuleDistribution{
+				Module: proportion.Module,
+				Amount: sdk.NewCoins(sdk.NewCoin(minted.Denom, amount)),
+			})
+		}
+	}
+
+	return distributions
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateDistributionProportions(t *testing.T) {
+	tests := []struct {
+		name        string
+		proportions []DistributionProportion
+		expErr      bool
+	}{
+		{"none", nil, false},
+		{"fee collector only", []DistributionProportion{{"fee_collector", sdk.OneDec()}}, false},
+		{"split", []DistributionProportion{{"fee_collector", sdk.NewDecWithPrec(75, 2)}, {"incentives", sdk.NewDecWithPrec(25, 2)}}, false},
+		{"blank module", []DistributionProportion{{"", sdk.OneDec()}}, true},
+		{"duplicate module", []DistributionProportion{{"incentives", sdk.NewDecWithPrec(5, 1)}, {"incentives", sdk.NewDecWithPrec(5, 1)}}, true},
+		{"zero proportion", []DistributionProportion{{"fee_collector", sdk.OneDec()}, {"incentives", sdk.ZeroDec()}}, true},
+		{"negative proportion", []DistributionProportion{{"fee_collector", sdk.NewDec(2)}, {"incentives", sdk.OneDec().Neg()}}, true},
+		{"sum below one", []DistributionProportion{{"fee_collector", sdk.NewDecWithPrec(5, 1)}}, true},
+		{"sum above one", []DistributionProportion{{"fee_collector", sdk.OneDec()}, {"incentives", sdk.NewDecWithPrec(1, 2)}}, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			params.DistributionProportions = tc.proportions
+			if tc.expErr {
+				require.Error(t, params.Validate())
+			} else {
+				require.NoError(t, params.Validate())
+			}
+		})
+	}
+}
+
+func TestSplitMintedCoin(t *testing.T) {
+	params := DefaultParams()
+	minted := sdk.NewInt64Coin("stake", 1001)
+
+	require.Equal(t, []ModuleDistribution{{Module: "fee_collector", Amount: sdk.NewCoins(minted)}}, params.SplitMintedCoin(minted, "fee_collector"))
+
+	params.DistributionProportions = []DistributionProportion{
+		{"fee_collector", sdk.NewDecWithPrec(5, 1)},
+		{"developers", sdk.NewDecWithPrec(3, 1)},
+		{"incentives", sdk.NewDecWithPrec(2, 1)},
+	}
+	// the rounding remainder goes to the last module account
+	require.Equal(t, []ModuleDistribution{
+		{Module: "fee_collector", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 500))},
+		{Module: "developers", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 300))},
+		{Module: "incentives", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 201))},
+	}, params.SplitMintedCoin(minted, "fee_collector"))
+
+	// the module accounts receiving nothing are omitted
+	require.Equal(t, []ModuleDistribution{
+		{Module: "incentives", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))},
+	}, params.SplitMintedCoin(sdk.NewInt64Coin("stake", 1), "fee_collector"))
+}
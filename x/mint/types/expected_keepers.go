@@ -1,6 +1,8 @@
 package types // noalias
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -27,3 +29,11 @@ type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// EpochKeeper defines the expected epochs keeper, which tells when the epochs
+// at the end of which the coins are minted end.
+type EpochKeeper interface {
+	// NextEpochTime returns the time at which the current epoch of the given
+	// identifier ends, if there is such an epoch.
+	NextEpochTime(ctx sdk.Context, identifier string) (time.Time, bool)
+}
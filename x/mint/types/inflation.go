@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InflationCalculationFn computes the inflation rate of the next block from the
+// current minter, the module parameters and the bonded ratio of the staking
+// token. It allows chains with other emission schedules to reuse the module.
+type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
+
+// DefaultInflationCalculationFn is the default inflation calculation, which
+// moves the inflation rate towards the bonded ratio goal with Minter's
+// NextInflationRate.
+func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return minter.NextInflationRate(params, bondedRatio)
+}
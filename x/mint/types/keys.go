@@ -1,7 +1,14 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+import "github.com/cosmos/cosmos-sdk/types/keyformat"
+
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// LastDistributionKey is the key of the distribution of the coins minted last.
+	LastDistributionKey = []byte{0x01}
+)
 
 const (
 	// module name
@@ -18,3 +25,9 @@ const (
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
 )
+
+// KeyFormats are the layouts of the keys of the mint store.
+var KeyFormats = []keyformat.KeyFormat{
+	{Name: "minter", Prefix: MinterKey, Value: keyformat.ProtoValue(&Minter{})},
+	{Name: "last_distribution", Prefix: LastDistributionKey, Value: keyformat.ProtoValue(&MintDistribution{})},
+}
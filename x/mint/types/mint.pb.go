@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -79,6 +80,14 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// proportions of the minted coins sent to module accounts, all of them being
+	// sent to the fee collector if empty
+	DistributionProportions []DistributionProportion `protobuf:"bytes,7,rep,name=distribution_proportions,json=distributionProportions,proto3" json:"distribution_proportions" yaml:"distribution_proportions"`
+	// identifier of the epochs at the end of which the coins are minted, the
+	// coins being minted every block if empty
+	EpochIdentifier string `protobuf:"bytes,8,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	// expected epochs per year
+	EpochsPerYear uint64 `protobuf:"varint,9,opt,name=epochs_per_year,json=epochsPerYear,proto3" json:"epochs_per_year,omitempty" yaml:"epochs_per_year"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,43 +136,241 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetDistributionProportions() []DistributionProportion {
+	if m != nil {
+		return m.DistributionProportions
+	}
+	return nil
+}
+
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetEpochsPerYear() uint64 {
+	if m != nil {
+		return m.EpochsPerYear
+	}
+	return 0
+}
+
+// DistributionProportion is the proportion of the minted coins sent to a module
+// account.
+type DistributionProportion struct {
+	// name of the module account
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// proportion of the minted coins
+	Proportion github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=proportion,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"proportion"`
+}
+
+func (m *DistributionProportion) Reset()         { *m = DistributionProportion{} }
+func (m *DistributionProportion) String() string { return proto.CompactTextString(m) }
+func (*DistributionProportion) ProtoMessage()    {}
+func (*DistributionProportion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *DistributionProportion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionProportion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionProportion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionProportion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionProportion.Merge(m, src)
+}
+func (m *DistributionProportion) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionProportion) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionProportion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionProportion proto.InternalMessageInfo
+
+func (m *DistributionProportion) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// ModuleDistribution is an amount of minted coins sent to a module account.
+type ModuleDistribution struct {
+	// name of the module account
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// minted coins sent to the module account
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ModuleDistribution) Reset()         { *m = ModuleDistribution{} }
+func (m *ModuleDistribution) String() string { return proto.CompactTextString(m) }
+func (*ModuleDistribution) ProtoMessage()    {}
+func (*ModuleDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *ModuleDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleDistribution.Merge(m, src)
+}
+func (m *ModuleDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleDistribution proto.InternalMessageInfo
+
+func (m *ModuleDistribution) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ModuleDistribution) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MintDistribution is the distribution of the coins minted at a height.
+type MintDistribution struct {
+	// height at which the coins were minted
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// minted coins sent to each module account
+	Distributions []ModuleDistribution `protobuf:"bytes,2,rep,name=distributions,proto3" json:"distributions"`
+}
+
+func (m *MintDistribution) Reset()         { *m = MintDistribution{} }
+func (m *MintDistribution) String() string { return proto.CompactTextString(m) }
+func (*MintDistribution) ProtoMessage()    {}
+func (*MintDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *MintDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintDistribution.Merge(m, src)
+}
+func (m *MintDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintDistribution proto.InternalMessageInfo
+
+func (m *MintDistribution) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintDistribution) GetDistributions() []ModuleDistribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*DistributionProportion)(nil), "cosmos.mint.v1beta1.DistributionProportion")
+	proto.RegisterType((*ModuleDistribution)(nil), "cosmos.mint.v1beta1.ModuleDistribution")
+	proto.RegisterType((*MintDistribution)(nil), "cosmos.mint.v1beta1.MintDistribution")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x63, 0x28, 0x95, 0x6a, 0x98, 0x00, 0x6f, 0xa0, 0x68, 0x82, 0x64, 0xca, 0x01, 0x8d,
-	0x03, 0x89, 0x26, 0x6e, 0x3b, 0x66, 0x15, 0x07, 0xc4, 0x50, 0xe5, 0x1b, 0x5c, 0xa2, 0x7f, 0x52,
-	0x93, 0x59, 0x8d, 0xed, 0xca, 0xf6, 0x46, 0x7b, 0xe5, 0x09, 0x38, 0x72, 0xe4, 0x2d, 0x78, 0x85,
-	0xdd, 0xd8, 0x11, 0x71, 0xa8, 0x50, 0xfb, 0x06, 0x7b, 0x02, 0x14, 0xbb, 0x6a, 0xa1, 0x20, 0xa4,
-	0x4a, 0x9c, 0x92, 0xef, 0xfb, 0xff, 0xf3, 0xfd, 0xbe, 0x58, 0x32, 0x8e, 0x2a, 0x65, 0x84, 0x32,
-	0x99, 0xe0, 0xd2, 0x66, 0x17, 0x47, 0x25, 0xb3, 0x70, 0xe4, 0x44, 0x3a, 0xd6, 0xca, 0x2a, 0xb2,
-	0xeb, 0xe7, 0xa9, 0xb3, 0x96, 0xf3, 0xfd, 0xbd, 0x5a, 0xd5, 0xca, 0xcd, 0xb3, 0xf6, 0xcd, 0xaf,
-	0x26, 0x5f, 0x11, 0xee, 0x9e, 0x72, 0x69, 0x99, 0x26, 0xaf, 0x70, 0x8f, 0xcb, 0x77, 0x0d, 0x58,
-	0xae, 0x64, 0x88, 0x0e, 0xd0, 0x61, 0x2f, 0x4f, 0x2f, 0x67, 0x71, 0xf0, 0x7d, 0x16, 0x3f, 0xa9,
-	0xb9, 0x3d, 0x3b, 0x2f, 0xd3, 0x4a, 0x89, 0x6c, 0xc9, 0xf6, 0x8f, 0x67, 0x66, 0x38, 0xca, 0xec,
-	0x74, 0xcc, 0x4c, 0xda, 0x67, 0x15, 0x5d, 0x07, 0x90, 0xf7, 0xf8, 0x3e, 0x48, 0x79, 0x0e, 0x4d,
-	0x31, 0xd6, 0xea, 0x82, 0x1b, 0xae, 0xa4, 0x09, 0x6f, 0xb8, 0xd4, 0x97, 0xdb, 0xa5, 0x5e, 0xcf,
-	0xe2, 0x70, 0x0a, 0xa2, 0x39, 0x4e, 0xfe, 0x08, 0x4c, 0xe8, 0x3d, 0xef, 0x0d, 0xd6, 0xd6, 0x97,
-	0x0e, 0xee, 0x0e, 0x40, 0x83, 0x30, 0xe4, 0x31, 0xc6, 0xed, 0x11, 0x14, 0x43, 0x26, 0x95, 0xf0,
-	0xbf, 0x44, 0x7b, 0xad, 0xd3, 0x6f, 0x0d, 0xf2, 0x01, 0xe1, 0x07, 0xab, 0xc2, 0x85, 0x06, 0xcb,
-	0x8a, 0xea, 0x0c, 0x64, 0xcd, 0x96, 0x3d, 0x5f, 0x6f, 0xdd, 0xf3, 0x91, 0xef, 0xf9, 0xd7, 0xd0,
-	0x84, 0xee, 0xae, 0x7c, 0x0a, 0x96, 0x9d, 0x38, 0x97, 0x8c, 0xf0, 0xce, 0x7a, 0x5d, 0xc0, 0x24,
-	0xbc, 0xe9, 0xd8, 0x2f, 0xb6, 0x66, 0xef, 0x6d, 0xb2, 0x05, 0x4c, 0x12, 0x7a, 0x67, 0xa5, 0x4f,
-	0x61, 0xb2, 0x01, 0xe3, 0x32, 0xec, 0xfc, 0x37, 0x18, 0x97, 0xbf, 0xc1, 0xb8, 0x24, 0x0c, 0xdf,
-	0xae, 0x15, 0x34, 0x45, 0xa9, 0xe4, 0x90, 0x0d, 0xc3, 0x5b, 0x0e, 0xd5, 0xdf, 0x1a, 0x45, 0x3c,
-	0xea, 0x97, 0xa8, 0x84, 0xe2, 0x56, 0xe5, 0x4e, 0x90, 0x1c, 0xdf, 0x2d, 0x1b, 0x55, 0x8d, 0x4c,
-	0x31, 0x66, 0xba, 0x98, 0x32, 0xd0, 0x61, 0xf7, 0x00, 0x1d, 0x76, 0xf2, 0xfd, 0xeb, 0x59, 0xfc,
-	0xd0, 0x7f, 0xbc, 0xb1, 0x90, 0xd0, 0x1d, 0xef, 0x0c, 0x98, 0x7e, 0xc3, 0x40, 0x1f, 0x77, 0x3e,
-	0x7d, 0x8e, 0x83, 0xfc, 0xe4, 0x72, 0x1e, 0xa1, 0xab, 0x79, 0x84, 0x7e, 0xcc, 0x23, 0xf4, 0x71,
-	0x11, 0x05, 0x57, 0x8b, 0x28, 0xf8, 0xb6, 0x88, 0x82, 0xb7, 0x4f, 0xff, 0xd9, 0x76, 0xe2, 0x2f,
-	0xa2, 0x2b, 0x5d, 0x76, 0xdd, 0xbd, 0x7a, 0xfe, 0x33, 0x00, 0x00, 0xff, 0xff, 0x34, 0x52, 0xcf,
-	0x87, 0xa4, 0x03, 0x00, 0x00,
+	// 673 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0xb6, 0x52, 0xa8, 0x47, 0xb5, 0xe1, 0x8d, 0x2d, 0x0c, 0x48, 0xa6, 0x1c, 0x58, 0x11,
+	0x22, 0x65, 0x70, 0xdb, 0x31, 0xab, 0x26, 0x81, 0xd8, 0x54, 0x99, 0x13, 0x5c, 0x22, 0x27, 0xf1,
+	0x5a, 0x6b, 0x8d, 0x1d, 0x25, 0xee, 0xe8, 0x4e, 0x20, 0x5e, 0x00, 0xb8, 0x71, 0xe4, 0xcc, 0x93,
+	0xec, 0xc6, 0x2e, 0x48, 0x88, 0x43, 0x41, 0xdb, 0x1b, 0xf4, 0x09, 0x50, 0xec, 0x2c, 0xed, 0xb2,
+	0x0e, 0x51, 0xc4, 0xa9, 0xfd, 0x7e, 0xfe, 0xfc, 0xfb, 0xf3, 0x39, 0x71, 0x80, 0xe1, 0xf3, 0x24,
+	0xe4, 0x49, 0x23, 0xa4, 0x4c, 0x34, 0x0e, 0x36, 0x3c, 0x22, 0xf0, 0x86, 0x2c, 0xec, 0x28, 0xe6,
+	0x82, 0xc3, 0x45, 0xb5, 0x6e, 0x4b, 0x28, 0x5b, 0x5f, 0x5d, 0x6a, 0xf3, 0x36, 0x97, 0xeb, 0x8d,
+	0xf4, 0x9f, 0x6a, 0x5d, 0x3d, 0xa3, 0xf2, 0x70, 0x42, 0x72, 0x2a, 0x9f, 0x53, 0xa6, 0xd6, 0xad,
+	0xaf, 0x1a, 0xa8, 0xec, 0x50, 0x26, 0x48, 0x0c, 0x9f, 0x83, 0x2a, 0x65, 0x7b, 0x5d, 0x2c, 0x28,
+	0x67, 0xba, 0xb6, 0xa6, 0xd5, 0xab, 0x8e, 0x7d, 0x34, 0x30, 0x4b, 0x3f, 0x06, 0xe6, 0xbd, 0x36,
+	0x15, 0x9d, 0x9e, 0x67, 0xfb, 0x3c, 0x6c, 0x64, 0x84, 0xea, 0xe7, 0x61, 0x12, 0xec, 0x37, 0xc4,
+	0x61, 0x44, 0x12, 0xbb, 0x49, 0x7c, 0x34, 0x22, 0x80, 0xaf, 0xc1, 0x0d, 0xcc, 0x58, 0x0f, 0x77,
+	0xdd, 0x28, 0xe6, 0x07, 0x34, 0xa1, 0x9c, 0x25, 0xfa, 0x8c, 0x64, 0x7d, 0x36, 0x1d, 0xeb, 0x70,
+	0x60, 0xea, 0x87, 0x38, 0xec, 0x6e, 0x5a, 0x17, 0x08, 0x2d, 0xb4, 0xa0, 0xb0, 0xd6, 0x08, 0xfa,
+	0x56, 0x01, 0x95, 0x16, 0x8e, 0x71, 0x98, 0xc0, 0xbb, 0x00, 0xa4, 0x23, 0x72, 0x03, 0xc2, 0x78,
+	0xa8, 0x22, 0xa1, 0x6a, 0x8a, 0x34, 0x53, 0x00, 0xbe, 0xd3, 0xc0, 0xcd, 0xdc, 0xb0, 0x1b, 0x63,
+	0x41, 0x5c, 0xbf, 0x83, 0x59, 0x9b, 0x64, 0x3e, 0x77, 0xa7, 0xf6, 0x79, 0x47, 0xf9, 0x9c, 0x48,
+	0x6a, 0xa1, 0xc5, 0x1c, 0x47, 0x58, 0x90, 0x2d, 0x89, 0xc2, 0x7d, 0x50, 0x1b, 0xb5, 0x87, 0xb8,
+	0xaf, 0xcf, 0x4a, 0xed, 0xed, 0xa9, 0xb5, 0x97, 0x8a, 0xda, 0x21, 0xee, 0x5b, 0xe8, 0x7a, 0x5e,
+	0xef, 0xe0, 0x7e, 0x41, 0x8c, 0x32, 0xbd, 0xfc, 0xdf, 0xc4, 0x28, 0x3b, 0x27, 0x46, 0x19, 0x24,
+	0x60, 0xae, 0xcd, 0x71, 0xd7, 0xf5, 0x38, 0x0b, 0x48, 0xa0, 0x5f, 0x91, 0x52, 0xcd, 0xa9, 0xa5,
+	0xa0, 0x92, 0x1a, 0xa3, 0xb2, 0x10, 0x48, 0x2b, 0x47, 0x16, 0xd0, 0x01, 0xf3, 0x5e, 0x97, 0xfb,
+	0xfb, 0x89, 0x1b, 0x91, 0xd8, 0x3d, 0x24, 0x38, 0xd6, 0x2b, 0x6b, 0x5a, 0xbd, 0xec, 0xac, 0x0e,
+	0x07, 0xe6, 0xb2, 0xda, 0x5c, 0x68, 0xb0, 0x50, 0x4d, 0x21, 0x2d, 0x12, 0xbf, 0x24, 0x38, 0x86,
+	0xef, 0x35, 0xa0, 0x07, 0x34, 0x11, 0x31, 0xf5, 0x7a, 0x32, 0x4e, 0x14, 0xf3, 0x88, 0xc7, 0x42,
+	0x3e, 0xb4, 0x57, 0xd7, 0x66, 0xeb, 0x73, 0x8f, 0x1f, 0xd8, 0x13, 0x5e, 0x3a, 0xbb, 0x39, 0xb6,
+	0xa9, 0x95, 0xef, 0x71, 0xd6, 0xd3, 0x94, 0xc3, 0x81, 0x69, 0x2a, 0xf9, 0xcb, 0xa8, 0x2d, 0xb4,
+	0x12, 0x4c, 0x24, 0x48, 0xe0, 0x36, 0x58, 0x20, 0x11, 0xf7, 0x3b, 0x2e, 0x0d, 0x08, 0x13, 0x74,
+	0x8f, 0x92, 0x58, 0xbf, 0x26, 0x27, 0x78, 0x7b, 0x38, 0x30, 0x57, 0x14, 0x6f, 0xb1, 0xc3, 0x42,
+	0xf3, 0x12, 0x7a, 0x9a, 0x23, 0xe9, 0x74, 0x24, 0x34, 0x36, 0x9d, 0x6a, 0x71, 0x3a, 0x85, 0x06,
+	0x0b, 0xd5, 0x14, 0x92, 0x4d, 0x67, 0xb3, 0xfc, 0xe9, 0xb3, 0x59, 0xb2, 0xde, 0x6a, 0x60, 0x79,
+	0x72, 0x5c, 0xb8, 0x0c, 0x2a, 0x21, 0x0f, 0x7a, 0x5d, 0x92, 0xbd, 0x63, 0x59, 0x05, 0x77, 0x01,
+	0x18, 0xa5, 0xd5, 0x67, 0xfe, 0xe9, 0x4a, 0x19, 0x63, 0xb0, 0x3e, 0x6a, 0x00, 0xee, 0x48, 0xea,
+	0x71, 0x23, 0x97, 0xca, 0xfb, 0xa0, 0x82, 0x43, 0xde, 0x63, 0x42, 0x9f, 0x91, 0x47, 0x78, 0xeb,
+	0xec, 0x08, 0xd3, 0xcb, 0x30, 0x3f, 0xc2, 0x2d, 0x4e, 0x99, 0xf3, 0x28, 0x75, 0xf5, 0xe5, 0xa7,
+	0x59, 0xff, 0x0b, 0x57, 0xe9, 0x86, 0x04, 0x65, 0xd4, 0xd6, 0x1b, 0xb0, 0x90, 0xde, 0x9f, 0x45,
+	0x43, 0x1d, 0x42, 0xdb, 0x1d, 0x21, 0x0d, 0xcd, 0xa2, 0xac, 0x82, 0x2f, 0x40, 0x6d, 0xfc, 0xbc,
+	0x93, 0xcc, 0xd7, 0xfa, 0xc4, 0x47, 0xeb, 0x62, 0x50, 0xa7, 0x9c, 0xba, 0x44, 0xe7, 0x39, 0x9c,
+	0xad, 0xa3, 0x13, 0x43, 0x3b, 0x3e, 0x31, 0xb4, 0x5f, 0x27, 0x86, 0xf6, 0xe1, 0xd4, 0x28, 0x1d,
+	0x9f, 0x1a, 0xa5, 0xef, 0xa7, 0x46, 0xe9, 0xd5, 0xfd, 0x3f, 0x86, 0xe9, 0xab, 0xcf, 0x8b, 0xcc,
+	0xe4, 0x55, 0xe4, 0xd7, 0xe0, 0xc9, 0xef, 0x01, 0x00, 0xca, 0x19, 0x07, 0xa8, 0x7a, 0x06, 0x00,
+	0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -229,6 +436,32 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochsPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EpochsPerYear))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DistributionProportions) > 0 {
+		for iNdEx := len(m.DistributionProportions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionProportions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -284,6 +517,132 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DistributionProportion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionProportion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionProportion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Proportion.Size()
+		i -= size
+		if _, err := m.Proportion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -329,6 +688,71 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if len(m.DistributionProportions) > 0 {
+		for _, e := range m.DistributionProportions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.EpochsPerYear != 0 {
+		n += 1 + sovMint(uint64(m.EpochsPerYear))
+	}
+	return n
+}
+
+func (m *DistributionProportion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Proportion.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *ModuleDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -672,6 +1096,426 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionProportions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionProportions = append(m.DistributionProportions, DistributionProportion{})
+			if err := m.DistributionProportions[len(m.DistributionProportions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerYear", wireType)
+			}
+			m.EpochsPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionProportion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionProportion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionProportion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proportion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proportion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, ModuleDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -132,3 +132,23 @@ func BenchmarkNextAnnualProvisions(b *testing.B) {
 	}
 
 }
+
+func TestEpochMintingParams(t *testing.T) {
+	params := DefaultParams()
+	require.False(t, params.IsEpochMinting())
+	require.Equal(t, params.BlocksPerYear, params.MintsPerYear())
+
+	params.EpochIdentifier = "day"
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsEpochMinting())
+	require.Equal(t, params.EpochsPerYear, params.MintsPerYear())
+
+	minter := NewMinter(sdk.NewDecWithPrec(1, 1), sdk.NewDec(3650))
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 10), minter.EpochProvision(params))
+
+	params.EpochsPerYear = 0
+	require.Error(t, params.Validate())
+	params.EpochsPerYear = 365
+	params.EpochIdentifier = " day"
+	require.Error(t, params.Validate())
+}
@@ -19,8 +19,15 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+
+	KeyDistributionProportions = []byte("DistributionProportions")
+	KeyEpochIdentifier         = []byte("EpochIdentifier")
+	KeyEpochsPerYear           = []byte("EpochsPerYear")
 )
 
+// defaultEpochsPerYear assumes daily epochs.
+const defaultEpochsPerYear uint64 = 365
+
 // ParamTable for minting module.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -37,6 +44,7 @@ func NewParams(
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		EpochsPerYear:       defaultEpochsPerYear,
 	}
 }
 
@@ -49,6 +57,7 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		EpochsPerYear:       defaultEpochsPerYear,
 	}
 }
 
@@ -72,6 +81,15 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateDistributionProportions(p.DistributionProportions); err != nil {
+		return err
+	}
+	if err := validateEpochIdentifier(p.EpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateEpochsPerYear(p.EpochsPerYear); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
@@ -83,6 +101,23 @@ func (p Params) Validate() error {
 
 }
 
+// IsEpochMinting returns true if the coins are minted at the end of the epochs
+// of the epoch identifier rather than every block.
+func (p Params) IsEpochMinting() bool {
+	return p.EpochIdentifier != ""
+}
+
+// MintsPerYear returns the expected number of times the coins are minted per
+// year, i.e. the epochs per year when minting at the end of epochs and the
+// blocks per year otherwise.
+func (p Params) MintsPerYear() uint64 {
+	if p.IsEpochMinting() {
+		return p.EpochsPerYear
+	}
+
+	return p.BlocksPerYear
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -98,6 +133,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyDistributionProportions, &p.DistributionProportions, validateDistributionProportions),
+		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, validateEpochIdentifier),
+		paramtypes.NewParamSetPair(KeyEpochsPerYear, &p.EpochsPerYear, validateEpochsPerYear),
 	}
 }
 
@@ -193,3 +231,63 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateDistributionProportions(i interface{}) error {
+	v, ok := i.([]DistributionProportion)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(v) == 0 {
+		return nil
+	}
+
+	total := sdk.ZeroDec()
+	modules := make(map[string]bool, len(v))
+	for _, p := range v {
+		if strings.TrimSpace(p.Module) == "" {
+			return errors.New("distribution module cannot be blank")
+		}
+		if modules[p.Module] {
+			return fmt.Errorf("duplicate distribution module: %s", p.Module)
+		}
+		modules[p.Module] = true
+
+		if p.Proportion.IsNil() || !p.Proportion.IsPositive() {
+			return fmt.Errorf("distribution proportion of %s must be positive: %s", p.Module, p.Proportion)
+		}
+		total = total.Add(p.Proportion)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("distribution proportions must sum to one: %s", total)
+	}
+
+	return nil
+}
+
+func validateEpochIdentifier(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != strings.TrimSpace(v) {
+		return fmt.Errorf("epoch identifier cannot have leading or trailing spaces: %q", v)
+	}
+
+	return nil
+}
+
+func validateEpochsPerYear(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("epochs per year must be positive: %d", v)
+	}
+
+	return nil
+}
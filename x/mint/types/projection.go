@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxProjectionChunks is the maximum number of chunks of mints a projection is
+// computed in, the mints of a chunk being compounded at once.
+const maxProjectionChunks = 1000
+
+// Project projects the minter and the staking token supply after the given
+// number of mints, under the default inflation calculation with a constant
+// bonded ratio. The inflation rate changes linearly between its bounds, and
+// the supply is compounded by chunks of mints at the inflation rate of the
+// middle of the chunk, the truncation of the minted amounts being ignored.
+func (m Minter) Project(params Params, bondedRatio sdk.Dec, stakingSupply sdk.Int, mints uint64) (Minter, sdk.Int) {
+	mintsPerYear := sdk.NewDec(int64(params.MintsPerYear()))
+	// (1 - bondedRatio/GoalBonded) * InflationRateChange / MintsPerYear
+	change := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange).
+		Quo(mintsPerYear)
+
+	inflation := m.Inflation
+	supply := stakingSupply.ToDec()
+	chunks := uint64(maxProjectionChunks)
+	if mints < chunks {
+		chunks = mints
+	}
+
+	for c := uint64(0); c < chunks; c++ {
+		size := mints / chunks
+		if c < mints%chunks {
+			size++
+		}
+
+		middle := params.clampInflation(inflation.Add(change.MulInt64(int64(size + 1)).QuoInt64(2)))
+		supply = supply.Mul(sdk.OneDec().Add(middle.Quo(mintsPerYear)).Power(size))
+		inflation = params.clampInflation(inflation.Add(change.MulInt64(int64(size))))
+	}
+
+	if mints > 0 {
+		m.Inflation = inflation
+		m.AnnualProvisions = inflation.Mul(supply)
+	}
+
+	return m, supply.TruncateInt()
+}
+
+// clampInflation bounds the inflation rate by the min and max inflation.
+func (p Params) clampInflation(inflation sdk.Dec) sdk.Dec {
+	if inflation.GT(p.InflationMax) {
+		return p.InflationMax
+	}
+	if inflation.LT(p.InflationMin) {
+		return p.InflationMin
+	}
+
+	return inflation
+}
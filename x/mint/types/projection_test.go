@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulateMints mints step by step as BeginBlocker does.
+func simulateMints(minter Minter, params Params, bondedRatio sdk.Dec, supply sdk.Int, mints int) (Minter, sdk.Int) {
+	for i := 0; i < mints; i++ {
+		minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+		supply = supply.Add(minter.BlockProvision(params).Amount)
+	}
+
+	return minter, supply
+}
+
+func TestProject(t *testing.T) {
+	params := DefaultParams()
+	params.BlocksPerYear = 1000
+	minter := DefaultInitialMinter()
+	supply := sdk.NewInt(1_000_000_000_000)
+
+	projected, projectedSupply := minter.Project(params, sdk.NewDecWithPrec(50, 2), supply, 0)
+	require.Equal(t, minter, projected)
+	require.Equal(t, supply, projectedSupply)
+
+	tests := []struct {
+		name        string
+		bondedRatio sdk.Dec
+		mints       int
+	}{
+		{"increasing inflation", sdk.NewDecWithPrec(50, 2), 100},
+		{"decreasing inflation", sdk.NewDecWithPrec(90, 2), 100},
+		{"more mints than chunks", sdk.NewDecWithPrec(50, 2), 2500},
+		{"inflation reaching its max", sdk.ZeroDec(), 2500},
+		{"inflation reaching its min", sdk.OneDec(), 2500},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			expected, expectedSupply := simulateMints(minter, params, tc.bondedRatio, supply, tc.mints)
+			projected, projectedSupply := minter.Project(params, tc.bondedRatio, supply, uint64(tc.mints))
+
+			require.Equal(t, expected.Inflation, projected.Inflation)
+			// the projection ignores the truncation of the minted amounts
+			tolerance := expectedSupply.QuoRaw(1_000_000)
+			require.True(t, projectedSupply.Sub(expectedSupply).Abs().LTE(tolerance), "projected %s, expected %s", projectedSupply, expectedSupply)
+		})
+	}
+}
@@ -7,22 +7,27 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -71,6 +76,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_changed_height is the height at which the parameters last changed.
+	LastChangedHeight int64 `protobuf:"varint,2,opt,name=last_changed_height,json=lastChangedHeight,proto3" json:"last_changed_height,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -113,6 +120,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangedHeight() int64 {
+	if m != nil {
+		return m.LastChangedHeight
+	}
+	return 0
+}
+
 // QueryInflationRequest is the request type for the Query/Inflation RPC method.
 type QueryInflationRequest struct {
 }
@@ -268,6 +282,305 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryLastDistributionRequest is the request type for the
+// Query/LastDistribution RPC method.
+type QueryLastDistributionRequest struct {
+}
+
+func (m *QueryLastDistributionRequest) Reset()         { *m = QueryLastDistributionRequest{} }
+func (m *QueryLastDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastDistributionRequest) ProtoMessage()    {}
+func (*QueryLastDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryLastDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastDistributionRequest.Merge(m, src)
+}
+func (m *QueryLastDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastDistributionRequest proto.InternalMessageInfo
+
+// QueryLastDistributionResponse is the response type for the
+// Query/LastDistribution RPC method.
+type QueryLastDistributionResponse struct {
+	// distribution is the distribution of the coins minted last.
+	Distribution MintDistribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *QueryLastDistributionResponse) Reset()         { *m = QueryLastDistributionResponse{} }
+func (m *QueryLastDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastDistributionResponse) ProtoMessage()    {}
+func (*QueryLastDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryLastDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastDistributionResponse.Merge(m, src)
+}
+func (m *QueryLastDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryLastDistributionResponse) GetDistribution() MintDistribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return MintDistribution{}
+}
+
+// QueryNextMintRequest is the request type for the Query/NextMint RPC method.
+type QueryNextMintRequest struct {
+}
+
+func (m *QueryNextMintRequest) Reset()         { *m = QueryNextMintRequest{} }
+func (m *QueryNextMintRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextMintRequest) ProtoMessage()    {}
+func (*QueryNextMintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *QueryNextMintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextMintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextMintRequest.Merge(m, src)
+}
+func (m *QueryNextMintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextMintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextMintRequest proto.InternalMessageInfo
+
+// QueryNextMintResponse is the response type for the Query/NextMint RPC method.
+type QueryNextMintResponse struct {
+	// amount is the amount of coins minted next at the current inflation rate.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	// height is the height at which the coins are minted next, zero if they are
+	// minted at the end of an epoch.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the time at which the current epoch ends, if the coins are minted
+	// at the end of epochs and it is known.
+	Time *time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *QueryNextMintResponse) Reset()         { *m = QueryNextMintResponse{} }
+func (m *QueryNextMintResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextMintResponse) ProtoMessage()    {}
+func (*QueryNextMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{9}
+}
+func (m *QueryNextMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextMintResponse.Merge(m, src)
+}
+func (m *QueryNextMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextMintResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextMintResponse proto.InternalMessageInfo
+
+func (m *QueryNextMintResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *QueryNextMintResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryNextMintResponse) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// QueryInflationProjectionRequest is the request type for the
+// Query/InflationProjection RPC method. Exactly one of height and time must be
+// set.
+type QueryInflationProjectionRequest struct {
+	// height is the future height of the projection.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the future time of the projection.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *QueryInflationProjectionRequest) Reset()         { *m = QueryInflationProjectionRequest{} }
+func (m *QueryInflationProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionRequest) ProtoMessage()    {}
+func (*QueryInflationProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{10}
+}
+func (m *QueryInflationProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionRequest.Merge(m, src)
+}
+func (m *QueryInflationProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryInflationProjectionRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// QueryInflationProjectionResponse is the response type for the
+// Query/InflationProjection RPC method.
+type QueryInflationProjectionResponse struct {
+	// inflation is the projected minting inflation value.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// annual_provisions is the projected minting annual provisions value.
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	// staking_token_supply is the projected supply of the staking token.
+	StakingTokenSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=staking_token_supply,json=stakingTokenSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staking_token_supply"`
+	// mints is the number of times the coins are projected to be minted until
+	// the height or time.
+	Mints uint64 `protobuf:"varint,4,opt,name=mints,proto3" json:"mints,omitempty"`
+}
+
+func (m *QueryInflationProjectionResponse) Reset()         { *m = QueryInflationProjectionResponse{} }
+func (m *QueryInflationProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationProjectionResponse) ProtoMessage()    {}
+func (*QueryInflationProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{11}
+}
+func (m *QueryInflationProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationProjectionResponse.Merge(m, src)
+}
+func (m *QueryInflationProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryInflationProjectionResponse) GetMints() uint64 {
+	if m != nil {
+		return m.Mints
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +588,68 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryLastDistributionRequest)(nil), "cosmos.mint.v1beta1.QueryLastDistributionRequest")
+	proto.RegisterType((*QueryLastDistributionResponse)(nil), "cosmos.mint.v1beta1.QueryLastDistributionResponse")
+	proto.RegisterType((*QueryNextMintRequest)(nil), "cosmos.mint.v1beta1.QueryNextMintRequest")
+	proto.RegisterType((*QueryNextMintResponse)(nil), "cosmos.mint.v1beta1.QueryNextMintResponse")
+	proto.RegisterType((*QueryInflationProjectionRequest)(nil), "cosmos.mint.v1beta1.QueryInflationProjectionRequest")
+	proto.RegisterType((*QueryInflationProjectionResponse)(nil), "cosmos.mint.v1beta1.QueryInflationProjectionResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x13, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x84, 0x59,
-	0x95, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0xcd, 0x87, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x90,
-	0xc7, 0x49, 0x0f, 0x64, 0xe6, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x0f, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4, 0x20, 0x84, 0x01, 0x4a, 0x72, 0x5c, 0x32, 0x60, 0x7b, 0x1c,
-	0xf3, 0xf2, 0x4a, 0x13, 0x73, 0x02, 0x8a, 0xf2, 0xcb, 0x32, 0x8b, 0x41, 0xe1, 0x04, 0x73, 0x47,
-	0x0d, 0x97, 0x2c, 0x0e, 0x79, 0xa8, 0x73, 0xa2, 0xb9, 0x04, 0x13, 0xc1, 0x72, 0xf1, 0x05, 0x70,
-	0x49, 0x32, 0x9d, 0x25, 0x90, 0x88, 0x66, 0x89, 0xd1, 0x51, 0x66, 0x2e, 0x56, 0xb0, 0xf5, 0x42,
-	0x0d, 0x8c, 0x5c, 0x6c, 0x90, 0x10, 0x14, 0x52, 0xc7, 0x1a, 0xbc, 0x98, 0xd1, 0x25, 0xa5, 0x41,
-	0x58, 0x21, 0xc4, 0x13, 0x4a, 0xca, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x15, 0x92, 0xd6, 0xc7,
-	0x96, 0x2e, 0x20, 0x71, 0x25, 0xd4, 0xc3, 0xc8, 0xc5, 0x09, 0x8f, 0x0e, 0x21, 0x2d, 0xdc, 0x86,
-	0xa3, 0x47, 0xa6, 0x94, 0x36, 0x51, 0x6a, 0xa1, 0x6e, 0x51, 0x03, 0xbb, 0x45, 0x41, 0x48, 0x0e,
-	0xab, 0x5b, 0xe0, 0x31, 0x27, 0xb4, 0x92, 0x91, 0x4b, 0x00, 0x3d, 0x56, 0x84, 0x0c, 0x71, 0xdb,
-	0x84, 0x23, 0x86, 0xa5, 0x8c, 0x48, 0xd1, 0x02, 0x75, 0xa3, 0x1e, 0xd8, 0x8d, 0x1a, 0x42, 0x6a,
-	0x58, 0xdd, 0x88, 0x91, 0x1e, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x13, 0x6f, 0xda, 0xa8, 0x80, 0x18, 0x0c, 0x4e, 0x22, 0x49, 0x6c, 0xe0, 0xac, 0x69,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xed, 0x9c, 0x7b, 0x26, 0x04, 0x00, 0x00,
+	// 797 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x4f, 0xdb, 0x48,
+	0x18, 0xc6, 0x33, 0x21, 0x64, 0x97, 0x59, 0x0e, 0x30, 0x09, 0x6c, 0xd6, 0x80, 0x13, 0x65, 0xb5,
+	0x6c, 0x00, 0xad, 0xad, 0x64, 0x59, 0xad, 0xf6, 0xb8, 0x81, 0x43, 0x91, 0x68, 0x4b, 0x53, 0x4e,
+	0xed, 0x21, 0x9d, 0x84, 0xc1, 0x71, 0x49, 0x66, 0x4c, 0x66, 0x8c, 0x40, 0xea, 0x01, 0xf5, 0x56,
+	0xa9, 0x07, 0xa4, 0xde, 0x7b, 0xef, 0x07, 0xe0, 0x23, 0x54, 0xe2, 0x88, 0xd4, 0x4b, 0xd5, 0x03,
+	0xad, 0xa0, 0x1f, 0xa4, 0xf2, 0x78, 0x1c, 0x12, 0x63, 0xd3, 0xa4, 0x6a, 0x4f, 0xe0, 0x79, 0xde,
+	0x3f, 0x3f, 0xe6, 0x7d, 0xe7, 0x01, 0xe6, 0x9b, 0x8c, 0x77, 0x18, 0x37, 0x3b, 0x36, 0x15, 0xe6,
+	0x41, 0xb9, 0x41, 0x04, 0x2e, 0x9b, 0xfb, 0x2e, 0xe9, 0x1e, 0x19, 0x4e, 0x97, 0x09, 0x86, 0x32,
+	0x7e, 0x80, 0xe1, 0x05, 0x18, 0x2a, 0x40, 0xcb, 0x5a, 0xcc, 0x62, 0x52, 0x37, 0xbd, 0xdf, 0xfc,
+	0x50, 0x6d, 0xde, 0x62, 0xcc, 0x6a, 0x13, 0x13, 0x3b, 0xb6, 0x89, 0x29, 0x65, 0x02, 0x0b, 0x9b,
+	0x51, 0xae, 0xd4, 0xbc, 0x52, 0xe5, 0x57, 0xc3, 0xdd, 0x35, 0x85, 0xdd, 0x21, 0x5c, 0xe0, 0x8e,
+	0xa3, 0x02, 0x74, 0x85, 0xd2, 0xc0, 0x9c, 0xf4, 0x50, 0x9a, 0xcc, 0xa6, 0x21, 0x7d, 0x00, 0x55,
+	0x62, 0x49, 0xbd, 0x98, 0x85, 0xe8, 0x81, 0x07, 0xbe, 0x85, 0xbb, 0xb8, 0xc3, 0x6b, 0x64, 0xdf,
+	0x25, 0x5c, 0x14, 0x8f, 0x01, 0xcc, 0x0c, 0x1c, 0x73, 0x87, 0x51, 0x4e, 0xd0, 0x7f, 0x30, 0xed,
+	0xc8, 0x93, 0x1c, 0x28, 0x80, 0xd2, 0x2f, 0x95, 0x39, 0x23, 0xe2, 0x0f, 0x35, 0xfc, 0xa4, 0x6a,
+	0xea, 0xec, 0x22, 0x9f, 0xa8, 0xa9, 0x04, 0x64, 0xc0, 0x4c, 0x1b, 0x73, 0x51, 0x6f, 0xb6, 0x30,
+	0xb5, 0xc8, 0x4e, 0xbd, 0x45, 0x6c, 0xab, 0x25, 0x72, 0xc9, 0x02, 0x28, 0x8d, 0xd5, 0xa6, 0x3d,
+	0x69, 0xcd, 0x57, 0xee, 0x48, 0xa1, 0xf8, 0x2b, 0x9c, 0x91, 0x04, 0x1b, 0x74, 0xb7, 0x2d, 0xaf,
+	0x24, 0x60, 0xdb, 0x85, 0xb3, 0x61, 0x41, 0xd1, 0x6d, 0xc2, 0x09, 0x3b, 0x38, 0x94, 0x80, 0x93,
+	0x55, 0xc3, 0x63, 0xf8, 0x70, 0x91, 0x5f, 0xb4, 0x6c, 0xd1, 0x72, 0x1b, 0x46, 0x93, 0x75, 0x4c,
+	0x75, 0x23, 0xfe, 0x8f, 0xbf, 0xf8, 0xce, 0x9e, 0x29, 0x8e, 0x1c, 0xc2, 0x8d, 0x75, 0xd2, 0xac,
+	0x5d, 0x17, 0x28, 0xea, 0x70, 0x5e, 0xf6, 0xf9, 0x9f, 0x52, 0x17, 0xb7, 0xb7, 0xba, 0xec, 0xc0,
+	0xe6, 0xde, 0x64, 0x02, 0x8e, 0x67, 0x70, 0x21, 0x46, 0x57, 0x38, 0x8f, 0xe1, 0x34, 0x96, 0x5a,
+	0xdd, 0xe9, 0x89, 0xdf, 0x88, 0x35, 0x85, 0x43, 0x4d, 0x7a, 0x74, 0x9b, 0x98, 0x8b, 0x75, 0x9b,
+	0x8b, 0xae, 0xdd, 0x70, 0xfb, 0x6f, 0xc9, 0x81, 0x0b, 0x31, 0xba, 0xa2, 0xbb, 0x0f, 0x27, 0x77,
+	0xfa, 0xce, 0xd5, 0x40, 0xff, 0x88, 0x1c, 0xe8, 0x5d, 0x9b, 0x0e, 0x14, 0x51, 0xa3, 0x1d, 0x28,
+	0x50, 0x9c, 0x85, 0x59, 0xd9, 0xf1, 0x1e, 0x39, 0x14, 0x5e, 0x42, 0x40, 0xf2, 0x1a, 0xc0, 0x99,
+	0x90, 0xa0, 0x10, 0xfe, 0x85, 0x69, 0xdc, 0x61, 0x2e, 0x15, 0xaa, 0xf9, 0x6f, 0x41, 0x73, 0x6f,
+	0x99, 0x7b, 0xcd, 0xd7, 0x98, 0x1d, 0x34, 0x54, 0xe1, 0x68, 0x16, 0xa6, 0x07, 0xd6, 0x47, 0x7d,
+	0xa1, 0x55, 0x98, 0xf2, 0xde, 0x47, 0x6e, 0x4c, 0x96, 0xd3, 0x0c, 0xff, 0xf1, 0x18, 0xc1, 0xe3,
+	0x31, 0xb6, 0x83, 0xc7, 0x53, 0x4d, 0x9d, 0x7c, 0xcc, 0x83, 0x9a, 0x8c, 0x2e, 0x32, 0x98, 0x1f,
+	0x5c, 0xa8, 0xad, 0x2e, 0x7b, 0x4a, 0x9a, 0x7d, 0xb7, 0xd9, 0xd7, 0x10, 0x44, 0x36, 0x4c, 0x8e,
+	0xd4, 0xf0, 0x6d, 0x12, 0x16, 0xe2, 0x3b, 0xfe, 0x88, 0x65, 0x8e, 0xde, 0xc5, 0xe4, 0xf7, 0xd9,
+	0x45, 0xf4, 0x04, 0x66, 0xb9, 0xc0, 0x7b, 0x36, 0xb5, 0xea, 0x82, 0xed, 0x11, 0x5a, 0xe7, 0xae,
+	0xe3, 0xb4, 0x8f, 0xe4, 0x18, 0x26, 0x46, 0xaa, 0xbf, 0x41, 0x45, 0x0d, 0xa9, 0x5a, 0xdb, 0x5e,
+	0xa9, 0x87, 0xb2, 0x12, 0xca, 0xc2, 0x71, 0x6f, 0x21, 0x79, 0x2e, 0x55, 0x00, 0xa5, 0x54, 0xcd,
+	0xff, 0xa8, 0x9c, 0xfe, 0x04, 0xc7, 0xe5, 0x3d, 0xa2, 0x63, 0x00, 0xd3, 0xbe, 0xeb, 0xa0, 0x3f,
+	0x23, 0x37, 0xf8, 0xa6, 0xc7, 0x69, 0xa5, 0xaf, 0x07, 0xfa, 0xa3, 0x28, 0xfe, 0xfe, 0xfc, 0xdd,
+	0xe7, 0x57, 0xc9, 0x05, 0x34, 0x67, 0x46, 0x99, 0xa9, 0xf2, 0xb7, 0x97, 0x00, 0x4e, 0xf4, 0xe6,
+	0x89, 0x96, 0xe3, 0x8b, 0x87, 0x0d, 0x4d, 0x5b, 0x19, 0x2a, 0x56, 0xb1, 0x2c, 0x4a, 0x96, 0x02,
+	0xd2, 0x23, 0x59, 0xae, 0x07, 0xfe, 0x06, 0xc0, 0xa9, 0xb0, 0x33, 0xa1, 0x72, 0x7c, 0xa7, 0x18,
+	0x97, 0xd3, 0x2a, 0xa3, 0xa4, 0x28, 0x46, 0x43, 0x32, 0x96, 0xd0, 0x62, 0x24, 0xe3, 0x8d, 0x3d,
+	0x94, 0xac, 0x61, 0x9f, 0xba, 0x8d, 0x35, 0xc6, 0xf3, 0xb4, 0xca, 0x28, 0x29, 0x43, 0xb1, 0xca,
+	0xff, 0x58, 0xfd, 0x2e, 0x87, 0x5e, 0x00, 0xf8, 0x73, 0x60, 0x64, 0x68, 0x29, 0xbe, 0x61, 0xc8,
+	0x05, 0xb5, 0xe5, 0x61, 0x42, 0x87, 0x9a, 0x31, 0x25, 0x87, 0xa2, 0xee, 0x9d, 0xa0, 0x53, 0x00,
+	0x33, 0x11, 0x16, 0x82, 0x56, 0x87, 0x58, 0xa8, 0x1b, 0x1e, 0xa7, 0xfd, 0x33, 0x62, 0x96, 0x82,
+	0x2d, 0x4b, 0xd8, 0x15, 0xb4, 0x74, 0xfb, 0x42, 0xd6, 0x9d, 0x5e, 0x6a, 0x75, 0xed, 0xec, 0x52,
+	0x07, 0xe7, 0x97, 0x3a, 0xf8, 0x74, 0xa9, 0x83, 0x93, 0x2b, 0x3d, 0x71, 0x7e, 0xa5, 0x27, 0xde,
+	0x5f, 0xe9, 0x89, 0x47, 0x4b, 0xb7, 0x7a, 0xc4, 0xa1, 0x5f, 0x5b, 0x5a, 0x45, 0x23, 0x2d, 0x4d,
+	0xf6, 0xef, 0x2f, 0x03, 0x00, 0xb7, 0x67, 0x6d, 0x5c, 0x8c, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +670,15 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// LastDistribution returns the distribution of the coins minted last among
+	// the module accounts.
+	LastDistribution(ctx context.Context, in *QueryLastDistributionRequest, opts ...grpc.CallOption) (*QueryLastDistributionResponse, error)
+	// NextMint returns when the coins are minted next and their expected amount.
+	NextMint(ctx context.Context, in *QueryNextMintRequest, opts ...grpc.CallOption) (*QueryNextMintResponse, error)
+	// InflationProjection returns the projected inflation rate and staking token
+	// supply at a future height or time, under the current params and bonded
+	// ratio.
+	InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +716,33 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) LastDistribution(ctx context.Context, in *QueryLastDistributionRequest, opts ...grpc.CallOption) (*QueryLastDistributionResponse, error) {
+	out := new(QueryLastDistributionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/LastDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextMint(ctx context.Context, in *QueryNextMintRequest, opts ...grpc.CallOption) (*QueryNextMintResponse, error) {
+	out := new(QueryNextMintResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/NextMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationProjection(ctx context.Context, in *QueryInflationProjectionRequest, opts ...grpc.CallOption) (*QueryInflationProjectionResponse, error) {
+	out := new(QueryInflationProjectionResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/InflationProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +751,15 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// LastDistribution returns the distribution of the coins minted last among
+	// the module accounts.
+	LastDistribution(context.Context, *QueryLastDistributionRequest) (*QueryLastDistributionResponse, error)
+	// NextMint returns when the coins are minted next and their expected amount.
+	NextMint(context.Context, *QueryNextMintRequest) (*QueryNextMintResponse, error)
+	// InflationProjection returns the projected inflation rate and staking token
+	// supply at a future height or time, under the current params and bonded
+	// ratio.
+	InflationProjection(context.Context, *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +775,15 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) LastDistribution(ctx context.Context, req *QueryLastDistributionRequest) (*QueryLastDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastDistribution not implemented")
+}
+func (*UnimplementedQueryServer) NextMint(ctx context.Context, req *QueryNextMintRequest) (*QueryNextMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextMint not implemented")
+}
+func (*UnimplementedQueryServer) InflationProjection(ctx context.Context, req *QueryInflationProjectionRequest) (*QueryInflationProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationProjection not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +843,60 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LastDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/LastDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastDistribution(ctx, req.(*QueryLastDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/NextMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextMint(ctx, req.(*QueryNextMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/InflationProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationProjection(ctx, req.(*QueryInflationProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +913,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "LastDistribution",
+			Handler:    _Query_LastDistribution_Handler,
+		},
+		{
+			MethodName: "NextMint",
+			Handler:    _Query_NextMint_Handler,
+		},
+		{
+			MethodName: "InflationProjection",
+			Handler:    _Query_InflationProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -512,6 +973,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastChangedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -637,34 +1103,260 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLastDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryLastDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintQuery(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintQuery(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mints != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Mints))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.StakingTokenSupply.Size()
+		i -= size
+		if _, err := m.StakingTokenSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangedHeight))
+	}
 	return n
 }
 
@@ -708,6 +1400,87 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLastDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNextMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInflationProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StakingTokenSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Mints != 0 {
+		n += 1 + sovQuery(uint64(m.Mints))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,6 +1599,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedHeight", wireType)
+			}
+			m.LastChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1113,6 +1905,601 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLastDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTokenSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingTokenSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			m.Mints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
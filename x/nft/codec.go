@@ -0,0 +1,20 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterInterfaces registers the interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSend{},
+	)
+
+	// the apps register the implementations of their metadata
+	registry.RegisterInterface("cosmos.nft.v1beta1.ClassData", (*ClassData)(nil))
+	registry.RegisterInterface("cosmos.nft.v1beta1.NFTData", (*NFTData)(nil))
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
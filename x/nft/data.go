@@ -0,0 +1,89 @@
+package nft
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ClassData is the app specific metadata of a class, packed in its data field.
+// Apps extend the module by registering their implementations with the
+// interface registry.
+type ClassData interface {
+	proto.Message
+
+	// ValidateBasic does a stateless check of the metadata of the class.
+	ValidateBasic() error
+}
+
+// NFTData is the app specific metadata of an nft, packed in its data field.
+// Apps extend the module by registering their implementations with the
+// interface registry.
+type NFTData interface {
+	proto.Message
+
+	// ValidateBasic does a stateless check of the metadata of the nft.
+	ValidateBasic() error
+}
+
+var (
+	_ types.UnpackInterfacesMessage = Class{}
+	_ types.UnpackInterfacesMessage = NFT{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (class Class) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if class.Data == nil {
+		return nil
+	}
+
+	var data ClassData
+	return unpacker.UnpackAny(class.Data, &data)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (nft NFT) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if nft.Data == nil {
+		return nil
+	}
+
+	var data NFTData
+	return unpacker.UnpackAny(nft.Data, &data)
+}
+
+// ValidateBasic performs a stateless validation of the class: of its id and
+// of its metadata, which must have been unpacked.
+func (class Class) ValidateBasic() error {
+	if err := ValidateClassID(class.Id); err != nil {
+		return err
+	}
+
+	if class.Data == nil {
+		return nil
+	}
+	if data, ok := class.Data.GetCachedValue().(ClassData); ok {
+		return data.ValidateBasic()
+	}
+
+	return nil
+}
+
+// ValidateBasic performs a stateless validation of the nft: of its ids and of
+// its metadata, which must have been unpacked.
+func (nft NFT) ValidateBasic() error {
+	if err := ValidateClassID(nft.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(nft.Id); err != nil {
+		return err
+	}
+
+	if nft.Data == nil {
+		return nil
+	}
+	if data, ok := nft.Data.GetCachedValue().(NFTData); ok {
+		return data.ValidateBasic()
+	}
+
+	return nil
+}
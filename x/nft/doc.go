@@ -0,0 +1,11 @@
+/*
+Package nft implements a minimal on-chain representation of non-fungible
+tokens: classes of nfts, and nfts owned by accounts.
+
+The nft classes are created and the nfts minted and burnt by the other modules
+of an app through the keeper, the module deciding who mints and burns what. The
+owners send their nfts with MsgSend. The app specific metadata of the classes
+and nfts is packed in their data fields, by implementations of the ClassData
+and NFTData interfaces registered by the app.
+*/
+package nft
@@ -0,0 +1,15 @@
+package nft
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/nft module sentinel errors
+var (
+	ErrClassExists    = sdkerrors.Register(ModuleName, 3, "nft class already exists")
+	ErrClassNotExists = sdkerrors.Register(ModuleName, 4, "nft class does not exist")
+	ErrNFTExists      = sdkerrors.Register(ModuleName, 5, "nft already exists")
+	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 6, "nft does not exist")
+	ErrInvalidID      = sdkerrors.Register(ModuleName, 7, "invalid id")
+	ErrUnauthorized   = sdkerrors.Register(ModuleName, 8, "unauthorized")
+)
@@ -0,0 +1,82 @@
+package nft
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(classes []*Class, entries []*Entry) *GenesisState {
+	return &GenesisState{
+		Classes: classes,
+		Entries: entries,
+	}
+}
+
+// DefaultGenesisState returns default state for nft module.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{}
+}
+
+// ValidateGenesis checks that the classes and nfts of the genesis state are
+// valid and unique, and that the nfts belong to classes of the genesis state.
+func ValidateGenesis(data GenesisState) error {
+	classes := make(map[string]bool, len(data.Classes))
+	for _, class := range data.Classes {
+		if err := class.ValidateBasic(); err != nil {
+			return err
+		}
+		if classes[class.Id] {
+			return sdkerrors.Wrapf(ErrClassExists, "duplicate class %s", class.Id)
+		}
+		classes[class.Id] = true
+	}
+
+	nfts := make(map[string]bool)
+	for _, entry := range data.Entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return err
+		}
+
+		for _, nft := range entry.Nfts {
+			if err := nft.ValidateBasic(); err != nil {
+				return err
+			}
+			if !classes[nft.ClassId] {
+				return sdkerrors.Wrap(ErrClassNotExists, nft.ClassId)
+			}
+
+			key := fmt.Sprintf("%s/%s", nft.ClassId, nft.Id)
+			if nfts[key] {
+				return sdkerrors.Wrapf(ErrNFTExists, "duplicate nft %s of class %s", nft.Id, nft.ClassId)
+			}
+			nfts[key] = true
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, class := range data.Classes {
+		if err := class.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range data.Entries {
+		for _, nft := range entry.Nfts {
+			if err := nft.UnpackInterfaces(unpacker); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
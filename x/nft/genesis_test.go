@@ -0,0 +1,58 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	class := &nft.Class{Id: "kitty", Name: "Crypto Kitty"}
+	kitty1 := &nft.NFT{ClassId: "kitty", Id: "kitty1"}
+
+	cases := map[string]struct {
+		genesis *nft.GenesisState
+		valid   bool
+	}{
+		"default": {
+			genesis: nft.DefaultGenesisState(),
+			valid:   true,
+		},
+		"valid": {
+			genesis: nft.NewGenesisState([]*nft.Class{class}, []*nft.Entry{{Owner: sender.String(), Nfts: []*nft.NFT{kitty1}}}),
+			valid:   true,
+		},
+		"invalid class id": {
+			genesis: nft.NewGenesisState([]*nft.Class{{Id: "k"}}, nil),
+		},
+		"duplicate class": {
+			genesis: nft.NewGenesisState([]*nft.Class{class, class}, nil),
+		},
+		"invalid owner": {
+			genesis: nft.NewGenesisState([]*nft.Class{class}, []*nft.Entry{{Owner: "owner", Nfts: []*nft.NFT{kitty1}}}),
+		},
+		"unknown class": {
+			genesis: nft.NewGenesisState(nil, []*nft.Entry{{Owner: sender.String(), Nfts: []*nft.NFT{kitty1}}}),
+		},
+		"duplicate nft": {
+			genesis: nft.NewGenesisState([]*nft.Class{class}, []*nft.Entry{
+				{Owner: sender.String(), Nfts: []*nft.NFT{kitty1}},
+				{Owner: receiver.String(), Nfts: []*nft.NFT{kitty1}},
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := nft.ValidateGenesis(*tc.genesis)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
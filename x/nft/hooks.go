@@ -0,0 +1,56 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTHooks are the event hooks of the nft module, called by the keeper when
+// the nfts are minted, burned and transferred, e.g. by modules paying
+// royalties, staking nfts or indexing them.
+type NFTHooks interface {
+	// BeforeTransfer is called before an nft is transferred from sender to
+	// receiver. The transfer fails with the error it returns.
+	BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	// AfterMint is called once an nft is minted to its owner, the nfts of the
+	// genesis included.
+	AfterMint(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress)
+	// AfterBurn is called once an nft of owner is burned.
+	AfterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress)
+}
+
+var _ NFTHooks = MultiNFTHooks{}
+
+// MultiNFTHooks combines multiple nft hooks, all of them being called in
+// order.
+type MultiNFTHooks []NFTHooks
+
+// NewMultiNFTHooks returns the combination of the hooks.
+func NewMultiNFTHooks(hooks ...NFTHooks) MultiNFTHooks {
+	return hooks
+}
+
+// BeforeTransfer calls BeforeTransfer of all the hooks, returning the first
+// error.
+func (h MultiNFTHooks) BeforeTransfer(ctx sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterMint calls AfterMint of all the hooks.
+func (h MultiNFTHooks) AfterMint(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterMint(ctx, classID, nftID, owner)
+	}
+}
+
+// AfterBurn calls AfterBurn of all the hooks.
+func (h MultiNFTHooks) AfterBurn(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	for i := range h {
+		h[i].AfterBurn(ctx, classID, nftID, owner)
+	}
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// SaveClass defines a method for creating a new nft class
+func (k Keeper) SaveClass(ctx sdk.Context, class nft.Class) error {
+	if err := k.validateClass(class); err != nil {
+		return err
+	}
+	if k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(nft.ErrClassExists, class.Id)
+	}
+
+	k.setClass(ctx, class)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventSaveClass{ClassId: class.Id})
+}
+
+// UpdateClass defines a method for updating an exist nft class
+func (k Keeper) UpdateClass(ctx sdk.Context, class nft.Class) error {
+	if err := k.validateClass(class); err != nil {
+		return err
+	}
+	if !k.HasClass(ctx, class.Id) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, class.Id)
+	}
+
+	k.setClass(ctx, class)
+	return nil
+}
+
+// GetClass defines a method for returning the class information of the specified id
+func (k Keeper) GetClass(ctx sdk.Context, classID string) (nft.Class, bool) {
+	var class nft.Class
+	bz := ctx.KVStore(k.storeKey).Get(nft.ClassStoreKey(classID))
+	if bz == nil {
+		return class, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &class)
+	return class, true
+}
+
+// GetClasses defines a method for returning all classes information
+func (k Keeper) GetClasses(ctx sdk.Context) (classes []*nft.Class) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), nft.ClassKey).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var class nft.Class
+		k.cdc.MustUnmarshal(iterator.Value(), &class)
+		classes = append(classes, &class)
+	}
+
+	return classes
+}
+
+// HasClass determines whether the specified classID exist
+func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
+	return ctx.KVStore(k.storeKey).Has(nft.ClassStoreKey(classID))
+}
+
+// GetTotalSupply returns the number of all nfts under the specified classID
+func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(nft.ClassTotalSupplyKey(classID))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setClass(ctx sdk.Context, class nft.Class) {
+	ctx.KVStore(k.storeKey).Set(nft.ClassStoreKey(class.Id), k.cdc.MustMarshal(&class))
+}
+
+func (k Keeper) setTotalSupply(ctx sdk.Context, classID string, supply uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if supply == 0 {
+		store.Delete(nft.ClassTotalSupplyKey(classID))
+		return
+	}
+
+	store.Set(nft.ClassTotalSupplyKey(classID), sdk.Uint64ToBigEndian(supply))
+}
+
+// validateClass unpacks the metadata of the class, which must be of a type
+// registered by the app, and validates the class.
+func (k Keeper) validateClass(class nft.Class) error {
+	if err := class.UnpackInterfaces(k.cdc); err != nil {
+		return err
+	}
+
+	return class.ValidateBasic()
+}
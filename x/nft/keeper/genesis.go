@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// InitGenesis new nft genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
+	for _, class := range data.Classes {
+		if err := k.SaveClass(ctx, *class); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, entry := range data.Entries {
+		for _, token := range entry.Nfts {
+			owner, err := sdk.AccAddressFromBech32(entry.Owner)
+			if err != nil {
+				panic(err)
+			}
+
+			if err := k.Mint(ctx, *token, owner); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
+	classes := k.GetClasses(ctx)
+	if len(classes) == 0 {
+		return nft.DefaultGenesisState()
+	}
+
+	// the nfts are grouped by owner, in the order of the classes
+	entries := make(map[string]*nft.Entry)
+	var owners []string
+	for _, class := range classes {
+		for _, token := range k.GetNFTsOfClass(ctx, class.Id) {
+			token := token
+			owner := k.GetOwner(ctx, token.ClassId, token.Id).String()
+			if _, ok := entries[owner]; !ok {
+				entries[owner] = &nft.Entry{Owner: owner}
+				owners = append(owners, owner)
+			}
+			entries[owner].Nfts = append(entries[owner].Nfts, &token)
+		}
+	}
+
+	genesis := nft.NewGenesisState(classes, nil)
+	for _, owner := range owners {
+		genesis.Entries = append(genesis.Entries, entries[owner])
+	}
+
+	return genesis
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var _ nft.QueryServer = Keeper{}
+
+// Balance return the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
+func (k Keeper) Balance(goCtx context.Context, r *nft.QueryBalanceRequest) (*nft.QueryBalanceResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &nft.QueryBalanceResponse{Amount: k.GetBalance(ctx, r.ClassId, owner)}, nil
+}
+
+// Owner return the owner of the NFT based on its class and id, same as ownerOf in ERC721
+func (k Keeper) Owner(goCtx context.Context, r *nft.QueryOwnerRequest) (*nft.QueryOwnerResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner := k.GetOwner(ctx, r.ClassId, r.Id)
+	if owner.Empty() {
+		return nil, status.Errorf(codes.NotFound, "nft %s of class %s not found", r.Id, r.ClassId)
+	}
+
+	return &nft.QueryOwnerResponse{Owner: owner.String()}, nil
+}
+
+// Supply return the number of NFTs from the given class, same as totalSupply of ERC721.
+func (k Keeper) Supply(goCtx context.Context, r *nft.QuerySupplyRequest) (*nft.QuerySupplyResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &nft.QuerySupplyResponse{Amount: k.GetTotalSupply(ctx, r.ClassId)}, nil
+}
+
+// NFTs return all NFTs of a given class or owner, at least one of the two
+// being set, similar to tokenByIndex in ERC721Enumerable
+func (k Keeper) NFTs(goCtx context.Context, r *nft.QueryNFTsRequest) (*nft.QueryNFTsResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if r.ClassId == "" && r.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "must provide at least one of class id and owner")
+	}
+
+	var owner sdk.AccAddress
+	if r.ClassId != "" {
+		if err := nft.ValidateClassID(r.ClassId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if r.Owner != "" {
+		var err error
+		if owner, err = sdk.AccAddressFromBech32(r.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := ctx.KVStore(k.storeKey)
+
+	var nfts []*nft.NFT
+	var onResult func(key, value []byte) error
+	var nftStore prefix.Store
+	switch {
+	case owner.Empty():
+		nftStore = prefix.NewStore(store, nft.NFTStoreKey(r.ClassId))
+		onResult = func(_, value []byte) error {
+			var token nft.NFT
+			if err := k.cdc.Unmarshal(value, &token); err != nil {
+				return err
+			}
+			nfts = append(nfts, &token)
+			return nil
+		}
+	case r.ClassId == "":
+		nftStore = prefix.NewStore(store, nft.NFTOfOwnerStoreKey(owner))
+		onResult = func(key, _ []byte) error {
+			classID, nftID := nft.ParseNFTOfOwnerKey(key)
+			if token, found := k.GetNFT(ctx, classID, nftID); found {
+				nfts = append(nfts, &token)
+			}
+			return nil
+		}
+	default:
+		nftStore = prefix.NewStore(store, nft.NFTOfClassByOwnerStoreKey(owner, r.ClassId))
+		onResult = func(key, _ []byte) error {
+			if token, found := k.GetNFT(ctx, r.ClassId, string(key)); found {
+				nfts = append(nfts, &token)
+			}
+			return nil
+		}
+	}
+
+	pageRes, err := query.Paginate(nftStore, r.Pagination, onResult)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &nft.QueryNFTsResponse{Nfts: nfts, Pagination: pageRes}, nil
+}
+
+// NFT return an NFT based on its class and id.
+func (k Keeper) NFT(goCtx context.Context, r *nft.QueryNFTRequest) (*nft.QueryNFTResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	token, found := k.GetNFT(ctx, r.ClassId, r.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "nft %s of class %s not found", r.Id, r.ClassId)
+	}
+
+	return &nft.QueryNFTResponse{Nft: &token}, nil
+}
+
+// Class return an NFT class based on its id
+func (k Keeper) Class(goCtx context.Context, r *nft.QueryClassRequest) (*nft.QueryClassResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, found := k.GetClass(ctx, r.ClassId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "class %s not found", r.ClassId)
+	}
+
+	return &nft.QueryClassResponse{Class: &class}, nil
+}
+
+// Classes return all NFT classes
+func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft.QueryClassesResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	classStore := prefix.NewStore(ctx.KVStore(k.storeKey), nft.ClassKey)
+
+	var classes []*nft.Class
+	pageRes, err := query.Paginate(classStore, r.Pagination, func(_, value []byte) error {
+		var class nft.Class
+		if err := k.cdc.Unmarshal(value, &class); err != nil {
+			return err
+		}
+		classes = append(classes, &class)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &nft.QueryClassesResponse{Classes: classes, Pagination: pageRes}, nil
+}
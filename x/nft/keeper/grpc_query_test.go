@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *KeeperTestSuite) TestQueries() {
+	ctx := gocontext.Background()
+	class := s.saveClass()
+	nft1 := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	nft2 := nft.NFT{ClassId: testClassID, Id: testID + "2", Uri: testURI}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft1, s.addrs[0]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft2, s.addrs[1]))
+
+	balance, err := s.queryClient.Balance(ctx, &nft.QueryBalanceRequest{ClassId: testClassID, Owner: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), balance.Amount)
+	_, err = s.queryClient.Balance(ctx, &nft.QueryBalanceRequest{ClassId: testClassID})
+	s.Require().Error(err)
+
+	owner, err := s.queryClient.Owner(ctx, &nft.QueryOwnerRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[0].String(), owner.Owner)
+	_, err = s.queryClient.Owner(ctx, &nft.QueryOwnerRequest{ClassId: testClassID, Id: "unknown"})
+	s.Require().Error(err)
+
+	supply, err := s.queryClient.Supply(ctx, &nft.QuerySupplyRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), supply.Amount)
+
+	token, err := s.queryClient.NFT(ctx, &nft.QueryNFTRequest{ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().Equal(&nft1, token.Nft)
+	_, err = s.queryClient.NFT(ctx, &nft.QueryNFTRequest{ClassId: testClassID, Id: "unknown"})
+	s.Require().Error(err)
+
+	classRes, err := s.queryClient.Class(ctx, &nft.QueryClassRequest{ClassId: testClassID})
+	s.Require().NoError(err)
+	s.Require().Equal(&class, classRes.Class)
+	_, err = s.queryClient.Class(ctx, &nft.QueryClassRequest{ClassId: "unknown"})
+	s.Require().Error(err)
+
+	classes, err := s.queryClient.Classes(ctx, &nft.QueryClassesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]*nft.Class{&class}, classes.Classes)
+}
+
+func (s *KeeperTestSuite) TestQueryNFTs() {
+	ctx := gocontext.Background()
+	s.saveClass()
+	otherClass := nft.Class{Id: "puppy"}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, otherClass))
+
+	nft1 := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	nft2 := nft.NFT{ClassId: testClassID, Id: testID + "2", Uri: testURI}
+	nft3 := nft.NFT{ClassId: otherClass.Id, Id: "puppy1", Uri: testURI}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft1, s.addrs[0]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft2, s.addrs[1]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft3, s.addrs[0]))
+
+	testCases := []struct {
+		name   string
+		req    *nft.QueryNFTsRequest
+		expErr bool
+		exp    []*nft.NFT
+	}{
+		{"neither class nor owner", &nft.QueryNFTsRequest{}, true, nil},
+		{"invalid owner", &nft.QueryNFTsRequest{Owner: "owner"}, true, nil},
+		{"class", &nft.QueryNFTsRequest{ClassId: testClassID}, false, []*nft.NFT{&nft1, &nft2}},
+		{"owner", &nft.QueryNFTsRequest{Owner: s.addrs[0].String()}, false, []*nft.NFT{&nft1, &nft3}},
+		{"class and owner", &nft.QueryNFTsRequest{ClassId: testClassID, Owner: s.addrs[0].String()}, false, []*nft.NFT{&nft1}},
+		{"paginated", &nft.QueryNFTsRequest{ClassId: testClassID, Pagination: &query.PageRequest{Limit: 1}}, false, []*nft.NFT{&nft1}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.NFTs(ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.exp, res.Nfts)
+		})
+	}
+}
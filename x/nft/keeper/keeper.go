@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// Keeper of the nft store. The other modules of the app create the classes and
+// mint, burn and update the nfts with it.
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey sdk.StoreKey
+	hooks    nft.NFTHooks
+}
+
+// NewKeeper creates a new nft Keeper instance. The codec must have the
+// implementations of the nft.ClassData and nft.NFTData interfaces of the app
+// registered.
+func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		cdc:      cdc,
+		storeKey: storeKey,
+	}
+}
+
+// SetHooks sets the hooks called when the nfts are minted, burned and
+// transferred.
+func (k *Keeper) SetHooks(hooks nft.NFTHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set nft hooks twice")
+	}
+
+	k.hooks = hooks
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", nft.ModuleName))
+}
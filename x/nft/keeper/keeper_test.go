@@ -0,0 +1,317 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+const (
+	testClassID          = "kitty"
+	testClassName        = "Crypto Kitty"
+	testClassSymbol      = "kitty"
+	testClassDescription = "Crypto Kitty"
+	testClassURI         = "class uri"
+	testID               = "kitty1"
+	testURI              = "kitty uri"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	queryClient nft.QueryClient
+	msgSrvr     nft.MsgServer
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// an app extends the nfts with its own metadata
+	app.InterfaceRegistry().RegisterImplementations((*nft.NFTData)(nil), &testdata.TestMsg{})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	nft.RegisterQueryServer(queryHelper, app.NFTKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+	s.queryClient = nft.NewQueryClient(queryHelper)
+	s.msgSrvr = keeper.NewMsgServerImpl(app.NFTKeeper)
+}
+
+func (s *KeeperTestSuite) TestSaveClass() {
+	except := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+	}
+	err := s.app.NFTKeeper.SaveClass(s.ctx, except)
+	s.Require().NoError(err)
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(except, actual)
+
+	classes := s.app.NFTKeeper.GetClasses(s.ctx)
+	s.Require().EqualValues([]*nft.Class{&except}, classes)
+
+	// the classes are unique
+	err = s.app.NFTKeeper.SaveClass(s.ctx, except)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+
+	// and their ids valid
+	err = s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "1kitty"})
+	s.Require().ErrorIs(err, nft.ErrInvalidID)
+}
+
+func (s *KeeperTestSuite) TestUpdateClass() {
+	class := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+	}
+	err := s.app.NFTKeeper.UpdateClass(s.ctx, class)
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+
+	class.Name = "My Crypto Kitty"
+	s.Require().NoError(s.app.NFTKeeper.UpdateClass(s.ctx, class))
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(class, actual)
+}
+
+func (s *KeeperTestSuite) TestMint() {
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+
+	// the class must exist
+	err := s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+
+	s.saveClass()
+	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
+	s.Require().NoError(err)
+
+	// test GetNFT
+	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(expNFT, actNFT)
+
+	// test GetOwner
+	owner := s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)
+	s.Require().True(s.addrs[0].Equals(owner))
+
+	// test GetNFTsOfClass and GetNFTsOfClassByOwner
+	s.Require().EqualValues([]nft.NFT{expNFT}, s.app.NFTKeeper.GetNFTsOfClass(s.ctx, testClassID))
+	s.Require().EqualValues([]nft.NFT{expNFT}, s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[0]))
+	s.Require().Empty(s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[1]))
+
+	// test GetBalance and GetTotalSupply
+	s.Require().EqualValues(uint64(1), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(uint64(1), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	// the nfts are unique
+	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNFTExists)
+
+	expNFT2 := nft.NFT{ClassId: testClassID, Id: testID + "2", Uri: testURI + "2"}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT2, s.addrs[0]))
+	s.Require().EqualValues(uint64(2), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(uint64(2), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *KeeperTestSuite) TestMintWithData() {
+	s.saveClass()
+
+	// the metadata must be of a type registered by the app
+	data, err := codectypes.NewAnyWithValue(&testdata.TestMsg{Signers: []string{s.addrs[0].String()}})
+	s.Require().NoError(err)
+	token := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI, Data: data}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, token, s.addrs[0]))
+
+	unregistered, err := codectypes.NewAnyWithValue(&testdata.Dog{Name: "Spot"})
+	s.Require().NoError(err)
+	token = nft.NFT{ClassId: testClassID, Id: testID + "2", Uri: testURI, Data: unregistered}
+	s.Require().Error(s.app.NFTKeeper.Mint(s.ctx, token, s.addrs[0]))
+}
+
+func (s *KeeperTestSuite) TestBurn() {
+	s.saveClass()
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	s.Require().NoError(s.app.NFTKeeper.Burn(s.ctx, testClassID, testID))
+
+	_, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().False(has)
+	s.Require().Empty(s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Empty(s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(uint64(0), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(uint64(0), s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	err := s.app.NFTKeeper.Burn(s.ctx, testClassID, testID)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *KeeperTestSuite) TestUpdate() {
+	s.saveClass()
+	myNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+
+	err := s.app.NFTKeeper.Update(s.ctx, myNFT)
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, myNFT, s.addrs[0]))
+
+	myNFT.Uri = "updated"
+	s.Require().NoError(s.app.NFTKeeper.Update(s.ctx, myNFT))
+
+	actual, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().EqualValues(myNFT, actual)
+}
+
+func (s *KeeperTestSuite) TestTransfer() {
+	s.saveClass()
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.app.NFTKeeper.Transfer(ctx, testClassID, testID, s.addrs[1]))
+
+	// the transfers made by other modules through the keeper are emitted too
+	events := ctx.EventManager().ABCIEvents()
+	s.Require().Len(events, 1)
+	event, err := sdk.ParseTypedEvent(events[0])
+	s.Require().NoError(err)
+	s.Require().Equal(&nft.EventSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[0].String(),
+		Receiver: s.addrs[1].String(),
+	}, event)
+
+	owner := s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)
+	s.Require().True(s.addrs[1].Equals(owner))
+	s.Require().EqualValues(uint64(0), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[0]))
+	s.Require().EqualValues(uint64(1), s.app.NFTKeeper.GetBalance(s.ctx, testClassID, s.addrs[1]))
+	s.Require().EqualValues([]nft.NFT{expNFT}, s.app.NFTKeeper.GetNFTsOfClassByOwner(s.ctx, testClassID, s.addrs[1]))
+
+	err = s.app.NFTKeeper.Transfer(s.ctx, testClassID, testID+"2", s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	class := s.saveClass()
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	expGenesis := &nft.GenesisState{
+		Classes: []*nft.Class{&class},
+		Entries: []*nft.Entry{{
+			Owner: s.addrs[0].String(),
+			Nfts:  []*nft.NFT{&expNFT},
+		}},
+	}
+	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(expGenesis, genesis)
+}
+
+func (s *KeeperTestSuite) TestInitGenesis() {
+	class := nft.Class{Id: testClassID, Name: testClassName}
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	expGenesis := &nft.GenesisState{
+		Classes: []*nft.Class{&class},
+		Entries: []*nft.Entry{{
+			Owner: s.addrs[0].String(),
+			Nfts:  []*nft.NFT{&expNFT},
+		}},
+	}
+	s.app.NFTKeeper.InitGenesis(s.ctx, expGenesis)
+
+	actual, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().EqualValues(class, actual)
+	s.Require().True(s.addrs[0].Equals(s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)))
+	s.Require().Equal(expGenesis, s.app.NFTKeeper.ExportGenesis(s.ctx))
+}
+
+func (s *KeeperTestSuite) TestHooks() {
+	s.saveClass()
+	hooks := &recordingHooks{}
+	k := s.app.NFTKeeper
+	k.SetHooks(nft.NewMultiNFTHooks(hooks))
+	s.Require().Panics(func() { k.SetHooks(hooks) })
+
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	s.Require().NoError(k.Mint(s.ctx, expNFT, s.addrs[0]))
+	s.Require().NoError(k.Transfer(s.ctx, testClassID, testID, s.addrs[1]))
+
+	// the transfers rejected by a hook fail
+	hooks.transferErr = nft.ErrNFTNotExists
+	s.Require().ErrorIs(k.Transfer(s.ctx, testClassID, testID, s.addrs[2]), nft.ErrNFTNotExists)
+	s.Require().True(s.addrs[1].Equals(k.GetOwner(s.ctx, testClassID, testID)))
+
+	s.Require().NoError(k.Burn(s.ctx, testClassID, testID))
+	s.Require().Equal([]string{
+		fmt.Sprintf("mint %s/%s to %s", testClassID, testID, s.addrs[0]),
+		fmt.Sprintf("transfer %s/%s from %s to %s", testClassID, testID, s.addrs[0], s.addrs[1]),
+		fmt.Sprintf("transfer %s/%s from %s to %s", testClassID, testID, s.addrs[1], s.addrs[2]),
+		fmt.Sprintf("burn %s/%s of %s", testClassID, testID, s.addrs[1]),
+	}, hooks.calls)
+}
+
+// recordingHooks records the calls of the nft hooks, failing the transfers
+// with transferErr.
+type recordingHooks struct {
+	calls       []string
+	transferErr error
+}
+
+func (h *recordingHooks) BeforeTransfer(_ sdk.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	h.calls = append(h.calls, fmt.Sprintf("transfer %s/%s from %s to %s", classID, nftID, sender, receiver))
+	return h.transferErr
+}
+
+func (h *recordingHooks) AfterMint(_ sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	h.calls = append(h.calls, fmt.Sprintf("mint %s/%s to %s", classID, nftID, owner))
+}
+
+func (h *recordingHooks) AfterBurn(_ sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	h.calls = append(h.calls, fmt.Sprintf("burn %s/%s of %s", classID, nftID, owner))
+}
+
+func (s *KeeperTestSuite) saveClass() nft.Class {
+	class := nft.Class{
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+	}
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, class))
+	return class
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// Send sends an nft of the sender to the receiver.
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(nft.ErrUnauthorized, "%s is not the owner of nft %s of class %s", msg.Sender, msg.Id, msg.ClassId)
+	}
+
+	if err := k.Transfer(ctx, msg.ClassId, msg.Id, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgSendResponse{}, nil
+}
@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *KeeperTestSuite) TestSend() {
+	s.saveClass()
+	expNFT := nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0]))
+
+	testCases := []struct {
+		msg    *nft.MsgSend
+		expErr error
+	}{
+		{nft.NewMsgSend(testClassID, testID+"2", s.addrs[0], s.addrs[1]), nft.ErrUnauthorized},
+		{nft.NewMsgSend(testClassID, testID, s.addrs[1], s.addrs[2]), nft.ErrUnauthorized},
+		{nft.NewMsgSend(testClassID, testID, s.addrs[0], s.addrs[1]), nil},
+	}
+
+	for _, tc := range testCases {
+		_, err := s.msgSrvr.Send(sdk.WrapSDKContext(s.ctx), tc.msg)
+		if tc.expErr != nil {
+			s.Require().ErrorIs(err, tc.expErr)
+			continue
+		}
+		s.Require().NoError(err)
+	}
+
+	s.Require().True(s.addrs[1].Equals(s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID)))
+
+	var sendEvents int
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == "cosmos.nft.v1beta1.EventSend" {
+			sendEvents++
+		}
+	}
+	s.Require().Equal(1, sendEvents)
+}
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// indexValue is the value of the entries of the index of the nfts by owner
+var indexValue = []byte{0x01}
+
+// Mint defines a method for minting a new nft
+func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if err := k.validateNFT(token); err != nil {
+		return err
+	}
+	if !k.HasClass(ctx, token.ClassId) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+	if k.HasNFT(ctx, token.ClassId, token.Id) {
+		return sdkerrors.Wrapf(nft.ErrNFTExists, "nft %s of class %s", token.Id, token.ClassId)
+	}
+
+	k.setNFT(ctx, token)
+	k.setOwner(ctx, token.ClassId, token.Id, receiver)
+	k.setTotalSupply(ctx, token.ClassId, k.GetTotalSupply(ctx, token.ClassId)+1)
+
+	if k.hooks != nil {
+		k.hooks.AfterMint(ctx, token.ClassId, token.Id, receiver)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventMint{
+		ClassId: token.ClassId,
+		Id:      token.Id,
+		Owner:   receiver.String(),
+	})
+}
+
+// Burn defines a method for burning a nft from a specific account.
+func (k Keeper) Burn(ctx sdk.Context, classID, nftID string) error {
+	if !k.HasClass(ctx, classID) {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(nft.ErrNFTNotExists, "nft %s of class %s", nftID, classID)
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(nft.NFTKeyOf(classID, nftID))
+	store.Delete(nft.OwnerStoreKey(classID, nftID))
+	store.Delete(nft.NFTOfClassByOwnerKeyOf(owner, classID, nftID))
+	k.setTotalSupply(ctx, classID, k.GetTotalSupply(ctx, classID)-1)
+
+	if k.hooks != nil {
+		k.hooks.AfterBurn(ctx, classID, nftID, owner)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventBurn{
+		ClassId: classID,
+		Id:      nftID,
+		Owner:   owner.String(),
+	})
+}
+
+// Update defines a method for updating an exist nft
+func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
+	if err := k.validateNFT(token); err != nil {
+		return err
+	}
+	if !k.HasNFT(ctx, token.ClassId, token.Id) {
+		return sdkerrors.Wrapf(nft.ErrNFTNotExists, "nft %s of class %s", token.Id, token.ClassId)
+	}
+
+	k.setNFT(ctx, token)
+	return nil
+}
+
+// Transfer defines a method for sending a nft from one account to another account.
+func (k Keeper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrapf(nft.ErrNFTNotExists, "nft %s of class %s", nftID, classID)
+	}
+
+	sender := k.GetOwner(ctx, classID, nftID)
+	if k.hooks != nil {
+		if err := k.hooks.BeforeTransfer(ctx, classID, nftID, sender, receiver); err != nil {
+			return err
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(nft.NFTOfClassByOwnerKeyOf(sender, classID, nftID))
+	k.setOwner(ctx, classID, nftID, receiver)
+
+	return ctx.EventManager().EmitTypedEvent(&nft.EventSend{
+		ClassId:  classID,
+		Id:       nftID,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	})
+}
+
+// GetNFT returns the nft information of the specified classID and nftID
+func (k Keeper) GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool) {
+	var token nft.NFT
+	bz := ctx.KVStore(k.storeKey).Get(nft.NFTKeyOf(classID, nftID))
+	if bz == nil {
+		return token, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &token)
+	return token, true
+}
+
+// GetNFTsOfClassByOwner returns all nft information of the specified classID under the specified owner
+func (k Keeper) GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []nft.NFT) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), nft.NFTOfClassByOwnerStoreKey(owner, classID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if token, found := k.GetNFT(ctx, classID, string(iterator.Key())); found {
+			nfts = append(nfts, token)
+		}
+	}
+
+	return nfts
+}
+
+// GetNFTsOfClass returns all nft information under the specified classID
+func (k Keeper) GetNFTsOfClass(ctx sdk.Context, classID string) (nfts []nft.NFT) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), nft.NFTStoreKey(classID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var token nft.NFT
+		k.cdc.MustUnmarshal(iterator.Value(), &token)
+		nfts = append(nfts, token)
+	}
+
+	return nfts
+}
+
+// GetOwner returns the owner information of the specified nft
+func (k Keeper) GetOwner(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(nft.OwnerStoreKey(classID, nftID))
+}
+
+// GetBalance returns the specified account, the number of all nfts under the specified classID
+func (k Keeper) GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), nft.NFTOfClassByOwnerStoreKey(owner, classID)).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var balance uint64
+	for ; iterator.Valid(); iterator.Next() {
+		balance++
+	}
+
+	return balance
+}
+
+// HasNFT determines whether the specified classID and nftID exist
+func (k Keeper) HasNFT(ctx sdk.Context, classID, id string) bool {
+	return ctx.KVStore(k.storeKey).Has(nft.NFTKeyOf(classID, id))
+}
+
+func (k Keeper) setNFT(ctx sdk.Context, token nft.NFT) {
+	ctx.KVStore(k.storeKey).Set(nft.NFTKeyOf(token.ClassId, token.Id), k.cdc.MustMarshal(&token))
+}
+
+func (k Keeper) setOwner(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(nft.OwnerStoreKey(classID, nftID), owner.Bytes())
+	store.Set(nft.NFTOfClassByOwnerKeyOf(owner, classID, nftID), indexValue)
+}
+
+// validateNFT unpacks the metadata of the nft, which must be of a type
+// registered by the app, and validates the nft.
+func (k Keeper) validateNFT(token nft.NFT) error {
+	if err := token.UnpackInterfaces(k.cdc); err != nil {
+		return err
+	}
+
+	return token.ValidateBasic()
+}
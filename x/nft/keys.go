@@ -0,0 +1,120 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "nft"
+
+	// StoreKey is the store key string for nft
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for nft
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for nft
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ClassKey is the prefix of the classes, followed by their ids
+	ClassKey = []byte{0x01}
+	// NFTKey is the prefix of the nfts, followed by their length prefixed
+	// class ids and their ids
+	NFTKey = []byte{0x02}
+	// NFTOfClassByOwnerKey is the prefix of the index of the nfts by owner,
+	// followed by the length prefixed owners and class ids and the nft ids
+	NFTOfClassByOwnerKey = []byte{0x03}
+	// OwnerKey is the prefix of the owners of the nfts, followed by their
+	// length prefixed class ids and their ids
+	OwnerKey = []byte{0x04}
+	// ClassTotalSupply is the prefix of the number of nfts of the classes,
+	// followed by their ids
+	ClassTotalSupply = []byte{0x05}
+)
+
+// ClassStoreKey returns the key of the class of id.
+func ClassStoreKey(classID string) []byte {
+	return append(ClassKey, classID...)
+}
+
+// NFTStoreKey returns the prefix of the keys of the nfts of a class.
+func NFTStoreKey(classID string) []byte {
+	return append(NFTKey, address.MustLengthPrefix([]byte(classID))...)
+}
+
+// NFTKeyOf returns the key of an nft.
+func NFTKeyOf(classID, nftID string) []byte {
+	return append(NFTStoreKey(classID), nftID...)
+}
+
+// NFTOfClassByOwnerStoreKey returns the prefix of the keys of the index entries
+// of the nfts of a class owned by owner.
+func NFTOfClassByOwnerStoreKey(owner sdk.AccAddress, classID string) []byte {
+	return append(NFTOfOwnerStoreKey(owner), address.MustLengthPrefix([]byte(classID))...)
+}
+
+// NFTOfOwnerStoreKey returns the prefix of the keys of the index entries of the
+// nfts owned by owner.
+func NFTOfOwnerStoreKey(owner sdk.AccAddress) []byte {
+	return append(NFTOfClassByOwnerKey, address.MustLengthPrefix(owner)...)
+}
+
+// NFTOfClassByOwnerKeyOf returns the key of the index entry of an nft by owner.
+func NFTOfClassByOwnerKeyOf(owner sdk.AccAddress, classID, nftID string) []byte {
+	return append(NFTOfClassByOwnerStoreKey(owner, classID), nftID...)
+}
+
+// OwnerStoreKey returns the key of the owner of an nft.
+func OwnerStoreKey(classID, nftID string) []byte {
+	return append(append(OwnerKey, address.MustLengthPrefix([]byte(classID))...), nftID...)
+}
+
+// ClassTotalSupplyKey returns the key of the number of nfts of a class.
+func ClassTotalSupplyKey(classID string) []byte {
+	return append(ClassTotalSupply, classID...)
+}
+
+// ParseNFTOfOwnerKey returns the class id and nft id of an index entry key
+// following the NFTOfOwnerStoreKey prefix.
+func ParseNFTOfOwnerKey(key []byte) (classID, nftID string) {
+	// the key is the length prefixed class id followed by the nft id
+	return string(key[1 : 1+int(key[0])]), string(key[1+int(key[0]):])
+}
+
+// KeyFormats are the layouts of the keys of the nft store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "class",
+		Prefix:   ClassKey,
+		Segments: []keyformat.Segment{keyformat.String("class_id")},
+		Value:    keyformat.ProtoValue(&Class{}),
+	},
+	{
+		Name:     "nft",
+		Prefix:   NFTKey,
+		Segments: []keyformat.Segment{keyformat.String("class_id").LengthPrefixed(), keyformat.String("id")},
+		Value:    keyformat.ProtoValue(&NFT{}),
+	},
+	{
+		Name:     "nft_of_class_by_owner",
+		Prefix:   NFTOfClassByOwnerKey,
+		Segments: []keyformat.Segment{keyformat.AccAddress("owner"), keyformat.String("class_id").LengthPrefixed(), keyformat.String("id")},
+	},
+	{
+		Name:     "owner",
+		Prefix:   OwnerKey,
+		Segments: []keyformat.Segment{keyformat.String("class_id").LengthPrefixed(), keyformat.String("id")},
+		Value:    keyformat.SegmentValue(keyformat.AccAddress("owner")),
+	},
+	{
+		Name:     "class_total_supply",
+		Prefix:   ClassTotalSupply,
+		Segments: []keyformat.Segment{keyformat.String("class_id")},
+		Value:    keyformat.SegmentValue(keyformat.Uint64("supply")),
+	},
+}
@@ -0,0 +1,63 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgSend{}
+	_ legacytx.LegacyMsg = &MsgSend{} // For amino support.
+)
+
+// NewMsgSend creates a new MsgSend.
+//nolint:interfacer
+func NewMsgSend(classID, id string, sender, receiver sdk.AccAddress) *MsgSend {
+	return &MsgSend{
+		ClassId:  classID,
+		Id:       id,
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+	}
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSend) ValidateBasic() error {
+	if err := ValidateClassID(msg.ClassId); err != nil {
+		return err
+	}
+	if err := ValidateNFTID(msg.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the sender of the nft.
+func (msg MsgSend) GetSigners() []sdk.AccAddress {
+	return msgservice.MustGetSigners(&msg)
+}
+
+// Type implements the LegacyMsg.Type method.
+func (msg MsgSend) Type() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// Route implements the LegacyMsg.Route method.
+func (msg MsgSend) Route() string {
+	return sdk.MsgTypeURL(&msg)
+}
+
+// GetSignBytes implements the LegacyMsg.GetSignBytes method.
+func (msg MsgSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(legacy.Cdc.MustMarshalJSON(&msg))
+}
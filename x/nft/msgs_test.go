@@ -0,0 +1,52 @@
+package nft_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+var (
+	sender   = sdk.AccAddress("sender______________")
+	receiver = sdk.AccAddress("receiver____________")
+)
+
+func TestMsgSend(t *testing.T) {
+	cases := map[string]struct {
+		msg   *nft.MsgSend
+		valid bool
+	}{
+		"valid": {
+			msg:   nft.NewMsgSend("kitty", "kitty1", sender, receiver),
+			valid: true,
+		},
+		"invalid class id": {
+			msg: nft.NewMsgSend("1kitty", "kitty1", sender, receiver),
+		},
+		"invalid nft id": {
+			msg: nft.NewMsgSend("kitty", "k", sender, receiver),
+		},
+		"no sender": {
+			msg: nft.NewMsgSend("kitty", "kitty1", sdk.AccAddress{}, receiver),
+		},
+		"no receiver": {
+			msg: nft.NewMsgSend("kitty", "kitty1", sender, sdk.AccAddress{}),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{sender}, tc.msg.GetSigners())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package nft
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = &QueryNFTsResponse{}
+	_ types.UnpackInterfacesMessage = &QueryNFTResponse{}
+	_ types.UnpackInterfacesMessage = &QueryClassResponse{}
+	_ types.UnpackInterfacesMessage = &QueryClassesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryNFTsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, token := range m.Nfts {
+		if err := token.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryNFTResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.Nft == nil {
+		return nil
+	}
+
+	return m.Nft.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryClassResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if m.Class == nil {
+		return nil
+	}
+
+	return m.Class.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryClassesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, class := range m.Classes {
+		if err := class.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding nft type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, nft.ClassKey):
+			var classA, classB nft.Class
+			cdc.MustUnmarshal(kvA.Value, &classA)
+			cdc.MustUnmarshal(kvB.Value, &classB)
+			return fmt.Sprintf("%v\n%v", classA, classB)
+		case bytes.HasPrefix(kvA.Key, nft.NFTKey):
+			var nftA, nftB nft.NFT
+			cdc.MustUnmarshal(kvA.Value, &nftA)
+			cdc.MustUnmarshal(kvB.Value, &nftB)
+			return fmt.Sprintf("%v\n%v", nftA, nftB)
+		case bytes.HasPrefix(kvA.Key, nft.NFTOfClassByOwnerKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		case bytes.HasPrefix(kvA.Key, nft.OwnerKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+		case bytes.HasPrefix(kvA.Key, nft.ClassTotalSupply):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/simulation"
+)
+
+var ownerAddr = sdk.AccAddress("owner_______________")
+
+func TestDecodeStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Marshaler
+	dec := simulation.NewDecodeStore(cdc)
+
+	class := nft.Class{Id: "kitty", Name: "Crypto Kitty", Uri: "class uri"}
+	classBz, err := cdc.Marshal(&class)
+	require.NoError(t, err)
+
+	token := nft.NFT{ClassId: "kitty", Id: "kitty1", Uri: "kitty uri"}
+	nftBz, err := cdc.Marshal(&token)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: nft.ClassStoreKey(class.Id), Value: classBz},
+			{Key: nft.NFTKeyOf(token.ClassId, token.Id), Value: nftBz},
+			{Key: nft.OwnerStoreKey(token.ClassId, token.Id), Value: ownerAddr},
+			{Key: nft.ClassTotalSupplyKey(class.Id), Value: sdk.Uint64ToBigEndian(1)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Class", false, fmt.Sprintf("%v\n%v", class, class)},
+		{"NFT", false, fmt.Sprintf("%v\n%v", token, token)},
+		{"Owner", false, fmt.Sprintf("%v\n%v", ownerAddr, ownerAddr)},
+		{"TotalSupply", false, "1\n1"},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+				return
+			}
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+		})
+	}
+}
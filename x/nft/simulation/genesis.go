@@ -0,0 +1,66 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// genClasses returns a slice of nft classes.
+func genClasses(r *rand.Rand, accounts []simtypes.Account) []*nft.Class {
+	classes := make([]*nft.Class, len(accounts)-1)
+	for i := 0; i < len(accounts)-1; i++ {
+		classes[i] = &nft.Class{
+			Id:          "class" + simtypes.RandStringOfLength(r, 10),
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+		}
+	}
+	return classes
+}
+
+// genNFT returns a slice of nft.
+func genNFT(r *rand.Rand, classID string, accounts []simtypes.Account) []*nft.Entry {
+	entries := make([]*nft.Entry, len(accounts)-1)
+	for i := 0; i < len(accounts)-1; i++ {
+		owner := accounts[i]
+		entries[i] = &nft.Entry{
+			Owner: owner.Address.String(),
+			Nfts: []*nft.NFT{
+				{
+					ClassId: classID,
+					Id:      fmt.Sprintf("nft%d", i),
+					Uri:     simtypes.RandStringOfLength(r, 10),
+				},
+			},
+		}
+	}
+	return entries
+}
+
+// RandomizedGenState generates a random GenesisState for nft.
+func RandomizedGenState(simState *module.SimulationState) {
+	var classes []*nft.Class
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, "nft", &classes, simState.Rand,
+		func(r *rand.Rand) { classes = genClasses(r, simState.Accounts) },
+	)
+
+	var entries []*nft.Entry
+	if len(classes) > 0 {
+		entries = genNFT(simState.Rand, classes[0].Id, simState.Accounts)
+	}
+
+	nftGenesis := nft.NewGenesisState(classes, entries)
+	bz, err := simState.Cdc.MarshalJSON(nftGenesis)
+	if err != nil {
+		panic(err)
+	}
+
+	simState.GenState[nft.ModuleName] = bz
+}
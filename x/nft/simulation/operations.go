@@ -0,0 +1,85 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSend = "op_weight_msg_nft_send"
+)
+
+var (
+	TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak nft.AccountKeeper, bk nft.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSend int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgSend = simappparams.DefaultWeightSend
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSend generates a MsgSend of a random nft of the sender.
+func SimulateMsgSend(ak nft.AccountKeeper, bk nft.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		if sender.Address.Equals(receiver.Address) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "sender and receiver cannot be same"), nil, nil
+		}
+
+		var nfts []nft.NFT
+		for _, class := range k.GetClasses(ctx) {
+			nfts = append(nfts, k.GetNFTsOfClassByOwner(ctx, class.Id, sender.Address)...)
+		}
+		if len(nfts) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "sender has no nft"), nil, nil
+		}
+		token := nfts[r.Intn(len(nfts))]
+
+		msg := nft.NewMsgSend(token.ClassId, token.Id, sender.Address, receiver.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         TypeMsgSend,
+			Context:         ctx,
+			SimAccount:      sender,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      nft.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,31 @@
+package nft
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// reIDString can be 3 ~ 100 characters long and support letters, followed by either
+	// a letter, a number or a separator ('/', ':', '-').
+	reIDString = `[a-zA-Z][a-zA-Z0-9/:-]{2,100}`
+	reID       = regexp.MustCompile(fmt.Sprintf(`^%s$`, reIDString))
+)
+
+// ValidateClassID returns whether the class id is valid
+func ValidateClassID(id string) error {
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid class id: %s", id)
+	}
+	return nil
+}
+
+// ValidateNFTID returns whether the nft id is valid
+func ValidateNFTID(id string) error {
+	if !reID.MatchString(id) {
+		return sdkerrors.Wrapf(ErrInvalidID, "invalid nft id: %s", id)
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package cli
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
@@ -19,7 +23,10 @@ func NewQueryCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd())
+	cmd.AddCommand(
+		NewQuerySubspaceParamsCmd(),
+		NewQueryParamChangesCmd(),
+	)
 
 	return cmd
 }
@@ -52,3 +59,53 @@ func NewQuerySubspaceParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryParamChangesCmd returns a CLI command handler for querying the history
+// of the changes of the parameters of a subspace.
+func NewQueryParamChangesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changes [subspace] [key]",
+		Short: "Query the history of the changes of the parameters of a subspace, or of one of its parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the changes of the parameters of a subspace, from the oldest, with their
+old and new values, the height they were made at and the ID of the governance
+proposal which made them, if any. The changes are restricted to the ones of the
+parameter of the given key, if any.
+
+Example:
+$ %s query params changes staking UnbondingTime
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := proposal.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &proposal.QueryParamChangesRequest{Subspace: args[0], Pagination: pageReq}
+			if len(args) > 1 {
+				req.Key = args[1]
+			}
+			res, err := queryClient.ParamChanges(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "changes")
+
+	return cmd
+}
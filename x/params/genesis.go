@@ -0,0 +1,30 @@
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// InitGenesis restores the history of the parameter changes, unless it is
+// empty. The restored history replaces the changes recorded while initializing
+// the chain, e.g. by the modules setting their params in their InitGenesis,
+// which set the params to the values already recorded by the history.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data *proposal.GenesisState) {
+	if data.ParamChangeSequence == 0 {
+		return
+	}
+
+	for _, record := range k.GetAllParamChanges(ctx) {
+		k.DeleteParamChange(ctx, record.Subspace, record.Sequence)
+	}
+	for _, record := range data.ParamChanges {
+		k.SetParamChange(ctx, record)
+	}
+	k.SetParamChangeSequence(ctx, data.ParamChangeSequence)
+}
+
+// ExportGenesis returns the history of the parameter changes.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *proposal.GenesisState {
+	return proposal.NewGenesisState(k.GetAllParamChanges(ctx), k.GetParamChangeSequence(ctx))
+}
@@ -0,0 +1,55 @@
+package params_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestImportExportParamChanges(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 1
+	app.StakingKeeper.SetParams(paramstypes.ContextWithProposalID(ctx, 7), stakingParams)
+
+	genState := params.ExportGenesis(ctx, app.ParamsKeeper)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, app.ParamsKeeper.GetParamChangeSequence(ctx), genState.ParamChangeSequence)
+	require.Contains(t, genState.ParamChanges, proposal.ParamChangeRecord{
+		Subspace:   stakingtypes.ModuleName,
+		Key:        string(stakingtypes.KeyMaxValidators),
+		OldValue:   "100",
+		NewValue:   "1",
+		Height:     10,
+		ProposalId: 7,
+		Sequence:   genState.ParamChangeSequence,
+	})
+
+	// the history replaces the changes recorded while initializing the new
+	// chain, and the changes following the import are recorded after it
+	newApp := simapp.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	slashingParams := newApp.SlashingKeeper.GetParams(newCtx)
+	slashingParams.SignedBlocksWindow = 200
+	newApp.SlashingKeeper.SetParams(newCtx, slashingParams)
+
+	params.InitGenesis(newCtx, newApp.ParamsKeeper, genState)
+	require.Equal(t, genState, params.ExportGenesis(newCtx, newApp.ParamsKeeper))
+
+	stakingParams.MaxValidators = 3
+	newApp.StakingKeeper.SetParams(newCtx, stakingParams)
+	require.Equal(t, genState.ParamChangeSequence+1, newApp.ParamsKeeper.GetParamChangeSequence(newCtx))
+
+	// an empty history keeps the changes recorded while initializing the chain
+	params.InitGenesis(newCtx, newApp.ParamsKeeper, proposal.DefaultGenesisState())
+	require.Equal(t, genState.ParamChangeSequence+1, newApp.ParamsKeeper.GetParamChangeSequence(newCtx))
+}
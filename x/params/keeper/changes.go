@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// recordParamChange adds the change of the value of a parameter to the history
+// of the changes of its subspace, along with the height and the ID of the
+// governance proposal making it, if any.
+func (k Keeper) recordParamChange(ctx sdk.Context, subspace string, key, oldValue, newValue []byte) {
+	sequence := k.GetParamChangeSequence(ctx) + 1
+	k.SetParamChangeSequence(ctx, sequence)
+
+	k.SetParamChange(ctx, proposal.ParamChangeRecord{
+		Subspace:   subspace,
+		Key:        string(key),
+		OldValue:   string(oldValue),
+		NewValue:   string(newValue),
+		Height:     ctx.BlockHeight(),
+		ProposalId: types.ProposalIDFromContext(ctx),
+		Sequence:   sequence,
+	})
+}
+
+// GetParamChangeSequence returns the sequence of the last parameter change
+// recorded, zero if none was.
+func (k Keeper) GetParamChangeSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.key).Get(types.ParamChangeSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetParamChangeSequence sets the sequence of the last parameter change
+// recorded.
+func (k Keeper) SetParamChangeSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.key).Set(types.ParamChangeSequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+// SetParamChange stores the record of a parameter change under its subspace
+// and sequence.
+func (k Keeper) SetParamChange(ctx sdk.Context, record proposal.ParamChangeRecord) {
+	ctx.KVStore(k.key).Set(types.ParamChangeKey(record.Subspace, record.Sequence), k.cdc.MustMarshal(&record))
+}
+
+// IterateParamChanges iterates over the recorded changes of the parameters of
+// the subspace, or of its parameter of the given key if it is not empty, from
+// the oldest, until cb returns true.
+func (k Keeper) IterateParamChanges(ctx sdk.Context, subspace, key string, cb func(proposal.ParamChangeRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ParamChangesPrefix(subspace))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record proposal.ParamChangeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if key != "" && record.Key != key {
+			continue
+		}
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// DeleteParamChange deletes the record of the parameter change of the subspace
+// recorded with the given sequence.
+func (k Keeper) DeleteParamChange(ctx sdk.Context, subspace string, sequence uint64) {
+	ctx.KVStore(k.key).Delete(types.ParamChangeKey(subspace, sequence))
+}
+
+// GetAllParamChanges returns the recorded changes of the parameters of all the
+// subspaces, by subspace and from the oldest.
+func (k Keeper) GetAllParamChanges(ctx sdk.Context) (records []proposal.ParamChangeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.KeyPrefixParamChange)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record proposal.ParamChangeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
@@ -6,8 +6,11 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
@@ -34,3 +37,43 @@ func (k Keeper) Params(c context.Context, req *proposal.QueryParamsRequest) (*pr
 
 	return &proposal.QueryParamsResponse{Param: param}, nil
 }
+
+// ParamChanges returns the history of the changes of the parameters of a
+// subspace, or of one of its parameters, from the oldest
+func (k Keeper) ParamChanges(c context.Context, req *proposal.QueryParamChangesRequest) (*proposal.QueryParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Subspace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, ok := k.GetSubspace(req.Subspace); !ok {
+		return nil, sdkerrors.Wrap(proposal.ErrUnknownSubspace, req.Subspace)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ParamChangesPrefix(req.Subspace))
+
+	var changes []proposal.ParamChangeRecord
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var record proposal.ParamChangeRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+		if req.Key != "" && record.Key != req.Key {
+			return false, nil
+		}
+
+		if accumulate {
+			changes = append(changes, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proposal.QueryParamChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
@@ -84,3 +85,51 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryParamChanges() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.queryClient.ParamChanges(ctx, &proposal.QueryParamChangesRequest{})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.ParamChanges(ctx, &proposal.QueryParamChangesRequest{Subspace: "test"})
+	suite.Require().Error(err)
+
+	space := suite.app.ParamsKeeper.Subspace("test").WithKeyTable(types.NewKeyTable(
+		types.NewParamSetPair([]byte("key1"), int64(0), validateNoOp),
+		types.NewParamSetPair([]byte("key2"), int64(0), validateNoOp),
+	))
+
+	space.Set(suite.ctx.WithBlockHeight(1), []byte("key1"), int64(1))
+	space.Set(suite.ctx.WithBlockHeight(2), []byte("key2"), int64(2))
+	// unchanged values are not recorded
+	space.Set(suite.ctx.WithBlockHeight(3), []byte("key1"), int64(1))
+	space.Set(types.ContextWithProposalID(suite.ctx.WithBlockHeight(4), 7), []byte("key1"), int64(3))
+
+	res, err := suite.queryClient.ParamChanges(ctx, &proposal.QueryParamChangesRequest{Subspace: "test"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proposal.ParamChangeRecord{
+		{Subspace: "test", Key: "key1", OldValue: "", NewValue: `"1"`, Height: 1, Sequence: 1},
+		{Subspace: "test", Key: "key2", OldValue: "", NewValue: `"2"`, Height: 2, Sequence: 2},
+		{Subspace: "test", Key: "key1", OldValue: `"1"`, NewValue: `"3"`, Height: 4, ProposalId: 7, Sequence: 3},
+	}, res.Changes)
+
+	res, err = suite.queryClient.ParamChanges(ctx, &proposal.QueryParamChangesRequest{
+		Subspace:   "test",
+		Key:        "key1",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Changes, 1)
+	suite.Require().Equal(int64(1), res.Changes[0].Height)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.ParamChanges(ctx, &proposal.QueryParamChangesRequest{
+		Subspace:   "test",
+		Key:        "key1",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Changes, 1)
+	suite.Require().Equal(int64(4), res.Changes[0].Height)
+}
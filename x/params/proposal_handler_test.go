@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -90,3 +91,25 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		})
 	}
 }
+
+func (suite *HandlerTestSuite) TestProposalHandlerRecordsChanges() {
+	ctx := paramstypes.ContextWithProposalID(suite.ctx.WithBlockHeight(10), 7)
+	err := suite.govHandler(ctx, testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")))
+	suite.Require().NoError(err)
+
+	var records []proposal.ParamChangeRecord
+	suite.app.ParamsKeeper.IterateParamChanges(suite.ctx, stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), func(record proposal.ParamChangeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	suite.Require().NotEmpty(records)
+	suite.Require().Equal(proposal.ParamChangeRecord{
+		Subspace:   stakingtypes.ModuleName,
+		Key:        string(stakingtypes.KeyMaxValidators),
+		OldValue:   "100",
+		NewValue:   "1",
+		Height:     10,
+		ProposalId: 7,
+		Sequence:   suite.app.ParamsKeeper.GetParamChangeSequence(suite.ctx),
+	}, records[len(records)-1])
+}
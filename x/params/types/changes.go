@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamChangeRecorder records a change of the value of the parameter of the
+// given key of a subspace, the old value being nil if the parameter was not
+// set. The values are JSON encoded.
+type ParamChangeRecorder func(ctx sdk.Context, subspace string, key, oldValue, newValue []byte)
+
+type proposalIDKey struct{}
+
+// ContextWithProposalID returns a copy of ctx carrying the ID of the governance
+// proposal executed with it, recorded along with the parameter changes.
+func ContextWithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDKey{}, proposalID)
+}
+
+// ProposalIDFromContext returns the ID of the governance proposal executed with
+// ctx, or zero if none is.
+func ProposalIDFromContext(ctx sdk.Context) uint64 {
+	proposalID, _ := ctx.Value(proposalIDKey{}).(uint64)
+	return proposalID
+}
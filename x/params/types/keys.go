@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "params"
@@ -7,3 +12,37 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// The prefixes of the keys of the params store not holding parameters. No
+// subspace name starts with them.
+var (
+	// KeyPrefixLastChangeHeight is the prefix of the keys holding the height at
+	// which the parameters of a subspace last changed.
+	KeyPrefixLastChangeHeight = []byte{0x00}
+
+	// KeyPrefixParamChange is the prefix of the keys holding the history of the
+	// changes of the parameters, by subspace.
+	KeyPrefixParamChange = []byte{0x01}
+
+	// ParamChangeSequenceKey is the key of the sequence of the last parameter
+	// change recorded.
+	ParamChangeSequenceKey = []byte{0x02}
+)
+
+// LastChangeHeightKey returns the key of the height at which the parameters of
+// the subspace of the given name last changed.
+func LastChangeHeightKey(subspace string) []byte {
+	return append(append([]byte{}, KeyPrefixLastChangeHeight...), subspace...)
+}
+
+// ParamChangesPrefix returns the prefix of the keys of the changes of the
+// parameters of the subspace, ordered by sequence.
+func ParamChangesPrefix(subspace string) []byte {
+	return append(append([]byte{}, KeyPrefixParamChange...), address.MustLengthPrefix([]byte(subspace))...)
+}
+
+// ParamChangeKey returns the key of the change of a parameter of the subspace
+// recorded with the given sequence.
+func ParamChangeKey(subspace string, sequence uint64) []byte {
+	return append(ParamChangesPrefix(subspace), sdk.Uint64ToBigEndian(sequence)...)
+}
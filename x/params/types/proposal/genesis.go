@@ -0,0 +1,36 @@
+package proposal
+
+import "fmt"
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(paramChanges []ParamChangeRecord, paramChangeSequence uint64) *GenesisState {
+	return &GenesisState{
+		ParamChanges:        paramChanges,
+		ParamChangeSequence: paramChangeSequence,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state, with no parameter
+// change recorded.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]ParamChangeRecord{}, 0)
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	sequences := make(map[uint64]bool, len(gs.ParamChanges))
+	for _, record := range gs.ParamChanges {
+		if record.Subspace == "" {
+			return fmt.Errorf("empty subspace of parameter change %d", record.Sequence)
+		}
+		if record.Sequence == 0 || record.Sequence > gs.ParamChangeSequence {
+			return fmt.Errorf("parameter change sequence %d must be positive and at most %d", record.Sequence, gs.ParamChangeSequence)
+		}
+		if sequences[record.Sequence] {
+			return fmt.Errorf("duplicate parameter change sequence %d", record.Sequence)
+		}
+		sequences[record.Sequence] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package proposal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	record := func(sequence uint64) proposal.ParamChangeRecord {
+		return proposal.ParamChangeRecord{Subspace: "staking", Key: "MaxValidators", NewValue: "1", Sequence: sequence}
+	}
+
+	testCases := []struct {
+		name     string
+		genState *proposal.GenesisState
+		expErr   bool
+	}{
+		{"default", proposal.DefaultGenesisState(), false},
+		{"valid", proposal.NewGenesisState([]proposal.ParamChangeRecord{record(1), record(3)}, 3), false},
+		{"empty subspace", proposal.NewGenesisState([]proposal.ParamChangeRecord{{Key: "MaxValidators", Sequence: 1}}, 1), true},
+		{"zero sequence", proposal.NewGenesisState([]proposal.ParamChangeRecord{record(0)}, 1), true},
+		{"sequence after the last one", proposal.NewGenesisState([]proposal.ParamChangeRecord{record(2)}, 1), true},
+		{"duplicate sequence", proposal.NewGenesisState([]proposal.ParamChangeRecord{record(1), record(1)}, 1), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
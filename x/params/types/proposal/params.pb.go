@@ -123,9 +123,114 @@ func (m *ParamChange) GetValue() string {
 	return ""
 }
 
+// ParamChangeRecord is the record of a change of the value of a parameter,
+// kept in the history of the changes of its subspace.
+type ParamChangeRecord struct {
+	// subspace is the name of the subspace of the parameter, usually a module.
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	// key is the key of the parameter in the subspace.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// old_value is the JSON value of the parameter before the change, empty if
+	// it was not set.
+	OldValue string `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// new_value is the JSON value of the parameter after the change.
+	NewValue string `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// height is the height of the block the parameter changed at.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// proposal_id is the ID of the governance proposal which changed the
+	// parameter, zero if it was not changed by a proposal.
+	ProposalId uint64 `protobuf:"varint,6,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// sequence is the sequence of the change among the changes of all the
+	// subspaces.
+	Sequence uint64 `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *ParamChangeRecord) Reset()         { *m = ParamChangeRecord{} }
+func (m *ParamChangeRecord) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeRecord) ProtoMessage()    {}
+func (*ParamChangeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_53a944ecb0483e4c, []int{2}
+}
+func (m *ParamChangeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeRecord.Merge(m, src)
+}
+func (m *ParamChangeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeRecord proto.InternalMessageInfo
+
+func (m *ParamChangeRecord) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParamChangeRecord) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ParamChangeRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ParameterChangeProposal)(nil), "cosmos.params.v1beta1.ParameterChangeProposal")
 	proto.RegisterType((*ParamChange)(nil), "cosmos.params.v1beta1.ParamChange")
+	proto.RegisterType((*ParamChangeRecord)(nil), "cosmos.params.v1beta1.ParamChangeRecord")
 }
 
 func init() {
@@ -133,27 +238,32 @@ func init() {
 }
 
 var fileDescriptor_53a944ecb0483e4c = []byte{
-	// 307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0x84, 0x72, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x21, 0x6a, 0xf4, 0xa0, 0x82,
-	0x50, 0x35, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa, 0x20, 0x16, 0x44, 0xb1, 0xd2,
-	0x7c, 0x46, 0x2e, 0xf1, 0x00, 0x90, 0xc2, 0xd4, 0x92, 0xd4, 0x22, 0xe7, 0x8c, 0xc4, 0xbc, 0xf4,
-	0xd4, 0x80, 0xa2, 0xfc, 0x82, 0xfc, 0xe2, 0xc4, 0x1c, 0x21, 0x11, 0x2e, 0xd6, 0x92, 0xcc, 0x92,
-	0x9c, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x81, 0x8b, 0x3b, 0x25,
-	0xb5, 0x38, 0xb9, 0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x09, 0x2c, 0x87, 0x2c, 0x24,
-	0xe4, 0xc4, 0xc5, 0x9e, 0x0c, 0x36, 0xa9, 0x58, 0x82, 0x59, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x49,
-	0x0f, 0xab, 0x93, 0xf4, 0xc0, 0x16, 0x43, 0x2c, 0x75, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08,
-	0xa6, 0xd1, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3, 0x8c, 0x05, 0xf2, 0x0c, 0x4a, 0xe1, 0x5c, 0xdc,
-	0x48, 0xea, 0x84, 0xa4, 0xb8, 0x38, 0x8a, 0x4b, 0x93, 0x8a, 0x0b, 0x12, 0x93, 0x61, 0xee, 0x82,
-	0xf3, 0x85, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x2b, 0xa1, 0x4e, 0x02, 0x31, 0x41, 0x5e, 0x28, 0x4b,
-	0xcc, 0x29, 0x4d, 0x95, 0x60, 0x86, 0x78, 0x01, 0xcc, 0xb1, 0x62, 0x01, 0x19, 0xec, 0x14, 0xb4,
-	0xe2, 0x91, 0x1c, 0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
-	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa4,
-	0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x03, 0x1d, 0x42, 0xe9, 0x16,
-	0xa7, 0x64, 0xeb, 0x57, 0xc0, 0x62, 0xa0, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xbf, 0x00, 0x1a, 0x64,
-	0x49, 0x6c, 0xe0, 0x50, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x26, 0x09, 0x3d, 0xa8,
-	0x01, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0xcf, 0x93, 0x40,
+	0x1c, 0xe6, 0x84, 0xb7, 0x6f, 0xdf, 0x63, 0x51, 0x52, 0x95, 0xd4, 0x04, 0x08, 0x13, 0x8b, 0x90,
+	0xaa, 0x53, 0xc7, 0x3a, 0xb9, 0x35, 0x0c, 0x9a, 0xb8, 0x34, 0xfc, 0xf9, 0x05, 0x48, 0x29, 0x87,
+	0xdc, 0xd1, 0xda, 0x6f, 0xe0, 0xe8, 0xe8, 0x66, 0x47, 0x3f, 0x4a, 0xc7, 0x8e, 0x4e, 0xc6, 0xd0,
+	0x2f, 0x62, 0xb8, 0x3b, 0x1a, 0x06, 0x97, 0x77, 0xe2, 0x9e, 0xdf, 0xf3, 0xf0, 0x3c, 0xcf, 0xe5,
+	0x7e, 0xd8, 0x4d, 0x08, 0xdd, 0x11, 0x1a, 0xd4, 0x51, 0x13, 0xed, 0x68, 0xb0, 0x5f, 0xc4, 0xc0,
+	0xa2, 0x85, 0x84, 0x7e, 0xdd, 0x10, 0x46, 0x8c, 0xe7, 0x42, 0xe3, 0xcb, 0xa1, 0xd4, 0xcc, 0x67,
+	0x19, 0xc9, 0x08, 0x57, 0x04, 0xfd, 0x49, 0x88, 0xdd, 0x9f, 0x08, 0xbf, 0x5c, 0xf7, 0x42, 0x60,
+	0xd0, 0xbc, 0xcf, 0xa3, 0x2a, 0x83, 0x75, 0x43, 0x6a, 0x42, 0xa3, 0xd2, 0x98, 0xe1, 0x3b, 0x56,
+	0xb0, 0x12, 0x4c, 0xe4, 0x20, 0xef, 0x21, 0x14, 0xc0, 0x70, 0xb0, 0x9e, 0x02, 0x4d, 0x9a, 0xa2,
+	0x66, 0x05, 0xa9, 0xcc, 0x27, 0x9c, 0x1b, 0x8f, 0x8c, 0x15, 0xbe, 0x4f, 0xb8, 0x13, 0x35, 0x55,
+	0x47, 0xf5, 0xf4, 0x37, 0xae, 0xff, 0xdf, 0x4a, 0x3e, 0x0f, 0x16, 0xa1, 0x2b, 0xed, 0xfc, 0xc7,
+	0x56, 0xc2, 0xe1, 0xc7, 0xe5, 0xf4, 0xdb, 0xc9, 0x56, 0x7e, 0x9c, 0x6c, 0xc5, 0xfd, 0x84, 0xf5,
+	0x91, 0xce, 0x98, 0xe3, 0x29, 0x6d, 0x63, 0x5a, 0x47, 0xc9, 0xd0, 0xeb, 0x86, 0x8d, 0xa7, 0x58,
+	0xdd, 0xc2, 0x51, 0x56, 0xea, 0x8f, 0xfd, 0x15, 0xf6, 0x51, 0xd9, 0x82, 0xa9, 0x8a, 0x2b, 0x70,
+	0xb0, 0xd4, 0xb8, 0xf1, 0x05, 0xe1, 0x67, 0x23, 0xe7, 0x10, 0x12, 0xd2, 0xa4, 0x8f, 0xf4, 0x7f,
+	0x85, 0x1f, 0x48, 0x99, 0x6e, 0xc6, 0x19, 0x53, 0x52, 0xa6, 0x1f, 0x7b, 0xdc, 0x93, 0x15, 0x1c,
+	0x24, 0xa9, 0x09, 0xb2, 0x82, 0x83, 0x20, 0x5f, 0xe0, 0x49, 0x0e, 0x45, 0x96, 0x33, 0xf3, 0xce,
+	0x41, 0x9e, 0x1a, 0x4a, 0x64, 0xd8, 0x58, 0xaf, 0xe5, 0x03, 0x6c, 0x8a, 0xd4, 0x9c, 0x38, 0xc8,
+	0xd3, 0x42, 0x3c, 0x8c, 0x3e, 0x88, 0x82, 0xf0, 0xa5, 0x85, 0x2a, 0x01, 0xf3, 0x9e, 0xb3, 0x37,
+	0xbc, 0x0a, 0x7f, 0x75, 0x16, 0x3a, 0x77, 0x16, 0xba, 0x74, 0x16, 0xfa, 0xdb, 0x59, 0xe8, 0xfb,
+	0xd5, 0x52, 0x2e, 0x57, 0x4b, 0xf9, 0x7d, 0xb5, 0x94, 0xcf, 0xef, 0xb2, 0x82, 0xe5, 0x6d, 0xec,
+	0x27, 0x64, 0x17, 0xc8, 0x3d, 0x12, 0x9f, 0xd7, 0x34, 0xdd, 0x06, 0x5f, 0x87, 0xa5, 0x62, 0xc7,
+	0x1a, 0x68, 0x30, 0x24, 0xc6, 0x13, 0xbe, 0x28, 0x6f, 0xff, 0x0d, 0x00, 0x0b, 0xc2, 0x5f, 0xb4,
+	0x7b, 0x02, 0x00, 0x00,
 }
 
 func (this *ParameterChangeProposal) Equal(that interface{}) bool {
@@ -221,6 +331,48 @@ func (this *ParamChange) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ParamChangeRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParamChangeRecord)
+	if !ok {
+		that2, ok := that.(ParamChangeRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Subspace != that1.Subspace {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.OldValue != that1.OldValue {
+		return false
+	}
+	if this.NewValue != that1.NewValue {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	return true
+}
 func (m *ParameterChangeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -316,6 +468,72 @@ func (m *ParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamChangeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -371,6 +589,40 @@ func (m *ParamChange) Size() (n int) {
 	return n
 }
 
+func (m *ParamChangeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovParams(uint64(m.ProposalId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovParams(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -671,6 +923,241 @@ func (m *ParamChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ParamChangeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
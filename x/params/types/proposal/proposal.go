@@ -22,6 +22,10 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&ParameterChangeProposal{}, "cosmos-sdk/ParameterChangeProposal")
 }
 
+// NewParameterChangeProposal creates a new ParameterChangeProposal.
+//
+// Deprecated: the parameters of the modules are updated with their
+// MsgUpdateParams messages, executed by gov MsgExecutionProposal proposals.
 func NewParameterChangeProposal(title, description string, changes []ParamChange) *ParameterChangeProposal {
 	return &ParameterChangeProposal{title, description, changes}
 }
@@ -6,6 +6,7 @@ package proposal
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -130,36 +131,168 @@ func (m *QueryParamsResponse) GetParam() ParamChange {
 	return ParamChange{}
 }
 
+// QueryParamChangesRequest is request type for the Query/ParamChanges RPC
+// method.
+type QueryParamChangesRequest struct {
+	// subspace defines the module to query the parameter changes for.
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	// key optionally restricts the changes to the ones of the parameter with the
+	// key in the subspace.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamChangesRequest) Reset()         { *m = QueryParamChangesRequest{} }
+func (m *QueryParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamChangesRequest) ProtoMessage()    {}
+func (*QueryParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{2}
+}
+func (m *QueryParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamChangesRequest.Merge(m, src)
+}
+func (m *QueryParamChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamChangesRequest proto.InternalMessageInfo
+
+func (m *QueryParamChangesRequest) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *QueryParamChangesRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *QueryParamChangesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamChangesResponse is response type for the Query/ParamChanges RPC
+// method.
+type QueryParamChangesResponse struct {
+	// changes are the changes of the parameters, from the oldest.
+	Changes []ParamChangeRecord `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParamChangesResponse) Reset()         { *m = QueryParamChangesResponse{} }
+func (m *QueryParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamChangesResponse) ProtoMessage()    {}
+func (*QueryParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b32979c1792ccc4, []int{3}
+}
+func (m *QueryParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamChangesResponse.Merge(m, src)
+}
+func (m *QueryParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryParamChangesResponse) GetChanges() []ParamChangeRecord {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryParamChangesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.params.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.params.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamChangesRequest)(nil), "cosmos.params.v1beta1.QueryParamChangesRequest")
+	proto.RegisterType((*QueryParamChangesResponse)(nil), "cosmos.params.v1beta1.QueryParamChangesResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/params/v1beta1/query.proto", fileDescriptor_2b32979c1792ccc4) }
 
 var fileDescriptor_2b32979c1792ccc4 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4b, 0x3b, 0x31,
-	0x14, 0xc0, 0x2f, 0xfd, 0xff, 0x5b, 0x34, 0x2e, 0x12, 0x15, 0xca, 0xa1, 0x57, 0x3d, 0x10, 0x54,
-	0x30, 0xa1, 0xd5, 0xd9, 0xa1, 0xee, 0xa2, 0x05, 0x17, 0xb7, 0x5c, 0x0d, 0x69, 0x69, 0x7b, 0x2f,
-	0xbd, 0x97, 0x13, 0xbb, 0x3a, 0x38, 0x17, 0xfc, 0x52, 0x1d, 0x0b, 0x2e, 0x4e, 0x22, 0xad, 0x1f,
-	0x44, 0x9a, 0xb4, 0x82, 0x58, 0xc5, 0x29, 0x2f, 0x2f, 0xbf, 0xf7, 0x7b, 0x79, 0x09, 0xdd, 0x6b,
-	0x02, 0xf6, 0x00, 0x85, 0x91, 0x99, 0xec, 0xa1, 0xb8, 0xab, 0x26, 0xca, 0xca, 0xaa, 0xe8, 0xe7,
-	0x2a, 0x1b, 0x70, 0x93, 0x81, 0x05, 0xb6, 0xe5, 0x11, 0xee, 0x11, 0x3e, 0x47, 0xc2, 0x4d, 0x0d,
-	0x1a, 0x1c, 0x21, 0x66, 0x91, 0x87, 0xc3, 0x6d, 0x0d, 0xa0, 0xbb, 0x4a, 0x48, 0xd3, 0x16, 0x32,
-	0x4d, 0xc1, 0x4a, 0xdb, 0x86, 0x14, 0xe7, 0xa7, 0xf1, 0xf2, 0x6e, 0x73, 0xb3, 0x63, 0xe2, 0x3a,
-	0x65, 0x57, 0xb3, 0xee, 0x97, 0x2e, 0xd9, 0x50, 0xfd, 0x5c, 0xa1, 0x65, 0x21, 0x5d, 0xc1, 0x3c,
-	0x41, 0x23, 0x9b, 0xaa, 0x4c, 0x76, 0xc9, 0xc1, 0x6a, 0xe3, 0x73, 0xcf, 0xd6, 0xe9, 0xbf, 0x8e,
-	0x1a, 0x94, 0x0b, 0x2e, 0x3d, 0x0b, 0xe3, 0x6b, 0xba, 0xf1, 0xc5, 0x81, 0x06, 0x52, 0x54, 0xec,
-	0x8c, 0x16, 0x5d, 0x2b, 0x67, 0x58, 0xab, 0xc5, 0x7c, 0xe9, 0x64, 0xdc, 0x55, 0x9d, 0xb7, 0x64,
-	0xaa, 0x55, 0xfd, 0xff, 0xe8, 0xb5, 0x12, 0x34, 0x7c, 0x59, 0x6d, 0x48, 0x68, 0xd1, 0x79, 0xd9,
-	0x23, 0xa1, 0x25, 0x2f, 0x67, 0x87, 0x3f, 0x58, 0xbe, 0x0f, 0x11, 0x1e, 0xfd, 0x05, 0xf5, 0x77,
-	0x8d, 0xf7, 0x1f, 0x9e, 0xdf, 0x9f, 0x0a, 0x15, 0xb6, 0x23, 0x7e, 0x7b, 0xb3, 0xfa, 0xc5, 0x68,
-	0x12, 0x91, 0xf1, 0x24, 0x22, 0x6f, 0x93, 0x88, 0x0c, 0xa7, 0x51, 0x30, 0x9e, 0x46, 0xc1, 0xcb,
-	0x34, 0x0a, 0x6e, 0x4e, 0x75, 0xdb, 0xb6, 0xf2, 0x84, 0x37, 0xa1, 0xb7, 0x50, 0xf8, 0xe5, 0x18,
-	0x6f, 0x3b, 0xe2, 0x7e, 0xe1, 0xb3, 0x03, 0xa3, 0x50, 0x98, 0x0c, 0x0c, 0xa0, 0xec, 0x26, 0x25,
-	0xf7, 0x09, 0x27, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xd5, 0x67, 0xc7, 0x18, 0x02, 0x00,
-	0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0xe3, 0x94, 0x7b, 0x01, 0x5f, 0x06, 0x64, 0x40, 0x0a, 0x11, 0xe4, 0x96, 0x48, 0x94,
+	0x52, 0x89, 0x98, 0x16, 0x66, 0x86, 0x22, 0x01, 0x13, 0x82, 0x48, 0x2c, 0x6c, 0x4e, 0x6a, 0xb9,
+	0x51, 0xdb, 0xd8, 0x8d, 0x13, 0x44, 0x57, 0x06, 0xe6, 0x4a, 0x4c, 0xbc, 0x03, 0xe2, 0x39, 0x3a,
+	0x56, 0x62, 0x61, 0x42, 0xa8, 0xe5, 0x41, 0x50, 0x6c, 0xa7, 0x7f, 0x44, 0x4b, 0xab, 0x3b, 0xc5,
+	0x71, 0xbe, 0xf3, 0x7d, 0x3f, 0x9f, 0xe3, 0xc0, 0x7b, 0x31, 0x97, 0x23, 0x2e, 0xb1, 0x20, 0x19,
+	0x19, 0x49, 0xfc, 0xa1, 0x1d, 0xd1, 0x9c, 0xb4, 0xf1, 0xb8, 0xa0, 0xd9, 0x24, 0x10, 0x19, 0xcf,
+	0x39, 0xba, 0xa5, 0x25, 0x81, 0x96, 0x04, 0x46, 0xe2, 0xde, 0x64, 0x9c, 0x71, 0xa5, 0xc0, 0xe5,
+	0x4a, 0x8b, 0xdd, 0x3b, 0x8c, 0x73, 0x36, 0xa4, 0x98, 0x88, 0x04, 0x93, 0x34, 0xe5, 0x39, 0xc9,
+	0x13, 0x9e, 0x4a, 0xf3, 0xb5, 0x65, 0xd2, 0x22, 0x22, 0xa9, 0xce, 0x58, 0x25, 0x0a, 0xc2, 0x92,
+	0x54, 0x89, 0x8d, 0xd6, 0xdf, 0x4d, 0x66, 0x28, 0x94, 0xc6, 0xef, 0x42, 0xf4, 0xb6, 0x74, 0x79,
+	0xa3, 0x36, 0x43, 0x3a, 0x2e, 0xa8, 0xcc, 0x91, 0x0b, 0xaf, 0xc8, 0x22, 0x92, 0x82, 0xc4, 0xd4,
+	0x01, 0x75, 0xd0, 0xbc, 0x1a, 0xae, 0xde, 0xd1, 0x75, 0x58, 0x1b, 0xd0, 0x89, 0x63, 0xab, 0xed,
+	0x72, 0xe9, 0xbf, 0x83, 0x37, 0xb6, 0x3c, 0xa4, 0xe0, 0xa9, 0xa4, 0xe8, 0x19, 0x3c, 0x51, 0x51,
+	0xca, 0xe1, 0xac, 0xe3, 0x07, 0x3b, 0xbb, 0x10, 0xa8, 0xaa, 0xe7, 0x7d, 0x92, 0x32, 0xda, 0xbd,
+	0x34, 0xfb, 0x75, 0x6e, 0x85, 0xba, 0xcc, 0x9f, 0x02, 0xe8, 0xac, 0x7d, 0xb5, 0xe2, 0x62, 0x84,
+	0xe8, 0x05, 0x84, 0xeb, 0xee, 0x38, 0x35, 0xc5, 0xd3, 0xa8, 0x78, 0xca, 0x56, 0x06, 0x7a, 0x5c,
+	0x6b, 0x26, 0x46, 0x4d, 0x52, 0xb8, 0x51, 0xe9, 0x7f, 0x07, 0xf0, 0xf6, 0x0e, 0x24, 0x73, 0xe0,
+	0x57, 0xf0, 0x72, 0xac, 0xb7, 0x1c, 0x50, 0xaf, 0x35, 0xcf, 0x3a, 0xcd, 0xc3, 0x47, 0x0e, 0x69,
+	0xcc, 0xb3, 0x9e, 0x39, 0x78, 0x55, 0x8e, 0x5e, 0x6e, 0xf1, 0xda, 0x8a, 0xf7, 0xc1, 0x41, 0x5e,
+	0x8d, 0xb1, 0x09, 0xdc, 0xf9, 0x66, 0xc3, 0x13, 0x05, 0x8c, 0x3e, 0x03, 0x78, 0xaa, 0x07, 0x84,
+	0x1e, 0xee, 0xc1, 0xfa, 0xf7, 0x22, 0xb8, 0xad, 0x63, 0xa4, 0x3a, 0xd7, 0xbf, 0xff, 0xe9, 0xc7,
+	0x9f, 0x2f, 0xf6, 0x39, 0xba, 0x8b, 0xff, 0x77, 0xef, 0xd0, 0x57, 0x00, 0xaf, 0x6d, 0xb6, 0x0f,
+	0xe1, 0x83, 0x19, 0xdb, 0xb3, 0x77, 0x1f, 0x1f, 0x5f, 0x60, 0xd0, 0x1a, 0x0a, 0xad, 0x8e, 0xbc,
+	0x3d, 0x68, 0xa6, 0xef, 0xdd, 0xd7, 0xb3, 0x85, 0x07, 0xe6, 0x0b, 0x0f, 0xfc, 0x5e, 0x78, 0x60,
+	0xba, 0xf4, 0xac, 0xf9, 0xd2, 0xb3, 0x7e, 0x2e, 0x3d, 0xeb, 0xfd, 0x53, 0x96, 0xe4, 0xfd, 0x22,
+	0x0a, 0x62, 0x3e, 0xaa, 0x3c, 0xf4, 0xe3, 0x91, 0xec, 0x0d, 0xf0, 0xc7, 0xca, 0x30, 0x9f, 0x08,
+	0x2a, 0xb1, 0xc8, 0xb8, 0xe0, 0x92, 0x0c, 0xa3, 0x53, 0xf5, 0x93, 0x3d, 0xf9, 0x3b, 0x00, 0xa8,
+	0x51, 0x05, 0xe4, 0x24, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +310,9 @@ type QueryClient interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamChanges queries the history of the changes of the parameters of a
+	// subspace, or of one of its parameters given its key, from the oldest.
+	ParamChanges(ctx context.Context, in *QueryParamChangesRequest, opts ...grpc.CallOption) (*QueryParamChangesResponse, error)
 }
 
 type queryClient struct {
@@ -196,11 +332,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ParamChanges(ctx context.Context, in *QueryParamChangesRequest, opts ...grpc.CallOption) (*QueryParamChangesResponse, error) {
+	out := new(QueryParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.params.v1beta1.Query/ParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries a specific parameter of a module, given its subspace and
 	// key.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamChanges queries the history of the changes of the parameters of a
+	// subspace, or of one of its parameters given its key, from the oldest.
+	ParamChanges(context.Context, *QueryParamChangesRequest) (*QueryParamChangesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -210,6 +358,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ParamChanges(ctx context.Context, req *QueryParamChangesRequest) (*QueryParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamChanges not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -233,6 +384,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.params.v1beta1.Query/ParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamChanges(ctx, req.(*QueryParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.params.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -241,6 +410,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ParamChanges",
+			Handler:    _Query_ParamChanges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/params/v1beta1/query.proto",
@@ -316,6 +489,104 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -355,6 +626,46 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -558,6 +869,276 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, ParamChangeRecord{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 
@@ -27,6 +28,9 @@ type Subspace struct {
 	tkey        sdk.StoreKey // []byte -> bool, stores parameter change
 	name        []byte
 	table       KeyTable
+
+	// recorder records the changes of the values of the parameters, if set
+	recorder ParamChangeRecorder
 }
 
 // NewSubspace constructs a store with namestore
@@ -164,10 +168,19 @@ func (s Subspace) checkType(key []byte, value interface{}) {
 	}
 }
 
+// WithChangeRecorder returns a copy of the Subspace passing the changes of the
+// values of its parameters to the recorder.
+func (s Subspace) WithChangeRecorder(recorder ParamChangeRecorder) Subspace {
+	s.recorder = recorder
+	return s
+}
+
 // Set stores a value for given a parameter key assuming the parameter type has
 // been registered. It will panic if the parameter type has not been registered
 // or if the value cannot be encoded. A change record is also set in the Subspace's
-// transient KVStore to mark the parameter as modified.
+// transient KVStore to mark the parameter as modified. If the value changed, the
+// height is recorded as the last change height of the Subspace and the change is
+// passed to the change recorder of the Subspace.
 func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 	s.checkType(key, value)
 	store := s.kvStore(ctx)
@@ -177,6 +190,12 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, value interface{}) {
 		panic(err)
 	}
 
+	if old := store.Get(key); !bytes.Equal(old, bz) {
+		ctx.KVStore(s.key).Set(LastChangeHeightKey(s.Name()), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+		if s.recorder != nil {
+			s.recorder(ctx, s.Name(), key, old, bz)
+		}
+	}
 	store.Set(key, bz)
 
 	tstore := s.transientStore(ctx)
@@ -246,6 +265,17 @@ func (s Subspace) Name() string {
 	return string(s.name)
 }
 
+// GetLastChangeHeight returns the height at which a parameter of the Subspace
+// last changed, or zero if none was ever set.
+func (s Subspace) GetLastChangeHeight(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(s.key).Get(LastChangeHeightKey(s.Name()))
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
 // Wrapper of Subspace, provides immutable functions only
 type ReadOnlySubspace struct {
 	s Subspace
@@ -271,6 +301,12 @@ func (ros ReadOnlySubspace) Modified(ctx sdk.Context, key []byte) bool {
 	return ros.s.Modified(ctx, key)
 }
 
+// GetLastChangeHeight delegates a read-only GetLastChangeHeight call to the
+// Subspace.
+func (ros ReadOnlySubspace) GetLastChangeHeight(ctx sdk.Context) int64 {
+	return ros.s.GetLastChangeHeight(ctx)
+}
+
 // Name delegates a read-only Name call to the Subspace.
 func (ros ReadOnlySubspace) Name() string {
 	return ros.s.Name()
@@ -202,6 +202,24 @@ func (suite *SubspaceTestSuite) TestName() {
 	suite.Require().Equal("testsubspace", suite.ss.Name())
 }
 
+func (suite *SubspaceTestSuite) TestGetLastChangeHeight() {
+	suite.Require().Equal(int64(0), suite.ss.GetLastChangeHeight(suite.ctx))
+
+	suite.ss.Set(suite.ctx.WithBlockHeight(3), keyUnbondingTime, time.Hour*48)
+	suite.Require().Equal(int64(3), suite.ss.GetLastChangeHeight(suite.ctx))
+
+	// setting the same value is not a change
+	suite.ss.Set(suite.ctx.WithBlockHeight(5), keyUnbondingTime, time.Hour*48)
+	suite.Require().Equal(int64(3), suite.ss.GetLastChangeHeight(suite.ctx))
+
+	suite.Require().NoError(suite.ss.Update(suite.ctx.WithBlockHeight(7), keyUnbondingTime, []byte(`"259200000000000"`)))
+	suite.Require().Equal(int64(7), suite.ss.GetLastChangeHeight(suite.ctx))
+
+	// the height is recorded per subspace
+	ss := types.NewSubspace(suite.cdc, suite.amino, key, tkey, "testsubspace2").WithKeyTable(paramKeyTable())
+	suite.Require().Equal(int64(0), ss.GetLastChangeHeight(suite.ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(SubspaceTestSuite))
 }
@@ -0,0 +1,146 @@
+package simulation
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+)
+
+// MsgCoverage is the number of operations which generated a Msg type, and
+// the number of operations which failed to.
+type MsgCoverage struct {
+	TypeURL string `json:"type_url"`
+	OK      int    `json:"ok"`
+	Failure int    `json:"failure"`
+}
+
+// Coverage reports the Msg types generated and the module stores changed by a
+// simulation, to reveal the parts of the application it does not exercise.
+type Coverage struct {
+	Msgs []MsgCoverage `json:"msgs"`
+	// NeverGeneratedMsgs are the type URLs of the registered Msg types which no
+	// operation successfully generated.
+	NeverGeneratedMsgs []string `json:"never_generated_msgs"`
+	ChangedStores      []string `json:"changed_stores"`
+	// UnchangedStores are the names of the stores whose content is the same at
+	// the end of the simulation as after its genesis.
+	UnchangedStores []string `json:"unchanged_stores"`
+}
+
+// storeHashes maps the names of the stores to the hashes of their content.
+type storeHashes map[string][]byte
+
+// hashStores hashes the content of the given stores.
+func hashStores(ctx sdk.Context, keys map[string]*sdk.KVStoreKey) storeHashes {
+	hashes := make(storeHashes, len(keys))
+	for name, key := range keys {
+		h := sha256.New()
+		it := ctx.KVStore(key).Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			writeLengthPrefixed(h, it.Key())
+			writeLengthPrefixed(h, it.Value())
+		}
+		it.Close()
+
+		hashes[name] = h.Sum(nil)
+	}
+
+	return hashes
+}
+
+func writeLengthPrefixed(w io.Writer, bz []byte) {
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(bz)))
+	_, _ = w.Write(length[:n])
+	_, _ = w.Write(bz)
+}
+
+// newCoverage computes the coverage of a simulation from its event stats and
+// the hashes of its stores at genesis and at the end. The registered Msg types
+// are listed from the interface registry of cdc, none being reported if cdc has
+// none.
+func newCoverage(cdc codec.JSONCodec, eventStats EventStats, genesis, final storeHashes) Coverage {
+	coverage := Coverage{
+		Msgs:               []MsgCoverage{},
+		NeverGeneratedMsgs: []string{},
+		ChangedStores:      []string{},
+		UnchangedStores:    []string{},
+	}
+
+	if protoCdc, ok := cdc.(codec.ProtoCodecMarshaler); ok {
+		registry := protoCdc.InterfaceRegistry()
+		typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+		sort.Strings(typeURLs)
+
+		for _, typeURL := range typeURLs {
+			// the operations log the legacy Msgs by their route and type
+			route, name := typeURL, typeURL
+			if msg, err := registry.Resolve(typeURL); err == nil {
+				if legacyMsg, ok := msg.(legacytx.LegacyMsg); ok {
+					route, name = legacyMsg.Route(), legacyMsg.Type()
+				}
+			}
+
+			results := eventStats[route][name]
+			msgCoverage := MsgCoverage{TypeURL: typeURL, OK: results["ok"], Failure: results["failure"]}
+			coverage.Msgs = append(coverage.Msgs, msgCoverage)
+			if msgCoverage.OK == 0 {
+				coverage.NeverGeneratedMsgs = append(coverage.NeverGeneratedMsgs, typeURL)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(genesis))
+	for name := range genesis {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if string(genesis[name]) == string(final[name]) {
+			coverage.UnchangedStores = append(coverage.UnchangedStores, name)
+		} else {
+			coverage.ChangedStores = append(coverage.ChangedStores, name)
+		}
+	}
+
+	return coverage
+}
+
+// Print prints a summary of the coverage, listing its gaps.
+func (c Coverage) Print(w io.Writer) {
+	fmt.Fprintf(w, "Coverage: %d/%d Msg types generated, %d/%d stores changed\n",
+		len(c.Msgs)-len(c.NeverGeneratedMsgs), len(c.Msgs),
+		len(c.ChangedStores), len(c.ChangedStores)+len(c.UnchangedStores),
+	)
+
+	if len(c.NeverGeneratedMsgs) > 0 {
+		fmt.Fprintf(w, "Msg types never generated: %s\n", strings.Join(c.NeverGeneratedMsgs, ", "))
+	}
+
+	if len(c.UnchangedStores) > 0 {
+		fmt.Fprintf(w, "Stores never changed: %s\n", strings.Join(c.UnchangedStores, ", "))
+	}
+}
+
+// ExportJSON saves the coverage as a JSON file on a given path
+func (c Coverage) ExportJSON(path string) {
+	bz, err := json.MarshalIndent(c, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	err = ioutil.WriteFile(path, bz, 0600)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,58 @@
+package simulation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestCoverage(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	sdk.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	changedKey, unchangedKey := sdk.NewKVStoreKey("changed"), sdk.NewKVStoreKey("unchanged")
+	ctx := testutil.DefaultContext(changedKey, sdk.NewTransientStoreKey("transient"))
+	keys := map[string]*sdk.KVStoreKey{"changed": changedKey}
+
+	ctx.KVStore(changedKey).Set([]byte("key"), []byte("value"))
+	genesis := hashStores(ctx, keys)
+
+	// the same content hashes the same
+	require.Equal(t, genesis, hashStores(ctx, keys))
+
+	ctx.KVStore(changedKey).Set([]byte("key"), []byte("other value"))
+	final := hashStores(ctx, keys)
+
+	// moving a byte from the value to the key changes the hash
+	ctx.KVStore(changedKey).Delete([]byte("key"))
+	ctx.KVStore(changedKey).Set([]byte("keyv"), []byte("alue"))
+	require.NotEqual(t, genesis, hashStores(ctx, keys))
+
+	genesis[unchangedKey.Name()] = []byte("hash")
+	final[unchangedKey.Name()] = []byte("hash")
+
+	eventStats := NewEventStats()
+	eventStats.Tally(banktypes.RouterKey, banktypes.TypeMsgSend, "ok")
+	eventStats.Tally(banktypes.RouterKey, banktypes.TypeMsgSend, "failure")
+	eventStats.Tally(banktypes.RouterKey, banktypes.TypeMsgMultiSend, "failure")
+
+	coverage := newCoverage(cdc, eventStats, genesis, final)
+	require.Equal(t, Coverage{
+		Msgs: []MsgCoverage{
+			{TypeURL: sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), OK: 0, Failure: 1},
+			{TypeURL: sdk.MsgTypeURL(&banktypes.MsgSend{}), OK: 1, Failure: 1},
+			{TypeURL: sdk.MsgTypeURL(&banktypes.MsgUpdateParams{}), OK: 0, Failure: 0},
+		},
+		NeverGeneratedMsgs: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), sdk.MsgTypeURL(&banktypes.MsgUpdateParams{})},
+		ChangedStores:      []string{"changed"},
+		UnchangedStores:    []string{"unchanged"},
+	}, coverage)
+}
@@ -11,6 +11,8 @@ import (
 	cryptoenc "github.com/tendermint/tendermint/crypto/encoding"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
 type mockValidator struct {
@@ -59,6 +61,17 @@ func (vals mockValidators) getKeys() []string {
 	return keys
 }
 
+// isOperator returns true if the consensus key of acc is the key of one of the
+// validators.
+func (vals mockValidators) isOperator(acc simulation.Account) bool {
+	if acc.ConsKey == nil {
+		return false
+	}
+
+	_, ok := vals[fmt.Sprintf("%X", acc.ConsKey.PubKey().Bytes())]
+	return ok
+}
+
 // randomProposer picks a random proposer from the current validator set
 func (vals mockValidators) randomProposer(r *rand.Rand) tmbytes.HexBytes {
 	keys := vals.getKeys()
@@ -141,18 +141,26 @@ func (ops WeightedOperations) totalWeight() int {
 }
 
 func (ops WeightedOperations) getSelectOpFn() simulation.SelectOpFn {
-	totalOpWeight := ops.totalWeight()
+	selectWeightedOp := ops.getSelectWeightedOpFn()
 
 	return func(r *rand.Rand) simulation.Operation {
+		return selectWeightedOp(r).Op()
+	}
+}
+
+func (ops WeightedOperations) getSelectWeightedOpFn() func(r *rand.Rand) simulation.WeightedOperation {
+	totalOpWeight := ops.totalWeight()
+
+	return func(r *rand.Rand) simulation.WeightedOperation {
 		x := r.Intn(totalOpWeight)
 		for i := 0; i < len(ops); i++ {
 			if x <= ops[i].Weight() {
-				return ops[i].Op()
+				return ops[i]
 			}
 
 			x -= ops[i].Weight()
 		}
 		// shouldn't happen
-		return ops[0].Op()
+		return ops[0]
 	}
 }
@@ -81,6 +81,10 @@ func SimulateFromSeed(
 
 	config.ChainID = chainID
 
+	// the content of the stores after genesis, to report which ones the
+	// operations never change
+	genesisHashes := hashStores(app.NewContext(false, tmproto.Header{ChainID: chainID}), config.CoverageStores)
+
 	fmt.Printf(
 		"Starting the simulation from time %v (unixtime %v)\n",
 		genesisTimestamp.UTC().Format(time.UnixDate), genesisTimestamp.Unix(),
@@ -98,6 +102,10 @@ func SimulateFromSeed(
 	accs = tmpAccs
 	nextValidators := validators
 
+	if len(config.AccountProfiles) > 0 {
+		accs = simulation.AssignAccountProfiles(r, accs, config.AccountProfiles, validators.isOperator)
+	}
+
 	header := tmproto.Header{
 		ChainID:         config.ChainID,
 		Height:          1,
@@ -226,6 +234,8 @@ func SimulateFromSeed(
 			eventStats.Print(w)
 		}
 
+		reportCoverage(w, app, header, config, cdc, eventStats, genesisHashes)
+
 		return true, exportedParams, err
 	}
 
@@ -242,9 +252,29 @@ func SimulateFromSeed(
 		eventStats.Print(w)
 	}
 
+	reportCoverage(w, app, header, config, cdc, eventStats, genesisHashes)
+
 	return false, exportedParams, nil
 }
 
+// reportCoverage reports the Msg types the simulation never generated and the
+// stores it never changed, exporting the report if requested.
+func reportCoverage(
+	w io.Writer, app *baseapp.BaseApp, header tmproto.Header, config simulation.Config,
+	cdc codec.JSONCodec, eventStats EventStats, genesisHashes storeHashes,
+) {
+	// the state of the last block is committed if the simulation commits
+	ctx := app.NewContext(config.Commit, header)
+	coverage := newCoverage(cdc, eventStats, genesisHashes, hashStores(ctx, config.CoverageStores))
+
+	if config.ExportCoveragePath != "" {
+		fmt.Println("Exporting simulation coverage...")
+		coverage.ExportJSON(config.ExportCoveragePath)
+	} else {
+		coverage.Print(w)
+	}
+}
+
 type blockSimFn func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 	accounts []simulation.Account, header tmproto.Header) (opCount int)
 
@@ -257,7 +287,11 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 
 	lastBlockSizeState := 0 // state for [4 * uniform distribution]
 	blocksize := 0
-	selectOp := ops.getSelectOpFn()
+	selectOp := ops.getSelectWeightedOpFn()
+
+	// accounts weighted by the propensity of their profile to send the msgs
+	// of each route
+	routeAccounts := make(map[string][]simulation.Account)
 
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accounts []simulation.Account, header tmproto.Header,
@@ -269,8 +303,9 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 		lastBlockSizeState, blocksize = getBlockSize(r, params, lastBlockSizeState, config.BlockSize)
 
 		type opAndR struct {
-			op   simulation.Operation
-			rand *rand.Rand
+			op       simulation.Operation
+			accounts []simulation.Account
+			rand     *rand.Rand
 		}
 
 		opAndRz := make([]opAndR, 0, blocksize)
@@ -278,9 +313,27 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 		// Predetermine the blocksize slice so that we can do things like block
 		// out certain operations without changing the ops that follow.
 		for i := 0; i < blocksize; i++ {
+			wOp := selectOp(r)
+
+			// with account profiles, the operation favors the accounts likely
+			// to send its msgs
+			opAccounts := accounts
+			if len(config.AccountProfiles) > 0 {
+				var route string
+				if routed, ok := wOp.(simulation.RoutedWeightedOperation); ok {
+					route = routed.Route()
+				}
+
+				if _, ok := routeAccounts[route]; !ok {
+					routeAccounts[route] = simulation.WeightedProfileAccounts(accounts, config.AccountProfiles, route)
+				}
+				opAccounts = routeAccounts[route]
+			}
+
 			opAndRz = append(opAndRz, opAndR{
-				op:   selectOp(r),
-				rand: simulation.DeriveRand(r),
+				op:       wOp.Op(),
+				accounts: opAccounts,
+				rand:     simulation.DeriveRand(r),
 			})
 		}
 
@@ -288,7 +341,7 @@ func createBlockSimulator(testingMode bool, tb testing.TB, w io.Writer, params P
 			// NOTE: the Rand 'r' should not be used here.
 			opAndR := opAndRz[i]
 			op, r2 := opAndR.op, opAndR.rand
-			opMsg, futureOps, err := op(r2, app, ctx, accounts, config.ChainID)
+			opMsg, futureOps, err := op(r2, app, ctx, opAndR.accounts, config.ChainID)
 			opMsg.LogEvent(event)
 
 			if !config.Lean || opMsg.OK {
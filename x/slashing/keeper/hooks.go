@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _ sdk.ValAddress) {
@@ -54,6 +55,15 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// StakingHookEvents implements stakingtypes.StakingHooksEventFilter.
+func (h Hooks) StakingHookEvents() []string {
+	return []string{
+		stakingtypes.HookAfterValidatorCreated,
+		stakingtypes.HookAfterValidatorRemoved,
+		stakingtypes.HookAfterValidatorBonded,
+	}
+}
+
 // Implements sdk.ValidatorHooks
 func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
 	h.k.AfterValidatorBonded(ctx, consAddr, valAddr)
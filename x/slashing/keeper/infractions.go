@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -35,6 +36,18 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// That way we avoid needing to read/write the whole array each time
 	previous := k.GetValidatorMissedBlockBitArray(ctx, consAddr, index)
 	missed := !signed
+
+	// Blocks missed around an upgrade are recorded as signed, as validators are
+	// expected to be down while the chain is being upgraded.
+	if missed && k.IsInUpgradeExclusionWindow(ctx) {
+		missed = false
+
+		logger.Debug(
+			"absent validator within upgrade exclusion window",
+			"height", height,
+			"validator", consAddr.String(),
+		)
+	}
 	switch {
 	case !previous && missed:
 		// Array value has changed from not missed to missed, increment counter
@@ -67,6 +80,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			"missed", signInfo.MissedBlocksCounter,
 			"threshold", minSignedPerWindow,
 		)
+
+		telemetry.KeeperIncrCounter(types.ModuleName, "missed_block", 1)
 	}
 
 	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
@@ -112,6 +127,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"slashed", k.SlashFractionDowntime(ctx).String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
+
+			telemetry.KeeperIncrCounter(types.ModuleName, "downtime", 1)
 		} else {
 			// validator was (a) not found or (b) already jailed so we do not slash
 			logger.Info(
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
@@ -17,10 +18,20 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	sk         types.StakingKeeper
 	paramspace types.ParamSubspace
+	uk         types.UpgradeKeeper
+
+	// authority is the address allowed to update the parameters of the
+	// module, usually the gov module account.
+	authority string
 }
 
-// NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
+// NewKeeper creates a slashing keeper. The authority is the address allowed to
+// update the parameters, usually the gov module account.
+func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid slashing authority %s", authority))
+	}
+
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
 		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
@@ -31,9 +42,27 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, sk types.StakingKeeper,
 		cdc:        cdc,
 		sk:         sk,
 		paramspace: paramspace,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the address allowed to update the parameters of the
+// module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetUpgradeKeeper sets the upgrade keeper used to look up the heights of
+// scheduled and applied upgrades. Missed blocks are only excluded from downtime
+// around upgrades if an upgrade keeper is set.
+func (k *Keeper) SetUpgradeKeeper(uk types.UpgradeKeeper) {
+	if k.uk != nil {
+		panic("cannot set slashing upgrade keeper twice")
+	}
+
+	k.uk = uk
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
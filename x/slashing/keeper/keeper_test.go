@@ -9,10 +9,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestUnJailNotBonded(t *testing.T) {
@@ -276,3 +278,85 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 	tstaking.CheckValidator(valAddr, stakingtypes.Unbonding, true)
 }
+
+// Test that blocks missed within the exclusion windows around an upgrade
+// are not counted towards downtime
+func TestHandleAbsentValidatorInUpgradeExclusionWindow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.UpgradeExclusionWindowBefore = 100 * upgradetypes.DefaultBlockTime
+	params.UpgradeExclusionWindowAfter = 100 * upgradetypes.DefaultBlockTime
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// blocks are produced at the default block time, at which the upgrade time
+	// is estimated
+	start := ctx.BlockTime()
+	atHeight := func(height int64) sdk.Context {
+		return ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * upgradetypes.DefaultBlockTime))
+	}
+
+	upgradeHeight := int64(300)
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: "test", Height: upgradeHeight}))
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(_ sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+
+	// blocks before the exclusion window are signed
+	height := int64(0)
+	for ; height < upgradeHeight-100; height++ {
+		ctx = atHeight(height)
+		require.False(t, app.SlashingKeeper.IsInUpgradeExclusionWindow(ctx))
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	// blocks missed before the upgrade height are not counted
+	for ; height < upgradeHeight; height++ {
+		ctx = atHeight(height)
+		require.True(t, app.SlashingKeeper.IsInUpgradeExclusionWindow(ctx))
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+
+	ctx = atHeight(upgradeHeight)
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "test", Height: upgradeHeight})
+
+	// blocks missed after the upgrade height are not counted
+	for ; height <= upgradeHeight+100; height++ {
+		ctx = atHeight(height)
+		require.True(t, app.SlashingKeeper.IsInUpgradeExclusionWindow(ctx))
+		app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(0), info.MissedBlocksCounter)
+
+	// blocks missed after the exclusion window are counted
+	ctx = atHeight(height)
+	require.False(t, app.SlashingKeeper.IsInUpgradeExclusionWindow(ctx))
+	app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	// validator should be bonded still, should not have been jailed or slashed
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+}
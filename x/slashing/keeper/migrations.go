@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v043"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -19,3 +20,13 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3. The migration sets the upgrade
+// exclusion window parameters to their default values, which disable the
+// exclusion windows.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramspace.Set(ctx, types.KeyUpgradeExclusionWindowBefore, types.DefaultUpgradeExclusionWindowBefore)
+	m.keeper.paramspace.Set(ctx, types.KeyUpgradeExclusionWindowAfter, types.DefaultUpgradeExclusionWindowAfter)
+
+	return nil
+}
@@ -42,6 +42,49 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// UpgradeExclusionWindowBefore - duration before a scheduled upgrade during
+// which missed blocks are not counted towards downtime
+func (k Keeper) UpgradeExclusionWindowBefore(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyUpgradeExclusionWindowBefore, &res)
+	return
+}
+
+// UpgradeExclusionWindowAfter - duration after an applied upgrade during which
+// missed blocks are not counted towards downtime
+func (k Keeper) UpgradeExclusionWindowAfter(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyUpgradeExclusionWindowAfter, &res)
+	return
+}
+
+// IsInUpgradeExclusionWindow returns true if the current block time is within
+// UpgradeExclusionWindowBefore of the estimated time of the scheduled upgrade,
+// or within UpgradeExclusionWindowAfter of the time of the last applied upgrade.
+func (k Keeper) IsInUpgradeExclusionWindow(ctx sdk.Context) bool {
+	if k.uk == nil {
+		return false
+	}
+
+	blockTime := ctx.BlockTime()
+
+	if before := k.UpgradeExclusionWindowBefore(ctx); before > 0 {
+		if plan, found := k.uk.GetUpgradePlan(ctx); found {
+			if !blockTime.Before(k.uk.EstimateUpgradeTime(ctx, plan).Add(-before)) {
+				return true
+			}
+		}
+	}
+
+	if after := k.UpgradeExclusionWindowAfter(ctx); after > 0 {
+		if _, upgradeTime, found := k.uk.GetLastUpgrade(ctx); found {
+			if !blockTime.After(upgradeTime.Add(after)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -98,7 +98,9 @@ func TestMigrate(t *testing.T) {
     "min_signed_per_window": "0.500000000000000000",
     "signed_blocks_window": "100",
     "slash_fraction_double_sign": "0.050000000000000000",
-    "slash_fraction_downtime": "0.010000000000000000"
+    "slash_fraction_downtime": "0.010000000000000000",
+    "upgrade_exclusion_window_after": "0s",
+    "upgrade_exclusion_window_before": "0s"
   },
   "signing_infos": [
     {
@@ -21,6 +21,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	UpgradeExclusionWindowBefore = "upgrade_exclusion_window_before"
+	UpgradeExclusionWindowAfter  = "upgrade_exclusion_window_after"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +51,12 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenUpgradeExclusionWindow randomized UpgradeExclusionWindowBefore and
+// UpgradeExclusionWindowAfter
+func GenUpgradeExclusionWindow(r *rand.Rand) time.Duration {
+	return time.Duration(r.Intn(60*60)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,9 +89,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var upgradeExclusionWindowBefore time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UpgradeExclusionWindowBefore, &upgradeExclusionWindowBefore, simState.Rand,
+		func(r *rand.Rand) { upgradeExclusionWindowBefore = GenUpgradeExclusionWindow(r) },
+	)
+
+	var upgradeExclusionWindowAfter time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UpgradeExclusionWindowAfter, &upgradeExclusionWindowAfter, simState.Rand,
+		func(r *rand.Rand) { upgradeExclusionWindowAfter = GenUpgradeExclusionWindow(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		upgradeExclusionWindowBefore, upgradeExclusionWindowAfter,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
@@ -3,10 +3,13 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // AccountKeeper expected account keeper
@@ -30,6 +33,8 @@ type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
 	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	Set(ctx sdk.Context, key []byte, value interface{})
+	GetLastChangeHeight(ctx sdk.Context) int64
 }
 
 // StakingKeeper expected staking keeper
@@ -54,6 +59,14 @@ type StakingKeeper interface {
 	MaxValidators(sdk.Context) uint32
 }
 
+// UpgradeKeeper defines the expected interface needed to look up scheduled and
+// applied software upgrades.
+type UpgradeKeeper interface {
+	GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
+	EstimateUpgradeTime(ctx sdk.Context, plan upgradetypes.Plan) time.Time
+	GetLastUpgrade(ctx sdk.Context) (height int64, t time.Time, found bool)
+}
+
 // StakingHooks event hooks for staking validator object (noalias)
 type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)                           // Must be called when a validator is created
@@ -63,5 +63,15 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	windowBefore := data.Params.UpgradeExclusionWindowBefore
+	if windowBefore < 0 {
+		return fmt.Errorf("upgrade exclusion window before cannot be negative, is %s", windowBefore)
+	}
+
+	windowAfter := data.Params.UpgradeExclusionWindowAfter
+	if windowAfter < 0 {
+		return fmt.Errorf("upgrade exclusion window after cannot be negative, is %s", windowAfter)
+	}
+
 	return nil
 }
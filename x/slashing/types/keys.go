@@ -3,8 +3,12 @@ package types
 import (
 	"encoding/binary"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -65,3 +69,25 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// KeyFormats are the layouts of the keys of the slashing store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "validator_signing_info",
+		Prefix:   ValidatorSigningInfoKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.ConsAddress("cons_address")},
+		Value:    keyformat.ProtoValue(&ValidatorSigningInfo{}),
+	},
+	{
+		Name:     "validator_missed_block",
+		Prefix:   ValidatorMissedBlockBitArrayKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.ConsAddress("cons_address"), keyformat.Uint64LE("index")},
+		Value:    keyformat.ProtoValue(&gogotypes.BoolValue{}),
+	},
+	{
+		Name:     "address_pubkey",
+		Prefix:   AddrPubkeyRelationKeyPrefix,
+		Segments: []keyformat.Segment{keyformat.ConsAddress("cons_address")},
+		Value:    keyformat.ProtoValue(&codectypes.Any{}),
+	},
+}
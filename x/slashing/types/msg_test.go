@@ -18,3 +18,17 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority")
+	require.NoError(t, NewMsgUpdateParams(authority, DefaultParams()).ValidateBasic())
+	require.Error(t, (&MsgUpdateParams{Authority: "invalid", Params: DefaultParams()}).ValidateBasic())
+
+	params := DefaultParams()
+	params.SignedBlocksWindow = 0
+	require.Error(t, NewMsgUpdateParams(authority, params).ValidateBasic())
+
+	params = DefaultParams()
+	params.SlashFractionDowntime = sdk.NewDec(2)
+	require.Error(t, NewMsgUpdateParams(authority, params).ValidateBasic())
+}
@@ -12,6 +12,9 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	DefaultUpgradeExclusionWindowBefore = time.Duration(0)
+	DefaultUpgradeExclusionWindowAfter  = time.Duration(0)
 )
 
 var (
@@ -27,6 +30,9 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyUpgradeExclusionWindowBefore = []byte("UpgradeExclusionWindowBefore")
+	KeyUpgradeExclusionWindowAfter  = []byte("UpgradeExclusionWindowAfter")
 )
 
 // ParamKeyTable for slashing module
@@ -38,14 +44,17 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	upgradeExclusionWindowBefore, upgradeExclusionWindowAfter time.Duration,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:           signedBlocksWindow,
+		MinSignedPerWindow:           minSignedPerWindow,
+		DowntimeJailDuration:         downtimeJailDuration,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		UpgradeExclusionWindowBefore: upgradeExclusionWindowBefore,
+		UpgradeExclusionWindowAfter:  upgradeExclusionWindowAfter,
 	}
 }
 
@@ -57,6 +66,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyUpgradeExclusionWindowBefore, &p.UpgradeExclusionWindowBefore, validateUpgradeExclusionWindow),
+		paramtypes.NewParamSetPair(KeyUpgradeExclusionWindowAfter, &p.UpgradeExclusionWindowAfter, validateUpgradeExclusionWindow),
 	}
 }
 
@@ -65,9 +76,39 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultUpgradeExclusionWindowBefore, DefaultUpgradeExclusionWindowAfter,
 	)
 }
 
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+
+	if err := validateUpgradeExclusionWindow(p.UpgradeExclusionWindowBefore); err != nil {
+		return err
+	}
+
+	return validateUpgradeExclusionWindow(p.UpgradeExclusionWindowAfter)
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -141,3 +182,16 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateUpgradeExclusionWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("upgrade exclusion window cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -70,6 +70,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 // QueryParamsResponse is the response type for the Query/Params RPC method
 type QueryParamsResponse struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_changed_height is the height at which the parameters last changed.
+	LastChangedHeight int64 `protobuf:"varint,2,opt,name=last_changed_height,json=lastChangedHeight,proto3" json:"last_changed_height,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -112,6 +114,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangedHeight() int64 {
+	if m != nil {
+		return m.LastChangedHeight
+	}
+	return 0
+}
+
 // QuerySigningInfoRequest is the request type for the Query/SigningInfo RPC
 // method
 type QuerySigningInfoRequest struct {
@@ -321,40 +330,42 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 526 bytes of a gzipped FileDescriptorProto
+	// 556 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0x6d, 0x0d, 0x38, 0x29, 0x22, 0x63, 0xa1, 0x35, 0xc8, 0xc6, 0xae, 0x90, 0x16,
-	0x35, 0x3b, 0x26, 0x22, 0x5e, 0xec, 0xc1, 0x1e, 0x0c, 0xde, 0x34, 0x8a, 0x07, 0x41, 0xc2, 0x6c,
-	0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0xfb, 0x36, 0xc1, 0x20, 0x5e, 0x3c, 0x7b, 0x10, 0xfc, 0x0c,
-	0x1e, 0x3d, 0xf8, 0x2d, 0x7a, 0x2c, 0x78, 0xf1, 0x24, 0x92, 0xf8, 0x09, 0xfc, 0x04, 0x92, 0x99,
-	0x49, 0xb2, 0x25, 0xae, 0xa6, 0x3d, 0xed, 0xf0, 0xe6, 0xfd, 0xdf, 0xff, 0xf7, 0xe6, 0x3d, 0x16,
-	0xdf, 0xe8, 0x68, 0xe8, 0x69, 0xa0, 0x10, 0x31, 0x38, 0x94, 0x4a, 0xd0, 0x41, 0x3d, 0xe4, 0x29,
-	0xab, 0xd3, 0xa3, 0x3e, 0x4f, 0x86, 0x41, 0x9c, 0xe8, 0x54, 0x93, 0x4d, 0x9b, 0x14, 0x4c, 0x93,
-	0x02, 0x97, 0x54, 0xbe, 0xe9, 0xd4, 0x21, 0x03, 0x6e, 0x15, 0x33, 0x7d, 0xcc, 0x84, 0x54, 0x2c,
-	0x95, 0x5a, 0xd9, 0x22, 0xe5, 0x0d, 0xa1, 0x85, 0x36, 0x47, 0x3a, 0x39, 0xb9, 0xe8, 0x35, 0xa1,
-	0xb5, 0x88, 0x38, 0x65, 0xb1, 0xa4, 0x4c, 0x29, 0x9d, 0x1a, 0x09, 0xb8, 0xdb, 0x6a, 0x1e, 0xdd,
-	0x8c, 0xc4, 0xe4, 0xf9, 0x1b, 0x98, 0x3c, 0x9d, 0xb8, 0x3f, 0x61, 0x09, 0xeb, 0x41, 0x8b, 0x1f,
-	0xf5, 0x39, 0xa4, 0xfe, 0x73, 0x7c, 0xe5, 0x54, 0x14, 0x62, 0xad, 0x80, 0x93, 0x3d, 0x5c, 0x8c,
-	0x4d, 0x64, 0x0b, 0x5d, 0x47, 0xbb, 0xa5, 0x46, 0x25, 0xc8, 0x69, 0x2f, 0xb0, 0xc2, 0xfd, 0xb5,
-	0xe3, 0x1f, 0x95, 0x42, 0xcb, 0x89, 0xfc, 0x07, 0x78, 0xd3, 0x54, 0x7d, 0x26, 0x85, 0x92, 0x4a,
-	0x3c, 0x56, 0x07, 0xda, 0x19, 0x92, 0x6d, 0xbc, 0xde, 0xd1, 0x0a, 0xda, 0xac, 0xdb, 0x4d, 0x38,
-	0xd8, 0xfa, 0x17, 0x5b, 0xa5, 0x49, 0xec, 0xa1, 0x0d, 0xf9, 0x43, 0xbc, 0xb5, 0xa8, 0x76, 0x60,
-	0xaf, 0xf0, 0xe5, 0x01, 0x8b, 0xda, 0x60, 0xaf, 0xda, 0x52, 0x1d, 0x68, 0x87, 0x58, 0xcb, 0x45,
-	0x7c, 0xc1, 0x22, 0xd9, 0x65, 0xa9, 0x4e, 0x32, 0x05, 0x1d, 0xf0, 0xa5, 0x01, 0x8b, 0x32, 0x51,
-	0x3f, 0x5c, 0xb4, 0x9e, 0x3e, 0x15, 0x79, 0x84, 0xf1, 0x7c, 0x60, 0xce, 0xb4, 0x3a, 0x35, 0x9d,
-	0x4c, 0x37, 0xb0, 0xfb, 0x30, 0x7f, 0x19, 0xc1, 0x9d, 0xb6, 0x95, 0x51, 0xfa, 0x5f, 0x10, 0xbe,
-	0xfa, 0x17, 0x13, 0xd7, 0x60, 0x13, 0xaf, 0xb9, 0xa6, 0x56, 0xcf, 0xdb, 0x94, 0x29, 0x40, 0x9a,
-	0xa7, 0x70, 0x57, 0x0c, 0xee, 0xce, 0x7f, 0x71, 0x2d, 0x45, 0x96, 0xb7, 0xf1, 0x7b, 0x15, 0x5f,
-	0x30, 0xbc, 0xe4, 0x03, 0xc2, 0x45, 0x3b, 0x6f, 0x72, 0x2b, 0x17, 0x6c, 0x71, 0xc9, 0xca, 0xb7,
-	0x97, 0x4b, 0xb6, 0xde, 0xfe, 0xce, 0xfb, 0x6f, 0xbf, 0x3e, 0xad, 0x6c, 0x93, 0x0a, 0xcd, 0xdb,
-	0x6c, 0xbb, 0x65, 0xe4, 0x2b, 0xc2, 0xa5, 0x4c, 0xf7, 0xe4, 0xce, 0xbf, 0x6d, 0x16, 0x97, 0xb1,
-	0x5c, 0x3f, 0x83, 0xc2, 0xd1, 0xed, 0x19, 0xba, 0xfb, 0xe4, 0x5e, 0x2e, 0x5d, 0x76, 0x37, 0x81,
-	0xbe, 0xcd, 0x6e, 0xfb, 0x3b, 0xf2, 0x19, 0xe1, 0xf5, 0xec, 0xdc, 0xc9, 0xf2, 0x08, 0xb3, 0xe7,
-	0x6c, 0x9c, 0x45, 0xe2, 0xb0, 0x03, 0x83, 0xbd, 0x4b, 0xaa, 0xcb, 0x61, 0xef, 0x37, 0x8f, 0x47,
-	0x1e, 0x3a, 0x19, 0x79, 0xe8, 0xe7, 0xc8, 0x43, 0x1f, 0xc7, 0x5e, 0xe1, 0x64, 0xec, 0x15, 0xbe,
-	0x8f, 0xbd, 0xc2, 0xcb, 0x9a, 0x90, 0xe9, 0x61, 0x3f, 0x0c, 0x3a, 0xba, 0x37, 0xad, 0x65, 0x3f,
-	0x35, 0xe8, 0xbe, 0xa6, 0x6f, 0xe6, 0x85, 0xd3, 0x61, 0xcc, 0x21, 0x2c, 0x9a, 0xbf, 0xcf, 0xdd,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x0f, 0xc0, 0xe6, 0x45, 0x05, 0x00, 0x00,
+	0x14, 0xc7, 0x33, 0x4d, 0x0d, 0x38, 0x29, 0xa2, 0xd3, 0x42, 0x63, 0x90, 0x4d, 0xbb, 0x42, 0x1a,
+	0xd4, 0xec, 0x9a, 0x88, 0x78, 0xb1, 0x07, 0x2b, 0x18, 0xbd, 0x69, 0x04, 0x0f, 0x82, 0x84, 0x49,
+	0x32, 0x9d, 0x1d, 0xdc, 0xcc, 0x6c, 0x77, 0x26, 0xc1, 0x20, 0x5e, 0xc4, 0xa3, 0x07, 0xc1, 0xcf,
+	0xe0, 0xd1, 0x83, 0xdf, 0xa2, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0x89, 0x9f, 0xc0, 0x4f, 0x20, 0x3b,
+	0x33, 0x49, 0xb6, 0xc4, 0xd5, 0xd4, 0x53, 0x86, 0xf7, 0xde, 0xff, 0xbd, 0xdf, 0x7f, 0xe6, 0x65,
+	0xe1, 0xd5, 0x9e, 0x90, 0x03, 0x21, 0x7d, 0x19, 0x62, 0x19, 0x30, 0x4e, 0xfd, 0x51, 0xa3, 0x4b,
+	0x14, 0x6e, 0xf8, 0x47, 0x43, 0x12, 0x8f, 0xbd, 0x28, 0x16, 0x4a, 0xa0, 0x6d, 0x53, 0xe4, 0xcd,
+	0x8a, 0x3c, 0x5b, 0x54, 0xbe, 0x66, 0xd5, 0x5d, 0x2c, 0x89, 0x51, 0xcc, 0xf5, 0x11, 0xa6, 0x8c,
+	0x63, 0xc5, 0x04, 0x37, 0x4d, 0xca, 0x5b, 0x54, 0x50, 0xa1, 0x8f, 0x7e, 0x72, 0xb2, 0xd1, 0x2b,
+	0x54, 0x08, 0x1a, 0x12, 0x1f, 0x47, 0xcc, 0xc7, 0x9c, 0x0b, 0xa5, 0x25, 0xd2, 0x66, 0xab, 0x59,
+	0x74, 0x73, 0x12, 0x5d, 0xe7, 0x6e, 0x41, 0xf4, 0x24, 0x99, 0xfe, 0x18, 0xc7, 0x78, 0x20, 0xdb,
+	0xe4, 0x68, 0x48, 0xa4, 0x72, 0xdf, 0x01, 0xb8, 0x79, 0x2a, 0x2c, 0x23, 0xc1, 0x25, 0x41, 0xfb,
+	0xb0, 0x10, 0xe9, 0x48, 0x09, 0xec, 0x80, 0x5a, 0xb1, 0x59, 0xf1, 0x32, 0xfc, 0x79, 0x46, 0x78,
+	0xb0, 0x7e, 0xfc, 0xbd, 0x92, 0x6b, 0x5b, 0x11, 0xf2, 0xe0, 0x66, 0x88, 0xa5, 0xea, 0xf4, 0x02,
+	0xcc, 0x29, 0xe9, 0x77, 0x02, 0xc2, 0x68, 0xa0, 0x4a, 0x6b, 0x3b, 0xa0, 0x96, 0x6f, 0x5f, 0x4a,
+	0x52, 0xf7, 0x4d, 0xe6, 0xa1, 0x4e, 0xb8, 0x77, 0xe1, 0xb6, 0xa6, 0x78, 0xca, 0x28, 0x67, 0x9c,
+	0x3e, 0xe2, 0x87, 0xc2, 0x12, 0xa2, 0x5d, 0xb8, 0xd1, 0x13, 0x5c, 0x76, 0x70, 0xbf, 0x1f, 0x13,
+	0x69, 0x78, 0xce, 0xb7, 0x8b, 0x49, 0xec, 0x9e, 0x09, 0xb9, 0x63, 0x58, 0x5a, 0x56, 0x5b, 0x23,
+	0x2f, 0xe0, 0xc5, 0x11, 0x0e, 0x3b, 0xd2, 0xa4, 0x3a, 0x8c, 0x1f, 0x0a, 0x6b, 0xa9, 0x9e, 0x69,
+	0xe9, 0x19, 0x0e, 0x59, 0x1f, 0x2b, 0x11, 0xa7, 0x1a, 0x5a, 0x83, 0x17, 0x46, 0x38, 0x4c, 0x45,
+	0xdd, 0xee, 0xf2, 0xe8, 0xd9, 0xdd, 0xa2, 0x07, 0x10, 0x2e, 0x5e, 0xd8, 0x0e, 0xad, 0xce, 0x86,
+	0x26, 0xeb, 0xe0, 0x99, 0x05, 0x5a, 0xdc, 0x24, 0x25, 0x56, 0xdb, 0x4e, 0x29, 0xdd, 0xcf, 0x00,
+	0x5e, 0xfe, 0xc3, 0x10, 0x6b, 0xb0, 0x05, 0xd7, 0xad, 0xa9, 0xfc, 0xff, 0x9a, 0xd2, 0x0d, 0x50,
+	0xeb, 0x14, 0xee, 0x9a, 0xc6, 0xdd, 0xfb, 0x27, 0xae, 0xa1, 0x48, 0xf3, 0x36, 0x7f, 0xe5, 0xe1,
+	0x39, 0xcd, 0x8b, 0xde, 0x03, 0x58, 0x30, 0xfb, 0x81, 0xae, 0x67, 0x82, 0x2d, 0x6f, 0x65, 0xf9,
+	0xc6, 0x6a, 0xc5, 0x66, 0xb6, 0xbb, 0xf7, 0xf6, 0xeb, 0xcf, 0x8f, 0x6b, 0xbb, 0xa8, 0xe2, 0x67,
+	0xfd, 0x15, 0xec, 0x56, 0x7e, 0x01, 0xb0, 0x98, 0x72, 0x8f, 0x6e, 0xfe, 0x7d, 0xcc, 0xf2, 0x32,
+	0x96, 0x1b, 0x67, 0x50, 0x58, 0xba, 0x7d, 0x4d, 0x77, 0x07, 0xdd, 0xce, 0xa4, 0x4b, 0xef, 0xa6,
+	0xf4, 0x5f, 0xa7, 0xb7, 0xfd, 0x0d, 0xfa, 0x04, 0xe0, 0x46, 0xfa, 0xdd, 0xd1, 0xea, 0x08, 0xf3,
+	0xeb, 0x6c, 0x9e, 0x45, 0x62, 0xb1, 0x3d, 0x8d, 0x5d, 0x43, 0xd5, 0xd5, 0xb0, 0x0f, 0x5a, 0xc7,
+	0x13, 0x07, 0x9c, 0x4c, 0x1c, 0xf0, 0x63, 0xe2, 0x80, 0x0f, 0x53, 0x27, 0x77, 0x32, 0x75, 0x72,
+	0xdf, 0xa6, 0x4e, 0xee, 0x79, 0x9d, 0x32, 0x15, 0x0c, 0xbb, 0x5e, 0x4f, 0x0c, 0x66, 0xbd, 0xcc,
+	0x4f, 0x5d, 0xf6, 0x5f, 0xfa, 0xaf, 0x16, 0x8d, 0xd5, 0x38, 0x22, 0xb2, 0x5b, 0xd0, 0x9f, 0xab,
+	0x5b, 0xbf, 0x07, 0x00, 0x63, 0x12, 0xd0, 0x40, 0x76, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -558,6 +569,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastChangedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -746,6 +762,9 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangedHeight))
+	}
 	return n
 }
 
@@ -923,6 +942,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedHeight", wireType)
+			}
+			m.LastChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
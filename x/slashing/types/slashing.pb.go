@@ -130,6 +130,12 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// upgrade_exclusion_window_before is the duration before the time of a scheduled
+	// upgrade during which missed blocks are not counted towards downtime.
+	UpgradeExclusionWindowBefore time.Duration `protobuf:"bytes,6,opt,name=upgrade_exclusion_window_before,json=upgradeExclusionWindowBefore,proto3,stdduration" json:"upgrade_exclusion_window_before" yaml:"upgrade_exclusion_window_before"`
+	// upgrade_exclusion_window_after is the duration after the time of the last
+	// applied upgrade during which missed blocks are not counted towards downtime.
+	UpgradeExclusionWindowAfter time.Duration `protobuf:"bytes,7,opt,name=upgrade_exclusion_window_after,json=upgradeExclusionWindowAfter,proto3,stdduration" json:"upgrade_exclusion_window_after" yaml:"upgrade_exclusion_window_after"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -179,6 +185,20 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetUpgradeExclusionWindowBefore() time.Duration {
+	if m != nil {
+		return m.UpgradeExclusionWindowBefore
+	}
+	return 0
+}
+
+func (m *Params) GetUpgradeExclusionWindowAfter() time.Duration {
+	if m != nil {
+		return m.UpgradeExclusionWindowAfter
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
@@ -189,47 +209,52 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbd, 0x6f, 0xd3, 0x4e,
-	0x18, 0xce, 0xfd, 0xd2, 0x5f, 0x29, 0x97, 0x4c, 0x6e, 0x4a, 0x4c, 0x00, 0x3b, 0x78, 0xa8, 0xc2,
-	0x50, 0x5b, 0x2d, 0x5b, 0x47, 0x53, 0x21, 0x3e, 0x24, 0x28, 0x6e, 0x01, 0x89, 0x01, 0xeb, 0x9c,
-	0xbb, 0x38, 0x47, 0xed, 0xbb, 0xc8, 0x77, 0xa1, 0x2d, 0x1b, 0x5b, 0xc7, 0x8e, 0x1d, 0x3b, 0xf2,
-	0xa7, 0x74, 0xec, 0x88, 0x18, 0x02, 0x4a, 0x17, 0xe6, 0x6e, 0x6c, 0xc8, 0x77, 0x76, 0x1b, 0xa5,
-	0x29, 0x52, 0xa7, 0xe4, 0x7d, 0xde, 0xe7, 0x7d, 0xee, 0x79, 0x3f, 0x12, 0xb8, 0xdc, 0xe5, 0x22,
-	0xe5, 0xc2, 0x13, 0x09, 0x12, 0x7d, 0xca, 0x62, 0xef, 0xf3, 0x6a, 0x44, 0x24, 0x5a, 0xbd, 0x00,
-	0xdc, 0x41, 0xc6, 0x25, 0x37, 0x9a, 0x9a, 0xe7, 0x5e, 0xc0, 0x05, 0xaf, 0xd5, 0x88, 0x79, 0xcc,
-	0x15, 0xc7, 0xcb, 0xbf, 0x69, 0x7a, 0xcb, 0x8a, 0x39, 0x8f, 0x13, 0xe2, 0xa9, 0x28, 0x1a, 0xf6,
-	0x3c, 0x3c, 0xcc, 0x90, 0xa4, 0x9c, 0x15, 0x79, 0x7b, 0x3a, 0x2f, 0x69, 0x4a, 0x84, 0x44, 0xe9,
-	0x40, 0x13, 0x9c, 0x83, 0x2a, 0x6c, 0xbc, 0x43, 0x09, 0xc5, 0x48, 0xf2, 0x6c, 0x8b, 0xc6, 0x8c,
-	0xb2, 0xf8, 0x39, 0xeb, 0x71, 0xc3, 0x84, 0xb7, 0x10, 0xc6, 0x19, 0x11, 0xc2, 0x04, 0x6d, 0xd0,
-	0xb9, 0x1d, 0x94, 0xa1, 0xb1, 0x0e, 0xeb, 0x42, 0xa2, 0x4c, 0x86, 0x7d, 0x42, 0xe3, 0xbe, 0x34,
-	0xff, 0x6b, 0x83, 0x4e, 0xd5, 0x6f, 0x9e, 0x8f, 0xec, 0xc5, 0x7d, 0x94, 0x26, 0xeb, 0xce, 0x64,
-	0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xa6, 0xa2, 0xbc, 0x96, 0x32, 0x4c, 0xf6, 0x42, 0xde, 0xeb, 0x09,
-	0x22, 0xcd, 0xea, 0x74, 0xed, 0x64, 0xd6, 0x09, 0x6a, 0x2a, 0x7c, 0xad, 0x22, 0xe3, 0x23, 0xac,
-	0x7f, 0x42, 0x34, 0x21, 0x38, 0x1c, 0x32, 0x49, 0x13, 0x73, 0xae, 0x0d, 0x3a, 0xb5, 0xb5, 0x96,
-	0xab, 0x5b, 0x74, 0xcb, 0x16, 0xdd, 0xed, 0xb2, 0x45, 0xdf, 0x3e, 0x19, 0xd9, 0x95, 0x4b, 0xed,
-	0xc9, 0x6a, 0xe7, 0xf0, 0xa7, 0x0d, 0x82, 0x9a, 0x86, 0xde, 0xe6, 0x88, 0x61, 0x41, 0x28, 0x79,
-	0x1a, 0x09, 0xc9, 0x19, 0xc1, 0xe6, 0xff, 0x6d, 0xd0, 0x59, 0x08, 0x26, 0x10, 0x63, 0x1b, 0x2e,
-	0xa5, 0x54, 0x08, 0x82, 0xc3, 0x28, 0xe1, 0xdd, 0x1d, 0x11, 0x76, 0xf9, 0x90, 0x49, 0x92, 0x99,
-	0xf3, 0xaa, 0x89, 0xf6, 0xf9, 0xc8, 0xbe, 0xaf, 0x1f, 0x9a, 0x49, 0x73, 0x82, 0x45, 0x8d, 0xfb,
-	0x0a, 0x7e, 0xa2, 0xd1, 0xf5, 0x85, 0xa3, 0x63, 0xbb, 0xf2, 0xfb, 0xd8, 0x06, 0xce, 0x9f, 0x39,
-	0x38, 0xbf, 0x89, 0x32, 0x94, 0x0a, 0xe3, 0x0d, 0x6c, 0x08, 0x1a, 0xb3, 0x4b, 0x8d, 0x5d, 0xca,
-	0x30, 0xdf, 0x55, 0x9b, 0xa8, 0xfa, 0xf6, 0xf9, 0xc8, 0xbe, 0x57, 0x8c, 0x7a, 0x06, 0xcb, 0x09,
-	0x0c, 0x0d, 0xeb, 0x87, 0xde, 0x2b, 0xd0, 0xf8, 0x0a, 0x72, 0xfb, 0x2c, 0x2c, 0x2a, 0x06, 0x24,
-	0x2b, 0x45, 0xf3, 0xfd, 0xd5, 0xfd, 0x57, 0xf9, 0xac, 0x7e, 0x8c, 0xec, 0xe5, 0x98, 0xca, 0xfe,
-	0x30, 0x72, 0xbb, 0x3c, 0xf5, 0x8a, 0x9b, 0xd5, 0x1f, 0x2b, 0x02, 0xef, 0x78, 0x72, 0x7f, 0x40,
-	0x84, 0xbb, 0x41, 0xba, 0x93, 0xcd, 0xce, 0x10, 0x75, 0x02, 0x23, 0xa5, 0x6c, 0x4b, 0xc1, 0x9b,
-	0x24, 0x2b, 0x3c, 0x7c, 0x81, 0x77, 0x30, 0xdf, 0x65, 0xf9, 0x0d, 0x86, 0xf9, 0xe4, 0xc3, 0xf2,
-	0x5a, 0xd5, 0x1d, 0xd4, 0xd6, 0xee, 0x5e, 0xd9, 0xe5, 0x46, 0x41, 0xf0, 0x1f, 0x15, 0xab, 0x7c,
-	0xa0, 0x1f, 0x9d, 0x2d, 0xe3, 0x1c, 0xe5, 0x4b, 0x6d, 0x94, 0xc9, 0x17, 0x88, 0x26, 0xa5, 0x80,
-	0x71, 0x08, 0x60, 0x4b, 0xfd, 0xa8, 0xc2, 0x5e, 0x86, 0xba, 0x39, 0x14, 0x62, 0x3e, 0x8c, 0x12,
-	0xa2, 0xcc, 0xab, 0x63, 0xaa, 0xfb, 0x5b, 0x37, 0x1e, 0xc2, 0xc3, 0x62, 0x0f, 0xd7, 0x2a, 0x3b,
-	0x41, 0x53, 0x25, 0x9f, 0x16, 0xb9, 0x0d, 0x95, 0xca, 0x27, 0x63, 0x1c, 0x00, 0xd8, 0xbc, 0x52,
-	0xa8, 0xad, 0xab, 0xf3, 0xab, 0xfb, 0x9b, 0x37, 0xf6, 0x63, 0x5d, 0xe3, 0x47, 0xcb, 0x3a, 0xc1,
-	0xd2, 0x94, 0x19, 0x8d, 0xfb, 0x2f, 0xbf, 0x8d, 0x2d, 0x70, 0x32, 0xb6, 0xc0, 0xe9, 0xd8, 0x02,
-	0xbf, 0xc6, 0x16, 0x38, 0x3c, 0xb3, 0x2a, 0xa7, 0x67, 0x56, 0xe5, 0xfb, 0x99, 0x55, 0xf9, 0xb0,
-	0xf2, 0xcf, 0xe7, 0xf7, 0x2e, 0xff, 0xd4, 0x94, 0x93, 0x68, 0x5e, 0xad, 0xef, 0xf1, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0x78, 0xfd, 0x40, 0xf4, 0x04, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0x11, 0x48, 0xcb, 0x25, 0x93, 0x9b, 0x12, 0x93, 0x16, 0x3b, 0x58, 0xa2, 0x0a, 0x43,
+	0x13, 0xb5, 0x6c, 0xdd, 0x30, 0x05, 0xf1, 0x43, 0x82, 0xe2, 0x16, 0x90, 0x18, 0xb0, 0xce, 0xf1,
+	0xc5, 0x39, 0x6a, 0xfb, 0x22, 0xdf, 0x99, 0xb6, 0x6c, 0x6c, 0x1d, 0x8b, 0xc4, 0xd0, 0xb1, 0x03,
+	0x03, 0x7f, 0x4a, 0xc7, 0x8e, 0x88, 0x21, 0xa0, 0x74, 0x61, 0xee, 0x5f, 0x80, 0xee, 0xce, 0x6e,
+	0xa3, 0x36, 0x25, 0xea, 0xd4, 0x7e, 0xef, 0x7b, 0xdf, 0xbb, 0x77, 0xf7, 0x3e, 0x07, 0x2e, 0x74,
+	0x28, 0x8b, 0x28, 0x6b, 0xb3, 0x10, 0xb1, 0x1e, 0x89, 0x83, 0xf6, 0xa7, 0x25, 0x0f, 0x73, 0xb4,
+	0x74, 0x0a, 0xb4, 0xfa, 0x09, 0xe5, 0x54, 0xab, 0x29, 0x5e, 0xeb, 0x14, 0xce, 0x78, 0xf5, 0x6a,
+	0x40, 0x03, 0x2a, 0x39, 0x6d, 0xf1, 0x9f, 0xa2, 0xd7, 0x8d, 0x80, 0xd2, 0x20, 0xc4, 0x6d, 0x59,
+	0x79, 0x69, 0xb7, 0xed, 0xa7, 0x09, 0xe2, 0x84, 0xc6, 0x59, 0xdf, 0x3c, 0xdf, 0xe7, 0x24, 0xc2,
+	0x8c, 0xa3, 0xa8, 0xaf, 0x08, 0xd6, 0x6e, 0x11, 0x56, 0xdf, 0xa2, 0x90, 0xf8, 0x88, 0xd3, 0x64,
+	0x9d, 0x04, 0x31, 0x89, 0x83, 0x67, 0x71, 0x97, 0x6a, 0x3a, 0x9c, 0x42, 0xbe, 0x9f, 0x60, 0xc6,
+	0x74, 0xd0, 0x00, 0xcd, 0x9b, 0x4e, 0x5e, 0x6a, 0x2b, 0xb0, 0xc2, 0x38, 0x4a, 0xb8, 0xdb, 0xc3,
+	0x24, 0xe8, 0x71, 0xfd, 0x5a, 0x03, 0x34, 0x8b, 0x76, 0xed, 0x64, 0x60, 0xce, 0xec, 0xa0, 0x28,
+	0x5c, 0xb1, 0x46, 0xbb, 0x96, 0x53, 0x96, 0xe5, 0x53, 0x59, 0x89, 0x59, 0x12, 0xfb, 0x78, 0xdb,
+	0xa5, 0xdd, 0x2e, 0xc3, 0x5c, 0x2f, 0x9e, 0x9f, 0x1d, 0xed, 0x5a, 0x4e, 0x59, 0x96, 0xaf, 0x64,
+	0xa5, 0x7d, 0x80, 0x95, 0x8f, 0x88, 0x84, 0xd8, 0x77, 0xd3, 0x98, 0x93, 0x50, 0xbf, 0xde, 0x00,
+	0xcd, 0xf2, 0x72, 0xbd, 0xa5, 0xae, 0xd8, 0xca, 0xaf, 0xd8, 0xda, 0xc8, 0xaf, 0x68, 0x9b, 0x87,
+	0x03, 0xb3, 0x70, 0xa6, 0x3d, 0x3a, 0x6d, 0xed, 0xfd, 0x36, 0x81, 0x53, 0x56, 0xd0, 0x1b, 0x81,
+	0x68, 0x06, 0x84, 0x9c, 0x46, 0x1e, 0xe3, 0x34, 0xc6, 0xbe, 0x7e, 0xa3, 0x01, 0x9a, 0xd3, 0xce,
+	0x08, 0xa2, 0x6d, 0xc0, 0xd9, 0x88, 0x30, 0x86, 0x7d, 0xd7, 0x0b, 0x69, 0x67, 0x93, 0xb9, 0x1d,
+	0x9a, 0xc6, 0x1c, 0x27, 0x7a, 0x49, 0x5e, 0xa2, 0x71, 0x32, 0x30, 0xe7, 0xd5, 0x41, 0x63, 0x69,
+	0x96, 0x33, 0xa3, 0x70, 0x5b, 0xc2, 0x8f, 0x14, 0xba, 0x32, 0xbd, 0x7f, 0x60, 0x16, 0xfe, 0x1e,
+	0x98, 0xc0, 0xfa, 0x3e, 0x05, 0x4b, 0x6b, 0x28, 0x41, 0x11, 0xd3, 0x5e, 0xc3, 0x2a, 0x23, 0x41,
+	0x7c, 0xa6, 0xb1, 0x45, 0x62, 0x9f, 0x6e, 0xc9, 0x24, 0x8a, 0xb6, 0x79, 0x32, 0x30, 0xe7, 0xb2,
+	0xa7, 0x1e, 0xc3, 0xb2, 0x1c, 0x4d, 0xc1, 0xea, 0xa0, 0x77, 0x12, 0xd4, 0xbe, 0x00, 0x61, 0x3f,
+	0x76, 0xb3, 0x89, 0x3e, 0x4e, 0x72, 0x51, 0x91, 0x5f, 0xc5, 0x7e, 0x29, 0xde, 0xea, 0xd7, 0xc0,
+	0x5c, 0x08, 0x08, 0xef, 0xa5, 0x5e, 0xab, 0x43, 0xa3, 0x76, 0xb6, 0xb3, 0xea, 0xcf, 0x22, 0xf3,
+	0x37, 0xdb, 0x7c, 0xa7, 0x8f, 0x59, 0x6b, 0x15, 0x77, 0x46, 0x2f, 0x3b, 0x46, 0xd4, 0x72, 0xb4,
+	0x88, 0xc4, 0xeb, 0x12, 0x5e, 0xc3, 0x49, 0xe6, 0xe1, 0x33, 0xbc, 0xe5, 0xd3, 0xad, 0x58, 0xec,
+	0xa0, 0x2b, 0x5e, 0xde, 0xcd, 0xb7, 0x55, 0xee, 0x41, 0x79, 0xf9, 0xf6, 0x85, 0x2c, 0x57, 0x33,
+	0x82, 0x7d, 0x3f, 0x8b, 0xf2, 0x8e, 0x3a, 0x74, 0xbc, 0x8c, 0xb5, 0x2f, 0x42, 0xad, 0xe6, 0xcd,
+	0xe7, 0x88, 0x84, 0xb9, 0x80, 0xb6, 0x07, 0x60, 0x5d, 0x7e, 0x54, 0x6e, 0x37, 0x41, 0x1d, 0x01,
+	0xb9, 0x3e, 0x4d, 0xbd, 0x10, 0x4b, 0xf3, 0x72, 0x99, 0x2a, 0xf6, 0xfa, 0x95, 0x1f, 0xe1, 0x6e,
+	0x96, 0xc3, 0xa5, 0xca, 0x96, 0x53, 0x93, 0xcd, 0x27, 0x59, 0x6f, 0x55, 0xb6, 0xc4, 0xcb, 0x68,
+	0xbb, 0x00, 0xd6, 0x2e, 0x0c, 0x2a, 0xeb, 0x72, 0xfd, 0x2a, 0xf6, 0xda, 0x95, 0xfd, 0x18, 0x97,
+	0xf8, 0x51, 0xb2, 0x96, 0x33, 0x7b, 0xce, 0x8c, 0xc2, 0xb5, 0x6f, 0x00, 0x9a, 0x69, 0x3f, 0x48,
+	0x90, 0x8f, 0x5d, 0xbc, 0xdd, 0x09, 0x53, 0x26, 0xc6, 0x54, 0x96, 0xae, 0x87, 0xbb, 0x34, 0xc1,
+	0x7a, 0x69, 0x52, 0x46, 0xcb, 0x59, 0x46, 0x0b, 0xca, 0xc3, 0x04, 0x3d, 0x15, 0xd6, 0x7c, 0xc6,
+	0x7a, 0x9c, 0x93, 0xd4, 0xaa, 0xd8, 0x92, 0xa2, 0x7d, 0x05, 0xd0, 0xb8, 0x54, 0x06, 0x75, 0xc5,
+	0xc7, 0x37, 0x35, 0xc9, 0xd5, 0x52, 0xe6, 0xea, 0xde, 0x04, 0x57, 0x52, 0x4e, 0x99, 0x9a, 0x1b,
+	0x6f, 0xea, 0xa1, 0x60, 0xd8, 0x2f, 0x7e, 0x0c, 0x0d, 0x70, 0x38, 0x34, 0xc0, 0xd1, 0xd0, 0x00,
+	0x7f, 0x86, 0x06, 0xd8, 0x3b, 0x36, 0x0a, 0x47, 0xc7, 0x46, 0xe1, 0xe7, 0xb1, 0x51, 0x78, 0xbf,
+	0xf8, 0xdf, 0xa4, 0xb6, 0xcf, 0x7e, 0xff, 0x65, 0x68, 0x5e, 0x49, 0xfa, 0x7d, 0xf0, 0x6f, 0x00,
+	0x61, 0xf9, 0xd5, 0x47, 0x1f, 0x06, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -305,6 +330,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.UpgradeExclusionWindowBefore != that1.UpgradeExclusionWindowBefore {
+		return false
+	}
+	if this.UpgradeExclusionWindowAfter != that1.UpgradeExclusionWindowAfter {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -390,6 +421,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UpgradeExclusionWindowAfter, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpgradeExclusionWindowAfter):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UpgradeExclusionWindowBefore, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpgradeExclusionWindowBefore):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -410,12 +457,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -491,6 +538,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpgradeExclusionWindowBefore)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UpgradeExclusionWindowAfter)
+	n += 1 + l + sovSlashing(uint64(l))
 	return n
 }
 
@@ -872,6 +923,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeExclusionWindowBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UpgradeExclusionWindowBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeExclusionWindowAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UpgradeExclusionWindowAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
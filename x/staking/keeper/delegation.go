@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -96,8 +97,13 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	}
 
 	store := ctx.KVStore(k.storeKey)
+	key := types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr())
+	if !store.Has(key) {
+		k.setDelegatorDelegationCount(ctx, delegatorAddress, k.GetDelegatorDelegationCount(ctx, delegatorAddress)+1)
+	}
+
 	b := types.MustMarshalDelegation(k.cdc, delegation)
-	store.Set(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()), b)
+	store.Set(key, b)
 }
 
 // remove a delegation
@@ -109,7 +115,12 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	// TODO: Consider calling hooks outside of the store wrapper functions, it's unobvious.
 	k.BeforeDelegationRemoved(ctx, delegatorAddress, delegation.GetValidatorAddr())
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr()))
+	key := types.GetDelegationKey(delegatorAddress, delegation.GetValidatorAddr())
+	if store.Has(key) {
+		k.setDelegatorDelegationCount(ctx, delegatorAddress, k.GetDelegatorDelegationCount(ctx, delegatorAddress)-1)
+	}
+
+	store.Delete(key)
 }
 
 // return a given amount of all the delegator unbonding-delegations
@@ -623,6 +634,14 @@ func (k Keeper) Delegate(
 	// Call the after-modification hook
 	k.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
 
+	// the delegations of redelegations are recorded as redelegations
+	if subtractAccount {
+		telemetry.KeeperIncrCounter(types.ModuleName, "delegate", 1)
+		if bondAmt.IsInt64() {
+			telemetry.KeeperAddSample(types.ModuleName, "delegate", float32(bondAmt.Int64()))
+		}
+	}
+
 	return newShares, nil
 }
 
@@ -748,6 +767,11 @@ func (k Keeper) Undelegate(
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "undelegate", 1)
+	if returnAmount.IsInt64() {
+		telemetry.KeeperAddSample(types.ModuleName, "undelegate", float32(returnAmount.Int64()))
+	}
+
 	return completionTime, nil
 }
 
@@ -797,6 +821,8 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 		k.SetUnbondingDelegation(ctx, ubd)
 	}
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "complete_unbonding", 1)
+
 	return balances, nil
 }
 
@@ -841,6 +867,11 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, err
 	}
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "redelegate", 1)
+	if returnAmount.IsInt64() {
+		telemetry.KeeperAddSample(types.ModuleName, "redelegate", float32(returnAmount.Int64()))
+	}
+
 	// create the unbonding delegation
 	completionTime, height, completeNow := k.getBeginInfo(ctx, valSrcAddr)
 
@@ -892,6 +923,8 @@ func (k Keeper) CompleteRedelegation(
 		k.SetRedelegation(ctx, red)
 	}
 
+	telemetry.KeeperIncrCounter(types.ModuleName, "complete_redelegation", 1)
+
 	return balances, nil
 }
 
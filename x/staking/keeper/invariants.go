@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "delegator-delegation-count",
+		DelegatorDelegationCountInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -38,7 +40,12 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DelegatorDelegationCountInvariant(k)(ctx)
 	}
 }
 
@@ -186,3 +193,43 @@ func DelegatorSharesInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// DelegatorDelegationCountInvariant checks that the maintained number of
+// delegations of each delegator matches its stored delegations.
+func DelegatorDelegationCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		counts := make(map[string]uint64)
+		k.IterateAllDelegations(ctx, func(delegation types.Delegation) bool {
+			counts[delegation.DelegatorAddress]++
+			return false
+		})
+
+		store := ctx.KVStore(k.storeKey)
+		iterator := sdk.KVStorePrefixIterator(store, types.DelegatorDelegationCountKey)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()[len(types.DelegatorDelegationCountKey):]
+			delAddr := sdk.AccAddress(key[1 : 1+int(key[0])]).String()
+			count := sdk.BigEndianToUint64(iterator.Value())
+
+			if counts[delAddr] != count {
+				broken = true
+				msg += fmt.Sprintf("\tdelegator %s has %d delegations, stored count is %d\n", delAddr, counts[delAddr], count)
+			}
+			delete(counts, delAddr)
+		}
+
+		for delAddr, count := range counts {
+			broken = true
+			msg += fmt.Sprintf("\tdelegator %s has %d delegations, stored count is 0\n", delAddr, count)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "delegator delegation count", msg), broken
+	}
+}
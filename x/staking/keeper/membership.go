@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsValidatorOperator returns true if the account is the operator of a
+// validator, whatever its status. It performs a single store lookup and is
+// meant to be used in hot paths such as ante decorators.
+func (k Keeper) IsValidatorOperator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetValidatorKey(sdk.ValAddress(addr)))
+}
+
+// IsDelegator returns true if the account has at least one delegation. It reads
+// the maintained delegation count of the delegator rather than iterating over
+// its delegations, and is meant to be used in hot paths such as ante
+// decorators.
+func (k Keeper) IsDelegator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorDelegationCountKey(addr))
+}
+
+// IsValidatorOperatorOrDelegator returns true if the account is either the
+// operator of a validator or has at least one delegation.
+func (k Keeper) IsValidatorOperatorOrDelegator(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return k.IsValidatorOperator(ctx, addr) || k.IsDelegator(ctx, addr)
+}
+
+// GetDelegatorDelegationCount returns the number of delegations of a delegator.
+func (k Keeper) GetDelegatorDelegationCount(ctx sdk.Context, delAddr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetDelegatorDelegationCountKey(delAddr))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setDelegatorDelegationCount sets the number of delegations of a delegator,
+// deleting the entry when it is zero so that its existence alone tells whether
+// the account is a delegator.
+func (k Keeper) setDelegatorDelegationCount(ctx sdk.Context, delAddr sdk.AccAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetDelegatorDelegationCountKey(delAddr)
+
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+
+	store.Set(key, sdk.Uint64ToBigEndian(count))
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestIsValidatorOperatorOrDelegator(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(10))
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	require.True(t, app.StakingKeeper.IsValidatorOperator(ctx, addrs[0]))
+	require.False(t, app.StakingKeeper.IsValidatorOperator(ctx, addrs[1]))
+	require.False(t, app.StakingKeeper.IsDelegator(ctx, addrs[1]))
+	require.False(t, app.StakingKeeper.IsValidatorOperatorOrDelegator(ctx, addrs[1]))
+
+	// the count only changes when a delegation is created or removed
+	del1 := types.NewDelegation(addrs[1], valAddrs[0], sdk.NewDec(5))
+	app.StakingKeeper.SetDelegation(ctx, del1)
+	app.StakingKeeper.SetDelegation(ctx, del1)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetDelegatorDelegationCount(ctx, addrs[1]))
+	require.True(t, app.StakingKeeper.IsDelegator(ctx, addrs[1]))
+	require.True(t, app.StakingKeeper.IsValidatorOperatorOrDelegator(ctx, addrs[1]))
+
+	del2 := types.NewDelegation(addrs[1], valAddrs[1], sdk.NewDec(5))
+	app.StakingKeeper.SetDelegation(ctx, del2)
+	require.Equal(t, uint64(2), app.StakingKeeper.GetDelegatorDelegationCount(ctx, addrs[1]))
+
+	_, broken := keeper.DelegatorDelegationCountInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+
+	app.StakingKeeper.RemoveDelegation(ctx, del1)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetDelegatorDelegationCount(ctx, addrs[1]))
+	require.True(t, app.StakingKeeper.IsDelegator(ctx, addrs[1]))
+
+	app.StakingKeeper.RemoveDelegation(ctx, del2)
+	app.StakingKeeper.RemoveDelegation(ctx, del2)
+	require.Equal(t, uint64(0), app.StakingKeeper.GetDelegatorDelegationCount(ctx, addrs[1]))
+	require.False(t, app.StakingKeeper.IsDelegator(ctx, addrs[1]))
+	require.False(t, app.StakingKeeper.IsValidatorOperatorOrDelegator(ctx, addrs[1]))
+
+	_, broken = keeper.DelegatorDelegationCountInvariant(app.StakingKeeper)(ctx)
+	require.False(t, broken)
+}
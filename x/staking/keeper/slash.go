@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -140,6 +141,11 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		"slash_factor", slashFactor.String(),
 		"burned", tokensToBurn,
 	)
+
+	telemetry.KeeperIncrCounter(types.ModuleName, "slash", 1)
+	if tokensToBurn.IsInt64() {
+		telemetry.KeeperAddSample(types.ModuleName, "slash", float32(tokensToBurn.Int64()))
+	}
 }
 
 // jail a validator
@@ -148,6 +154,8 @@ func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.jailValidator(ctx, validator)
 	logger := k.Logger(ctx)
 	logger.Info("validator jailed", "validator", consAddr)
+
+	telemetry.KeeperIncrCounter(types.ModuleName, "jail", 1)
 }
 
 // unjail a validator
@@ -156,6 +164,8 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.unjailValidator(ctx, validator)
 	logger := k.Logger(ctx)
 	logger.Info("validator un-jailed", "validator", consAddr)
+
+	telemetry.KeeperIncrCounter(types.ModuleName, "unjail", 1)
 }
 
 // slash an unbonding delegation and update the pool
@@ -0,0 +1,55 @@
+package v045
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type delegatorDelegationCount struct {
+	delegator sdk.AccAddress
+	count     uint64
+}
+
+// populateDelegatorDelegationCounts builds the number of delegations of every
+// delegator. Delegation keys are of format:
+// prefix_bytes | delegator_len (1 byte) | delegator_bytes | validator_len (1 byte) | validator_bytes
+// so the delegations of a delegator are contiguous in the store.
+func populateDelegatorDelegationCounts(store sdk.KVStore) {
+	delegationStore := prefix.NewStore(store, types.DelegationKey)
+
+	iter := delegationStore.Iterator(nil, nil)
+
+	var counts []delegatorDelegationCount
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		delegator := sdk.AccAddress(key[1 : 1+int(key[0])])
+
+		if len(counts) > 0 && bytes.Equal(counts[len(counts)-1].delegator, delegator) {
+			counts[len(counts)-1].count++
+			continue
+		}
+
+		counts = append(counts, delegatorDelegationCount{delegator: sdk.CopyBytes(delegator), count: 1})
+	}
+	iter.Close()
+
+	for _, c := range counts {
+		store.Set(types.GetDelegatorDelegationCountKey(c.delegator), sdk.Uint64ToBigEndian(c.count))
+	}
+}
+
+// MigrateStore performs in-place store migrations from v0.43 to v0.45. The
+// migration includes:
+//
+// - Populating the number of delegations of each delegator, used to tell in a
+// single lookup whether an account is a delegator
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	populateDelegatorDelegationCounts(store)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v045_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v045staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v045"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	store := ctx.KVStore(stakingKey)
+
+	_, _, del1 := testdata.KeyTestPubAddr()
+	_, _, del2 := testdata.KeyTestPubAddr()
+	_, _, del3 := testdata.KeyTestPubAddr()
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	valAddr1, valAddr2 := sdk.ValAddress(addr1), sdk.ValAddress(addr2)
+
+	// Use dummy value for all delegations.
+	value := []byte("foo")
+	store.Set(types.GetDelegationKey(del1, valAddr1), value)
+	store.Set(types.GetDelegationKey(del1, valAddr2), value)
+	store.Set(types.GetDelegationKey(del2, valAddr2), value)
+
+	require.NoError(t, v045staking.MigrateStore(ctx, stakingKey))
+
+	require.Equal(t, sdk.Uint64ToBigEndian(2), store.Get(types.GetDelegatorDelegationCountKey(del1)))
+	require.Equal(t, sdk.Uint64ToBigEndian(1), store.Get(types.GetDelegatorDelegationCountKey(del2)))
+	require.False(t, store.Has(types.GetDelegatorDelegationCountKey(del3)))
+
+	// delegations are left untouched
+	require.Equal(t, value, store.Get(types.GetDelegationKey(del1, valAddr1)))
+	require.Equal(t, value, store.Get(types.GetDelegationKey(del1, valAddr2)))
+	require.Equal(t, value, store.Get(types.GetDelegationKey(del2, valAddr2)))
+}
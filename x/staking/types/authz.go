@@ -47,6 +47,9 @@ func (a StakeAuthorization) MsgTypeURL() string {
 }
 
 func (a StakeAuthorization) ValidateBasic() error {
+	if a.MaxTokens != nil && a.MaxTokens.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "nil coin amount")
+	}
 	if a.MaxTokens != nil && a.MaxTokens.IsNegative() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "negative coin amount: %v", a.MaxTokens)
 	}
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The staking hook events, named after the StakingHooks methods.
+const (
+	HookAfterValidatorCreated          = "AfterValidatorCreated"
+	HookBeforeValidatorModified        = "BeforeValidatorModified"
+	HookAfterValidatorRemoved          = "AfterValidatorRemoved"
+	HookAfterValidatorBonded           = "AfterValidatorBonded"
+	HookAfterValidatorBeginUnbonding   = "AfterValidatorBeginUnbonding"
+	HookBeforeDelegationCreated        = "BeforeDelegationCreated"
+	HookBeforeDelegationSharesModified = "BeforeDelegationSharesModified"
+	HookBeforeDelegationRemoved        = "BeforeDelegationRemoved"
+	HookAfterDelegationModified        = "AfterDelegationModified"
+	HookBeforeValidatorSlashed         = "BeforeValidatorSlashed"
+)
+
+// StakingHookEvents returns all the staking hook events.
+func StakingHookEvents() []string {
+	return []string{
+		HookAfterValidatorCreated,
+		HookBeforeValidatorModified,
+		HookAfterValidatorRemoved,
+		HookAfterValidatorBonded,
+		HookAfterValidatorBeginUnbonding,
+		HookBeforeDelegationCreated,
+		HookBeforeDelegationSharesModified,
+		HookBeforeDelegationRemoved,
+		HookAfterDelegationModified,
+		HookBeforeValidatorSlashed,
+	}
+}
+
+// StakingHooksEventFilter is implemented by the StakingHooks acting on some of
+// the hook events only, their other methods being no-ops. The resolved
+// execution order of an event then only lists the hooks acting on it.
+type StakingHooksEventFilter interface {
+	StakingHookEvents() []string
+}
+
+// HooksOrderOption declares an ordering constraint between the hooks of a
+// module and the hooks of other modules.
+type HooksOrderOption func(*stakingHooksEntry)
+
+// RunHooksAfter makes the hooks of a module run after the hooks of modules.
+func RunHooksAfter(modules ...string) HooksOrderOption {
+	return func(e *stakingHooksEntry) {
+		e.after = append(e.after, modules...)
+	}
+}
+
+// RunHooksBefore makes the hooks of a module run before the hooks of modules.
+func RunHooksBefore(modules ...string) HooksOrderOption {
+	return func(e *stakingHooksEntry) {
+		e.before = append(e.before, modules...)
+	}
+}
+
+type stakingHooksEntry struct {
+	module string
+	hooks  StakingHooks
+	after  []string
+	before []string
+}
+
+// StakingHooksRegistry registers the staking hooks of the modules of an app
+// along with the constraints on their execution order.
+type StakingHooksRegistry struct {
+	entries []*stakingHooksEntry
+}
+
+// NewStakingHooksRegistry returns an empty StakingHooksRegistry.
+func NewStakingHooksRegistry() *StakingHooksRegistry {
+	return &StakingHooksRegistry{}
+}
+
+// Register registers the staking hooks of module, ordered by opts.
+func (r *StakingHooksRegistry) Register(module string, hooks StakingHooks, opts ...HooksOrderOption) *StakingHooksRegistry {
+	e := &stakingHooksEntry{module: module, hooks: hooks}
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	r.entries = append(r.entries, e)
+
+	return r
+}
+
+// Resolve returns the registered hooks in an execution order satisfying their
+// ordering constraints, where the hooks not constrained relative to each other
+// keep their registration order. It returns an error if a module is
+// registered twice, if a constraint refers to a module which is not
+// registered, or if the constraints are cyclic.
+func (r *StakingHooksRegistry) Resolve() (StakingHooksOrder, error) {
+	index := make(map[string]int, len(r.entries))
+	for i, e := range r.entries {
+		if e.module == "" {
+			return StakingHooksOrder{}, fmt.Errorf("staking hooks registered without a module name")
+		}
+		if _, ok := index[e.module]; ok {
+			return StakingHooksOrder{}, fmt.Errorf("staking hooks of module %s registered twice", e.module)
+		}
+		index[e.module] = i
+	}
+
+	// successors[i] are the entries whose hooks must run after the ones of i
+	successors := make([][]int, len(r.entries))
+	predecessors := make([]int, len(r.entries))
+	constrain := func(first, then, declaredBy string) error {
+		i, ok := index[first]
+		if !ok {
+			return fmt.Errorf("staking hooks of module %s are ordered relative to unregistered module %s", declaredBy, first)
+		}
+		j, ok := index[then]
+		if !ok {
+			return fmt.Errorf("staking hooks of module %s are ordered relative to unregistered module %s", declaredBy, then)
+		}
+		if i == j {
+			return fmt.Errorf("staking hooks of module %s are ordered relative to themselves", declaredBy)
+		}
+
+		successors[i] = append(successors[i], j)
+		predecessors[j]++
+		return nil
+	}
+
+	for _, e := range r.entries {
+		for _, m := range e.after {
+			if err := constrain(m, e.module, e.module); err != nil {
+				return StakingHooksOrder{}, err
+			}
+		}
+		for _, m := range e.before {
+			if err := constrain(e.module, m, e.module); err != nil {
+				return StakingHooksOrder{}, err
+			}
+		}
+	}
+
+	// topological sort, picking the earliest registered entry among the ones
+	// whose predecessors were all picked
+	var order StakingHooksOrder
+	picked := make([]bool, len(r.entries))
+	for len(order.modules) < len(r.entries) {
+		next := -1
+		for i := range r.entries {
+			if !picked[i] && predecessors[i] == 0 {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			var cyclic []string
+			for i, e := range r.entries {
+				if !picked[i] {
+					cyclic = append(cyclic, e.module)
+				}
+			}
+			return StakingHooksOrder{}, fmt.Errorf("cyclic ordering constraints between the staking hooks of modules %s", strings.Join(cyclic, ", "))
+		}
+
+		picked[next] = true
+		for _, j := range successors[next] {
+			predecessors[j]--
+		}
+
+		order.modules = append(order.modules, r.entries[next].module)
+		order.hooks = append(order.hooks, r.entries[next].hooks)
+	}
+
+	return order, nil
+}
+
+// StakingHooksOrder is the resolved execution order of registered staking
+// hooks.
+type StakingHooksOrder struct {
+	modules []string
+	hooks   []StakingHooks
+}
+
+// Hooks returns the hooks running the registered ones in order.
+func (o StakingHooksOrder) Hooks() MultiStakingHooks {
+	return NewMultiStakingHooks(o.hooks...)
+}
+
+// Modules returns the modules of the hooks in execution order.
+func (o StakingHooksOrder) Modules() []string {
+	return o.modules
+}
+
+// EventOrder returns the modules of the hooks acting on event, in execution
+// order.
+func (o StakingHooksOrder) EventOrder(event string) []string {
+	var modules []string
+	for i, hooks := range o.hooks {
+		if filter, ok := hooks.(StakingHooksEventFilter); ok && !containsEvent(filter.StakingHookEvents(), event) {
+			continue
+		}
+
+		modules = append(modules, o.modules[i])
+	}
+
+	return modules
+}
+
+// String returns the execution order of each staking hook event, one per line.
+func (o StakingHooksOrder) String() string {
+	var sb strings.Builder
+	for _, event := range StakingHookEvents() {
+		fmt.Fprintf(&sb, "%s: %s\n", event, strings.Join(o.EventOrder(event), ", "))
+	}
+
+	return sb.String()
+}
+
+func containsEvent(events []string, event string) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// recordingHooks records the name of its module on AfterValidatorCreated, its
+// other hooks being no-ops.
+type recordingHooks struct {
+	types.MultiStakingHooks
+
+	module string
+	calls  *[]string
+	events []string
+}
+
+func (h recordingHooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) {
+	*h.calls = append(*h.calls, h.module)
+}
+
+type filteredHooks struct {
+	recordingHooks
+}
+
+func (h filteredHooks) StakingHookEvents() []string {
+	return h.events
+}
+
+func TestStakingHooksRegistryResolve(t *testing.T) {
+	var calls []string
+	hooks := func(module string) types.StakingHooks {
+		return recordingHooks{module: module, calls: &calls}
+	}
+
+	order, err := types.NewStakingHooksRegistry().
+		Register("a", hooks("a"), types.RunHooksAfter("c")).
+		Register("b", hooks("b")).
+		Register("c", hooks("c")).
+		Register("d", hooks("d"), types.RunHooksBefore("a")).
+		Resolve()
+	require.NoError(t, err)
+
+	// the unconstrained hooks keep their registration order
+	require.Equal(t, []string{"b", "c", "d", "a"}, order.Modules())
+
+	order.Hooks().AfterValidatorCreated(sdk.Context{}, nil)
+	require.Equal(t, order.Modules(), calls)
+
+	order, err = types.NewStakingHooksRegistry().Resolve()
+	require.NoError(t, err)
+	require.Empty(t, order.Modules())
+}
+
+func TestStakingHooksRegistryResolveErrors(t *testing.T) {
+	hooks := types.NewMultiStakingHooks()
+
+	testCases := map[string]struct {
+		registry *types.StakingHooksRegistry
+		expErr   string
+	}{
+		"no module name": {
+			types.NewStakingHooksRegistry().Register("", hooks),
+			"without a module name",
+		},
+		"registered twice": {
+			types.NewStakingHooksRegistry().Register("a", hooks).Register("a", hooks),
+			"module a registered twice",
+		},
+		"unregistered module": {
+			types.NewStakingHooksRegistry().Register("a", hooks, types.RunHooksAfter("b")),
+			"unregistered module b",
+		},
+		"ordered relative to itself": {
+			types.NewStakingHooksRegistry().Register("a", hooks, types.RunHooksBefore("a")),
+			"relative to themselves",
+		},
+		"cyclic": {
+			types.NewStakingHooksRegistry().
+				Register("a", hooks, types.RunHooksAfter("c")).
+				Register("b", hooks, types.RunHooksAfter("a")).
+				Register("c", hooks, types.RunHooksAfter("b")).
+				Register("d", hooks),
+			"cyclic ordering constraints between the staking hooks of modules a, b, c",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := tc.registry.Resolve()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expErr)
+		})
+	}
+}
+
+func TestStakingHooksOrderEventOrder(t *testing.T) {
+	var calls []string
+	order, err := types.NewStakingHooksRegistry().
+		Register("all", recordingHooks{module: "all", calls: &calls}).
+		Register("some", filteredHooks{recordingHooks{
+			module: "some", calls: &calls,
+			events: []string{types.HookAfterValidatorCreated, types.HookBeforeValidatorSlashed},
+		}}).
+		Resolve()
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"all", "some"}, order.EventOrder(types.HookAfterValidatorCreated))
+	require.Equal(t, []string{"all"}, order.EventOrder(types.HookAfterValidatorBonded))
+
+	require.Contains(t, order.String(), "AfterValidatorCreated: all, some\n")
+	require.Contains(t, order.String(), "AfterValidatorBonded: all\n")
+	require.Equal(t, len(types.StakingHookEvents()), strings.Count(order.String(), "\n"))
+}
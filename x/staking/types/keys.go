@@ -7,8 +7,11 @@ import (
 	"strconv"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/keyformat"
 )
 
 const (
@@ -41,6 +44,7 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	DelegatorDelegationCountKey      = []byte{0x37} // prefix for each key to the number of delegations of a delegator
 
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
@@ -182,6 +186,13 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
 }
 
+// GetDelegatorDelegationCountKey creates the key for the number of delegations
+// of a delegator
+// VALUE: uint64 (big endian)
+func GetDelegatorDelegationCountKey(delAddr sdk.AccAddress) []byte {
+	return append(DelegatorDelegationCountKey, address.MustLengthPrefix(delAddr)...)
+}
+
 // GetUBDKey creates the key for an unbonding delegation by delegator and validator addr
 // VALUE: staking/UnbondingDelegation
 func GetUBDKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
@@ -333,3 +344,97 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// KeyFormats are the layouts of the keys of the staking store.
+var KeyFormats = []keyformat.KeyFormat{
+	{
+		Name:     "last_validator_power",
+		Prefix:   LastValidatorPowerKey,
+		Segments: []keyformat.Segment{keyformat.ValAddress("operator")},
+		Value:    keyformat.ProtoValue(&gogotypes.Int64Value{}),
+	},
+	{Name: "last_total_power", Prefix: LastTotalPowerKey, Value: keyformat.ProtoValue(&sdk.IntProto{})},
+	{
+		Name:     "validator",
+		Prefix:   ValidatorsKey,
+		Segments: []keyformat.Segment{keyformat.ValAddress("operator")},
+		Value:    keyformat.ProtoValue(&Validator{}),
+	},
+	{
+		Name:     "validator_by_cons_addr",
+		Prefix:   ValidatorsByConsAddrKey,
+		Segments: []keyformat.Segment{keyformat.ConsAddress("cons_address")},
+		Value:    keyformat.SegmentValue(keyformat.ValAddress("operator")),
+	},
+	{
+		// the operator address bytes are inverted, to sort equal powers by
+		// ascending address
+		Name:     "validator_by_power",
+		Prefix:   ValidatorsByPowerIndexKey,
+		Segments: []keyformat.Segment{keyformat.Uint64("consensus_power"), keyformat.Bytes("inverted_operator", 0)},
+		Value:    keyformat.SegmentValue(keyformat.ValAddress("operator")),
+	},
+	{
+		Name:     "delegation",
+		Prefix:   DelegationKey,
+		Segments: []keyformat.Segment{keyformat.AccAddress("delegator"), keyformat.ValAddress("validator")},
+		Value:    keyformat.ProtoValue(&Delegation{}),
+	},
+	{
+		Name:     "unbonding_delegation",
+		Prefix:   UnbondingDelegationKey,
+		Segments: []keyformat.Segment{keyformat.AccAddress("delegator"), keyformat.ValAddress("validator")},
+		Value:    keyformat.ProtoValue(&UnbondingDelegation{}),
+	},
+	{
+		Name:     "unbonding_delegation_by_validator",
+		Prefix:   UnbondingDelegationByValIndexKey,
+		Segments: []keyformat.Segment{keyformat.ValAddress("validator"), keyformat.AccAddress("delegator")},
+	},
+	{
+		Name:     "redelegation",
+		Prefix:   RedelegationKey,
+		Segments: []keyformat.Segment{keyformat.AccAddress("delegator"), keyformat.ValAddress("src_validator"), keyformat.ValAddress("dst_validator")},
+		Value:    keyformat.ProtoValue(&Redelegation{}),
+	},
+	{
+		Name:     "redelegation_by_src_validator",
+		Prefix:   RedelegationByValSrcIndexKey,
+		Segments: []keyformat.Segment{keyformat.ValAddress("src_validator"), keyformat.AccAddress("delegator"), keyformat.ValAddress("dst_validator")},
+	},
+	{
+		Name:     "redelegation_by_dst_validator",
+		Prefix:   RedelegationByValDstIndexKey,
+		Segments: []keyformat.Segment{keyformat.ValAddress("dst_validator"), keyformat.AccAddress("delegator"), keyformat.ValAddress("src_validator")},
+	},
+	{
+		Name:     "delegator_delegation_count",
+		Prefix:   DelegatorDelegationCountKey,
+		Segments: []keyformat.Segment{keyformat.AccAddress("delegator")},
+		Value:    keyformat.SegmentValue(keyformat.Uint64("count")),
+	},
+	{
+		Name:     "unbonding_queue",
+		Prefix:   UnbondingQueueKey,
+		Segments: []keyformat.Segment{keyformat.Time("completion_time")},
+		Value:    keyformat.ProtoValue(&DVPairs{}),
+	},
+	{
+		Name:     "redelegation_queue",
+		Prefix:   RedelegationQueueKey,
+		Segments: []keyformat.Segment{keyformat.Time("completion_time")},
+		Value:    keyformat.ProtoValue(&DVVTriplets{}),
+	},
+	{
+		Name:     "validator_queue",
+		Prefix:   ValidatorQueueKey,
+		Segments: []keyformat.Segment{keyformat.Uint64("time_length"), keyformat.Time("completion_time"), keyformat.Uint64("completion_height")},
+		Value:    keyformat.ProtoValue(&ValAddresses{}),
+	},
+	{
+		Name:     "historical_info",
+		Prefix:   HistoricalInfoKey,
+		Segments: []keyformat.Segment{keyformat.String("height")},
+		Value:    keyformat.ProtoValue(&HistoricalInfo{}),
+	},
+}
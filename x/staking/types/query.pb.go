@@ -1347,6 +1347,8 @@ var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 type QueryParamsResponse struct {
 	// params holds all the parameters of this module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// last_changed_height is the height at which the parameters last changed.
+	LastChangedHeight int64 `protobuf:"varint,2,opt,name=last_changed_height,json=lastChangedHeight,proto3" json:"last_changed_height,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -1389,6 +1391,13 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+func (m *QueryParamsResponse) GetLastChangedHeight() int64 {
+	if m != nil {
+		return m.LastChangedHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1425,89 +1434,91 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x4f, 0x1c, 0x75,
-	0x14, 0xdf, 0x2f, 0x20, 0x91, 0xd7, 0xb4, 0xa9, 0xdf, 0x05, 0xc4, 0x29, 0xee, 0xd2, 0x09, 0x22,
-	0xa5, 0x74, 0x46, 0xa0, 0x52, 0xac, 0x4d, 0x15, 0xac, 0x54, 0xd2, 0x83, 0xb0, 0x46, 0xfc, 0x75,
-	0x20, 0xb3, 0x3b, 0xd3, 0xd9, 0x49, 0x97, 0x99, 0xed, 0xcc, 0x40, 0x40, 0xc2, 0x41, 0x4f, 0x7a,
-	0xd3, 0x78, 0x52, 0x2f, 0x3d, 0x98, 0x98, 0xe8, 0x51, 0xff, 0x01, 0x4f, 0xd6, 0x1b, 0x46, 0x0f,
-	0x7a, 0xa9, 0x06, 0x3c, 0x34, 0x9e, 0xbc, 0x19, 0x6f, 0x66, 0xbf, 0xf3, 0x66, 0x76, 0x86, 0xf9,
-	0xb9, 0xcb, 0x12, 0xd2, 0x13, 0xbb, 0xdf, 0x7d, 0x3f, 0x3e, 0x9f, 0xf7, 0xbe, 0xef, 0x7d, 0xdf,
-	0x0b, 0xc0, 0x57, 0x0c, 0x6b, 0xdd, 0xb0, 0x44, 0xcb, 0x96, 0xee, 0x68, 0xba, 0x2a, 0x6e, 0x4e,
-	0x95, 0x15, 0x5b, 0x9a, 0x12, 0xef, 0x6e, 0x28, 0xe6, 0xb6, 0x50, 0x37, 0x0d, 0xdb, 0xa0, 0x83,
-	0x8e, 0x8c, 0x80, 0x32, 0x02, 0xca, 0x70, 0x13, 0xa8, 0x5b, 0x96, 0x2c, 0xc5, 0x51, 0xf0, 0xd4,
-	0xeb, 0x92, 0xaa, 0xe9, 0x92, 0xad, 0x19, 0xba, 0x63, 0x83, 0xeb, 0x57, 0x0d, 0xd5, 0x60, 0x1f,
-	0xc5, 0xc6, 0x27, 0x3c, 0x1d, 0x56, 0x0d, 0x43, 0xad, 0x29, 0xa2, 0x54, 0xd7, 0x44, 0x49, 0xd7,
-	0x0d, 0x9b, 0xa9, 0x58, 0xf8, 0xeb, 0x68, 0x0c, 0x36, 0x17, 0x07, 0x93, 0xe2, 0xb7, 0x60, 0x70,
-	0xa5, 0xe1, 0x7b, 0x55, 0xaa, 0x69, 0xb2, 0x64, 0x1b, 0xa6, 0x55, 0x52, 0xee, 0x6e, 0x28, 0x96,
-	0x4d, 0x07, 0xa1, 0xd7, 0xb2, 0x25, 0x7b, 0xc3, 0x1a, 0x22, 0x23, 0x64, 0xbc, 0xaf, 0x84, 0xdf,
-	0xe8, 0x22, 0x40, 0x13, 0xdf, 0x50, 0xd7, 0x08, 0x19, 0x3f, 0x35, 0x3d, 0x26, 0x20, 0xc9, 0x06,
-	0x19, 0xc1, 0x61, 0x8f, 0xfe, 0x84, 0x65, 0x49, 0x55, 0xd0, 0x66, 0xc9, 0xa7, 0xc9, 0x7f, 0x4b,
-	0xe0, 0xc9, 0x90, 0x6b, 0xab, 0x6e, 0xe8, 0x96, 0x42, 0x6f, 0x02, 0x6c, 0x7a, 0xa7, 0x43, 0x64,
-	0xa4, 0x7b, 0xfc, 0xd4, 0xf4, 0x79, 0x21, 0x3a, 0x90, 0x82, 0xa7, 0xbf, 0xd0, 0x73, 0xff, 0x41,
-	0x31, 0x57, 0xf2, 0xa9, 0x36, 0x0c, 0x85, 0xc0, 0x3e, 0x9b, 0x0a, 0xd6, 0x41, 0x11, 0x40, 0x7b,
-	0x1d, 0x06, 0x82, 0x60, 0xdd, 0x30, 0x3d, 0x03, 0x67, 0x3c, 0x7f, 0x6b, 0x92, 0x2c, 0x9b, 0x18,
-	0xae, 0xd3, 0xde, 0xe9, 0xbc, 0x2c, 0x9b, 0xfc, 0xda, 0xe1, 0x38, 0x7b, 0x5c, 0x5f, 0x85, 0x3e,
-	0x4f, 0x94, 0xe9, 0xb6, 0x40, 0xb5, 0xa9, 0xc9, 0x7f, 0x4a, 0x60, 0x24, 0xe8, 0xe1, 0x86, 0x52,
-	0x53, 0x54, 0xe7, 0x4a, 0xb4, 0x06, 0xb6, 0x63, 0x29, 0x7e, 0x48, 0xe0, 0x7c, 0x02, 0x26, 0x0c,
-	0xc0, 0xfb, 0xd0, 0x2f, 0x7b, 0xc7, 0x6b, 0x26, 0x1e, 0xbb, 0x69, 0x9f, 0x88, 0x8b, 0x45, 0xd3,
-	0x94, 0x6b, 0x69, 0xe1, 0x5c, 0x23, 0x28, 0xdf, 0xfc, 0x51, 0xcc, 0x87, 0x7f, 0xb3, 0x4a, 0x79,
-	0x39, 0x7c, 0xd8, 0xb9, 0xfb, 0xf1, 0x05, 0x81, 0x0b, 0x41, 0xaa, 0x6f, 0xea, 0x65, 0x43, 0x97,
-	0x35, 0x5d, 0x3d, 0xf9, 0x3c, 0xfc, 0x4e, 0x60, 0x22, 0x0b, 0x38, 0x4c, 0x48, 0x19, 0xf2, 0x1b,
-	0xee, 0xef, 0xa1, 0x7c, 0x5c, 0x8c, 0xcb, 0x47, 0x84, 0x49, 0xbc, 0xa5, 0xd4, 0xb3, 0x76, 0x0c,
-	0x81, 0xaf, 0x63, 0x61, 0xf9, 0x53, 0xee, 0x05, 0x19, 0x53, 0x7e, 0x28, 0xc8, 0xde, 0x29, 0x0b,
-	0x72, 0x38, 0x17, 0x5d, 0x11, 0xb9, 0xb8, 0xfa, 0xf8, 0x47, 0xf7, 0x8a, 0xb9, 0x87, 0xf7, 0x8a,
-	0x39, 0x7e, 0x13, 0xfb, 0x56, 0xf8, 0x92, 0xd1, 0xf7, 0x20, 0x1f, 0x71, 0x95, 0xb1, 0xaa, 0x5b,
-	0xb8, 0xc9, 0x25, 0x1a, 0xbe, 0xac, 0xfc, 0x36, 0x14, 0x99, 0xdf, 0x88, 0x40, 0x1f, 0x37, 0xe5,
-	0x75, 0xec, 0x2d, 0x91, 0xae, 0x91, 0xfb, 0x12, 0xf4, 0x3a, 0x79, 0x46, 0xba, 0x6d, 0x5c, 0x14,
-	0x34, 0xc0, 0x7f, 0xe9, 0xf6, 0xb2, 0x1b, 0x2e, 0xec, 0xe8, 0x1a, 0xca, 0xc2, 0xb5, 0x43, 0x35,
-	0xe4, 0x0b, 0xc6, 0xcf, 0x6e, 0x57, 0x8b, 0x46, 0x87, 0xe1, 0xa8, 0x74, 0xac, 0xab, 0x39, 0xb1,
-	0x39, 0xde, 0xf6, 0xf5, 0x95, 0xdb, 0xbe, 0x3c, 0x4e, 0x29, 0xed, 0xeb, 0x64, 0x42, 0xef, 0x35,
-	0xb2, 0x14, 0x98, 0x8f, 0x62, 0x23, 0xfb, 0x87, 0xc0, 0x53, 0x8c, 0x5b, 0x49, 0x91, 0xdb, 0x0e,
-	0xf9, 0x24, 0x50, 0xcb, 0xac, 0xac, 0x45, 0x56, 0xf7, 0x59, 0xcb, 0xac, 0xac, 0x06, 0xde, 0x97,
-	0x49, 0xa0, 0xb2, 0x65, 0x1f, 0x96, 0xee, 0x76, 0xa4, 0x65, 0xcb, 0x5e, 0x4d, 0x78, 0x8d, 0x7a,
-	0x3a, 0x90, 0xce, 0x3d, 0x02, 0x5c, 0x14, 0x65, 0x4c, 0x9f, 0x06, 0x83, 0xa6, 0x92, 0x50, 0x44,
-	0x93, 0x71, 0x19, 0xf4, 0x9b, 0x3b, 0x54, 0x46, 0x03, 0xa6, 0x72, 0xdc, 0x73, 0x40, 0x31, 0x78,
-	0x43, 0xc3, 0x93, 0xf5, 0x89, 0x95, 0xcf, 0xf7, 0xa1, 0xbe, 0xfa, 0x48, 0xcc, 0xde, 0x5b, 0x50,
-	0x88, 0x41, 0x7d, 0xdc, 0xef, 0x5e, 0x35, 0x36, 0x99, 0x9d, 0x1e, 0xdf, 0x2f, 0x63, 0x25, 0xbc,
-	0xa6, 0x59, 0xb6, 0x61, 0x6a, 0x15, 0xa9, 0xb6, 0xa4, 0xdf, 0x36, 0x7c, 0xbb, 0x58, 0x55, 0xd1,
-	0xd4, 0xaa, 0xcd, 0x3c, 0x74, 0x97, 0xf0, 0x1b, 0xff, 0x0e, 0x9c, 0x8b, 0xd4, 0x42, 0x6c, 0x57,
-	0xa1, 0xa7, 0xaa, 0x59, 0x36, 0xc2, 0x1a, 0x8b, 0x83, 0x75, 0x48, 0x9b, 0xe9, 0xf0, 0x14, 0xce,
-	0x32, 0xd3, 0xcb, 0x86, 0x51, 0x43, 0x18, 0xfc, 0x2d, 0x78, 0xc2, 0x77, 0x86, 0x4e, 0x66, 0xa1,
-	0xa7, 0x6e, 0x18, 0x35, 0x74, 0x32, 0x1c, 0xe7, 0xa4, 0xa1, 0x83, 0xb4, 0x99, 0x3c, 0xdf, 0x0f,
-	0xd4, 0x31, 0x26, 0x99, 0xd2, 0xba, 0x5b, 0x1b, 0xfc, 0x1b, 0x90, 0x0f, 0x9c, 0xa2, 0x93, 0x6b,
-	0xd0, 0x5b, 0x67, 0x27, 0xe8, 0xa6, 0x10, 0xeb, 0x86, 0x49, 0xb9, 0xf3, 0x84, 0xa3, 0x33, 0xfd,
-	0xf7, 0x00, 0x3c, 0xc6, 0xac, 0xd2, 0xcf, 0x09, 0x40, 0xf3, 0xce, 0x53, 0x21, 0xce, 0x4c, 0xf4,
-	0x4e, 0xcc, 0x89, 0x99, 0xe5, 0x71, 0x66, 0x9b, 0xf8, 0xf0, 0x97, 0xbf, 0x3e, 0xeb, 0x1a, 0xa5,
-	0xbc, 0x18, 0xb3, 0x8d, 0xfb, 0xea, 0xe5, 0x6b, 0x02, 0x7d, 0x9e, 0x09, 0x7a, 0x29, 0x9b, 0x2b,
-	0x17, 0x99, 0x90, 0x55, 0x1c, 0x81, 0xbd, 0xc8, 0x80, 0x3d, 0x4f, 0x67, 0xd2, 0x81, 0x89, 0x3b,
-	0xc1, 0xa2, 0xd9, 0xa5, 0xbf, 0x12, 0xe8, 0x8f, 0x5a, 0xe9, 0xe8, 0x5c, 0x36, 0x14, 0xe1, 0x91,
-	0x82, 0x7b, 0xa1, 0x0d, 0x4d, 0xa4, 0x72, 0x93, 0x51, 0x99, 0xa7, 0x2f, 0xb5, 0x41, 0x45, 0xf4,
-	0xbd, 0x3b, 0xf4, 0x3f, 0x02, 0x4f, 0x27, 0x6e, 0x48, 0x74, 0x3e, 0x1b, 0xca, 0x84, 0xd9, 0x89,
-	0x5b, 0x38, 0x8a, 0x09, 0x64, 0xbc, 0xc2, 0x18, 0xdf, 0xa2, 0x4b, 0xed, 0x30, 0x6e, 0x4e, 0x44,
-	0x7e, 0xee, 0x3f, 0x12, 0x80, 0xa6, 0xab, 0x94, 0xc2, 0x08, 0x2d, 0x1e, 0x29, 0x85, 0x11, 0x1e,
-	0x6a, 0xf9, 0xb7, 0x19, 0x85, 0x12, 0x5d, 0x3e, 0x62, 0xd2, 0xc4, 0x9d, 0x60, 0xe3, 0xdf, 0xa5,
-	0xff, 0x12, 0xc8, 0x47, 0x44, 0x8f, 0x5e, 0x49, 0x84, 0x18, 0xbf, 0x54, 0x71, 0x73, 0xad, 0x2b,
-	0x22, 0xc9, 0x75, 0x46, 0x52, 0xa5, 0x4a, 0xa7, 0x49, 0x46, 0x26, 0x91, 0xfe, 0x44, 0xa0, 0x3f,
-	0x6a, 0x27, 0x49, 0x29, 0xcb, 0x84, 0x25, 0x2b, 0xa5, 0x2c, 0x93, 0x16, 0x20, 0xfe, 0x1a, 0x23,
-	0x3f, 0x4b, 0x2f, 0xc7, 0x91, 0x4f, 0xcc, 0x62, 0xa3, 0x16, 0x13, 0x87, 0xfc, 0x94, 0x5a, 0xcc,
-	0xb2, 0xc7, 0xa4, 0xd4, 0x62, 0xa6, 0x1d, 0x23, 0xbd, 0x16, 0x3d, 0x66, 0x19, 0xd3, 0x68, 0xd1,
-	0x1f, 0x08, 0x9c, 0x0e, 0x4c, 0xc4, 0x74, 0x2a, 0x11, 0x68, 0xd4, 0xc2, 0xc0, 0x4d, 0xb7, 0xa2,
-	0x82, 0x5c, 0x96, 0x18, 0x97, 0x57, 0xe8, 0x7c, 0x3b, 0x5c, 0xcc, 0x00, 0xe2, 0x3d, 0x02, 0xf9,
-	0x88, 0x29, 0x33, 0xa5, 0x0a, 0xe3, 0x87, 0x66, 0x6e, 0xae, 0x75, 0x45, 0x64, 0xb5, 0xc8, 0x58,
-	0xbd, 0x4c, 0xaf, 0xb7, 0xc3, 0xca, 0xf7, 0x3e, 0x3f, 0x20, 0x40, 0xc3, 0x7e, 0xe8, 0x6c, 0x8b,
-	0xc0, 0x5c, 0x42, 0x57, 0x5a, 0xd6, 0x43, 0x3e, 0x6f, 0x31, 0x3e, 0x2b, 0xf4, 0xf5, 0xa3, 0xf1,
-	0x09, 0x3f, 0xeb, 0xdf, 0x11, 0x38, 0x13, 0x9c, 0x05, 0x69, 0xf2, 0x2d, 0x8a, 0x1c, 0x56, 0xb9,
-	0x99, 0x96, 0x74, 0x90, 0xd4, 0x1c, 0x23, 0x35, 0x4d, 0x9f, 0x8b, 0x23, 0x55, 0xf5, 0xf4, 0xd6,
-	0x34, 0xfd, 0xb6, 0x21, 0xee, 0x38, 0x23, 0xf0, 0x2e, 0xfd, 0x80, 0x40, 0x4f, 0x63, 0xb8, 0xa4,
-	0xe3, 0x89, 0x7e, 0x7d, 0x73, 0x2c, 0x77, 0x21, 0x83, 0x24, 0xe2, 0x1a, 0x65, 0xb8, 0x0a, 0x74,
-	0x38, 0x0e, 0x57, 0x63, 0x96, 0xa5, 0x1f, 0x13, 0xe8, 0x75, 0x26, 0x4f, 0x3a, 0x91, 0x6c, 0xdb,
-	0x3f, 0xec, 0x72, 0x17, 0x33, 0xc9, 0x22, 0x92, 0x31, 0x86, 0x64, 0x84, 0x16, 0x62, 0x91, 0x38,
-	0xa3, 0xef, 0xe2, 0xfd, 0xfd, 0x02, 0xd9, 0xdb, 0x2f, 0x90, 0x3f, 0xf7, 0x0b, 0xe4, 0x93, 0x83,
-	0x42, 0x6e, 0xef, 0xa0, 0x90, 0xfb, 0xed, 0xa0, 0x90, 0x7b, 0x77, 0x52, 0xd5, 0xec, 0xea, 0x46,
-	0x59, 0xa8, 0x18, 0xeb, 0xae, 0x0d, 0xe7, 0xcf, 0x25, 0x4b, 0xbe, 0x23, 0x6e, 0x79, 0x06, 0xed,
-	0xed, 0xba, 0x62, 0x95, 0x7b, 0xd9, 0x3f, 0x88, 0x66, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x6e, 0xeb, 0x7f, 0xe4, 0x1a, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0xdf, 0x49, 0x43, 0x44, 0x5f, 0xd5, 0xaa, 0x9d, 0x4d, 0xd3, 0xe0, 0x86, 0xdd, 0xad, 0x15,
+	0x4a, 0x9a, 0xa6, 0x36, 0x49, 0x4a, 0x1a, 0x4a, 0x55, 0x48, 0x5a, 0xd2, 0x46, 0x3d, 0x90, 0xac,
+	0x44, 0xf8, 0x3a, 0xac, 0xbc, 0x6b, 0xd7, 0x6b, 0x75, 0x63, 0x6f, 0x6d, 0x27, 0x4a, 0x88, 0x72,
+	0x00, 0x2e, 0x70, 0x03, 0x71, 0x02, 0x2e, 0x3d, 0x20, 0x21, 0xc1, 0x11, 0xfe, 0x01, 0x4e, 0x94,
+	0x5b, 0x10, 0x1c, 0xe0, 0x52, 0x50, 0xc2, 0xa1, 0xe2, 0xc4, 0x0d, 0x71, 0x43, 0x3b, 0x7e, 0xf6,
+	0xda, 0xeb, 0x8f, 0xf5, 0x6e, 0x36, 0x8a, 0x7a, 0xca, 0x7a, 0xe6, 0x7d, 0xfc, 0x7e, 0xef, 0xcd,
+	0x9b, 0x79, 0x4f, 0x01, 0xbe, 0x62, 0x58, 0xab, 0x86, 0x25, 0x5a, 0xb6, 0x74, 0x4f, 0xd3, 0x55,
+	0x71, 0x7d, 0xb2, 0xac, 0xd8, 0xd2, 0xa4, 0x78, 0x7f, 0x4d, 0x31, 0x37, 0x85, 0xba, 0x69, 0xd8,
+	0x06, 0x1d, 0x72, 0x64, 0x04, 0x94, 0x11, 0x50, 0x86, 0x1b, 0x47, 0xdd, 0xb2, 0x64, 0x29, 0x8e,
+	0x82, 0xa7, 0x5e, 0x97, 0x54, 0x4d, 0x97, 0x6c, 0xcd, 0xd0, 0x1d, 0x1b, 0xdc, 0xa0, 0x6a, 0xa8,
+	0x06, 0xfb, 0x29, 0x36, 0x7e, 0xe1, 0xea, 0x88, 0x6a, 0x18, 0x6a, 0x4d, 0x11, 0xa5, 0xba, 0x26,
+	0x4a, 0xba, 0x6e, 0xd8, 0x4c, 0xc5, 0xc2, 0xdd, 0xd1, 0x18, 0x6c, 0x2e, 0x0e, 0x26, 0xc5, 0x6f,
+	0xc0, 0xd0, 0x72, 0xc3, 0xf7, 0x8a, 0x54, 0xd3, 0x64, 0xc9, 0x36, 0x4c, 0xab, 0xa8, 0xdc, 0x5f,
+	0x53, 0x2c, 0x9b, 0x0e, 0xc1, 0x80, 0x65, 0x4b, 0xf6, 0x9a, 0x35, 0x4c, 0x0a, 0x64, 0xec, 0x68,
+	0x11, 0xbf, 0xe8, 0x02, 0x40, 0x13, 0xdf, 0x70, 0x5f, 0x81, 0x8c, 0x1d, 0x9b, 0x3a, 0x2f, 0x20,
+	0xc9, 0x06, 0x19, 0xc1, 0x61, 0x8f, 0xfe, 0x84, 0x25, 0x49, 0x55, 0xd0, 0x66, 0xd1, 0xa7, 0xc9,
+	0x7f, 0x4b, 0xe0, 0x4c, 0xc8, 0xb5, 0x55, 0x37, 0x74, 0x4b, 0xa1, 0xb7, 0x00, 0xd6, 0xbd, 0xd5,
+	0x61, 0x52, 0x38, 0x32, 0x76, 0x6c, 0xea, 0x9c, 0x10, 0x1d, 0x48, 0xc1, 0xd3, 0x9f, 0xef, 0x7f,
+	0xf8, 0x28, 0x9f, 0x29, 0xfa, 0x54, 0x1b, 0x86, 0x42, 0x60, 0x9f, 0x6f, 0x0b, 0xd6, 0x41, 0x11,
+	0x40, 0x7b, 0x1d, 0x4e, 0x07, 0xc1, 0xba, 0x61, 0x7a, 0x0e, 0x4e, 0x78, 0xfe, 0x4a, 0x92, 0x2c,
+	0x9b, 0x18, 0xae, 0xe3, 0xde, 0xea, 0x9c, 0x2c, 0x9b, 0x7c, 0xa9, 0x35, 0xce, 0x1e, 0xd7, 0xd7,
+	0xe0, 0xa8, 0x27, 0xca, 0x74, 0x3b, 0xa0, 0xda, 0xd4, 0xe4, 0x3f, 0x25, 0x50, 0x08, 0x7a, 0xb8,
+	0xa9, 0xd4, 0x14, 0xd5, 0x39, 0x12, 0x9d, 0x81, 0xed, 0x59, 0x8a, 0x1f, 0x13, 0x38, 0x97, 0x80,
+	0x09, 0x03, 0xf0, 0x1e, 0x0c, 0xca, 0xde, 0x72, 0xc9, 0xc4, 0x65, 0x37, 0xed, 0xe3, 0x71, 0xb1,
+	0x68, 0x9a, 0x72, 0x2d, 0xcd, 0x9f, 0x6d, 0x04, 0xe5, 0x9b, 0x3f, 0xf2, 0xd9, 0xf0, 0x9e, 0x55,
+	0xcc, 0xca, 0xe1, 0xc5, 0xde, 0x9d, 0x8f, 0x2f, 0x08, 0x5c, 0x08, 0x52, 0x7d, 0x43, 0x2f, 0x1b,
+	0xba, 0xac, 0xe9, 0xea, 0xe1, 0xe7, 0xe1, 0x77, 0x02, 0xe3, 0x69, 0xc0, 0x61, 0x42, 0xca, 0x90,
+	0x5d, 0x73, 0xf7, 0x43, 0xf9, 0xb8, 0x18, 0x97, 0x8f, 0x08, 0x93, 0x78, 0x4a, 0xa9, 0x67, 0xed,
+	0x00, 0x02, 0x5f, 0xc7, 0xc2, 0xf2, 0xa7, 0xdc, 0x0b, 0x32, 0xa6, 0xbc, 0x25, 0xc8, 0xde, 0x2a,
+	0x0b, 0x72, 0x38, 0x17, 0x7d, 0x11, 0xb9, 0xb8, 0xfa, 0xf4, 0x47, 0x0f, 0xf2, 0x99, 0xc7, 0x0f,
+	0xf2, 0x19, 0x7e, 0x1d, 0xce, 0x84, 0x3c, 0x62, 0xe4, 0xde, 0x85, 0x6c, 0xc4, 0x51, 0xc6, 0xaa,
+	0xee, 0xe0, 0x24, 0x17, 0x69, 0xf8, 0xb0, 0xf2, 0x9b, 0x90, 0x67, 0x7e, 0x23, 0x02, 0x7d, 0xd0,
+	0x94, 0x57, 0xa1, 0x10, 0xef, 0x1a, 0xb9, 0x2f, 0xc2, 0x80, 0x93, 0x67, 0xa4, 0xdb, 0xc5, 0x41,
+	0x41, 0x03, 0xfc, 0x97, 0xee, 0x5d, 0x76, 0xd3, 0x85, 0x1d, 0x5d, 0x43, 0x69, 0xb8, 0xf6, 0xa8,
+	0x86, 0x7c, 0xc1, 0xf8, 0xd9, 0xbd, 0xd5, 0xa2, 0xd1, 0x61, 0x38, 0x2a, 0x3d, 0xbb, 0xd5, 0x9c,
+	0xd8, 0x1c, 0xec, 0xf5, 0xf5, 0x95, 0x7b, 0x7d, 0x79, 0x9c, 0xda, 0x5c, 0x5f, 0x87, 0x13, 0x7a,
+	0xef, 0x22, 0x6b, 0x03, 0xf3, 0x49, 0xbc, 0xc8, 0xfe, 0x21, 0xf0, 0x0c, 0xe3, 0x56, 0x54, 0xe4,
+	0xae, 0x43, 0x3e, 0x01, 0xd4, 0x32, 0x2b, 0xa5, 0xc8, 0xea, 0x3e, 0x69, 0x99, 0x95, 0x95, 0xc0,
+	0xfb, 0x32, 0x01, 0x54, 0xb6, 0xec, 0x56, 0xe9, 0x23, 0x8e, 0xb4, 0x6c, 0xd9, 0x2b, 0x09, 0xaf,
+	0x51, 0x7f, 0x0f, 0xd2, 0xb9, 0x43, 0x80, 0x8b, 0xa2, 0x8c, 0xe9, 0xd3, 0x60, 0xc8, 0x54, 0x12,
+	0x8a, 0x68, 0x22, 0x2e, 0x83, 0x7e, 0x73, 0x2d, 0x65, 0x74, 0xda, 0x54, 0x0e, 0xba, 0x0f, 0xc8,
+	0x07, 0x4f, 0x68, 0xb8, 0xb3, 0x3e, 0xb4, 0xf2, 0xf9, 0x3e, 0x74, 0xaf, 0x3e, 0x11, 0xbd, 0xf7,
+	0x06, 0xe4, 0x62, 0x50, 0x1f, 0xf4, 0xbb, 0x57, 0x8d, 0x4d, 0x66, 0xaf, 0xdb, 0xf7, 0xcb, 0x58,
+	0x09, 0xb7, 0x35, 0xcb, 0x36, 0x4c, 0xad, 0x22, 0xd5, 0x16, 0xf5, 0xbb, 0x86, 0x6f, 0x16, 0xab,
+	0x2a, 0x9a, 0x5a, 0xb5, 0x99, 0x87, 0x23, 0x45, 0xfc, 0xe2, 0xdf, 0x86, 0xb3, 0x91, 0x5a, 0x88,
+	0xed, 0x2a, 0xf4, 0x57, 0x35, 0xcb, 0x1e, 0x26, 0xc1, 0xb3, 0xd3, 0x0a, 0xab, 0x45, 0x9b, 0xe9,
+	0xf0, 0x14, 0x4e, 0x32, 0xd3, 0x4b, 0x86, 0x51, 0x43, 0x18, 0xfc, 0x1d, 0x38, 0xe5, 0x5b, 0x43,
+	0x27, 0x33, 0xd0, 0x5f, 0x37, 0x8c, 0x1a, 0x3a, 0x19, 0x89, 0x73, 0xd2, 0xd0, 0x41, 0xda, 0x4c,
+	0x9e, 0x1f, 0x04, 0xea, 0x18, 0x93, 0x4c, 0x69, 0xd5, 0xad, 0x0d, 0xfe, 0x43, 0x02, 0xd9, 0xc0,
+	0x32, 0x7a, 0xb9, 0x06, 0x03, 0x75, 0xb6, 0x82, 0x7e, 0x72, 0xb1, 0x7e, 0x98, 0x94, 0xdb, 0x50,
+	0x38, 0x3a, 0x54, 0x80, 0x6c, 0x4d, 0xb2, 0xec, 0x52, 0xa5, 0x2a, 0xe9, 0xaa, 0x22, 0x97, 0x30,
+	0x98, 0x7d, 0x2c, 0x98, 0xa7, 0x1a, 0x5b, 0x37, 0x9c, 0x9d, 0xdb, 0x6c, 0x63, 0xea, 0xef, 0xd3,
+	0xf0, 0x14, 0x43, 0x41, 0x3f, 0x27, 0x00, 0xcd, 0x22, 0xa1, 0x42, 0x9c, 0xdb, 0xe8, 0x21, 0x9a,
+	0x13, 0x53, 0xcb, 0x63, 0x93, 0x37, 0xfe, 0xc1, 0x2f, 0x7f, 0x7d, 0xd6, 0x37, 0x4a, 0x79, 0x31,
+	0x66, 0x7c, 0xf7, 0x15, 0xd8, 0xd7, 0x04, 0x8e, 0x7a, 0x26, 0xe8, 0xa5, 0x74, 0xae, 0x5c, 0x64,
+	0x42, 0x5a, 0x71, 0x04, 0xf6, 0x32, 0x03, 0xf6, 0x22, 0x9d, 0x6e, 0x0f, 0x4c, 0xdc, 0x0a, 0x56,
+	0xd9, 0x36, 0xfd, 0x95, 0xc0, 0x60, 0xd4, 0x0c, 0x48, 0x67, 0xd3, 0xa1, 0x08, 0xf7, 0x20, 0xdc,
+	0x4b, 0x5d, 0x68, 0x22, 0x95, 0x5b, 0x8c, 0xca, 0x1c, 0x7d, 0xa5, 0x0b, 0x2a, 0xa2, 0xef, 0xa1,
+	0xa2, 0xff, 0x11, 0x78, 0x36, 0x71, 0xa4, 0xa2, 0x73, 0xe9, 0x50, 0x26, 0x34, 0x5b, 0xdc, 0xfc,
+	0x7e, 0x4c, 0x20, 0xe3, 0x65, 0xc6, 0xf8, 0x0e, 0x5d, 0xec, 0x86, 0x71, 0xb3, 0x85, 0xf2, 0x73,
+	0xff, 0x91, 0x00, 0x34, 0x5d, 0xb5, 0x29, 0x8c, 0xd0, 0xa4, 0xc2, 0x89, 0xa9, 0xe5, 0x91, 0xc2,
+	0x5b, 0x8c, 0x42, 0x91, 0x2e, 0xed, 0x33, 0x69, 0xe2, 0x56, 0xf0, 0xa5, 0xd8, 0xa6, 0xff, 0x12,
+	0xc8, 0x46, 0x44, 0x8f, 0x5e, 0x49, 0x84, 0x18, 0x3f, 0x85, 0x71, 0xb3, 0x9d, 0x2b, 0x22, 0xc9,
+	0x55, 0x46, 0x52, 0xa5, 0x4a, 0xaf, 0x49, 0x46, 0x26, 0x91, 0xfe, 0x44, 0x60, 0x30, 0x6a, 0x88,
+	0x69, 0x53, 0x96, 0x09, 0x53, 0x59, 0x9b, 0xb2, 0x4c, 0x9a, 0x98, 0xf8, 0x6b, 0x8c, 0xfc, 0x0c,
+	0xbd, 0x1c, 0x47, 0x3e, 0x31, 0x8b, 0x8d, 0x5a, 0x4c, 0x9c, 0x0a, 0xda, 0xd4, 0x62, 0x9a, 0xc1,
+	0xa7, 0x4d, 0x2d, 0xa6, 0x1a, 0x4a, 0xda, 0xd7, 0xa2, 0xc7, 0x2c, 0x65, 0x1a, 0x2d, 0xfa, 0x03,
+	0x81, 0xe3, 0x81, 0x16, 0x9a, 0x4e, 0x26, 0x02, 0x8d, 0x9a, 0x30, 0xb8, 0xa9, 0x4e, 0x54, 0x90,
+	0xcb, 0x22, 0xe3, 0x72, 0x83, 0xce, 0x75, 0xc3, 0xc5, 0x0c, 0x20, 0xde, 0x21, 0x90, 0x8d, 0x68,
+	0x4b, 0xdb, 0x54, 0x61, 0x7c, 0x97, 0xcd, 0xcd, 0x76, 0xae, 0x88, 0xac, 0x16, 0x18, 0xab, 0x57,
+	0xe9, 0xf5, 0x6e, 0x58, 0xf9, 0xde, 0xe7, 0x47, 0x04, 0x68, 0xd8, 0x0f, 0x9d, 0xe9, 0x10, 0x98,
+	0x4b, 0xe8, 0x4a, 0xc7, 0x7a, 0xc8, 0xe7, 0x4d, 0xc6, 0x67, 0x99, 0xbe, 0xbe, 0x3f, 0x3e, 0xe1,
+	0x67, 0xfd, 0x3b, 0x02, 0x27, 0x82, 0xcd, 0x23, 0x4d, 0x3e, 0x45, 0x91, 0xdd, 0x2d, 0x37, 0xdd,
+	0x91, 0x0e, 0x92, 0x9a, 0x65, 0xa4, 0xa6, 0xe8, 0x0b, 0x71, 0xa4, 0xaa, 0x9e, 0x5e, 0x49, 0xd3,
+	0xef, 0x1a, 0xe2, 0x96, 0xd3, 0xf4, 0x6d, 0xd3, 0xf7, 0x09, 0xf4, 0x37, 0xba, 0x51, 0x3a, 0x96,
+	0xe8, 0xd7, 0xd7, 0xf8, 0x72, 0x17, 0x52, 0x48, 0x22, 0xae, 0x51, 0x86, 0x2b, 0x47, 0x47, 0xe2,
+	0x70, 0x35, 0x9a, 0x5f, 0xfa, 0x31, 0x81, 0x01, 0xa7, 0x53, 0xa5, 0xe3, 0xc9, 0xb6, 0xfd, 0xdd,
+	0x31, 0x77, 0x31, 0x95, 0x2c, 0x22, 0x39, 0xcf, 0x90, 0x14, 0x68, 0x2e, 0x16, 0x89, 0xd3, 0x2a,
+	0x2f, 0x3c, 0xdc, 0xcd, 0x91, 0x9d, 0xdd, 0x1c, 0xf9, 0x73, 0x37, 0x47, 0x3e, 0xd9, 0xcb, 0x65,
+	0x76, 0xf6, 0x72, 0x99, 0xdf, 0xf6, 0x72, 0x99, 0x77, 0x26, 0x54, 0xcd, 0xae, 0xae, 0x95, 0x85,
+	0x8a, 0xb1, 0xea, 0xda, 0x70, 0xfe, 0x5c, 0xb2, 0xe4, 0x7b, 0xe2, 0x86, 0x67, 0xd0, 0xde, 0xac,
+	0x2b, 0x56, 0x79, 0x80, 0xfd, 0x47, 0x69, 0xfa, 0xff, 0x01, 0x00, 0x8f, 0xab, 0x8c, 0x71, 0x15,
+	0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3182,6 +3193,11 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastChangedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastChangedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3638,6 +3654,9 @@ func (m *QueryParamsResponse) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.LastChangedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastChangedHeight))
+	}
 	return n
 }
 
@@ -6536,6 +6555,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChangedHeight", wireType)
+			}
+			m.LastChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastChangedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
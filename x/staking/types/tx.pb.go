@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -450,6 +451,99 @@ func (m *MsgUndelegateResponse) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address allowed to update the parameters of the module.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params are the new parameters of the module. All the parameters must be
+	// set.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{10}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse is the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{11}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -461,66 +555,75 @@ func init() {
 	proto.RegisterType((*MsgBeginRedelegateResponse)(nil), "cosmos.staking.v1beta1.MsgBeginRedelegateResponse")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.v1beta1.MsgUndelegate")
 	proto.RegisterType((*MsgUndelegateResponse)(nil), "cosmos.staking.v1beta1.MsgUndelegateResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4d, 0x6b, 0xe3, 0x46,
-	0x18, 0xb6, 0x6c, 0xc7, 0x4d, 0x27, 0xe4, 0x4b, 0xf9, 0xc0, 0x11, 0xc1, 0x0a, 0x4a, 0x3f, 0x42,
-	0xdb, 0xc8, 0x4d, 0x4a, 0x29, 0xe4, 0x52, 0xe2, 0xb8, 0xa1, 0x21, 0x35, 0x14, 0x25, 0xed, 0xa1,
-	0x14, 0x8c, 0x3e, 0xc6, 0xaa, 0xb0, 0xa4, 0x51, 0x34, 0xe3, 0x10, 0x43, 0x7f, 0x40, 0x8f, 0x81,
-	0xde, 0xf6, 0x94, 0x1f, 0xb1, 0x3f, 0x22, 0x2c, 0xec, 0x92, 0xe3, 0xb2, 0x07, 0xef, 0x92, 0xc0,
-	0x92, 0xb3, 0x7f, 0xc1, 0xa2, 0xd1, 0x48, 0x96, 0xe5, 0x0f, 0x4c, 0x58, 0x5f, 0xf6, 0x64, 0x33,
-	0xf3, 0xcc, 0xf3, 0xce, 0xfb, 0xbc, 0xcf, 0xbc, 0xaf, 0x80, 0xa8, 0x23, 0xec, 0x20, 0x5c, 0xc6,
-	0x44, 0x6d, 0x5a, 0xae, 0x59, 0xbe, 0xdc, 0xd3, 0x20, 0x51, 0xf7, 0xca, 0xe4, 0x4a, 0xf6, 0x7c,
-	0x44, 0x10, 0xbf, 0x1e, 0x02, 0x64, 0x06, 0x90, 0x19, 0x40, 0xd8, 0x30, 0x11, 0x32, 0x6d, 0x58,
-	0xa6, 0x28, 0xad, 0xd5, 0x28, 0xab, 0x6e, 0x3b, 0x3c, 0x22, 0x88, 0xe9, 0x2d, 0x62, 0x39, 0x10,
-	0x13, 0xd5, 0xf1, 0x18, 0x60, 0xd5, 0x44, 0x26, 0xa2, 0x7f, 0xcb, 0xc1, 0x3f, 0xb6, 0xba, 0x11,
-	0x46, 0xaa, 0x87, 0x1b, 0x2c, 0x6c, 0xb8, 0x55, 0x62, 0xb7, 0xd4, 0x54, 0x0c, 0xe3, 0x2b, 0xea,
-	0xc8, 0x72, 0xd9, 0xfe, 0x17, 0x23, 0xb2, 0x88, 0x2e, 0x4d, 0x51, 0xd2, 0xcb, 0x3c, 0xe0, 0x6b,
-	0xd8, 0x3c, 0xf2, 0xa1, 0x4a, 0xe0, 0x9f, 0xaa, 0x6d, 0x19, 0x2a, 0x41, 0x3e, 0x7f, 0x0a, 0xe6,
-	0x0c, 0x88, 0x75, 0xdf, 0xf2, 0x88, 0x85, 0xdc, 0x22, 0xb7, 0xc5, 0xed, 0xcc, 0xed, 0x6f, 0xcb,
-	0xc3, 0xf3, 0x96, 0xab, 0x3d, 0x68, 0x25, 0x7f, 0xdb, 0x11, 0x33, 0x4a, 0xf2, 0x34, 0x5f, 0x03,
-	0x40, 0x47, 0x8e, 0x63, 0x61, 0x1c, 0x70, 0x65, 0x29, 0xd7, 0xd7, 0xa3, 0xb8, 0x8e, 0x62, 0xa4,
-	0xa2, 0x12, 0x88, 0x19, 0x5f, 0x82, 0x80, 0xff, 0x17, 0xac, 0x38, 0x96, 0x5b, 0xc7, 0xd0, 0x6e,
-	0xd4, 0x0d, 0x68, 0x43, 0x53, 0xa5, 0x77, 0xcc, 0x6d, 0x71, 0x3b, 0x9f, 0x57, 0x7e, 0x0b, 0xe0,
-	0x6f, 0x3a, 0xe2, 0x57, 0xa6, 0x45, 0xfe, 0x69, 0x69, 0xb2, 0x8e, 0x1c, 0x26, 0x1b, 0xfb, 0xd9,
-	0xc5, 0x46, 0xb3, 0x4c, 0xda, 0x1e, 0xc4, 0xf2, 0x89, 0x4b, 0xba, 0x1d, 0x51, 0x68, 0xab, 0x8e,
-	0x7d, 0x20, 0x0d, 0xa1, 0x94, 0x94, 0x65, 0xc7, 0x72, 0xcf, 0xa0, 0xdd, 0xa8, 0xc6, 0x6b, 0xfc,
-	0x09, 0x58, 0x66, 0x08, 0xe4, 0xd7, 0x55, 0xc3, 0xf0, 0x21, 0xc6, 0xc5, 0x3c, 0x8d, 0xbd, 0xd9,
-	0xed, 0x88, 0xc5, 0x90, 0x6d, 0x00, 0x22, 0x29, 0x4b, 0xf1, 0xda, 0x61, 0xb8, 0x14, 0x50, 0x5d,
-	0x46, 0x8a, 0xc7, 0x54, 0x33, 0x69, 0xaa, 0x01, 0x88, 0xa4, 0x2c, 0xc5, 0x6b, 0x11, 0xd5, 0x31,
-	0x28, 0x78, 0x2d, 0xad, 0x09, 0xdb, 0xc5, 0x02, 0x95, 0x77, 0x55, 0x0e, 0xfd, 0x26, 0x47, 0x7e,
-	0x93, 0x0f, 0xdd, 0x76, 0xa5, 0xf8, 0xe2, 0xf9, 0xee, 0x2a, 0xd3, 0x5d, 0xf7, 0xdb, 0x1e, 0x41,
-	0xf2, 0xef, 0x2d, 0xed, 0x14, 0xb6, 0x15, 0x76, 0x9a, 0xff, 0x11, 0xcc, 0x5c, 0xaa, 0x76, 0x0b,
-	0x16, 0x3f, 0xa3, 0x34, 0x1b, 0x51, 0x95, 0x02, 0x93, 0x25, 0x4a, 0x64, 0x45, 0x75, 0x0e, 0xd1,
-	0x07, 0xb3, 0xff, 0xdd, 0x88, 0x99, 0xc7, 0x1b, 0x31, 0x23, 0x6d, 0x02, 0x61, 0xd0, 0x4e, 0x0a,
-	0xc4, 0x1e, 0x72, 0x31, 0x94, 0xfe, 0xcf, 0x81, 0xa5, 0x1a, 0x36, 0x7f, 0x31, 0x2c, 0x32, 0x25,
-	0xaf, 0xfd, 0x3c, 0x4c, 0xd3, 0x2c, 0xd5, 0x94, 0xef, 0x76, 0xc4, 0x85, 0x50, 0xd3, 0x31, 0x4a,
-	0x3a, 0x60, 0xb1, 0xe7, 0xb5, 0xba, 0xaf, 0x12, 0xc8, 0x9c, 0x55, 0x9d, 0xd0, 0x55, 0x55, 0xa8,
-	0x77, 0x3b, 0xe2, 0x7a, 0x18, 0x28, 0x45, 0x25, 0x29, 0x0b, 0x7a, 0x9f, 0xbf, 0xf9, 0xab, 0xe1,
-	0x66, 0x0e, 0x0d, 0xf5, 0xeb, 0x14, 0x8d, 0x9c, 0xa8, 0x99, 0x00, 0x8a, 0xe9, 0xa2, 0xc4, 0x15,
-	0x7b, 0xcf, 0x81, 0xb9, 0x1a, 0x36, 0xd9, 0x39, 0x38, 0xdc, 0xfe, 0xdc, 0xc7, 0xb3, 0x7f, 0xf6,
-	0x49, 0xf6, 0xff, 0x09, 0x14, 0x54, 0x07, 0xb5, 0x5c, 0x42, 0x6b, 0x35, 0x81, 0x6f, 0x19, 0x3c,
-	0x21, 0xc2, 0x1a, 0x58, 0x49, 0xe4, 0x19, 0xe7, 0xff, 0x2a, 0x4b, 0xfb, 0x63, 0x05, 0x9a, 0x96,
-	0xab, 0x40, 0x63, 0x0a, 0x32, 0x9c, 0x83, 0xb5, 0x5e, 0x8e, 0xd8, 0xd7, 0x53, 0x52, 0x6c, 0x75,
-	0x3b, 0xe2, 0x66, 0x5a, 0x8a, 0x04, 0x4c, 0x52, 0x56, 0xe2, 0xf5, 0x33, 0x5f, 0x1f, 0xca, 0x6a,
-	0x60, 0x12, 0xb3, 0xe6, 0x46, 0xb3, 0x26, 0x60, 0x49, 0xd6, 0x2a, 0x26, 0x83, 0x3a, 0xe7, 0x9f,
-	0xaa, 0x73, 0x93, 0x36, 0x88, 0x94, 0x9e, 0x91, 0xdc, 0x7c, 0x8d, 0xbe, 0x3e, 0xcf, 0x86, 0x81,
-	0x45, 0xeb, 0xc1, 0x8c, 0x64, 0xfd, 0x40, 0x18, 0x68, 0x68, 0xe7, 0xd1, 0x00, 0xad, 0xcc, 0x06,
-	0xa1, 0xae, 0xdf, 0x8a, 0x1c, 0x7d, 0x5d, 0xec, 0x70, 0xb0, 0x2d, 0x3d, 0x72, 0x60, 0xbe, 0x86,
-	0xcd, 0x3f, 0x5c, 0xe3, 0x93, 0xf7, 0x6f, 0x03, 0xac, 0xf5, 0x65, 0x3a, 0x25, 0x49, 0xf7, 0x9f,
-	0xe5, 0x41, 0xae, 0x86, 0x4d, 0xfe, 0x02, 0x2c, 0xa6, 0x3f, 0x1a, 0xbe, 0x19, 0xd5, 0xb3, 0x07,
-	0x27, 0x82, 0xb0, 0x3f, 0x39, 0x36, 0xce, 0xa4, 0x09, 0xe6, 0xfb, 0x27, 0xc7, 0xce, 0x18, 0x92,
-	0x3e, 0xa4, 0xf0, 0xfd, 0xa4, 0xc8, 0x38, 0xd8, 0xdf, 0x60, 0x36, 0x6e, 0x7a, 0xdb, 0x63, 0x4e,
-	0x47, 0x20, 0xe1, 0xdb, 0x09, 0x40, 0x31, 0xfb, 0x05, 0x58, 0x4c, 0xb7, 0x94, 0x71, 0xea, 0xa5,
-	0xb0, 0x63, 0xd5, 0x1b, 0xf5, 0xb4, 0x34, 0x00, 0x12, 0xef, 0xe0, 0xcb, 0x31, 0x0c, 0x3d, 0x98,
-	0xb0, 0x3b, 0x11, 0x2c, 0x8a, 0x51, 0x39, 0xbe, 0xbd, 0x2f, 0x71, 0x77, 0xf7, 0x25, 0xee, 0xdd,
-	0x7d, 0x89, 0xbb, 0x7e, 0x28, 0x65, 0xee, 0x1e, 0x4a, 0x99, 0xd7, 0x0f, 0xa5, 0xcc, 0x5f, 0xdf,
-	0x8d, 0x1d, 0x63, 0x57, 0xf1, 0x57, 0x2a, 0x1d, 0x68, 0x5a, 0x81, 0x5a, 0xf2, 0x87, 0x0f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa1, 0x2b, 0xfd, 0x07, 0x8a, 0x0b, 0x00, 0x00,
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x5e, 0x67, 0x93, 0xa5, 0x9d, 0xd0, 0x24, 0x75, 0x92, 0x76, 0x63, 0x45, 0x76, 0xe4, 0x02,
+	0x8d, 0x0a, 0xf1, 0xd2, 0x00, 0x42, 0x8a, 0x90, 0x50, 0xb7, 0x4b, 0x45, 0x55, 0x56, 0xaa, 0xdc,
+	0xc2, 0x01, 0x21, 0xad, 0x66, 0xed, 0x89, 0x63, 0xed, 0xda, 0xe3, 0x7a, 0x66, 0xa3, 0x58, 0x82,
+	0x0b, 0xe2, 0xc0, 0xb1, 0x7f, 0x42, 0xaf, 0xdc, 0x38, 0xf0, 0x47, 0x54, 0x9c, 0x7a, 0x44, 0x1c,
+	0x16, 0x94, 0x20, 0xc1, 0x79, 0x6f, 0x48, 0x08, 0x21, 0x7b, 0xc6, 0xb3, 0x5e, 0x7b, 0x77, 0x65,
+	0xa1, 0xee, 0xa9, 0xa7, 0xb5, 0xde, 0x7c, 0xf3, 0xbd, 0x79, 0xdf, 0xfb, 0xe6, 0xc7, 0x02, 0xcd,
+	0xc2, 0xc4, 0xc3, 0xa4, 0x41, 0x28, 0xec, 0xb9, 0xbe, 0xd3, 0x38, 0xbd, 0xdd, 0x45, 0x14, 0xde,
+	0x6e, 0xd0, 0x33, 0x23, 0x08, 0x31, 0xc5, 0xf2, 0x35, 0x06, 0x30, 0x38, 0xc0, 0xe0, 0x00, 0x65,
+	0xc7, 0xc1, 0xd8, 0xe9, 0xa3, 0x46, 0x82, 0xea, 0x0e, 0x8e, 0x1b, 0xd0, 0x8f, 0xd8, 0x14, 0x45,
+	0xcb, 0x0f, 0x51, 0xd7, 0x43, 0x84, 0x42, 0x2f, 0xe0, 0x80, 0x2d, 0x07, 0x3b, 0x38, 0xf9, 0x6c,
+	0xc4, 0x5f, 0x3c, 0xba, 0xc3, 0x32, 0x75, 0xd8, 0x00, 0x4f, 0xcb, 0x86, 0x54, 0xbe, 0xca, 0x2e,
+	0x24, 0x48, 0x2c, 0xd1, 0xc2, 0xae, 0xcf, 0xc7, 0xdf, 0x98, 0x51, 0x45, 0xba, 0x68, 0x86, 0xba,
+	0xce, 0x51, 0x1e, 0x89, 0x11, 0xf1, 0x0f, 0x1b, 0xd0, 0xff, 0x5e, 0x06, 0x72, 0x9b, 0x38, 0x77,
+	0x43, 0x04, 0x29, 0xfa, 0x02, 0xf6, 0x5d, 0x1b, 0x52, 0x1c, 0xca, 0x0f, 0xc0, 0xaa, 0x8d, 0x88,
+	0x15, 0xba, 0x01, 0x75, 0xb1, 0x5f, 0x97, 0xf6, 0xa4, 0xfd, 0xd5, 0xc3, 0x1b, 0xc6, 0x74, 0x41,
+	0x8c, 0xd6, 0x18, 0xda, 0x5c, 0x7e, 0x3e, 0xd4, 0x2a, 0x66, 0x76, 0xb6, 0xdc, 0x06, 0xc0, 0xc2,
+	0x9e, 0xe7, 0x12, 0x12, 0x73, 0x2d, 0x25, 0x5c, 0x37, 0x67, 0x71, 0xdd, 0x15, 0x48, 0x13, 0x52,
+	0x44, 0x38, 0x5f, 0x86, 0x40, 0xfe, 0x1a, 0x6c, 0x7a, 0xae, 0xdf, 0x21, 0xa8, 0x7f, 0xdc, 0xb1,
+	0x51, 0x1f, 0x39, 0x30, 0x59, 0x63, 0x75, 0x4f, 0xda, 0xbf, 0xdc, 0xfc, 0x2c, 0x86, 0xff, 0x3a,
+	0xd4, 0xde, 0x72, 0x5c, 0x7a, 0x32, 0xe8, 0x1a, 0x16, 0xf6, 0xb8, 0x9e, 0xfc, 0xe7, 0x80, 0xd8,
+	0xbd, 0x06, 0x8d, 0x02, 0x44, 0x8c, 0xfb, 0x3e, 0x1d, 0x0d, 0x35, 0x25, 0x82, 0x5e, 0xff, 0x48,
+	0x9f, 0x42, 0xa9, 0x9b, 0x57, 0x3d, 0xd7, 0x7f, 0x84, 0xfa, 0xc7, 0x2d, 0x11, 0x93, 0xef, 0x83,
+	0xab, 0x1c, 0x81, 0xc3, 0x0e, 0xb4, 0xed, 0x10, 0x11, 0x52, 0x5f, 0x4e, 0x72, 0xef, 0x8e, 0x86,
+	0x5a, 0x9d, 0xb1, 0x15, 0x20, 0xba, 0xb9, 0x21, 0x62, 0x77, 0x58, 0x28, 0xa6, 0x3a, 0x4d, 0x15,
+	0x17, 0x54, 0x2b, 0x79, 0xaa, 0x02, 0x44, 0x37, 0x37, 0x44, 0x2c, 0xa5, 0xba, 0x07, 0x6a, 0xc1,
+	0xa0, 0xdb, 0x43, 0x51, 0xbd, 0x96, 0xc8, 0xbb, 0x65, 0x30, 0x23, 0x1a, 0xa9, 0x11, 0x8d, 0x3b,
+	0x7e, 0xd4, 0xac, 0xff, 0xfc, 0xd3, 0xc1, 0x16, 0xd7, 0xdd, 0x0a, 0xa3, 0x80, 0x62, 0xe3, 0xe1,
+	0xa0, 0xfb, 0x00, 0x45, 0x26, 0x9f, 0x2d, 0x7f, 0x00, 0x56, 0x4e, 0x61, 0x7f, 0x80, 0xea, 0xaf,
+	0x25, 0x34, 0x3b, 0x69, 0x97, 0x62, 0xf7, 0x65, 0x5a, 0xe4, 0xa6, 0x7d, 0x66, 0xe8, 0xa3, 0xf7,
+	0xbf, 0x7f, 0xa6, 0x55, 0xfe, 0x7a, 0xa6, 0x55, 0xbe, 0xfd, 0xf3, 0xc7, 0x5b, 0x45, 0x7d, 0x92,
+	0x68, 0xb1, 0x8e, 0x5d, 0xa0, 0x14, 0xad, 0x67, 0x22, 0x12, 0x60, 0x9f, 0x20, 0xfd, 0x87, 0x2a,
+	0xd8, 0x68, 0x13, 0xe7, 0x13, 0xdb, 0xa5, 0x0b, 0xf2, 0xe5, 0xc7, 0xd3, 0xf4, 0x5f, 0x4a, 0xf4,
+	0x97, 0x47, 0x43, 0x6d, 0x8d, 0xe9, 0x3f, 0x47, 0x75, 0x0f, 0xac, 0x8f, 0x7d, 0xd9, 0x09, 0x21,
+	0x45, 0xdc, 0x85, 0xad, 0x92, 0x0e, 0x6c, 0x21, 0x6b, 0x34, 0xd4, 0xae, 0xb1, 0x44, 0x39, 0x2a,
+	0xdd, 0x5c, 0xb3, 0x26, 0xf6, 0x82, 0x7c, 0x36, 0xdd, 0xf8, 0xcc, 0x7c, 0x9f, 0x2e, 0xd0, 0xf4,
+	0x47, 0xea, 0x44, 0x7f, 0x8b, 0x9d, 0x54, 0x40, 0x3d, 0xdf, 0x2a, 0xd1, 0xc7, 0x7f, 0x24, 0xb0,
+	0xda, 0x26, 0x0e, 0x67, 0x43, 0xd3, 0x37, 0x90, 0xf4, 0xf2, 0x36, 0xd0, 0xd2, 0xff, 0xda, 0x40,
+	0x1f, 0x82, 0x1a, 0xf4, 0xf0, 0xc0, 0xa7, 0xf5, 0x6a, 0x39, 0xe7, 0x73, 0x78, 0x4e, 0x9a, 0xe2,
+	0xb2, 0xb7, 0xc1, 0x66, 0xa6, 0x7a, 0xa1, 0xca, 0x1f, 0x4b, 0xc9, 0xb9, 0xdb, 0x44, 0x8e, 0xeb,
+	0x9b, 0xc8, 0x5e, 0x80, 0x38, 0x8f, 0xc1, 0xf6, 0xb8, 0x72, 0x12, 0x5a, 0x39, 0x81, 0xf6, 0x46,
+	0x43, 0x6d, 0x37, 0x2f, 0x50, 0x06, 0xa6, 0x9b, 0x9b, 0x22, 0xfe, 0x28, 0xb4, 0xa6, 0xb2, 0xda,
+	0x84, 0x0a, 0xd6, 0xea, 0x6c, 0xd6, 0x0c, 0x2c, 0xcb, 0xda, 0x22, 0xb4, 0xa8, 0xfe, 0xf2, 0xcb,
+	0x55, 0xbf, 0x07, 0x94, 0xa2, 0xca, 0x69, 0x13, 0xe4, 0x76, 0xb2, 0x7f, 0x83, 0x3e, 0x8a, 0x4d,
+	0xde, 0x89, 0xaf, 0x6a, 0x7e, 0xa2, 0x28, 0x85, 0xe3, 0xf3, 0x71, 0x7a, 0x8f, 0x37, 0x2f, 0xc5,
+	0x0b, 0x78, 0xfa, 0x9b, 0x26, 0x99, 0x6b, 0xe3, 0xc9, 0xf1, 0xb0, 0xfe, 0xaf, 0x04, 0xae, 0xb4,
+	0x89, 0xf3, 0xb9, 0x6f, 0xbf, 0xa2, 0x5e, 0x3f, 0x06, 0xdb, 0x13, 0xf5, 0x2f, 0x4a, 0xe8, 0x6f,
+	0xc0, 0x7a, 0x9c, 0x27, 0xb0, 0x21, 0x45, 0x0f, 0x61, 0x08, 0x3d, 0x22, 0xef, 0x82, 0xcb, 0x70,
+	0x40, 0x4f, 0x70, 0xe8, 0xd2, 0x88, 0x29, 0x6c, 0x8e, 0x03, 0xf2, 0x47, 0xa0, 0x16, 0x24, 0x38,
+	0xfe, 0xfa, 0x50, 0x67, 0xdd, 0x18, 0x8c, 0x2d, 0x2d, 0x9b, 0xcd, 0x39, 0x5a, 0x8b, 0xcb, 0x1d,
+	0xb3, 0xe9, 0x3b, 0xe0, 0x7a, 0x2e, 0x7d, 0x5a, 0xe8, 0xe1, 0x77, 0x2b, 0xa0, 0xda, 0x26, 0x8e,
+	0xfc, 0x04, 0xac, 0xe7, 0x9f, 0x54, 0xb7, 0x66, 0xe5, 0x2c, 0xde, 0x81, 0xca, 0x61, 0x79, 0xac,
+	0xd0, 0xb8, 0x07, 0xae, 0x4c, 0xde, 0x95, 0xfb, 0x73, 0x48, 0x26, 0x90, 0xca, 0xbb, 0x65, 0x91,
+	0x22, 0xd9, 0x57, 0xe0, 0x92, 0x38, 0xd0, 0x6f, 0xcc, 0x99, 0x9d, 0x82, 0x94, 0xb7, 0x4b, 0x80,
+	0x04, 0xfb, 0x13, 0xb0, 0x9e, 0x3f, 0x18, 0xe7, 0xa9, 0x97, 0xc3, 0x2a, 0x87, 0xe5, 0xb1, 0x22,
+	0x65, 0x17, 0x80, 0xcc, 0xbe, 0x7d, 0x73, 0x0e, 0xc3, 0x18, 0xa6, 0x1c, 0x94, 0x82, 0x89, 0x1c,
+	0x27, 0xe0, 0xf5, 0x09, 0xcf, 0xde, 0x9c, 0x37, 0x3d, 0x03, 0x54, 0x1a, 0x25, 0x81, 0x69, 0xa6,
+	0xe6, 0xbd, 0xe7, 0xe7, 0xaa, 0xf4, 0xe2, 0x5c, 0x95, 0x7e, 0x3f, 0x57, 0xa5, 0xa7, 0x17, 0x6a,
+	0xe5, 0xc5, 0x85, 0x5a, 0xf9, 0xe5, 0x42, 0xad, 0x7c, 0xf9, 0xce, 0xdc, 0x27, 0xc2, 0x99, 0xf8,
+	0x1b, 0x91, 0x3c, 0x16, 0xba, 0xb5, 0x64, 0x5b, 0xbe, 0xf7, 0xdf, 0x00, 0xd8, 0xbd, 0x70, 0x9a,
+	0x2b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -548,6 +651,10 @@ type MsgClient interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	// UpdateParams updates the parameters of the module, validated as a whole.
+	// The signer must be the authority of the module, which is the governance
+	// module account unless overridden by the application.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -603,6 +710,15 @@ func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -618,6 +734,10 @@ type MsgServer interface {
 	// Undelegate defines a method for performing an undelegation from a
 	// delegate and a validator.
 	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	// UpdateParams updates the parameters of the module, validated as a whole.
+	// The signer must be the authority of the module, which is the governance
+	// module account unless overridden by the application.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -639,6 +759,9 @@ func (*UnimplementedMsgServer) BeginRedelegate(ctx context.Context, req *MsgBegi
 func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -734,6 +857,24 @@ func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -758,6 +899,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Undelegate",
 			Handler:    _Msg_Undelegate_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1195,6 +1340,69 @@ func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1368,6 +1576,30 @@ func (m *MsgUndelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2633,6 +2865,171 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,17 +16,35 @@ import (
 // If the current height is in the provided set of heights to skip, it will skip and clear the upgrade plan.
 // If it is ready, it will execute it if the handler is installed, and panic/abort otherwise.
 // If the plan is not ready, it will ensure the handler is not registered too early (and abort otherwise).
+// The height of a plan scheduled by time is re-estimated every DriftCheckInterval blocks.
+// The pre-upgrade checks of the modules run a configured number of blocks before the upgrade height.
 //
 // The purpose is to ensure the binary is switched EXACTLY at the desired block, and to allow
 // a migration to be executed if needed upon this switch (migration defined in the new binary)
 // skipUpgradeHeightArray is a set of block heights for which the upgrade must be skipped
 func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	k.TrackBlockTime(ctx)
+
 	plan, found := k.GetUpgradePlan(ctx)
 	if !found {
 		return
 	}
 
+	// re-estimate the height of a time-based plan every drift check interval
+	if !plan.ShouldExecute(ctx) && ctx.BlockHeight()%types.DriftCheckInterval == 0 {
+		plan = k.CorrectUpgradeDrift(ctx, plan)
+	}
+
+	// warn ahead of the upgrade height of the preconditions it fails
+	if k.IsPreUpgradeCheckHeight(ctx, plan) {
+		if k.IsSkipHeight(plan.Height) {
+			ctx.Logger().Info(fmt.Sprintf("upgrade \"%s\" at %s will be skipped by this node", plan.Name, plan.DueAt()))
+		} else {
+			k.RunPreUpgradeChecks(ctx, plan)
+		}
+	}
+
 	// To make sure clear upgrade is executed at the same block
 	if plan.ShouldExecute(ctx) {
 		// If skip upgrade has been set for current height, we clear the upgrade plan
@@ -40,6 +58,11 @@ func BeginBlocker(k keeper.Keeper, ctx sdk.Context, _ abci.RequestBeginBlock) {
 		}
 
 		if !k.HasHandler(plan.Name) {
+			// The binary is about to be switched, warn if it cannot be downloaded for this node.
+			if err := k.ValidateUpgradeArtifact(plan); err != nil {
+				ctx.Logger().Error("upgrade binary cannot be downloaded", "upgrade", plan.Name, "err", err)
+			}
+
 			// Write the upgrade info to disk. The UpgradeStoreLoader uses this info to perform or skip
 			// store migrations.
 			err := k.DumpUpgradeInfoWithInfoToDisk(ctx.BlockHeight(), plan.Name, plan.Info)
@@ -84,6 +84,26 @@ func TestDoHeightUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestDoTimeUpgrade(t *testing.T) {
+	s := setupTest(types.DriftCheckInterval-1, map[int64]bool{})
+	start := s.ctx.BlockTime()
+	s.module.BeginBlock(s.ctx, abci.RequestBeginBlock{})
+
+	t.Log("Verify the height of a time-based upgrade is estimated at the default block time")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Time: start.Add(10 * types.DefaultBlockTime)}})
+	require.NoError(t, err)
+	plan, _ := s.keeper.GetUpgradePlan(s.ctx)
+	require.Equal(t, s.ctx.BlockHeight()+10, plan.Height)
+
+	t.Log("Verify the height is re-estimated at the drift check interval")
+	newCtx := s.ctx.WithBlockHeight(types.DriftCheckInterval).WithBlockTime(start.Add(types.MaxUpgradeTimeDrift))
+	s.module.BeginBlock(newCtx, abci.RequestBeginBlock{})
+	plan, _ = s.keeper.GetUpgradePlan(newCtx)
+	require.Equal(t, newCtx.BlockHeight()+1, plan.Height)
+
+	VerifyDoUpgradeWithCtx(t, newCtx.WithBlockHeight(plan.Height), "test")
+}
+
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Can overwrite plan")
@@ -385,6 +405,42 @@ func TestUpgradeWithoutSkip(t *testing.T) {
 	VerifyDone(t, s.ctx, "test")
 }
 
+type preUpgradeChecker func(sdk.Context, types.Plan) error
+
+func (c preUpgradeChecker) PreUpgradeCheck(ctx sdk.Context, plan types.Plan) error { return c(ctx, plan) }
+
+func TestPreUpgradeChecks(t *testing.T) {
+	s := setupTest(10, map[int64]bool{300: true})
+
+	var checked []int64
+	s.keeper.SetPreUpgradeChecker("test", preUpgradeChecker(func(ctx sdk.Context, _ types.Plan) error {
+		checked = append(checked, ctx.BlockHeight())
+		return fmt.Errorf("precondition not met")
+	}))
+
+	beginBlock := func(height int64) {
+		newCtx := s.ctx.WithBlockHeight(height)
+		s.module.BeginBlock(newCtx, abci.RequestBeginBlock{Header: newCtx.BlockHeader()})
+	}
+
+	planHeight := s.ctx.BlockHeight() + types.DefaultPreUpgradeCheckBlocks + 10
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: planHeight}})
+	require.NoError(t, err)
+
+	t.Log("verify the checks only run at the pre-upgrade check height, without halting")
+	for height := planHeight - types.DefaultPreUpgradeCheckBlocks - 1; height < planHeight; height++ {
+		beginBlock(height)
+	}
+	require.Equal(t, []int64{planHeight - types.DefaultPreUpgradeCheckBlocks}, checked)
+
+	t.Log("verify the checks do not run for a skipped upgrade")
+	checked = nil
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "skipped", Height: 300}})
+	require.NoError(t, err)
+	beginBlock(300 - types.DefaultPreUpgradeCheckBlocks)
+	require.Empty(t, checked)
+}
+
 func TestDumpUpgradeInfoToFile(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 
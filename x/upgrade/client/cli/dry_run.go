@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// UpgradeDryRunner loads the application from db at its latest height and
+// executes the upgrade handler of the named plan on a branch of its state, at
+// the next height, e.g. with the upgrade keeper's DryRunUpgrade.
+type UpgradeDryRunner func(logger log.Logger, db dbm.DB, traceStore io.Writer, planName string, appOpts servertypes.AppOptions) (types.DryRunReport, error)
+
+// NewUpgradeCmd returns the command grouping the upgrade tooling run against
+// a node's data directory.
+func NewUpgradeCmd(runner UpgradeDryRunner, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "Tools to prepare and recover from the upgrades of a node",
+	}
+
+	cmd.AddCommand(
+		NewDryRunCmd(runner, defaultNodeHome),
+		NewRollbackToBackupCmd(defaultNodeHome),
+	)
+
+	return cmd
+}
+
+// NewDryRunCmd returns a command executing an upgrade handler, and the store
+// migrations it runs, against the state of a node's data directory.
+func NewDryRunCmd(runner UpgradeDryRunner, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run [plan-name]",
+		Short: "Execute the handler of an upgrade against the state of a node's data directory",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Open the application database of a node's data directory read-only, load its
+latest state and execute the upgrade handler registered in this binary for the
+given plan, along with the store migrations it runs, as if the upgrade happened
+at the next height. The resulting state is discarded. A report of the duration
+of the upgrade, of the migrations it ran, of the changes in size of the stores
+and of its error, if any, is printed.
+
+The database being opened read-only, it cannot be opened while the node is
+running with the goleveldb backend, which locks it; run the upgrade against a
+copy of the data directory instead, e.g. from a filesystem snapshot.
+
+Example:
+  $ %s upgrade dry-run v2 --home ~/.simapp-copy
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			// the errors below are not caused by the usage of the command
+			cmd.SilenceUsage = true
+
+			db, err := sdk.NewReadOnlyLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return fmt.Errorf("failed to open the application database, which cannot be opened while a node is running on it: %w", err)
+			}
+			defer db.Close()
+
+			report, err := runner(serverCtx.Logger, db, nil, args[0], serverCtx.Viper)
+			if err != nil {
+				return fmt.Errorf("error running the upgrade: %w", err)
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+
+			if !report.Success {
+				return fmt.Errorf("upgrade %s failed at height %d: %s", report.PlanName, report.Height, report.Error)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -22,6 +22,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetMigrationReportCmd(),
+		GetArtifactManifestCmd(),
+		GetSkipUpgradeHeightsCmd(),
 	)
 
 	return cmd
@@ -118,7 +121,8 @@ func GetModuleVersionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "module_versions [optional module_name]",
 		Short: "get the list of module versions",
-		Long: "Gets a list of module names and their respective consensus versions.\n" +
+		Long: "Gets a list of module names and their respective consensus versions,\n" +
+			"and the migrations applied by the most recent upgrade which ran migrations.\n" +
 			"Following the command with a specific module name will return only\n" +
 			"that module's information.",
 		Args: cobra.MaximumNArgs(1),
@@ -154,3 +158,94 @@ func GetModuleVersionsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetMigrationReportCmd returns the migration report of the queried node
+func GetMigrationReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migration-report",
+		Short: "get the migration report of the most recent upgrade",
+		Long: "Gets the result (duration, success) of the migrations of every module run by the\n" +
+			"most recent upgrade, as recorded by the queried node. The report is not part of the state.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MigrationReport(cmd.Context(), &types.QueryMigrationReportRequest{})
+			if err != nil {
+				return err
+			}
+
+			if res.Report == nil {
+				return fmt.Errorf("no migration report found")
+			}
+
+			return clientCtx.PrintProto(res.Report)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetArtifactManifestCmd returns the artifact manifest of the current upgrade plan
+func GetArtifactManifestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "artifact-manifest",
+		Short: "get the binaries to upgrade to for the current upgrade plan",
+		Long: "Gets the download url and sha256 checksum of the binary of every platform listed\n" +
+			"in the info of the currently scheduled upgrade plan.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ArtifactManifest(cmd.Context(), &types.QueryArtifactManifestRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSkipUpgradeHeightsCmd returns the upgrade heights skipped by the queried node
+func GetSkipUpgradeHeightsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "skip-upgrade-heights",
+		Short: "get the upgrade heights skipped by the queried node",
+		Long: "Gets the upgrade heights the queried node is configured to skip with --unsafe-skip-upgrades.\n" +
+			"The heights are a node configuration, not part of the state.",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SkipUpgradeHeights(cmd.Context(), &types.QuerySkipUpgradeHeightsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
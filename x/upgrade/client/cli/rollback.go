@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// FlagBackupDir is the flag selecting the backup the application database is
+// rolled back to.
+const FlagBackupDir = "backup-dir"
+
+// NewRollbackToBackupCmd returns a command replacing the application database
+// of a node's data directory with the backup made before an upgrade.
+func NewRollbackToBackupCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback-to-backup",
+		Short: "Replace the application database of a node with its backup made before an upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace the application database of a node's data directory with the backup
+made before the store migrations of the last upgrade, as recorded in %s by
+nodes started with --x-upgrade-backup-before-migrations, or with the backup of
+the given directory. The replaced database is renamed rather than deleted, and
+the backup is kept.
+
+The node must be stopped. Once rolled back, restart it with the binary of the
+upgrade to run the migrations again, e.g. after fixing them.
+
+Example:
+  $ %s upgrade rollback-to-backup --home ~/.simapp
+`,
+				types.UpgradeInfoFileName, version.AppName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			dataDir := filepath.Join(homeDir, "data")
+
+			backupDir, _ := cmd.Flags().GetString(FlagBackupDir)
+			if backupDir == "" {
+				var err error
+				if backupDir, err = types.ReadUpgradeBackup(dataDir); err != nil {
+					return err
+				}
+				if backupDir == "" {
+					return fmt.Errorf("no backup recorded in %s", filepath.Join(dataDir, types.UpgradeInfoFileName))
+				}
+			}
+
+			// the errors below are not caused by the usage of the command
+			cmd.SilenceUsage = true
+
+			replaced, err := types.RestoreDB(dataDir, backupDir)
+			if err != nil {
+				return fmt.Errorf("failed to roll the application database back to %s: %w", backupDir, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "rolled the application database back to %s\n", backupDir)
+			if replaced != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "the replaced database was moved to %s\n", replaced)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(FlagBackupDir, "", "The directory of the backup to roll back to, instead of the one recorded in the upgrade info")
+
+	return cmd
+}
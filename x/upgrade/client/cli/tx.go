@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,6 +15,7 @@ import (
 
 const (
 	FlagUpgradeHeight = "upgrade-height"
+	FlagUpgradeTime   = "upgrade-time"
 	FlagUpgradeInfo   = "upgrade-info"
 )
 
@@ -29,11 +32,13 @@ func GetTxCmd() *cobra.Command {
 // NewCmdSubmitUpgradeProposal implements a command handler for submitting a software upgrade proposal transaction.
 func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "software-upgrade [name] (--upgrade-height [height]) (--upgrade-info [info]) [flags]",
+		Use:   "software-upgrade [name] (--upgrade-height [height] | --upgrade-time [time]) (--upgrade-info [info]) [flags]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
 			"Please specify a unique name and height for the upgrade to take effect.\n" +
+			"Alternatively specify the time for the upgrade to take effect, the height being estimated from it\n" +
+			"when the proposal passes.\n" +
 			"You may include info to reference a binary download link, in a format compatible with: https://github.com/cosmos/cosmos-sdk/tree/master/cosmovisor",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -70,6 +75,7 @@ func NewCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().Int64(FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
+	cmd.Flags().String(FlagUpgradeTime, "", "The time (RFC3339) at which the upgrade should happen, instead of the height")
 	cmd.Flags().String(FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
 
 	return cmd
@@ -151,6 +157,18 @@ func parseArgsToContent(cmd *cobra.Command, name string) (gov.Content, error) {
 	}
 
 	plan := types.Plan{Name: name, Height: height, Info: info}
+
+	timeStr, err := cmd.Flags().GetString(FlagUpgradeTime)
+	if err != nil {
+		return nil, err
+	}
+	if timeStr != "" {
+		plan.Time, err = time.Parse(time.RFC3339, timeStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	content := types.NewSoftwareUpgradeProposal(title, description, plan)
 	return content, nil
 }
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// BackupBeforeUpgrade backs the application database up, before it is loaded,
+// if the upgrade written to disk by the old binary is about to be applied,
+// i.e. if its height follows the latest committed one, and records the
+// directory of the backup in the upgrade info file. The directory is returned,
+// or an empty string if there is no upgrade to apply. An upgrade is backed up
+// once, restarting the node before it is applied keeping the first backup.
+func (k Keeper) BackupBeforeUpgrade(db dbm.DB) (string, error) {
+	upgradeInfoPath, err := k.GetUpgradeInfoPath()
+	if err != nil {
+		return "", err
+	}
+
+	bz, err := ioutil.ReadFile(upgradeInfoPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var info upgradeInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return "", err
+	}
+
+	if info.Name == "" || info.Height != rootmulti.GetLatestVersion(db)+1 {
+		return "", nil
+	}
+
+	if info.Backup != "" {
+		if _, err := os.Stat(info.Backup); err == nil {
+			return info.Backup, nil
+		}
+	}
+
+	info.Backup = types.UpgradeBackupDir(filepath.Dir(upgradeInfoPath), info.Name, info.Height)
+	if err := types.BackupDB(db, info.Backup); err != nil {
+		return "", err
+	}
+
+	if bz, err = json.Marshal(info); err != nil {
+		return "", err
+	}
+
+	return info.Backup, ioutil.WriteFile(upgradeInfoPath, bz, 0600)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// TrackBlockTime samples the time of the current block every
+// DriftCheckInterval blocks, and at the first block if no time was sampled
+// yet. Only the two most recent samples are kept, so that the average block
+// time is always measured over at least one interval once available.
+func (k Keeper) TrackBlockTime(ctx sdk.Context) {
+	samples := k.getBlockTimeSamples(ctx)
+	if len(samples) > 0 && ctx.BlockHeight()%types.DriftCheckInterval != 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockTimeKey(ctx.BlockHeight()), sdk.FormatTimeBytes(ctx.BlockTime()))
+	for len(samples) > 1 {
+		store.Delete(types.BlockTimeKey(samples[0].height))
+		samples = samples[1:]
+	}
+}
+
+// AverageBlockTime returns the average block time since the oldest block time
+// sample, or DefaultBlockTime if no block time was sampled before the current
+// block.
+func (k Keeper) AverageBlockTime(ctx sdk.Context) time.Duration {
+	samples := k.getBlockTimeSamples(ctx)
+	if len(samples) == 0 || samples[0].height >= ctx.BlockHeight() {
+		return types.DefaultBlockTime
+	}
+
+	elapsed := ctx.BlockTime().Sub(samples[0].time)
+	avg := elapsed / time.Duration(ctx.BlockHeight()-samples[0].height)
+	if avg <= 0 {
+		return types.DefaultBlockTime
+	}
+	return avg
+}
+
+// EstimateUpgradeHeight returns the height expected to be reached at the given
+// time at the average block time. It is at least the next height.
+func (k Keeper) EstimateUpgradeHeight(ctx sdk.Context, t time.Time) int64 {
+	avg := k.AverageBlockTime(ctx)
+	remaining := t.Sub(ctx.BlockTime())
+	blocks := int64((remaining + avg - 1) / avg)
+	if blocks < 1 {
+		blocks = 1
+	}
+	return ctx.BlockHeight() + blocks
+}
+
+// EstimateUpgradeTime returns the time of a time-based upgrade plan, or the
+// time expected to be reached at the height of the plan at the average block
+// time.
+func (k Keeper) EstimateUpgradeTime(ctx sdk.Context, plan types.Plan) time.Time {
+	if plan.IsTimeBased() {
+		return plan.Time
+	}
+	return ctx.BlockTime().Add(time.Duration(plan.Height-ctx.BlockHeight()) * k.AverageBlockTime(ctx))
+}
+
+// CorrectUpgradeDrift re-estimates the height of a time-based upgrade plan and
+// reschedules the plan when the estimated time of its current height drifted
+// from its time by more than MaxUpgradeTimeDrift. It returns the plan, which is
+// unchanged unless it was rescheduled.
+func (k Keeper) CorrectUpgradeDrift(ctx sdk.Context, plan types.Plan) types.Plan {
+	if !plan.IsTimeBased() {
+		return plan
+	}
+
+	estimated := k.EstimateUpgradeHeight(ctx, plan.Time)
+	drift := estimated - plan.Height
+	if drift < 0 {
+		drift = -drift
+	}
+	if time.Duration(drift)*k.AverageBlockTime(ctx) <= types.MaxUpgradeTimeDrift {
+		return plan
+	}
+
+	previousHeight := plan.Height
+	plan.Height = estimated
+
+	// the upgraded IBC state is stored under the last height of the chain
+	store := ctx.KVStore(k.storeKey)
+	if bz, found := k.GetUpgradedClient(ctx, previousHeight); found {
+		store.Set(types.UpgradedClientKey(plan.Height), bz)
+	}
+	if bz, found := k.GetUpgradedConsensusState(ctx, previousHeight); found {
+		store.Set(types.UpgradedConsStateKey(plan.Height), bz)
+	}
+	k.ClearIBCState(ctx, previousHeight)
+	store.Set(types.PlanKey(), k.cdc.MustMarshal(&plan))
+
+	k.Logger(ctx).Info(fmt.Sprintf("rescheduled upgrade \"%s\" from height %d to %d", plan.Name, previousHeight, plan.Height))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRescheduleUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, fmt.Sprintf("%d", previousHeight)),
+		),
+	)
+
+	return plan
+}
+
+type blockTimeSample struct {
+	height int64
+	time   time.Time
+}
+
+// getBlockTimeSamples returns the block time samples ordered by height.
+func (k Keeper) getBlockTimeSamples(ctx sdk.Context) []blockTimeSample {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), []byte{types.BlockTimeByte})
+	defer it.Close()
+
+	var samples []blockTimeSample
+	for ; it.Valid(); it.Next() {
+		t, err := sdk.ParseTimeBytes(it.Value())
+		if err != nil {
+			panic(err)
+		}
+		samples = append(samples, blockTimeSample{
+			height: int64(sdk.BigEndianToUint64(it.Key()[1:])),
+			time:   t,
+		})
+	}
+	return samples
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// DryRunUpgrade executes the upgrade handler of the plan, and the store
+// migrations it runs, on a branch of the state which is discarded, reporting
+// how long it took, how the sizes of the given stores changed and whether it
+// failed. Unlike ApplyUpgrade, it neither writes the migration report to disk
+// nor sets the protocol version, and a panicking handler is reported as failed.
+func (k Keeper) DryRunUpgrade(ctx sdk.Context, plan types.Plan, storeKeys []sdk.StoreKey) (types.DryRunReport, error) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		return types.DryRunReport{}, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no upgrade handler registered for %s", plan.Name)
+	}
+
+	// the state is never written, the migrations running on a cache of it
+	cacheCtx, _ := ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+
+	sizesBefore := make([]types.StoreSize, len(storeKeys))
+	for i, key := range storeKeys {
+		sizesBefore[i] = storeSize(cacheCtx, key)
+	}
+
+	report := types.DryRunReport{PlanName: plan.Name, Height: ctx.BlockHeight()}
+	previousReport := *k.migrationReport
+	*k.migrationReport = types.MigrationReport{PlanName: plan.Name, Height: ctx.BlockHeight()}
+	defer func() { *k.migrationReport = previousReport }()
+
+	start := time.Now()
+	err := runUpgradeHandler(cacheCtx, handler, plan, k.GetModuleVersionMap(cacheCtx))
+	report.Duration = time.Since(start)
+	report.Success = err == nil
+	if err != nil {
+		report.Error = err.Error()
+	}
+	report.Migrations = k.migrationReport.Results
+
+	for i, key := range storeKeys {
+		delta := types.StoreSizeDelta{Store: key.Name(), Before: sizesBefore[i], After: storeSize(cacheCtx, key)}
+		if delta.Before != delta.After {
+			report.StoreSizes = append(report.StoreSizes, delta)
+		}
+	}
+	sort.Slice(report.StoreSizes, func(i, j int) bool {
+		return report.StoreSizes[i].Store < report.StoreSizes[j].Store
+	})
+
+	return report, nil
+}
+
+// runUpgradeHandler runs the upgrade handler, turning a panic into an error.
+func runUpgradeHandler(ctx sdk.Context, handler types.UpgradeHandler, plan types.Plan, vm module.VersionMap) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade handler panicked: %v", r)
+		}
+	}()
+
+	_, err = handler(ctx, plan, vm)
+	return err
+}
+
+// storeSize iterates over the whole store to compute its size.
+func storeSize(ctx sdk.Context, key sdk.StoreKey) types.StoreSize {
+	it := ctx.KVStore(key).Iterator(nil, nil)
+	defer it.Close()
+
+	var size types.StoreSize
+	for ; it.Valid(); it.Next() {
+		size.Keys++
+		size.Bytes += int64(len(it.Key()) + len(it.Value()))
+	}
+
+	return size
+}
@@ -58,7 +58,13 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 		if version, ok := k.getModuleVersion(ctx, req.ModuleName); ok {
 			// return the requested module
 			res := []*types.ModuleVersion{{Name: req.ModuleName, Version: version}}
-			return &types.QueryModuleVersionsResponse{ModuleVersions: res}, nil
+			var migrations []types.ModuleMigration
+			for _, migration := range k.GetLastMigrations(ctx) {
+				if migration.Name == req.ModuleName {
+					migrations = append(migrations, migration)
+				}
+			}
+			return &types.QueryModuleVersionsResponse{ModuleVersions: res, LastMigrations: migrations}, nil
 		}
 		// module requested, but not found
 		return nil, errors.Wrapf(errors.ErrNotFound, "x/upgrade: QueryModuleVersions module %s not found", req.ModuleName)
@@ -68,5 +74,38 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 	mv := k.GetModuleVersions(ctx)
 	return &types.QueryModuleVersionsResponse{
 		ModuleVersions: mv,
+		LastMigrations: k.GetLastMigrations(ctx),
 	}, nil
 }
+
+// MigrationReport implements the Query/MigrationReport gRPC method
+func (k Keeper) MigrationReport(c context.Context, req *types.QueryMigrationReportRequest) (*types.QueryMigrationReportResponse, error) {
+	report, err := k.ReadMigrationReportFromDisk()
+	if err != nil {
+		return nil, errors.Wrap(err, "x/upgrade: failed to read migration report")
+	}
+
+	return &types.QueryMigrationReportResponse{Report: report}, nil
+}
+
+// ArtifactManifest implements the Query/ArtifactManifest gRPC method
+func (k Keeper) ArtifactManifest(c context.Context, req *types.QueryArtifactManifestRequest) (*types.QueryArtifactManifestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, errors.Wrap(errors.ErrNotFound, "x/upgrade: no upgrade scheduled")
+	}
+
+	manifest, err := types.ParseArtifactManifest(plan.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArtifactManifestResponse{PlanName: plan.Name, Manifest: manifest}, nil
+}
+
+// SkipUpgradeHeights implements the Query/SkipUpgradeHeights gRPC method
+func (k Keeper) SkipUpgradeHeights(c context.Context, req *types.QuerySkipUpgradeHeightsRequest) (*types.QuerySkipUpgradeHeightsResponse, error) {
+	return &types.QuerySkipUpgradeHeightsResponse{Heights: k.GetSkipUpgradeHeights()}, nil
+}
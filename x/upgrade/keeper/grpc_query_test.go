@@ -3,6 +3,7 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
@@ -203,6 +205,34 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestArtifactManifest() {
+	_, err := suite.queryClient.ArtifactManifest(gocontext.Background(), &types.QueryArtifactManifestRequest{})
+	suite.Require().Error(err)
+
+	url := "https://foo.bar/simd?checksum=sha256:" + strings.Repeat("ab", 32)
+	plan := types.Plan{Name: "test-plan", Height: 5, Info: `{"binaries":{"linux/amd64":"` + url + `"}}`}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	res, err := suite.queryClient.ArtifactManifest(gocontext.Background(), &types.QueryArtifactManifestRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal("test-plan", res.PlanName)
+	suite.Require().Equal([]types.Artifact{{Platform: "linux/amd64", Url: url, Sha256: strings.Repeat("ab", 32)}}, res.Manifest.Artifacts)
+}
+
+func (suite *UpgradeTestSuite) TestSkipUpgradeHeights() {
+	res, err := suite.queryClient.SkipUpgradeHeights(gocontext.Background(), &types.QuerySkipUpgradeHeightsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Heights)
+
+	k := keeper.NewKeeper(map[int64]bool{20: true, 10: true}, suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), suite.T().TempDir(), nil)
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, k)
+
+	res, err = types.NewQueryClient(queryHelper).SkipUpgradeHeights(gocontext.Background(), &types.QuerySkipUpgradeHeightsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]int64{10, 20}, res.Heights)
+}
+
 func TestUpgradeTestSuite(t *testing.T) {
 	suite.Run(t, new(UpgradeTestSuite))
 }
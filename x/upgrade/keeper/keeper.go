@@ -8,6 +8,10 @@ import (
 	"path"
 	"path/filepath"
 	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
 
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
@@ -23,15 +27,24 @@ import (
 )
 
 // UpgradeInfoFileName file to store upgrade information
-const UpgradeInfoFileName string = "upgrade-info.json"
+const UpgradeInfoFileName string = types.UpgradeInfoFileName
+
+// MigrationReportFileName file to store the migration report of the most recent upgrade
+const MigrationReportFileName string = "migration-report.json"
+
+var _ module.MigrationRecorder = Keeper{}
 
 type Keeper struct {
-	homePath           string                          // root directory of app config
-	skipUpgradeHeights map[int64]bool                  // map of heights to skip for an upgrade
-	storeKey           sdk.StoreKey                    // key to access x/upgrade store
-	cdc                codec.BinaryCodec               // App-wide binary codec
-	upgradeHandlers    map[string]types.UpgradeHandler // map of plan name to upgrade handler
-	versionSetter      xp.ProtocolVersionSetter        // implements setting the protocol version field on BaseApp
+	homePath           string                             // root directory of app config
+	skipUpgradeHeights map[int64]bool                     // map of heights to skip for an upgrade
+	storeKey           sdk.StoreKey                       // key to access x/upgrade store
+	cdc                codec.BinaryCodec                  // App-wide binary codec
+	upgradeHandlers    map[string]types.UpgradeHandler    // map of plan name to upgrade handler
+	versionSetter      xp.ProtocolVersionSetter           // implements setting the protocol version field on BaseApp
+	migrationReport    *types.MigrationReport             // migration results of the upgrade being applied
+	downloadBinaries   bool                               // whether the upgrade binaries are downloaded automatically
+	preUpgradeCheckers map[string]types.PreUpgradeChecker // map of module name to pre-upgrade checker
+	preUpgradeBlocks   int64                              // number of blocks before an upgrade at which the pre-upgrade checks run
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -48,9 +61,19 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey sdk.StoreKey, cdc cod
 		cdc:                cdc,
 		upgradeHandlers:    map[string]types.UpgradeHandler{},
 		versionSetter:      vs,
+		migrationReport:    &types.MigrationReport{},
+		preUpgradeCheckers: map[string]types.PreUpgradeChecker{},
+		preUpgradeBlocks:   types.DefaultPreUpgradeCheckBlocks,
 	}
 }
 
+// SetAllowDownloadBinaries sets whether the binaries of upgrades are downloaded
+// automatically, from the artifact manifest of the plan, by tooling such as
+// cosmovisor. When set, the node validates the manifest at the upgrade height.
+func (k *Keeper) SetAllowDownloadBinaries(allow bool) {
+	k.downloadBinaries = allow
+}
+
 // SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
 // with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
 // must be set even if it is a no-op function.
@@ -159,16 +182,77 @@ func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 	return 0, false
 }
 
+// RecordMigration implements module.MigrationRecorder. It adds the migration
+// result to the migration report of the upgrade being applied, and stores
+// successful migrations as the last applied migrations. The migrations of a
+// previous upgrade are cleared when the first migration is recorded.
+func (k Keeper) RecordMigration(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64, duration time.Duration, err error) {
+	if len(k.migrationReport.Results) == 0 {
+		k.clearLastMigrations(ctx)
+	}
+
+	migration := types.ModuleMigration{Name: moduleName, FromVersion: fromVersion, ToVersion: toVersion}
+	result := types.MigrationResult{Migration: migration, Duration: duration, Success: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	k.migrationReport.Results = append(k.migrationReport.Results, result)
+
+	if err == nil {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.MigrationByte})
+		store.Set([]byte(moduleName), k.cdc.MustMarshal(&migration))
+	}
+}
+
+// GetLastMigrations returns the migrations applied by the most recent upgrade
+// which ran migrations, ordered by module name.
+func (k Keeper) GetLastMigrations(ctx sdk.Context) []types.ModuleMigration {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, []byte{types.MigrationByte})
+	defer it.Close()
+
+	migrations := make([]types.ModuleMigration, 0)
+	for ; it.Valid(); it.Next() {
+		var migration types.ModuleMigration
+		k.cdc.MustUnmarshal(it.Value(), &migration)
+		migrations = append(migrations, migration)
+	}
+	return migrations
+}
+
+// clearLastMigrations deletes the migrations applied by a previous upgrade.
+func (k Keeper) clearLastMigrations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, []byte{types.MigrationByte})
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // ScheduleUpgrade schedules an upgrade based on the specified plan.
 // If there is another Plan already scheduled, it will overwrite it
 // (implicitly cancelling the current plan)
 // ScheduleUpgrade will also write the upgraded client to the upgraded client path
 // if an upgraded client is specified in the plan
+// The height of a plan scheduled by time is estimated from the average block time.
 func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	if err := plan.ValidateBasic(); err != nil {
 		return err
 	}
 
+	if plan.IsTimeBased() {
+		if !plan.Time.After(ctx.BlockTime()) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be scheduled in the past")
+		}
+		plan.Height = k.EstimateUpgradeHeight(ctx, plan.Time)
+	}
+
 	if plan.Height <= ctx.BlockHeight() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "upgrade cannot be scheduled in the past")
 	}
@@ -239,6 +323,27 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
+// GetLastUpgrade returns the height and block time at which the most recent
+// upgrade was applied, or false if no upgrade was ever applied.
+func (k Keeper) GetLastUpgrade(ctx sdk.Context) (height int64, t time.Time, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastUpgradeKey())
+	if bz == nil {
+		return 0, time.Time{}, false
+	}
+
+	t, err := sdk.ParseTimeBytes(bz[8:])
+	if err != nil {
+		panic(err)
+	}
+	return int64(sdk.BigEndianToUint64(bz[:8])), t, true
+}
+
+// setLastUpgrade stores the height and block time of the upgrade being applied
+func (k Keeper) setLastUpgrade(ctx sdk.Context) {
+	bz := append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), sdk.FormatTimeBytes(ctx.BlockTime())...)
+	ctx.KVStore(k.storeKey).Set(types.LastUpgradeKey(), bz)
+}
+
 // ClearIBCState clears any planned IBC state
 func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	// delete IBC client and consensus state from store if this is IBC plan
@@ -298,7 +403,14 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
+	*k.migrationReport = types.MigrationReport{PlanName: plan.Name, Height: ctx.BlockHeight()}
 	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	// the report is written before panicking so that failed migrations can be inspected
+	if len(k.migrationReport.Results) > 0 {
+		if dumpErr := k.DumpMigrationReportToDisk(); dumpErr != nil {
+			ctx.Logger().Error("failed to write migration report to disk", "err", dumpErr)
+		}
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -318,6 +430,29 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	k.ClearIBCState(ctx, plan.Height)
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
+	k.setLastUpgrade(ctx)
+}
+
+// ValidateUpgradeArtifact checks that the binary to upgrade to on this node's
+// platform can be downloaded from the artifact manifest of the plan. It is a
+// no-op unless downloading binaries was allowed with SetAllowDownloadBinaries.
+func (k Keeper) ValidateUpgradeArtifact(plan types.Plan) error {
+	if !k.downloadBinaries {
+		return nil
+	}
+
+	manifest, err := types.ParseArtifactManifest(plan.Info)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "upgrade %s has no artifact manifest", plan.Name)
+	}
+	if _, found := manifest.ArtifactFor(types.OSArch()); !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "upgrade %s has no binary for %s", plan.Name, types.OSArch())
+	}
+
+	return nil
 }
 
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
@@ -325,6 +460,20 @@ func (k Keeper) IsSkipHeight(height int64) bool {
 	return k.skipUpgradeHeights[height]
 }
 
+// GetSkipUpgradeHeights returns the upgrade heights skipped by this node, in
+// ascending order.
+func (k Keeper) GetSkipUpgradeHeights() []int64 {
+	heights := make([]int64, 0, len(k.skipUpgradeHeights))
+	for height, skip := range k.skipUpgradeHeights {
+		if skip {
+			heights = append(heights, height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	return heights
+}
+
 // DumpUpgradeInfoToDisk writes upgrade information to UpgradeInfoFileName. The function
 // doesn't save the `Plan.Info` data, hence it won't support auto download functionality
 // by cosmvisor.
@@ -401,6 +550,59 @@ func (k Keeper) ReadUpgradeInfoFromDisk() (store.UpgradeInfo, error) {
 	return upgradeInfo, nil
 }
 
+// DumpMigrationReportToDisk writes the migration report of the most recently
+// applied upgrade to MigrationReportFileName.
+func (k Keeper) DumpMigrationReportToDisk() error {
+	reportPath, err := k.GetMigrationReportPath()
+	if err != nil {
+		return err
+	}
+
+	bz, err := codec.ProtoMarshalJSON(k.migrationReport, nil)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(reportPath, bz, 0600)
+}
+
+// GetMigrationReportPath returns the migration report file path
+func (k Keeper) GetMigrationReportPath() (string, error) {
+	reportDir := path.Join(k.getHomeDir(), "data")
+	err := tmos.EnsureDir(reportDir, os.ModePerm)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(reportDir, MigrationReportFileName), nil
+}
+
+// ReadMigrationReportFromDisk returns the migration report written to disk by
+// the most recent upgrade which ran migrations. A nil report is returned if no
+// report was written.
+func (k Keeper) ReadMigrationReportFromDisk() (*types.MigrationReport, error) {
+	reportPath, err := k.GetMigrationReportPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(reportPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var report types.MigrationReport
+	if err := jsonpb.Unmarshal(strings.NewReader(string(data)), &report); err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
 // upgradeInfo is stripped types.Plan structure used to dump upgrade plan data.
 type upgradeInfo struct {
 	// Name has types.Plan.Name value
@@ -409,4 +611,7 @@ type upgradeInfo struct {
 	Height int64 `json:"height,omitempty"`
 	// Height has types.Plan.Height value
 	Info string `json:"info,omitempty"`
+	// Backup is the directory the application database was backed up in
+	// before the upgrade
+	Backup string `json:"backup,omitempty"`
 }
@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	store "github.com/cosmos/cosmos-sdk/store/types"
@@ -232,6 +235,292 @@ func (s *KeeperTestSuite) TestMigrations() {
 	s.Require().Equal(vmBefore["bank"]+1, vm["bank"])
 }
 
+func (s *KeeperTestSuite) TestMigrationReport() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+
+	// require no report when no migrations were run
+	report, err := keeper.ReadMigrationReportFromDisk()
+	require.NoError(err)
+	require.Nil(report)
+
+	keeper.SetUpgradeHandler("first", func(ctx sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		keeper.RecordMigration(ctx, "bank", 1, 2, time.Second, nil)
+		keeper.RecordMigration(ctx, "foo", 0, 1, time.Millisecond, nil)
+		return vm, nil
+	})
+	keeper.ApplyUpgrade(s.ctx, types.Plan{Name: "first", Height: s.ctx.BlockHeight()})
+
+	require.Equal([]types.ModuleMigration{
+		{Name: "bank", FromVersion: 1, ToVersion: 2},
+		{Name: "foo", FromVersion: 0, ToVersion: 1},
+	}, keeper.GetLastMigrations(s.ctx))
+	report, err = keeper.ReadMigrationReportFromDisk()
+	require.NoError(err)
+	require.Equal("first", report.PlanName)
+	require.Equal(s.ctx.BlockHeight(), report.Height)
+	require.Len(report.Results, 2)
+	require.Equal(time.Second, report.Results[0].Duration)
+	require.True(report.Results[1].Success)
+
+	// an upgrade without migrations keeps the last applied migrations
+	keeper.SetUpgradeHandler("second", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	keeper.ApplyUpgrade(s.ctx, types.Plan{Name: "second", Height: s.ctx.BlockHeight()})
+	require.Len(keeper.GetLastMigrations(s.ctx), 2)
+
+	// a failed migration is reported on disk before the upgrade panics
+	keeper.SetUpgradeHandler("third", func(ctx sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		err := fmt.Errorf("migration failed")
+		keeper.RecordMigration(ctx, "bank", 2, 3, time.Second, err)
+		return nil, err
+	})
+	require.Panics(func() {
+		keeper.ApplyUpgrade(s.ctx, types.Plan{Name: "third", Height: s.ctx.BlockHeight()})
+	})
+	report, err = keeper.ReadMigrationReportFromDisk()
+	require.NoError(err)
+	require.Equal("third", report.PlanName)
+	require.Equal([]types.MigrationResult{{
+		Migration: types.ModuleMigration{Name: "bank", FromVersion: 2, ToVersion: 3},
+		Duration:  time.Second,
+		Error:     "migration failed",
+	}}, report.Results)
+}
+
+func (s *KeeperTestSuite) TestValidateUpgradeArtifact() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+
+	checksum := strings.Repeat("ab", 32)
+	freeForm := types.Plan{Name: "free-form", Height: 100, Info: "some text here"}
+	other := types.Plan{Name: "other", Height: 100, Info: `{"binaries":{"other/arch":"https://foo.bar/simd?checksum=sha256:` + checksum + `"}}`}
+	native := types.Plan{Name: "native", Height: 100, Info: `{"binaries":{"` + types.OSArch() + `":"https://foo.bar/simd?checksum=sha256:` + checksum + `"}}`}
+
+	// nothing is validated unless binaries are downloaded
+	require.NoError(keeper.ValidateUpgradeArtifact(freeForm))
+
+	keeper.SetAllowDownloadBinaries(true)
+	require.Error(keeper.ValidateUpgradeArtifact(freeForm))
+	require.Error(keeper.ValidateUpgradeArtifact(other))
+	require.NoError(keeper.ValidateUpgradeArtifact(native))
+}
+
+func (s *KeeperTestSuite) TestTimeBasedUpgrade() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+	start := s.ctx.BlockTime()
+
+	// the default block time is used until a block time was sampled
+	keeper.TrackBlockTime(s.ctx)
+	require.Equal(types.DefaultBlockTime, keeper.AverageBlockTime(s.ctx))
+
+	// blocks of 10s are sampled every drift check interval
+	ctx := s.ctx.WithBlockHeight(types.DriftCheckInterval).WithBlockTime(start.Add(10 * time.Second * (types.DriftCheckInterval - 10)))
+	keeper.TrackBlockTime(ctx)
+	require.Equal(10*time.Second, keeper.AverageBlockTime(ctx))
+
+	plan := types.Plan{Name: "by-time", Time: ctx.BlockTime().Add(time.Hour)}
+	require.NoError(keeper.ScheduleUpgrade(ctx, plan))
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(found)
+	require.Equal(ctx.BlockHeight()+360, plan.Height)
+
+	// the time of a time-based plan is its upgrade time, and the time of a
+	// height-based plan is estimated at the average block time
+	require.Equal(plan.Time, keeper.EstimateUpgradeTime(ctx, plan))
+	byHeight := types.Plan{Name: "by-height", Height: ctx.BlockHeight() + 30}
+	require.Equal(ctx.BlockTime().Add(300*time.Second), keeper.EstimateUpgradeTime(ctx, byHeight))
+
+	// a plan in the past cannot be scheduled
+	require.Error(keeper.ScheduleUpgrade(ctx, types.Plan{Name: "past", Time: ctx.BlockTime()}))
+
+	// a small drift is tolerated
+	require.NoError(keeper.SetUpgradedClient(ctx, plan.Height, []byte("client")))
+	ctx = ctx.WithBlockHeight(2 * types.DriftCheckInterval).WithBlockTime(ctx.BlockTime().Add(1003 * time.Second))
+	keeper.TrackBlockTime(ctx)
+	require.Equal(plan, keeper.CorrectUpgradeDrift(ctx, plan))
+
+	// blocks slowing down to 20s reschedule the plan
+	ctx = ctx.WithBlockHeight(3 * types.DriftCheckInterval).WithBlockTime(ctx.BlockTime().Add(2000 * time.Second))
+	keeper.TrackBlockTime(ctx)
+	require.Equal(20*time.Second, keeper.AverageBlockTime(ctx))
+	rescheduled := keeper.CorrectUpgradeDrift(ctx, plan)
+	// 597s remain until the upgrade time
+	require.Equal(ctx.BlockHeight()+30, rescheduled.Height)
+	stored, _ := keeper.GetUpgradePlan(ctx)
+	require.Equal(rescheduled, stored)
+
+	// the upgraded client moved with the plan
+	_, found = keeper.GetUpgradedClient(ctx, plan.Height)
+	require.False(found)
+	bz, found := keeper.GetUpgradedClient(ctx, rescheduled.Height)
+	require.True(found)
+	require.Equal([]byte("client"), bz)
+}
+
+func (s *KeeperTestSuite) TestLastUpgrade() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+
+	s.T().Log("verify no last upgrade if no upgrade was applied")
+	_, _, found := keeper.GetLastUpgrade(s.ctx)
+	require.False(found)
+
+	keeper.SetUpgradeHandler("test0", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	keeper.ApplyUpgrade(s.ctx, types.Plan{
+		Name:   "test0",
+		Height: s.ctx.BlockHeight(),
+	})
+
+	s.T().Log("verify valid upgrade height and time")
+	height, t, found := keeper.GetLastUpgrade(s.ctx)
+	require.True(found)
+	require.Equal(s.ctx.BlockHeight(), height)
+	require.True(s.ctx.BlockTime().Equal(t))
+
+	// an upgrade with a name sorting before the previous one is applied later
+	keeper.SetUpgradeHandler("a", func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	newCtx := s.ctx.WithBlockHeight(15).WithBlockTime(s.ctx.BlockTime().Add(time.Hour))
+	keeper.ApplyUpgrade(newCtx, types.Plan{
+		Name:   "a",
+		Height: 15,
+	})
+
+	s.T().Log("verify the most recently applied upgrade is returned")
+	height, t, found = keeper.GetLastUpgrade(newCtx)
+	require.True(found)
+	require.Equal(int64(15), height)
+	require.True(newCtx.BlockTime().Equal(t))
+}
+
+func (s *KeeperTestSuite) TestDryRunUpgrade() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+	storeKey := s.app.GetKey(types.StoreKey)
+
+	_, err := keeper.DryRunUpgrade(s.ctx, types.Plan{Name: "unknown", Height: s.ctx.BlockHeight()}, nil)
+	require.Error(err)
+
+	keeper.SetUpgradeHandler("migrate", func(ctx sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.KVStore(storeKey).Set([]byte("foo"), []byte("bar"))
+		keeper.RecordMigration(ctx, "bank", 1, 2, time.Second, nil)
+		return vm, nil
+	})
+	report, err := keeper.DryRunUpgrade(s.ctx, types.Plan{Name: "migrate", Height: s.ctx.BlockHeight()}, []sdk.StoreKey{storeKey, s.app.GetKey("bank")})
+	require.NoError(err)
+	require.True(report.Success)
+	require.Equal(s.ctx.BlockHeight(), report.Height)
+	require.Len(report.Migrations, 1)
+	// only the upgrade store was changed, by the handler and the recorded migration
+	require.Len(report.StoreSizes, 1)
+	require.Equal(types.StoreKey, report.StoreSizes[0].Store)
+	require.Equal(int64(2), report.StoreSizes[0].KeysDelta())
+
+	// nothing was written to the state or to disk
+	require.False(s.ctx.KVStore(storeKey).Has([]byte("foo")))
+	require.Empty(keeper.GetLastMigrations(s.ctx))
+	require.Zero(keeper.GetDoneHeight(s.ctx, "migrate"))
+	migrationReport, err := keeper.ReadMigrationReportFromDisk()
+	require.NoError(err)
+	require.Nil(migrationReport)
+
+	keeper.SetUpgradeHandler("panic", func(_ sdk.Context, _ types.Plan, _ module.VersionMap) (module.VersionMap, error) {
+		panic("migration failed")
+	})
+	report, err = keeper.DryRunUpgrade(s.ctx, types.Plan{Name: "panic", Height: s.ctx.BlockHeight()}, nil)
+	require.NoError(err)
+	require.False(report.Success)
+	require.Contains(report.Error, "migration failed")
+}
+
+func (s *KeeperTestSuite) TestBackupBeforeUpgrade() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+	db := dbm.NewMemDB()
+	require.NoError(db.Set([]byte("foo"), []byte("bar")))
+
+	// no upgrade to apply
+	backup, err := keeper.BackupBeforeUpgrade(db)
+	require.NoError(err)
+	require.Empty(backup)
+
+	// the upgrade is not applied at the next height
+	require.NoError(keeper.DumpUpgradeInfoWithInfoToDisk(2, "test", "info"))
+	backup, err = keeper.BackupBeforeUpgrade(db)
+	require.NoError(err)
+	require.Empty(backup)
+
+	require.NoError(keeper.DumpUpgradeInfoWithInfoToDisk(1, "test", "info"))
+	backup, err = keeper.BackupBeforeUpgrade(db)
+	require.NoError(err)
+	require.Equal(types.UpgradeBackupDir(filepath.Join(s.homeDir, "data"), "test", 1), backup)
+
+	recorded, err := types.ReadUpgradeBackup(filepath.Join(s.homeDir, "data"))
+	require.NoError(err)
+	require.Equal(backup, recorded)
+	upgradeInfo, err := keeper.ReadUpgradeInfoFromDisk()
+	require.NoError(err)
+	require.Equal(store.UpgradeInfo{Name: "test", Height: 1}, upgradeInfo)
+
+	// restarting before the upgrade is applied keeps the first backup
+	again, err := keeper.BackupBeforeUpgrade(db)
+	require.NoError(err)
+	require.Equal(backup, again)
+}
+
+type preUpgradeChecker func(sdk.Context, types.Plan) error
+
+func (c preUpgradeChecker) PreUpgradeCheck(ctx sdk.Context, plan types.Plan) error { return c(ctx, plan) }
+
+func (s *KeeperTestSuite) TestRunPreUpgradeChecks() {
+	keeper := s.app.UpgradeKeeper
+	require := s.Require()
+	storeKey := s.app.GetKey(types.StoreKey)
+	plan := types.Plan{Name: "test", Height: 100}
+
+	require.Empty(keeper.RunPreUpgradeChecks(s.ctx, plan))
+
+	var order []string
+	keeper.SetPreUpgradeChecker("b", preUpgradeChecker(func(ctx sdk.Context, _ types.Plan) error {
+		order = append(order, "b")
+		ctx.KVStore(storeKey).Set([]byte("foo"), []byte("bar"))
+		return nil
+	}))
+	keeper.SetPreUpgradeChecker("a", preUpgradeChecker(func(_ sdk.Context, p types.Plan) error {
+		order = append(order, "a")
+		return fmt.Errorf("%s precondition not met", p.Name)
+	}))
+	keeper.SetPreUpgradeChecker("c", preUpgradeChecker(func(_ sdk.Context, _ types.Plan) error {
+		order = append(order, "c")
+		panic("check failed")
+	}))
+
+	failures := keeper.RunPreUpgradeChecks(s.ctx, plan)
+	require.Equal([]string{"a", "b", "c"}, order)
+	require.Len(failures, 2)
+	require.EqualError(failures["a"], "test precondition not met")
+	require.Contains(failures["c"].Error(), "check failed")
+	// the checks do not write to the state
+	require.False(s.ctx.KVStore(storeKey).Has([]byte("foo")))
+}
+
+func (s *KeeperTestSuite) TestIsPreUpgradeCheckHeight() {
+	keeper := s.app.UpgradeKeeper
+	plan := types.Plan{Name: "test", Height: s.ctx.BlockHeight() + types.DefaultPreUpgradeCheckBlocks}
+
+	s.Require().True(keeper.IsPreUpgradeCheckHeight(s.ctx, plan))
+	s.Require().False(keeper.IsPreUpgradeCheckHeight(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), plan))
+
+	keeper.SetPreUpgradeCheckBlocks(0)
+	s.Require().False(keeper.IsPreUpgradeCheckHeight(s.ctx.WithBlockHeight(plan.Height), plan))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
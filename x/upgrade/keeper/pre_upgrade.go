@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SetPreUpgradeCheckBlocks sets the number of blocks before the height of an
+// upgrade plan at which the pre-upgrade checks run. Zero disables the checks.
+func (k *Keeper) SetPreUpgradeCheckBlocks(blocks int64) {
+	k.preUpgradeBlocks = blocks
+}
+
+// SetPreUpgradeChecker sets the pre-upgrade checker of a module, run ahead of
+// every upgrade.
+func (k Keeper) SetPreUpgradeChecker(moduleName string, checker types.PreUpgradeChecker) {
+	k.preUpgradeCheckers[moduleName] = checker
+}
+
+// RegisterPreUpgradeCheckers sets the pre-upgrade checkers of the modules
+// implementing types.PreUpgradeChecker.
+func (k Keeper) RegisterPreUpgradeCheckers(modules map[string]module.AppModule) {
+	for name, m := range modules {
+		if checker, ok := m.(types.PreUpgradeChecker); ok {
+			k.SetPreUpgradeChecker(name, checker)
+		}
+	}
+}
+
+// IsPreUpgradeCheckHeight returns true if the pre-upgrade checks of the plan
+// run at the height of the context.
+func (k Keeper) IsPreUpgradeCheckHeight(ctx sdk.Context, plan types.Plan) bool {
+	return k.preUpgradeBlocks > 0 && plan.Height-k.preUpgradeBlocks == ctx.BlockHeight()
+}
+
+// RunPreUpgradeChecks runs the pre-upgrade checks of the modules, in the order
+// of their names, on a branch of the state which is discarded. A warning is
+// logged for every failed check, and the errors of the failed checks are
+// returned by module name. A panicking check is reported as failed.
+func (k Keeper) RunPreUpgradeChecks(ctx sdk.Context, plan types.Plan) map[string]error {
+	names := make([]string, 0, len(k.preUpgradeCheckers))
+	for name := range k.preUpgradeCheckers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	failures := make(map[string]error)
+	for _, name := range names {
+		cacheCtx, _ := ctx.CacheContext()
+		if err := runPreUpgradeCheck(cacheCtx, k.preUpgradeCheckers[name], plan); err != nil {
+			failures[name] = err
+			k.Logger(ctx).Error("pre-upgrade check failed", "upgrade", plan.Name, "height", plan.Height, "module", name, "err", err)
+		}
+	}
+
+	if len(failures) == 0 {
+		k.Logger(ctx).Info("pre-upgrade checks passed", "upgrade", plan.Name, "height", plan.Height, "checks", len(names))
+	}
+
+	return failures
+}
+
+// runPreUpgradeCheck runs the pre-upgrade check, turning a panic into an error.
+func runPreUpgradeCheck(ctx sdk.Context, checker types.PreUpgradeChecker, plan types.Plan) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("pre-upgrade check panicked: %v", r)
+		}
+	}()
+
+	return checker.PreUpgradeCheck(ctx, plan)
+}
@@ -25,8 +25,16 @@ func init() {
 }
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule        = AppModule{}
+	_ module.AppModuleBasic   = AppModuleBasic{}
+	_ types.PreUpgradeChecker = AppModule{}
+)
+
+// Module init related flags
+const (
+	FlagAllowDownloadBinaries = "x-upgrade-allow-download-binaries"
+	FlagPreUpgradeCheckBlocks = "x-upgrade-pre-check-blocks"
+	FlagBackupBeforeUpgrade   = "x-upgrade-backup-before-migrations"
 )
 
 // AppModuleBasic implements the sdk.AppModuleBasic interface
@@ -80,6 +88,20 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 	}
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Bool(FlagAllowDownloadBinaries, false, "Validate at the upgrade height that the upgrade binary of this node's platform can be downloaded from the plan artifact manifest")
+	startCmd.Flags().Int64(FlagPreUpgradeCheckBlocks, types.DefaultPreUpgradeCheckBlocks, "Number of blocks before the height of an upgrade at which the modules check its preconditions, warning of failed checks (0 to disable)")
+	startCmd.Flags().Bool(FlagBackupBeforeUpgrade, false, "Back the application database up in the upgrade-backups directory of the data directory before the store migrations of an upgrade run, recording its location in upgrade-info.json")
+}
+
+// PreUpgradeCheck implements types.PreUpgradeChecker, checking that the upgrade
+// binary of this node's platform can be downloaded when downloading binaries
+// is allowed.
+func (am AppModule) PreUpgradeCheck(_ sdk.Context, plan types.Plan) error {
+	return am.keeper.ValidateUpgradeArtifact(plan)
+}
+
 // RegisterInvariants does nothing, there are no invariants to enforce
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
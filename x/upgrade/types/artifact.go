@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"runtime"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// AnyPlatform is the platform of a binary which runs on every platform
+	AnyPlatform = "any"
+
+	// checksumPrefix prefixes the sha256 checksum in the checksum parameter of an artifact url
+	checksumPrefix = "sha256:"
+)
+
+// planBinaries is the format of the plan info listing the binaries of an upgrade.
+type planBinaries struct {
+	Binaries map[string]string `json:"binaries"`
+}
+
+// ParseArtifactManifest parses the artifact manifest from the info of an upgrade
+// plan. The info must list the binaries as
+// `{"binaries": {"<os>/<arch>": "<url>?checksum=sha256:<hex>"}}`. A nil manifest
+// is returned if the info is free-form and does not list any binaries.
+func ParseArtifactManifest(info string) (*ArtifactManifest, error) {
+	var binaries planBinaries
+	if err := json.Unmarshal([]byte(strings.TrimSpace(info)), &binaries); err != nil || binaries.Binaries == nil {
+		return nil, nil
+	}
+
+	manifest := &ArtifactManifest{Artifacts: make([]Artifact, 0, len(binaries.Binaries))}
+	for platform, rawURL := range binaries.Binaries {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid url of %s binary: %s", platform, err)
+		}
+		checksum := u.Query().Get("checksum")
+		if !strings.HasPrefix(checksum, checksumPrefix) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "url of %s binary must have a %s checksum parameter", platform, checksumPrefix)
+		}
+		manifest.Artifacts = append(manifest.Artifacts, Artifact{
+			Platform: platform,
+			Url:      rawURL,
+			Sha256:   strings.TrimPrefix(checksum, checksumPrefix),
+		})
+	}
+	sort.Slice(manifest.Artifacts, func(i, j int) bool {
+		return manifest.Artifacts[i].Platform < manifest.Artifacts[j].Platform
+	})
+
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Validate performs a basic validation of the artifact manifest
+func (m ArtifactManifest) Validate() error {
+	if len(m.Artifacts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "artifact manifest must list at least one binary")
+	}
+
+	platforms := make(map[string]bool, len(m.Artifacts))
+	for _, a := range m.Artifacts {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if platforms[a.Platform] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate binary for platform %s", a.Platform)
+		}
+		platforms[a.Platform] = true
+	}
+
+	return nil
+}
+
+// ArtifactFor returns the binary to upgrade to on the given platform, falling
+// back to the binary for any platform.
+func (m ArtifactManifest) ArtifactFor(platform string) (Artifact, bool) {
+	fallback, found := Artifact{}, false
+	for _, a := range m.Artifacts {
+		if a.Platform == platform {
+			return a, true
+		}
+		if a.Platform == AnyPlatform {
+			fallback, found = a, true
+		}
+	}
+	return fallback, found
+}
+
+// Validate performs a basic validation of the artifact
+func (a Artifact) Validate() error {
+	if a.Platform != AnyPlatform {
+		parts := strings.Split(a.Platform, "/")
+		if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid platform %q, expected os/arch or %s", a.Platform, AnyPlatform)
+		}
+	}
+
+	u, err := url.Parse(a.Url)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid url of %s binary: %s", a.Platform, err)
+	}
+	if len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "url of %s binary must be absolute", a.Platform)
+	}
+
+	checksum, err := hex.DecodeString(a.Sha256)
+	if err != nil || len(checksum) != 32 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sha256 checksum of %s binary", a.Platform)
+	}
+
+	return nil
+}
+
+// OSArch returns the os/arch platform of the running binary
+func OSArch() string {
+	return fmt.Sprintf("%s/%s", runtime.GOOS, runtime.GOARCH)
+}
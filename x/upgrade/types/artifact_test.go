@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestParseArtifactManifest(t *testing.T) {
+	checksum := strings.Repeat("ab", 32)
+	linuxURL := "https://foo.bar/simd-linux?checksum=sha256:" + checksum
+	anyURL := "https://foo.bar/simd.zip?checksum=sha256:" + checksum
+
+	cases := map[string]struct {
+		info     string
+		expected *types.ArtifactManifest
+		valid    bool
+	}{
+		"free-form info": {
+			info:  "https://foo.bar/baz",
+			valid: true,
+		},
+		"json without binaries": {
+			info:  `{"commit":"abc"}`,
+			valid: true,
+		},
+		"binaries ordered by platform": {
+			info: `{"binaries":{"linux/amd64":"` + linuxURL + `","any":"` + anyURL + `"}}`,
+			expected: &types.ArtifactManifest{Artifacts: []types.Artifact{
+				{Platform: "any", Url: anyURL, Sha256: checksum},
+				{Platform: "linux/amd64", Url: linuxURL, Sha256: checksum},
+			}},
+			valid: true,
+		},
+		"no binaries": {
+			info: `{"binaries":{}}`,
+		},
+		"missing checksum": {
+			info: `{"binaries":{"linux/amd64":"https://foo.bar/simd"}}`,
+		},
+		"md5 checksum": {
+			info: `{"binaries":{"linux/amd64":"https://foo.bar/simd?checksum=md5:` + strings.Repeat("ab", 16) + `"}}`,
+		},
+		"short checksum": {
+			info: `{"binaries":{"linux/amd64":"https://foo.bar/simd?checksum=sha256:abab"}}`,
+		},
+		"relative url": {
+			info: `{"binaries":{"linux/amd64":"simd?checksum=sha256:` + checksum + `"}}`,
+		},
+		"invalid platform": {
+			info: `{"binaries":{"linux":"` + linuxURL + `"}}`,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc // copy to local variable for scopelint
+		t.Run(name, func(t *testing.T) {
+			manifest, err := types.ParseArtifactManifest(tc.info)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, manifest)
+		})
+	}
+}
+
+func TestArtifactFor(t *testing.T) {
+	manifest := types.ArtifactManifest{Artifacts: []types.Artifact{
+		{Platform: "any", Url: "https://foo.bar/any"},
+		{Platform: "linux/amd64", Url: "https://foo.bar/linux"},
+	}}
+
+	artifact, found := manifest.ArtifactFor("linux/amd64")
+	require.True(t, found)
+	require.Equal(t, "https://foo.bar/linux", artifact.Url)
+
+	artifact, found = manifest.ArtifactFor("darwin/arm64")
+	require.True(t, found)
+	require.Equal(t, "https://foo.bar/any", artifact.Url)
+
+	_, found = types.ArtifactManifest{Artifacts: manifest.Artifacts[1:]}.ArtifactFor("darwin/arm64")
+	require.False(t, found)
+}
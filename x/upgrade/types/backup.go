@@ -0,0 +1,190 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// UpgradeInfoFileName is the name of the file of the data directory the
+	// upgrade information is written to when the chain halts for an upgrade
+	UpgradeInfoFileName = "upgrade-info.json"
+
+	// UpgradeBackupsDir is the directory of the data directory the application
+	// database is backed up in before the upgrades
+	UpgradeBackupsDir = "upgrade-backups"
+
+	// applicationDBName is the name of the application database
+	applicationDBName = "application"
+
+	// backupBatchSize is the number of entries written at once to a backup
+	backupBatchSize = 10000
+)
+
+// UpgradeBackupDir returns the directory the application database is backed
+// up in before the upgrade of the given name and height.
+func UpgradeBackupDir(dataDir, name string, height int64) string {
+	return filepath.Join(dataDir, UpgradeBackupsDir, fmt.Sprintf("%s-%d", name, height))
+}
+
+// BackupDB copies all the entries of db into a new application database in
+// dir, which must not exist. The directory is removed if the backup fails.
+func BackupDB(db dbm.DB, dir string) (err error) {
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		return fmt.Errorf("backup directory %s already exists", dir)
+	}
+
+	backup, err := sdk.NewLevelDB(applicationDBName, dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := backup.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	batch := backup.NewBatch()
+	defer func() { batch.Close() }()
+	for n := 0; it.Valid(); it.Next() {
+		if err := batch.Set(it.Key(), it.Value()); err != nil {
+			return err
+		}
+
+		if n++; n%backupBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = backup.NewBatch()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// ReadUpgradeBackup returns the directory of the backup of the application
+// database recorded in the upgrade info file of the data directory, or an
+// empty string if no backup was recorded.
+func ReadUpgradeBackup(dataDir string) (string, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dataDir, UpgradeInfoFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	var info struct {
+		Backup string `json:"backup"`
+	}
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return "", err
+	}
+
+	return info.Backup, nil
+}
+
+// RestoreDB replaces the application database of the data directory with the
+// one backed up in backupDir, which is kept. The replaced database is renamed
+// rather than deleted, and its new path returned. The files of the backup are
+// hard-linked, falling back to copies where links are not supported, except
+// for the files written in place by the database.
+func RestoreDB(dataDir, backupDir string) (string, error) {
+	src := filepath.Join(backupDir, applicationDBName+".db")
+	if _, err := os.Stat(src); err != nil {
+		return "", fmt.Errorf("no application database backed up in %s: %w", backupDir, err)
+	}
+
+	dst := filepath.Join(dataDir, applicationDBName+".db")
+	replaced := ""
+	if _, err := os.Stat(dst); err == nil {
+		replaced = fmt.Sprintf("%s.replaced-%d", dst, time.Now().Unix())
+		if err := os.Rename(dst, replaced); err != nil {
+			return "", err
+		}
+	}
+
+	if err := copyDBDir(src, dst); err != nil {
+		os.RemoveAll(dst)
+		if replaced != "" {
+			if renameErr := os.Rename(replaced, dst); renameErr != nil {
+				return "", fmt.Errorf("%v; failed to restore the replaced database %s: %w", err, replaced, renameErr)
+			}
+		}
+		return "", err
+	}
+
+	return replaced, nil
+}
+
+// copyDBDir copies the files of a database directory, hard-linking the table
+// files which are never modified once written.
+func copyDBDir(src, dst string) error {
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+
+		from, to := filepath.Join(src, f.Name()), filepath.Join(dst, f.Name())
+		if strings.HasSuffix(f.Name(), ".ldb") || strings.HasSuffix(f.Name(), ".sst") {
+			if err := os.Link(from, to); err == nil {
+				continue
+			}
+		}
+		if err := copyFile(from, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyFile(from, to string) error {
+	in, err := os.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(to, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
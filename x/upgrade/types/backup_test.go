@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBackupAndRestoreDB(t *testing.T) {
+	dataDir := t.TempDir()
+	k, v := []byte("key"), []byte("value")
+
+	db, err := sdk.NewLevelDB(applicationDBName, dataDir)
+	require.NoError(t, err)
+	initStore(t, db, "foo", k, v)
+
+	backupDir := UpgradeBackupDir(dataDir, "test", 2)
+	require.Equal(t, filepath.Join(dataDir, UpgradeBackupsDir, "test-2"), backupDir)
+	require.NoError(t, BackupDB(db, backupDir))
+	require.Error(t, BackupDB(db, backupDir), "the backup directory already exists")
+
+	// the migrations corrupt the database
+	require.NoError(t, db.Set([]byte("s/latest"), []byte("corrupted")))
+	require.NoError(t, db.Close())
+
+	backup, err := ReadUpgradeBackup(dataDir)
+	require.NoError(t, err)
+	require.Empty(t, backup)
+
+	bz, err := json.Marshal(map[string]interface{}{"name": "test", "height": 2, "backup": backupDir})
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dataDir, UpgradeInfoFileName), bz, 0600))
+	backup, err = ReadUpgradeBackup(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, backupDir, backup)
+
+	_, err = RestoreDB(dataDir, filepath.Join(dataDir, "unknown"))
+	require.Error(t, err)
+
+	replaced, err := RestoreDB(dataDir, backup)
+	require.NoError(t, err)
+	require.NotEmpty(t, replaced)
+	_, err = os.Stat(replaced)
+	require.NoError(t, err)
+
+	db, err = sdk.NewLevelDB(applicationDBName, dataDir)
+	require.NoError(t, err)
+	checkStore(t, db, 1, "foo", k, v)
+	require.NoError(t, db.Close())
+
+	// the backup is kept, and can be restored again
+	db, err = sdk.NewLevelDB(applicationDBName, backupDir)
+	require.NoError(t, err)
+	checkStore(t, db, 1, "foo", k, v)
+	require.NoError(t, db.Close())
+}
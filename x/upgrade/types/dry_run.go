@@ -0,0 +1,38 @@
+package types
+
+import "time"
+
+// StoreSize is the number of entries of a store and the sum of the sizes of
+// their keys and values.
+type StoreSize struct {
+	Keys  int64 `json:"keys"`
+	Bytes int64 `json:"bytes"`
+}
+
+// StoreSizeDelta is the size of a store before and after an upgrade.
+type StoreSizeDelta struct {
+	Store  string    `json:"store"`
+	Before StoreSize `json:"before"`
+	After  StoreSize `json:"after"`
+}
+
+// KeysDelta returns the number of entries added to the store by the upgrade.
+func (d StoreSizeDelta) KeysDelta() int64 { return d.After.Keys - d.Before.Keys }
+
+// BytesDelta returns the number of bytes added to the store by the upgrade.
+func (d StoreSizeDelta) BytesDelta() int64 { return d.After.Bytes - d.Before.Bytes }
+
+// DryRunReport is the outcome of the execution of an upgrade handler, and of
+// the store migrations it runs, on a branch of the state which is discarded.
+type DryRunReport struct {
+	PlanName string `json:"plan_name"`
+	Height   int64  `json:"height"`
+	// Duration is the wall-clock time the upgrade handler took.
+	Duration   time.Duration     `json:"duration"`
+	Success    bool              `json:"success"`
+	Error      string            `json:"error,omitempty"`
+	Migrations []MigrationResult `json:"migrations"`
+	// StoreSizes are the sizes of the stores whose content was changed by the
+	// upgrade, ordered by store name.
+	StoreSizes []StoreSizeDelta `json:"store_sizes"`
+}
@@ -0,0 +1,10 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeRescheduleUpgrade = "reschedule_upgrade"
+
+	AttributeKeyName           = "name"
+	AttributeKeyHeight         = "height"
+	AttributeKeyPreviousHeight = "previous_height"
+)
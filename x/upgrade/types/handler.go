@@ -24,3 +24,15 @@ import (
 //
 // Please also refer to docs/core/upgrade.md for more information.
 type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
+
+// PreUpgradeChecker is implemented by the modules, usually by their AppModule,
+// which validate the preconditions of an upgrade ahead of its height, so that
+// operators are warned of a failing upgrade before the chain halts for it.
+//
+// The checks run on a branch of the state which is discarded, a number of blocks
+// configured by the node, DefaultPreUpgradeCheckBlocks by default, before the
+// height of the plan. A failed check is only logged: it neither cancels the
+// upgrade nor halts the chain.
+type PreUpgradeChecker interface {
+	PreUpgradeCheck(ctx sdk.Context, plan Plan) error
+}
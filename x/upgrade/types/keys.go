@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
 
 const (
 	// ModuleName is the name of this module
@@ -28,6 +32,15 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// MigrationByte is a prefix to look up the migrations applied by the most recent upgrade by module name
+	MigrationByte = 0x4
+
+	// BlockTimeByte is a prefix to look up the block time samples (value) by block height (key)
+	BlockTimeByte = 0x5
+
+	// LastUpgradeByte specifies the Byte under which the height and time of the last applied upgrade are stored
+	LastUpgradeByte = 0x6
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -38,12 +51,45 @@ const (
 	KeyUpgradedConsState = "upgradedConsState"
 )
 
+const (
+	// DriftCheckInterval is the number of blocks between two block time samples,
+	// at which the height of a time-based upgrade plan is re-estimated
+	DriftCheckInterval = 100
+
+	// MaxUpgradeTimeDrift is the drift from its time above which the height of
+	// a time-based upgrade plan is re-estimated
+	MaxUpgradeTimeDrift = time.Minute
+
+	// DefaultBlockTime is the block time used to estimate upgrade heights
+	// before any block time was sampled
+	DefaultBlockTime = 5 * time.Second
+
+	// DefaultPreUpgradeCheckBlocks is the default number of blocks before the
+	// height of an upgrade plan at which the pre-upgrade checks run
+	DefaultPreUpgradeCheckBlocks = 100
+)
+
 // PlanKey is the key under which the current plan is saved
 // We store PlanByte as a const to keep it immutable (unlike a []byte)
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// BlockTimeKey is the key under which the time of the block at the given
+// height is sampled
+func BlockTimeKey(height int64) []byte {
+	bz := make([]byte, 9)
+	bz[0] = BlockTimeByte
+	binary.BigEndian.PutUint64(bz[1:], uint64(height))
+	return bz
+}
+
+// LastUpgradeKey is the key under which the height and time of the last
+// applied upgrade are saved
+func LastUpgradeKey() []byte {
+	return []byte{LastUpgradeByte}
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,30 +10,44 @@ import (
 
 func (p Plan) String() string {
 	due := p.DueAt()
+	if p.IsTimeBased() {
+		due = fmt.Sprintf("time: %s, estimated %s", p.Time.UTC().Format(time.RFC3339), due)
+	}
 	return fmt.Sprintf(`Upgrade Plan
   Name: %s
   %s
   Info: %s.`, p.Name, due, p.Info)
 }
 
-// ValidateBasic does basic validation of a Plan
+// ValidateBasic does basic validation of a Plan. A Plan is scheduled either by
+// time or by height, the height of a time-based Plan being estimated when it is
+// scheduled.
 func (p Plan) ValidateBasic() error {
-	if !p.Time.IsZero() {
-		return sdkerrors.ErrInvalidRequest.Wrap("time-based upgrades have been deprecated in the SDK")
-	}
 	if p.UpgradedClientState != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap("upgrade logic for IBC has been moved to the IBC module")
 	}
 	if len(p.Name) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name cannot be empty")
 	}
-	if p.Height <= 0 {
+	if p.IsTimeBased() {
+		if p.Height != 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "only one of time or height can be set")
+		}
+	} else if p.Height <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
+	if _, err := ParseArtifactManifest(p.Info); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// IsTimeBased returns true if the Plan was scheduled by time
+func (p Plan) IsTimeBased() bool {
+	return !p.Time.IsZero()
+}
+
 // ShouldExecute returns true if the Plan is ready to execute given the current context
 func (p Plan) ShouldExecute(ctx sdk.Context) bool {
 	if p.Height > 0 {
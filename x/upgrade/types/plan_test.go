@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -35,6 +36,14 @@ func TestPlanString(t *testing.T) {
 			},
 			expect: "Upgrade Plan\n  Name: by height\n  height: 7890\n  Info: https://foo.bar/baz.",
 		},
+		"with time": {
+			p: types.Plan{
+				Name:   "by time",
+				Time:   mustParseTime("2019-07-08T11:33:55Z"),
+				Height: 7890,
+			},
+			expect: "Upgrade Plan\n  Name: by time\n  time: 2019-07-08T11:33:55Z, estimated height: 7890\n  Info: .",
+		},
 		"neither": {
 			p: types.Plan{
 				Name: "almost-empty",
@@ -69,10 +78,24 @@ func TestPlanValid(t *testing.T) {
 				Height: 123450000,
 			},
 		},
-		"time-base upgrade": {
+		"proper by time": {
 			p: types.Plan{
+				Name: "all-good",
 				Time: time.Now(),
 			},
+			valid: true,
+		},
+		"time-base upgrade without name": {
+			p: types.Plan{
+				Time: time.Now(),
+			},
+		},
+		"both time and height": {
+			p: types.Plan{
+				Name:   "ambiguous",
+				Time:   time.Now(),
+				Height: 123450000,
+			},
 		},
 		"IBC upgrade": {
 			p: types.Plan{
@@ -92,6 +115,21 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"binaries with checksums": {
+			p: types.Plan{
+				Name:   "download",
+				Height: 123450000,
+				Info:   `{"binaries":{"linux/amd64":"https://foo.bar/simd?checksum=sha256:` + strings.Repeat("ab", 32) + `"}}`,
+			},
+			valid: true,
+		},
+		"binaries without checksums": {
+			p: types.Plan{
+				Name:   "download",
+				Height: 123450000,
+				Info:   `{"binaries":{"linux/amd64":"https://foo.bar/simd"}}`,
+			},
+		},
 	}
 
 	for name, tc := range cases {
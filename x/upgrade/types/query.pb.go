@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -365,6 +366,9 @@ func (m *QueryModuleVersionsRequest) GetModuleName() string {
 type QueryModuleVersionsResponse struct {
 	// module_versions is a list of module names with their consensus versions.
 	ModuleVersions []*ModuleVersion `protobuf:"bytes,1,rep,name=module_versions,json=moduleVersions,proto3" json:"module_versions,omitempty"`
+	// last_migrations are the migrations applied by the most recent upgrade
+	// which ran migrations.
+	LastMigrations []ModuleMigration `protobuf:"bytes,2,rep,name=last_migrations,json=lastMigrations,proto3" json:"last_migrations"`
 }
 
 func (m *QueryModuleVersionsResponse) Reset()         { *m = QueryModuleVersionsResponse{} }
@@ -407,6 +411,280 @@ func (m *QueryModuleVersionsResponse) GetModuleVersions() []*ModuleVersion {
 	return nil
 }
 
+func (m *QueryModuleVersionsResponse) GetLastMigrations() []ModuleMigration {
+	if m != nil {
+		return m.LastMigrations
+	}
+	return nil
+}
+
+// QueryMigrationReportRequest is the request type for the Query/MigrationReport
+// RPC method.
+type QueryMigrationReportRequest struct {
+}
+
+func (m *QueryMigrationReportRequest) Reset()         { *m = QueryMigrationReportRequest{} }
+func (m *QueryMigrationReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationReportRequest) ProtoMessage()    {}
+func (*QueryMigrationReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{8}
+}
+func (m *QueryMigrationReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationReportRequest.Merge(m, src)
+}
+func (m *QueryMigrationReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationReportRequest proto.InternalMessageInfo
+
+// QueryMigrationReportResponse is the response type for the Query/MigrationReport
+// RPC method.
+type QueryMigrationReportResponse struct {
+	// report is the migration report of the queried node. It is empty when the
+	// node has not run any migrations.
+	Report *MigrationReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (m *QueryMigrationReportResponse) Reset()         { *m = QueryMigrationReportResponse{} }
+func (m *QueryMigrationReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMigrationReportResponse) ProtoMessage()    {}
+func (*QueryMigrationReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{9}
+}
+func (m *QueryMigrationReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMigrationReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMigrationReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMigrationReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMigrationReportResponse.Merge(m, src)
+}
+func (m *QueryMigrationReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMigrationReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMigrationReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMigrationReportResponse proto.InternalMessageInfo
+
+func (m *QueryMigrationReportResponse) GetReport() *MigrationReport {
+	if m != nil {
+		return m.Report
+	}
+	return nil
+}
+
+// QueryArtifactManifestRequest is the request type for the Query/ArtifactManifest
+// RPC method.
+type QueryArtifactManifestRequest struct {
+}
+
+func (m *QueryArtifactManifestRequest) Reset()         { *m = QueryArtifactManifestRequest{} }
+func (m *QueryArtifactManifestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryArtifactManifestRequest) ProtoMessage()    {}
+func (*QueryArtifactManifestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryArtifactManifestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArtifactManifestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArtifactManifestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArtifactManifestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArtifactManifestRequest.Merge(m, src)
+}
+func (m *QueryArtifactManifestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArtifactManifestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArtifactManifestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArtifactManifestRequest proto.InternalMessageInfo
+
+// QueryArtifactManifestResponse is the response type for the Query/ArtifactManifest
+// RPC method.
+type QueryArtifactManifestResponse struct {
+	// plan_name is the name of the current upgrade plan.
+	PlanName string `protobuf:"bytes,1,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	// manifest is the artifact manifest of the current upgrade plan. It is empty
+	// when the plan info does not list any binaries.
+	Manifest *ArtifactManifest `protobuf:"bytes,2,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (m *QueryArtifactManifestResponse) Reset()         { *m = QueryArtifactManifestResponse{} }
+func (m *QueryArtifactManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryArtifactManifestResponse) ProtoMessage()    {}
+func (*QueryArtifactManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryArtifactManifestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryArtifactManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryArtifactManifestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryArtifactManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryArtifactManifestResponse.Merge(m, src)
+}
+func (m *QueryArtifactManifestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryArtifactManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryArtifactManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryArtifactManifestResponse proto.InternalMessageInfo
+
+func (m *QueryArtifactManifestResponse) GetPlanName() string {
+	if m != nil {
+		return m.PlanName
+	}
+	return ""
+}
+
+func (m *QueryArtifactManifestResponse) GetManifest() *ArtifactManifest {
+	if m != nil {
+		return m.Manifest
+	}
+	return nil
+}
+
+// QuerySkipUpgradeHeightsRequest is the request type for the Query/SkipUpgradeHeights
+// RPC method.
+type QuerySkipUpgradeHeightsRequest struct {
+}
+
+func (m *QuerySkipUpgradeHeightsRequest) Reset()         { *m = QuerySkipUpgradeHeightsRequest{} }
+func (m *QuerySkipUpgradeHeightsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkipUpgradeHeightsRequest) ProtoMessage()    {}
+func (*QuerySkipUpgradeHeightsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{12}
+}
+func (m *QuerySkipUpgradeHeightsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkipUpgradeHeightsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkipUpgradeHeightsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkipUpgradeHeightsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkipUpgradeHeightsRequest.Merge(m, src)
+}
+func (m *QuerySkipUpgradeHeightsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkipUpgradeHeightsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkipUpgradeHeightsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkipUpgradeHeightsRequest proto.InternalMessageInfo
+
+// QuerySkipUpgradeHeightsResponse is the response type for the Query/SkipUpgradeHeights
+// RPC method.
+type QuerySkipUpgradeHeightsResponse struct {
+	// heights are the upgrade heights skipped by the queried node, in ascending
+	// order.
+	Heights []int64 `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
+}
+
+func (m *QuerySkipUpgradeHeightsResponse) Reset()         { *m = QuerySkipUpgradeHeightsResponse{} }
+func (m *QuerySkipUpgradeHeightsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkipUpgradeHeightsResponse) ProtoMessage()    {}
+func (*QuerySkipUpgradeHeightsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{13}
+}
+func (m *QuerySkipUpgradeHeightsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkipUpgradeHeightsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkipUpgradeHeightsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySkipUpgradeHeightsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkipUpgradeHeightsResponse.Merge(m, src)
+}
+func (m *QuerySkipUpgradeHeightsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkipUpgradeHeightsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkipUpgradeHeightsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkipUpgradeHeightsResponse proto.InternalMessageInfo
+
+func (m *QuerySkipUpgradeHeightsResponse) GetHeights() []int64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -416,6 +694,12 @@ func init() {
 	proto.RegisterType((*QueryUpgradedConsensusStateResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradedConsensusStateResponse")
 	proto.RegisterType((*QueryModuleVersionsRequest)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsRequest")
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
+	proto.RegisterType((*QueryMigrationReportRequest)(nil), "cosmos.upgrade.v1beta1.QueryMigrationReportRequest")
+	proto.RegisterType((*QueryMigrationReportResponse)(nil), "cosmos.upgrade.v1beta1.QueryMigrationReportResponse")
+	proto.RegisterType((*QueryArtifactManifestRequest)(nil), "cosmos.upgrade.v1beta1.QueryArtifactManifestRequest")
+	proto.RegisterType((*QueryArtifactManifestResponse)(nil), "cosmos.upgrade.v1beta1.QueryArtifactManifestResponse")
+	proto.RegisterType((*QuerySkipUpgradeHeightsRequest)(nil), "cosmos.upgrade.v1beta1.QuerySkipUpgradeHeightsRequest")
+	proto.RegisterType((*QuerySkipUpgradeHeightsResponse)(nil), "cosmos.upgrade.v1beta1.QuerySkipUpgradeHeightsResponse")
 }
 
 func init() {
@@ -423,44 +707,61 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xae, 0xbb, 0x6e, 0x7a, 0x5f, 0x17, 0x0d, 0xe4, 0x43, 0xc9, 0xc2, 0x14, 0x2a, 0x33, 0xa0,
-	0x88, 0x35, 0xde, 0xda, 0x0b, 0x1a, 0x02, 0x01, 0x93, 0x10, 0x43, 0x30, 0x41, 0x11, 0x1c, 0xb8,
-	0x54, 0x6e, 0x63, 0xda, 0x88, 0x24, 0xce, 0x62, 0x67, 0x62, 0x9a, 0x76, 0xe1, 0xc4, 0x11, 0x89,
-	0x3b, 0x37, 0x2e, 0x7c, 0x12, 0x8e, 0x93, 0xb8, 0x70, 0xd8, 0x01, 0xb5, 0x7c, 0x10, 0x14, 0xc7,
-	0x45, 0x29, 0x4d, 0x3a, 0xe0, 0xd4, 0xc6, 0x7e, 0xfe, 0xfd, 0xe2, 0xc7, 0x81, 0xb8, 0xcf, 0x85,
-	0xcf, 0x05, 0x89, 0xc3, 0x41, 0x44, 0x1d, 0x46, 0xf6, 0x37, 0x7b, 0x4c, 0xd2, 0x4d, 0xb2, 0x17,
-	0xb3, 0xe8, 0xc0, 0x0e, 0x23, 0x2e, 0x39, 0xaa, 0xa5, 0x18, 0x5b, 0x63, 0x6c, 0x8d, 0x31, 0x57,
-	0x06, 0x9c, 0x0f, 0x3c, 0x46, 0x14, 0xaa, 0x17, 0xbf, 0x22, 0x34, 0xd0, 0x14, 0x73, 0x55, 0x6f,
-	0xd1, 0xd0, 0x25, 0x34, 0x08, 0xb8, 0xa4, 0xd2, 0xe5, 0x81, 0xd0, 0xbb, 0x6b, 0x05, 0xa6, 0x13,
-	0x03, 0x85, 0xc2, 0x2b, 0xf0, 0xfc, 0xd3, 0x24, 0xc5, 0x76, 0x1c, 0x45, 0x2c, 0x90, 0x4f, 0x3c,
-	0x1a, 0x74, 0xd8, 0x5e, 0xcc, 0x84, 0xc4, 0x8f, 0xa0, 0x31, 0xbb, 0x25, 0x42, 0x1e, 0x08, 0x86,
-	0x36, 0x60, 0x25, 0xf4, 0x68, 0x60, 0x80, 0x3a, 0x68, 0x54, 0x5b, 0xab, 0x76, 0x7e, 0x78, 0x5b,
-	0x71, 0x14, 0x12, 0x37, 0xb5, 0xd1, 0xdd, 0x30, 0xf4, 0x5c, 0xe6, 0x64, 0x8c, 0x10, 0x82, 0x95,
-	0x80, 0xfa, 0x4c, 0x89, 0xfd, 0xdf, 0x51, 0xff, 0x71, 0x4b, 0x9b, 0x4f, 0xc1, 0xb5, 0x79, 0x0d,
-	0x2e, 0x0d, 0x99, 0x3b, 0x18, 0x4a, 0xc5, 0x58, 0xe8, 0xe8, 0x27, 0xbc, 0x03, 0xb1, 0xe2, 0x3c,
-	0x4f, 0x53, 0x38, 0xdb, 0x09, 0x3a, 0x10, 0xb1, 0x78, 0x26, 0xa9, 0x64, 0x13, 0xb7, 0x8b, 0xb0,
-	0xea, 0x51, 0x21, 0xbb, 0x53, 0x12, 0x30, 0x59, 0x7a, 0xa0, 0x56, 0xb6, 0xca, 0x06, 0xc0, 0x2e,
-	0xbc, 0x34, 0x57, 0x4a, 0x27, 0xb9, 0x01, 0x0d, 0x3d, 0xb2, 0xd3, 0xed, 0x4f, 0x20, 0x5d, 0x91,
-	0x60, 0x8c, 0x72, 0x1d, 0x34, 0xce, 0x74, 0x6a, 0x71, 0xae, 0x42, 0x62, 0xf2, 0xb0, 0xf2, 0x1f,
-	0x38, 0x57, 0xc6, 0xb7, 0xa0, 0xa9, 0xac, 0x1e, 0x73, 0x27, 0xf6, 0xd8, 0x0b, 0x16, 0x89, 0xe4,
-	0x10, 0x33, 0x69, 0x7d, 0xb5, 0xd1, 0xcd, 0xbc, 0x22, 0x98, 0x2e, 0xed, 0x26, 0x2f, 0xca, 0x87,
-	0x17, 0x72, 0xe9, 0x3a, 0xe1, 0x2e, 0x3c, 0xab, 0xf9, 0xfb, 0x7a, 0xcb, 0x00, 0xf5, 0x85, 0x46,
-	0xb5, 0x75, 0xb9, 0xe8, 0xcc, 0xa6, 0x84, 0x3a, 0xcb, 0xfe, 0x94, 0x6e, 0xeb, 0x64, 0x11, 0x2e,
-	0x2a, 0x3f, 0xf4, 0x11, 0xc0, 0x6a, 0xa6, 0x1a, 0x88, 0x14, 0x09, 0x16, 0xf4, 0xcb, 0xdc, 0xf8,
-	0x73, 0x42, 0x3a, 0x0c, 0x5e, 0x7f, 0xfb, 0xf5, 0xc7, 0x87, 0xf2, 0x15, 0xb4, 0x46, 0x0a, 0xba,
-	0xdd, 0x4f, 0x49, 0xdd, 0xa4, 0x71, 0xe8, 0x13, 0x80, 0xd5, 0x4c, 0x7d, 0x4e, 0x09, 0x38, 0xdb,
-	0xcb, 0x53, 0x02, 0xe6, 0x34, 0x13, 0xb7, 0x55, 0xc0, 0x26, 0xba, 0x5e, 0x14, 0x90, 0xa6, 0x24,
-	0x15, 0x90, 0x1c, 0x26, 0x47, 0x7a, 0x84, 0x4e, 0x00, 0xac, 0xe5, 0xf7, 0x0c, 0x6d, 0xcd, 0x4d,
-	0x30, 0xb7, 0xe7, 0xe6, 0xcd, 0x7f, 0xe2, 0xea, 0x41, 0x76, 0xd4, 0x20, 0x77, 0xd0, 0x6d, 0x32,
-	0xff, 0x2b, 0x32, 0x53, 0x7b, 0x72, 0x98, 0xb9, 0x5c, 0x47, 0xef, 0xca, 0x00, 0x7d, 0x06, 0x70,
-	0x79, 0xba, 0x9c, 0xa8, 0x35, 0x37, 0x5a, 0xee, 0x45, 0x30, 0xdb, 0x7f, 0xc5, 0xd1, 0x63, 0x10,
-	0x35, 0xc6, 0x35, 0x74, 0xb5, 0x68, 0x8c, 0xdf, 0xee, 0xc6, 0xbd, 0xfb, 0x5f, 0x46, 0x16, 0x38,
-	0x1e, 0x59, 0xe0, 0xfb, 0xc8, 0x02, 0xef, 0xc7, 0x56, 0xe9, 0x78, 0x6c, 0x95, 0xbe, 0x8d, 0xad,
-	0xd2, 0xcb, 0xf5, 0x81, 0x2b, 0x87, 0x71, 0xcf, 0xee, 0x73, 0x7f, 0x22, 0x96, 0xfe, 0x34, 0x85,
-	0xf3, 0x9a, 0xbc, 0xf9, 0xa5, 0x2c, 0x0f, 0x42, 0x26, 0x7a, 0x4b, 0xea, 0xeb, 0xda, 0xfe, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0x80, 0xcd, 0x5d, 0xc6, 0xfa, 0x05, 0x00, 0x00,
+	// 856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0x33, 0x21, 0xa5, 0xf0, 0x52, 0x01, 0x1a, 0x55, 0xa9, 0x31, 0x60, 0x22, 0x97, 0x96,
+	0x50, 0x20, 0x0e, 0x81, 0xfe, 0x10, 0xa8, 0x3f, 0x80, 0xaa, 0x2a, 0x55, 0x41, 0xad, 0x51, 0x39,
+	0xf4, 0x62, 0x39, 0xc9, 0x60, 0x2c, 0x12, 0xdb, 0x78, 0x6c, 0x54, 0x84, 0x90, 0x2a, 0x4e, 0x3d,
+	0x56, 0xea, 0xbd, 0xb7, 0x5e, 0x7a, 0xe8, 0xa1, 0xd7, 0x3d, 0xaf, 0xc4, 0x11, 0x69, 0x2f, 0x7b,
+	0x58, 0xad, 0x56, 0xb0, 0x7f, 0xc8, 0xca, 0xe3, 0x71, 0x94, 0x5f, 0x63, 0xc8, 0x9e, 0x70, 0xde,
+	0xbc, 0xef, 0x7b, 0x9f, 0xe7, 0x79, 0xf9, 0x12, 0x50, 0xeb, 0x2e, 0x6d, 0xb9, 0x54, 0x0b, 0x3d,
+	0xcb, 0x37, 0x1b, 0x44, 0x3b, 0x5f, 0xab, 0x91, 0xc0, 0x5c, 0xd3, 0xce, 0x42, 0xe2, 0x5f, 0x94,
+	0x3d, 0xdf, 0x0d, 0x5c, 0x5c, 0x88, 0x73, 0xca, 0x3c, 0xa7, 0xcc, 0x73, 0xe4, 0x69, 0xcb, 0x75,
+	0xad, 0x26, 0xd1, 0x58, 0x56, 0x2d, 0x3c, 0xd6, 0x4c, 0x87, 0x4b, 0xe4, 0x59, 0x7e, 0x64, 0x7a,
+	0xb6, 0x66, 0x3a, 0x8e, 0x1b, 0x98, 0x81, 0xed, 0x3a, 0x94, 0x9f, 0xbe, 0x6f, 0xb9, 0x96, 0xcb,
+	0x1e, 0xb5, 0xe8, 0x89, 0x47, 0x17, 0x04, 0x28, 0x49, 0x5b, 0x96, 0xa5, 0x4e, 0xc3, 0x07, 0x3f,
+	0x47, 0x6c, 0xbb, 0xa1, 0xef, 0x13, 0x27, 0xf8, 0xa9, 0x69, 0x3a, 0x3a, 0x39, 0x0b, 0x09, 0x0d,
+	0xd4, 0x1f, 0x41, 0xea, 0x3f, 0xa2, 0x9e, 0xeb, 0x50, 0x82, 0x2b, 0x90, 0xf3, 0x9a, 0xa6, 0x23,
+	0xa1, 0x22, 0x2a, 0xe5, 0xab, 0xb3, 0xe5, 0xc1, 0x23, 0x95, 0x99, 0x86, 0x65, 0xaa, 0xab, 0xbc,
+	0xd1, 0xb6, 0xe7, 0x35, 0x6d, 0xd2, 0xe8, 0x68, 0x84, 0x31, 0xe4, 0x1c, 0xb3, 0x45, 0x58, 0xb1,
+	0x71, 0x9d, 0x3d, 0xab, 0x55, 0x90, 0xfa, 0xd3, 0x79, 0xf3, 0x02, 0x8c, 0x9e, 0x10, 0xdb, 0x3a,
+	0x09, 0x98, 0x62, 0x44, 0xe7, 0x9f, 0xd4, 0x3d, 0x50, 0x99, 0xe6, 0x97, 0x98, 0xa2, 0xb1, 0x1b,
+	0x65, 0x3b, 0x34, 0xa4, 0x87, 0x81, 0x19, 0x90, 0xa4, 0xdb, 0x3c, 0xe4, 0x9b, 0x26, 0x0d, 0x8c,
+	0xae, 0x12, 0x10, 0x85, 0xbe, 0x67, 0x91, 0xcd, 0xac, 0x84, 0x54, 0x1b, 0x3e, 0x4c, 0x2d, 0xc5,
+	0x49, 0xbe, 0x00, 0x89, 0x8f, 0xdc, 0x30, 0xea, 0x49, 0x8a, 0x41, 0xa3, 0x1c, 0x29, 0x5b, 0x44,
+	0xa5, 0xf7, 0xf4, 0x42, 0x38, 0xb0, 0x42, 0xd4, 0xe4, 0x87, 0xdc, 0x18, 0x9a, 0xca, 0xaa, 0x5f,
+	0x82, 0xcc, 0x5a, 0xed, 0xbb, 0x8d, 0xb0, 0x49, 0x8e, 0x88, 0x4f, 0xa3, 0xab, 0xed, 0xa0, 0x6d,
+	0xb1, 0x03, 0xa3, 0xe3, 0x15, 0x41, 0x1c, 0x3a, 0x88, 0x5e, 0xd4, 0x53, 0x04, 0x33, 0x03, 0xf5,
+	0x1c, 0xf1, 0x00, 0x26, 0x79, 0x81, 0x73, 0x7e, 0x24, 0xa1, 0xe2, 0x48, 0x29, 0x5f, 0xfd, 0x48,
+	0x74, 0x69, 0x5d, 0x85, 0xf4, 0x89, 0x56, 0x57, 0x5d, 0x7c, 0x04, 0x93, 0xec, 0xf5, 0xb5, 0x6c,
+	0xcb, 0x8f, 0xb7, 0x50, 0xca, 0xb2, 0x7a, 0x8b, 0xe9, 0xf5, 0xf6, 0x93, 0xfc, 0x9d, 0xdc, 0xcd,
+	0xcb, 0xf9, 0x8c, 0x3e, 0x11, 0x55, 0x69, 0x07, 0xa9, 0x3a, 0x97, 0x8c, 0x91, 0x84, 0x74, 0xe2,
+	0xb9, 0x7e, 0x90, 0x2c, 0xa3, 0x01, 0xb3, 0x83, 0x8f, 0xf9, 0x98, 0x5f, 0xc3, 0xa8, 0xcf, 0x22,
+	0x7c, 0x25, 0xc5, 0x34, 0x3d, 0x05, 0xb8, 0x4c, 0x55, 0x78, 0x83, 0x6d, 0x3f, 0xb0, 0x8f, 0xcd,
+	0x7a, 0xb0, 0x6f, 0x3a, 0xf6, 0x31, 0xa1, 0x6d, 0x80, 0x6b, 0x04, 0x73, 0x82, 0x04, 0x8e, 0x30,
+	0x03, 0xe3, 0xd1, 0xa6, 0x77, 0x5e, 0xd4, 0x58, 0x14, 0x88, 0xae, 0x09, 0x7f, 0x0b, 0x63, 0x2d,
+	0x2e, 0x60, 0x9b, 0x91, 0xaf, 0x96, 0x44, 0x84, 0x7d, 0x0d, 0xda, 0x4a, 0xb5, 0x08, 0x0a, 0x63,
+	0x38, 0x3c, 0xb5, 0x3d, 0xbe, 0x9a, 0xf1, 0xd2, 0x26, 0xfb, 0xa2, 0x6e, 0xc1, 0xbc, 0x30, 0x83,
+	0x73, 0x4a, 0xf0, 0x6e, 0xbc, 0xfb, 0xf1, 0x26, 0x8c, 0xe8, 0xc9, 0xc7, 0xea, 0xef, 0x00, 0xef,
+	0x30, 0x35, 0xfe, 0x1b, 0x41, 0xbe, 0xe3, 0x7b, 0x8f, 0x35, 0x11, 0xac, 0xc0, 0x3c, 0xe4, 0xca,
+	0xe3, 0x05, 0x31, 0x96, 0xba, 0x72, 0xfd, 0xec, 0xf5, 0x5f, 0xd9, 0x8f, 0xf1, 0x82, 0x26, 0x30,
+	0xae, 0x7a, 0x2c, 0x32, 0xa2, 0x77, 0x8a, 0xff, 0x41, 0x90, 0xef, 0xf0, 0x86, 0x07, 0x00, 0xfb,
+	0x4d, 0x47, 0xae, 0x3c, 0x5e, 0xc0, 0x01, 0xd7, 0x19, 0xe0, 0x2a, 0x5e, 0x16, 0x01, 0x9a, 0xb1,
+	0x88, 0x01, 0x6a, 0x97, 0xd1, 0x1a, 0x5c, 0xe1, 0x17, 0x08, 0x0a, 0x83, 0x4d, 0x04, 0x6f, 0xa6,
+	0x12, 0xa4, 0x9a, 0x98, 0xbc, 0xf5, 0x56, 0x5a, 0x3e, 0xc8, 0x1e, 0x1b, 0xe4, 0x1b, 0xfc, 0x95,
+	0x96, 0xfe, 0x2f, 0xa2, 0xcf, 0xd3, 0xb4, 0xcb, 0x0e, 0xe7, 0xbc, 0xfa, 0x23, 0x8b, 0xf0, 0xbf,
+	0x08, 0x26, 0xba, 0x8d, 0x07, 0x57, 0x53, 0xd1, 0x06, 0xba, 0x9c, 0xbc, 0x3e, 0x94, 0x86, 0x8f,
+	0xa1, 0xb1, 0x31, 0x96, 0xf0, 0xa2, 0x68, 0x8c, 0x1e, 0xdf, 0xc3, 0xff, 0x21, 0x98, 0xec, 0xf9,
+	0xfa, 0xe3, 0x07, 0x3a, 0x0f, 0x34, 0x23, 0x79, 0x63, 0x38, 0x11, 0xe7, 0xad, 0x30, 0xde, 0x4f,
+	0x70, 0x49, 0xc8, 0x9b, 0x08, 0x8d, 0xd8, 0x93, 0xf0, 0xff, 0x08, 0xa6, 0x7a, 0xdd, 0x00, 0xa7,
+	0x37, 0x17, 0xd8, 0x97, 0xfc, 0xe9, 0x90, 0x2a, 0xce, 0xbc, 0xc6, 0x98, 0x97, 0xf1, 0x92, 0x70,
+	0xe7, 0xb9, 0xd2, 0x48, 0x3c, 0x0a, 0x3f, 0x41, 0x80, 0xfb, 0xdd, 0x07, 0x7f, 0x96, 0x0a, 0x20,
+	0x34, 0x34, 0xf9, 0xf3, 0xa1, 0x75, 0x1c, 0x7d, 0x83, 0xa1, 0x97, 0xf1, 0x8a, 0x08, 0x9d, 0x9e,
+	0xda, 0x9e, 0xc1, 0x83, 0x7c, 0xa7, 0xe9, 0xce, 0x77, 0x37, 0x77, 0x0a, 0xba, 0xbd, 0x53, 0xd0,
+	0xab, 0x3b, 0x05, 0xfd, 0x79, 0xaf, 0x64, 0x6e, 0xef, 0x95, 0xcc, 0xf3, 0x7b, 0x25, 0xf3, 0xeb,
+	0x8a, 0x65, 0x07, 0x27, 0x61, 0xad, 0x5c, 0x77, 0x5b, 0x49, 0xc5, 0xf8, 0xcf, 0x2a, 0x6d, 0x9c,
+	0x6a, 0xbf, 0xb5, 0xcb, 0x07, 0x17, 0x1e, 0xa1, 0xb5, 0x51, 0xf6, 0xf3, 0x6a, 0xfd, 0xcd, 0x00,
+	0x2e, 0xf3, 0x87, 0x2f, 0x11, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -490,6 +791,15 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(ctx context.Context, in *QueryModuleVersionsRequest, opts ...grpc.CallOption) (*QueryModuleVersionsResponse, error)
+	// MigrationReport queries the results of the migrations run by the most
+	// recent upgrade, as recorded by the queried node.
+	MigrationReport(ctx context.Context, in *QueryMigrationReportRequest, opts ...grpc.CallOption) (*QueryMigrationReportResponse, error)
+	// ArtifactManifest queries the binaries to upgrade to for the current
+	// upgrade plan.
+	ArtifactManifest(ctx context.Context, in *QueryArtifactManifestRequest, opts ...grpc.CallOption) (*QueryArtifactManifestResponse, error)
+	// SkipUpgradeHeights queries the upgrade heights the queried node is
+	// configured to skip with --unsafe-skip-upgrades.
+	SkipUpgradeHeights(ctx context.Context, in *QuerySkipUpgradeHeightsRequest, opts ...grpc.CallOption) (*QuerySkipUpgradeHeightsResponse, error)
 }
 
 type queryClient struct {
@@ -537,6 +847,33 @@ func (c *queryClient) ModuleVersions(ctx context.Context, in *QueryModuleVersion
 	return out, nil
 }
 
+func (c *queryClient) MigrationReport(ctx context.Context, in *QueryMigrationReportRequest, opts ...grpc.CallOption) (*QueryMigrationReportResponse, error) {
+	out := new(QueryMigrationReportResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/MigrationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ArtifactManifest(ctx context.Context, in *QueryArtifactManifestRequest, opts ...grpc.CallOption) (*QueryArtifactManifestResponse, error) {
+	out := new(QueryArtifactManifestResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/ArtifactManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SkipUpgradeHeights(ctx context.Context, in *QuerySkipUpgradeHeightsRequest, opts ...grpc.CallOption) (*QuerySkipUpgradeHeightsResponse, error) {
+	out := new(QuerySkipUpgradeHeightsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/SkipUpgradeHeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -554,6 +891,15 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	ModuleVersions(context.Context, *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error)
+	// MigrationReport queries the results of the migrations run by the most
+	// recent upgrade, as recorded by the queried node.
+	MigrationReport(context.Context, *QueryMigrationReportRequest) (*QueryMigrationReportResponse, error)
+	// ArtifactManifest queries the binaries to upgrade to for the current
+	// upgrade plan.
+	ArtifactManifest(context.Context, *QueryArtifactManifestRequest) (*QueryArtifactManifestResponse, error)
+	// SkipUpgradeHeights queries the upgrade heights the queried node is
+	// configured to skip with --unsafe-skip-upgrades.
+	SkipUpgradeHeights(context.Context, *QuerySkipUpgradeHeightsRequest) (*QuerySkipUpgradeHeightsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -572,6 +918,15 @@ func (*UnimplementedQueryServer) UpgradedConsensusState(ctx context.Context, req
 func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryModuleVersionsRequest) (*QueryModuleVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModuleVersions not implemented")
 }
+func (*UnimplementedQueryServer) MigrationReport(ctx context.Context, req *QueryMigrationReportRequest) (*QueryMigrationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrationReport not implemented")
+}
+func (*UnimplementedQueryServer) ArtifactManifest(ctx context.Context, req *QueryArtifactManifestRequest) (*QueryArtifactManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArtifactManifest not implemented")
+}
+func (*UnimplementedQueryServer) SkipUpgradeHeights(ctx context.Context, req *QuerySkipUpgradeHeightsRequest) (*QuerySkipUpgradeHeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkipUpgradeHeights not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -649,6 +1004,60 @@ func _Query_ModuleVersions_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MigrationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMigrationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MigrationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/MigrationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MigrationReport(ctx, req.(*QueryMigrationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ArtifactManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArtifactManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ArtifactManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/ArtifactManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ArtifactManifest(ctx, req.(*QueryArtifactManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SkipUpgradeHeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkipUpgradeHeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkipUpgradeHeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/SkipUpgradeHeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkipUpgradeHeights(ctx, req.(*QuerySkipUpgradeHeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -669,6 +1078,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ModuleVersions",
 			Handler:    _Query_ModuleVersions_Handler,
 		},
+		{
+			MethodName: "MigrationReport",
+			Handler:    _Query_MigrationReport_Handler,
+		},
+		{
+			MethodName: "ArtifactManifest",
+			Handler:    _Query_ArtifactManifest_Handler,
+		},
+		{
+			MethodName: "SkipUpgradeHeights",
+			Handler:    _Query_SkipUpgradeHeights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -898,6 +1319,20 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastMigrations) > 0 {
+		for iNdEx := len(m.LastMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ModuleVersions) > 0 {
 		for iNdEx := len(m.ModuleVersions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -915,24 +1350,212 @@ func (m *QueryModuleVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryCurrentPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMigrationReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMigrationReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMigrationReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMigrationReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArtifactManifestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArtifactManifestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArtifactManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryArtifactManifestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryArtifactManifestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryArtifactManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Manifest != nil {
+		{
+			size, err := m.Manifest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanName) > 0 {
+		i -= len(m.PlanName)
+		copy(dAtA[i:], m.PlanName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkipUpgradeHeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkipUpgradeHeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkipUpgradeHeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkipUpgradeHeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkipUpgradeHeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkipUpgradeHeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Heights) > 0 {
+		dAtA5 := make([]byte, len(m.Heights)*10)
+		var j4 int
+		for _, num1 := range m.Heights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryCurrentPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryCurrentPlanResponse) Size() (n int) {
@@ -1023,6 +1646,85 @@ func (m *QueryModuleVersionsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.LastMigrations) > 0 {
+		for _, e := range m.LastMigrations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMigrationReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMigrationReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryArtifactManifestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryArtifactManifestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Manifest != nil {
+		l = m.Manifest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySkipUpgradeHeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySkipUpgradeHeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1617,6 +2319,520 @@ func (m *QueryModuleVersionsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastMigrations = append(m.LastMigrations, ModuleMigration{})
+			if err := m.LastMigrations[len(m.LastMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMigrationReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMigrationReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMigrationReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Report == nil {
+				m.Report = &MigrationReport{}
+			}
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArtifactManifestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArtifactManifestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArtifactManifestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryArtifactManifestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryArtifactManifestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryArtifactManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Manifest == nil {
+				m.Manifest = &ArtifactManifest{}
+			}
+			if err := m.Manifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkipUpgradeHeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkipUpgradeHeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkipUpgradeHeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkipUpgradeHeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkipUpgradeHeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkipUpgradeHeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])